@@ -169,6 +169,7 @@ require (
 	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/zookeeperreceiver v0.100.0 // indirect
 	github.com/samber/lo v1.38.1 // indirect
 	github.com/shoenig/go-m1cpu v0.1.6 // indirect
+	github.com/trinodb/trino-go-client v0.315.0 // indirect
 	go.opentelemetry.io/collector/exporter v0.100.1-0.20240509190532-c555005fcc80 // indirect
 	go.opentelemetry.io/collector/receiver v0.100.1-0.20240509190532-c555005fcc80 // indirect
 )
@@ -542,6 +543,7 @@ require (
 	github.com/open-telemetry/opentelemetry-collector-contrib/exporter/opensearchexporter v0.100.0 // indirect
 	github.com/open-telemetry/opentelemetry-collector-contrib/exporter/prometheusexporter v0.100.0 // indirect
 	github.com/open-telemetry/opentelemetry-collector-contrib/extension/ackextension v0.100.0 // indirect
+	github.com/open-telemetry/opentelemetry-collector-contrib/extension/ratelimiterextension v0.100.0 // indirect
 	github.com/open-telemetry/opentelemetry-collector-contrib/extension/asapauthextension v0.100.0 // indirect
 	github.com/open-telemetry/opentelemetry-collector-contrib/extension/awsproxy v0.100.0 // indirect
 	github.com/open-telemetry/opentelemetry-collector-contrib/extension/basicauthextension v0.100.0 // indirect
@@ -1222,6 +1224,8 @@ replace github.com/open-telemetry/opentelemetry-collector-contrib/extension/enco
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/extension/ackextension => ../../extension/ackextension
 
+replace github.com/open-telemetry/opentelemetry-collector-contrib/extension/ratelimiterextension => ../../extension/ratelimiterextension
+
 replace github.com/open-telemetry/opentelemetry-collector-contrib/connector/grafanacloudconnector => ../../connector/grafanacloudconnector
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/extension/sumologicextension => ../../extension/sumologicextension
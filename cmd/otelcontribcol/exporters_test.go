@@ -125,6 +125,10 @@ func TestDefaultExporters(t *testing.T) {
 		{
 			exporter: "debug",
 		},
+		{
+			exporter:      "mqtt",
+			skipLifecycle: true, // Requires an MQTT broker
+		},
 		{
 			exporter: "opencensus",
 			getConfigFn: func() component.Config {
@@ -176,6 +180,10 @@ func TestDefaultExporters(t *testing.T) {
 			},
 			expectConsumeErr: true,
 		},
+		{
+			exporter:      "parquet",
+			skipLifecycle: true, // Writes to the local file system
+		},
 		{
 			exporter: "prometheus",
 			getConfigFn: func() component.Config {
@@ -332,6 +340,10 @@ func TestDefaultExporters(t *testing.T) {
 				return cfg
 			},
 		},
+		{
+			exporter:      "bigquery",
+			skipLifecycle: true, // Requires credentials to be able to successfully load the exporter
+		},
 		{
 			exporter: "carbon",
 			getConfigFn: func() component.Config {
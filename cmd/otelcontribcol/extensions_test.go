@@ -272,6 +272,12 @@ func TestDefaultExtensions(t *testing.T) {
 			},
 			skipLifecycle: true,
 		},
+		{
+			extension: "ratelimiter",
+		},
+		{
+			extension: "watchdog",
+		},
 	}
 
 	extensionCount := 0
@@ -9,14 +9,17 @@ toolchain go1.21.10
 require (
 	github.com/open-telemetry/opentelemetry-collector-contrib/confmap/provider/s3provider v0.100.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/confmap/provider/secretsmanagerprovider v0.100.0
+	github.com/open-telemetry/opentelemetry-collector-contrib/confmap/provider/vaultprovider v0.100.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/connector/countconnector v0.100.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/connector/datadogconnector v0.100.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/connector/exceptionsconnector v0.100.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/connector/failoverconnector v0.100.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/connector/grafanacloudconnector v0.100.0
+	github.com/open-telemetry/opentelemetry-collector-contrib/connector/logmetricsconnector v0.100.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/connector/roundrobinconnector v0.100.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/connector/routingconnector v0.100.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/connector/servicegraphconnector v0.100.0
+	github.com/open-telemetry/opentelemetry-collector-contrib/connector/spaneventsconnector v0.100.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/connector/spanmetricsconnector v0.100.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/exporter/alertmanagerexporter v0.100.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/exporter/alibabacloudlogserviceexporter v0.100.0
@@ -27,6 +30,7 @@ require (
 	github.com/open-telemetry/opentelemetry-collector-contrib/exporter/awsxrayexporter v0.100.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/exporter/azuredataexplorerexporter v0.100.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/exporter/azuremonitorexporter v0.100.0
+	github.com/open-telemetry/opentelemetry-collector-contrib/exporter/bigqueryexporter v0.100.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/exporter/carbonexporter v0.100.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/exporter/cassandraexporter v0.100.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/exporter/clickhouseexporter v0.100.0
@@ -47,8 +51,10 @@ require (
 	github.com/open-telemetry/opentelemetry-collector-contrib/exporter/logzioexporter v0.100.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/exporter/lokiexporter v0.100.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/exporter/mezmoexporter v0.100.0
+	github.com/open-telemetry/opentelemetry-collector-contrib/exporter/mqttexporter v0.100.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/exporter/opencensusexporter v0.100.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/exporter/opensearchexporter v0.100.0
+	github.com/open-telemetry/opentelemetry-collector-contrib/exporter/parquetexporter v0.100.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/exporter/prometheusexporter v0.100.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/exporter/prometheusremotewriteexporter v0.100.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/exporter/pulsarexporter v0.100.0
@@ -86,12 +92,14 @@ require (
 	github.com/open-telemetry/opentelemetry-collector-contrib/extension/oidcauthextension v0.100.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/extension/opampextension v0.100.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/extension/pprofextension v0.100.0
+	github.com/open-telemetry/opentelemetry-collector-contrib/extension/ratelimiterextension v0.100.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/extension/remotetapextension v0.100.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/extension/sigv4authextension v0.100.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/extension/solarwindsapmsettingsextension v0.100.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/dbstorage v0.100.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/filestorage v0.100.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/extension/sumologicextension v0.100.0
+	github.com/open-telemetry/opentelemetry-collector-contrib/extension/watchdogextension v0.100.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/internal/common v0.100.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza v0.100.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/processor/attributesprocessor v0.100.0
@@ -102,8 +110,10 @@ require (
 	github.com/open-telemetry/opentelemetry-collector-contrib/processor/groupbytraceprocessor v0.100.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/processor/intervalprocessor v0.100.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/processor/k8sattributesprocessor v0.100.0
+	github.com/open-telemetry/opentelemetry-collector-contrib/processor/k8slookupprocessor v0.100.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/processor/metricsgenerationprocessor v0.100.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/processor/metricstransformprocessor v0.100.0
+	github.com/open-telemetry/opentelemetry-collector-contrib/processor/piifilterprocessor v0.100.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/processor/probabilisticsamplerprocessor v0.100.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/processor/redactionprocessor v0.100.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/processor/remotetapprocessor v0.100.0
@@ -113,6 +123,7 @@ require (
 	github.com/open-telemetry/opentelemetry-collector-contrib/processor/spanprocessor v0.100.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/processor/sumologicprocessor v0.100.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/processor/tailsamplingprocessor v0.100.0
+	github.com/open-telemetry/opentelemetry-collector-contrib/processor/topologyprocessor v0.100.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor v0.100.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/activedirectorydsreceiver v0.100.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/aerospikereceiver v0.100.0
@@ -161,6 +172,7 @@ require (
 	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/memcachedreceiver v0.100.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/mongodbatlasreceiver v0.100.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/mongodbreceiver v0.100.0
+	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/mqttreceiver v0.100.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/mysqlreceiver v0.100.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/namedpipereceiver v0.100.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/nginxreceiver v0.100.0
@@ -177,6 +189,7 @@ require (
 	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/rabbitmqreceiver v0.100.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/receivercreator v0.100.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/redisreceiver v0.100.0
+	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/restreceiver v0.100.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/riakreceiver v0.100.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/sapmreceiver v0.100.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/signalfxreceiver v0.100.0
@@ -436,6 +449,7 @@ require (
 	github.com/eapache/go-resiliency v1.6.0 // indirect
 	github.com/eapache/go-xerial-snappy v0.0.0-20230731223053-c322873962e3 // indirect
 	github.com/eapache/queue v1.1.0 // indirect
+	github.com/eclipse/paho.golang v0.21.0 // indirect
 	github.com/elastic/elastic-transport-go/v8 v8.5.0 // indirect
 	github.com/elastic/go-docappender/v2 v2.1.1 // indirect
 	github.com/elastic/go-elasticsearch/v7 v7.17.10 // indirect
@@ -474,7 +488,7 @@ require (
 	github.com/goccy/go-json v0.10.2 // indirect
 	github.com/gocql/gocql v1.6.0 // indirect
 	github.com/godbus/dbus v0.0.0-20190726142602-4481cbc300e2 // indirect
-	github.com/godbus/dbus/v5 v5.0.6 // indirect
+	github.com/godbus/dbus/v5 v5.1.0 // indirect
 	github.com/gofrs/uuid v4.4.0+incompatible // indirect
 	github.com/gogo/googleapis v1.4.1 // indirect
 	github.com/gogo/protobuf v1.3.2 // indirect
@@ -526,6 +540,7 @@ require (
 	github.com/hectane/go-acl v0.0.0-20190604041725-da78bae5fc95 // indirect
 	github.com/hetznercloud/hcloud-go/v2 v2.6.0 // indirect
 	github.com/iancoleman/strcase v0.3.0 // indirect
+	github.com/ibmdb/go_ibm_db v0.4.5 // indirect
 	github.com/imdario/mergo v0.3.16 // indirect
 	github.com/inconshreveable/mousetrap v1.1.0 // indirect
 	github.com/influxdata/go-syslog/v3 v3.0.1-0.20230911200830-875f5bc594a4 // indirect
@@ -622,6 +637,7 @@ require (
 	github.com/open-telemetry/opentelemetry-collector-contrib/internal/kafka v0.100.0 // indirect
 	github.com/open-telemetry/opentelemetry-collector-contrib/internal/kubelet v0.100.0 // indirect
 	github.com/open-telemetry/opentelemetry-collector-contrib/internal/metadataproviders v0.100.0 // indirect
+	github.com/open-telemetry/opentelemetry-collector-contrib/internal/mqtt v0.100.0 // indirect
 	github.com/open-telemetry/opentelemetry-collector-contrib/internal/sharedcomponent v0.100.0 // indirect
 	github.com/open-telemetry/opentelemetry-collector-contrib/internal/splunk v0.100.0 // indirect
 	github.com/open-telemetry/opentelemetry-collector-contrib/internal/sqlquery v0.100.0 // indirect
@@ -715,6 +731,7 @@ require (
 	github.com/tinylib/msgp v1.1.9 // indirect
 	github.com/tklauser/go-sysconf v0.3.14 // indirect
 	github.com/tklauser/numcpus v0.8.0 // indirect
+	github.com/trinodb/trino-go-client v0.315.0 // indirect
 	github.com/valyala/fastjson v1.6.4 // indirect
 	github.com/vincent-petithory/dataurl v1.0.0 // indirect
 	github.com/vishvananda/netlink v1.1.1-0.20201029203352-d40f9887b852 // indirect
@@ -858,6 +875,7 @@ replace github.com/open-telemetry/opentelemetry-collector-contrib/receiver/postg
 replace github.com/open-telemetry/opentelemetry-collector-contrib/receiver/receivercreator => ../../receiver/receivercreator
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/processor/k8sattributesprocessor => ../../processor/k8sattributesprocessor
+replace github.com/open-telemetry/opentelemetry-collector-contrib/processor/k8slookupprocessor => ../../processor/k8slookupprocessor
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/exporter/awsemfexporter => ../../exporter/awsemfexporter
 
@@ -1003,6 +1021,8 @@ replace github.com/open-telemetry/opentelemetry-collector-contrib/receiver/fluen
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/receiver/redisreceiver => ../../receiver/redisreceiver
 
+replace github.com/open-telemetry/opentelemetry-collector-contrib/receiver/restreceiver => ../../receiver/restreceiver
+
 replace github.com/open-telemetry/opentelemetry-collector-contrib/extension/basicauthextension => ../../extension/basicauthextension
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/exporter/influxdbexporter => ../../exporter/influxdbexporter
@@ -1059,6 +1079,14 @@ replace github.com/open-telemetry/opentelemetry-collector-contrib/extension/sigv
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/opencensus => ../../pkg/translator/opencensus
 
+replace github.com/open-telemetry/opentelemetry-collector-contrib/exporter/mqttexporter => ../../exporter/mqttexporter
+
+replace github.com/open-telemetry/opentelemetry-collector-contrib/internal/mqtt => ../../internal/mqtt
+
+replace github.com/open-telemetry/opentelemetry-collector-contrib/receiver/mqttreceiver => ../../receiver/mqttreceiver
+
+replace github.com/open-telemetry/opentelemetry-collector-contrib/exporter/parquetexporter => ../../exporter/parquetexporter
+
 replace github.com/open-telemetry/opentelemetry-collector-contrib/exporter/pulsarexporter => ../../exporter/pulsarexporter
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/exporter/zipkinexporter => ../../exporter/zipkinexporter
@@ -1167,10 +1195,14 @@ replace github.com/open-telemetry/opentelemetry-collector-contrib/receiver/riakr
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/processor/tailsamplingprocessor => ../../processor/tailsamplingprocessor
 
+replace github.com/open-telemetry/opentelemetry-collector-contrib/processor/topologyprocessor => ../../processor/topologyprocessor
+
 replace github.com/open-telemetry/opentelemetry-collector-contrib/receiver/syslogreceiver => ../../receiver/syslogreceiver
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourceprocessor => ../../processor/resourceprocessor
 
+replace github.com/open-telemetry/opentelemetry-collector-contrib/exporter/bigqueryexporter => ../../exporter/bigqueryexporter
+
 replace github.com/open-telemetry/opentelemetry-collector-contrib/exporter/carbonexporter => ../../exporter/carbonexporter
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/pkg/winperfcounters => ../../pkg/winperfcounters
@@ -1189,6 +1221,8 @@ replace github.com/open-telemetry/opentelemetry-collector-contrib/exporter/honey
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/receiver/otlpjsonfilereceiver => ../../receiver/otlpjsonfilereceiver
 
+replace github.com/open-telemetry/opentelemetry-collector-contrib/processor/piifilterprocessor => ../../processor/piifilterprocessor
+
 replace github.com/open-telemetry/opentelemetry-collector-contrib/processor/redactionprocessor => ../../processor/redactionprocessor
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/extension/jaegerremotesampling => ../../extension/jaegerremotesampling
@@ -1241,12 +1275,16 @@ replace github.com/open-telemetry/opentelemetry-collector-contrib/connector/fail
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/connector/grafanacloudconnector => ../../connector/grafanacloudconnector
 
+replace github.com/open-telemetry/opentelemetry-collector-contrib/connector/logmetricsconnector => ../../connector/logmetricsconnector
+
 replace github.com/open-telemetry/opentelemetry-collector-contrib/connector/roundrobinconnector => ../../connector/roundrobinconnector
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/connector/routingconnector => ../../connector/routingconnector
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/connector/servicegraphconnector => ../../connector/servicegraphconnector
 
+replace github.com/open-telemetry/opentelemetry-collector-contrib/connector/spaneventsconnector => ../../connector/spaneventsconnector
+
 replace github.com/open-telemetry/opentelemetry-collector-contrib/connector/spanmetricsconnector => ../../connector/spanmetricsconnector
 
 replace github.com/openshift/api v3.9.0+incompatible => github.com/openshift/api v0.0.0-20180801171038-322a19404e37
@@ -1279,12 +1317,16 @@ replace github.com/open-telemetry/opentelemetry-collector-contrib/extension/sola
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/extension/sumologicextension => ../../extension/sumologicextension
 
+replace github.com/open-telemetry/opentelemetry-collector-contrib/extension/watchdogextension => ../../extension/watchdogextension
+
 replace github.com/open-telemetry/opentelemetry-collector-contrib/receiver/namedpipereceiver => ../../receiver/namedpipereceiver
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/internal/sqlquery => ../../internal/sqlquery
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/extension/ackextension => ../../extension/ackextension
 
+replace github.com/open-telemetry/opentelemetry-collector-contrib/extension/ratelimiterextension => ../../extension/ratelimiterextension
+
 replace github.com/open-telemetry/opentelemetry-collector-contrib/extension/googleclientauthextension => ../../extension/googleclientauthextension
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/receiver/splunkenterprisereceiver => ../../receiver/splunkenterprisereceiver
@@ -1295,4 +1337,7 @@ replace github.com/open-telemetry/opentelemetry-collector-contrib/confmap/provid
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/confmap/provider/secretsmanagerprovider => ../../confmap/provider/secretsmanagerprovider
 
+
+replace github.com/open-telemetry/opentelemetry-collector-contrib/confmap/provider/vaultprovider => ../../confmap/provider/vaultprovider
+
 replace github.com/open-telemetry/opentelemetry-collector-contrib/pkg/sampling => ../../pkg/sampling
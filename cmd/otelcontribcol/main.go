@@ -18,6 +18,7 @@ import (
 
 	s3provider "github.com/open-telemetry/opentelemetry-collector-contrib/confmap/provider/s3provider"
 	secretsmanagerprovider "github.com/open-telemetry/opentelemetry-collector-contrib/confmap/provider/secretsmanagerprovider"
+	vaultprovider "github.com/open-telemetry/opentelemetry-collector-contrib/confmap/provider/vaultprovider"
 )
 
 func main() {
@@ -40,6 +41,7 @@ func main() {
 					yamlprovider.NewFactory(),
 					s3provider.NewFactory(),
 					secretsmanagerprovider.NewFactory(),
+					vaultprovider.NewFactory(),
 				},
 				ConverterFactories: []confmap.ConverterFactory{
 					expandconverter.NewFactory(),
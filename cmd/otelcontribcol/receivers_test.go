@@ -33,6 +33,7 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/otlpjsonfilereceiver"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/prometheusreceiver"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/redisreceiver"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/restreceiver"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/snmpreceiver"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/syslogreceiver"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/tcplogreceiver"
@@ -275,6 +276,10 @@ func TestDefaultReceivers(t *testing.T) {
 				return cfg
 			},
 		},
+		{
+			receiver:      "mqtt",
+			skipLifecycle: true, // Requires an MQTT broker
+		},
 		{
 			receiver: "mysql",
 		},
@@ -343,6 +348,14 @@ func TestDefaultReceivers(t *testing.T) {
 				return cfg
 			},
 		},
+		{
+			receiver: "rest",
+			getConfigFn: func() component.Config {
+				cfg := rcvrFactories["rest"].CreateDefaultConfig().(*restreceiver.Config)
+				cfg.Endpoint = "http://localhost:8080"
+				return cfg
+			},
+		},
 		{
 			receiver: "riak",
 		},
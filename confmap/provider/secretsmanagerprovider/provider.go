@@ -5,32 +5,68 @@ package secretsmanagerprovider // import "github.com/open-telemetry/opentelemetr
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
+	"sync"
+	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
 	"go.opentelemetry.io/collector/confmap"
+	"go.uber.org/zap"
 )
 
 const (
 	schemeName = "secretsmanager"
+
+	// defaultRefreshInterval is the interval between two reads of the secrets, to detect
+	// their rotation.
+	defaultRefreshInterval = 5 * time.Minute
 )
 
+type secretsManagerClient interface {
+	GetSecretValue(context.Context, *secretsmanager.GetSecretValueInput, ...func(*secretsmanager.Options)) (*secretsmanager.GetSecretValueOutput, error)
+}
+
 type provider struct {
-	client *secretsmanager.Client
+	logger          *zap.Logger
+	refreshInterval time.Duration
+
+	clientOnce sync.Once
+	clientErr  error
+	client     secretsManagerClient
+
+	// done is closed on shutdown to stop watching the secrets.
+	done         chan struct{}
+	shutdownOnce sync.Once
+	wg           sync.WaitGroup
 }
 
 // NewFactory returns a new confmap.ProviderFactory that creates a confmap.Provider
 // which reads configuration the given AWS Secrets Manager Name or ARN.
 //
 // This Provider supports "secretsmanager" scheme, and can be called with a selector:
-// `secretsmanager:NAME_OR_ARN`
+// `secretsmanager:NAME_OR_ARN[#KEY]`, where KEY selects a field of the secrets stored
+// as JSON objects, e.g. `secretsmanager:prod/db#password`.
+//
+// The secrets are read again periodically, and the configuration is reloaded when
+// a secret is rotated.
 func NewFactory() confmap.ProviderFactory {
 	return confmap.NewProviderFactory(newWithSettings)
 }
 
-func newWithSettings(_ confmap.ProviderSettings) confmap.Provider {
-	return &provider{client: nil}
+func newWithSettings(set confmap.ProviderSettings) confmap.Provider {
+	logger := set.Logger
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	return &provider{
+		logger:          logger,
+		refreshInterval: defaultRefreshInterval,
+		done:            make(chan struct{}),
+	}
 }
 
 // New returns a new confmap.Provider that reads the configuration from the given AWS Secrets Manager Name or ARN.
@@ -40,36 +76,129 @@ func newWithSettings(_ confmap.ProviderSettings) confmap.Provider {
 //
 // Deprecated: [v0.100.0] Use NewFactory() instead.
 func New() confmap.Provider {
-	return &provider{}
+	return newWithSettings(confmap.ProviderSettings{})
 }
 
-func (provider *provider) Retrieve(ctx context.Context, uri string, _ confmap.WatcherFunc) (*confmap.Retrieved, error) {
+func (p *provider) Retrieve(ctx context.Context, uri string, watcher confmap.WatcherFunc) (*confmap.Retrieved, error) {
 	if !strings.HasPrefix(uri, schemeName+":") {
 		return nil, fmt.Errorf("%q uri is not supported by %q provider", uri, schemeName)
 	}
 
-	secretArn := strings.Replace(uri, schemeName+":", "", 1)
+	secretID, key, _ := strings.Cut(strings.TrimPrefix(uri, schemeName+":"), "#")
+	if secretID == "" {
+		return nil, fmt.Errorf("%q uri has no secret name or ARN", uri)
+	}
 
-	input := &secretsmanager.GetSecretValueInput{
-		SecretId: &secretArn,
+	// initialize the Secrets Manager client in the first call of Retrieve
+	p.clientOnce.Do(func() {
+		if p.client != nil {
+			return
+		}
+		cfg, err := config.LoadDefaultConfig(context.Background())
+		if err != nil {
+			p.clientErr = fmt.Errorf("failed to load configurations to initialize an AWS SDK client, error: %w", err)
+			return
+		}
+		p.client = secretsmanager.NewFromConfig(cfg)
+	})
+	if p.clientErr != nil {
+		return nil, p.clientErr
 	}
 
-	response, err := provider.client.GetSecretValue(ctx, input)
+	value, versionID, err := p.read(ctx, secretID, key)
 	if err != nil {
 		return nil, err
 	}
 
-	if response.SecretString == nil {
-		return nil, nil
+	if watcher == nil {
+		return confmap.NewRetrieved(value)
 	}
 
-	return confmap.NewRetrieved(*response.SecretString)
+	stop := make(chan struct{})
+	p.wg.Add(1)
+	go func() {
+		defer p.wg.Done()
+		p.watch(stop, secretID, key, versionID, watcher)
+	}()
+	var closeOnce sync.Once
+	return confmap.NewRetrieved(value, confmap.WithRetrievedClose(func(context.Context) error {
+		closeOnce.Do(func() { close(stop) })
+		return nil
+	}))
 }
 
 func (*provider) Scheme() string {
 	return schemeName
 }
 
-func (*provider) Shutdown(context.Context) error {
+func (p *provider) Shutdown(context.Context) error {
+	p.shutdownOnce.Do(func() { close(p.done) })
+	p.wg.Wait()
 	return nil
 }
+
+// read returns the value of the secret, or of its field, and the ID of its current version.
+func (p *provider) read(ctx context.Context, secretID, key string) (any, string, error) {
+	response, err := p.client.GetSecretValue(ctx, &secretsmanager.GetSecretValueInput{
+		SecretId: aws.String(secretID),
+	})
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to read the secret %q: %w", secretID, err)
+	}
+	versionID := aws.ToString(response.VersionId)
+
+	if response.SecretString == nil {
+		return nil, "", fmt.Errorf("the secret %q has no string value", secretID)
+	}
+	if key == "" {
+		return *response.SecretString, versionID, nil
+	}
+
+	var fields map[string]any
+	if err = json.Unmarshal([]byte(*response.SecretString), &fields); err != nil {
+		return nil, "", fmt.Errorf("the secret %q is not a JSON object: %w", secretID, err)
+	}
+	value, ok := fields[key]
+	if !ok {
+		return nil, "", fmt.Errorf("the secret %q has no %q field", secretID, key)
+	}
+	return value, versionID, nil
+}
+
+// watch reads the secret periodically and notifies the watcher once its version changes.
+func (p *provider) watch(stop <-chan struct{}, secretID, key, versionID string, watcher confmap.WatcherFunc) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-stop:
+		case <-p.done:
+		case <-ctx.Done():
+		}
+		cancel()
+	}()
+
+	ticker := time.NewTicker(p.refreshInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		_, current, err := p.read(ctx, secretID, key)
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			p.logger.Warn("Failed to read the secret, keeping the current value", zap.String("secret", secretID), zap.Error(err))
+			continue
+		}
+		if current != versionID {
+			p.logger.Info("The secret was rotated, reloading the configuration", zap.String("secret", secretID))
+			watcher(&confmap.ChangeEvent{})
+			return
+		}
+	}
+}
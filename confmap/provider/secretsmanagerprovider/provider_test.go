@@ -6,8 +6,10 @@ package secretsmanagerprovider
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
+	"sync"
 	"testing"
 	"time"
 
@@ -17,6 +19,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/confmap"
+	"go.uber.org/zap"
 )
 
 type resolver struct {
@@ -36,7 +39,9 @@ func (r resolver) ResolveEndpoint(ctx context.Context, params secretsmanager.End
 func NewTestProvider(url string) confmap.Provider {
 	cfg := aws.NewConfig()
 
-	return &provider{client: secretsmanager.NewFromConfig(*cfg, secretsmanager.WithEndpointResolverV2(resolver{url: url}))}
+	p := newWithSettings(confmap.ProviderSettings{}).(*provider)
+	p.client = secretsmanager.NewFromConfig(*cfg, secretsmanager.WithEndpointResolverV2(resolver{url: url}))
+	return p
 }
 
 func TestSecretsManagerFetchSecret(t *testing.T) {
@@ -75,6 +80,118 @@ func TestSecretsManagerFetchSecret(t *testing.T) {
 	assert.Equal(t, secretValue, value)
 }
 
+type testSecret struct {
+	value     string
+	versionID string
+}
+
+type testClient struct {
+	mu      sync.Mutex
+	secrets map[string]testSecret
+}
+
+func (c *testClient) setSecret(id string, s testSecret) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.secrets[id] = s
+}
+
+func (c *testClient) GetSecretValue(_ context.Context, input *secretsmanager.GetSecretValueInput, _ ...func(*secretsmanager.Options)) (*secretsmanager.GetSecretValueOutput, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s, ok := c.secrets[aws.ToString(input.SecretId)]
+	if !ok {
+		return nil, errors.New("secret not found")
+	}
+	return &secretsmanager.GetSecretValueOutput{
+		SecretString: aws.String(s.value),
+		VersionId:    aws.String(s.versionID),
+	}, nil
+}
+
+func newTestProvider(client *testClient) *provider {
+	p := NewFactory().Create(confmap.ProviderSettings{Logger: zap.NewNop()}).(*provider)
+	p.client = client
+	p.refreshInterval = 10 * time.Millisecond
+	return p
+}
+
+func TestRetrieveField(t *testing.T) {
+	p := newTestProvider(&testClient{secrets: map[string]testSecret{
+		"prod/db":    {value: `{"user":"otel","password":"s3cr3t"}`, versionID: "1"},
+		"prod/token": {value: "t0k3n", versionID: "1"},
+	}})
+
+	tests := []struct {
+		uri     string
+		want    any
+		wantErr string
+	}{
+		{uri: "secretsmanager:prod/db#password", want: "s3cr3t"},
+		{uri: "secretsmanager:prod/token", want: "t0k3n"},
+		{uri: "secretsmanager:prod/db#missing", wantErr: `the secret "prod/db" has no "missing" field`},
+		{uri: "secretsmanager:prod/token#value", wantErr: `the secret "prod/token" is not a JSON object: invalid character '0' in literal true (expecting 'r')`},
+		{uri: "secretsmanager:prod/other", wantErr: `failed to read the secret "prod/other": secret not found`},
+		{uri: "secretsmanager:", wantErr: `"secretsmanager:" uri has no secret name or ARN`},
+		{uri: "file:prod/db", wantErr: `"file:prod/db" uri is not supported by "secretsmanager" provider`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.uri, func(t *testing.T) {
+			retrieved, err := p.Retrieve(context.Background(), tt.uri, nil)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			value, err := retrieved.AsRaw()
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, value)
+		})
+	}
+	assert.NoError(t, p.Shutdown(context.Background()))
+}
+
+func TestWatchRotation(t *testing.T) {
+	client := &testClient{secrets: map[string]testSecret{
+		"prod/db": {value: `{"password":"old"}`, versionID: "1"},
+	}}
+	p := newTestProvider(client)
+
+	changed := make(chan *confmap.ChangeEvent, 1)
+	retrieved, err := p.Retrieve(context.Background(), "secretsmanager:prod/db#password", func(event *confmap.ChangeEvent) {
+		changed <- event
+	})
+	require.NoError(t, err)
+
+	time.Sleep(50 * time.Millisecond)
+	assert.Empty(t, changed)
+
+	client.setSecret("prod/db", testSecret{value: `{"password":"new"}`, versionID: "2"})
+	select {
+	case event := <-changed:
+		assert.NoError(t, event.Error)
+	case <-time.After(5 * time.Second):
+		t.Fatal("the rotation of the secret was not detected")
+	}
+
+	require.NoError(t, retrieved.Close(context.Background()))
+	require.NoError(t, p.Shutdown(context.Background()))
+}
+
+func TestWatchStoppedOnClose(t *testing.T) {
+	p := newTestProvider(&testClient{secrets: map[string]testSecret{
+		"prod/token": {value: "t0k3n", versionID: "1"},
+	}})
+
+	retrieved, err := p.Retrieve(context.Background(), "secretsmanager:prod/token", func(*confmap.ChangeEvent) {
+		t.Error("unexpected change event")
+	})
+	require.NoError(t, err)
+	require.NoError(t, retrieved.Close(context.Background()))
+	// the watch is already stopped, the shutdown does not wait for it
+	require.NoError(t, p.Shutdown(context.Background()))
+}
+
 func TestFactory(t *testing.T) {
 	p := NewFactory().Create(confmap.ProviderSettings{})
 	_, ok := p.(*provider)
@@ -0,0 +1,275 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package vaultprovider // import "github.com/open-telemetry/opentelemetry-collector-contrib/confmap/provider/vaultprovider"
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/confmap"
+	"go.uber.org/zap"
+)
+
+const (
+	schemeName = "vault"
+
+	// Environment variables configuring the Vault client, as for the Vault CLI.
+	addressEnv   = "VAULT_ADDR"
+	tokenEnv     = "VAULT_TOKEN"
+	namespaceEnv = "VAULT_NAMESPACE"
+
+	defaultAddress = "https://127.0.0.1:8200"
+
+	// defaultRefreshInterval is the interval between two reads of the secrets without lease,
+	// to detect their rotation.
+	defaultRefreshInterval = 5 * time.Minute
+)
+
+// secret is the response of Vault to the read of a secret.
+type secret struct {
+	LeaseID       string         `json:"lease_id"`
+	LeaseDuration int            `json:"lease_duration"`
+	Renewable     bool           `json:"renewable"`
+	Data          map[string]any `json:"data"`
+}
+
+type provider struct {
+	logger          *zap.Logger
+	client          *http.Client
+	address         string
+	token           string
+	namespace       string
+	refreshInterval time.Duration
+
+	// done is closed on shutdown to stop watching the secrets.
+	done         chan struct{}
+	shutdownOnce sync.Once
+	wg           sync.WaitGroup
+}
+
+// NewFactory returns a new confmap.ProviderFactory that creates a confmap.Provider
+// which reads secrets from HashiCorp Vault.
+//
+// This Provider supports "vault" scheme, and can be called with a selector:
+// `vault:PATH[#KEY]`, e.g. `vault:secret/data/db#password` for the password of the
+// `db` secret of the KV secrets engine mounted at `secret`. Without KEY all the
+// fields of the secret are returned.
+//
+// The address of Vault and the token are read from the VAULT_ADDR and VAULT_TOKEN
+// environment variables, and the namespace from VAULT_NAMESPACE.
+//
+// The leases of the secrets are renewed, and the configuration is reloaded when a
+// secret is rotated or its lease can't be renewed anymore.
+func NewFactory() confmap.ProviderFactory {
+	return confmap.NewProviderFactory(newWithSettings)
+}
+
+func newWithSettings(set confmap.ProviderSettings) confmap.Provider {
+	address := os.Getenv(addressEnv)
+	if address == "" {
+		address = defaultAddress
+	}
+	return &provider{
+		logger:          set.Logger,
+		client:          &http.Client{Timeout: 30 * time.Second},
+		address:         strings.TrimSuffix(address, "/"),
+		token:           os.Getenv(tokenEnv),
+		namespace:       os.Getenv(namespaceEnv),
+		refreshInterval: defaultRefreshInterval,
+		done:            make(chan struct{}),
+	}
+}
+
+func (p *provider) Retrieve(ctx context.Context, uri string, watcher confmap.WatcherFunc) (*confmap.Retrieved, error) {
+	if !strings.HasPrefix(uri, schemeName+":") {
+		return nil, fmt.Errorf("%q uri is not supported by %q provider", uri, schemeName)
+	}
+
+	path, key, _ := strings.Cut(strings.TrimPrefix(uri, schemeName+":"), "#")
+	path = strings.Trim(path, "/")
+	if path == "" {
+		return nil, fmt.Errorf("%q uri has no secret path", uri)
+	}
+
+	s, err := p.read(ctx, path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the secret %q: %w", path, err)
+	}
+	value, err := secretValue(s, key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the secret %q: %w", path, err)
+	}
+
+	if watcher == nil {
+		return confmap.NewRetrieved(value)
+	}
+
+	stop := make(chan struct{})
+	p.wg.Add(1)
+	go func() {
+		defer p.wg.Done()
+		p.watch(stop, path, key, s, value, watcher)
+	}()
+	var closeOnce sync.Once
+	return confmap.NewRetrieved(value, confmap.WithRetrievedClose(func(context.Context) error {
+		closeOnce.Do(func() { close(stop) })
+		return nil
+	}))
+}
+
+func (*provider) Scheme() string {
+	return schemeName
+}
+
+func (p *provider) Shutdown(context.Context) error {
+	p.shutdownOnce.Do(func() { close(p.done) })
+	p.wg.Wait()
+	return nil
+}
+
+// watch renews the lease of the secret and notifies the watcher once the secret changes,
+// or once its lease can't be renewed.
+func (p *provider) watch(stop <-chan struct{}, path, key string, s *secret, value any, watcher confmap.WatcherFunc) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-stop:
+		case <-p.done:
+		case <-ctx.Done():
+		}
+		cancel()
+	}()
+
+	for {
+		timer := time.NewTimer(p.nextCheck(s))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		if s.Renewable && s.LeaseID != "" {
+			renewed, err := p.renew(ctx, s)
+			if ctx.Err() != nil {
+				return
+			}
+			if err == nil {
+				s.LeaseDuration = renewed.LeaseDuration
+				s.Renewable = renewed.Renewable
+				continue
+			}
+			p.logger.Warn("Failed to renew the lease of the secret, reloading the configuration",
+				zap.String("path", path), zap.Error(err))
+			watcher(&confmap.ChangeEvent{})
+			return
+		}
+
+		current, err := p.read(ctx, path)
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			p.logger.Warn("Failed to read the secret, keeping the current value", zap.String("path", path), zap.Error(err))
+			continue
+		}
+		currentValue, err := secretValue(current, key)
+		if err != nil || !reflect.DeepEqual(currentValue, value) {
+			p.logger.Info("The secret changed, reloading the configuration", zap.String("path", path))
+			watcher(&confmap.ChangeEvent{})
+			return
+		}
+		s = current
+	}
+}
+
+// nextCheck returns the duration until the lease of the secret is renewed, or until the
+// secret is read again to detect its rotation.
+func (p *provider) nextCheck(s *secret) time.Duration {
+	if s.LeaseDuration <= 0 {
+		return p.refreshInterval
+	}
+	// renew the lease before it expires, or read the secret once the lease expired
+	lease := time.Duration(s.LeaseDuration) * time.Second
+	if s.Renewable {
+		return lease * 2 / 3
+	}
+	return lease
+}
+
+func (p *provider) read(ctx context.Context, path string) (*secret, error) {
+	return p.do(ctx, http.MethodGet, path, nil)
+}
+
+func (p *provider) renew(ctx context.Context, s *secret) (*secret, error) {
+	body, err := json.Marshal(map[string]any{
+		"lease_id":  s.LeaseID,
+		"increment": s.LeaseDuration,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return p.do(ctx, http.MethodPut, "sys/leases/renew", body)
+}
+
+func (p *provider) do(ctx context.Context, method, path string, body []byte) (*secret, error) {
+	req, err := http.NewRequestWithContext(ctx, method, p.address+"/v1/"+path, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("X-Vault-Token", p.token)
+	if p.namespace != "" {
+		req.Header.Set("X-Vault-Namespace", p.namespace)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("vault returned status %d", resp.StatusCode)
+	}
+
+	s := &secret{}
+	if err = json.NewDecoder(resp.Body).Decode(s); err != nil {
+		return nil, fmt.Errorf("failed to decode the response: %w", err)
+	}
+	return s, nil
+}
+
+// secretValue returns the field of the secret, or all its fields without key. The fields
+// of the secrets of the KV secrets engine version 2 are nested in their data.
+func secretValue(s *secret, key string) (any, error) {
+	data := s.Data
+	if nested, ok := data["data"].(map[string]any); ok {
+		if _, ok = data["metadata"]; ok {
+			data = nested
+		}
+	}
+	if data == nil {
+		return nil, errors.New("the secret has no data")
+	}
+	if key == "" {
+		return data, nil
+	}
+	value, ok := data[key]
+	if !ok {
+		return nil, fmt.Errorf("the secret has no %q field", key)
+	}
+	return value, nil
+}
@@ -0,0 +1,180 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package vaultprovider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/confmap"
+	"go.uber.org/zap"
+)
+
+// testVault serves the secrets per path, and counts the lease renewals.
+type testVault struct {
+	mu       sync.Mutex
+	secrets  map[string]secret
+	renewals atomic.Int64
+	renewErr bool
+}
+
+func (v *testVault) setSecret(path string, s secret) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.secrets[path] = s
+}
+
+func (v *testVault) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("X-Vault-Token") != "token" {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	path := r.URL.Path[len("/v1/"):]
+	if r.Method == http.MethodPut && path == "sys/leases/renew" {
+		v.renewals.Add(1)
+		if v.renewErr {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		var req map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		_ = json.NewEncoder(w).Encode(secret{LeaseID: req["lease_id"].(string), LeaseDuration: 1, Renewable: true})
+		return
+	}
+	s, ok := v.secrets[path]
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	_ = json.NewEncoder(w).Encode(s)
+}
+
+func newTestProvider(t *testing.T, vault *testVault) *provider {
+	server := httptest.NewServer(vault)
+	t.Cleanup(server.Close)
+	t.Setenv(addressEnv, server.URL)
+	t.Setenv(tokenEnv, "token")
+	p := NewFactory().Create(confmap.ProviderSettings{Logger: zap.NewNop()}).(*provider)
+	p.refreshInterval = 10 * time.Millisecond
+	return p
+}
+
+func TestRetrieve(t *testing.T) {
+	vault := &testVault{secrets: map[string]secret{
+		"secret/data/db": {Data: map[string]any{
+			"data":     map[string]any{"user": "otel", "password": "s3cr3t"},
+			"metadata": map[string]any{"version": 1},
+		}},
+		"database/creds/otel": {LeaseID: "database/creds/otel/1", LeaseDuration: 3600, Renewable: true, Data: map[string]any{
+			"username": "v-otel",
+			"password": "generated",
+		}},
+	}}
+	p := newTestProvider(t, vault)
+
+	tests := []struct {
+		uri     string
+		want    any
+		wantErr string
+	}{
+		{uri: "vault:secret/data/db#password", want: "s3cr3t"},
+		{uri: "vault:secret/data/db", want: map[string]any{"user": "otel", "password": "s3cr3t"}},
+		{uri: "vault:/database/creds/otel#username", want: "v-otel"},
+		{uri: "vault:secret/data/db#missing", wantErr: `failed to read the secret "secret/data/db": the secret has no "missing" field`},
+		{uri: "vault:secret/data/other", wantErr: `failed to read the secret "secret/data/other": vault returned status 404`},
+		{uri: "vault:", wantErr: `"vault:" uri has no secret path`},
+		{uri: "file:secret", wantErr: `"file:secret" uri is not supported by "vault" provider`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.uri, func(t *testing.T) {
+			retrieved, err := p.Retrieve(context.Background(), tt.uri, nil)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			value, err := retrieved.AsRaw()
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, value)
+		})
+	}
+	assert.NoError(t, p.Shutdown(context.Background()))
+}
+
+func TestWatchRotation(t *testing.T) {
+	vault := &testVault{secrets: map[string]secret{
+		"secret/db": {Data: map[string]any{"password": "old"}},
+	}}
+	p := newTestProvider(t, vault)
+
+	changed := make(chan *confmap.ChangeEvent, 1)
+	retrieved, err := p.Retrieve(context.Background(), "vault:secret/db#password", func(event *confmap.ChangeEvent) {
+		changed <- event
+	})
+	require.NoError(t, err)
+
+	// the secret is read again without change
+	time.Sleep(50 * time.Millisecond)
+	assert.Empty(t, changed)
+
+	vault.setSecret("secret/db", secret{Data: map[string]any{"password": "new"}})
+	select {
+	case event := <-changed:
+		assert.NoError(t, event.Error)
+	case <-time.After(5 * time.Second):
+		t.Fatal("the rotation of the secret was not detected")
+	}
+
+	require.NoError(t, retrieved.Close(context.Background()))
+	require.NoError(t, p.Shutdown(context.Background()))
+}
+
+func TestWatchLeaseRenewal(t *testing.T) {
+	vault := &testVault{secrets: map[string]secret{
+		"database/creds/otel": {LeaseID: "database/creds/otel/1", LeaseDuration: 1, Renewable: true, Data: map[string]any{
+			"password": "generated",
+		}},
+	}}
+	p := newTestProvider(t, vault)
+
+	changed := make(chan *confmap.ChangeEvent, 1)
+	_, err := p.Retrieve(context.Background(), "vault:database/creds/otel#password", func(event *confmap.ChangeEvent) {
+		changed <- event
+	})
+	require.NoError(t, err)
+
+	assert.Eventually(t, func() bool {
+		return vault.renewals.Load() >= 2
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Empty(t, changed)
+
+	vault.mu.Lock()
+	vault.renewErr = true
+	vault.mu.Unlock()
+	select {
+	case event := <-changed:
+		assert.NoError(t, event.Error)
+	case <-time.After(5 * time.Second):
+		t.Fatal("the failed renewal did not reload the configuration")
+	}
+
+	require.NoError(t, p.Shutdown(context.Background()))
+}
+
+func TestFactory(t *testing.T) {
+	p := NewFactory().Create(confmap.ProviderSettings{Logger: zap.NewNop()})
+	assert.Equal(t, schemeName, p.Scheme())
+	assert.NoError(t, p.Shutdown(context.Background()))
+}
@@ -5,6 +5,8 @@ package failoverconnector // import "github.com/open-telemetry/opentelemetry-col
 
 import (
 	"errors"
+	"fmt"
+	"net/url"
 	"time"
 
 	"go.opentelemetry.io/collector/component"
@@ -13,6 +15,7 @@ import (
 var (
 	errNoPipelinePriority    = errors.New("No pipelines are defined in the priority list")
 	errInvalidRetryIntervals = errors.New("Retry interval must be positive, and retry_interval must be greater than retry_gap times the length of the priority list")
+	errInvalidHealthTimeout  = errors.New("Health check timeout must be positive")
 )
 
 type Config struct {
@@ -33,6 +36,20 @@ type Config struct {
 	// MaxRetry is the maximum retries per level, once this limit is hit for a level, even if the next pipeline level fails,
 	// it will not try to recover the level that exceeded the maximum retries
 	MaxRetries int `mapstructure:"max_retries"`
+
+	// HealthCheck configures the active health checks run against a higher priority level before failing back to it
+	HealthCheck HealthCheckConfig `mapstructure:"health_check"`
+}
+
+// HealthCheckConfig defines the HTTP endpoints probed to check the health of the exporters of a pipeline
+type HealthCheckConfig struct {
+	// Endpoints maps pipelines of the priority levels to the HTTP endpoints reporting the health of their exporters.
+	// A level is only retried once all of its pipelines with an endpoint respond with a 2xx status code, levels
+	// without any endpoint are retried with data directly
+	Endpoints map[component.ID]string `mapstructure:"endpoints"`
+
+	// Timeout is the time to wait for the response of a single endpoint
+	Timeout time.Duration `mapstructure:"timeout"`
 }
 
 // Validate needs to ensure RetryInterval > # elements in PriorityList * RetryGap
@@ -44,5 +61,39 @@ func (c *Config) Validate() error {
 	if c.RetryGap <= 0 || c.RetryInterval <= 0 || c.RetryInterval <= retryTime {
 		return errInvalidRetryIntervals
 	}
+	return c.HealthCheck.validate(c.PipelinePriority)
+}
+
+func (c *HealthCheckConfig) validate(pipelinePriority [][]component.ID) error {
+	if len(c.Endpoints) == 0 {
+		return nil
+	}
+	if c.Timeout <= 0 {
+		return errInvalidHealthTimeout
+	}
+	for pipeline, endpoint := range c.Endpoints {
+		if levelIndex(pipelinePriority, pipeline) < 0 {
+			return fmt.Errorf("Health check pipeline %s is not part of the priority levels", pipeline)
+		}
+		u, err := url.Parse(endpoint)
+		if err != nil {
+			return fmt.Errorf("Health check endpoint of pipeline %s is invalid: %w", pipeline, err)
+		}
+		if u.Scheme != "http" && u.Scheme != "https" {
+			return fmt.Errorf("Health check endpoint of pipeline %s must be an http or https URL", pipeline)
+		}
+	}
 	return nil
 }
+
+// levelIndex returns the priority level of the pipeline, -1 if the pipeline isn't part of any level
+func levelIndex(pipelinePriority [][]component.ID, pipeline component.ID) int {
+	for i, pipelines := range pipelinePriority {
+		for _, id := range pipelines {
+			if id == pipeline {
+				return i
+			}
+		}
+	}
+	return -1
+}
@@ -4,6 +4,7 @@
 package failoverconnector
 
 import (
+	"errors"
 	"path/filepath"
 	"testing"
 	"time"
@@ -32,6 +33,9 @@ func TestLoadConfig(t *testing.T) {
 				RetryInterval: 10 * time.Minute,
 				RetryGap:      30 * time.Second,
 				MaxRetries:    10,
+				HealthCheck: HealthCheckConfig{
+					Timeout: 5 * time.Second,
+				},
 			},
 		},
 		{
@@ -55,6 +59,31 @@ func TestLoadConfig(t *testing.T) {
 				RetryInterval: 5 * time.Minute,
 				RetryGap:      time.Minute,
 				MaxRetries:    10,
+				HealthCheck: HealthCheckConfig{
+					Timeout: 5 * time.Second,
+				},
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "health_check"),
+			expected: &Config{
+				PipelinePriority: [][]component.ID{
+					{
+						component.NewIDWithName(component.DataTypeTraces, "first"),
+					},
+					{
+						component.NewIDWithName(component.DataTypeTraces, "second"),
+					},
+				},
+				RetryInterval: 10 * time.Minute,
+				RetryGap:      30 * time.Second,
+				MaxRetries:    10,
+				HealthCheck: HealthCheckConfig{
+					Endpoints: map[component.ID]string{
+						component.NewIDWithName(component.DataTypeTraces, "first"): "http://localhost:13133/health",
+					},
+					Timeout: 2 * time.Second,
+				},
 			},
 		},
 	}
@@ -93,6 +122,16 @@ func TestValidateConfig(t *testing.T) {
 			id:   component.NewIDWithName(metadata.Type, "invalid"),
 			err:  errInvalidRetryIntervals,
 		},
+		{
+			name: "invalid health check timeout",
+			id:   component.NewIDWithName(metadata.Type, "invalid_health_check_timeout"),
+			err:  errInvalidHealthTimeout,
+		},
+		{
+			name: "health check pipeline not in priority levels",
+			id:   component.NewIDWithName(metadata.Type, "invalid_health_check_pipeline"),
+			err:  errors.New("Health check pipeline traces/third is not part of the priority levels"),
+		},
 	}
 
 	for _, tc := range testcases {
@@ -29,6 +29,9 @@ func createDefaultConfig() component.Config {
 		RetryGap:      30 * time.Second,
 		RetryInterval: 10 * time.Minute,
 		MaxRetries:    10,
+		HealthCheck: HealthCheckConfig{
+			Timeout: 5 * time.Second,
+		},
 	}
 }
 
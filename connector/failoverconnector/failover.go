@@ -8,6 +8,7 @@ import (
 	"sync"
 
 	"go.opentelemetry.io/collector/component"
+	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/connector/failoverconnector/internal/state"
 )
@@ -29,7 +30,7 @@ var (
 	errConsumer        = errors.New("Error registering consumer")
 )
 
-func newFailoverRouter[C any](provider consumerProvider[C], cfg *Config) *failoverRouter[C] {
+func newFailoverRouter[C any](provider consumerProvider[C], cfg *Config, logger *zap.Logger) *failoverRouter[C] {
 	var wg sync.WaitGroup
 	done := make(chan struct{})
 	pSConstants := state.PSConstants{
@@ -37,6 +38,9 @@ func newFailoverRouter[C any](provider consumerProvider[C], cfg *Config) *failov
 		RetryGap:      cfg.RetryGap,
 		MaxRetries:    cfg.MaxRetries,
 	}
+	if hc := newHealthChecker(cfg, logger); hc != nil {
+		pSConstants.HealthCheck = hc.checkLevel
+	}
 
 	selector := state.NewPipelineSelector(len(cfg.PipelinePriority), pSConstants)
 	selector.Start(done, &wg)
@@ -4,6 +4,9 @@
 package failoverconnector // import "github.com/open-telemetry/opentelemetry-collector-contrib/connector/failoverconnector"
 import (
 	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -217,6 +220,122 @@ func TestFailoverRecovery_MaxRetries(t *testing.T) {
 
 }
 
+func TestFailoverRecovery_HealthCheck(t *testing.T) {
+	var sinkFirst, sinkSecond consumertest.TracesSink
+	tracesFirst := component.NewIDWithName(component.DataTypeTraces, "traces/first")
+	tracesSecond := component.NewIDWithName(component.DataTypeTraces, "traces/second")
+
+	var healthy atomic.Bool
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if healthy.Load() {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	cfg := &Config{
+		PipelinePriority: [][]component.ID{{tracesFirst}, {tracesSecond}},
+		RetryInterval:    50 * time.Millisecond,
+		RetryGap:         10 * time.Millisecond,
+		MaxRetries:       10000,
+		HealthCheck: HealthCheckConfig{
+			Endpoints: map[component.ID]string{tracesFirst: srv.URL},
+			Timeout:   time.Second,
+		},
+	}
+
+	router := connector.NewTracesRouter(map[component.ID]consumer.Traces{
+		tracesFirst:  &sinkFirst,
+		tracesSecond: &sinkSecond,
+	})
+
+	conn, err := NewFactory().CreateTracesToTraces(context.Background(),
+		connectortest.NewNopCreateSettings(), cfg, router.(consumer.Traces))
+
+	require.NoError(t, err)
+
+	failoverConnector := conn.(*tracesFailover)
+
+	tr := sampleTrace()
+
+	defer func() {
+		assert.NoError(t, failoverConnector.Shutdown(context.Background()))
+	}()
+
+	failoverConnector.failover.ModifyConsumerAtIndex(0, consumertest.NewErr(errTracesConsumer))
+
+	require.NoError(t, conn.ConsumeTraces(context.Background(), tr))
+	require.Equal(t, 1, failoverConnector.failover.pS.TestStableIndex())
+
+	// The exporter recovered but its health check still fails, the level must not be retried with data
+	failoverConnector.failover.ModifyConsumerAtIndex(0, &sinkFirst)
+	require.Never(t, func() bool {
+		return consumeTracesAndCheckCurrent(failoverConnector, 0, tr)
+	}, 200*time.Millisecond, 5*time.Millisecond)
+	assert.Zero(t, sinkFirst.SpanCount())
+
+	healthy.Store(true)
+
+	require.Eventually(t, func() bool {
+		return consumeTracesAndCheckStable(failoverConnector, 0, tr)
+	}, 3*time.Second, 5*time.Millisecond)
+}
+
+func TestFailoverIndependentSignals(t *testing.T) {
+	var tracesSinkFirst, tracesSinkSecond consumertest.TracesSink
+	var logsSinkFirst, logsSinkSecond consumertest.LogsSink
+	tracesFirst := component.NewIDWithName(component.DataTypeTraces, "first")
+	tracesSecond := component.NewIDWithName(component.DataTypeTraces, "second")
+	logsFirst := component.NewIDWithName(component.DataTypeLogs, "first")
+	logsSecond := component.NewIDWithName(component.DataTypeLogs, "second")
+
+	// A single connector configuration is shared by the traces and logs pipelines
+	cfg := &Config{
+		PipelinePriority: [][]component.ID{{tracesFirst, logsFirst}, {tracesSecond, logsSecond}},
+		RetryInterval:    time.Minute,
+		RetryGap:         10 * time.Second,
+		MaxRetries:       10000,
+	}
+	factory := NewFactory()
+
+	tracesRouter := connector.NewTracesRouter(map[component.ID]consumer.Traces{
+		tracesFirst:  &tracesSinkFirst,
+		tracesSecond: &tracesSinkSecond,
+	})
+	tracesConn, err := factory.CreateTracesToTraces(context.Background(),
+		connectortest.NewNopCreateSettings(), cfg, tracesRouter.(consumer.Traces))
+	require.NoError(t, err)
+	tracesConnector := tracesConn.(*tracesFailover)
+	defer func() {
+		assert.NoError(t, tracesConnector.Shutdown(context.Background()))
+	}()
+
+	logsRouter := connector.NewLogsRouter(map[component.ID]consumer.Logs{
+		logsFirst:  &logsSinkFirst,
+		logsSecond: &logsSinkSecond,
+	})
+	logsConn, err := factory.CreateLogsToLogs(context.Background(),
+		connectortest.NewNopCreateSettings(), cfg, logsRouter.(consumer.Logs))
+	require.NoError(t, err)
+	logsConnector := logsConn.(*logsFailover)
+	defer func() {
+		assert.NoError(t, logsConnector.Shutdown(context.Background()))
+	}()
+
+	tracesConnector.failover.ModifyConsumerAtIndex(0, consumertest.NewErr(errTracesConsumer))
+
+	require.NoError(t, tracesConn.ConsumeTraces(context.Background(), sampleTrace()))
+	require.Equal(t, 1, tracesConnector.failover.pS.TestStableIndex())
+
+	// The traces outage doesn't divert the logs
+	require.NoError(t, logsConn.ConsumeLogs(context.Background(), sampleLog()))
+	assert.Equal(t, 0, logsConnector.failover.pS.TestStableIndex())
+	assert.Equal(t, 1, logsSinkFirst.LogRecordCount())
+	assert.Zero(t, logsSinkSecond.LogRecordCount())
+}
+
 func resetConsumers(conn *tracesFailover, consumers ...consumer.Traces) {
 	for i, sink := range consumers {
 
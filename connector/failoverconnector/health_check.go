@@ -0,0 +1,69 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package failoverconnector // import "github.com/open-telemetry/opentelemetry-collector-contrib/connector/failoverconnector"
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"go.uber.org/zap"
+)
+
+// healthChecker probes the health check endpoints of the pipelines of each priority level
+type healthChecker struct {
+	client *http.Client
+	logger *zap.Logger
+	// endpoints of each priority level
+	endpoints [][]string
+}
+
+// newHealthChecker returns nil if no health check endpoint is configured
+func newHealthChecker(cfg *Config, logger *zap.Logger) *healthChecker {
+	if len(cfg.HealthCheck.Endpoints) == 0 {
+		return nil
+	}
+	endpoints := make([][]string, len(cfg.PipelinePriority))
+	for i, pipelines := range cfg.PipelinePriority {
+		for _, pipeline := range pipelines {
+			if endpoint, ok := cfg.HealthCheck.Endpoints[pipeline]; ok {
+				endpoints[i] = append(endpoints[i], endpoint)
+			}
+		}
+	}
+	return &healthChecker{
+		client:    &http.Client{Timeout: cfg.HealthCheck.Timeout},
+		logger:    logger,
+		endpoints: endpoints,
+	}
+}
+
+// checkLevel returns an error if any endpoint of the priority level is unhealthy
+func (h *healthChecker) checkLevel(ctx context.Context, idx int) error {
+	for _, endpoint := range h.endpoints[idx] {
+		if err := h.checkEndpoint(ctx, endpoint); err != nil {
+			h.logger.Debug("Health check failed, skipping retry of priority level", zap.Int("level", idx), zap.Error(err))
+			return err
+		}
+	}
+	return nil
+}
+
+func (h *healthChecker) checkEndpoint(ctx context.Context, endpoint string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("Health check of %s returned status %d", endpoint, resp.StatusCode)
+	}
+	return nil
+}
@@ -103,11 +103,23 @@ func (p *PipelineSelector) retryHighPriorityPipelines(ctx context.Context, retry
 			if i >= p.loadStable() {
 				return
 			}
+			if !p.levelIsHealthy(ctx, i) {
+				p.incrementRetryCount(i)
+				continue
+			}
 			p.currentIndex.Store(int32(i))
 		}
 	}
 }
 
+// levelIsHealthy runs the health check, if any, of the priority level before it is retried
+func (p *PipelineSelector) levelIsHealthy(ctx context.Context, idx int) bool {
+	if p.constants.HealthCheck == nil {
+		return true
+	}
+	return p.constants.HealthCheck(ctx, idx) == nil
+}
+
 // checkContinueRetry checks if retry should be suspended if all higher priority levels have exceeded their max retries
 func (p *PipelineSelector) checkContinueRetry(index int) bool {
 	for i := 0; i < index; i++ {
@@ -5,7 +5,9 @@ package state
 
 import (
 	"context"
+	"errors"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -84,3 +86,43 @@ func TestCurrentPipelineWithRetry(t *testing.T) {
 		return idx == 0
 	}, 3*time.Second, 5*time.Millisecond)
 }
+
+func TestCurrentPipelineWithHealthCheck(t *testing.T) {
+	var healthy atomic.Bool
+	constants := PSConstants{
+		RetryInterval: 50 * time.Millisecond,
+		RetryGap:      10 * time.Millisecond,
+		MaxRetries:    1000,
+		HealthCheck: func(_ context.Context, idx int) error {
+			if idx == 0 && healthy.Load() {
+				return nil
+			}
+			return errors.New("unhealthy")
+		},
+	}
+	pS := NewPipelineSelector(3, constants)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer func() {
+		cancel()
+	}()
+
+	pS.TestSetStableIndex(2)
+	pS.currentIndex.Store(2)
+	pS.TestRetryPipelines(ctx, constants.RetryInterval, constants.RetryGap)
+
+	// Unhealthy levels are never retried with data
+	require.Never(t, func() bool {
+		idx, _ := pS.SelectedPipeline()
+		return idx != 2
+	}, 200*time.Millisecond, 5*time.Millisecond)
+	require.Positive(t, pS.loadRetryCount(0))
+	require.Positive(t, pS.loadRetryCount(1))
+
+	healthy.Store(true)
+
+	require.Eventually(t, func() bool {
+		idx, _ := pS.SelectedPipeline()
+		return idx == 0
+	}, 3*time.Second, 5*time.Millisecond)
+}
@@ -13,6 +13,9 @@ type PSConstants struct {
 	RetryInterval time.Duration
 	RetryGap      time.Duration
 	MaxRetries    int
+	// HealthCheck, if set, is run against a higher priority level before retrying it, the level
+	// is skipped until the next retry interval if an error is returned
+	HealthCheck func(ctx context.Context, idx int) error
 }
 
 type TryLock struct {
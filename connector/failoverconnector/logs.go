@@ -70,7 +70,7 @@ func newLogsToLogs(set connector.CreateSettings, cfg component.Config, logs cons
 		return nil, errors.New("consumer is not of type LogsRouter")
 	}
 
-	failover := newFailoverRouter[consumer.Logs](lr.Consumer, config)
+	failover := newFailoverRouter[consumer.Logs](lr.Consumer, config, set.TelemetrySettings.Logger)
 	err := failover.registerConsumers()
 	if err != nil {
 		return nil, err
@@ -70,7 +70,7 @@ func newMetricsToMetrics(set connector.CreateSettings, cfg component.Config, met
 		return nil, errors.New("consumer is not of type MetricsRouter")
 	}
 
-	failover := newFailoverRouter[consumer.Metrics](mr.Consumer, config)
+	failover := newFailoverRouter[consumer.Metrics](mr.Consumer, config, set.TelemetrySettings.Logger)
 	err := failover.registerConsumers()
 	if err != nil {
 		return nil, err
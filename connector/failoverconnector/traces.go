@@ -70,7 +70,7 @@ func newTracesToTraces(set connector.CreateSettings, cfg component.Config, trace
 		return nil, errors.New("consumer is not of type TracesRouter")
 	}
 
-	failover := newFailoverRouter[consumer.Traces](tr.Consumer, config)
+	failover := newFailoverRouter[consumer.Traces](tr.Consumer, config, set.TelemetrySettings.Logger)
 	err := failover.registerConsumers()
 	if err != nil {
 		return nil, err
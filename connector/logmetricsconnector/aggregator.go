@@ -0,0 +1,187 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package logmetricsconnector // import "github.com/open-telemetry/opentelemetry-collector-contrib/connector/logmetricsconnector"
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatautil"
+)
+
+var noAttributes = [16]byte{}
+
+func newAggregator(metricDefs map[string]metricDef) *aggregator {
+	return &aggregator{
+		metricDefs: metricDefs,
+		gauges:     make(map[string]map[[16]byte]*gaugePoint),
+		histograms: make(map[string]map[[16]byte]*histogramPoint),
+		timestamp:  time.Now(),
+	}
+}
+
+// aggregator accumulates the values extracted from the log records of a resource.
+type aggregator struct {
+	metricDefs map[string]metricDef
+	gauges     map[string]map[[16]byte]*gaugePoint
+	histograms map[string]map[[16]byte]*histogramPoint
+	timestamp  time.Time
+}
+
+// gaugePoint holds the latest value recorded for a set of attributes.
+type gaugePoint struct {
+	attrs     pcommon.Map
+	value     float64
+	timestamp pcommon.Timestamp
+}
+
+type histogramPoint struct {
+	attrs        pcommon.Map
+	count        uint64
+	sum          float64
+	min          float64
+	max          float64
+	bucketCounts []uint64
+	startTime    pcommon.Timestamp
+}
+
+func (a *aggregator) empty() bool {
+	return len(a.gauges)+len(a.histograms) == 0
+}
+
+func (a *aggregator) record(metricName string, attrs pcommon.Map, value float64, logRecord plog.LogRecord) {
+	key := noAttributes
+	if attrs.Len() > 0 {
+		key = pdatautil.MapHash(attrs)
+	}
+	timestamp := logRecord.Timestamp()
+	if timestamp == 0 {
+		timestamp = logRecord.ObservedTimestamp()
+	}
+	if timestamp == 0 {
+		timestamp = pcommon.NewTimestampFromTime(a.timestamp)
+	}
+
+	md := a.metricDefs[metricName]
+	switch md.typ {
+	case MetricTypeHistogram:
+		if _, ok := a.histograms[metricName]; !ok {
+			a.histograms[metricName] = make(map[[16]byte]*histogramPoint)
+		}
+		hp, ok := a.histograms[metricName][key]
+		if !ok {
+			hp = &histogramPoint{
+				attrs:        attrs,
+				min:          math.Inf(1),
+				max:          math.Inf(-1),
+				bucketCounts: make([]uint64, len(md.buckets)+1),
+				startTime:    timestamp,
+			}
+			a.histograms[metricName][key] = hp
+		}
+		hp.count++
+		hp.sum += value
+		hp.min = math.Min(hp.min, value)
+		hp.max = math.Max(hp.max, value)
+		// The buckets are upper bound inclusive.
+		hp.bucketCounts[sort.SearchFloat64s(md.buckets, value)]++
+		if timestamp < hp.startTime {
+			hp.startTime = timestamp
+		}
+	default:
+		if _, ok := a.gauges[metricName]; !ok {
+			a.gauges[metricName] = make(map[[16]byte]*gaugePoint)
+		}
+		gp, ok := a.gauges[metricName][key]
+		if !ok {
+			a.gauges[metricName][key] = &gaugePoint{attrs: attrs, value: value, timestamp: timestamp}
+		} else if timestamp >= gp.timestamp {
+			gp.value = value
+			gp.timestamp = timestamp
+		}
+	}
+}
+
+func (a *aggregator) appendMetricsTo(metricSlice pmetric.MetricSlice) {
+	for name, md := range a.metricDefs {
+		switch md.typ {
+		case MetricTypeHistogram:
+			if len(a.histograms[name]) == 0 {
+				continue
+			}
+			histogramMetric := metricSlice.AppendEmpty()
+			histogramMetric.SetName(name)
+			histogramMetric.SetDescription(md.desc)
+			histogramMetric.SetUnit(md.unit)
+			histogram := histogramMetric.SetEmptyHistogram()
+			histogram.SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+			for _, hp := range a.histograms[name] {
+				dp := histogram.DataPoints().AppendEmpty()
+				hp.attrs.CopyTo(dp.Attributes())
+				dp.SetCount(hp.count)
+				dp.SetSum(hp.sum)
+				dp.SetMin(hp.min)
+				dp.SetMax(hp.max)
+				dp.ExplicitBounds().FromRaw(md.buckets)
+				dp.BucketCounts().FromRaw(hp.bucketCounts)
+				dp.SetStartTimestamp(hp.startTime)
+				dp.SetTimestamp(pcommon.NewTimestampFromTime(a.timestamp))
+			}
+		default:
+			if len(a.gauges[name]) == 0 {
+				continue
+			}
+			gaugeMetric := metricSlice.AppendEmpty()
+			gaugeMetric.SetName(name)
+			gaugeMetric.SetDescription(md.desc)
+			gaugeMetric.SetUnit(md.unit)
+			gauge := gaugeMetric.SetEmptyGauge()
+			for _, gp := range a.gauges[name] {
+				dp := gauge.DataPoints().AppendEmpty()
+				gp.attrs.CopyTo(dp.Attributes())
+				dp.SetDoubleValue(gp.value)
+				dp.SetTimestamp(gp.timestamp)
+			}
+		}
+	}
+}
+
+// metricAttributes returns the dimensions of a metric from the log record attributes, false if
+// any of them is missing and has no default value.
+func metricAttributes(attrCfgs []AttributeConfig, attrs pcommon.Map) (pcommon.Map, bool) {
+	metricAttrs := pcommon.NewMap()
+	for _, attr := range attrCfgs {
+		if attrVal, ok := attrs.Get(attr.Key); ok {
+			switch attrVal.Type() {
+			case pcommon.ValueTypeInt:
+				metricAttrs.PutInt(attr.Key, attrVal.Int())
+			case pcommon.ValueTypeDouble:
+				metricAttrs.PutDouble(attr.Key, attrVal.Double())
+			case pcommon.ValueTypeBool:
+				metricAttrs.PutBool(attr.Key, attrVal.Bool())
+			default:
+				metricAttrs.PutStr(attr.Key, attrVal.AsString())
+			}
+		} else if attr.DefaultValue != nil {
+			switch v := attr.DefaultValue.(type) {
+			case string:
+				if v != "" {
+					metricAttrs.PutStr(attr.Key, v)
+				}
+			case int:
+				metricAttrs.PutInt(attr.Key, int64(v))
+			case float64:
+				metricAttrs.PutDouble(attr.Key, v)
+			case bool:
+				metricAttrs.PutBool(attr.Key, v)
+			}
+		}
+	}
+	return metricAttrs, metricAttrs.Len() == len(attrCfgs)
+}
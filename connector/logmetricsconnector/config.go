@@ -0,0 +1,114 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package logmetricsconnector // import "github.com/open-telemetry/opentelemetry-collector-contrib/connector/logmetricsconnector"
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"go.opentelemetry.io/collector/component"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottllog"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+)
+
+// MetricType is the type of the metric emitted from the extracted values.
+type MetricType string
+
+const (
+	// MetricTypeGauge emits the last value extracted for each set of attributes.
+	MetricTypeGauge MetricType = "gauge"
+	// MetricTypeHistogram emits a delta histogram of the values extracted for each set of attributes.
+	MetricTypeHistogram MetricType = "histogram"
+)
+
+var defaultHistogramBuckets = []float64{
+	2, 4, 6, 8, 10, 50, 100, 200, 400, 800, 1000, 1400, 2000, 5000, 10_000, 15_000,
+}
+
+// Config for the connector
+type Config struct {
+	// Metrics to emit, by metric name.
+	Metrics map[string]MetricInfo `mapstructure:"metrics"`
+	// ErrorMode determines how errors evaluating the conditions and value expressions are handled.
+	ErrorMode ottl.ErrorMode `mapstructure:"error_mode"`
+}
+
+// MetricInfo defines a metric emitted from the values extracted from log records.
+type MetricInfo struct {
+	Description string `mapstructure:"description"`
+	Unit        string `mapstructure:"unit"`
+	// Type of the metric, gauge or histogram. Defaults to gauge.
+	Type MetricType `mapstructure:"type"`
+	// Value is the OTTL value expression, in the log context, whose result is recorded. It must
+	// resolve to an integer, a double or a string holding a number.
+	Value string `mapstructure:"value"`
+	// Conditions restricts the log records the value is extracted from, any condition matching.
+	Conditions []string `mapstructure:"conditions"`
+	// Attributes are the log record attributes used as dimensions of the metric.
+	Attributes []AttributeConfig `mapstructure:"attributes"`
+	// Histogram configures the histogram metrics.
+	Histogram HistogramConfig `mapstructure:"histogram"`
+}
+
+type AttributeConfig struct {
+	Key          string `mapstructure:"key"`
+	DefaultValue any    `mapstructure:"default_value"`
+}
+
+// HistogramConfig configures the explicit bucket boundaries of a histogram.
+type HistogramConfig struct {
+	Buckets []float64 `mapstructure:"buckets"`
+}
+
+func (c *Config) Validate() error {
+	if len(c.Metrics) == 0 {
+		return errors.New("no metrics configured")
+	}
+	for name, info := range c.Metrics {
+		if name == "" {
+			return errors.New("metric name missing")
+		}
+		if info.Value == "" {
+			return fmt.Errorf("metric %q: value missing", name)
+		}
+		switch info.Type {
+		case "", MetricTypeGauge:
+			if len(info.Histogram.Buckets) > 0 {
+				return fmt.Errorf("metric %q: histogram buckets not supported by gauges", name)
+			}
+		case MetricTypeHistogram:
+			if !sort.Float64sAreSorted(info.Histogram.Buckets) {
+				return fmt.Errorf("metric %q: histogram buckets must be sorted", name)
+			}
+		default:
+			return fmt.Errorf("metric %q: unsupported type %q", name, info.Type)
+		}
+		set := component.TelemetrySettings{Logger: zap.NewNop()}
+		if _, err := newValueExpression(info.Value, set); err != nil {
+			return fmt.Errorf("value: metric %q: %w", name, err)
+		}
+		if _, err := filterottl.NewBoolExprForLog(info.Conditions, filterottl.StandardLogFuncs(), ottl.PropagateError, set); err != nil {
+			return fmt.Errorf("condition: metric %q: %w", name, err)
+		}
+		for _, attr := range info.Attributes {
+			if attr.Key == "" {
+				return fmt.Errorf("attributes: metric %q: attribute key missing", name)
+			}
+		}
+	}
+	return nil
+}
+
+func newValueExpression(value string, set component.TelemetrySettings) (*ottl.ValueExpression[ottllog.TransformContext], error) {
+	parser, err := ottllog.NewParser(ottlfuncs.StandardConverters[ottllog.TransformContext](), set)
+	if err != nil {
+		return nil, err
+	}
+	return parser.ParseValueExpression(value)
+}
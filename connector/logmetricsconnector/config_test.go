@@ -0,0 +1,191 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package logmetricsconnector
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/connector/logmetricsconnector/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func TestLoadConfig(t *testing.T) {
+	testCases := []struct {
+		name   string
+		expect *Config
+	}{
+		{
+			name: "gauge",
+			expect: &Config{
+				Metrics: map[string]MetricInfo{
+					"queue.size": {
+						Description: "Size of the queue.",
+						Unit:        "{item}",
+						Value:       `attributes["queue.size"]`,
+					},
+				},
+				ErrorMode: ottl.PropagateError,
+			},
+		},
+		{
+			name: "histogram",
+			expect: &Config{
+				Metrics: map[string]MetricInfo{
+					"http.server.request.duration": {
+						Description: "Duration of the HTTP requests.",
+						Unit:        "ms",
+						Type:        MetricTypeHistogram,
+						Value:       `attributes["duration_ms"]`,
+						Conditions: []string{
+							`attributes["duration_ms"] != nil`,
+						},
+						Attributes: []AttributeConfig{
+							{
+								Key: "http.request.method",
+							},
+							{
+								Key:          "http.response.status_code",
+								DefaultValue: int(0),
+							},
+						},
+						Histogram: HistogramConfig{
+							Buckets: []float64{10, 50, 100, 500, 1000},
+						},
+					},
+				},
+				ErrorMode: ottl.IgnoreError,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+			require.NoError(t, err)
+
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+
+			sub, err := cm.Sub(component.NewIDWithName(metadata.Type, tc.name).String())
+			require.NoError(t, err)
+			require.NoError(t, component.UnmarshalConfig(sub, cfg))
+
+			assert.NoError(t, component.ValidateConfig(cfg))
+			assert.Equal(t, tc.expect, cfg)
+		})
+	}
+}
+
+func TestConfigErrors(t *testing.T) {
+	testCases := []struct {
+		name   string
+		input  *Config
+		expect string
+	}{
+		{
+			name:   "no_metrics",
+			input:  &Config{},
+			expect: "no metrics configured",
+		},
+		{
+			name: "missing_metric_name",
+			input: &Config{
+				Metrics: map[string]MetricInfo{
+					"": {Value: "1"},
+				},
+			},
+			expect: "metric name missing",
+		},
+		{
+			name: "missing_value",
+			input: &Config{
+				Metrics: map[string]MetricInfo{
+					"my.metric": {},
+				},
+			},
+			expect: `metric "my.metric": value missing`,
+		},
+		{
+			name: "unsupported_type",
+			input: &Config{
+				Metrics: map[string]MetricInfo{
+					"my.metric": {Value: "1", Type: "sum"},
+				},
+			},
+			expect: `metric "my.metric": unsupported type "sum"`,
+		},
+		{
+			name: "gauge_buckets",
+			input: &Config{
+				Metrics: map[string]MetricInfo{
+					"my.metric": {
+						Value:     "1",
+						Histogram: HistogramConfig{Buckets: []float64{1, 2}},
+					},
+				},
+			},
+			expect: `metric "my.metric": histogram buckets not supported by gauges`,
+		},
+		{
+			name: "unsorted_buckets",
+			input: &Config{
+				Metrics: map[string]MetricInfo{
+					"my.metric": {
+						Value:     "1",
+						Type:      MetricTypeHistogram,
+						Histogram: HistogramConfig{Buckets: []float64{2, 1}},
+					},
+				},
+			},
+			expect: `metric "my.metric": histogram buckets must be sorted`,
+		},
+		{
+			name: "invalid_value",
+			input: &Config{
+				Metrics: map[string]MetricInfo{
+					"my.metric": {Value: "invalid value"},
+				},
+			},
+			expect: `value: metric "my.metric": expression has invalid syntax`,
+		},
+		{
+			name: "invalid_condition",
+			input: &Config{
+				Metrics: map[string]MetricInfo{
+					"my.metric": {
+						Value:      "1",
+						Conditions: []string{"invalid condition"},
+					},
+				},
+			},
+			expect: `condition: metric "my.metric": unable to parse OTTL condition`,
+		},
+		{
+			name: "missing_attribute_key",
+			input: &Config{
+				Metrics: map[string]MetricInfo{
+					"my.metric": {
+						Value:      "1",
+						Attributes: []AttributeConfig{{DefaultValue: "foo"}},
+					},
+				},
+			},
+			expect: `attributes: metric "my.metric": attribute key missing`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.input.Validate()
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), tc.expect)
+		})
+	}
+}
@@ -0,0 +1,140 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package logmetricsconnector // import "github.com/open-telemetry/opentelemetry-collector-contrib/connector/logmetricsconnector"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottllog"
+)
+
+const scopeName = "otelcol/logmetricsconnector"
+
+// logMetrics extracts numeric values from log records and emits
+// them as gauges or histograms onto a metrics pipeline.
+type logMetrics struct {
+	metricsConsumer consumer.Metrics
+	component.StartFunc
+	component.ShutdownFunc
+
+	logger     *zap.Logger
+	errorMode  ottl.ErrorMode
+	metricDefs map[string]metricDef
+}
+
+func (c *logMetrics) Capabilities() consumer.Capabilities {
+	return consumer.Capabilities{MutatesData: false}
+}
+
+func (c *logMetrics) ConsumeLogs(ctx context.Context, ld plog.Logs) error {
+	var multiError error
+	metrics := pmetric.NewMetrics()
+	metrics.ResourceMetrics().EnsureCapacity(ld.ResourceLogs().Len())
+	for i := 0; i < ld.ResourceLogs().Len(); i++ {
+		resourceLog := ld.ResourceLogs().At(i)
+		aggregator := newAggregator(c.metricDefs)
+
+		for j := 0; j < resourceLog.ScopeLogs().Len(); j++ {
+			scopeLogs := resourceLog.ScopeLogs().At(j)
+
+			for k := 0; k < scopeLogs.LogRecords().Len(); k++ {
+				logRecord := scopeLogs.LogRecords().At(k)
+
+				lCtx := ottllog.NewTransformContext(logRecord, scopeLogs.Scope(), resourceLog.Resource())
+				multiError = errors.Join(multiError, c.extract(ctx, aggregator, logRecord, lCtx))
+			}
+		}
+
+		if aggregator.empty() {
+			continue // don't add an empty resource
+		}
+
+		metricsResource := metrics.ResourceMetrics().AppendEmpty()
+		resourceLog.Resource().Attributes().CopyTo(metricsResource.Resource().Attributes())
+
+		metricsScope := metricsResource.ScopeMetrics().AppendEmpty()
+		metricsScope.Scope().SetName(scopeName)
+
+		aggregator.appendMetricsTo(metricsScope.Metrics())
+	}
+	if multiError != nil {
+		return multiError
+	}
+	if metrics.ResourceMetrics().Len() == 0 {
+		return nil
+	}
+	return c.metricsConsumer.ConsumeMetrics(ctx, metrics)
+}
+
+// extract records the values of the metrics whose conditions match the log record.
+func (c *logMetrics) extract(ctx context.Context, aggregator *aggregator, logRecord plog.LogRecord, lCtx ottllog.TransformContext) error {
+	var multiError error
+	for name, md := range c.metricDefs {
+		if md.condition != nil {
+			match, err := md.condition.Eval(ctx, lCtx)
+			if err != nil {
+				// The condition handles the errors according to the error mode.
+				multiError = errors.Join(multiError, err)
+				continue
+			}
+			if !match {
+				continue
+			}
+		}
+
+		attrs, ok := metricAttributes(md.attrs, logRecord.Attributes())
+		if !ok {
+			// Missing necessary attributes to be recorded
+			continue
+		}
+
+		raw, err := md.value.Eval(ctx, lCtx)
+		if err == nil {
+			var value float64
+			if value, err = toFloat(raw); err == nil {
+				aggregator.record(name, attrs, value, logRecord)
+				continue
+			}
+		}
+		err = fmt.Errorf("metric %q: %w", name, err)
+		switch c.errorMode {
+		case ottl.PropagateError:
+			multiError = errors.Join(multiError, err)
+		case ottl.IgnoreError:
+			c.logger.Warn("failed to extract the metric value, ignoring log record", zap.Error(err))
+		case ottl.SilentError:
+		}
+	}
+	return multiError
+}
+
+// toFloat converts the result of a value expression into a metric value.
+func toFloat(raw any) (float64, error) {
+	switch v := raw.(type) {
+	case int64:
+		return float64(v), nil
+	case float64:
+		return v, nil
+	case string:
+		value, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return 0, fmt.Errorf("value %q is not a number", v)
+		}
+		return value, nil
+	case nil:
+		return 0, errors.New("value is nil")
+	default:
+		return 0, fmt.Errorf("value of type %T is not a number", raw)
+	}
+}
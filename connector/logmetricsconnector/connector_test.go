@@ -0,0 +1,294 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package logmetricsconnector
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/connector"
+	"go.opentelemetry.io/collector/connector/connectortest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+var testTime = time.Date(2024, 5, 10, 12, 0, 0, 0, time.UTC)
+
+// testLogs returns a resource, with the attribute resource.name: foo, holding log records
+// with the given attributes. The log records are one second apart.
+func testLogs(t *testing.T, recordAttrs ...map[string]any) plog.Logs {
+	ld := plog.NewLogs()
+	rl := ld.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().PutStr("resource.name", "foo")
+	sl := rl.ScopeLogs().AppendEmpty()
+	for i, attrs := range recordAttrs {
+		lr := sl.LogRecords().AppendEmpty()
+		lr.SetTimestamp(pcommon.NewTimestampFromTime(testTime.Add(time.Duration(i) * time.Second)))
+		lr.Body().SetStr("log message")
+		require.NoError(t, lr.Attributes().FromRaw(attrs))
+	}
+	return ld
+}
+
+func newTestConnector(t *testing.T, cfg *Config) (connector.Logs, *consumertest.MetricsSink) {
+	require.NoError(t, cfg.Validate())
+	sink := &consumertest.MetricsSink{}
+	conn, err := NewFactory().CreateLogsToMetrics(context.Background(), connectortest.NewNopCreateSettings(), cfg, sink)
+	require.NoError(t, err)
+	require.NotNil(t, conn)
+	assert.False(t, conn.Capabilities().MutatesData)
+	require.NoError(t, conn.Start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() {
+		assert.NoError(t, conn.Shutdown(context.Background()))
+	})
+	return conn, sink
+}
+
+// findMetric returns the metric with the given name from the first resource.
+func findMetric(t *testing.T, md pmetric.Metrics, name string) pmetric.Metric {
+	require.Equal(t, 1, md.ResourceMetrics().Len())
+	rm := md.ResourceMetrics().At(0)
+	require.Equal(t, 1, rm.ScopeMetrics().Len())
+	sm := rm.ScopeMetrics().At(0)
+	assert.Equal(t, scopeName, sm.Scope().Name())
+	for i := 0; i < sm.Metrics().Len(); i++ {
+		if sm.Metrics().At(i).Name() == name {
+			return sm.Metrics().At(i)
+		}
+	}
+	require.Failf(t, "metric not found", "metric %q", name)
+	return pmetric.NewMetric()
+}
+
+func TestLogsToGauge(t *testing.T) {
+	cfg := &Config{
+		Metrics: map[string]MetricInfo{
+			"queue.size": {
+				Description: "Size of the queue.",
+				Unit:        "{item}",
+				Value:       `attributes["queue.size"]`,
+				Conditions:  []string{`attributes["queue.size"] != nil`},
+				Attributes:  []AttributeConfig{{Key: "queue.name"}},
+			},
+		},
+		ErrorMode: ottl.PropagateError,
+	}
+	conn, sink := newTestConnector(t, cfg)
+
+	ld := testLogs(t,
+		map[string]any{"queue.name": "a", "queue.size": 3},
+		map[string]any{"queue.name": "a", "queue.size": 5.5},
+		map[string]any{"queue.name": "b", "queue.size": "7"},
+		map[string]any{"queue.name": "b"},
+		map[string]any{"queue.size": 11},
+	)
+	require.NoError(t, conn.ConsumeLogs(context.Background(), ld))
+
+	allMetrics := sink.AllMetrics()
+	require.Len(t, allMetrics, 1)
+	assert.Equal(t, "foo", allMetrics[0].ResourceMetrics().At(0).Resource().Attributes().AsRaw()["resource.name"])
+
+	metric := findMetric(t, allMetrics[0], "queue.size")
+	assert.Equal(t, "Size of the queue.", metric.Description())
+	assert.Equal(t, "{item}", metric.Unit())
+	require.Equal(t, pmetric.MetricTypeGauge, metric.Type())
+
+	values := map[string]float64{}
+	timestamps := map[string]pcommon.Timestamp{}
+	dps := metric.Gauge().DataPoints()
+	require.Equal(t, 2, dps.Len())
+	for i := 0; i < dps.Len(); i++ {
+		name, ok := dps.At(i).Attributes().Get("queue.name")
+		require.True(t, ok)
+		values[name.Str()] = dps.At(i).DoubleValue()
+		timestamps[name.Str()] = dps.At(i).Timestamp()
+	}
+	assert.Equal(t, map[string]float64{"a": 5.5, "b": 7}, values)
+	assert.Equal(t, pcommon.NewTimestampFromTime(testTime.Add(time.Second)), timestamps["a"])
+	assert.Equal(t, pcommon.NewTimestampFromTime(testTime.Add(2*time.Second)), timestamps["b"])
+}
+
+func TestLogsToHistogram(t *testing.T) {
+	cfg := &Config{
+		Metrics: map[string]MetricInfo{
+			"http.server.request.duration": {
+				Unit:  "ms",
+				Type:  MetricTypeHistogram,
+				Value: `attributes["duration_ms"]`,
+				Attributes: []AttributeConfig{
+					{Key: "http.response.status_code", DefaultValue: 0},
+				},
+				Histogram: HistogramConfig{Buckets: []float64{10, 100}},
+			},
+		},
+		ErrorMode: ottl.PropagateError,
+	}
+	conn, sink := newTestConnector(t, cfg)
+
+	ld := testLogs(t,
+		map[string]any{"http.response.status_code": 200, "duration_ms": 5},
+		map[string]any{"http.response.status_code": 200, "duration_ms": 10},
+		map[string]any{"http.response.status_code": 200, "duration_ms": 250},
+		map[string]any{"duration_ms": 42.5},
+	)
+	require.NoError(t, conn.ConsumeLogs(context.Background(), ld))
+
+	allMetrics := sink.AllMetrics()
+	require.Len(t, allMetrics, 1)
+	metric := findMetric(t, allMetrics[0], "http.server.request.duration")
+	require.Equal(t, pmetric.MetricTypeHistogram, metric.Type())
+	assert.Equal(t, pmetric.AggregationTemporalityDelta, metric.Histogram().AggregationTemporality())
+
+	dps := metric.Histogram().DataPoints()
+	require.Equal(t, 2, dps.Len())
+	for i := 0; i < dps.Len(); i++ {
+		dp := dps.At(i)
+		code, ok := dp.Attributes().Get("http.response.status_code")
+		require.True(t, ok)
+		assert.Equal(t, []float64{10, 100}, dp.ExplicitBounds().AsRaw())
+		switch code.Int() {
+		case 200:
+			assert.Equal(t, uint64(3), dp.Count())
+			assert.Equal(t, 265.0, dp.Sum())
+			assert.Equal(t, 5.0, dp.Min())
+			assert.Equal(t, 250.0, dp.Max())
+			assert.Equal(t, []uint64{2, 0, 1}, dp.BucketCounts().AsRaw())
+			assert.Equal(t, pcommon.NewTimestampFromTime(testTime), dp.StartTimestamp())
+		case 0:
+			assert.Equal(t, uint64(1), dp.Count())
+			assert.Equal(t, 42.5, dp.Sum())
+			assert.Equal(t, []uint64{0, 1, 0}, dp.BucketCounts().AsRaw())
+			assert.Equal(t, pcommon.NewTimestampFromTime(testTime.Add(3*time.Second)), dp.StartTimestamp())
+		default:
+			assert.Failf(t, "unexpected status code", "%d", code.Int())
+		}
+	}
+}
+
+func TestLogsToMetricsDefaultBuckets(t *testing.T) {
+	cfg := &Config{
+		Metrics: map[string]MetricInfo{
+			"payload.size": {
+				Type:  MetricTypeHistogram,
+				Value: `attributes["size"]`,
+			},
+		},
+		ErrorMode: ottl.PropagateError,
+	}
+	conn, sink := newTestConnector(t, cfg)
+
+	require.NoError(t, conn.ConsumeLogs(context.Background(), testLogs(t, map[string]any{"size": 3})))
+
+	allMetrics := sink.AllMetrics()
+	require.Len(t, allMetrics, 1)
+	dp := findMetric(t, allMetrics[0], "payload.size").Histogram().DataPoints().At(0)
+	assert.Equal(t, defaultHistogramBuckets, dp.ExplicitBounds().AsRaw())
+	assert.Equal(t, len(defaultHistogramBuckets)+1, dp.BucketCounts().Len())
+	assert.Equal(t, uint64(1), dp.BucketCounts().At(1))
+}
+
+func TestLogsToMetricsNoMatch(t *testing.T) {
+	cfg := &Config{
+		Metrics: map[string]MetricInfo{
+			"queue.size": {
+				Value:      `attributes["queue.size"]`,
+				Conditions: []string{`attributes["queue.size"] != nil`},
+			},
+		},
+		ErrorMode: ottl.PropagateError,
+	}
+	conn, sink := newTestConnector(t, cfg)
+
+	require.NoError(t, conn.ConsumeLogs(context.Background(), testLogs(t, map[string]any{"foo": "bar"})))
+	assert.Empty(t, sink.AllMetrics())
+}
+
+func TestLogsToMetricsErrorMode(t *testing.T) {
+	testCases := []struct {
+		name      string
+		errorMode ottl.ErrorMode
+		expectErr bool
+	}{
+		{
+			name:      "propagate",
+			errorMode: ottl.PropagateError,
+			expectErr: true,
+		},
+		{
+			name:      "ignore",
+			errorMode: ottl.IgnoreError,
+		},
+		{
+			name:      "silent",
+			errorMode: ottl.SilentError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := &Config{
+				Metrics: map[string]MetricInfo{
+					"queue.size": {
+						Value: `attributes["queue.size"]`,
+					},
+				},
+				ErrorMode: tc.errorMode,
+			}
+			conn, sink := newTestConnector(t, cfg)
+
+			ld := testLogs(t,
+				map[string]any{"queue.size": "not a number"},
+				map[string]any{"queue.size": 3},
+			)
+			err := conn.ConsumeLogs(context.Background(), ld)
+			if tc.expectErr {
+				assert.ErrorContains(t, err, `metric "queue.size": value "not a number" is not a number`)
+				assert.Empty(t, sink.AllMetrics())
+				return
+			}
+			require.NoError(t, err)
+			allMetrics := sink.AllMetrics()
+			require.Len(t, allMetrics, 1)
+			dps := findMetric(t, allMetrics[0], "queue.size").Gauge().DataPoints()
+			require.Equal(t, 1, dps.Len())
+			assert.Equal(t, 3.0, dps.At(0).DoubleValue())
+		})
+	}
+}
+
+func TestToFloat(t *testing.T) {
+	testCases := []struct {
+		name      string
+		raw       any
+		expect    float64
+		expectErr string
+	}{
+		{name: "int", raw: int64(3), expect: 3},
+		{name: "double", raw: 2.5, expect: 2.5},
+		{name: "string", raw: "1.25", expect: 1.25},
+		{name: "invalid_string", raw: "foo", expectErr: `value "foo" is not a number`},
+		{name: "nil", raw: nil, expectErr: "value is nil"},
+		{name: "bool", raw: true, expectErr: "value of type bool is not a number"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			value, err := toFloat(tc.raw)
+			if tc.expectErr != "" {
+				assert.EqualError(t, err, tc.expectErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expect, value)
+		})
+	}
+}
@@ -0,0 +1,93 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:generate mdatagen metadata.yaml
+
+package logmetricsconnector // import "github.com/open-telemetry/opentelemetry-collector-contrib/connector/logmetricsconnector"
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/connector"
+	"go.opentelemetry.io/collector/consumer"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/connector/logmetricsconnector/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/expr"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottllog"
+)
+
+// NewFactory returns a ConnectorFactory.
+func NewFactory() connector.Factory {
+	return connector.NewFactory(
+		metadata.Type,
+		createDefaultConfig,
+		connector.WithLogsToMetrics(createLogsToMetrics, metadata.LogsToMetricsStability),
+	)
+}
+
+// createDefaultConfig creates the default configuration.
+func createDefaultConfig() component.Config {
+	return &Config{
+		ErrorMode: ottl.PropagateError,
+	}
+}
+
+// createLogsToMetrics creates a logs to metrics connector based on provided config.
+func createLogsToMetrics(
+	_ context.Context,
+	set connector.CreateSettings,
+	cfg component.Config,
+	nextConsumer consumer.Metrics,
+) (connector.Logs, error) {
+	c := cfg.(*Config)
+
+	metricDefs := make(map[string]metricDef, len(c.Metrics))
+	for name, info := range c.Metrics {
+		value, err := newValueExpression(info.Value, set.TelemetrySettings)
+		if err != nil {
+			return nil, fmt.Errorf("value: metric %q: %w", name, err)
+		}
+		md := metricDef{
+			desc:    info.Description,
+			unit:    info.Unit,
+			typ:     info.Type,
+			value:   value,
+			attrs:   info.Attributes,
+			buckets: info.Histogram.Buckets,
+		}
+		if md.typ == "" {
+			md.typ = MetricTypeGauge
+		}
+		if md.typ == MetricTypeHistogram && len(md.buckets) == 0 {
+			md.buckets = defaultHistogramBuckets
+		}
+		if len(info.Conditions) > 0 {
+			md.condition, err = filterottl.NewBoolExprForLog(info.Conditions, filterottl.StandardLogFuncs(), c.ErrorMode, set.TelemetrySettings)
+			if err != nil {
+				return nil, fmt.Errorf("condition: metric %q: %w", name, err)
+			}
+		}
+		metricDefs[name] = md
+	}
+
+	return &logMetrics{
+		metricsConsumer: nextConsumer,
+		logger:          set.Logger,
+		errorMode:       c.ErrorMode,
+		metricDefs:      metricDefs,
+	}, nil
+}
+
+type metricDef struct {
+	condition expr.BoolExpr[ottllog.TransformContext]
+	value     *ottl.ValueExpression[ottllog.TransformContext]
+	desc      string
+	unit      string
+	typ       MetricType
+	attrs     []AttributeConfig
+	buckets   []float64
+}
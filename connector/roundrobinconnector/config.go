@@ -3,5 +3,38 @@
 
 package roundrobinconnector // import "github.com/open-telemetry/opentelemetry-collector-contrib/connector/roundrobinconnector"
 
+import (
+	"errors"
+	"fmt"
+)
+
+const (
+	// traceIDRouting routes all the spans of a trace to the same pipeline.
+	traceIDRouting = "traceID"
+	// resourceRouting routes all the data of a resource to the same pipeline.
+	resourceRouting = "resource"
+)
+
 // Config for the connector
-type Config struct{}
+type Config struct {
+	// RoutingKey selects how the data is split between the pipelines. Empty distributes the batches
+	// in a round-robin mode, "traceID" shards the spans by trace ID and "resource" shards the data
+	// by the hash of the resource attributes.
+	RoutingKey string `mapstructure:"routing_key"`
+
+	// ResourceAttributes restricts the resource attributes hashed by the "resource" routing key,
+	// all the resource attributes are hashed if empty.
+	ResourceAttributes []string `mapstructure:"resource_attributes"`
+}
+
+func (c *Config) Validate() error {
+	switch c.RoutingKey {
+	case "", traceIDRouting, resourceRouting:
+	default:
+		return fmt.Errorf("unsupported routing_key %q", c.RoutingKey)
+	}
+	if len(c.ResourceAttributes) > 0 && c.RoutingKey != resourceRouting {
+		return errors.New("resource_attributes requires the resource routing_key")
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package roundrobinconnector
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigValidate(t *testing.T) {
+	testCases := []struct {
+		name   string
+		cfg    *Config
+		expect string
+	}{
+		{
+			name: "round_robin",
+			cfg:  &Config{},
+		},
+		{
+			name: "trace_id",
+			cfg:  &Config{RoutingKey: traceIDRouting},
+		},
+		{
+			name: "resource_attributes",
+			cfg:  &Config{RoutingKey: resourceRouting, ResourceAttributes: []string{"service.name"}},
+		},
+		{
+			name:   "unsupported_routing_key",
+			cfg:    &Config{RoutingKey: "span"},
+			expect: `unsupported routing_key "span"`,
+		},
+		{
+			name:   "resource_attributes_without_resource_routing",
+			cfg:    &Config{RoutingKey: traceIDRouting, ResourceAttributes: []string{"service.name"}},
+			expect: "resource_attributes requires the resource routing_key",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.cfg.Validate()
+			if tc.expect == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tc.expect)
+		})
+	}
+}
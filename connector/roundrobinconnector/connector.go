@@ -5,6 +5,7 @@ package roundrobinconnector // import "github.com/open-telemetry/opentelemetry-c
 
 import (
 	"context"
+	"errors"
 	"sync/atomic"
 
 	"go.opentelemetry.io/collector/component"
@@ -33,36 +34,46 @@ type router[T any] interface {
 	Consumer(pipelineIDs ...component.ID) (T, error)
 }
 
-func newLogs(nextConsumer consumer.Logs) (connector.Logs, error) {
+var errTraceIDRouting = errors.New("the traceID routing_key is only supported by traces")
+
+func newLogs(cfg *Config, nextConsumer consumer.Logs) (connector.Logs, error) {
+	if cfg.RoutingKey == traceIDRouting {
+		return nil, errTraceIDRouting
+	}
 	nextConsumers, err := allConsumers[consumer.Logs](nextConsumer.(connector.LogsRouterAndConsumer))
 	if err != nil {
 		return nil, err
 	}
-	return &roundRobin{nextLogs: nextConsumers}, nil
+	return &roundRobin{cfg: cfg, nextLogs: nextConsumers}, nil
 }
 
-func newMetrics(nextConsumer consumer.Metrics) (connector.Metrics, error) {
+func newMetrics(cfg *Config, nextConsumer consumer.Metrics) (connector.Metrics, error) {
+	if cfg.RoutingKey == traceIDRouting {
+		return nil, errTraceIDRouting
+	}
 	nextConsumers, err := allConsumers[consumer.Metrics](nextConsumer.(connector.MetricsRouterAndConsumer))
 	if err != nil {
 		return nil, err
 	}
-	return &roundRobin{nextMetrics: nextConsumers}, nil
+	return &roundRobin{cfg: cfg, nextMetrics: nextConsumers}, nil
 }
 
-func newTraces(nextConsumer consumer.Traces) (connector.Traces, error) {
+func newTraces(cfg *Config, nextConsumer consumer.Traces) (connector.Traces, error) {
 	nextConsumers, err := allConsumers[consumer.Traces](nextConsumer.(connector.TracesRouterAndConsumer))
 	if err != nil {
 		return nil, err
 	}
-	return &roundRobin{nextTraces: nextConsumers}, nil
+	return &roundRobin{cfg: cfg, nextTraces: nextConsumers}, nil
 }
 
 // roundRobin is used to pass signals directly from one pipeline to one of the configured once in a round-robin mode.
 // This is useful when there is a need to scale (shard) data processing and downstream components do not
-// handle concurrent requests very well.
+// handle concurrent requests very well. With a routing key, the data is sharded instead so that related
+// data, such as the spans of a trace, is always consumed by the same pipeline.
 type roundRobin struct {
 	component.StartFunc
 	component.ShutdownFunc
+	cfg          *Config
 	nextConsumer atomic.Uint64
 	nextMetrics  []consumer.Metrics
 	nextLogs     []consumer.Logs
@@ -74,13 +85,25 @@ func (rr *roundRobin) Capabilities() consumer.Capabilities {
 }
 
 func (rr *roundRobin) ConsumeLogs(ctx context.Context, ld plog.Logs) error {
+	if rr.cfg.RoutingKey == resourceRouting {
+		return rr.shardLogs(ctx, ld)
+	}
 	return rr.nextLogs[rr.nextConsumer.Add(1)%uint64(len(rr.nextLogs))].ConsumeLogs(ctx, ld)
 }
 
 func (rr *roundRobin) ConsumeMetrics(ctx context.Context, md pmetric.Metrics) error {
+	if rr.cfg.RoutingKey == resourceRouting {
+		return rr.shardMetrics(ctx, md)
+	}
 	return rr.nextMetrics[rr.nextConsumer.Add(1)%uint64(len(rr.nextMetrics))].ConsumeMetrics(ctx, md)
 }
 
 func (rr *roundRobin) ConsumeTraces(ctx context.Context, td ptrace.Traces) error {
+	switch rr.cfg.RoutingKey {
+	case traceIDRouting:
+		return rr.shardTracesByTraceID(ctx, td)
+	case resourceRouting:
+		return rr.shardTracesByResource(ctx, td)
+	}
 	return rr.nextTraces[rr.nextConsumer.Add(1)%uint64(len(rr.nextTraces))].ConsumeTraces(ctx, td)
 }
@@ -9,12 +9,14 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/connector"
 	"go.opentelemetry.io/collector/connector/connectortest"
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/pdata/ptrace"
@@ -138,3 +140,136 @@ func TestTracesRoundRobin(t *testing.T) {
 
 	assert.NoError(t, traces.Shutdown(ctx))
 }
+
+func TestTracesShardByTraceID(t *testing.T) {
+	f := NewFactory()
+	cfg := &Config{RoutingKey: traceIDRouting}
+
+	ctx := context.Background()
+	set := connectortest.NewNopCreateSettings()
+
+	sinks := []*consumertest.TracesSink{new(consumertest.TracesSink), new(consumertest.TracesSink), new(consumertest.TracesSink)}
+	traces, err := f.CreateTracesToTraces(ctx, set, cfg, connector.NewTracesRouter(newPipelineMap[consumer.Traces](component.DataTypeTraces, sinks[0], sinks[1], sinks[2])))
+	require.NoError(t, err)
+
+	td := ptrace.NewTraces()
+	rs := td.ResourceSpans().AppendEmpty()
+	rs.Resource().Attributes().PutStr("service.name", "svc")
+	ss := rs.ScopeSpans().AppendEmpty()
+	ss.Scope().SetName("scope")
+	for i := 0; i < 32; i++ {
+		span := ss.Spans().AppendEmpty()
+		span.SetTraceID(pcommon.TraceID{byte(i % 8), 1})
+		span.SetSpanID(pcommon.SpanID{byte(i), 1})
+	}
+
+	// The same traces are sent twice, each trace must be consumed by a single pipeline.
+	for i := 0; i < 2; i++ {
+		require.NoError(t, traces.ConsumeTraces(ctx, td))
+	}
+
+	traceSinks := make(map[pcommon.TraceID]int)
+	spanCount := 0
+	for i, sink := range sinks {
+		for _, shard := range sink.AllTraces() {
+			for j := 0; j < shard.ResourceSpans().Len(); j++ {
+				shardRs := shard.ResourceSpans().At(j)
+				assert.Equal(t, map[string]any{"service.name": "svc"}, shardRs.Resource().Attributes().AsRaw())
+				for k := 0; k < shardRs.ScopeSpans().Len(); k++ {
+					shardSs := shardRs.ScopeSpans().At(k)
+					assert.Equal(t, "scope", shardSs.Scope().Name())
+					for l := 0; l < shardSs.Spans().Len(); l++ {
+						traceID := shardSs.Spans().At(l).TraceID()
+						if prev, ok := traceSinks[traceID]; ok {
+							assert.Equal(t, prev, i, "spans of a trace consumed by multiple pipelines")
+						}
+						traceSinks[traceID] = i
+						spanCount++
+					}
+				}
+			}
+		}
+	}
+	assert.Equal(t, 64, spanCount)
+	assert.Len(t, traceSinks, 8)
+}
+
+func TestLogsShardByResource(t *testing.T) {
+	f := NewFactory()
+	cfg := &Config{RoutingKey: resourceRouting, ResourceAttributes: []string{"service.name"}}
+
+	ctx := context.Background()
+	set := connectortest.NewNopCreateSettings()
+
+	sinks := []*consumertest.LogsSink{new(consumertest.LogsSink), new(consumertest.LogsSink)}
+	logs, err := f.CreateLogsToLogs(ctx, set, cfg, connector.NewLogsRouter(newPipelineMap[consumer.Logs](component.DataTypeLogs, sinks[0], sinks[1])))
+	require.NoError(t, err)
+
+	ld := plog.NewLogs()
+	for i := 0; i < 16; i++ {
+		rl := ld.ResourceLogs().AppendEmpty()
+		rl.Resource().Attributes().PutStr("service.name", "svc-"+strconv.Itoa(i%4))
+		// Not part of the resource attributes used for routing
+		rl.Resource().Attributes().PutInt("instance", int64(i))
+		rl.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+	}
+	require.NoError(t, logs.ConsumeLogs(ctx, ld))
+
+	serviceSinks := make(map[string]int)
+	recordCount := 0
+	for i, sink := range sinks {
+		assert.LessOrEqual(t, len(sink.AllLogs()), 1)
+		for _, shard := range sink.AllLogs() {
+			for j := 0; j < shard.ResourceLogs().Len(); j++ {
+				service, ok := shard.ResourceLogs().At(j).Resource().Attributes().Get("service.name")
+				require.True(t, ok)
+				if prev, ok := serviceSinks[service.Str()]; ok {
+					assert.Equal(t, prev, i, "logs of a resource consumed by multiple pipelines")
+				}
+				serviceSinks[service.Str()] = i
+			}
+			recordCount += shard.LogRecordCount()
+		}
+	}
+	assert.Equal(t, 16, recordCount)
+	assert.Len(t, serviceSinks, 4)
+}
+
+func TestMetricsShardByResource(t *testing.T) {
+	f := NewFactory()
+	cfg := &Config{RoutingKey: resourceRouting}
+
+	ctx := context.Background()
+	set := connectortest.NewNopCreateSettings()
+
+	sink1 := new(consumertest.MetricsSink)
+	sink2 := new(consumertest.MetricsSink)
+	metrics, err := f.CreateMetricsToMetrics(ctx, set, cfg, connector.NewMetricsRouter(newPipelineMap[consumer.Metrics](component.DataTypeMetrics, sink1, sink2)))
+	require.NoError(t, err)
+
+	md := pmetric.NewMetrics()
+	rm := md.ResourceMetrics().AppendEmpty()
+	rm.Resource().Attributes().PutStr("service.name", "svc")
+	rm.ScopeMetrics().AppendEmpty().Metrics().AppendEmpty().SetName("metric")
+
+	for i := 0; i < 4; i++ {
+		require.NoError(t, metrics.ConsumeMetrics(ctx, md))
+	}
+
+	// All the data of the resource is consumed by the same pipeline
+	assert.ElementsMatch(t, []int{0, 4}, []int{len(sink1.AllMetrics()), len(sink2.AllMetrics())})
+}
+
+func TestTraceIDRoutingNotSupported(t *testing.T) {
+	f := NewFactory()
+	cfg := &Config{RoutingKey: traceIDRouting}
+
+	ctx := context.Background()
+	set := connectortest.NewNopCreateSettings()
+
+	_, err := f.CreateLogsToLogs(ctx, set, cfg, connector.NewLogsRouter(newPipelineMap[consumer.Logs](component.DataTypeLogs, consumertest.NewNop())))
+	assert.ErrorIs(t, err, errTraceIDRouting)
+
+	_, err = f.CreateMetricsToMetrics(ctx, set, cfg, connector.NewMetricsRouter(newPipelineMap[consumer.Metrics](component.DataTypeMetrics, consumertest.NewNop())))
+	assert.ErrorIs(t, err, errTraceIDRouting)
+}
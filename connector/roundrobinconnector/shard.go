@@ -0,0 +1,148 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package roundrobinconnector // import "github.com/open-telemetry/opentelemetry-collector-contrib/connector/roundrobinconnector"
+
+import (
+	"context"
+	"errors"
+	"hash/fnv"
+	"sort"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+// resourceShard returns the index of the pipeline consuming the data of the resource.
+func (rr *roundRobin) resourceShard(res pcommon.Resource, shards int) int {
+	h := fnv.New64a()
+	attrs := res.Attributes()
+	keys := rr.cfg.ResourceAttributes
+	if len(keys) == 0 {
+		keys = make([]string, 0, attrs.Len())
+		attrs.Range(func(k string, _ pcommon.Value) bool {
+			keys = append(keys, k)
+			return true
+		})
+		sort.Strings(keys)
+	}
+	for _, k := range keys {
+		v, ok := attrs.Get(k)
+		if !ok {
+			continue
+		}
+		_, _ = h.Write([]byte(k))
+		_, _ = h.Write([]byte{0})
+		_, _ = h.Write([]byte(v.AsString()))
+		_, _ = h.Write([]byte{0})
+	}
+	return int(h.Sum64() % uint64(shards))
+}
+
+// traceIDShard returns the index of the pipeline consuming the spans of the trace.
+func traceIDShard(traceID pcommon.TraceID, shards int) int {
+	h := fnv.New64a()
+	_, _ = h.Write(traceID[:])
+	return int(h.Sum64() % uint64(shards))
+}
+
+func (rr *roundRobin) shardLogs(ctx context.Context, ld plog.Logs) error {
+	shards := make([]plog.Logs, len(rr.nextLogs))
+	for i := range shards {
+		shards[i] = plog.NewLogs()
+	}
+	for i := 0; i < ld.ResourceLogs().Len(); i++ {
+		rl := ld.ResourceLogs().At(i)
+		idx := rr.resourceShard(rl.Resource(), len(shards))
+		rl.CopyTo(shards[idx].ResourceLogs().AppendEmpty())
+	}
+	var errs error
+	for i, shard := range shards {
+		if shard.ResourceLogs().Len() > 0 {
+			errs = errors.Join(errs, rr.nextLogs[i].ConsumeLogs(ctx, shard))
+		}
+	}
+	return errs
+}
+
+func (rr *roundRobin) shardMetrics(ctx context.Context, md pmetric.Metrics) error {
+	shards := make([]pmetric.Metrics, len(rr.nextMetrics))
+	for i := range shards {
+		shards[i] = pmetric.NewMetrics()
+	}
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		rm := md.ResourceMetrics().At(i)
+		idx := rr.resourceShard(rm.Resource(), len(shards))
+		rm.CopyTo(shards[idx].ResourceMetrics().AppendEmpty())
+	}
+	var errs error
+	for i, shard := range shards {
+		if shard.ResourceMetrics().Len() > 0 {
+			errs = errors.Join(errs, rr.nextMetrics[i].ConsumeMetrics(ctx, shard))
+		}
+	}
+	return errs
+}
+
+func (rr *roundRobin) shardTracesByResource(ctx context.Context, td ptrace.Traces) error {
+	shards := newTraceShards(len(rr.nextTraces))
+	for i := 0; i < td.ResourceSpans().Len(); i++ {
+		rs := td.ResourceSpans().At(i)
+		idx := rr.resourceShard(rs.Resource(), len(shards))
+		rs.CopyTo(shards[idx].ResourceSpans().AppendEmpty())
+	}
+	return rr.consumeTraceShards(ctx, shards)
+}
+
+// shardTracesByTraceID splits the spans of the batch by trace ID, keeping their resource and scope.
+func (rr *roundRobin) shardTracesByTraceID(ctx context.Context, td ptrace.Traces) error {
+	shards := newTraceShards(len(rr.nextTraces))
+	for i := 0; i < td.ResourceSpans().Len(); i++ {
+		rs := td.ResourceSpans().At(i)
+		destResources := make(map[int]ptrace.ResourceSpans)
+		for j := 0; j < rs.ScopeSpans().Len(); j++ {
+			ss := rs.ScopeSpans().At(j)
+			destScopes := make(map[int]ptrace.ScopeSpans)
+			for k := 0; k < ss.Spans().Len(); k++ {
+				span := ss.Spans().At(k)
+				idx := traceIDShard(span.TraceID(), len(shards))
+				destScope, ok := destScopes[idx]
+				if !ok {
+					destResource, ok := destResources[idx]
+					if !ok {
+						destResource = shards[idx].ResourceSpans().AppendEmpty()
+						rs.Resource().CopyTo(destResource.Resource())
+						destResource.SetSchemaUrl(rs.SchemaUrl())
+						destResources[idx] = destResource
+					}
+					destScope = destResource.ScopeSpans().AppendEmpty()
+					ss.Scope().CopyTo(destScope.Scope())
+					destScope.SetSchemaUrl(ss.SchemaUrl())
+					destScopes[idx] = destScope
+				}
+				span.CopyTo(destScope.Spans().AppendEmpty())
+			}
+		}
+	}
+	return rr.consumeTraceShards(ctx, shards)
+}
+
+func newTraceShards(n int) []ptrace.Traces {
+	shards := make([]ptrace.Traces, n)
+	for i := range shards {
+		shards[i] = ptrace.NewTraces()
+	}
+	return shards
+}
+
+func (rr *roundRobin) consumeTraceShards(ctx context.Context, shards []ptrace.Traces) error {
+	var errs error
+	for i, shard := range shards {
+		if shard.ResourceSpans().Len() > 0 {
+			errs = errors.Join(errs, rr.nextTraces[i].ConsumeTraces(ctx, shard))
+		}
+	}
+	return errs
+}
@@ -44,6 +44,9 @@ type Config struct {
 	// DatabaseNameAttribute is the attribute name used to identify the database name from span attributes.
 	// The default value is db.name.
 	DatabaseNameAttribute string `mapstructure:"database_name_attribute"`
+
+	// RuntimeConfig defines the file updating the dimensions and the latency histogram buckets while the connector is running.
+	RuntimeConfig RuntimeConfig `mapstructure:"runtime_config"`
 }
 
 type StoreConfig struct {
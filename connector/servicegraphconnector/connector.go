@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -62,6 +63,21 @@ type serviceGraphConnector struct {
 	store *store.Store
 
 	startTime time.Time
+	// durationStartTime is the start time of the latency histograms, reset with them when the
+	// buckets are updated at runtime.
+	durationStartTime time.Time
+
+	// staticDimensions and staticDurationBounds are the settings of the config, overridden by
+	// the runtime config file.
+	staticDimensions     []string
+	staticDurationBounds []float64
+	runtimeConfigWatcher *runtimeConfigWatcher
+
+	dimensionsMutex sync.RWMutex
+	dimensions      []string
+	// keyGeneration is incremented when the dimensions are updated at runtime, so that the keys
+	// of the new series don't collide with the ones of the series already accumulated.
+	keyGeneration int
 
 	seriesMutex                          sync.Mutex
 	reqTotal                             map[string]int64
@@ -137,12 +153,17 @@ func newConnector(set component.TelemetrySettings, config component.Config, next
 		metric.WithUnit("1"),
 	)
 
-	return &serviceGraphConnector{
+	startTime := time.Now()
+	p := &serviceGraphConnector{
 		config:          pConfig,
 		logger:          set.Logger,
 		metricsConsumer: next,
 
-		startTime:                            time.Now(),
+		startTime:                            startTime,
+		durationStartTime:                    startTime,
+		staticDimensions:                     pConfig.Dimensions,
+		staticDurationBounds:                 bounds,
+		dimensions:                           pConfig.Dimensions,
 		reqTotal:                             make(map[string]int64),
 		reqFailedTotal:                       make(map[string]int64),
 		reqClientDurationSecondsCount:        make(map[string]uint64),
@@ -158,11 +179,25 @@ func newConnector(set component.TelemetrySettings, config component.Config, next
 		statTotalEdges:                       totalEdges,
 		statExpiredEdges:                     expiredEdges,
 	}
+	if pConfig.RuntimeConfig.File != "" {
+		p.runtimeConfigWatcher = &runtimeConfigWatcher{
+			cfg:    pConfig.RuntimeConfig,
+			logger: set.Logger,
+			apply:  p.applyRuntimeSettings,
+		}
+	}
+	return p
 }
 
 func (p *serviceGraphConnector) Start(_ context.Context, _ component.Host) error {
 	p.store = store.NewStore(p.config.Store.TTL, p.config.Store.MaxItems, p.onComplete, p.onExpire)
 
+	if p.runtimeConfigWatcher != nil {
+		if err := p.runtimeConfigWatcher.start(); err != nil {
+			return err
+		}
+	}
+
 	go p.metricFlushLoop(p.config.MetricsFlushInterval)
 
 	go p.cacheLoop(p.config.CacheLoop)
@@ -211,6 +246,9 @@ func (p *serviceGraphConnector) flushMetrics(ctx context.Context) error {
 func (p *serviceGraphConnector) Shutdown(_ context.Context) error {
 	p.logger.Info("Shutting down servicegraphconnector")
 	close(p.shutdownCh)
+	if p.runtimeConfigWatcher != nil {
+		p.runtimeConfigWatcher.stop()
+	}
 	return nil
 }
 
@@ -329,7 +367,8 @@ func (p *serviceGraphConnector) aggregateMetrics(ctx context.Context, td ptrace.
 }
 
 func (p *serviceGraphConnector) upsertDimensions(kind string, m map[string]string, resourceAttr pcommon.Map, spanAttr pcommon.Map) {
-	for _, dim := range p.config.Dimensions {
+	dimensions, _ := p.currentDimensions()
+	for _, dim := range dimensions {
 		if v, ok := findAttributeValue(dim, resourceAttr, spanAttr); ok {
 			m[kind+"_"+dim] = v
 		}
@@ -543,7 +582,7 @@ func (p *serviceGraphConnector) collectClientLatencyMetrics(ilm pmetric.ScopeMet
 		timestamp := pcommon.NewTimestampFromTime(time.Now())
 
 		dpDuration := mDuration.Histogram().DataPoints().AppendEmpty()
-		dpDuration.SetStartTimestamp(pcommon.NewTimestampFromTime(p.startTime))
+		dpDuration.SetStartTimestamp(pcommon.NewTimestampFromTime(p.durationStartTime))
 		dpDuration.SetTimestamp(timestamp)
 		dpDuration.ExplicitBounds().FromRaw(p.reqDurationBounds)
 		dpDuration.BucketCounts().FromRaw(p.reqServerDurationSecondsBucketCounts[key])
@@ -571,7 +610,7 @@ func (p *serviceGraphConnector) collectServerLatencyMetrics(ilm pmetric.ScopeMet
 		timestamp := pcommon.NewTimestampFromTime(time.Now())
 
 		dpDuration := mDuration.Histogram().DataPoints().AppendEmpty()
-		dpDuration.SetStartTimestamp(pcommon.NewTimestampFromTime(p.startTime))
+		dpDuration.SetStartTimestamp(pcommon.NewTimestampFromTime(p.durationStartTime))
 		dpDuration.SetTimestamp(timestamp)
 		dpDuration.ExplicitBounds().FromRaw(p.reqDurationBounds)
 		dpDuration.BucketCounts().FromRaw(p.reqClientDurationSecondsBucketCounts[key])
@@ -590,10 +629,14 @@ func (p *serviceGraphConnector) collectServerLatencyMetrics(ilm pmetric.ScopeMet
 }
 
 func (p *serviceGraphConnector) buildMetricKey(clientName, serverName, connectionType string, edgeDimensions map[string]string) string {
+	dimensions, keyGeneration := p.currentDimensions()
 	var metricKey strings.Builder
+	if keyGeneration > 0 {
+		metricKey.WriteString(strconv.Itoa(keyGeneration) + metricKeySeparator)
+	}
 	metricKey.WriteString(clientName + metricKeySeparator + serverName + metricKeySeparator + connectionType)
 
-	for _, dimName := range p.config.Dimensions {
+	for _, dimName := range dimensions {
 		dim, ok := edgeDimensions[dimName]
 		if !ok {
 			continue
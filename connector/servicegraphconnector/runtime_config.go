@@ -0,0 +1,177 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package servicegraphconnector // import "github.com/open-telemetry/opentelemetry-collector-contrib/connector/servicegraphconnector"
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/confmap"
+	"go.uber.org/zap"
+	"gopkg.in/yaml.v3"
+)
+
+const defaultRuntimeConfigReloadInterval = 30 * time.Second
+
+// RuntimeConfig defines the file holding the settings updated while the connector is running.
+type RuntimeConfig struct {
+	// File is a YAML file holding `dimensions` and `latency_histogram_buckets` overriding the
+	// ones of the config, reloaded when it changes.
+	File string `mapstructure:"file"`
+	// ReloadInterval is how often the file is checked for changes.
+	// See defaultRuntimeConfigReloadInterval for the default value.
+	ReloadInterval time.Duration `mapstructure:"reload_interval"`
+}
+
+// runtimeSettings are the settings of the runtime config file, the settings which aren't set
+// keeping the values of the config.
+type runtimeSettings struct {
+	Dimensions              []string        `mapstructure:"dimensions"`
+	LatencyHistogramBuckets []time.Duration `mapstructure:"latency_histogram_buckets"`
+}
+
+func loadRuntimeSettings(path string) (runtimeSettings, error) {
+	var settings runtimeSettings
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return settings, err
+	}
+	var raw map[string]any
+	if err = yaml.Unmarshal(content, &raw); err != nil {
+		return settings, fmt.Errorf("invalid runtime config file %q: %w", path, err)
+	}
+	if err = confmap.NewFromStringMap(raw).Unmarshal(&settings); err != nil {
+		return settings, fmt.Errorf("invalid runtime config file %q: %w", path, err)
+	}
+	if !sort.SliceIsSorted(settings.LatencyHistogramBuckets, func(i, j int) bool {
+		return settings.LatencyHistogramBuckets[i] < settings.LatencyHistogramBuckets[j]
+	}) {
+		return settings, errors.New("'latency_histogram_buckets' must be sorted")
+	}
+	return settings, nil
+}
+
+// runtimeConfigWatcher applies the runtime config file when it changes, keeping the current
+// settings when the new ones are invalid.
+type runtimeConfigWatcher struct {
+	cfg     RuntimeConfig
+	logger  *zap.Logger
+	apply   func(runtimeSettings)
+	modTime time.Time
+	size    int64
+	stopCh  chan struct{}
+	wg      sync.WaitGroup
+}
+
+func (w *runtimeConfigWatcher) start() error {
+	if err := w.load(); err != nil {
+		return err
+	}
+	interval := w.cfg.ReloadInterval
+	if interval <= 0 {
+		interval = defaultRuntimeConfigReloadInterval
+	}
+	w.stopCh = make(chan struct{})
+	w.wg.Add(1)
+	go w.watch(interval)
+	return nil
+}
+
+func (w *runtimeConfigWatcher) stop() {
+	if w.stopCh != nil {
+		close(w.stopCh)
+		w.wg.Wait()
+		w.stopCh = nil
+	}
+}
+
+func (w *runtimeConfigWatcher) load() error {
+	info, err := os.Stat(w.cfg.File)
+	if err != nil {
+		return err
+	}
+	// the same content is not applied nor reported again
+	w.modTime, w.size = info.ModTime(), info.Size()
+	settings, err := loadRuntimeSettings(w.cfg.File)
+	if err != nil {
+		return err
+	}
+	w.apply(settings)
+	return nil
+}
+
+func (w *runtimeConfigWatcher) watch(interval time.Duration) {
+	defer w.wg.Done()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-w.stopCh:
+			return
+		case <-ticker.C:
+			info, err := os.Stat(w.cfg.File)
+			if err != nil {
+				w.logger.Warn("Failed to check the runtime config file, keeping the current settings", zap.Error(err))
+				continue
+			}
+			if info.ModTime().Equal(w.modTime) && info.Size() == w.size {
+				continue
+			}
+			if err = w.load(); err != nil {
+				w.logger.Error("Failed to reload the runtime config file, keeping the current settings", zap.Error(err))
+				continue
+			}
+			w.logger.Info("Reloaded the runtime config file", zap.String("path", w.cfg.File))
+		}
+	}
+}
+
+// applyRuntimeSettings updates the dimensions and the latency histogram buckets. The series
+// already accumulated are kept, the edges being aggregated in new series when the dimensions
+// change. The latency histograms are reset with a new start timestamp when the buckets change,
+// as their counts cannot be carried over to other buckets.
+func (p *serviceGraphConnector) applyRuntimeSettings(settings runtimeSettings) {
+	dimensions := p.staticDimensions
+	if settings.Dimensions != nil {
+		dimensions = settings.Dimensions
+	}
+	bounds := p.staticDurationBounds
+	if settings.LatencyHistogramBuckets != nil {
+		bounds = mapDurationsToFloat(settings.LatencyHistogramBuckets)
+	}
+
+	p.dimensionsMutex.Lock()
+	if !reflect.DeepEqual(dimensions, p.dimensions) {
+		p.dimensions = dimensions
+		// keys of the new dimensions may collide with the ones of the series already accumulated
+		p.keyGeneration++
+	}
+	p.dimensionsMutex.Unlock()
+
+	p.seriesMutex.Lock()
+	defer p.seriesMutex.Unlock()
+	if reflect.DeepEqual(bounds, p.reqDurationBounds) {
+		return
+	}
+	p.reqDurationBounds = bounds
+	p.reqClientDurationSecondsCount = make(map[string]uint64)
+	p.reqClientDurationSecondsSum = make(map[string]float64)
+	p.reqClientDurationSecondsBucketCounts = make(map[string][]uint64)
+	p.reqServerDurationSecondsCount = make(map[string]uint64)
+	p.reqServerDurationSecondsSum = make(map[string]float64)
+	p.reqServerDurationSecondsBucketCounts = make(map[string][]uint64)
+	p.durationStartTime = time.Now()
+}
+
+// currentDimensions returns the dimensions and the generation of the metric keys.
+func (p *serviceGraphConnector) currentDimensions() ([]string, int) {
+	p.dimensionsMutex.RLock()
+	defer p.dimensionsMutex.RUnlock()
+	return p.dimensions, p.keyGeneration
+}
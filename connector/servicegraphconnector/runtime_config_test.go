@@ -0,0 +1,97 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package servicegraphconnector
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.uber.org/zap/zaptest"
+)
+
+func newRuntimeConfigConnector(t *testing.T, file string) *serviceGraphConnector {
+	cfg := &Config{
+		Dimensions:              []string{"some-attribute"},
+		LatencyHistogramBuckets: []time.Duration{time.Second, 2 * time.Second},
+		Store: StoreConfig{
+			MaxItems: 10,
+			TTL:      time.Second,
+		},
+		RuntimeConfig: RuntimeConfig{File: file, ReloadInterval: 10 * time.Millisecond},
+	}
+	set := componenttest.NewNopTelemetrySettings()
+	set.Logger = zaptest.NewLogger(t)
+	return newConnector(set, cfg, consumertest.NewNop())
+}
+
+func TestApplyRuntimeSettings(t *testing.T) {
+	p := newRuntimeConfigConnector(t, "")
+	require.NoError(t, p.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() { require.NoError(t, p.Shutdown(context.Background())) }()
+	require.NoError(t, p.ConsumeTraces(context.Background(), buildSampleTrace(t, "first")))
+	require.Len(t, p.reqTotal, 1)
+
+	p.applyRuntimeSettings(runtimeSettings{
+		Dimensions:              []string{"other-attribute"},
+		LatencyHistogramBuckets: []time.Duration{500 * time.Millisecond},
+	})
+	dimensions, keyGeneration := p.currentDimensions()
+	assert.Equal(t, []string{"other-attribute"}, dimensions)
+	assert.Equal(t, 1, keyGeneration)
+	assert.Equal(t, []float64{0.5}, p.reqDurationBounds)
+
+	// the request counts are kept, the latency histograms are reset with the new buckets
+	assert.Len(t, p.reqTotal, 1)
+	assert.Empty(t, p.reqServerDurationSecondsCount)
+	assert.Empty(t, p.reqClientDurationSecondsBucketCounts)
+	assert.True(t, p.durationStartTime.After(p.startTime))
+
+	require.NoError(t, p.ConsumeTraces(context.Background(), buildSampleTrace(t, "first")))
+	assert.Len(t, p.reqTotal, 2)
+	for _, counts := range p.reqServerDurationSecondsBucketCounts {
+		assert.Equal(t, []uint64{0, 1}, counts)
+	}
+
+	// settings not set in the file keep the values of the config
+	p.applyRuntimeSettings(runtimeSettings{})
+	dimensions, keyGeneration = p.currentDimensions()
+	assert.Equal(t, []string{"some-attribute"}, dimensions)
+	assert.Equal(t, 2, keyGeneration)
+	assert.Equal(t, []float64{1, 2}, p.reqDurationBounds)
+}
+
+func TestRuntimeConfigFileReload(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "runtime.yaml")
+	require.NoError(t, os.WriteFile(file, []byte("dimensions: [other-attribute]\n"), 0600))
+	p := newRuntimeConfigConnector(t, file)
+	require.NoError(t, p.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() { require.NoError(t, p.Shutdown(context.Background())) }()
+	dimensions, _ := p.currentDimensions()
+	assert.Equal(t, []string{"other-attribute"}, dimensions)
+
+	require.NoError(t, os.WriteFile(file, []byte("dimensions: [other-attribute, some-attribute]\nlatency_histogram_buckets: [100ms]\n"), 0600))
+	assert.Eventually(t, func() bool {
+		p.seriesMutex.Lock()
+		defer p.seriesMutex.Unlock()
+		dimensions, _ := p.currentDimensions()
+		return len(dimensions) == 2 && len(p.reqDurationBounds) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestRuntimeConfigFileInvalid(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "runtime.yaml")
+	require.NoError(t, os.WriteFile(file, []byte("latency_histogram_buckets: [2s, 1s]\n"), 0600))
+	p := newRuntimeConfigConnector(t, file)
+	assert.EqualError(t, p.runtimeConfigWatcher.start(), "'latency_histogram_buckets' must be sorted")
+
+	require.NoError(t, os.WriteFile(file, []byte("dimension: [other-attribute]\n"), 0600))
+	assert.ErrorContains(t, p.runtimeConfigWatcher.start(), "invalid runtime config file")
+}
@@ -0,0 +1,33 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package spaneventsconnector // import "github.com/open-telemetry/opentelemetry-collector-contrib/connector/spaneventsconnector"
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+
+	"go.opentelemetry.io/collector/component"
+)
+
+// Config defines the configuration of the span events connector.
+type Config struct {
+	// Events is a list of regex patterns matched against the names of the span events.
+	// The events whose name matches any of the patterns are emitted as log records,
+	// all the events when empty.
+	Events []string `mapstructure:"events"`
+}
+
+var _ component.Config = (*Config)(nil)
+
+// Validate checks that the event name patterns compile.
+func (c *Config) Validate() error {
+	var errs []error
+	for _, pattern := range c.Events {
+		if _, err := regexp.Compile(pattern); err != nil {
+			errs = append(errs, fmt.Errorf("invalid regexp pattern %s: %w", pattern, err))
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,56 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package spaneventsconnector
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/connector/spaneventsconnector/internal/metadata"
+)
+
+func TestLoadConfig(t *testing.T) {
+	testCases := []struct {
+		id     component.ID
+		expect *Config
+	}{
+		{
+			id:     component.NewID(metadata.Type),
+			expect: &Config{},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "exceptions"),
+			expect: &Config{
+				Events: []string{"^exception$"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.id.String(), func(t *testing.T) {
+			cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+			require.NoError(t, err)
+
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+
+			sub, err := cm.Sub(tc.id.String())
+			require.NoError(t, err)
+			require.NoError(t, component.UnmarshalConfig(sub, cfg))
+
+			assert.NoError(t, component.ValidateConfig(cfg))
+			assert.Equal(t, tc.expect, cfg)
+		})
+	}
+}
+
+func TestConfigValidate(t *testing.T) {
+	cfg := &Config{Events: []string{"^exception$", "("}}
+	assert.EqualError(t, cfg.Validate(), "invalid regexp pattern (: error parsing regexp: missing closing ): `(`")
+}
@@ -0,0 +1,101 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package spaneventsconnector // import "github.com/open-telemetry/opentelemetry-collector-contrib/connector/spaneventsconnector"
+
+import (
+	"context"
+	"regexp"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
+)
+
+// eventNameKey is the attribute holding the name of the span event in its log record.
+const eventNameKey = "event.name"
+
+// spanEvents emits the events of the spans as log records onto a logs pipeline,
+// keeping the trace context, the resource and the scope of their span.
+type spanEvents struct {
+	logsConsumer consumer.Logs
+	component.StartFunc
+	component.ShutdownFunc
+
+	logger *zap.Logger
+	events []*regexp.Regexp
+}
+
+func (c *spanEvents) Capabilities() consumer.Capabilities {
+	return consumer.Capabilities{MutatesData: false}
+}
+
+func (c *spanEvents) ConsumeTraces(ctx context.Context, td ptrace.Traces) error {
+	ld := plog.NewLogs()
+	for i := 0; i < td.ResourceSpans().Len(); i++ {
+		rs := td.ResourceSpans().At(i)
+		var rl plog.ResourceLogs
+		hasResource := false
+		for j := 0; j < rs.ScopeSpans().Len(); j++ {
+			ss := rs.ScopeSpans().At(j)
+			var sl plog.ScopeLogs
+			hasScope := false
+			for k := 0; k < ss.Spans().Len(); k++ {
+				span := ss.Spans().At(k)
+				for l := 0; l < span.Events().Len(); l++ {
+					event := span.Events().At(l)
+					if !c.matches(event.Name()) {
+						continue
+					}
+					if !hasResource {
+						rl = ld.ResourceLogs().AppendEmpty()
+						rs.Resource().CopyTo(rl.Resource())
+						rl.SetSchemaUrl(rs.SchemaUrl())
+						hasResource = true
+					}
+					if !hasScope {
+						sl = rl.ScopeLogs().AppendEmpty()
+						ss.Scope().CopyTo(sl.Scope())
+						sl.SetSchemaUrl(ss.SchemaUrl())
+						hasScope = true
+					}
+					eventToLogRecord(span, event, sl.LogRecords().AppendEmpty())
+				}
+			}
+		}
+	}
+	if ld.LogRecordCount() == 0 {
+		return nil
+	}
+	if err := c.logsConsumer.ConsumeLogs(ctx, ld); err != nil {
+		c.logger.Error("failed to emit the span events as logs", zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// matches reports whether the event with the name is emitted as a log record.
+func (c *spanEvents) matches(name string) bool {
+	if len(c.events) == 0 {
+		return true
+	}
+	for _, re := range c.events {
+		if re.MatchString(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// eventToLogRecord fills the log record with the event and the trace context of its span.
+func eventToLogRecord(span ptrace.Span, event ptrace.SpanEvent, lr plog.LogRecord) {
+	lr.SetTimestamp(event.Timestamp())
+	lr.SetTraceID(span.TraceID())
+	lr.SetSpanID(span.SpanID())
+	// the lower 8 bits of both flags are the W3C trace flags
+	lr.SetFlags(plog.LogRecordFlags(span.Flags() & 0xff))
+	event.Attributes().CopyTo(lr.Attributes())
+	lr.Attributes().PutStr(eventNameKey, event.Name())
+}
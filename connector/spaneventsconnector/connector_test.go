@@ -0,0 +1,142 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package spaneventsconnector
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/connector"
+	"go.opentelemetry.io/collector/connector/connectortest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+var (
+	testTime    = time.Date(2024, 5, 10, 12, 0, 0, 0, time.UTC)
+	testTraceID = pcommon.TraceID([16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16})
+	testSpanID  = pcommon.SpanID([8]byte{1, 2, 3, 4, 5, 6, 7, 8})
+)
+
+// testTraces returns a resource, with the attribute service.name: checkout, holding a span
+// with an exception event and an event of each of the given names, one second apart.
+func testTraces(eventNames ...string) ptrace.Traces {
+	td := ptrace.NewTraces()
+	rs := td.ResourceSpans().AppendEmpty()
+	rs.Resource().Attributes().PutStr("service.name", "checkout")
+	ss := rs.ScopeSpans().AppendEmpty()
+	ss.Scope().SetName("checkout-instrumentation")
+	ss.Scope().SetVersion("1.0.0")
+	span := ss.Spans().AppendEmpty()
+	span.SetName("POST /checkout")
+	span.SetTraceID(testTraceID)
+	span.SetSpanID(testSpanID)
+	span.SetFlags(1)
+	span.Attributes().PutStr("http.request.method", "POST")
+
+	exception := span.Events().AppendEmpty()
+	exception.SetName("exception")
+	exception.SetTimestamp(pcommon.NewTimestampFromTime(testTime))
+	exception.Attributes().PutStr("exception.type", "java.lang.NullPointerException")
+	exception.Attributes().PutStr("exception.message", "cart is null")
+	for i, name := range eventNames {
+		event := span.Events().AppendEmpty()
+		event.SetName(name)
+		event.SetTimestamp(pcommon.NewTimestampFromTime(testTime.Add(time.Duration(i+1) * time.Second)))
+	}
+	return td
+}
+
+func newTestConnector(t *testing.T, cfg *Config) (connector.Traces, *consumertest.LogsSink) {
+	require.NoError(t, cfg.Validate())
+	sink := &consumertest.LogsSink{}
+	conn, err := NewFactory().CreateTracesToLogs(context.Background(), connectortest.NewNopCreateSettings(), cfg, sink)
+	require.NoError(t, err)
+	require.NotNil(t, conn)
+	assert.False(t, conn.Capabilities().MutatesData)
+	require.NoError(t, conn.Start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() {
+		assert.NoError(t, conn.Shutdown(context.Background()))
+	})
+	return conn, sink
+}
+
+func TestConsumeTraces(t *testing.T) {
+	conn, sink := newTestConnector(t, &Config{})
+	td := testTraces("cache.miss")
+	require.NoError(t, conn.ConsumeTraces(context.Background(), td))
+
+	require.Len(t, sink.AllLogs(), 1)
+	ld := sink.AllLogs()[0]
+	require.Equal(t, 1, ld.ResourceLogs().Len())
+	rl := ld.ResourceLogs().At(0)
+	assert.Equal(t, map[string]any{"service.name": "checkout"}, rl.Resource().Attributes().AsRaw())
+	require.Equal(t, 1, rl.ScopeLogs().Len())
+	sl := rl.ScopeLogs().At(0)
+	assert.Equal(t, "checkout-instrumentation", sl.Scope().Name())
+	assert.Equal(t, "1.0.0", sl.Scope().Version())
+
+	require.Equal(t, 2, sl.LogRecords().Len())
+	exception := sl.LogRecords().At(0)
+	assert.Equal(t, pcommon.NewTimestampFromTime(testTime), exception.Timestamp())
+	assert.Equal(t, testTraceID, exception.TraceID())
+	assert.Equal(t, testSpanID, exception.SpanID())
+	assert.True(t, exception.Flags().IsSampled())
+	assert.Equal(t, map[string]any{
+		"event.name":        "exception",
+		"exception.type":    "java.lang.NullPointerException",
+		"exception.message": "cart is null",
+	}, exception.Attributes().AsRaw())
+	cacheMiss := sl.LogRecords().At(1)
+	assert.Equal(t, pcommon.NewTimestampFromTime(testTime.Add(time.Second)), cacheMiss.Timestamp())
+	assert.Equal(t, testSpanID, cacheMiss.SpanID())
+	assert.Equal(t, map[string]any{"event.name": "cache.miss"}, cacheMiss.Attributes().AsRaw())
+
+	// the spans are left untouched
+	assert.Equal(t, 2, td.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).Events().Len())
+}
+
+func TestConsumeTracesMatchingEvents(t *testing.T) {
+	conn, sink := newTestConnector(t, &Config{Events: []string{"^exception$"}})
+	require.NoError(t, conn.ConsumeTraces(context.Background(), testTraces("cache.miss", "retry")))
+
+	require.Len(t, sink.AllLogs(), 1)
+	records := sink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+	require.Equal(t, 1, records.Len())
+	name, ok := records.At(0).Attributes().Get("event.name")
+	require.True(t, ok)
+	assert.Equal(t, "exception", name.Str())
+}
+
+func TestConsumeTracesWithoutMatchingEvents(t *testing.T) {
+	conn, sink := newTestConnector(t, &Config{Events: []string{"^retry$"}})
+	require.NoError(t, conn.ConsumeTraces(context.Background(), testTraces("cache.miss")))
+	assert.Empty(t, sink.AllLogs())
+
+	// the resources and scopes without matching events are not emitted
+	td := testTraces("retry")
+	td.ResourceSpans().At(0).ScopeSpans().At(0).CopyTo(td.ResourceSpans().At(0).ScopeSpans().AppendEmpty())
+	td.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).Events().RemoveIf(func(event ptrace.SpanEvent) bool {
+		return event.Name() == "retry"
+	})
+	td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty()
+	require.NoError(t, conn.ConsumeTraces(context.Background(), td))
+	require.Len(t, sink.AllLogs(), 1)
+	ld := sink.AllLogs()[0]
+	assert.Equal(t, 1, ld.ResourceLogs().Len())
+	assert.Equal(t, 1, ld.ResourceLogs().At(0).ScopeLogs().Len())
+	assert.Equal(t, 1, ld.LogRecordCount())
+}
+
+func TestConsumeTracesError(t *testing.T) {
+	cfg := &Config{}
+	conn, err := NewFactory().CreateTracesToLogs(context.Background(), connectortest.NewNopCreateSettings(), cfg, consumertest.NewErr(assert.AnError))
+	require.NoError(t, err)
+	assert.ErrorIs(t, conn.ConsumeTraces(context.Background(), testTraces()), assert.AnError)
+}
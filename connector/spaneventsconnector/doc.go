@@ -0,0 +1,5 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package spaneventsconnector emits the events of the spans as log records, with the trace context of their span.
+package spaneventsconnector // import "github.com/open-telemetry/opentelemetry-collector-contrib/connector/spaneventsconnector"
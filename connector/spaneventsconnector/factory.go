@@ -0,0 +1,56 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:generate mdatagen metadata.yaml
+
+package spaneventsconnector // import "github.com/open-telemetry/opentelemetry-collector-contrib/connector/spaneventsconnector"
+
+import (
+	"context"
+	"regexp"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/connector"
+	"go.opentelemetry.io/collector/consumer"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/connector/spaneventsconnector/internal/metadata"
+)
+
+// NewFactory returns a ConnectorFactory.
+func NewFactory() connector.Factory {
+	return connector.NewFactory(
+		metadata.Type,
+		createDefaultConfig,
+		connector.WithTracesToLogs(createTracesToLogs, metadata.TracesToLogsStability),
+	)
+}
+
+// createDefaultConfig creates the default configuration, emitting all the span events.
+func createDefaultConfig() component.Config {
+	return &Config{}
+}
+
+// createTracesToLogs creates a traces to logs connector based on provided config.
+func createTracesToLogs(
+	_ context.Context,
+	set connector.CreateSettings,
+	cfg component.Config,
+	nextConsumer consumer.Logs,
+) (connector.Traces, error) {
+	c := cfg.(*Config)
+
+	events := make([]*regexp.Regexp, 0, len(c.Events))
+	for _, pattern := range c.Events {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, re)
+	}
+
+	return &spanEvents{
+		logsConsumer: nextConsumer,
+		logger:       set.Logger,
+		events:       events,
+	}, nil
+}
@@ -79,6 +79,9 @@ type Config struct {
 
 	// Events defines the configuration for events section of spans.
 	Events EventsConfig `mapstructure:"events"`
+
+	// RuntimeConfig defines the file updating the dimensions and the histogram buckets while the connector is running.
+	RuntimeConfig RuntimeConfig `mapstructure:"runtime_config"`
 }
 
 type HistogramConfig struct {
@@ -139,6 +142,10 @@ func (c Config) Validate() error {
 		return fmt.Errorf("invalid metrics_expiration: %v, the duration should be positive", c.MetricsExpiration)
 	}
 
+	if c.RuntimeConfig.ReloadInterval < 0 {
+		return fmt.Errorf("invalid runtime_config::reload_interval: %v, the duration should be positive", c.RuntimeConfig.ReloadInterval)
+	}
+
 	return nil
 }
 
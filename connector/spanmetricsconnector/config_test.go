@@ -125,6 +125,24 @@ func TestLoadConfig(t *testing.T) {
 				Histogram:                    HistogramConfig{Disable: false, Unit: defaultUnit},
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "runtime_config"),
+			expected: &Config{
+				AggregationTemporality:   "AGGREGATION_TEMPORALITY_CUMULATIVE",
+				DimensionsCacheSize:      defaultDimensionsCacheSize,
+				ResourceMetricsCacheSize: defaultResourceMetricsCacheSize,
+				MetricsFlushInterval:     60 * time.Second,
+				Histogram:                HistogramConfig{Disable: false, Unit: defaultUnit},
+				RuntimeConfig: RuntimeConfig{
+					File:           "/etc/otelcol/spanmetrics.yaml",
+					ReloadInterval: time.Minute,
+				},
+			},
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "invalid_runtime_config_reload_interval"),
+			errorMessage: "invalid runtime_config::reload_interval: -1m0s, the duration should be positive",
+		},
 	}
 
 	for _, tt := range tests {
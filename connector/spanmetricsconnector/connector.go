@@ -6,6 +6,7 @@ package spanmetricsconnector // import "github.com/open-telemetry/opentelemetry-
 import (
 	"bytes"
 	"context"
+	"strconv"
 	"sync"
 	"time"
 
@@ -46,6 +47,8 @@ type connectorImp struct {
 	lock   sync.Mutex
 	logger *zap.Logger
 	config Config
+	// staticConfig is the config the runtime settings are applied to.
+	staticConfig Config
 
 	metricsConsumer consumer.Metrics
 
@@ -72,6 +75,11 @@ type connectorImp struct {
 	eDimensions []dimension
 
 	events EventsConfig
+
+	runtimeConfigWatcher *runtimeConfigWatcher
+	// keyGeneration is incremented when the dimensions are updated at runtime, so that the keys
+	// of the new series don't collide with the ones of the series already accumulated.
+	keyGeneration int
 }
 
 type resourceMetrics struct {
@@ -81,6 +89,9 @@ type resourceMetrics struct {
 	attributes pcommon.Map
 	// startTimestamp captures when the first data points for this resource are recorded.
 	startTimestamp pcommon.Timestamp
+	// histogramsStartTimestamp is the start timestamp of the histograms, reset with them when
+	// the histogram buckets are updated at runtime.
+	histogramsStartTimestamp pcommon.Timestamp
 	// lastSeen captures when the last data points for this resource were recorded.
 	lastSeen time.Time
 }
@@ -125,9 +136,10 @@ func newConnector(logger *zap.Logger, config component.Config, ticker *clock.Tic
 		resourceMetricsKeyAttributes[attr] = s
 	}
 
-	return &connectorImp{
+	p := &connectorImp{
 		logger:                       logger,
 		config:                       *cfg,
+		staticConfig:                 *cfg,
 		resourceMetrics:              resourceMetricsCache,
 		resourceMetricsKeyAttributes: resourceMetricsKeyAttributes,
 		dimensions:                   newDimensions(cfg.Dimensions),
@@ -137,7 +149,15 @@ func newConnector(logger *zap.Logger, config component.Config, ticker *clock.Tic
 		done:                         make(chan struct{}),
 		eDimensions:                  newDimensions(cfg.Events.Dimensions),
 		events:                       cfg.Events,
-	}, nil
+	}
+	if cfg.RuntimeConfig.File != "" {
+		p.runtimeConfigWatcher = &runtimeConfigWatcher{
+			cfg:    cfg.RuntimeConfig,
+			logger: logger,
+			apply:  p.applyRuntimeSettings,
+		}
+	}
+	return p, nil
 }
 
 func initHistogramMetrics(cfg Config) metrics.HistogramMetrics {
@@ -190,6 +210,12 @@ func durationsToUnits(vs []time.Duration, unitDivider int64) []float64 {
 func (p *connectorImp) Start(ctx context.Context, _ component.Host) error {
 	p.logger.Info("Starting spanmetrics connector")
 
+	if p.runtimeConfigWatcher != nil {
+		if err := p.runtimeConfigWatcher.start(); err != nil {
+			return err
+		}
+	}
+
 	p.started = true
 	go func() {
 		for {
@@ -215,6 +241,9 @@ func (p *connectorImp) Shutdown(context.Context) error {
 			p.done <- struct{}{}
 			p.started = false
 		}
+		if p.runtimeConfigWatcher != nil {
+			p.runtimeConfigWatcher.stop()
+		}
 	})
 	return nil
 }
@@ -268,7 +297,7 @@ func (p *connectorImp) buildMetrics() pmetric.Metrics {
 			metric = sm.Metrics().AppendEmpty()
 			metric.SetName(buildMetricName(p.config.Namespace, metricNameDuration))
 			metric.SetUnit(p.config.Histogram.Unit.String())
-			histograms.BuildMetrics(metric, rawMetrics.startTimestamp, p.config.GetAggregationTemporality())
+			histograms.BuildMetrics(metric, rawMetrics.histogramsStartTimestamp, p.config.GetAggregationTemporality())
 		}
 
 		events := rawMetrics.events
@@ -435,12 +464,14 @@ func (p *connectorImp) getOrCreateResourceMetrics(attr pcommon.Map) *resourceMet
 	key := p.createResourceKey(attr)
 	v, ok := p.resourceMetrics.Get(key)
 	if !ok {
+		startTimestamp := pcommon.NewTimestampFromTime(time.Now())
 		v = &resourceMetrics{
-			histograms:     initHistogramMetrics(p.config),
-			sums:           metrics.NewSumMetrics(p.config.Exemplars.MaxPerDataPoint),
-			events:         metrics.NewSumMetrics(p.config.Exemplars.MaxPerDataPoint),
-			attributes:     attr,
-			startTimestamp: pcommon.NewTimestampFromTime(time.Now()),
+			histograms:               initHistogramMetrics(p.config),
+			sums:                     metrics.NewSumMetrics(p.config.Exemplars.MaxPerDataPoint),
+			events:                   metrics.NewSumMetrics(p.config.Exemplars.MaxPerDataPoint),
+			attributes:               attr,
+			startTimestamp:           startTimestamp,
+			histogramsStartTimestamp: startTimestamp,
 		}
 		p.resourceMetrics.Add(key, v)
 	}
@@ -500,6 +531,10 @@ func concatDimensionValue(dest *bytes.Buffer, value string, prefixSep bool) {
 // The metric key is a simple concatenation of dimension values, delimited by a null character.
 func (p *connectorImp) buildKey(serviceName string, span ptrace.Span, optionalDims []dimension, resourceOrEventAttrs pcommon.Map) metrics.Key {
 	p.keyBuf.Reset()
+	if p.keyGeneration > 0 {
+		p.keyBuf.WriteString(strconv.Itoa(p.keyGeneration))
+		p.keyBuf.WriteString(metricKeySeparator)
+	}
 	if !contains(p.config.ExcludeDimensions, serviceNameKey) {
 		concatDimensionValue(p.keyBuf, serviceName, false)
 	}
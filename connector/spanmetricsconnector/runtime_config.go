@@ -0,0 +1,196 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package spanmetricsconnector // import "github.com/open-telemetry/opentelemetry-collector-contrib/connector/spanmetricsconnector"
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/confmap"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.uber.org/zap"
+	"gopkg.in/yaml.v3"
+)
+
+const defaultRuntimeConfigReloadInterval = 30 * time.Second
+
+// RuntimeConfig defines the file holding the settings updated while the connector is running.
+type RuntimeConfig struct {
+	// File is a YAML file holding `dimensions`, `exclude_dimensions` and `histogram_buckets`
+	// overriding the ones of the config, reloaded when it changes.
+	File string `mapstructure:"file"`
+	// ReloadInterval is how often the file is checked for changes.
+	// Optional. See defaultRuntimeConfigReloadInterval for the default value.
+	ReloadInterval time.Duration `mapstructure:"reload_interval"`
+}
+
+// runtimeSettings are the settings of the runtime config file, the settings which aren't set
+// keeping the values of the config.
+type runtimeSettings struct {
+	Dimensions        []Dimension     `mapstructure:"dimensions"`
+	ExcludeDimensions []string        `mapstructure:"exclude_dimensions"`
+	HistogramBuckets  []time.Duration `mapstructure:"histogram_buckets"`
+}
+
+func loadRuntimeSettings(path string) (runtimeSettings, error) {
+	var settings runtimeSettings
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return settings, err
+	}
+	var raw map[string]any
+	if err = yaml.Unmarshal(content, &raw); err != nil {
+		return settings, fmt.Errorf("invalid runtime config file %q: %w", path, err)
+	}
+	if err = confmap.NewFromStringMap(raw).Unmarshal(&settings); err != nil {
+		return settings, fmt.Errorf("invalid runtime config file %q: %w", path, err)
+	}
+	return settings, nil
+}
+
+// override returns the settings of the config overridden by the runtime settings.
+func (s runtimeSettings) override(cfg Config) (runtimeSettings, error) {
+	effective := runtimeSettings{
+		Dimensions:        cfg.Dimensions,
+		ExcludeDimensions: cfg.ExcludeDimensions,
+	}
+	if cfg.Histogram.Explicit != nil {
+		effective.HistogramBuckets = cfg.Histogram.Explicit.Buckets
+	}
+	if s.Dimensions != nil {
+		if err := validateDimensions(s.Dimensions); err != nil {
+			return effective, fmt.Errorf("failed validating dimensions: %w", err)
+		}
+		effective.Dimensions = s.Dimensions
+	}
+	if s.ExcludeDimensions != nil {
+		effective.ExcludeDimensions = s.ExcludeDimensions
+	}
+	if s.HistogramBuckets != nil {
+		if cfg.Histogram.Disable || cfg.Histogram.Exponential != nil {
+			return effective, errors.New("'histogram_buckets' requires the explicit buckets histogram")
+		}
+		if !sort.SliceIsSorted(s.HistogramBuckets, func(i, j int) bool { return s.HistogramBuckets[i] < s.HistogramBuckets[j] }) {
+			return effective, errors.New("'histogram_buckets' must be sorted")
+		}
+		effective.HistogramBuckets = s.HistogramBuckets
+	}
+	return effective, nil
+}
+
+// runtimeConfigWatcher applies the runtime config file when it changes, keeping the current
+// settings when the new ones are invalid.
+type runtimeConfigWatcher struct {
+	cfg     RuntimeConfig
+	logger  *zap.Logger
+	apply   func(runtimeSettings) error
+	modTime time.Time
+	size    int64
+	stopCh  chan struct{}
+	wg      sync.WaitGroup
+}
+
+func (w *runtimeConfigWatcher) start() error {
+	if err := w.load(); err != nil {
+		return err
+	}
+	interval := w.cfg.ReloadInterval
+	if interval <= 0 {
+		interval = defaultRuntimeConfigReloadInterval
+	}
+	w.stopCh = make(chan struct{})
+	w.wg.Add(1)
+	go w.watch(interval)
+	return nil
+}
+
+func (w *runtimeConfigWatcher) stop() {
+	if w.stopCh != nil {
+		close(w.stopCh)
+		w.wg.Wait()
+		w.stopCh = nil
+	}
+}
+
+func (w *runtimeConfigWatcher) load() error {
+	info, err := os.Stat(w.cfg.File)
+	if err != nil {
+		return err
+	}
+	// the same content is not applied nor reported again
+	w.modTime, w.size = info.ModTime(), info.Size()
+	settings, err := loadRuntimeSettings(w.cfg.File)
+	if err != nil {
+		return err
+	}
+	return w.apply(settings)
+}
+
+func (w *runtimeConfigWatcher) watch(interval time.Duration) {
+	defer w.wg.Done()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-w.stopCh:
+			return
+		case <-ticker.C:
+			info, err := os.Stat(w.cfg.File)
+			if err != nil {
+				w.logger.Warn("Failed to check the runtime config file, keeping the current settings", zap.Error(err))
+				continue
+			}
+			if info.ModTime().Equal(w.modTime) && info.Size() == w.size {
+				continue
+			}
+			if err = w.load(); err != nil {
+				w.logger.Error("Failed to reload the runtime config file, keeping the current settings", zap.Error(err))
+				continue
+			}
+			w.logger.Info("Reloaded the runtime config file", zap.String("path", w.cfg.File))
+		}
+	}
+}
+
+// applyRuntimeSettings updates the dimensions and the histogram buckets. The series already
+// accumulated are kept, the spans being aggregated in new series when the dimensions change.
+// The explicit histograms are reset with a new start timestamp when the buckets change, as
+// their counts cannot be carried over to other buckets.
+func (p *connectorImp) applyRuntimeSettings(settings runtimeSettings) error {
+	effective, err := settings.override(p.staticConfig)
+	if err != nil {
+		return err
+	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if !reflect.DeepEqual(effective.Dimensions, p.config.Dimensions) || !reflect.DeepEqual(effective.ExcludeDimensions, p.config.ExcludeDimensions) {
+		p.config.Dimensions = effective.Dimensions
+		p.config.ExcludeDimensions = effective.ExcludeDimensions
+		p.dimensions = newDimensions(effective.Dimensions)
+		p.metricKeyToDimensions.Purge()
+		// keys of the new dimensions may collide with the ones of the series already accumulated
+		p.keyGeneration++
+	}
+
+	if p.config.Histogram.Explicit == nil && effective.HistogramBuckets == nil {
+		return nil
+	}
+	if p.config.Histogram.Explicit != nil && reflect.DeepEqual(effective.HistogramBuckets, p.config.Histogram.Explicit.Buckets) {
+		return nil
+	}
+	p.config.Histogram.Explicit = &ExplicitHistogramConfig{Buckets: effective.HistogramBuckets}
+	now := pcommon.NewTimestampFromTime(time.Now())
+	p.resourceMetrics.ForEach(func(_ resourceKey, rm *resourceMetrics) {
+		rm.histograms = initHistogramMetrics(p.config)
+		rm.histogramsStartTimestamp = now
+	})
+	return nil
+}
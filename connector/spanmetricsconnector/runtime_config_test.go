@@ -0,0 +1,135 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package spanmetricsconnector
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
+)
+
+func buildRuntimeConfigTrace() ptrace.Traces {
+	traces := ptrace.NewTraces()
+	rs := traces.ResourceSpans().AppendEmpty()
+	rs.Resource().Attributes().PutStr(serviceNameKey, "service-a")
+	span := rs.ScopeSpans().AppendEmpty().Spans().AppendEmpty()
+	span.SetName("GET /orders")
+	span.SetKind(ptrace.SpanKindServer)
+	now := time.Now()
+	span.SetStartTimestamp(pcommon.NewTimestampFromTime(now))
+	span.SetEndTimestamp(pcommon.NewTimestampFromTime(now.Add(5 * time.Millisecond)))
+	span.Attributes().PutStr("http.method", "GET")
+	span.Attributes().PutStr("http.route", "/orders")
+	return traces
+}
+
+func newRuntimeConfigConnector(t *testing.T, file string) *connectorImp {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Dimensions = []Dimension{{Name: "http.method"}}
+	cfg.Histogram.Explicit = &ExplicitHistogramConfig{Buckets: []time.Duration{10 * time.Millisecond, 100 * time.Millisecond}}
+	cfg.RuntimeConfig = RuntimeConfig{File: file, ReloadInterval: 10 * time.Millisecond}
+	p, err := newConnector(zap.NewNop(), cfg, nil)
+	require.NoError(t, err)
+	return p
+}
+
+func findMetric(t *testing.T, md pmetric.Metrics, name string) pmetric.Metric {
+	ms := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	for i := 0; i < ms.Len(); i++ {
+		if ms.At(i).Name() == name {
+			return ms.At(i)
+		}
+	}
+	require.Failf(t, "metric not found", "metric %q not found", name)
+	return pmetric.Metric{}
+}
+
+func TestApplyRuntimeSettingsDimensions(t *testing.T) {
+	p := newRuntimeConfigConnector(t, "")
+	p.aggregateMetrics(buildRuntimeConfigTrace())
+
+	require.NoError(t, p.applyRuntimeSettings(runtimeSettings{
+		Dimensions:        []Dimension{{Name: "http.route"}},
+		ExcludeDimensions: []string{spanKindKey},
+	}))
+	p.aggregateMetrics(buildRuntimeConfigTrace())
+
+	// the series of the previous dimensions is kept next to the one of the new dimensions
+	dps := findMetric(t, p.buildMetrics(), metricNameCalls).Sum().DataPoints()
+	require.Equal(t, 2, dps.Len())
+	var attrs []map[string]any
+	for i := 0; i < dps.Len(); i++ {
+		assert.EqualValues(t, 1, dps.At(i).IntValue())
+		attrs = append(attrs, dps.At(i).Attributes().AsRaw())
+	}
+	assert.ElementsMatch(t, []map[string]any{
+		{serviceNameKey: "service-a", spanNameKey: "GET /orders", spanKindKey: "SPAN_KIND_SERVER", statusCodeKey: "STATUS_CODE_UNSET", "http.method": "GET"},
+		{serviceNameKey: "service-a", spanNameKey: "GET /orders", statusCodeKey: "STATUS_CODE_UNSET", "http.route": "/orders"},
+	}, attrs)
+
+	// settings not set in the file keep the values of the config
+	require.NoError(t, p.applyRuntimeSettings(runtimeSettings{}))
+	assert.Equal(t, []Dimension{{Name: "http.method"}}, p.config.Dimensions)
+	assert.Nil(t, p.config.ExcludeDimensions)
+	assert.Equal(t, 2, p.keyGeneration)
+}
+
+func TestApplyRuntimeSettingsHistogramBuckets(t *testing.T) {
+	p := newRuntimeConfigConnector(t, "")
+	p.aggregateMetrics(buildRuntimeConfigTrace())
+
+	require.NoError(t, p.applyRuntimeSettings(runtimeSettings{HistogramBuckets: []time.Duration{time.Millisecond, 50 * time.Millisecond}}))
+	assert.Equal(t, 0, p.keyGeneration)
+	md := p.buildMetrics()
+	assert.EqualValues(t, 1, findMetric(t, md, metricNameCalls).Sum().DataPoints().At(0).IntValue())
+	assert.Equal(t, 0, findMetric(t, md, metricNameDuration).Histogram().DataPoints().Len())
+
+	p.aggregateMetrics(buildRuntimeConfigTrace())
+	dp := findMetric(t, p.buildMetrics(), metricNameDuration).Histogram().DataPoints().At(0)
+	assert.Equal(t, []float64{1, 50}, dp.ExplicitBounds().AsRaw())
+	assert.Equal(t, []uint64{0, 1, 0}, dp.BucketCounts().AsRaw())
+}
+
+func TestApplyRuntimeSettingsInvalid(t *testing.T) {
+	p := newRuntimeConfigConnector(t, "")
+	assert.ErrorContains(t, p.applyRuntimeSettings(runtimeSettings{Dimensions: []Dimension{{Name: serviceNameKey}}}), "duplicate dimension name service.name")
+	assert.EqualError(t, p.applyRuntimeSettings(runtimeSettings{HistogramBuckets: []time.Duration{time.Second, time.Millisecond}}), "'histogram_buckets' must be sorted")
+
+	p.staticConfig.Histogram = HistogramConfig{Unit: defaultUnit, Exponential: &ExponentialHistogramConfig{MaxSize: 10}}
+	assert.EqualError(t, p.applyRuntimeSettings(runtimeSettings{HistogramBuckets: []time.Duration{time.Second}}), "'histogram_buckets' requires the explicit buckets histogram")
+	assert.Equal(t, []Dimension{{Name: "http.method"}}, p.config.Dimensions)
+}
+
+func TestRuntimeConfigFileReload(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "runtime.yaml")
+	require.NoError(t, os.WriteFile(file, []byte("dimensions:\n  - name: http.route\n"), 0600))
+	p := newRuntimeConfigConnector(t, file)
+	require.NoError(t, p.runtimeConfigWatcher.start())
+	defer p.runtimeConfigWatcher.stop()
+	assert.Equal(t, []Dimension{{Name: "http.route"}}, p.config.Dimensions)
+
+	require.NoError(t, os.WriteFile(file, []byte("dimensions:\n  - name: http.route\n  - name: http.method\nhistogram_buckets: [5ms, 1s]\n"), 0600))
+	assert.Eventually(t, func() bool {
+		p.lock.Lock()
+		defer p.lock.Unlock()
+		return len(p.config.Dimensions) == 2 && len(p.config.Histogram.Explicit.Buckets) == 2
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestRuntimeConfigFileInvalid(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "runtime.yaml")
+	require.NoError(t, os.WriteFile(file, []byte("dimension:\n  - name: http.route\n"), 0600))
+	p := newRuntimeConfigConnector(t, file)
+	assert.ErrorContains(t, p.Start(context.Background(), componenttest.NewNopHost()), "invalid runtime config file")
+}
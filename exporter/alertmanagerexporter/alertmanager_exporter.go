@@ -10,6 +10,8 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
+	"text/template"
 	"time"
 
 	"github.com/prometheus/common/model"
@@ -18,10 +20,19 @@ import (
 	"go.opentelemetry.io/collector/exporter"
 	"go.opentelemetry.io/collector/exporter/exporterhelper"
 	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/expr"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottllog"
 )
 
+// eventNameAttribute is the attribute of the log records holding the name of their event.
+const eventNameAttribute = "event.name"
+
 type alertmanagerExporter struct {
 	config            *Config
 	client            *http.Client
@@ -31,16 +42,71 @@ type alertmanagerExporter struct {
 	generatorURL      string
 	defaultSeverity   string
 	severityAttribute string
+	resolveTimeout    time.Duration
+
+	// logConditions, labels and annotations are parsed when the exporter is started.
+	logConditions expr.BoolExpr[ottllog.TransformContext]
+	labels        map[string]*template.Template
+	annotations   map[string]*template.Template
 }
 
 type alertmanagerEvent struct {
 	spanEvent ptrace.SpanEvent
+	// logRecord is set instead of spanEvent for the events of log records.
+	logRecord *plog.LogRecord
+	resource  pcommon.Map
 	traceID   string
 	spanID    string
 	severity  string
 }
 
-func (s *alertmanagerExporter) convertEventSliceToArray(eventSlice ptrace.SpanEventSlice, traceID pcommon.TraceID, spanID pcommon.SpanID) []*alertmanagerEvent {
+func (e *alertmanagerEvent) name() string {
+	if e.logRecord == nil {
+		return e.spanEvent.Name()
+	}
+	if name, ok := e.logRecord.Attributes().Get(eventNameAttribute); ok {
+		return name.AsString()
+	}
+	return ""
+}
+
+func (e *alertmanagerEvent) attributes() pcommon.Map {
+	if e.logRecord == nil {
+		return e.spanEvent.Attributes()
+	}
+	return e.logRecord.Attributes()
+}
+
+// alertTemplateData is the data the templates of the labels and annotations are executed with.
+type alertTemplateData struct {
+	Name               string
+	Severity           string
+	Body               string
+	TraceID            string
+	SpanID             string
+	Attributes         map[string]any
+	ResourceAttributes map[string]any
+}
+
+func (e *alertmanagerEvent) templateData() alertTemplateData {
+	data := alertTemplateData{
+		Name:       e.name(),
+		Severity:   e.severity,
+		TraceID:    e.traceID,
+		SpanID:     e.spanID,
+		Attributes: e.attributes().AsRaw(),
+	}
+	if e.logRecord != nil {
+		data.Body = e.logRecord.Body().AsString()
+	}
+	// the events built without a resource have no resource attributes
+	if e.resource != (pcommon.Map{}) {
+		data.ResourceAttributes = e.resource.AsRaw()
+	}
+	return data
+}
+
+func (s *alertmanagerExporter) convertEventSliceToArray(eventSlice ptrace.SpanEventSlice, resource pcommon.Resource, traceID pcommon.TraceID, spanID pcommon.SpanID) []*alertmanagerEvent {
 	if eventSlice.Len() > 0 {
 		events := make([]*alertmanagerEvent, eventSlice.Len())
 
@@ -54,6 +120,7 @@ func (s *alertmanagerExporter) convertEventSliceToArray(eventSlice ptrace.SpanEv
 			}
 			event := alertmanagerEvent{
 				spanEvent: eventSlice.At(i),
+				resource:  resource.Attributes(),
 				traceID:   traceID.String(),
 				spanID:    spanID.String(),
 				severity:  severity,
@@ -88,37 +155,136 @@ func (s *alertmanagerExporter) extractEvents(td ptrace.Traces) []*alertmanagerEv
 			for k := 0; k < spans.Len(); k++ {
 				traceID := spans.At(k).TraceID()
 				spanID := spans.At(k).SpanID()
-				events = append(events, s.convertEventSliceToArray(spans.At(k).Events(), traceID, spanID)...)
+				events = append(events, s.convertEventSliceToArray(spans.At(k).Events(), resource, traceID, spanID)...)
 			}
 		}
 	}
 	return events
 }
 
+func (s *alertmanagerExporter) extractLogEvents(ctx context.Context, ld plog.Logs) []*alertmanagerEvent {
+	var events []*alertmanagerEvent
+	rls := ld.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		resource := rls.At(i).Resource()
+		slss := rls.At(i).ScopeLogs()
+		for j := 0; j < slss.Len(); j++ {
+			scope := slss.At(j).Scope()
+			logs := slss.At(j).LogRecords()
+			for k := 0; k < logs.Len(); k++ {
+				logRecord := logs.At(k)
+				if s.logConditions != nil {
+					matched, err := s.logConditions.Eval(ctx, ottllog.NewTransformContext(logRecord, scope, resource))
+					if err != nil {
+						s.settings.Logger.Warn("failed to evaluate the log conditions", zap.Error(err))
+						continue
+					}
+					if !matched {
+						continue
+					}
+				}
+				events = append(events, &alertmanagerEvent{
+					logRecord: &logRecord,
+					resource:  resource.Attributes(),
+					traceID:   traceIDString(logRecord.TraceID()),
+					spanID:    spanIDString(logRecord.SpanID()),
+					severity:  s.logSeverity(logRecord),
+				})
+			}
+		}
+	}
+	return events
+}
+
+// logSeverity returns the severity attribute of the log record, or its severity text.
+func (s *alertmanagerExporter) logSeverity(logRecord plog.LogRecord) string {
+	if severity, ok := logRecord.Attributes().Get(s.severityAttribute); ok {
+		return severity.AsString()
+	}
+	if logRecord.SeverityText() != "" {
+		return strings.ToLower(logRecord.SeverityText())
+	}
+	return s.defaultSeverity
+}
+
+func traceIDString(traceID pcommon.TraceID) string {
+	if traceID.IsEmpty() {
+		return ""
+	}
+	return traceID.String()
+}
+
+func spanIDString(spanID pcommon.SpanID) string {
+	if spanID.IsEmpty() {
+		return ""
+	}
+	return spanID.String()
+}
+
 func createAnnotations(event *alertmanagerEvent) model.LabelSet {
-	labelMap := make(model.LabelSet, event.spanEvent.Attributes().Len()+2)
-	event.spanEvent.Attributes().Range(func(key string, attr pcommon.Value) bool {
+	attributes := event.attributes()
+	labelMap := make(model.LabelSet, attributes.Len()+3)
+	attributes.Range(func(key string, attr pcommon.Value) bool {
 		labelMap[model.LabelName(key)] = model.LabelValue(attr.AsString())
 		return true
 	})
-	labelMap["TraceID"] = model.LabelValue(event.traceID)
-	labelMap["SpanID"] = model.LabelValue(event.spanID)
+	if event.logRecord == nil {
+		labelMap["TraceID"] = model.LabelValue(event.traceID)
+		labelMap["SpanID"] = model.LabelValue(event.spanID)
+		return labelMap
+	}
+
+	labelMap["Body"] = model.LabelValue(event.logRecord.Body().AsString())
+	if event.traceID != "" {
+		labelMap["TraceID"] = model.LabelValue(event.traceID)
+	}
+	if event.spanID != "" {
+		labelMap["SpanID"] = model.LabelValue(event.spanID)
+	}
 	return labelMap
 }
 
+// executeTemplates adds the labels or annotations rendered from the templates to the label set,
+// the empty values are not added.
+func (s *alertmanagerExporter) executeTemplates(labelSet model.LabelSet, templates map[string]*template.Template, data alertTemplateData) {
+	for name, tmpl := range templates {
+		var value strings.Builder
+		if err := tmpl.Execute(&value, data); err != nil {
+			s.settings.Logger.Warn("failed to execute template", zap.String("name", name), zap.Error(err))
+			continue
+		}
+		if value.Len() == 0 {
+			continue
+		}
+		labelSet[model.LabelName(name)] = model.LabelValue(value.String())
+	}
+}
+
 func (s *alertmanagerExporter) convertEventsToAlertPayload(events []*alertmanagerEvent) []model.Alert {
 
 	payload := make([]model.Alert, len(events))
 
 	for i, event := range events {
 		annotations := createAnnotations(event)
+		labels := model.LabelSet{"severity": model.LabelValue(event.severity)}
+		if name := event.name(); name != "" {
+			labels["event_name"] = model.LabelValue(name)
+		}
+		if len(s.labels) > 0 || len(s.annotations) > 0 {
+			data := event.templateData()
+			s.executeTemplates(labels, s.labels, data)
+			s.executeTemplates(annotations, s.annotations, data)
+		}
 
 		alert := model.Alert{
 			StartsAt:     time.Now(),
-			Labels:       model.LabelSet{"severity": model.LabelValue(event.severity), "event_name": model.LabelValue(event.spanEvent.Name())},
+			Labels:       labels,
 			Annotations:  annotations,
 			GeneratorURL: s.generatorURL,
 		}
+		if s.resolveTimeout > 0 {
+			alert.EndsAt = alert.StartsAt.Add(s.resolveTimeout)
+		}
 
 		payload[i] = alert
 	}
@@ -179,6 +345,14 @@ func (s *alertmanagerExporter) pushTraces(ctx context.Context, td ptrace.Traces)
 	return nil
 }
 
+func (s *alertmanagerExporter) pushLogs(ctx context.Context, ld plog.Logs) error {
+	events := s.extractLogEvents(ctx, ld)
+	if len(events) == 0 {
+		return nil
+	}
+	return s.postAlert(ctx, s.convertEventsToAlertPayload(events))
+}
+
 func (s *alertmanagerExporter) start(ctx context.Context, host component.Host) error {
 
 	client, err := s.config.ClientConfig.ToClient(ctx, host, s.settings)
@@ -186,6 +360,19 @@ func (s *alertmanagerExporter) start(ctx context.Context, host component.Host) e
 		return fmt.Errorf("failed to create HTTP Client: %w", err)
 	}
 	s.client = client
+
+	if len(s.config.LogConditions) > 0 {
+		s.logConditions, err = filterottl.NewBoolExprForLog(s.config.LogConditions, filterottl.StandardLogFuncs(), ottl.PropagateError, s.settings)
+		if err != nil {
+			return fmt.Errorf("failed to parse log conditions: %w", err)
+		}
+	}
+	if s.labels, err = parseTemplates(s.config.Labels); err != nil {
+		return fmt.Errorf("failed to parse label templates: %w", err)
+	}
+	if s.annotations, err = parseTemplates(s.config.Annotations); err != nil {
+		return fmt.Errorf("failed to parse annotation templates: %w", err)
+	}
 	return nil
 }
 
@@ -207,6 +394,7 @@ func newAlertManagerExporter(cfg *Config, set component.TelemetrySettings) *aler
 		generatorURL:      cfg.GeneratorURL,
 		defaultSeverity:   cfg.DefaultSeverity,
 		severityAttribute: cfg.SeverityAttribute,
+		resolveTimeout:    cfg.ResolveTimeout,
 	}
 }
 
@@ -229,3 +417,23 @@ func newTracesExporter(ctx context.Context, cfg component.Config, set exporter.C
 		exporterhelper.WithShutdown(s.shutdown),
 	)
 }
+
+func newLogsExporter(ctx context.Context, cfg component.Config, set exporter.CreateSettings) (exporter.Logs, error) {
+
+	config := cfg.(*Config)
+
+	s := newAlertManagerExporter(config, set.TelemetrySettings)
+
+	return exporterhelper.NewLogsExporter(
+		ctx,
+		set,
+		cfg,
+		s.pushLogs,
+		exporterhelper.WithCapabilities(consumer.Capabilities{MutatesData: false}),
+		exporterhelper.WithStart(s.start),
+		exporterhelper.WithTimeout(config.TimeoutSettings),
+		exporterhelper.WithRetry(config.BackoffConfig),
+		exporterhelper.WithQueue(config.QueueSettings),
+		exporterhelper.WithShutdown(s.shutdown),
+	)
+}
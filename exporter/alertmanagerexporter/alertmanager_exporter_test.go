@@ -21,6 +21,7 @@ import (
 	"go.opentelemetry.io/collector/config/configtls"
 	"go.opentelemetry.io/collector/exporter/exportertest"
 	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
 
@@ -270,6 +271,127 @@ func TestAlertManagerExporterAlertPayload(t *testing.T) {
 
 }
 
+func createLogs() plog.Logs {
+	logs := plog.NewLogs()
+	rl := logs.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().PutStr(conventions.AttributeServiceName, "checkout")
+	records := rl.ScopeLogs().AppendEmpty().LogRecords()
+
+	errorLog := records.AppendEmpty()
+	errorLog.SetSeverityNumber(plog.SeverityNumberError)
+	errorLog.SetSeverityText("ERROR")
+	errorLog.Body().SetStr("payment failed")
+	errorLog.Attributes().PutStr("event.name", "payment.failure")
+	errorLog.Attributes().PutInt("order", 42)
+	errorLog.SetTraceID([16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16})
+	errorLog.SetSpanID([8]byte{1, 2, 3, 4, 5, 6, 7, 8})
+
+	infoLog := records.AppendEmpty()
+	infoLog.SetSeverityNumber(plog.SeverityNumberInfo)
+	infoLog.Body().SetStr("payment done")
+	return logs
+}
+
+func TestAlertManagerExporterExtractLogEvents(t *testing.T) {
+	tests := []struct {
+		name       string
+		conditions []string
+		severities []string
+	}{
+		{
+			name:       "all",
+			severities: []string{"error", "info"},
+		},
+		{
+			name:       "conditions",
+			conditions: []string{"severity_number >= SEVERITY_NUMBER_ERROR", `attributes["missing"] != nil`},
+			severities: []string{"error"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.LogConditions = tt.conditions
+			am := newAlertManagerExporter(cfg, exportertest.NewNopCreateSettings().TelemetrySettings)
+			require.NoError(t, am.start(context.Background(), componenttest.NewNopHost()))
+
+			got := am.extractLogEvents(context.Background(), createLogs())
+			severities := make([]string, len(got))
+			for i, event := range got {
+				severities[i] = event.severity
+			}
+			assert.Equal(t, tt.severities, severities)
+		})
+	}
+}
+
+func TestAlertManagerExporterLogAlertPayload(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Labels = map[string]string{
+		"service": `{{ index .ResourceAttributes "service.name" }}`,
+		"missing": `{{ with index .Attributes "missing" }}{{ . }}{{ end }}`,
+	}
+	cfg.Annotations = map[string]string{
+		"summary": "{{ .Name }} for order {{ .Attributes.order }}: {{ .Body }}",
+	}
+	cfg.ResolveTimeout = 5 * time.Minute
+	am := newAlertManagerExporter(cfg, exportertest.NewNopCreateSettings().TelemetrySettings)
+	require.NoError(t, am.start(context.Background(), componenttest.NewNopHost()))
+
+	got := am.convertEventsToAlertPayload(am.extractLogEvents(context.Background(), createLogs()))
+	require.Len(t, got, 2)
+
+	assert.Equal(t, model.LabelSet{
+		"severity":   "error",
+		"event_name": "payment.failure",
+		"service":    "checkout",
+	}, got[0].Labels)
+	assert.Equal(t, model.LabelSet{
+		"event.name": "payment.failure",
+		"order":      "42",
+		"Body":       "payment failed",
+		"TraceID":    "0102030405060708090a0b0c0d0e0f10",
+		"SpanID":     "0102030405060708",
+		"summary":    "payment.failure for order 42: payment failed",
+	}, got[0].Annotations)
+	assert.Equal(t, 5*time.Minute, got[0].EndsAt.Sub(got[0].StartsAt))
+
+	assert.Equal(t, model.LabelSet{
+		"severity": "info",
+		"service":  "checkout",
+	}, got[1].Labels)
+	assert.Equal(t, model.LabelSet{
+		"Body":    "payment done",
+		"summary": " for order <no value>: payment done",
+	}, got[1].Annotations)
+}
+
+func TestAlertManagerExporterSpanEventTemplates(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Labels = map[string]string{"service": `{{ index .ResourceAttributes "service.name" }}`}
+	am := newAlertManagerExporter(cfg, exportertest.NewNopCreateSettings().TelemetrySettings)
+	require.NoError(t, am.start(context.Background(), componenttest.NewNopHost()))
+
+	traces, span := createTracesAndSpan()
+	span.Events().AppendEmpty().SetName("unittest-event")
+
+	got := am.convertEventsToAlertPayload(am.extractEvents(traces))
+	require.Len(t, got, 1)
+	assert.Equal(t, model.LabelSet{
+		"severity":   "info",
+		"event_name": "unittest-event",
+		"service":    "unittest-resource",
+	}, got[0].Labels)
+	assert.True(t, got[0].EndsAt.IsZero())
+}
+
+func TestAlertManagerLogsExporterNoErrors(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	lle, err := newLogsExporter(context.Background(), cfg, exportertest.NewNopCreateSettings())
+	require.NotNil(t, lle)
+	assert.NoError(t, err)
+}
+
 func TestAlertManagerTracesExporterNoErrors(t *testing.T) {
 	factory := NewFactory()
 	cfg := factory.CreateDefaultConfig().(*Config)
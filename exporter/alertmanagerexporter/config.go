@@ -5,11 +5,18 @@ package alertmanagerexporter // import "github.com/open-telemetry/opentelemetry-
 
 import (
 	"errors"
+	"fmt"
+	"text/template"
+	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/config/configretry"
 	"go.opentelemetry.io/collector/exporter/exporterhelper"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
 )
 
 // Config defines configuration for alertmanager exporter.
@@ -22,6 +29,17 @@ type Config struct {
 	GeneratorURL            string                   `mapstructure:"generator_url"`
 	DefaultSeverity         string                   `mapstructure:"severity"`
 	SeverityAttribute       string                   `mapstructure:"severity_attribute"`
+
+	// LogConditions is the list of ottllog conditions selecting the log records sent as alerts,
+	// a log record matching any of them is sent. All the log records are sent when it is empty.
+	LogConditions []string `mapstructure:"log_conditions"`
+	// Labels and Annotations are the text/template templates of the labels and annotations
+	// added to the alerts, per name.
+	Labels      map[string]string `mapstructure:"labels"`
+	Annotations map[string]string `mapstructure:"annotations"`
+	// ResolveTimeout is the duration after which Alertmanager resolves an alert which is not sent again,
+	// by default Alertmanager applies its own resolve timeout.
+	ResolveTimeout time.Duration `mapstructure:"resolve_timeout"`
 }
 
 var _ component.Config = (*Config)(nil)
@@ -35,5 +53,32 @@ func (cfg *Config) Validate() error {
 	if cfg.DefaultSeverity == "" {
 		return errors.New("severity must be non-empty")
 	}
+	if cfg.ResolveTimeout < 0 {
+		return errors.New("resolve_timeout must be non-negative")
+	}
+	if len(cfg.LogConditions) > 0 {
+		if _, err := filterottl.NewBoolExprForLog(cfg.LogConditions, filterottl.StandardLogFuncs(), ottl.PropagateError, component.TelemetrySettings{Logger: zap.NewNop()}); err != nil {
+			return fmt.Errorf("invalid log_conditions: %w", err)
+		}
+	}
+	if _, err := parseTemplates(cfg.Labels); err != nil {
+		return fmt.Errorf("invalid labels: %w", err)
+	}
+	if _, err := parseTemplates(cfg.Annotations); err != nil {
+		return fmt.Errorf("invalid annotations: %w", err)
+	}
 	return nil
 }
+
+// parseTemplates parses the templates of the labels or annotations, per name.
+func parseTemplates(templates map[string]string) (map[string]*template.Template, error) {
+	parsed := make(map[string]*template.Template, len(templates))
+	for name, text := range templates {
+		tmpl, err := template.New(name).Parse(text)
+		if err != nil {
+			return nil, err
+		}
+		parsed[name] = tmpl
+	}
+	return parsed, nil
+}
@@ -5,6 +5,7 @@ package alertmanagerexporter
 
 import (
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -80,6 +81,19 @@ func TestLoadConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "logs"),
+			expected: func() *Config {
+				cfg := createDefaultConfig().(*Config)
+				cfg.Endpoint = "a.new.alertmanager.target:9093"
+				cfg.DefaultSeverity = "warning"
+				cfg.LogConditions = []string{"severity_number >= SEVERITY_NUMBER_ERROR"}
+				cfg.Labels = map[string]string{"service": `{{ index .ResourceAttributes "service.name" }}`}
+				cfg.Annotations = map[string]string{"summary": "{{ .Body }}"}
+				cfg.ResolveTimeout = 5 * time.Minute
+				return cfg
+			}(),
+		},
 	}
 
 	for _, tt := range tests {
@@ -121,6 +135,42 @@ func TestConfig_Validate(t *testing.T) {
 			}(),
 			wantErr: "severity must be non-empty",
 		},
+		{
+			name: "NegativeResolveTimeout",
+			cfg: func() *Config {
+				cfg := createDefaultConfig().(*Config)
+				cfg.ResolveTimeout = -time.Minute
+				return cfg
+			}(),
+			wantErr: "resolve_timeout must be non-negative",
+		},
+		{
+			name: "InvalidLogConditions",
+			cfg: func() *Config {
+				cfg := createDefaultConfig().(*Config)
+				cfg.LogConditions = []string{"not a condition"}
+				return cfg
+			}(),
+			wantErr: "invalid log_conditions: ",
+		},
+		{
+			name: "InvalidLabels",
+			cfg: func() *Config {
+				cfg := createDefaultConfig().(*Config)
+				cfg.Labels = map[string]string{"service": "{{ .Attributes"}
+				return cfg
+			}(),
+			wantErr: "invalid labels: ",
+		},
+		{
+			name: "InvalidAnnotations",
+			cfg: func() *Config {
+				cfg := createDefaultConfig().(*Config)
+				cfg.Annotations = map[string]string{"summary": "{{ end }}"}
+				return cfg
+			}(),
+			wantErr: "invalid annotations: ",
+		},
 		{
 			name:    "Success",
 			cfg:     createDefaultConfig().(*Config),
@@ -131,9 +181,12 @@ func TestConfig_Validate(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			err := tt.cfg.Validate()
-			if tt.wantErr == "" {
+			switch {
+			case tt.wantErr == "":
 				require.NoError(t, err)
-			} else {
+			case strings.HasSuffix(tt.wantErr, ": "):
+				require.ErrorContains(t, err, tt.wantErr)
+			default:
 				require.EqualError(t, err, tt.wantErr)
 			}
 		})
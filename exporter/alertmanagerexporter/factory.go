@@ -23,7 +23,8 @@ func NewFactory() exporter.Factory {
 	return exporter.NewFactory(
 		metadata.Type,
 		createDefaultConfig,
-		exporter.WithTraces(createTracesExporter, metadata.TracesStability))
+		exporter.WithTraces(createTracesExporter, metadata.TracesStability),
+		exporter.WithLogs(createLogsExporter, metadata.LogsStability))
 }
 
 func createDefaultConfig() component.Config {
@@ -51,3 +52,13 @@ func createTracesExporter(ctx context.Context, set exporter.CreateSettings, conf
 	}
 	return newTracesExporter(ctx, cfg, set)
 }
+
+func createLogsExporter(ctx context.Context, set exporter.CreateSettings, config component.Config) (exporter.Logs, error) {
+	cfg := config.(*Config)
+
+	if cfg.Endpoint == "" {
+		return nil, fmt.Errorf(
+			"exporter config requires a non-empty \"endpoint\"")
+	}
+	return newLogsExporter(ctx, cfg, set)
+}
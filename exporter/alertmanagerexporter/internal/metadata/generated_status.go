@@ -12,4 +12,5 @@ var (
 
 const (
 	TracesStability = component.StabilityLevelDevelopment
+	LogsStability   = component.StabilityLevelDevelopment
 )
@@ -85,6 +85,13 @@ func makeCause(span ptrace.Span, attributes map[string]pcommon.Value, resource p
 					stacktrace = val.Str()
 				}
 
+				if val, ok := event.Attributes().Get(awsxray.AWSXrayExceptionIDAttribute); ok {
+					// the exception was received from X-Ray, its ID is kept so that the exceptions
+					// referencing it as their cause still do
+					exceptions = append(exceptions, makeXRayException(val.Str(), exceptionType, message, stacktrace, isRemote, event.Attributes()))
+					continue
+				}
+
 				parsed := parseException(exceptionType, message, stacktrace, isRemote, language)
 				exceptions = append(exceptions, parsed...)
 			} else if isAwsSdkSpan && event.Name() == AwsIndividualHTTPEventName {
@@ -180,6 +187,65 @@ func makeCause(span ptrace.Span, attributes map[string]pcommon.Value, resource p
 	return isError, isFault, isThrottle, filtered, cause
 }
 
+// makeXRayException rebuilds an exception of an X-Ray segment from the attributes of its exception event.
+func makeXRayException(id, exceptionType, message, stacktrace string, isRemote bool, attrs pcommon.Map) awsxray.Exception {
+	exception := awsxray.Exception{
+		ID:      aws.String(id),
+		Type:    aws.String(exceptionType),
+		Remote:  aws.Bool(isRemote),
+		Message: aws.String(message),
+	}
+	if val, ok := attrs.Get(awsxray.AWSXrayExceptionRemoteAttribute); ok && val.Type() == pcommon.ValueTypeBool {
+		exception.Remote = aws.Bool(val.Bool())
+	}
+	if val, ok := attrs.Get(awsxray.AWSXrayExceptionTruncatedAttribute); ok && val.Type() == pcommon.ValueTypeInt {
+		exception.Truncated = aws.Int64(val.Int())
+	}
+	if val, ok := attrs.Get(awsxray.AWSXrayExceptionSkippedAttribute); ok && val.Type() == pcommon.ValueTypeInt {
+		exception.Skipped = aws.Int64(val.Int())
+	}
+	if val, ok := attrs.Get(awsxray.AWSXrayExceptionCauseAttribute); ok && val.Str() != "" {
+		exception.Cause = aws.String(val.Str())
+	}
+	exception.Stack = parseXRayStacktrace(stacktrace)
+	return exception
+}
+
+// parseXRayStacktrace parses the stack frames of the stack traces written by the X-Ray receiver:
+// a first line with the type and message of the exception, then a "\tat <label>(<path>: <line>)"
+// line per frame.
+func parseXRayStacktrace(stacktrace string) []awsxray.StackFrame {
+	var frames []awsxray.StackFrame
+	lines := strings.Split(stacktrace, "\n")
+	for _, line := range lines[1:] {
+		line, ok := strings.CutPrefix(line, "\tat ")
+		if !ok || !strings.HasSuffix(line, ")") {
+			continue
+		}
+		open := strings.LastIndex(line, "(")
+		if open < 0 {
+			continue
+		}
+		location := line[open+1 : len(line)-1]
+		sep := strings.LastIndex(location, ": ")
+		if sep < 0 {
+			continue
+		}
+		frame := awsxray.StackFrame{}
+		if label := line[:open]; label != "" {
+			frame.Label = aws.String(label)
+		}
+		if path := location[:sep]; path != "" {
+			frame.Path = aws.String(path)
+		}
+		if lineNumber, err := strconv.Atoi(location[sep+2:]); err == nil {
+			frame.Line = aws.Int(lineNumber)
+		}
+		frames = append(frames, frame)
+	}
+	return frames
+}
+
 func parseException(exceptionType string, message string, stacktrace string, isRemote bool, language string) []awsxray.Exception {
 	exceptions := make([]awsxray.Exception, 0, 1)
 	segmentID := newSegmentID()
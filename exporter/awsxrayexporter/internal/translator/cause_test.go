@@ -8,11 +8,14 @@ import (
 	"testing"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
+
+	awsxray "github.com/open-telemetry/opentelemetry-collector-contrib/internal/aws/xray"
 )
 
 func TestCauseWithExceptions(t *testing.T) {
@@ -59,6 +62,53 @@ Caused by: java.lang.IllegalArgumentException: bad argument`)
 	assert.Empty(t, cause.Exceptions[2].Message)
 }
 
+func TestCauseWithXRayExceptions(t *testing.T) {
+	span := constructExceptionServerSpan(make(map[string]any), ptrace.StatusCodeError)
+
+	event1 := span.Events().AppendEmpty()
+	event1.SetName(ExceptionEventName)
+	event1.Attributes().PutStr(awsxray.AWSXrayExceptionIDAttribute, "e0b6465a9c8fd8a7")
+	event1.Attributes().PutStr(conventions.AttributeExceptionType, "IllegalStateException")
+	event1.Attributes().PutStr(conventions.AttributeExceptionMessage, "bad state")
+	event1.Attributes().PutBool(awsxray.AWSXrayExceptionRemoteAttribute, true)
+	event1.Attributes().PutInt(awsxray.AWSXrayExceptionTruncatedAttribute, 2)
+	event1.Attributes().PutInt(awsxray.AWSXrayExceptionSkippedAttribute, 1)
+	event1.Attributes().PutStr(awsxray.AWSXrayExceptionCauseAttribute, "3a1c2c6e1a1f5c7b")
+	event1.Attributes().PutStr(conventions.AttributeExceptionStacktrace,
+		"IllegalStateException: bad state\n\tat recordException(Span.java: 626)\n\tat invoke(: <unknown>)\n")
+
+	event2 := span.Events().AppendEmpty()
+	event2.SetName(ExceptionEventName)
+	event2.Attributes().PutStr(awsxray.AWSXrayExceptionIDAttribute, "3a1c2c6e1a1f5c7b")
+	event2.Attributes().PutStr(conventions.AttributeExceptionType, "IllegalArgumentException")
+
+	filtered, _ := makeHTTP(span)
+	_, _, _, _, cause := makeCause(span, filtered, pcommon.NewResource())
+
+	require.NotNil(t, cause)
+	assert.Equal(t, []awsxray.Exception{
+		{
+			ID:        aws.String("e0b6465a9c8fd8a7"),
+			Type:      aws.String("IllegalStateException"),
+			Message:   aws.String("bad state"),
+			Remote:    aws.Bool(true),
+			Truncated: aws.Int64(2),
+			Skipped:   aws.Int64(1),
+			Cause:     aws.String("3a1c2c6e1a1f5c7b"),
+			Stack: []awsxray.StackFrame{
+				{Label: aws.String("recordException"), Path: aws.String("Span.java"), Line: aws.Int(626)},
+				{Label: aws.String("invoke")},
+			},
+		},
+		{
+			ID:      aws.String("3a1c2c6e1a1f5c7b"),
+			Type:    aws.String("IllegalArgumentException"),
+			Message: aws.String(""),
+			Remote:  aws.Bool(false),
+		},
+	}, cause.Exceptions)
+}
+
 func TestMakeCauseAwsSdkSpan(t *testing.T) {
 	errorMsg := "this is a test"
 	attributeMap := make(map[string]any)
@@ -440,6 +440,11 @@ func makeXRayAttributes(attributes map[string]pcommon.Value, resource pcommon.Re
 
 	if indexAllAttrs {
 		for key, value := range attributes {
+			if isMetadataNamespace(key, value) {
+				// the metadata namespaces are kept as metadata, they are JSON objects and not annotation values
+				addMetadataNamespace(key, value, metadata, defaultMetadata)
+				continue
+			}
 			key = fixAnnotationKey(key)
 			annoVal := annotationValue(value)
 			if annoVal != nil {
@@ -455,21 +460,8 @@ func makeXRayAttributes(attributes map[string]pcommon.Value, resource pcommon.Re
 				if annoVal != nil {
 					annotations[key] = annoVal
 				}
-			case strings.HasPrefix(key, awsxray.AWSXraySegmentMetadataAttributePrefix) && value.Type() == pcommon.ValueTypeStr:
-				namespace := strings.TrimPrefix(key, awsxray.AWSXraySegmentMetadataAttributePrefix)
-				var metaVal map[string]any
-				err := json.Unmarshal([]byte(value.Str()), &metaVal)
-				switch {
-				case err != nil:
-					// if unable to unmarshal, keep the original key/value
-					defaultMetadata[key] = value.Str()
-				case strings.EqualFold(namespace, defaultMetadataNamespace):
-					for k, v := range metaVal {
-						defaultMetadata[k] = v
-					}
-				default:
-					metadata[namespace] = metaVal
-				}
+			case isMetadataNamespace(key, value):
+				addMetadataNamespace(key, value, metadata, defaultMetadata)
 			default:
 				metaVal := value.AsRaw()
 				if metaVal != nil {
@@ -486,6 +478,29 @@ func makeXRayAttributes(attributes map[string]pcommon.Value, resource pcommon.Re
 	return user, annotations, metadata
 }
 
+// isMetadataNamespace reports whether the attribute holds a metadata namespace of an X-Ray segment,
+// as a JSON object.
+func isMetadataNamespace(key string, value pcommon.Value) bool {
+	return strings.HasPrefix(key, awsxray.AWSXraySegmentMetadataAttributePrefix) && value.Type() == pcommon.ValueTypeStr
+}
+
+func addMetadataNamespace(key string, value pcommon.Value, metadata map[string]map[string]any, defaultMetadata map[string]any) {
+	namespace := strings.TrimPrefix(key, awsxray.AWSXraySegmentMetadataAttributePrefix)
+	var metaVal map[string]any
+	err := json.Unmarshal([]byte(value.Str()), &metaVal)
+	switch {
+	case err != nil:
+		// if unable to unmarshal, keep the original key/value
+		defaultMetadata[key] = value.Str()
+	case strings.EqualFold(namespace, defaultMetadataNamespace):
+		for k, v := range metaVal {
+			defaultMetadata[k] = v
+		}
+	default:
+		metadata[namespace] = metaVal
+	}
+}
+
 func annotationValue(value pcommon.Value) any {
 	switch value.Type() {
 	case pcommon.ValueTypeStr:
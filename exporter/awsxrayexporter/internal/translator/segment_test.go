@@ -583,6 +583,25 @@ func TestSpanWithAttributesSegmentMetadata(t *testing.T) {
 	}, segment.Metadata["http"]["connection"])
 }
 
+func TestSpanWithAttributesSegmentMetadataAndIndexAll(t *testing.T) {
+	spanName := "/api/locations"
+	parentSpanID := newSegmentID()
+	attributes := make(map[string]any)
+	attributes["attr1"] = "val1"
+	attributes[awsxray.AWSXraySegmentMetadataAttributePrefix+"http"] = "{\"connection\":{\"reused\":false}}"
+	resource := constructDefaultResource()
+	span := constructServerSpan(parentSpanID, spanName, ptrace.StatusCodeError, "OK", attributes)
+
+	segment, _ := MakeSegment(span, resource, nil, true, nil, false)
+
+	assert.NotNil(t, segment)
+	assert.Equal(t, "val1", segment.Annotations["attr1"])
+	assert.Nil(t, segment.Annotations["aws_xray_metadata_http"])
+	assert.Equal(t, map[string]any{
+		"reused": false,
+	}, segment.Metadata["http"]["connection"])
+}
+
 func TestResourceAttributesCanBeIndexed(t *testing.T) {
 	err := featuregate.GlobalRegistry().Set("exporter.xray.allowDot", false)
 	assert.Nil(t, err)
@@ -0,0 +1,152 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package bigqueryexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/bigqueryexporter"
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configretry"
+	"go.opentelemetry.io/collector/exporter/exporterhelper"
+)
+
+const (
+	writeModeStreaming = "streaming"
+	writeModeLoad      = "load"
+
+	partitioningNone = "none"
+
+	// maxClusteringColumns is the maximum number of clustering columns of a BigQuery table.
+	maxClusteringColumns = 4
+)
+
+// partitioningTypes maps the partitioning granularities to the BigQuery time partitioning types.
+var partitioningTypes = map[string]string{
+	"hour":  "HOUR",
+	"day":   "DAY",
+	"month": "MONTH",
+	"year":  "YEAR",
+}
+
+// Config defines the configuration of the BigQuery exporter.
+type Config struct {
+	exporterhelper.TimeoutSettings `mapstructure:",squash"` // squash ensures fields are correctly decoded in embedded struct.
+	exporterhelper.QueueSettings   `mapstructure:"sending_queue"`
+	configretry.BackOffConfig      `mapstructure:"retry_on_failure"`
+
+	// ProjectID is the Google Cloud project of the dataset, the project of the credentials by default.
+	ProjectID string `mapstructure:"project"`
+	// Dataset is the BigQuery dataset of the tables.
+	Dataset string `mapstructure:"dataset"`
+	// UserAgent is the user agent of the requests to the BigQuery API.
+	UserAgent string `mapstructure:"user_agent"`
+	// Endpoint overrides the BigQuery API endpoint, e.g. to connect to an emulator.
+	Endpoint string `mapstructure:"endpoint"`
+	// Insecure connects to the endpoint without credentials. Only has effect if Endpoint is not "".
+	Insecure bool `mapstructure:"insecure"`
+
+	// WriteMode is how the rows are written: with streaming inserts, or with batch load jobs.
+	WriteMode string `mapstructure:"write_mode"`
+	// Load configures the batching of the rows into load jobs, in the load write mode.
+	Load LoadConfig `mapstructure:"load"`
+
+	// CreateTables creates the missing tables when the exporter starts.
+	CreateTables bool `mapstructure:"create_tables"`
+	// UpdateSchema adds the missing columns to the existing tables when the exporter starts,
+	// instead of dropping their values.
+	UpdateSchema bool `mapstructure:"update_schema"`
+
+	// Logs, Metrics and Traces configure the tables of the signals.
+	Logs    TableConfig `mapstructure:"logs"`
+	Metrics TableConfig `mapstructure:"metrics"`
+	Traces  TableConfig `mapstructure:"traces"`
+}
+
+// LoadConfig configures the batching of the rows into load jobs.
+type LoadConfig struct {
+	// FlushInterval is the interval between the load jobs of a table.
+	FlushInterval time.Duration `mapstructure:"flush_interval"`
+	// MaxRows is the number of buffered rows starting a load job before the flush interval.
+	MaxRows int `mapstructure:"max_rows"`
+}
+
+// TableConfig configures the table of a signal.
+type TableConfig struct {
+	// Table is the name of the table.
+	Table string `mapstructure:"table"`
+	// Partitioning is the granularity of the time partitioning of the table on its timestamp
+	// column: hour, day, month, year or none.
+	Partitioning string `mapstructure:"partitioning"`
+	// PartitionExpiration deletes the partitions once they are older, if set.
+	PartitionExpiration time.Duration `mapstructure:"partition_expiration"`
+	// Clustering are the columns clustering the table, up to 4.
+	Clustering []string `mapstructure:"clustering"`
+	// AttributeColumns are the attributes written to their own column as well, e.g. to cluster
+	// the table on them. The attributes of the records win over the ones of their resource.
+	AttributeColumns []string `mapstructure:"attribute_columns"`
+}
+
+var _ component.Config = (*Config)(nil)
+
+// Validate checks if the exporter configuration is valid.
+func (cfg *Config) Validate() error {
+	var errs []error
+	if cfg.Dataset == "" {
+		errs = append(errs, errors.New("'dataset' must not be empty"))
+	}
+	switch cfg.WriteMode {
+	case writeModeStreaming:
+	case writeModeLoad:
+		if cfg.Load.FlushInterval <= 0 {
+			errs = append(errs, errors.New("'load.flush_interval' must be positive"))
+		}
+		if cfg.Load.MaxRows <= 0 {
+			errs = append(errs, errors.New("'load.max_rows' must be positive"))
+		}
+		if cfg.Timeout > 0 && cfg.Timeout <= cfg.Load.FlushInterval {
+			// the exports wait for the load job of their rows
+			errs = append(errs, fmt.Errorf("'timeout' must be greater than 'load.flush_interval' %s in the load write mode", cfg.Load.FlushInterval))
+		}
+	default:
+		errs = append(errs, fmt.Errorf("unsupported 'write_mode' '%s', must be '%s' or '%s'", cfg.WriteMode, writeModeStreaming, writeModeLoad))
+	}
+	errs = append(errs,
+		cfg.Logs.validate("logs", logsSchema),
+		cfg.Metrics.validate("metrics", metricsSchema),
+		cfg.Traces.validate("traces", tracesSchema),
+	)
+	return errors.Join(errs...)
+}
+
+func (cfg TableConfig) validate(signal string, schema []column) error {
+	var errs []error
+	if cfg.Table == "" {
+		errs = append(errs, fmt.Errorf("'%s.table' must not be empty", signal))
+	}
+	if _, ok := partitioningTypes[cfg.Partitioning]; !ok && cfg.Partitioning != partitioningNone {
+		errs = append(errs, fmt.Errorf("unsupported '%s.partitioning' '%s', must be one of 'hour', 'day', 'month', 'year' and 'none'", signal, cfg.Partitioning))
+	}
+	if cfg.PartitionExpiration < 0 {
+		errs = append(errs, fmt.Errorf("'%s.partition_expiration' cannot be negative", signal))
+	}
+	if len(cfg.Clustering) > maxClusteringColumns {
+		errs = append(errs, fmt.Errorf("'%s.clustering' cannot have more than %d columns", signal, maxClusteringColumns))
+	}
+	columns := tableColumns(schema, cfg.AttributeColumns)
+	names := make(map[string]bool, len(columns))
+	for _, c := range columns {
+		if names[c.name] {
+			errs = append(errs, fmt.Errorf("'%s.attribute_columns' has a duplicate column '%s'", signal, c.name))
+		}
+		names[c.name] = true
+	}
+	for _, name := range cfg.Clustering {
+		if !names[name] {
+			errs = append(errs, fmt.Errorf("'%s.clustering' has an unknown column '%s'", signal, name))
+		}
+	}
+	return errors.Join(errs...)
+}
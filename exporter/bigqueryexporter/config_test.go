@@ -0,0 +1,103 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package bigqueryexporter
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/bigqueryexporter/internal/metadata"
+)
+
+func TestLoadConfig(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+	factory := NewFactory()
+
+	tests := []struct {
+		id       component.ID
+		expected func(*Config)
+	}{
+		{
+			id:       component.NewIDWithName(metadata.Type, ""),
+			expected: func(*Config) {},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "customname"),
+			expected: func(cfg *Config) {
+				cfg.Timeout = 10 * time.Minute
+				cfg.WriteMode = writeModeLoad
+				cfg.Load = LoadConfig{FlushInterval: 5 * time.Minute, MaxRows: 50000}
+				cfg.UpdateSchema = false
+				cfg.Logs = TableConfig{
+					Table:               "logs",
+					Partitioning:        "hour",
+					PartitionExpiration: 720 * time.Hour,
+					Clustering:          []string{"service_name", "k8s_namespace_name"},
+					AttributeColumns:    []string{"k8s.namespace.name"},
+				}
+				cfg.Traces.Partitioning = partitioningNone
+				cfg.Traces.Clustering = []string{}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			cfg := factory.CreateDefaultConfig()
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, component.UnmarshalConfig(sub, cfg))
+			require.NoError(t, component.ValidateConfig(cfg))
+
+			expected := factory.CreateDefaultConfig().(*Config)
+			expected.ProjectID = "otel-project"
+			expected.Dataset = "telemetry"
+			tt.expected(expected)
+			assert.Equal(t, expected, cfg)
+		})
+	}
+}
+
+func TestConfigValidate(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+	cfg := NewFactory().CreateDefaultConfig()
+	sub, err := cm.Sub(component.NewIDWithName(metadata.Type, "invalid").String())
+	require.NoError(t, err)
+	require.NoError(t, component.UnmarshalConfig(sub, cfg))
+
+	assert.EqualError(t, component.ValidateConfig(cfg), "'dataset' must not be empty\n"+
+		"unsupported 'write_mode' 'batch', must be 'streaming' or 'load'\n"+
+		"unsupported 'metrics.partitioning' 'week', must be one of 'hour', 'day', 'month', 'year' and 'none'\n"+
+		"'metrics.clustering' has an unknown column 'k8s_namespace_name'")
+
+	c := NewFactory().CreateDefaultConfig().(*Config)
+	c.Dataset = "telemetry"
+	c.WriteMode = writeModeLoad
+	c.Load = LoadConfig{}
+	c.Traces.Table = ""
+	c.Traces.PartitionExpiration = -time.Hour
+	c.Traces.Clustering = []string{"service_name", "name", "kind", "status_code", "trace_id"}
+	c.Traces.AttributeColumns = []string{"http.method", "http_method", "name"}
+	assert.EqualError(t, c.Validate(), "'load.flush_interval' must be positive\n"+
+		"'load.max_rows' must be positive\n"+
+		"'traces.table' must not be empty\n"+
+		"'traces.partition_expiration' cannot be negative\n"+
+		"'traces.clustering' cannot have more than 4 columns\n"+
+		"'traces.attribute_columns' has a duplicate column 'http_method'\n"+
+		"'traces.attribute_columns' has a duplicate column 'name'")
+
+	c = NewFactory().CreateDefaultConfig().(*Config)
+	c.Dataset = "telemetry"
+	c.WriteMode = writeModeLoad
+	assert.EqualError(t, c.Validate(), "'timeout' must be greater than 'load.flush_interval' 1m0s in the load write mode")
+	c.Timeout = 0
+	assert.NoError(t, c.Validate())
+}
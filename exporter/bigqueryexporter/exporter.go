@@ -0,0 +1,248 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package bigqueryexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/bigqueryexporter"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
+	"golang.org/x/oauth2/google"
+	bigquery "google.golang.org/api/bigquery/v2"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+)
+
+// bigQueryExporter writes the rows of a signal to its table.
+type bigQueryExporter struct {
+	logger    *zap.Logger
+	config    *Config
+	table     TableConfig
+	columns   []column
+	builder   *rowBuilder
+	userAgent string
+
+	projectID  string
+	service    *bigquery.Service
+	httpClient *http.Client
+	// ignoreUnknownValues drops the values of the columns missing from the table, when the
+	// schema of an existing table cannot be updated.
+	ignoreUnknownValues bool
+	loader              *loader
+}
+
+func newExporter(logger *zap.Logger, cfg *Config, table TableConfig, schema []column, userAgent string) *bigQueryExporter {
+	return &bigQueryExporter{
+		logger:    logger,
+		config:    cfg,
+		table:     table,
+		columns:   tableColumns(schema, table.AttributeColumns),
+		builder:   newRowBuilder(table),
+		userAgent: userAgent,
+	}
+}
+
+func (e *bigQueryExporter) start(ctx context.Context, _ component.Host) error {
+	e.projectID = e.config.ProjectID
+	if e.projectID == "" {
+		credentials, err := google.FindDefaultCredentials(ctx, bigquery.BigqueryScope)
+		if err != nil {
+			return fmt.Errorf("failed to find the default credentials for the project: %w", err)
+		}
+		if credentials.ProjectID == "" {
+			return errors.New("'project' must be set, the default credentials have no project")
+		}
+		e.projectID = credentials.ProjectID
+	}
+
+	opts := []option.ClientOption{option.WithUserAgent(e.userAgent)}
+	if e.config.Endpoint != "" {
+		opts = append(opts, option.WithEndpoint(e.config.Endpoint))
+		if e.config.Insecure {
+			e.httpClient = &http.Client{Transport: http.DefaultTransport.(*http.Transport).Clone()}
+			opts = append(opts, option.WithHTTPClient(e.httpClient))
+		}
+	}
+	service, err := bigquery.NewService(ctx, opts...)
+	if err != nil {
+		return fmt.Errorf("failed to create the BigQuery client: %w", err)
+	}
+	e.service = service
+
+	if err := e.ensureTable(ctx); err != nil {
+		return err
+	}
+	if e.config.WriteMode == writeModeLoad {
+		e.loader = newLoader(e)
+		e.loader.start()
+	}
+	return nil
+}
+
+func (e *bigQueryExporter) shutdown(ctx context.Context) error {
+	var err error
+	if e.loader != nil {
+		err = e.loader.shutdown(ctx)
+	}
+	if e.httpClient != nil {
+		e.httpClient.CloseIdleConnections()
+	}
+	return err
+}
+
+func (e *bigQueryExporter) consumeLogs(ctx context.Context, ld plog.Logs) error {
+	return e.write(ctx, e.builder.logsRows(ld))
+}
+
+func (e *bigQueryExporter) consumeMetrics(ctx context.Context, md pmetric.Metrics) error {
+	return e.write(ctx, e.builder.metricsRows(md))
+}
+
+func (e *bigQueryExporter) consumeTraces(ctx context.Context, td ptrace.Traces) error {
+	return e.write(ctx, e.builder.tracesRows(td))
+}
+
+func (e *bigQueryExporter) write(ctx context.Context, rows []row) error {
+	if len(rows) == 0 {
+		return nil
+	}
+	if e.loader != nil {
+		return e.loader.add(ctx, rows)
+	}
+	return e.insertRows(ctx, rows)
+}
+
+func (e *bigQueryExporter) tableRef() string {
+	return fmt.Sprintf("%s.%s.%s", e.projectID, e.config.Dataset, e.table.Table)
+}
+
+// ensureTable creates the table if it does not exist, or adds the missing columns to its
+// schema. Columns are only ever added, BigQuery not allowing to change or drop them.
+func (e *bigQueryExporter) ensureTable(ctx context.Context) error {
+	table, err := e.service.Tables.Get(e.projectID, e.config.Dataset, e.table.Table).Context(ctx).Do()
+	if isHTTPStatus(err, http.StatusNotFound) {
+		if !e.config.CreateTables {
+			return fmt.Errorf("the table %s does not exist and 'create_tables' is disabled", e.tableRef())
+		}
+		return e.createTable(ctx)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get the table %s: %w", e.tableRef(), err)
+	}
+
+	missing := missingColumns(table.Schema, e.columns)
+	if len(missing) == 0 {
+		return nil
+	}
+	names := make([]string, 0, len(missing))
+	for _, c := range missing {
+		names = append(names, c.name)
+	}
+	if !e.config.UpdateSchema {
+		e.ignoreUnknownValues = true
+		e.logger.Warn("The table is missing columns and 'update_schema' is disabled, their values are dropped",
+			zap.String("table", e.tableRef()), zap.Strings("columns", names))
+		return nil
+	}
+
+	schema := table.Schema
+	if schema == nil {
+		schema = &bigquery.TableSchema{}
+	}
+	for _, c := range missing {
+		field := c.field()
+		if field.Mode == modeRequired {
+			// the existing rows have no value for the added columns
+			field.Mode = modeNullable
+		}
+		schema.Fields = append(schema.Fields, field)
+	}
+	if _, err := e.service.Tables.Patch(e.projectID, e.config.Dataset, e.table.Table, &bigquery.Table{Schema: schema}).Context(ctx).Do(); err != nil {
+		return fmt.Errorf("failed to add the columns %s to the table %s: %w", strings.Join(names, ", "), e.tableRef(), err)
+	}
+	e.logger.Info("Added the missing columns to the table", zap.String("table", e.tableRef()), zap.Strings("columns", names))
+	return nil
+}
+
+func (e *bigQueryExporter) createTable(ctx context.Context) error {
+	table := &bigquery.Table{
+		TableReference: &bigquery.TableReference{ProjectId: e.projectID, DatasetId: e.config.Dataset, TableId: e.table.Table},
+		Schema:         tableSchema(e.columns),
+	}
+	if partitioningType, ok := partitioningTypes[e.table.Partitioning]; ok {
+		table.TimePartitioning = &bigquery.TimePartitioning{
+			Type:         partitioningType,
+			Field:        timestampColumn,
+			ExpirationMs: e.table.PartitionExpiration.Milliseconds(),
+		}
+	}
+	if len(e.table.Clustering) > 0 {
+		table.Clustering = &bigquery.Clustering{Fields: e.table.Clustering}
+	}
+	_, err := e.service.Tables.Insert(e.projectID, e.config.Dataset, table).Context(ctx).Do()
+	if isHTTPStatus(err, http.StatusConflict) {
+		// created by another collector meanwhile
+		return e.ensureTable(ctx)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to create the table %s: %w", e.tableRef(), err)
+	}
+	e.logger.Info("Created the table", zap.String("table", e.tableRef()))
+	return nil
+}
+
+// missingColumns returns the columns missing from the schema.
+func missingColumns(schema *bigquery.TableSchema, columns []column) []column {
+	existing := map[string]bool{}
+	if schema != nil {
+		for _, field := range schema.Fields {
+			existing[strings.ToLower(field.Name)] = true
+		}
+	}
+	var missing []column
+	for _, c := range columns {
+		if !existing[strings.ToLower(c.name)] {
+			missing = append(missing, c)
+		}
+	}
+	return missing
+}
+
+func isHTTPStatus(err error, code int) bool {
+	var apiErr *googleapi.Error
+	return errors.As(err, &apiErr) && apiErr.Code == code
+}
+
+// apiError makes the errors of the requests caused by the data permanent, the other errors
+// being retried.
+func apiError(err error) error {
+	var apiErr *googleapi.Error
+	if !errors.As(err, &apiErr) {
+		return err
+	}
+	switch {
+	case apiErr.Code == http.StatusRequestTimeout, apiErr.Code == http.StatusTooManyRequests:
+		return err
+	case apiErr.Code >= 400 && apiErr.Code < 500:
+		return consumererror.NewPermanent(err)
+	default:
+		return err
+	}
+}
+
+// contextWithTimeout returns the context of the background requests, bounded by the timeout.
+func (e *bigQueryExporter) contextWithTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if e.config.Timeout > 0 {
+		return context.WithTimeout(ctx, e.config.Timeout)
+	}
+	return context.WithCancel(ctx)
+}
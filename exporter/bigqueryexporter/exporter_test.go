@@ -0,0 +1,349 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package bigqueryexporter
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.uber.org/zap"
+	bigquery "google.golang.org/api/bigquery/v2"
+)
+
+// fakeBigQuery serves the requests of the exporter to the BigQuery API for the tables of the
+// project p and the dataset d.
+type fakeBigQuery struct {
+	t      *testing.T
+	server *httptest.Server
+
+	mu           sync.Mutex
+	tables       map[string]*bigquery.Table
+	inserted     []map[string]any
+	loaded       []map[string]any
+	insertErrors bool
+	status       int
+}
+
+func newFakeBigQuery(t *testing.T) *fakeBigQuery {
+	f := &fakeBigQuery{t: t, tables: map[string]*bigquery.Table{}}
+	f.server = httptest.NewServer(http.HandlerFunc(f.handle))
+	t.Cleanup(f.server.Close)
+	return f
+}
+
+func (f *fakeBigQuery) handle(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.status != 0 {
+		w.WriteHeader(f.status)
+		return
+	}
+	const tables = "/bigquery/v2/projects/p/datasets/d/tables"
+	switch {
+	case r.Method == http.MethodPost && r.URL.Path == tables:
+		var table bigquery.Table
+		f.decode(r.Body, &table)
+		f.tables[table.TableReference.TableId] = &table
+		f.reply(w, &table)
+	case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/insertAll"):
+		var request bigquery.TableDataInsertAllRequest
+		f.decode(r.Body, &request)
+		response := &bigquery.TableDataInsertAllResponse{}
+		for i, row := range request.Rows {
+			if f.insertErrors {
+				response.InsertErrors = append(response.InsertErrors, &bigquery.TableDataInsertAllResponseInsertErrors{
+					Index:  int64(i),
+					Errors: []*bigquery.ErrorProto{{Reason: "invalid", Message: "no such field"}},
+				})
+				continue
+			}
+			values := map[string]any{}
+			for name, value := range row.Json {
+				values[name] = value
+			}
+			f.inserted = append(f.inserted, values)
+		}
+		f.reply(w, response)
+	case r.Method == http.MethodPost && r.URL.Path == "/upload/bigquery/v2/projects/p/jobs":
+		f.reply(w, f.load(r))
+	case strings.HasPrefix(r.URL.Path, tables+"/"):
+		table, found := f.tables[strings.TrimPrefix(r.URL.Path, tables+"/")]
+		if !found {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.Method == http.MethodPatch {
+			var patch bigquery.Table
+			f.decode(r.Body, &patch)
+			table.Schema = patch.Schema
+		}
+		f.reply(w, table)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+// load reads the rows of the multipart upload of a load job, the job being done right away.
+// The job fails with the insert errors.
+func (f *fakeBigQuery) load(r *http.Request) *bigquery.Job {
+	_, params, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	require.NoError(f.t, err)
+	reader := multipart.NewReader(r.Body, params["boundary"])
+	part, err := reader.NextPart()
+	require.NoError(f.t, err)
+	var job bigquery.Job
+	f.decode(part, &job)
+	part, err = reader.NextPart()
+	require.NoError(f.t, err)
+	if f.insertErrors {
+		job.Status = &bigquery.JobStatus{State: jobStateDone, ErrorResult: &bigquery.ErrorProto{Reason: "backendError", Message: "try again"}}
+		return &job
+	}
+	scanner := bufio.NewScanner(part)
+	for scanner.Scan() {
+		values := map[string]any{}
+		require.NoError(f.t, json.Unmarshal(scanner.Bytes(), &values))
+		f.loaded = append(f.loaded, values)
+	}
+	job.Status = &bigquery.JobStatus{State: jobStateDone}
+	return &job
+}
+
+func (f *fakeBigQuery) decode(body io.Reader, v any) {
+	require.NoError(f.t, json.NewDecoder(body).Decode(v))
+}
+
+func (f *fakeBigQuery) reply(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	require.NoError(f.t, json.NewEncoder(w).Encode(v))
+}
+
+func (f *fakeBigQuery) putTable(name string, table *bigquery.Table) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.tables[name] = table
+}
+
+// table returns the table, the fake being called concurrently by the exporter.
+func (f *fakeBigQuery) table(name string) *bigquery.Table {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.tables[name]
+}
+
+func (f *fakeBigQuery) insertedRows() []map[string]any {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.inserted
+}
+
+func (f *fakeBigQuery) loadedRows() []map[string]any {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.loaded
+}
+
+// fail makes the fake reply with the insert and load job errors, or with the status code if
+// not 0.
+func (f *fakeBigQuery) fail(insertErrors bool, status int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.insertErrors = insertErrors
+	f.status = status
+}
+
+func (f *fakeBigQuery) config() *Config {
+	cfg := createDefaultConfig().(*Config)
+	cfg.ProjectID = "p"
+	cfg.Dataset = "d"
+	cfg.Endpoint = f.server.URL + "/bigquery/v2/"
+	cfg.Insecure = true
+	return cfg
+}
+
+func testLogs(count int) plog.Logs {
+	ld := plog.NewLogs()
+	rl := ld.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().PutStr("service.name", "checkout")
+	records := rl.ScopeLogs().AppendEmpty().LogRecords()
+	for i := 0; i < count; i++ {
+		lr := records.AppendEmpty()
+		lr.SetTimestamp(testTimestamp)
+		lr.SetSeverityText("INFO")
+		lr.Body().SetStr("paid")
+		lr.Attributes().PutStr("http.method", "POST")
+	}
+	return ld
+}
+
+func TestExporterCreatesTable(t *testing.T) {
+	fake := newFakeBigQuery(t)
+	cfg := fake.config()
+	cfg.Logs.Table = "logs"
+	cfg.Logs.PartitionExpiration = 48 * time.Hour
+	cfg.Logs.AttributeColumns = []string{"http.method"}
+	exp := newExporter(zap.NewNop(), cfg, cfg.Logs, logsSchema, "test")
+	require.NoError(t, exp.start(context.Background(), componenttest.NewNopHost()))
+	defer func() { require.NoError(t, exp.shutdown(context.Background())) }()
+
+	table := fake.table("logs")
+	require.NotNil(t, table)
+	assert.Equal(t, &bigquery.TimePartitioning{Type: "DAY", Field: "timestamp", ExpirationMs: 48 * 3600 * 1000}, table.TimePartitioning)
+	assert.Equal(t, &bigquery.Clustering{Fields: []string{"service_name", "severity_text"}}, table.Clustering)
+	require.Len(t, table.Schema.Fields, len(logsSchema)+1)
+	assert.Equal(t, "http_method", table.Schema.Fields[len(logsSchema)].Name)
+
+	require.NoError(t, exp.consumeLogs(context.Background(), testLogs(2)))
+	inserted := fake.insertedRows()
+	require.Len(t, inserted, 2)
+	assert.Equal(t, "2024-05-10T12:30:15.123456Z", inserted[0]["timestamp"])
+	assert.Equal(t, "POST", inserted[0]["http_method"])
+	assert.Equal(t, `{"http.method":"POST"}`, inserted[0]["attributes"])
+	assert.Equal(t, `"paid"`, inserted[0]["body"])
+}
+
+func TestExporterUpdatesSchema(t *testing.T) {
+	fake := newFakeBigQuery(t)
+	fake.putTable("otel_traces", &bigquery.Table{Schema: tableSchema(tracesSchema[:3])})
+	cfg := fake.config()
+	cfg.Traces.AttributeColumns = []string{"http.route"}
+	exp := newExporter(zap.NewNop(), cfg, cfg.Traces, tracesSchema, "test")
+	require.NoError(t, exp.start(context.Background(), componenttest.NewNopHost()))
+	require.NoError(t, exp.shutdown(context.Background()))
+
+	fields := fake.table("otel_traces").Schema.Fields
+	require.Len(t, fields, len(tracesSchema)+1)
+	assert.Equal(t, "http_route", fields[len(tracesSchema)].Name)
+	// the added columns are nullable, the existing rows having no value for them
+	assert.Equal(t, "trace_id", fields[3].Name)
+	assert.Equal(t, modeNullable, fields[3].Mode)
+	assert.False(t, exp.ignoreUnknownValues)
+}
+
+func TestExporterWithoutSchemaUpdates(t *testing.T) {
+	fake := newFakeBigQuery(t)
+	fake.putTable("otel_logs", &bigquery.Table{Schema: tableSchema(logsSchema[:3])})
+	cfg := fake.config()
+	cfg.UpdateSchema = false
+	exp := newExporter(zap.NewNop(), cfg, cfg.Logs, logsSchema, "test")
+	require.NoError(t, exp.start(context.Background(), componenttest.NewNopHost()))
+	require.NoError(t, exp.shutdown(context.Background()))
+
+	assert.Len(t, fake.table("otel_logs").Schema.Fields, 3)
+	assert.True(t, exp.ignoreUnknownValues)
+
+	cfg.CreateTables = false
+	exp = newExporter(zap.NewNop(), cfg, cfg.Traces, tracesSchema, "test")
+	assert.EqualError(t, exp.start(context.Background(), componenttest.NewNopHost()),
+		"the table p.d.otel_traces does not exist and 'create_tables' is disabled")
+	require.NoError(t, exp.shutdown(context.Background()))
+}
+
+func TestExporterInsertErrors(t *testing.T) {
+	fake := newFakeBigQuery(t)
+	exp := newExporter(zap.NewNop(), fake.config(), fake.config().Logs, logsSchema, "test")
+	require.NoError(t, exp.start(context.Background(), componenttest.NewNopHost()))
+	defer func() { require.NoError(t, exp.shutdown(context.Background())) }()
+
+	fake.fail(true, 0)
+	err := exp.consumeLogs(context.Background(), testLogs(2))
+	assert.EqualError(t, err, "Permanent error: failed to insert 2 rows, the first one with invalid: no such field")
+	assert.True(t, consumererror.IsPermanent(err))
+
+	fake.fail(false, http.StatusBadRequest)
+	err = exp.consumeLogs(context.Background(), testLogs(1))
+	assert.True(t, consumererror.IsPermanent(err))
+
+	fake.fail(false, http.StatusServiceUnavailable)
+	err = exp.consumeLogs(context.Background(), testLogs(1))
+	require.Error(t, err)
+	assert.False(t, consumererror.IsPermanent(err))
+}
+
+func TestExporterLoadJobs(t *testing.T) {
+	fake := newFakeBigQuery(t)
+	cfg := fake.config()
+	cfg.WriteMode = writeModeLoad
+	cfg.Load = LoadConfig{FlushInterval: time.Hour, MaxRows: 3}
+	exp := newExporter(zap.NewNop(), cfg, cfg.Logs, logsSchema, "test")
+	require.NoError(t, exp.start(context.Background(), componenttest.NewNopHost()))
+
+	// the rows are loaded once max rows are buffered, the exports waiting for the job
+	var wg sync.WaitGroup
+	for i := 0; i < 2; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, exp.consumeLogs(context.Background(), testLogs(2)))
+		}()
+	}
+	wg.Wait()
+	require.Len(t, fake.loadedRows(), 4)
+	assert.Empty(t, fake.insertedRows())
+	assert.Equal(t, map[string]any{"http.method": "POST"}, fake.loadedRows()[0]["attributes"])
+	assert.Equal(t, "paid", fake.loadedRows()[0]["body"])
+
+	// the rows of the exports that stopped waiting before the job are not loaded
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, exp.consumeLogs(ctx, testLogs(1)), context.DeadlineExceeded)
+
+	// the remaining rows are loaded at shutdown
+	errs := make(chan error, 1)
+	go func() { errs <- exp.consumeLogs(context.Background(), testLogs(1)) }()
+	assert.Eventually(t, func() bool {
+		exp.loader.mu.Lock()
+		defer exp.loader.mu.Unlock()
+		return exp.loader.batch.rows == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	require.NoError(t, exp.shutdown(context.Background()))
+	assert.NoError(t, <-errs)
+	assert.Len(t, fake.loadedRows(), 5)
+	assert.ErrorIs(t, exp.consumeLogs(context.Background(), testLogs(1)), errLoaderStopped)
+}
+
+func TestExporterLoadJobFailure(t *testing.T) {
+	fake := newFakeBigQuery(t)
+	cfg := fake.config()
+	cfg.WriteMode = writeModeLoad
+	cfg.Load = LoadConfig{FlushInterval: time.Hour, MaxRows: 100}
+	cfg.QueueSettings.NumConsumers = 1
+	exp := newExporter(zap.NewNop(), cfg, cfg.Logs, logsSchema, "test")
+	require.NoError(t, exp.start(context.Background(), componenttest.NewNopHost()))
+	defer func() { require.NoError(t, exp.shutdown(context.Background())) }()
+
+	// the job starts once all the consumers of the queue wait for it, and its failure is retried
+	fake.fail(true, 0)
+	err := exp.consumeLogs(context.Background(), testLogs(2))
+	assert.ErrorContains(t, err, "failed with backendError: try again")
+	assert.False(t, consumererror.IsPermanent(err))
+	assert.Empty(t, fake.loadedRows())
+
+	fake.fail(false, 0)
+	require.NoError(t, exp.consumeLogs(context.Background(), testLogs(2)))
+	assert.Len(t, fake.loadedRows(), 2)
+}
+
+func TestLoaderBufferFull(t *testing.T) {
+	l := &loader{maxRows: 2, batch: newLoadBatch(), flush: make(chan struct{}, 1)}
+	l.batch.rows = 4
+	err := l.add(context.Background(), []row{{}})
+	assert.ErrorIs(t, err, errLoadBufferFull)
+	assert.False(t, consumererror.IsPermanent(err))
+}
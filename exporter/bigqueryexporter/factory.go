@@ -0,0 +1,134 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package bigqueryexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/bigqueryexporter"
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configretry"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/exporter"
+	"go.opentelemetry.io/collector/exporter/exporterhelper"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/bigqueryexporter/internal/metadata"
+)
+
+const (
+	defaultTimeout       = 30 * time.Second
+	defaultFlushInterval = time.Minute
+	defaultMaxRows       = 100000
+	defaultPartitioning  = "day"
+)
+
+// NewFactory creates a factory for the BigQuery exporter.
+func NewFactory() exporter.Factory {
+	return exporter.NewFactory(
+		metadata.Type,
+		createDefaultConfig,
+		exporter.WithTraces(createTracesExporter, metadata.TracesStability),
+		exporter.WithMetrics(createMetricsExporter, metadata.MetricsStability),
+		exporter.WithLogs(createLogsExporter, metadata.LogsStability))
+}
+
+// createDefaultConfig creates the default configuration for exporter.
+func createDefaultConfig() component.Config {
+	return &Config{
+		TimeoutSettings: exporterhelper.TimeoutSettings{Timeout: defaultTimeout},
+		QueueSettings:   exporterhelper.NewDefaultQueueSettings(),
+		BackOffConfig:   configretry.NewDefaultBackOffConfig(),
+		UserAgent:       "opentelemetry-collector-contrib {{version}}",
+		WriteMode:       writeModeStreaming,
+		Load: LoadConfig{
+			FlushInterval: defaultFlushInterval,
+			MaxRows:       defaultMaxRows,
+		},
+		CreateTables: true,
+		UpdateSchema: true,
+		Logs: TableConfig{
+			Table:        "otel_logs",
+			Partitioning: defaultPartitioning,
+			Clustering:   []string{"service_name", "severity_text"},
+		},
+		Metrics: TableConfig{
+			Table:        "otel_metrics",
+			Partitioning: defaultPartitioning,
+			Clustering:   []string{"service_name", "metric_name"},
+		},
+		Traces: TableConfig{
+			Table:        "otel_traces",
+			Partitioning: defaultPartitioning,
+			Clustering:   []string{"service_name", "name"},
+		},
+	}
+}
+
+func createTracesExporter(
+	ctx context.Context,
+	set exporter.CreateSettings,
+	cfg component.Config) (exporter.Traces, error) {
+
+	eCfg := cfg.(*Config)
+	exp := newExporter(set.Logger, eCfg, eCfg.Traces, tracesSchema, userAgent(set, eCfg))
+	return exporterhelper.NewTracesExporter(
+		ctx,
+		set,
+		cfg,
+		exp.consumeTraces,
+		exporterhelper.WithCapabilities(consumer.Capabilities{MutatesData: false}),
+		exporterhelper.WithTimeout(eCfg.TimeoutSettings),
+		exporterhelper.WithRetry(eCfg.BackOffConfig),
+		exporterhelper.WithQueue(eCfg.QueueSettings),
+		exporterhelper.WithStart(exp.start),
+		exporterhelper.WithShutdown(exp.shutdown),
+	)
+}
+
+func createMetricsExporter(
+	ctx context.Context,
+	set exporter.CreateSettings,
+	cfg component.Config) (exporter.Metrics, error) {
+
+	eCfg := cfg.(*Config)
+	exp := newExporter(set.Logger, eCfg, eCfg.Metrics, metricsSchema, userAgent(set, eCfg))
+	return exporterhelper.NewMetricsExporter(
+		ctx,
+		set,
+		cfg,
+		exp.consumeMetrics,
+		exporterhelper.WithCapabilities(consumer.Capabilities{MutatesData: false}),
+		exporterhelper.WithTimeout(eCfg.TimeoutSettings),
+		exporterhelper.WithRetry(eCfg.BackOffConfig),
+		exporterhelper.WithQueue(eCfg.QueueSettings),
+		exporterhelper.WithStart(exp.start),
+		exporterhelper.WithShutdown(exp.shutdown),
+	)
+}
+
+func createLogsExporter(
+	ctx context.Context,
+	set exporter.CreateSettings,
+	cfg component.Config) (exporter.Logs, error) {
+
+	eCfg := cfg.(*Config)
+	exp := newExporter(set.Logger, eCfg, eCfg.Logs, logsSchema, userAgent(set, eCfg))
+	return exporterhelper.NewLogsExporter(
+		ctx,
+		set,
+		cfg,
+		exp.consumeLogs,
+		exporterhelper.WithCapabilities(consumer.Capabilities{MutatesData: false}),
+		exporterhelper.WithTimeout(eCfg.TimeoutSettings),
+		exporterhelper.WithRetry(eCfg.BackOffConfig),
+		exporterhelper.WithQueue(eCfg.QueueSettings),
+		exporterhelper.WithStart(exp.start),
+		exporterhelper.WithShutdown(exp.shutdown),
+	)
+}
+
+func userAgent(set exporter.CreateSettings, cfg *Config) string {
+	return strings.ReplaceAll(cfg.UserAgent, "{{version}}", set.BuildInfo.Version)
+}
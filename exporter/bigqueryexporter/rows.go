@@ -0,0 +1,310 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package bigqueryexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/bigqueryexporter"
+
+import (
+	"encoding/json"
+	"math"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+const (
+	serviceNameAttribute = "service.name"
+
+	// timestampLayout is the layout of the timestamps of the rows, BigQuery storing microseconds.
+	timestampLayout = "2006-01-02T15:04:05.000000Z07:00"
+)
+
+// row is a row of a table, by column name.
+type row map[string]any
+
+// jsonValue is the value of a JSON column. The streaming inserts take it as a JSON string, the
+// load jobs as a JSON value.
+type jsonValue struct {
+	value any
+}
+
+func (v jsonValue) MarshalJSON() ([]byte, error) {
+	return json.Marshal(v.value)
+}
+
+// rowBuilder converts the telemetry to the rows of a table.
+type rowBuilder struct {
+	attributeColumns []attributeColumn
+}
+
+func newRowBuilder(cfg TableConfig) *rowBuilder {
+	return &rowBuilder{attributeColumns: attributeColumns(cfg.AttributeColumns)}
+}
+
+func (b *rowBuilder) logsRows(ld plog.Logs) []row {
+	var rows []row
+	for i := 0; i < ld.ResourceLogs().Len(); i++ {
+		rl := ld.ResourceLogs().At(i)
+		resource := rl.Resource()
+		for j := 0; j < rl.ScopeLogs().Len(); j++ {
+			sl := rl.ScopeLogs().At(j)
+			for k := 0; k < sl.LogRecords().Len(); k++ {
+				lr := sl.LogRecords().At(k)
+				timestamp := lr.Timestamp()
+				if timestamp == 0 {
+					timestamp = lr.ObservedTimestamp()
+				}
+				r := row{
+					timestampColumn:   formatTimestamp(timestamp),
+					"trace_flags":     int64(lr.Flags()),
+					"severity_text":   lr.SeverityText(),
+					"severity_number": int64(lr.SeverityNumber()),
+					"body":            jsonValue{lr.Body().AsRaw()},
+				}
+				if observed := lr.ObservedTimestamp(); observed != 0 {
+					r["observed_timestamp"] = formatTimestamp(observed)
+				}
+				if traceID := lr.TraceID(); !traceID.IsEmpty() {
+					r["trace_id"] = traceID.String()
+				}
+				if spanID := lr.SpanID(); !spanID.IsEmpty() {
+					r["span_id"] = spanID.String()
+				}
+				b.putCommon(r, resource, sl.Scope(), lr.Attributes())
+				rows = append(rows, r)
+			}
+		}
+	}
+	return rows
+}
+
+func (b *rowBuilder) tracesRows(td ptrace.Traces) []row {
+	var rows []row
+	for i := 0; i < td.ResourceSpans().Len(); i++ {
+		rs := td.ResourceSpans().At(i)
+		resource := rs.Resource()
+		for j := 0; j < rs.ScopeSpans().Len(); j++ {
+			ss := rs.ScopeSpans().At(j)
+			for k := 0; k < ss.Spans().Len(); k++ {
+				span := ss.Spans().At(k)
+				r := row{
+					timestampColumn:  formatTimestamp(span.StartTimestamp()),
+					"end_timestamp":  formatTimestamp(span.EndTimestamp()),
+					"duration_ns":    int64(span.EndTimestamp()) - int64(span.StartTimestamp()),
+					"trace_id":       span.TraceID().String(),
+					"span_id":        span.SpanID().String(),
+					"trace_state":    span.TraceState().AsRaw(),
+					"name":           span.Name(),
+					"kind":           span.Kind().String(),
+					"status_code":    span.Status().Code().String(),
+					"status_message": span.Status().Message(),
+				}
+				if parentSpanID := span.ParentSpanID(); !parentSpanID.IsEmpty() {
+					r["parent_span_id"] = parentSpanID.String()
+				}
+				if span.Events().Len() > 0 {
+					r["events"] = jsonValue{spanEvents(span.Events())}
+				}
+				if span.Links().Len() > 0 {
+					r["links"] = jsonValue{spanLinks(span.Links())}
+				}
+				b.putCommon(r, resource, ss.Scope(), span.Attributes())
+				rows = append(rows, r)
+			}
+		}
+	}
+	return rows
+}
+
+func spanEvents(events ptrace.SpanEventSlice) []map[string]any {
+	values := make([]map[string]any, 0, events.Len())
+	for i := 0; i < events.Len(); i++ {
+		event := events.At(i)
+		values = append(values, map[string]any{
+			"timestamp":  formatTimestamp(event.Timestamp()),
+			"name":       event.Name(),
+			"attributes": event.Attributes().AsRaw(),
+		})
+	}
+	return values
+}
+
+func spanLinks(links ptrace.SpanLinkSlice) []map[string]any {
+	values := make([]map[string]any, 0, links.Len())
+	for i := 0; i < links.Len(); i++ {
+		link := links.At(i)
+		values = append(values, map[string]any{
+			"trace_id":    link.TraceID().String(),
+			"span_id":     link.SpanID().String(),
+			"trace_state": link.TraceState().AsRaw(),
+			"attributes":  link.Attributes().AsRaw(),
+		})
+	}
+	return values
+}
+
+// metricsRows returns a row per data point. The exemplars are not exported.
+func (b *rowBuilder) metricsRows(md pmetric.Metrics) []row {
+	var rows []row
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		rm := md.ResourceMetrics().At(i)
+		resource := rm.Resource()
+		for j := 0; j < rm.ScopeMetrics().Len(); j++ {
+			sm := rm.ScopeMetrics().At(j)
+			for k := 0; k < sm.Metrics().Len(); k++ {
+				metric := sm.Metrics().At(k)
+				newRow := func(timestamp, startTimestamp pcommon.Timestamp, attributes pcommon.Map) row {
+					r := row{
+						timestampColumn:      formatTimestamp(timestamp),
+						"metric_name":        metric.Name(),
+						"metric_description": metric.Description(),
+						"metric_unit":        metric.Unit(),
+						"metric_type":        metric.Type().String(),
+					}
+					if startTimestamp != 0 {
+						r["start_timestamp"] = formatTimestamp(startTimestamp)
+					}
+					b.putCommon(r, resource, sm.Scope(), attributes)
+					rows = append(rows, r)
+					return r
+				}
+				switch metric.Type() {
+				case pmetric.MetricTypeGauge:
+					dps := metric.Gauge().DataPoints()
+					for l := 0; l < dps.Len(); l++ {
+						dp := dps.At(l)
+						putValue(newRow(dp.Timestamp(), dp.StartTimestamp(), dp.Attributes()), dp)
+					}
+				case pmetric.MetricTypeSum:
+					dps := metric.Sum().DataPoints()
+					for l := 0; l < dps.Len(); l++ {
+						dp := dps.At(l)
+						r := newRow(dp.Timestamp(), dp.StartTimestamp(), dp.Attributes())
+						r["is_monotonic"] = metric.Sum().IsMonotonic()
+						r["aggregation_temporality"] = metric.Sum().AggregationTemporality().String()
+						putValue(r, dp)
+					}
+				case pmetric.MetricTypeHistogram:
+					dps := metric.Histogram().DataPoints()
+					for l := 0; l < dps.Len(); l++ {
+						dp := dps.At(l)
+						r := newRow(dp.Timestamp(), dp.StartTimestamp(), dp.Attributes())
+						r["aggregation_temporality"] = metric.Histogram().AggregationTemporality().String()
+						r["count"] = int64(dp.Count())
+						r["bucket_counts"] = uint64s(dp.BucketCounts().AsRaw())
+						r["explicit_bounds"] = finiteFloats(dp.ExplicitBounds().AsRaw())
+						if dp.HasSum() {
+							putFloat(r, "sum", dp.Sum())
+						}
+						if dp.HasMin() {
+							putFloat(r, "min", dp.Min())
+						}
+						if dp.HasMax() {
+							putFloat(r, "max", dp.Max())
+						}
+					}
+				case pmetric.MetricTypeExponentialHistogram:
+					dps := metric.ExponentialHistogram().DataPoints()
+					for l := 0; l < dps.Len(); l++ {
+						dp := dps.At(l)
+						r := newRow(dp.Timestamp(), dp.StartTimestamp(), dp.Attributes())
+						r["aggregation_temporality"] = metric.ExponentialHistogram().AggregationTemporality().String()
+						r["count"] = int64(dp.Count())
+						r["scale"] = int64(dp.Scale())
+						r["zero_count"] = int64(dp.ZeroCount())
+						r["positive_offset"] = int64(dp.Positive().Offset())
+						r["positive_bucket_counts"] = uint64s(dp.Positive().BucketCounts().AsRaw())
+						r["negative_offset"] = int64(dp.Negative().Offset())
+						r["negative_bucket_counts"] = uint64s(dp.Negative().BucketCounts().AsRaw())
+						if dp.HasSum() {
+							putFloat(r, "sum", dp.Sum())
+						}
+						if dp.HasMin() {
+							putFloat(r, "min", dp.Min())
+						}
+						if dp.HasMax() {
+							putFloat(r, "max", dp.Max())
+						}
+					}
+				case pmetric.MetricTypeSummary:
+					dps := metric.Summary().DataPoints()
+					for l := 0; l < dps.Len(); l++ {
+						dp := dps.At(l)
+						r := newRow(dp.Timestamp(), dp.StartTimestamp(), dp.Attributes())
+						r["count"] = int64(dp.Count())
+						putFloat(r, "sum", dp.Sum())
+						quantiles := make([]map[string]any, 0, dp.QuantileValues().Len())
+						for m := 0; m < dp.QuantileValues().Len(); m++ {
+							quantile := dp.QuantileValues().At(m)
+							quantiles = append(quantiles, map[string]any{"quantile": quantile.Quantile(), "value": quantile.Value()})
+						}
+						r["quantiles"] = jsonValue{quantiles}
+					}
+				}
+			}
+		}
+	}
+	return rows
+}
+
+// putValue sets the value of a gauge or a sum data point. A NaN or infinite value is left NULL,
+// BigQuery rejecting it.
+func putValue(r row, dp pmetric.NumberDataPoint) {
+	switch dp.ValueType() {
+	case pmetric.NumberDataPointValueTypeDouble:
+		putFloat(r, "value", dp.DoubleValue())
+	case pmetric.NumberDataPointValueTypeInt:
+		r["value"] = float64(dp.IntValue())
+	}
+}
+
+func putFloat(r row, column string, value float64) {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return
+	}
+	r[column] = value
+}
+
+// uint64s converts the counts to int64, the type of the BigQuery integers.
+func uint64s(values []uint64) []int64 {
+	converted := make([]int64, 0, len(values))
+	for _, value := range values {
+		converted = append(converted, int64(value))
+	}
+	return converted
+}
+
+// finiteFloats returns the finite values, BigQuery rejecting NaN and infinite values.
+func finiteFloats(values []float64) []float64 {
+	finite := make([]float64, 0, len(values))
+	for _, value := range values {
+		if !math.IsNaN(value) && !math.IsInf(value, 0) {
+			finite = append(finite, value)
+		}
+	}
+	return finite
+}
+
+// putCommon sets the columns shared by the tables: the service, the scope, the attributes, and
+// the attribute columns.
+func (b *rowBuilder) putCommon(r row, resource pcommon.Resource, scope pcommon.InstrumentationScope, attributes pcommon.Map) {
+	if serviceName, ok := resource.Attributes().Get(serviceNameAttribute); ok {
+		r["service_name"] = serviceName.AsString()
+	}
+	r["scope_name"] = scope.Name()
+	r["scope_version"] = scope.Version()
+	r["attributes"] = jsonValue{attributes.AsRaw()}
+	r["resource_attributes"] = jsonValue{resource.Attributes().AsRaw()}
+	for _, column := range b.attributeColumns {
+		if value, ok := attributes.Get(column.attribute); ok {
+			r[column.column] = value.AsString()
+		} else if value, ok := resource.Attributes().Get(column.attribute); ok {
+			r[column.column] = value.AsString()
+		}
+	}
+}
+
+func formatTimestamp(timestamp pcommon.Timestamp) string {
+	return timestamp.AsTime().UTC().Format(timestampLayout)
+}
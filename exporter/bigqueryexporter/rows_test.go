@@ -0,0 +1,180 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package bigqueryexporter
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+var testTimestamp = pcommon.NewTimestampFromTime(time.Date(2024, 5, 10, 12, 30, 15, 123456789, time.UTC))
+
+func TestColumnName(t *testing.T) {
+	assert.Equal(t, "k8s_namespace_name", columnName("k8s.namespace.name"))
+	assert.Equal(t, "http_request_method", columnName("http.request.method"))
+	assert.Equal(t, "_1st_try", columnName("1st-try"))
+	assert.Equal(t, "caf_", columnName("café"))
+}
+
+func TestLogsRows(t *testing.T) {
+	ld := plog.NewLogs()
+	rl := ld.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().PutStr("service.name", "checkout")
+	rl.Resource().Attributes().PutStr("k8s.namespace.name", "shop")
+	sl := rl.ScopeLogs().AppendEmpty()
+	sl.Scope().SetName("logger")
+	lr := sl.LogRecords().AppendEmpty()
+	lr.SetObservedTimestamp(testTimestamp)
+	lr.SetSeverityText("WARN")
+	lr.SetSeverityNumber(plog.SeverityNumberWarn)
+	lr.Body().SetStr("payment declined")
+	lr.Attributes().PutInt("http.status_code", 402)
+	lr.SetTraceID([16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16})
+	lr.SetSpanID([8]byte{1, 2, 3, 4, 5, 6, 7, 8})
+
+	rows := newRowBuilder(TableConfig{AttributeColumns: []string{"k8s.namespace.name", "http.status_code"}}).logsRows(ld)
+	require.Len(t, rows, 1)
+	assert.Equal(t, row{
+		"timestamp":           "2024-05-10T12:30:15.123456Z",
+		"observed_timestamp":  "2024-05-10T12:30:15.123456Z",
+		"trace_id":            "0102030405060708090a0b0c0d0e0f10",
+		"span_id":             "0102030405060708",
+		"trace_flags":         int64(0),
+		"severity_text":       "WARN",
+		"severity_number":     int64(plog.SeverityNumberWarn),
+		"body":                jsonValue{"payment declined"},
+		"service_name":        "checkout",
+		"scope_name":          "logger",
+		"scope_version":       "",
+		"attributes":          jsonValue{map[string]any{"http.status_code": int64(402)}},
+		"resource_attributes": jsonValue{map[string]any{"service.name": "checkout", "k8s.namespace.name": "shop"}},
+		"k8s_namespace_name":  "shop",
+		"http_status_code":    "402",
+	}, rows[0])
+}
+
+func TestTracesRows(t *testing.T) {
+	td := ptrace.NewTraces()
+	rs := td.ResourceSpans().AppendEmpty()
+	rs.Resource().Attributes().PutStr("service.name", "checkout")
+	span := rs.ScopeSpans().AppendEmpty().Spans().AppendEmpty()
+	span.SetName("POST /pay")
+	span.SetKind(ptrace.SpanKindServer)
+	span.SetTraceID([16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16})
+	span.SetSpanID([8]byte{1, 2, 3, 4, 5, 6, 7, 8})
+	span.SetStartTimestamp(testTimestamp)
+	span.SetEndTimestamp(testTimestamp + 1500)
+	span.Status().SetCode(ptrace.StatusCodeError)
+	span.Status().SetMessage("declined")
+	event := span.Events().AppendEmpty()
+	event.SetName("retry")
+	event.SetTimestamp(testTimestamp + 1000)
+	link := span.Links().AppendEmpty()
+	link.SetTraceID([16]byte{16})
+	link.SetSpanID([8]byte{8})
+
+	rows := newRowBuilder(TableConfig{}).tracesRows(td)
+	require.Len(t, rows, 1)
+	assert.Equal(t, row{
+		"timestamp":           "2024-05-10T12:30:15.123456Z",
+		"end_timestamp":       "2024-05-10T12:30:15.123458Z",
+		"duration_ns":         int64(1500),
+		"trace_id":            "0102030405060708090a0b0c0d0e0f10",
+		"span_id":             "0102030405060708",
+		"trace_state":         "",
+		"name":                "POST /pay",
+		"kind":                "Server",
+		"status_code":         "Error",
+		"status_message":      "declined",
+		"service_name":        "checkout",
+		"scope_name":          "",
+		"scope_version":       "",
+		"attributes":          jsonValue{map[string]any{}},
+		"resource_attributes": jsonValue{map[string]any{"service.name": "checkout"}},
+		"events": jsonValue{[]map[string]any{
+			{"timestamp": "2024-05-10T12:30:15.123457Z", "name": "retry", "attributes": map[string]any{}},
+		}},
+		"links": jsonValue{[]map[string]any{
+			{"trace_id": "10000000000000000000000000000000", "span_id": "0800000000000000", "trace_state": "", "attributes": map[string]any{}},
+		}},
+	}, rows[0])
+}
+
+func TestMetricsRows(t *testing.T) {
+	md := pmetric.NewMetrics()
+	metrics := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics()
+
+	gauge := metrics.AppendEmpty()
+	gauge.SetName("queue.size")
+	dp := gauge.SetEmptyGauge().DataPoints().AppendEmpty()
+	dp.SetTimestamp(testTimestamp)
+	dp.SetIntValue(12)
+	dp = gauge.Gauge().DataPoints().AppendEmpty()
+	dp.SetTimestamp(testTimestamp)
+	dp.SetDoubleValue(math.NaN())
+
+	sum := metrics.AppendEmpty()
+	sum.SetName("requests")
+	sum.SetEmptySum().SetIsMonotonic(true)
+	sum.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	dp = sum.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(testTimestamp - 1000)
+	dp.SetTimestamp(testTimestamp)
+	dp.SetDoubleValue(42.5)
+
+	histogram := metrics.AppendEmpty()
+	histogram.SetName("latency")
+	histogram.SetEmptyHistogram().SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+	hdp := histogram.Histogram().DataPoints().AppendEmpty()
+	hdp.SetTimestamp(testTimestamp)
+	hdp.SetCount(3)
+	hdp.SetSum(0.6)
+	hdp.SetMax(0.3)
+	hdp.BucketCounts().FromRaw([]uint64{1, 2, 0})
+	hdp.ExplicitBounds().FromRaw([]float64{0.1, 0.5})
+
+	summary := metrics.AppendEmpty()
+	summary.SetName("gc.pause")
+	sdp := summary.SetEmptySummary().DataPoints().AppendEmpty()
+	sdp.SetTimestamp(testTimestamp)
+	sdp.SetCount(2)
+	sdp.SetSum(10)
+	quantile := sdp.QuantileValues().AppendEmpty()
+	quantile.SetQuantile(0.99)
+	quantile.SetValue(8)
+
+	rows := newRowBuilder(TableConfig{}).metricsRows(md)
+	require.Len(t, rows, 5)
+	for _, r := range rows {
+		assert.Equal(t, "2024-05-10T12:30:15.123456Z", r["timestamp"])
+	}
+	assert.Equal(t, float64(12), rows[0]["value"])
+	assert.Equal(t, "Gauge", rows[0]["metric_type"])
+	assert.NotContains(t, rows[1], "value")
+
+	assert.Equal(t, "Sum", rows[2]["metric_type"])
+	assert.Equal(t, "2024-05-10T12:30:15.123455Z", rows[2]["start_timestamp"])
+	assert.Equal(t, true, rows[2]["is_monotonic"])
+	assert.Equal(t, "Cumulative", rows[2]["aggregation_temporality"])
+	assert.Equal(t, 42.5, rows[2]["value"])
+
+	assert.Equal(t, "Histogram", rows[3]["metric_type"])
+	assert.Equal(t, int64(3), rows[3]["count"])
+	assert.Equal(t, 0.6, rows[3]["sum"])
+	assert.Equal(t, 0.3, rows[3]["max"])
+	assert.NotContains(t, rows[3], "min")
+	assert.Equal(t, []int64{1, 2, 0}, rows[3]["bucket_counts"])
+	assert.Equal(t, []float64{0.1, 0.5}, rows[3]["explicit_bounds"])
+
+	assert.Equal(t, "Summary", rows[4]["metric_type"])
+	assert.Equal(t, jsonValue{[]map[string]any{{"quantile": 0.99, "value": float64(8)}}}, rows[4]["quantiles"])
+}
@@ -0,0 +1,164 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package bigqueryexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/bigqueryexporter"
+
+import (
+	"strings"
+	"unicode"
+
+	bigquery "google.golang.org/api/bigquery/v2"
+)
+
+const (
+	typeString    = "STRING"
+	typeInteger   = "INTEGER"
+	typeFloat     = "FLOAT"
+	typeBoolean   = "BOOLEAN"
+	typeTimestamp = "TIMESTAMP"
+	typeJSON      = "JSON"
+
+	modeNullable = "NULLABLE"
+	modeRequired = "REQUIRED"
+	modeRepeated = "REPEATED"
+
+	// timestampColumn is the column partitioning the tables.
+	timestampColumn = "timestamp"
+)
+
+// column is a column of the schema of a table.
+type column struct {
+	name        string
+	typ         string
+	mode        string
+	description string
+}
+
+func (c column) field() *bigquery.TableFieldSchema {
+	return &bigquery.TableFieldSchema{Name: c.name, Type: c.typ, Mode: c.mode, Description: c.description}
+}
+
+var logsSchema = []column{
+	{name: timestampColumn, typ: typeTimestamp, mode: modeRequired, description: "Time when the event occurred, the observed time if unset."},
+	{name: "observed_timestamp", typ: typeTimestamp, mode: modeNullable, description: "Time when the event was observed."},
+	{name: "trace_id", typ: typeString, mode: modeNullable},
+	{name: "span_id", typ: typeString, mode: modeNullable},
+	{name: "trace_flags", typ: typeInteger, mode: modeNullable},
+	{name: "severity_text", typ: typeString, mode: modeNullable},
+	{name: "severity_number", typ: typeInteger, mode: modeNullable},
+	{name: "body", typ: typeJSON, mode: modeNullable},
+	{name: "service_name", typ: typeString, mode: modeNullable},
+	{name: "scope_name", typ: typeString, mode: modeNullable},
+	{name: "scope_version", typ: typeString, mode: modeNullable},
+	{name: "attributes", typ: typeJSON, mode: modeNullable},
+	{name: "resource_attributes", typ: typeJSON, mode: modeNullable},
+}
+
+var tracesSchema = []column{
+	{name: timestampColumn, typ: typeTimestamp, mode: modeRequired, description: "Start time of the span."},
+	{name: "end_timestamp", typ: typeTimestamp, mode: modeNullable},
+	{name: "duration_ns", typ: typeInteger, mode: modeNullable},
+	{name: "trace_id", typ: typeString, mode: modeRequired},
+	{name: "span_id", typ: typeString, mode: modeRequired},
+	{name: "parent_span_id", typ: typeString, mode: modeNullable},
+	{name: "trace_state", typ: typeString, mode: modeNullable},
+	{name: "name", typ: typeString, mode: modeNullable},
+	{name: "kind", typ: typeString, mode: modeNullable},
+	{name: "status_code", typ: typeString, mode: modeNullable},
+	{name: "status_message", typ: typeString, mode: modeNullable},
+	{name: "service_name", typ: typeString, mode: modeNullable},
+	{name: "scope_name", typ: typeString, mode: modeNullable},
+	{name: "scope_version", typ: typeString, mode: modeNullable},
+	{name: "attributes", typ: typeJSON, mode: modeNullable},
+	{name: "resource_attributes", typ: typeJSON, mode: modeNullable},
+	{name: "events", typ: typeJSON, mode: modeNullable},
+	{name: "links", typ: typeJSON, mode: modeNullable},
+}
+
+var metricsSchema = []column{
+	{name: timestampColumn, typ: typeTimestamp, mode: modeRequired},
+	{name: "start_timestamp", typ: typeTimestamp, mode: modeNullable},
+	{name: "metric_name", typ: typeString, mode: modeRequired},
+	{name: "metric_description", typ: typeString, mode: modeNullable},
+	{name: "metric_unit", typ: typeString, mode: modeNullable},
+	{name: "metric_type", typ: typeString, mode: modeRequired, description: "Gauge, Sum, Histogram, ExponentialHistogram or Summary."},
+	{name: "is_monotonic", typ: typeBoolean, mode: modeNullable},
+	{name: "aggregation_temporality", typ: typeString, mode: modeNullable},
+	{name: "value", typ: typeFloat, mode: modeNullable, description: "Value of the gauge and sum data points."},
+	{name: "count", typ: typeInteger, mode: modeNullable},
+	{name: "sum", typ: typeFloat, mode: modeNullable},
+	{name: "min", typ: typeFloat, mode: modeNullable},
+	{name: "max", typ: typeFloat, mode: modeNullable},
+	{name: "bucket_counts", typ: typeInteger, mode: modeRepeated},
+	{name: "explicit_bounds", typ: typeFloat, mode: modeRepeated},
+	{name: "quantiles", typ: typeJSON, mode: modeNullable},
+	{name: "scale", typ: typeInteger, mode: modeNullable},
+	{name: "zero_count", typ: typeInteger, mode: modeNullable},
+	{name: "positive_offset", typ: typeInteger, mode: modeNullable},
+	{name: "positive_bucket_counts", typ: typeInteger, mode: modeRepeated},
+	{name: "negative_offset", typ: typeInteger, mode: modeNullable},
+	{name: "negative_bucket_counts", typ: typeInteger, mode: modeRepeated},
+	{name: "service_name", typ: typeString, mode: modeNullable},
+	{name: "scope_name", typ: typeString, mode: modeNullable},
+	{name: "scope_version", typ: typeString, mode: modeNullable},
+	{name: "attributes", typ: typeJSON, mode: modeNullable},
+	{name: "resource_attributes", typ: typeJSON, mode: modeNullable},
+}
+
+// attributeColumn is an attribute written to its own column.
+type attributeColumn struct {
+	attribute string
+	column    string
+}
+
+// attributeColumns returns the columns of the attributes.
+func attributeColumns(attributes []string) []attributeColumn {
+	columns := make([]attributeColumn, 0, len(attributes))
+	for _, attribute := range attributes {
+		columns = append(columns, attributeColumn{attribute: attribute, column: columnName(attribute)})
+	}
+	return columns
+}
+
+// tableColumns returns the columns of the schema followed by the columns of the attributes.
+func tableColumns(schema []column, attributes []string) []column {
+	columns := make([]column, 0, len(schema)+len(attributes))
+	columns = append(columns, schema...)
+	for _, attribute := range attributeColumns(attributes) {
+		columns = append(columns, column{
+			name:        attribute.column,
+			typ:         typeString,
+			mode:        modeNullable,
+			description: "Value of the attribute " + attribute.attribute + ".",
+		})
+	}
+	return columns
+}
+
+// tableSchema returns the BigQuery schema of the columns.
+func tableSchema(columns []column) *bigquery.TableSchema {
+	fields := make([]*bigquery.TableFieldSchema, 0, len(columns))
+	for _, c := range columns {
+		fields = append(fields, c.field())
+	}
+	return &bigquery.TableSchema{Fields: fields}
+}
+
+// columnName returns the name of the column of an attribute: the letters, the digits and the
+// underscores are kept, the other characters are replaced with underscores, e.g.
+// "k8s.namespace.name" becomes "k8s_namespace_name".
+func columnName(attribute string) string {
+	var b strings.Builder
+	for i, r := range attribute {
+		switch {
+		case r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_'):
+			if i == 0 && unicode.IsDigit(r) {
+				b.WriteRune('_')
+			}
+			b.WriteRune(r)
+		default:
+			b.WriteRune('_')
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,287 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package bigqueryexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/bigqueryexporter"
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.uber.org/zap"
+	bigquery "google.golang.org/api/bigquery/v2"
+	"google.golang.org/api/googleapi"
+)
+
+const (
+	// maxInsertRows is the number of rows of a streaming insert request, as recommended by
+	// BigQuery.
+	maxInsertRows = 500
+
+	jobStateDone = "DONE"
+)
+
+// jobPollInterval is the interval between the checks of the status of a load job.
+var jobPollInterval = time.Second
+
+var (
+	errLoadBufferFull = errors.New("too many rows waiting for a load job")
+	errLoaderStopped  = errors.New("the exporter is stopped")
+)
+
+// insertRows writes the rows with streaming inserts. The invalid rows are dropped, the other
+// rows of the request being inserted.
+func (e *bigQueryExporter) insertRows(ctx context.Context, rows []row) error {
+	var errs []error
+	for start := 0; start < len(rows); start += maxInsertRows {
+		end := min(start+maxInsertRows, len(rows))
+		request := &bigquery.TableDataInsertAllRequest{
+			SkipInvalidRows:     true,
+			IgnoreUnknownValues: e.ignoreUnknownValues,
+			Rows:                make([]*bigquery.TableDataInsertAllRequestRows, 0, end-start),
+		}
+		for _, r := range rows[start:end] {
+			values, err := streamingValues(r)
+			if err != nil {
+				errs = append(errs, consumererror.NewPermanent(err))
+				continue
+			}
+			request.Rows = append(request.Rows, &bigquery.TableDataInsertAllRequestRows{Json: values})
+		}
+		response, err := e.service.Tabledata.InsertAll(e.projectID, e.config.Dataset, e.table.Table, request).Context(ctx).Do()
+		if err != nil {
+			// the requests already inserted are inserted again if retried
+			return errors.Join(append(errs, apiError(err))...)
+		}
+		if len(response.InsertErrors) > 0 {
+			errs = append(errs, consumererror.NewPermanent(insertErrors(response.InsertErrors)))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// streamingValues returns the values of the row for a streaming insert, the values of the JSON
+// columns being JSON strings.
+func streamingValues(r row) (map[string]bigquery.JsonValue, error) {
+	values := make(map[string]bigquery.JsonValue, len(r))
+	for name, value := range r {
+		if v, ok := value.(jsonValue); ok {
+			text, err := json.Marshal(v)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal the column %s: %w", name, err)
+			}
+			value = string(text)
+		}
+		values[name] = value
+	}
+	return values, nil
+}
+
+func insertErrors(insertErrors []*bigquery.TableDataInsertAllResponseInsertErrors) error {
+	message := "unknown error"
+	if first := insertErrors[0]; len(first.Errors) > 0 {
+		message = fmt.Sprintf("%s: %s", first.Errors[0].Reason, first.Errors[0].Message)
+	}
+	return fmt.Errorf("failed to insert %d rows, the first one with %s", len(insertErrors), message)
+}
+
+// loader buffers the rows of a table, and writes them with a load job every flush interval,
+// once max rows are buffered, or once all the queue consumers wait for it. The load jobs are
+// free, unlike the streaming inserts, but the rows are only written once the job is done: the
+// exports wait for the job of their rows, and fail with it.
+type loader struct {
+	exporter    *bigQueryExporter
+	interval    time.Duration
+	maxRows     int
+	maxRequests int
+
+	mu      sync.Mutex
+	batch   *loadBatch
+	stopped bool
+
+	flush chan struct{}
+	done  chan struct{}
+	wg    sync.WaitGroup
+}
+
+// loadBatch is the rows of the exports written by the same load job.
+type loadBatch struct {
+	requests []*loadRequest
+	// rows is the number of rows of the requests not canceled.
+	rows int
+	// loaded is closed once the job is done, err being its result.
+	loaded chan struct{}
+	err    error
+}
+
+// loadRequest is the rows of an export as newline delimited JSON, canceled if the export
+// stops waiting before the job starts.
+type loadRequest struct {
+	data     []byte
+	rows     int
+	canceled bool
+}
+
+func newLoadBatch() *loadBatch {
+	return &loadBatch{loaded: make(chan struct{})}
+}
+
+func newLoader(e *bigQueryExporter) *loader {
+	l := &loader{
+		exporter: e,
+		interval: e.config.Load.FlushInterval,
+		maxRows:  e.config.Load.MaxRows,
+		batch:    newLoadBatch(),
+		flush:    make(chan struct{}, 1),
+		done:     make(chan struct{}),
+	}
+	if e.config.QueueSettings.Enabled {
+		// no other export can be added while all the consumers of the queue are waiting
+		l.maxRequests = e.config.QueueSettings.NumConsumers
+	}
+	return l
+}
+
+func (l *loader) start() {
+	l.wg.Add(1)
+	go func() {
+		defer l.wg.Done()
+		ticker := time.NewTicker(l.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+			case <-l.flush:
+			case <-l.done:
+				return
+			}
+			ctx, cancel := l.exporter.contextWithTimeout(context.Background())
+			_ = l.load(ctx)
+			cancel()
+		}
+	}()
+}
+
+// add buffers the rows, and waits for the load job writing them. The rows are pushed back on
+// the pipeline while the buffer is full, i.e. while the load jobs cannot keep up.
+func (l *loader) add(ctx context.Context, rows []row) error {
+	var data bytes.Buffer
+	encoder := json.NewEncoder(&data)
+	for _, r := range rows {
+		if err := encoder.Encode(r); err != nil {
+			return consumererror.NewPermanent(fmt.Errorf("failed to marshal the rows: %w", err))
+		}
+	}
+	request := &loadRequest{data: data.Bytes(), rows: len(rows)}
+
+	l.mu.Lock()
+	if l.stopped {
+		l.mu.Unlock()
+		return errLoaderStopped
+	}
+	batch := l.batch
+	if batch.rows >= 2*l.maxRows {
+		l.mu.Unlock()
+		return errLoadBufferFull
+	}
+	batch.requests = append(batch.requests, request)
+	batch.rows += request.rows
+	if batch.rows >= l.maxRows || (l.maxRequests > 0 && len(batch.requests) >= l.maxRequests) {
+		select {
+		case l.flush <- struct{}{}:
+		default:
+		}
+	}
+	l.mu.Unlock()
+
+	select {
+	case <-batch.loaded:
+		return batch.err
+	case <-ctx.Done():
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		if l.batch == batch {
+			// the job has not started, the rows are not loaded
+			request.canceled = true
+			batch.rows -= request.rows
+			return ctx.Err()
+		}
+		return fmt.Errorf("stopped waiting for the load job, the rows may be loaded: %w", ctx.Err())
+	}
+}
+
+// shutdown stops the flushes and loads the remaining rows.
+func (l *loader) shutdown(ctx context.Context) error {
+	close(l.done)
+	l.wg.Wait()
+	l.mu.Lock()
+	l.stopped = true
+	l.mu.Unlock()
+	return l.load(ctx)
+}
+
+// load writes the buffered rows with a load job, and hands its result to the exports of the
+// rows. The errors of the job are retried by the exports.
+func (l *loader) load(ctx context.Context) error {
+	l.mu.Lock()
+	batch := l.batch
+	l.batch = newLoadBatch()
+	l.mu.Unlock()
+
+	var data bytes.Buffer
+	for _, request := range batch.requests {
+		if !request.canceled {
+			data.Write(request.data)
+		}
+	}
+	if batch.rows > 0 {
+		batch.err = l.exporter.loadRows(ctx, &data)
+		if batch.err != nil {
+			l.exporter.logger.Warn("Failed to load the rows, the exports are retried",
+				zap.String("table", l.exporter.tableRef()), zap.Int("rows", batch.rows), zap.Error(batch.err))
+		}
+	}
+	close(batch.loaded)
+	return batch.err
+}
+
+// loadRows writes the rows of newline delimited JSON with a load job, and waits for the job.
+func (e *bigQueryExporter) loadRows(ctx context.Context, data io.Reader) error {
+	job := &bigquery.Job{
+		JobReference: &bigquery.JobReference{ProjectId: e.projectID, JobId: "otelcol_" + uuid.NewString()},
+		Configuration: &bigquery.JobConfiguration{
+			Load: &bigquery.JobConfigurationLoad{
+				DestinationTable:    &bigquery.TableReference{ProjectId: e.projectID, DatasetId: e.config.Dataset, TableId: e.table.Table},
+				SourceFormat:        "NEWLINE_DELIMITED_JSON",
+				WriteDisposition:    "WRITE_APPEND",
+				IgnoreUnknownValues: e.ignoreUnknownValues,
+			},
+		},
+	}
+	job, err := e.service.Jobs.Insert(e.projectID, job).Media(data, googleapi.ContentType("application/octet-stream")).Context(ctx).Do()
+	if err != nil {
+		return fmt.Errorf("failed to create the load job: %w", apiError(err))
+	}
+	for job.Status == nil || job.Status.State != jobStateDone {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("the load job %s is not done: %w", job.JobReference.JobId, ctx.Err())
+		case <-time.After(jobPollInterval):
+		}
+		job, err = e.service.Jobs.Get(e.projectID, job.JobReference.JobId).Location(job.JobReference.Location).Context(ctx).Do()
+		if err != nil {
+			return fmt.Errorf("failed to get the status of the load job: %w", err)
+		}
+	}
+	if result := job.Status.ErrorResult; result != nil {
+		return fmt.Errorf("the load job %s failed with %s: %s", job.JobReference.JobId, result.Reason, result.Message)
+	}
+	return nil
+}
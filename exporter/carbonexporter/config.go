@@ -23,6 +23,22 @@ type Config struct {
 	// If `sending_queue` is enabled, it is recommended to use same value as `sending_queue::num_consumers`.
 	MaxIdleConns int `mapstructure:"max_idle_conns"`
 
+	// Protocol is the Carbon protocol used to send the metrics, either "plaintext" or "pickle".
+	// The default value is "plaintext".
+	Protocol string `mapstructure:"protocol"`
+	// PickleBatchSize is the maximum number of metric points sent in a single pickle message.
+	// The default value is 500.
+	PickleBatchSize int `mapstructure:"pickle_batch_size"`
+
+	// TagMode tells how the attributes not used by the metric path template are sent, either
+	// as Graphite tags with "tags" or dropped with "none". Without tags the bucket and quantile
+	// of histograms and summaries are appended to the metric path. The default value is "tags".
+	TagMode string `mapstructure:"tag_mode"`
+	// MetricPathTemplate builds the metric path from the "{name}" of the metric and the values
+	// of its attributes, referenced as "{attr.<key>}", e.g. "{attr.host.name}.{name}". By default
+	// the metric path is the metric name.
+	MetricPathTemplate string `mapstructure:"metric_path_template"`
+
 	// Timeout is the maximum duration allowed to connecting and sending the
 	// data to the Carbon/Graphite backend. The default value is 5s.
 	exporterhelper.TimeoutSettings `mapstructure:",squash"`     // squash ensures fields are correctly decoded in embedded struct.
@@ -49,5 +65,25 @@ func (cfg *Config) Validate() error {
 		return errors.New("'max_idle_conns' must be non-negative")
 	}
 
+	switch cfg.Protocol {
+	case "", protocolPlaintext, protocolPickle:
+	default:
+		return fmt.Errorf("'protocol' must be %q or %q, got %q", protocolPlaintext, protocolPickle, cfg.Protocol)
+	}
+
+	if cfg.PickleBatchSize < 0 {
+		return errors.New("'pickle_batch_size' must be non-negative")
+	}
+
+	switch cfg.TagMode {
+	case "", tagModeTags, tagModeNone:
+	default:
+		return fmt.Errorf("'tag_mode' must be %q or %q, got %q", tagModeTags, tagModeNone, cfg.TagMode)
+	}
+
+	if _, err := parsePathTemplate(cfg.MetricPathTemplate); err != nil {
+		return fmt.Errorf("'metric_path_template' is invalid: %w", err)
+	}
+
 	return nil
 }
@@ -42,7 +42,11 @@ func TestLoadConfig(t *testing.T) {
 				TCPAddrConfig: confignet.TCPAddrConfig{
 					Endpoint: "localhost:8080",
 				},
-				MaxIdleConns: 15,
+				MaxIdleConns:       15,
+				Protocol:           protocolPickle,
+				PickleBatchSize:    100,
+				TagMode:            tagModeNone,
+				MetricPathTemplate: "{attr.host.name}.{name}",
 				TimeoutSettings: exporterhelper.TimeoutSettings{
 					Timeout: 10 * time.Second,
 				},
@@ -118,6 +122,55 @@ func TestValidateConfig(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "invalid_protocol",
+			config: &Config{
+				TCPAddrConfig: confignet.TCPAddrConfig{Endpoint: defaultEndpoint},
+				Protocol:      "udp",
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid_pickle_batch_size",
+			config: &Config{
+				TCPAddrConfig:   confignet.TCPAddrConfig{Endpoint: defaultEndpoint},
+				Protocol:        protocolPickle,
+				PickleBatchSize: -1,
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid_tag_mode",
+			config: &Config{
+				TCPAddrConfig: confignet.TCPAddrConfig{Endpoint: defaultEndpoint},
+				TagMode:       "labels",
+			},
+			wantErr: true,
+		},
+		{
+			name: "metric_path_template_without_name",
+			config: &Config{
+				TCPAddrConfig:      confignet.TCPAddrConfig{Endpoint: defaultEndpoint},
+				MetricPathTemplate: "{attr.host.name}.cpu",
+			},
+			wantErr: true,
+		},
+		{
+			name: "metric_path_template_unknown_placeholder",
+			config: &Config{
+				TCPAddrConfig:      confignet.TCPAddrConfig{Endpoint: defaultEndpoint},
+				MetricPathTemplate: "{host}.{name}",
+			},
+			wantErr: true,
+		},
+		{
+			name: "metric_path_template_unbalanced",
+			config: &Config{
+				TCPAddrConfig:      confignet.TCPAddrConfig{Endpoint: defaultEndpoint},
+				MetricPathTemplate: "{attr.host.name.{name}",
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -20,8 +20,17 @@ import (
 
 // newCarbonExporter returns a new Carbon exporter.
 func newCarbonExporter(ctx context.Context, cfg *Config, set exporter.CreateSettings) (exporter.Metrics, error) {
+	paths, err := newPathBuilder(cfg.MetricPathTemplate, cfg.TagMode)
+	if err != nil {
+		return nil, err
+	}
+	batchSize := cfg.PickleBatchSize
+	if batchSize == 0 {
+		batchSize = defaultPickleBatchSize
+	}
 	sender := carbonSender{
 		writeTimeout: cfg.Timeout,
+		formatter:    newMetricsFormatter(paths, cfg.Protocol, batchSize),
 		conns:        newConnPool(cfg.TCPAddrConfig, cfg.Timeout, cfg.MaxIdleConns),
 	}
 
@@ -46,11 +55,12 @@ func newCarbonExporter(ctx context.Context, cfg *Config, set exporter.CreateSett
 // the exporter can leverage the helper and get consistent observability.
 type carbonSender struct {
 	writeTimeout time.Duration
+	formatter    *metricsFormatter
 	conns        connPool
 }
 
 func (cs *carbonSender) pushMetricsData(_ context.Context, md pmetric.Metrics) error {
-	lines := metricDataToPlaintext(md)
+	payload := cs.formatter.format(md)
 
 	// There is no way to do a call equivalent to recvfrom with an empty buffer
 	// to check if the connection was terminated (if the size of the buffer is
@@ -81,7 +91,7 @@ func (cs *carbonSender) pushMetricsData(_ context.Context, md pmetric.Metrics) e
 	}
 
 	// If we did not write all bytes will get an error, so no need to check for that.
-	_, err = conn.Write([]byte(lines))
+	_, err = conn.Write(payload)
 	if err != nil {
 		// Do not re-enqueue the connection since it failed to write.
 		return multierr.Append(err, conn.Close())
@@ -18,6 +18,9 @@ import (
 // Defaults for not specified configuration settings.
 const (
 	defaultEndpoint = "localhost:2003"
+
+	// The default maximum number of metric points of a pickle message.
+	defaultPickleBatchSize = 500
 )
 
 // NewFactory creates a factory for Carbon exporter.
@@ -34,6 +37,9 @@ func createDefaultConfig() component.Config {
 			Endpoint: defaultEndpoint,
 		},
 		MaxIdleConns:    100,
+		Protocol:        protocolPlaintext,
+		PickleBatchSize: defaultPickleBatchSize,
+		TagMode:         tagModeTags,
 		TimeoutSettings: exporterhelper.NewDefaultTimeoutSettings(),
 		QueueConfig:     exporterhelper.NewDefaultQueueSettings(),
 		RetryConfig:     configretry.NewDefaultBackOffConfig(),
@@ -0,0 +1,194 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package carbonexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/carbonexporter"
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+const (
+	// Tag modes of the exporter.
+	tagModeTags = "tags"
+	tagModeNone = "none"
+
+	// Placeholders of the metric path template.
+	pathTemplateName       = "name"
+	pathTemplateAttrPrefix = "attr."
+
+	// pathNodeMissingPlaceholder replaces the attributes of the template missing from a data point.
+	pathNodeMissingPlaceholder = "unknown"
+	// pathNodeSeparator separates the nodes of a metric path without tags.
+	pathNodeSeparator = "."
+)
+
+var pathTemplatePlaceholder = regexp.MustCompile(`\{([^{}]*)\}`)
+
+// pathTemplatePart is either a literal or a placeholder of a metric path template.
+type pathTemplatePart struct {
+	literal string
+	// attr is the key of the attribute of the placeholder, empty for the metric name.
+	attr          string
+	isPlaceholder bool
+}
+
+// pathTemplate builds metric paths from the metric name and the values of attributes.
+type pathTemplate struct {
+	parts []pathTemplatePart
+	attrs map[string]struct{}
+}
+
+// parsePathTemplate parses a metric path template, it returns nil for an empty template.
+func parsePathTemplate(template string) (*pathTemplate, error) {
+	if template == "" {
+		return nil, nil
+	}
+
+	t := &pathTemplate{attrs: make(map[string]struct{})}
+	hasName := false
+	last := 0
+	for _, loc := range pathTemplatePlaceholder.FindAllStringSubmatchIndex(template, -1) {
+		if loc[0] > last {
+			t.parts = append(t.parts, pathTemplatePart{literal: template[last:loc[0]]})
+		}
+		last = loc[1]
+
+		placeholder := template[loc[2]:loc[3]]
+		switch {
+		case placeholder == pathTemplateName:
+			hasName = true
+			t.parts = append(t.parts, pathTemplatePart{isPlaceholder: true})
+		case strings.HasPrefix(placeholder, pathTemplateAttrPrefix) && len(placeholder) > len(pathTemplateAttrPrefix):
+			attr := strings.TrimPrefix(placeholder, pathTemplateAttrPrefix)
+			t.attrs[attr] = struct{}{}
+			t.parts = append(t.parts, pathTemplatePart{attr: attr, isPlaceholder: true})
+		default:
+			return nil, fmt.Errorf("unknown placeholder %q", "{"+placeholder+"}")
+		}
+	}
+	if last < len(template) {
+		t.parts = append(t.parts, pathTemplatePart{literal: template[last:]})
+	}
+
+	rest := pathTemplatePlaceholder.ReplaceAllString(template, "")
+	if strings.ContainsAny(rest, "{}") {
+		return nil, errors.New("unbalanced braces")
+	}
+	if !hasName {
+		return nil, fmt.Errorf("the template must contain the %q placeholder", "{"+pathTemplateName+"}")
+	}
+	return t, nil
+}
+
+func (t *pathTemplate) render(buf *bytes.Buffer, name string, attributes pcommon.Map) {
+	for _, part := range t.parts {
+		switch {
+		case !part.isPlaceholder:
+			buf.WriteString(part.literal)
+		case part.attr == "":
+			buf.WriteString(name)
+		default:
+			value := pathNodeMissingPlaceholder
+			if v, ok := attributes.Get(part.attr); ok && v.AsString() != "" {
+				value = v.AsString()
+			}
+			buf.WriteString(sanitizePathNode(value))
+		}
+	}
+}
+
+func (t *pathTemplate) uses(attr string) bool {
+	if t == nil {
+		return false
+	}
+	_, ok := t.attrs[attr]
+	return ok
+}
+
+// pathBuilder builds the <metric_path> of the Carbon metrics per the metric path template
+// and the tag mode.
+type pathBuilder struct {
+	template *pathTemplate
+	tagMode  string
+}
+
+// defaultPathBuilder builds the metric paths from the metric name and Graphite tags.
+var defaultPathBuilder = &pathBuilder{tagMode: tagModeTags}
+
+func newPathBuilder(template, tagMode string) (*pathBuilder, error) {
+	t, err := parsePathTemplate(template)
+	if err != nil {
+		return nil, err
+	}
+	if tagMode == "" {
+		tagMode = tagModeTags
+	}
+	return &pathBuilder{template: t, tagMode: tagMode}, nil
+}
+
+// build returns the metric path of a metric, the attributes not used by the template
+// become tags unless the tag mode is "none".
+func (pb *pathBuilder) build(name string, attributes pcommon.Map) string {
+	withTags := pb.tagMode != tagModeNone && attributes.Len() > 0
+	if pb.template == nil && !withTags {
+		return name
+	}
+
+	buf := writerPool.Get().(*bytes.Buffer)
+	buf.Reset()
+	defer writerPool.Put(buf)
+
+	if pb.template == nil {
+		buf.WriteString(name)
+	} else {
+		pb.template.render(buf, name, attributes)
+	}
+	if withTags {
+		attributes.Range(func(k string, v pcommon.Value) bool {
+			if pb.template.uses(k) {
+				return true
+			}
+			value := v.AsString()
+			if value == "" {
+				value = tagValueEmptyPlaceholder
+			}
+			buf.WriteString(tagPrefix)
+			buf.WriteString(sanitizeTagKey(k))
+			buf.WriteString(tagKeyValueSeparator)
+			buf.WriteString(value)
+			return true
+		})
+	}
+
+	return buf.String()
+}
+
+// appendTag adds the tag of a bucket or quantile to a metric path, as a Graphite
+// tag or as the last node of the path when the tag mode is "none".
+func (pb *pathBuilder) appendTag(path, key, value string) string {
+	if pb.tagMode == tagModeNone {
+		return path + pathNodeSeparator + key + "_" + sanitizePathNode(value)
+	}
+	return path + tagPrefix + key + tagKeyValueSeparator + value
+}
+
+// sanitizePathNode replaces the characters separating the nodes or the tags of a
+// metric path, the invalid characters are ". ;=~".
+func sanitizePathNode(value string) string {
+	mapRune := func(r rune) rune {
+		switch r {
+		case '.', ' ', ';', '=', '~':
+			return sanitizedRune
+		default:
+			return r
+		}
+	}
+
+	return strings.Map(mapRune, value)
+}
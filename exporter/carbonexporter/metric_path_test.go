@@ -0,0 +1,124 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package carbonexporter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+func TestPathBuilder(t *testing.T) {
+	attributes := pcommon.NewMap()
+	attributes.PutStr("host.name", "web-1.example.com")
+	attributes.PutStr("region", "eu west")
+	attributes.PutStr("env", "")
+
+	tests := []struct {
+		name     string
+		template string
+		tagMode  string
+		want     string
+	}{
+		{
+			name: "default",
+			want: "cpu;host.name=web-1.example.com;region=eu west;env=" + tagValueEmptyPlaceholder,
+		},
+		{
+			name:    "no_tags",
+			tagMode: tagModeNone,
+			want:    "cpu",
+		},
+		{
+			name:     "template_with_tags",
+			template: "servers.{attr.host.name}.{name}",
+			want:     "servers.web-1_example_com.cpu;region=eu west;env=" + tagValueEmptyPlaceholder,
+		},
+		{
+			name:     "template_without_tags",
+			template: "{attr.region}.{attr.host.name}.{name}",
+			tagMode:  tagModeNone,
+			want:     "eu_west.web-1_example_com.cpu",
+		},
+		{
+			name:     "template_missing_attributes",
+			template: "{attr.env}.{attr.zone}.{name}",
+			tagMode:  tagModeNone,
+			want:     pathNodeMissingPlaceholder + "." + pathNodeMissingPlaceholder + ".cpu",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pb, err := newPathBuilder(tt.template, tt.tagMode)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, pb.build("cpu", attributes))
+		})
+	}
+}
+
+func TestParsePathTemplate(t *testing.T) {
+	tests := []struct {
+		template string
+		wantErr  string
+	}{
+		{template: ""},
+		{template: "{name}"},
+		{template: "prefix.{attr.service.name}.{name}.suffix"},
+		{template: "{attr.host}", wantErr: `the template must contain the "{name}" placeholder`},
+		{template: "{attr.}.{name}", wantErr: `unknown placeholder "{attr.}"`},
+		{template: "{host}.{name}", wantErr: `unknown placeholder "{host}"`},
+		{template: "{name}}", wantErr: "unbalanced braces"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.template, func(t *testing.T) {
+			_, err := parsePathTemplate(tt.template)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestFormatWithoutTags(t *testing.T) {
+	md := pmetric.NewMetrics()
+	rm := md.ResourceMetrics().AppendEmpty()
+	ms := rm.ScopeMetrics().AppendEmpty().Metrics()
+
+	histogram := ms.AppendEmpty()
+	histogram.SetName("latency")
+	hdp := histogram.SetEmptyHistogram().DataPoints().AppendEmpty()
+	hdp.Attributes().PutStr("service.name", "api")
+	hdp.SetTimestamp(pcommon.Timestamp(1574092046 * 1e9))
+	hdp.SetCount(3)
+	hdp.SetSum(4.5)
+	hdp.ExplicitBounds().FromRaw([]float64{1.5})
+	hdp.BucketCounts().FromRaw([]uint64{1, 2})
+
+	summary := ms.AppendEmpty()
+	summary.SetName("size")
+	sdp := summary.SetEmptySummary().DataPoints().AppendEmpty()
+	sdp.Attributes().PutStr("service.name", "api")
+	sdp.SetTimestamp(pcommon.Timestamp(1574092046 * 1e9))
+	sdp.SetCount(1)
+	sdp.SetSum(2)
+	q := sdp.QuantileValues().AppendEmpty()
+	q.SetQuantile(0.999)
+	q.SetValue(2)
+
+	pb, err := newPathBuilder("{attr.service.name}.{name}", tagModeNone)
+	require.NoError(t, err)
+	got := string(newMetricsFormatter(pb, protocolPlaintext, 0).format(md))
+	assert.Equal(t, "api.latency.count 3 1574092046\n"+
+		"api.latency 4.5 1574092046\n"+
+		"api.latency.bucket.upper_bound_1_5 1 1574092046\n"+
+		"api.latency.bucket.upper_bound_inf 2 1574092046\n"+
+		"api.size.count 1 1574092046\n"+
+		"api.size 2 1574092046\n"+
+		"api.size.quantile.quantile_99_9 2 1574092046\n", got)
+}
@@ -25,14 +25,12 @@ const (
 	tagLineNewLine           = "\n"
 
 	// Constants used when converting from distribution metrics to Carbon format.
-	distributionBucketSuffix             = ".bucket"
-	distributionUpperBoundTagKey         = "upper_bound"
-	distributionUpperBoundTagBeforeValue = tagPrefix + distributionUpperBoundTagKey + tagKeyValueSeparator
+	distributionBucketSuffix     = ".bucket"
+	distributionUpperBoundTagKey = "upper_bound"
 
 	// Constants used when converting from summary metrics to Carbon format.
-	summaryQuantileSuffix         = ".quantile"
-	summaryQuantileTagKey         = "quantile"
-	summaryQuantileTagBeforeValue = tagPrefix + summaryQuantileTagKey + tagKeyValueSeparator
+	summaryQuantileSuffix = ".quantile"
+	summaryQuantileTagKey = "quantile"
 
 	// Suffix to be added to original metric name for a Carbon metric representing
 	// a count metric for either distribution or summary metrics.
@@ -80,14 +78,62 @@ var writerPool = sync.Pool{
 //   - number of time series successfully converted to carbon.
 //   - number of time series that could not be converted to Carbon.
 func metricDataToPlaintext(md pmetric.Metrics) string {
+	return string(newMetricsFormatter(defaultPathBuilder, protocolPlaintext, 0).format(md))
+}
+
+// pointWriter writes single Carbon metrics in the format of a Carbon protocol.
+type pointWriter interface {
+	writePoint(path, value, timestamp string)
+}
+
+// plaintextWriter writes the Carbon metrics as lines of the plaintext protocol.
+type plaintextWriter struct {
+	buf *bytes.Buffer
+}
+
+func (w *plaintextWriter) writePoint(path, value, timestamp string) {
+	writeLine(w.buf, path, value, timestamp)
+}
+
+// metricsFormatter converts internal metrics data to the payload sent with a Carbon protocol.
+type metricsFormatter struct {
+	paths     *pathBuilder
+	protocol  string
+	batchSize int
+}
+
+func newMetricsFormatter(paths *pathBuilder, protocol string, batchSize int) *metricsFormatter {
+	return &metricsFormatter{
+		paths:     paths,
+		protocol:  protocol,
+		batchSize: batchSize,
+	}
+}
+
+// format returns the payload of the metrics, the plaintext lines or the pickle messages of
+// at most batchSize metric points.
+func (f *metricsFormatter) format(md pmetric.Metrics) []byte {
 	if md.DataPointCount() == 0 {
-		return ""
+		return nil
 	}
 
 	buf := writerPool.Get().(*bytes.Buffer)
 	buf.Reset()
 	defer writerPool.Put(buf)
 
+	if f.protocol == protocolPickle {
+		w := newPickleWriter(buf, f.batchSize)
+		f.writeMetrics(w, md)
+		w.flush()
+	} else {
+		f.writeMetrics(&plaintextWriter{buf: buf}, md)
+	}
+
+	// the buffer is reused, the payload is copied
+	return append([]byte(nil), buf.Bytes()...)
+}
+
+func (f *metricsFormatter) writeMetrics(w pointWriter, md pmetric.Metrics) {
 	for i := 0; i < md.ResourceMetrics().Len(); i++ {
 		rm := md.ResourceMetrics().At(i)
 		for j := 0; j < rm.ScopeMetrics().Len(); j++ {
@@ -100,22 +146,20 @@ func metricDataToPlaintext(md pmetric.Metrics) string {
 				}
 				switch metric.Type() {
 				case pmetric.MetricTypeGauge:
-					writeNumberDataPoints(buf, metric.Name(), metric.Gauge().DataPoints())
+					f.writeNumberDataPoints(w, metric.Name(), metric.Gauge().DataPoints())
 				case pmetric.MetricTypeSum:
-					writeNumberDataPoints(buf, metric.Name(), metric.Sum().DataPoints())
+					f.writeNumberDataPoints(w, metric.Name(), metric.Sum().DataPoints())
 				case pmetric.MetricTypeHistogram:
-					formatHistogramDataPoints(buf, metric.Name(), metric.Histogram().DataPoints())
+					f.formatHistogramDataPoints(w, metric.Name(), metric.Histogram().DataPoints())
 				case pmetric.MetricTypeSummary:
-					formatSummaryDataPoints(buf, metric.Name(), metric.Summary().DataPoints())
+					f.formatSummaryDataPoints(w, metric.Name(), metric.Summary().DataPoints())
 				}
 			}
 		}
 	}
-
-	return buf.String()
 }
 
-func writeNumberDataPoints(buf *bytes.Buffer, metricName string, dps pmetric.NumberDataPointSlice) {
+func (f *metricsFormatter) writeNumberDataPoints(w pointWriter, metricName string, dps pmetric.NumberDataPointSlice) {
 	for i := 0; i < dps.Len(); i++ {
 		dp := dps.At(i)
 		var valueStr string
@@ -127,9 +171,8 @@ func writeNumberDataPoints(buf *bytes.Buffer, metricName string, dps pmetric.Num
 		case pmetric.NumberDataPointValueTypeDouble:
 			valueStr = formatFloatForValue(dp.DoubleValue())
 		}
-		writeLine(
-			buf,
-			buildPath(metricName, dp.Attributes()),
+		w.writePoint(
+			f.paths.build(metricName, dp.Attributes()),
 			valueStr,
 			formatTimestamp(dp.Timestamp()))
 	}
@@ -149,8 +192,8 @@ func writeNumberDataPoints(buf *bytes.Buffer, metricName string, dps pmetric.Num
 // and will include a dimension "upper_bound" that specifies the maximum value in
 // that bucket. This metric specifies the number of events with a value that is
 // less than or equal to the upper bound.
-func formatHistogramDataPoints(
-	buf *bytes.Buffer,
+func (f *metricsFormatter) formatHistogramDataPoints(
+	w pointWriter,
 	metricName string,
 	dps pmetric.HistogramDataPointSlice,
 ) {
@@ -158,7 +201,7 @@ func formatHistogramDataPoints(
 		dp := dps.At(i)
 
 		timestampStr := formatTimestamp(dp.Timestamp())
-		formatCountAndSum(buf, metricName, dp.Attributes(), dp.Count(), dp.Sum(), timestampStr)
+		f.formatCountAndSum(w, metricName, dp.Attributes(), dp.Count(), dp.Sum(), timestampStr)
 		if dp.ExplicitBounds().Len() == 0 {
 			continue
 		}
@@ -170,11 +213,10 @@ func formatHistogramDataPoints(
 		}
 		carbonBounds[len(carbonBounds)-1] = infinityCarbonValue
 
-		bucketPath := buildPath(metricName+distributionBucketSuffix, dp.Attributes())
+		bucketPath := f.paths.build(metricName+distributionBucketSuffix, dp.Attributes())
 		for j := 0; j < dp.BucketCounts().Len(); j++ {
-			writeLine(
-				buf,
-				bucketPath+distributionUpperBoundTagBeforeValue+carbonBounds[j],
+			w.writePoint(
+				f.paths.appendTag(bucketPath, distributionUpperBoundTagKey, carbonBounds[j]),
 				formatUint64(dp.BucketCounts().At(j)),
 				timestampStr)
 		}
@@ -193,8 +235,8 @@ func formatHistogramDataPoints(
 //
 // 3. Each quantile is represented by a metric named "<metricName>.quantile"
 // and will include a tag key "quantile" that specifies the quantile value.
-func formatSummaryDataPoints(
-	buf *bytes.Buffer,
+func (f *metricsFormatter) formatSummaryDataPoints(
+	w pointWriter,
 	metricName string,
 	dps pmetric.SummaryDataPointSlice,
 ) {
@@ -202,17 +244,16 @@ func formatSummaryDataPoints(
 		dp := dps.At(i)
 
 		timestampStr := formatTimestamp(dp.Timestamp())
-		formatCountAndSum(buf, metricName, dp.Attributes(), dp.Count(), dp.Sum(), timestampStr)
+		f.formatCountAndSum(w, metricName, dp.Attributes(), dp.Count(), dp.Sum(), timestampStr)
 
 		if dp.QuantileValues().Len() == 0 {
 			continue
 		}
 
-		quantilePath := buildPath(metricName+summaryQuantileSuffix, dp.Attributes())
+		quantilePath := f.paths.build(metricName+summaryQuantileSuffix, dp.Attributes())
 		for j := 0; j < dp.QuantileValues().Len(); j++ {
-			writeLine(
-				buf,
-				quantilePath+summaryQuantileTagBeforeValue+formatFloatForLabel(dp.QuantileValues().At(j).Quantile()*100),
+			w.writePoint(
+				f.paths.appendTag(quantilePath, summaryQuantileTagKey, formatFloatForLabel(dp.QuantileValues().At(j).Quantile()*100)),
 				formatFloatForValue(dp.QuantileValues().At(j).Value()),
 				timestampStr)
 		}
@@ -226,8 +267,8 @@ func formatSummaryDataPoints(
 // 1. The total count will be represented by a metric named "<metricName>.count".
 //
 // 2. The total sum will be represented by a metruc with the original "<metricName>".
-func formatCountAndSum(
-	buf *bytes.Buffer,
+func (f *metricsFormatter) formatCountAndSum(
+	w pointWriter,
 	metricName string,
 	attributes pcommon.Map,
 	count uint64,
@@ -235,43 +276,20 @@ func formatCountAndSum(
 	timestampStr string,
 ) {
 	// Write count and sum metrics.
-	writeLine(
-		buf,
-		buildPath(metricName+countSuffix, attributes),
+	w.writePoint(
+		f.paths.build(metricName+countSuffix, attributes),
 		formatUint64(count),
 		timestampStr)
 
-	writeLine(
-		buf,
-		buildPath(metricName, attributes),
+	w.writePoint(
+		f.paths.build(metricName, attributes),
 		formatFloatForValue(sum),
 		timestampStr)
 }
 
 // buildPath is used to build the <metric_path> per description above.
 func buildPath(name string, attributes pcommon.Map) string {
-	if attributes.Len() == 0 {
-		return name
-	}
-
-	buf := writerPool.Get().(*bytes.Buffer)
-	buf.Reset()
-	defer writerPool.Put(buf)
-
-	buf.WriteString(name)
-	attributes.Range(func(k string, v pcommon.Value) bool {
-		value := v.AsString()
-		if value == "" {
-			value = tagValueEmptyPlaceholder
-		}
-		buf.WriteString(tagPrefix)
-		buf.WriteString(sanitizeTagKey(k))
-		buf.WriteString(tagKeyValueSeparator)
-		buf.WriteString(value)
-		return true
-	})
-
-	return buf.String()
+	return defaultPathBuilder.build(name, attributes)
 }
 
 // writeLine builds a single Carbon metric textual line, ie.: it already adds
@@ -0,0 +1,113 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package carbonexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/carbonexporter"
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"strconv"
+)
+
+const (
+	// Carbon protocols supported by the exporter.
+	protocolPlaintext = "plaintext"
+	protocolPickle    = "pickle"
+
+	// Opcodes of the pickle protocol 2, see https://github.com/python/cpython/blob/main/Lib/pickletools.py
+	pickleProto      = 0x80
+	pickleVersion    = 2
+	pickleEmptyList  = ']'
+	pickleMark       = '('
+	pickleBinUnicode = 'X'
+	pickleLong1      = 0x8a
+	pickleBinFloat   = 'G'
+	pickleTuple2     = 0x86
+	pickleAppends    = 'e'
+	pickleStop       = '.'
+)
+
+// pickleWriter writes the Carbon metrics as pickle messages as defined in
+// https://graphite.readthedocs.io/en/latest/feeding-carbon.html#the-pickle-protocol,
+// each message is a list of at most batchSize tuples:
+//
+//	[(path, (timestamp, value)), ...]
+//
+// prefixed by its length as a 4 bytes big-endian integer.
+type pickleWriter struct {
+	out       *bytes.Buffer
+	msg       bytes.Buffer
+	batchSize int
+	count     int
+}
+
+func newPickleWriter(out *bytes.Buffer, batchSize int) *pickleWriter {
+	return &pickleWriter{
+		out:       out,
+		batchSize: batchSize,
+	}
+}
+
+func (w *pickleWriter) writePoint(path, value, timestamp string) {
+	if w.count == 0 {
+		w.msg.Reset()
+		w.msg.Write([]byte{pickleProto, pickleVersion, pickleEmptyList, pickleMark})
+	}
+
+	writePickleString(&w.msg, path)
+	ts, _ := strconv.ParseUint(timestamp, 10, 64)
+	writePickleLong(&w.msg, ts)
+	v, _ := strconv.ParseFloat(value, 64)
+	writePickleFloat(&w.msg, v)
+	w.msg.Write([]byte{pickleTuple2, pickleTuple2})
+
+	w.count++
+	if w.count >= w.batchSize {
+		w.flush()
+	}
+}
+
+// flush writes the pending metric points as a message.
+func (w *pickleWriter) flush() {
+	if w.count == 0 {
+		return
+	}
+	w.msg.Write([]byte{pickleAppends, pickleStop})
+
+	var header [4]byte
+	binary.BigEndian.PutUint32(header[:], uint32(w.msg.Len()))
+	w.out.Write(header[:])
+	w.out.Write(w.msg.Bytes())
+	w.count = 0
+}
+
+func writePickleString(buf *bytes.Buffer, s string) {
+	var length [4]byte
+	binary.LittleEndian.PutUint32(length[:], uint32(len(s)))
+	buf.WriteByte(pickleBinUnicode)
+	buf.Write(length[:])
+	buf.WriteString(s)
+}
+
+// writePickleLong writes an integer as the little-endian two's complement bytes of LONG1.
+func writePickleLong(buf *bytes.Buffer, i uint64) {
+	var digits []byte
+	for ; i > 0; i >>= 8 {
+		digits = append(digits, byte(i))
+	}
+	if len(digits) > 0 && digits[len(digits)-1]&0x80 != 0 {
+		// keep the integer positive
+		digits = append(digits, 0)
+	}
+	buf.WriteByte(pickleLong1)
+	buf.WriteByte(byte(len(digits)))
+	buf.Write(digits)
+}
+
+func writePickleFloat(buf *bytes.Buffer, f float64) {
+	var bits [8]byte
+	binary.BigEndian.PutUint64(bits[:], math.Float64bits(f))
+	buf.WriteByte(pickleBinFloat)
+	buf.Write(bits[:])
+}
@@ -0,0 +1,84 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package carbonexporter
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+func TestPickleWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w := newPickleWriter(&buf, 10)
+	w.writePoint("a;k=v", "1.5", "1700000000")
+	w.flush()
+
+	// pickle.loads(payload) == [("a;k=v", (1700000000, 1.5))]
+	payload := []byte{
+		0x80, 0x02, ']', '(',
+		'X', 5, 0, 0, 0, 'a', ';', 'k', '=', 'v',
+		0x8a, 4, 0x00, 0xf1, 0x53, 0x65,
+		'G', 0x3f, 0xf8, 0, 0, 0, 0, 0, 0,
+		0x86, 0x86,
+		'e', '.',
+	}
+	want := binary.BigEndian.AppendUint32(nil, uint32(len(payload)))
+	want = append(want, payload...)
+	assert.Equal(t, want, buf.Bytes())
+
+	// nothing is pending
+	w.flush()
+	assert.Equal(t, want, buf.Bytes())
+}
+
+func TestWritePickleLong(t *testing.T) {
+	tests := []struct {
+		value uint64
+		want  []byte
+	}{
+		{value: 0, want: []byte{0x8a, 0}},
+		{value: 127, want: []byte{0x8a, 1, 0x7f}},
+		{value: 128, want: []byte{0x8a, 2, 0x80, 0x00}},
+		{value: 1 << 32, want: []byte{0x8a, 5, 0, 0, 0, 0, 1}},
+	}
+	for _, tt := range tests {
+		var buf bytes.Buffer
+		writePickleLong(&buf, tt.value)
+		assert.Equal(t, tt.want, buf.Bytes())
+	}
+}
+
+func TestFormatPickleBatches(t *testing.T) {
+	md := pmetric.NewMetrics()
+	metric := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+	metric.SetName("gauge")
+	dps := metric.SetEmptyGauge().DataPoints()
+	for i := 0; i < 5; i++ {
+		dp := dps.AppendEmpty()
+		dp.SetIntValue(int64(i))
+		dp.SetTimestamp(pcommon.Timestamp(1574092046 * 1e9))
+	}
+
+	got := newMetricsFormatter(defaultPathBuilder, protocolPickle, 2).format(md)
+	var messages int
+	for len(got) > 0 {
+		require.GreaterOrEqual(t, len(got), 4)
+		size := int(binary.BigEndian.Uint32(got))
+		require.GreaterOrEqual(t, len(got), 4+size)
+		message := got[4 : 4+size]
+		assert.Equal(t, []byte{0x80, 0x02}, message[:2])
+		assert.Equal(t, []byte{'e', '.'}, message[len(message)-2:])
+		got = got[4+size:]
+		messages++
+	}
+	assert.Equal(t, 3, messages)
+
+	assert.Empty(t, newMetricsFormatter(defaultPathBuilder, protocolPickle, 2).format(pmetric.NewMetrics()))
+}
@@ -0,0 +1,131 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package loadbalancingexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/loadbalancingexporter"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.opencensus.io/stats"
+	"go.opencensus.io/tag"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultFailureThreshold = 5
+	defaultOpenDuration     = 30 * time.Second
+)
+
+var errBackendSaturated = errors.New("too many exports in flight to the backend")
+
+// export sends the data to the backend with the consume function, unless too many exports are
+// in flight to the backend already, and records the outcome for the circuit breaker.
+func (lb *loadBalancer) export(ctx context.Context, exp *wrappedExporter, endpoint string, consume func() error) error {
+	inFlight := exp.inFlight.Add(1)
+	defer func() {
+		recordBackendMeasure(ctx, endpoint, mBackendInFlight.M(exp.inFlight.Add(-1)))
+	}()
+	if lb.maxInFlight > 0 && inFlight > lb.maxInFlight {
+		// the error is retryable, the data is retried or queued upstream instead of here
+		recordBackendMeasure(ctx, endpoint, mBackendRejected.M(1))
+		return fmt.Errorf("%w %q", errBackendSaturated, endpoint)
+	}
+	recordBackendMeasure(ctx, endpoint, mBackendInFlight.M(inFlight))
+
+	err := consume()
+	lb.recordOutcome(exp, endpoint, err)
+	return err
+}
+
+// recordOutcome opens the circuit of the backend once its exports failed failure threshold
+// times in a row. The permanent errors are caused by the data, not by the backend.
+func (lb *loadBalancer) recordOutcome(exp *wrappedExporter, endpoint string, err error) {
+	if !lb.circuitBreaker.Enabled {
+		return
+	}
+	if err == nil {
+		exp.consecutiveFailures.Store(0)
+		return
+	}
+	if consumererror.IsPermanent(err) {
+		return
+	}
+	if exp.consecutiveFailures.Add(1) >= int64(lb.circuitBreaker.FailureThreshold) {
+		lb.openCircuit(exp, endpoint)
+	}
+}
+
+// openCircuit removes the backend from the ring for the open duration, its data being routed
+// to the other backends meanwhile. The last backend of the ring is kept, its data having
+// nowhere else to go.
+func (lb *loadBalancer) openCircuit(exp *wrappedExporter, endpoint string) {
+	lb.updateLock.Lock()
+	defer lb.updateLock.Unlock()
+
+	if exp.circuitOpen || lb.exporters[endpointWithPort(endpoint)] != exp {
+		// already open, or removed by the resolver meanwhile
+		return
+	}
+	if len(lb.healthyEndpoints()) <= 1 {
+		return
+	}
+	exp.circuitOpen = true
+	lb.ring = lb.healthyRing()
+	exp.circuitTimer = time.AfterFunc(lb.circuitBreaker.OpenDuration, func() {
+		lb.closeCircuit(exp, endpoint)
+	})
+	lb.logger.Warn("Removed the failing backend from the ring",
+		zap.String("endpoint", endpoint),
+		zap.Int64("consecutive_failures", exp.consecutiveFailures.Load()),
+		zap.Duration("open_duration", lb.circuitBreaker.OpenDuration))
+	recordBackendMeasure(context.Background(), endpoint, mBackendCircuitOpen.M(1))
+}
+
+// closeCircuit adds the backend back to the ring. The circuit is half-open: the next failed
+// export opens it again.
+func (lb *loadBalancer) closeCircuit(exp *wrappedExporter, endpoint string) {
+	lb.updateLock.Lock()
+	defer lb.updateLock.Unlock()
+
+	if !exp.circuitOpen {
+		return
+	}
+	exp.circuitOpen = false
+	exp.consecutiveFailures.Store(int64(lb.circuitBreaker.FailureThreshold - 1))
+	if lb.exporters[endpointWithPort(endpoint)] == exp {
+		lb.ring = lb.healthyRing()
+		lb.logger.Info("Added the backend back to the ring", zap.String("endpoint", endpoint))
+	}
+	recordBackendMeasure(context.Background(), endpoint, mBackendCircuitOpen.M(0))
+}
+
+// healthyEndpoints returns the resolved endpoints whose circuit is closed. The update lock
+// must be held.
+func (lb *loadBalancer) healthyEndpoints() []string {
+	healthy := make([]string, 0, len(lb.resolved))
+	for _, endpoint := range lb.resolved {
+		if exp, found := lb.exporters[endpointWithPort(endpoint)]; found && exp.circuitOpen {
+			continue
+		}
+		healthy = append(healthy, endpoint)
+	}
+	return healthy
+}
+
+// healthyRing returns the ring of the resolved endpoints whose circuit is closed. The update
+// lock must be held.
+func (lb *loadBalancer) healthyRing() *hashRing {
+	healthy := lb.healthyEndpoints()
+	if len(healthy) == len(lb.resolved) {
+		return lb.resolvedRing
+	}
+	return newHashRing(healthy)
+}
+
+func recordBackendMeasure(ctx context.Context, endpoint string, measurement stats.Measurement) {
+	_ = stats.RecordWithTags(ctx, []tag.Mutator{tag.Upsert(endpointTagKey, endpoint)}, measurement)
+}
@@ -0,0 +1,118 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package loadbalancingexporter
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/exporter/exportertest"
+)
+
+func newCircuitBreakerLoadBalancer(t *testing.T, endpoints []string, cfg *Config) *loadBalancer {
+	componentFactory := func(_ context.Context, _ string) (component.Component, error) {
+		return newNopMockExporter(), nil
+	}
+	cfg.Resolver = ResolverSettings{Static: &StaticResolver{Hostnames: endpoints}}
+	lb, err := newLoadBalancer(exportertest.NewNopCreateSettings(), cfg, componentFactory)
+	require.NoError(t, err)
+	lb.onBackendChanges(endpoints)
+	return lb
+}
+
+func ringEndpoints(lb *loadBalancer) map[string]bool {
+	lb.updateLock.RLock()
+	defer lb.updateLock.RUnlock()
+	endpoints := map[string]bool{}
+	for _, item := range lb.ring.items {
+		endpoints[item.endpoint] = true
+	}
+	return endpoints
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	lb := newCircuitBreakerLoadBalancer(t, []string{"endpoint-1", "endpoint-2"}, &Config{
+		CircuitBreaker: CircuitBreakerSettings{Enabled: true, FailureThreshold: 2, OpenDuration: 50 * time.Millisecond},
+	})
+	defer func() { require.NoError(t, lb.Shutdown(context.Background())) }()
+	exp := lb.exporters["endpoint-1:4317"]
+	unavailable := errors.New("unavailable")
+	fail := func() error { return unavailable }
+
+	// a success resets the consecutive failures
+	assert.ErrorIs(t, lb.export(context.Background(), exp, "endpoint-1", fail), unavailable)
+	require.NoError(t, lb.export(context.Background(), exp, "endpoint-1", func() error { return nil }))
+	assert.ErrorIs(t, lb.export(context.Background(), exp, "endpoint-1", fail), unavailable)
+	assert.Equal(t, map[string]bool{"endpoint-1": true, "endpoint-2": true}, ringEndpoints(lb))
+
+	// the permanent errors are caused by the data
+	assert.Error(t, lb.export(context.Background(), exp, "endpoint-1", func() error { return consumererror.NewPermanent(unavailable) }))
+	assert.Equal(t, map[string]bool{"endpoint-1": true, "endpoint-2": true}, ringEndpoints(lb))
+
+	assert.ErrorIs(t, lb.export(context.Background(), exp, "endpoint-1", fail), unavailable)
+	assert.Equal(t, map[string]bool{"endpoint-2": true}, ringEndpoints(lb))
+
+	// the backend is added back once the circuit closes, a single failure opening it again
+	assert.Eventually(t, func() bool { return len(ringEndpoints(lb)) == 2 }, 5*time.Second, 10*time.Millisecond)
+	assert.ErrorIs(t, lb.export(context.Background(), exp, "endpoint-1", fail), unavailable)
+	assert.Equal(t, map[string]bool{"endpoint-2": true}, ringEndpoints(lb))
+
+	// the backends whose circuit is open stay out of the ring of a new resolution
+	lb.onBackendChanges([]string{"endpoint-1", "endpoint-2", "endpoint-3"})
+	assert.Equal(t, map[string]bool{"endpoint-2": true, "endpoint-3": true}, ringEndpoints(lb))
+}
+
+func TestCircuitBreakerKeepsLastBackend(t *testing.T) {
+	lb := newCircuitBreakerLoadBalancer(t, []string{"endpoint-1", "endpoint-2"}, &Config{
+		CircuitBreaker: CircuitBreakerSettings{Enabled: true, FailureThreshold: 1, OpenDuration: time.Minute},
+	})
+	defer func() { require.NoError(t, lb.Shutdown(context.Background())) }()
+	fail := func() error { return errors.New("unavailable") }
+
+	assert.Error(t, lb.export(context.Background(), lb.exporters["endpoint-1:4317"], "endpoint-1", fail))
+	assert.Error(t, lb.export(context.Background(), lb.exporters["endpoint-2:4317"], "endpoint-2", fail))
+	assert.Equal(t, map[string]bool{"endpoint-2": true}, ringEndpoints(lb))
+}
+
+func TestCircuitBreakerDisabled(t *testing.T) {
+	lb := newCircuitBreakerLoadBalancer(t, []string{"endpoint-1", "endpoint-2"}, &Config{})
+	exp := lb.exporters["endpoint-1:4317"]
+	for i := 0; i < 10; i++ {
+		assert.Error(t, lb.export(context.Background(), exp, "endpoint-1", func() error { return errors.New("unavailable") }))
+	}
+	assert.Equal(t, map[string]bool{"endpoint-1": true, "endpoint-2": true}, ringEndpoints(lb))
+}
+
+func TestExportMaxInFlight(t *testing.T) {
+	lb := newCircuitBreakerLoadBalancer(t, []string{"endpoint-1"}, &Config{MaxInFlightPerBackend: 1})
+	exp := lb.exporters["endpoint-1:4317"]
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	done := make(chan error)
+	go func() {
+		done <- lb.export(context.Background(), exp, "endpoint-1", func() error {
+			close(started)
+			<-release
+			return nil
+		})
+	}()
+	<-started
+
+	// the backend is saturated, the data is pushed back on the pipeline
+	err := lb.export(context.Background(), exp, "endpoint-1", func() error { return nil })
+	assert.ErrorIs(t, err, errBackendSaturated)
+	assert.False(t, consumererror.IsPermanent(err))
+
+	close(release)
+	require.NoError(t, <-done)
+	assert.NoError(t, lb.export(context.Background(), exp, "endpoint-1", func() error { return nil }))
+	assert.EqualValues(t, 0, exp.inFlight.Load())
+}
@@ -4,6 +4,7 @@
 package loadbalancingexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/loadbalancingexporter"
 
 import (
+	"errors"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/service/servicediscovery/types"
@@ -24,6 +25,45 @@ type Config struct {
 	Protocol   Protocol         `mapstructure:"protocol"`
 	Resolver   ResolverSettings `mapstructure:"resolver"`
 	RoutingKey string           `mapstructure:"routing_key"`
+
+	// CircuitBreaker removes the backends failing repeatedly from the ring for a while.
+	CircuitBreaker CircuitBreakerSettings `mapstructure:"circuit_breaker"`
+	// MaxInFlightPerBackend is the number of exports in flight to a backend above which the data
+	// routed to it is rejected, pushing back on the pipeline instead of piling up behind a slow
+	// backend. Unlimited when 0.
+	MaxInFlightPerBackend int `mapstructure:"max_in_flight_per_backend"`
+}
+
+// CircuitBreakerSettings defines when a failing backend is removed from the ring, its data
+// being routed to the other backends until it is tried again.
+type CircuitBreakerSettings struct {
+	Enabled bool `mapstructure:"enabled"`
+	// FailureThreshold is the number of consecutive failed exports opening the circuit of a backend.
+	FailureThreshold int `mapstructure:"failure_threshold"`
+	// OpenDuration is how long a backend is kept out of the ring before it is tried again.
+	OpenDuration time.Duration `mapstructure:"open_duration"`
+}
+
+// Validate checks the circuit breaker settings are valid.
+func (cfg *CircuitBreakerSettings) Validate() error {
+	if !cfg.Enabled {
+		return nil
+	}
+	if cfg.FailureThreshold <= 0 {
+		return errors.New("'circuit_breaker.failure_threshold' must be positive")
+	}
+	if cfg.OpenDuration <= 0 {
+		return errors.New("'circuit_breaker.open_duration' must be positive")
+	}
+	return nil
+}
+
+// Validate checks the exporter configuration is valid.
+func (cfg *Config) Validate() error {
+	if cfg.MaxInFlightPerBackend < 0 {
+		return errors.New("'max_in_flight_per_backend' cannot be negative")
+	}
+	return nil
 }
 
 // Protocol holds the individual protocol-specific settings. Only OTLP is supported at the moment.
@@ -6,7 +6,9 @@ package loadbalancingexporter
 import (
 	"path/filepath"
 	"testing"
+	"time"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/confmap/confmaptest"
@@ -25,3 +27,31 @@ func TestLoadConfig(t *testing.T) {
 	require.NoError(t, component.UnmarshalConfig(sub, cfg))
 	require.NotNil(t, cfg)
 }
+
+func TestLoadConfigCircuitBreaker(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+	cfg := NewFactory().CreateDefaultConfig().(*Config)
+	assert.Equal(t, CircuitBreakerSettings{FailureThreshold: defaultFailureThreshold, OpenDuration: defaultOpenDuration}, cfg.CircuitBreaker)
+
+	sub, err := cm.Sub(component.NewIDWithName(metadata.Type, "5").String())
+	require.NoError(t, err)
+	require.NoError(t, component.UnmarshalConfig(sub, cfg))
+	require.NoError(t, component.ValidateConfig(cfg))
+	assert.Equal(t, CircuitBreakerSettings{Enabled: true, FailureThreshold: 3, OpenDuration: time.Minute}, cfg.CircuitBreaker)
+	assert.Equal(t, 100, cfg.MaxInFlightPerBackend)
+}
+
+func TestConfigValidate(t *testing.T) {
+	cfg := NewFactory().CreateDefaultConfig().(*Config)
+	cfg.MaxInFlightPerBackend = -1
+	assert.EqualError(t, component.ValidateConfig(cfg), "'max_in_flight_per_backend' cannot be negative")
+
+	cfg = NewFactory().CreateDefaultConfig().(*Config)
+	cfg.CircuitBreaker = CircuitBreakerSettings{Enabled: true, OpenDuration: time.Second}
+	assert.ErrorContains(t, component.ValidateConfig(cfg), "'circuit_breaker.failure_threshold' must be positive")
+
+	// the settings are not checked while the circuit breaker is disabled
+	cfg.CircuitBreaker.Enabled = false
+	assert.NoError(t, component.ValidateConfig(cfg))
+}
@@ -38,6 +38,10 @@ func createDefaultConfig() component.Config {
 		Protocol: Protocol{
 			OTLP: *otlpDefaultCfg,
 		},
+		CircuitBreaker: CircuitBreakerSettings{
+			FailureThreshold: defaultFailureThreshold,
+			OpenDuration:     defaultOpenDuration,
+		},
 	}
 }
 
@@ -32,10 +32,17 @@ type loadBalancer struct {
 
 	res  resolver
 	ring *hashRing
+	// resolved and resolvedRing hold all the backends of the last resolution, the ring holding
+	// only the ones whose circuit is closed.
+	resolved     []string
+	resolvedRing *hashRing
 
 	componentFactory componentFactory
 	exporters        map[string]*wrappedExporter
 
+	circuitBreaker CircuitBreakerSettings
+	maxInFlight    int64
+
 	stopped    bool
 	updateLock sync.RWMutex
 }
@@ -109,6 +116,8 @@ func newLoadBalancer(params exporter.CreateSettings, cfg component.Config, facto
 		res:              res,
 		componentFactory: factory,
 		exporters:        map[string]*wrappedExporter{},
+		circuitBreaker:   oCfg.CircuitBreaker,
+		maxInFlight:      int64(oCfg.MaxInFlightPerBackend),
 	}, nil
 }
 
@@ -121,11 +130,12 @@ func (lb *loadBalancer) Start(ctx context.Context, host component.Host) error {
 func (lb *loadBalancer) onBackendChanges(resolved []string) {
 	newRing := newHashRing(resolved)
 
-	if !newRing.equal(lb.ring) {
+	if !newRing.equal(lb.resolvedRing) {
 		lb.updateLock.Lock()
 		defer lb.updateLock.Unlock()
 
-		lb.ring = newRing
+		lb.resolved = resolved
+		lb.resolvedRing = newRing
 
 		// TODO: set a timeout?
 		ctx := context.Background()
@@ -133,6 +143,9 @@ func (lb *loadBalancer) onBackendChanges(resolved []string) {
 		// add the missing exporters first
 		lb.addMissingExporters(ctx, resolved)
 		lb.removeExtraExporters(ctx, resolved)
+
+		// the backends whose circuit is open stay out of the new ring
+		lb.ring = lb.healthyRing()
 	}
 }
 
@@ -171,6 +184,9 @@ func (lb *loadBalancer) removeExtraExporters(ctx context.Context, endpoints []st
 	for existing := range lb.exporters {
 		if !endpointFound(existing, endpointsWithPort) {
 			exp := lb.exporters[existing]
+			if exp.circuitTimer != nil {
+				exp.circuitTimer.Stop()
+			}
 			// Shutdown the exporter asynchronously to avoid blocking the resolver
 			go func() {
 				_ = exp.Shutdown(ctx)
@@ -193,6 +209,13 @@ func endpointFound(endpoint string, endpoints []string) bool {
 func (lb *loadBalancer) Shutdown(ctx context.Context) error {
 	err := lb.res.shutdown(ctx)
 	lb.stopped = true
+	lb.updateLock.Lock()
+	for _, exp := range lb.exporters {
+		if exp.circuitTimer != nil {
+			exp.circuitTimer.Stop()
+		}
+	}
+	lb.updateLock.Unlock()
 	return err
 }
 
@@ -96,7 +96,9 @@ func (e *logExporterImp) consumeLog(ctx context.Context, ld plog.Logs) error {
 	defer le.consumeWG.Done()
 
 	start := time.Now()
-	err = le.ConsumeLogs(ctx, ld)
+	err = e.loadBalancer.export(ctx, le, endpoint, func() error {
+		return le.ConsumeLogs(ctx, ld)
+	})
 	duration := time.Since(start)
 	if err == nil {
 		_ = stats.RecordWithTags(
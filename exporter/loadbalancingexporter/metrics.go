@@ -14,6 +14,10 @@ var (
 	mNumBackends    = stats.Int64("loadbalancer_num_backends", "Current number of backends in use", stats.UnitDimensionless)
 	mBackendLatency = stats.Int64("loadbalancer_backend_latency", "Response latency in ms for the backends", stats.UnitMilliseconds)
 
+	mBackendInFlight    = stats.Int64("loadbalancer_backend_in_flight", "Number of exports in flight to the backends", stats.UnitDimensionless)
+	mBackendRejected    = stats.Int64("loadbalancer_backend_rejected", "Number of exports rejected as too many were in flight to the backends", stats.UnitDimensionless)
+	mBackendCircuitOpen = stats.Int64("loadbalancer_backend_circuit_open", "Whether the backends are removed from the ring, their circuit being open", stats.UnitDimensionless)
+
 	endpointTagKey      = tag.MustNewKey("endpoint")
 	successTrueMutator  = tag.Upsert(tag.MustNewKey("success"), "true")
 	successFalseMutator = tag.Upsert(tag.MustNewKey("success"), "false")
@@ -69,5 +73,32 @@ func metricViews() []*view.View {
 			},
 			Aggregation: view.Count(),
 		},
+		{
+			Name:        mBackendInFlight.Name(),
+			Measure:     mBackendInFlight,
+			Description: mBackendInFlight.Description(),
+			TagKeys: []tag.Key{
+				tag.MustNewKey("endpoint"),
+			},
+			Aggregation: view.LastValue(),
+		},
+		{
+			Name:        mBackendRejected.Name(),
+			Measure:     mBackendRejected,
+			Description: mBackendRejected.Description(),
+			TagKeys: []tag.Key{
+				tag.MustNewKey("endpoint"),
+			},
+			Aggregation: view.Count(),
+		},
+		{
+			Name:        mBackendCircuitOpen.Name(),
+			Measure:     mBackendCircuitOpen,
+			Description: mBackendCircuitOpen.Description(),
+			TagKeys: []tag.Key{
+				tag.MustNewKey("endpoint"),
+			},
+			Aggregation: view.LastValue(),
+		},
 	}
 }
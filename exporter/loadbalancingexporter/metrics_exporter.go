@@ -114,7 +114,9 @@ func (e *metricExporterImp) ConsumeMetrics(ctx context.Context, md pmetric.Metri
 
 	for exp, metrics := range exporterSegregatedMetrics {
 		start := time.Now()
-		err := exp.ConsumeMetrics(ctx, metrics)
+		err := e.loadBalancer.export(ctx, exp, endpoints[exp], func() error {
+			return exp.ConsumeMetrics(ctx, metrics)
+		})
 		exp.consumeWG.Done()
 		duration := time.Since(start)
 		errs = multierr.Append(errs, err)
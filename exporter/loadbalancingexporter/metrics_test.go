@@ -15,6 +15,10 @@ func TestProcessorMetrics(t *testing.T) {
 		"loadbalancer_num_backends",
 		"loadbalancer_num_backend_updates",
 		"loadbalancer_backend_latency",
+		"loadbalancer_backend_outcome",
+		"loadbalancer_backend_in_flight",
+		"loadbalancer_backend_rejected",
+		"loadbalancer_backend_circuit_open",
 	}
 
 	views := metricViews()
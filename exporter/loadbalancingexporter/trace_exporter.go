@@ -111,7 +111,9 @@ func (e *traceExporterImp) ConsumeTraces(ctx context.Context, td ptrace.Traces)
 
 	for exp, td := range exporterSegregatedTraces {
 		start := time.Now()
-		err := exp.ConsumeTraces(ctx, td)
+		err := e.loadBalancer.export(ctx, exp, endpoints[exp], func() error {
+			return exp.ConsumeTraces(ctx, td)
+		})
 		exp.consumeWG.Done()
 		errs = multierr.Append(errs, err)
 		duration := time.Since(start)
@@ -7,6 +7,8 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/exporter"
@@ -20,6 +22,16 @@ import (
 type wrappedExporter struct {
 	component.Component
 	consumeWG sync.WaitGroup
+
+	// inFlight is the number of exports in flight to the backend.
+	inFlight atomic.Int64
+	// consecutiveFailures is the number of exports which failed in a row, opening the circuit
+	// of the backend when it reaches the failure threshold.
+	consecutiveFailures atomic.Int64
+	// circuitOpen tells whether the backend is removed from the ring, and circuitTimer closes
+	// the circuit again. Both are guarded by the update lock of the load balancer.
+	circuitOpen  bool
+	circuitTimer *time.Timer
 }
 
 func newWrappedExporter(exp component.Component) *wrappedExporter {
@@ -0,0 +1,74 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mqttexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/mqttexporter"
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configretry"
+	"go.opentelemetry.io/collector/exporter/exporterhelper"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/mqtt"
+)
+
+// Config defines the configuration of the MQTT exporter.
+type Config struct {
+	exporterhelper.TimeoutSettings `mapstructure:",squash"` // squash ensures fields are correctly decoded in embedded struct.
+	exporterhelper.QueueSettings   `mapstructure:"sending_queue"`
+	configretry.BackOffConfig      `mapstructure:"retry_on_failure"`
+	mqtt.ClientConfig              `mapstructure:",squash"`
+
+	// Encoding is the encoding of the payloads of the messages: otlp_proto or otlp_json.
+	Encoding string `mapstructure:"encoding"`
+
+	// Logs defines the messages of the logs.
+	Logs TopicConfig `mapstructure:"logs"`
+	// Metrics defines the messages of the metrics.
+	Metrics TopicConfig `mapstructure:"metrics"`
+	// Traces defines the messages of the traces.
+	Traces TopicConfig `mapstructure:"traces"`
+}
+
+// TopicConfig defines the messages a signal is published with.
+type TopicConfig struct {
+	// Topic is the topic the data is published to. Its placeholders {attribute} are replaced by
+	// the values of the resource attributes, e.g. gateways/{host.name}/logs, the data of the
+	// resources being published to their topic.
+	Topic string `mapstructure:"topic"`
+
+	// QoS is the quality of service of the messages: 0 (at most once), 1 (at least once) or 2
+	// (exactly once).
+	QoS int `mapstructure:"qos"`
+
+	// Retain has the broker keep the last message of the topic for the future subscribers.
+	Retain bool `mapstructure:"retain"`
+}
+
+var _ component.Config = (*Config)(nil)
+
+// Validate checks if the exporter configuration is valid.
+func (cfg *Config) Validate() error {
+	var errs []error
+	if _, ok := marshalers[cfg.Encoding]; !ok {
+		errs = append(errs, fmt.Errorf("unsupported 'encoding' '%s', must be one of 'otlp_proto' and 'otlp_json'", cfg.Encoding))
+	}
+	errs = append(errs, cfg.Logs.validate("logs"))
+	errs = append(errs, cfg.Metrics.validate("metrics"))
+	errs = append(errs, cfg.Traces.validate("traces"))
+	return errors.Join(errs...)
+}
+
+func (cfg TopicConfig) validate(signal string) error {
+	var errs []error
+	if cfg.Topic == "" {
+		errs = append(errs, fmt.Errorf("'%s.topic' must not be empty", signal))
+	} else if strings.ContainsAny(cfg.Topic, "+#") {
+		errs = append(errs, fmt.Errorf("'%s.topic' '%s' must not contain the wildcards '+' and '#'", signal, cfg.Topic))
+	}
+	errs = append(errs, mqtt.ValidateQoS(signal+".qos", cfg.QoS))
+	return errors.Join(errs...)
+}
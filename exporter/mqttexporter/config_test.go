@@ -0,0 +1,78 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mqttexporter
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/mqttexporter/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/mqtt"
+)
+
+func TestLoadConfig(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+	factory := NewFactory()
+
+	tests := []struct {
+		id       component.ID
+		expected func(*Config)
+	}{
+		{
+			id:       component.NewIDWithName(metadata.Type, ""),
+			expected: func(*Config) {},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "customname"),
+			expected: func(cfg *Config) {
+				cfg.Endpoint = "mqtts://broker.example.com:8883"
+				cfg.ClientID = "gateway-42"
+				cfg.Auth = mqtt.AuthConfig{Username: "gateway", Password: "secret"}
+				cfg.TLSSetting.ServerName = "broker.internal"
+				cfg.KeepAlive = time.Minute
+				cfg.Timeout = 10 * time.Second
+				cfg.Encoding = "otlp_json"
+				cfg.Logs = TopicConfig{Topic: "gateways/{host.name}/logs", QoS: 2}
+				cfg.Metrics = TopicConfig{Topic: "gateways/{host.name}/metrics", QoS: 0, Retain: true}
+				cfg.Traces = TopicConfig{Topic: "gateways/{host.name}/traces", QoS: 1}
+				cfg.QueueSettings.Enabled = false
+				cfg.BackOffConfig.Enabled = false
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			cfg := factory.CreateDefaultConfig()
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, component.UnmarshalConfig(sub, cfg))
+			require.NoError(t, component.ValidateConfig(cfg))
+
+			expected := factory.CreateDefaultConfig().(*Config)
+			tt.expected(expected)
+			assert.Equal(t, expected, cfg)
+		})
+	}
+}
+
+func TestConfigValidate(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+	cfg := NewFactory().CreateDefaultConfig().(*Config)
+	sub, err := cm.Sub(component.NewIDWithName(metadata.Type, "invalid").String())
+	require.NoError(t, err)
+	require.NoError(t, component.UnmarshalConfig(sub, cfg))
+
+	assert.EqualError(t, cfg.Validate(), "unsupported 'encoding' 'avro', must be one of 'otlp_proto' and 'otlp_json'\n"+
+		"'logs.topic' 'otel/+/logs' must not contain the wildcards '+' and '#'\n"+
+		"unsupported 'logs.qos' 3, must be 0, 1 or 2\n"+
+		"'traces.topic' must not be empty")
+}
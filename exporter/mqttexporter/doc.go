@@ -0,0 +1,8 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:generate mdatagen metadata.yaml
+
+// Package mqttexporter publishes the logs, the metrics and the traces to an MQTT broker with
+// the MQTT v5 protocol, e.g. to relay the telemetry of IoT gateways over their existing broker.
+package mqttexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/mqttexporter"
@@ -0,0 +1,160 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mqttexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/mqttexporter"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/eclipse/paho.golang/paho"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/mqtt"
+)
+
+// publisher is the part of the connection to the broker publishing the messages.
+type publisher interface {
+	AwaitConnection(ctx context.Context) error
+	Publish(ctx context.Context, p *paho.Publish) (*paho.PublishResponse, error)
+	Disconnect(ctx context.Context) error
+}
+
+type mqttExporter struct {
+	config    *Config
+	logger    *zap.Logger
+	marshaler marshaler
+
+	logsTopic    topicTemplate
+	metricsTopic topicTemplate
+	tracesTopic  topicTemplate
+
+	conn publisher
+}
+
+func newMQTTExporter(config *Config, logger *zap.Logger) *mqttExporter {
+	return &mqttExporter{
+		config:       config,
+		logger:       logger,
+		marshaler:    marshalers[config.Encoding],
+		logsTopic:    newTopicTemplate(config.Logs.Topic),
+		metricsTopic: newTopicTemplate(config.Metrics.Topic),
+		tracesTopic:  newTopicTemplate(config.Traces.Topic),
+	}
+}
+
+func (e *mqttExporter) Start(ctx context.Context, _ component.Host) error {
+	conn, err := mqtt.NewConnection(ctx, e.config.ClientConfig, e.logger, nil, nil)
+	if err != nil {
+		return err
+	}
+	e.conn = conn
+	return nil
+}
+
+func (e *mqttExporter) Shutdown(ctx context.Context) error {
+	if e.conn == nil {
+		return nil
+	}
+	return e.conn.Disconnect(ctx)
+}
+
+func (e *mqttExporter) consumeLogs(ctx context.Context, ld plog.Logs) error {
+	byTopic := map[string]plog.Logs{}
+	if topic, ok := e.logsTopic.static(); ok {
+		byTopic[topic] = ld
+	} else {
+		for i := 0; i < ld.ResourceLogs().Len(); i++ {
+			rl := ld.ResourceLogs().At(i)
+			topic := e.logsTopic.render(rl.Resource().Attributes())
+			if _, ok := byTopic[topic]; !ok {
+				byTopic[topic] = plog.NewLogs()
+			}
+			rl.CopyTo(byTopic[topic].ResourceLogs().AppendEmpty())
+		}
+	}
+	var errs []error
+	for topic, logs := range byTopic {
+		payload, err := e.marshaler.logs.MarshalLogs(logs)
+		if err != nil {
+			return consumererror.NewPermanent(fmt.Errorf("failed to marshal the logs: %w", err))
+		}
+		errs = append(errs, e.publish(ctx, topic, e.config.Logs, payload))
+	}
+	return errors.Join(errs...)
+}
+
+func (e *mqttExporter) consumeMetrics(ctx context.Context, md pmetric.Metrics) error {
+	byTopic := map[string]pmetric.Metrics{}
+	if topic, ok := e.metricsTopic.static(); ok {
+		byTopic[topic] = md
+	} else {
+		for i := 0; i < md.ResourceMetrics().Len(); i++ {
+			rm := md.ResourceMetrics().At(i)
+			topic := e.metricsTopic.render(rm.Resource().Attributes())
+			if _, ok := byTopic[topic]; !ok {
+				byTopic[topic] = pmetric.NewMetrics()
+			}
+			rm.CopyTo(byTopic[topic].ResourceMetrics().AppendEmpty())
+		}
+	}
+	var errs []error
+	for topic, metrics := range byTopic {
+		payload, err := e.marshaler.metrics.MarshalMetrics(metrics)
+		if err != nil {
+			return consumererror.NewPermanent(fmt.Errorf("failed to marshal the metrics: %w", err))
+		}
+		errs = append(errs, e.publish(ctx, topic, e.config.Metrics, payload))
+	}
+	return errors.Join(errs...)
+}
+
+func (e *mqttExporter) consumeTraces(ctx context.Context, td ptrace.Traces) error {
+	byTopic := map[string]ptrace.Traces{}
+	if topic, ok := e.tracesTopic.static(); ok {
+		byTopic[topic] = td
+	} else {
+		for i := 0; i < td.ResourceSpans().Len(); i++ {
+			rs := td.ResourceSpans().At(i)
+			topic := e.tracesTopic.render(rs.Resource().Attributes())
+			if _, ok := byTopic[topic]; !ok {
+				byTopic[topic] = ptrace.NewTraces()
+			}
+			rs.CopyTo(byTopic[topic].ResourceSpans().AppendEmpty())
+		}
+	}
+	var errs []error
+	for topic, traces := range byTopic {
+		payload, err := e.marshaler.traces.MarshalTraces(traces)
+		if err != nil {
+			return consumererror.NewPermanent(fmt.Errorf("failed to marshal the traces: %w", err))
+		}
+		errs = append(errs, e.publish(ctx, topic, e.config.Traces, payload))
+	}
+	return errors.Join(errs...)
+}
+
+// publish publishes the payload to the topic once connected to the broker, waiting for its
+// acknowledgement with the QoS 1 and 2.
+func (e *mqttExporter) publish(ctx context.Context, topic string, config TopicConfig, payload []byte) error {
+	if err := e.conn.AwaitConnection(ctx); err != nil {
+		return fmt.Errorf("not connected to the MQTT broker: %w", err)
+	}
+	_, err := e.conn.Publish(ctx, &paho.Publish{
+		QoS:        byte(config.QoS),
+		Retain:     config.Retain,
+		Topic:      topic,
+		Properties: &paho.PublishProperties{ContentType: e.marshaler.contentType},
+		Payload:    payload,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to publish to the topic '%s': %w", topic, err)
+	}
+	return nil
+}
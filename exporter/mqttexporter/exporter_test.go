@@ -0,0 +1,144 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mqttexporter
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"testing"
+
+	"github.com/eclipse/paho.golang/paho"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
+)
+
+type fakePublisher struct {
+	published []*paho.Publish
+	err       error
+}
+
+func (p *fakePublisher) AwaitConnection(context.Context) error {
+	return nil
+}
+
+func (p *fakePublisher) Publish(_ context.Context, publish *paho.Publish) (*paho.PublishResponse, error) {
+	if p.err != nil {
+		return nil, p.err
+	}
+	p.published = append(p.published, publish)
+	return &paho.PublishResponse{}, nil
+}
+
+func (p *fakePublisher) Disconnect(context.Context) error {
+	return nil
+}
+
+func newTestExporter(t *testing.T, configure func(*Config)) (*mqttExporter, *fakePublisher) {
+	cfg := createDefaultConfig().(*Config)
+	configure(cfg)
+	require.NoError(t, cfg.Validate())
+	exp := newMQTTExporter(cfg, zap.NewNop())
+	conn := &fakePublisher{}
+	exp.conn = conn
+	return exp, conn
+}
+
+func TestConsumeLogs(t *testing.T) {
+	exp, conn := newTestExporter(t, func(cfg *Config) {
+		cfg.Logs = TopicConfig{Topic: "gateways/{host.name}/logs", QoS: 2}
+	})
+
+	ld := plog.NewLogs()
+	for _, host := range []string{"gateway-1", "gateway-2", "gateway-1"} {
+		rl := ld.ResourceLogs().AppendEmpty()
+		rl.Resource().Attributes().PutStr("host.name", host)
+		rl.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Body().SetStr("door opened")
+	}
+	require.NoError(t, exp.consumeLogs(context.Background(), ld))
+
+	// a message per topic, with the resources of the topic
+	require.Len(t, conn.published, 2)
+	sort.Slice(conn.published, func(i, j int) bool { return conn.published[i].Topic < conn.published[j].Topic })
+	for i, expected := range []struct {
+		topic     string
+		resources int
+	}{
+		{topic: "gateways/gateway-1/logs", resources: 2},
+		{topic: "gateways/gateway-2/logs", resources: 1},
+	} {
+		publish := conn.published[i]
+		assert.Equal(t, expected.topic, publish.Topic)
+		assert.EqualValues(t, 2, publish.QoS)
+		assert.False(t, publish.Retain)
+		assert.Equal(t, "application/x-protobuf", publish.Properties.ContentType)
+
+		logs, err := (&plog.ProtoUnmarshaler{}).UnmarshalLogs(publish.Payload)
+		require.NoError(t, err)
+		assert.Equal(t, expected.resources, logs.ResourceLogs().Len())
+		assert.Equal(t, expected.resources, logs.LogRecordCount())
+	}
+}
+
+func TestConsumeMetrics(t *testing.T) {
+	exp, conn := newTestExporter(t, func(cfg *Config) {
+		cfg.Encoding = "otlp_json"
+		cfg.Metrics = TopicConfig{Topic: "otel/metrics", QoS: 0, Retain: true}
+	})
+
+	md := pmetric.NewMetrics()
+	for _, host := range []string{"gateway-1", "gateway-2"} {
+		rm := md.ResourceMetrics().AppendEmpty()
+		rm.Resource().Attributes().PutStr("host.name", host)
+		m := rm.ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+		m.SetName("temperature")
+		m.SetEmptyGauge().DataPoints().AppendEmpty().SetDoubleValue(21.5)
+	}
+	require.NoError(t, exp.consumeMetrics(context.Background(), md))
+
+	// the static topics publish the data as is
+	require.Len(t, conn.published, 1)
+	publish := conn.published[0]
+	assert.Equal(t, "otel/metrics", publish.Topic)
+	assert.EqualValues(t, 0, publish.QoS)
+	assert.True(t, publish.Retain)
+	assert.Equal(t, "application/json", publish.Properties.ContentType)
+
+	metrics, err := (&pmetric.JSONUnmarshaler{}).UnmarshalMetrics(publish.Payload)
+	require.NoError(t, err)
+	assert.Equal(t, md, metrics)
+}
+
+func TestConsumeTraces(t *testing.T) {
+	exp, conn := newTestExporter(t, func(cfg *Config) {
+		cfg.Traces.Topic = "services/{service.name}/traces"
+	})
+
+	td := ptrace.NewTraces()
+	rs := td.ResourceSpans().AppendEmpty()
+	rs.Resource().Attributes().PutStr("service.name", "sensors/temperature")
+	rs.ScopeSpans().AppendEmpty().Spans().AppendEmpty().SetName("read")
+	require.NoError(t, exp.consumeTraces(context.Background(), td))
+
+	require.Len(t, conn.published, 1)
+	assert.Equal(t, "services/sensors_temperature/traces", conn.published[0].Topic)
+	assert.EqualValues(t, 1, conn.published[0].QoS)
+	traces, err := (&ptrace.ProtoUnmarshaler{}).UnmarshalTraces(conn.published[0].Payload)
+	require.NoError(t, err)
+	assert.Equal(t, td, traces)
+
+	// the failed publications are retried by the exporter helper
+	conn.err = errors.New("PUBACK reason code 151: quota exceeded")
+	err = exp.consumeTraces(context.Background(), td)
+	assert.EqualError(t, err, "failed to publish to the topic 'services/sensors_temperature/traces': PUBACK reason code 151: quota exceeded")
+}
+
+func TestShutdownWithoutStart(t *testing.T) {
+	exp := newMQTTExporter(createDefaultConfig().(*Config), zap.NewNop())
+	assert.NoError(t, exp.Shutdown(context.Background()))
+}
@@ -0,0 +1,119 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mqttexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/mqttexporter"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configretry"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/exporter"
+	"go.opentelemetry.io/collector/exporter/exporterhelper"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/mqttexporter/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/mqtt"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/sharedcomponent"
+)
+
+const defaultQoS = 1
+
+// NewFactory creates a factory for the MQTT exporter.
+func NewFactory() exporter.Factory {
+	return exporter.NewFactory(
+		metadata.Type,
+		createDefaultConfig,
+		exporter.WithTraces(createTracesExporter, metadata.TracesStability),
+		exporter.WithMetrics(createMetricsExporter, metadata.MetricsStability),
+		exporter.WithLogs(createLogsExporter, metadata.LogsStability))
+}
+
+func createDefaultConfig() component.Config {
+	return &Config{
+		TimeoutSettings: exporterhelper.NewDefaultTimeoutSettings(),
+		QueueSettings:   exporterhelper.NewDefaultQueueSettings(),
+		BackOffConfig:   configretry.NewDefaultBackOffConfig(),
+		ClientConfig:    mqtt.NewDefaultClientConfig(),
+		Encoding:        "otlp_proto",
+		Logs:            TopicConfig{Topic: "otel/logs", QoS: defaultQoS},
+		Metrics:         TopicConfig{Topic: "otel/metrics", QoS: defaultQoS},
+		Traces:          TopicConfig{Topic: "otel/traces", QoS: defaultQoS},
+	}
+}
+
+func createTracesExporter(
+	ctx context.Context,
+	set exporter.CreateSettings,
+	cfg component.Config,
+) (exporter.Traces, error) {
+	eCfg := cfg.(*Config)
+	exp := getOrCreateMQTTExporter(eCfg, set)
+	return exporterhelper.NewTracesExporter(
+		ctx,
+		set,
+		cfg,
+		exp.Unwrap().(*mqttExporter).consumeTraces,
+		exporterhelper.WithCapabilities(consumer.Capabilities{MutatesData: false}),
+		exporterhelper.WithTimeout(eCfg.TimeoutSettings),
+		exporterhelper.WithRetry(eCfg.BackOffConfig),
+		exporterhelper.WithQueue(eCfg.QueueSettings),
+		exporterhelper.WithStart(exp.Start),
+		exporterhelper.WithShutdown(exp.Shutdown),
+	)
+}
+
+func createMetricsExporter(
+	ctx context.Context,
+	set exporter.CreateSettings,
+	cfg component.Config,
+) (exporter.Metrics, error) {
+	eCfg := cfg.(*Config)
+	exp := getOrCreateMQTTExporter(eCfg, set)
+	return exporterhelper.NewMetricsExporter(
+		ctx,
+		set,
+		cfg,
+		exp.Unwrap().(*mqttExporter).consumeMetrics,
+		exporterhelper.WithCapabilities(consumer.Capabilities{MutatesData: false}),
+		exporterhelper.WithTimeout(eCfg.TimeoutSettings),
+		exporterhelper.WithRetry(eCfg.BackOffConfig),
+		exporterhelper.WithQueue(eCfg.QueueSettings),
+		exporterhelper.WithStart(exp.Start),
+		exporterhelper.WithShutdown(exp.Shutdown),
+	)
+}
+
+func createLogsExporter(
+	ctx context.Context,
+	set exporter.CreateSettings,
+	cfg component.Config,
+) (exporter.Logs, error) {
+	eCfg := cfg.(*Config)
+	exp := getOrCreateMQTTExporter(eCfg, set)
+	return exporterhelper.NewLogsExporter(
+		ctx,
+		set,
+		cfg,
+		exp.Unwrap().(*mqttExporter).consumeLogs,
+		exporterhelper.WithCapabilities(consumer.Capabilities{MutatesData: false}),
+		exporterhelper.WithTimeout(eCfg.TimeoutSettings),
+		exporterhelper.WithRetry(eCfg.BackOffConfig),
+		exporterhelper.WithQueue(eCfg.QueueSettings),
+		exporterhelper.WithStart(exp.Start),
+		exporterhelper.WithShutdown(exp.Shutdown),
+	)
+}
+
+// getOrCreateMQTTExporter returns the exporter of the configuration, the signals sharing its
+// connection since the broker only accepts a single connection per client ID.
+func getOrCreateMQTTExporter(cfg *Config, set exporter.CreateSettings) *sharedcomponent.SharedComponent {
+	return exporters.GetOrAdd(cfg, func() component.Component {
+		return newMQTTExporter(cfg, set.Logger)
+	})
+}
+
+// This is the map of already created MQTT exporters for particular configurations.
+// We maintain this map because the Factory is asked trace, metric and log exporters
+// separately but they must share a connection to the broker.
+var exporters = sharedcomponent.NewSharedComponents()
@@ -0,0 +1,35 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mqttexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/mqttexporter"
+
+import (
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+// marshaler encodes the payloads of the messages.
+type marshaler struct {
+	logs    plog.Marshaler
+	metrics pmetric.Marshaler
+	traces  ptrace.Marshaler
+	// contentType is the content type property of the messages.
+	contentType string
+}
+
+// marshalers are the marshalers by encoding.
+var marshalers = map[string]marshaler{
+	"otlp_proto": {
+		logs:        &plog.ProtoMarshaler{},
+		metrics:     &pmetric.ProtoMarshaler{},
+		traces:      &ptrace.ProtoMarshaler{},
+		contentType: "application/x-protobuf",
+	},
+	"otlp_json": {
+		logs:        &plog.JSONMarshaler{},
+		metrics:     &pmetric.JSONMarshaler{},
+		traces:      &ptrace.JSONMarshaler{},
+		contentType: "application/json",
+	},
+}
@@ -0,0 +1,67 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mqttexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/mqttexporter"
+
+import (
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+// undefinedValue replaces the placeholders of the attributes a resource does not have.
+const undefinedValue = "undefined"
+
+// topicValueReplacer replaces the characters of the attribute values which would split or match
+// the levels of the topics.
+var topicValueReplacer = strings.NewReplacer("/", "_", "+", "_", "#", "_")
+
+// topicTemplate is a topic whose placeholders {attribute} are replaced by the values of the
+// resource attributes. A '{' without a closing '}' is kept as is.
+type topicTemplate struct {
+	// parts are the literal parts of the topic, alternating with the names of the attributes.
+	parts []string
+}
+
+func newTopicTemplate(topic string) topicTemplate {
+	var parts []string
+	for {
+		start := strings.IndexByte(topic, '{')
+		if start < 0 {
+			break
+		}
+		end := strings.IndexByte(topic[start:], '}')
+		if end < 0 {
+			break
+		}
+		parts = append(parts, topic[:start], topic[start+1:start+end])
+		topic = topic[start+end+1:]
+	}
+	return topicTemplate{parts: append(parts, topic)}
+}
+
+// static returns the topic if it has no placeholders, the same for all the resources.
+func (t topicTemplate) static() (string, bool) {
+	return t.parts[0], len(t.parts) == 1
+}
+
+// render returns the topic of the resource with the given attributes.
+func (t topicTemplate) render(attributes pcommon.Map) string {
+	if topic, ok := t.static(); ok {
+		return topic
+	}
+	var topic strings.Builder
+	for i, part := range t.parts {
+		if i%2 == 0 {
+			topic.WriteString(part)
+			continue
+		}
+		value, ok := attributes.Get(part)
+		if !ok {
+			topic.WriteString(undefinedValue)
+			continue
+		}
+		topic.WriteString(topicValueReplacer.Replace(value.AsString()))
+	}
+	return topic.String()
+}
@@ -0,0 +1,38 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mqttexporter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+func TestTopicTemplate(t *testing.T) {
+	attributes := pcommon.NewMap()
+	attributes.PutStr("host.name", "gateway-42")
+	attributes.PutStr("service.name", "sensors/temperature")
+	attributes.PutInt("site.id", 7)
+
+	tests := []struct {
+		topic    string
+		expected string
+		static   bool
+	}{
+		{topic: "otel/logs", expected: "otel/logs", static: true},
+		{topic: "gateways/{host.name}/logs", expected: "gateways/gateway-42/logs"},
+		{topic: "sites/{site.id}/{host.name}", expected: "sites/7/gateway-42"},
+		{topic: "services/{service.name}/traces", expected: "services/sensors_temperature/traces"},
+		{topic: "regions/{cloud.region}/metrics", expected: "regions/undefined/metrics"},
+		{topic: "{host.name}", expected: "gateway-42"},
+		{topic: "devices/{host.name/logs", expected: "devices/{host.name/logs", static: true},
+	}
+	for _, tt := range tests {
+		template := newTopicTemplate(tt.topic)
+		_, static := template.static()
+		assert.Equal(t, tt.static, static, tt.topic)
+		assert.Equal(t, tt.expected, template.render(attributes), tt.topic)
+	}
+}
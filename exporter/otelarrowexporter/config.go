@@ -35,6 +35,13 @@ type Config struct {
 	// Arrow includes settings specific to OTel Arrow.
 	Arrow ArrowConfig `mapstructure:"arrow"`
 
+	// MaxRequestSize is the largest size in bytes of a request,
+	// as estimated by its OTLP protobuf encoding.  Larger batches
+	// are split before being sent, instead of being rejected by
+	// the receiver, e.g. by the 4MiB default limit of gRPC
+	// servers.  Zero disables splitting.
+	MaxRequestSize int `mapstructure:"max_request_size"`
+
 	// UserDialOptions cannot be configured via `mapstructure`
 	// schemes.  This is useful for custom purposes where the
 	// exporter is built and configured via code instead of yaml.
@@ -79,12 +86,44 @@ type ArrowConfig struct {
 	// Prioritizer is a policy name for how load is distributed
 	// across streams.
 	Prioritizer arrow.PrioritizerName `mapstructure:"prioritizer"`
+
+	// Dictionary controls the Arrow dictionary encoding of the
+	// producer.
+	Dictionary DictionaryConfig `mapstructure:"dictionary"`
+}
+
+// DictionaryConfig controls the size of the Arrow dictionaries and
+// when they are reset.
+type DictionaryConfig struct {
+	// IndexLimit is the largest index type a dictionary may use
+	// before falling back to non-dictionary encoding.  One of
+	// "none", "uint8", "uint16", "uint32", or "uint64".  The value
+	// "none" disables dictionary encoding.  When empty, the
+	// default of the underlying library (uint16) is used.
+	IndexLimit string `mapstructure:"index_limit"`
+
+	// ResetThreshold is the ratio of unique values to values
+	// inserted under which a dictionary overflow resets the
+	// dictionary instead of falling back to non-dictionary
+	// encoding.  When zero, the default of the underlying
+	// library (0.3) is used.
+	ResetThreshold float64 `mapstructure:"reset_threshold"`
 }
 
 var _ component.Config = (*Config)(nil)
 
+var _ component.ConfigValidator = (*Config)(nil)
+
 var _ component.ConfigValidator = (*ArrowConfig)(nil)
 
+// Validate returns an error when the maximum request size is negative.
+func (cfg *Config) Validate() error {
+	if cfg.MaxRequestSize < 0 {
+		return fmt.Errorf("max request size must be >= 0: %d", cfg.MaxRequestSize)
+	}
+	return nil
+}
+
 // Validate returns an error when the number of streams is less than 1.
 func (cfg *ArrowConfig) Validate() error {
 	if cfg.NumStreams < 1 {
@@ -110,9 +149,36 @@ func (cfg *ArrowConfig) Validate() error {
 	default:
 		return fmt.Errorf("unsupported payload compression: %s", cfg.PayloadCompression)
 	}
+
+	if err := cfg.Dictionary.Validate(); err != nil {
+		return fmt.Errorf("invalid dictionary: %w", err)
+	}
+	return nil
+}
+
+// Validate returns an error for an unknown index limit or a reset
+// threshold outside of [0, 1].
+func (cfg *DictionaryConfig) Validate() error {
+	if _, ok := dictionaryIndexLimits[cfg.IndexLimit]; !ok {
+		return fmt.Errorf("unsupported index limit: %s", cfg.IndexLimit)
+	}
+	if cfg.ResetThreshold < 0 || cfg.ResetThreshold > 1 {
+		return fmt.Errorf("reset threshold must be between 0 and 1: %v", cfg.ResetThreshold)
+	}
 	return nil
 }
 
+// dictionaryIndexLimits maps the supported index limits to their
+// producer option, nil meaning the library default.
+var dictionaryIndexLimits = map[string]config.Option{
+	"":       nil,
+	"none":   config.WithNoDictionary(),
+	"uint8":  config.WithUint8LimitDictIndex(),
+	"uint16": config.WithUint16LimitDictIndex(),
+	"uint32": config.WithUint32LimitDictIndex(),
+	"uint64": config.WithUint64LimitDictIndex(),
+}
+
 func (cfg *ArrowConfig) toArrowProducerOptions() (arrowOpts []config.Option) {
 	switch cfg.PayloadCompression {
 	case configcompression.TypeZstd:
@@ -122,5 +188,11 @@ func (cfg *ArrowConfig) toArrowProducerOptions() (arrowOpts []config.Option) {
 	default:
 		// Should have failed in validate, nothing we can do.
 	}
+	if opt := dictionaryIndexLimits[cfg.Dictionary.IndexLimit]; opt != nil {
+		arrowOpts = append(arrowOpts, opt)
+	}
+	if cfg.Dictionary.ResetThreshold > 0 {
+		arrowOpts = append(arrowOpts, config.WithDictResetThreshold(cfg.Dictionary.ResetThreshold))
+	}
 	return
 }
@@ -90,7 +90,12 @@ func TestUnmarshalConfig(t *testing.T) {
 				PayloadCompression: configcompression.TypeZstd,
 				Zstd:               zstd.DefaultEncoderConfig(),
 				Prioritizer:        "leastloaded8",
+				Dictionary: DictionaryConfig{
+					IndexLimit:     "uint32",
+					ResetThreshold: 0.5,
+				},
 			},
+			MaxRequestSize: 4194304,
 		}, cfg)
 }
 
@@ -122,6 +127,17 @@ func TestArrowConfigValidate(t *testing.T) {
 	require.Error(t, settings(true, math.MaxInt, 10*time.Second, zstd.MaxLevel+1).Validate())
 }
 
+func TestConfigValidateMaxRequestSize(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	require.NoError(t, cfg.Validate())
+
+	cfg.MaxRequestSize = 4 << 20
+	require.NoError(t, cfg.Validate())
+
+	cfg.MaxRequestSize = -1
+	require.ErrorContains(t, cfg.Validate(), "max request size must be >= 0")
+}
+
 func TestDefaultConfigValid(t *testing.T) {
 	cfg := createDefaultConfig()
 	// this must be set by the user and config
@@ -153,3 +169,58 @@ func TestArrowConfigPayloadCompressionNone(t *testing.T) {
 		require.False(t, config.Zstd)
 	}
 }
+
+func TestArrowConfigDictionary(t *testing.T) {
+	for _, test := range []struct {
+		indexLimit string
+		expect     uint64
+	}{
+		{"", math.MaxUint16},
+		{"none", 0},
+		{"uint8", math.MaxUint8},
+		{"uint16", math.MaxUint16},
+		{"uint32", math.MaxUint32},
+		{"uint64", math.MaxUint64},
+	} {
+		settings := ArrowConfig{
+			Dictionary: DictionaryConfig{
+				IndexLimit: test.indexLimit,
+			},
+		}
+		cfg := config.DefaultConfig()
+		for _, opt := range settings.toArrowProducerOptions() {
+			opt(cfg)
+		}
+		require.Equal(t, test.expect, cfg.LimitIndexSize, test.indexLimit)
+		require.Equal(t, 0.3, cfg.DictResetThreshold)
+	}
+
+	settings := ArrowConfig{
+		Dictionary: DictionaryConfig{
+			ResetThreshold: 0.75,
+		},
+	}
+	cfg := config.DefaultConfig()
+	for _, opt := range settings.toArrowProducerOptions() {
+		opt(cfg)
+	}
+	require.Equal(t, 0.75, cfg.DictResetThreshold)
+}
+
+func TestArrowConfigDictionaryValidate(t *testing.T) {
+	settings := func(indexLimit string, resetThreshold float64) *ArrowConfig {
+		cfg := createDefaultConfig().(*Config).Arrow
+		cfg.MaxStreamLifetime = 10 * time.Second
+		cfg.Dictionary = DictionaryConfig{
+			IndexLimit:     indexLimit,
+			ResetThreshold: resetThreshold,
+		}
+		return &cfg
+	}
+	require.NoError(t, settings("", 0).Validate())
+	require.NoError(t, settings("none", 1).Validate())
+	require.NoError(t, settings("uint8", 0.3).Validate())
+	require.Error(t, settings("int8", 0).Validate())
+	require.Error(t, settings("", -0.1).Validate())
+	require.Error(t, settings("", 1.5).Validate())
+}
@@ -19,10 +19,14 @@ import (
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/exporter"
 	"go.opentelemetry.io/collector/exporter/exporterhelper"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
 	"google.golang.org/grpc"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/otelarrowexporter/internal/arrow"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/otelarrowexporter/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/batchpersize"
 )
 
 // NewFactory creates a factory for OTLP exporter.
@@ -69,7 +73,8 @@ func createDefaultConfig() component.Config {
 
 func (exp *baseExporter) helperOptions() []exporterhelper.Option {
 	return []exporterhelper.Option{
-		exporterhelper.WithCapabilities(consumer.Capabilities{MutatesData: false}),
+		// Splitting the batches larger than max_request_size moves their data.
+		exporterhelper.WithCapabilities(consumer.Capabilities{MutatesData: exp.config.MaxRequestSize > 0}),
 		exporterhelper.WithTimeout(exp.config.TimeoutSettings),
 		exporterhelper.WithRetry(exp.config.RetryConfig),
 		exporterhelper.WithQueue(exp.config.QueueSettings),
@@ -101,8 +106,12 @@ func createTracesExporter(
 	if err != nil {
 		return nil, err
 	}
+	next, err := consumer.NewTraces(exp.pushTraces)
+	if err != nil {
+		return nil, err
+	}
 	return exporterhelper.NewTracesExporter(ctx, exp.settings, exp.config,
-		exp.pushTraces,
+		batchpersize.NewBatchPerSizeTraces(exp.config.MaxRequestSize, &ptrace.ProtoMarshaler{}, next).ConsumeTraces,
 		exp.helperOptions()...,
 	)
 }
@@ -120,8 +129,12 @@ func createMetricsExporter(
 	if err != nil {
 		return nil, err
 	}
+	next, err := consumer.NewMetrics(exp.pushMetrics)
+	if err != nil {
+		return nil, err
+	}
 	return exporterhelper.NewMetricsExporter(ctx, exp.settings, exp.config,
-		exp.pushMetrics,
+		batchpersize.NewBatchPerSizeMetrics(exp.config.MaxRequestSize, &pmetric.ProtoMarshaler{}, next).ConsumeMetrics,
 		exp.helperOptions()...,
 	)
 }
@@ -139,8 +152,12 @@ func createLogsExporter(
 	if err != nil {
 		return nil, err
 	}
+	next, err := consumer.NewLogs(exp.pushLogs)
+	if err != nil {
+		return nil, err
+	}
 	return exporterhelper.NewLogsExporter(ctx, exp.settings, exp.config,
-		exp.pushLogs,
+		batchpersize.NewBatchPerSizeLogs(exp.config.MaxRequestSize, &plog.ProtoMarshaler{}, next).ConsumeLogs,
 		exp.helperOptions()...,
 	)
 }
@@ -18,11 +18,15 @@ import (
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/multierr"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 )
 
+const scopeName = "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/otelarrowexporter"
+
 // Exporter is 1:1 with exporter, isolates arrow-specific
 // functionality.
 type Exporter struct {
@@ -83,6 +87,12 @@ type Exporter struct {
 
 	// netReporter measures network traffic.
 	netReporter netstats.Interface
+
+	// inFlightRequests and inFlightBytes measure the requests
+	// waiting for a stream or for the server's response, a signal
+	// of backpressure from the receiver.
+	inFlightRequests metric.Int64UpDownCounter
+	inFlightBytes    metric.Int64UpDownCounter
 }
 
 // doneCancel is used to store the done signal and cancelation
@@ -146,6 +156,10 @@ func NewExporter(
 // Start creates the background context used by all streams and starts
 // a stream controller, which initializes the initial set of streams.
 func (e *Exporter) Start(ctx context.Context) error {
+	if err := e.initInstruments(); err != nil {
+		return err
+	}
+
 	// this is the background context
 	ctx, e.doneCancel = newDoneCancel(ctx)
 
@@ -249,6 +263,27 @@ func (e *Exporter) runArrowStream(ctx context.Context, dc doneCancel, state *str
 	stream.run(ctx, dc, e.streamClient, e.grpcOptions)
 }
 
+// initInstruments creates the in-flight request and byte counters.
+func (e *Exporter) initInstruments() error {
+	var errs, err error
+	meter := e.telemetry.MeterProvider.Meter(scopeName)
+
+	e.inFlightRequests, err = meter.Int64UpDownCounter(
+		"otel_arrow_exporter_in_flight_requests",
+		metric.WithDescription("Number of requests in flight"),
+	)
+	errs = multierr.Append(errs, err)
+
+	e.inFlightBytes, err = meter.Int64UpDownCounter(
+		"otel_arrow_exporter_in_flight_bytes",
+		metric.WithDescription("Number of uncompressed bytes in flight"),
+		metric.WithUnit("By"),
+	)
+	errs = multierr.Append(errs, err)
+
+	return errs
+}
+
 // SendAndWait tries to send using an Arrow stream.  The results are:
 //
 // (true, nil):      Arrow send: success at consumer
@@ -302,6 +337,13 @@ func (e *Exporter) SendAndWait(ctx context.Context, data any) (bool, error) {
 	}
 	md["otlp-pdata-size"] = strconv.Itoa(uncompSize)
 
+	e.inFlightRequests.Add(ctx, 1)
+	e.inFlightBytes.Add(ctx, int64(uncompSize))
+	defer func() {
+		e.inFlightRequests.Add(ctx, -1)
+		e.inFlightBytes.Add(ctx, -int64(uncompSize))
+	}()
+
 	wri := writeItem{
 		records:     data,
 		md:          md,
@@ -26,6 +26,8 @@ import (
 	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/propagation"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/mock/gomock"
 	"go.uber.org/zap/zaptest"
@@ -707,6 +709,111 @@ func TestAddJitter(t *testing.T) {
 	}
 }
 
+// inFlightValue returns the current value of the named in-flight
+// counter, or zero if it has not been recorded.
+func inFlightValue(t *testing.T, reader *sdkmetric.ManualReader, name string) int64 {
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &rm))
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			if m.Name != name {
+				continue
+			}
+			var sum int64
+			for _, dp := range m.Data.(metricdata.Sum[int64]).DataPoints {
+				sum += dp.Value
+			}
+			return sum
+		}
+	}
+	return 0
+}
+
+// TestArrowExporterInFlightMetrics tests that the in-flight request
+// and byte counters rise during a send and return to zero after
+// success, error, and downgrade.
+func TestArrowExporterInFlightMetrics(t *testing.T) {
+	const (
+		requestsName = "otel_arrow_exporter_in_flight_requests"
+		bytesName    = "otel_arrow_exporter_in_flight_bytes"
+	)
+	var sizer ptrace.ProtoMarshaler
+	expectBytes := int64(sizer.TracesSize(twoTraces))
+
+	for _, pname := range AllPrioritizers {
+		t.Run(string(pname), func(t *testing.T) {
+			for _, respond := range []struct {
+				name   string
+				status func(int64) *arrowpb.BatchStatus
+			}{
+				{"success", statusOKFor},
+				{"error", statusUnavailableFor},
+			} {
+				t.Run(respond.name, func(t *testing.T) {
+					tc := newSingleStreamTestCase(t, pname)
+					reader := sdkmetric.NewManualReader()
+					tc.exporter.telemetry.MeterProvider = sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+					channel := newHealthyTestChannel()
+
+					tc.traceCall.Times(1).DoAndReturn(tc.returnNewStream(channel))
+
+					ctx := context.Background()
+					require.NoError(t, tc.exporter.Start(ctx))
+
+					var wg sync.WaitGroup
+					var duringRequests, duringBytes int64
+					wg.Add(1)
+					go func() {
+						defer wg.Done()
+						outputData := <-channel.sendChannel()
+						duringRequests = inFlightValue(t, reader, requestsName)
+						duringBytes = inFlightValue(t, reader, bytesName)
+						channel.recv <- respond.status(outputData.BatchId)
+					}()
+
+					sent, err := tc.exporter.SendAndWait(ctx, twoTraces)
+					require.True(t, sent)
+					if respond.name == "error" {
+						require.Error(t, err)
+					} else {
+						require.NoError(t, err)
+					}
+
+					wg.Wait()
+
+					require.Equal(t, int64(1), duringRequests)
+					require.Equal(t, expectBytes, duringBytes)
+					require.Equal(t, int64(0), inFlightValue(t, reader, requestsName))
+					require.Equal(t, int64(0), inFlightValue(t, reader, bytesName))
+
+					require.NoError(t, tc.exporter.Shutdown(ctx))
+				})
+			}
+
+			t.Run("downgrade", func(t *testing.T) {
+				tc := newSingleStreamTestCase(t, pname)
+				reader := sdkmetric.NewManualReader()
+				tc.exporter.telemetry.MeterProvider = sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+				channel := newArrowUnsupportedTestChannel()
+
+				tc.traceCall.AnyTimes().DoAndReturn(tc.returnNewStream(channel))
+
+				bg := context.Background()
+				require.NoError(t, tc.exporter.Start(bg))
+
+				sent, err := tc.exporter.SendAndWait(bg, twoTraces)
+				require.False(t, sent)
+				require.NoError(t, err)
+
+				require.Equal(t, int64(0), inFlightValue(t, reader, requestsName))
+				require.Equal(t, int64(0), inFlightValue(t, reader, bytesName))
+
+				require.NoError(t, tc.exporter.Shutdown(bg))
+			})
+		})
+	}
+}
+
 // TestArrowExporterStreamLifetimeAndShutdown exercises multiple
 // stream lifetimes and then shuts down, inspects the logs for
 // legibility.
@@ -418,6 +418,45 @@ func TestSendTraces(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestSendTracesSplitByMaxRequestSize(t *testing.T) {
+	ln, err := net.Listen("tcp", "localhost:")
+	require.NoError(t, err)
+	rcv, _ := otelArrowTracesReceiverOnGRPCServer(ln, false)
+	rcv.start()
+	defer rcv.srv.GracefulStop()
+
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig().(*Config)
+	cfg.QueueSettings.Enabled = false
+	cfg.ClientConfig = configgrpc.ClientConfig{
+		Endpoint: ln.Addr().String(),
+		TLSSetting: configtls.ClientConfig{
+			Insecure: true,
+		},
+	}
+	cfg.Arrow.Disabled = true
+	cfg.MaxRequestSize = 1024
+
+	set := exportertest.NewNopCreateSettings()
+	exp, err := factory.CreateTracesExporter(context.Background(), set, cfg)
+	require.NoError(t, err)
+	require.NotNil(t, exp)
+
+	defer func() {
+		assert.NoError(t, exp.Shutdown(context.Background()))
+	}()
+
+	assert.NoError(t, exp.Start(context.Background(), componenttest.NewNopHost()))
+
+	td := testdata.GenerateTraces(100)
+	assert.NoError(t, exp.ConsumeTraces(context.Background(), td))
+
+	assert.Eventually(t, func() bool {
+		return rcv.totalItems.Load() == 100
+	}, 10*time.Second, 5*time.Millisecond)
+	assert.Greater(t, rcv.requestCount.Load(), int32(1))
+}
+
 func TestSendTracesWhenEndpointHasHttpScheme(t *testing.T) {
 	tests := []struct {
 		name               string
@@ -0,0 +1,89 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package parquetexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/parquetexporter"
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/apache/arrow/go/v15/parquet/compress"
+	"go.opentelemetry.io/collector/component"
+)
+
+// compressionCodecs maps the supported compressions to the Parquet codecs.
+var compressionCodecs = map[string]compress.Compression{
+	"none":   compress.Codecs.Uncompressed,
+	"snappy": compress.Codecs.Snappy,
+	"gzip":   compress.Codecs.Gzip,
+	"zstd":   compress.Codecs.Zstd,
+}
+
+// Config defines the configuration of the Parquet exporter.
+type Config struct {
+	// Directory is the directory of the files, which has a sub-directory per signal.
+	Directory string `mapstructure:"directory"`
+
+	// Compression is the compression codec of the columns: none, snappy, gzip or zstd.
+	Compression string `mapstructure:"compression"`
+
+	// Rotation defines when a file is closed and a new one started. A file can only be read once
+	// it is closed.
+	Rotation Rotation `mapstructure:"rotation"`
+
+	// Retention defines when the closed files are removed.
+	Retention Retention `mapstructure:"retention"`
+}
+
+// Rotation defines when a file is closed and a new one started.
+type Rotation struct {
+	// MaxRows is the number of rows of a file. It defaults to 100000.
+	MaxRows int `mapstructure:"max_rows"`
+
+	// MaxMegabytes is the size of a file, once compressed. It defaults to 100 megabytes.
+	MaxMegabytes int `mapstructure:"max_megabytes"`
+
+	// Interval is the maximum duration a file is written to. It defaults to 15 minutes.
+	Interval time.Duration `mapstructure:"interval"`
+}
+
+// Retention defines when the closed files are removed.
+type Retention struct {
+	// MaxAge is the age of the files after which they are removed. The default is to keep the
+	// files regardless of their age.
+	MaxAge time.Duration `mapstructure:"max_age"`
+
+	// MaxFiles is the number of files kept per signal, the oldest being removed first. The
+	// default is to keep all the files.
+	MaxFiles int `mapstructure:"max_files"`
+}
+
+var _ component.Config = (*Config)(nil)
+
+// Validate checks if the exporter configuration is valid.
+func (cfg *Config) Validate() error {
+	var errs []error
+	if cfg.Directory == "" {
+		errs = append(errs, errors.New("'directory' must not be empty"))
+	}
+	if _, ok := compressionCodecs[cfg.Compression]; !ok {
+		errs = append(errs, fmt.Errorf("unsupported 'compression' '%s', must be one of 'none', 'snappy', 'gzip' and 'zstd'", cfg.Compression))
+	}
+	if cfg.Rotation.MaxRows <= 0 {
+		errs = append(errs, errors.New("'rotation.max_rows' must be positive"))
+	}
+	if cfg.Rotation.MaxMegabytes <= 0 {
+		errs = append(errs, errors.New("'rotation.max_megabytes' must be positive"))
+	}
+	if cfg.Rotation.Interval <= 0 {
+		errs = append(errs, errors.New("'rotation.interval' must be positive"))
+	}
+	if cfg.Retention.MaxAge < 0 {
+		errs = append(errs, errors.New("'retention.max_age' cannot be negative"))
+	}
+	if cfg.Retention.MaxFiles < 0 {
+		errs = append(errs, errors.New("'retention.max_files' cannot be negative"))
+	}
+	return errors.Join(errs...)
+}
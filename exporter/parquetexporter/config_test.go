@@ -0,0 +1,78 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package parquetexporter
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/parquetexporter/internal/metadata"
+)
+
+func TestLoadConfig(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+	factory := NewFactory()
+
+	tests := []struct {
+		id       component.ID
+		expected func(*Config)
+	}{
+		{
+			id:       component.NewIDWithName(metadata.Type, ""),
+			expected: func(*Config) {},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "customname"),
+			expected: func(cfg *Config) {
+				cfg.Compression = "zstd"
+				cfg.Rotation = Rotation{MaxRows: 50000, MaxMegabytes: 10, Interval: time.Hour}
+				cfg.Retention = Retention{MaxAge: 168 * time.Hour, MaxFiles: 100}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			cfg := factory.CreateDefaultConfig()
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, component.UnmarshalConfig(sub, cfg))
+			require.NoError(t, component.ValidateConfig(cfg))
+
+			expected := factory.CreateDefaultConfig().(*Config)
+			expected.Directory = "/var/lib/otelcol/parquet"
+			tt.expected(expected)
+			assert.Equal(t, expected, cfg)
+		})
+	}
+}
+
+func TestConfigValidate(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+	cfg := NewFactory().CreateDefaultConfig()
+	sub, err := cm.Sub(component.NewIDWithName(metadata.Type, "invalid").String())
+	require.NoError(t, err)
+	require.NoError(t, component.UnmarshalConfig(sub, cfg))
+
+	assert.EqualError(t, component.ValidateConfig(cfg), "'directory' must not be empty\n"+
+		"unsupported 'compression' 'lz4', must be one of 'none', 'snappy', 'gzip' and 'zstd'\n"+
+		"'rotation.max_rows' must be positive\n"+
+		"'retention.max_files' cannot be negative")
+
+	c := NewFactory().CreateDefaultConfig().(*Config)
+	c.Directory = "/var/lib/otelcol/parquet"
+	c.Rotation.MaxMegabytes = 0
+	c.Rotation.Interval = 0
+	c.Retention.MaxAge = -time.Hour
+	assert.EqualError(t, c.Validate(), "'rotation.max_megabytes' must be positive\n"+
+		"'rotation.interval' must be positive\n"+
+		"'retention.max_age' cannot be negative")
+}
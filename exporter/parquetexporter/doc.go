@@ -0,0 +1,8 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:generate mdatagen metadata.yaml
+
+// Package parquetexporter writes the logs, the metrics and the traces to local Parquet files, a
+// directory per signal, for the offline analysis of the telemetry e.g. with DuckDB.
+package parquetexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/parquetexporter"
@@ -0,0 +1,127 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package parquetexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/parquetexporter"
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/apache/arrow/go/v15/arrow"
+	"github.com/apache/arrow/go/v15/parquet"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
+)
+
+// parquetExporter writes the rows of a signal to the Parquet files of its directory, a file
+// at a time.
+type parquetExporter struct {
+	logger *zap.Logger
+	cfg    *Config
+	signal string
+	schema *arrow.Schema
+	dir    string
+	props  *parquet.WriterProperties
+	now    func() time.Time
+
+	mu sync.Mutex
+	// the current file, nil until the first rows are written after a rotation
+	file *currentFile
+}
+
+func newParquetExporter(logger *zap.Logger, cfg *Config, signal string, schema *arrow.Schema) *parquetExporter {
+	return &parquetExporter{
+		logger: logger,
+		cfg:    cfg,
+		signal: signal,
+		schema: schema,
+		dir:    filepath.Join(cfg.Directory, signal),
+		props:  parquet.NewWriterProperties(parquet.WithCompression(compressionCodecs[cfg.Compression])),
+		now:    time.Now,
+	}
+}
+
+func (e *parquetExporter) start(context.Context, component.Host) error {
+	if err := os.MkdirAll(e.dir, 0755); err != nil {
+		return fmt.Errorf("failed to create the directory '%s': %w", e.dir, err)
+	}
+	// the files of a collector which did not shut down cleanly cannot be read, their footer
+	// being missing
+	leftovers, err := filepath.Glob(filepath.Join(e.dir, "*"+tmpSuffix))
+	if err != nil {
+		return err
+	}
+	if len(leftovers) > 0 {
+		e.logger.Warn("Found incomplete files, which cannot be read",
+			zap.String("directory", e.dir), zap.Strings("files", leftovers))
+	}
+	return nil
+}
+
+func (e *parquetExporter) shutdown(context.Context) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.closeFile()
+}
+
+func (e *parquetExporter) consumeLogs(_ context.Context, ld plog.Logs) error {
+	return e.write(logsRows(ld))
+}
+
+func (e *parquetExporter) consumeMetrics(_ context.Context, md pmetric.Metrics) error {
+	return e.write(metricsRows(md))
+}
+
+func (e *parquetExporter) consumeTraces(_ context.Context, td ptrace.Traces) error {
+	return e.write(tracesRows(td))
+}
+
+// write appends the rows to the current file as a row group, and rotates the file once it
+// reaches the maximum number of rows or size.
+func (e *parquetExporter) write(rows []row) error {
+	if len(rows) == 0 {
+		return nil
+	}
+	record, err := newRecord(e.schema, rows)
+	if err != nil {
+		return consumererror.NewPermanent(err)
+	}
+	defer record.Release()
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.file == nil {
+		if err = e.openFile(); err != nil {
+			return err
+		}
+	}
+	if err = e.file.writer.Write(record); err != nil {
+		return fmt.Errorf("failed to write to the file '%s': %w", e.file.path, err)
+	}
+	e.file.rows += len(rows)
+	if e.file.rows >= e.cfg.Rotation.MaxRows || e.file.sink.written >= int64(e.cfg.Rotation.MaxMegabytes)*1024*1024 {
+		return e.closeFile()
+	}
+	return nil
+}
+
+// rotate closes the file of the path once its rotation interval elapsed, unless it was closed
+// already.
+func (e *parquetExporter) rotate(path string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.file == nil || e.file.path != path {
+		return
+	}
+	if err := e.closeFile(); err != nil {
+		e.logger.Error("Failed to rotate the file", zap.Error(err))
+	}
+}
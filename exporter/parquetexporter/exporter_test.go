@@ -0,0 +1,174 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package parquetexporter
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/apache/arrow/go/v15/arrow"
+	"github.com/apache/arrow/go/v15/arrow/array"
+	"github.com/apache/arrow/go/v15/arrow/memory"
+	"github.com/apache/arrow/go/v15/parquet/file"
+	"github.com/apache/arrow/go/v15/parquet/pqarrow"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func newTestExporter(t *testing.T, logger *zap.Logger, configure func(*Config)) *parquetExporter {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Directory = t.TempDir()
+	if configure != nil {
+		configure(cfg)
+	}
+	require.NoError(t, cfg.Validate())
+	e := newParquetExporter(logger, cfg, signalLogs, logsSchema)
+	require.NoError(t, e.start(context.Background(), componenttest.NewNopHost()))
+	return e
+}
+
+func testLogs(bodies ...string) plog.Logs {
+	ld := plog.NewLogs()
+	lrs := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+	for _, body := range bodies {
+		lr := lrs.AppendEmpty()
+		lr.SetTimestamp(testTimestamp)
+		lr.Body().SetStr(body)
+	}
+	return ld
+}
+
+// files returns the complete files of the exporter, from the oldest to the newest.
+func files(t *testing.T, e *parquetExporter) []string {
+	paths, err := filepath.Glob(filepath.Join(e.dir, "*"+fileSuffix))
+	require.NoError(t, err)
+	return paths
+}
+
+// readBodies returns the bodies of the logs of the file.
+func readBodies(t *testing.T, path string) []string {
+	rdr, err := file.OpenParquetFile(path, false)
+	require.NoError(t, err)
+	defer rdr.Close()
+	fr, err := pqarrow.NewFileReader(rdr, pqarrow.ArrowReadProperties{}, memory.DefaultAllocator)
+	require.NoError(t, err)
+	table, err := fr.ReadTable(context.Background())
+	require.NoError(t, err)
+	defer table.Release()
+
+	timestamps := table.Column(table.Schema().FieldIndices("timestamp")[0]).Data()
+	for _, chunk := range timestamps.Chunks() {
+		for i := 0; i < chunk.Len(); i++ {
+			assert.Equal(t, arrow.Timestamp(testTimestamp), chunk.(*array.Timestamp).Value(i))
+		}
+	}
+	var bodies []string
+	for _, chunk := range table.Column(table.Schema().FieldIndices("body")[0]).Data().Chunks() {
+		for i := 0; i < chunk.Len(); i++ {
+			bodies = append(bodies, chunk.(*array.String).Value(i))
+		}
+	}
+	return bodies
+}
+
+func TestExporterWritesFiles(t *testing.T) {
+	e := newTestExporter(t, zap.NewNop(), nil)
+
+	require.NoError(t, e.consumeLogs(context.Background(), testLogs("one", "two")))
+	require.NoError(t, e.consumeLogs(context.Background(), testLogs("three")))
+	require.NoError(t, e.consumeLogs(context.Background(), plog.NewLogs()))
+	// the file is only readable once closed
+	assert.Empty(t, files(t, e))
+
+	require.NoError(t, e.shutdown(context.Background()))
+	paths := files(t, e)
+	require.Len(t, paths, 1)
+	assert.Regexp(t, `logs-\d{8}T\d{6}\.\d{9}Z\.parquet$`, paths[0])
+	assert.Equal(t, []string{"one", "two", "three"}, readBodies(t, paths[0]))
+}
+
+func TestExporterRotation(t *testing.T) {
+	e := newTestExporter(t, zap.NewNop(), func(cfg *Config) {
+		cfg.Compression = "zstd"
+		cfg.Rotation.MaxRows = 2
+	})
+	now := time.Now()
+	e.now = func() time.Time {
+		now = now.Add(time.Second)
+		return now
+	}
+
+	require.NoError(t, e.consumeLogs(context.Background(), testLogs("one")))
+	require.NoError(t, e.consumeLogs(context.Background(), testLogs("two", "three")))
+	require.NoError(t, e.consumeLogs(context.Background(), testLogs("four")))
+	require.NoError(t, e.shutdown(context.Background()))
+
+	paths := files(t, e)
+	require.Len(t, paths, 2)
+	assert.Equal(t, []string{"one", "two", "three"}, readBodies(t, paths[0]))
+	assert.Equal(t, []string{"four"}, readBodies(t, paths[1]))
+}
+
+func TestExporterRotationInterval(t *testing.T) {
+	e := newTestExporter(t, zap.NewNop(), func(cfg *Config) {
+		cfg.Rotation.Interval = 50 * time.Millisecond
+	})
+	defer func() { require.NoError(t, e.shutdown(context.Background())) }()
+
+	require.NoError(t, e.consumeLogs(context.Background(), testLogs("one")))
+	assert.Eventually(t, func() bool { return len(files(t, e)) == 1 }, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, []string{"one"}, readBodies(t, files(t, e)[0]))
+}
+
+func TestExporterRetention(t *testing.T) {
+	e := newTestExporter(t, zap.NewNop(), func(cfg *Config) {
+		cfg.Rotation.MaxRows = 1
+		cfg.Retention.MaxAge = time.Hour
+		cfg.Retention.MaxFiles = 2
+	})
+	expired := filepath.Join(e.dir, "logs-20240101T000000.000000000Z.parquet")
+	require.NoError(t, os.WriteFile(expired, nil, 0600))
+	require.NoError(t, os.Chtimes(expired, time.Now().Add(-2*time.Hour), time.Now().Add(-2*time.Hour)))
+	other := filepath.Join(e.dir, "notes.txt")
+	require.NoError(t, os.WriteFile(other, nil, 0600))
+
+	now := time.Now()
+	e.now = func() time.Time {
+		now = now.Add(time.Second)
+		return now
+	}
+	for _, body := range []string{"one", "two", "three"} {
+		require.NoError(t, e.consumeLogs(context.Background(), testLogs(body)))
+	}
+	require.NoError(t, e.shutdown(context.Background()))
+
+	paths := files(t, e)
+	require.Len(t, paths, 2)
+	assert.Equal(t, []string{"two"}, readBodies(t, paths[0]))
+	assert.Equal(t, []string{"three"}, readBodies(t, paths[1]))
+	assert.FileExists(t, other)
+}
+
+func TestExporterLeftoverFiles(t *testing.T) {
+	core, logs := observer.New(zapcore.WarnLevel)
+	cfg := createDefaultConfig().(*Config)
+	cfg.Directory = t.TempDir()
+	leftover := filepath.Join(cfg.Directory, signalLogs, "logs-20240101T000000.000000000Z"+tmpSuffix)
+	require.NoError(t, os.MkdirAll(filepath.Dir(leftover), 0755))
+	require.NoError(t, os.WriteFile(leftover, []byte("PAR1"), 0600))
+
+	e := newParquetExporter(zap.New(core), cfg, signalLogs, logsSchema)
+	require.NoError(t, e.start(context.Background(), componenttest.NewNopHost()))
+	require.NoError(t, e.shutdown(context.Background()))
+	require.Equal(t, 1, logs.Len())
+	assert.Equal(t, "Found incomplete files, which cannot be read", logs.All()[0].Message)
+}
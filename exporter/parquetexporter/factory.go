@@ -0,0 +1,94 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package parquetexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/parquetexporter"
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/exporter"
+	"go.opentelemetry.io/collector/exporter/exporterhelper"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/parquetexporter/internal/metadata"
+)
+
+const (
+	defaultMaxRows      = 100000
+	defaultMaxMegabytes = 100
+	defaultInterval     = 15 * time.Minute
+)
+
+// NewFactory creates a factory for the Parquet exporter.
+func NewFactory() exporter.Factory {
+	return exporter.NewFactory(
+		metadata.Type,
+		createDefaultConfig,
+		exporter.WithTraces(createTracesExporter, metadata.TracesStability),
+		exporter.WithMetrics(createMetricsExporter, metadata.MetricsStability),
+		exporter.WithLogs(createLogsExporter, metadata.LogsStability))
+}
+
+func createDefaultConfig() component.Config {
+	return &Config{
+		Compression: "snappy",
+		Rotation: Rotation{
+			MaxRows:      defaultMaxRows,
+			MaxMegabytes: defaultMaxMegabytes,
+			Interval:     defaultInterval,
+		},
+	}
+}
+
+func createTracesExporter(
+	ctx context.Context,
+	set exporter.CreateSettings,
+	cfg component.Config,
+) (exporter.Traces, error) {
+	pe := newParquetExporter(set.Logger, cfg.(*Config), signalTraces, tracesSchema)
+	return exporterhelper.NewTracesExporter(
+		ctx,
+		set,
+		cfg,
+		pe.consumeTraces,
+		exporterhelper.WithCapabilities(consumer.Capabilities{MutatesData: false}),
+		exporterhelper.WithStart(pe.start),
+		exporterhelper.WithShutdown(pe.shutdown),
+	)
+}
+
+func createMetricsExporter(
+	ctx context.Context,
+	set exporter.CreateSettings,
+	cfg component.Config,
+) (exporter.Metrics, error) {
+	pe := newParquetExporter(set.Logger, cfg.(*Config), signalMetrics, metricsSchema)
+	return exporterhelper.NewMetricsExporter(
+		ctx,
+		set,
+		cfg,
+		pe.consumeMetrics,
+		exporterhelper.WithCapabilities(consumer.Capabilities{MutatesData: false}),
+		exporterhelper.WithStart(pe.start),
+		exporterhelper.WithShutdown(pe.shutdown),
+	)
+}
+
+func createLogsExporter(
+	ctx context.Context,
+	set exporter.CreateSettings,
+	cfg component.Config,
+) (exporter.Logs, error) {
+	pe := newParquetExporter(set.Logger, cfg.(*Config), signalLogs, logsSchema)
+	return exporterhelper.NewLogsExporter(
+		ctx,
+		set,
+		cfg,
+		pe.consumeLogs,
+		exporterhelper.WithCapabilities(consumer.Capabilities{MutatesData: false}),
+		exporterhelper.WithStart(pe.start),
+		exporterhelper.WithShutdown(pe.shutdown),
+	)
+}
@@ -0,0 +1,345 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package parquetexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/parquetexporter"
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/apache/arrow/go/v15/arrow"
+	"github.com/apache/arrow/go/v15/arrow/array"
+	"github.com/apache/arrow/go/v15/arrow/memory"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+const serviceNameAttribute = "service.name"
+
+// row is a row of a file, by column name. A missing column is null.
+type row map[string]any
+
+func logsRows(ld plog.Logs) []row {
+	var rows []row
+	for i := 0; i < ld.ResourceLogs().Len(); i++ {
+		rl := ld.ResourceLogs().At(i)
+		resource := rl.Resource()
+		for j := 0; j < rl.ScopeLogs().Len(); j++ {
+			sl := rl.ScopeLogs().At(j)
+			for k := 0; k < sl.LogRecords().Len(); k++ {
+				lr := sl.LogRecords().At(k)
+				timestamp := lr.Timestamp()
+				if timestamp == 0 {
+					timestamp = lr.ObservedTimestamp()
+				}
+				r := row{
+					"timestamp":       timestamp,
+					"trace_flags":     uint32(lr.Flags()),
+					"severity_text":   lr.SeverityText(),
+					"severity_number": int32(lr.SeverityNumber()),
+					"body":            lr.Body().AsString(),
+				}
+				if observed := lr.ObservedTimestamp(); observed != 0 {
+					r["observed_timestamp"] = observed
+				}
+				if traceID := lr.TraceID(); !traceID.IsEmpty() {
+					r["trace_id"] = traceID.String()
+				}
+				if spanID := lr.SpanID(); !spanID.IsEmpty() {
+					r["span_id"] = spanID.String()
+				}
+				putCommon(r, resource, sl.Scope(), lr.Attributes())
+				rows = append(rows, r)
+			}
+		}
+	}
+	return rows
+}
+
+func tracesRows(td ptrace.Traces) []row {
+	var rows []row
+	for i := 0; i < td.ResourceSpans().Len(); i++ {
+		rs := td.ResourceSpans().At(i)
+		resource := rs.Resource()
+		for j := 0; j < rs.ScopeSpans().Len(); j++ {
+			ss := rs.ScopeSpans().At(j)
+			for k := 0; k < ss.Spans().Len(); k++ {
+				span := ss.Spans().At(k)
+				r := row{
+					"timestamp":      span.StartTimestamp(),
+					"end_timestamp":  span.EndTimestamp(),
+					"duration_ns":    int64(span.EndTimestamp()) - int64(span.StartTimestamp()),
+					"trace_id":       span.TraceID().String(),
+					"span_id":        span.SpanID().String(),
+					"trace_state":    span.TraceState().AsRaw(),
+					"name":           span.Name(),
+					"kind":           span.Kind().String(),
+					"status_code":    span.Status().Code().String(),
+					"status_message": span.Status().Message(),
+				}
+				if parentSpanID := span.ParentSpanID(); !parentSpanID.IsEmpty() {
+					r["parent_span_id"] = parentSpanID.String()
+				}
+				if span.Events().Len() > 0 {
+					putJSON(r, "events", spanEvents(span.Events()))
+				}
+				if span.Links().Len() > 0 {
+					putJSON(r, "links", spanLinks(span.Links()))
+				}
+				putCommon(r, resource, ss.Scope(), span.Attributes())
+				rows = append(rows, r)
+			}
+		}
+	}
+	return rows
+}
+
+func spanEvents(events ptrace.SpanEventSlice) []map[string]any {
+	values := make([]map[string]any, 0, events.Len())
+	for i := 0; i < events.Len(); i++ {
+		event := events.At(i)
+		values = append(values, map[string]any{
+			"timestamp":  event.Timestamp().AsTime().UTC(),
+			"name":       event.Name(),
+			"attributes": event.Attributes().AsRaw(),
+		})
+	}
+	return values
+}
+
+func spanLinks(links ptrace.SpanLinkSlice) []map[string]any {
+	values := make([]map[string]any, 0, links.Len())
+	for i := 0; i < links.Len(); i++ {
+		link := links.At(i)
+		values = append(values, map[string]any{
+			"trace_id":    link.TraceID().String(),
+			"span_id":     link.SpanID().String(),
+			"trace_state": link.TraceState().AsRaw(),
+			"attributes":  link.Attributes().AsRaw(),
+		})
+	}
+	return values
+}
+
+// metricsRows returns a row per data point. The exemplars are not exported.
+func metricsRows(md pmetric.Metrics) []row {
+	var rows []row
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		rm := md.ResourceMetrics().At(i)
+		resource := rm.Resource()
+		for j := 0; j < rm.ScopeMetrics().Len(); j++ {
+			sm := rm.ScopeMetrics().At(j)
+			for k := 0; k < sm.Metrics().Len(); k++ {
+				metric := sm.Metrics().At(k)
+				newRow := func(timestamp, startTimestamp pcommon.Timestamp, attributes pcommon.Map) row {
+					r := row{
+						"timestamp":          timestamp,
+						"metric_name":        metric.Name(),
+						"metric_description": metric.Description(),
+						"metric_unit":        metric.Unit(),
+						"metric_type":        metric.Type().String(),
+					}
+					if startTimestamp != 0 {
+						r["start_timestamp"] = startTimestamp
+					}
+					putCommon(r, resource, sm.Scope(), attributes)
+					rows = append(rows, r)
+					return r
+				}
+				switch metric.Type() {
+				case pmetric.MetricTypeGauge:
+					dps := metric.Gauge().DataPoints()
+					for l := 0; l < dps.Len(); l++ {
+						dp := dps.At(l)
+						putValue(newRow(dp.Timestamp(), dp.StartTimestamp(), dp.Attributes()), dp)
+					}
+				case pmetric.MetricTypeSum:
+					dps := metric.Sum().DataPoints()
+					for l := 0; l < dps.Len(); l++ {
+						dp := dps.At(l)
+						r := newRow(dp.Timestamp(), dp.StartTimestamp(), dp.Attributes())
+						r["is_monotonic"] = metric.Sum().IsMonotonic()
+						r["aggregation_temporality"] = metric.Sum().AggregationTemporality().String()
+						putValue(r, dp)
+					}
+				case pmetric.MetricTypeHistogram:
+					dps := metric.Histogram().DataPoints()
+					for l := 0; l < dps.Len(); l++ {
+						dp := dps.At(l)
+						r := newRow(dp.Timestamp(), dp.StartTimestamp(), dp.Attributes())
+						r["aggregation_temporality"] = metric.Histogram().AggregationTemporality().String()
+						r["count"] = dp.Count()
+						r["bucket_counts"] = dp.BucketCounts().AsRaw()
+						r["explicit_bounds"] = dp.ExplicitBounds().AsRaw()
+						if dp.HasSum() {
+							r["sum"] = dp.Sum()
+						}
+						if dp.HasMin() {
+							r["min"] = dp.Min()
+						}
+						if dp.HasMax() {
+							r["max"] = dp.Max()
+						}
+					}
+				case pmetric.MetricTypeExponentialHistogram:
+					dps := metric.ExponentialHistogram().DataPoints()
+					for l := 0; l < dps.Len(); l++ {
+						dp := dps.At(l)
+						r := newRow(dp.Timestamp(), dp.StartTimestamp(), dp.Attributes())
+						r["aggregation_temporality"] = metric.ExponentialHistogram().AggregationTemporality().String()
+						r["count"] = dp.Count()
+						r["scale"] = dp.Scale()
+						r["zero_count"] = dp.ZeroCount()
+						r["positive_offset"] = dp.Positive().Offset()
+						r["positive_bucket_counts"] = dp.Positive().BucketCounts().AsRaw()
+						r["negative_offset"] = dp.Negative().Offset()
+						r["negative_bucket_counts"] = dp.Negative().BucketCounts().AsRaw()
+						if dp.HasSum() {
+							r["sum"] = dp.Sum()
+						}
+						if dp.HasMin() {
+							r["min"] = dp.Min()
+						}
+						if dp.HasMax() {
+							r["max"] = dp.Max()
+						}
+					}
+				case pmetric.MetricTypeSummary:
+					dps := metric.Summary().DataPoints()
+					for l := 0; l < dps.Len(); l++ {
+						dp := dps.At(l)
+						r := newRow(dp.Timestamp(), dp.StartTimestamp(), dp.Attributes())
+						r["count"] = dp.Count()
+						r["sum"] = dp.Sum()
+						quantiles := make([]map[string]any, 0, dp.QuantileValues().Len())
+						for m := 0; m < dp.QuantileValues().Len(); m++ {
+							quantile := dp.QuantileValues().At(m)
+							quantiles = append(quantiles, map[string]any{"quantile": quantile.Quantile(), "value": quantile.Value()})
+						}
+						putJSON(r, "quantiles", quantiles)
+					}
+				}
+			}
+		}
+	}
+	return rows
+}
+
+// putValue sets the value of a gauge or a sum data point, the integers being converted to
+// floats.
+func putValue(r row, dp pmetric.NumberDataPoint) {
+	switch dp.ValueType() {
+	case pmetric.NumberDataPointValueTypeDouble:
+		r["value"] = dp.DoubleValue()
+	case pmetric.NumberDataPointValueTypeInt:
+		r["value"] = float64(dp.IntValue())
+	}
+}
+
+// putCommon sets the columns shared by the signals: the service, the scope and the attributes.
+func putCommon(r row, resource pcommon.Resource, scope pcommon.InstrumentationScope, attributes pcommon.Map) {
+	if serviceName, ok := resource.Attributes().Get(serviceNameAttribute); ok {
+		r["service_name"] = serviceName.AsString()
+	}
+	r["scope_name"] = scope.Name()
+	r["scope_version"] = scope.Version()
+	putJSON(r, "attributes", attributes.AsRaw())
+	putJSON(r, "resource_attributes", resource.Attributes().AsRaw())
+}
+
+// putJSON sets the column to the JSON text of the value. A value that cannot be encoded, e.g.
+// containing a NaN, is left null.
+func putJSON(r row, column string, value any) {
+	if encoded, err := json.Marshal(value); err == nil {
+		r[column] = string(encoded)
+	}
+}
+
+// newRecord returns the record of the rows in the columns of the schema. The caller must
+// release it.
+func newRecord(schema *arrow.Schema, rows []row) (arrow.Record, error) {
+	builder := array.NewRecordBuilder(memory.DefaultAllocator, schema)
+	defer builder.Release()
+	for _, r := range rows {
+		for i, field := range schema.Fields() {
+			if err := appendValue(builder.Field(i), r[field.Name]); err != nil {
+				return nil, fmt.Errorf("column '%s': %w", field.Name, err)
+			}
+		}
+	}
+	return builder.NewRecord(), nil
+}
+
+func appendValue(builder array.Builder, value any) error {
+	ok := true
+	switch value := value.(type) {
+	case nil:
+		builder.AppendNull()
+	case pcommon.Timestamp:
+		var b *array.TimestampBuilder
+		if b, ok = builder.(*array.TimestampBuilder); ok {
+			b.Append(arrow.Timestamp(value))
+		}
+	case string:
+		var b *array.StringBuilder
+		if b, ok = builder.(*array.StringBuilder); ok {
+			b.Append(value)
+		}
+	case bool:
+		var b *array.BooleanBuilder
+		if b, ok = builder.(*array.BooleanBuilder); ok {
+			b.Append(value)
+		}
+	case int32:
+		var b *array.Int32Builder
+		if b, ok = builder.(*array.Int32Builder); ok {
+			b.Append(value)
+		}
+	case int64:
+		var b *array.Int64Builder
+		if b, ok = builder.(*array.Int64Builder); ok {
+			b.Append(value)
+		}
+	case uint32:
+		var b *array.Uint32Builder
+		if b, ok = builder.(*array.Uint32Builder); ok {
+			b.Append(value)
+		}
+	case uint64:
+		var b *array.Uint64Builder
+		if b, ok = builder.(*array.Uint64Builder); ok {
+			b.Append(value)
+		}
+	case float64:
+		var b *array.Float64Builder
+		if b, ok = builder.(*array.Float64Builder); ok {
+			b.Append(value)
+		}
+	case []uint64:
+		var b *array.ListBuilder
+		if b, ok = builder.(*array.ListBuilder); ok {
+			var values *array.Uint64Builder
+			if values, ok = b.ValueBuilder().(*array.Uint64Builder); ok {
+				b.Append(true)
+				values.AppendValues(value, nil)
+			}
+		}
+	case []float64:
+		var b *array.ListBuilder
+		if b, ok = builder.(*array.ListBuilder); ok {
+			var values *array.Float64Builder
+			if values, ok = b.ValueBuilder().(*array.Float64Builder); ok {
+				b.Append(true)
+				values.AppendValues(value, nil)
+			}
+		}
+	default:
+		ok = false
+	}
+	if !ok {
+		return fmt.Errorf("unsupported value of type %T for the type %s", value, builder.Type())
+	}
+	return nil
+}
@@ -0,0 +1,145 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package parquetexporter
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/apache/arrow/go/v15/arrow"
+	"github.com/apache/arrow/go/v15/arrow/array"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+var testTimestamp = pcommon.NewTimestampFromTime(time.Date(2024, 5, 10, 12, 30, 15, 123456789, time.UTC))
+
+func TestLogsRows(t *testing.T) {
+	ld := plog.NewLogs()
+	rl := ld.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().PutStr("service.name", "checkout")
+	sl := rl.ScopeLogs().AppendEmpty()
+	sl.Scope().SetName("logger")
+	lr := sl.LogRecords().AppendEmpty()
+	lr.SetObservedTimestamp(testTimestamp)
+	lr.SetSeverityText("WARN")
+	lr.SetSeverityNumber(plog.SeverityNumberWarn)
+	lr.Body().SetEmptyMap().PutStr("message", "payment declined")
+	lr.Attributes().PutInt("http.status_code", 402)
+	lr.SetTraceID([16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16})
+	lr.SetSpanID([8]byte{1, 2, 3, 4, 5, 6, 7, 8})
+
+	rows := logsRows(ld)
+	require.Len(t, rows, 1)
+	assert.Equal(t, row{
+		"timestamp":           testTimestamp,
+		"observed_timestamp":  testTimestamp,
+		"trace_id":            "0102030405060708090a0b0c0d0e0f10",
+		"span_id":             "0102030405060708",
+		"trace_flags":         uint32(0),
+		"severity_text":       "WARN",
+		"severity_number":     int32(plog.SeverityNumberWarn),
+		"body":                `{"message":"payment declined"}`,
+		"service_name":        "checkout",
+		"scope_name":          "logger",
+		"scope_version":       "",
+		"attributes":          `{"http.status_code":402}`,
+		"resource_attributes": `{"service.name":"checkout"}`,
+	}, rows[0])
+}
+
+func TestTracesRows(t *testing.T) {
+	td := ptrace.NewTraces()
+	span := td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty()
+	span.SetName("POST /pay")
+	span.SetKind(ptrace.SpanKindServer)
+	span.SetTraceID([16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16})
+	span.SetSpanID([8]byte{1, 2, 3, 4, 5, 6, 7, 8})
+	span.SetStartTimestamp(testTimestamp)
+	span.SetEndTimestamp(testTimestamp + 1500)
+	span.Status().SetCode(ptrace.StatusCodeError)
+	event := span.Events().AppendEmpty()
+	event.SetName("retry")
+	event.SetTimestamp(testTimestamp + 1000)
+	event.Attributes().PutInt("attempt", 2)
+
+	rows := tracesRows(td)
+	require.Len(t, rows, 1)
+	assert.Equal(t, row{
+		"timestamp":           testTimestamp,
+		"end_timestamp":       testTimestamp + 1500,
+		"duration_ns":         int64(1500),
+		"trace_id":            "0102030405060708090a0b0c0d0e0f10",
+		"span_id":             "0102030405060708",
+		"trace_state":         "",
+		"name":                "POST /pay",
+		"kind":                "Server",
+		"status_code":         "Error",
+		"status_message":      "",
+		"scope_name":          "",
+		"scope_version":       "",
+		"attributes":          `{}`,
+		"resource_attributes": `{}`,
+		"events":              `[{"attributes":{"attempt":2},"name":"retry","timestamp":"2024-05-10T12:30:15.123457789Z"}]`,
+	}, rows[0])
+}
+
+func TestMetricsRows(t *testing.T) {
+	md := pmetric.NewMetrics()
+	metrics := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics()
+
+	histogram := metrics.AppendEmpty()
+	histogram.SetName("http.server.duration")
+	histogram.SetUnit("ms")
+	dp := histogram.SetEmptyHistogram().DataPoints().AppendEmpty()
+	dp.SetTimestamp(testTimestamp)
+	dp.SetCount(3)
+	dp.SetSum(math.NaN())
+	dp.BucketCounts().FromRaw([]uint64{1, 2})
+	dp.ExplicitBounds().FromRaw([]float64{100})
+
+	gauge := metrics.AppendEmpty()
+	gauge.SetName("queue.size")
+	gauge.SetEmptyGauge().DataPoints().AppendEmpty().SetIntValue(7)
+
+	rows := metricsRows(md)
+	require.Len(t, rows, 2)
+	assert.Equal(t, testTimestamp, rows[0]["timestamp"])
+	assert.Equal(t, "Histogram", rows[0]["metric_type"])
+	assert.Equal(t, "ms", rows[0]["metric_unit"])
+	assert.Equal(t, uint64(3), rows[0]["count"])
+	assert.True(t, math.IsNaN(rows[0]["sum"].(float64)))
+	assert.Equal(t, []uint64{1, 2}, rows[0]["bucket_counts"])
+	assert.Equal(t, []float64{100}, rows[0]["explicit_bounds"])
+	assert.NotContains(t, rows[0], "min")
+	assert.Equal(t, "Gauge", rows[1]["metric_type"])
+	assert.Equal(t, 7.0, rows[1]["value"])
+}
+
+func TestNewRecord(t *testing.T) {
+	rows := []row{
+		{"timestamp": testTimestamp, "metric_name": "queue.size", "metric_type": "Gauge", "value": 7.0},
+		{"timestamp": testTimestamp, "metric_name": "latency", "metric_type": "Histogram", "bucket_counts": []uint64{1, 2}},
+	}
+	record, err := newRecord(metricsSchema, rows)
+	require.NoError(t, err)
+	defer record.Release()
+
+	assert.EqualValues(t, 2, record.NumRows())
+	assert.Equal(t, arrow.Timestamp(testTimestamp), record.Column(0).(*array.Timestamp).Value(1))
+	value := record.Column(metricsSchema.FieldIndices("value")[0]).(*array.Float64)
+	assert.Equal(t, 7.0, value.Value(0))
+	assert.True(t, value.IsNull(1))
+	bucketCounts := record.Column(metricsSchema.FieldIndices("bucket_counts")[0]).(*array.List)
+	assert.True(t, bucketCounts.IsNull(0))
+	assert.Equal(t, []uint64{1, 2}, bucketCounts.ListValues().(*array.Uint64).Uint64Values())
+
+	_, err = newRecord(metricsSchema, []row{{"timestamp": testTimestamp, "value": "7"}})
+	assert.EqualError(t, err, "column 'value': unsupported value of type string for the type float64")
+}
@@ -0,0 +1,85 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package parquetexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/parquetexporter"
+
+import (
+	"github.com/apache/arrow/go/v15/arrow"
+)
+
+const (
+	signalLogs    = "logs"
+	signalMetrics = "metrics"
+	signalTraces  = "traces"
+)
+
+var timestampType = &arrow.TimestampType{Unit: arrow.Nanosecond, TimeZone: "UTC"}
+
+// The maps of attributes, the bodies of the logs, the events and the links of the spans, and
+// the quantiles of the summaries are JSON strings.
+var logsSchema = arrow.NewSchema([]arrow.Field{
+	{Name: "timestamp", Type: timestampType},
+	{Name: "observed_timestamp", Type: timestampType, Nullable: true},
+	{Name: "trace_id", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "span_id", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "trace_flags", Type: arrow.PrimitiveTypes.Uint32, Nullable: true},
+	{Name: "severity_text", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "severity_number", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
+	{Name: "body", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "service_name", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "scope_name", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "scope_version", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "attributes", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "resource_attributes", Type: arrow.BinaryTypes.String, Nullable: true},
+}, nil)
+
+var tracesSchema = arrow.NewSchema([]arrow.Field{
+	{Name: "timestamp", Type: timestampType},
+	{Name: "end_timestamp", Type: timestampType, Nullable: true},
+	{Name: "duration_ns", Type: arrow.PrimitiveTypes.Int64, Nullable: true},
+	{Name: "trace_id", Type: arrow.BinaryTypes.String},
+	{Name: "span_id", Type: arrow.BinaryTypes.String},
+	{Name: "parent_span_id", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "trace_state", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "kind", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "status_code", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "status_message", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "service_name", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "scope_name", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "scope_version", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "attributes", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "resource_attributes", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "events", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "links", Type: arrow.BinaryTypes.String, Nullable: true},
+}, nil)
+
+var metricsSchema = arrow.NewSchema([]arrow.Field{
+	{Name: "timestamp", Type: timestampType},
+	{Name: "start_timestamp", Type: timestampType, Nullable: true},
+	{Name: "metric_name", Type: arrow.BinaryTypes.String},
+	{Name: "metric_description", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "metric_unit", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "metric_type", Type: arrow.BinaryTypes.String},
+	{Name: "is_monotonic", Type: arrow.FixedWidthTypes.Boolean, Nullable: true},
+	{Name: "aggregation_temporality", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "value", Type: arrow.PrimitiveTypes.Float64, Nullable: true},
+	{Name: "count", Type: arrow.PrimitiveTypes.Uint64, Nullable: true},
+	{Name: "sum", Type: arrow.PrimitiveTypes.Float64, Nullable: true},
+	{Name: "min", Type: arrow.PrimitiveTypes.Float64, Nullable: true},
+	{Name: "max", Type: arrow.PrimitiveTypes.Float64, Nullable: true},
+	{Name: "bucket_counts", Type: arrow.ListOf(arrow.PrimitiveTypes.Uint64), Nullable: true},
+	{Name: "explicit_bounds", Type: arrow.ListOf(arrow.PrimitiveTypes.Float64), Nullable: true},
+	{Name: "quantiles", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "scale", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
+	{Name: "zero_count", Type: arrow.PrimitiveTypes.Uint64, Nullable: true},
+	{Name: "positive_offset", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
+	{Name: "positive_bucket_counts", Type: arrow.ListOf(arrow.PrimitiveTypes.Uint64), Nullable: true},
+	{Name: "negative_offset", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
+	{Name: "negative_bucket_counts", Type: arrow.ListOf(arrow.PrimitiveTypes.Uint64), Nullable: true},
+	{Name: "service_name", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "scope_name", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "scope_version", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "attributes", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "resource_attributes", Type: arrow.BinaryTypes.String, Nullable: true},
+}, nil)
@@ -0,0 +1,137 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package parquetexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/parquetexporter"
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/apache/arrow/go/v15/parquet/pqarrow"
+	"go.uber.org/zap"
+)
+
+const (
+	fileSuffix = ".parquet"
+	// tmpSuffix is the suffix of the files being written, which are renamed once closed so that
+	// the readers only see complete files.
+	tmpSuffix = fileSuffix + ".tmp"
+
+	// fileTimeLayout is the layout of the time the files are opened at in their name, which sort
+	// in the order of the files.
+	fileTimeLayout = "20060102T150405.000000000Z"
+)
+
+// countingWriter counts the bytes written to the file.
+type countingWriter struct {
+	file    *os.File
+	written int64
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	n, err := w.file.Write(p)
+	w.written += int64(n)
+	return n, err
+}
+
+// currentFile is the file being written.
+type currentFile struct {
+	path   string
+	sink   *countingWriter
+	writer *pqarrow.FileWriter
+	timer  *time.Timer
+	rows   int
+}
+
+// openFile starts a new file, which is rotated at the latest after the rotation interval. The
+// lock must be held.
+func (e *parquetExporter) openFile() error {
+	path := filepath.Join(e.dir, e.signal+"-"+e.now().UTC().Format(fileTimeLayout)+fileSuffix)
+	file, err := os.OpenFile(path+tmpSuffix, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to create the file '%s': %w", path, err)
+	}
+	sink := &countingWriter{file: file}
+	writer, err := pqarrow.NewFileWriter(e.schema, sink, e.props, pqarrow.DefaultWriterProps())
+	if err != nil {
+		_ = file.Close()
+		_ = os.Remove(path + tmpSuffix)
+		return fmt.Errorf("failed to create the file '%s': %w", path, err)
+	}
+	e.file = &currentFile{
+		path:   path,
+		sink:   sink,
+		writer: writer,
+		timer:  time.AfterFunc(e.cfg.Rotation.Interval, func() { e.rotate(path) }),
+	}
+	return nil
+}
+
+// closeFile writes the footer of the current file, renames it to its final name, and applies
+// the retention. The lock must be held.
+func (e *parquetExporter) closeFile() error {
+	if e.file == nil {
+		return nil
+	}
+	file := e.file
+	e.file = nil
+	file.timer.Stop()
+
+	err := errors.Join(file.writer.Close(), file.sink.file.Close())
+	if err == nil {
+		err = os.Rename(file.path+tmpSuffix, file.path)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to close the file '%s': %w", file.path, err)
+	}
+	e.logger.Debug("Closed the file", zap.String("path", file.path), zap.Int("rows", file.rows))
+	e.applyRetention()
+	return nil
+}
+
+// applyRetention removes the closed files older than the maximum age, then the oldest files
+// beyond the maximum number of files.
+func (e *parquetExporter) applyRetention() {
+	if e.cfg.Retention.MaxAge == 0 && e.cfg.Retention.MaxFiles == 0 {
+		return
+	}
+	entries, err := os.ReadDir(e.dir)
+	if err != nil {
+		e.logger.Warn("Failed to list the files for the retention", zap.String("directory", e.dir), zap.Error(err))
+		return
+	}
+	// the entries are sorted by name, hence from the oldest to the newest file
+	var files []string
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasPrefix(name, e.signal+"-") || !strings.HasSuffix(name, fileSuffix) {
+			continue
+		}
+		if e.cfg.Retention.MaxAge > 0 {
+			info, err := entry.Info()
+			if err == nil && e.now().Sub(info.ModTime()) > e.cfg.Retention.MaxAge {
+				e.removeFile(name)
+				continue
+			}
+		}
+		files = append(files, name)
+	}
+	if e.cfg.Retention.MaxFiles > 0 && len(files) > e.cfg.Retention.MaxFiles {
+		for _, name := range files[:len(files)-e.cfg.Retention.MaxFiles] {
+			e.removeFile(name)
+		}
+	}
+}
+
+func (e *parquetExporter) removeFile(name string) {
+	path := filepath.Join(e.dir, name)
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		e.logger.Warn("Failed to remove the file", zap.String("path", path), zap.Error(err))
+		return
+	}
+	e.logger.Debug("Removed the file", zap.String("path", path))
+}
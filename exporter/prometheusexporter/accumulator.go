@@ -47,13 +47,17 @@ type lastValueAccumulator struct {
 	// metricExpiration contains duration for which metric
 	// should be served after it was updated
 	metricExpiration time.Duration
+
+	// expirationOverrides override metricExpiration for the series they match
+	expirationOverrides []MetricExpirationOverride
 }
 
 // NewAccumulator returns LastValueAccumulator
-func newAccumulator(logger *zap.Logger, metricExpiration time.Duration) accumulator {
+func newAccumulator(logger *zap.Logger, metricExpiration time.Duration, expirationOverrides []MetricExpirationOverride) accumulator {
 	return &lastValueAccumulator{
-		logger:           logger,
-		metricExpiration: metricExpiration,
+		logger:              logger,
+		metricExpiration:    metricExpiration,
+		expirationOverrides: expirationOverrides,
 	}
 }
 
@@ -297,11 +301,11 @@ func (a *lastValueAccumulator) Collect() ([]pmetric.Metric, []pcommon.Map) {
 
 	var metrics []pmetric.Metric
 	var resourceAttrs []pcommon.Map
-	expirationTime := time.Now().Add(-a.metricExpiration)
+	now := time.Now()
 
 	a.registeredMetrics.Range(func(key, value any) bool {
 		v := value.(*accumulatedValue)
-		if expirationTime.After(v.updated) {
+		if now.Add(-a.expiration(v.value)).After(v.updated) {
 			a.logger.Debug(fmt.Sprintf("metric expired: %s", v.value.Name()))
 			a.registeredMetrics.Delete(key)
 			return true
@@ -315,6 +319,35 @@ func (a *lastValueAccumulator) Collect() ([]pmetric.Metric, []pcommon.Map) {
 	return metrics, resourceAttrs
 }
 
+// expiration returns the duration for which the series of the metric should be served after it
+// was updated.
+func (a *lastValueAccumulator) expiration(metric pmetric.Metric) time.Duration {
+	if len(a.expirationOverrides) == 0 {
+		return a.metricExpiration
+	}
+	attributes := dataPointAttributes(metric)
+	for _, override := range a.expirationOverrides {
+		if override.matches(metric.Name(), attributes) {
+			return override.Expiration
+		}
+	}
+	return a.metricExpiration
+}
+
+func dataPointAttributes(metric pmetric.Metric) pcommon.Map {
+	switch metric.Type() {
+	case pmetric.MetricTypeGauge:
+		return metric.Gauge().DataPoints().At(0).Attributes()
+	case pmetric.MetricTypeSum:
+		return metric.Sum().DataPoints().At(0).Attributes()
+	case pmetric.MetricTypeHistogram:
+		return metric.Histogram().DataPoints().At(0).Attributes()
+	case pmetric.MetricTypeSummary:
+		return metric.Summary().DataPoints().At(0).Attributes()
+	}
+	return pcommon.NewMap()
+}
+
 func timeseriesSignature(ilmName string, metric pmetric.Metric, attributes pcommon.Map, resourceAttrs pcommon.Map) string {
 	var b strings.Builder
 	b.WriteString(metric.Type().String())
@@ -229,7 +229,7 @@ func TestAccumulateMetrics(t *testing.T) {
 			tt.metric(ts2, 21, ilm2.Metrics())
 			tt.metric(ts1, 13, ilm2.Metrics())
 
-			a := newAccumulator(zap.NewNop(), 1*time.Hour).(*lastValueAccumulator)
+			a := newAccumulator(zap.NewNop(), 1*time.Hour, nil).(*lastValueAccumulator)
 
 			// 2 metric arrived
 			n := a.Accumulate(resourceMetrics2)
@@ -335,7 +335,7 @@ func TestAccumulateDeltaToCumulative(t *testing.T) {
 			resourceMetrics := pmetric.NewResourceMetrics()
 			ilm := resourceMetrics.ScopeMetrics().AppendEmpty()
 			ilm.Scope().SetName("test")
-			a := newAccumulator(zap.NewNop(), 1*time.Hour).(*lastValueAccumulator)
+			a := newAccumulator(zap.NewNop(), 1*time.Hour, nil).(*lastValueAccumulator)
 
 			dataPointValue1 := float64(11)
 			dataPointValue2 := float64(32)
@@ -411,7 +411,7 @@ func TestAccumulateDeltaToCumulativeHistogram(t *testing.T) {
 		m2 := ilm.Metrics().At(1).Histogram().DataPoints().At(0)
 		signature := timeseriesSignature(ilm.Scope().Name(), ilm.Metrics().At(0), m2.Attributes(), pcommon.NewMap())
 
-		a := newAccumulator(zap.NewNop(), 1*time.Hour).(*lastValueAccumulator)
+		a := newAccumulator(zap.NewNop(), 1*time.Hour, nil).(*lastValueAccumulator)
 		n := a.Accumulate(resourceMetrics)
 		require.Equal(t, 2, n)
 
@@ -445,7 +445,7 @@ func TestAccumulateDeltaToCumulativeHistogram(t *testing.T) {
 		signature := timeseriesSignature(ilm.Scope().Name(), ilm.Metrics().At(0), m2.Attributes(), pcommon.NewMap())
 
 		// should ignore metric with different buckets from the past
-		a := newAccumulator(zap.NewNop(), 1*time.Hour).(*lastValueAccumulator)
+		a := newAccumulator(zap.NewNop(), 1*time.Hour, nil).(*lastValueAccumulator)
 		n := a.Accumulate(resourceMetrics)
 		require.Equal(t, 1, n)
 
@@ -479,7 +479,7 @@ func TestAccumulateDeltaToCumulativeHistogram(t *testing.T) {
 		signature := timeseriesSignature(ilm.Scope().Name(), ilm.Metrics().At(0), m2.Attributes(), pcommon.NewMap())
 
 		// should ignore metric with different buckets from the past
-		a := newAccumulator(zap.NewNop(), 1*time.Hour).(*lastValueAccumulator)
+		a := newAccumulator(zap.NewNop(), 1*time.Hour, nil).(*lastValueAccumulator)
 		n := a.Accumulate(resourceMetrics)
 		require.Equal(t, 2, n)
 
@@ -513,7 +513,7 @@ func TestAccumulateDeltaToCumulativeHistogram(t *testing.T) {
 		m1 := ilm.Metrics().At(0).Histogram().DataPoints().At(0)
 		signature := timeseriesSignature(ilm.Scope().Name(), ilm.Metrics().At(0), m1.Attributes(), pcommon.NewMap())
 
-		a := newAccumulator(zap.NewNop(), 1*time.Hour).(*lastValueAccumulator)
+		a := newAccumulator(zap.NewNop(), 1*time.Hour, nil).(*lastValueAccumulator)
 		n := a.Accumulate(resourceMetrics)
 		require.Equal(t, 1, n)
 
@@ -547,7 +547,7 @@ func TestAccumulateDeltaToCumulativeHistogram(t *testing.T) {
 		m2 := ilm.Metrics().At(1).Histogram().DataPoints().At(0)
 		signature := timeseriesSignature(ilm.Scope().Name(), ilm.Metrics().At(0), m2.Attributes(), pcommon.NewMap())
 
-		a := newAccumulator(zap.NewNop(), 1*time.Hour).(*lastValueAccumulator)
+		a := newAccumulator(zap.NewNop(), 1*time.Hour, nil).(*lastValueAccumulator)
 		n := a.Accumulate(resourceMetrics)
 		require.Equal(t, 2, n)
 
@@ -632,7 +632,7 @@ func TestAccumulateDroppedMetrics(t *testing.T) {
 			ilm.Scope().SetName("test")
 			tt.fillMetric(time.Now(), ilm.Metrics().AppendEmpty())
 
-			a := newAccumulator(zap.NewNop(), 1*time.Hour).(*lastValueAccumulator)
+			a := newAccumulator(zap.NewNop(), 1*time.Hour, nil).(*lastValueAccumulator)
 			n := a.Accumulate(resourceMetrics)
 			require.Equal(t, 0, n)
 
@@ -644,6 +644,58 @@ func TestAccumulateDroppedMetrics(t *testing.T) {
 	}
 }
 
+func TestCollectEndedSeries(t *testing.T) {
+	ts := time.Now().Add(-time.Minute)
+	resourceMetrics := pmetric.NewResourceMetrics()
+	ilm := resourceMetrics.ScopeMetrics().AppendEmpty()
+	ilm.Scope().SetName("test")
+	metric := ilm.Metrics().AppendEmpty()
+	metric.SetName("test_metric")
+	dp := metric.SetEmptyGauge().DataPoints().AppendEmpty()
+	dp.SetDoubleValue(42.42)
+	dp.SetTimestamp(pcommon.NewTimestampFromTime(ts))
+
+	a := newAccumulator(zap.NewNop(), 1*time.Hour, nil).(*lastValueAccumulator)
+	require.Equal(t, 1, a.Accumulate(resourceMetrics))
+	metrics, _ := a.Collect()
+	require.Len(t, metrics, 1)
+
+	// the series ended by a data point without recorded value is missing from the next scrape, for
+	// Prometheus to mark it stale, instead of being served with a NaN sample
+	dp.SetFlags(pmetric.DefaultDataPointFlags.WithNoRecordedValue(true))
+	dp.SetTimestamp(pcommon.NewTimestampFromTime(ts.Add(time.Second)))
+	require.Equal(t, 0, a.Accumulate(resourceMetrics))
+	metrics, _ = a.Collect()
+	require.Empty(t, metrics)
+}
+
+func TestCollectExpirationOverrides(t *testing.T) {
+	a := newAccumulator(zap.NewNop(), 5*time.Minute, []MetricExpirationOverride{
+		{MetricNames: []string{"job.*"}, Expiration: 30 * time.Second},
+		{MetricNames: []string{"sql.*"}, Attributes: map[string]string{"interval": "daily"}, Expiration: 48 * time.Hour},
+	}).(*lastValueAccumulator)
+	store := func(name string, interval string, updated time.Duration) {
+		m := pmetric.NewMetric()
+		m.SetName(name)
+		dp := m.SetEmptyGauge().DataPoints().AppendEmpty()
+		dp.SetIntValue(1)
+		dp.Attributes().PutStr("interval", interval)
+		a.registeredMetrics.Store(name+interval, &accumulatedValue{value: m, resourceAttrs: pcommon.NewMap(), updated: time.Now().Add(-updated)})
+	}
+	store("job.duration", "", time.Minute)
+	store("sql.rows", "daily", 24*time.Hour)
+	store("sql.rows", "hourly", 24*time.Hour)
+	store("other", "", time.Minute)
+
+	metrics, _ := a.Collect()
+	names := make([]string, 0, len(metrics))
+	for _, metric := range metrics {
+		interval, _ := metric.Gauge().DataPoints().At(0).Attributes().Get("interval")
+		names = append(names, metric.Name()+"/"+interval.Str())
+	}
+	require.ElementsMatch(t, []string{"sql.rows/daily", "other/"}, names)
+}
+
 func TestTimeseriesSignatureNotMutating(t *testing.T) {
 	attrs := pcommon.NewMap()
 	attrs.PutStr("label_2", "2")
@@ -34,7 +34,7 @@ type collector struct {
 
 func newCollector(config *Config, logger *zap.Logger) *collector {
 	return &collector{
-		accumulator:       newAccumulator(logger, config.MetricExpiration),
+		accumulator:       newAccumulator(logger, config.MetricExpiration, config.MetricExpirationOverrides),
 		logger:            logger,
 		namespace:         prometheustranslator.CleanUpString(config.Namespace),
 		sendTimestamps:    config.SendTimestamps,
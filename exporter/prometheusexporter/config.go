@@ -4,11 +4,15 @@
 package prometheusexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/prometheusexporter"
 
 import (
+	"errors"
+	"fmt"
+	"path"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/pdata/pcommon"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/resourcetotelemetry"
 )
@@ -29,6 +33,10 @@ type Config struct {
 	// MetricExpiration defines how long metrics are kept without updates
 	MetricExpiration time.Duration `mapstructure:"metric_expiration"`
 
+	// MetricExpirationOverrides override the metric expiration of the series they match, the first
+	// matching override applying.
+	MetricExpirationOverrides []MetricExpirationOverride `mapstructure:"metric_expiration_overrides"`
+
 	// ResourceToTelemetrySettings defines configuration for converting resource attributes to metric labels.
 	ResourceToTelemetrySettings resourcetotelemetry.Settings `mapstructure:"resource_to_telemetry_conversion"`
 
@@ -39,9 +47,61 @@ type Config struct {
 	AddMetricSuffixes bool `mapstructure:"add_metric_suffixes"`
 }
 
+// MetricExpirationOverride overrides the metric expiration of the series of the metrics whose
+// name matches, and whose attributes have the given values.
+type MetricExpirationOverride struct {
+	// MetricNames are the names or the glob patterns of the names of the metrics, before their
+	// normalization.
+	MetricNames []string `mapstructure:"metric_names"`
+
+	// Attributes are the values the attributes of the data points must have, if set.
+	Attributes map[string]string `mapstructure:"attributes"`
+
+	// Expiration defines how long the matching series are kept without updates.
+	Expiration time.Duration `mapstructure:"expiration"`
+}
+
 var _ component.Config = (*Config)(nil)
 
 // Validate checks if the exporter configuration is valid
 func (cfg *Config) Validate() error {
-	return nil
+	var errs []error
+	for i, override := range cfg.MetricExpirationOverrides {
+		if err := override.validate(); err != nil {
+			errs = append(errs, fmt.Errorf("metric_expiration_overrides[%d]: %w", i, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (override MetricExpirationOverride) validate() error {
+	var errs []error
+	if len(override.MetricNames) == 0 {
+		errs = append(errs, errors.New("'metric_names' cannot be empty"))
+	}
+	for _, name := range override.MetricNames {
+		if _, err := path.Match(name, ""); err != nil {
+			errs = append(errs, fmt.Errorf("invalid pattern '%s' in 'metric_names': %w", name, err))
+		}
+	}
+	if override.Expiration <= 0 {
+		errs = append(errs, errors.New("'expiration' must be positive"))
+	}
+	return errors.Join(errs...)
+}
+
+// matches reports whether the series of the metric with the attributes matches the override.
+func (override MetricExpirationOverride) matches(name string, attributes pcommon.Map) bool {
+	for key, expected := range override.Attributes {
+		value, ok := attributes.Get(key)
+		if !ok || value.AsString() != expected {
+			return false
+		}
+	}
+	for _, pattern := range override.MetricNames {
+		if matched, _ := path.Match(pattern, name); matched {
+			return true
+		}
+	}
+	return false
 }
@@ -55,6 +55,21 @@ func TestLoadConfig(t *testing.T) {
 				AddMetricSuffixes: false,
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "3"),
+			expected: &Config{
+				ServerConfig: confighttp.ServerConfig{
+					Endpoint: "1.2.3.4:1234",
+				},
+				ConstLabels:      map[string]string{},
+				MetricExpiration: 5 * time.Minute,
+				MetricExpirationOverrides: []MetricExpirationOverride{
+					{MetricNames: []string{"job.*"}, Expiration: 30 * time.Second},
+					{MetricNames: []string{"sql.rows", "sql.duration"}, Attributes: map[string]string{"interval": "daily"}, Expiration: 48 * time.Hour},
+				},
+				AddMetricSuffixes: true,
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -71,3 +86,15 @@ func TestLoadConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestConfigValidate(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.MetricExpirationOverrides = []MetricExpirationOverride{
+		{MetricNames: []string{"job.*"}, Expiration: 30 * time.Second},
+		{MetricNames: []string{"sql.["}},
+		{Expiration: time.Minute},
+	}
+	assert.EqualError(t, cfg.Validate(), "metric_expiration_overrides[1]: invalid pattern 'sql.[' in 'metric_names': syntax error in pattern\n"+
+		"'expiration' must be positive\n"+
+		"metric_expiration_overrides[2]: 'metric_names' cannot be empty")
+}
@@ -7,7 +7,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"os"
 	"path/filepath"
+	"strconv"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -25,6 +28,9 @@ type prweWAL struct {
 	wal       *wal.Log
 	walConfig *WALConfig
 	walPath   string
+	// checkpointPath is the file holding the index of the last request exported from the WAL,
+	// so that the requests already exported aren't replayed when the WAL is reopened.
+	checkpointPath string
 
 	exportSink func(ctx context.Context, reqL []*prompb.WriteRequest) error
 
@@ -67,11 +73,12 @@ func newWAL(walConfig *WALConfig, exportSink func(context.Context, []*prompb.Wri
 	}
 
 	return &prweWAL{
-		exportSink: exportSink,
-		walConfig:  walConfig,
-		stopChan:   make(chan struct{}),
-		rWALIndex:  &atomic.Uint64{},
-		wWALIndex:  &atomic.Uint64{},
+		exportSink:     exportSink,
+		walConfig:      walConfig,
+		checkpointPath: filepath.Join(walConfig.Directory, "prom_remotewrite.checkpoint"),
+		stopChan:       make(chan struct{}),
+		rWALIndex:      &atomic.Uint64{},
+		wWALIndex:      &atomic.Uint64{},
 	}
 }
 
@@ -121,6 +128,45 @@ func (prwe *prweWAL) retrieveWALIndices() (err error) {
 		return fmt.Errorf("prometheusremotewriteexporter: failed to retrieve the last WAL index: %w", err)
 	}
 	prwe.wWALIndex.Store(wIndex)
+
+	// The WAL always keeps at least its last entry, skip the entries which were already exported.
+	// A checkpoint beyond the last index belongs to a WAL which was since removed and is ignored.
+	checkpoint, err := prwe.readCheckpoint()
+	if err != nil {
+		return err
+	}
+	if checkpoint >= rIndex && checkpoint <= wIndex {
+		prwe.rWALIndex.Store(checkpoint + 1)
+	}
+	return nil
+}
+
+// readCheckpoint returns the index of the last exported request, 0 if none was recorded.
+func (prwe *prweWAL) readCheckpoint() (uint64, error) {
+	data, err := os.ReadFile(prwe.checkpointPath)
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("prometheusremotewriteexporter: failed to read the WAL checkpoint: %w", err)
+	}
+	index, err := strconv.ParseUint(strings.TrimSpace(string(data)), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("prometheusremotewriteexporter: invalid WAL checkpoint: %w", err)
+	}
+	return index, nil
+}
+
+// writeCheckpoint records the index of the last exported request, replacing the checkpoint
+// atomically so that a crash never leaves it partially written.
+func (prwe *prweWAL) writeCheckpoint(index uint64) error {
+	tmpPath := prwe.checkpointPath + ".tmp"
+	if err := os.WriteFile(tmpPath, []byte(strconv.FormatUint(index, 10)), 0o600); err != nil {
+		return fmt.Errorf("prometheusremotewriteexporter: failed to write the WAL checkpoint: %w", err)
+	}
+	if err := os.Rename(tmpPath, prwe.checkpointPath); err != nil {
+		return fmt.Errorf("prometheusremotewriteexporter: failed to write the WAL checkpoint: %w", err)
+	}
 	return nil
 }
 
@@ -193,6 +239,8 @@ func (prwe *prweWAL) continuallyPopWALThenExport(ctx context.Context, signalStar
 		// updated value of reqL is always flushed to disk.
 		if errL := prwe.exportSink(ctx, reqL); errL != nil {
 			err = multierr.Append(err, errL)
+		} else if len(reqL) > 0 {
+			err = multierr.Append(err, prwe.writeCheckpoint(prwe.rWALIndex.Load()-1))
 		}
 	}()
 
@@ -272,8 +320,18 @@ func (prwe *prweWAL) syncAndTruncateFront() error {
 		return err
 	}
 	// Truncate the WAL from the front for the entries that we already
-	// read from the WAL and had already exported.
-	if err := prwe.wal.TruncateFront(prwe.rWALIndex.Load()); err != nil && !errors.Is(err, wal.ErrOutOfRange) {
+	// read from the WAL and had already exported. The WAL can't be
+	// truncated beyond its last entry, which is skipped on replay
+	// thanks to the checkpoint.
+	truncateIndex := prwe.rWALIndex.Load()
+	lastIndex, err := prwe.wal.LastIndex()
+	if err != nil {
+		return err
+	}
+	if truncateIndex > lastIndex {
+		truncateIndex = lastIndex
+	}
+	if err := prwe.wal.TruncateFront(truncateIndex); err != nil && !errors.Is(err, wal.ErrOutOfRange) {
 		return err
 	}
 	return nil
@@ -290,6 +348,9 @@ func (prwe *prweWAL) exportThenFrontTruncateWAL(ctx context.Context, reqL []*pro
 	if errL := prwe.exportSink(ctx, reqL); errL != nil {
 		return errL
 	}
+	if err := prwe.writeCheckpoint(prwe.rWALIndex.Load() - 1); err != nil {
+		return err
+	}
 	if err := prwe.syncAndTruncateFront(); err != nil {
 		return err
 	}
@@ -348,8 +409,9 @@ func (prwe *prweWAL) readPrompbFromWAL(ctx context.Context, index uint64) (wreq
 				return nil, err
 			}
 
-			// Now increment the WAL's read index.
-			prwe.rWALIndex.Add(1)
+			// Now move the WAL's read index past the entry read, the
+			// first read of an empty WAL being made at index 1.
+			prwe.rWALIndex.Store(index + 1)
 
 			return req, nil
 		}
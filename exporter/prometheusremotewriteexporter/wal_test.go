@@ -149,3 +149,98 @@ func TestWAL_persist(t *testing.T) {
 	require.Equal(t, reqLFromWAL[0], reqL[0])
 	require.Equal(t, reqLFromWAL[1], reqL[1])
 }
+
+func TestWAL_checkpointSkipsExportedRequests(t *testing.T) {
+	config := &WALConfig{Directory: t.TempDir()}
+
+	var exported []*prompb.WriteRequest
+	exportSink := func(_ context.Context, reqL []*prompb.WriteRequest) error {
+		exported = append(exported, reqL...)
+		return nil
+	}
+	newRequest := func(value float64) *prompb.WriteRequest {
+		return &prompb.WriteRequest{
+			Timeseries: []prompb.TimeSeries{
+				{
+					Labels:  []prompb.Label{{Name: "ts1l1", Value: "ts1k1"}},
+					Samples: []prompb.Sample{{Value: value, Timestamp: 100}},
+				},
+			},
+		}
+	}
+
+	ctx := context.Background()
+	pwal := newWAL(config, exportSink)
+	require.NotNil(t, pwal)
+	require.NoError(t, pwal.retrieveWALIndices())
+	require.NoError(t, pwal.persistToWAL([]*prompb.WriteRequest{newRequest(1), newRequest(2)}))
+
+	// 1. Read and export all the requests persisted.
+	var reqL []*prompb.WriteRequest
+	for i := 0; i < 2; i++ {
+		req, err := pwal.readPrompbFromWAL(ctx, pwal.rWALIndex.Load())
+		require.NoError(t, err)
+		reqL = append(reqL, req)
+	}
+	require.NoError(t, pwal.exportThenFrontTruncateWAL(ctx, reqL))
+	require.Len(t, exported, 2)
+	assert.Equal(t, 1.0, exported[0].Timeseries[0].Samples[0].Value)
+	assert.Equal(t, 2.0, exported[1].Timeseries[0].Samples[0].Value)
+	assert.Equal(t, uint64(3), pwal.rWALIndex.Load())
+	require.NoError(t, pwal.stop())
+
+	// 2. Reopen the WAL, as on restart: the requests already exported must not be replayed.
+	pwal = newWAL(config, exportSink)
+	require.NotNil(t, pwal)
+	require.NoError(t, pwal.retrieveWALIndices())
+	t.Cleanup(func() {
+		assert.NoError(t, pwal.stop())
+	})
+	assert.Equal(t, uint64(3), pwal.rWALIndex.Load())
+
+	checkpoint, err := pwal.readCheckpoint()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), checkpoint)
+
+	// 3. New requests are read in order after the exported ones.
+	require.NoError(t, pwal.persistToWAL([]*prompb.WriteRequest{newRequest(3)}))
+	req, err := pwal.readPrompbFromWAL(ctx, pwal.rWALIndex.Load())
+	require.NoError(t, err)
+	assert.Equal(t, 3.0, req.Timeseries[0].Samples[0].Value)
+}
+
+func TestWAL_replayUnexportedRequests(t *testing.T) {
+	config := &WALConfig{Directory: t.TempDir()}
+
+	pwal := newWAL(config, doNothingExportSink)
+	require.NotNil(t, pwal)
+	require.NoError(t, pwal.retrieveWALIndices())
+	reqL := make([]*prompb.WriteRequest, 3)
+	for i := range reqL {
+		reqL[i] = &prompb.WriteRequest{
+			Timeseries: []prompb.TimeSeries{
+				{
+					Labels:  []prompb.Label{{Name: "ts1l1", Value: "ts1k1"}},
+					Samples: []prompb.Sample{{Value: float64(i), Timestamp: 100}},
+				},
+			},
+		}
+	}
+	require.NoError(t, pwal.persistToWAL(reqL))
+	// Simulate a crash: the requests were persisted but never exported.
+	require.NoError(t, pwal.stop())
+
+	ctx := context.Background()
+	pwal = newWAL(config, doNothingExportSink)
+	require.NotNil(t, pwal)
+	require.NoError(t, pwal.retrieveWALIndices())
+	t.Cleanup(func() {
+		assert.NoError(t, pwal.stop())
+	})
+
+	for i := range reqL {
+		req, err := pwal.readPrompbFromWAL(ctx, pwal.rWALIndex.Load())
+		require.NoError(t, err)
+		assert.Equal(t, float64(i), req.Timeseries[0].Samples[0].Value)
+	}
+}
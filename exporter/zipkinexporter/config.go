@@ -5,8 +5,10 @@ package zipkinexporter // import "github.com/open-telemetry/opentelemetry-collec
 
 import (
 	"errors"
+	"fmt"
 
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configgrpc"
 	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/config/configretry"
 	"go.opentelemetry.io/collector/exporter/exporterhelper"
@@ -24,14 +26,37 @@ type Config struct {
 	Format string `mapstructure:"format"`
 
 	DefaultServiceName string `mapstructure:"default_service_name"`
+
+	// GRPC configures the client sending the spans as protobuf over gRPC, using the
+	// Zipkin v3 SpanService, instead of HTTP. The format is ignored when it is set.
+	GRPC *configgrpc.ClientConfig `mapstructure:"grpc"`
+
+	// TraceIDFormat is the format of the exported trace IDs, either "128bit" or "64bit".
+	// The "64bit" format truncates the trace IDs to their lower 64 bits, for Zipkin
+	// backends not supporting 128-bit trace IDs.
+	TraceIDFormat string `mapstructure:"trace_id_format"`
 }
 
+const (
+	traceIDFormat128Bit = "128bit"
+	traceIDFormat64Bit  = "64bit"
+)
+
 var _ component.Config = (*Config)(nil)
 
 // Validate checks if the exporter configuration is valid
 func (cfg *Config) Validate() error {
-	if cfg.ClientConfig.Endpoint == "" {
+	if cfg.GRPC != nil {
+		if cfg.GRPC.Endpoint == "" {
+			return errors.New("grpc endpoint required")
+		}
+	} else if cfg.ClientConfig.Endpoint == "" {
 		return errors.New("endpoint required")
 	}
+	switch cfg.TraceIDFormat {
+	case "", traceIDFormat128Bit, traceIDFormat64Bit:
+	default:
+		return fmt.Errorf("trace_id_format %q is not one of %s or %s", cfg.TraceIDFormat, traceIDFormat128Bit, traceIDFormat64Bit)
+	}
 	return nil
 }
@@ -12,6 +12,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configgrpc"
 	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/config/configretry"
 	"go.opentelemetry.io/collector/config/configtls"
@@ -69,8 +70,23 @@ func TestLoadConfig(t *testing.T) {
 				},
 				Format:             "proto",
 				DefaultServiceName: "test_name",
+				TraceIDFormat:      "128bit",
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "grpc"),
+			expected: func() component.Config {
+				cfg := createDefaultConfig().(*Config)
+				cfg.GRPC = &configgrpc.ClientConfig{
+					Endpoint: "somedest:9412",
+					TLSSetting: configtls.ClientConfig{
+						Insecure: true,
+					},
+				}
+				cfg.TraceIDFormat = "64bit"
+				return cfg
+			}(),
+		},
 	}
 
 	for _, tt := range tests {
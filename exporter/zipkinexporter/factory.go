@@ -42,6 +42,7 @@ func createDefaultConfig() component.Config {
 		ClientConfig:       defaultClientHTTPSettings,
 		Format:             defaultFormat,
 		DefaultServiceName: defaultServiceName,
+		TraceIDFormat:      traceIDFormat128Bit,
 	}
 }
 
@@ -62,6 +63,7 @@ func createTracesExporter(
 		cfg,
 		ze.pushTraces,
 		exporterhelper.WithStart(ze.start),
+		exporterhelper.WithShutdown(ze.shutdown),
 		// explicitly disable since we rely on http.Client timeout logic.
 		exporterhelper.WithTimeout(exporterhelper.TimeoutSettings{Timeout: 0}),
 		exporterhelper.WithQueue(zc.QueueSettings),
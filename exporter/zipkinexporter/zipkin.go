@@ -12,15 +12,25 @@ import (
 	"github.com/openzipkin/zipkin-go/proto/zipkin_proto3"
 	zipkinreporter "github.com/openzipkin/zipkin-go/reporter"
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configgrpc"
 	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/consumer/consumererror"
 	"go.opentelemetry.io/collector/pdata/ptrace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/zipkin/zipkinv2"
 )
 
 var translator zipkinv2.FromTranslator
 
+// spanServiceReportMethod is the method of the Zipkin v3 SpanService receiving the spans,
+// see https://github.com/openzipkin/zipkin-api/blob/master/zipkin.proto.
+const spanServiceReportMethod = "/zipkin.proto3.SpanService/Report"
+
 // zipkinExporter is a multiplexing exporter that spawns a new OpenCensus-Go Zipkin
 // exporter per unique node encountered. This is because serviceNames per node define
 // unique services, alongside their IPs. Also it is useful to receive traffic from
@@ -34,6 +44,10 @@ type zipkinExporter struct {
 	serializer     zipkinreporter.SpanSerializer
 	clientSettings *confighttp.ClientConfig
 	settings       component.TelemetrySettings
+
+	grpcSettings   *configgrpc.ClientConfig
+	grpcConn       *grpc.ClientConn
+	truncateTraces bool
 }
 
 func createZipkinExporter(cfg *Config, settings component.TelemetrySettings) (*zipkinExporter, error) {
@@ -43,6 +57,14 @@ func createZipkinExporter(cfg *Config, settings component.TelemetrySettings) (*z
 		clientSettings:     &cfg.ClientConfig,
 		client:             nil,
 		settings:           settings,
+		grpcSettings:       cfg.GRPC,
+		truncateTraces:     cfg.TraceIDFormat == traceIDFormat64Bit,
+	}
+
+	if ze.grpcSettings != nil {
+		// The SpanService only accepts protobuf.
+		ze.serializer = zipkin_proto3.SpanSerializer{}
+		return ze, nil
 	}
 
 	switch cfg.Format {
@@ -57,23 +79,45 @@ func createZipkinExporter(cfg *Config, settings component.TelemetrySettings) (*z
 	return ze, nil
 }
 
-// start creates the http client
+// start creates the http client, or the gRPC connection
 func (ze *zipkinExporter) start(ctx context.Context, host component.Host) (err error) {
+	if ze.grpcSettings != nil {
+		ze.grpcConn, err = ze.grpcSettings.ToClientConn(ctx, host, ze.settings)
+		return
+	}
 	ze.client, err = ze.clientSettings.ToClient(ctx, host, ze.settings)
 	return
 }
 
+// shutdown closes the gRPC connection
+func (ze *zipkinExporter) shutdown(context.Context) error {
+	if ze.grpcConn != nil {
+		return ze.grpcConn.Close()
+	}
+	return nil
+}
+
 func (ze *zipkinExporter) pushTraces(ctx context.Context, td ptrace.Traces) error {
 	spans, err := translator.FromTraces(td)
 	if err != nil {
 		return consumererror.NewPermanent(fmt.Errorf("failed to push trace data via Zipkin exporter: %w", err))
 	}
 
+	if ze.truncateTraces {
+		for _, span := range spans {
+			span.TraceID.High = 0
+		}
+	}
+
 	body, err := ze.serializer.Serialize(spans)
 	if err != nil {
 		return consumererror.NewPermanent(fmt.Errorf("failed to push trace data via Zipkin exporter: %w", err))
 	}
 
+	if ze.grpcConn != nil {
+		return ze.reportSpans(ctx, body)
+	}
+
 	req, err := http.NewRequestWithContext(ctx, "POST", ze.url, bytes.NewReader(body))
 	if err != nil {
 		return fmt.Errorf("failed to push trace data via Zipkin exporter: %w", err)
@@ -90,3 +134,29 @@ func (ze *zipkinExporter) pushTraces(ctx context.Context, td ptrace.Traces) erro
 	}
 	return nil
 }
+
+// reportSpans sends the protobuf encoded spans to the SpanService. zipkin-go only
+// converts its span model to the encoded form of the messages, so the body is
+// unmarshaled again.
+func (ze *zipkinExporter) reportSpans(ctx context.Context, body []byte) error {
+	var listOfSpans zipkin_proto3.ListOfSpans
+	if err := proto.Unmarshal(body, &listOfSpans); err != nil {
+		return consumererror.NewPermanent(fmt.Errorf("failed to push trace data via Zipkin exporter: %w", err))
+	}
+
+	if ze.clientSettings.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, ze.clientSettings.Timeout)
+		defer cancel()
+	}
+	err := ze.grpcConn.Invoke(ctx, spanServiceReportMethod, &listOfSpans, &emptypb.Empty{})
+	if err == nil {
+		return nil
+	}
+	switch status.Code(err) {
+	case codes.InvalidArgument, codes.Unimplemented, codes.PermissionDenied, codes.Unauthenticated:
+		return consumererror.NewPermanent(fmt.Errorf("failed to push trace data via Zipkin exporter: %w", err))
+	default:
+		return fmt.Errorf("failed to push trace data via Zipkin exporter: %w", err)
+	}
+}
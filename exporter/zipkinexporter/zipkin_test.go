@@ -19,8 +19,14 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config/configgrpc"
 	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/config/confignet"
+	"go.opentelemetry.io/collector/config/configtls"
+	"go.opentelemetry.io/collector/consumer/consumertest"
 	"go.opentelemetry.io/collector/exporter/exportertest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.opentelemetry.io/collector/receiver/receivertest"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/common/testutil"
@@ -341,3 +347,73 @@ func TestZipkinExporter_roundtripProto(t *testing.T) {
 	_, err = zipkin_proto3.ParseSpans(gotBytes, false)
 	require.NoError(t, err)
 }
+
+func TestZipkinExporter_roundtripGRPC(t *testing.T) {
+	tests := []struct {
+		name          string
+		traceIDFormat string
+		wantTraceID   pcommon.TraceID
+	}{
+		{
+			name:          "128bit",
+			traceIDFormat: "128bit",
+			wantTraceID:   pcommon.TraceID([16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}),
+		},
+		{
+			name:          "64bit",
+			traceIDFormat: "64bit",
+			wantTraceID:   pcommon.TraceID([16]byte{0, 0, 0, 0, 0, 0, 0, 0, 9, 10, 11, 12, 13, 14, 15, 16}),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Run the Zipkin receiver serving the SpanService.
+			sink := new(consumertest.TracesSink)
+			addr := testutil.GetAvailableLocalAddress(t)
+			recvCfg := &zipkinreceiver.Config{
+				ServerConfig: confighttp.ServerConfig{
+					Endpoint: testutil.GetAvailableLocalAddress(t),
+				},
+				GRPC: &configgrpc.ServerConfig{
+					NetAddr: confignet.AddrConfig{
+						Endpoint:  addr,
+						Transport: confignet.TransportTypeTCP,
+					},
+				},
+			}
+			zi, err := zipkinreceiver.NewFactory().CreateTracesReceiver(context.Background(), receivertest.NewNopCreateSettings(), recvCfg, sink)
+			require.NoError(t, err)
+			require.NoError(t, zi.Start(context.Background(), componenttest.NewNopHost()))
+			t.Cleanup(func() { require.NoError(t, zi.Shutdown(context.Background())) })
+
+			cfg := createDefaultConfig().(*Config)
+			cfg.GRPC = &configgrpc.ClientConfig{
+				Endpoint: addr,
+				TLSSetting: configtls.ClientConfig{
+					Insecure: true,
+				},
+			}
+			cfg.TraceIDFormat = tt.traceIDFormat
+			require.NoError(t, cfg.Validate())
+			zexp, err := NewFactory().CreateTracesExporter(context.Background(), exportertest.NewNopCreateSettings(), cfg)
+			require.NoError(t, err)
+			require.NoError(t, zexp.Start(context.Background(), componenttest.NewNopHost()))
+			t.Cleanup(func() { require.NoError(t, zexp.Shutdown(context.Background())) })
+
+			td := ptrace.NewTraces()
+			rs := td.ResourceSpans().AppendEmpty()
+			rs.Resource().Attributes().PutStr("service.name", "frontend")
+			span := rs.ScopeSpans().AppendEmpty().Spans().AppendEmpty()
+			span.SetName("get /api")
+			span.SetTraceID([16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16})
+			span.SetSpanID([8]byte{1, 2, 3, 4, 5, 6, 7, 8})
+			require.NoError(t, zexp.ConsumeTraces(context.Background(), td))
+
+			require.Len(t, sink.AllTraces(), 1)
+			got := sink.AllTraces()[0].ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0)
+			assert.Equal(t, "get /api", got.Name())
+			assert.Equal(t, tt.wantTraceID, got.TraceID())
+		})
+	}
+}
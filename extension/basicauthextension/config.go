@@ -12,10 +12,11 @@ import (
 var (
 	errNoCredentialSource     = errors.New("no credential source provided")
 	errMultipleAuthenticators = errors.New("only one of `htpasswd` or `client_auth` can be specified")
+	errClientUsernameMetadata = errors.New("`username_metadata_key` can only be specified with `htpasswd`")
 )
 
 type HtpasswdSettings struct {
-	// Path to the htpasswd file.
+	// Path to the htpasswd file. The credentials are reloaded when the file changes.
 	File string `mapstructure:"file"`
 	// Inline contents of the htpasswd file.
 	Inline string `mapstructure:"inline"`
@@ -34,6 +35,10 @@ type Config struct {
 
 	// ClientAuth settings
 	ClientAuth *ClientAuthSettings `mapstructure:"client_auth,omitempty"`
+
+	// UsernameMetadataKey is the key of the client metadata set to the username of the
+	// authenticated user, when used as server authenticator.
+	UsernameMetadataKey string `mapstructure:"username_metadata_key,omitempty"`
 }
 
 func (cfg *Config) Validate() error {
@@ -48,5 +53,9 @@ func (cfg *Config) Validate() error {
 		return errNoCredentialSource
 	}
 
+	if clientCondition && cfg.UsernameMetadataKey != "" {
+		return errClientUsernameMetadata
+	}
+
 	return nil
 }
@@ -33,6 +33,7 @@ func TestLoadConfig(t *testing.T) {
 				Htpasswd: &HtpasswdSettings{
 					Inline: "username1:password1\nusername2:password2\n",
 				},
+				UsernameMetadataKey: "tenant",
 			},
 		},
 		{
@@ -48,6 +49,10 @@ func TestLoadConfig(t *testing.T) {
 			id:          component.NewIDWithName(metadata.Type, "both"),
 			expectedErr: true,
 		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "client_username_metadata"),
+			expectedErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.id.String(), func(t *testing.T) {
@@ -12,11 +12,14 @@ import (
 	"net/http"
 	"os"
 	"strings"
+	"sync"
 
+	"github.com/fsnotify/fsnotify"
 	"github.com/tg123/go-htpasswd"
 	"go.opentelemetry.io/collector/client"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/extension/auth"
+	"go.uber.org/zap"
 	creds "google.golang.org/grpc/credentials"
 )
 
@@ -28,13 +31,20 @@ var (
 )
 
 type basicAuth struct {
-	htpasswd   *HtpasswdSettings
-	clientAuth *ClientAuthSettings
-	matchFunc  func(username, password string) bool
+	htpasswd            *HtpasswdSettings
+	clientAuth          *ClientAuthSettings
+	usernameMetadataKey string
+	logger              *zap.Logger
+
+	mu        sync.RWMutex
+	matchFunc func(username, password string) bool
+
+	shutdownCH chan struct{}
+	wg         sync.WaitGroup
 }
 
 func newClientAuthExtension(cfg *Config) auth.Client {
-	ba := basicAuth{
+	ba := &basicAuth{
 		clientAuth: cfg.ClientAuth,
 	}
 	return auth.NewClient(
@@ -43,22 +53,99 @@ func newClientAuthExtension(cfg *Config) auth.Client {
 	)
 }
 
-func newServerAuthExtension(cfg *Config) (auth.Server, error) {
+func newServerAuthExtension(cfg *Config, logger *zap.Logger) (auth.Server, error) {
 
 	if cfg.Htpasswd == nil || (cfg.Htpasswd.File == "" && cfg.Htpasswd.Inline == "") {
 		return nil, errNoCredentialSource
 	}
 
-	ba := basicAuth{
-		htpasswd: cfg.Htpasswd,
+	ba := &basicAuth{
+		htpasswd:            cfg.Htpasswd,
+		usernameMetadataKey: cfg.UsernameMetadataKey,
+		logger:              logger,
 	}
 	return auth.NewServer(
 		auth.WithServerStart(ba.serverStart),
+		auth.WithServerShutdown(ba.serverShutdown),
 		auth.WithServerAuthenticate(ba.authenticate),
 	), nil
 }
 
 func (ba *basicAuth) serverStart(_ context.Context, _ component.Host) error {
+	if err := ba.loadHtpasswd(); err != nil {
+		return err
+	}
+
+	if ba.htpasswd.File == "" {
+		return nil
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("watch htpasswd file: %w", err)
+	}
+	if err = watcher.Add(ba.htpasswd.File); err != nil {
+		_ = watcher.Close()
+		return fmt.Errorf("watch htpasswd file: %w", err)
+	}
+
+	ba.shutdownCH = make(chan struct{})
+	ba.wg.Add(1)
+	go ba.watchHtpasswd(watcher)
+	return nil
+}
+
+func (ba *basicAuth) serverShutdown(_ context.Context) error {
+	if ba.shutdownCH == nil {
+		return nil
+	}
+	close(ba.shutdownCH)
+	ba.wg.Wait()
+	ba.shutdownCH = nil
+	return nil
+}
+
+// watchHtpasswd reloads the credentials when the htpasswd file changes.
+func (ba *basicAuth) watchHtpasswd(watcher *fsnotify.Watcher) {
+	defer ba.wg.Done()
+	defer watcher.Close()
+	for {
+		select {
+		case <-ba.shutdownCH:
+			return
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return
+			}
+			ba.logger.Warn("htpasswd file watcher error", zap.Error(err))
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return
+			}
+			// Files mounted from Kubernetes ConfigMaps and Secrets are replaced through
+			// a symlink swap, which removes the watched file.
+			// SEE: https://martensson.io/go-fsnotify-and-kubernetes-configmaps/
+			if event.Has(fsnotify.Remove) || event.Has(fsnotify.Rename) || event.Has(fsnotify.Chmod) {
+				_ = watcher.Remove(event.Name)
+				if err := watcher.Add(ba.htpasswd.File); err != nil {
+					ba.logger.Error("failed to watch the htpasswd file", zap.String("filename", ba.htpasswd.File), zap.Error(err))
+				}
+			}
+			ba.reloadHtpasswd()
+		}
+	}
+}
+
+func (ba *basicAuth) reloadHtpasswd() {
+	if err := ba.loadHtpasswd(); err != nil {
+		ba.logger.Error("failed to reload the htpasswd file, keeping the previous credentials",
+			zap.String("filename", ba.htpasswd.File), zap.Error(err))
+		return
+	}
+	ba.logger.Info("reloaded the htpasswd file", zap.String("filename", ba.htpasswd.File))
+}
+
+func (ba *basicAuth) loadHtpasswd() error {
 	var rs []io.Reader
 
 	if ba.htpasswd.File != "" {
@@ -82,11 +169,19 @@ func (ba *basicAuth) serverStart(_ context.Context, _ component.Host) error {
 		return fmt.Errorf("read htpasswd content: %w", err)
 	}
 
+	ba.mu.Lock()
 	ba.matchFunc = htp.Match
+	ba.mu.Unlock()
 
 	return nil
 }
 
+func (ba *basicAuth) match(username, password string) bool {
+	ba.mu.RLock()
+	defer ba.mu.RUnlock()
+	return ba.matchFunc(username, password)
+}
+
 func (ba *basicAuth) authenticate(ctx context.Context, headers map[string][]string) (context.Context, error) {
 	auth := getAuthHeader(headers)
 	if auth == "" {
@@ -98,15 +193,36 @@ func (ba *basicAuth) authenticate(ctx context.Context, headers map[string][]stri
 		return ctx, err
 	}
 
-	if !ba.matchFunc(authData.username, authData.password) {
+	if !ba.match(authData.username, authData.password) {
 		return ctx, errInvalidCredentials
 	}
 
 	cl := client.FromContext(ctx)
 	cl.Auth = authData
+	if ba.usernameMetadataKey != "" {
+		cl.Metadata = withUsernameMetadata(cl.Metadata, headers, ba.usernameMetadataKey, authData.username)
+	}
 	return client.NewContext(ctx, cl), nil
 }
 
+// withUsernameMetadata returns the metadata of the request with the key set to the username.
+// client.Metadata can't be iterated, its values are looked up from the request headers.
+func withUsernameMetadata(md client.Metadata, headers map[string][]string, key, username string) client.Metadata {
+	values := make(map[string][]string, len(headers)+1)
+	for k := range headers {
+		if v := md.Get(k); len(v) > 0 {
+			values[k] = v
+		}
+	}
+	for k := range values {
+		if strings.EqualFold(k, key) {
+			delete(values, k)
+		}
+	}
+	values[key] = []string{username}
+	return client.NewMetadata(values)
+}
+
 func getAuthHeader(h map[string][]string) string {
 	const (
 		canonicalHeaderKey = "Authorization"
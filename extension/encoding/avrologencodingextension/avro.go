@@ -13,6 +13,10 @@ type avroDeserializer interface {
 	Deserialize([]byte) (map[string]any, error)
 }
 
+type avroSerializer interface {
+	Serialize(map[string]any) ([]byte, error)
+}
+
 type avroStaticSchemaDeserializer struct {
 	codec *goavro.Codec
 }
@@ -36,3 +40,27 @@ func (d *avroStaticSchemaDeserializer) Deserialize(data []byte) (map[string]any,
 
 	return native.(map[string]any), nil
 }
+
+type avroStaticSchemaSerializer struct {
+	codec *goavro.Codec
+}
+
+func newAVROStaticSchemaSerializer(schema string) (avroSerializer, error) {
+	codec, err := goavro.NewCodec(schema)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create avro codec: %w", err)
+	}
+
+	return &avroStaticSchemaSerializer{
+		codec: codec,
+	}, nil
+}
+
+func (s *avroStaticSchemaSerializer) Serialize(record map[string]any) ([]byte, error) {
+	binary, err := s.codec.BinaryFromNative(nil, record)
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize avro record: %w", err)
+	}
+
+	return binary, nil
+}
@@ -16,11 +16,13 @@ import (
 )
 
 var (
+	_ encoding.LogsMarshalerExtension   = (*avroLogExtension)(nil)
 	_ encoding.LogsUnmarshalerExtension = (*avroLogExtension)(nil)
 )
 
 type avroLogExtension struct {
 	deserializer avroDeserializer
+	serializer   avroSerializer
 }
 
 func newExtension(config *Config) (*avroLogExtension, error) {
@@ -29,7 +31,39 @@ func newExtension(config *Config) (*avroLogExtension, error) {
 		return nil, err
 	}
 
-	return &avroLogExtension{deserializer: deserializer}, nil
+	serializer, err := newAVROStaticSchemaSerializer(config.Schema)
+	if err != nil {
+		return nil, err
+	}
+
+	return &avroLogExtension{deserializer: deserializer, serializer: serializer}, nil
+}
+
+// MarshalLogs writes the bodies of the log records one after the other, each body
+// being a map matching the schema.
+func (e *avroLogExtension) MarshalLogs(ld plog.Logs) ([]byte, error) {
+	var buf []byte
+
+	rls := ld.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		sls := rls.At(i).ScopeLogs()
+		for j := 0; j < sls.Len(); j++ {
+			logs := sls.At(j).LogRecords()
+			for k := 0; k < logs.Len(); k++ {
+				body := logs.At(k).Body()
+				if body.Type() != pcommon.ValueTypeMap {
+					return nil, fmt.Errorf("failed to serialize avro log: expected 'Map' body, found %q", body.Type())
+				}
+				record, err := e.serializer.Serialize(body.Map().AsRaw())
+				if err != nil {
+					return nil, fmt.Errorf("failed to serialize avro log: %w", err)
+				}
+				buf = append(buf, record...)
+			}
+		}
+	}
+
+	return buf, nil
 }
 
 func (e *avroLogExtension) UnmarshalLogs(buf []byte) (plog.Logs, error) {
@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/plog"
 )
 
 func TestExtension_Start_Shutdown(t *testing.T) {
@@ -51,3 +52,45 @@ func TestInvalidUnmarshal(t *testing.T) {
 	_, err = e.UnmarshalLogs([]byte("NOT A AVRO"))
 	assert.Error(t, err)
 }
+
+func TestMarshal(t *testing.T) {
+	t.Parallel()
+
+	schema := `{
+		"type" : "record",
+		"namespace" : "com.example",
+		"name" : "LogMsg",
+		"fields" : [
+			{ "name" : "message" , "type" : "string" },
+			{ "name" : "count" , "type" : "int" },
+			{ "name": "properties", "type": { "type": "array", "items": "string" } }
+		]
+	}`
+	e, err := newExtension(&Config{Schema: schema})
+	require.NoError(t, err)
+
+	ld := plog.NewLogs()
+	logs := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+	require.NoError(t, logs.AppendEmpty().Body().SetEmptyMap().FromRaw(map[string]any{
+		"message":    "log message",
+		"count":      5,
+		"properties": []any{"prop1", "prop2"},
+	}))
+
+	buf, err := e.MarshalLogs(ld)
+	require.NoError(t, err)
+
+	unmarshaled, err := e.UnmarshalLogs(buf)
+	require.NoError(t, err)
+	assert.Equal(t, `{"count":5,"message":"log message","properties":["prop1","prop2"]}`,
+		unmarshaled.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Body().AsString())
+
+	// the body must match the schema
+	logs.At(0).Body().Map().Remove("message")
+	_, err = e.MarshalLogs(ld)
+	assert.ErrorContains(t, err, "failed to serialize avro log")
+
+	logs.At(0).Body().SetStr("log message")
+	_, err = e.MarshalLogs(ld)
+	assert.EqualError(t, err, `failed to serialize avro log: expected 'Map' body, found "Str"`)
+}
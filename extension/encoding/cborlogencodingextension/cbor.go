@@ -0,0 +1,51 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cborlogencodingextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/encoding/cborlogencodingextension"
+
+import (
+	"math/big"
+	"reflect"
+	"time"
+
+	"github.com/fxamacker/cbor/v2"
+)
+
+var (
+	// decMode decodes the CBOR maps as map[string]any, as expected by pcommon.Map.FromRaw.
+	decMode, _ = cbor.DecOptions{
+		DefaultMapType: reflect.TypeOf(map[string]any(nil)),
+	}.DecMode()
+
+	// encMode sorts the keys of the maps so that the same body is always encoded
+	// the same way.
+	encMode, _ = cbor.CoreDetEncOptions().EncMode()
+)
+
+// transformValue replaces the values decoded from CBOR which are not supported by
+// pcommon.Value.FromRaw.
+func transformValue(value any) any {
+	switch v := value.(type) {
+	case time.Time:
+		return v.UnixNano()
+	case big.Int:
+		return v.String()
+	case *big.Int:
+		return v.String()
+	case cbor.Tag:
+		// unknown tags are replaced by their content
+		return transformValue(v.Content)
+	case map[string]any:
+		for k, e := range v {
+			v[k] = transformValue(e)
+		}
+		return v
+	case []any:
+		for i, e := range v {
+			v[i] = transformValue(e)
+		}
+		return v
+	default:
+		return value
+	}
+}
@@ -0,0 +1,7 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cborlogencodingextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/encoding/cborlogencodingextension"
+
+// Config has no options, each log record body is encoded as a CBOR data item.
+type Config struct{}
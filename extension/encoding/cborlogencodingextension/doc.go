@@ -0,0 +1,5 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:generate mdatagen metadata.yaml
+package cborlogencodingextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/encoding/cborlogencodingextension"
@@ -0,0 +1,84 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cborlogencodingextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/encoding/cborlogencodingextension"
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/encoding"
+)
+
+var (
+	_ encoding.LogsMarshalerExtension   = (*cborLogExtension)(nil)
+	_ encoding.LogsUnmarshalerExtension = (*cborLogExtension)(nil)
+)
+
+type cborLogExtension struct{}
+
+// MarshalLogs writes the body of each log record as a CBOR data item, the result being
+// a CBOR sequence as defined in RFC 8742.
+func (e *cborLogExtension) MarshalLogs(ld plog.Logs) ([]byte, error) {
+	var buf bytes.Buffer
+	encoder := encMode.NewEncoder(&buf)
+
+	rls := ld.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		sls := rls.At(i).ScopeLogs()
+		for j := 0; j < sls.Len(); j++ {
+			logs := sls.At(j).LogRecords()
+			for k := 0; k < logs.Len(); k++ {
+				if err := encoder.Encode(logs.At(k).Body().AsRaw()); err != nil {
+					return nil, fmt.Errorf("failed to encode cbor log: %w", err)
+				}
+			}
+		}
+	}
+
+	return buf.Bytes(), nil
+}
+
+// UnmarshalLogs creates a log record for each CBOR data item of the buffer, with the
+// data item as body.
+func (e *cborLogExtension) UnmarshalLogs(buf []byte) (plog.Logs, error) {
+	p := plog.NewLogs()
+	logRecords := p.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+	now := pcommon.NewTimestampFromTime(time.Now())
+
+	decoder := decMode.NewDecoder(bytes.NewReader(buf))
+	for {
+		var value any
+		err := decoder.Decode(&value)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return p, fmt.Errorf("failed to decode cbor log: %w", err)
+		}
+
+		logRecord := logRecords.AppendEmpty()
+		logRecord.SetObservedTimestamp(now)
+		if err = logRecord.Body().FromRaw(transformValue(value)); err != nil {
+			return p, err
+		}
+	}
+
+	return p, nil
+}
+
+func (e *cborLogExtension) Start(_ context.Context, _ component.Host) error {
+	return nil
+}
+
+func (e *cborLogExtension) Shutdown(_ context.Context) error {
+	return nil
+}
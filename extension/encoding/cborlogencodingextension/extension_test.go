@@ -0,0 +1,90 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cborlogencodingextension
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/plog"
+)
+
+func TestExtension_Start_Shutdown(t *testing.T) {
+	e := &cborLogExtension{}
+
+	err := e.Start(context.Background(), componenttest.NewNopHost())
+	require.NoError(t, err)
+
+	err = e.Shutdown(context.Background())
+	require.NoError(t, err)
+}
+
+func TestMarshalUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	e := &cborLogExtension{}
+
+	ld := plog.NewLogs()
+	logs := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+	require.NoError(t, logs.AppendEmpty().Body().SetEmptyMap().FromRaw(map[string]any{
+		"message":    "log message",
+		"count":      5,
+		"ratio":      0.5,
+		"properties": []any{"prop1", true},
+		"nested":     map[string]any{"field1": []byte("raw")},
+	}))
+	logs.AppendEmpty().Body().SetStr("second log")
+
+	buf, err := e.MarshalLogs(ld)
+	require.NoError(t, err)
+
+	unmarshaled, err := e.UnmarshalLogs(buf)
+	require.NoError(t, err)
+	records := unmarshaled.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+	require.Equal(t, 2, records.Len())
+	assert.Equal(t, logs.At(0).Body().AsRaw(), records.At(0).Body().AsRaw())
+	assert.Equal(t, "second log", records.At(1).Body().Str())
+	assert.NotZero(t, records.At(0).ObservedTimestamp())
+}
+
+func TestUnmarshalTags(t *testing.T) {
+	t.Parallel()
+
+	bigInt, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+	buf, err := cbor.Marshal(map[string]any{
+		"time":   cbor.Tag{Number: 1, Content: 1697187201},
+		"bigint": bigInt,
+		"custom": cbor.Tag{Number: 1000, Content: []any{"value"}},
+	})
+	require.NoError(t, err)
+
+	logs, err := (&cborLogExtension{}).UnmarshalLogs(buf)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{
+		"time":   time.Unix(1697187201, 0).UnixNano(),
+		"bigint": "123456789012345678901234567890",
+		"custom": []any{"value"},
+	}, logs.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Body().AsRaw())
+}
+
+func TestInvalidUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	e := &cborLogExtension{}
+
+	_, err := e.UnmarshalLogs([]byte{0xbf, 0x61})
+	assert.ErrorContains(t, err, "failed to decode cbor log")
+
+	// the keys of the maps must be strings
+	buf, err := cbor.Marshal(map[int]string{1: "value"})
+	require.NoError(t, err)
+	_, err = e.UnmarshalLogs(buf)
+	assert.ErrorContains(t, err, "failed to decode cbor log")
+}
@@ -0,0 +1,33 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package protobuflogencodingextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/encoding/protobuflogencodingextension"
+
+import "errors"
+
+var (
+	errNoDescriptorFile = errors.New("no descriptor file provided")
+	errNoMessage        = errors.New("no message provided")
+)
+
+type Config struct {
+	// DescriptorFile is the path of the FileDescriptorSet describing the message, as written
+	// by `protoc --include_imports --descriptor_set_out`.
+	DescriptorFile string `mapstructure:"descriptor_file"`
+	// Message is the full name of the message of the log record bodies, e.g. `com.example.LogMsg`.
+	Message string `mapstructure:"message"`
+	// Delimited prefixes each message with its size as a varint, so that the log records
+	// of a buffer are encoded one after the other.
+	Delimited bool `mapstructure:"delimited"`
+}
+
+func (c *Config) Validate() error {
+	if c.DescriptorFile == "" {
+		return errNoDescriptorFile
+	}
+	if c.Message == "" {
+		return errNoMessage
+	}
+
+	return nil
+}
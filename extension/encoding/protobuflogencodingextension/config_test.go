@@ -0,0 +1,24 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package protobuflogencodingextension
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigValidate(t *testing.T) {
+	cfg := &Config{}
+	err := cfg.Validate()
+	assert.ErrorIs(t, err, errNoDescriptorFile)
+
+	cfg.DescriptorFile = "testdata/log.desc"
+	err = cfg.Validate()
+	assert.ErrorIs(t, err, errNoMessage)
+
+	cfg.Message = "com.example.LogMsg"
+	err = cfg.Validate()
+	assert.NoError(t, err)
+}
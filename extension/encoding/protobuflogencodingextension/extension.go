@@ -0,0 +1,133 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package protobuflogencodingextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/encoding/protobuflogencodingextension"
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"google.golang.org/protobuf/encoding/protodelim"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/encoding"
+)
+
+var (
+	_ encoding.LogsMarshalerExtension   = (*protobufLogExtension)(nil)
+	_ encoding.LogsUnmarshalerExtension = (*protobufLogExtension)(nil)
+)
+
+var errMultipleLogRecords = errors.New("the log records can only be marshaled one by one when the messages are not delimited")
+
+type protobufLogExtension struct {
+	descriptor protoreflect.MessageDescriptor
+	delimited  bool
+}
+
+func newExtension(config *Config) (*protobufLogExtension, error) {
+	descriptor, err := loadMessageDescriptor(config.DescriptorFile, config.Message)
+	if err != nil {
+		return nil, err
+	}
+
+	return &protobufLogExtension{descriptor: descriptor, delimited: config.Delimited}, nil
+}
+
+func (e *protobufLogExtension) MarshalLogs(ld plog.Logs) ([]byte, error) {
+	if !e.delimited && ld.LogRecordCount() > 1 {
+		return nil, errMultipleLogRecords
+	}
+
+	var buf bytes.Buffer
+	rls := ld.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		sls := rls.At(i).ScopeLogs()
+		for j := 0; j < sls.Len(); j++ {
+			logs := sls.At(j).LogRecords()
+			for k := 0; k < logs.Len(); k++ {
+				body := logs.At(k).Body()
+				if body.Type() != pcommon.ValueTypeMap {
+					return nil, fmt.Errorf("failed to serialize protobuf log: expected 'Map' body, found %q", body.Type())
+				}
+				msg := dynamicpb.NewMessage(e.descriptor)
+				if err := mapToMessage(body.Map().AsRaw(), msg); err != nil {
+					return nil, fmt.Errorf("failed to serialize protobuf log: %w", err)
+				}
+				if err := e.write(&buf, msg); err != nil {
+					return nil, fmt.Errorf("failed to serialize protobuf log: %w", err)
+				}
+			}
+		}
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (e *protobufLogExtension) write(buf *bytes.Buffer, msg proto.Message) error {
+	if e.delimited {
+		_, err := protodelim.MarshalTo(buf, msg)
+		return err
+	}
+	b, err := proto.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	buf.Write(b)
+	return nil
+}
+
+func (e *protobufLogExtension) UnmarshalLogs(buf []byte) (plog.Logs, error) {
+	p := plog.NewLogs()
+	logRecords := p.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+	now := pcommon.NewTimestampFromTime(time.Now())
+
+	appendLog := func(msg protoreflect.Message) error {
+		logRecord := logRecords.AppendEmpty()
+		logRecord.SetObservedTimestamp(now)
+		// Set the fields of the message as the body of the log record
+		return logRecord.Body().SetEmptyMap().FromRaw(messageToMap(msg))
+	}
+
+	if !e.delimited {
+		msg := dynamicpb.NewMessage(e.descriptor)
+		if err := proto.Unmarshal(buf, msg); err != nil {
+			return p, fmt.Errorf("failed to deserialize protobuf log: %w", err)
+		}
+		return p, appendLog(msg)
+	}
+
+	reader := bytes.NewReader(buf)
+	for {
+		msg := dynamicpb.NewMessage(e.descriptor)
+		err := protodelim.UnmarshalFrom(reader, msg)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return p, fmt.Errorf("failed to deserialize protobuf log: %w", err)
+		}
+		if err = appendLog(msg); err != nil {
+			return p, err
+		}
+	}
+
+	return p, nil
+}
+
+func (e *protobufLogExtension) Start(_ context.Context, _ component.Host) error {
+	return nil
+}
+
+func (e *protobufLogExtension) Shutdown(_ context.Context) error {
+	return nil
+}
@@ -0,0 +1,110 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package protobuflogencodingextension
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/plog"
+)
+
+func TestExtension_Start_Shutdown(t *testing.T) {
+	e := &protobufLogExtension{}
+
+	err := e.Start(context.Background(), componenttest.NewNopHost())
+	require.NoError(t, err)
+
+	err = e.Shutdown(context.Background())
+	require.NoError(t, err)
+}
+
+func TestNewExtensionInvalidMessage(t *testing.T) {
+	_, err := newExtension(&Config{DescriptorFile: "testdata/log.desc", Message: "com.example.Other"})
+	assert.ErrorContains(t, err, `failed to find message "com.example.Other"`)
+}
+
+func createTestLogs(t *testing.T, bodies ...map[string]any) plog.Logs {
+	t.Helper()
+
+	ld := plog.NewLogs()
+	logs := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+	for _, body := range bodies {
+		require.NoError(t, logs.AppendEmpty().Body().SetEmptyMap().FromRaw(body))
+	}
+	return ld
+}
+
+func TestMarshalUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	e, err := newExtension(&Config{DescriptorFile: "testdata/log.desc", Message: "com.example.LogMsg"})
+	require.NoError(t, err)
+
+	buf, err := e.MarshalLogs(createTestLogs(t, map[string]any{
+		"message":       "log message",
+		"level":         "INFO",
+		"properties":    []any{"prop1", "prop2"},
+		"nested_record": map[string]any{"field1": 12},
+	}))
+	require.NoError(t, err)
+
+	logs, err := e.UnmarshalLogs(buf)
+	require.NoError(t, err)
+	logRecord := logs.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	assert.Equal(t, `{"level":"INFO","message":"log message","nested_record":{"field1":12},"properties":["prop1","prop2"]}`, logRecord.Body().AsString())
+	assert.NotZero(t, logRecord.ObservedTimestamp())
+
+	_, err = e.MarshalLogs(createTestLogs(t, map[string]any{"message": "first"}, map[string]any{"message": "second"}))
+	assert.ErrorIs(t, err, errMultipleLogRecords)
+}
+
+func TestMarshalUnmarshalDelimited(t *testing.T) {
+	t.Parallel()
+
+	e, err := newExtension(&Config{DescriptorFile: "testdata/log.desc", Message: "com.example.LogMsg", Delimited: true})
+	require.NoError(t, err)
+
+	buf, err := e.MarshalLogs(createTestLogs(t, map[string]any{"message": "first"}, map[string]any{"message": "second", "count": 2}))
+	require.NoError(t, err)
+
+	logs, err := e.UnmarshalLogs(buf)
+	require.NoError(t, err)
+	logRecords := logs.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+	require.Equal(t, 2, logRecords.Len())
+	assert.Equal(t, `{"message":"first"}`, logRecords.At(0).Body().AsString())
+	assert.Equal(t, `{"count":2,"message":"second"}`, logRecords.At(1).Body().AsString())
+
+	// the last message is truncated
+	_, err = e.UnmarshalLogs(buf[:len(buf)-1])
+	assert.ErrorContains(t, err, "failed to deserialize protobuf log")
+}
+
+func TestInvalidMarshal(t *testing.T) {
+	t.Parallel()
+
+	e, err := newExtension(&Config{DescriptorFile: "testdata/log.desc", Message: "com.example.LogMsg"})
+	require.NoError(t, err)
+
+	_, err = e.MarshalLogs(createTestLogs(t, map[string]any{"other": "value"}))
+	assert.EqualError(t, err, `failed to serialize protobuf log: message "com.example.LogMsg" has no field "other"`)
+
+	ld := plog.NewLogs()
+	ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Body().SetStr("log message")
+	_, err = e.MarshalLogs(ld)
+	assert.EqualError(t, err, `failed to serialize protobuf log: expected 'Map' body, found "Str"`)
+}
+
+func TestInvalidUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	e, err := newExtension(&Config{DescriptorFile: "testdata/log.desc", Message: "com.example.LogMsg"})
+	require.NoError(t, err)
+
+	_, err = e.UnmarshalLogs([]byte("NOT A PROTOBUF"))
+	assert.ErrorContains(t, err, "failed to deserialize protobuf log")
+}
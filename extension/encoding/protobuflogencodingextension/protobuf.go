@@ -0,0 +1,269 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package protobuflogencodingextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/encoding/protobuflogencodingextension"
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// loadMessageDescriptor returns the descriptor of the message from the FileDescriptorSet
+// written in the file.
+func loadMessageDescriptor(path string, message string) (protoreflect.MessageDescriptor, error) {
+	content, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read descriptor file: %w", err)
+	}
+
+	set := &descriptorpb.FileDescriptorSet{}
+	if err = proto.Unmarshal(content, set); err != nil {
+		return nil, fmt.Errorf("failed to parse descriptor file: %w", err)
+	}
+	files, err := protodesc.NewFiles(set)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse descriptor file: %w", err)
+	}
+
+	desc, err := files.FindDescriptorByName(protoreflect.FullName(message))
+	if err != nil {
+		return nil, fmt.Errorf("failed to find message %q: %w", message, err)
+	}
+	md, ok := desc.(protoreflect.MessageDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("%q is not a message", message)
+	}
+
+	return md, nil
+}
+
+// messageToMap returns the populated fields of the message by their names, the enums
+// being replaced by the names of their values.
+func messageToMap(m protoreflect.Message) map[string]any {
+	fields := map[string]any{}
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.IsList():
+			list := v.List()
+			values := make([]any, list.Len())
+			for i := 0; i < list.Len(); i++ {
+				values[i] = singularToRaw(fd, list.Get(i))
+			}
+			fields[string(fd.Name())] = values
+		case fd.IsMap():
+			values := map[string]any{}
+			v.Map().Range(func(k protoreflect.MapKey, v protoreflect.Value) bool {
+				values[k.String()] = singularToRaw(fd.MapValue(), v)
+				return true
+			})
+			fields[string(fd.Name())] = values
+		default:
+			fields[string(fd.Name())] = singularToRaw(fd, v)
+		}
+		return true
+	})
+	return fields
+}
+
+func singularToRaw(fd protoreflect.FieldDescriptor, v protoreflect.Value) any {
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return messageToMap(v.Message())
+	case protoreflect.EnumKind:
+		if ev := fd.Enum().Values().ByNumber(v.Enum()); ev != nil {
+			return string(ev.Name())
+		}
+		return int64(v.Enum())
+	case protoreflect.BoolKind:
+		return v.Bool()
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return v.Int()
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return int64(v.Uint())
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		return v.Float()
+	case protoreflect.BytesKind:
+		return v.Bytes()
+	default:
+		return v.String()
+	}
+}
+
+// mapToMessage sets the fields of the message from the map, the fields being
+// referenced by their names or their JSON names.
+func mapToMessage(fields map[string]any, m protoreflect.Message) error {
+	md := m.Descriptor()
+	for name, raw := range fields {
+		fd := md.Fields().ByName(protoreflect.Name(name))
+		if fd == nil {
+			fd = md.Fields().ByJSONName(name)
+		}
+		if fd == nil {
+			return fmt.Errorf("message %q has no field %q", md.FullName(), name)
+		}
+
+		switch {
+		case fd.IsList():
+			values, ok := raw.([]any)
+			if !ok {
+				return fmt.Errorf("field %q: expected a list, found %T", name, raw)
+			}
+			list := m.Mutable(fd).List()
+			for _, value := range values {
+				if fd.Kind() == protoreflect.MessageKind || fd.Kind() == protoreflect.GroupKind {
+					element := list.NewElement()
+					if err := rawToMessage(name, value, element.Message()); err != nil {
+						return err
+					}
+					list.Append(element)
+					continue
+				}
+				v, err := rawToSingular(fd, value)
+				if err != nil {
+					return fmt.Errorf("field %q: %w", name, err)
+				}
+				list.Append(v)
+			}
+		case fd.IsMap():
+			values, ok := raw.(map[string]any)
+			if !ok {
+				return fmt.Errorf("field %q: expected a map, found %T", name, raw)
+			}
+			mapField := m.Mutable(fd).Map()
+			for key, value := range values {
+				k, err := rawToSingular(fd.MapKey(), key)
+				if err != nil {
+					return fmt.Errorf("field %q: %w", name, err)
+				}
+				if fd.MapValue().Kind() == protoreflect.MessageKind {
+					element := mapField.NewValue()
+					if err = rawToMessage(name, value, element.Message()); err != nil {
+						return err
+					}
+					mapField.Set(k.MapKey(), element)
+					continue
+				}
+				v, err := rawToSingular(fd.MapValue(), value)
+				if err != nil {
+					return fmt.Errorf("field %q: %w", name, err)
+				}
+				mapField.Set(k.MapKey(), v)
+			}
+		case fd.Kind() == protoreflect.MessageKind || fd.Kind() == protoreflect.GroupKind:
+			if err := rawToMessage(name, raw, m.Mutable(fd).Message()); err != nil {
+				return err
+			}
+		default:
+			v, err := rawToSingular(fd, raw)
+			if err != nil {
+				return fmt.Errorf("field %q: %w", name, err)
+			}
+			m.Set(fd, v)
+		}
+	}
+	return nil
+}
+
+func rawToMessage(name string, raw any, m protoreflect.Message) error {
+	fields, ok := raw.(map[string]any)
+	if !ok {
+		return fmt.Errorf("field %q: expected a map, found %T", name, raw)
+	}
+	return mapToMessage(fields, m)
+}
+
+// rawToSingular converts a value of a pcommon.Value, or a map key, to the kind of the field.
+func rawToSingular(fd protoreflect.FieldDescriptor, raw any) (protoreflect.Value, error) {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		switch v := raw.(type) {
+		case bool:
+			return protoreflect.ValueOfBool(v), nil
+		case string:
+			b, err := strconv.ParseBool(v)
+			if err != nil {
+				return protoreflect.Value{}, err
+			}
+			return protoreflect.ValueOfBool(b), nil
+		}
+	case protoreflect.EnumKind:
+		switch v := raw.(type) {
+		case string:
+			ev := fd.Enum().Values().ByName(protoreflect.Name(v))
+			if ev == nil {
+				return protoreflect.Value{}, fmt.Errorf("enum %q has no value %q", fd.Enum().FullName(), v)
+			}
+			return protoreflect.ValueOfEnum(ev.Number()), nil
+		case int64:
+			return protoreflect.ValueOfEnum(protoreflect.EnumNumber(v)), nil
+		}
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		if i, ok := toInt64(raw); ok {
+			return protoreflect.ValueOfInt32(int32(i)), nil
+		}
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		if i, ok := toInt64(raw); ok {
+			return protoreflect.ValueOfInt64(i), nil
+		}
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		if i, ok := toInt64(raw); ok {
+			return protoreflect.ValueOfUint32(uint32(i)), nil
+		}
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		if i, ok := toInt64(raw); ok {
+			return protoreflect.ValueOfUint64(uint64(i)), nil
+		}
+	case protoreflect.FloatKind:
+		if f, ok := toFloat64(raw); ok {
+			return protoreflect.ValueOfFloat32(float32(f)), nil
+		}
+	case protoreflect.DoubleKind:
+		if f, ok := toFloat64(raw); ok {
+			return protoreflect.ValueOfFloat64(f), nil
+		}
+	case protoreflect.StringKind:
+		if s, ok := raw.(string); ok {
+			return protoreflect.ValueOfString(s), nil
+		}
+	case protoreflect.BytesKind:
+		switch v := raw.(type) {
+		case []byte:
+			return protoreflect.ValueOfBytes(v), nil
+		case string:
+			return protoreflect.ValueOfBytes([]byte(v)), nil
+		}
+	}
+	return protoreflect.Value{}, fmt.Errorf("cannot convert %T to %s", raw, fd.Kind())
+}
+
+// toInt64 converts the integers, and the map keys, to int64.
+func toInt64(raw any) (int64, bool) {
+	switch v := raw.(type) {
+	case int64:
+		return v, true
+	case float64:
+		return int64(v), v == float64(int64(v))
+	case string:
+		i, err := strconv.ParseInt(v, 10, 64)
+		return i, err == nil
+	}
+	return 0, false
+}
+
+func toFloat64(raw any) (float64, bool) {
+	switch v := raw.(type) {
+	case float64:
+		return v, true
+	case int64:
+		return float64(v), true
+	}
+	return 0, false
+}
@@ -0,0 +1,79 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package protobuflogencodingextension
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+func TestLoadMessageDescriptor(t *testing.T) {
+	md, err := loadMessageDescriptor("testdata/log.desc", "com.example.LogMsg")
+	require.NoError(t, err)
+	assert.Equal(t, "com.example.LogMsg", string(md.FullName()))
+
+	_, err = loadMessageDescriptor("testdata/log.desc", "com.example.Other")
+	assert.ErrorContains(t, err, `failed to find message "com.example.Other"`)
+
+	_, err = loadMessageDescriptor("testdata/log.desc", "com.example.LogMsg.Level")
+	assert.EqualError(t, err, `"com.example.LogMsg.Level" is not a message`)
+
+	_, err = loadMessageDescriptor("testdata/log.proto", "com.example.LogMsg")
+	assert.ErrorContains(t, err, "failed to parse descriptor file")
+
+	_, err = loadMessageDescriptor("testdata/missing.desc", "com.example.LogMsg")
+	assert.ErrorContains(t, err, "failed to read descriptor file")
+}
+
+func TestMapToMessage(t *testing.T) {
+	md, err := loadMessageDescriptor("testdata/log.desc", "com.example.LogMsg")
+	require.NoError(t, err)
+
+	fields := map[string]any{
+		"timestamp":    int64(1697187201488),
+		"message":      "log message",
+		"count":        int64(5),
+		"level":        "ERROR",
+		"ratio":        0.5,
+		"sampled":      true,
+		"payload":      []byte("raw"),
+		"properties":   []any{"prop1", "prop2"},
+		"nestedRecord": map[string]any{"field1": int64(12)},
+		"records":      []any{map[string]any{"field2": "val2"}},
+		"labels":       map[string]any{"env": "prod"},
+	}
+	msg := dynamicpb.NewMessage(md)
+	require.NoError(t, mapToMessage(fields, msg))
+
+	// the fields are read with their names
+	fields["nested_record"] = fields["nestedRecord"]
+	delete(fields, "nestedRecord")
+	assert.Equal(t, fields, messageToMap(msg))
+}
+
+func TestMapToMessageInvalid(t *testing.T) {
+	md, err := loadMessageDescriptor("testdata/log.desc", "com.example.LogMsg")
+	require.NoError(t, err)
+
+	tests := []struct {
+		fields  map[string]any
+		wantErr string
+	}{
+		{fields: map[string]any{"other": "value"}, wantErr: `message "com.example.LogMsg" has no field "other"`},
+		{fields: map[string]any{"message": int64(1)}, wantErr: `field "message": cannot convert int64 to string`},
+		{fields: map[string]any{"level": "FATAL"}, wantErr: `field "level": enum "com.example.LogMsg.Level" has no value "FATAL"`},
+		{fields: map[string]any{"properties": "prop1"}, wantErr: `field "properties": expected a list, found string`},
+		{fields: map[string]any{"labels": []any{}}, wantErr: `field "labels": expected a map, found []interface {}`},
+		{fields: map[string]any{"nested_record": "value"}, wantErr: `field "nested_record": expected a map, found string`},
+		{fields: map[string]any{"records": []any{"value"}}, wantErr: `field "records": expected a map, found string`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.wantErr, func(t *testing.T) {
+			assert.EqualError(t, mapToMessage(tt.fields, dynamicpb.NewMessage(md)), tt.wantErr)
+		})
+	}
+}
@@ -10,12 +10,29 @@ import (
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/configgrpc"
 	"go.opentelemetry.io/collector/config/confighttp"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/jaegerremotesampling/internal"
 )
 
 var (
-	errTooManySources     = errors.New("too many sources specified, has to be either 'file' or 'remote'")
-	errNoSources          = errors.New("no sources specified, has to be either 'file' or 'remote'")
+	errTooManySources     = errors.New("too many sources specified, has to be either 'file', 'remote' or 'adaptive'")
+	errNoSources          = errors.New("no sources specified, has to be either 'file', 'remote' or 'adaptive'")
 	errAtLeastOneProtocol = errors.New("no protocols selected to serve the strategies, use 'grpc', 'http', or both")
+	errNoMetricsEndpoint  = errors.New("no endpoint specified for the 'adaptive' source")
+	errTargetSamples      = errors.New("'target_samples_per_second' of the 'adaptive' source cannot be negative")
+	errProbability        = errors.New("the sampling probabilities of the 'adaptive' source have to be in the [0, 1] range")
+)
+
+// Defaults of the adaptive source, applied to its unset settings. The metric and label names are the ones
+// exposed by a prometheus exporter for the calls counter of the spanmetrics connector.
+const (
+	defaultCallsMetric                = "traces_span_metrics_calls_total"
+	defaultServiceLabel               = "service_name"
+	defaultOperationLabel             = "span_name"
+	defaultTargetSamplesPerSecond     = 1
+	defaultInitialSamplingProbability = 0.001
+	defaultMinSamplingProbability     = 0.00001
+	defaultCalculationInterval        = time.Minute
 )
 
 // Config has the configuration for the extension enabling the health check
@@ -35,10 +52,39 @@ type Source struct {
 	// File specifies a local file as the strategies source
 	File string `mapstructure:"file"`
 
+	// Adaptive computes the strategies from the span throughput counted by the spanmetrics connector
+	Adaptive *AdaptiveConfig `mapstructure:"adaptive"`
+
 	// ReloadInterval determines the periodicity to refresh the strategies
 	ReloadInterval time.Duration `mapstructure:"reload_interval"`
 }
 
+// AdaptiveConfig configures the calculation of per-operation probabilistic strategies from the calls
+// counter of the spanmetrics connector, scraped from a Prometheus endpoint every reload interval.
+type AdaptiveConfig struct {
+	// ClientConfig configures the client scraping the Prometheus endpoint exposing the calls counter,
+	// for instance the one of a prometheus exporter in a pipeline of the spanmetrics connector.
+	confighttp.ClientConfig `mapstructure:",squash"`
+
+	// CallsMetric is the name of the calls counter
+	CallsMetric string `mapstructure:"calls_metric"`
+
+	// ServiceLabel is the label of the calls counter holding the service name
+	ServiceLabel string `mapstructure:"service_label"`
+
+	// OperationLabel is the label of the calls counter holding the operation name
+	OperationLabel string `mapstructure:"operation_label"`
+
+	// TargetSamplesPerSecond is the number of traces to sample per second for each operation
+	TargetSamplesPerSecond float64 `mapstructure:"target_samples_per_second"`
+
+	// InitialSamplingProbability is served for the operations without a throughput yet
+	InitialSamplingProbability float64 `mapstructure:"initial_sampling_probability"`
+
+	// MinSamplingProbability is the lowest probability assigned to an operation
+	MinSamplingProbability float64 `mapstructure:"min_sampling_probability"`
+}
+
 var _ component.Config = (*Config)(nil)
 
 // Validate checks if the extension configuration is valid
@@ -47,13 +93,83 @@ func (cfg *Config) Validate() error {
 		return errAtLeastOneProtocol
 	}
 
-	if cfg.Source.File != "" && cfg.Source.Remote != nil {
+	sources := 0
+	if cfg.Source.File != "" {
+		sources++
+	}
+	if cfg.Source.Remote != nil {
+		sources++
+	}
+	if cfg.Source.Adaptive != nil {
+		sources++
+	}
+
+	if sources > 1 {
 		return errTooManySources
 	}
 
-	if cfg.Source.File == "" && cfg.Source.Remote == nil {
+	if sources == 0 {
 		return errNoSources
 	}
 
+	if cfg.Source.Adaptive != nil {
+		return cfg.Source.Adaptive.Validate()
+	}
+
 	return nil
 }
+
+// Validate checks if the adaptive source configuration is valid
+func (cfg *AdaptiveConfig) Validate() error {
+	if cfg.Endpoint == "" {
+		return errNoMetricsEndpoint
+	}
+
+	if cfg.TargetSamplesPerSecond < 0 {
+		return errTargetSamples
+	}
+
+	for _, probability := range []float64{cfg.InitialSamplingProbability, cfg.MinSamplingProbability} {
+		if probability < 0 || probability > 1 {
+			return errProbability
+		}
+	}
+
+	return nil
+}
+
+// settings returns the settings of the adaptive strategy store, with the defaults applied to the unset fields
+func (cfg *AdaptiveConfig) settings(reloadInterval time.Duration) internal.AdaptiveSettings {
+	settings := internal.AdaptiveSettings{
+		MetricsEndpoint:            cfg.Endpoint,
+		CallsMetric:                cfg.CallsMetric,
+		ServiceLabel:               cfg.ServiceLabel,
+		OperationLabel:             cfg.OperationLabel,
+		TargetSamplesPerSecond:     cfg.TargetSamplesPerSecond,
+		InitialSamplingProbability: cfg.InitialSamplingProbability,
+		MinSamplingProbability:     cfg.MinSamplingProbability,
+		CalculationInterval:        reloadInterval,
+	}
+	if settings.CallsMetric == "" {
+		settings.CallsMetric = defaultCallsMetric
+	}
+	if settings.ServiceLabel == "" {
+		settings.ServiceLabel = defaultServiceLabel
+	}
+	if settings.OperationLabel == "" {
+		settings.OperationLabel = defaultOperationLabel
+	}
+	if settings.TargetSamplesPerSecond == 0 {
+		settings.TargetSamplesPerSecond = defaultTargetSamplesPerSecond
+	}
+	if settings.InitialSamplingProbability == 0 {
+		settings.InitialSamplingProbability = defaultInitialSamplingProbability
+	}
+	if settings.MinSamplingProbability == 0 {
+		settings.MinSamplingProbability = defaultMinSamplingProbability
+	}
+	if settings.CalculationInterval <= 0 {
+		settings.CalculationInterval = defaultCalculationInterval
+	}
+	return settings
+}
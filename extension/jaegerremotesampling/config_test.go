@@ -55,6 +55,26 @@ func TestLoadConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "2"),
+			expected: &Config{
+				HTTPServerConfig: &confighttp.ServerConfig{Endpoint: "0.0.0.0:5778"},
+				GRPCServerConfig: &configgrpc.ServerConfig{NetAddr: confignet.AddrConfig{
+					Endpoint:  "0.0.0.0:14250",
+					Transport: confignet.TransportTypeTCP,
+				}},
+				Source: Source{
+					ReloadInterval: 30 * time.Second,
+					Adaptive: &AdaptiveConfig{
+						ClientConfig: confighttp.ClientConfig{
+							Endpoint: "http://localhost:8889/metrics",
+						},
+						TargetSamplesPerSecond:     2,
+						InitialSamplingProbability: 0.01,
+					},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.id.String(), func(t *testing.T) {
@@ -101,6 +121,53 @@ func TestValidate(t *testing.T) {
 			},
 			expected: errTooManySources,
 		},
+		{
+			desc: "too many sources with adaptive",
+			cfg: Config{
+				GRPCServerConfig: &configgrpc.ServerConfig{},
+				Source: Source{
+					File:     "/tmp/some-file",
+					Adaptive: &AdaptiveConfig{},
+				},
+			},
+			expected: errTooManySources,
+		},
+		{
+			desc: "adaptive without endpoint",
+			cfg: Config{
+				GRPCServerConfig: &configgrpc.ServerConfig{},
+				Source: Source{
+					Adaptive: &AdaptiveConfig{},
+				},
+			},
+			expected: errNoMetricsEndpoint,
+		},
+		{
+			desc: "adaptive with negative target",
+			cfg: Config{
+				GRPCServerConfig: &configgrpc.ServerConfig{},
+				Source: Source{
+					Adaptive: &AdaptiveConfig{
+						ClientConfig:           confighttp.ClientConfig{Endpoint: "http://localhost:8889/metrics"},
+						TargetSamplesPerSecond: -1,
+					},
+				},
+			},
+			expected: errTargetSamples,
+		},
+		{
+			desc: "adaptive with invalid probability",
+			cfg: Config{
+				GRPCServerConfig: &configgrpc.ServerConfig{},
+				Source: Source{
+					Adaptive: &AdaptiveConfig{
+						ClientConfig:           confighttp.ClientConfig{Endpoint: "http://localhost:8889/metrics"},
+						MinSamplingProbability: 1.5,
+					},
+				},
+			},
+			expected: errProbability,
+		},
 	}
 	for _, tC := range testCases {
 		t.Run(tC.desc, func(t *testing.T) {
@@ -109,3 +176,21 @@ func TestValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestAdaptiveSettings(t *testing.T) {
+	cfg := &AdaptiveConfig{
+		ClientConfig: confighttp.ClientConfig{Endpoint: "http://localhost:8889/metrics"},
+		ServiceLabel: "service",
+	}
+	settings := cfg.settings(0)
+	assert.Equal(t, "http://localhost:8889/metrics", settings.MetricsEndpoint)
+	assert.Equal(t, defaultCallsMetric, settings.CallsMetric)
+	assert.Equal(t, "service", settings.ServiceLabel)
+	assert.Equal(t, defaultOperationLabel, settings.OperationLabel)
+	assert.Equal(t, float64(defaultTargetSamplesPerSecond), settings.TargetSamplesPerSecond)
+	assert.Equal(t, defaultInitialSamplingProbability, settings.InitialSamplingProbability)
+	assert.Equal(t, defaultMinSamplingProbability, settings.MinSamplingProbability)
+	assert.Equal(t, defaultCalculationInterval, settings.CalculationInterval)
+
+	assert.Equal(t, 30*time.Second, cfg.settings(30*time.Second).CalculationInterval)
+}
@@ -42,6 +42,7 @@ func (jrse *jrsExtension) Start(ctx context.Context, host component.Host) error
 	// source of the sampling config:
 	// - remote (gRPC)
 	// - local file
+	// - adaptive (spanmetrics)
 	// we can then use a simplified logic here to assign the appropriate store
 	if jrse.cfg.Source.File != "" {
 		opts := static.Options{
@@ -73,6 +74,20 @@ func (jrse *jrsExtension) Start(ctx context.Context, host component.Host) error
 		jrse.samplingStore = remoteStore
 	}
 
+	if jrse.cfg.Source.Adaptive != nil {
+		client, err := jrse.cfg.Source.Adaptive.ToClient(ctx, host, jrse.telemetry)
+		if err != nil {
+			return fmt.Errorf("failed to create the adaptive strategy store: %w", err)
+		}
+		adaptiveStore, closer := internal.NewAdaptiveStrategyStore(
+			jrse.cfg.Source.Adaptive.settings(jrse.cfg.Source.ReloadInterval),
+			client,
+			jrse.telemetry.Logger,
+		)
+		jrse.closers = append(jrse.closers, closer.Close)
+		jrse.samplingStore = adaptiveStore
+	}
+
 	if jrse.cfg.HTTPServerConfig != nil {
 		httpServer, err := internal.NewHTTP(jrse.telemetry, *jrse.cfg.HTTPServerConfig, jrse.samplingStore)
 		if err != nil {
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"net"
 	"net/http"
+	"net/http/httptest"
 	"path/filepath"
 	"testing"
 	"time"
@@ -17,6 +18,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/config/configgrpc"
+	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/config/configopaque"
 	"go.opentelemetry.io/collector/config/configtls"
 	"google.golang.org/grpc"
@@ -46,6 +48,31 @@ func TestStartAndShutdownLocalFile(t *testing.T) {
 	assert.NoError(t, e.Shutdown(context.Background()))
 }
 
+func TestStartAndShutdownAdaptive(t *testing.T) {
+	// prepare
+	metrics := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = fmt.Fprintln(w, `traces_span_metrics_calls_total{service_name="foo",span_name="get"} 1`)
+	}))
+	defer metrics.Close()
+	cfg := testConfig()
+	cfg.Source.Adaptive = &AdaptiveConfig{
+		ClientConfig: confighttp.ClientConfig{
+			Endpoint: metrics.URL,
+		},
+	}
+
+	e := newExtension(cfg, componenttest.NewNopTelemetrySettings())
+	require.NotNil(t, e)
+	require.NoError(t, e.Start(context.Background(), componenttest.NewNopHost()))
+
+	// test and verify
+	resp, err := e.samplingStore.GetSamplingStrategy(context.Background(), "foo")
+	require.NoError(t, err)
+	assert.Equal(t, api_v2.SamplingStrategyType_PROBABILISTIC, resp.StrategyType)
+	assert.Equal(t, defaultInitialSamplingProbability, resp.OperationSampling.DefaultSamplingProbability)
+	assert.NoError(t, e.Shutdown(context.Background()))
+}
+
 func TestRemote(t *testing.T) {
 	for _, tc := range []struct {
 		name                          string
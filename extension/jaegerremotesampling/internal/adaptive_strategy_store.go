@@ -0,0 +1,255 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package internal // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/jaegerremotesampling/internal"
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/jaegertracing/jaeger/cmd/collector/app/sampling/strategystore"
+	"github.com/jaegertracing/jaeger/proto-gen/api_v2"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	"go.uber.org/zap"
+)
+
+// maxProbabilityIncrease limits how much the sampling probability of an operation can grow
+// between two calculations, to avoid oscillations when the throughput is bursty.
+const maxProbabilityIncrease = 2
+
+// AdaptiveSettings configures the calculation of the adaptive sampling strategies.
+type AdaptiveSettings struct {
+	// MetricsEndpoint is the URL of the Prometheus endpoint exposing the calls counter.
+	MetricsEndpoint string
+	// CallsMetric is the name of the counter of the spans, per service and operation.
+	CallsMetric string
+	// ServiceLabel and OperationLabel are the labels of the counter holding the service
+	// and operation names.
+	ServiceLabel   string
+	OperationLabel string
+	// TargetSamplesPerSecond is the number of traces to sample per second for each operation.
+	TargetSamplesPerSecond float64
+	// InitialSamplingProbability applies to the operations without a calculated probability yet.
+	InitialSamplingProbability float64
+	// MinSamplingProbability is the lowest probability assigned to an operation.
+	MinSamplingProbability float64
+	// CalculationInterval is the interval between two calculations of the strategies.
+	CalculationInterval time.Duration
+}
+
+// counters holds the value of the calls counter of each operation, per service.
+type counters map[string]map[string]float64
+
+type adaptiveStrategyStore struct {
+	settings AdaptiveSettings
+	client   *http.Client
+	logger   *zap.Logger
+
+	rw sync.RWMutex
+	// probabilities holds the sampling probability of each operation, per service.
+	probabilities map[string]map[string]float64
+
+	// previous and previousTime are the result of the previous scrape.
+	previous     counters
+	previousTime time.Time
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewAdaptiveStrategyStore returns a StrategyStore calculating the probabilistic sampling strategy of each
+// operation from its throughput, as counted by the calls metric of the spanmetrics connector. The throughput
+// measured from the sampled spans is scaled by the probability they were sampled with, and the probability of
+// each operation is adjusted for its estimated throughput to match the target samples per second.
+func NewAdaptiveStrategyStore(
+	settings AdaptiveSettings,
+	client *http.Client,
+	logger *zap.Logger,
+) (strategystore.StrategyStore, io.Closer) {
+	store := &adaptiveStrategyStore{
+		settings:      settings,
+		client:        client,
+		logger:        logger,
+		probabilities: make(map[string]map[string]float64),
+	}
+
+	var ctx context.Context
+	ctx, store.cancel = context.WithCancel(context.Background())
+	store.wg.Add(1)
+	go store.periodicallyCalculate(ctx)
+	return store, store
+}
+
+func (a *adaptiveStrategyStore) GetSamplingStrategy(
+	_ context.Context,
+	serviceName string,
+) (*api_v2.SamplingStrategyResponse, error) {
+	a.rw.RLock()
+	defer a.rw.RUnlock()
+
+	operations := a.probabilities[serviceName]
+	strategies := make([]*api_v2.OperationSamplingStrategy, 0, len(operations))
+	for operation, probability := range operations {
+		strategies = append(strategies, &api_v2.OperationSamplingStrategy{
+			Operation: operation,
+			ProbabilisticSampling: &api_v2.ProbabilisticSamplingStrategy{
+				SamplingRate: probability,
+			},
+		})
+	}
+	sort.Slice(strategies, func(i, j int) bool {
+		return strategies[i].Operation < strategies[j].Operation
+	})
+
+	return &api_v2.SamplingStrategyResponse{
+		StrategyType: api_v2.SamplingStrategyType_PROBABILISTIC,
+		ProbabilisticSampling: &api_v2.ProbabilisticSamplingStrategy{
+			SamplingRate: a.settings.InitialSamplingProbability,
+		},
+		OperationSampling: &api_v2.PerOperationSamplingStrategies{
+			DefaultSamplingProbability: a.settings.InitialSamplingProbability,
+			PerOperationStrategies:     strategies,
+		},
+	}, nil
+}
+
+func (a *adaptiveStrategyStore) Close() error {
+	a.cancel()
+	a.wg.Wait()
+	return nil
+}
+
+func (a *adaptiveStrategyStore) periodicallyCalculate(ctx context.Context) {
+	defer a.wg.Done()
+
+	a.calculate(ctx)
+
+	ticker := time.NewTicker(a.settings.CalculationInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			a.calculate(ctx)
+		}
+	}
+}
+
+func (a *adaptiveStrategyStore) calculate(ctx context.Context) {
+	current, err := a.scrape(ctx)
+	if ctx.Err() != nil {
+		return
+	}
+	if err != nil {
+		a.logger.Warn("failed to scrape the span throughput, keeping the current sampling strategies", zap.Error(err))
+		return
+	}
+	a.update(current, time.Now())
+}
+
+// scrape reads the calls counters from the metrics endpoint, summing the series of the same operation.
+func (a *adaptiveStrategyStore) scrape(ctx context.Context) (counters, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, a.settings.MetricsEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("metrics endpoint returned status %d", resp.StatusCode)
+	}
+
+	var parser expfmt.TextParser
+	families, err := parser.TextToMetricFamilies(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the metrics: %w", err)
+	}
+
+	result := make(counters)
+	family, ok := families[a.settings.CallsMetric]
+	if !ok {
+		return result, nil
+	}
+	for _, m := range family.GetMetric() {
+		var service, operation string
+		for _, label := range m.GetLabel() {
+			switch label.GetName() {
+			case a.settings.ServiceLabel:
+				service = label.GetValue()
+			case a.settings.OperationLabel:
+				operation = label.GetValue()
+			}
+		}
+		if service == "" || operation == "" {
+			continue
+		}
+		if result[service] == nil {
+			result[service] = make(map[string]float64)
+		}
+		result[service][operation] += counterValue(m)
+	}
+	return result, nil
+}
+
+func counterValue(m *dto.Metric) float64 {
+	if m.GetCounter() != nil {
+		return m.GetCounter().GetValue()
+	}
+	return m.GetUntyped().GetValue()
+}
+
+// update recalculates the probabilities from the throughput between the previous and the current counters.
+func (a *adaptiveStrategyStore) update(current counters, now time.Time) {
+	previous, previousTime := a.previous, a.previousTime
+	a.previous, a.previousTime = current, now
+	if previous == nil {
+		return
+	}
+	elapsed := now.Sub(previousTime).Seconds()
+	if elapsed <= 0 {
+		return
+	}
+
+	a.rw.Lock()
+	defer a.rw.Unlock()
+	for service, operations := range current {
+		for operation, value := range operations {
+			delta := value - previous[service][operation]
+			if delta < 0 {
+				// the counter was reset
+				delta = value
+			}
+			if delta == 0 {
+				continue
+			}
+			if a.probabilities[service] == nil {
+				a.probabilities[service] = make(map[string]float64)
+			}
+			probability, ok := a.probabilities[service][operation]
+			if !ok {
+				probability = a.settings.InitialSamplingProbability
+			}
+			a.probabilities[service][operation] = a.nextProbability(probability, delta/elapsed)
+		}
+	}
+}
+
+// nextProbability returns the probability reaching the target samples per second, for an operation whose
+// spans are sampled with the given probability at the given rate.
+func (a *adaptiveStrategyStore) nextProbability(probability, sampledPerSecond float64) float64 {
+	throughput := sampledPerSecond / probability
+	next := a.settings.TargetSamplesPerSecond / throughput
+	next = math.Min(next, probability*maxProbabilityIncrease)
+	return math.Max(a.settings.MinSamplingProbability, math.Min(1, next))
+}
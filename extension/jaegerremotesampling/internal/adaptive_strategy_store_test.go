@@ -0,0 +1,132 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package internal
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jaegertracing/jaeger/proto-gen/api_v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+var testAdaptiveSettings = AdaptiveSettings{
+	CallsMetric:                "traces_span_metrics_calls_total",
+	ServiceLabel:               "service_name",
+	OperationLabel:             "span_name",
+	TargetSamplesPerSecond:     1,
+	InitialSamplingProbability: 0.1,
+	MinSamplingProbability:     0.001,
+	CalculationInterval:        10 * time.Millisecond,
+}
+
+func operationProbabilities(t *testing.T, resp *api_v2.SamplingStrategyResponse) map[string]float64 {
+	require.Equal(t, api_v2.SamplingStrategyType_PROBABILISTIC, resp.StrategyType)
+	result := make(map[string]float64)
+	for _, strategy := range resp.OperationSampling.PerOperationStrategies {
+		result[strategy.Operation] = strategy.ProbabilisticSampling.SamplingRate
+	}
+	return result
+}
+
+func TestAdaptiveStrategyStoreUpdate(t *testing.T) {
+	store := &adaptiveStrategyStore{
+		settings:      testAdaptiveSettings,
+		probabilities: make(map[string]map[string]float64),
+	}
+	now := time.Now()
+
+	store.update(counters{"foo": {"a": 1000, "b": 10, "c": 5, "d": 100}}, now)
+	resp, err := store.GetSamplingStrategy(context.Background(), "foo")
+	require.NoError(t, err)
+	assert.Empty(t, operationProbabilities(t, resp), "the first scrape only sets the baseline")
+	assert.Equal(t, 0.1, resp.OperationSampling.DefaultSamplingProbability)
+
+	store.update(counters{"foo": {"a": 1100, "b": 11, "c": 100005, "d": 10}}, now.Add(10*time.Second))
+	resp, err = store.GetSamplingStrategy(context.Background(), "foo")
+	require.NoError(t, err)
+	assert.InDeltaMapValues(t, map[string]float64{
+		// 10 sampled spans/s at 0.1 estimates 100 spans/s
+		"a": 0.01,
+		// 0.1 sampled spans/s at 0.1 estimates 1 span/s, the increase is limited
+		"b": 0.2,
+		// 100000 spans/s is under the minimum
+		"c": 0.001,
+		// the counter was reset, 1 sampled span/s at 0.1 estimates 10 spans/s
+		"d": 0.1,
+	}, operationProbabilities(t, resp), 1e-9)
+
+	store.update(counters{"foo": {"a": 1110, "b": 12, "c": 100005, "d": 10}}, now.Add(20*time.Second))
+	resp, err = store.GetSamplingStrategy(context.Background(), "foo")
+	require.NoError(t, err)
+	assert.InDeltaMapValues(t, map[string]float64{
+		// 1 sampled span/s at 0.01 estimates 100 spans/s
+		"a": 0.01,
+		"b": 0.4,
+		// no spans, the probabilities are kept
+		"c": 0.001,
+		"d": 0.1,
+	}, operationProbabilities(t, resp), 1e-9)
+
+	resp, err = store.GetSamplingStrategy(context.Background(), "bar")
+	require.NoError(t, err)
+	assert.Empty(t, operationProbabilities(t, resp))
+	assert.Equal(t, 0.1, resp.ProbabilisticSampling.SamplingRate)
+}
+
+func TestAdaptiveStrategyStoreScrape(t *testing.T) {
+	var calls atomic.Int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		value := calls.Add(1) * 100
+		_, _ = fmt.Fprintf(w, `# TYPE traces_span_metrics_calls_total counter
+traces_span_metrics_calls_total{service_name="foo",span_name="get",span_kind="SPAN_KIND_SERVER"} %d
+traces_span_metrics_calls_total{service_name="foo",span_name="get",span_kind="SPAN_KIND_CLIENT"} %d
+traces_span_metrics_calls_total{span_name="unknown"} %d
+# TYPE other_total counter
+other_total{service_name="bar",span_name="get"} %d
+`, value, value, value, value)
+	}))
+	defer server.Close()
+
+	settings := testAdaptiveSettings
+	settings.MetricsEndpoint = server.URL
+	store, closer := NewAdaptiveStrategyStore(settings, server.Client(), zap.NewNop())
+	defer func() {
+		assert.NoError(t, closer.Close())
+	}()
+
+	assert.Eventually(t, func() bool {
+		resp, err := store.GetSamplingStrategy(context.Background(), "foo")
+		require.NoError(t, err)
+		probabilities := operationProbabilities(t, resp)
+		return len(probabilities) == 1 && probabilities["get"] < settings.InitialSamplingProbability
+	}, 5*time.Second, 10*time.Millisecond)
+
+	resp, err := store.GetSamplingStrategy(context.Background(), "bar")
+	require.NoError(t, err)
+	assert.Empty(t, operationProbabilities(t, resp))
+}
+
+func TestAdaptiveStrategyStoreScrapeError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	settings := testAdaptiveSettings
+	settings.MetricsEndpoint = server.URL
+	store := &adaptiveStrategyStore{
+		settings: settings,
+		client:   server.Client(),
+	}
+	_, err := store.scrape(context.Background())
+	assert.EqualError(t, err, "metrics endpoint returned status 500")
+}
@@ -4,6 +4,11 @@
 package pprofextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/pprofextension"
 
 import (
+	"errors"
+	"fmt"
+	"runtime/pprof"
+	"time"
+
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/confignet"
 )
@@ -29,11 +34,60 @@ type Config struct {
 	// Optional file name to save the CPU profile to. The profiling starts when the
 	// Collector starts and is saved to the file when the Collector is terminated.
 	SaveToFile string `mapstructure:"save_to_file"`
+
+	// Optional periodic capture of the profiles of the Collector, written to files
+	// in the pprof format.
+	ContinuousProfiling *ContinuousProfilingConfig `mapstructure:"continuous_profiling"`
+}
+
+// ContinuousProfilingConfig configures the periodic capture of the profiles.
+type ContinuousProfilingConfig struct {
+	// Directory where the profiles are written, it is created if needed.
+	Directory string `mapstructure:"directory"`
+
+	// Interval between two captures, 1m if not set.
+	Interval time.Duration `mapstructure:"interval"`
+
+	// Duration of the CPU profile of each capture, 10s if not set. It must be
+	// shorter than the interval.
+	CPUDuration time.Duration `mapstructure:"cpu_duration"`
+
+	// Profiles to capture, "cpu" or the name of a runtime/pprof profile such as
+	// "heap", "allocs", "goroutine", "block" or "mutex". Defaults to cpu and heap.
+	Profiles []string `mapstructure:"profiles"`
+
+	// Number of files kept for each profile, the oldest ones are removed. 10 if
+	// not set.
+	MaxFiles int `mapstructure:"max_files"`
 }
 
 var _ component.Config = (*Config)(nil)
 
 // Validate checks if the extension configuration is valid
 func (cfg *Config) Validate() error {
+	if cfg.ContinuousProfiling == nil {
+		return nil
+	}
+	cp := cfg.ContinuousProfiling.withDefaults()
+	if cp.Directory == "" {
+		return errors.New("continuous_profiling: directory is required")
+	}
+	if cp.Interval < 0 || cp.CPUDuration < 0 || cp.MaxFiles < 0 {
+		return errors.New("continuous_profiling: interval, cpu_duration and max_files cannot be negative")
+	}
+	for _, profile := range cp.Profiles {
+		if profile == cpuProfile {
+			if cp.CPUDuration >= cp.Interval {
+				return errors.New("continuous_profiling: cpu_duration must be shorter than the interval")
+			}
+			if cfg.SaveToFile != "" {
+				return errors.New("continuous_profiling: the cpu profile cannot be captured with save_to_file")
+			}
+			continue
+		}
+		if pprof.Lookup(profile) == nil {
+			return fmt.Errorf("continuous_profiling: unknown profile %q", profile)
+		}
+	}
 	return nil
 }
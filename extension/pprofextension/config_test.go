@@ -6,6 +6,7 @@ package pprofextension
 import (
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -35,6 +36,17 @@ func TestLoadConfig(t *testing.T) {
 				MutexProfileFraction: 5,
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "continuous"),
+			expected: &Config{
+				TCPAddr: confignet.TCPAddrConfig{Endpoint: defaultEndpoint},
+				ContinuousProfiling: &ContinuousProfilingConfig{
+					Directory: "/var/lib/otelcol/profiles",
+					Interval:  5 * time.Minute,
+					Profiles:  []string{"cpu", "heap", "goroutine"},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.id.String(), func(t *testing.T) {
@@ -51,3 +63,47 @@ func TestLoadConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateContinuousProfiling(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  *Config
+		err  string
+	}{
+		{
+			name: "no_directory",
+			cfg:  &Config{ContinuousProfiling: &ContinuousProfilingConfig{}},
+			err:  "continuous_profiling: directory is required",
+		},
+		{
+			name: "cpu_duration_too_long",
+			cfg: &Config{ContinuousProfiling: &ContinuousProfilingConfig{
+				Directory:   "profiles",
+				Interval:    time.Second,
+				CPUDuration: time.Second,
+			}},
+			err: "continuous_profiling: cpu_duration must be shorter than the interval",
+		},
+		{
+			name: "save_to_file",
+			cfg: &Config{
+				SaveToFile:          "cpu.pprof",
+				ContinuousProfiling: &ContinuousProfilingConfig{Directory: "profiles"},
+			},
+			err: "continuous_profiling: the cpu profile cannot be captured with save_to_file",
+		},
+		{
+			name: "unknown_profile",
+			cfg: &Config{ContinuousProfiling: &ContinuousProfilingConfig{
+				Directory: "profiles",
+				Profiles:  []string{"heap", "memory"},
+			}},
+			err: `continuous_profiling: unknown profile "memory"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.EqualError(t, tt.cfg.Validate(), tt.err)
+		})
+	}
+}
@@ -0,0 +1,160 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pprofextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/pprofextension"
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime/pprof"
+	"sort"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	cpuProfile = "cpu"
+
+	defaultContinuousInterval = time.Minute
+	defaultCPUDuration        = 10 * time.Second
+	defaultMaxFiles           = 10
+
+	// profileTimeFormat is part of the profile file names, it sorts chronologically.
+	profileTimeFormat = "20060102T150405.000Z"
+)
+
+var defaultContinuousProfiles = []string{cpuProfile, "heap"}
+
+func (cfg ContinuousProfilingConfig) withDefaults() ContinuousProfilingConfig {
+	if cfg.Interval == 0 {
+		cfg.Interval = defaultContinuousInterval
+	}
+	if cfg.CPUDuration == 0 {
+		cfg.CPUDuration = defaultCPUDuration
+	}
+	if len(cfg.Profiles) == 0 {
+		cfg.Profiles = defaultContinuousProfiles
+	}
+	if cfg.MaxFiles == 0 {
+		cfg.MaxFiles = defaultMaxFiles
+	}
+	return cfg
+}
+
+// continuousProfiler periodically captures the profiles of the process and writes
+// them to files named <profile>-<time>.pb.gz.
+type continuousProfiler struct {
+	cfg    ContinuousProfilingConfig
+	logger *zap.Logger
+
+	stopCh chan struct{}
+	wg     sync.WaitGroup
+}
+
+func newContinuousProfiler(cfg ContinuousProfilingConfig, logger *zap.Logger) *continuousProfiler {
+	return &continuousProfiler{
+		cfg:    cfg.withDefaults(),
+		logger: logger,
+		stopCh: make(chan struct{}),
+	}
+}
+
+func (c *continuousProfiler) start() error {
+	if err := os.MkdirAll(c.cfg.Directory, 0700); err != nil {
+		return fmt.Errorf("failed to create the continuous profiling directory: %w", err)
+	}
+	c.wg.Add(1)
+	go c.run()
+	return nil
+}
+
+func (c *continuousProfiler) stop() {
+	close(c.stopCh)
+	c.wg.Wait()
+}
+
+func (c *continuousProfiler) run() {
+	defer c.wg.Done()
+	ticker := time.NewTicker(c.cfg.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-c.stopCh:
+			return
+		case <-ticker.C:
+			c.capture(time.Now())
+		}
+	}
+}
+
+// capture writes a file for each configured profile, failures are logged and don't
+// prevent the next captures.
+func (c *continuousProfiler) capture(now time.Time) {
+	for _, profile := range c.cfg.Profiles {
+		var buf bytes.Buffer
+		var err error
+		if profile == cpuProfile {
+			err = c.captureCPU(&buf)
+		} else {
+			err = pprof.Lookup(profile).WriteTo(&buf, 0)
+		}
+		if err == nil {
+			err = c.write(profile, now, buf.Bytes())
+		}
+		if err != nil {
+			c.logger.Warn("Failed to capture profile", zap.String("profile", profile), zap.Error(err))
+		}
+	}
+}
+
+// captureCPU profiles the CPU for the configured duration, or until the profiler is stopped.
+func (c *continuousProfiler) captureCPU(buf *bytes.Buffer) error {
+	// This fails while a CPU profile is requested from the net/http/pprof endpoint.
+	if err := pprof.StartCPUProfile(buf); err != nil {
+		return err
+	}
+	timer := time.NewTimer(c.cfg.CPUDuration)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+	case <-c.stopCh:
+	}
+	pprof.StopCPUProfile()
+	return nil
+}
+
+// write saves the profile through a temporary file, so that only complete profiles are
+// picked up by the tools watching the directory, and removes the oldest files.
+func (c *continuousProfiler) write(profile string, now time.Time, data []byte) error {
+	name := filepath.Join(c.cfg.Directory, fmt.Sprintf("%s-%s.pb.gz", profile, now.UTC().Format(profileTimeFormat)))
+	tmp := name + ".tmp"
+	if err := os.WriteFile(tmp, data, 0600); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, name); err != nil {
+		_ = os.Remove(tmp)
+		return err
+	}
+	return c.prune(profile)
+}
+
+func (c *continuousProfiler) prune(profile string) error {
+	files, err := filepath.Glob(filepath.Join(c.cfg.Directory, profile+"-*.pb.gz"))
+	if err != nil {
+		return err
+	}
+	if len(files) <= c.cfg.MaxFiles {
+		return nil
+	}
+	sort.Strings(files)
+	for _, f := range files[:len(files)-c.cfg.MaxFiles] {
+		if err := os.Remove(f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
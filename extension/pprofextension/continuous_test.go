@@ -0,0 +1,62 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pprofextension
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestContinuousProfilerCapture(t *testing.T) {
+	dir := t.TempDir()
+	c := newContinuousProfiler(ContinuousProfilingConfig{
+		Directory:   dir,
+		CPUDuration: 10 * time.Millisecond,
+		Profiles:    []string{"cpu", "heap", "goroutine"},
+		MaxFiles:    2,
+	}, zap.NewNop())
+
+	now := time.Date(2024, 5, 10, 12, 0, 0, 0, time.UTC)
+	for i := 0; i < 3; i++ {
+		c.capture(now.Add(time.Duration(i) * time.Minute))
+	}
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	var names []string
+	for _, e := range entries {
+		names = append(names, e.Name())
+	}
+	// the oldest capture is removed
+	assert.ElementsMatch(t, []string{
+		"cpu-20240510T120100.000Z.pb.gz",
+		"cpu-20240510T120200.000Z.pb.gz",
+		"goroutine-20240510T120100.000Z.pb.gz",
+		"goroutine-20240510T120200.000Z.pb.gz",
+		"heap-20240510T120100.000Z.pb.gz",
+		"heap-20240510T120200.000Z.pb.gz",
+	}, names)
+
+	data, err := os.ReadFile(filepath.Join(dir, "heap-20240510T120200.000Z.pb.gz"))
+	require.NoError(t, err)
+	// gzip magic number
+	assert.Equal(t, []byte{0x1f, 0x8b}, data[:2])
+}
+
+func TestContinuousProfilerDefaults(t *testing.T) {
+	cfg := ContinuousProfilingConfig{Directory: "profiles"}.withDefaults()
+	assert.Equal(t, ContinuousProfilingConfig{
+		Directory:   "profiles",
+		Interval:    defaultContinuousInterval,
+		CPUDuration: defaultCPUDuration,
+		Profiles:    []string{"cpu", "heap"},
+		MaxFiles:    defaultMaxFiles,
+	}, cfg)
+}
@@ -23,6 +23,7 @@ var running = &atomic.Bool{}
 type pprofExtension struct {
 	config            Config
 	file              *os.File
+	continuous        *continuousProfiler
 	server            http.Server
 	stopCh            chan struct{}
 	telemetrySettings component.TelemetrySettings
@@ -79,6 +80,17 @@ func (p *pprofExtension) Start(_ context.Context, _ component.Host) error {
 		}
 		p.file = f
 		startErr = pprof.StartCPUProfile(f)
+		if startErr != nil {
+			return startErr
+		}
+	}
+
+	if p.config.ContinuousProfiling != nil {
+		p.continuous = newContinuousProfiler(*p.config.ContinuousProfiling, p.telemetrySettings.Logger)
+		startErr = p.continuous.start()
+		if startErr != nil {
+			p.continuous = nil
+		}
 	}
 
 	return startErr
@@ -86,6 +98,10 @@ func (p *pprofExtension) Start(_ context.Context, _ component.Host) error {
 
 func (p *pprofExtension) Shutdown(context.Context) error {
 	defer running.Store(false)
+	if p.continuous != nil {
+		p.continuous.stop()
+		p.continuous = nil
+	}
 	if p.file != nil {
 		pprof.StopCPUProfile()
 		_ = p.file.Close() // ignore the error
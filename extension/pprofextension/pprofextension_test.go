@@ -8,9 +8,12 @@ import (
 	"net"
 	"net/http"
 	"os"
+	"path/filepath"
 	"runtime"
 	"testing"
+	"time"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/component/componenttest"
@@ -141,3 +144,29 @@ func TestPerformanceProfilerLifecycleWithFile(t *testing.T) {
 	require.NoError(t, pprofExt.Start(context.Background(), componenttest.NewNopHost()))
 	require.NoError(t, pprofExt.Shutdown(context.Background()))
 }
+
+func TestPerformanceProfilerLifecycleWithContinuousProfiling(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "profiles")
+	config := Config{
+		TCPAddr: confignet.TCPAddrConfig{
+			Endpoint: testutil.GetAvailableLocalAddress(t),
+		},
+		ContinuousProfiling: &ContinuousProfilingConfig{
+			Directory:   dir,
+			Interval:    100 * time.Millisecond,
+			CPUDuration: 10 * time.Millisecond,
+		},
+	}
+	tt, err := componenttest.SetupTelemetry(component.MustNewID("TestPprofExtension"))
+	require.NoError(t, err, "SetupTelemetry should succeed")
+	pprofExt := newServer(config, tt.TelemetrySettings())
+	require.NotNil(t, pprofExt)
+
+	require.NoError(t, pprofExt.Start(context.Background(), componenttest.NewNopHost()))
+	assert.Eventually(t, func() bool {
+		cpu, _ := filepath.Glob(filepath.Join(dir, "cpu-*.pb.gz"))
+		heap, _ := filepath.Glob(filepath.Join(dir, "heap-*.pb.gz"))
+		return len(cpu) > 0 && len(heap) > 0
+	}, 10*time.Second, 50*time.Millisecond)
+	require.NoError(t, pprofExt.Shutdown(context.Background()))
+}
@@ -0,0 +1,42 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ratelimiterextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/ratelimiterextension"
+
+import (
+	"errors"
+)
+
+var (
+	errInvalidRate       = errors.New("`rate` must be greater than 0")
+	errInvalidBurst      = errors.New("`burst` must be greater than 0")
+	errInvalidMaxClients = errors.New("`max_clients` must be greater than 0")
+)
+
+// Config defines the configuration of the rate limiter extension.
+type Config struct {
+	// Rate is the number of requests per second accepted from each client.
+	Rate float64 `mapstructure:"rate"`
+	// Burst is the number of requests a client can send at once, before being limited to the rate.
+	Burst int `mapstructure:"burst"`
+	// MetadataKey is the request header, or the client metadata key, identifying the client or
+	// the tenant, e.g. `X-Scope-OrgID`. When empty, all the requests share the same limit.
+	MetadataKey string `mapstructure:"metadata_key"`
+	// MaxClients is the maximum number of clients whose rate is tracked at once. The requests
+	// of new clients are limited while the rates of MaxClients clients are tracked.
+	MaxClients int `mapstructure:"max_clients"`
+}
+
+// Validate checks if the extension configuration is valid
+func (cfg *Config) Validate() error {
+	if cfg.Rate <= 0 {
+		return errInvalidRate
+	}
+	if cfg.Burst <= 0 {
+		return errInvalidBurst
+	}
+	if cfg.MaxClients <= 0 {
+		return errInvalidMaxClients
+	}
+	return nil
+}
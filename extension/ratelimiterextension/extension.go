@@ -0,0 +1,114 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ratelimiterextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/ratelimiterextension"
+
+import (
+	"context"
+	"errors"
+	"net/textproto"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/client"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/extension/auth"
+	"golang.org/x/time/rate"
+)
+
+// ErrRateLimited is returned for the requests exceeding the rate of their client.
+var ErrRateLimited = errors.New("rate limit exceeded")
+
+// RateLimiter is an extension that can be used by the receivers to limit the rate of the
+// requests they accept, per client or tenant.
+type RateLimiter interface {
+	// Limit returns ErrRateLimited when the client of the request exceeded its rate. The client
+	// is identified by the headers of the request, or by the client metadata of the context.
+	Limit(ctx context.Context, headers map[string][]string) error
+}
+
+type rateLimiter struct {
+	cfg *Config
+
+	mu       sync.Mutex
+	limiters map[string]*rate.Limiter
+}
+
+var (
+	_ RateLimiter = (*rateLimiter)(nil)
+	// the rate limiter can be set as the authenticator of the receivers without support
+	// for the extension, the limited requests are then rejected as unauthenticated.
+	_ auth.Server = (*rateLimiter)(nil)
+)
+
+func newRateLimiter(cfg *Config) *rateLimiter {
+	return &rateLimiter{
+		cfg:      cfg,
+		limiters: map[string]*rate.Limiter{},
+	}
+}
+
+func (*rateLimiter) Start(context.Context, component.Host) error {
+	return nil
+}
+
+func (*rateLimiter) Shutdown(context.Context) error {
+	return nil
+}
+
+func (l *rateLimiter) Authenticate(ctx context.Context, headers map[string][]string) (context.Context, error) {
+	return ctx, l.Limit(ctx, headers)
+}
+
+func (l *rateLimiter) Limit(ctx context.Context, headers map[string][]string) error {
+	limiter := l.limiter(l.clientKey(ctx, headers), time.Now())
+	if limiter == nil || !limiter.Allow() {
+		return ErrRateLimited
+	}
+	return nil
+}
+
+// clientKey returns the value of the metadata key in the headers, or in the client metadata.
+func (l *rateLimiter) clientKey(ctx context.Context, headers map[string][]string) string {
+	if l.cfg.MetadataKey == "" {
+		return ""
+	}
+	if values := headers[textproto.CanonicalMIMEHeaderKey(l.cfg.MetadataKey)]; len(values) > 0 {
+		return values[0]
+	}
+	for k, values := range headers {
+		if strings.EqualFold(k, l.cfg.MetadataKey) && len(values) > 0 {
+			return values[0]
+		}
+	}
+	if values := client.FromContext(ctx).Metadata.Get(l.cfg.MetadataKey); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// limiter returns the token bucket of the client, or nil if the rates of too many clients
+// are tracked.
+func (l *rateLimiter) limiter(key string, now time.Time) *rate.Limiter {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if limiter, ok := l.limiters[key]; ok {
+		return limiter
+	}
+	if len(l.limiters) >= l.cfg.MaxClients {
+		// forget the clients that didn't send requests for long enough to refill their bucket
+		for k, limiter := range l.limiters {
+			if limiter.TokensAt(now) >= float64(l.cfg.Burst) {
+				delete(l.limiters, k)
+			}
+		}
+		if len(l.limiters) >= l.cfg.MaxClients {
+			return nil
+		}
+	}
+	limiter := rate.NewLimiter(rate.Limit(l.cfg.Rate), l.cfg.Burst)
+	l.limiters[key] = limiter
+	return limiter
+}
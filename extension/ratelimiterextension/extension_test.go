@@ -0,0 +1,69 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ratelimiterextension
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/client"
+)
+
+func TestLimitGlobal(t *testing.T) {
+	l := newRateLimiter(&Config{Rate: 1, Burst: 2, MaxClients: 10})
+
+	assert.NoError(t, l.Limit(context.Background(), nil))
+	assert.NoError(t, l.Limit(context.Background(), map[string][]string{"X-Scope-Orgid": {"a"}}))
+	assert.ErrorIs(t, l.Limit(context.Background(), map[string][]string{"X-Scope-Orgid": {"b"}}), ErrRateLimited)
+}
+
+func TestLimitPerClient(t *testing.T) {
+	l := newRateLimiter(&Config{Rate: 1, Burst: 1, MetadataKey: "X-Scope-OrgID", MaxClients: 10})
+
+	tenantA := map[string][]string{"X-Scope-Orgid": {"a"}}
+	assert.NoError(t, l.Limit(context.Background(), tenantA))
+	assert.ErrorIs(t, l.Limit(context.Background(), tenantA), ErrRateLimited)
+
+	// the gRPC metadata keys are lowercase
+	assert.NoError(t, l.Limit(context.Background(), map[string][]string{"x-scope-orgid": {"b"}}))
+
+	// the client metadata is used when the header is missing
+	ctx := client.NewContext(context.Background(), client.Info{
+		Metadata: client.NewMetadata(map[string][]string{"x-scope-orgid": {"c"}}),
+	})
+	assert.NoError(t, l.Limit(ctx, nil))
+	assert.ErrorIs(t, l.Limit(ctx, nil), ErrRateLimited)
+
+	// the requests without the key share the same limit
+	assert.NoError(t, l.Limit(context.Background(), nil))
+	assert.ErrorIs(t, l.Limit(context.Background(), nil), ErrRateLimited)
+}
+
+func TestLimitMaxClients(t *testing.T) {
+	l := newRateLimiter(&Config{Rate: 1, Burst: 1, MetadataKey: "tenant", MaxClients: 1})
+
+	assert.NoError(t, l.Limit(context.Background(), map[string][]string{"tenant": {"a"}}))
+	assert.ErrorIs(t, l.Limit(context.Background(), map[string][]string{"tenant": {"b"}}), ErrRateLimited)
+
+	// the bucket of an idle client is refilled and can be forgotten
+	require.NotNil(t, l.limiter("b", time.Now().Add(2*time.Second)))
+	assert.Len(t, l.limiters, 1)
+	assert.Contains(t, l.limiters, "b")
+}
+
+func TestAuthenticate(t *testing.T) {
+	l := newRateLimiter(&Config{Rate: 1, Burst: 1, MaxClients: 10})
+	require.NoError(t, l.Start(context.Background(), nil))
+
+	ctx, err := l.Authenticate(context.Background(), nil)
+	assert.NoError(t, err)
+	assert.NotNil(t, ctx)
+	_, err = l.Authenticate(context.Background(), nil)
+	assert.ErrorIs(t, err, ErrRateLimited)
+
+	require.NoError(t, l.Shutdown(context.Background()))
+}
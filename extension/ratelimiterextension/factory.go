@@ -0,0 +1,41 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ratelimiterextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/ratelimiterextension"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/extension"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/ratelimiterextension/internal/metadata"
+)
+
+const (
+	defaultRate       = 1000
+	defaultBurst      = 1000
+	defaultMaxClients = 10000
+)
+
+// NewFactory creates a factory for the rate limiter extension.
+func NewFactory() extension.Factory {
+	return extension.NewFactory(
+		metadata.Type,
+		createDefaultConfig,
+		createExtension,
+		metadata.ExtensionStability,
+	)
+}
+
+func createDefaultConfig() component.Config {
+	return &Config{
+		Rate:       defaultRate,
+		Burst:      defaultBurst,
+		MaxClients: defaultMaxClients,
+	}
+}
+
+func createExtension(_ context.Context, _ extension.CreateSettings, cfg component.Config) (extension.Extension, error) {
+	return newRateLimiter(cfg.(*Config)), nil
+}
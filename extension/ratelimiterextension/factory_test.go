@@ -0,0 +1,40 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ratelimiterextension
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/extension/auth"
+	"go.opentelemetry.io/collector/extension/extensiontest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/ratelimiterextension/internal/metadata"
+)
+
+func TestCreateDefaultConfig(t *testing.T) {
+	expected := &Config{
+		Rate:       defaultRate,
+		Burst:      defaultBurst,
+		MaxClients: defaultMaxClients,
+	}
+	actual := createDefaultConfig()
+	assert.Equal(t, expected, actual)
+	assert.NoError(t, componenttest.CheckConfigStruct(actual))
+}
+
+func TestCreateExtension(t *testing.T) {
+	ext, err := createExtension(context.Background(), extensiontest.NewNopCreateSettings(), createDefaultConfig())
+	assert.NoError(t, err)
+	assert.Implements(t, (*RateLimiter)(nil), ext)
+	assert.Implements(t, (*auth.Server)(nil), ext)
+}
+
+func TestNewFactory(t *testing.T) {
+	f := NewFactory()
+	assert.NotNil(t, f)
+	assert.Equal(t, f.Type(), metadata.Type)
+}
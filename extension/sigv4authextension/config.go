@@ -4,18 +4,31 @@
 package sigv4authextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/sigv4authextension"
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"go.opentelemetry.io/collector/component"
 )
 
+const (
+	// credentialsSourceEKSPodIdentity uses the credentials served by the EKS Pod Identity Agent.
+	credentialsSourceEKSPodIdentity = "eks_pod_identity"
+)
+
 // Config stores the configuration for the Sigv4 Authenticator
 type Config struct {
-	Region        string     `mapstructure:"region,omitempty"`
-	Service       string     `mapstructure:"service,omitempty"`
-	AssumeRole    AssumeRole `mapstructure:"assume_role"`
-	credsProvider *aws.CredentialsProvider
+	Region     string     `mapstructure:"region,omitempty"`
+	Service    string     `mapstructure:"service,omitempty"`
+	AssumeRole AssumeRole `mapstructure:"assume_role"`
+	// RoleChain lists the roles assumed in order after the role of AssumeRole, each one
+	// with the credentials of the previous role.
+	RoleChain []AssumeRole `mapstructure:"role_chain,omitempty"`
+	// CredentialsSource selects where the base credentials come from, before any role is
+	// assumed. Either empty for the default credential chain of the AWS SDK, or
+	// "eks_pod_identity" for the EKS Pod Identity Agent.
+	CredentialsSource string `mapstructure:"credentials_source,omitempty"`
+	credsProvider     *aws.CredentialsProvider
 }
 
 // AssumeRole holds the configuration needed to assume a role
@@ -23,6 +36,12 @@ type AssumeRole struct {
 	ARN         string `mapstructure:"arn,omitempty"`
 	SessionName string `mapstructure:"session_name,omitempty"`
 	STSRegion   string `mapstructure:"sts_region,omitempty"`
+	// ExternalID is passed to STS when assuming the role, as required by the trust policy
+	// of roles shared with third parties.
+	ExternalID string `mapstructure:"external_id,omitempty"`
+	// STSEndpoint overrides the STS endpoint resolved from the STS region, for VPC endpoints
+	// or partitions the AWS SDK doesn't know about.
+	STSEndpoint string `mapstructure:"sts_endpoint,omitempty"`
 }
 
 // compile time check that the Config struct satisfies the component.Config interface
@@ -35,6 +54,22 @@ func (cfg *Config) Validate() error {
 	if cfg.AssumeRole.STSRegion == "" && cfg.Region != "" {
 		cfg.AssumeRole.STSRegion = cfg.Region
 	}
+	for i := range cfg.RoleChain {
+		if cfg.RoleChain[i].ARN == "" {
+			return fmt.Errorf("role_chain[%d]: arn cannot be empty", i)
+		}
+		if cfg.RoleChain[i].STSRegion == "" {
+			cfg.RoleChain[i].STSRegion = cfg.AssumeRole.STSRegion
+		}
+	}
+	switch cfg.CredentialsSource {
+	case "", credentialsSourceEKSPodIdentity:
+	default:
+		return fmt.Errorf("credentials_source %q is not supported", cfg.CredentialsSource)
+	}
+	if cfg.AssumeRole.ARN == "" && cfg.AssumeRole.ExternalID != "" {
+		return errors.New("assume_role: external_id requires an arn")
+	}
 
 	credsProvider, err := getCredsProviderFromConfig(cfg)
 	if err != nil {
@@ -54,3 +54,32 @@ func TestLoadConfigError(t *testing.T) {
 	require.NoError(t, component.UnmarshalConfig(sub, cfg))
 	assert.Error(t, component.ValidateConfig(cfg))
 }
+
+func TestValidateConfigError(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  *Config
+		err  string
+	}{
+		{
+			name: "role_chain_without_arn",
+			cfg:  &Config{RoleChain: []AssumeRole{{SessionName: "session"}}},
+			err:  "role_chain[0]: arn cannot be empty",
+		},
+		{
+			name: "unknown_credentials_source",
+			cfg:  &Config{CredentialsSource: "ec2"},
+			err:  `credentials_source "ec2" is not supported`,
+		},
+		{
+			name: "external_id_without_arn",
+			cfg:  &Config{AssumeRole: AssumeRole{ExternalID: "external"}},
+			err:  "assume_role: external_id requires an arn",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.EqualError(t, tt.cfg.Validate(), tt.err)
+		})
+	}
+}
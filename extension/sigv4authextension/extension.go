@@ -6,11 +6,15 @@ package sigv4authextension // import "github.com/open-telemetry/opentelemetry-co
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net/http"
+	"os"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	sigv4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
 	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials/endpointcreds"
 	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
 	"github.com/aws/aws-sdk-go-v2/service/sts"
 	"go.opentelemetry.io/collector/component"
@@ -76,10 +80,30 @@ func getCredsProviderFromConfig(cfg *Config) (*aws.CredentialsProvider, error) {
 	if err != nil {
 		return nil, err
 	}
-	if cfg.AssumeRole.ARN != "" {
-		stsSvc := sts.NewFromConfig(awscfg)
+	if cfg.CredentialsSource == credentialsSourceEKSPodIdentity {
+		awscfg.Credentials = aws.NewCredentialsCache(newEKSPodIdentityProvider())
+	}
 
-		provider := stscreds.NewAssumeRoleProvider(stsSvc, cfg.AssumeRole.ARN)
+	roles := cfg.RoleChain
+	if cfg.AssumeRole.ARN != "" {
+		roles = append([]AssumeRole{cfg.AssumeRole}, roles...)
+	}
+	for _, role := range roles {
+		// the STS client signs its requests with the credentials of the previous step
+		stsSvc := sts.NewFromConfig(awscfg, func(o *sts.Options) {
+			if role.STSRegion != "" {
+				o.Region = role.STSRegion
+			}
+			if role.STSEndpoint != "" {
+				o.BaseEndpoint = aws.String(role.STSEndpoint)
+			}
+		})
+		provider := stscreds.NewAssumeRoleProvider(stsSvc, role.ARN, func(o *stscreds.AssumeRoleOptions) {
+			o.RoleSessionName = role.SessionName
+			if role.ExternalID != "" {
+				o.ExternalID = aws.String(role.ExternalID)
+			}
+		})
 		awscfg.Credentials = aws.NewCredentialsCache(provider)
 	}
 
@@ -90,3 +114,35 @@ func getCredsProviderFromConfig(cfg *Config) (*aws.CredentialsProvider, error) {
 
 	return &awscfg.Credentials, nil
 }
+
+const (
+	// The EKS Pod Identity Agent injects these environment variables in the pods, the defaults
+	// are the values it uses.
+	podIdentityEndpointEnvVar  = "AWS_CONTAINER_CREDENTIALS_FULL_URI"
+	podIdentityTokenFileEnvVar = "AWS_CONTAINER_AUTHORIZATION_TOKEN_FILE"
+	defaultPodIdentityEndpoint = "http://169.254.170.23/v1/credentials"
+	defaultPodIdentityToken    = "/var/run/secrets/pods.eks.amazonaws.com/serviceaccount/eks-pod-identity-token"
+)
+
+// newEKSPodIdentityProvider returns a provider retrieving the credentials from the EKS Pod
+// Identity Agent, authorized with the service account token mounted in the pod. The token
+// is read on every retrieval since it is rotated by the kubelet.
+func newEKSPodIdentityProvider() aws.CredentialsProvider {
+	endpoint := os.Getenv(podIdentityEndpointEnvVar)
+	if endpoint == "" {
+		endpoint = defaultPodIdentityEndpoint
+	}
+	tokenFile := os.Getenv(podIdentityTokenFileEnvVar)
+	if tokenFile == "" {
+		tokenFile = defaultPodIdentityToken
+	}
+	return endpointcreds.New(endpoint, func(o *endpointcreds.Options) {
+		o.AuthorizationTokenProvider = endpointcreds.TokenProviderFunc(func() (string, error) {
+			token, err := os.ReadFile(tokenFile)
+			if err != nil {
+				return "", fmt.Errorf("failed to read the EKS Pod Identity token: %w", err)
+			}
+			return strings.TrimSpace(string(token)), nil
+		})
+	})
+}
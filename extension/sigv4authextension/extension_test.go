@@ -5,7 +5,13 @@ package sigv4authextension
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -102,6 +108,127 @@ func TestGetCredsProviderFromConfig(t *testing.T) {
 	}
 }
 
+func TestGetCredsProviderFromConfigEKSPodIdentity(t *testing.T) {
+	tokenFile := filepath.Join(t.TempDir(), "eks-pod-identity-token")
+	require.NoError(t, os.WriteFile(tokenFile, []byte("pod-identity-token\n"), 0600))
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "pod-identity-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = fmt.Fprint(w, `{"AccessKeyId":"PodAccessKeyID","SecretAccessKey":"PodSecretAccessKey","Token":"PodToken","Expiration":"2100-01-01T00:00:00Z"}`)
+	}))
+	defer server.Close()
+
+	t.Setenv("AWS_ACCESS_KEY_ID", "")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "")
+	t.Setenv(podIdentityEndpointEnvVar, server.URL)
+	t.Setenv(podIdentityTokenFileEnvVar, tokenFile)
+
+	credsProvider, err := getCredsProviderFromConfig(&Config{
+		Region:            "region",
+		AssumeRole:        AssumeRole{STSRegion: "region"},
+		CredentialsSource: credentialsSourceEKSPodIdentity,
+	})
+	require.NoError(t, err)
+	creds, err := (*credsProvider).Retrieve(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "PodAccessKeyID", creds.AccessKeyID)
+	assert.Equal(t, "PodToken", creds.SessionToken)
+
+	t.Setenv(podIdentityTokenFileEnvVar, filepath.Join(t.TempDir(), "missing"))
+	_, err = getCredsProviderFromConfig(&Config{
+		AssumeRole:        AssumeRole{STSRegion: "region"},
+		CredentialsSource: credentialsSourceEKSPodIdentity,
+	})
+	assert.ErrorContains(t, err, "failed to read the EKS Pod Identity token")
+}
+
+// assumeRoleRequest is the part of an AssumeRole request checked by the tests.
+type assumeRoleRequest struct {
+	roleARN     string
+	externalID  string
+	accessKeyID string
+}
+
+func TestGetCredsProviderFromConfigRoleChain(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		requests []assumeRoleRequest
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !assert.NoError(t, r.ParseForm()) {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		// the access key ID of the signing credentials is the first part of the credential scope
+		_, scope, _ := strings.Cut(r.Header.Get("Authorization"), "Credential=")
+		accessKeyID, _, _ := strings.Cut(scope, "/")
+		roleARN := r.PostForm.Get("RoleArn")
+		mu.Lock()
+		requests = append(requests, assumeRoleRequest{
+			roleARN:     roleARN,
+			externalID:  r.PostForm.Get("ExternalId"),
+			accessKeyID: accessKeyID,
+		})
+		mu.Unlock()
+
+		role := roleARN[strings.LastIndex(roleARN, "/")+1:]
+		w.Header().Set("Content-Type", "text/xml")
+		_, _ = fmt.Fprintf(w, `<AssumeRoleResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <AssumeRoleResult>
+    <Credentials>
+      <AccessKeyId>%[1]sAccessKeyID</AccessKeyId>
+      <SecretAccessKey>%[1]sSecretAccessKey</SecretAccessKey>
+      <SessionToken>%[1]sToken</SessionToken>
+      <Expiration>2100-01-01T00:00:00Z</Expiration>
+    </Credentials>
+    <AssumedRoleUser>
+      <Arn>%[2]s</Arn>
+      <AssumedRoleId>%[1]s</AssumedRoleId>
+    </AssumedRoleUser>
+  </AssumeRoleResult>
+  <ResponseMetadata>
+    <RequestId>request</RequestId>
+  </ResponseMetadata>
+</AssumeRoleResponse>`, role, roleARN)
+	}))
+	defer server.Close()
+
+	t.Setenv("AWS_ACCESS_KEY_ID", "AccessKeyID")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "SecretAccessKey")
+
+	cfg := &Config{
+		Region: "us-gov-west-1",
+		AssumeRole: AssumeRole{
+			ARN:         "arn:aws-us-gov:iam::123456789012:role/first",
+			ExternalID:  "external",
+			STSEndpoint: server.URL,
+		},
+		RoleChain: []AssumeRole{
+			{
+				ARN:         "arn:aws-us-gov:iam::210987654321:role/second",
+				STSEndpoint: server.URL,
+			},
+		},
+	}
+	require.NoError(t, cfg.Validate())
+	assert.Equal(t, "us-gov-west-1", cfg.RoleChain[0].STSRegion)
+
+	creds, err := (*cfg.credsProvider).Retrieve(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "secondAccessKeyID", creds.AccessKeyID)
+	assert.Equal(t, "secondToken", creds.SessionToken)
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, []assumeRoleRequest{
+		{roleARN: "arn:aws-us-gov:iam::123456789012:role/first", externalID: "external", accessKeyID: "AccessKeyID"},
+		{roleARN: "arn:aws-us-gov:iam::210987654321:role/second", accessKeyID: "firstAccessKeyID"},
+	}, requests)
+}
+
 func TestCloneRequest(t *testing.T) {
 	req1, err := http.NewRequest("GET", "https://example.com", nil)
 	assert.NoError(t, err)
@@ -0,0 +1,86 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package watchdogextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/watchdogextension"
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+)
+
+var (
+	errNoReceivers          = errors.New("at least one receiver must be watched")
+	errInvalidCheckInterval = errors.New("`check_interval` must be greater than 0")
+	errInvalidStallTimeout  = errors.New("`stall_timeout` must be greater than 0")
+	errNoCommand            = errors.New("`exec::command` must not be empty")
+)
+
+// Config defines the configuration of the watchdog extension.
+type Config struct {
+	// MetricsEndpoint is the URL of the Prometheus endpoint exposing the internal metrics of the collector.
+	MetricsEndpoint string `mapstructure:"metrics_endpoint"`
+	// CheckInterval is the interval between two checks of the throughput of the receivers.
+	CheckInterval time.Duration `mapstructure:"check_interval"`
+	// StallTimeout is the duration without data after which a receiver is stalled.
+	StallTimeout time.Duration `mapstructure:"stall_timeout"`
+	// Receivers are the receivers expected to receive data continuously.
+	Receivers []ReceiverConfig `mapstructure:"receivers"`
+	// Actions are the actions taken once a receiver is stalled.
+	Actions ActionsConfig `mapstructure:"actions"`
+}
+
+// ReceiverConfig defines a watched receiver.
+type ReceiverConfig struct {
+	// ID is the ID of the receiver, e.g. `otlp` or `filelog/app`.
+	ID component.ID `mapstructure:"id"`
+	// StallTimeout overrides the stall timeout of the extension for the receiver.
+	StallTimeout time.Duration `mapstructure:"stall_timeout"`
+}
+
+// ActionsConfig defines the actions taken once a receiver is stalled.
+type ActionsConfig struct {
+	// MarkUnhealthy reports a recoverable error as the status of the extension while a receiver
+	// is stalled, the health check extensions then report the collector as unhealthy.
+	MarkUnhealthy bool `mapstructure:"mark_unhealthy"`
+	// LogDiagnostics logs the internal metrics of the collector and the runtime statistics.
+	LogDiagnostics bool `mapstructure:"log_diagnostics"`
+	// Exec runs a command, e.g. to restart the collector.
+	Exec *ExecConfig `mapstructure:"exec"`
+}
+
+// ExecConfig defines the command run once a receiver is stalled.
+type ExecConfig struct {
+	// Command is the executable and its arguments.
+	Command []string `mapstructure:"command"`
+	// Timeout is the maximum duration of the command, it is killed afterwards.
+	Timeout time.Duration `mapstructure:"timeout"`
+}
+
+// Validate checks if the extension configuration is valid
+func (cfg *Config) Validate() error {
+	if u, err := url.Parse(cfg.MetricsEndpoint); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("invalid `metrics_endpoint` %q: must be an http or https URL", cfg.MetricsEndpoint)
+	}
+	if cfg.CheckInterval <= 0 {
+		return errInvalidCheckInterval
+	}
+	if cfg.StallTimeout <= 0 {
+		return errInvalidStallTimeout
+	}
+	if len(cfg.Receivers) == 0 {
+		return errNoReceivers
+	}
+	for _, r := range cfg.Receivers {
+		if r.StallTimeout < 0 {
+			return fmt.Errorf("receiver %q: %w", r.ID, errInvalidStallTimeout)
+		}
+	}
+	if cfg.Actions.Exec != nil && len(cfg.Actions.Exec.Command) == 0 {
+		return errNoCommand
+	}
+	return nil
+}
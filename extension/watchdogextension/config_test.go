@@ -0,0 +1,88 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package watchdogextension
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/watchdogextension/internal/metadata"
+)
+
+func TestLoadConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		id          component.ID
+		expected    component.Config
+		expectedErr string
+	}{
+		{
+			id:          component.NewID(metadata.Type),
+			expectedErr: errNoReceivers.Error(),
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "custom"),
+			expected: &Config{
+				MetricsEndpoint: "http://collector:8888/metrics",
+				CheckInterval:   10 * time.Second,
+				StallTimeout:    2 * time.Minute,
+				Receivers: []ReceiverConfig{
+					{ID: component.MustNewID("otlp")},
+					{ID: component.MustNewIDWithName("filelog", "app"), StallTimeout: 10 * time.Minute},
+				},
+				Actions: ActionsConfig{
+					LogDiagnostics: true,
+					Exec: &ExecConfig{
+						Command: []string{"systemctl", "restart", "otelcol-contrib"},
+						Timeout: time.Minute,
+					},
+				},
+			},
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "no_receivers"),
+			expectedErr: errNoReceivers.Error(),
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "invalid_endpoint"),
+			expectedErr: "invalid `metrics_endpoint` \"collector:8888\": must be an http or https URL",
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "invalid_check_interval"),
+			expectedErr: errInvalidCheckInterval.Error(),
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "invalid_stall_timeout"),
+			expectedErr: `receiver "otlp": ` + errInvalidStallTimeout.Error(),
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "no_command"),
+			expectedErr: errNoCommand.Error(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+			require.NoError(t, err)
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, component.UnmarshalConfig(sub, cfg))
+			if tt.expectedErr != "" {
+				assert.EqualError(t, component.ValidateConfig(cfg), tt.expectedErr)
+				return
+			}
+			assert.NoError(t, component.ValidateConfig(cfg))
+			assert.Equal(t, tt.expected, cfg)
+		})
+	}
+}
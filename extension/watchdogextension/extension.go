@@ -0,0 +1,185 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package watchdogextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/watchdogextension"
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	dto "github.com/prometheus/client_model/go"
+	"go.opentelemetry.io/collector/component"
+	"go.uber.org/zap"
+)
+
+// receiverState tracks the throughput of a watched receiver.
+type receiverState struct {
+	id           component.ID
+	stallTimeout time.Duration
+	accepted     float64
+	lastChange   time.Time
+	stalled      bool
+}
+
+type watchdog struct {
+	cfg       *Config
+	telemetry component.TelemetrySettings
+	client    *http.Client
+	receivers []*receiverState
+	unhealthy bool
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func newWatchdog(cfg *Config, telemetry component.TelemetrySettings) *watchdog {
+	return &watchdog{
+		cfg:       cfg,
+		telemetry: telemetry,
+		client:    &http.Client{Timeout: cfg.CheckInterval},
+	}
+}
+
+func (w *watchdog) Start(context.Context, component.Host) error {
+	if len(w.cfg.Receivers) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+	w.receivers = make([]*receiverState, 0, len(w.cfg.Receivers))
+	for _, r := range w.cfg.Receivers {
+		stallTimeout := r.StallTimeout
+		if stallTimeout == 0 {
+			stallTimeout = w.cfg.StallTimeout
+		}
+		w.receivers = append(w.receivers, &receiverState{
+			id:           r.ID,
+			stallTimeout: stallTimeout,
+			lastChange:   now,
+		})
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	w.cancel = cancel
+	w.wg.Add(1)
+	go func() {
+		defer w.wg.Done()
+		ticker := time.NewTicker(w.cfg.CheckInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case now := <-ticker.C:
+				w.check(ctx, now)
+			}
+		}
+	}()
+	return nil
+}
+
+func (w *watchdog) Shutdown(context.Context) error {
+	if w.cancel != nil {
+		w.cancel()
+	}
+	w.wg.Wait()
+	return nil
+}
+
+// check updates the throughput of the receivers, and acts on the newly stalled ones.
+func (w *watchdog) check(ctx context.Context, now time.Time) {
+	families, err := w.scrape(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			w.telemetry.Logger.Warn("Failed to read the internal metrics of the collector", zap.Error(err))
+		}
+		return
+	}
+
+	var stalled []string
+	for _, r := range w.receivers {
+		accepted := acceptedItems(families, r.id)
+		if accepted != r.accepted {
+			r.accepted = accepted
+			r.lastChange = now
+			if r.stalled {
+				r.stalled = false
+				w.telemetry.Logger.Info("The receiver receives data again", zap.String("receiver", r.id.String()))
+			}
+		}
+		if !r.stalled && now.Sub(r.lastChange) >= r.stallTimeout {
+			r.stalled = true
+			w.onStall(ctx, r, families, now)
+		}
+		if r.stalled {
+			stalled = append(stalled, r.id.String())
+		}
+	}
+
+	if !w.cfg.Actions.MarkUnhealthy {
+		return
+	}
+	switch {
+	case len(stalled) > 0:
+		w.unhealthy = true
+		w.telemetry.ReportStatus(component.NewRecoverableErrorEvent(fmt.Errorf("stalled receivers: %s", strings.Join(stalled, ", "))))
+	case w.unhealthy:
+		w.unhealthy = false
+		w.telemetry.ReportStatus(component.NewStatusEvent(component.StatusOK))
+	}
+}
+
+// onStall takes the actions configured for a newly stalled receiver.
+func (w *watchdog) onStall(ctx context.Context, r *receiverState, families map[string]*dto.MetricFamily, now time.Time) {
+	stalledFor := now.Sub(r.lastChange)
+	w.telemetry.Logger.Warn("The receiver is stalled",
+		zap.String("receiver", r.id.String()),
+		zap.Duration("stalled_for", stalledFor))
+
+	if w.cfg.Actions.LogDiagnostics {
+		var mem runtime.MemStats
+		runtime.ReadMemStats(&mem)
+		w.telemetry.Logger.Warn("Diagnostics of the stalled receiver",
+			zap.String("receiver", r.id.String()),
+			zap.Any("metrics", diagnostics(families)),
+			zap.Int("goroutines", runtime.NumGoroutine()),
+			zap.Uint64("heap_alloc_bytes", mem.HeapAlloc),
+			zap.Uint32("gc_cycles", mem.NumGC))
+	}
+
+	if w.cfg.Actions.Exec != nil {
+		if err := w.exec(ctx, r, stalledFor); err != nil {
+			w.telemetry.Logger.Error("Failed to run the command of the watchdog", zap.String("receiver", r.id.String()), zap.Error(err))
+		}
+	}
+}
+
+// exec runs the command configured for the stalled receivers, with the receiver and the duration
+// of the stall in the WATCHDOG_RECEIVER and WATCHDOG_STALLED_FOR environment variables.
+func (w *watchdog) exec(ctx context.Context, r *receiverState, stalledFor time.Duration) error {
+	timeout := w.cfg.Actions.Exec.Timeout
+	if timeout <= 0 {
+		timeout = defaultExecTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	command := w.cfg.Actions.Exec.Command
+	cmd := exec.CommandContext(ctx, command[0], command[1:]...) // #nosec G204
+	cmd.Env = append(os.Environ(),
+		"WATCHDOG_RECEIVER="+r.id.String(),
+		"WATCHDOG_STALLED_FOR="+stalledFor.String())
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("%w: %s", err, output)
+	}
+	w.telemetry.Logger.Info("Ran the command of the watchdog", zap.String("receiver", r.id.String()), zap.ByteString("output", output))
+	return nil
+}
@@ -0,0 +1,189 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package watchdogextension
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+const testMetrics = `# HELP otelcol_receiver_accepted_spans Number of spans successfully pushed into the pipeline.
+# TYPE otelcol_receiver_accepted_spans counter
+otelcol_receiver_accepted_spans{receiver="otlp",service_instance_id="1",transport="grpc"} %d
+otelcol_receiver_accepted_spans{receiver="otlp",service_instance_id="1",transport="http"} 5
+# HELP otelcol_receiver_accepted_log_records Number of log records successfully pushed into the pipeline.
+# TYPE otelcol_receiver_accepted_log_records counter
+otelcol_receiver_accepted_log_records{receiver="filelog/app",service_instance_id="1",transport=""} %d
+# HELP otelcol_exporter_queue_size Current size of the retry queue (in batches)
+# TYPE otelcol_exporter_queue_size gauge
+otelcol_exporter_queue_size{exporter="otlp",service_instance_id="1"} 1000
+# HELP otelcol_process_uptime Uptime of the process
+# TYPE otelcol_process_uptime counter
+otelcol_process_uptime{service_instance_id="1"} 120
+`
+
+// testCollector serves the internal metrics of a collector with the given counts of spans
+// and log records received.
+type testCollector struct {
+	spans atomic.Int64
+	logs  atomic.Int64
+}
+
+func (c *testCollector) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	_, _ = fmt.Fprintf(w, testMetrics, c.spans.Load(), c.logs.Load())
+}
+
+type statusEvents struct {
+	mu     sync.Mutex
+	events []*component.StatusEvent
+}
+
+func (s *statusEvents) report(event *component.StatusEvent) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.events = append(s.events, event)
+}
+
+func (s *statusEvents) statuses() []component.Status {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var statuses []component.Status
+	for _, event := range s.events {
+		statuses = append(statuses, event.Status())
+	}
+	return statuses
+}
+
+func newTestWatchdog(t *testing.T, cfg *Config) (*watchdog, *testCollector, *statusEvents, *observer.ObservedLogs) {
+	collector := &testCollector{}
+	server := httptest.NewServer(collector)
+	t.Cleanup(server.Close)
+	cfg.MetricsEndpoint = server.URL
+
+	core, logs := observer.New(zap.InfoLevel)
+	events := &statusEvents{}
+	telemetry := componenttest.NewNopTelemetrySettings()
+	telemetry.Logger = zap.New(core)
+	telemetry.ReportStatus = events.report
+	return newWatchdog(cfg, telemetry), collector, events, logs
+}
+
+func TestCheck(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.CheckInterval = time.Hour
+	cfg.StallTimeout = time.Minute
+	cfg.Receivers = []ReceiverConfig{
+		{ID: component.MustNewID("otlp")},
+		{ID: component.MustNewIDWithName("filelog", "app"), StallTimeout: 10 * time.Minute},
+	}
+	w, collector, events, logs := newTestWatchdog(t, cfg)
+	require.NoError(t, w.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() { require.NoError(t, w.Shutdown(context.Background())) }()
+	start := w.receivers[0].lastChange
+
+	collector.spans.Store(10)
+	collector.logs.Store(10)
+	w.check(context.Background(), start.Add(30*time.Second))
+	assert.Equal(t, float64(15), w.receivers[0].accepted)
+	assert.Equal(t, float64(10), w.receivers[1].accepted)
+	assert.Empty(t, events.statuses())
+
+	// the otlp receiver stalls, not the filelog receiver with a longer timeout
+	w.check(context.Background(), start.Add(2*time.Minute))
+	assert.True(t, w.receivers[0].stalled)
+	assert.False(t, w.receivers[1].stalled)
+	assert.Equal(t, []component.Status{component.StatusRecoverableError}, events.statuses())
+	assert.Equal(t, "stalled receivers: otlp", events.events[0].Err().Error())
+	require.Equal(t, 1, logs.FilterMessage("The receiver is stalled").Len())
+	diagnostics := logs.FilterMessage("Diagnostics of the stalled receiver").All()
+	require.Len(t, diagnostics, 1)
+	assert.Equal(t, map[string]float64{
+		`otelcol_receiver_accepted_spans{receiver="otlp",transport="grpc"}`:          10,
+		`otelcol_receiver_accepted_spans{receiver="otlp",transport="http"}`:          5,
+		`otelcol_receiver_accepted_log_records{receiver="filelog/app",transport=""}`: 10,
+		`otelcol_exporter_queue_size{exporter="otlp"}`:                               1000,
+	}, diagnostics[0].ContextMap()["metrics"])
+
+	// the actions are taken once per stall
+	w.check(context.Background(), start.Add(3*time.Minute))
+	assert.Equal(t, 1, logs.FilterMessage("The receiver is stalled").Len())
+
+	collector.spans.Store(20)
+	w.check(context.Background(), start.Add(4*time.Minute))
+	assert.False(t, w.receivers[0].stalled)
+	assert.Equal(t, []component.Status{
+		component.StatusRecoverableError,
+		component.StatusRecoverableError,
+		component.StatusOK,
+	}, events.statuses())
+	assert.Equal(t, 1, logs.FilterMessage("The receiver receives data again").Len())
+}
+
+func TestCheckMetricsUnavailable(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Receivers = []ReceiverConfig{{ID: component.MustNewID("otlp")}}
+	w, _, events, logs := newTestWatchdog(t, cfg)
+	// nothing listens on the port
+	cfg.MetricsEndpoint = "http://127.0.0.1:1/metrics"
+	require.NoError(t, w.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() { require.NoError(t, w.Shutdown(context.Background())) }()
+
+	// the receivers are not stalled while the metrics can't be read
+	w.check(context.Background(), time.Now().Add(time.Hour))
+	assert.False(t, w.receivers[0].stalled)
+	assert.Empty(t, events.statuses())
+	assert.Equal(t, 1, logs.FilterMessage("Failed to read the internal metrics of the collector").Len())
+}
+
+func TestExec(t *testing.T) {
+	out := filepath.Join(t.TempDir(), "out")
+	cfg := createDefaultConfig().(*Config)
+	cfg.CheckInterval = 10 * time.Millisecond
+	cfg.StallTimeout = 50 * time.Millisecond
+	cfg.Receivers = []ReceiverConfig{{ID: component.MustNewIDWithName("filelog", "app")}}
+	cfg.Actions = ActionsConfig{
+		Exec: &ExecConfig{Command: []string{"sh", "-c", `echo "$WATCHDOG_RECEIVER" > ` + out}},
+	}
+	w, _, events, _ := newTestWatchdog(t, cfg)
+	require.NoError(t, w.Start(context.Background(), componenttest.NewNopHost()))
+
+	assert.Eventually(t, func() bool {
+		content, err := os.ReadFile(out)
+		return err == nil && string(content) == "filelog/app\n"
+	}, 5*time.Second, 10*time.Millisecond)
+	require.NoError(t, w.Shutdown(context.Background()))
+	// the status is only reported with mark_unhealthy
+	assert.Empty(t, events.statuses())
+}
+
+func TestExecFailure(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Receivers = []ReceiverConfig{{ID: component.MustNewID("zipkin")}}
+	cfg.Actions = ActionsConfig{
+		Exec: &ExecConfig{Command: []string{"sh", "-c", "echo failed; exit 3"}},
+	}
+	w, _, _, logs := newTestWatchdog(t, cfg)
+	require.NoError(t, w.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() { require.NoError(t, w.Shutdown(context.Background())) }()
+
+	w.check(context.Background(), time.Now().Add(time.Hour))
+	failures := logs.FilterMessage("Failed to run the command of the watchdog").All()
+	require.Len(t, failures, 1)
+	assert.Equal(t, "exit status 3: failed\n", failures[0].ContextMap()["error"])
+}
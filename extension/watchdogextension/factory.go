@@ -0,0 +1,48 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package watchdogextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/watchdogextension"
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/extension"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/watchdogextension/internal/metadata"
+)
+
+const (
+	// defaultMetricsEndpoint is the default endpoint of the internal metrics of the collector.
+	defaultMetricsEndpoint = "http://localhost:8888/metrics"
+	defaultCheckInterval   = 30 * time.Second
+	defaultStallTimeout    = 5 * time.Minute
+	defaultExecTimeout     = 30 * time.Second
+)
+
+// NewFactory creates a factory for the watchdog extension.
+func NewFactory() extension.Factory {
+	return extension.NewFactory(
+		metadata.Type,
+		createDefaultConfig,
+		createExtension,
+		metadata.ExtensionStability,
+	)
+}
+
+func createDefaultConfig() component.Config {
+	return &Config{
+		MetricsEndpoint: defaultMetricsEndpoint,
+		CheckInterval:   defaultCheckInterval,
+		StallTimeout:    defaultStallTimeout,
+		Actions: ActionsConfig{
+			MarkUnhealthy:  true,
+			LogDiagnostics: true,
+		},
+	}
+}
+
+func createExtension(_ context.Context, set extension.CreateSettings, cfg component.Config) (extension.Extension, error) {
+	return newWatchdog(cfg.(*Config), set.TelemetrySettings), nil
+}
@@ -0,0 +1,132 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package watchdogextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/watchdogextension"
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	"go.opentelemetry.io/collector/component"
+)
+
+// acceptedMetrics are the internal metrics counting the items accepted by the receivers.
+var acceptedMetrics = []string{
+	"otelcol_receiver_accepted_spans",
+	"otelcol_receiver_accepted_metric_points",
+	"otelcol_receiver_accepted_log_records",
+}
+
+// diagnosticsPrefixes are the prefixes of the internal metrics logged in the diagnostics.
+var diagnosticsPrefixes = []string{
+	"otelcol_receiver_",
+	"otelcol_processor_",
+	"otelcol_exporter_",
+}
+
+// scrape reads the internal metrics of the collector, by name.
+func (w *watchdog) scrape(ctx context.Context) (map[string]*dto.MetricFamily, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, w.cfg.MetricsEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", string(expfmt.NewFormat(expfmt.TypeTextPlain)))
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("the metrics endpoint returned status %d", resp.StatusCode)
+	}
+
+	var parser expfmt.TextParser
+	families, err := parser.TextToMetricFamilies(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the metrics: %w", err)
+	}
+	return families, nil
+}
+
+// acceptedItems returns the number of items accepted by the receiver.
+func acceptedItems(families map[string]*dto.MetricFamily, id component.ID) float64 {
+	var total float64
+	for _, name := range acceptedMetrics {
+		// the metrics have the _total suffix unless the collector removes it
+		for _, family := range []*dto.MetricFamily{families[name], families[name+"_total"]} {
+			if family == nil {
+				continue
+			}
+			for _, m := range family.GetMetric() {
+				if labelValue(m, "receiver") == id.String() {
+					total += value(m)
+				}
+			}
+		}
+	}
+	return total
+}
+
+// diagnostics returns the values of the internal metrics of the pipelines, by name and component.
+func diagnostics(families map[string]*dto.MetricFamily) map[string]float64 {
+	values := map[string]float64{}
+	for name, family := range families {
+		if !hasDiagnosticsPrefix(name) {
+			continue
+		}
+		for _, m := range family.GetMetric() {
+			key := name + "{" + componentLabels(m) + "}"
+			values[key] += value(m)
+		}
+	}
+	return values
+}
+
+func hasDiagnosticsPrefix(name string) bool {
+	for _, prefix := range diagnosticsPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// componentLabels returns the labels identifying the component of the metric, ignoring
+// the labels of the collector instance.
+func componentLabels(m *dto.Metric) string {
+	var labels []string
+	for _, l := range m.GetLabel() {
+		switch l.GetName() {
+		case "receiver", "processor", "exporter", "transport", "data_type":
+			labels = append(labels, fmt.Sprintf("%s=%q", l.GetName(), l.GetValue()))
+		}
+	}
+	sort.Strings(labels)
+	return strings.Join(labels, ",")
+}
+
+func labelValue(m *dto.Metric, name string) string {
+	for _, l := range m.GetLabel() {
+		if l.GetName() == name {
+			return l.GetValue()
+		}
+	}
+	return ""
+}
+
+func value(m *dto.Metric) float64 {
+	switch {
+	case m.Counter != nil:
+		return m.Counter.GetValue()
+	case m.Gauge != nil:
+		return m.Gauge.GetValue()
+	case m.Untyped != nil:
+		return m.Untyped.GetValue()
+	}
+	return 0
+}
@@ -17,7 +17,7 @@ import (
 // Settings specifies the processor settings.
 type Settings struct {
 	// Actions specifies the list of attributes to act on.
-	// The set of actions are {INSERT, UPDATE, UPSERT, DELETE, HASH, EXTRACT, CONVERT}.
+	// The set of actions are {INSERT, UPDATE, UPSERT, DELETE, HASH, EXTRACT, CONVERT, RENAMEPATTERN}.
 	// This is a required field.
 	Actions []ActionKeyValue `mapstructure:"actions"`
 }
@@ -57,6 +57,11 @@ type ActionKeyValue struct {
 	// If the value cannot be converted, the original value will be left as-is
 	ConvertedType string `mapstructure:"converted_type"`
 
+	// Replacement specifies the new key of the attributes renamed by the action
+	// RENAMEPATTERN. It is expanded like regexp.ReplaceAllString, so it can refer
+	// to the submatches of the pattern, e.g. `$1` or `${name}`.
+	Replacement string `mapstructure:"replacement"`
+
 	// Action specifies the type of action to perform.
 	// The set of values are {INSERT, UPDATE, UPSERT, DELETE, HASH}.
 	// Both lower case and upper case are supported.
@@ -81,6 +86,9 @@ type ActionKeyValue struct {
 	//           'key' to target keys specified in the 'rule'. If a target key
 	//           already exists, it will be overridden.
 	// CONVERT  - converts the type of an existing attribute, if convertable
+	// RENAMEPATTERN - renames the attributes whose key matches the pattern,
+	//           replacing the matches with Replacement. If an attribute already
+	//           exists with the new key, it will be overridden.
 	// This is a required field.
 	Action Action `mapstructure:"action"`
 }
@@ -135,6 +143,11 @@ const (
 
 	// CONVERT converts the type of an existing attribute, if convertable
 	CONVERT Action = "convert"
+
+	// RENAMEPATTERN renames the attributes whose key matches the pattern, replacing
+	// the matches with the replacement. If an attribute already exists with the new
+	// key, it will be overridden.
+	RENAMEPATTERN Action = "rename_pattern"
 )
 
 type attributeAction struct {
@@ -142,6 +155,7 @@ type attributeAction struct {
 	FromAttribute string
 	FromContext   string
 	ConvertedType string
+	Replacement   string
 	// Compiled regex if provided
 	Regex *regexp.Regexp
 	// Attribute names extracted from the regexp's subexpressions.
@@ -171,6 +185,8 @@ func NewAttrProc(settings *Settings) (*AttrProc, error) {
 		a.Action = Action(strings.ToLower(string(a.Action)))
 
 		switch a.Action {
+		case RENAMEPATTERN:
+			// `pattern` is a required field, which is validated with the other fields of the action
 		case DELETE, HASH:
 			// requires `key` and/or `pattern`
 			if a.Key == "" && a.RegexPattern == "" {
@@ -272,6 +288,19 @@ func NewAttrProc(settings *Settings) (*AttrProc, error) {
 				return nil, fmt.Errorf("error creating AttrProc due to invalid value \"%s\" in field \"converted_type\" for action \"%s\" at the %d-th action", a.ConvertedType, a.Action, i)
 			}
 			action.ConvertedType = a.ConvertedType
+		case RENAMEPATTERN:
+			if a.Key != "" || valueSourceCount > 0 || a.ConvertedType != "" {
+				return nil, fmt.Errorf("error creating AttrProc. Action \"%s\" only uses the \"pattern\" and \"replacement\" fields. Other fields must not be specified for %d-th action", a.Action, i)
+			}
+			if a.RegexPattern == "" {
+				return nil, fmt.Errorf("error creating AttrProc due to missing required field \"pattern\" for action \"%s\" at the %d-th action", a.Action, i)
+			}
+			re, err := regexp.Compile(a.RegexPattern)
+			if err != nil {
+				return nil, fmt.Errorf("error creating AttrProc. Field \"pattern\" has invalid pattern: \"%s\" to be set at the %d-th actions", a.RegexPattern, i)
+			}
+			action.Regex = re
+			action.Replacement = a.Replacement
 		default:
 			return nil, fmt.Errorf("error creating AttrProc due to unsupported action %q at the %d-th actions", a.Action, i)
 		}
@@ -335,6 +364,8 @@ func (ap *AttrProc) Process(ctx context.Context, logger *zap.Logger, attrs pcomm
 			extractAttributes(action, attrs)
 		case CONVERT:
 			convertAttribute(logger, action, attrs)
+		case RENAMEPATTERN:
+			renameAttributes(action, attrs)
 		}
 	}
 }
@@ -428,6 +459,32 @@ func extractAttributes(action attributeAction, attrs pcommon.Map) {
 	}
 }
 
+func renameAttributes(action attributeAction, attrs pcommon.Map) {
+	type renamed struct {
+		key   string
+		value pcommon.Value
+	}
+
+	// All the attributes are removed before any is added back with its new key,
+	// so an attribute whose new key is the old key of another one is not lost.
+	var renames []renamed
+	for _, k := range getMatchingKeys(action.Regex, attrs) {
+		newKey := action.Regex.ReplaceAllString(k, action.Replacement)
+		if newKey == k || newKey == "" {
+			continue
+		}
+		value, _ := attrs.Get(k)
+		r := renamed{key: newKey, value: pcommon.NewValueEmpty()}
+		value.CopyTo(r.value)
+		renames = append(renames, r)
+		attrs.Remove(k)
+	}
+
+	for _, r := range renames {
+		r.value.CopyTo(attrs.PutEmpty(r.key))
+	}
+}
+
 func getMatchingKeys(regexp *regexp.Regexp, attrs pcommon.Map) []string {
 	var keys []string
 
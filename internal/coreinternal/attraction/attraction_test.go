@@ -586,6 +586,90 @@ func TestAttributes_Delete_Regexp(t *testing.T) {
 	}
 }
 
+func TestAttributes_RenamePattern(t *testing.T) {
+	testCases := []testCase{
+		// Ensure the span contains no changes.
+		{
+			name:               "RenameEmptyAttributes",
+			inputAttributes:    map[string]any{},
+			expectedAttributes: map[string]any{},
+		},
+		// Ensure the attributes not matching the pattern are not renamed.
+		{
+			name: "RenameAttributeNoMatch",
+			inputAttributes: map[string]any{
+				"http.method": "GET",
+			},
+			expectedAttributes: map[string]any{
+				"http.method": "GET",
+			},
+		},
+		// Ensure the legacy prefix is stripped from all the matching keys.
+		{
+			name: "RenameAttributesMatch",
+			inputAttributes: map[string]any{
+				"legacy.http.method":      "GET",
+				"legacy.http.status_code": int64(200),
+				"service.name":            "svc",
+			},
+			expectedAttributes: map[string]any{
+				"http.method":      "GET",
+				"http.status_code": int64(200),
+				"service.name":     "svc",
+			},
+		},
+		// Ensure an existing attribute with the new key is overridden.
+		{
+			name: "RenameAttributeOverride",
+			inputAttributes: map[string]any{
+				"legacy.http.method": "POST",
+				"http.method":        "GET",
+			},
+			expectedAttributes: map[string]any{
+				"http.method": "POST",
+			},
+		},
+	}
+
+	cfg := &Settings{
+		Actions: []ActionKeyValue{
+			{RegexPattern: "^legacy\\.(.*)$", Replacement: "$1", Action: RENAMEPATTERN},
+		},
+	}
+
+	ap, err := NewAttrProc(cfg)
+	require.NoError(t, err)
+	require.NotNil(t, ap)
+
+	for _, tt := range testCases {
+		runIndividualTestCase(t, tt, ap)
+	}
+}
+
+func TestAttributes_RenamePatternSwap(t *testing.T) {
+	// keys renamed to the old key of another renamed attribute must not be lost
+	cfg := &Settings{
+		Actions: []ActionKeyValue{
+			{RegexPattern: "^(a|b)\\.(.*)$", Replacement: "${2}.${1}", Action: RENAMEPATTERN},
+		},
+	}
+
+	ap, err := NewAttrProc(cfg)
+	require.NoError(t, err)
+
+	runIndividualTestCase(t, testCase{
+		name: "RenameAttributesSwap",
+		inputAttributes: map[string]any{
+			"a.b": "first",
+			"b.a": "second",
+		},
+		expectedAttributes: map[string]any{
+			"b.a": "first",
+			"a.b": "second",
+		},
+	}, ap)
+}
+
 func TestAttributes_HashValue(t *testing.T) {
 	intVal := int64(24)
 	intBytes := make([]byte, int64ByteSize)
@@ -890,6 +974,27 @@ func TestInvalidConfig(t *testing.T) {
 			},
 			errorString: "error creating AttrProc. Field \"pattern\" contains at least one unnamed matcher group at the 0-th actions",
 		},
+		{
+			name: "missing pattern for rename_pattern",
+			actionLists: []ActionKeyValue{
+				{Replacement: "$1", Action: RENAMEPATTERN},
+			},
+			errorString: "error creating AttrProc due to missing required field \"pattern\" for action \"rename_pattern\" at the 0-th action",
+		},
+		{
+			name: "set key for rename_pattern",
+			actionLists: []ActionKeyValue{
+				{Key: "aa", RegexPattern: "^legacy\\.(.*)$", Action: RENAMEPATTERN},
+			},
+			errorString: "error creating AttrProc. Action \"rename_pattern\" only uses the \"pattern\" and \"replacement\" fields. Other fields must not be specified for 0-th action",
+		},
+		{
+			name: "invalid regex for rename_pattern",
+			actionLists: []ActionKeyValue{
+				{RegexPattern: "(legacy", Action: RENAMEPATTERN},
+			},
+			errorString: "error creating AttrProc. Field \"pattern\" has invalid pattern: \"(legacy\" to be set at the 0-th actions",
+		},
 	}
 
 	for _, tc := range testcase {
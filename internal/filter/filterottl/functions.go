@@ -6,6 +6,7 @@ package filterottl // import "github.com/open-telemetry/opentelemetry-collector-
 import (
 	"context"
 	"fmt"
+	"math"
 
 	"go.opentelemetry.io/collector/pdata/pmetric"
 
@@ -17,6 +18,7 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlscope"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspan"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspanevent"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspanlink"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
 )
 
@@ -28,6 +30,10 @@ func StandardSpanEventFuncs() map[string]ottl.Factory[ottlspanevent.TransformCon
 	return ottlfuncs.StandardConverters[ottlspanevent.TransformContext]()
 }
 
+func StandardSpanLinkFuncs() map[string]ottl.Factory[ottlspanlink.TransformContext] {
+	return ottlfuncs.StandardConverters[ottlspanlink.TransformContext]()
+}
+
 func StandardMetricFuncs() map[string]ottl.Factory[ottlmetric.TransformContext] {
 	m := ottlfuncs.StandardConverters[ottlmetric.TransformContext]()
 	hasAttributeOnDatapointFactory := newHasAttributeOnDatapointFactory()
@@ -38,7 +44,10 @@ func StandardMetricFuncs() map[string]ottl.Factory[ottlmetric.TransformContext]
 }
 
 func StandardDataPointFuncs() map[string]ottl.Factory[ottldatapoint.TransformContext] {
-	return ottlfuncs.StandardConverters[ottldatapoint.TransformContext]()
+	m := ottlfuncs.StandardConverters[ottldatapoint.TransformContext]()
+	isNaNFactory := newIsNaNFactory[ottldatapoint.TransformContext]()
+	m[isNaNFactory.Name()] = isNaNFactory
+	return m
 }
 
 func StandardScopeFuncs() map[string]ottl.Factory[ottlscope.TransformContext] {
@@ -175,3 +184,33 @@ func checkSummaryDataPointSlice(dps pmetric.SummaryDataPointSlice, key string, e
 	}
 	return false
 }
+
+type isNaNArguments[K any] struct {
+	Target ottl.Getter[K]
+}
+
+func newIsNaNFactory[K any]() ottl.Factory[K] {
+	return ottl.NewFactory("IsNaN", &isNaNArguments[K]{}, createIsNaNFunction[K])
+}
+
+func createIsNaNFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	args, ok := oArgs.(*isNaNArguments[K])
+
+	if !ok {
+		return nil, fmt.Errorf("isNaNFactory args must be of type *isNaNArguments[K]")
+	}
+
+	return isNaN(args.Target), nil
+}
+
+// isNaN returns true if the value is a NaN double, which cannot be matched with a comparison
+func isNaN[K any](target ottl.Getter[K]) ottl.ExprFunc[K] {
+	return func(ctx context.Context, tCtx K) (any, error) {
+		val, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		f, ok := val.(float64)
+		return ok && math.IsNaN(f), nil
+	}
+}
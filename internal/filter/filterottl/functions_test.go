@@ -5,12 +5,14 @@ package filterottl
 
 import (
 	"context"
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlmetric"
 )
 
@@ -357,3 +359,49 @@ func Test_HasAttrOnDatapoint(t *testing.T) {
 		})
 	}
 }
+
+func Test_IsNaN(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    any
+		expected bool
+	}{
+		{
+			name:     "NaN double",
+			value:    math.NaN(),
+			expected: true,
+		},
+		{
+			name:     "double",
+			value:    1.5,
+			expected: false,
+		},
+		{
+			name:     "infinite double",
+			value:    math.Inf(1),
+			expected: false,
+		},
+		{
+			name:     "int",
+			value:    int64(1),
+			expected: false,
+		},
+		{
+			name:     "nil",
+			value:    nil,
+			expected: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := ottl.StandardGetSetter[any]{
+				Getter: func(context.Context, any) (any, error) {
+					return tt.value, nil
+				},
+			}
+			result, err := isNaN[any](target)(context.Background(), nil)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
@@ -0,0 +1,113 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mqtt // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/mqtt"
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net/url"
+	"time"
+
+	"go.opentelemetry.io/collector/config/configopaque"
+	"go.opentelemetry.io/collector/config/configtls"
+)
+
+const (
+	defaultKeepAlive      = 30 * time.Second
+	defaultConnectTimeout = 10 * time.Second
+)
+
+// schemes are the supported schemes of the endpoints, by whether they use TLS.
+var schemes = map[string]bool{
+	"mqtt":  false,
+	"tcp":   false,
+	"ws":    false,
+	"mqtts": true,
+	"ssl":   true,
+	"tls":   true,
+	"wss":   true,
+}
+
+// ClientConfig defines the connection to an MQTT broker, with the MQTT v5 protocol.
+type ClientConfig struct {
+	// Endpoint is the URL of the broker, e.g. tcp://localhost:1883, mqtts://broker:8883 or
+	// wss://broker/mqtt.
+	Endpoint string `mapstructure:"endpoint"`
+
+	// ClientID identifies the client to the broker, which assigns one when it is empty.
+	ClientID string `mapstructure:"client_id"`
+
+	// Auth defines the credentials of the client.
+	Auth AuthConfig `mapstructure:"auth"`
+
+	// TLSSetting defines the TLS connection of the mqtts, ssl, tls and wss endpoints, e.g. the
+	// client certificate.
+	TLSSetting configtls.ClientConfig `mapstructure:"tls"`
+
+	// KeepAlive is the maximum interval between the packets sent to the broker.
+	KeepAlive time.Duration `mapstructure:"keep_alive"`
+
+	// ConnectTimeout is the timeout of a connection attempt.
+	ConnectTimeout time.Duration `mapstructure:"connect_timeout"`
+
+	// SessionExpiryInterval is how long the broker keeps the session of the client once it
+	// disconnects, e.g. its subscriptions and the messages they receive meanwhile. The default
+	// is a new session on every connection.
+	SessionExpiryInterval time.Duration `mapstructure:"session_expiry_interval"`
+}
+
+// AuthConfig defines the credentials of the client.
+type AuthConfig struct {
+	Username string              `mapstructure:"username"`
+	Password configopaque.String `mapstructure:"password"`
+}
+
+// NewDefaultClientConfig returns the default connection to a local broker.
+func NewDefaultClientConfig() ClientConfig {
+	return ClientConfig{
+		Endpoint:       "tcp://localhost:1883",
+		KeepAlive:      defaultKeepAlive,
+		ConnectTimeout: defaultConnectTimeout,
+	}
+}
+
+// Validate checks if the connection configuration is valid.
+func (cfg *ClientConfig) Validate() error {
+	var errs []error
+	if u, err := url.Parse(cfg.Endpoint); err != nil || u.Host == "" {
+		errs = append(errs, fmt.Errorf("invalid 'endpoint' '%s', must be a URL such as tcp://localhost:1883", cfg.Endpoint))
+	} else if _, ok := schemes[u.Scheme]; !ok {
+		errs = append(errs, fmt.Errorf("unsupported scheme '%s' of the 'endpoint', must be one of 'mqtt', 'tcp', 'ws', 'mqtts', 'ssl', 'tls' and 'wss'", u.Scheme))
+	}
+	if cfg.KeepAlive < 0 || cfg.KeepAlive > math.MaxUint16*time.Second {
+		errs = append(errs, fmt.Errorf("'keep_alive' must be between 0s and %s", math.MaxUint16*time.Second))
+	}
+	if cfg.ConnectTimeout <= 0 {
+		errs = append(errs, errors.New("'connect_timeout' must be positive"))
+	}
+	if cfg.SessionExpiryInterval < 0 || cfg.SessionExpiryInterval > math.MaxUint32*time.Second {
+		errs = append(errs, fmt.Errorf("'session_expiry_interval' must be between 0s and %s", math.MaxUint32*time.Second))
+	}
+	if cfg.SessionExpiryInterval > 0 && cfg.ClientID == "" {
+		// the session is resumed by the client ID
+		errs = append(errs, errors.New("'session_expiry_interval' requires 'client_id'"))
+	}
+	return errors.Join(errs...)
+}
+
+// usesTLS returns whether the scheme of the endpoint uses TLS.
+func (cfg *ClientConfig) usesTLS() bool {
+	u, err := url.Parse(cfg.Endpoint)
+	return err == nil && schemes[u.Scheme]
+}
+
+// ValidateQoS checks if the QoS of the setting is one of the MQTT QoS levels: 0 (at most once),
+// 1 (at least once) and 2 (exactly once).
+func ValidateQoS(setting string, qos int) error {
+	if qos < 0 || qos > 2 {
+		return fmt.Errorf("unsupported '%s' %d, must be 0, 1 or 2", setting, qos)
+	}
+	return nil
+}
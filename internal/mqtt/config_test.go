@@ -0,0 +1,45 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mqtt
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientConfig_Validate(t *testing.T) {
+	cfg := NewDefaultClientConfig()
+	require.NoError(t, cfg.Validate())
+	assert.False(t, cfg.usesTLS())
+
+	for _, endpoint := range []string{"mqtt://broker:1883", "mqtts://broker:8883", "ssl://broker:8883", "wss://broker/mqtt"} {
+		cfg.Endpoint = endpoint
+		assert.NoError(t, cfg.Validate(), endpoint)
+	}
+	assert.True(t, cfg.usesTLS())
+
+	invalid := ClientConfig{
+		Endpoint:              "http://broker:1883",
+		KeepAlive:             24 * time.Hour,
+		SessionExpiryInterval: time.Hour,
+	}
+	assert.EqualError(t, invalid.Validate(), "unsupported scheme 'http' of the 'endpoint', must be one of 'mqtt', 'tcp', 'ws', 'mqtts', 'ssl', 'tls' and 'wss'\n"+
+		"'keep_alive' must be between 0s and 18h12m15s\n"+
+		"'connect_timeout' must be positive\n"+
+		"'session_expiry_interval' requires 'client_id'")
+
+	invalid = NewDefaultClientConfig()
+	invalid.Endpoint = "localhost:1883"
+	assert.EqualError(t, invalid.Validate(), "invalid 'endpoint' 'localhost:1883', must be a URL such as tcp://localhost:1883")
+}
+
+func TestValidateQoS(t *testing.T) {
+	for qos := 0; qos <= 2; qos++ {
+		assert.NoError(t, ValidateQoS("qos", qos))
+	}
+	assert.EqualError(t, ValidateQoS("logs.qos", 3), "unsupported 'logs.qos' 3, must be 0, 1 or 2")
+}
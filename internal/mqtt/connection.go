@@ -0,0 +1,73 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mqtt // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/mqtt"
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/eclipse/paho.golang/autopaho"
+	"github.com/eclipse/paho.golang/paho"
+	"go.uber.org/zap"
+)
+
+// NewConnection connects to the broker in the background, reconnecting whenever the connection is
+// lost until it is disconnected. onConnectionUp is called on every connection, e.g. to subscribe
+// again, and onPublishReceived on every message received by the subscriptions, both being
+// optional.
+func NewConnection(
+	ctx context.Context,
+	cfg ClientConfig,
+	logger *zap.Logger,
+	onConnectionUp func(*autopaho.ConnectionManager),
+	onPublishReceived func(paho.PublishReceived) (bool, error),
+) (*autopaho.ConnectionManager, error) {
+	serverURL, err := url.Parse(cfg.Endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the endpoint: %w", err)
+	}
+	pahoCfg := autopaho.ClientConfig{
+		ServerUrls:                    []*url.URL{serverURL},
+		KeepAlive:                     uint16(cfg.KeepAlive / time.Second),
+		CleanStartOnInitialConnection: cfg.SessionExpiryInterval == 0,
+		SessionExpiryInterval:         uint32(cfg.SessionExpiryInterval / time.Second),
+		ConnectTimeout:                cfg.ConnectTimeout,
+		ConnectUsername:               cfg.Auth.Username,
+		OnConnectionUp: func(cm *autopaho.ConnectionManager, _ *paho.Connack) {
+			logger.Info("Connected to the MQTT broker", zap.String("endpoint", cfg.Endpoint))
+			if onConnectionUp != nil {
+				onConnectionUp(cm)
+			}
+		},
+		OnConnectError: func(err error) {
+			logger.Warn("Failed to connect to the MQTT broker", zap.String("endpoint", cfg.Endpoint), zap.Error(err))
+		},
+		ClientConfig: paho.ClientConfig{
+			ClientID: cfg.ClientID,
+			OnClientError: func(err error) {
+				logger.Warn("MQTT client error", zap.Error(err))
+			},
+			OnServerDisconnect: func(d *paho.Disconnect) {
+				logger.Warn("Disconnected by the MQTT broker", zap.Uint8("reason_code", d.ReasonCode))
+			},
+		},
+	}
+	if cfg.Auth.Password != "" {
+		// the password flag is set by a non-nil password
+		pahoCfg.ConnectPassword = []byte(cfg.Auth.Password)
+	}
+	if cfg.usesTLS() {
+		if pahoCfg.TlsCfg, err = cfg.TLSSetting.LoadTLSConfig(ctx); err != nil {
+			return nil, fmt.Errorf("failed to load the TLS config: %w", err)
+		}
+	}
+	if onPublishReceived != nil {
+		pahoCfg.OnPublishReceived = []func(paho.PublishReceived) (bool, error){onPublishReceived}
+	}
+	// the connection outlives the context of the start of the component, it is stopped by
+	// disconnecting it
+	return autopaho.NewConnection(context.Background(), pahoCfg)
+}
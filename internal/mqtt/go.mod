@@ -0,0 +1,23 @@
+module github.com/open-telemetry/opentelemetry-collector-contrib/internal/mqtt
+
+go 1.21.0
+
+require (
+	github.com/eclipse/paho.golang v0.21.0
+	github.com/stretchr/testify v1.9.0
+	go.opentelemetry.io/collector/config/configopaque v1.7.1-0.20240509190532-c555005fcc80
+	go.opentelemetry.io/collector/config/configtls v0.100.1-0.20240509190532-c555005fcc80
+	go.uber.org/goleak v1.3.0
+	go.uber.org/zap v1.27.0
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.2-0.20180830191138-d8f796af33cc // indirect
+	github.com/fsnotify/fsnotify v1.7.0 // indirect
+	github.com/gorilla/websocket v1.5.1 // indirect
+	github.com/pmezard/go-difflib v1.0.1-0.20181226105442-5d4384ee4fb2 // indirect
+	go.uber.org/multierr v1.11.0 // indirect
+	golang.org/x/net v0.24.0 // indirect
+	golang.org/x/sys v0.19.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
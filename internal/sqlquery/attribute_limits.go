@@ -0,0 +1,128 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlquery // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/sqlquery"
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+)
+
+// DefaultOverflowValue replaces the values of the attribute columns which are not allowed.
+const DefaultOverflowValue = "_other"
+
+// AttributeLimitCfg caps the cardinality of an attribute column, the values not included,
+// excluded, or over the maximum number of distinct values being replaced by the overflow value.
+type AttributeLimitCfg struct {
+	Column            string   `mapstructure:"column"`
+	Include           []string `mapstructure:"include"`
+	Exclude           []string `mapstructure:"exclude"`
+	MaxDistinctValues int      `mapstructure:"max_distinct_values"`
+	OverflowValue     string   `mapstructure:"overflow_value"`
+}
+
+func (c AttributeLimitCfg) Validate() error {
+	var errs []error
+	if c.Column == "" {
+		errs = append(errs, errors.New("'attribute_limits.column' cannot be empty"))
+	}
+	for _, pattern := range append(append([]string{}, c.Include...), c.Exclude...) {
+		if _, err := regexp.Compile(pattern); err != nil {
+			errs = append(errs, fmt.Errorf("attribute_limits: invalid pattern for column '%s': %w", c.Column, err))
+		}
+	}
+	if c.MaxDistinctValues < 0 {
+		errs = append(errs, fmt.Errorf("attribute_limits: 'max_distinct_values' for column '%s' cannot be negative", c.Column))
+	}
+	return errors.Join(errs...)
+}
+
+// attributeLimiter replaces the values of the limited columns of the rows. The distinct
+// values are counted over the lifetime of the scraper.
+type attributeLimiter struct {
+	limits []*columnLimit
+}
+
+type columnLimit struct {
+	cfg      AttributeLimitCfg
+	include  []*regexp.Regexp
+	exclude  []*regexp.Regexp
+	distinct map[string]struct{}
+}
+
+func newAttributeLimiter(cfgs []AttributeLimitCfg) *attributeLimiter {
+	if len(cfgs) == 0 {
+		return nil
+	}
+	limiter := &attributeLimiter{}
+	for _, cfg := range cfgs {
+		limit := &columnLimit{cfg: cfg, distinct: map[string]struct{}{}}
+		if limit.cfg.OverflowValue == "" {
+			limit.cfg.OverflowValue = DefaultOverflowValue
+		}
+		// the patterns are checked by the validation of the configuration
+		for _, pattern := range cfg.Include {
+			limit.include = append(limit.include, regexp.MustCompile(pattern))
+		}
+		for _, pattern := range cfg.Exclude {
+			limit.exclude = append(limit.exclude, regexp.MustCompile(pattern))
+		}
+		limiter.limits = append(limiter.limits, limit)
+	}
+	return limiter
+}
+
+// apply returns the row with the values of the limited columns replaced by their overflow
+// value when they are not allowed. The row is copied when modified.
+func (l *attributeLimiter) apply(row StringMap) StringMap {
+	if l == nil {
+		return row
+	}
+	out := row
+	copied := false
+	for _, limit := range l.limits {
+		value, found := row[limit.cfg.Column]
+		if !found || limit.allows(value) {
+			continue
+		}
+		if !copied {
+			out = make(StringMap, len(row))
+			for k, v := range row {
+				out[k] = v
+			}
+			copied = true
+		}
+		out[limit.cfg.Column] = limit.cfg.OverflowValue
+	}
+	return out
+}
+
+func (c *columnLimit) allows(value string) bool {
+	if len(c.include) > 0 && !matchesAny(c.include, value) {
+		return false
+	}
+	if matchesAny(c.exclude, value) {
+		return false
+	}
+	if c.cfg.MaxDistinctValues == 0 {
+		return true
+	}
+	if _, seen := c.distinct[value]; seen {
+		return true
+	}
+	if len(c.distinct) >= c.cfg.MaxDistinctValues {
+		return false
+	}
+	c.distinct[value] = struct{}{}
+	return true
+}
+
+func matchesAny(patterns []*regexp.Regexp, value string) bool {
+	for _, pattern := range patterns {
+		if pattern.MatchString(value) {
+			return true
+		}
+	}
+	return false
+}
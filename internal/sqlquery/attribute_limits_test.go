@@ -0,0 +1,59 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlquery
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAttributeLimitCfg_Validate(t *testing.T) {
+	require.NoError(t, AttributeLimitCfg{Column: "host", Include: []string{"^db"}, MaxDistinctValues: 10}.Validate())
+	assert.EqualError(t, AttributeLimitCfg{}.Validate(), "'attribute_limits.column' cannot be empty")
+	assert.ErrorContains(t, AttributeLimitCfg{Column: "host", Exclude: []string{"("}}.Validate(),
+		"attribute_limits: invalid pattern for column 'host'")
+	assert.EqualError(t, AttributeLimitCfg{Column: "host", MaxDistinctValues: -1}.Validate(),
+		"attribute_limits: 'max_distinct_values' for column 'host' cannot be negative")
+}
+
+func TestAttributeLimiter(t *testing.T) {
+	limiter := newAttributeLimiter([]AttributeLimitCfg{
+		{Column: "host", Include: []string{"^db"}, Exclude: []string{"-test$"}},
+		{Column: "user", MaxDistinctValues: 2, OverflowValue: "others"},
+	})
+
+	tests := []struct {
+		row  StringMap
+		want StringMap
+	}{
+		{row: StringMap{"host": "db1", "user": "alice"}, want: StringMap{"host": "db1", "user": "alice"}},
+		{row: StringMap{"host": "web1", "user": "bob"}, want: StringMap{"host": DefaultOverflowValue, "user": "bob"}},
+		{row: StringMap{"host": "db1-test", "user": "carol"}, want: StringMap{"host": DefaultOverflowValue, "user": "others"}},
+		{row: StringMap{"host": "db2", "user": "alice"}, want: StringMap{"host": "db2", "user": "alice"}},
+		{row: StringMap{"value": "1"}, want: StringMap{"value": "1"}},
+	}
+	for _, tt := range tests {
+		row := copyStringMap(tt.row)
+		assert.Equal(t, tt.want, limiter.apply(row))
+		// the rows returned by the client are not modified
+		assert.Equal(t, tt.row, row)
+	}
+}
+
+func TestAttributeLimiter_Nil(t *testing.T) {
+	limiter := newAttributeLimiter(nil)
+	assert.Nil(t, limiter)
+	row := StringMap{"host": "db1"}
+	assert.Equal(t, row, limiter.apply(row))
+}
+
+func copyStringMap(m StringMap) StringMap {
+	out := make(StringMap, len(m))
+	for k, v := range m {
+		out[k] = v
+	}
+	return out
+}
@@ -0,0 +1,69 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlquery // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/sqlquery"
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// templatePart is either a literal text or a reference to a column of a body template.
+type templatePart struct {
+	text   string
+	column string
+}
+
+// parseBodyTemplate splits the template into its literal texts and its `${column}` references.
+func parseBodyTemplate(template string) ([]templatePart, error) {
+	var parts []templatePart
+	for template != "" {
+		start := strings.Index(template, "${")
+		if start < 0 {
+			parts = append(parts, templatePart{text: template})
+			break
+		}
+		if start > 0 {
+			parts = append(parts, templatePart{text: template[:start]})
+		}
+		end := strings.IndexByte(template[start:], '}')
+		if end < 0 {
+			return nil, fmt.Errorf("unterminated reference '%s'", template[start:])
+		}
+		column := strings.TrimSpace(template[start+2 : start+end])
+		if column == "" {
+			return nil, errors.New("empty column reference")
+		}
+		parts = append(parts, templatePart{column: column})
+		template = template[start+end+1:]
+	}
+	return parts, nil
+}
+
+// BodyTemplateColumns returns the columns referenced by the template.
+func BodyTemplateColumns(template string) []string {
+	parts, _ := parseBodyTemplate(template)
+	var columns []string
+	for _, part := range parts {
+		if part.column != "" {
+			columns = append(columns, part.column)
+		}
+	}
+	return columns
+}
+
+// ExecuteBodyTemplate returns the template with its references replaced by the values of the
+// columns of the row, the columns missing from the row being replaced by empty strings.
+func ExecuteBodyTemplate(template string, row StringMap) string {
+	parts, _ := parseBodyTemplate(template)
+	var body strings.Builder
+	for _, part := range parts {
+		if part.column != "" {
+			body.WriteString(row[part.column])
+		} else {
+			body.WriteString(part.text)
+		}
+	}
+	return body.String()
+}
@@ -0,0 +1,58 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlquery
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseBodyTemplate(t *testing.T) {
+	tests := []struct {
+		template string
+		expected []templatePart
+		errMsg   string
+	}{
+		{
+			template: "${user} did ${ action } on ${object}",
+			expected: []templatePart{
+				{column: "user"}, {text: " did "}, {column: "action"}, {text: " on "}, {column: "object"},
+			},
+		},
+		{
+			template: "costs $5",
+			expected: []templatePart{{text: "costs $5"}},
+		},
+		{
+			template: "user ${user",
+			errMsg:   "unterminated reference '${user'",
+		},
+		{
+			template: "user ${}",
+			errMsg:   "empty column reference",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.template, func(t *testing.T) {
+			parts, err := parseBodyTemplate(tt.template)
+			if tt.errMsg != "" {
+				assert.EqualError(t, err, tt.errMsg)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, parts)
+		})
+	}
+}
+
+func TestExecuteBodyTemplate(t *testing.T) {
+	template := "${user} did ${action} on ${object}"
+	assert.Equal(t, []string{"user", "action", "object"}, BodyTemplateColumns(template))
+	assert.Equal(t, "alice did DELETE on orders",
+		ExecuteBodyTemplate(template, StringMap{"user": "alice", "action": "DELETE", "object": "orders"}))
+	assert.Equal(t, "alice did  on orders",
+		ExecuteBodyTemplate(template, StringMap{"user": "alice", "object": "orders"}))
+	assert.Empty(t, BodyTemplateColumns(""))
+}
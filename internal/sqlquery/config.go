@@ -18,6 +18,14 @@ type Config struct {
 	Queries                        []Query         `mapstructure:"queries"`
 	StorageID                      *component.ID   `mapstructure:"storage"`
 	Telemetry                      TelemetryConfig `mapstructure:"telemetry"`
+	// PreparedStatements prepares the queries once and reuses the statements on the following
+	// executions, instead of sending the text of the queries on every collection interval.
+	PreparedStatements bool `mapstructure:"prepared_statements"`
+	// ReplicaLag skips the incremental queries while the replica is lagging behind.
+	ReplicaLag ReplicaLagCfg `mapstructure:"replica_lag"`
+	// TrustedConnection authenticates to SQL Server with the Windows credentials of the
+	// collector (SSPI), so that no SQL login is needed.
+	TrustedConnection bool `mapstructure:"trusted_connection"`
 }
 
 func (c Config) Validate() error {
@@ -30,55 +38,268 @@ func (c Config) Validate() error {
 	if len(c.Queries) == 0 {
 		return errors.New("'queries' cannot be empty")
 	}
+	if err := c.validateTrustedConnection(); err != nil {
+		return err
+	}
 	for _, query := range c.Queries {
 		if err := query.Validate(); err != nil {
 			return err
 		}
 	}
-	return nil
+	return c.ReplicaLag.Validate()
 }
 
 type Query struct {
 	SQL                string      `mapstructure:"sql"`
 	Metrics            []MetricCfg `mapstructure:"metrics"`
 	Logs               []LogsCfg   `mapstructure:"logs"`
+	Traces             []TracesCfg `mapstructure:"traces"`
 	TrackingColumn     string      `mapstructure:"tracking_column"`
 	TrackingStartValue string      `mapstructure:"tracking_start_value"`
+	// AttributeLimits caps the cardinality of the attribute columns of the metrics.
+	AttributeLimits []AttributeLimitCfg `mapstructure:"attribute_limits"`
+	// Table generates the query, its tracking and its logs to tail a table, instead of
+	// configuring them explicitly.
+	Table *TableCfg `mapstructure:"table"`
+	// ResourceAttributeColumns are the columns set as the attributes of the resources, the rows
+	// being grouped in a resource per distinct values of the columns.
+	ResourceAttributeColumns []string `mapstructure:"resource_attribute_columns"`
+	// Scope is the instrumentation scope of the metrics and the logs of the query, to tell
+	// apart the telemetry of the queries downstream.
+	Scope ScopeCfg `mapstructure:"scope"`
+	// Dedup suppresses the rows already emitted as logs by the previous collections.
+	Dedup *DedupCfg `mapstructure:"dedup"`
+	// StalenessMarkers emits a data point flagged with no recorded value for each series of the
+	// previous collection missing from the result set.
+	StalenessMarkers bool `mapstructure:"staleness_markers"`
 }
 
 func (q Query) Validate() error {
+	if q.Table != nil {
+		return errors.Join(q.validateTable(), q.Scope.Validate())
+	}
 	var errs []error
 	if q.SQL == "" {
 		errs = append(errs, errors.New("'query.sql' cannot be empty"))
 	}
-	if len(q.Logs) == 0 && len(q.Metrics) == 0 {
-		errs = append(errs, errors.New("at least one of 'query.logs' and 'query.metrics' must not be empty"))
+	if len(q.Logs) == 0 && len(q.Metrics) == 0 && len(q.Traces) == 0 {
+		errs = append(errs, errors.New("at least one of 'query.logs', 'query.metrics' and 'query.traces' must not be empty"))
 	}
 	for _, logs := range q.Logs {
 		if err := logs.Validate(); err != nil {
 			errs = append(errs, err)
 		}
 	}
+	for _, traces := range q.Traces {
+		if err := traces.Validate(); err != nil {
+			errs = append(errs, err)
+		}
+	}
 	for _, metric := range q.Metrics {
 		if err := metric.Validate(); err != nil {
 			errs = append(errs, err)
 		}
 	}
+	for _, limit := range q.AttributeLimits {
+		if err := limit.Validate(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if err := q.Scope.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+	if q.Dedup != nil {
+		if len(q.Logs) == 0 {
+			errs = append(errs, errors.New("'dedup' applies only to logs, 'query.logs' cannot be empty"))
+		}
+		if err := q.Dedup.Validate(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if q.StalenessMarkers && len(q.Metrics) == 0 {
+		errs = append(errs, errors.New("'staleness_markers' applies only to metrics, 'query.metrics' cannot be empty"))
+	}
+	return errors.Join(errs...)
+}
+
+func (q Query) validateTable() error {
+	var errs []error
+	if q.SQL != "" || len(q.Logs) != 0 || len(q.Metrics) != 0 || len(q.Traces) != 0 || q.TrackingColumn != "" || q.TrackingStartValue != "" {
+		errs = append(errs, errors.New("'query.table' cannot be combined with 'sql', 'logs', 'metrics', 'traces', 'tracking_column' or 'tracking_start_value'"))
+	}
+	if len(q.ResourceAttributeColumns) != 0 {
+		errs = append(errs, errors.New("'query.table' cannot be combined with 'resource_attribute_columns'"))
+	}
+	if q.Dedup != nil {
+		errs = append(errs, errors.New("'query.table' cannot be combined with 'dedup'"))
+	}
+	if q.StalenessMarkers {
+		errs = append(errs, errors.New("'query.table' cannot be combined with 'staleness_markers'"))
+	}
+	if err := q.Table.Validate(); err != nil {
+		errs = append(errs, err)
+	}
 	return errors.Join(errs...)
 }
 
 type LogsCfg struct {
 	BodyColumn string `mapstructure:"body_column"`
+	// BodyTemplate builds the body from the columns of the row, referenced as `${column}`,
+	// instead of taking the value of the body column.
+	BodyTemplate string `mapstructure:"body_template"`
+	// BodyType is the type of the body of the log records: `string` for the value of the body
+	// column, or `map` for all the columns of the row. `string` by default.
+	BodyType BodyType `mapstructure:"body_type"`
+	// ParseBodyAs parses the value of the body column, e.g. `json` for a structured body
+	// holding the JSON object or array of the column.
+	ParseBodyAs BodyParser `mapstructure:"parse_body_as"`
+	// AttributeColumns are the columns set as attributes of the log record.
+	AttributeColumns []string `mapstructure:"attribute_columns"`
+	// ExpandColumns are the columns containing an array or a JSON object, whose elements are
+	// set as the attributes `<column>.<index or key>` of the log record.
+	ExpandColumns []string `mapstructure:"expand_columns"`
+	// MaxBodyBytes is the maximum size of the bodies of the log records, unlimited when 0.
+	MaxBodyBytes int `mapstructure:"max_body_bytes"`
+	// MaxBodyAction is applied to the bodies larger than MaxBodyBytes, `truncate` by default.
+	MaxBodyAction MaxBodyAction `mapstructure:"max_body_action"`
+	// CompressBody gzips the bodies larger than MaxBodyBytes, MaxBodyAction being applied only
+	// when the compressed body is still too large.
+	CompressBody bool `mapstructure:"compress_body"`
+	// SeverityColumn is the column setting the severity of the log record.
+	SeverityColumn string `mapstructure:"severity_column"`
+	// SeverityMapping maps the values of the severity column to the names of the severities,
+	// e.g. `W: warn`, for the values which aren't severity names.
+	SeverityMapping map[string]string `mapstructure:"severity_mapping"`
+	// EventName is set as the `event.name` attribute, making the log records events.
+	EventName string `mapstructure:"event_name"`
+	// SchemaURL is the schema URL of the scope of the log records.
+	SchemaURL string `mapstructure:"schema_url"`
+	// TimestampColumn is the column setting the timestamp of the log record.
+	TimestampColumn string `mapstructure:"timestamp_column"`
+	// TimestampFormat is the format of the timestamp column: one of the epoch formats, a
+	// strptime layout, or a Go layout. RFC 3339 by default.
+	TimestampFormat string `mapstructure:"timestamp_format"`
+	// TraceIDColumn is the column setting the trace ID of the log record, as hex or as a W3C
+	// traceparent, which also sets the span ID and the trace flags.
+	TraceIDColumn string `mapstructure:"trace_id_column"`
+	// SpanIDColumn is the column setting the span ID of the log record, as hex.
+	SpanIDColumn string `mapstructure:"span_id_column"`
 }
 
+// The formats of the timestamp columns holding the time since the Unix epoch.
+const (
+	TimestampFormatEpochSeconds = "epoch_seconds"
+	TimestampFormatEpochMillis  = "epoch_millis"
+	TimestampFormatEpochMicros  = "epoch_micros"
+	TimestampFormatEpochNanos   = "epoch_nanos"
+)
+
 func (config LogsCfg) Validate() error {
 	var errs []error
-	if config.BodyColumn == "" {
+	if err := config.BodyType.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+	if err := config.ParseBodyAs.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+	if config.ParseBodyAs != BodyParserUnspecified {
+		if config.BodyType == BodyTypeMap {
+			errs = append(errs, errors.New("'parse_body_as' cannot be set with 'body_type: map'"))
+		}
+		if config.MaxBodyBytes != 0 {
+			errs = append(errs, errors.New("'max_body_bytes' cannot be set with 'parse_body_as'"))
+		}
+	}
+	if config.BodyType == BodyTypeMap {
+		if config.BodyColumn != "" {
+			errs = append(errs, errors.New("'body_column' cannot be set with 'body_type: map'"))
+		}
+		if config.BodyTemplate != "" {
+			errs = append(errs, errors.New("'body_template' cannot be set with 'body_type: map'"))
+		}
+		if config.MaxBodyBytes != 0 {
+			errs = append(errs, errors.New("'max_body_bytes' cannot be set with 'body_type: map'"))
+		}
+	} else if config.BodyTemplate != "" {
+		if config.BodyColumn != "" {
+			errs = append(errs, errors.New("'body_column' cannot be set with 'body_template'"))
+		}
+		if _, err := parseBodyTemplate(config.BodyTemplate); err != nil {
+			errs = append(errs, fmt.Errorf("invalid 'body_template': %w", err))
+		}
+	} else if config.BodyColumn == "" {
 		errs = append(errs, errors.New("'body_column' must not be empty"))
 	}
+	if config.MaxBodyBytes < 0 {
+		errs = append(errs, errors.New("'max_body_bytes' cannot be negative"))
+	}
+	if err := config.MaxBodyAction.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+	if config.CompressBody && config.MaxBodyBytes == 0 {
+		errs = append(errs, errors.New("'compress_body' requires 'max_body_bytes'"))
+	}
+	if config.TimestampFormat != "" && config.TimestampColumn == "" {
+		errs = append(errs, errors.New("'timestamp_format' requires 'timestamp_column'"))
+	}
+	if len(config.SeverityMapping) > 0 && config.SeverityColumn == "" {
+		errs = append(errs, errors.New("'severity_mapping' requires 'severity_column'"))
+	}
+	if err := validateSeverityMapping(config.SeverityMapping); err != nil {
+		errs = append(errs, err)
+	}
 	return errors.Join(errs...)
 }
 
+type BodyType string
+
+const (
+	BodyTypeUnspecified BodyType = ""
+	BodyTypeString      BodyType = "string"
+	BodyTypeMap         BodyType = "map"
+)
+
+func (t BodyType) Validate() error {
+	switch t {
+	case BodyTypeUnspecified, BodyTypeString, BodyTypeMap:
+		return nil
+	}
+	return fmt.Errorf("logs config has unsupported body_type: '%s'", t)
+}
+
+type BodyParser string
+
+const (
+	BodyParserUnspecified BodyParser = ""
+	BodyParserJSON        BodyParser = "json"
+)
+
+func (p BodyParser) Validate() error {
+	switch p {
+	case BodyParserUnspecified, BodyParserJSON:
+		return nil
+	}
+	return fmt.Errorf("logs config has unsupported parse_body_as: '%s'", p)
+}
+
+type MaxBodyAction string
+
+const (
+	MaxBodyActionUnspecified MaxBodyAction = ""
+	MaxBodyActionTruncate    MaxBodyAction = "truncate"
+	MaxBodyActionDrop        MaxBodyAction = "drop"
+	MaxBodyActionFlag        MaxBodyAction = "flag"
+	MaxBodyActionSplit       MaxBodyAction = "split"
+)
+
+func (a MaxBodyAction) Validate() error {
+	switch a {
+	case MaxBodyActionUnspecified, MaxBodyActionTruncate, MaxBodyActionDrop, MaxBodyActionFlag, MaxBodyActionSplit:
+		return nil
+	}
+	return fmt.Errorf("logs config has unsupported max_body_action: '%s'", a)
+}
+
 type MetricCfg struct {
 	MetricName       string            `mapstructure:"metric_name"`
 	ValueColumn      string            `mapstructure:"value_column"`
@@ -92,15 +313,69 @@ type MetricCfg struct {
 	StaticAttributes map[string]string `mapstructure:"static_attributes"`
 	StartTsColumn    string            `mapstructure:"start_ts_column"`
 	TsColumn         string            `mapstructure:"ts_column"`
+	// ValueColumns are the column names or the glob patterns of the columns each setting a
+	// metric named from the column, instead of a single value column, e.g. `["*"]` for all the
+	// numeric columns of the row. MetricName, when set, is the prefix of the names.
+	ValueColumns []string `mapstructure:"value_columns"`
+	// MetricNameColumn is the column holding the name of the metric of each row, e.g. the
+	// `stat_name` of a key/value statistics table. MetricName, when set, is the prefix of the names.
+	MetricNameColumn string `mapstructure:"metric_name_column"`
+	// UnitColumn is the column holding the unit of the metric of each row, overriding Unit
+	// unless empty.
+	UnitColumn string `mapstructure:"unit_column"`
+	// DescriptionColumn is the column holding the description of the metric of each row,
+	// overriding Description unless empty.
+	DescriptionColumn string `mapstructure:"description_column"`
+	// ExpandValue expands the value column containing an array or a JSON object into a data
+	// point per element, instead of parsing it as a single value.
+	ExpandValue bool `mapstructure:"expand_value"`
+	// ExpansionAttribute is the attribute set to the index or the key of the element of the
+	// expanded data points, `index` for arrays and `key` for objects by default.
+	ExpansionAttribute string `mapstructure:"expansion_attribute"`
+	// Histogram maps the bucket columns of the row to the data point of the metrics with
+	// `data_type: histogram`, which have no value column.
+	Histogram *HistogramCfg `mapstructure:"histogram"`
+	// ExponentialHistogram maps the scale and the bucket array columns of the row to the data
+	// point of the metrics with `data_type: exponential_histogram`.
+	ExponentialHistogram *ExponentialHistogramCfg `mapstructure:"exponential_histogram"`
+	// Summary maps the quantile columns of the row to the data point of the metrics with
+	// `data_type: summary`.
+	Summary *SummaryCfg `mapstructure:"summary"`
+	// Exemplar maps the trace columns of the row to an exemplar of the data points, for all the
+	// data types but the summaries.
+	Exemplar *ExemplarCfg `mapstructure:"exemplar"`
 }
 
 func (c MetricCfg) Validate() error {
 	var errs []error
-	if c.MetricName == "" {
+	if c.MetricName == "" && len(c.ValueColumns) == 0 && c.MetricNameColumn == "" {
 		errs = append(errs, errors.New("'metric_name' cannot be empty"))
 	}
-	if c.ValueColumn == "" {
-		errs = append(errs, errors.New("'value_column' cannot be empty"))
+	switch c.DataType {
+	case MetricTypeHistogram:
+		errs = append(errs, c.validateHistogram()...)
+	case MetricTypeExponentialHistogram:
+		errs = append(errs, c.validateExponentialHistogram()...)
+	case MetricTypeSummary:
+		errs = append(errs, c.validateSummary()...)
+	default:
+		if len(c.ValueColumns) != 0 {
+			errs = append(errs, c.validateValueColumns()...)
+		} else if c.ValueColumn == "" {
+			errs = append(errs, errors.New("'value_column' cannot be empty"))
+		}
+	}
+	if c.Histogram != nil && c.DataType != MetricTypeHistogram {
+		errs = append(errs, errors.New("'histogram' requires 'data_type: histogram'"))
+	}
+	if c.ExponentialHistogram != nil && c.DataType != MetricTypeExponentialHistogram {
+		errs = append(errs, errors.New("'exponential_histogram' requires 'data_type: exponential_histogram'"))
+	}
+	if c.Summary != nil && c.DataType != MetricTypeSummary {
+		errs = append(errs, errors.New("'summary' requires 'data_type: summary'"))
+	}
+	if c.Exemplar != nil {
+		errs = append(errs, c.validateExemplar()...)
 	}
 	if err := c.ValueType.Validate(); err != nil {
 		errs = append(errs, err)
@@ -111,26 +386,107 @@ func (c MetricCfg) Validate() error {
 	if err := c.Aggregation.Validate(); err != nil {
 		errs = append(errs, err)
 	}
-	if c.DataType == MetricTypeGauge && c.Aggregation != "" {
+	if (c.DataType == MetricTypeGauge || c.DataType == MetricTypeSummary) && c.Aggregation != "" {
 		errs = append(errs, fmt.Errorf("aggregation=%s but data_type=%s does not support aggregation", c.Aggregation, c.DataType))
 	}
+	if c.ExpansionAttribute != "" && !c.ExpandValue {
+		errs = append(errs, errors.New("'expansion_attribute' requires 'expand_value'"))
+	}
 	if errs != nil && c.MetricName != "" {
 		errs = append(errs, fmt.Errorf("invalid metric config with metric_name '%s'", c.MetricName))
 	}
 	return errors.Join(errs...)
 }
 
+func (c MetricCfg) validateHistogram() []error {
+	errs := c.validateWithoutValue()
+	if c.Histogram == nil {
+		errs = append(errs, errors.New("'data_type: histogram' requires 'histogram'"))
+	} else if err := c.Histogram.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+	return errs
+}
+
+func (c MetricCfg) validateExponentialHistogram() []error {
+	errs := c.validateWithoutValue()
+	if c.ExponentialHistogram == nil {
+		errs = append(errs, errors.New("'data_type: exponential_histogram' requires 'exponential_histogram'"))
+	} else if err := c.ExponentialHistogram.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+	return errs
+}
+
+func (c MetricCfg) validateSummary() []error {
+	errs := c.validateWithoutValue()
+	if c.Summary == nil {
+		errs = append(errs, errors.New("'data_type: summary' requires 'summary'"))
+	} else if err := c.Summary.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+	return errs
+}
+
+func (c MetricCfg) validateExemplar() []error {
+	var errs []error
+	switch c.DataType {
+	case MetricTypeSummary:
+		errs = append(errs, errors.New("'exemplar' cannot be set with 'data_type: summary'"))
+	case MetricTypeHistogram, MetricTypeExponentialHistogram:
+		if c.Exemplar.ValueColumn == "" {
+			errs = append(errs, fmt.Errorf("'exemplar.value_column' cannot be empty with 'data_type: %s'", c.DataType))
+		}
+	}
+	if err := c.Exemplar.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+	return errs
+}
+
+func (c MetricCfg) validateValueColumns() []error {
+	errs := validateValueColumns(c.ValueColumns)
+	if c.ValueColumn != "" {
+		errs = append(errs, errors.New("'value_column' cannot be set with 'value_columns'"))
+	}
+	if c.ExpandValue {
+		errs = append(errs, errors.New("'expand_value' cannot be set with 'value_columns'"))
+	}
+	if c.MetricNameColumn != "" {
+		errs = append(errs, errors.New("'metric_name_column' cannot be set with 'value_columns'"))
+	}
+	return errs
+}
+
+// validateWithoutValue validates the metrics whose data points aren't set from the value column.
+func (c MetricCfg) validateWithoutValue() []error {
+	var errs []error
+	if c.ValueColumn != "" {
+		errs = append(errs, fmt.Errorf("'value_column' cannot be set with 'data_type: %s'", c.DataType))
+	}
+	if len(c.ValueColumns) != 0 {
+		errs = append(errs, fmt.Errorf("'value_columns' cannot be set with 'data_type: %s'", c.DataType))
+	}
+	if c.ExpandValue {
+		errs = append(errs, fmt.Errorf("'expand_value' cannot be set with 'data_type: %s'", c.DataType))
+	}
+	return errs
+}
+
 type MetricType string
 
 const (
-	MetricTypeUnspecified MetricType = ""
-	MetricTypeGauge       MetricType = "gauge"
-	MetricTypeSum         MetricType = "sum"
+	MetricTypeUnspecified          MetricType = ""
+	MetricTypeGauge                MetricType = "gauge"
+	MetricTypeSum                  MetricType = "sum"
+	MetricTypeHistogram            MetricType = "histogram"
+	MetricTypeExponentialHistogram MetricType = "exponential_histogram"
+	MetricTypeSummary              MetricType = "summary"
 )
 
 func (t MetricType) Validate() error {
 	switch t {
-	case MetricTypeUnspecified, MetricTypeGauge, MetricTypeSum:
+	case MetricTypeUnspecified, MetricTypeGauge, MetricTypeSum, MetricTypeHistogram, MetricTypeExponentialHistogram, MetricTypeSummary:
 		return nil
 	}
 	return fmt.Errorf("metric config has unsupported data_type: '%s'", t)
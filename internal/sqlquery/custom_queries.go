@@ -0,0 +1,56 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlquery // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/sqlquery"
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"sync"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/receiver/scraperhelper"
+	"go.uber.org/zap"
+)
+
+// ValidateCustomQueries validates the custom queries of a database receiver, e.g. the
+// PostgreSQL receiver, which only support metrics.
+func ValidateCustomQueries(queries []Query) error {
+	var errs []error
+	for i, query := range queries {
+		if len(query.Logs) != 0 || query.Table != nil {
+			errs = append(errs, fmt.Errorf("custom_queries[%d]: only 'metrics' are supported", i))
+			continue
+		}
+		if err := query.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("custom_queries[%d]: %w", i, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// NewCustomQueryScrapers returns a scraper per custom query of a database receiver. The
+// scrapers share the connection to the database opened by dbProviderFunc, so that the
+// custom queries reuse the connection settings and the credentials of the receiver.
+func NewCustomQueryScrapers(receiverType component.Type, queries []Query, scrapeCfg scraperhelper.ControllerConfig, logger *zap.Logger, dbProviderFunc DbProviderFunc) []*Scraper {
+	if len(queries) == 0 {
+		return nil
+	}
+	var once sync.Once
+	var db *sql.DB
+	var err error
+	sharedDbProviderFunc := func() (*sql.DB, error) {
+		once.Do(func() {
+			db, err = dbProviderFunc()
+		})
+		return db, err
+	}
+
+	scrapers := make([]*Scraper, 0, len(queries))
+	for i, query := range queries {
+		id := component.NewIDWithName(receiverType, fmt.Sprintf("custom-query-%d: %s", i, query.SQL))
+		scrapers = append(scrapers, NewScraper(id, query, scrapeCfg, logger, TelemetryConfig{}, sharedDbProviderFunc, NewDbClient))
+	}
+	return scrapers
+}
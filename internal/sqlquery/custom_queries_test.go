@@ -0,0 +1,57 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlquery
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/receiver/scraperhelper"
+	"go.uber.org/zap"
+)
+
+func TestValidateCustomQueries(t *testing.T) {
+	metrics := []MetricCfg{{MetricName: "my.count", ValueColumn: "count"}}
+	require.NoError(t, ValidateCustomQueries(nil))
+	require.NoError(t, ValidateCustomQueries([]Query{{SQL: "select count(*) as count from t", Metrics: metrics}}))
+	assert.EqualError(t, ValidateCustomQueries([]Query{
+		{SQL: "select count(*) as count from t", Metrics: metrics},
+		{SQL: "select body from t", Logs: []LogsCfg{{BodyColumn: "body"}}},
+		{Metrics: metrics},
+	}), "custom_queries[1]: only 'metrics' are supported\ncustom_queries[2]: 'query.sql' cannot be empty")
+}
+
+func TestNewCustomQueryScrapers(t *testing.T) {
+	assert.Nil(t, NewCustomQueryScrapers(component.MustNewType("postgresql"), nil, scraperhelper.ControllerConfig{}, zap.NewNop(), nil))
+
+	opened := 0
+	connector := &countingConnector{}
+	dbProviderFunc := func() (*sql.DB, error) {
+		opened++
+		return sql.OpenDB(connector), nil
+	}
+	queries := []Query{
+		{SQL: "select 1 as count", Metrics: []MetricCfg{{MetricName: "one", ValueColumn: "count"}}},
+		{SQL: "select 2 as count", Metrics: []MetricCfg{{MetricName: "two", ValueColumn: "count"}}},
+	}
+	scrapers := NewCustomQueryScrapers(component.MustNewType("postgresql"), queries, scraperhelper.ControllerConfig{}, zap.NewNop(), dbProviderFunc)
+	require.Len(t, scrapers, 2)
+	assert.Equal(t, component.MustNewIDWithName("postgresql", "custom-query-0: select 1 as count"), scrapers[0].ID())
+	assert.Equal(t, component.MustNewIDWithName("postgresql", "custom-query-1: select 2 as count"), scrapers[1].ID())
+
+	for _, scraper := range scrapers {
+		require.NoError(t, scraper.Start(context.Background(), componenttest.NewNopHost()))
+	}
+	// the scrapers share the connection
+	assert.Equal(t, 1, opened)
+	assert.Same(t, scrapers[0].Db, scrapers[1].Db)
+	for _, scraper := range scrapers {
+		require.NoError(t, scraper.Shutdown(context.Background()))
+	}
+}
@@ -0,0 +1,12 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build db2
+
+package sqlquery // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/sqlquery"
+
+import (
+	// register the IBM Db2 driver, as go_ibm_db. It requires cgo and the IBM Db2 CLI driver, see
+	// https://github.com/ibmdb/go_ibm_db#how-to-install-in-linuxmac, hence the db2 build tag.
+	_ "github.com/ibmdb/go_ibm_db"
+)
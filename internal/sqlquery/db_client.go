@@ -8,6 +8,7 @@ import (
 	"errors"
 
 	// register Db drivers
+	_ "github.com/ClickHouse/clickhouse-go/v2"
 	_ "github.com/SAP/go-hdb/driver"
 	_ "github.com/go-sql-driver/mysql"
 	_ "github.com/lib/pq"
@@ -15,6 +16,7 @@ import (
 	_ "github.com/microsoft/go-mssqldb/integratedauth/krb5"
 	_ "github.com/sijms/go-ora/v2"
 	_ "github.com/snowflakedb/gosnowflake"
+	_ "github.com/trinodb/trino-go-client/trino"
 	"go.uber.org/zap"
 )
 
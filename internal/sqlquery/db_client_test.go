@@ -7,7 +7,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -32,6 +34,57 @@ func TestDBSQLClient_SingleRow(t *testing.T) {
 	}, rows[0])
 }
 
+func TestDBSQLClient_CompositeTypes(t *testing.T) {
+	cl := DbSQLClient{
+		Db:     fakeDB{rowVals: [][]any{{[]string{"system.query_log"}, map[string]uint64{"SelectedRows": 42}, net.ParseIP("10.0.0.1"), [2]int{1, 2}}}},
+		Logger: zap.NewNop(),
+		SQL:    "",
+	}
+	rows, err := cl.QueryRows(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, rows, 1)
+	assert.EqualValues(t, map[string]string{
+		"col_0": `["system.query_log"]`,
+		"col_1": `{"SelectedRows":42}`,
+		"col_2": "10.0.0.1",
+		"col_3": "[1,2]",
+	}, rows[0])
+}
+
+func TestDBSQLClient_DecimalAndTimestamp(t *testing.T) {
+	// e.g. the DECIMAL and TIMESTAMP types of Db2, the decimals being returned as text
+	released := time.Date(2024, 5, 10, 12, 30, 15, 123456000, time.FixedZone("CEST", 2*60*60))
+	cl := DbSQLClient{
+		Db:     fakeDB{rowVals: [][]any{{[]byte("1234.50"), released}}},
+		Logger: zap.NewNop(),
+		SQL:    "",
+	}
+	rows, err := cl.QueryRows(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, rows, 1)
+	assert.EqualValues(t, map[string]string{
+		"col_0": "1234.50",
+		"col_1": "2024-05-10T12:30:15+02:00",
+	}, rows[0])
+}
+
+func TestDBSQLClient_NestedValues(t *testing.T) {
+	// e.g. the ARRAY, MAP and ROW types of Trino, decoded from the JSON of the responses
+	cl := DbSQLClient{
+		Db:     fakeDB{rowVals: [][]any{{[]any{"tpch", "tiny"}, map[string]any{"queued": float64(2)}, []any{int64(1), []any{"nested"}}}}},
+		Logger: zap.NewNop(),
+		SQL:    "",
+	}
+	rows, err := cl.QueryRows(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, rows, 1)
+	assert.EqualValues(t, map[string]string{
+		"col_0": `["tpch","tiny"]`,
+		"col_1": `{"queued":2}`,
+		"col_2": `[1,["nested"]]`,
+	}, rows[0])
+}
+
 func TestDBSQLClient_MultiRow(t *testing.T) {
 	cl := DbSQLClient{
 		Db: fakeDB{rowVals: [][]any{
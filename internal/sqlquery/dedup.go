@@ -0,0 +1,132 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlquery // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/sqlquery"
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// DefaultDedupWindow is the window of the de-duplication when none is configured.
+const DefaultDedupWindow = 24 * time.Hour
+
+// DedupCfg suppresses the rows emitted by the previous collections of a query, for the tables
+// without a monotonically increasing tracking column.
+type DedupCfg struct {
+	// Columns are the columns identifying a row, all the columns of the row when empty.
+	Columns []string `mapstructure:"columns"`
+	// Window is how long a row is suppressed after it was last returned by the query,
+	// DefaultDedupWindow when 0.
+	Window time.Duration `mapstructure:"window"`
+}
+
+func (c DedupCfg) Validate() error {
+	var errs []error
+	if c.Window < 0 {
+		errs = append(errs, errors.New("'dedup.window' cannot be negative"))
+	}
+	for _, column := range c.Columns {
+		if column == "" {
+			errs = append(errs, errors.New("'dedup.columns' cannot contain an empty column"))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Deduplicator keeps the hashes of the rows returned by the query within the window, with the
+// last time each row was returned.
+type Deduplicator struct {
+	columns []string
+	window  time.Duration
+	seen    map[string]time.Time
+}
+
+func NewDeduplicator(cfg DedupCfg) *Deduplicator {
+	window := cfg.Window
+	if window == 0 {
+		window = DefaultDedupWindow
+	}
+	return &Deduplicator{
+		columns: cfg.Columns,
+		window:  window,
+		seen:    map[string]time.Time{},
+	}
+}
+
+// Filter returns the rows which were not returned within the window, including the rows
+// repeated in the result set, and records the rows as returned at now. The rows missing a
+// column are kept, without being recorded.
+func (d *Deduplicator) Filter(rows []StringMap, now time.Time) ([]StringMap, error) {
+	for hash, lastSeen := range d.seen {
+		if now.Sub(lastSeen) >= d.window {
+			delete(d.seen, hash)
+		}
+	}
+
+	var errs []error
+	kept := make([]StringMap, 0, len(rows))
+	for i, row := range rows {
+		hash, err := d.hash(row)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("row %d: %w", i, err))
+			kept = append(kept, row)
+			continue
+		}
+		_, found := d.seen[hash]
+		// the window slides while the query keeps returning the row
+		d.seen[hash] = now
+		if !found {
+			kept = append(kept, row)
+		}
+	}
+	return kept, errors.Join(errs...)
+}
+
+func (d *Deduplicator) hash(row StringMap) (string, error) {
+	columns := d.columns
+	if len(columns) == 0 {
+		columns = make([]string, 0, len(row))
+		for column := range row {
+			columns = append(columns, column)
+		}
+		sort.Strings(columns)
+	}
+	h := sha256.New()
+	for _, column := range columns {
+		value, found := row[column]
+		if !found {
+			return "", fmt.Errorf("dedup: column '%s' not found in result set", column)
+		}
+		// the length prefixes keep the hashes of different values distinct
+		fmt.Fprintf(h, "%d:%s;%d:%s;", len(column), column, len(value), value)
+	}
+	return hex.EncodeToString(h.Sum(nil)[:16]), nil
+}
+
+// MarshalState encodes the rows within the window, to persist them across restarts.
+func (d *Deduplicator) MarshalState() ([]byte, error) {
+	state := make(map[string]int64, len(d.seen))
+	for hash, lastSeen := range d.seen {
+		state[hash] = lastSeen.UnixNano()
+	}
+	return json.Marshal(state)
+}
+
+// UnmarshalState restores the rows encoded by MarshalState.
+func (d *Deduplicator) UnmarshalState(data []byte) error {
+	var state map[string]int64
+	if err := json.Unmarshal(data, &state); err != nil {
+		return err
+	}
+	d.seen = make(map[string]time.Time, len(state))
+	for hash, lastSeen := range state {
+		d.seen[hash] = time.Unix(0, lastSeen)
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlquery
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDedupCfg_Validate(t *testing.T) {
+	assert.NoError(t, DedupCfg{}.Validate())
+	assert.NoError(t, DedupCfg{Columns: []string{"id"}, Window: time.Hour}.Validate())
+	assert.EqualError(t, DedupCfg{Window: -time.Hour}.Validate(), "'dedup.window' cannot be negative")
+	assert.EqualError(t, DedupCfg{Columns: []string{""}}.Validate(), "'dedup.columns' cannot contain an empty column")
+
+	logs := []LogsCfg{{BodyColumn: "body"}}
+	assert.NoError(t, Query{SQL: "select * from events", Logs: logs, Dedup: &DedupCfg{}}.Validate())
+	assert.EqualError(t, Query{SQL: "select count(*) as count from events", Metrics: []MetricCfg{{
+		MetricName:  "events.count",
+		ValueColumn: "count",
+		ValueType:   MetricValueTypeInt,
+		DataType:    MetricTypeGauge,
+	}}, Dedup: &DedupCfg{}}.Validate(), "'dedup' applies only to logs, 'query.logs' cannot be empty")
+}
+
+func TestDeduplicator_Filter(t *testing.T) {
+	now := time.Now()
+	d := NewDeduplicator(DedupCfg{Columns: []string{"id"}, Window: time.Hour})
+
+	rows := []StringMap{{"id": "1", "body": "a"}, {"id": "2", "body": "b"}, {"id": "1", "body": "c"}}
+	kept, err := d.Filter(rows, now)
+	require.NoError(t, err)
+	assert.Equal(t, []StringMap{rows[0], rows[1]}, kept)
+
+	// the rows returned again within the window are suppressed, and their window slides
+	rows = []StringMap{{"id": "2", "body": "b"}, {"id": "3", "body": "d"}}
+	kept, err = d.Filter(rows, now.Add(50*time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, []StringMap{rows[1]}, kept)
+
+	rows = []StringMap{{"id": "1", "body": "a"}, {"id": "2", "body": "b"}}
+	kept, err = d.Filter(rows, now.Add(100*time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, []StringMap{rows[0]}, kept)
+
+	rows = []StringMap{{"body": "e"}}
+	kept, err = d.Filter(rows, now.Add(100*time.Minute))
+	assert.EqualError(t, err, "row 0: dedup: column 'id' not found in result set")
+	assert.Equal(t, rows, kept)
+}
+
+func TestDeduplicator_FullRow(t *testing.T) {
+	now := time.Now()
+	d := NewDeduplicator(DedupCfg{})
+	assert.Equal(t, DefaultDedupWindow, d.window)
+
+	rows := []StringMap{{"a": "1", "b": "2"}, {"a": "1", "b": "3"}, {"b": "2", "a": "1"}, {"a": "12", "b": ""}}
+	kept, err := d.Filter(rows, now)
+	require.NoError(t, err)
+	assert.Equal(t, []StringMap{rows[0], rows[1], rows[3]}, kept)
+}
+
+func TestDeduplicator_State(t *testing.T) {
+	now := time.Now()
+	d := NewDeduplicator(DedupCfg{Columns: []string{"id"}})
+	_, err := d.Filter([]StringMap{{"id": "1"}, {"id": "2"}}, now)
+	require.NoError(t, err)
+	state, err := d.MarshalState()
+	require.NoError(t, err)
+
+	restored := NewDeduplicator(DedupCfg{Columns: []string{"id"}})
+	require.NoError(t, restored.UnmarshalState(state))
+	kept, err := restored.Filter([]StringMap{{"id": "1"}, {"id": "3"}}, now.Add(time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, []StringMap{{"id": "3"}}, kept)
+
+	assert.Error(t, restored.UnmarshalState([]byte("not json")))
+}
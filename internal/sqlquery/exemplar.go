@@ -0,0 +1,158 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlquery // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/sqlquery"
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+// ExemplarCfg maps the columns of a row to an exemplar of its data points, linking the metrics
+// of the views recording the traces of their queries, e.g. the slowest queries, to the traces.
+type ExemplarCfg struct {
+	// TraceIDColumn is the column holding the hex encoded trace id, the rows with a NULL or an
+	// empty trace id having no exemplar.
+	TraceIDColumn string `mapstructure:"trace_id_column"`
+	// SpanIDColumn is the column holding the hex encoded span id, not set when empty.
+	SpanIDColumn string `mapstructure:"span_id_column"`
+	// ValueColumn is the column holding the value of the exemplar, the value of the data point
+	// when empty. It is required for the histograms.
+	ValueColumn string `mapstructure:"value_column"`
+	// TsColumn is the column holding the timestamp of the exemplar in nanoseconds, the timestamp
+	// of the data point when empty.
+	TsColumn string `mapstructure:"ts_column"`
+	// FilteredAttributeColumns are the columns set as the filtered attributes of the exemplar.
+	FilteredAttributeColumns []string `mapstructure:"filtered_attribute_columns"`
+}
+
+func (c ExemplarCfg) Validate() error {
+	var errs []error
+	if c.TraceIDColumn == "" {
+		errs = append(errs, errors.New("'exemplar.trace_id_column' cannot be empty"))
+	}
+	for _, column := range c.FilteredAttributeColumns {
+		if column == "" {
+			errs = append(errs, errors.New("'exemplar.filtered_attribute_columns' cannot contain an empty column"))
+			break
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// appendExemplar appends the exemplar of the row to the exemplars of a data point, setDefault
+// setting the value of the data point when the exemplar has no value column, which is nil for the
+// histograms requiring one.
+func appendExemplar(row StringMap, cfg MetricCfg, dest pmetric.ExemplarSlice, ts pcommon.Timestamp, setDefault func(pmetric.Exemplar)) error {
+	exemplarCfg := cfg.Exemplar
+	if exemplarCfg == nil {
+		return nil
+	}
+	traceIDValue, found := row[exemplarCfg.TraceIDColumn]
+	if !found {
+		return fmt.Errorf("rowToMetric: exemplar trace_id_column '%s' not found in result set", exemplarCfg.TraceIDColumn)
+	}
+	if traceIDValue == "" {
+		return nil
+	}
+	var traceID pcommon.TraceID
+	if err := decodeID(exemplarCfg.TraceIDColumn, traceIDValue, traceID[:]); err != nil {
+		return err
+	}
+	exemplar := pmetric.NewExemplar()
+	exemplar.SetTraceID(traceID)
+	if exemplarCfg.SpanIDColumn != "" {
+		spanIDValue, found := row[exemplarCfg.SpanIDColumn]
+		if !found {
+			return fmt.Errorf("rowToMetric: exemplar span_id_column '%s' not found in result set", exemplarCfg.SpanIDColumn)
+		}
+		if spanIDValue != "" {
+			var spanID pcommon.SpanID
+			if err := decodeID(exemplarCfg.SpanIDColumn, spanIDValue, spanID[:]); err != nil {
+				return err
+			}
+			exemplar.SetSpanID(spanID)
+		}
+	}
+
+	exemplar.SetTimestamp(ts)
+	if exemplarCfg.TsColumn != "" {
+		value, found := row[exemplarCfg.TsColumn]
+		if !found {
+			return fmt.Errorf("rowToMetric: exemplar ts_column '%s' not found in result set", exemplarCfg.TsColumn)
+		}
+		timestamp, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return fmt.Errorf("failed to parse uint64 for %q, value was %q: %w", exemplarCfg.TsColumn, value, err)
+		}
+		exemplar.SetTimestamp(pcommon.Timestamp(timestamp))
+	}
+
+	if exemplarCfg.ValueColumn == "" {
+		setDefault(exemplar)
+	} else if err := setExemplarValue(row, cfg, exemplar); err != nil {
+		return err
+	}
+
+	for _, column := range exemplarCfg.FilteredAttributeColumns {
+		value, found := row[column]
+		if !found {
+			return fmt.Errorf("rowToMetric: exemplar filtered_attribute_column not found: '%s'", column)
+		}
+		exemplar.FilteredAttributes().PutStr(column, value)
+	}
+	exemplar.MoveTo(dest.AppendEmpty())
+	return nil
+}
+
+// setExemplarValue parses the value of the exemplar as the values of the metric, and as a double
+// for the histograms.
+func setExemplarValue(row StringMap, cfg MetricCfg, dest pmetric.Exemplar) error {
+	column := cfg.Exemplar.ValueColumn
+	value, found := row[column]
+	if !found {
+		return fmt.Errorf("rowToMetric: exemplar value_column '%s' not found in result set", column)
+	}
+	isNumber := cfg.DataType == MetricTypeUnspecified || cfg.DataType == MetricTypeGauge || cfg.DataType == MetricTypeSum
+	if isNumber && cfg.ValueType != MetricValueTypeDouble {
+		val, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return fmt.Errorf("rowToMetric: exemplar col %q: error converting to integer: %w", column, err)
+		}
+		dest.SetIntValue(val)
+		return nil
+	}
+	val, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return fmt.Errorf("rowToMetric: exemplar col %q: error converting to double: %w", column, err)
+	}
+	dest.SetDoubleValue(val)
+	return nil
+}
+
+// decodeID decodes a hex encoded trace or span id, the dashes of the UUIDs being ignored.
+func decodeID(column string, value string, dest []byte) error {
+	decoded, err := hex.DecodeString(strings.ReplaceAll(value, "-", ""))
+	if err != nil || len(decoded) != len(dest) {
+		return fmt.Errorf("rowToMetric: exemplar col %q: %q is not a hex encoded id of %d bytes", column, value, len(dest))
+	}
+	copy(dest, decoded)
+	return nil
+}
+
+// numberExemplarValue sets the value of the data point as the value of its exemplar.
+func numberExemplarValue(dataPoint pmetric.NumberDataPoint) func(pmetric.Exemplar) {
+	return func(exemplar pmetric.Exemplar) {
+		if dataPoint.ValueType() == pmetric.NumberDataPointValueTypeInt {
+			exemplar.SetIntValue(dataPoint.IntValue())
+		} else {
+			exemplar.SetDoubleValue(dataPoint.DoubleValue())
+		}
+	}
+}
@@ -0,0 +1,123 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlquery
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/scraperhelper"
+)
+
+func TestExemplarCfg_Validate(t *testing.T) {
+	assert.NoError(t, ExemplarCfg{TraceIDColumn: "trace_id", FilteredAttributeColumns: []string{"query"}}.Validate())
+	assert.EqualError(t, ExemplarCfg{SpanIDColumn: "span_id"}.Validate(), "'exemplar.trace_id_column' cannot be empty")
+	assert.EqualError(t, ExemplarCfg{TraceIDColumn: "trace_id", FilteredAttributeColumns: []string{""}}.Validate(),
+		"'exemplar.filtered_attribute_columns' cannot contain an empty column")
+
+	exemplar := &ExemplarCfg{TraceIDColumn: "trace_id"}
+	assert.NoError(t, MetricCfg{MetricName: "duration", ValueColumn: "duration", Exemplar: exemplar}.Validate())
+	assert.ErrorContains(t, MetricCfg{MetricName: "duration", ValueColumn: "duration", Exemplar: &ExemplarCfg{}}.Validate(),
+		"'exemplar.trace_id_column' cannot be empty")
+	assert.ErrorContains(t, MetricCfg{
+		MetricName: "duration",
+		DataType:   MetricTypeHistogram,
+		Histogram:  &HistogramCfg{ExplicitBounds: []float64{1}, BucketCountColumns: []string{"le_1", "inf"}},
+		Exemplar:   exemplar,
+	}.Validate(), "'exemplar.value_column' cannot be empty with 'data_type: histogram'")
+	assert.ErrorContains(t, MetricCfg{
+		MetricName: "duration",
+		DataType:   MetricTypeSummary,
+		Summary:    &SummaryCfg{CountColumn: "calls"},
+		Exemplar:   exemplar,
+	}.Validate(), "'exemplar' cannot be set with 'data_type: summary'")
+}
+
+func TestRowToMetricExemplar(t *testing.T) {
+	row := StringMap{
+		"duration": "42",
+		"slowest":  "120",
+		"trace_id": "5b8efff798038103d269b633813fc60c",
+		"span_id":  "eee19b7ec3c1b174",
+		"query":    "select 1",
+		"user":     "admin",
+	}
+	cfg := MetricCfg{
+		MetricName:       "db.query.duration",
+		ValueColumn:      "duration",
+		AttributeColumns: []string{"user"},
+		Exemplar: &ExemplarCfg{
+			TraceIDColumn:            "trace_id",
+			SpanIDColumn:             "span_id",
+			FilteredAttributeColumns: []string{"query"},
+		},
+	}
+	ts := pcommon.NewTimestampFromTime(time.Now())
+	metric := pmetric.NewMetric()
+	require.NoError(t, rowToMetric(row, cfg, metric, 0, ts, scraperhelper.ControllerConfig{}))
+
+	exemplars := metric.Gauge().DataPoints().At(0).Exemplars()
+	require.Equal(t, 1, exemplars.Len())
+	exemplar := exemplars.At(0)
+	assert.Equal(t, "5b8efff798038103d269b633813fc60c", exemplar.TraceID().String())
+	assert.Equal(t, "eee19b7ec3c1b174", exemplar.SpanID().String())
+	assert.Equal(t, ts, exemplar.Timestamp())
+	assert.Equal(t, int64(42), exemplar.IntValue())
+	assert.Equal(t, map[string]any{"query": "select 1"}, exemplar.FilteredAttributes().AsRaw())
+
+	// the value column of the exemplar and the UUID formatted trace ids
+	cfg.ValueType = MetricValueTypeDouble
+	cfg.Exemplar.ValueColumn = "slowest"
+	row["trace_id"] = "5b8efff7-9803-8103-d269-b633813fc60c"
+	metric = pmetric.NewMetric()
+	require.NoError(t, rowToMetric(row, cfg, metric, 0, ts, scraperhelper.ControllerConfig{}))
+	exemplar = metric.Gauge().DataPoints().At(0).Exemplars().At(0)
+	assert.Equal(t, "5b8efff798038103d269b633813fc60c", exemplar.TraceID().String())
+	assert.Equal(t, pmetric.ExemplarValueTypeDouble, exemplar.ValueType())
+	assert.Equal(t, 120.0, exemplar.DoubleValue())
+
+	// the rows without a trace have no exemplar
+	row["trace_id"] = ""
+	metric = pmetric.NewMetric()
+	require.NoError(t, rowToMetric(row, cfg, metric, 0, ts, scraperhelper.ControllerConfig{}))
+	assert.Equal(t, 0, metric.Gauge().DataPoints().At(0).Exemplars().Len())
+
+	row["trace_id"] = "5b8efff798038103"
+	assert.EqualError(t, rowToMetric(row, cfg, pmetric.NewMetric(), 0, ts, scraperhelper.ControllerConfig{}),
+		`rowToMetric: exemplar col "trace_id": "5b8efff798038103" is not a hex encoded id of 16 bytes`)
+	row["trace_id"] = "5b8efff798038103d269b633813fc60c"
+	row["slowest"] = "slow"
+	assert.ErrorContains(t, rowToMetric(row, cfg, pmetric.NewMetric(), 0, ts, scraperhelper.ControllerConfig{}),
+		`rowToMetric: exemplar col "slowest": error converting to double`)
+	delete(row, "span_id")
+	assert.EqualError(t, rowToMetric(row, cfg, pmetric.NewMetric(), 0, ts, scraperhelper.ControllerConfig{}),
+		"rowToMetric: exemplar span_id_column 'span_id' not found in result set")
+}
+
+func TestRowToHistogramExemplar(t *testing.T) {
+	row := StringMap{
+		"le_10":    "3",
+		"inf":      "1",
+		"slowest":  "12.5",
+		"trace_id": "5b8efff798038103d269b633813fc60c",
+		"ts":       "1700000000000000000",
+	}
+	cfg := MetricCfg{
+		MetricName: "db.query.duration",
+		DataType:   MetricTypeHistogram,
+		Histogram:  &HistogramCfg{ExplicitBounds: []float64{10}, BucketCountColumns: []string{"le_10", "inf"}},
+		Exemplar:   &ExemplarCfg{TraceIDColumn: "trace_id", ValueColumn: "slowest", TsColumn: "ts"},
+	}
+	metric := pmetric.NewMetric()
+	require.NoError(t, rowToHistogram(row, cfg, metric, 0, pcommon.NewTimestampFromTime(time.Now()), scraperhelper.ControllerConfig{}))
+
+	exemplar := metric.Histogram().DataPoints().At(0).Exemplars().At(0)
+	assert.Equal(t, 12.5, exemplar.DoubleValue())
+	assert.Equal(t, pcommon.Timestamp(1700000000000000000), exemplar.Timestamp())
+	assert.True(t, exemplar.SpanID().IsEmpty())
+}
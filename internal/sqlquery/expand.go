@@ -0,0 +1,145 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlquery // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/sqlquery"
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var errNotExpandable = errors.New("value is not an array or a JSON object")
+
+// ExpandedValue is an element of an array, or a member of a JSON object, of an expanded
+// column value.
+type ExpandedValue struct {
+	// Key is the index of the array element, or the key of the object member.
+	Key     string
+	Value   string
+	IsIndex bool
+}
+
+// ExpandValue splits a column value containing a JSON array, a JSON object, or an array
+// in the Postgres text format (e.g. `{1,2,3}`), into its elements. The members of the
+// objects are sorted by key, and the nested arrays and objects are kept as JSON.
+func ExpandValue(value string) ([]ExpandedValue, error) {
+	trimmed := strings.TrimSpace(value)
+	switch {
+	case strings.HasPrefix(trimmed, "["):
+		return expandJSONArray(trimmed)
+	case strings.HasPrefix(trimmed, "{"):
+		if expanded, err := expandJSONObject(trimmed); err == nil {
+			return expanded, nil
+		}
+		return expandPostgresArray(trimmed)
+	}
+	return nil, errNotExpandable
+}
+
+func expandJSONArray(value string) ([]ExpandedValue, error) {
+	var elements []json.RawMessage
+	if err := json.Unmarshal([]byte(value), &elements); err != nil {
+		return nil, fmt.Errorf("invalid JSON array: %w", err)
+	}
+	out := make([]ExpandedValue, 0, len(elements))
+	for i, element := range elements {
+		if isJSONNull(element) {
+			continue
+		}
+		out = append(out, ExpandedValue{Key: strconv.Itoa(i), Value: jsonElementString(element), IsIndex: true})
+	}
+	return out, nil
+}
+
+func expandJSONObject(value string) ([]ExpandedValue, error) {
+	var members map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(value), &members); err != nil {
+		return nil, fmt.Errorf("invalid JSON object: %w", err)
+	}
+	out := make([]ExpandedValue, 0, len(members))
+	for key, member := range members {
+		if isJSONNull(member) {
+			continue
+		}
+		out = append(out, ExpandedValue{Key: key, Value: jsonElementString(member)})
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].Key < out[j].Key
+	})
+	return out, nil
+}
+
+func isJSONNull(element json.RawMessage) bool {
+	return bytes.Equal(bytes.TrimSpace(element), []byte("null"))
+}
+
+// jsonElementString returns the strings without quotes, and the other JSON values as is.
+func jsonElementString(element json.RawMessage) string {
+	var s string
+	if err := json.Unmarshal(element, &s); err == nil {
+		return s
+	}
+	return string(bytes.TrimSpace(element))
+}
+
+// expandPostgresArray splits a one-dimensional array in the Postgres text format. The
+// elements may be double-quoted with backslash escapes, and the NULL elements are skipped.
+func expandPostgresArray(value string) ([]ExpandedValue, error) {
+	if !strings.HasSuffix(value, "}") {
+		return nil, errNotExpandable
+	}
+	content := value[1 : len(value)-1]
+	var out []ExpandedValue
+	if strings.TrimSpace(content) == "" {
+		return out, nil
+	}
+
+	var element strings.Builder
+	quoted, inQuotes, depth, index := false, false, 0, 0
+	appendElement := func() {
+		s := element.String()
+		if !quoted {
+			s = strings.TrimSpace(s)
+		}
+		if quoted || !strings.EqualFold(s, "NULL") {
+			out = append(out, ExpandedValue{Key: strconv.Itoa(index), Value: s, IsIndex: true})
+		}
+		element.Reset()
+		quoted = false
+		index++
+	}
+	for i := 0; i < len(content); i++ {
+		c := content[i]
+		switch {
+		case inQuotes && c == '\\' && i+1 < len(content):
+			i++
+			element.WriteByte(content[i])
+		case c == '"' && depth == 0:
+			inQuotes = !inQuotes
+			quoted = true
+		case inQuotes:
+			element.WriteByte(c)
+		case c == '{':
+			// nested arrays are kept as elements
+			depth++
+			element.WriteByte(c)
+		case c == '}':
+			depth--
+			element.WriteByte(c)
+		case c == ',' && depth == 0:
+			appendElement()
+		default:
+			element.WriteByte(c)
+		}
+	}
+	if inQuotes || depth != 0 {
+		return nil, errors.New("invalid array: unbalanced quotes or braces")
+	}
+	appendElement()
+	return out, nil
+}
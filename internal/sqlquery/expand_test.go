@@ -0,0 +1,92 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlquery // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/sqlquery"
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpandValue(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		expected []ExpandedValue
+		errMsg   string
+	}{
+		{
+			name:  "json array",
+			value: `[1, "two", null, {"k": 3}]`,
+			expected: []ExpandedValue{
+				{Key: "0", Value: "1", IsIndex: true},
+				{Key: "1", Value: "two", IsIndex: true},
+				{Key: "3", Value: `{"k": 3}`, IsIndex: true},
+			},
+		},
+		{
+			name:  "json object",
+			value: ` {"b": 2.5, "a": "x", "c": null}`,
+			expected: []ExpandedValue{
+				{Key: "a", Value: "x"},
+				{Key: "b", Value: "2.5"},
+			},
+		},
+		{
+			name:  "postgres array",
+			value: `{1,2,NULL,3}`,
+			expected: []ExpandedValue{
+				{Key: "0", Value: "1", IsIndex: true},
+				{Key: "1", Value: "2", IsIndex: true},
+				{Key: "3", Value: "3", IsIndex: true},
+			},
+		},
+		{
+			name:  "postgres array with quoted elements",
+			value: `{"a,b","say \"hi\"","NULL",{1,2}}`,
+			expected: []ExpandedValue{
+				{Key: "0", Value: "a,b", IsIndex: true},
+				{Key: "1", Value: `say "hi"`, IsIndex: true},
+				{Key: "2", Value: "NULL", IsIndex: true},
+				{Key: "3", Value: "{1,2}", IsIndex: true},
+			},
+		},
+		{
+			name:     "empty postgres array",
+			value:    `{}`,
+			expected: []ExpandedValue{},
+		},
+		{
+			name:   "scalar",
+			value:  "42",
+			errMsg: "value is not an array or a JSON object",
+		},
+		{
+			name:   "invalid json array",
+			value:  "[1,",
+			errMsg: "invalid JSON array: unexpected end of JSON input",
+		},
+		{
+			name:   "unbalanced postgres array",
+			value:  `{"a}`,
+			errMsg: "invalid array: unbalanced quotes or braces",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expanded, err := ExpandValue(tt.value)
+			if tt.errMsg != "" {
+				assert.EqualError(t, err, tt.errMsg)
+				return
+			}
+			require.NoError(t, err)
+			if len(tt.expected) == 0 {
+				assert.Empty(t, expanded)
+				return
+			}
+			assert.Equal(t, tt.expected, expanded)
+		})
+	}
+}
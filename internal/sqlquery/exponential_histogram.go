@@ -0,0 +1,175 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlquery // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/sqlquery"
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/scraperhelper"
+)
+
+// The range of the scales of the exponential histograms supported by OTLP.
+const (
+	minExponentialHistogramScale = -10
+	maxExponentialHistogramScale = 20
+)
+
+// ExponentialHistogramCfg maps the columns of a row to the data point of an exponential
+// histogram, for the databases storing native histograms.
+type ExponentialHistogramCfg struct {
+	// ScaleColumn is the column holding the scale of the histogram.
+	ScaleColumn string `mapstructure:"scale_column"`
+	// ZeroCountColumn is the column holding the count of the values in the zero bucket.
+	ZeroCountColumn string `mapstructure:"zero_count_column"`
+	// Positive and Negative are the buckets of the positive and the negative values.
+	Positive ExponentialBucketsCfg `mapstructure:"positive"`
+	Negative ExponentialBucketsCfg `mapstructure:"negative"`
+	// CountColumn is the column holding the count of the values, the sum of the counts of the
+	// buckets when empty.
+	CountColumn string `mapstructure:"count_column"`
+	// SumColumn is the column holding the sum of the values, not set when empty.
+	SumColumn string `mapstructure:"sum_column"`
+}
+
+// ExponentialBucketsCfg maps the columns of the positive or the negative buckets.
+type ExponentialBucketsCfg struct {
+	// OffsetColumn is the column holding the index of the first bucket, 0 when empty.
+	OffsetColumn string `mapstructure:"offset_column"`
+	// BucketCountsColumn is the column holding the counts of the buckets, as a JSON array or
+	// an array in the Postgres text format.
+	BucketCountsColumn string `mapstructure:"bucket_counts_column"`
+}
+
+func (c ExponentialHistogramCfg) Validate() error {
+	var errs []error
+	if c.ScaleColumn == "" {
+		errs = append(errs, errors.New("'exponential_histogram.scale_column' cannot be empty"))
+	}
+	if c.Positive.OffsetColumn != "" && c.Positive.BucketCountsColumn == "" {
+		errs = append(errs, errors.New("'exponential_histogram.positive.offset_column' requires 'bucket_counts_column'"))
+	}
+	if c.Negative.OffsetColumn != "" && c.Negative.BucketCountsColumn == "" {
+		errs = append(errs, errors.New("'exponential_histogram.negative.offset_column' requires 'bucket_counts_column'"))
+	}
+	return errors.Join(errs...)
+}
+
+func rowToExponentialHistogram(row StringMap, cfg MetricCfg, dest pmetric.Metric, startTime pcommon.Timestamp, ts pcommon.Timestamp, scrapeCfg scraperhelper.ControllerConfig) error {
+	histogram := dest.SetEmptyExponentialHistogram()
+	histogram.SetAggregationTemporality(cfgToAggregationTemporality(cfg.Aggregation))
+	startTime, ts, err := rowTimestamps(row, cfg, startTime, ts)
+	if err != nil {
+		return err
+	}
+	dataPoint := histogram.DataPoints().AppendEmpty()
+	dataPoint.SetTimestamp(ts)
+	dataPoint.SetStartTimestamp(histogramStartTimestamp(cfg, startTime, ts, scrapeCfg))
+
+	histogramCfg := cfg.ExponentialHistogram
+	scale, err := histogramInt32(row, histogramCfg.ScaleColumn)
+	if err != nil {
+		return err
+	}
+	if scale < minExponentialHistogramScale || scale > maxExponentialHistogramScale {
+		return fmt.Errorf("rowToMetric: scale_column '%s': the scale %d is out of the range [%d, %d]", histogramCfg.ScaleColumn, scale, minExponentialHistogramScale, maxExponentialHistogramScale)
+	}
+	dataPoint.SetScale(scale)
+
+	var total uint64
+	if histogramCfg.ZeroCountColumn != "" {
+		zeroCount, err := histogramCount(row, histogramCfg.ZeroCountColumn)
+		if err != nil {
+			return err
+		}
+		dataPoint.SetZeroCount(zeroCount)
+		total += zeroCount
+	}
+	positive, err := setExponentialBuckets(row, histogramCfg.Positive, dataPoint.Positive())
+	if err != nil {
+		return err
+	}
+	negative, err := setExponentialBuckets(row, histogramCfg.Negative, dataPoint.Negative())
+	if err != nil {
+		return err
+	}
+	total += positive + negative
+
+	dataPoint.SetCount(total)
+	if histogramCfg.CountColumn != "" {
+		count, err := histogramCount(row, histogramCfg.CountColumn)
+		if err != nil {
+			return err
+		}
+		dataPoint.SetCount(count)
+	}
+	sum, found, err := histogramSum(row, histogramCfg.SumColumn)
+	if err != nil {
+		return err
+	}
+	if found {
+		dataPoint.SetSum(sum)
+	}
+	if err = appendExemplar(row, cfg, dataPoint.Exemplars(), ts, nil); err != nil {
+		return err
+	}
+	return setAttributes(row, cfg, dataPoint.Attributes())
+}
+
+// setExponentialBuckets sets the buckets from the array of their counts, the null elements being
+// empty buckets, and returns the total of the counts.
+func setExponentialBuckets(row StringMap, cfg ExponentialBucketsCfg, dest pmetric.ExponentialHistogramDataPointBuckets) (uint64, error) {
+	if cfg.BucketCountsColumn == "" {
+		return 0, nil
+	}
+	if cfg.OffsetColumn != "" {
+		offset, err := histogramInt32(row, cfg.OffsetColumn)
+		if err != nil {
+			return 0, err
+		}
+		dest.SetOffset(offset)
+	}
+	value, found := row[cfg.BucketCountsColumn]
+	if !found {
+		return 0, fmt.Errorf("rowToMetric: column '%s' not found in result set", cfg.BucketCountsColumn)
+	}
+	elements, err := ExpandValue(value)
+	if err != nil {
+		return 0, fmt.Errorf("rowToMetric: bucket_counts_column '%s': %w", cfg.BucketCountsColumn, err)
+	}
+	var counts []uint64
+	var total uint64
+	for _, element := range elements {
+		if !element.IsIndex {
+			return 0, fmt.Errorf("rowToMetric: bucket_counts_column '%s': the bucket counts must be an array", cfg.BucketCountsColumn)
+		}
+		index, _ := strconv.Atoi(element.Key)
+		count, err := parseHistogramCount(cfg.BucketCountsColumn, element.Value)
+		if err != nil {
+			return 0, err
+		}
+		for len(counts) <= index {
+			counts = append(counts, 0)
+		}
+		counts[index] = count
+		total += count
+	}
+	dest.BucketCounts().FromRaw(counts)
+	return total, nil
+}
+
+func histogramInt32(row StringMap, column string) (int32, error) {
+	value, found := row[column]
+	if !found {
+		return 0, fmt.Errorf("rowToMetric: column '%s' not found in result set", column)
+	}
+	parsed, err := strconv.ParseInt(value, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("rowToMetric: col %q: error converting to integer: %w", column, err)
+	}
+	return int32(parsed), nil
+}
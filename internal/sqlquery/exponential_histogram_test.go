@@ -0,0 +1,130 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlquery
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/scraperhelper"
+)
+
+func TestExponentialHistogramCfg_Validate(t *testing.T) {
+	assert.NoError(t, ExponentialHistogramCfg{ScaleColumn: "scale", Positive: ExponentialBucketsCfg{OffsetColumn: "offset", BucketCountsColumn: "buckets"}}.Validate())
+	assert.EqualError(t, ExponentialHistogramCfg{}.Validate(), "'exponential_histogram.scale_column' cannot be empty")
+	assert.EqualError(t, ExponentialHistogramCfg{ScaleColumn: "scale", Negative: ExponentialBucketsCfg{OffsetColumn: "offset"}}.Validate(),
+		"'exponential_histogram.negative.offset_column' requires 'bucket_counts_column'")
+
+	histogram := &ExponentialHistogramCfg{ScaleColumn: "scale"}
+	assert.NoError(t, MetricCfg{MetricName: "latency", DataType: MetricTypeExponentialHistogram, ExponentialHistogram: histogram}.Validate())
+	assert.ErrorContains(t, MetricCfg{MetricName: "latency", DataType: MetricTypeExponentialHistogram}.Validate(),
+		"'data_type: exponential_histogram' requires 'exponential_histogram'")
+	assert.ErrorContains(t, MetricCfg{MetricName: "latency", ValueColumn: "latency", DataType: MetricTypeExponentialHistogram, ExponentialHistogram: histogram}.Validate(),
+		"'value_column' cannot be set with 'data_type: exponential_histogram'")
+	assert.ErrorContains(t, MetricCfg{MetricName: "latency", DataType: MetricTypeHistogram, ExponentialHistogram: histogram}.Validate(),
+		"'exponential_histogram' requires 'data_type: exponential_histogram'")
+}
+
+func TestRowToExponentialHistogram(t *testing.T) {
+	row := StringMap{
+		"scale":          "3",
+		"zero_count":     "2",
+		"pos_offset":     "-1",
+		"pos_buckets":    "[1, null, 4]",
+		"neg_buckets":    "{5,6}",
+		"total_count":    "18",
+		"total_duration": "12.5",
+		"query":          "select",
+	}
+	cfg := MetricCfg{
+		MetricName:       "db.query.duration",
+		DataType:         MetricTypeExponentialHistogram,
+		AttributeColumns: []string{"query"},
+		ExponentialHistogram: &ExponentialHistogramCfg{
+			ScaleColumn:     "scale",
+			ZeroCountColumn: "zero_count",
+			Positive:        ExponentialBucketsCfg{OffsetColumn: "pos_offset", BucketCountsColumn: "pos_buckets"},
+			Negative:        ExponentialBucketsCfg{BucketCountsColumn: "neg_buckets"},
+			SumColumn:       "total_duration",
+		},
+	}
+	startTime := pcommon.NewTimestampFromTime(time.Now().Add(-time.Hour))
+	ts := pcommon.NewTimestampFromTime(time.Now())
+	metric := pmetric.NewMetric()
+	require.NoError(t, rowToMetric(row, cfg, metric, startTime, ts, scraperhelper.ControllerConfig{}))
+
+	require.Equal(t, pmetric.MetricTypeExponentialHistogram, metric.Type())
+	assert.Equal(t, pmetric.AggregationTemporalityCumulative, metric.ExponentialHistogram().AggregationTemporality())
+	dataPoint := metric.ExponentialHistogram().DataPoints().At(0)
+	assert.Equal(t, startTime, dataPoint.StartTimestamp())
+	assert.Equal(t, ts, dataPoint.Timestamp())
+	assert.EqualValues(t, 3, dataPoint.Scale())
+	assert.EqualValues(t, 2, dataPoint.ZeroCount())
+	assert.EqualValues(t, -1, dataPoint.Positive().Offset())
+	assert.Equal(t, []uint64{1, 0, 4}, dataPoint.Positive().BucketCounts().AsRaw())
+	assert.EqualValues(t, 0, dataPoint.Negative().Offset())
+	assert.Equal(t, []uint64{5, 6}, dataPoint.Negative().BucketCounts().AsRaw())
+	// the count defaults to the sum of the buckets without a count column
+	assert.EqualValues(t, 18, dataPoint.Count())
+	assert.Equal(t, 12.5, dataPoint.Sum())
+	assert.Equal(t, map[string]any{"query": "select"}, dataPoint.Attributes().AsRaw())
+
+	cfg.ExponentialHistogram.CountColumn = "total_count"
+	row["total_count"] = "20"
+	metric = pmetric.NewMetric()
+	require.NoError(t, rowToMetric(row, cfg, metric, startTime, ts, scraperhelper.ControllerConfig{}))
+	assert.EqualValues(t, 20, metric.ExponentialHistogram().DataPoints().At(0).Count())
+}
+
+func TestRowToExponentialHistogram_Errors(t *testing.T) {
+	cfg := MetricCfg{
+		MetricName: "db.query.duration",
+		DataType:   MetricTypeExponentialHistogram,
+		ExponentialHistogram: &ExponentialHistogramCfg{
+			ScaleColumn: "scale",
+			Positive:    ExponentialBucketsCfg{BucketCountsColumn: "buckets"},
+		},
+	}
+	tests := []struct {
+		name string
+		row  StringMap
+		err  string
+	}{
+		{
+			name: "missing scale",
+			row:  StringMap{"buckets": "[1]"},
+			err:  "rowToMetric: column 'scale' not found in result set",
+		},
+		{
+			name: "scale out of range",
+			row:  StringMap{"scale": "21", "buckets": "[1]"},
+			err:  "rowToMetric: scale_column 'scale': the scale 21 is out of the range [-10, 20]",
+		},
+		{
+			name: "not an array",
+			row:  StringMap{"scale": "0", "buckets": "1"},
+			err:  "rowToMetric: bucket_counts_column 'buckets': value is not an array or a JSON object",
+		},
+		{
+			name: "object",
+			row:  StringMap{"scale": "0", "buckets": `{"a": 1}`},
+			err:  "rowToMetric: bucket_counts_column 'buckets': the bucket counts must be an array",
+		},
+		{
+			name: "negative count",
+			row:  StringMap{"scale": "0", "buckets": "[1, -2]"},
+			err:  `rowToMetric: col "buckets": error converting to count: "-2" is not a non-negative integer`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := rowToMetric(tt.row, cfg, pmetric.NewMetric(), 0, 0, scraperhelper.ControllerConfig{})
+			assert.EqualError(t, err, tt.err)
+		})
+	}
+}
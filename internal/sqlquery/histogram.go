@@ -0,0 +1,151 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlquery // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/sqlquery"
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/scraperhelper"
+)
+
+// HistogramCfg maps the bucket columns of a row to the data point of a histogram, for the
+// views exposing a distribution as a column per bucket.
+type HistogramCfg struct {
+	// ExplicitBounds are the upper bounds of the buckets, in increasing order.
+	ExplicitBounds []float64 `mapstructure:"explicit_bounds"`
+	// BucketCountColumns are the columns holding the counts of the buckets, one per bound followed
+	// by the column of the bucket above the last bound.
+	BucketCountColumns []string `mapstructure:"bucket_count_columns"`
+	// CumulativeBuckets is set when the bucket columns hold the counts of the values less than or
+	// equal to their bound, as the `le` buckets of Prometheus, rather than the count of the bucket.
+	CumulativeBuckets bool `mapstructure:"cumulative_buckets"`
+	// CountColumn is the column holding the count of the values, the sum of the counts of the
+	// buckets when empty.
+	CountColumn string `mapstructure:"count_column"`
+	// SumColumn is the column holding the sum of the values, not set when empty.
+	SumColumn string `mapstructure:"sum_column"`
+}
+
+func (c HistogramCfg) Validate() error {
+	var errs []error
+	if len(c.BucketCountColumns) != len(c.ExplicitBounds)+1 {
+		errs = append(errs, fmt.Errorf("'histogram.bucket_count_columns' must have one more column than 'histogram.explicit_bounds', got %d columns for %d bounds", len(c.BucketCountColumns), len(c.ExplicitBounds)))
+	}
+	for i := 1; i < len(c.ExplicitBounds); i++ {
+		if c.ExplicitBounds[i] <= c.ExplicitBounds[i-1] {
+			errs = append(errs, errors.New("'histogram.explicit_bounds' must be strictly increasing"))
+			break
+		}
+	}
+	for _, column := range c.BucketCountColumns {
+		if column == "" {
+			errs = append(errs, errors.New("'histogram.bucket_count_columns' cannot contain an empty column"))
+			break
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func rowToHistogram(row StringMap, cfg MetricCfg, dest pmetric.Metric, startTime pcommon.Timestamp, ts pcommon.Timestamp, scrapeCfg scraperhelper.ControllerConfig) error {
+	histogram := dest.SetEmptyHistogram()
+	histogram.SetAggregationTemporality(cfgToAggregationTemporality(cfg.Aggregation))
+	startTime, ts, err := rowTimestamps(row, cfg, startTime, ts)
+	if err != nil {
+		return err
+	}
+	dataPoint := histogram.DataPoints().AppendEmpty()
+	dataPoint.SetTimestamp(ts)
+	dataPoint.SetStartTimestamp(histogramStartTimestamp(cfg, startTime, ts, scrapeCfg))
+
+	histogramCfg := cfg.Histogram
+	counts := make([]uint64, len(histogramCfg.BucketCountColumns))
+	var total, previous uint64
+	for i, column := range histogramCfg.BucketCountColumns {
+		count, err := histogramCount(row, column)
+		if err != nil {
+			return err
+		}
+		if histogramCfg.CumulativeBuckets {
+			if count < previous {
+				return fmt.Errorf("rowToMetric: bucket_count_column '%s': the cumulative count %d is lower than the count %d of the previous bucket", column, count, previous)
+			}
+			count, previous = count-previous, count
+		}
+		counts[i] = count
+		total += count
+	}
+	dataPoint.ExplicitBounds().FromRaw(histogramCfg.ExplicitBounds)
+	dataPoint.BucketCounts().FromRaw(counts)
+
+	dataPoint.SetCount(total)
+	if histogramCfg.CountColumn != "" {
+		count, err := histogramCount(row, histogramCfg.CountColumn)
+		if err != nil {
+			return err
+		}
+		dataPoint.SetCount(count)
+	}
+	sum, found, err := histogramSum(row, histogramCfg.SumColumn)
+	if err != nil {
+		return err
+	}
+	if found {
+		dataPoint.SetSum(sum)
+	}
+	if err = appendExemplar(row, cfg, dataPoint.Exemplars(), ts, nil); err != nil {
+		return err
+	}
+	return setAttributes(row, cfg, dataPoint.Attributes())
+}
+
+// histogramStartTimestamp returns the start of the cumulation of the histograms, the previous
+// collection for the delta histograms.
+func histogramStartTimestamp(cfg MetricCfg, startTime pcommon.Timestamp, ts pcommon.Timestamp, scrapeCfg scraperhelper.ControllerConfig) pcommon.Timestamp {
+	if cfg.Aggregation == MetricAggregationDelta {
+		return pcommon.NewTimestampFromTime(ts.AsTime().Add(-scrapeCfg.CollectionInterval))
+	}
+	return startTime
+}
+
+// histogramSum parses the sum of the histogram, when configured.
+func histogramSum(row StringMap, column string) (float64, bool, error) {
+	if column == "" {
+		return 0, false, nil
+	}
+	value, found := row[column]
+	if !found {
+		return 0, false, fmt.Errorf("rowToMetric: sum_column '%s' not found in result set", column)
+	}
+	sum, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, false, fmt.Errorf("rowToMetric: col %q: error converting to double: %w", column, err)
+	}
+	return sum, true, nil
+}
+
+// histogramCount parses a count of the histogram, the drivers returning the aggregates of the
+// integer columns as decimals, e.g. `12.000`.
+func histogramCount(row StringMap, column string) (uint64, error) {
+	value, found := row[column]
+	if !found {
+		return 0, fmt.Errorf("rowToMetric: column '%s' not found in result set", column)
+	}
+	return parseHistogramCount(column, value)
+}
+
+func parseHistogramCount(column string, value string) (uint64, error) {
+	if count, err := strconv.ParseUint(value, 10, 64); err == nil {
+		return count, nil
+	}
+	count, err := strconv.ParseFloat(value, 64)
+	if err != nil || count < 0 || count != math.Trunc(count) {
+		return 0, fmt.Errorf("rowToMetric: col %q: error converting to count: %q is not a non-negative integer", column, value)
+	}
+	return uint64(count), nil
+}
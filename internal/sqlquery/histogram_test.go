@@ -0,0 +1,173 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlquery
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/scraperhelper"
+)
+
+func TestHistogramCfg_Validate(t *testing.T) {
+	assert.NoError(t, HistogramCfg{ExplicitBounds: []float64{1, 5}, BucketCountColumns: []string{"le_1", "le_5", "inf"}}.Validate())
+	assert.NoError(t, HistogramCfg{BucketCountColumns: []string{"count"}}.Validate())
+	assert.EqualError(t, HistogramCfg{ExplicitBounds: []float64{1, 5}, BucketCountColumns: []string{"le_1", "le_5"}}.Validate(),
+		"'histogram.bucket_count_columns' must have one more column than 'histogram.explicit_bounds', got 2 columns for 2 bounds")
+	assert.EqualError(t, HistogramCfg{ExplicitBounds: []float64{5, 1}, BucketCountColumns: []string{"le_5", "le_1", "inf"}}.Validate(),
+		"'histogram.explicit_bounds' must be strictly increasing")
+	assert.EqualError(t, HistogramCfg{ExplicitBounds: []float64{1}, BucketCountColumns: []string{"le_1", ""}}.Validate(),
+		"'histogram.bucket_count_columns' cannot contain an empty column")
+
+	histogram := &HistogramCfg{ExplicitBounds: []float64{1}, BucketCountColumns: []string{"le_1", "inf"}}
+	assert.NoError(t, MetricCfg{MetricName: "latency", DataType: MetricTypeHistogram, Histogram: histogram}.Validate())
+	assert.ErrorContains(t, MetricCfg{MetricName: "latency", DataType: MetricTypeHistogram}.Validate(),
+		"'data_type: histogram' requires 'histogram'")
+	assert.ErrorContains(t, MetricCfg{MetricName: "latency", ValueColumn: "latency", DataType: MetricTypeHistogram, Histogram: histogram}.Validate(),
+		"'value_column' cannot be set with 'data_type: histogram'")
+	assert.ErrorContains(t, MetricCfg{MetricName: "latency", DataType: MetricTypeHistogram, Histogram: histogram, ExpandValue: true}.Validate(),
+		"'expand_value' cannot be set with 'data_type: histogram'")
+	assert.ErrorContains(t, MetricCfg{MetricName: "latency", ValueColumn: "latency", Histogram: histogram}.Validate(),
+		"'histogram' requires 'data_type: histogram'")
+}
+
+func TestRowToHistogram(t *testing.T) {
+	row := StringMap{"le_10": "3", "le_100": "5", "inf": "1", "calls": "9", "total": "420.5", "query": "select"}
+	cfg := MetricCfg{
+		MetricName:       "db.query.latency",
+		Unit:             "ms",
+		DataType:         MetricTypeHistogram,
+		AttributeColumns: []string{"query"},
+		StaticAttributes: map[string]string{"db": "orders"},
+		Histogram: &HistogramCfg{
+			ExplicitBounds:     []float64{10, 100},
+			BucketCountColumns: []string{"le_10", "le_100", "inf"},
+			CountColumn:        "calls",
+			SumColumn:          "total",
+		},
+	}
+	startTime := pcommon.NewTimestampFromTime(time.Now().Add(-time.Hour))
+	ts := pcommon.NewTimestampFromTime(time.Now())
+	metric := pmetric.NewMetric()
+	require.NoError(t, rowToMetric(row, cfg, metric, startTime, ts, scraperhelper.ControllerConfig{}))
+
+	assert.Equal(t, "db.query.latency", metric.Name())
+	assert.Equal(t, "ms", metric.Unit())
+	require.Equal(t, pmetric.MetricTypeHistogram, metric.Type())
+	assert.Equal(t, pmetric.AggregationTemporalityCumulative, metric.Histogram().AggregationTemporality())
+	dataPoint := metric.Histogram().DataPoints().At(0)
+	assert.Equal(t, startTime, dataPoint.StartTimestamp())
+	assert.Equal(t, ts, dataPoint.Timestamp())
+	assert.Equal(t, []float64{10, 100}, dataPoint.ExplicitBounds().AsRaw())
+	assert.Equal(t, []uint64{3, 5, 1}, dataPoint.BucketCounts().AsRaw())
+	assert.EqualValues(t, 9, dataPoint.Count())
+	assert.Equal(t, 420.5, dataPoint.Sum())
+	assert.Equal(t, map[string]any{"query": "select", "db": "orders"}, dataPoint.Attributes().AsRaw())
+}
+
+func TestRowToHistogram_CumulativeBuckets(t *testing.T) {
+	cfg := MetricCfg{
+		MetricName:  "db.query.latency",
+		DataType:    MetricTypeHistogram,
+		Aggregation: MetricAggregationDelta,
+		Histogram: &HistogramCfg{
+			ExplicitBounds:     []float64{10, 100},
+			BucketCountColumns: []string{"le_10", "le_100", "le_inf"},
+			CumulativeBuckets:  true,
+		},
+	}
+	ts := pcommon.NewTimestampFromTime(time.Now())
+	metric := pmetric.NewMetric()
+	scrapeCfg := scraperhelper.ControllerConfig{CollectionInterval: time.Minute}
+	require.NoError(t, rowToMetric(StringMap{"le_10": "3", "le_100": "8.000", "le_inf": "9"}, cfg, metric, 0, ts, scrapeCfg))
+
+	assert.Equal(t, pmetric.AggregationTemporalityDelta, metric.Histogram().AggregationTemporality())
+	dataPoint := metric.Histogram().DataPoints().At(0)
+	assert.Equal(t, pcommon.NewTimestampFromTime(ts.AsTime().Add(-time.Minute)), dataPoint.StartTimestamp())
+	assert.Equal(t, []uint64{3, 5, 1}, dataPoint.BucketCounts().AsRaw())
+	// the count defaults to the sum of the buckets without a count column
+	assert.EqualValues(t, 9, dataPoint.Count())
+	assert.False(t, dataPoint.HasSum())
+
+	err := rowToMetric(StringMap{"le_10": "3", "le_100": "2", "le_inf": "9"}, cfg, pmetric.NewMetric(), 0, ts, scrapeCfg)
+	assert.EqualError(t, err, "rowToMetric: bucket_count_column 'le_100': the cumulative count 2 is lower than the count 3 of the previous bucket")
+}
+
+func TestRowToHistogram_Errors(t *testing.T) {
+	cfg := MetricCfg{
+		MetricName: "db.query.latency",
+		DataType:   MetricTypeHistogram,
+		Histogram: &HistogramCfg{
+			ExplicitBounds:     []float64{10},
+			BucketCountColumns: []string{"le_10", "inf"},
+			SumColumn:          "total",
+		},
+	}
+	tests := []struct {
+		name string
+		row  StringMap
+		err  string
+	}{
+		{
+			name: "missing bucket column",
+			row:  StringMap{"le_10": "3", "total": "1"},
+			err:  "rowToMetric: column 'inf' not found in result set",
+		},
+		{
+			name: "negative count",
+			row:  StringMap{"le_10": "-3", "inf": "1", "total": "1"},
+			err:  `rowToMetric: col "le_10": error converting to count: "-3" is not a non-negative integer`,
+		},
+		{
+			name: "fractional count",
+			row:  StringMap{"le_10": "3.5", "inf": "1", "total": "1"},
+			err:  `rowToMetric: col "le_10": error converting to count: "3.5" is not a non-negative integer`,
+		},
+		{
+			name: "missing sum column",
+			row:  StringMap{"le_10": "3", "inf": "1"},
+			err:  "rowToMetric: sum_column 'total' not found in result set",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := rowToMetric(tt.row, cfg, pmetric.NewMetric(), 0, 0, scraperhelper.ControllerConfig{})
+			assert.EqualError(t, err, tt.err)
+		})
+	}
+}
+
+func TestScraper_Histogram(t *testing.T) {
+	client := &FakeDBClient{
+		StringMaps: [][]StringMap{{
+			{"le_10": "3", "inf": "1", "query": "select"},
+			{"le_10": "7", "inf": "0", "query": "update"},
+		}},
+	}
+	scrpr := Scraper{
+		Client: client,
+		Query: Query{
+			Metrics: []MetricCfg{{
+				MetricName:       "db.query.latency",
+				DataType:         MetricTypeHistogram,
+				AttributeColumns: []string{"query"},
+				Histogram: &HistogramCfg{
+					ExplicitBounds:     []float64{10},
+					BucketCountColumns: []string{"le_10", "inf"},
+				},
+			}},
+		},
+	}
+	metrics, err := scrpr.Scrape(context.Background())
+	require.NoError(t, err)
+	ms := metrics.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 2, ms.Len())
+	assert.Equal(t, []uint64{3, 1}, ms.At(0).Histogram().DataPoints().At(0).BucketCounts().AsRaw())
+	assert.Equal(t, []uint64{7, 0}, ms.At(1).Histogram().DataPoints().At(0).BucketCounts().AsRaw())
+}
@@ -0,0 +1,33 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlquery // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/sqlquery"
+
+import "fmt"
+
+// rowMetadataCfg returns the config of the metric of the row whose unit and description are set
+// from the unit and description columns, as the statistics views of several databases expose
+// them next to the values. The unit and the description of the config are kept when the
+// columns of the row are empty.
+func rowMetadataCfg(row StringMap, cfg MetricCfg) (MetricCfg, error) {
+	rowCfg := cfg
+	if cfg.UnitColumn != "" {
+		unit, found := row[cfg.UnitColumn]
+		if !found {
+			return MetricCfg{}, fmt.Errorf("rowToMetric: unit_column '%s' not found in result set", cfg.UnitColumn)
+		}
+		if unit != "" {
+			rowCfg.Unit = unit
+		}
+	}
+	if cfg.DescriptionColumn != "" {
+		description, found := row[cfg.DescriptionColumn]
+		if !found {
+			return MetricCfg{}, fmt.Errorf("rowToMetric: description_column '%s' not found in result set", cfg.DescriptionColumn)
+		}
+		if description != "" {
+			rowCfg.Description = description
+		}
+	}
+	return rowCfg, nil
+}
@@ -0,0 +1,85 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlquery
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+)
+
+func TestScraper_MetadataColumns(t *testing.T) {
+	client := &FakeDBClient{
+		StringMaps: [][]StringMap{{
+			{"stat_name": "buffer_hits", "value": "120", "unit": "{hit}", "description": "Buffer cache hits"},
+			{"stat_name": "read_time", "value": "3", "unit": "ms", "description": ""},
+			{"stat_name": "sessions", "value": "4", "unit": "", "description": "Open sessions"},
+		}},
+	}
+	scrpr := Scraper{
+		Client: client,
+		Query: Query{
+			Metrics: []MetricCfg{{
+				MetricName:        "db.stat",
+				MetricNameColumn:  "stat_name",
+				ValueColumn:       "value",
+				Unit:              "1",
+				Description:       "Database statistic",
+				UnitColumn:        "unit",
+				DescriptionColumn: "description",
+				DataType:          MetricTypeGauge,
+			}},
+		},
+	}
+
+	metrics, err := scrpr.Scrape(context.Background())
+	require.NoError(t, err)
+	ms := metrics.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 3, ms.Len())
+	assert.Equal(t, "{hit}", ms.At(0).Unit())
+	assert.Equal(t, "Buffer cache hits", ms.At(0).Description())
+	assert.Equal(t, "ms", ms.At(1).Unit())
+	assert.Equal(t, "Database statistic", ms.At(1).Description())
+	assert.Equal(t, "1", ms.At(2).Unit())
+	assert.Equal(t, "Open sessions", ms.At(2).Description())
+}
+
+func TestScraper_MetadataColumnNotFound(t *testing.T) {
+	client := &FakeDBClient{
+		StringMaps: [][]StringMap{{
+			{"value": "1", "unit": "By"},
+		}},
+	}
+	scrpr := Scraper{
+		Client: client,
+		Query: Query{
+			Metrics: []MetricCfg{{
+				MetricName:        "db.size",
+				ValueColumn:       "value",
+				UnitColumn:        "unit",
+				DescriptionColumn: "description",
+				DataType:          MetricTypeGauge,
+			}},
+		},
+	}
+
+	_, err := scrpr.Scrape(context.Background())
+	assert.True(t, scrapererror.IsPartialScrapeError(err))
+	assert.ErrorContains(t, err, "row 0: rowToMetric: description_column 'description' not found in result set")
+}
+
+func TestRowMetricCfgsMetadataColumns(t *testing.T) {
+	row := StringMap{"reads": "10", "writes": "2", "unit": "By"}
+
+	// the metadata columns aren't values
+	cfgs, err := Query{}.rowMetricCfgs(row, MetricCfg{ValueColumns: []string{"*"}, UnitColumn: "unit"})
+	require.NoError(t, err)
+	assert.Equal(t, []MetricCfg{
+		{MetricName: "reads", ValueColumn: "reads", ValueType: MetricValueTypeInt, Unit: "By", UnitColumn: "unit"},
+		{MetricName: "writes", ValueColumn: "writes", ValueType: MetricValueTypeInt, Unit: "By", UnitColumn: "unit"},
+	}, cfgs)
+}
@@ -0,0 +1,31 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlquery // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/sqlquery"
+
+import "fmt"
+
+// rowMetricNameCfg returns the config of the metric of the row named from the metric name
+// column, turning the rows of a key/value statistics table into a metric per key.
+func rowMetricNameCfg(row StringMap, cfg MetricCfg) (MetricCfg, error) {
+	name, found := row[cfg.MetricNameColumn]
+	if !found {
+		return MetricCfg{}, fmt.Errorf("rowToMetric: metric_name_column '%s' not found in result set", cfg.MetricNameColumn)
+	}
+	if name == "" {
+		return MetricCfg{}, fmt.Errorf("rowToMetric: metric_name_column '%s' is empty", cfg.MetricNameColumn)
+	}
+	rowCfg := cfg
+	rowCfg.MetricNameColumn = ""
+	rowCfg.MetricName = prefixedMetricName(cfg.MetricName, name)
+	return rowCfg, nil
+}
+
+// prefixedMetricName returns the name of a metric named from the result set, prefixed by the
+// metric name of the config when set.
+func prefixedMetricName(prefix string, name string) string {
+	if prefix == "" {
+		return name
+	}
+	return prefix + "." + name
+}
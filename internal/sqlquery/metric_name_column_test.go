@@ -0,0 +1,65 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlquery
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+)
+
+func TestScraper_MetricNameColumn(t *testing.T) {
+	client := &FakeDBClient{
+		StringMaps: [][]StringMap{{
+			{"stat_name": "commits", "value": "120", "db": "orders"},
+			{"stat_name": "rollbacks", "value": "3", "db": "orders"},
+			{"stat_name": "commits", "value": "45", "db": "users"},
+			{"stat_name": "", "value": "1", "db": "users"},
+		}},
+	}
+	scrpr := Scraper{
+		Client: client,
+		Query: Query{
+			Metrics: []MetricCfg{{
+				MetricName:       "db.stat",
+				MetricNameColumn: "stat_name",
+				ValueColumn:      "value",
+				AttributeColumns: []string{"db"},
+				DataType:         MetricTypeSum,
+				Monotonic:        true,
+			}},
+		},
+	}
+
+	metrics, err := scrpr.Scrape(context.Background())
+	assert.True(t, scrapererror.IsPartialScrapeError(err))
+	assert.ErrorContains(t, err, "row 3: rowToMetric: metric_name_column 'stat_name' is empty")
+	ms := metrics.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 3, ms.Len())
+	assert.Equal(t, "db.stat.commits", ms.At(0).Name())
+	assert.Equal(t, "db.stat.rollbacks", ms.At(1).Name())
+	assert.Equal(t, "db.stat.commits", ms.At(2).Name())
+	assert.True(t, ms.At(1).Sum().IsMonotonic())
+	assert.EqualValues(t, 45, ms.At(2).Sum().DataPoints().At(0).IntValue())
+	assert.Equal(t, map[string]any{"db": "users"}, ms.At(2).Sum().DataPoints().At(0).Attributes().AsRaw())
+}
+
+func TestRowMetricNameCfg(t *testing.T) {
+	cfg, err := rowMetricNameCfg(StringMap{"name": "buffer_hits", "value": "1"}, MetricCfg{MetricNameColumn: "name", ValueColumn: "value"})
+	require.NoError(t, err)
+	assert.Equal(t, MetricCfg{MetricName: "buffer_hits", ValueColumn: "value"}, cfg)
+
+	_, err = rowMetricNameCfg(StringMap{"value": "1"}, MetricCfg{MetricNameColumn: "name", ValueColumn: "value"})
+	assert.EqualError(t, err, "rowToMetric: metric_name_column 'name' not found in result set")
+}
+
+func TestMetricCfgValidateMetricNameColumn(t *testing.T) {
+	assert.NoError(t, MetricCfg{MetricNameColumn: "name", ValueColumn: "value"}.Validate())
+	assert.EqualError(t, MetricCfg{MetricNameColumn: "name"}.Validate(), "'value_column' cannot be empty")
+	assert.ErrorContains(t, MetricCfg{MetricNameColumn: "name", ValueColumns: []string{"*"}}.Validate(),
+		"'metric_name_column' cannot be set with 'value_columns'")
+}
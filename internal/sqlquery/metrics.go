@@ -16,40 +16,79 @@ func rowToMetric(row StringMap, cfg MetricCfg, dest pmetric.Metric, startTime pc
 	dest.SetName(cfg.MetricName)
 	dest.SetDescription(cfg.Description)
 	dest.SetUnit(cfg.Unit)
+	switch cfg.DataType {
+	case MetricTypeHistogram:
+		return rowToHistogram(row, cfg, dest, startTime, ts, scrapeCfg)
+	case MetricTypeExponentialHistogram:
+		return rowToExponentialHistogram(row, cfg, dest, startTime, ts, scrapeCfg)
+	case MetricTypeSummary:
+		return rowToSummary(row, cfg, dest, startTime, ts)
+	}
 	dataPointSlice := setMetricFields(cfg, dest)
-	dataPoint := dataPointSlice.AppendEmpty()
+	startTime, ts, err := rowTimestamps(row, cfg, startTime, ts)
+	if err != nil {
+		return err
+	}
+	value, found := row[cfg.ValueColumn]
+	if !found {
+		return fmt.Errorf("rowToMetric: value_column '%s' not found in result set", cfg.ValueColumn)
+	}
+	if !cfg.ExpandValue {
+		return setDataPoint(row, cfg, value, dataPointSlice.AppendEmpty(), startTime, ts, scrapeCfg)
+	}
+	values, err := ExpandValue(value)
+	if err != nil {
+		return fmt.Errorf("rowToMetric: value_column '%s': %w", cfg.ValueColumn, err)
+	}
+	for _, v := range values {
+		dataPoint := dataPointSlice.AppendEmpty()
+		if err = setDataPoint(row, cfg, v.Value, dataPoint, startTime, ts, scrapeCfg); err != nil {
+			return err
+		}
+		dataPoint.Attributes().PutStr(expansionAttribute(cfg, v), v.Key)
+	}
+	return nil
+}
+
+func rowTimestamps(row StringMap, cfg MetricCfg, startTime pcommon.Timestamp, ts pcommon.Timestamp) (pcommon.Timestamp, pcommon.Timestamp, error) {
 	if cfg.StartTsColumn != "" {
 		if val, found := row[cfg.StartTsColumn]; found {
 			timestamp, err := strconv.ParseInt(val, 10, 64)
 			if err != nil {
-				return fmt.Errorf("failed to parse uint64 for %q, value was %q: %w", cfg.StartTsColumn, val, err)
+				return 0, 0, fmt.Errorf("failed to parse uint64 for %q, value was %q: %w", cfg.StartTsColumn, val, err)
 			}
 			startTime = pcommon.Timestamp(timestamp)
 		} else {
-			return fmt.Errorf("rowToMetric: start_ts_column not found")
+			return 0, 0, fmt.Errorf("rowToMetric: start_ts_column not found")
 		}
 	}
 	if cfg.TsColumn != "" {
 		if val, found := row[cfg.TsColumn]; found {
 			timestamp, err := strconv.ParseInt(val, 10, 64)
 			if err != nil {
-				return fmt.Errorf("failed to parse uint64 for %q, value was %q: %w", cfg.TsColumn, val, err)
+				return 0, 0, fmt.Errorf("failed to parse uint64 for %q, value was %q: %w", cfg.TsColumn, val, err)
 			}
 			ts = pcommon.Timestamp(timestamp)
 		} else {
-			return fmt.Errorf("rowToMetric: ts_column not found")
+			return 0, 0, fmt.Errorf("rowToMetric: ts_column not found")
 		}
 	}
+	return startTime, ts, nil
+}
+
+func setDataPoint(row StringMap, cfg MetricCfg, value string, dataPoint pmetric.NumberDataPoint, startTime pcommon.Timestamp, ts pcommon.Timestamp, scrapeCfg scraperhelper.ControllerConfig) error {
 	setTimestamp(cfg, dataPoint, startTime, ts, scrapeCfg)
-	value, found := row[cfg.ValueColumn]
-	if !found {
-		return fmt.Errorf("rowToMetric: value_column '%s' not found in result set", cfg.ValueColumn)
-	}
 	err := setDataPointValue(cfg, value, dataPoint)
 	if err != nil {
 		return fmt.Errorf("rowToMetric: %w", err)
 	}
-	attrs := dataPoint.Attributes()
+	if err = appendExemplar(row, cfg, dataPoint.Exemplars(), dataPoint.Timestamp(), numberExemplarValue(dataPoint)); err != nil {
+		return err
+	}
+	return setAttributes(row, cfg, dataPoint.Attributes())
+}
+
+func setAttributes(row StringMap, cfg MetricCfg, attrs pcommon.Map) error {
 	for k, v := range cfg.StaticAttributes {
 		attrs.PutStr(k, v)
 	}
@@ -63,6 +102,17 @@ func rowToMetric(row StringMap, cfg MetricCfg, dest pmetric.Metric, startTime pc
 	return nil
 }
 
+func expansionAttribute(cfg MetricCfg, v ExpandedValue) string {
+	switch {
+	case cfg.ExpansionAttribute != "":
+		return cfg.ExpansionAttribute
+	case v.IsIndex:
+		return "index"
+	default:
+		return "key"
+	}
+}
+
 func setTimestamp(cfg MetricCfg, dp pmetric.NumberDataPoint, startTime pcommon.Timestamp, ts pcommon.Timestamp, scrapeCfg scraperhelper.ControllerConfig) {
 	dp.SetTimestamp(ts)
 
@@ -0,0 +1,96 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlquery // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/sqlquery"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// ReplicaLagCfg configures the query measuring the lag of the replica the receiver is
+// connected to, e.g. `select extract(epoch from now() - pg_last_xact_replay_timestamp())`
+// for PostgreSQL. The query returns a single row with a single column, the lag in seconds.
+type ReplicaLagCfg struct {
+	SQL string `mapstructure:"sql"`
+	// MaxLag is the lag over which the incremental queries are skipped, the lag being only
+	// measured when 0.
+	MaxLag time.Duration `mapstructure:"max_lag"`
+}
+
+func (c ReplicaLagCfg) Validate() error {
+	var errs []error
+	if c.MaxLag < 0 {
+		errs = append(errs, errors.New("'replica_lag.max_lag' cannot be negative"))
+	}
+	if c.SQL == "" && c.MaxLag != 0 {
+		errs = append(errs, errors.New("'replica_lag.max_lag' requires 'replica_lag.sql'"))
+	}
+	return errors.Join(errs...)
+}
+
+// ReplicaLagChecker runs the replica lag query and reports the last measured lag as the
+// `receiver/sqlquery/replica_lag` metric.
+type ReplicaLagChecker struct {
+	Client DbClient
+	MaxLag time.Duration
+
+	// lag holds the bits of the last measured lag in seconds, NaN until it is measured.
+	lag atomic.Uint64
+}
+
+func NewReplicaLagChecker(cfg ReplicaLagCfg, meter metric.Meter, id component.ID) (*ReplicaLagChecker, error) {
+	checker := &ReplicaLagChecker{MaxLag: cfg.MaxLag}
+	checker.lag.Store(math.Float64bits(math.NaN()))
+	attrs := metric.WithAttributes(attribute.String("receiver", id.String()))
+	_, err := meter.Float64ObservableGauge(
+		"receiver/sqlquery/replica_lag",
+		metric.WithDescription("Lag of the replica the receiver is connected to, as last measured by the replica lag query"),
+		metric.WithUnit("s"),
+		metric.WithFloat64Callback(func(_ context.Context, observer metric.Float64Observer) error {
+			if lag := math.Float64frombits(checker.lag.Load()); !math.IsNaN(lag) {
+				observer.Observe(lag, attrs)
+			}
+			return nil
+		}),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return checker, nil
+}
+
+// Check measures the lag of the replica, and returns whether it exceeds the maximum lag. A
+// NULL lag, e.g. on a primary, never exceeds the maximum lag.
+func (c *ReplicaLagChecker) Check(ctx context.Context) (bool, error) {
+	rows, err := c.Client.QueryRows(ctx)
+	if err != nil && !errors.Is(err, ErrNullValueWarning) {
+		return false, fmt.Errorf("replica lag query failed: %w", err)
+	}
+	if len(rows) != 1 || len(rows[0]) != 1 {
+		return false, fmt.Errorf("replica lag query must return a single row with a single column, got %d rows", len(rows))
+	}
+	var value string
+	for _, v := range rows[0] {
+		value = v
+	}
+	if value == "" {
+		return false, nil
+	}
+	seconds, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return false, fmt.Errorf("failed to parse the replica lag %q: %w", value, err)
+	}
+	c.lag.Store(math.Float64bits(seconds))
+	lag := time.Duration(seconds * float64(time.Second))
+	return c.MaxLag > 0 && lag > c.MaxLag, nil
+}
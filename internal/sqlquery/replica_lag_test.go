@@ -0,0 +1,71 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlquery
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+func TestReplicaLagCfg_Validate(t *testing.T) {
+	require.NoError(t, ReplicaLagCfg{}.Validate())
+	require.NoError(t, ReplicaLagCfg{SQL: "select lag", MaxLag: time.Minute}.Validate())
+	assert.EqualError(t, ReplicaLagCfg{SQL: "select lag", MaxLag: -time.Minute}.Validate(), "'replica_lag.max_lag' cannot be negative")
+	assert.EqualError(t, ReplicaLagCfg{MaxLag: time.Minute}.Validate(), "'replica_lag.max_lag' requires 'replica_lag.sql'")
+}
+
+func TestReplicaLagChecker(t *testing.T) {
+	reader := sdkmetric.NewManualReader()
+	meterProvider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	checker, err := NewReplicaLagChecker(ReplicaLagCfg{SQL: "select lag", MaxLag: 30 * time.Second}, meterProvider.Meter("test"), component.MustNewID("sqlquery"))
+	require.NoError(t, err)
+
+	// the lag is not reported until it is measured
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &rm))
+	assert.Empty(t, rm.ScopeMetrics)
+
+	checker.Client = &FakeDBClient{StringMaps: [][]StringMap{
+		{{"lag": "12.5"}},
+		{{"lag": "45"}},
+		{{"lag": ""}},
+		{{"lag": "soon"}},
+		{{"lag": "1"}, {"lag": "2"}},
+	}}
+	lagging, err := checker.Check(context.Background())
+	require.NoError(t, err)
+	assert.False(t, lagging)
+	lagging, err = checker.Check(context.Background())
+	require.NoError(t, err)
+	assert.True(t, lagging)
+	lagging, err = checker.Check(context.Background())
+	require.NoError(t, err)
+	assert.False(t, lagging)
+	_, err = checker.Check(context.Background())
+	assert.ErrorContains(t, err, `failed to parse the replica lag "soon"`)
+	_, err = checker.Check(context.Background())
+	assert.EqualError(t, err, "replica lag query must return a single row with a single column, got 2 rows")
+
+	// the last measured lag is reported
+	require.NoError(t, reader.Collect(context.Background(), &rm))
+	require.Len(t, rm.ScopeMetrics, 1)
+	require.Len(t, rm.ScopeMetrics[0].Metrics, 1)
+	m := rm.ScopeMetrics[0].Metrics[0]
+	assert.Equal(t, "receiver/sqlquery/replica_lag", m.Name)
+	dps := m.Data.(metricdata.Gauge[float64]).DataPoints
+	require.Len(t, dps, 1)
+	assert.Equal(t, 45.0, dps[0].Value)
+
+	checker.Client = &FakeDBClient{Err: errors.New("connection refused")}
+	_, err = checker.Check(context.Background())
+	assert.EqualError(t, err, "replica lag query failed: connection refused")
+}
@@ -0,0 +1,62 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlquery // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/sqlquery"
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+// ResourceRows are the rows sharing the values of the resource attribute columns of a query.
+type ResourceRows struct {
+	// Attributes are the values of the resource attribute columns, set as the attributes
+	// of the resource.
+	Attributes map[string]string
+	Rows       []StringMap
+}
+
+// PutAttributes sets the resource attributes of the rows in attrs.
+func (r ResourceRows) PutAttributes(attrs pcommon.Map) {
+	for column, value := range r.Attributes {
+		attrs.PutStr(column, value)
+	}
+}
+
+// GroupRowsByResource groups the rows by the values of the resource attribute columns, in the
+// order of the first row of each resource. All the rows are in a single resource without
+// attributes when there are no resource attribute columns, even when there are no rows.
+func GroupRowsByResource(rows []StringMap, columns []string) ([]ResourceRows, error) {
+	if len(columns) == 0 {
+		return []ResourceRows{{Rows: rows}}, nil
+	}
+
+	var errs []error
+	var groups []ResourceRows
+	indexes := map[string]int{}
+	for i, row := range rows {
+		attributes := make(map[string]string, len(columns))
+		var key strings.Builder
+		for _, column := range columns {
+			value, found := row[column]
+			if !found {
+				errs = append(errs, fmt.Errorf("row %d: resource_attribute_columns: column '%s' not found in result set", i, column))
+				continue
+			}
+			attributes[column] = value
+			// the length prefixes keep the keys of different values distinct
+			fmt.Fprintf(&key, "%d:%s;%d:%s;", len(column), column, len(value), value)
+		}
+		index, found := indexes[key.String()]
+		if !found {
+			index = len(groups)
+			indexes[key.String()] = index
+			groups = append(groups, ResourceRows{Attributes: attributes})
+		}
+		groups[index].Rows = append(groups[index].Rows, row)
+	}
+	return groups, errors.Join(errs...)
+}
@@ -0,0 +1,57 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlquery
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+func TestGroupRowsByResource(t *testing.T) {
+	rows := []StringMap{
+		{"tenant_id": "a", "shard": "1", "count": "1"},
+		{"tenant_id": "b", "shard": "1", "count": "2"},
+		{"tenant_id": "a", "shard": "1", "count": "3"},
+		{"tenant_id": "a", "shard": "2", "count": "4"},
+	}
+
+	resources, err := GroupRowsByResource(rows, []string{"tenant_id", "shard"})
+	require.NoError(t, err)
+	assert.Equal(t, []ResourceRows{
+		{Attributes: map[string]string{"tenant_id": "a", "shard": "1"}, Rows: []StringMap{rows[0], rows[2]}},
+		{Attributes: map[string]string{"tenant_id": "b", "shard": "1"}, Rows: []StringMap{rows[1]}},
+		{Attributes: map[string]string{"tenant_id": "a", "shard": "2"}, Rows: []StringMap{rows[3]}},
+	}, resources)
+
+	attrs := pcommon.NewMap()
+	resources[1].PutAttributes(attrs)
+	assert.Equal(t, map[string]any{"tenant_id": "b", "shard": "1"}, attrs.AsRaw())
+}
+
+func TestGroupRowsByResource_NoColumns(t *testing.T) {
+	rows := []StringMap{{"count": "1"}, {"count": "2"}}
+	resources, err := GroupRowsByResource(rows, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []ResourceRows{{Rows: rows}}, resources)
+
+	resources, err = GroupRowsByResource(nil, nil)
+	require.NoError(t, err)
+	assert.Len(t, resources, 1)
+}
+
+func TestGroupRowsByResource_MissingColumn(t *testing.T) {
+	rows := []StringMap{
+		{"tenant_id": "a", "count": "1"},
+		{"count": "2"},
+	}
+	resources, err := GroupRowsByResource(rows, []string{"tenant_id"})
+	assert.EqualError(t, err, "row 1: resource_attribute_columns: column 'tenant_id' not found in result set")
+	assert.Equal(t, []ResourceRows{
+		{Attributes: map[string]string{"tenant_id": "a"}, Rows: []StringMap{rows[0]}},
+		{Attributes: map[string]string{}, Rows: []StringMap{rows[1]}},
+	}, resources)
+}
@@ -4,6 +4,7 @@
 package sqlquery // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/sqlquery"
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"reflect"
@@ -34,11 +35,24 @@ func newRowScanner(colTypes []colType) *rowScanner {
 			if t, isTime := v.(time.Time); isTime {
 				return t.Format(time.RFC3339), nil
 			}
-			if reflect.TypeOf(v).Kind() == reflect.Slice {
-				// The Postgres driver returns a []uint8 (ascii string) for decimal and numeric types,
-				// which we want to render as strings. e.g. "4.1" instead of "[52, 46, 49]".
-				// Other slice types get the same treatment.
-				format = "%s"
+			if s, isStringer := v.(fmt.Stringer); isStringer {
+				// e.g. the UUID, IPv4 and Decimal types of ClickHouse
+				return s.String(), nil
+			}
+			switch reflect.TypeOf(v).Kind() {
+			case reflect.Slice:
+				if reflect.TypeOf(v).Elem().Kind() == reflect.Uint8 {
+					// The Postgres driver returns a []uint8 (ascii string) for decimal and numeric types,
+					// which we want to render as strings. e.g. "4.1" instead of "[52, 46, 49]".
+					format = "%s"
+					break
+				}
+				fallthrough
+			case reflect.Array, reflect.Map:
+				// e.g. the Array and Map types of ClickHouse, rendered as JSON
+				if b, err := json.Marshal(v); err == nil {
+					return string(b), nil
+				}
 			}
 			// turn whatever we got from the database driver into a string
 			return fmt.Sprintf(format, v), nil
@@ -0,0 +1,38 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlquery // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/sqlquery"
+
+import (
+	"errors"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+// ScopeCfg is the instrumentation scope of the metrics and the logs of a query, left empty
+// when not configured.
+type ScopeCfg struct {
+	Name       string            `mapstructure:"name"`
+	Version    string            `mapstructure:"version"`
+	Attributes map[string]string `mapstructure:"attributes"`
+}
+
+func (c ScopeCfg) Validate() error {
+	var errs []error
+	if c.Version != "" && c.Name == "" {
+		errs = append(errs, errors.New("'scope.version' cannot be set without 'scope.name'"))
+	}
+	if _, found := c.Attributes[""]; found {
+		errs = append(errs, errors.New("'scope.attributes' cannot have an empty key"))
+	}
+	return errors.Join(errs...)
+}
+
+// CopyTo sets the name, the version and the attributes of the scope.
+func (c ScopeCfg) CopyTo(scope pcommon.InstrumentationScope) {
+	scope.SetName(c.Name)
+	scope.SetVersion(c.Version)
+	for key, value := range c.Attributes {
+		scope.Attributes().PutStr(key, value)
+	}
+}
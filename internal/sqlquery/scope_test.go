@@ -0,0 +1,36 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlquery
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+func TestScopeCfg_Validate(t *testing.T) {
+	assert.NoError(t, ScopeCfg{}.Validate())
+	assert.NoError(t, ScopeCfg{Name: "orders", Version: "2", Attributes: map[string]string{"team": "payments"}}.Validate())
+	assert.EqualError(t, ScopeCfg{Version: "2"}.Validate(), "'scope.version' cannot be set without 'scope.name'")
+	assert.EqualError(t, ScopeCfg{Name: "orders", Attributes: map[string]string{"": "payments"}}.Validate(), "'scope.attributes' cannot have an empty key")
+	assert.EqualError(t, Query{SQL: "select count(*) as count from orders", Metrics: []MetricCfg{{
+		MetricName:  "orders.count",
+		ValueColumn: "count",
+		ValueType:   MetricValueTypeInt,
+		DataType:    MetricTypeGauge,
+	}}, Scope: ScopeCfg{Version: "2"}}.Validate(), "'scope.version' cannot be set without 'scope.name'")
+}
+
+func TestScopeCfg_CopyTo(t *testing.T) {
+	scope := pcommon.NewInstrumentationScope()
+	ScopeCfg{Name: "orders", Version: "2", Attributes: map[string]string{"team": "payments"}}.CopyTo(scope)
+	assert.Equal(t, "orders", scope.Name())
+	assert.Equal(t, "2", scope.Version())
+	assert.Equal(t, map[string]any{"team": "payments"}, scope.Attributes().AsRaw())
+
+	scope = pcommon.NewInstrumentationScope()
+	ScopeCfg{}.CopyTo(scope)
+	assert.Equal(t, pcommon.NewInstrumentationScope(), scope)
+}
@@ -35,6 +35,18 @@ type Scraper struct {
 	Telemetry          TelemetryConfig
 	Client             DbClient
 	Db                 *sql.DB
+	PreparedStatements bool
+	StatementTelemetry *StatementTelemetry
+	// StorageProviderFunc provides the storage persisting the start timestamps of the monotonic
+	// cumulative sums across restarts, they are kept in memory only when nil.
+	StorageProviderFunc StorageProviderFunc
+
+	// wrappedDb runs the queries on Db, caching their prepared statements when PreparedStatements is set.
+	wrappedDb        Db
+	attributeLimiter *attributeLimiter
+	startTimes       *startTimeTracker
+	staleness        *stalenessTracker
+	storage          StateStorage
 }
 
 var _ scraperhelper.Scraper = (*Scraper)(nil)
@@ -55,18 +67,55 @@ func (s *Scraper) ID() component.ID {
 	return s.id
 }
 
-func (s *Scraper) Start(context.Context, component.Host) error {
+func (s *Scraper) Start(ctx context.Context, host component.Host) error {
 	var err error
 	s.Db, err = s.DbProviderFunc()
 	if err != nil {
 		return fmt.Errorf("failed to open Db connection: %w", err)
 	}
-	s.Client = s.ClientProviderFunc(DbWrapper{s.Db}, s.Query.SQL, s.Logger, s.Telemetry)
+	s.wrappedDb = WrapDb(s.Db, s.PreparedStatements, s.StatementTelemetry)
+	s.Client = s.ClientProviderFunc(s.wrappedDb, s.Query.SQL, s.Logger, s.Telemetry)
 	s.StartTime = pcommon.NewTimestampFromTime(time.Now())
+	s.attributeLimiter = newAttributeLimiter(s.Query.AttributeLimits)
+	s.startTimes = newStartTimeTracker()
+	if s.Query.StalenessMarkers {
+		s.staleness = newStalenessTracker()
+	}
+	if s.StorageProviderFunc != nil {
+		s.storage, err = s.StorageProviderFunc(ctx, host)
+		if err != nil {
+			return fmt.Errorf("error connecting to storage: %w", err)
+		}
+		s.retrieveStartTimes(ctx)
+	}
 
 	return nil
 }
 
+// retrieveStartTimes restores the start timestamps of the series from storage, so that the
+// cumulations continue across the restarts of the collector.
+func (s *Scraper) retrieveStartTimes(ctx context.Context) {
+	state, err := s.storage.Get(ctx, startTimesStorageKey)
+	if err != nil || state == nil {
+		return
+	}
+	if err = s.startTimes.unmarshalState(state); err != nil {
+		s.Logger.Warn("Failed to restore the start timestamps of the cumulative sums, their cumulations restart", zap.Error(err))
+	}
+}
+
+// storeStartTimes persists the start timestamps of the series, if storage is configured.
+func (s *Scraper) storeStartTimes(ctx context.Context) error {
+	if s.storage == nil {
+		return nil
+	}
+	state, err := s.startTimes.marshalState()
+	if err != nil {
+		return err
+	}
+	return s.storage.Set(ctx, startTimesStorageKey, state)
+}
+
 func (s *Scraper) Scrape(ctx context.Context) (pmetric.Metrics, error) {
 	out := pmetric.NewMetrics()
 	rows, err := s.Client.QueryRows(ctx)
@@ -78,29 +127,77 @@ func (s *Scraper) Scrape(ctx context.Context) (pmetric.Metrics, error) {
 		}
 	}
 	ts := pcommon.NewTimestampFromTime(time.Now())
-	rms := out.ResourceMetrics()
-	rm := rms.AppendEmpty()
-	sms := rm.ScopeMetrics()
-	sm := sms.AppendEmpty()
-	ms := sm.Metrics()
 	var errs []error
-	for _, metricCfg := range s.Query.Metrics {
-		for i, row := range rows {
-			if err = rowToMetric(row, metricCfg, ms.AppendEmpty(), s.StartTime, ts, s.ScrapeCfg); err != nil {
-				err = fmt.Errorf("row %d: %w", i, err)
-				errs = append(errs, err)
+	startTimesChanged := false
+	for i, row := range rows {
+		rows[i] = s.attributeLimiter.apply(row)
+	}
+	resources, err := GroupRowsByResource(rows, s.Query.ResourceAttributeColumns)
+	if err != nil {
+		errs = append(errs, err)
+	}
+	for _, resource := range resources {
+		rm := out.ResourceMetrics().AppendEmpty()
+		resource.PutAttributes(rm.Resource().Attributes())
+		sm := rm.ScopeMetrics().AppendEmpty()
+		s.Query.Scope.CopyTo(sm.Scope())
+		ms := sm.Metrics()
+		for _, queryMetricCfg := range s.Query.Metrics {
+			for i, row := range resource.Rows {
+				var metricCfgs []MetricCfg
+				if metricCfgs, err = s.Query.rowMetricCfgs(row, queryMetricCfg); err != nil {
+					errs = append(errs, fmt.Errorf("row %d: %w", i, err))
+					continue
+				}
+				for _, metricCfg := range metricCfgs {
+					metric := ms.AppendEmpty()
+					if err = rowToMetric(row, metricCfg, metric, s.StartTime, ts, s.ScrapeCfg); err != nil {
+						err = fmt.Errorf("row %d: %w", i, err)
+						errs = append(errs, err)
+						continue
+					}
+					if s.startTimes != nil && tracksStartTime(metricCfg) {
+						startTimesChanged = true
+						if err = s.startTimes.adjust(rm.Resource().Attributes(), metric, ts); err != nil {
+							errs = append(errs, fmt.Errorf("row %d: %w", i, err))
+						}
+					}
+					if s.staleness != nil {
+						if err = s.staleness.observe(rm.Resource().Attributes(), metric); err != nil {
+							errs = append(errs, fmt.Errorf("row %d: %w", i, err))
+						}
+					}
+				}
 			}
 		}
 	}
+	if s.staleness != nil {
+		s.staleness.appendMarkers(out, s.Query.Scope, ts)
+	}
+	if s.startTimes != nil {
+		expiry := startTimeExpiryIntervals * s.ScrapeCfg.CollectionInterval
+		if s.startTimes.evict(ts - pcommon.Timestamp(expiry)) {
+			startTimesChanged = true
+		}
+	}
+	if startTimesChanged {
+		if err = s.storeStartTimes(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("error storing the start timestamps: %w", err))
+		}
+	}
 	if errs != nil {
 		return out, scrapererror.NewPartialScrapeError(errors.Join(errs...), len(errs))
 	}
 	return out, nil
 }
 
-func (s *Scraper) Shutdown(_ context.Context) error {
+func (s *Scraper) Shutdown(ctx context.Context) error {
+	var errs []error
+	if s.storage != nil {
+		errs = append(errs, s.storage.Close(ctx))
+	}
 	if s.Db != nil {
-		return s.Db.Close()
+		errs = append(errs, CloseStatements(s.wrappedDb), s.Db.Close())
 	}
-	return nil
+	return errors.Join(errs...)
 }
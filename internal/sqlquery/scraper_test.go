@@ -480,3 +480,161 @@ func TestScraper_StartAndTS_ErrorOnParse(t *testing.T) {
 	_, err := scrpr.Scrape(context.Background())
 	assert.Error(t, err)
 }
+
+func TestScraper_ExpandValue(t *testing.T) {
+	client := &FakeDBClient{
+		StringMaps: [][]StringMap{{
+			{"counts": "{3,5}", "host": "db1"},
+			{"counts": `{"reads": 1.5, "writes": 2}`, "host": "db2"},
+		}},
+	}
+	scrpr := Scraper{
+		Client: client,
+		Query: Query{
+			Metrics: []MetricCfg{{
+				MetricName:       "my.counts",
+				ValueColumn:      "counts",
+				AttributeColumns: []string{"host"},
+				ValueType:        MetricValueTypeDouble,
+				ExpandValue:      true,
+			}},
+		},
+	}
+	metrics, err := scrpr.Scrape(context.Background())
+	require.NoError(t, err)
+	ms := metrics.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 2, ms.Len())
+
+	dps := ms.At(0).Gauge().DataPoints()
+	require.Equal(t, 2, dps.Len())
+	assert.Equal(t, 3.0, dps.At(0).DoubleValue())
+	assert.Equal(t, map[string]any{"host": "db1", "index": "0"}, dps.At(0).Attributes().AsRaw())
+	assert.Equal(t, 5.0, dps.At(1).DoubleValue())
+	assert.Equal(t, map[string]any{"host": "db1", "index": "1"}, dps.At(1).Attributes().AsRaw())
+
+	dps = ms.At(1).Gauge().DataPoints()
+	require.Equal(t, 2, dps.Len())
+	assert.Equal(t, 1.5, dps.At(0).DoubleValue())
+	assert.Equal(t, map[string]any{"host": "db2", "key": "reads"}, dps.At(0).Attributes().AsRaw())
+	assert.Equal(t, 2.0, dps.At(1).DoubleValue())
+	assert.Equal(t, map[string]any{"host": "db2", "key": "writes"}, dps.At(1).Attributes().AsRaw())
+}
+
+func TestScraper_ExpandValue_Errors(t *testing.T) {
+	client := &FakeDBClient{
+		StringMaps: [][]StringMap{{
+			{"counts": "42"},
+			{"counts": "[1,2]"},
+		}},
+	}
+	scrpr := Scraper{
+		Client: client,
+		Query: Query{
+			Metrics: []MetricCfg{{
+				MetricName:         "my.counts",
+				ValueColumn:        "counts",
+				ExpandValue:        true,
+				ExpansionAttribute: "position",
+			}},
+		},
+	}
+	metrics, err := scrpr.Scrape(context.Background())
+	assert.EqualError(t, err, "row 0: rowToMetric: value_column 'counts': value is not an array or a JSON object")
+	assert.True(t, scrapererror.IsPartialScrapeError(err))
+	dps := metrics.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(1).Gauge().DataPoints()
+	require.Equal(t, 2, dps.Len())
+	assert.Equal(t, map[string]any{"position": "1"}, dps.At(1).Attributes().AsRaw())
+}
+
+func TestScraper_AttributeLimits(t *testing.T) {
+	client := &FakeDBClient{
+		StringMaps: [][]StringMap{{
+			{"count": "1", "host": "db1"},
+			{"count": "2", "host": "db2"},
+			{"count": "3", "host": "db3"},
+		}},
+	}
+	limits := []AttributeLimitCfg{{Column: "host", MaxDistinctValues: 2}}
+	scrpr := Scraper{
+		Client: client,
+		Query: Query{
+			Metrics: []MetricCfg{{
+				MetricName:       "my.count",
+				ValueColumn:      "count",
+				AttributeColumns: []string{"host"},
+				ValueType:        MetricValueTypeInt,
+				DataType:         MetricTypeGauge,
+			}},
+			AttributeLimits: limits,
+		},
+		attributeLimiter: newAttributeLimiter(limits),
+	}
+	metrics, err := scrpr.Scrape(context.Background())
+	require.NoError(t, err)
+	ms := metrics.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 3, ms.Len())
+	assert.Equal(t, map[string]any{"host": "db1"}, ms.At(0).Gauge().DataPoints().At(0).Attributes().AsRaw())
+	assert.Equal(t, map[string]any{"host": "db2"}, ms.At(1).Gauge().DataPoints().At(0).Attributes().AsRaw())
+	assert.Equal(t, map[string]any{"host": DefaultOverflowValue}, ms.At(2).Gauge().DataPoints().At(0).Attributes().AsRaw())
+}
+
+func TestScraper_ResourceAttributeColumns(t *testing.T) {
+	client := &FakeDBClient{
+		StringMaps: [][]StringMap{{
+			{"count": "1", "tenant_id": "a"},
+			{"count": "2", "tenant_id": "b"},
+			{"count": "3", "tenant_id": "a"},
+		}},
+	}
+	scrpr := Scraper{
+		Client: client,
+		Query: Query{
+			Metrics: []MetricCfg{{
+				MetricName:  "my.count",
+				ValueColumn: "count",
+				ValueType:   MetricValueTypeInt,
+				DataType:    MetricTypeGauge,
+			}},
+			ResourceAttributeColumns: []string{"tenant_id"},
+		},
+	}
+	metrics, err := scrpr.Scrape(context.Background())
+	require.NoError(t, err)
+	rms := metrics.ResourceMetrics()
+	require.Equal(t, 2, rms.Len())
+
+	assert.Equal(t, map[string]any{"tenant_id": "a"}, rms.At(0).Resource().Attributes().AsRaw())
+	ms := rms.At(0).ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 2, ms.Len())
+	assert.EqualValues(t, 1, ms.At(0).Gauge().DataPoints().At(0).IntValue())
+	assert.EqualValues(t, 3, ms.At(1).Gauge().DataPoints().At(0).IntValue())
+
+	assert.Equal(t, map[string]any{"tenant_id": "b"}, rms.At(1).Resource().Attributes().AsRaw())
+	ms = rms.At(1).ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 1, ms.Len())
+	assert.EqualValues(t, 2, ms.At(0).Gauge().DataPoints().At(0).IntValue())
+}
+
+func TestScraper_Scope(t *testing.T) {
+	client := &FakeDBClient{
+		StringMaps: [][]StringMap{{{"count": "1"}}},
+	}
+	scrpr := Scraper{
+		Client: client,
+		Query: Query{
+			Metrics: []MetricCfg{{
+				MetricName:  "my.count",
+				ValueColumn: "count",
+				ValueType:   MetricValueTypeInt,
+				DataType:    MetricTypeGauge,
+			}},
+			Scope: ScopeCfg{Name: "orders", Version: "2", Attributes: map[string]string{"team": "payments"}},
+		},
+	}
+	metrics, err := scrpr.Scrape(context.Background())
+	require.NoError(t, err)
+	scope := metrics.ResourceMetrics().At(0).ScopeMetrics().At(0).Scope()
+	assert.Equal(t, "orders", scope.Name())
+	assert.Equal(t, "2", scope.Version())
+	assert.Equal(t, map[string]any{"team": "payments"}, scope.Attributes().AsRaw())
+}
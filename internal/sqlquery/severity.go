@@ -0,0 +1,51 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlquery // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/sqlquery"
+
+import (
+	"fmt"
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/plog"
+)
+
+// severityNumbers maps the lowercase names of the severity numbers, and their common
+// aliases, to the severity numbers.
+var severityNumbers = func() map[string]plog.SeverityNumber {
+	m := map[string]plog.SeverityNumber{
+		"information": plog.SeverityNumberInfo,
+		"notice":      plog.SeverityNumberInfo2,
+		"warning":     plog.SeverityNumberWarn,
+		"err":         plog.SeverityNumberError,
+		"critical":    plog.SeverityNumberFatal,
+		"crit":        plog.SeverityNumberFatal,
+		"alert":       plog.SeverityNumberFatal2,
+		"emergency":   plog.SeverityNumberFatal3,
+		"emerg":       plog.SeverityNumberFatal3,
+		"panic":       plog.SeverityNumberFatal4,
+	}
+	for n := plog.SeverityNumberTrace; n <= plog.SeverityNumberFatal4; n++ {
+		m[strings.ToLower(n.String())] = n
+	}
+	return m
+}()
+
+func validateSeverityMapping(mapping map[string]string) error {
+	for value, severity := range mapping {
+		if _, ok := severityNumbers[strings.ToLower(severity)]; !ok {
+			return fmt.Errorf("'severity_mapping' has unsupported severity '%s' for value '%s'", severity, value)
+		}
+	}
+	return nil
+}
+
+// ParseSeverity returns the severity number of the value of a severity column. The value is
+// looked up in the mapping first, then among the names of the severity numbers regardless of
+// the case.
+func ParseSeverity(value string, mapping map[string]string) plog.SeverityNumber {
+	if severity, ok := mapping[value]; ok {
+		value = severity
+	}
+	return severityNumbers[strings.ToLower(strings.TrimSpace(value))]
+}
@@ -0,0 +1,40 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlquery
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/pdata/plog"
+)
+
+func TestParseSeverity(t *testing.T) {
+	mapping := map[string]string{"W": "warn", "E": "ERROR2", "info": "debug"}
+	tests := []struct {
+		value string
+		want  plog.SeverityNumber
+	}{
+		{value: "W", want: plog.SeverityNumberWarn},
+		{value: "E", want: plog.SeverityNumberError2},
+		{value: "info", want: plog.SeverityNumberDebug},
+		{value: "INFO", want: plog.SeverityNumberInfo},
+		{value: "Warning", want: plog.SeverityNumberWarn},
+		{value: " fatal3 ", want: plog.SeverityNumberFatal3},
+		{value: "critical", want: plog.SeverityNumberFatal},
+		{value: "w", want: plog.SeverityNumberUnspecified},
+		{value: "", want: plog.SeverityNumberUnspecified},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, ParseSeverity(tt.value, mapping), tt.value)
+	}
+}
+
+func TestLogsCfg_ValidateSeverity(t *testing.T) {
+	assert.NoError(t, LogsCfg{BodyColumn: "body", SeverityColumn: "level", SeverityMapping: map[string]string{"W": "Warn4"}}.Validate())
+	assert.EqualError(t, LogsCfg{BodyColumn: "body", SeverityMapping: map[string]string{"W": "warn"}}.Validate(),
+		"'severity_mapping' requires 'severity_column'")
+	assert.EqualError(t, LogsCfg{BodyColumn: "body", SeverityColumn: "level", SeverityMapping: map[string]string{"W": "loud"}}.Validate(),
+		"'severity_mapping' has unsupported severity 'loud' for value 'W'")
+}
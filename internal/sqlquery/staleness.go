@@ -0,0 +1,184 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlquery // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/sqlquery"
+
+import (
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+// staleSeries is the staleness marker of a series, a metric with a single data point without value.
+type staleSeries struct {
+	resource    pcommon.Map
+	resourceKey string
+	marker      pmetric.Metric
+}
+
+// stalenessTracker keeps the series of the previous collection, to emit a staleness marker for each
+// series missing from the next one, the rows of the finished queries or the dropped tables
+// disappearing from the result sets without telling the backends that their series ended.
+type stalenessTracker struct {
+	previous map[string]staleSeries
+	current  map[string]staleSeries
+}
+
+func newStalenessTracker() *stalenessTracker {
+	return &stalenessTracker{previous: map[string]staleSeries{}, current: map[string]staleSeries{}}
+}
+
+// observe records the series of the data points of the metric as present in the collection.
+func (t *stalenessTracker) observe(resource pcommon.Map, metric pmetric.Metric) error {
+	resourceKey, err := seriesKey("", resource, pcommon.NewMap())
+	if err != nil {
+		return err
+	}
+	// the resource of the collection is consumed downstream, the markers keep their own
+	var resourceCopy *pcommon.Map
+	var observeErr error
+	forEachDataPoint(metric, func(attributes pcommon.Map, startTime pcommon.Timestamp) {
+		if observeErr != nil {
+			return
+		}
+		key, err := seriesKey(metric.Name(), resource, attributes)
+		if err != nil {
+			observeErr = err
+			return
+		}
+		if _, found := t.current[key]; found {
+			return
+		}
+		if resourceCopy == nil {
+			copied := pcommon.NewMap()
+			resource.CopyTo(copied)
+			resourceCopy = &copied
+		}
+		t.current[key] = staleSeries{
+			resource:    *resourceCopy,
+			resourceKey: resourceKey,
+			marker:      newStaleMarker(metric, attributes, startTime),
+		}
+	})
+	return observeErr
+}
+
+// appendMarkers appends the staleness markers of the series of the previous collection missing
+// from the current one, and starts the next collection.
+func (t *stalenessTracker) appendMarkers(out pmetric.Metrics, scope ScopeCfg, ts pcommon.Timestamp) {
+	metricsByResource := map[string]pmetric.MetricSlice{}
+	for key, series := range t.previous {
+		if _, found := t.current[key]; found {
+			continue
+		}
+		metrics, found := metricsByResource[series.resourceKey]
+		if !found {
+			rm := out.ResourceMetrics().AppendEmpty()
+			series.resource.CopyTo(rm.Resource().Attributes())
+			sm := rm.ScopeMetrics().AppendEmpty()
+			scope.CopyTo(sm.Scope())
+			metrics = sm.Metrics()
+			metricsByResource[series.resourceKey] = metrics
+		}
+		marker := metrics.AppendEmpty()
+		series.marker.CopyTo(marker)
+		setMarkerTimestamp(marker, ts)
+	}
+	t.previous = t.current
+	t.current = map[string]staleSeries{}
+}
+
+// newStaleMarker returns the metric of the series with the attributes, flagged with no recorded
+// value.
+func newStaleMarker(metric pmetric.Metric, attributes pcommon.Map, startTime pcommon.Timestamp) pmetric.Metric {
+	marker := pmetric.NewMetric()
+	marker.SetName(metric.Name())
+	marker.SetDescription(metric.Description())
+	marker.SetUnit(metric.Unit())
+	flags := pmetric.DefaultDataPointFlags.WithNoRecordedValue(true)
+	//exhaustive:enforce
+	switch metric.Type() {
+	case pmetric.MetricTypeGauge:
+		dataPoint := marker.SetEmptyGauge().DataPoints().AppendEmpty()
+		attributes.CopyTo(dataPoint.Attributes())
+		dataPoint.SetFlags(flags)
+	case pmetric.MetricTypeSum:
+		sum := marker.SetEmptySum()
+		sum.SetIsMonotonic(metric.Sum().IsMonotonic())
+		sum.SetAggregationTemporality(metric.Sum().AggregationTemporality())
+		dataPoint := sum.DataPoints().AppendEmpty()
+		attributes.CopyTo(dataPoint.Attributes())
+		dataPoint.SetStartTimestamp(startTime)
+		dataPoint.SetFlags(flags)
+	case pmetric.MetricTypeHistogram:
+		histogram := marker.SetEmptyHistogram()
+		histogram.SetAggregationTemporality(metric.Histogram().AggregationTemporality())
+		dataPoint := histogram.DataPoints().AppendEmpty()
+		attributes.CopyTo(dataPoint.Attributes())
+		dataPoint.SetStartTimestamp(startTime)
+		dataPoint.SetFlags(flags)
+	case pmetric.MetricTypeExponentialHistogram:
+		histogram := marker.SetEmptyExponentialHistogram()
+		histogram.SetAggregationTemporality(metric.ExponentialHistogram().AggregationTemporality())
+		dataPoint := histogram.DataPoints().AppendEmpty()
+		attributes.CopyTo(dataPoint.Attributes())
+		dataPoint.SetStartTimestamp(startTime)
+		dataPoint.SetFlags(flags)
+	case pmetric.MetricTypeSummary:
+		dataPoint := marker.SetEmptySummary().DataPoints().AppendEmpty()
+		attributes.CopyTo(dataPoint.Attributes())
+		dataPoint.SetFlags(flags)
+	case pmetric.MetricTypeEmpty:
+	}
+	return marker
+}
+
+// forEachDataPoint calls f with the attributes and the start timestamp of each data point of the metric.
+func forEachDataPoint(metric pmetric.Metric, f func(attributes pcommon.Map, startTime pcommon.Timestamp)) {
+	//exhaustive:enforce
+	switch metric.Type() {
+	case pmetric.MetricTypeGauge:
+		dataPoints := metric.Gauge().DataPoints()
+		for i := 0; i < dataPoints.Len(); i++ {
+			f(dataPoints.At(i).Attributes(), dataPoints.At(i).StartTimestamp())
+		}
+	case pmetric.MetricTypeSum:
+		dataPoints := metric.Sum().DataPoints()
+		for i := 0; i < dataPoints.Len(); i++ {
+			f(dataPoints.At(i).Attributes(), dataPoints.At(i).StartTimestamp())
+		}
+	case pmetric.MetricTypeHistogram:
+		dataPoints := metric.Histogram().DataPoints()
+		for i := 0; i < dataPoints.Len(); i++ {
+			f(dataPoints.At(i).Attributes(), dataPoints.At(i).StartTimestamp())
+		}
+	case pmetric.MetricTypeExponentialHistogram:
+		dataPoints := metric.ExponentialHistogram().DataPoints()
+		for i := 0; i < dataPoints.Len(); i++ {
+			f(dataPoints.At(i).Attributes(), dataPoints.At(i).StartTimestamp())
+		}
+	case pmetric.MetricTypeSummary:
+		dataPoints := metric.Summary().DataPoints()
+		for i := 0; i < dataPoints.Len(); i++ {
+			f(dataPoints.At(i).Attributes(), dataPoints.At(i).StartTimestamp())
+		}
+	case pmetric.MetricTypeEmpty:
+	}
+}
+
+// setMarkerTimestamp sets the timestamp of the data point of the marker.
+func setMarkerTimestamp(marker pmetric.Metric, ts pcommon.Timestamp) {
+	//exhaustive:enforce
+	switch marker.Type() {
+	case pmetric.MetricTypeGauge:
+		marker.Gauge().DataPoints().At(0).SetTimestamp(ts)
+	case pmetric.MetricTypeSum:
+		marker.Sum().DataPoints().At(0).SetTimestamp(ts)
+	case pmetric.MetricTypeHistogram:
+		marker.Histogram().DataPoints().At(0).SetTimestamp(ts)
+	case pmetric.MetricTypeExponentialHistogram:
+		marker.ExponentialHistogram().DataPoints().At(0).SetTimestamp(ts)
+	case pmetric.MetricTypeSummary:
+		marker.Summary().DataPoints().At(0).SetTimestamp(ts)
+	case pmetric.MetricTypeEmpty:
+	}
+}
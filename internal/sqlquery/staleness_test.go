@@ -0,0 +1,124 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlquery
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+func TestScraper_StalenessMarkers(t *testing.T) {
+	client := &FakeDBClient{
+		StringMaps: [][]StringMap{
+			{{"count": "3", "query": "select 1", "db": "orders"}, {"count": "5", "query": "select 2", "db": "orders"}},
+			{{"count": "4", "query": "select 1", "db": "orders"}},
+			{{"count": "4", "query": "select 1", "db": "orders"}},
+			{{"count": "6", "query": "select 2", "db": "orders"}},
+		},
+	}
+	scrpr := Scraper{
+		Client: client,
+		Query: Query{
+			ResourceAttributeColumns: []string{"db"},
+			Scope:                    ScopeCfg{Name: "top_queries"},
+			Metrics: []MetricCfg{{
+				MetricName:       "db.query.calls",
+				Unit:             "{call}",
+				ValueColumn:      "count",
+				AttributeColumns: []string{"query"},
+				DataType:         MetricTypeSum,
+				Monotonic:        true,
+			}},
+			StalenessMarkers: true,
+		},
+		startTimes: newStartTimeTracker(),
+		staleness:  newStalenessTracker(),
+	}
+
+	metrics, err := scrpr.Scrape(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, metrics.DataPointCount())
+
+	// the series of "select 2" is missing
+	metrics, err = scrpr.Scrape(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 2, metrics.ResourceMetrics().Len())
+	markers := metrics.ResourceMetrics().At(1)
+	assert.Equal(t, map[string]any{"db": "orders"}, markers.Resource().Attributes().AsRaw())
+	assert.Equal(t, "top_queries", markers.ScopeMetrics().At(0).Scope().Name())
+	marker := markers.ScopeMetrics().At(0).Metrics().At(0)
+	assert.Equal(t, "db.query.calls", marker.Name())
+	assert.Equal(t, "{call}", marker.Unit())
+	assert.True(t, marker.Sum().IsMonotonic())
+	assert.Equal(t, pmetric.AggregationTemporalityCumulative, marker.Sum().AggregationTemporality())
+	dataPoint := marker.Sum().DataPoints().At(0)
+	assert.True(t, dataPoint.Flags().NoRecordedValue())
+	assert.Equal(t, map[string]any{"query": "select 2"}, dataPoint.Attributes().AsRaw())
+	current := metrics.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Sum().DataPoints().At(0)
+	assert.Equal(t, current.Timestamp(), dataPoint.Timestamp())
+
+	// the marker is emitted once
+	metrics, err = scrpr.Scrape(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, metrics.DataPointCount())
+
+	// a series coming back is emitted again, and a marker ends the other one
+	metrics, err = scrpr.Scrape(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 2, metrics.ResourceMetrics().Len())
+	assert.EqualValues(t, 6, metrics.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Sum().DataPoints().At(0).IntValue())
+	dataPoint = metrics.ResourceMetrics().At(1).ScopeMetrics().At(0).Metrics().At(0).Sum().DataPoints().At(0)
+	assert.True(t, dataPoint.Flags().NoRecordedValue())
+	assert.Equal(t, map[string]any{"query": "select 1"}, dataPoint.Attributes().AsRaw())
+}
+
+func TestStalenessMarkerTypes(t *testing.T) {
+	tracker := newStalenessTracker()
+	md := pmetric.NewMetrics()
+	rm := md.ResourceMetrics().AppendEmpty()
+	rm.Resource().Attributes().PutStr("db", "orders")
+	metrics := rm.ScopeMetrics().AppendEmpty().Metrics()
+	gauge := metrics.AppendEmpty()
+	gauge.SetName("gauge")
+	gauge.SetEmptyGauge().DataPoints().AppendEmpty().SetIntValue(1)
+	histogram := metrics.AppendEmpty()
+	histogram.SetName("histogram")
+	histogram.SetEmptyHistogram().SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+	histogram.Histogram().DataPoints().AppendEmpty().SetCount(3)
+	exponential := metrics.AppendEmpty()
+	exponential.SetName("exponential")
+	exponential.SetEmptyExponentialHistogram().DataPoints().AppendEmpty().SetCount(3)
+	summary := metrics.AppendEmpty()
+	summary.SetName("summary")
+	summary.SetEmptySummary().DataPoints().AppendEmpty().SetCount(3)
+	for i := 0; i < metrics.Len(); i++ {
+		require.NoError(t, tracker.observe(rm.Resource().Attributes(), metrics.At(i)))
+	}
+	tracker.appendMarkers(pmetric.NewMetrics(), ScopeCfg{}, 0)
+	// the resource is consumed downstream
+	rm.Resource().Attributes().Clear()
+
+	out := pmetric.NewMetrics()
+	tracker.appendMarkers(out, ScopeCfg{}, 10)
+	require.Equal(t, 1, out.ResourceMetrics().Len())
+	assert.Equal(t, map[string]any{"db": "orders"}, out.ResourceMetrics().At(0).Resource().Attributes().AsRaw())
+	markers := map[string]pmetric.Metric{}
+	ms := out.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	for i := 0; i < ms.Len(); i++ {
+		markers[ms.At(i).Name()] = ms.At(i)
+	}
+	require.Len(t, markers, 4)
+	assert.True(t, markers["gauge"].Gauge().DataPoints().At(0).Flags().NoRecordedValue())
+	assert.Equal(t, pmetric.NumberDataPointValueTypeEmpty, markers["gauge"].Gauge().DataPoints().At(0).ValueType())
+	assert.Equal(t, pmetric.AggregationTemporalityDelta, markers["histogram"].Histogram().AggregationTemporality())
+	assert.True(t, markers["histogram"].Histogram().DataPoints().At(0).Flags().NoRecordedValue())
+	assert.Zero(t, markers["histogram"].Histogram().DataPoints().At(0).Count())
+	assert.True(t, markers["exponential"].ExponentialHistogram().DataPoints().At(0).Flags().NoRecordedValue())
+	assert.True(t, markers["summary"].Summary().DataPoints().At(0).Flags().NoRecordedValue())
+	assert.EqualValues(t, 10, markers["summary"].Summary().DataPoints().At(0).Timestamp())
+}
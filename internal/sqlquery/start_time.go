@@ -0,0 +1,130 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlquery // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/sqlquery"
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+// startTimesStorageKey is the key of the start timestamps of the series in the storage of the scraper.
+const startTimesStorageKey = "startTimes"
+
+// startTimeExpiryIntervals is the number of collection intervals after which the series missing from
+// the collections are no longer tracked.
+const startTimeExpiryIntervals = 10
+
+// StateStorage persists the state of a scraper across restarts, satisfied by the clients of the
+// storage extensions.
+type StateStorage interface {
+	Get(ctx context.Context, key string) ([]byte, error)
+	Set(ctx context.Context, key string, value []byte) error
+	Close(ctx context.Context) error
+}
+
+type StorageProviderFunc func(context.Context, component.Host) (StateStorage, error)
+
+// trackedSeries is the start of the cumulation of a series, with its last data point and the time of
+// the collection it was last seen in.
+type trackedSeries struct {
+	StartTime int64   `json:"start"`
+	Timestamp int64   `json:"ts"`
+	Value     float64 `json:"value"`
+	LastSeen  int64   `json:"seen"`
+}
+
+// startTimeTracker keeps the start timestamps of the series of the monotonic cumulative sums, and starts
+// a new cumulation when the value of a series decreases, the counters of the databases being reset by
+// their restarts.
+type startTimeTracker struct {
+	series map[string]trackedSeries
+}
+
+func newStartTimeTracker() *startTimeTracker {
+	return &startTimeTracker{series: map[string]trackedSeries{}}
+}
+
+// tracksStartTime reports whether the start timestamps of the metric are tracked, the sums with a
+// start_ts_column reporting their own.
+func tracksStartTime(cfg MetricCfg) bool {
+	return cfg.DataType == MetricTypeSum && cfg.Monotonic && cfg.Aggregation != MetricAggregationDelta && cfg.StartTsColumn == ""
+}
+
+// adjust sets the start timestamps of the data points of the sum: the start timestamp of their first
+// collection, moved to the timestamp of the previous data point when the value decreased.
+func (t *startTimeTracker) adjust(resource pcommon.Map, metric pmetric.Metric, now pcommon.Timestamp) error {
+	dataPoints := metric.Sum().DataPoints()
+	for i := 0; i < dataPoints.Len(); i++ {
+		dataPoint := dataPoints.At(i)
+		key, err := seriesKey(metric.Name(), resource, dataPoint.Attributes())
+		if err != nil {
+			return err
+		}
+		value := dataPoint.DoubleValue()
+		if dataPoint.ValueType() == pmetric.NumberDataPointValueTypeInt {
+			value = float64(dataPoint.IntValue())
+		}
+		series, found := t.series[key]
+		switch {
+		case !found:
+			series.StartTime = int64(dataPoint.StartTimestamp())
+		case value < series.Value:
+			// the counter was reset at some point since the previous collection
+			series.StartTime = series.Timestamp
+		}
+		series.Timestamp = int64(dataPoint.Timestamp())
+		series.Value = value
+		series.LastSeen = int64(now)
+		t.series[key] = series
+		dataPoint.SetStartTimestamp(pcommon.Timestamp(series.StartTime))
+	}
+	return nil
+}
+
+// evict stops tracking the series not collected since the given time, the series of the dropped rows
+// or of the rotated attribute values otherwise growing the state forever. It reports whether any
+// series was evicted.
+func (t *startTimeTracker) evict(notSeenSince pcommon.Timestamp) bool {
+	evicted := false
+	for key, series := range t.series {
+		if series.LastSeen < int64(notSeenSince) {
+			delete(t.series, key)
+			evicted = true
+		}
+	}
+	return evicted
+}
+
+func seriesKey(name string, resource pcommon.Map, attributes pcommon.Map) (string, error) {
+	// the keys of the maps are sorted by the encoding
+	encoded, err := json.Marshal([]any{name, resource.AsRaw(), attributes.AsRaw()})
+	if err != nil {
+		return "", fmt.Errorf("series of '%s': %w", name, err)
+	}
+	h := sha256.Sum256(encoded)
+	return hex.EncodeToString(h[:16]), nil
+}
+
+func (t *startTimeTracker) marshalState() ([]byte, error) {
+	return json.Marshal(t.series)
+}
+
+func (t *startTimeTracker) unmarshalState(data []byte) error {
+	var series map[string]trackedSeries
+	if err := json.Unmarshal(data, &series); err != nil {
+		return err
+	}
+	t.series = series
+	if t.series == nil {
+		t.series = map[string]trackedSeries{}
+	}
+	return nil
+}
@@ -0,0 +1,200 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlquery
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/scraperhelper"
+	"go.uber.org/zap"
+)
+
+type fakeStateStorage struct {
+	values map[string][]byte
+	closed bool
+}
+
+func (s *fakeStateStorage) Get(_ context.Context, key string) ([]byte, error) {
+	return s.values[key], nil
+}
+
+func (s *fakeStateStorage) Set(_ context.Context, key string, value []byte) error {
+	s.values[key] = value
+	return nil
+}
+
+func (s *fakeStateStorage) Close(context.Context) error {
+	s.closed = true
+	return nil
+}
+
+func fakeDbProvider() (*sql.DB, error) {
+	return nil, nil
+}
+
+func TestTracksStartTime(t *testing.T) {
+	cfg := MetricCfg{DataType: MetricTypeSum, Monotonic: true}
+	assert.True(t, tracksStartTime(cfg))
+	cfg.Aggregation = MetricAggregationCumulative
+	assert.True(t, tracksStartTime(cfg))
+
+	assert.False(t, tracksStartTime(MetricCfg{DataType: MetricTypeSum}))
+	assert.False(t, tracksStartTime(MetricCfg{DataType: MetricTypeGauge, Monotonic: true}))
+	assert.False(t, tracksStartTime(MetricCfg{DataType: MetricTypeSum, Monotonic: true, Aggregation: MetricAggregationDelta}))
+	assert.False(t, tracksStartTime(MetricCfg{DataType: MetricTypeSum, Monotonic: true, StartTsColumn: "start"}))
+}
+
+func TestStartTimeTracker_Adjust(t *testing.T) {
+	tracker := newStartTimeTracker()
+	resource := pcommon.NewMap()
+	resource.PutStr("db", "orders")
+	sum := func(ts int64, values ...int64) pmetric.Metric {
+		metric := pmetric.NewMetric()
+		metric.SetName("transaction.count")
+		dataPoints := metric.SetEmptySum().DataPoints()
+		for i, value := range values {
+			dataPoint := dataPoints.AppendEmpty()
+			dataPoint.SetStartTimestamp(1)
+			dataPoint.SetTimestamp(pcommon.Timestamp(ts))
+			dataPoint.SetIntValue(value)
+			dataPoint.Attributes().PutInt("index", int64(i))
+		}
+		return metric
+	}
+	startTimes := func(metric pmetric.Metric) []pcommon.Timestamp {
+		var out []pcommon.Timestamp
+		for i := 0; i < metric.Sum().DataPoints().Len(); i++ {
+			out = append(out, metric.Sum().DataPoints().At(i).StartTimestamp())
+		}
+		return out
+	}
+
+	metric := sum(10, 5, 7)
+	require.NoError(t, tracker.adjust(resource, metric, 10))
+	assert.Equal(t, []pcommon.Timestamp{1, 1}, startTimes(metric))
+
+	// the second series was reset since the previous collection
+	metric = sum(20, 6, 2)
+	require.NoError(t, tracker.adjust(resource, metric, 20))
+	assert.Equal(t, []pcommon.Timestamp{1, 10}, startTimes(metric))
+
+	metric = sum(30, 6, 3)
+	require.NoError(t, tracker.adjust(resource, metric, 30))
+	assert.Equal(t, []pcommon.Timestamp{1, 10}, startTimes(metric))
+
+	// the series of another resource are tracked on their own
+	other := pcommon.NewMap()
+	other.PutStr("db", "payments")
+	metric = sum(40, 1)
+	metric.Sum().DataPoints().At(0).SetStartTimestamp(35)
+	require.NoError(t, tracker.adjust(other, metric, 40))
+	assert.Equal(t, []pcommon.Timestamp{35}, startTimes(metric))
+
+	state, err := tracker.marshalState()
+	require.NoError(t, err)
+	restored := newStartTimeTracker()
+	require.NoError(t, restored.unmarshalState(state))
+	metric = sum(50, 1, 4)
+	metric.Sum().DataPoints().At(0).SetStartTimestamp(45)
+	require.NoError(t, restored.adjust(resource, metric, 50))
+	assert.Equal(t, []pcommon.Timestamp{30, 10}, startTimes(metric))
+
+	assert.Error(t, restored.unmarshalState([]byte("{")))
+}
+
+func TestStartTimeTracker_Evict(t *testing.T) {
+	tracker := newStartTimeTracker()
+	resource := pcommon.NewMap()
+	sum := func(name string) pmetric.Metric {
+		metric := pmetric.NewMetric()
+		metric.SetName(name)
+		metric.SetEmptySum().DataPoints().AppendEmpty().SetIntValue(1)
+		return metric
+	}
+
+	require.NoError(t, tracker.adjust(resource, sum("rows.inserted"), 10))
+	require.NoError(t, tracker.adjust(resource, sum("rows.deleted"), 10))
+	require.NoError(t, tracker.adjust(resource, sum("rows.inserted"), 20))
+	assert.False(t, tracker.evict(10))
+	assert.Len(t, tracker.series, 2)
+
+	// the series missing from the collections since then are no longer tracked
+	assert.True(t, tracker.evict(15))
+	assert.Len(t, tracker.series, 1)
+	key, err := seriesKey("rows.inserted", resource, pcommon.NewMap())
+	require.NoError(t, err)
+	assert.Contains(t, tracker.series, key)
+	assert.False(t, tracker.evict(15))
+}
+
+func TestScraper_StartTimeStorage(t *testing.T) {
+	query := Query{
+		Metrics: []MetricCfg{{
+			MetricName:  "transaction.count",
+			ValueColumn: "count",
+			ValueType:   MetricValueTypeInt,
+			DataType:    MetricTypeSum,
+			Monotonic:   true,
+			Aggregation: MetricAggregationCumulative,
+		}},
+	}
+	storage := &fakeStateStorage{values: map[string][]byte{}}
+	newScraper := func(counts ...string) *Scraper {
+		client := &FakeDBClient{}
+		for _, count := range counts {
+			client.StringMaps = append(client.StringMaps, []StringMap{{"count": count}})
+		}
+		scrpr := NewScraper(component.MustNewID("sqlquery"), query, scraperhelper.NewDefaultControllerConfig(), zap.NewNop(), TelemetryConfig{}, fakeDbProvider, func(Db, string, *zap.Logger, TelemetryConfig) DbClient {
+			return client
+		})
+		scrpr.StorageProviderFunc = func(context.Context, component.Host) (StateStorage, error) {
+			return storage, nil
+		}
+		return scrpr
+	}
+	startTimestamp := func(metrics pmetric.Metrics) pcommon.Timestamp {
+		return metrics.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Sum().DataPoints().At(0).StartTimestamp()
+	}
+
+	scrpr := newScraper("42", "43")
+	require.NoError(t, scrpr.Start(context.Background(), componenttest.NewNopHost()))
+	metrics, err := scrpr.Scrape(context.Background())
+	require.NoError(t, err)
+	start := startTimestamp(metrics)
+	assert.Equal(t, scrpr.StartTime, start)
+	_, err = scrpr.Scrape(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, scrpr.Shutdown(context.Background()))
+	assert.True(t, storage.closed)
+
+	// the cumulation continues after a restart of the collector, and restarts with the counter
+	scrpr = newScraper("44", "3")
+	require.NoError(t, scrpr.Start(context.Background(), componenttest.NewNopHost()))
+	metrics, err = scrpr.Scrape(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, start, startTimestamp(metrics))
+	reset := metrics.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Sum().DataPoints().At(0).Timestamp()
+	metrics, err = scrpr.Scrape(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, reset, startTimestamp(metrics))
+}
+
+func TestScraper_StartTimeStorageError(t *testing.T) {
+	scrpr := NewScraper(component.MustNewID("sqlquery"), Query{}, scraperhelper.NewDefaultControllerConfig(), zap.NewNop(), TelemetryConfig{}, fakeDbProvider, func(Db, string, *zap.Logger, TelemetryConfig) DbClient {
+		return &FakeDBClient{}
+	})
+	scrpr.StorageProviderFunc = func(context.Context, component.Host) (StateStorage, error) {
+		return nil, errors.New("storage extension 'file_storage' not found")
+	}
+	assert.EqualError(t, scrpr.Start(context.Background(), componenttest.NewNopHost()), "error connecting to storage: storage extension 'file_storage' not found")
+}
@@ -0,0 +1,130 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlquery // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/sqlquery"
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"sync"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// StatementTelemetry counts the statements prepared by the receiver and their reuse, the
+// ratio of the reused statements to all the executions being the hit ratio of the cache
+// of prepared statements.
+type StatementTelemetry struct {
+	prepared metric.Int64Counter
+	reused   metric.Int64Counter
+	attrs    metric.MeasurementOption
+}
+
+func NewStatementTelemetry(meter metric.Meter, id component.ID) (*StatementTelemetry, error) {
+	prepared, err := meter.Int64Counter(
+		"receiver/sqlquery/statements_prepared",
+		metric.WithDescription("Number of statements prepared by the receiver"),
+		metric.WithUnit("1"),
+	)
+	if err != nil {
+		return nil, err
+	}
+	reused, err := meter.Int64Counter(
+		"receiver/sqlquery/statements_reused",
+		metric.WithDescription("Number of query executions reusing a statement prepared by the receiver"),
+		metric.WithUnit("1"),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &StatementTelemetry{
+		prepared: prepared,
+		reused:   reused,
+		attrs:    metric.WithAttributes(attribute.String("receiver", id.String())),
+	}, nil
+}
+
+func (t *StatementTelemetry) recordPrepared(ctx context.Context) {
+	if t != nil {
+		t.prepared.Add(ctx, 1, t.attrs)
+	}
+}
+
+func (t *StatementTelemetry) recordReused(ctx context.Context) {
+	if t != nil {
+		t.reused.Add(ctx, 1, t.attrs)
+	}
+}
+
+// WrapDb returns the Db running the queries on the database, preparing each query on its
+// first execution and reusing the statement afterwards when preparedStatements is set.
+func WrapDb(db *sql.DB, preparedStatements bool, telemetry *StatementTelemetry) Db {
+	if !preparedStatements {
+		return DbWrapper{Db: db}
+	}
+	return &PreparedDbWrapper{
+		Db:        db,
+		Telemetry: telemetry,
+		stmts:     map[string]*sql.Stmt{},
+	}
+}
+
+// PreparedDbWrapper is a Db reusing the statements prepared for its queries, so that the
+// database doesn't parse the query again on every collection interval.
+type PreparedDbWrapper struct {
+	Db        *sql.DB
+	Telemetry *StatementTelemetry
+
+	mu    sync.Mutex
+	stmts map[string]*sql.Stmt
+}
+
+func (d *PreparedDbWrapper) QueryContext(ctx context.Context, query string, args ...any) (rows, error) {
+	stmt, err := d.statement(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := stmt.QueryContext(ctx, args...)
+	return rowsWrapper{rows}, err
+}
+
+// statement returns the statement prepared for the query, preparing it the first time.
+// database/sql prepares it again on each new connection of the pool.
+func (d *PreparedDbWrapper) statement(ctx context.Context, query string) (*sql.Stmt, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if stmt, ok := d.stmts[query]; ok {
+		d.Telemetry.recordReused(ctx)
+		return stmt, nil
+	}
+	stmt, err := d.Db.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	d.Telemetry.recordPrepared(ctx)
+	d.stmts[query] = stmt
+	return stmt, nil
+}
+
+// Close closes the prepared statements, the database itself being closed by its owner.
+func (d *PreparedDbWrapper) Close() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	var errs []error
+	for query, stmt := range d.stmts {
+		errs = append(errs, stmt.Close())
+		delete(d.stmts, query)
+	}
+	return errors.Join(errs...)
+}
+
+// CloseStatements closes the statements prepared by the Db returned by WrapDb, if any.
+func CloseStatements(db Db) error {
+	if prepared, ok := db.(*PreparedDbWrapper); ok {
+		return prepared.Close()
+	}
+	return nil
+}
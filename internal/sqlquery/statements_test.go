@@ -0,0 +1,202 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlquery // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/sqlquery"
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.uber.org/zap"
+)
+
+// countingConnector opens connections counting the prepared and closed statements and the
+// queries sent without statement.
+type countingConnector struct {
+	prepared atomic.Int64
+	closed   atomic.Int64
+	queried  atomic.Int64
+}
+
+func (c *countingConnector) Connect(context.Context) (driver.Conn, error) {
+	return &countingConn{connector: c}, nil
+}
+
+func (c *countingConnector) Driver() driver.Driver {
+	return nil
+}
+
+type countingConn struct {
+	connector *countingConnector
+}
+
+func (c *countingConn) Prepare(query string) (driver.Stmt, error) {
+	if query == "invalid" {
+		return nil, errors.New("syntax error")
+	}
+	c.connector.prepared.Add(1)
+	return countingStmt{connector: c.connector}, nil
+}
+
+func (c *countingConn) QueryContext(context.Context, string, []driver.NamedValue) (driver.Rows, error) {
+	c.connector.queried.Add(1)
+	return &countingRows{}, nil
+}
+
+func (c *countingConn) Close() error {
+	return nil
+}
+
+func (c *countingConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("not supported")
+}
+
+type countingStmt struct {
+	connector *countingConnector
+}
+
+func (s countingStmt) Close() error {
+	s.connector.closed.Add(1)
+	return nil
+}
+
+func (countingStmt) NumInput() int {
+	return -1
+}
+
+func (countingStmt) Exec([]driver.Value) (driver.Result, error) {
+	return nil, errors.New("not supported")
+}
+
+func (countingStmt) Query([]driver.Value) (driver.Rows, error) {
+	return &countingRows{}, nil
+}
+
+// countingRows returns a single row with the value 42.
+type countingRows struct {
+	done bool
+}
+
+func (r *countingRows) Columns() []string {
+	return []string{"count"}
+}
+
+func (r *countingRows) Close() error {
+	return nil
+}
+
+func (r *countingRows) Next(dest []driver.Value) error {
+	if r.done {
+		return io.EOF
+	}
+	r.done = true
+	dest[0] = int64(42)
+	return nil
+}
+
+func TestWrapDb(t *testing.T) {
+	connector := &countingConnector{}
+	db := sql.OpenDB(connector)
+	defer db.Close()
+
+	assert.IsType(t, DbWrapper{}, WrapDb(db, false, nil))
+	assert.IsType(t, &PreparedDbWrapper{}, WrapDb(db, true, nil))
+}
+
+func TestPreparedDbWrapper(t *testing.T) {
+	connector := &countingConnector{}
+	db := sql.OpenDB(connector)
+	defer db.Close()
+	db.SetMaxOpenConns(1)
+
+	reader := sdkmetric.NewManualReader()
+	meterProvider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	telemetry, err := NewStatementTelemetry(meterProvider.Meter("test"), component.MustNewID("sqlquery"))
+	require.NoError(t, err)
+
+	client := NewDbClient(WrapDb(db, true, telemetry), "select count(*) as count from foo", zap.NewNop(), TelemetryConfig{})
+	for i := 0; i < 3; i++ {
+		rows, err := client.QueryRows(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, []StringMap{{"count": "42"}}, rows)
+	}
+	assert.EqualValues(t, 1, connector.prepared.Load())
+	assert.EqualValues(t, 0, connector.queried.Load())
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &rm))
+	counts := map[string]int64{}
+	for _, m := range rm.ScopeMetrics[0].Metrics {
+		for _, dp := range m.Data.(metricdata.Sum[int64]).DataPoints {
+			value, _ := dp.Attributes.Value("receiver")
+			assert.Equal(t, "sqlquery", value.AsString())
+			counts[m.Name] += dp.Value
+		}
+	}
+	assert.Equal(t, map[string]int64{
+		"receiver/sqlquery/statements_prepared": 1,
+		"receiver/sqlquery/statements_reused":   2,
+	}, counts)
+}
+
+func TestCloseStatements(t *testing.T) {
+	connector := &countingConnector{}
+	db := sql.OpenDB(connector)
+	defer db.Close()
+	db.SetMaxOpenConns(1)
+
+	wrapper := WrapDb(db, true, nil)
+	for _, query := range []string{"select 1", "select 2"} {
+		rows, err := wrapper.QueryContext(context.Background(), query)
+		require.NoError(t, err)
+		require.True(t, rows.Next())
+		require.False(t, rows.Next())
+	}
+	assert.EqualValues(t, 2, connector.prepared.Load())
+
+	require.NoError(t, CloseStatements(wrapper))
+	assert.EqualValues(t, 2, connector.closed.Load())
+	// the statements are prepared again if the wrapper is used after being closed
+	_, err := wrapper.QueryContext(context.Background(), "select 1")
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, connector.prepared.Load())
+
+	assert.NoError(t, CloseStatements(WrapDb(db, false, nil)))
+}
+
+func TestPreparedDbWrapper_PrepareError(t *testing.T) {
+	connector := &countingConnector{}
+	db := sql.OpenDB(connector)
+	defer db.Close()
+
+	wrapper := WrapDb(db, true, nil)
+	_, err := wrapper.QueryContext(context.Background(), "invalid")
+	assert.EqualError(t, err, "syntax error")
+	// the statement is prepared again on the next execution
+	_, err = wrapper.QueryContext(context.Background(), "invalid")
+	assert.EqualError(t, err, "syntax error")
+}
+
+func TestDbWrapper_NotPrepared(t *testing.T) {
+	connector := &countingConnector{}
+	db := sql.OpenDB(connector)
+	defer db.Close()
+
+	client := NewDbClient(WrapDb(db, false, nil), "select count(*) as count from foo", zap.NewNop(), TelemetryConfig{})
+	for i := 0; i < 2; i++ {
+		_, err := client.QueryRows(context.Background())
+		require.NoError(t, err)
+	}
+	assert.EqualValues(t, 0, connector.prepared.Load())
+	assert.EqualValues(t, 2, connector.queried.Load())
+}
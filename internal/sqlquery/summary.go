@@ -0,0 +1,99 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlquery // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/sqlquery"
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+// SummaryCfg maps the percentile columns of a row to the data point of a summary, for the
+// performance views exposing precomputed percentiles.
+type SummaryCfg struct {
+	// Quantiles are the columns holding the values of the quantiles.
+	Quantiles []QuantileCfg `mapstructure:"quantiles"`
+	// CountColumn is the column holding the count of the values, 0 when empty.
+	CountColumn string `mapstructure:"count_column"`
+	// SumColumn is the column holding the sum of the values, 0 when empty.
+	SumColumn string `mapstructure:"sum_column"`
+}
+
+// QuantileCfg maps a column to a quantile of the summary.
+type QuantileCfg struct {
+	// Quantile is the quantile of the column, between 0 and 1, e.g. 0.99 for the `p99` column.
+	Quantile float64 `mapstructure:"quantile"`
+	Column   string  `mapstructure:"column"`
+}
+
+func (c SummaryCfg) Validate() error {
+	var errs []error
+	seen := map[float64]bool{}
+	for _, quantile := range c.Quantiles {
+		if quantile.Column == "" {
+			errs = append(errs, errors.New("'summary.quantiles' cannot contain an empty column"))
+		}
+		if quantile.Quantile < 0 || quantile.Quantile > 1 {
+			errs = append(errs, fmt.Errorf("'summary.quantiles' must be between 0 and 1, got %g", quantile.Quantile))
+		}
+		if seen[quantile.Quantile] {
+			errs = append(errs, fmt.Errorf("'summary.quantiles' contains the quantile %g more than once", quantile.Quantile))
+		}
+		seen[quantile.Quantile] = true
+	}
+	return errors.Join(errs...)
+}
+
+func rowToSummary(row StringMap, cfg MetricCfg, dest pmetric.Metric, startTime pcommon.Timestamp, ts pcommon.Timestamp) error {
+	summary := dest.SetEmptySummary()
+	startTime, ts, err := rowTimestamps(row, cfg, startTime, ts)
+	if err != nil {
+		return err
+	}
+	dataPoint := summary.DataPoints().AppendEmpty()
+	dataPoint.SetTimestamp(ts)
+	// the count and the sum of the summaries are cumulative
+	dataPoint.SetStartTimestamp(startTime)
+
+	summaryCfg := cfg.Summary
+	quantiles := make([]QuantileCfg, len(summaryCfg.Quantiles))
+	copy(quantiles, summaryCfg.Quantiles)
+	sort.Slice(quantiles, func(i, j int) bool {
+		return quantiles[i].Quantile < quantiles[j].Quantile
+	})
+	for _, quantile := range quantiles {
+		// the percentiles of the empty windows are NULL, and missing from the row
+		value, found := row[quantile.Column]
+		if !found {
+			continue
+		}
+		parsed, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return fmt.Errorf("rowToMetric: col %q: error converting to double: %w", quantile.Column, err)
+		}
+		quantileValue := dataPoint.QuantileValues().AppendEmpty()
+		quantileValue.SetQuantile(quantile.Quantile)
+		quantileValue.SetValue(parsed)
+	}
+
+	if summaryCfg.CountColumn != "" {
+		count, err := histogramCount(row, summaryCfg.CountColumn)
+		if err != nil {
+			return err
+		}
+		dataPoint.SetCount(count)
+	}
+	sum, found, err := histogramSum(row, summaryCfg.SumColumn)
+	if err != nil {
+		return err
+	}
+	if found {
+		dataPoint.SetSum(sum)
+	}
+	return setAttributes(row, cfg, dataPoint.Attributes())
+}
@@ -0,0 +1,111 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlquery
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/scraperhelper"
+)
+
+func TestSummaryCfg_Validate(t *testing.T) {
+	assert.NoError(t, SummaryCfg{Quantiles: []QuantileCfg{{Quantile: 0.5, Column: "p50"}, {Quantile: 0.99, Column: "p99"}}}.Validate())
+	assert.NoError(t, SummaryCfg{CountColumn: "calls"}.Validate())
+	assert.EqualError(t, SummaryCfg{Quantiles: []QuantileCfg{{Quantile: 0.5}}}.Validate(),
+		"'summary.quantiles' cannot contain an empty column")
+	assert.EqualError(t, SummaryCfg{Quantiles: []QuantileCfg{{Quantile: 99, Column: "p99"}}}.Validate(),
+		"'summary.quantiles' must be between 0 and 1, got 99")
+	assert.EqualError(t, SummaryCfg{Quantiles: []QuantileCfg{{Quantile: 0.5, Column: "p50"}, {Quantile: 0.5, Column: "median"}}}.Validate(),
+		"'summary.quantiles' contains the quantile 0.5 more than once")
+
+	summary := &SummaryCfg{Quantiles: []QuantileCfg{{Quantile: 0.5, Column: "p50"}}}
+	assert.NoError(t, MetricCfg{MetricName: "latency", DataType: MetricTypeSummary, Summary: summary}.Validate())
+	assert.ErrorContains(t, MetricCfg{MetricName: "latency", DataType: MetricTypeSummary}.Validate(),
+		"'data_type: summary' requires 'summary'")
+	assert.ErrorContains(t, MetricCfg{MetricName: "latency", ValueColumn: "latency", DataType: MetricTypeSummary, Summary: summary}.Validate(),
+		"'value_column' cannot be set with 'data_type: summary'")
+	assert.ErrorContains(t, MetricCfg{MetricName: "latency", ValueColumn: "latency", Summary: summary}.Validate(),
+		"'summary' requires 'data_type: summary'")
+	assert.ErrorContains(t, MetricCfg{MetricName: "latency", DataType: MetricTypeSummary, Summary: summary, Aggregation: MetricAggregationDelta}.Validate(),
+		"aggregation=delta but data_type=summary does not support aggregation")
+}
+
+func TestRowToSummary(t *testing.T) {
+	row := StringMap{"p50": "1.5", "p99": "42", "p90": "12.25", "calls": "1200.000", "total": "5230.5", "query": "select"}
+	cfg := MetricCfg{
+		MetricName:       "db.query.latency",
+		Unit:             "ms",
+		DataType:         MetricTypeSummary,
+		AttributeColumns: []string{"query"},
+		Summary: &SummaryCfg{
+			Quantiles: []QuantileCfg{
+				{Quantile: 0.99, Column: "p99"},
+				{Quantile: 0.5, Column: "p50"},
+				{Quantile: 0.9, Column: "p90"},
+				// a NULL percentile is missing from the row
+				{Quantile: 0.999, Column: "p999"},
+			},
+			CountColumn: "calls",
+			SumColumn:   "total",
+		},
+	}
+	startTime := pcommon.NewTimestampFromTime(time.Now().Add(-time.Hour))
+	ts := pcommon.NewTimestampFromTime(time.Now())
+	metric := pmetric.NewMetric()
+	require.NoError(t, rowToMetric(row, cfg, metric, startTime, ts, scraperhelper.ControllerConfig{}))
+
+	assert.Equal(t, "db.query.latency", metric.Name())
+	assert.Equal(t, "ms", metric.Unit())
+	require.Equal(t, pmetric.MetricTypeSummary, metric.Type())
+	dataPoint := metric.Summary().DataPoints().At(0)
+	assert.Equal(t, startTime, dataPoint.StartTimestamp())
+	assert.Equal(t, ts, dataPoint.Timestamp())
+	assert.EqualValues(t, 1200, dataPoint.Count())
+	assert.Equal(t, 5230.5, dataPoint.Sum())
+	require.Equal(t, 3, dataPoint.QuantileValues().Len())
+	// the quantiles are sorted
+	for i, expected := range [][2]float64{{0.5, 1.5}, {0.9, 12.25}, {0.99, 42}} {
+		assert.Equal(t, expected[0], dataPoint.QuantileValues().At(i).Quantile())
+		assert.Equal(t, expected[1], dataPoint.QuantileValues().At(i).Value())
+	}
+	assert.Equal(t, map[string]any{"query": "select"}, dataPoint.Attributes().AsRaw())
+}
+
+func TestRowToSummary_Errors(t *testing.T) {
+	cfg := MetricCfg{
+		MetricName: "db.query.latency",
+		DataType:   MetricTypeSummary,
+		Summary: &SummaryCfg{
+			Quantiles:   []QuantileCfg{{Quantile: 0.5, Column: "p50"}},
+			CountColumn: "calls",
+		},
+	}
+	tests := []struct {
+		name string
+		row  StringMap
+		err  string
+	}{
+		{
+			name: "invalid quantile",
+			row:  StringMap{"p50": "fast", "calls": "1"},
+			err:  `rowToMetric: col "p50": error converting to double: strconv.ParseFloat: parsing "fast": invalid syntax`,
+		},
+		{
+			name: "missing count",
+			row:  StringMap{"p50": "1"},
+			err:  "rowToMetric: column 'calls' not found in result set",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := rowToMetric(tt.row, cfg, pmetric.NewMetric(), 0, 0, scraperhelper.ControllerConfig{})
+			assert.EqualError(t, err, tt.err)
+		})
+	}
+}
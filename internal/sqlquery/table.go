@@ -0,0 +1,90 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlquery // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/sqlquery"
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+)
+
+// DefaultTableStartValue is the initial value of the timestamp column of a table, so
+// that all its rows are read on the first collection interval.
+const DefaultTableStartValue = "1970-01-01T00:00:00Z"
+
+// identifierPattern matches the unquoted, possibly schema-qualified, names of the tables
+// and columns, which are inserted in the generated queries.
+var identifierPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_$]*(\.[A-Za-z_][A-Za-z0-9_$]*)*$`)
+
+// TableCfg tails a table, e.g. an audit table, as log records: the rows inserted or updated
+// since the last collection interval are read according to their timestamp column, and the
+// deleted rows are optionally emitted as tombstones.
+type TableCfg struct {
+	Name            string `mapstructure:"name"`
+	KeyColumn       string `mapstructure:"key_column"`
+	TimestampColumn string `mapstructure:"timestamp_column"`
+	// TimestampFormat is the format of the timestamp column, as LogsCfg.TimestampFormat.
+	TimestampFormat string `mapstructure:"timestamp_format"`
+	// StartValue is the initial value of the timestamp column, DefaultTableStartValue by default.
+	StartValue string `mapstructure:"start_value"`
+	// DetectDeletions emits a tombstone log record for the keys of the rows deleted since the
+	// last collection interval.
+	DetectDeletions bool `mapstructure:"detect_deletions"`
+}
+
+func (c TableCfg) Validate() error {
+	var errs []error
+	for _, field := range []struct{ name, value string }{
+		{name: "name", value: c.Name},
+		{name: "key_column", value: c.KeyColumn},
+		{name: "timestamp_column", value: c.TimestampColumn},
+	} {
+		name, value := field.name, field.value
+		if value == "" {
+			errs = append(errs, fmt.Errorf("'table.%s' cannot be empty", name))
+		} else if !identifierPattern.MatchString(value) {
+			errs = append(errs, fmt.Errorf("'table.%s' is not a valid identifier: '%s'", name, value))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Query returns the query declaring the table completed with the SQL reading the rows of
+// the table whose timestamp is greater than the tracking value, the key being the body of
+// the log records. The other settings of the declaring query, e.g. its scope, are kept.
+func (c TableCfg) Query(driver string, query Query) Query {
+	startValue := c.StartValue
+	if startValue == "" {
+		startValue = DefaultTableStartValue
+	}
+	query.SQL = fmt.Sprintf("SELECT * FROM %s WHERE %s > %s ORDER BY %s, %s",
+		c.Name, c.TimestampColumn, placeholder(driver), c.TimestampColumn, c.KeyColumn)
+	query.Logs = []LogsCfg{{
+		BodyColumn:      c.KeyColumn,
+		TimestampColumn: c.TimestampColumn,
+		TimestampFormat: c.TimestampFormat,
+	}}
+	query.TrackingColumn = c.TimestampColumn
+	query.TrackingStartValue = startValue
+	query.Table = &c
+	return query
+}
+
+// KeysSQL returns the query reading all the keys of the table, to detect the deleted rows.
+func (c TableCfg) KeysSQL() string {
+	return fmt.Sprintf("SELECT %s FROM %s", c.KeyColumn, c.Name)
+}
+
+// placeholder returns the notation of the first parameter of a query for the driver.
+func placeholder(driver string) string {
+	switch driver {
+	case "postgres":
+		return "$1"
+	case "sqlserver":
+		return "@p1"
+	case "oracle":
+		return ":1"
+	}
+	return "?"
+}
@@ -0,0 +1,53 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlquery
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTableCfg_Validate(t *testing.T) {
+	require.NoError(t, TableCfg{Name: "audit.events", KeyColumn: "id", TimestampColumn: "updated_at"}.Validate())
+	assert.EqualError(t, TableCfg{Name: "events; drop table events", TimestampColumn: "updated_at"}.Validate(),
+		"'table.name' is not a valid identifier: 'events; drop table events'\n'table.key_column' cannot be empty")
+}
+
+func TestTableCfg_Query(t *testing.T) {
+	table := TableCfg{Name: "audit.events", KeyColumn: "id", TimestampColumn: "updated_at", DetectDeletions: true}
+	query := table.Query("postgres", Query{Table: &table})
+	assert.Equal(t, "SELECT * FROM audit.events WHERE updated_at > $1 ORDER BY updated_at, id", query.SQL)
+	assert.Equal(t, []LogsCfg{{BodyColumn: "id", TimestampColumn: "updated_at"}}, query.Logs)
+	assert.Equal(t, "updated_at", query.TrackingColumn)
+	assert.Equal(t, DefaultTableStartValue, query.TrackingStartValue)
+	assert.Equal(t, &table, query.Table)
+	assert.NoError(t, query.Logs[0].Validate())
+
+	table.StartValue = "2024-01-01T00:00:00Z"
+	query = table.Query("mysql", Query{Table: &table})
+	assert.Equal(t, "SELECT * FROM audit.events WHERE updated_at > ? ORDER BY updated_at, id", query.SQL)
+	assert.Equal(t, "2024-01-01T00:00:00Z", query.TrackingStartValue)
+	assert.Equal(t, "SELECT id FROM audit.events", table.KeysSQL())
+}
+
+func TestTableCfg_QueryKeepsScope(t *testing.T) {
+	table := TableCfg{Name: "audit.events", KeyColumn: "id", TimestampColumn: "updated_at"}
+	scope := ScopeCfg{Name: "audit", Version: "1.0.0"}
+	query := table.Query("postgres", Query{Table: &table, Scope: scope})
+	assert.Equal(t, scope, query.Scope)
+	assert.Equal(t, "SELECT * FROM audit.events WHERE updated_at > $1 ORDER BY updated_at, id", query.SQL)
+}
+
+func TestQuery_ValidateTable(t *testing.T) {
+	table := &TableCfg{Name: "events", KeyColumn: "id", TimestampColumn: "updated_at"}
+	require.NoError(t, Query{Table: table}.Validate())
+	assert.EqualError(t, Query{SQL: "select * from events", Table: table}.Validate(),
+		"'query.table' cannot be combined with 'sql', 'logs', 'metrics', 'traces', 'tracking_column' or 'tracking_start_value'")
+	assert.EqualError(t, Query{ResourceAttributeColumns: []string{"tenant_id"}, Table: table}.Validate(),
+		"'query.table' cannot be combined with 'resource_attribute_columns'")
+	assert.EqualError(t, Query{Dedup: &DedupCfg{}, Table: table}.Validate(),
+		"'query.table' cannot be combined with 'dedup'")
+}
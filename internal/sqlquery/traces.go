@@ -0,0 +1,191 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlquery // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/sqlquery"
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+// TracesCfg converts each row of the result set into a span.
+type TracesCfg struct {
+	// TraceIDColumn is the column setting the trace ID of the span, as hex.
+	TraceIDColumn string `mapstructure:"trace_id_column"`
+	// SpanIDColumn is the column setting the span ID of the span, as hex.
+	SpanIDColumn string `mapstructure:"span_id_column"`
+	// ParentSpanIDColumn is the column setting the parent span ID of the span, as hex. The
+	// spans whose value is NULL are root spans.
+	ParentSpanIDColumn string `mapstructure:"parent_span_id_column"`
+	// NameColumn is the column setting the name of the span.
+	NameColumn string `mapstructure:"name_column"`
+	// Name is the name of the spans, when they are not named from a column.
+	Name string `mapstructure:"name"`
+	// StartTimestampColumn is the column setting the start timestamp of the span.
+	StartTimestampColumn string `mapstructure:"start_timestamp_column"`
+	// EndTimestampColumn is the column setting the end timestamp of the span. The spans whose
+	// value is NULL, e.g. the jobs still running, end when they start.
+	EndTimestampColumn string `mapstructure:"end_timestamp_column"`
+	// TimestampFormat is the format of the timestamp columns: one of the epoch formats, a
+	// strptime layout, or a Go layout. RFC 3339 by default.
+	TimestampFormat string `mapstructure:"timestamp_format"`
+	// StatusCodeColumn is the column setting the status code of the span: `unset`, `ok` or
+	// `error` regardless of the case.
+	StatusCodeColumn string `mapstructure:"status_code_column"`
+	// StatusMapping maps the values of the status code column to the status codes, e.g.
+	// `FAILED: error`, for the values which aren't status codes.
+	StatusMapping map[string]string `mapstructure:"status_mapping"`
+	// StatusMessageColumn is the column setting the status message of the span.
+	StatusMessageColumn string `mapstructure:"status_message_column"`
+	// AttributeColumns are the columns set as attributes of the span.
+	AttributeColumns []string `mapstructure:"attribute_columns"`
+	// EventsColumn is the column holding the events of the span, as a JSON array of objects
+	// with a `name`, a `timestamp` and `attributes`.
+	EventsColumn string `mapstructure:"events_column"`
+	// LinksColumn is the column holding the links of the span, as a JSON array of objects with
+	// a `trace_id`, a `span_id` and `attributes`.
+	LinksColumn string `mapstructure:"links_column"`
+	// Events is an auxiliary query whose rows are the events of the spans.
+	Events *SpanEventsCfg `mapstructure:"events"`
+	// Links is an auxiliary query whose rows are the links of the spans.
+	Links *SpanLinksCfg `mapstructure:"links"`
+}
+
+// SpanEventsCfg is an auxiliary query whose rows are joined to the spans on their span ID, each
+// row being an event of the span.
+type SpanEventsCfg struct {
+	SQL string `mapstructure:"sql"`
+	// SpanIDColumn is the column holding the span ID of the span of the event, as hex.
+	SpanIDColumn string `mapstructure:"span_id_column"`
+	// NameColumn is the column setting the name of the event.
+	NameColumn string `mapstructure:"name_column"`
+	// TimestampColumn is the column setting the timestamp of the event, in the timestamp
+	// format of the spans.
+	TimestampColumn string `mapstructure:"timestamp_column"`
+	// AttributeColumns are the columns set as attributes of the event.
+	AttributeColumns []string `mapstructure:"attribute_columns"`
+}
+
+func (config SpanEventsCfg) Validate() error {
+	var errs []error
+	if config.SQL == "" {
+		errs = append(errs, errors.New("'events.sql' cannot be empty"))
+	}
+	if config.SpanIDColumn == "" {
+		errs = append(errs, errors.New("'events.span_id_column' must not be empty"))
+	}
+	if config.NameColumn == "" {
+		errs = append(errs, errors.New("'events.name_column' must not be empty"))
+	}
+	if config.TimestampColumn == "" {
+		errs = append(errs, errors.New("'events.timestamp_column' must not be empty"))
+	}
+	return errors.Join(errs...)
+}
+
+// SpanLinksCfg is an auxiliary query whose rows are joined to the spans on their span ID, each
+// row being a link of the span.
+type SpanLinksCfg struct {
+	SQL string `mapstructure:"sql"`
+	// SpanIDColumn is the column holding the span ID of the span of the link, as hex.
+	SpanIDColumn string `mapstructure:"span_id_column"`
+	// LinkedTraceIDColumn is the column holding the trace ID of the linked span, as hex.
+	LinkedTraceIDColumn string `mapstructure:"linked_trace_id_column"`
+	// LinkedSpanIDColumn is the column holding the span ID of the linked span, as hex.
+	LinkedSpanIDColumn string `mapstructure:"linked_span_id_column"`
+	// AttributeColumns are the columns set as attributes of the link.
+	AttributeColumns []string `mapstructure:"attribute_columns"`
+}
+
+func (config SpanLinksCfg) Validate() error {
+	var errs []error
+	if config.SQL == "" {
+		errs = append(errs, errors.New("'links.sql' cannot be empty"))
+	}
+	if config.SpanIDColumn == "" {
+		errs = append(errs, errors.New("'links.span_id_column' must not be empty"))
+	}
+	if config.LinkedTraceIDColumn == "" {
+		errs = append(errs, errors.New("'links.linked_trace_id_column' must not be empty"))
+	}
+	if config.LinkedSpanIDColumn == "" {
+		errs = append(errs, errors.New("'links.linked_span_id_column' must not be empty"))
+	}
+	return errors.Join(errs...)
+}
+
+func (config TracesCfg) Validate() error {
+	var errs []error
+	if config.TraceIDColumn == "" {
+		errs = append(errs, errors.New("'trace_id_column' must not be empty"))
+	}
+	if config.SpanIDColumn == "" {
+		errs = append(errs, errors.New("'span_id_column' must not be empty"))
+	}
+	if config.NameColumn == "" && config.Name == "" {
+		errs = append(errs, errors.New("one of 'name_column' and 'name' must be set"))
+	}
+	if config.NameColumn != "" && config.Name != "" {
+		errs = append(errs, errors.New("'name_column' cannot be set with 'name'"))
+	}
+	if config.StartTimestampColumn == "" {
+		errs = append(errs, errors.New("'start_timestamp_column' must not be empty"))
+	}
+	if len(config.StatusMapping) > 0 && config.StatusCodeColumn == "" {
+		errs = append(errs, errors.New("'status_mapping' requires 'status_code_column'"))
+	}
+	if err := validateStatusMapping(config.StatusMapping); err != nil {
+		errs = append(errs, err)
+	}
+	if config.Events != nil {
+		if err := config.Events.Validate(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if config.Links != nil {
+		if err := config.Links.Validate(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// statusCodes maps the lowercase names of the status codes to the status codes.
+var statusCodes = map[string]ptrace.StatusCode{
+	"unset":             ptrace.StatusCodeUnset,
+	"ok":                ptrace.StatusCodeOk,
+	"error":             ptrace.StatusCodeError,
+	"status_code_unset": ptrace.StatusCodeUnset,
+	"status_code_ok":    ptrace.StatusCodeOk,
+	"status_code_error": ptrace.StatusCodeError,
+}
+
+func validateStatusMapping(mapping map[string]string) error {
+	for value, code := range mapping {
+		if _, ok := statusCodes[strings.ToLower(code)]; !ok {
+			return fmt.Errorf("'status_mapping' has unsupported status code '%s' for value '%s'", code, value)
+		}
+	}
+	return nil
+}
+
+// ParseStatusCode returns the status code of the value of a status code column. The value is
+// looked up in the mapping first, then among the names of the status codes regardless of the
+// case. A NULL value leaves the status code unset.
+func ParseStatusCode(value string, mapping map[string]string) (ptrace.StatusCode, error) {
+	if code, ok := mapping[value]; ok {
+		value = code
+	}
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return ptrace.StatusCodeUnset, nil
+	}
+	code, ok := statusCodes[strings.ToLower(value)]
+	if !ok {
+		return ptrace.StatusCodeUnset, fmt.Errorf("unsupported status code '%s'", value)
+	}
+	return code, nil
+}
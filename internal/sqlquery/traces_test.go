@@ -0,0 +1,64 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlquery
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+func TestTracesCfg_Validate(t *testing.T) {
+	valid := TracesCfg{
+		TraceIDColumn:        "trace_id",
+		SpanIDColumn:         "span_id",
+		NameColumn:           "job_name",
+		StartTimestampColumn: "started_at",
+		StatusCodeColumn:     "state",
+		StatusMapping:        map[string]string{"FAILED": "error", "SUCCEEDED": "OK"},
+	}
+	require.NoError(t, valid.Validate())
+
+	assert.EqualError(t, TracesCfg{}.Validate(), "'trace_id_column' must not be empty\n"+
+		"'span_id_column' must not be empty\n"+
+		"one of 'name_column' and 'name' must be set\n"+
+		"'start_timestamp_column' must not be empty")
+
+	invalid := valid
+	invalid.Events = &SpanEventsCfg{SQL: "select * from job_steps", SpanIDColumn: "span_id", NameColumn: "step"}
+	invalid.Links = &SpanLinksCfg{SpanIDColumn: "span_id", LinkedTraceIDColumn: "trace_id", LinkedSpanIDColumn: "triggered_by"}
+	invalid.Name = "job"
+	invalid.StatusCodeColumn = ""
+	invalid.StatusMapping = map[string]string{"FAILED": "failed"}
+	assert.EqualError(t, invalid.Validate(), "'name_column' cannot be set with 'name'\n"+
+		"'status_mapping' requires 'status_code_column'\n"+
+		"'status_mapping' has unsupported status code 'failed' for value 'FAILED'\n"+
+		"'events.timestamp_column' must not be empty\n"+
+		"'links.sql' cannot be empty")
+}
+
+func TestParseStatusCode(t *testing.T) {
+	mapping := map[string]string{"FAILED": "error", "SUCCEEDED": "ok"}
+	tests := []struct {
+		value    string
+		expected ptrace.StatusCode
+	}{
+		{value: "FAILED", expected: ptrace.StatusCodeError},
+		{value: "SUCCEEDED", expected: ptrace.StatusCodeOk},
+		{value: "Error", expected: ptrace.StatusCodeError},
+		{value: " ok ", expected: ptrace.StatusCodeOk},
+		{value: "STATUS_CODE_ERROR", expected: ptrace.StatusCodeError},
+		{value: "", expected: ptrace.StatusCodeUnset},
+	}
+	for _, tt := range tests {
+		code, err := ParseStatusCode(tt.value, mapping)
+		require.NoError(t, err)
+		assert.Equal(t, tt.expected, code, tt.value)
+	}
+
+	_, err := ParseStatusCode("RUNNING", mapping)
+	assert.EqualError(t, err, "unsupported status code 'RUNNING'")
+}
@@ -0,0 +1,52 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlquery // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/sqlquery"
+
+import (
+	"errors"
+	"net/url"
+	"strings"
+)
+
+const (
+	driverSQLServer = "sqlserver"
+
+	// winsspiAuthenticator is the integrated authentication provider of the SQL Server
+	// driver using the Windows credentials of the process, only registered on Windows.
+	winsspiAuthenticator = "winsspi"
+)
+
+var errTrustedConnectionUnsupported = errors.New("'trusted_connection' is only supported on Windows")
+
+func (c Config) validateTrustedConnection() error {
+	if !c.TrustedConnection {
+		return nil
+	}
+	if c.Driver != driverSQLServer {
+		return errors.New("'trusted_connection' is only supported by the 'sqlserver' driver")
+	}
+	if !trustedConnectionSupported {
+		return errTrustedConnectionUnsupported
+	}
+	return nil
+}
+
+// ConnectionString returns the datasource passed to the driver. With a trusted connection,
+// the SQL Server driver is forced to authenticate with the Windows credentials of the
+// collector's service account instead of falling back to a SQL login.
+func (c Config) ConnectionString() string {
+	if !c.TrustedConnection {
+		return c.DataSource
+	}
+	if strings.HasPrefix(c.DataSource, driverSQLServer+"://") {
+		if u, err := url.Parse(c.DataSource); err == nil {
+			query := u.Query()
+			query.Set("authenticator", winsspiAuthenticator)
+			u.RawQuery = query.Encode()
+			return u.String()
+		}
+	}
+	// the ADO and ODBC connection strings are lists of key=value pairs separated by ';'
+	return strings.TrimSuffix(c.DataSource, ";") + ";authenticator=" + winsspiAuthenticator
+}
@@ -0,0 +1,122 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlquery // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/sqlquery"
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// validateValueColumns validates the column names and the glob patterns of the value columns.
+func validateValueColumns(columns []string) []error {
+	var errs []error
+	for _, column := range columns {
+		if column == "" {
+			errs = append(errs, errors.New("'value_columns' cannot contain empty columns"))
+		} else if _, err := path.Match(column, ""); err != nil {
+			errs = append(errs, fmt.Errorf("'value_columns': invalid pattern %q: %w", column, err))
+		}
+	}
+	return errs
+}
+
+// isValueColumnPattern returns whether the value column is a glob pattern rather than a column name.
+func isValueColumnPattern(column string) bool {
+	return strings.ContainsAny(column, `*?[\`)
+}
+
+// rowMetricCfgs returns the configs of the metrics of the row. A metric with value columns is
+// split into a metric per value column of the row, named from the column, the glob patterns
+// matching the numeric columns which aren't attributes, timestamps, metadata or exemplar columns.
+func (q Query) rowMetricCfgs(row StringMap, cfg MetricCfg) ([]MetricCfg, error) {
+	if cfg.UnitColumn != "" || cfg.DescriptionColumn != "" {
+		var err error
+		if cfg, err = rowMetadataCfg(row, cfg); err != nil {
+			return nil, err
+		}
+	}
+	if cfg.MetricNameColumn != "" {
+		rowCfg, err := rowMetricNameCfg(row, cfg)
+		if err != nil {
+			return nil, err
+		}
+		return []MetricCfg{rowCfg}, nil
+	}
+	if len(cfg.ValueColumns) == 0 {
+		return []MetricCfg{cfg}, nil
+	}
+	excluded := q.nonValueColumns(cfg)
+	columns := map[string]struct{}{}
+	for _, column := range cfg.ValueColumns {
+		if !isValueColumnPattern(column) {
+			// the columns named explicitly must be in the result set and hold numbers
+			columns[column] = struct{}{}
+			continue
+		}
+		for name, value := range row {
+			if _, found := excluded[name]; found {
+				continue
+			}
+			if matched, _ := path.Match(column, name); !matched {
+				continue
+			}
+			if _, err := strconv.ParseFloat(value, 64); err == nil {
+				columns[name] = struct{}{}
+			}
+		}
+	}
+	names := make([]string, 0, len(columns))
+	for name := range columns {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	cfgs := make([]MetricCfg, 0, len(names))
+	for _, name := range names {
+		columnCfg := cfg
+		columnCfg.ValueColumns = nil
+		columnCfg.ValueColumn = name
+		columnCfg.MetricName = prefixedMetricName(cfg.MetricName, name)
+		if cfg.ValueType == MetricValueTypeUnspecified {
+			columnCfg.ValueType = MetricValueTypeInt
+			if _, err := strconv.ParseInt(row[name], 10, 64); err != nil {
+				columnCfg.ValueType = MetricValueTypeDouble
+			}
+		}
+		cfgs = append(cfgs, columnCfg)
+	}
+	return cfgs, nil
+}
+
+// nonValueColumns returns the columns of the query and of the metric which aren't matched by the
+// glob patterns of the value columns.
+func (q Query) nonValueColumns(cfg MetricCfg) map[string]struct{} {
+	columns := map[string]struct{}{}
+	for _, column := range q.ResourceAttributeColumns {
+		columns[column] = struct{}{}
+	}
+	for _, column := range cfg.AttributeColumns {
+		columns[column] = struct{}{}
+	}
+	if q.TrackingColumn != "" {
+		columns[q.TrackingColumn] = struct{}{}
+	}
+	for _, column := range []string{cfg.StartTsColumn, cfg.TsColumn, cfg.UnitColumn, cfg.DescriptionColumn} {
+		if column != "" {
+			columns[column] = struct{}{}
+		}
+	}
+	if cfg.Exemplar != nil {
+		for _, column := range append([]string{cfg.Exemplar.TraceIDColumn, cfg.Exemplar.SpanIDColumn, cfg.Exemplar.ValueColumn, cfg.Exemplar.TsColumn}, cfg.Exemplar.FilteredAttributeColumns...) {
+			if column != "" {
+				columns[column] = struct{}{}
+			}
+		}
+	}
+	return columns
+}
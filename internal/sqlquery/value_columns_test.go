@@ -0,0 +1,102 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlquery
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+func TestScraper_ValueColumns(t *testing.T) {
+	client := &FakeDBClient{
+		StringMaps: [][]StringMap{{
+			{"db": "orders", "schema": "public", "seq_scan": "12", "idx_scan": "340", "hit_ratio": "0.98", "last_vacuum": "2024-05-01", "ts": "1714521600000000000"},
+		}},
+	}
+	scrpr := Scraper{
+		Client: client,
+		Query: Query{
+			ResourceAttributeColumns: []string{"db"},
+			Metrics: []MetricCfg{{
+				MetricName:       "pg.stat",
+				ValueColumns:     []string{"*"},
+				AttributeColumns: []string{"schema"},
+				TsColumn:         "ts",
+			}},
+		},
+	}
+
+	metrics, err := scrpr.Scrape(context.Background())
+	require.NoError(t, err)
+	ms := metrics.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	// the metrics are sorted by column, the non numeric columns and the columns used otherwise skipped
+	require.Equal(t, 3, ms.Len())
+	assert.Equal(t, "pg.stat.hit_ratio", ms.At(0).Name())
+	assert.Equal(t, "pg.stat.idx_scan", ms.At(1).Name())
+	assert.Equal(t, "pg.stat.seq_scan", ms.At(2).Name())
+	hitRatio := ms.At(0).Gauge().DataPoints().At(0)
+	assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, hitRatio.ValueType())
+	assert.Equal(t, 0.98, hitRatio.DoubleValue())
+	assert.Equal(t, map[string]any{"schema": "public"}, hitRatio.Attributes().AsRaw())
+	assert.EqualValues(t, 1714521600000000000, hitRatio.Timestamp())
+	seqScan := ms.At(2).Gauge().DataPoints().At(0)
+	assert.Equal(t, pmetric.NumberDataPointValueTypeInt, seqScan.ValueType())
+	assert.EqualValues(t, 12, seqScan.IntValue())
+}
+
+func TestRowMetricCfgs(t *testing.T) {
+	row := StringMap{"rows_read": "10", "rows_written": "2", "blocks_read": "7.5", "name": "t1", "trace_id": "1"}
+	query := Query{}
+
+	cfgs, err := query.rowMetricCfgs(row, MetricCfg{MetricName: "db", ValueColumn: "rows_read"})
+	require.NoError(t, err)
+	assert.Equal(t, []MetricCfg{{MetricName: "db", ValueColumn: "rows_read"}}, cfgs)
+
+	cfgs, err = query.rowMetricCfgs(row, MetricCfg{
+		ValueColumns: []string{"rows_*", "blocks_read"},
+		ValueType:    MetricValueTypeDouble,
+		DataType:     MetricTypeSum,
+		Monotonic:    true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []MetricCfg{
+		{MetricName: "blocks_read", ValueColumn: "blocks_read", ValueType: MetricValueTypeDouble, DataType: MetricTypeSum, Monotonic: true},
+		{MetricName: "rows_read", ValueColumn: "rows_read", ValueType: MetricValueTypeDouble, DataType: MetricTypeSum, Monotonic: true},
+		{MetricName: "rows_written", ValueColumn: "rows_written", ValueType: MetricValueTypeDouble, DataType: MetricTypeSum, Monotonic: true},
+	}, cfgs)
+
+	// the exemplar columns aren't values, the columns named explicitly are always values
+	cfgs, err = query.rowMetricCfgs(row, MetricCfg{
+		ValueColumns: []string{"*", "name"},
+		Exemplar:     &ExemplarCfg{TraceIDColumn: "trace_id"},
+	})
+	require.NoError(t, err)
+	names := make([]string, 0, len(cfgs))
+	for _, cfg := range cfgs {
+		names = append(names, cfg.MetricName)
+	}
+	assert.Equal(t, []string{"blocks_read", "name", "rows_read", "rows_written"}, names)
+	assert.Equal(t, MetricValueTypeDouble, cfgs[0].ValueType)
+	assert.Equal(t, MetricValueTypeInt, cfgs[2].ValueType)
+}
+
+func TestMetricCfgValidateValueColumns(t *testing.T) {
+	assert.NoError(t, MetricCfg{ValueColumns: []string{"*"}}.Validate())
+	assert.Equal(t, "'value_columns': invalid pattern \"[a-\": syntax error in pattern\n"+
+		"'value_columns' cannot contain empty columns\n"+
+		"'value_column' cannot be set with 'value_columns'\n"+
+		"'expand_value' cannot be set with 'value_columns'\n"+
+		"invalid metric config with metric_name 'db'",
+		MetricCfg{MetricName: "db", ValueColumns: []string{"[a-", ""}, ValueColumn: "count", ExpandValue: true}.Validate().Error())
+	assert.ErrorContains(t, MetricCfg{
+		MetricName:   "db",
+		ValueColumns: []string{"*"},
+		DataType:     MetricTypeSummary,
+		Summary:      &SummaryCfg{CountColumn: "count", SumColumn: "sum"},
+	}.Validate(), "'value_columns' cannot be set with 'data_type: summary'")
+}
@@ -0,0 +1,210 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package batchpersize splits batches of telemetry so the encoded payload sent by
+// an exporter stays within the request size limit of its protocol, e.g. the 4MiB
+// limit gRPC servers apply to received messages by default, instead of having the
+// request rejected and retried over and over.
+//
+// The size of a batch is estimated with the same encoding the exporter uses:
+// ptrace.ProtoMarshaler and its equivalents for protobuf, or JSONSizer for OTLP JSON.
+// The otelarrowexporter is the only exporter splitting its batches with it so far.
+// The batch is encoded once, the size of its halves being estimated from its size in
+// proportion to the spans, data points or log records they hold, plus the size of the
+// resource and scope duplicated in both halves.
+package batchpersize // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/batchpersize"
+
+import (
+	"context"
+	"errors"
+
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+type batchTraces struct {
+	maxSize int
+	sizer   ptrace.Sizer
+	next    consumer.Traces
+}
+
+// NewBatchPerSizeTraces returns a consumer which splits traces into batches whose
+// size, as estimated by sizer, doesn't exceed maxSize bytes. A single span larger
+// than maxSize is passed on as is.
+//
+// When some batches fail, the returned error is a consumererror.Traces holding only
+// the failed batches, so that an exporterhelper retries them and not the batches
+// already sent.
+func NewBatchPerSizeTraces(maxSize int, sizer ptrace.Sizer, next consumer.Traces) consumer.Traces {
+	return &batchTraces{
+		maxSize: maxSize,
+		sizer:   sizer,
+		next:    next,
+	}
+}
+
+// Capabilities implements the consumer interface.
+func (bt *batchTraces) Capabilities() consumer.Capabilities {
+	// the spans are moved into the smaller batches
+	return consumer.Capabilities{MutatesData: true}
+}
+
+func (bt *batchTraces) ConsumeTraces(ctx context.Context, td ptrace.Traces) error {
+	if bt.maxSize <= 0 {
+		return bt.next.ConsumeTraces(ctx, td)
+	}
+	failed := ptrace.NewTraces()
+	retryable, permanent := consumeBySize(ctx, td, bt.sizer.TracesSize(td), bt.sizer.TracesSize(tracesEnvelope(td)), bt.maxSize,
+		ptrace.Traces.SpanCount, splitTraces, bt.next.ConsumeTraces,
+		func(td ptrace.Traces, err error) {
+			var tracesErr consumererror.Traces
+			if errors.As(err, &tracesErr) {
+				td = tracesErr.Data()
+			}
+			td.ResourceSpans().MoveAndAppendTo(failed.ResourceSpans())
+		})
+	return joinErrors(retryable, permanent, func(err error) error {
+		return consumererror.NewTraces(err, failed)
+	})
+}
+
+type batchMetrics struct {
+	maxSize int
+	sizer   pmetric.Sizer
+	next    consumer.Metrics
+}
+
+// NewBatchPerSizeMetrics returns a consumer which splits metrics into batches whose
+// size, as estimated by sizer, doesn't exceed maxSize bytes. A single data point
+// larger than maxSize is passed on as is.
+//
+// When some batches fail, the returned error is a consumererror.Metrics holding only
+// the failed batches.
+func NewBatchPerSizeMetrics(maxSize int, sizer pmetric.Sizer, next consumer.Metrics) consumer.Metrics {
+	return &batchMetrics{
+		maxSize: maxSize,
+		sizer:   sizer,
+		next:    next,
+	}
+}
+
+// Capabilities implements the consumer interface.
+func (bm *batchMetrics) Capabilities() consumer.Capabilities {
+	// the data points are moved into the smaller batches
+	return consumer.Capabilities{MutatesData: true}
+}
+
+func (bm *batchMetrics) ConsumeMetrics(ctx context.Context, md pmetric.Metrics) error {
+	if bm.maxSize <= 0 {
+		return bm.next.ConsumeMetrics(ctx, md)
+	}
+	failed := pmetric.NewMetrics()
+	retryable, permanent := consumeBySize(ctx, md, bm.sizer.MetricsSize(md), bm.sizer.MetricsSize(metricsEnvelope(md)), bm.maxSize,
+		pmetric.Metrics.DataPointCount, splitMetrics, bm.next.ConsumeMetrics,
+		func(md pmetric.Metrics, err error) {
+			var metricsErr consumererror.Metrics
+			if errors.As(err, &metricsErr) {
+				md = metricsErr.Data()
+			}
+			md.ResourceMetrics().MoveAndAppendTo(failed.ResourceMetrics())
+		})
+	return joinErrors(retryable, permanent, func(err error) error {
+		return consumererror.NewMetrics(err, failed)
+	})
+}
+
+type batchLogs struct {
+	maxSize int
+	sizer   plog.Sizer
+	next    consumer.Logs
+}
+
+// NewBatchPerSizeLogs returns a consumer which splits logs into batches whose
+// size, as estimated by sizer, doesn't exceed maxSize bytes. A single log record
+// larger than maxSize is passed on as is.
+//
+// When some batches fail, the returned error is a consumererror.Logs holding only
+// the failed batches.
+func NewBatchPerSizeLogs(maxSize int, sizer plog.Sizer, next consumer.Logs) consumer.Logs {
+	return &batchLogs{
+		maxSize: maxSize,
+		sizer:   sizer,
+		next:    next,
+	}
+}
+
+// Capabilities implements the consumer interface.
+func (bl *batchLogs) Capabilities() consumer.Capabilities {
+	// the log records are moved into the smaller batches
+	return consumer.Capabilities{MutatesData: true}
+}
+
+func (bl *batchLogs) ConsumeLogs(ctx context.Context, ld plog.Logs) error {
+	if bl.maxSize <= 0 {
+		return bl.next.ConsumeLogs(ctx, ld)
+	}
+	failed := plog.NewLogs()
+	retryable, permanent := consumeBySize(ctx, ld, bl.sizer.LogsSize(ld), bl.sizer.LogsSize(logsEnvelope(ld)), bl.maxSize,
+		plog.Logs.LogRecordCount, splitLogs, bl.next.ConsumeLogs,
+		func(ld plog.Logs, err error) {
+			var logsErr consumererror.Logs
+			if errors.As(err, &logsErr) {
+				ld = logsErr.Data()
+			}
+			ld.ResourceLogs().MoveAndAppendTo(failed.ResourceLogs())
+		})
+	return joinErrors(retryable, permanent, func(err error) error {
+		return consumererror.NewLogs(err, failed)
+	})
+}
+
+// consumeBySize passes data on to next, or its halves if its estimated size exceeds
+// maxSize, the size of each half being estimated from size in proportion to count,
+// plus the size of the envelope copied into both halves. The batches failing with a
+// retryable error are passed to retry. It returns the retryable and the permanent
+// errors of the batches.
+func consumeBySize[T any](
+	ctx context.Context,
+	data T,
+	size, envelope, maxSize int,
+	count func(T) int,
+	split func(T) (T, bool),
+	next func(context.Context, T) error,
+	retry func(T, error),
+) (retryable, permanent []error) {
+	if size > maxSize {
+		total := count(data)
+		if second, ok := split(data); ok {
+			firstSize := size / 2
+			if total > 0 {
+				firstSize = size * count(data) / total
+			}
+			retryable, permanent = consumeBySize(ctx, data, firstSize+envelope, envelope, maxSize, count, split, next, retry)
+			retryable2, permanent2 := consumeBySize(ctx, second, size-firstSize+envelope, envelope, maxSize, count, split, next, retry)
+			return append(retryable, retryable2...), append(permanent, permanent2...)
+		}
+	}
+
+	err := next(ctx, data)
+	switch {
+	case err == nil:
+		return nil, nil
+	case consumererror.IsPermanent(err):
+		return nil, []error{err}
+	}
+	retry(data, err)
+	return []error{err}, nil
+}
+
+// joinErrors returns the error of a split batch. When some batches can be retried,
+// only their errors are returned, wrapped by withData with the failed batches, the
+// batches rejected permanently being dropped as they would be by a retry.
+func joinErrors(retryable, permanent []error, withData func(error) error) error {
+	if len(retryable) > 0 {
+		return withData(errors.Join(retryable...))
+	}
+	return errors.Join(permanent...)
+}
@@ -0,0 +1,309 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package batchpersize
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+func TestBatchPerSizeTraces(t *testing.T) {
+	tests := []struct {
+		name      string
+		resources int
+		scopes    int
+		spans     int
+	}{
+		{name: "resources", resources: 10, scopes: 1, spans: 10},
+		{name: "scopes", resources: 1, scopes: 10, spans: 10},
+		{name: "spans", resources: 1, scopes: 1, spans: 100},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sizer := &ptrace.ProtoMarshaler{}
+			td := newTraces(tt.resources, tt.scopes, tt.spans)
+			maxSize := sizer.TracesSize(td) / 5
+
+			sink := new(consumertest.TracesSink)
+			require.NoError(t, NewBatchPerSizeTraces(maxSize, sizer, sink).ConsumeTraces(context.Background(), td))
+
+			assert.Greater(t, len(sink.AllTraces()), 1)
+			for _, batch := range sink.AllTraces() {
+				assert.LessOrEqual(t, sizer.TracesSize(batch), maxSize)
+			}
+			assert.Equal(t, tt.resources*tt.scopes*tt.spans, sink.SpanCount())
+
+			// each batch keeps the resource and scope of its spans
+			batch := sink.AllTraces()[len(sink.AllTraces())-1]
+			rs := batch.ResourceSpans().At(0)
+			_, ok := rs.Resource().Attributes().Get("resource")
+			assert.True(t, ok)
+			assert.Equal(t, "https://opentelemetry.io/schemas/1.25.0", rs.SchemaUrl())
+			assert.True(t, strings.HasPrefix(rs.ScopeSpans().At(0).Scope().Name(), "scope"))
+		})
+	}
+}
+
+func TestBatchPerSizeTracesWithinLimit(t *testing.T) {
+	sizer := &ptrace.ProtoMarshaler{}
+	td := newTraces(2, 2, 2)
+
+	sink := new(consumertest.TracesSink)
+	require.NoError(t, NewBatchPerSizeTraces(sizer.TracesSize(td), sizer, sink).ConsumeTraces(context.Background(), td))
+	require.Len(t, sink.AllTraces(), 1)
+	assert.Equal(t, newTraces(2, 2, 2), sink.AllTraces()[0])
+}
+
+func TestBatchPerSizeTracesOversizedSpan(t *testing.T) {
+	sizer := &ptrace.ProtoMarshaler{}
+	td := newTraces(1, 1, 1)
+
+	sink := new(consumertest.TracesSink)
+	require.NoError(t, NewBatchPerSizeTraces(1, sizer, sink).ConsumeTraces(context.Background(), td))
+	require.Len(t, sink.AllTraces(), 1)
+	assert.Equal(t, 1, sink.SpanCount())
+}
+
+func TestBatchPerSizeTracesReturnError(t *testing.T) {
+	sizer := &ptrace.ProtoMarshaler{}
+	td := newTraces(2, 1, 1)
+
+	err := errors.New("test_error")
+	bps := NewBatchPerSizeTraces(sizer.TracesSize(td)/2, sizer, consumertest.NewErr(err))
+	assert.ErrorIs(t, bps.ConsumeTraces(context.Background(), td), err)
+}
+
+// failingResources returns a consumer failing with errFor the batches holding the resources
+// whose "resource" attribute is in fail, passing the others on to sink.
+func failingResources(t *testing.T, sink *consumertest.TracesSink, errFor func(int64) error) consumer.Traces {
+	next, err := consumer.NewTraces(func(ctx context.Context, td ptrace.Traces) error {
+		resource, _ := td.ResourceSpans().At(0).Resource().Attributes().Get("resource")
+		if err := errFor(resource.Int()); err != nil {
+			return err
+		}
+		return sink.ConsumeTraces(ctx, td)
+	})
+	require.NoError(t, err)
+	return next
+}
+
+func TestBatchPerSizeTracesRetryFailedBatches(t *testing.T) {
+	sizer := &ptrace.ProtoMarshaler{}
+	td := newTraces(4, 1, 1)
+
+	sink := new(consumertest.TracesSink)
+	next := failingResources(t, sink, func(resource int64) error {
+		if resource%2 == 1 {
+			return errors.New("test_error")
+		}
+		return nil
+	})
+	err := NewBatchPerSizeTraces(sizer.TracesSize(td)/4, sizer, next).ConsumeTraces(context.Background(), td)
+	require.Error(t, err)
+	assert.False(t, consumererror.IsPermanent(err))
+
+	// only the failed batches are returned to be retried
+	var tracesErr consumererror.Traces
+	require.ErrorAs(t, err, &tracesErr)
+	failed := tracesErr.Data().ResourceSpans()
+	require.Equal(t, 2, failed.Len())
+	for i := 0; i < failed.Len(); i++ {
+		resource, _ := failed.At(i).Resource().Attributes().Get("resource")
+		assert.Equal(t, int64(1), resource.Int()%2)
+	}
+	assert.Equal(t, 2, sink.SpanCount())
+}
+
+func TestBatchPerSizeTracesPermanentError(t *testing.T) {
+	sizer := &ptrace.ProtoMarshaler{}
+	td := newTraces(2, 1, 1)
+
+	// the batch rejected permanently is dropped, the other one is retried
+	next := failingResources(t, new(consumertest.TracesSink), func(resource int64) error {
+		if resource == 0 {
+			return consumererror.NewPermanent(errors.New("permanent_error"))
+		}
+		return errors.New("test_error")
+	})
+	err := NewBatchPerSizeTraces(sizer.TracesSize(td)/2, sizer, next).ConsumeTraces(context.Background(), td)
+	assert.False(t, consumererror.IsPermanent(err))
+	var tracesErr consumererror.Traces
+	require.ErrorAs(t, err, &tracesErr)
+	assert.Equal(t, 1, tracesErr.Data().SpanCount())
+
+	// the error is permanent when all the batches are rejected permanently
+	td = newTraces(2, 1, 1)
+	next = failingResources(t, new(consumertest.TracesSink), func(int64) error {
+		return consumererror.NewPermanent(errors.New("permanent_error"))
+	})
+	err = NewBatchPerSizeTraces(sizer.TracesSize(td)/2, sizer, next).ConsumeTraces(context.Background(), td)
+	assert.True(t, consumererror.IsPermanent(err))
+}
+
+// countingSizer counts the spans it sizes.
+type countingSizer struct {
+	ptrace.ProtoMarshaler
+	spans int
+}
+
+func (s *countingSizer) TracesSize(td ptrace.Traces) int {
+	s.spans += td.SpanCount()
+	return s.ProtoMarshaler.TracesSize(td)
+}
+
+func TestBatchPerSizeTracesSizedOnce(t *testing.T) {
+	sizer := &countingSizer{}
+	td := newTraces(1, 1, 100)
+	maxSize := (&ptrace.ProtoMarshaler{}).TracesSize(td) / 10
+
+	sink := new(consumertest.TracesSink)
+	require.NoError(t, NewBatchPerSizeTraces(maxSize, sizer, sink).ConsumeTraces(context.Background(), td))
+	assert.Greater(t, len(sink.AllTraces()), 10)
+	// the spans are encoded once, to size the whole batch
+	assert.Equal(t, 100, sizer.spans)
+}
+
+func TestBatchPerSizeMetrics(t *testing.T) {
+	tests := []struct {
+		name       string
+		metrics    int
+		dataPoints int
+		fill       func(pmetric.Metric, int)
+	}{
+		{name: "metrics", metrics: 50, dataPoints: 2, fill: fillGauge},
+		{name: "gauge data points", metrics: 1, dataPoints: 100, fill: fillGauge},
+		{name: "sum data points", metrics: 1, dataPoints: 100, fill: fillSum},
+		{name: "histogram data points", metrics: 1, dataPoints: 100, fill: fillHistogram},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sizer := &pmetric.ProtoMarshaler{}
+			md := pmetric.NewMetrics()
+			sm := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty()
+			for i := 0; i < tt.metrics; i++ {
+				m := sm.Metrics().AppendEmpty()
+				m.SetName("metric" + strconv.Itoa(i))
+				tt.fill(m, tt.dataPoints)
+			}
+			maxSize := sizer.MetricsSize(md) / 5
+
+			sink := new(consumertest.MetricsSink)
+			require.NoError(t, NewBatchPerSizeMetrics(maxSize, sizer, sink).ConsumeMetrics(context.Background(), md))
+
+			assert.Greater(t, len(sink.AllMetrics()), 1)
+			for _, batch := range sink.AllMetrics() {
+				assert.LessOrEqual(t, sizer.MetricsSize(batch), maxSize)
+			}
+			assert.Equal(t, tt.metrics*tt.dataPoints, sink.DataPointCount())
+
+			// the metric metadata is kept when its data points are split
+			batch := sink.AllMetrics()[len(sink.AllMetrics())-1]
+			m := batch.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0)
+			assert.Equal(t, md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Type(), m.Type())
+			assert.Equal(t, "By", m.Unit())
+			if m.Type() == pmetric.MetricTypeSum {
+				assert.True(t, m.Sum().IsMonotonic())
+				assert.Equal(t, pmetric.AggregationTemporalityCumulative, m.Sum().AggregationTemporality())
+			}
+		})
+	}
+}
+
+func TestBatchPerSizeLogs(t *testing.T) {
+	sizer := JSONSizer{}
+	ld := plog.NewLogs()
+	for i := 0; i < 3; i++ {
+		rl := ld.ResourceLogs().AppendEmpty()
+		rl.Resource().Attributes().PutInt("resource", int64(i))
+		lrs := rl.ScopeLogs().AppendEmpty().LogRecords()
+		for j := 0; j < 50; j++ {
+			lrs.AppendEmpty().Body().SetStr(strings.Repeat("log line ", 10))
+		}
+	}
+	maxSize := sizer.LogsSize(ld) / 10
+
+	sink := new(consumertest.LogsSink)
+	require.NoError(t, NewBatchPerSizeLogs(maxSize, sizer, sink).ConsumeLogs(context.Background(), ld))
+
+	assert.Greater(t, len(sink.AllLogs()), 10)
+	for _, batch := range sink.AllLogs() {
+		assert.LessOrEqual(t, sizer.LogsSize(batch), maxSize)
+	}
+	assert.Equal(t, 150, sink.LogRecordCount())
+}
+
+func TestBatchPerSizeDisabled(t *testing.T) {
+	sink := new(consumertest.LogsSink)
+	ld := plog.NewLogs()
+	ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+	ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+
+	require.NoError(t, NewBatchPerSizeLogs(0, &plog.ProtoMarshaler{}, sink).ConsumeLogs(context.Background(), ld))
+	assert.Len(t, sink.AllLogs(), 1)
+}
+
+func newTraces(resources, scopes, spans int) ptrace.Traces {
+	td := ptrace.NewTraces()
+	for i := 0; i < resources; i++ {
+		rs := td.ResourceSpans().AppendEmpty()
+		rs.SetSchemaUrl("https://opentelemetry.io/schemas/1.25.0")
+		rs.Resource().Attributes().PutInt("resource", int64(i))
+		for j := 0; j < scopes; j++ {
+			ss := rs.ScopeSpans().AppendEmpty()
+			ss.Scope().SetName("scope" + strconv.Itoa(j))
+			for k := 0; k < spans; k++ {
+				span := ss.Spans().AppendEmpty()
+				span.SetName("span" + strconv.Itoa(k))
+				span.Attributes().PutStr("key", strings.Repeat("value", 10))
+			}
+		}
+	}
+	return td
+}
+
+func fillGauge(m pmetric.Metric, n int) {
+	m.SetUnit("By")
+	dps := m.SetEmptyGauge().DataPoints()
+	for i := 0; i < n; i++ {
+		dp := dps.AppendEmpty()
+		dp.SetIntValue(int64(i))
+		dp.Attributes().PutInt("index", int64(i))
+	}
+}
+
+func fillSum(m pmetric.Metric, n int) {
+	m.SetUnit("By")
+	sum := m.SetEmptySum()
+	sum.SetIsMonotonic(true)
+	sum.SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	for i := 0; i < n; i++ {
+		dp := sum.DataPoints().AppendEmpty()
+		dp.SetIntValue(int64(i))
+		dp.Attributes().PutInt("index", int64(i))
+	}
+}
+
+func fillHistogram(m pmetric.Metric, n int) {
+	m.SetUnit("By")
+	histogram := m.SetEmptyHistogram()
+	for i := 0; i < n; i++ {
+		dp := histogram.DataPoints().AppendEmpty()
+		dp.SetCount(uint64(i))
+		dp.BucketCounts().FromRaw([]uint64{0, uint64(i)})
+		dp.ExplicitBounds().FromRaw([]float64{10})
+		dp.Attributes().PutInt("index", int64(i))
+	}
+}
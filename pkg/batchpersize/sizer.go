@@ -0,0 +1,44 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package batchpersize // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/batchpersize"
+
+import (
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+var (
+	_ ptrace.Sizer  = JSONSizer{}
+	_ pmetric.Sizer = JSONSizer{}
+	_ plog.Sizer    = JSONSizer{}
+)
+
+// JSONSizer estimates the size of telemetry encoded as OTLP JSON. Unlike protobuf,
+// the JSON size can only be known by encoding the data.
+type JSONSizer struct{}
+
+func (JSONSizer) TracesSize(td ptrace.Traces) int {
+	buf, err := (&ptrace.JSONMarshaler{}).MarshalTraces(td)
+	if err != nil {
+		return 0
+	}
+	return len(buf)
+}
+
+func (JSONSizer) MetricsSize(md pmetric.Metrics) int {
+	buf, err := (&pmetric.JSONMarshaler{}).MarshalMetrics(md)
+	if err != nil {
+		return 0
+	}
+	return len(buf)
+}
+
+func (JSONSizer) LogsSize(ld plog.Logs) int {
+	buf, err := (&plog.JSONMarshaler{}).MarshalLogs(ld)
+	if err != nil {
+		return 0
+	}
+	return len(buf)
+}
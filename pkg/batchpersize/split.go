@@ -0,0 +1,286 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package batchpersize // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/batchpersize"
+
+import (
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+// splitTraces moves the second half of td into a new batch, halving the resources,
+// or the scopes of a single resource, or the spans of a single scope.
+// It reports false if td holds at most one span.
+func splitTraces(td ptrace.Traces) (ptrace.Traces, bool) {
+	second := ptrace.NewTraces()
+	rss := td.ResourceSpans()
+	if rss.Len() > 1 {
+		moveHalf(rss.Len(), rss.RemoveIf, func(rs ptrace.ResourceSpans) {
+			rs.MoveTo(second.ResourceSpans().AppendEmpty())
+		})
+		return second, true
+	}
+	if rss.Len() == 0 {
+		return second, false
+	}
+
+	rs := rss.At(0)
+	rs2 := second.ResourceSpans().AppendEmpty()
+	rs.Resource().CopyTo(rs2.Resource())
+	rs2.SetSchemaUrl(rs.SchemaUrl())
+	sss := rs.ScopeSpans()
+	if sss.Len() > 1 {
+		moveHalf(sss.Len(), sss.RemoveIf, func(ss ptrace.ScopeSpans) {
+			ss.MoveTo(rs2.ScopeSpans().AppendEmpty())
+		})
+		return second, true
+	}
+	if sss.Len() == 0 || sss.At(0).Spans().Len() <= 1 {
+		return second, false
+	}
+
+	ss := sss.At(0)
+	ss2 := rs2.ScopeSpans().AppendEmpty()
+	ss.Scope().CopyTo(ss2.Scope())
+	ss2.SetSchemaUrl(ss.SchemaUrl())
+	spans := ss.Spans()
+	moveHalf(spans.Len(), spans.RemoveIf, func(span ptrace.Span) {
+		span.MoveTo(ss2.Spans().AppendEmpty())
+	})
+	return second, true
+}
+
+// splitMetrics moves the second half of md into a new batch, halving the resources,
+// or the scopes of a single resource, or the metrics of a single scope, or the data
+// points of a single metric. It reports false if md holds at most one data point.
+func splitMetrics(md pmetric.Metrics) (pmetric.Metrics, bool) {
+	second := pmetric.NewMetrics()
+	rms := md.ResourceMetrics()
+	if rms.Len() > 1 {
+		moveHalf(rms.Len(), rms.RemoveIf, func(rm pmetric.ResourceMetrics) {
+			rm.MoveTo(second.ResourceMetrics().AppendEmpty())
+		})
+		return second, true
+	}
+	if rms.Len() == 0 {
+		return second, false
+	}
+
+	rm := rms.At(0)
+	rm2 := second.ResourceMetrics().AppendEmpty()
+	rm.Resource().CopyTo(rm2.Resource())
+	rm2.SetSchemaUrl(rm.SchemaUrl())
+	sms := rm.ScopeMetrics()
+	if sms.Len() > 1 {
+		moveHalf(sms.Len(), sms.RemoveIf, func(sm pmetric.ScopeMetrics) {
+			sm.MoveTo(rm2.ScopeMetrics().AppendEmpty())
+		})
+		return second, true
+	}
+	if sms.Len() == 0 {
+		return second, false
+	}
+
+	sm := sms.At(0)
+	sm2 := rm2.ScopeMetrics().AppendEmpty()
+	sm.Scope().CopyTo(sm2.Scope())
+	sm2.SetSchemaUrl(sm.SchemaUrl())
+	ms := sm.Metrics()
+	if ms.Len() > 1 {
+		moveHalf(ms.Len(), ms.RemoveIf, func(m pmetric.Metric) {
+			m.MoveTo(sm2.Metrics().AppendEmpty())
+		})
+		return second, true
+	}
+	if ms.Len() == 0 {
+		return second, false
+	}
+	return second, splitDataPoints(ms.At(0), sm2.Metrics().AppendEmpty())
+}
+
+// splitDataPoints moves the second half of the data points of m into dest, which
+// gets the same name, description, unit and type as m.
+func splitDataPoints(m pmetric.Metric, dest pmetric.Metric) bool {
+	dest.SetName(m.Name())
+	dest.SetDescription(m.Description())
+	dest.SetUnit(m.Unit())
+
+	switch m.Type() {
+	case pmetric.MetricTypeGauge:
+		dps := m.Gauge().DataPoints()
+		if dps.Len() <= 1 {
+			return false
+		}
+		destDps := dest.SetEmptyGauge().DataPoints()
+		moveHalf(dps.Len(), dps.RemoveIf, func(dp pmetric.NumberDataPoint) {
+			dp.MoveTo(destDps.AppendEmpty())
+		})
+	case pmetric.MetricTypeSum:
+		dps := m.Sum().DataPoints()
+		if dps.Len() <= 1 {
+			return false
+		}
+		sum := dest.SetEmptySum()
+		sum.SetAggregationTemporality(m.Sum().AggregationTemporality())
+		sum.SetIsMonotonic(m.Sum().IsMonotonic())
+		moveHalf(dps.Len(), dps.RemoveIf, func(dp pmetric.NumberDataPoint) {
+			dp.MoveTo(sum.DataPoints().AppendEmpty())
+		})
+	case pmetric.MetricTypeHistogram:
+		dps := m.Histogram().DataPoints()
+		if dps.Len() <= 1 {
+			return false
+		}
+		histogram := dest.SetEmptyHistogram()
+		histogram.SetAggregationTemporality(m.Histogram().AggregationTemporality())
+		moveHalf(dps.Len(), dps.RemoveIf, func(dp pmetric.HistogramDataPoint) {
+			dp.MoveTo(histogram.DataPoints().AppendEmpty())
+		})
+	case pmetric.MetricTypeExponentialHistogram:
+		dps := m.ExponentialHistogram().DataPoints()
+		if dps.Len() <= 1 {
+			return false
+		}
+		histogram := dest.SetEmptyExponentialHistogram()
+		histogram.SetAggregationTemporality(m.ExponentialHistogram().AggregationTemporality())
+		moveHalf(dps.Len(), dps.RemoveIf, func(dp pmetric.ExponentialHistogramDataPoint) {
+			dp.MoveTo(histogram.DataPoints().AppendEmpty())
+		})
+	case pmetric.MetricTypeSummary:
+		dps := m.Summary().DataPoints()
+		if dps.Len() <= 1 {
+			return false
+		}
+		destDps := dest.SetEmptySummary().DataPoints()
+		moveHalf(dps.Len(), dps.RemoveIf, func(dp pmetric.SummaryDataPoint) {
+			dp.MoveTo(destDps.AppendEmpty())
+		})
+	default:
+		return false
+	}
+	return true
+}
+
+// splitLogs moves the second half of ld into a new batch, halving the resources,
+// or the scopes of a single resource, or the log records of a single scope.
+// It reports false if ld holds at most one log record.
+func splitLogs(ld plog.Logs) (plog.Logs, bool) {
+	second := plog.NewLogs()
+	rls := ld.ResourceLogs()
+	if rls.Len() > 1 {
+		moveHalf(rls.Len(), rls.RemoveIf, func(rl plog.ResourceLogs) {
+			rl.MoveTo(second.ResourceLogs().AppendEmpty())
+		})
+		return second, true
+	}
+	if rls.Len() == 0 {
+		return second, false
+	}
+
+	rl := rls.At(0)
+	rl2 := second.ResourceLogs().AppendEmpty()
+	rl.Resource().CopyTo(rl2.Resource())
+	rl2.SetSchemaUrl(rl.SchemaUrl())
+	sls := rl.ScopeLogs()
+	if sls.Len() > 1 {
+		moveHalf(sls.Len(), sls.RemoveIf, func(sl plog.ScopeLogs) {
+			sl.MoveTo(rl2.ScopeLogs().AppendEmpty())
+		})
+		return second, true
+	}
+	if sls.Len() == 0 || sls.At(0).LogRecords().Len() <= 1 {
+		return second, false
+	}
+
+	sl := sls.At(0)
+	sl2 := rl2.ScopeLogs().AppendEmpty()
+	sl.Scope().CopyTo(sl2.Scope())
+	sl2.SetSchemaUrl(sl.SchemaUrl())
+	lrs := sl.LogRecords()
+	moveHalf(lrs.Len(), lrs.RemoveIf, func(lr plog.LogRecord) {
+		lr.MoveTo(sl2.LogRecords().AppendEmpty())
+	})
+	return second, true
+}
+
+// tracesEnvelope returns the first resource and scope of td without their spans.
+func tracesEnvelope(td ptrace.Traces) ptrace.Traces {
+	envelope := ptrace.NewTraces()
+	if td.ResourceSpans().Len() == 0 {
+		return envelope
+	}
+	rs := td.ResourceSpans().At(0)
+	rs2 := envelope.ResourceSpans().AppendEmpty()
+	rs.Resource().CopyTo(rs2.Resource())
+	rs2.SetSchemaUrl(rs.SchemaUrl())
+	if rs.ScopeSpans().Len() > 0 {
+		ss := rs.ScopeSpans().At(0)
+		ss2 := rs2.ScopeSpans().AppendEmpty()
+		ss.Scope().CopyTo(ss2.Scope())
+		ss2.SetSchemaUrl(ss.SchemaUrl())
+	}
+	return envelope
+}
+
+// metricsEnvelope returns the first resource, scope and metric of md without their
+// data points.
+func metricsEnvelope(md pmetric.Metrics) pmetric.Metrics {
+	envelope := pmetric.NewMetrics()
+	if md.ResourceMetrics().Len() == 0 {
+		return envelope
+	}
+	rm := md.ResourceMetrics().At(0)
+	rm2 := envelope.ResourceMetrics().AppendEmpty()
+	rm.Resource().CopyTo(rm2.Resource())
+	rm2.SetSchemaUrl(rm.SchemaUrl())
+	if rm.ScopeMetrics().Len() == 0 {
+		return envelope
+	}
+	sm := rm.ScopeMetrics().At(0)
+	sm2 := rm2.ScopeMetrics().AppendEmpty()
+	sm.Scope().CopyTo(sm2.Scope())
+	sm2.SetSchemaUrl(sm.SchemaUrl())
+	if sm.Metrics().Len() > 0 {
+		m := sm.Metrics().At(0)
+		m2 := sm2.Metrics().AppendEmpty()
+		m2.SetName(m.Name())
+		m2.SetDescription(m.Description())
+		m2.SetUnit(m.Unit())
+	}
+	return envelope
+}
+
+// logsEnvelope returns the first resource and scope of ld without their log records.
+func logsEnvelope(ld plog.Logs) plog.Logs {
+	envelope := plog.NewLogs()
+	if ld.ResourceLogs().Len() == 0 {
+		return envelope
+	}
+	rl := ld.ResourceLogs().At(0)
+	rl2 := envelope.ResourceLogs().AppendEmpty()
+	rl.Resource().CopyTo(rl2.Resource())
+	rl2.SetSchemaUrl(rl.SchemaUrl())
+	if rl.ScopeLogs().Len() > 0 {
+		sl := rl.ScopeLogs().At(0)
+		sl2 := rl2.ScopeLogs().AppendEmpty()
+		sl.Scope().CopyTo(sl2.Scope())
+		sl2.SetSchemaUrl(sl.SchemaUrl())
+	}
+	return envelope
+}
+
+// moveHalf calls move for each element of the second half of a slice of length n
+// and removes them from the slice.
+func moveHalf[T any](n int, removeIf func(func(T) bool), move func(T)) {
+	keep := n / 2
+	i := 0
+	removeIf(func(elem T) bool {
+		i++
+		if i <= keep {
+			return false
+		}
+		move(elem)
+		return true
+	})
+}
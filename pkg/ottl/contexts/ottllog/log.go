@@ -196,6 +196,13 @@ func (pep *pathExpressionParser) parsePath(path ottl.Path[TransformContext]) (ot
 	case "dropped_attributes_count":
 		return accessDroppedAttributesCount(), nil
 	case "flags":
+		nextPath := path.Next()
+		if nextPath != nil {
+			if nextPath.Name() == "sampled" {
+				return accessFlagsSampled(), nil
+			}
+			return nil, internal.FormatDefaultErrorMessage(nextPath.Name(), nextPath.String(), contextName, internal.LogRef)
+		}
 		return accessFlags(), nil
 	case "trace_id":
 		nextPath := path.Next()
@@ -434,6 +441,20 @@ func accessFlags() ottl.StandardGetSetter[TransformContext] {
 	}
 }
 
+func accessFlagsSampled() ottl.StandardGetSetter[TransformContext] {
+	return ottl.StandardGetSetter[TransformContext]{
+		Getter: func(_ context.Context, tCtx TransformContext) (any, error) {
+			return tCtx.GetLogRecord().Flags().IsSampled(), nil
+		},
+		Setter: func(_ context.Context, tCtx TransformContext, val any) error {
+			if sampled, ok := val.(bool); ok {
+				tCtx.GetLogRecord().SetFlags(tCtx.GetLogRecord().Flags().WithIsSampled(sampled))
+			}
+			return nil
+		},
+	}
+}
+
 func accessTraceID() ottl.StandardGetSetter[TransformContext] {
 	return ottl.StandardGetSetter[TransformContext]{
 		Getter: func(_ context.Context, tCtx TransformContext) (any, error) {
@@ -228,6 +228,20 @@ func Test_newPathGetSetter(t *testing.T) {
 				log.SetFlags(plog.LogRecordFlags(5))
 			},
 		},
+		{
+			name: "flags sampled",
+			path: &internal.TestPath[TransformContext]{
+				N: "flags",
+				NextPath: &internal.TestPath[TransformContext]{
+					N: "sampled",
+				},
+			},
+			orig:   false,
+			newVal: true,
+			modified: func(log plog.LogRecord, _ pcommon.InstrumentationScope, _ pcommon.Resource, _ pcommon.Map) {
+				log.SetFlags(plog.LogRecordFlags(5))
+			},
+		},
 		{
 			name: "trace_id",
 			path: &internal.TestPath[TransformContext]{
@@ -0,0 +1,376 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlspanlink // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspanlink"
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal"
+)
+
+const spanLinkContextName = "Span Link"
+
+var _ internal.ResourceContext = TransformContext{}
+var _ internal.InstrumentationScopeContext = TransformContext{}
+var _ internal.SpanContext = TransformContext{}
+
+type TransformContext struct {
+	spanLink             ptrace.SpanLink
+	span                 ptrace.Span
+	instrumentationScope pcommon.InstrumentationScope
+	resource             pcommon.Resource
+	cache                pcommon.Map
+}
+
+type Option func(*ottl.Parser[TransformContext])
+
+func NewTransformContext(spanLink ptrace.SpanLink, span ptrace.Span, instrumentationScope pcommon.InstrumentationScope, resource pcommon.Resource) TransformContext {
+	return TransformContext{
+		spanLink:             spanLink,
+		span:                 span,
+		instrumentationScope: instrumentationScope,
+		resource:             resource,
+		cache:                pcommon.NewMap(),
+	}
+}
+
+func (tCtx TransformContext) GetSpanLink() ptrace.SpanLink {
+	return tCtx.spanLink
+}
+
+func (tCtx TransformContext) GetSpan() ptrace.Span {
+	return tCtx.span
+}
+
+func (tCtx TransformContext) GetInstrumentationScope() pcommon.InstrumentationScope {
+	return tCtx.instrumentationScope
+}
+
+func (tCtx TransformContext) GetResource() pcommon.Resource {
+	return tCtx.resource
+}
+
+func (tCtx TransformContext) getCache() pcommon.Map {
+	return tCtx.cache
+}
+
+func NewParser(functions map[string]ottl.Factory[TransformContext], telemetrySettings component.TelemetrySettings, options ...Option) (ottl.Parser[TransformContext], error) {
+	pep := pathExpressionParser{telemetrySettings}
+	p, err := ottl.NewParser[TransformContext](
+		functions,
+		pep.parsePath,
+		telemetrySettings,
+		ottl.WithEnumParser[TransformContext](parseEnum),
+	)
+	if err != nil {
+		return ottl.Parser[TransformContext]{}, err
+	}
+	for _, opt := range options {
+		opt(&p)
+	}
+	return p, nil
+}
+
+type StatementSequenceOption func(*ottl.StatementSequence[TransformContext])
+
+func WithStatementSequenceErrorMode(errorMode ottl.ErrorMode) StatementSequenceOption {
+	return func(s *ottl.StatementSequence[TransformContext]) {
+		ottl.WithStatementSequenceErrorMode[TransformContext](errorMode)(s)
+	}
+}
+
+func NewStatementSequence(statements []*ottl.Statement[TransformContext], telemetrySettings component.TelemetrySettings, options ...StatementSequenceOption) ottl.StatementSequence[TransformContext] {
+	s := ottl.NewStatementSequence(statements, telemetrySettings)
+	for _, op := range options {
+		op(&s)
+	}
+	return s
+}
+
+type ConditionSequenceOption func(*ottl.ConditionSequence[TransformContext])
+
+func WithConditionSequenceErrorMode(errorMode ottl.ErrorMode) ConditionSequenceOption {
+	return func(c *ottl.ConditionSequence[TransformContext]) {
+		ottl.WithConditionSequenceErrorMode[TransformContext](errorMode)(c)
+	}
+}
+
+func NewConditionSequence(conditions []*ottl.Condition[TransformContext], telemetrySettings component.TelemetrySettings, options ...ConditionSequenceOption) ottl.ConditionSequence[TransformContext] {
+	c := ottl.NewConditionSequence(conditions, telemetrySettings)
+	for _, op := range options {
+		op(&c)
+	}
+	return c
+}
+
+func parseEnum(val *ottl.EnumSymbol) (*ottl.Enum, error) {
+	if val != nil {
+		if enum, ok := internal.SpanSymbolTable[*val]; ok {
+			return &enum, nil
+		}
+		return nil, fmt.Errorf("enum symbol, %s, not found", *val)
+	}
+	return nil, fmt.Errorf("enum symbol not provided")
+}
+
+type pathExpressionParser struct {
+	telemetrySettings component.TelemetrySettings
+}
+
+func (pep *pathExpressionParser) parsePath(path ottl.Path[TransformContext]) (ottl.GetSetter[TransformContext], error) {
+	if path == nil {
+		return nil, fmt.Errorf("path cannot be nil")
+	}
+	switch path.Name() {
+	case "cache":
+		if path.Keys() == nil {
+			return accessCache(), nil
+		}
+		return accessCacheKey(path.Keys()), nil
+	case "resource":
+		return internal.ResourcePathGetSetter[TransformContext](path.Next())
+	case "instrumentation_scope":
+		return internal.ScopePathGetSetter[TransformContext](path.Next())
+	case "span":
+		return internal.SpanPathGetSetter[TransformContext](path.Next())
+	case "trace_id":
+		nextPath := path.Next()
+		if nextPath != nil {
+			if nextPath.Name() == "string" {
+				return accessSpanLinkStringTraceID(), nil
+			}
+			return nil, internal.FormatDefaultErrorMessage(nextPath.Name(), nextPath.String(), spanLinkContextName, internal.SpanLinkRef)
+		}
+		return accessSpanLinkTraceID(), nil
+	case "span_id":
+		nextPath := path.Next()
+		if nextPath != nil {
+			if nextPath.Name() == "string" {
+				return accessSpanLinkStringSpanID(), nil
+			}
+			return nil, internal.FormatDefaultErrorMessage(nextPath.Name(), nextPath.String(), spanLinkContextName, internal.SpanLinkRef)
+		}
+		return accessSpanLinkSpanID(), nil
+	case "trace_state":
+		if path.Keys() == nil {
+			return accessSpanLinkTraceState(), nil
+		}
+		return accessSpanLinkTraceStateKey(path.Keys())
+	case "flags":
+		return accessSpanLinkFlags(), nil
+	case "attributes":
+		if path.Keys() == nil {
+			return accessSpanLinkAttributes(), nil
+		}
+		return accessSpanLinkAttributesKey(path.Keys()), nil
+	case "dropped_attributes_count":
+		return accessSpanLinkDroppedAttributesCount(), nil
+	default:
+		return nil, internal.FormatDefaultErrorMessage(path.Name(), path.String(), spanLinkContextName, internal.SpanLinkRef)
+	}
+}
+
+func accessCache() ottl.StandardGetSetter[TransformContext] {
+	return ottl.StandardGetSetter[TransformContext]{
+		Getter: func(_ context.Context, tCtx TransformContext) (any, error) {
+			return tCtx.getCache(), nil
+		},
+		Setter: func(_ context.Context, tCtx TransformContext, val any) error {
+			if m, ok := val.(pcommon.Map); ok {
+				m.CopyTo(tCtx.getCache())
+			}
+			return nil
+		},
+	}
+}
+
+func accessCacheKey(key []ottl.Key[TransformContext]) ottl.StandardGetSetter[TransformContext] {
+	return ottl.StandardGetSetter[TransformContext]{
+		Getter: func(ctx context.Context, tCtx TransformContext) (any, error) {
+			return internal.GetMapValue[TransformContext](ctx, tCtx, tCtx.getCache(), key)
+		},
+		Setter: func(ctx context.Context, tCtx TransformContext, val any) error {
+			return internal.SetMapValue[TransformContext](ctx, tCtx, tCtx.getCache(), key, val)
+		},
+	}
+}
+
+func accessSpanLinkTraceID() ottl.StandardGetSetter[TransformContext] {
+	return ottl.StandardGetSetter[TransformContext]{
+		Getter: func(_ context.Context, tCtx TransformContext) (any, error) {
+			return tCtx.GetSpanLink().TraceID(), nil
+		},
+		Setter: func(_ context.Context, tCtx TransformContext, val any) error {
+			if newTraceID, ok := val.(pcommon.TraceID); ok {
+				tCtx.GetSpanLink().SetTraceID(newTraceID)
+			}
+			return nil
+		},
+	}
+}
+
+func accessSpanLinkStringTraceID() ottl.StandardGetSetter[TransformContext] {
+	return ottl.StandardGetSetter[TransformContext]{
+		Getter: func(_ context.Context, tCtx TransformContext) (any, error) {
+			id := tCtx.GetSpanLink().TraceID()
+			return hex.EncodeToString(id[:]), nil
+		},
+		Setter: func(_ context.Context, tCtx TransformContext, val any) error {
+			if str, ok := val.(string); ok {
+				id, err := internal.ParseTraceID(str)
+				if err != nil {
+					return err
+				}
+				tCtx.GetSpanLink().SetTraceID(id)
+			}
+			return nil
+		},
+	}
+}
+
+func accessSpanLinkSpanID() ottl.StandardGetSetter[TransformContext] {
+	return ottl.StandardGetSetter[TransformContext]{
+		Getter: func(_ context.Context, tCtx TransformContext) (any, error) {
+			return tCtx.GetSpanLink().SpanID(), nil
+		},
+		Setter: func(_ context.Context, tCtx TransformContext, val any) error {
+			if newSpanID, ok := val.(pcommon.SpanID); ok {
+				tCtx.GetSpanLink().SetSpanID(newSpanID)
+			}
+			return nil
+		},
+	}
+}
+
+func accessSpanLinkStringSpanID() ottl.StandardGetSetter[TransformContext] {
+	return ottl.StandardGetSetter[TransformContext]{
+		Getter: func(_ context.Context, tCtx TransformContext) (any, error) {
+			id := tCtx.GetSpanLink().SpanID()
+			return hex.EncodeToString(id[:]), nil
+		},
+		Setter: func(_ context.Context, tCtx TransformContext, val any) error {
+			if str, ok := val.(string); ok {
+				id, err := internal.ParseSpanID(str)
+				if err != nil {
+					return err
+				}
+				tCtx.GetSpanLink().SetSpanID(id)
+			}
+			return nil
+		},
+	}
+}
+
+func accessSpanLinkTraceState() ottl.StandardGetSetter[TransformContext] {
+	return ottl.StandardGetSetter[TransformContext]{
+		Getter: func(_ context.Context, tCtx TransformContext) (any, error) {
+			return tCtx.GetSpanLink().TraceState().AsRaw(), nil
+		},
+		Setter: func(_ context.Context, tCtx TransformContext, val any) error {
+			if str, ok := val.(string); ok {
+				tCtx.GetSpanLink().TraceState().FromRaw(str)
+			}
+			return nil
+		},
+	}
+}
+
+func accessSpanLinkTraceStateKey(keys []ottl.Key[TransformContext]) (ottl.StandardGetSetter[TransformContext], error) {
+	if len(keys) != 1 {
+		return ottl.StandardGetSetter[TransformContext]{}, fmt.Errorf("must provide exactly 1 key when accessing trace_state")
+	}
+	return ottl.StandardGetSetter[TransformContext]{
+		Getter: func(ctx context.Context, tCtx TransformContext) (any, error) {
+			if ts, err := trace.ParseTraceState(tCtx.GetSpanLink().TraceState().AsRaw()); err == nil {
+				s, err := keys[0].String(ctx, tCtx)
+				if err != nil {
+					return nil, err
+				}
+				if s == nil {
+					return nil, fmt.Errorf("trace_state indexing type must be a string")
+				}
+				return ts.Get(*s), nil
+			}
+			return nil, nil
+		},
+		Setter: func(ctx context.Context, tCtx TransformContext, val any) error {
+			if str, ok := val.(string); ok {
+				if ts, err := trace.ParseTraceState(tCtx.GetSpanLink().TraceState().AsRaw()); err == nil {
+					s, err := keys[0].String(ctx, tCtx)
+					if err != nil {
+						return err
+					}
+					if s == nil {
+						return fmt.Errorf("trace_state indexing type must be a string")
+					}
+					if updated, err := ts.Insert(*s, str); err == nil {
+						tCtx.GetSpanLink().TraceState().FromRaw(updated.String())
+					}
+				}
+			}
+			return nil
+		},
+	}, nil
+}
+
+func accessSpanLinkFlags() ottl.StandardGetSetter[TransformContext] {
+	return ottl.StandardGetSetter[TransformContext]{
+		Getter: func(_ context.Context, tCtx TransformContext) (any, error) {
+			return int64(tCtx.GetSpanLink().Flags()), nil
+		},
+		Setter: func(_ context.Context, tCtx TransformContext, val any) error {
+			if newFlags, ok := val.(int64); ok {
+				tCtx.GetSpanLink().SetFlags(uint32(newFlags))
+			}
+			return nil
+		},
+	}
+}
+
+func accessSpanLinkAttributes() ottl.StandardGetSetter[TransformContext] {
+	return ottl.StandardGetSetter[TransformContext]{
+		Getter: func(_ context.Context, tCtx TransformContext) (any, error) {
+			return tCtx.GetSpanLink().Attributes(), nil
+		},
+		Setter: func(_ context.Context, tCtx TransformContext, val any) error {
+			if attrs, ok := val.(pcommon.Map); ok {
+				attrs.CopyTo(tCtx.GetSpanLink().Attributes())
+			}
+			return nil
+		},
+	}
+}
+
+func accessSpanLinkAttributesKey(key []ottl.Key[TransformContext]) ottl.StandardGetSetter[TransformContext] {
+	return ottl.StandardGetSetter[TransformContext]{
+		Getter: func(ctx context.Context, tCtx TransformContext) (any, error) {
+			return internal.GetMapValue[TransformContext](ctx, tCtx, tCtx.GetSpanLink().Attributes(), key)
+		},
+		Setter: func(ctx context.Context, tCtx TransformContext, val any) error {
+			return internal.SetMapValue[TransformContext](ctx, tCtx, tCtx.GetSpanLink().Attributes(), key, val)
+		},
+	}
+}
+
+func accessSpanLinkDroppedAttributesCount() ottl.StandardGetSetter[TransformContext] {
+	return ottl.StandardGetSetter[TransformContext]{
+		Getter: func(_ context.Context, tCtx TransformContext) (any, error) {
+			return int64(tCtx.GetSpanLink().DroppedAttributesCount()), nil
+		},
+		Setter: func(_ context.Context, tCtx TransformContext, val any) error {
+			if newCount, ok := val.(int64); ok {
+				tCtx.GetSpanLink().SetDroppedAttributesCount(uint32(newCount))
+			}
+			return nil
+		},
+	}
+}
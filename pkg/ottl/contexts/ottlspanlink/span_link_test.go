@@ -0,0 +1,349 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlspanlink
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottltest"
+)
+
+var (
+	traceID  = [16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}
+	traceID2 = [16]byte{16, 15, 14, 13, 12, 11, 10, 9, 8, 7, 6, 5, 4, 3, 2, 1}
+	spanID   = [8]byte{1, 2, 3, 4, 5, 6, 7, 8}
+	spanID2  = [8]byte{8, 7, 6, 5, 4, 3, 2, 1}
+)
+
+func Test_newPathGetSetter(t *testing.T) {
+	newAttrs := pcommon.NewMap()
+	newAttrs.PutStr("hello", "world")
+
+	newCache := pcommon.NewMap()
+	newCache.PutStr("temp", "value")
+
+	tests := []struct {
+		name     string
+		path     ottl.Path[TransformContext]
+		orig     any
+		newVal   any
+		modified func(spanLink ptrace.SpanLink, span ptrace.Span, il pcommon.InstrumentationScope, resource pcommon.Resource, cache pcommon.Map)
+	}{
+		{
+			name: "cache",
+			path: &internal.TestPath[TransformContext]{
+				N: "cache",
+			},
+			orig:   pcommon.NewMap(),
+			newVal: newCache,
+			modified: func(_ ptrace.SpanLink, _ ptrace.Span, _ pcommon.InstrumentationScope, _ pcommon.Resource, cache pcommon.Map) {
+				newCache.CopyTo(cache)
+			},
+		},
+		{
+			name: "cache access",
+			path: &internal.TestPath[TransformContext]{
+				N: "cache",
+				KeySlice: []ottl.Key[TransformContext]{
+					&internal.TestKey[TransformContext]{
+						S: ottltest.Strp("temp"),
+					},
+				},
+			},
+			orig:   nil,
+			newVal: "new value",
+			modified: func(_ ptrace.SpanLink, _ ptrace.Span, _ pcommon.InstrumentationScope, _ pcommon.Resource, cache pcommon.Map) {
+				cache.PutStr("temp", "new value")
+			},
+		},
+		{
+			name: "trace_id",
+			path: &internal.TestPath[TransformContext]{
+				N: "trace_id",
+			},
+			orig:   pcommon.TraceID(traceID),
+			newVal: pcommon.TraceID(traceID2),
+			modified: func(spanLink ptrace.SpanLink, _ ptrace.Span, _ pcommon.InstrumentationScope, _ pcommon.Resource, _ pcommon.Map) {
+				spanLink.SetTraceID(traceID2)
+			},
+		},
+		{
+			name: "trace_id string",
+			path: &internal.TestPath[TransformContext]{
+				N: "trace_id",
+				NextPath: &internal.TestPath[TransformContext]{
+					N: "string",
+				},
+			},
+			orig:   "0102030405060708090a0b0c0d0e0f10",
+			newVal: "100f0e0d0c0b0a090807060504030201",
+			modified: func(spanLink ptrace.SpanLink, _ ptrace.Span, _ pcommon.InstrumentationScope, _ pcommon.Resource, _ pcommon.Map) {
+				spanLink.SetTraceID(traceID2)
+			},
+		},
+		{
+			name: "span_id",
+			path: &internal.TestPath[TransformContext]{
+				N: "span_id",
+			},
+			orig:   pcommon.SpanID(spanID),
+			newVal: pcommon.SpanID(spanID2),
+			modified: func(spanLink ptrace.SpanLink, _ ptrace.Span, _ pcommon.InstrumentationScope, _ pcommon.Resource, _ pcommon.Map) {
+				spanLink.SetSpanID(spanID2)
+			},
+		},
+		{
+			name: "span_id string",
+			path: &internal.TestPath[TransformContext]{
+				N: "span_id",
+				NextPath: &internal.TestPath[TransformContext]{
+					N: "string",
+				},
+			},
+			orig:   "0102030405060708",
+			newVal: "0807060504030201",
+			modified: func(spanLink ptrace.SpanLink, _ ptrace.Span, _ pcommon.InstrumentationScope, _ pcommon.Resource, _ pcommon.Map) {
+				spanLink.SetSpanID(spanID2)
+			},
+		},
+		{
+			name: "trace_state",
+			path: &internal.TestPath[TransformContext]{
+				N: "trace_state",
+			},
+			orig:   "key1=val1,key2=val2",
+			newVal: "key=newVal",
+			modified: func(spanLink ptrace.SpanLink, _ ptrace.Span, _ pcommon.InstrumentationScope, _ pcommon.Resource, _ pcommon.Map) {
+				spanLink.TraceState().FromRaw("key=newVal")
+			},
+		},
+		{
+			name: "trace_state key",
+			path: &internal.TestPath[TransformContext]{
+				N: "trace_state",
+				KeySlice: []ottl.Key[TransformContext]{
+					&internal.TestKey[TransformContext]{
+						S: ottltest.Strp("key1"),
+					},
+				},
+			},
+			orig:   "val1",
+			newVal: "newVal",
+			modified: func(spanLink ptrace.SpanLink, _ ptrace.Span, _ pcommon.InstrumentationScope, _ pcommon.Resource, _ pcommon.Map) {
+				spanLink.TraceState().FromRaw("key1=newVal,key2=val2")
+			},
+		},
+		{
+			name: "flags",
+			path: &internal.TestPath[TransformContext]{
+				N: "flags",
+			},
+			orig:   int64(0),
+			newVal: int64(1),
+			modified: func(spanLink ptrace.SpanLink, _ ptrace.Span, _ pcommon.InstrumentationScope, _ pcommon.Resource, _ pcommon.Map) {
+				spanLink.SetFlags(1)
+			},
+		},
+		{
+			name: "attributes",
+			path: &internal.TestPath[TransformContext]{
+				N: "attributes",
+			},
+			orig: func() pcommon.Map {
+				attrs := pcommon.NewMap()
+				attrs.PutStr("str", "val")
+				return attrs
+			}(),
+			newVal: newAttrs,
+			modified: func(spanLink ptrace.SpanLink, _ ptrace.Span, _ pcommon.InstrumentationScope, _ pcommon.Resource, _ pcommon.Map) {
+				newAttrs.CopyTo(spanLink.Attributes())
+			},
+		},
+		{
+			name: "attributes string",
+			path: &internal.TestPath[TransformContext]{
+				N: "attributes",
+				KeySlice: []ottl.Key[TransformContext]{
+					&internal.TestKey[TransformContext]{
+						S: ottltest.Strp("str"),
+					},
+				},
+			},
+			orig:   "val",
+			newVal: "newVal",
+			modified: func(spanLink ptrace.SpanLink, _ ptrace.Span, _ pcommon.InstrumentationScope, _ pcommon.Resource, _ pcommon.Map) {
+				spanLink.Attributes().PutStr("str", "newVal")
+			},
+		},
+		{
+			name: "dropped_attributes_count",
+			path: &internal.TestPath[TransformContext]{
+				N: "dropped_attributes_count",
+			},
+			orig:   int64(10),
+			newVal: int64(20),
+			modified: func(spanLink ptrace.SpanLink, _ ptrace.Span, _ pcommon.InstrumentationScope, _ pcommon.Resource, _ pcommon.Map) {
+				spanLink.SetDroppedAttributesCount(20)
+			},
+		},
+		{
+			name: "span name",
+			path: &internal.TestPath[TransformContext]{
+				N: "span",
+				NextPath: &internal.TestPath[TransformContext]{
+					N: "name",
+				},
+			},
+			orig:   "test",
+			newVal: "new name",
+			modified: func(_ ptrace.SpanLink, span ptrace.Span, _ pcommon.InstrumentationScope, _ pcommon.Resource, _ pcommon.Map) {
+				span.SetName("new name")
+			},
+		},
+		{
+			name: "instrumentation_scope name",
+			path: &internal.TestPath[TransformContext]{
+				N: "instrumentation_scope",
+				NextPath: &internal.TestPath[TransformContext]{
+					N: "name",
+				},
+			},
+			orig:   "library",
+			newVal: "new library",
+			modified: func(_ ptrace.SpanLink, _ ptrace.Span, il pcommon.InstrumentationScope, _ pcommon.Resource, _ pcommon.Map) {
+				il.SetName("new library")
+			},
+		},
+		{
+			name: "resource attributes",
+			path: &internal.TestPath[TransformContext]{
+				N: "resource",
+				NextPath: &internal.TestPath[TransformContext]{
+					N: "attributes",
+					KeySlice: []ottl.Key[TransformContext]{
+						&internal.TestKey[TransformContext]{
+							S: ottltest.Strp("service.name"),
+						},
+					},
+				},
+			},
+			orig:   "checkout",
+			newVal: "cart",
+			modified: func(_ ptrace.SpanLink, _ ptrace.Span, _ pcommon.InstrumentationScope, resource pcommon.Resource, _ pcommon.Map) {
+				resource.Attributes().PutStr("service.name", "cart")
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pep := pathExpressionParser{}
+			accessor, err := pep.parsePath(tt.path)
+			assert.NoError(t, err)
+
+			spanLink, span, il, resource := createTelemetry()
+
+			tCtx := NewTransformContext(spanLink, span, il, resource)
+
+			got, err := accessor.Get(context.Background(), tCtx)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.orig, got)
+
+			err = accessor.Set(context.Background(), tCtx, tt.newVal)
+			assert.NoError(t, err)
+
+			exSpanLink, exSpan, exIl, exRes := createTelemetry()
+			exCache := pcommon.NewMap()
+			tt.modified(exSpanLink, exSpan, exIl, exRes, exCache)
+
+			assert.Equal(t, exSpanLink, spanLink)
+			assert.Equal(t, exSpan, span)
+			assert.Equal(t, exIl, il)
+			assert.Equal(t, exRes, resource)
+			assert.Equal(t, exCache, tCtx.getCache())
+		})
+	}
+}
+
+func Test_newPathGetSetter_Invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		path ottl.Path[TransformContext]
+	}{
+		{
+			name: "unknown field",
+			path: &internal.TestPath[TransformContext]{
+				N: "name",
+			},
+		},
+		{
+			name: "unknown trace_id field",
+			path: &internal.TestPath[TransformContext]{
+				N: "trace_id",
+				NextPath: &internal.TestPath[TransformContext]{
+					N: "bytes",
+				},
+			},
+		},
+		{
+			name: "multiple trace_state keys",
+			path: &internal.TestPath[TransformContext]{
+				N: "trace_state",
+				KeySlice: []ottl.Key[TransformContext]{
+					&internal.TestKey[TransformContext]{
+						S: ottltest.Strp("key1"),
+					},
+					&internal.TestKey[TransformContext]{
+						S: ottltest.Strp("key2"),
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pep := pathExpressionParser{}
+			_, err := pep.parsePath(tt.path)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func createTelemetry() (ptrace.SpanLink, ptrace.Span, pcommon.InstrumentationScope, pcommon.Resource) {
+	spanLink := ptrace.NewSpanLink()
+	spanLink.SetTraceID(traceID)
+	spanLink.SetSpanID(spanID)
+	spanLink.TraceState().FromRaw("key1=val1,key2=val2")
+	spanLink.SetDroppedAttributesCount(10)
+	spanLink.Attributes().PutStr("str", "val")
+
+	span := ptrace.NewSpan()
+	span.SetName("test")
+
+	il := pcommon.NewInstrumentationScope()
+	il.SetName("library")
+	il.SetVersion("version")
+
+	resource := pcommon.NewResource()
+	resource.Attributes().PutStr("service.name", "checkout")
+
+	return spanLink, span, il, resource
+}
+
+func Test_ParseEnum(t *testing.T) {
+	actual, err := parseEnum((*ottl.EnumSymbol)(ottltest.Strp("SPAN_KIND_SERVER")))
+	assert.NoError(t, err)
+	assert.Equal(t, ottl.Enum(ptrace.SpanKindServer), *actual)
+
+	_, err = parseEnum((*ottl.EnumSymbol)(ottltest.Strp("not an enum")))
+	assert.Error(t, err)
+	_, err = parseEnum(nil)
+	assert.Error(t, err)
+}
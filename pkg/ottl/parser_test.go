@@ -1967,6 +1967,42 @@ func Test_parseCondition(t *testing.T) {
 	}
 }
 
+func Test_ParseValueExpression(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+		expected   any
+		wantErr    bool
+	}{
+		{name: "literal", expression: `1`, expected: int64(1)},
+		{name: "string", expression: `"fido"`, expected: "fido"},
+		{name: "math", expression: `1 + 2 * 3`, expected: int64(7)},
+		{name: "path", expression: `name`, expected: "bear"},
+		{name: "condition", expression: `name == "bear"`, wantErr: true},
+		{name: "editor", expression: `set(name, "fido")`, wantErr: true},
+		{name: "incomplete", expression: `1 +`, wantErr: true},
+	}
+	p, _ := NewParser(
+		defaultFunctionsForTests(),
+		testParsePath[any],
+		componenttest.NewNopTelemetrySettings(),
+		WithEnumParser[any](testParseEnum),
+	)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expression, err := p.ParseValueExpression(tt.expression)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			result, err := expression.Eval(context.Background(), "bear")
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
 func Test_Statement_Execute(t *testing.T) {
 	tests := []struct {
 		name              string
@@ -17,6 +17,7 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/decode"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/attrs"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/emit"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/archive"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/fingerprint"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/header"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/reader"
@@ -81,6 +82,20 @@ type Config struct {
 	FlushPeriod        time.Duration   `mapstructure:"force_flush_period,omitempty"`
 	Header             *HeaderConfig   `mapstructure:"header,omitempty"`
 	DeleteAfterRead    bool            `mapstructure:"delete_after_read,omitempty"`
+	Archive            *ArchiveConfig  `mapstructure:"archive,omitempty"`
+}
+
+// ArchiveConfig defines the action taken on the files once they have been read entirely
+// and have not been modified for the idle timeout.
+type ArchiveConfig struct {
+	// Action is one of "move", "delete" or "marker".
+	Action string `mapstructure:"action"`
+	// Directory the files are moved to with the move action.
+	Directory string `mapstructure:"directory"`
+	// MarkerSuffix is appended to the path of a file to name its marker, ".done" by default.
+	MarkerSuffix string `mapstructure:"marker_suffix,omitempty"`
+	// IdleTimeout is the time since the last modification of a file before it can be archived.
+	IdleTimeout time.Duration `mapstructure:"idle_timeout,omitempty"`
 }
 
 type HeaderConfig struct {
@@ -147,6 +162,13 @@ func (c Config) Build(set component.TelemetrySettings, emit emit.Callback, opts
 		return nil, err
 	}
 
+	var archiver *archive.Archiver
+	if c.Archive != nil {
+		if archiver, err = archive.New(c.Archive.Action, c.Archive.Directory, c.Archive.MarkerSuffix, c.Archive.IdleTimeout); err != nil {
+			return nil, fmt.Errorf("failed to build archive config: %w", err)
+		}
+	}
+
 	readerFactory := reader.Factory{
 		SugaredLogger:     set.Logger.Sugar().With("component", "fileconsumer"),
 		FromBeginning:     startAtBeginning,
@@ -161,6 +183,7 @@ func (c Config) Build(set component.TelemetrySettings, emit emit.Callback, opts
 		Attributes:        c.Resolver,
 		HeaderConfig:      hCfg,
 		DeleteAtEOF:       c.DeleteAfterRead,
+		Archiver:          archiver,
 	}
 
 	var t tracker.Tracker
@@ -215,6 +238,21 @@ func (c Config) validate() error {
 		}
 	}
 
+	if c.Archive != nil {
+		if c.DeleteAfterRead {
+			return fmt.Errorf("'archive' cannot be used with 'delete_after_read'")
+		}
+		if c.StartAt == "end" {
+			return fmt.Errorf("'archive' cannot be used with 'start_at: end'")
+		}
+		if c.Archive.Action == archive.ActionDelete && !allowFileDeletion.IsEnabled() {
+			return fmt.Errorf("'archive' with the delete action requires feature gate '%s'", allowFileDeletion.ID())
+		}
+		if _, errArchive := archive.New(c.Archive.Action, c.Archive.Directory, c.Archive.MarkerSuffix, c.Archive.IdleTimeout); errArchive != nil {
+			return fmt.Errorf("invalid config for 'archive': %w", errArchive)
+		}
+	}
+
 	if c.Header != nil {
 		if !AllowHeaderMetadataParsing.IsEnabled() {
 			return fmt.Errorf("'header' requires feature gate '%s'", AllowHeaderMetadataParsing.ID())
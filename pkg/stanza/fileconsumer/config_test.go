@@ -554,6 +554,61 @@ func TestBuild(t *testing.T) {
 			require.Error,
 			nil,
 		},
+		{
+			"ArchiveMove",
+			func(cfg *Config) {
+				cfg.StartAt = "beginning"
+				cfg.Archive = &ArchiveConfig{Action: "move", Directory: "/var/log/archive"}
+			},
+			require.NoError,
+			func(t *testing.T, m *Manager) {
+				require.NotNil(t, m.readerFactory.Archiver)
+				require.Equal(t, "move", m.readerFactory.Archiver.Action())
+			},
+		},
+		{
+			"ArchiveMoveNoDirectory",
+			func(cfg *Config) {
+				cfg.StartAt = "beginning"
+				cfg.Archive = &ArchiveConfig{Action: "move"}
+			},
+			require.Error,
+			nil,
+		},
+		{
+			"ArchiveInvalidAction",
+			func(cfg *Config) {
+				cfg.Archive = &ArchiveConfig{Action: "copy"}
+			},
+			require.Error,
+			nil,
+		},
+		{
+			"ArchiveDeleteNoFlag",
+			func(cfg *Config) {
+				cfg.Archive = &ArchiveConfig{Action: "delete"}
+			},
+			require.Error,
+			nil,
+		},
+		{
+			"ArchiveWithDeleteAfterRead",
+			func(cfg *Config) {
+				cfg.Archive = &ArchiveConfig{Action: "marker"}
+				cfg.DeleteAfterRead = true
+			},
+			require.Error,
+			nil,
+		},
+		{
+			"ArchiveStartAtEnd",
+			func(cfg *Config) {
+				cfg.Archive = &ArchiveConfig{Action: "marker"}
+				cfg.StartAt = "end"
+			},
+			require.Error,
+			nil,
+		},
 		{
 			"ValidMaxBatches",
 			func(cfg *Config) {
@@ -13,6 +13,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/checkpoint"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/fileid"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/fingerprint"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/reader"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/tracker"
@@ -161,7 +162,7 @@ func (m *Manager) consume(ctx context.Context, paths []string) {
 }
 
 func (m *Manager) makeFingerprint(path string) (*fingerprint.Fingerprint, *os.File) {
-	file, err := os.Open(path) // #nosec - operator must read in files defined by user
+	file, err := openFile(path)
 	if err != nil {
 		m.Errorw("Failed to open file", zap.Error(err))
 		return nil, nil
@@ -190,6 +191,9 @@ func (m *Manager) makeFingerprint(path string) (*fingerprint.Fingerprint, *os.Fi
 // been read this polling interval
 func (m *Manager) makeReaders(paths []string) {
 	for _, path := range paths {
+		if m.readerFactory.Archiver != nil && m.readerFactory.Archiver.Skip(path) {
+			continue
+		}
 		fp, file := m.makeFingerprint(path)
 		if fp == nil {
 			continue
@@ -217,13 +221,18 @@ func (m *Manager) makeReaders(paths []string) {
 }
 
 func (m *Manager) newReader(file *os.File, fp *fingerprint.Fingerprint) (*reader.Reader, error) {
+	// On windows, the FileID prevents a new file starting with the same content as a
+	// rotated file from taking over its offset, in which case the rotated file would be
+	// read again from the beginning.
+	fileID := fileid.Get(file)
+
 	// Check previous poll cycle for match
-	if oldReader := m.tracker.GetOpenFile(fp); oldReader != nil {
+	if oldReader := m.tracker.GetOpenFile(fp, fileID); oldReader != nil {
 		return m.readerFactory.NewReaderFromMetadata(file, oldReader.Close())
 	}
 
 	// Check for closed files for match
-	if oldMetadata := m.tracker.GetClosedFile(fp); oldMetadata != nil {
+	if oldMetadata := m.tracker.GetClosedFile(fp, fileID); oldMetadata != nil {
 		return m.readerFactory.NewReaderFromMetadata(file, oldMetadata)
 	}
 
@@ -1038,6 +1038,83 @@ func TestDeleteAfterRead(t *testing.T) {
 	}
 }
 
+func TestArchiveMove(t *testing.T) {
+	t.Parallel()
+
+	tempDir := t.TempDir()
+	archiveDir := t.TempDir()
+	temp := filetest.OpenTemp(t, tempDir)
+	filetest.WriteString(t, temp, "testlog1\ntestlog2\n")
+	require.NoError(t, temp.Close())
+
+	cfg := NewConfig().includeDir(tempDir)
+	cfg.StartAt = "beginning"
+	cfg.Archive = &ArchiveConfig{Action: "move", Directory: archiveDir}
+	sink := emittest.NewSink()
+	operator := testManagerWithSink(t, cfg, sink)
+
+	operator.poll(context.Background())
+	sink.ExpectTokens(t, []byte("testlog1"), []byte("testlog2"))
+
+	_, err := os.Stat(temp.Name())
+	require.True(t, os.IsNotExist(err))
+	archived, err := os.ReadFile(filepath.Join(archiveDir, filepath.Base(temp.Name())))
+	require.NoError(t, err)
+	require.Equal(t, "testlog1\ntestlog2\n", string(archived))
+
+	operator.poll(context.Background())
+	sink.ExpectNoCalls(t)
+}
+
+func TestArchiveMarker(t *testing.T) {
+	t.Parallel()
+
+	tempDir := t.TempDir()
+	temp := filetest.OpenTemp(t, tempDir)
+	filetest.WriteString(t, temp, "testlog1\n")
+
+	cfg := NewConfig().includeDir(tempDir)
+	cfg.StartAt = "beginning"
+	cfg.Archive = &ArchiveConfig{Action: "marker"}
+	sink := emittest.NewSink()
+	operator := testManagerWithSink(t, cfg, sink)
+
+	operator.poll(context.Background())
+	sink.ExpectToken(t, []byte("testlog1"))
+	_, err := os.Stat(temp.Name() + ".done")
+	require.NoError(t, err)
+
+	// the file and its marker are not read again, even after a restart
+	filetest.WriteString(t, temp, "testlog2\n")
+	operator = testManagerWithSink(t, cfg, sink)
+	operator.poll(context.Background())
+	sink.ExpectNoCalls(t)
+}
+
+func TestArchiveIdleTimeout(t *testing.T) {
+	t.Parallel()
+
+	tempDir := t.TempDir()
+	temp := filetest.OpenTemp(t, tempDir)
+	filetest.WriteString(t, temp, "testlog1\n")
+
+	cfg := NewConfig().includeDir(tempDir)
+	cfg.StartAt = "beginning"
+	cfg.Archive = &ArchiveConfig{Action: "marker", IdleTimeout: time.Hour}
+	sink := emittest.NewSink()
+	operator := testManagerWithSink(t, cfg, sink)
+
+	operator.poll(context.Background())
+	sink.ExpectToken(t, []byte("testlog1"))
+
+	// the file is still being written to
+	filetest.WriteString(t, temp, "testlog2\n")
+	operator.poll(context.Background())
+	sink.ExpectToken(t, []byte("testlog2"))
+	_, err := os.Stat(temp.Name() + ".done")
+	require.True(t, os.IsNotExist(err))
+}
+
 func TestMaxBatching(t *testing.T) {
 	t.Parallel()
 
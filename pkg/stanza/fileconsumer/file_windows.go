@@ -7,8 +7,31 @@ package fileconsumer // import "github.com/open-telemetry/opentelemetry-collecto
 
 import (
 	"context"
+	"os"
+	"syscall"
 )
 
 // Noop on windows because we close files immediately after reading.
 func (m *Manager) readLostFiles(ctx context.Context) {
 }
+
+// openFile opens the file for reading, sharing it for deletion in addition to reading
+// and writing. os.Open doesn't share files for deletion on windows, which makes the
+// writers fail to rename or remove a file while it is being read, e.g. to rotate it.
+func openFile(path string) (*os.File, error) {
+	pathp, err := syscall.UTF16PtrFromString(path)
+	if err != nil {
+		return nil, &os.PathError{Op: "open", Path: path, Err: err}
+	}
+	h, err := syscall.CreateFile(pathp,
+		syscall.GENERIC_READ,
+		syscall.FILE_SHARE_READ|syscall.FILE_SHARE_WRITE|syscall.FILE_SHARE_DELETE,
+		nil,
+		syscall.OPEN_EXISTING,
+		syscall.FILE_ATTRIBUTE_NORMAL,
+		0)
+	if err != nil {
+		return nil, &os.PathError{Op: "open", Path: path, Err: err}
+	}
+	return os.NewFile(uintptr(h), path), nil
+}
@@ -0,0 +1,35 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build windows
+
+package fileconsumer
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// The writers can rotate and remove the files while they are read.
+func TestOpenFileSharesDeletion(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "file.log")
+	require.NoError(t, os.WriteFile(path, []byte("content"), 0600))
+
+	f, err := openFile(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	rotated := filepath.Join(dir, "file.log.1")
+	require.NoError(t, os.Rename(path, rotated))
+	require.NoError(t, os.WriteFile(path, []byte("new content"), 0600))
+	require.NoError(t, os.Remove(rotated))
+
+	content := make([]byte, 7)
+	_, err = f.Read(content)
+	require.NoError(t, err)
+	require.Equal(t, "content", string(content))
+}
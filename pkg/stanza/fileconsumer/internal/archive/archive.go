@@ -0,0 +1,108 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package archive takes an action on the files once they have been read entirely, so
+// that they are neither read again nor left behind.
+package archive // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/archive"
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+const (
+	// ActionMove moves the files to a directory.
+	ActionMove = "move"
+	// ActionDelete deletes the files.
+	ActionDelete = "delete"
+	// ActionMarker creates an empty marker file next to the files.
+	ActionMarker = "marker"
+
+	DefaultMarkerSuffix = ".done"
+)
+
+type Archiver struct {
+	action       string
+	directory    string
+	markerSuffix string
+	idleTimeout  time.Duration
+}
+
+func New(action string, directory string, markerSuffix string, idleTimeout time.Duration) (*Archiver, error) {
+	if idleTimeout < 0 {
+		return nil, errors.New("'idle_timeout' must not be negative")
+	}
+	a := &Archiver{action: action, idleTimeout: idleTimeout}
+	switch action {
+	case ActionMove:
+		if directory == "" {
+			return nil, errors.New("'directory' is required with the move action")
+		}
+		a.directory = directory
+	case ActionDelete:
+	case ActionMarker:
+		a.markerSuffix = markerSuffix
+		if a.markerSuffix == "" {
+			a.markerSuffix = DefaultMarkerSuffix
+		}
+	default:
+		return nil, fmt.Errorf("invalid action %q, must be one of %s, %s or %s", action, ActionMove, ActionDelete, ActionMarker)
+	}
+	return a, nil
+}
+
+// Action returns the action taken on the files.
+func (a *Archiver) Action() string {
+	return a.action
+}
+
+// Complete reports whether the file has been read entirely, up to offset, and has not
+// been modified for the idle timeout.
+func (a *Archiver) Complete(info os.FileInfo, offset int64, now time.Time) bool {
+	return offset >= info.Size() && now.Sub(info.ModTime()) >= a.idleTimeout
+}
+
+// Archive takes the action on the file, which must be closed.
+func (a *Archiver) Archive(path string) error {
+	switch a.action {
+	case ActionMove:
+		return a.move(path)
+	case ActionDelete:
+		return os.Remove(path)
+	default:
+		f, err := os.OpenFile(path+a.markerSuffix, os.O_CREATE|os.O_WRONLY, 0600)
+		if err != nil {
+			return err
+		}
+		return f.Close()
+	}
+}
+
+// move renames the file into the directory, without replacing a file with the same name
+// already archived.
+func (a *Archiver) move(path string) error {
+	if err := os.MkdirAll(a.directory, 0700); err != nil {
+		return err
+	}
+	target := filepath.Join(a.directory, filepath.Base(path))
+	if _, err := os.Lstat(target); err == nil {
+		target = fmt.Sprintf("%s.%d", target, time.Now().UnixNano())
+	}
+	return os.Rename(path, target)
+}
+
+// Skip reports whether the file must not be read: a marker file, or a file with a marker.
+func (a *Archiver) Skip(path string) bool {
+	if a.action != ActionMarker {
+		return false
+	}
+	if strings.HasSuffix(path, a.markerSuffix) {
+		return true
+	}
+	_, err := os.Lstat(path + a.markerSuffix)
+	return err == nil
+}
@@ -0,0 +1,89 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package archive
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew(t *testing.T) {
+	_, err := New(ActionMove, "", "", 0)
+	assert.EqualError(t, err, "'directory' is required with the move action")
+	_, err = New("copy", "", "", 0)
+	assert.EqualError(t, err, `invalid action "copy", must be one of move, delete or marker`)
+	_, err = New(ActionDelete, "", "", -time.Second)
+	assert.EqualError(t, err, "'idle_timeout' must not be negative")
+
+	a, err := New(ActionMarker, "", "", 0)
+	require.NoError(t, err)
+	assert.Equal(t, DefaultMarkerSuffix, a.markerSuffix)
+}
+
+func writeFile(t *testing.T, path string) {
+	require.NoError(t, os.WriteFile(path, []byte("content\n"), 0600))
+}
+
+func TestComplete(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "file.log")
+	writeFile(t, path)
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+
+	a, err := New(ActionDelete, "", "", time.Minute)
+	require.NoError(t, err)
+	assert.False(t, a.Complete(info, 4, info.ModTime().Add(time.Hour)), "the file is not read entirely")
+	assert.False(t, a.Complete(info, 8, info.ModTime().Add(time.Second)), "the file is not idle")
+	assert.True(t, a.Complete(info, 8, info.ModTime().Add(time.Minute)))
+}
+
+func TestArchiveMove(t *testing.T) {
+	dir := t.TempDir()
+	archiveDir := filepath.Join(dir, "archive")
+	a, err := New(ActionMove, archiveDir, "", 0)
+	require.NoError(t, err)
+
+	path := filepath.Join(dir, "file.log")
+	writeFile(t, path)
+	require.NoError(t, a.Archive(path))
+	assert.NoFileExists(t, path)
+	assert.FileExists(t, filepath.Join(archiveDir, "file.log"))
+
+	// a file with the same name is not replaced
+	writeFile(t, path)
+	require.NoError(t, a.Archive(path))
+	entries, err := os.ReadDir(archiveDir)
+	require.NoError(t, err)
+	assert.Len(t, entries, 2)
+	assert.False(t, a.Skip(path))
+}
+
+func TestArchiveDelete(t *testing.T) {
+	a, err := New(ActionDelete, "", "", 0)
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "file.log")
+	writeFile(t, path)
+	require.NoError(t, a.Archive(path))
+	assert.NoFileExists(t, path)
+}
+
+func TestArchiveMarker(t *testing.T) {
+	a, err := New(ActionMarker, "", ".ingested", 0)
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "file.log")
+	writeFile(t, path)
+	assert.False(t, a.Skip(path))
+	require.NoError(t, a.Archive(path))
+	assert.FileExists(t, path)
+	assert.FileExists(t, path+".ingested")
+	assert.True(t, a.Skip(path))
+	assert.True(t, a.Skip(path+".ingested"))
+}
@@ -0,0 +1,16 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !windows
+
+package fileid // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/fileid"
+
+import (
+	"os"
+)
+
+// Get returns an empty string, the files are only identified by their fingerprint on
+// non-windows platforms.
+func Get(_ *os.File) string {
+	return ""
+}
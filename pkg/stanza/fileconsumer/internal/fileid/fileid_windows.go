@@ -0,0 +1,23 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build windows
+
+package fileid // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/fileid"
+
+import (
+	"fmt"
+	"os"
+	"syscall"
+)
+
+// Get returns the FileID of the file, made of the serial number of its volume and its
+// file index, or an empty string if it can't be retrieved. The FileID is kept when the
+// file is renamed within its volume.
+func Get(file *os.File) string {
+	var info syscall.ByHandleFileInformation
+	if err := syscall.GetFileInformationByHandle(syscall.Handle(file.Fd()), &info); err != nil {
+		return ""
+	}
+	return fmt.Sprintf("%08x-%08x%08x", info.VolumeSerialNumber, info.FileIndexHigh, info.FileIndexLow)
+}
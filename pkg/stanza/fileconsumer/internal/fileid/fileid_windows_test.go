@@ -0,0 +1,38 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build windows
+
+package fileid
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func openFileID(t *testing.T, path string) string {
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+	return Get(f)
+}
+
+func TestGet(t *testing.T) {
+	dir := t.TempDir()
+	first := filepath.Join(dir, "first.log")
+	second := filepath.Join(dir, "second.log")
+	require.NoError(t, os.WriteFile(first, []byte("content"), 0600))
+	require.NoError(t, os.WriteFile(second, []byte("content"), 0600))
+
+	id := openFileID(t, first)
+	require.NotEmpty(t, id)
+	assert.NotEqual(t, id, openFileID(t, second), "files with the same content have different IDs")
+
+	rotated := filepath.Join(dir, "first.log.1")
+	require.NoError(t, os.Rename(first, rotated))
+	assert.Equal(t, id, openFileID(t, rotated), "the ID is kept by the renamed file")
+}
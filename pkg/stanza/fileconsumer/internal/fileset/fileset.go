@@ -21,6 +21,7 @@ var (
 
 type Matchable interface {
 	GetFingerprint() *fingerprint.Fingerprint
+	GetFileID() string
 }
 
 type Fileset[T Matchable] struct {
@@ -56,8 +57,18 @@ func (set *Fileset[T]) Add(readers ...T) {
 }
 
 func (set *Fileset[T]) Match(fp *fingerprint.Fingerprint, cmp func(a, b *fingerprint.Fingerprint) bool) T {
+	return set.MatchFile(fp, "", cmp)
+}
+
+// MatchFile is like Match but doesn't match the entries of another file than the one
+// identified by fileID. The file IDs are only compared when both are known, since two
+// different files can start with the same content.
+func (set *Fileset[T]) MatchFile(fp *fingerprint.Fingerprint, fileID string, cmp func(a, b *fingerprint.Fingerprint) bool) T {
 	var val T
 	for idx, r := range set.readers {
+		if fileID != "" && r.GetFileID() != "" && fileID != r.GetFileID() {
+			continue
+		}
 		if cmp(fp, r.GetFingerprint()) {
 			set.readers = append(set.readers[:idx], set.readers[idx+1:]...)
 			return r
@@ -47,7 +47,7 @@ func pop[T Matchable](expectedErr error, expectedElemet T) func(t *testing.T, fi
 func match[T Matchable](ele T, expect bool) func(t *testing.T, fileset *Fileset[T]) {
 	return func(t *testing.T, fileset *Fileset[T]) {
 		pr := fileset.Len()
-		r := fileset.Match(ele.GetFingerprint(), StartsWith)
+		r := fileset.MatchFile(ele.GetFingerprint(), ele.GetFileID(), StartsWith)
 		if expect {
 			require.NotNil(t, r)
 			require.Equal(t, pr-1, fileset.Len())
@@ -67,6 +67,12 @@ func newReader(bytes []byte) *reader.Reader {
 	}
 }
 
+func newReaderWithFileID(bytes []byte, fileID string) *reader.Reader {
+	r := newReader(bytes)
+	r.FileID = fileID
+	return r
+}
+
 func TestFilesetReader(t *testing.T) {
 	testCases := []test[*reader.Reader]{
 		{
@@ -85,6 +91,22 @@ func TestFilesetReader(t *testing.T) {
 				pop(errFilesetEmpty, newReader([]byte(""))),
 			},
 		},
+		{
+			name: "test_match_file_id",
+			ops: []func(t *testing.T, fileset *Fileset[*reader.Reader]){
+				push(newReaderWithFileID([]byte("ABCDEF"), "1"), newReader([]byte("QWERT"))),
+
+				// another file with the same content
+				match(newReaderWithFileID([]byte("ABCDEFGHI"), "2"), false),
+				match(newReaderWithFileID([]byte("ABCDEFGHI"), "1"), true),
+
+				// the file IDs are only compared when both are known
+				push(newReaderWithFileID([]byte("XYZ"), "3")),
+				match(newReader([]byte("XYZabc")), true),
+				match(newReaderWithFileID([]byte("QWERT"), "4"), true),
+				pop(errFilesetEmpty, newReader([]byte(""))),
+			},
+		},
 		{
 			name: "test_pop",
 			ops: []func(t *testing.T, fileset *Fileset[*reader.Reader]){
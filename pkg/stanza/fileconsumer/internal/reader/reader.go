@@ -8,11 +8,13 @@ import (
 	"context"
 	"errors"
 	"os"
+	"time"
 
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/decode"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/emit"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/archive"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/fingerprint"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/header"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/scanner"
@@ -25,6 +27,9 @@ type Metadata struct {
 	FileAttributes  map[string]any
 	HeaderFinalized bool
 	FlushState      *flush.State
+	// FileID identifies the file independently of its path, it is empty on the
+	// platforms where it isn't supported.
+	FileID string
 }
 
 // Reader manages a single file
@@ -43,6 +48,7 @@ type Reader struct {
 	processFunc            emit.Callback
 	emitFunc               emit.Callback
 	deleteAtEOF            bool
+	archiver               *archive.Archiver
 	needsUpdateFingerprint bool
 }
 
@@ -75,6 +81,8 @@ func (r *Reader) ReadToEnd(ctx context.Context) {
 				r.logger.Errorw("Failed during scan", zap.Error(err))
 			} else if r.deleteAtEOF {
 				r.delete()
+			} else if r.archiver != nil {
+				r.archive()
 			}
 			return
 		}
@@ -128,6 +136,25 @@ func (r *Reader) delete() {
 	}
 }
 
+// archive closes the file and takes the archive action on it, once it has been
+// read entirely and is idle.
+func (r *Reader) archive() {
+	info, err := r.file.Stat()
+	if err != nil {
+		r.logger.Errorw("Failed to stat file", zap.Error(err))
+		return
+	}
+	if !r.archiver.Complete(info, r.Offset, time.Now()) {
+		return
+	}
+	r.close()
+	if err = r.archiver.Archive(r.fileName); err != nil {
+		r.logger.Errorw("Failed to archive file", zap.String("action", r.archiver.Action()), zap.Error(err))
+		return
+	}
+	r.logger.Debugw("Archived file", zap.String("action", r.archiver.Action()))
+}
+
 // Close will close the file and return the metadata
 func (r *Reader) Close() *Metadata {
 	r.close()
@@ -187,6 +214,10 @@ func (m Metadata) GetFingerprint() *fingerprint.Fingerprint {
 	return m.Fingerprint
 }
 
+func (m Metadata) GetFileID() string {
+	return m.FileID
+}
+
 func (r *Reader) updateFingerprint() {
 	r.needsUpdateFingerprint = false
 	if r.file == nil {
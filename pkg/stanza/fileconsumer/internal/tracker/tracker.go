@@ -15,8 +15,8 @@ import (
 type Tracker interface {
 	Add(reader *reader.Reader)
 	GetCurrentFile(fp *fingerprint.Fingerprint) *reader.Reader
-	GetOpenFile(fp *fingerprint.Fingerprint) *reader.Reader
-	GetClosedFile(fp *fingerprint.Fingerprint) *reader.Metadata
+	GetOpenFile(fp *fingerprint.Fingerprint, fileID string) *reader.Reader
+	GetClosedFile(fp *fingerprint.Fingerprint, fileID string) *reader.Metadata
 	GetMetadata() []*reader.Metadata
 	LoadMetadata(metadata []*reader.Metadata)
 	CurrentPollFiles() []*reader.Reader
@@ -61,13 +61,13 @@ func (t *fileTracker) GetCurrentFile(fp *fingerprint.Fingerprint) *reader.Reader
 	return t.currentPollFiles.Match(fp, fileset.Equal)
 }
 
-func (t *fileTracker) GetOpenFile(fp *fingerprint.Fingerprint) *reader.Reader {
-	return t.previousPollFiles.Match(fp, fileset.StartsWith)
+func (t *fileTracker) GetOpenFile(fp *fingerprint.Fingerprint, fileID string) *reader.Reader {
+	return t.previousPollFiles.MatchFile(fp, fileID, fileset.StartsWith)
 }
 
-func (t *fileTracker) GetClosedFile(fp *fingerprint.Fingerprint) *reader.Metadata {
+func (t *fileTracker) GetClosedFile(fp *fingerprint.Fingerprint, fileID string) *reader.Metadata {
 	for i := 0; i < len(t.knownFiles); i++ {
-		if oldMetadata := t.knownFiles[i].Match(fp, fileset.StartsWith); oldMetadata != nil {
+		if oldMetadata := t.knownFiles[i].MatchFile(fp, fileID, fileset.StartsWith); oldMetadata != nil {
 			return oldMetadata
 		}
 	}
@@ -158,9 +158,11 @@ func (t *noStateTracker) EndConsume() {
 	}
 }
 
-func (t *noStateTracker) GetOpenFile(_ *fingerprint.Fingerprint) *reader.Reader { return nil }
+func (t *noStateTracker) GetOpenFile(_ *fingerprint.Fingerprint, _ string) *reader.Reader { return nil }
 
-func (t *noStateTracker) GetClosedFile(_ *fingerprint.Fingerprint) *reader.Metadata { return nil }
+func (t *noStateTracker) GetClosedFile(_ *fingerprint.Fingerprint, _ string) *reader.Metadata {
+	return nil
+}
 
 func (t *noStateTracker) GetMetadata() []*reader.Metadata { return nil }
 
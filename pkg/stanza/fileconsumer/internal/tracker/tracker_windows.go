@@ -11,7 +11,9 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/reader"
 )
 
-// On windows, we close files immediately after reading because they cannot be moved while open.
+// On windows, we close files immediately after reading. While they are opened with deletion
+// sharing, a deleted file keeps its name until all its handles are closed, which would prevent
+// the writers to create a new file with the same name, e.g. after rotating it.
 func (t *fileTracker) EndConsume() {
 	// t.currentPollFiles -> t.previousPollFiles
 	t.previousPollFiles = t.currentPollFiles
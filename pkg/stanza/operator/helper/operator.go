@@ -62,10 +62,16 @@ func (c BasicConfig) Build(set component.TelemetrySettings) (BasicOperator, erro
 		)
 	}
 
+	telemetry, err := newOperatorTelemetry(set, c.ID(), c.Type())
+	if err != nil {
+		return BasicOperator{}, errors.Wrap(err, "create operator telemetry")
+	}
+
 	operator := BasicOperator{
 		OperatorID:    c.ID(),
 		OperatorType:  c.Type(),
 		SugaredLogger: set.Logger.Sugar().With("operator_id", c.ID(), "operator_type", c.Type()),
+		telemetry:     telemetry,
 	}
 
 	return operator, nil
@@ -76,6 +82,8 @@ type BasicOperator struct {
 	OperatorID   string
 	OperatorType string
 	*zap.SugaredLogger
+
+	telemetry *operatorTelemetry
 }
 
 // ID will return the operator id.
@@ -99,24 +99,28 @@ func (p *ParserOperator) ProcessWith(ctx context.Context, entry *entry.Entry, pa
 }
 
 func (p *ParserOperator) ProcessWithCallback(ctx context.Context, entry *entry.Entry, parse ParseFunction, cb func(*entry.Entry) error) error {
+	p.telemetry.recordReceived(ctx)
+	start := p.telemetry.start()
+
 	// Short circuit if the "if" condition does not match
 	skip, err := p.Skip(ctx, entry)
 	if err != nil {
+		p.telemetry.recordProcessed(ctx, start)
 		return p.HandleEntryError(ctx, entry, err)
 	}
 	if skip {
+		p.telemetry.recordProcessed(ctx, start)
 		p.Write(ctx, entry)
 		return nil
 	}
 
-	if err = p.ParseWith(ctx, entry, parse); err != nil {
-		return err
-	}
-	if cb != nil {
+	err = p.ParseWith(ctx, entry, parse)
+	if err == nil && cb != nil {
 		err = cb(entry)
-		if err != nil {
-			return err
-		}
+	}
+	p.telemetry.recordProcessed(ctx, start)
+	if err != nil {
+		return err
 	}
 
 	p.Write(ctx, entry)
@@ -0,0 +1,136 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package helper // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/helper"
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+const (
+	// defaultIdlePeerTimeout is the time after which the bucket of a peer which hasn't sent any data is removed
+	defaultIdlePeerTimeout time.Duration = time.Minute
+)
+
+// RateLimitConfig limits the number of bytes a single peer can send per second
+type RateLimitConfig struct {
+	BytesPerSecond ByteSize `mapstructure:"bytes_per_second,omitempty"`
+	// Burst is the number of bytes a peer can send at once, it defaults to BytesPerSecond
+	Burst ByteSize `mapstructure:"burst,omitempty"`
+}
+
+// Build creates a PeerRateLimiter from the config
+func (c RateLimitConfig) Build() (*PeerRateLimiter, error) {
+	if c.BytesPerSecond <= 0 {
+		return nil, fmt.Errorf("invalid value for parameter 'rate_limit.bytes_per_second', must be greater than 0")
+	}
+	if c.Burst < 0 {
+		return nil, fmt.Errorf("invalid value for parameter 'rate_limit.burst', must not be negative")
+	}
+
+	burst := c.Burst
+	if burst == 0 {
+		burst = c.BytesPerSecond
+	}
+	return &PeerRateLimiter{
+		rate:        float64(c.BytesPerSecond),
+		burst:       float64(burst),
+		idleTimeout: defaultIdlePeerTimeout,
+		buckets:     make(map[string]*tokenBucket),
+		now:         time.Now,
+	}, nil
+}
+
+// tokenBucket keeps the number of bytes a peer is allowed to send
+type tokenBucket struct {
+	tokens   float64
+	lastSeen time.Time
+}
+
+// PeerRateLimiter is a token bucket rate limiter which tracks a bucket for each peer,
+// so a single chatty peer cannot consume the capacity of the others.
+type PeerRateLimiter struct {
+	rate        float64
+	burst       float64
+	idleTimeout time.Duration
+
+	mutex     sync.Mutex
+	buckets   map[string]*tokenBucket
+	lastPrune time.Time
+	now       func() time.Time
+}
+
+// Allow reports whether the peer can send n bytes now, and consumes them if so.
+// It is meant for connectionless inputs, where data over the limit is dropped.
+func (l *PeerRateLimiter) Allow(peer string, n int) bool {
+	return l.reserve(peer, n, false) == 0
+}
+
+// Wait blocks until the peer is allowed to send n bytes or the context is done.
+// It is meant for connection oriented inputs, where waiting applies backpressure to the peer.
+func (l *PeerRateLimiter) Wait(ctx context.Context, peer string, n int) error {
+	delay := l.reserve(peer, n, true)
+	if delay == 0 {
+		return nil
+	}
+
+	t := time.NewTimer(delay)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// reserve refills the bucket of the peer and takes n bytes from it. It returns how long the peer
+// has to wait before the bytes are available. Unless force is set, nothing is taken if it has to wait.
+func (l *PeerRateLimiter) reserve(peer string, n int, force bool) time.Duration {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	now := l.now()
+	l.prune(now)
+
+	bucket, ok := l.buckets[peer]
+	if !ok {
+		bucket = &tokenBucket{tokens: l.burst, lastSeen: now}
+		l.buckets[peer] = bucket
+	}
+
+	if elapsed := now.Sub(bucket.lastSeen); elapsed > 0 {
+		bucket.tokens += elapsed.Seconds() * l.rate
+		if bucket.tokens > l.burst {
+			bucket.tokens = l.burst
+		}
+	}
+	bucket.lastSeen = now
+
+	remaining := bucket.tokens - float64(n)
+	if remaining >= 0 {
+		bucket.tokens = remaining
+		return 0
+	}
+	if force {
+		bucket.tokens = remaining
+	}
+	return time.Duration(-remaining / l.rate * float64(time.Second))
+}
+
+// prune removes the buckets of the peers which have been idle long enough to have refilled
+func (l *PeerRateLimiter) prune(now time.Time) {
+	if now.Sub(l.lastPrune) < l.idleTimeout {
+		return
+	}
+	l.lastPrune = now
+	for peer, bucket := range l.buckets {
+		idle := now.Sub(bucket.lastSeen)
+		if idle > l.idleTimeout && bucket.tokens+idle.Seconds()*l.rate >= l.burst {
+			delete(l.buckets, peer)
+		}
+	}
+}
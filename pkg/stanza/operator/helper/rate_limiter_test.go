@@ -0,0 +1,76 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package helper
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimitConfigBuild(t *testing.T) {
+	_, err := RateLimitConfig{}.Build()
+	require.ErrorContains(t, err, "rate_limit.bytes_per_second")
+
+	_, err = RateLimitConfig{BytesPerSecond: 10, Burst: -1}.Build()
+	require.ErrorContains(t, err, "rate_limit.burst")
+
+	limiter, err := RateLimitConfig{BytesPerSecond: 10}.Build()
+	require.NoError(t, err)
+	require.Equal(t, float64(10), limiter.burst)
+}
+
+func TestPeerRateLimiterAllow(t *testing.T) {
+	limiter, err := RateLimitConfig{BytesPerSecond: 100, Burst: 150}.Build()
+	require.NoError(t, err)
+	now := time.Unix(1000, 0)
+	limiter.now = func() time.Time { return now }
+
+	require.True(t, limiter.Allow("10.0.0.1", 100))
+	require.False(t, limiter.Allow("10.0.0.1", 100))
+	// other peers have their own bucket
+	require.True(t, limiter.Allow("10.0.0.2", 150))
+
+	// the bucket refills over time
+	now = now.Add(500 * time.Millisecond)
+	require.True(t, limiter.Allow("10.0.0.1", 100))
+	require.False(t, limiter.Allow("10.0.0.1", 1))
+
+	// the bucket never holds more than the burst
+	now = now.Add(time.Hour)
+	require.False(t, limiter.Allow("10.0.0.1", 151))
+	require.True(t, limiter.Allow("10.0.0.1", 150))
+}
+
+func TestPeerRateLimiterWait(t *testing.T) {
+	limiter, err := RateLimitConfig{BytesPerSecond: 1000}.Build()
+	require.NoError(t, err)
+
+	require.NoError(t, limiter.Wait(context.Background(), "10.0.0.1", 1000))
+
+	start := time.Now()
+	require.NoError(t, limiter.Wait(context.Background(), "10.0.0.1", 100))
+	require.GreaterOrEqual(t, time.Since(start), 50*time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	require.ErrorIs(t, limiter.Wait(ctx, "10.0.0.1", 10000), context.Canceled)
+}
+
+func TestPeerRateLimiterPrune(t *testing.T) {
+	limiter, err := RateLimitConfig{BytesPerSecond: 100}.Build()
+	require.NoError(t, err)
+	now := time.Unix(1000, 0)
+	limiter.now = func() time.Time { return now }
+
+	require.True(t, limiter.Allow("10.0.0.1", 100))
+	require.True(t, limiter.Allow("10.0.0.2", 100))
+	require.Len(t, limiter.buckets, 2)
+
+	now = now.Add(2 * defaultIdlePeerTimeout)
+	require.True(t, limiter.Allow("10.0.0.2", 10))
+	require.Len(t, limiter.buckets, 1)
+}
@@ -0,0 +1,141 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package helper // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/helper"
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configtelemetry"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const meterScope = "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza"
+
+// durationBuckets covers the time spent by an operator on a single entry, from microseconds to seconds
+var durationBuckets = []float64{0.00001, 0.0001, 0.001, 0.01, 0.1, 1, 10}
+
+// operatorTelemetry records the internal metrics of an operator. The number of entries going
+// through the operator is always recorded, while the duration histograms are only recorded
+// when the metrics level of the collector is detailed, as they are measured for each entry.
+type operatorTelemetry struct {
+	attrs     metric.MeasurementOption
+	sendAttrs metric.MeasurementOption
+	dropAttrs metric.MeasurementOption
+
+	entriesReceived metric.Int64Counter
+	entriesSent     metric.Int64Counter
+	entriesErrored  metric.Int64Counter
+
+	// processDuration and writeDuration are nil unless the metrics level is detailed
+	processDuration metric.Float64Histogram
+	writeDuration   metric.Float64Histogram
+}
+
+func newOperatorTelemetry(set component.TelemetrySettings, operatorID, operatorType string) (*operatorTelemetry, error) {
+	if set.MeterProvider == nil || set.MetricsLevel < configtelemetry.LevelBasic {
+		return nil, nil
+	}
+	meter := set.MeterProvider.Meter(meterScope)
+
+	attrs := []attribute.KeyValue{
+		attribute.String("operator_id", operatorID),
+		attribute.String("operator_type", operatorType),
+	}
+	t := &operatorTelemetry{
+		attrs:     metric.WithAttributeSet(attribute.NewSet(attrs...)),
+		sendAttrs: metric.WithAttributeSet(attribute.NewSet(append(attrs, attribute.String("action", SendOnError))...)),
+		dropAttrs: metric.WithAttributeSet(attribute.NewSet(append(attrs, attribute.String("action", DropOnError))...)),
+	}
+
+	var err error
+	if t.entriesReceived, err = meter.Int64Counter(
+		"stanza_operator_entries_received",
+		metric.WithDescription("Number of entries received by the operator"),
+		metric.WithUnit("{entries}"),
+	); err != nil {
+		return nil, err
+	}
+	if t.entriesSent, err = meter.Int64Counter(
+		"stanza_operator_entries_sent",
+		metric.WithDescription("Number of entries sent by the operator to its outputs"),
+		metric.WithUnit("{entries}"),
+	); err != nil {
+		return nil, err
+	}
+	if t.entriesErrored, err = meter.Int64Counter(
+		"stanza_operator_entries_errored",
+		metric.WithDescription("Number of entries the operator failed to process, by the on_error action applied to them"),
+		metric.WithUnit("{entries}"),
+	); err != nil {
+		return nil, err
+	}
+
+	if set.MetricsLevel < configtelemetry.LevelDetailed {
+		return t, nil
+	}
+	if t.processDuration, err = meter.Float64Histogram(
+		"stanza_operator_process_duration",
+		metric.WithDescription("Time spent by the operator processing an entry, excluding the time spent by its outputs"),
+		metric.WithUnit("s"),
+		metric.WithExplicitBucketBoundaries(durationBuckets...),
+	); err != nil {
+		return nil, err
+	}
+	if t.writeDuration, err = meter.Float64Histogram(
+		"stanza_operator_write_duration",
+		metric.WithDescription("Time spent by the operator handing an entry to its outputs, which grows when downstream operators or consumers apply backpressure"),
+		metric.WithUnit("s"),
+		metric.WithExplicitBucketBoundaries(durationBuckets...),
+	); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+func (t *operatorTelemetry) recordReceived(ctx context.Context) {
+	if t == nil {
+		return
+	}
+	t.entriesReceived.Add(ctx, 1, t.attrs)
+}
+
+func (t *operatorTelemetry) recordErrored(ctx context.Context, onError string) {
+	if t == nil {
+		return
+	}
+	if onError == SendOnError || onError == SendOnErrorQuiet {
+		t.entriesErrored.Add(ctx, 1, t.sendAttrs)
+		return
+	}
+	t.entriesErrored.Add(ctx, 1, t.dropAttrs)
+}
+
+// start returns the time from which the duration of the processing or writing of an entry is measured,
+// or the zero time if the durations are not recorded, to avoid reading the clock for each entry
+func (t *operatorTelemetry) start() time.Time {
+	if t == nil || t.processDuration == nil {
+		return time.Time{}
+	}
+	return time.Now()
+}
+
+func (t *operatorTelemetry) recordProcessed(ctx context.Context, start time.Time) {
+	if t == nil || start.IsZero() {
+		return
+	}
+	t.processDuration.Record(ctx, time.Since(start).Seconds(), t.attrs)
+}
+
+func (t *operatorTelemetry) recordSent(ctx context.Context, start time.Time) {
+	if t == nil {
+		return
+	}
+	t.entriesSent.Add(ctx, 1, t.attrs)
+	if !start.IsZero() {
+		t.writeDuration.Record(ctx, time.Since(start).Seconds(), t.attrs)
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package helper
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config/configtelemetry"
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/testutil"
+)
+
+func newTelemetrySettings(level configtelemetry.Level) (component.TelemetrySettings, *sdkmetric.ManualReader) {
+	reader := sdkmetric.NewManualReader()
+	set := componenttest.NewNopTelemetrySettings()
+	set.MeterProvider = sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	set.MetricsLevel = level
+	return set, reader
+}
+
+func collectMetrics(t *testing.T, reader *sdkmetric.ManualReader) map[string]metricdata.Aggregation {
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &rm))
+	metrics := map[string]metricdata.Aggregation{}
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			metrics[m.Name] = m.Data
+		}
+	}
+	return metrics
+}
+
+func sumValue(t *testing.T, data metricdata.Aggregation, attrs ...attribute.KeyValue) int64 {
+	sum, ok := data.(metricdata.Sum[int64])
+	require.True(t, ok)
+	expected := attribute.NewSet(append([]attribute.KeyValue{
+		attribute.String("operator_id", "test-id"),
+		attribute.String("operator_type", "test-type"),
+	}, attrs...)...)
+	for _, dp := range sum.DataPoints {
+		if dp.Attributes.Equals(&expected) {
+			return dp.Value
+		}
+	}
+	return 0
+}
+
+func TestOperatorTelemetry(t *testing.T) {
+	set, reader := newTelemetrySettings(configtelemetry.LevelNormal)
+	cfg := NewTransformerConfig("test-id", "test-type")
+	cfg.OutputIDs = []string{"test-output"}
+	transformer, err := cfg.Build(set)
+	require.NoError(t, err)
+
+	output := &testutil.Operator{}
+	output.On("ID").Return("test-output")
+	output.On("CanProcess").Return(true)
+	output.On("Process", mock.Anything, mock.Anything).Return(nil)
+	require.NoError(t, transformer.SetOutputs([]operator.Operator{output}))
+
+	ctx := context.Background()
+	require.NoError(t, transformer.ProcessWith(ctx, entry.New(), func(*entry.Entry) error { return nil }))
+	require.NoError(t, transformer.ProcessWith(ctx, entry.New(), func(*entry.Entry) error { return nil }))
+	require.Error(t, transformer.ProcessWith(ctx, entry.New(), func(*entry.Entry) error { return fmt.Errorf("failure") }))
+	transformer.OnError = DropOnErrorQuiet
+	require.Error(t, transformer.ProcessWith(ctx, entry.New(), func(*entry.Entry) error { return fmt.Errorf("failure") }))
+
+	metrics := collectMetrics(t, reader)
+	require.Equal(t, int64(4), sumValue(t, metrics["stanza_operator_entries_received"]))
+	// entries are still sent when on_error is send
+	require.Equal(t, int64(3), sumValue(t, metrics["stanza_operator_entries_sent"]))
+	require.Equal(t, int64(1), sumValue(t, metrics["stanza_operator_entries_errored"], attribute.String("action", SendOnError)))
+	require.Equal(t, int64(1), sumValue(t, metrics["stanza_operator_entries_errored"], attribute.String("action", DropOnError)))
+
+	// durations are only measured with the detailed level
+	require.NotContains(t, metrics, "stanza_operator_process_duration")
+	require.NotContains(t, metrics, "stanza_operator_write_duration")
+}
+
+func TestOperatorTelemetryDetailed(t *testing.T) {
+	set, reader := newTelemetrySettings(configtelemetry.LevelDetailed)
+	cfg := NewParserConfig("test-id", "test-type")
+	parser, err := cfg.Build(set)
+	require.NoError(t, err)
+
+	e := entry.New()
+	e.Body = map[string]any{"key": "value"}
+	require.NoError(t, parser.ProcessWith(context.Background(), e, func(v any) (any, error) { return v, nil }))
+
+	metrics := collectMetrics(t, reader)
+	require.Equal(t, int64(1), sumValue(t, metrics["stanza_operator_entries_received"]))
+	for _, name := range []string{"stanza_operator_process_duration", "stanza_operator_write_duration"} {
+		histogram, ok := metrics[name].(metricdata.Histogram[float64])
+		require.True(t, ok, name)
+		require.Len(t, histogram.DataPoints, 1)
+		require.Equal(t, uint64(1), histogram.DataPoints[0].Count)
+	}
+}
+
+func TestOperatorTelemetryDisabled(t *testing.T) {
+	set, reader := newTelemetrySettings(configtelemetry.LevelNone)
+	cfg := NewTransformerConfig("test-id", "test-type")
+	transformer, err := cfg.Build(set)
+	require.NoError(t, err)
+	require.Nil(t, transformer.telemetry)
+
+	require.NoError(t, transformer.ProcessWith(context.Background(), entry.New(), func(*entry.Entry) error { return nil }))
+	require.Empty(t, collectMetrics(t, reader))
+}
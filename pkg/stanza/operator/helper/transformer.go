@@ -77,17 +77,24 @@ func (t *TransformerOperator) CanProcess() bool {
 
 // ProcessWith will process an entry with a transform function.
 func (t *TransformerOperator) ProcessWith(ctx context.Context, entry *entry.Entry, transform TransformFunction) error {
+	t.telemetry.recordReceived(ctx)
+	start := t.telemetry.start()
+
 	// Short circuit if the "if" condition does not match
 	skip, err := t.Skip(ctx, entry)
 	if err != nil {
+		t.telemetry.recordProcessed(ctx, start)
 		return t.HandleEntryError(ctx, entry, err)
 	}
 	if skip {
+		t.telemetry.recordProcessed(ctx, start)
 		t.Write(ctx, entry)
 		return nil
 	}
 
-	if err := transform(entry); err != nil {
+	err = transform(entry)
+	t.telemetry.recordProcessed(ctx, start)
+	if err != nil {
 		return t.HandleEntryError(ctx, entry, err)
 	}
 	t.Write(ctx, entry)
@@ -96,6 +103,7 @@ func (t *TransformerOperator) ProcessWith(ctx context.Context, entry *entry.Entr
 
 // HandleEntryError will handle an entry error using the on_error strategy.
 func (t *TransformerOperator) HandleEntryError(ctx context.Context, entry *entry.Entry, err error) error {
+	t.telemetry.recordErrored(ctx, t.OnError)
 	if t.OnError == SendOnErrorQuiet || t.OnError == DropOnErrorQuiet {
 		t.Debugw("Failed to process entry", zap.Any("error", err), zap.Any("action", t.OnError))
 	} else {
@@ -48,13 +48,15 @@ type WriterOperator struct {
 
 // Write will write an entry to the outputs of the operator.
 func (w *WriterOperator) Write(ctx context.Context, e *entry.Entry) {
+	start := w.telemetry.start()
 	for i, operator := range w.OutputOperators {
 		if i == len(w.OutputOperators)-1 {
 			_ = operator.Process(ctx, e)
-			return
+			break
 		}
 		_ = operator.Process(ctx, e.Copy())
 	}
+	w.telemetry.recordSent(ctx, start)
 }
 
 // CanOutput always returns true for a writer operator.
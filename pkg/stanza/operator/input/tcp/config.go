@@ -71,6 +71,12 @@ type BaseConfig struct {
 	SplitConfig      split.Config            `mapstructure:"multiline,omitempty"`
 	TrimConfig       trim.Config             `mapstructure:",squash"`
 	SplitFuncBuilder SplitFuncBuilder
+
+	// AddResourceAttributes adds the IP address of the peer as the net.peer.ip resource attribute,
+	// and its host name as net.peer.name if ResolvePeerName is set.
+	AddResourceAttributes bool                    `mapstructure:"add_resource_attributes,omitempty"`
+	ResolvePeerName       bool                    `mapstructure:"resolve_peer_name,omitempty"`
+	RateLimit             *helper.RateLimitConfig `mapstructure:"rate_limit,omitempty"`
 }
 
 type SplitFuncBuilder func(enc encoding.Encoding) (bufio.SplitFunc, error)
@@ -120,8 +126,15 @@ func (c Config) Build(set component.TelemetrySettings) (operator.Operator, error
 	}
 	splitFunc = trim.WithFunc(splitFunc, c.TrimConfig.Func())
 
+	var limiter *helper.PeerRateLimiter
+	if c.RateLimit != nil {
+		if limiter, err = c.RateLimit.Build(); err != nil {
+			return nil, err
+		}
+	}
+
 	var resolver *helper.IPResolver
-	if c.AddAttributes {
+	if c.AddAttributes || (c.AddResourceAttributes && c.ResolvePeerName) {
 		resolver = helper.NewIPResolver()
 	}
 
@@ -137,6 +150,10 @@ func (c Config) Build(set component.TelemetrySettings) (operator.Operator, error
 			Max: 3 * time.Second,
 		},
 		resolver: resolver,
+
+		addResourceAttributes: c.AddResourceAttributes,
+		resolvePeerName:       c.ResolvePeerName,
+		limiter:               limiter,
 	}
 
 	if c.TLS != nil {
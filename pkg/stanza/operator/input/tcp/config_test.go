@@ -9,6 +9,7 @@ import (
 
 	"go.opentelemetry.io/collector/config/configtls"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/helper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/operatortest"
 )
 
@@ -43,6 +44,21 @@ func TestUnmarshal(t *testing.T) {
 					return cfg
 				}(),
 			},
+			{
+				Name:      "peer_resource_and_rate_limit",
+				ExpectErr: false,
+				Expect: func() *Config {
+					cfg := NewConfig()
+					cfg.ListenAddress = "10.0.0.1:9000"
+					cfg.AddResourceAttributes = true
+					cfg.ResolvePeerName = true
+					cfg.RateLimit = &helper.RateLimitConfig{
+						BytesPerSecond: 1024 * 1024,
+						Burst:          4 * 1024 * 1024,
+					}
+					return cfg
+				}(),
+			},
 		},
 	}.Run(t)
 }
@@ -33,6 +33,10 @@ type Input struct {
 	addAttributes   bool
 	OneLogPerPacket bool
 
+	addResourceAttributes bool
+	resolvePeerName       bool
+	limiter               *helper.PeerRateLimiter
+
 	listener net.Listener
 	cancel   context.CancelFunc
 	wg       sync.WaitGroup
@@ -129,10 +133,15 @@ func (i *Input) goHandleMessages(ctx context.Context, conn net.Conn, cancel cont
 		defer i.wg.Done()
 		defer cancel()
 
+		var reader io.Reader = conn
+		if i.limiter != nil {
+			reader = &rateLimitedReader{ctx: ctx, reader: conn, limiter: i.limiter, peer: peerIP(conn)}
+		}
+
 		dec := decode.New(i.encoding)
 		if i.OneLogPerPacket {
 			var buf bytes.Buffer
-			_, err := io.Copy(&buf, conn)
+			_, err := io.Copy(&buf, reader)
 			if err != nil {
 				i.Errorw("IO copy net connection buffer error", zap.Error(err))
 			}
@@ -143,7 +152,7 @@ func (i *Input) goHandleMessages(ctx context.Context, conn net.Conn, cancel cont
 
 		buf := make([]byte, 0, i.MaxLogSize)
 
-		scanner := bufio.NewScanner(conn)
+		scanner := bufio.NewScanner(reader)
 		scanner.Buffer(buf, i.MaxLogSize)
 
 		scanner.Split(i.splitFunc)
@@ -188,9 +197,46 @@ func (i *Input) handleMessage(ctx context.Context, conn net.Conn, dec *decode.De
 		}
 	}
 
+	if i.addResourceAttributes {
+		if addr, ok := conn.RemoteAddr().(*net.TCPAddr); ok {
+			ip := addr.IP.String()
+			entry.AddResourceKey("net.peer.ip", ip)
+			if i.resolvePeerName {
+				entry.AddResourceKey("net.peer.name", i.resolver.GetHostFromIP(ip))
+			}
+		}
+	}
+
 	i.Write(ctx, entry)
 }
 
+// peerIP returns the IP address of the remote end of a connection, which identifies the peer for rate limiting.
+func peerIP(conn net.Conn) string {
+	if addr, ok := conn.RemoteAddr().(*net.TCPAddr); ok {
+		return addr.IP.String()
+	}
+	return conn.RemoteAddr().String()
+}
+
+// rateLimitedReader waits for the rate limit of the peer after each read, so a peer sending
+// more than its limit is slowed down by TCP flow control instead of having its data dropped.
+type rateLimitedReader struct {
+	ctx     context.Context
+	reader  io.Reader
+	limiter *helper.PeerRateLimiter
+	peer    string
+}
+
+func (r *rateLimitedReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	if n > 0 {
+		if waitErr := r.limiter.Wait(r.ctx, r.peer, n); waitErr != nil && err == nil {
+			err = waitErr
+		}
+	}
+	return n, err
+}
+
 func truncateMaxLog(data []byte, maxLogSize int) (token []byte) {
 	if len(data) >= maxLogSize {
 		return data[:maxLogSize]
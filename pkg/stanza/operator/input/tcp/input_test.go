@@ -19,6 +19,7 @@ import (
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/helper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/testutil"
 )
 
@@ -368,6 +369,48 @@ func TestTCPInputAattributes(t *testing.T) {
 	t.Run("CarriageReturn", tcpInputAttributesTest([]byte("message\r\n"), []string{"message"}))
 }
 
+func TestTCPInputResourceAttributes(t *testing.T) {
+	cfg := NewConfigWithID("test_id")
+	cfg.ListenAddress = ":0"
+	cfg.AddResourceAttributes = true
+	cfg.RateLimit = &helper.RateLimitConfig{BytesPerSecond: 1024}
+
+	op, err := cfg.Build(componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+
+	mockOutput := testutil.Operator{}
+	tcpInput := op.(*Input)
+	tcpInput.InputOperator.OutputOperators = []operator.Operator{&mockOutput}
+
+	entryChan := make(chan *entry.Entry, 1)
+	mockOutput.On("Process", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		entryChan <- args.Get(1).(*entry.Entry)
+	}).Return(nil)
+
+	require.NoError(t, tcpInput.Start(testutil.NewUnscopedMockPersister()))
+	defer func() {
+		require.NoError(t, tcpInput.Stop(), "expected to stop tcp input operator without error")
+	}()
+
+	conn, err := net.Dial("tcp", tcpInput.listener.Addr().String())
+	require.NoError(t, err)
+	defer conn.Close()
+
+	_, err = conn.Write([]byte("message\n"))
+	require.NoError(t, err)
+
+	select {
+	case entry := <-entryChan:
+		require.Equal(t, "message", entry.Body)
+		require.Equal(t, map[string]any{
+			"net.peer.ip": conn.LocalAddr().(*net.TCPAddr).IP.String(),
+		}, entry.Resource)
+		require.Nil(t, entry.Attributes)
+	case <-time.After(time.Second):
+		require.FailNow(t, "Timed out waiting for message to be written")
+	}
+}
+
 func TestTLSTCPInput(t *testing.T) {
 	t.Run("Simple", tlsInputTest([]byte("message\n"), []string{"message"}))
 	t.Run("CarriageReturn", tlsInputTest([]byte("message\r\n"), []string{"message"}))
@@ -7,6 +7,7 @@ import (
 	"path/filepath"
 	"testing"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/helper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/operatortest"
 )
 
@@ -51,6 +52,19 @@ func TestUnmarshal(t *testing.T) {
 					return cfg
 				}(),
 			},
+			{
+				Name:      "peer_resource_and_rate_limit",
+				ExpectErr: false,
+				Expect: func() *Config {
+					cfg := NewConfig()
+					cfg.ListenAddress = "10.0.0.1:9000"
+					cfg.AddResourceAttributes = true
+					cfg.RateLimit = &helper.RateLimitConfig{
+						BytesPerSecond: 64 * 1024,
+					}
+					return cfg
+				}(),
+			},
 		},
 	}.Run(t)
 }
@@ -29,6 +29,10 @@ type Input struct {
 	OneLogPerPacket bool
 	AsyncConfig     *AsyncConfig
 
+	addResourceAttributes bool
+	resolvePeerName       bool
+	limiter               *helper.PeerRateLimiter
+
 	connection net.PacketConn
 	cancel     context.CancelFunc
 	wg         sync.WaitGroup
@@ -108,6 +112,12 @@ func (i *Input) readAndProcessMessages(ctx context.Context) {
 }
 
 func (i *Input) processMessage(ctx context.Context, message []byte, remoteAddr net.Addr, dec *decode.Decoder, scannerBuffer []byte) {
+	if i.limiter != nil && !i.limiter.Allow(peerIP(remoteAddr), len(message)) {
+		// datagrams can't be slowed down, so the ones over the rate limit of the peer are dropped
+		i.Debugw("Dropping message over the rate limit", zap.String("peer", remoteAddr.String()), zap.Int("bytes", len(message)))
+		return
+	}
+
 	if i.OneLogPerPacket {
 		log := truncateMaxLog(message)
 		i.handleMessage(ctx, remoteAddr, dec, log)
@@ -220,9 +230,27 @@ func (i *Input) handleMessage(ctx context.Context, remoteAddr net.Addr, dec *dec
 		}
 	}
 
+	if i.addResourceAttributes {
+		if addr, ok := remoteAddr.(*net.UDPAddr); ok {
+			ip := addr.IP.String()
+			entry.AddResourceKey("net.peer.ip", ip)
+			if i.resolvePeerName {
+				entry.AddResourceKey("net.peer.name", i.resolver.GetHostFromIP(ip))
+			}
+		}
+	}
+
 	i.Write(ctx, entry)
 }
 
+// peerIP returns the IP address of a remote address, which identifies the peer for rate limiting.
+func peerIP(addr net.Addr) string {
+	if udpAddr, ok := addr.(*net.UDPAddr); ok {
+		return udpAddr.IP.String()
+	}
+	return addr.String()
+}
+
 // readMessage will read log messages from the connection.
 func (i *Input) readMessage(buffer []byte) ([]byte, net.Addr, int, error) {
 	n, addr, err := i.connection.ReadFrom(buffer)
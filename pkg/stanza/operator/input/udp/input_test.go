@@ -16,6 +16,7 @@ import (
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/helper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/testutil"
 )
 
@@ -161,6 +162,57 @@ func TestInputAttributes(t *testing.T) {
 	t.Run("NewlineInMessage", udpInputAttributesTest([]byte("message1\nmessage2\n"), []string{"message1\nmessage2"}))
 }
 
+func TestInputRateLimit(t *testing.T) {
+	cfg := NewConfigWithID("test_input")
+	cfg.ListenAddress = ":0"
+	cfg.AddResourceAttributes = true
+	cfg.RateLimit = &helper.RateLimitConfig{BytesPerSecond: 10}
+
+	op, err := cfg.Build(componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+
+	mockOutput := testutil.Operator{}
+	udpInput, ok := op.(*Input)
+	require.True(t, ok)
+	udpInput.InputOperator.OutputOperators = []operator.Operator{&mockOutput}
+
+	entryChan := make(chan *entry.Entry, 2)
+	mockOutput.On("Process", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		entryChan <- args.Get(1).(*entry.Entry)
+	}).Return(nil)
+
+	require.NoError(t, udpInput.Start(testutil.NewUnscopedMockPersister()))
+	defer func() {
+		require.NoError(t, udpInput.Stop(), "expected to stop udp input operator without error")
+	}()
+
+	conn, err := net.Dial("udp", udpInput.connection.LocalAddr().String())
+	require.NoError(t, err)
+	defer conn.Close()
+
+	// the second message exceeds the rate limit of the peer and is dropped
+	_, err = conn.Write([]byte("message1"))
+	require.NoError(t, err)
+	_, err = conn.Write([]byte("message2"))
+	require.NoError(t, err)
+
+	select {
+	case entry := <-entryChan:
+		require.Equal(t, "message1", entry.Body)
+		require.Equal(t, map[string]any{
+			"net.peer.ip": conn.LocalAddr().(*net.UDPAddr).IP.String(),
+		}, entry.Resource)
+	case <-time.After(time.Second):
+		require.FailNow(t, "Timed out waiting for message to be written")
+	}
+
+	select {
+	case entry := <-entryChan:
+		require.FailNow(t, "Unexpected entry: %s", entry)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
 func TestFailToBind(t *testing.T) {
 	ip := "localhost"
 	port := 0
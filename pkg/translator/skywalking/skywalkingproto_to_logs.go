@@ -0,0 +1,102 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package skywalking // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/skywalking"
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	conventions "go.opentelemetry.io/collector/semconv/v1.8.0"
+	logging "skywalking.apache.org/repo/goapi/collect/logging/v3"
+)
+
+const (
+	AttributeSkywalkingEndpoint = "sw8.endpoint"
+	AttributeSkywalkingLayer    = "sw8.layer"
+
+	// levelTag is the tag holding the level of the logs sent by the agents.
+	levelTag = "level"
+)
+
+var severityNumbers = map[string]plog.SeverityNumber{
+	"TRACE":   plog.SeverityNumberTrace,
+	"DEBUG":   plog.SeverityNumberDebug,
+	"INFO":    plog.SeverityNumberInfo,
+	"WARN":    plog.SeverityNumberWarn,
+	"WARNING": plog.SeverityNumberWarn,
+	"ERROR":   plog.SeverityNumberError,
+	"FATAL":   plog.SeverityNumberFatal,
+}
+
+// ProtoToLogs converts a skywalking log to internal logs
+func ProtoToLogs(logData *logging.LogData) plog.Logs {
+	logs := plog.NewLogs()
+	if logData == nil {
+		return logs
+	}
+
+	resourceLogs := logs.ResourceLogs().AppendEmpty()
+	attrs := resourceLogs.Resource().Attributes()
+	attrs.PutStr(conventions.AttributeServiceName, logData.GetService())
+	if logData.GetServiceInstance() != "" {
+		attrs.PutStr(conventions.AttributeServiceInstanceID, logData.GetServiceInstance())
+	}
+	if logData.GetLayer() != "" {
+		attrs.PutStr(AttributeSkywalkingLayer, logData.GetLayer())
+	}
+
+	swLogToLogRecord(logData, resourceLogs.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty())
+	return logs
+}
+
+func swLogToLogRecord(logData *logging.LogData, dest plog.LogRecord) {
+	// the timestamp of the logs is optional, skywalking uses the time they are received instead
+	if logData.GetTimestamp() > 0 {
+		dest.SetTimestamp(microsecondsToTimestamp(logData.GetTimestamp()))
+	}
+	dest.SetObservedTimestamp(pcommon.NewTimestampFromTime(time.Now()))
+
+	attrs := dest.Attributes()
+	swKvPairsToInternalAttributes(logData.GetTags().GetData(), attrs)
+	if level, ok := attrs.Get(levelTag); ok {
+		dest.SetSeverityText(level.Str())
+		dest.SetSeverityNumber(severityNumbers[strings.ToUpper(level.Str())])
+	}
+	if logData.GetEndpoint() != "" {
+		attrs.PutStr(AttributeSkywalkingEndpoint, logData.GetEndpoint())
+	}
+
+	if traceContext := logData.GetTraceContext(); traceContext != nil {
+		// the same ids as the spans of ProtoToTraces, to correlate the logs with them
+		dest.SetTraceID(swTraceIDToTraceID(traceContext.GetTraceId()))
+		dest.SetSpanID(segmentIDToSpanID(traceContext.GetTraceSegmentId(), uint32(traceContext.GetSpanId())))
+		attrs.PutStr(AttributeSkywalkingTraceID, traceContext.GetTraceId())
+		attrs.PutStr(AttributeSkywalkingSegmentID, traceContext.GetTraceSegmentId())
+		attrs.PutInt(AttributeSkywalkingSpanID, int64(traceContext.GetSpanId()))
+	}
+
+	swLogBodyToBody(logData.GetBody(), dest.Body())
+}
+
+func swLogBodyToBody(body *logging.LogDataBody, dest pcommon.Value) {
+	switch content := body.GetContent().(type) {
+	case *logging.LogDataBody_Text:
+		dest.SetStr(content.Text.GetText())
+	case *logging.LogDataBody_Json:
+		// keep the structure of the json objects, the other documents are kept as they are
+		var raw map[string]any
+		if err := json.Unmarshal([]byte(content.Json.GetJson()), &raw); err != nil || raw == nil {
+			dest.SetStr(content.Json.GetJson())
+			return
+		}
+		if err := dest.SetEmptyMap().FromRaw(raw); err != nil {
+			dest.SetStr(content.Json.GetJson())
+		}
+	case *logging.LogDataBody_Yaml:
+		dest.SetStr(content.Yaml.GetYaml())
+	}
+}
@@ -0,0 +1,116 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package skywalking
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	common "skywalking.apache.org/repo/goapi/collect/common/v3"
+	logging "skywalking.apache.org/repo/goapi/collect/logging/v3"
+)
+
+func TestProtoToLogs(t *testing.T) {
+	logData := &logging.LogData{
+		Timestamp:       1656347429643,
+		Service:         "demo-service",
+		ServiceInstance: "demo-instance",
+		Endpoint:        "/api/orders",
+		Layer:           "GENERAL",
+		Body: &logging.LogDataBody{
+			Content: &logging.LogDataBody_Text{Text: &logging.TextLog{Text: "order created"}},
+		},
+		TraceContext: &logging.TraceContext{
+			TraceId:        "56a5e1c519ae4c76a2b8b11d92cead7f.12.16563474296430001",
+			TraceSegmentId: "56a5e1c519ae4c76a2b8b11d92cead7f.12.16563474296430000",
+			SpanId:         1,
+		},
+		Tags: &logging.LogTags{
+			Data: []*common.KeyStringValuePair{
+				{Key: "level", Value: "warn"},
+				{Key: "logger", Value: "OrderService"},
+			},
+		},
+	}
+
+	logs := ProtoToLogs(logData)
+	require.Equal(t, 1, logs.LogRecordCount())
+	rl := logs.ResourceLogs().At(0)
+	assert.Equal(t, map[string]any{
+		"service.name":        "demo-service",
+		"service.instance.id": "demo-instance",
+		"sw8.layer":           "GENERAL",
+	}, rl.Resource().Attributes().AsRaw())
+
+	lr := rl.ScopeLogs().At(0).LogRecords().At(0)
+	assert.Equal(t, microsecondsToTimestamp(1656347429643), lr.Timestamp())
+	assert.NotZero(t, lr.ObservedTimestamp())
+	assert.Equal(t, "order created", lr.Body().Str())
+	assert.Equal(t, "warn", lr.SeverityText())
+	assert.Equal(t, plog.SeverityNumberWarn, lr.SeverityNumber())
+	// the logs share the ids of the spans they were emitted in
+	assert.Equal(t, swTraceIDToTraceID(logData.TraceContext.TraceId), lr.TraceID())
+	assert.Equal(t, segmentIDToSpanID(logData.TraceContext.TraceSegmentId, 1), lr.SpanID())
+	assert.Equal(t, map[string]any{
+		"level":          "warn",
+		"logger":         "OrderService",
+		"sw8.endpoint":   "/api/orders",
+		"sw8.trace_id":   "56a5e1c519ae4c76a2b8b11d92cead7f.12.16563474296430001",
+		"sw8.segment_id": "56a5e1c519ae4c76a2b8b11d92cead7f.12.16563474296430000",
+		"sw8.span_id":    int64(1),
+	}, lr.Attributes().AsRaw())
+}
+
+func TestProtoToLogsWithoutOptionalFields(t *testing.T) {
+	logs := ProtoToLogs(&logging.LogData{Service: "demo-service"})
+	rl := logs.ResourceLogs().At(0)
+	assert.Equal(t, map[string]any{"service.name": "demo-service"}, rl.Resource().Attributes().AsRaw())
+	lr := rl.ScopeLogs().At(0).LogRecords().At(0)
+	assert.Zero(t, lr.Timestamp())
+	assert.True(t, lr.TraceID().IsEmpty())
+	assert.Equal(t, plog.SeverityNumberUnspecified, lr.SeverityNumber())
+	assert.Equal(t, pcommon.ValueTypeEmpty, lr.Body().Type())
+	assert.Equal(t, 0, lr.Attributes().Len())
+
+	assert.Equal(t, 0, ProtoToLogs(nil).ResourceLogs().Len())
+}
+
+func TestSwLogBodyToBody(t *testing.T) {
+	tests := []struct {
+		name string
+		body *logging.LogDataBody
+		want any
+	}{
+		{
+			name: "json object",
+			body: &logging.LogDataBody{Content: &logging.LogDataBody_Json{Json: &logging.JSONLog{Json: `{"message":"order created","count":2}`}}},
+			want: map[string]any{"message": "order created", "count": 2.0},
+		},
+		{
+			name: "json array",
+			body: &logging.LogDataBody{Content: &logging.LogDataBody_Json{Json: &logging.JSONLog{Json: `["order created"]`}}},
+			want: `["order created"]`,
+		},
+		{
+			name: "invalid json",
+			body: &logging.LogDataBody{Content: &logging.LogDataBody_Json{Json: &logging.JSONLog{Json: `{"message"`}}},
+			want: `{"message"`,
+		},
+		{
+			name: "yaml",
+			body: &logging.LogDataBody{Content: &logging.LogDataBody_Yaml{Yaml: &logging.YAMLLog{Yaml: "message: order created"}}},
+			want: "message: order created",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body := pcommon.NewValueEmpty()
+			swLogBodyToBody(tt.body, body)
+			assert.Equal(t, tt.want, body.AsRaw())
+		})
+	}
+}
@@ -197,6 +197,16 @@ func TestLoadConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "rename_pattern"),
+			expected: &Config{
+				Settings: attraction.Settings{
+					Actions: []attraction.ActionKeyValue{
+						{RegexPattern: "^legacy\\.(.*)$", Replacement: "$1", Action: attraction.RENAMEPATTERN},
+					},
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
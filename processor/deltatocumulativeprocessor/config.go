@@ -15,6 +15,11 @@ var _ component.ConfigValidator = (*Config)(nil)
 type Config struct {
 	MaxStale   time.Duration `mapstructure:"max_stale"`
 	MaxStreams int           `mapstructure:"max_streams"`
+
+	// Storage is the ID of a storage extension holding the accumulated
+	// streams, instead of memory. Sharing it between collectors lets them
+	// accumulate the same streams behind a load balancer.
+	Storage *component.ID `mapstructure:"storage"`
 }
 
 func (c *Config) Validate() error {
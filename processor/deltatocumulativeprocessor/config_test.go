@@ -22,6 +22,8 @@ func TestLoadConfig(t *testing.T) {
 	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
 	require.NoError(t, err)
 
+	storageID := component.MustNewIDWithName("redis", "shared")
+
 	tests := []struct {
 		id       component.ID
 		expected component.Config
@@ -47,6 +49,14 @@ func TestLoadConfig(t *testing.T) {
 				MaxStreams: 20,
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "set-valid-storage"),
+			expected: &Config{
+				MaxStale:   5 * time.Minute,
+				MaxStreams: 0,
+				Storage:    &storageID,
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,89 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package data // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/deltatocumulativeprocessor/internal/data"
+
+import (
+	"errors"
+
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+// Codec converts datapoints to and from bytes, so they can be held outside of
+// the process
+type Codec[D Point[D]] interface {
+	Marshal(D) ([]byte, error)
+	Unmarshal([]byte) (D, error)
+}
+
+var errNoPoint = errors.New("encoded metrics hold no datapoint")
+
+var (
+	marshaler   = &pmetric.ProtoMarshaler{}
+	unmarshaler = &pmetric.ProtoUnmarshaler{}
+)
+
+// NumberCodec encodes a Number as the only datapoint of protobuf encoded metrics
+type NumberCodec struct{}
+
+func (NumberCodec) Marshal(dp Number) ([]byte, error) {
+	md := pmetric.NewMetrics()
+	dps := emptyMetric(md).SetEmptySum().DataPoints()
+	dp.CopyTo(Number{NumberDataPoint: dps.AppendEmpty()})
+	return marshaler.MarshalMetrics(md)
+}
+
+func (NumberCodec) Unmarshal(buf []byte) (Number, error) {
+	md, err := unmarshaler.UnmarshalMetrics(buf)
+	if err != nil {
+		return Number{}, err
+	}
+	m, ok := onlyMetric(md)
+	if !ok || m.Type() != pmetric.MetricTypeSum || m.Sum().DataPoints().Len() != 1 {
+		return Number{}, errNoPoint
+	}
+	return Number{NumberDataPoint: m.Sum().DataPoints().At(0)}, nil
+}
+
+// ExpHistogramCodec encodes an ExpHistogram as the only datapoint of protobuf
+// encoded metrics
+type ExpHistogramCodec struct{}
+
+func (ExpHistogramCodec) Marshal(dp ExpHistogram) ([]byte, error) {
+	md := pmetric.NewMetrics()
+	dps := emptyMetric(md).SetEmptyExponentialHistogram().DataPoints()
+	dp.CopyTo(ExpHistogram{DataPoint: dps.AppendEmpty()})
+	return marshaler.MarshalMetrics(md)
+}
+
+func (ExpHistogramCodec) Unmarshal(buf []byte) (ExpHistogram, error) {
+	md, err := unmarshaler.UnmarshalMetrics(buf)
+	if err != nil {
+		return ExpHistogram{}, err
+	}
+	m, ok := onlyMetric(md)
+	if !ok || m.Type() != pmetric.MetricTypeExponentialHistogram || m.ExponentialHistogram().DataPoints().Len() != 1 {
+		return ExpHistogram{}, errNoPoint
+	}
+	return ExpHistogram{DataPoint: m.ExponentialHistogram().DataPoints().At(0)}, nil
+}
+
+func emptyMetric(md pmetric.Metrics) pmetric.Metric {
+	return md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+}
+
+func onlyMetric(md pmetric.Metrics) (pmetric.Metric, bool) {
+	if md.ResourceMetrics().Len() != 1 {
+		return pmetric.Metric{}, false
+	}
+	sms := md.ResourceMetrics().At(0).ScopeMetrics()
+	if sms.Len() != 1 || sms.At(0).Metrics().Len() != 1 {
+		return pmetric.Metric{}, false
+	}
+	return sms.At(0).Metrics().At(0), true
+}
+
+var (
+	_ Codec[Number]       = NumberCodec{}
+	_ Codec[ExpHistogram] = ExpHistogramCodec{}
+)
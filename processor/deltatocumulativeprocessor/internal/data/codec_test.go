@@ -0,0 +1,46 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package data
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+func TestNumberCodec(t *testing.T) {
+	dp := Number{NumberDataPoint: pmetric.NewNumberDataPoint()}
+	dp.SetStartTimestamp(10)
+	dp.SetTimestamp(20)
+	dp.SetDoubleValue(4.2)
+	dp.Attributes().PutStr("key", "value")
+
+	buf, err := NumberCodec{}.Marshal(dp)
+	require.NoError(t, err)
+	got, err := NumberCodec{}.Unmarshal(buf)
+	require.NoError(t, err)
+	require.Equal(t, dp.NumberDataPoint, got.NumberDataPoint)
+
+	// a histogram is not a number
+	buf, err = ExpHistogramCodec{}.Marshal(ExpHistogram{DataPoint: pmetric.NewExponentialHistogramDataPoint()})
+	require.NoError(t, err)
+	_, err = NumberCodec{}.Unmarshal(buf)
+	require.ErrorIs(t, err, errNoPoint)
+}
+
+func TestExpHistogramCodec(t *testing.T) {
+	dp := ExpHistogram{DataPoint: pmetric.NewExponentialHistogramDataPoint()}
+	dp.SetTimestamp(20)
+	dp.SetScale(2)
+	dp.SetCount(3)
+	dp.Positive().SetOffset(1)
+	dp.Positive().BucketCounts().FromRaw([]uint64{1, 2})
+
+	buf, err := ExpHistogramCodec{}.Marshal(dp)
+	require.NoError(t, err)
+	got, err := ExpHistogramCodec{}.Unmarshal(buf)
+	require.NoError(t, err)
+	require.Equal(t, dp.DataPoint, got.DataPoint)
+}
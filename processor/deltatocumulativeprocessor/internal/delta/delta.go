@@ -25,8 +25,18 @@ type Accumulator[D data.Point[D]] struct {
 	streams.Map[D]
 }
 
+// tryLoader is implemented by the maps that can fail to load a stream for
+// another reason than it being absent, e.g. streams.ExternalMap.
+type tryLoader[D any] interface {
+	TryLoad(streams.Ident) (D, bool, error)
+}
+
 func (a Accumulator[D]) Store(id streams.Ident, dp D) error {
-	aggr, ok := a.Map.Load(id)
+	aggr, ok, err := a.load(id)
+	if err != nil {
+		// not restarting the stream from the sample, which would overwrite it
+		return err
+	}
 
 	// new series: initialize with current sample
 	if !ok {
@@ -57,6 +67,14 @@ func (a Accumulator[D]) Store(id streams.Ident, dp D) error {
 	return gap
 }
 
+func (a Accumulator[D]) load(id streams.Ident) (D, bool, error) {
+	if l, ok := a.Map.(tryLoader[D]); ok {
+		return l.TryLoad(id)
+	}
+	aggr, ok := a.Map.Load(id)
+	return aggr, ok, nil
+}
+
 type ErrOlderStart struct {
 	Start  pcommon.Timestamp
 	Sample pcommon.Timestamp
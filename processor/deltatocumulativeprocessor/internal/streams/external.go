@@ -0,0 +1,171 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package streams // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/deltatocumulativeprocessor/internal/streams"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"go.opentelemetry.io/collector/extension/experimental/storage"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/exp/metrics/identity"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/deltatocumulativeprocessor/internal/data"
+)
+
+var (
+	errNoStorage = errors.New("the storage extension has not been started")
+
+	// ErrNotStored is returned by ExternalMap.Get for the streams absent
+	// from the storage.
+	ErrNotStored = errors.New("stream not found in storage")
+)
+
+func External[D data.Point[D]](codec data.Codec[D]) *ExternalMap[D] {
+	return &ExternalMap[D]{
+		Codec:  codec,
+		Logger: zap.NewNop(),
+		ctx:    context.Background(),
+		seen:   make(map[identity.Stream]pcommon.Timestamp),
+	}
+}
+
+var _ Map[data.Number] = (*ExternalMap[data.Number])(nil)
+
+// ExternalMap keeps the datapoints of each stream in a storage extension
+// instead of memory. When that storage is shared by several collectors,
+// every collector continues a stream from where the others left off.
+//
+// Only the streams seen by this collector are tracked locally, so Len, Items
+// and Clear only cover those. Delete leaves streams in storage that another
+// collector has written to since.
+type ExternalMap[D data.Point[D]] struct {
+	// Client may only be set once the storage extension is started. Until
+	// then, all streams are considered absent and storing fails.
+	Client storage.Client
+	Codec  data.Codec[D]
+	// Logger reports the streams failing to load, which Load considers
+	// absent.
+	Logger *zap.Logger
+
+	// ctx is the context of the storage calls, the methods of Map taking
+	// none. See SetContext.
+	ctx context.Context
+
+	// seen keeps the timestamp of the last datapoint stored by this
+	// collector for each stream
+	seen map[identity.Stream]pcommon.Timestamp
+}
+
+// SetContext sets the context of the storage calls made by the methods of
+// Map, e.g. to the context of the batch being aggregated.
+func (m *ExternalMap[D]) SetContext(ctx context.Context) {
+	m.ctx = ctx
+}
+
+// Get returns the datapoint of the stream, or ErrNotStored if the storage
+// has none. Other errors are storage or unmarshaling failures.
+func (m *ExternalMap[D]) Get(ctx context.Context, id identity.Stream) (D, error) {
+	var zero D
+	if m.Client == nil {
+		return zero, errNoStorage
+	}
+	buf, err := m.Client.Get(ctx, key(id))
+	if err != nil {
+		return zero, fmt.Errorf("failed to read the stream from storage: %w", err)
+	}
+	if buf == nil {
+		return zero, ErrNotStored
+	}
+	dp, err := m.Codec.Unmarshal(buf)
+	if err != nil {
+		return zero, fmt.Errorf("failed to unmarshal the stream: %w", err)
+	}
+	return dp, nil
+}
+
+// TryLoad is Load, returning the failures to load the stream apart from it
+// being absent.
+func (m *ExternalMap[D]) TryLoad(id identity.Stream) (D, bool, error) {
+	dp, err := m.Get(m.ctx, id)
+	switch {
+	case err == nil:
+		return dp, true, nil
+	case errors.Is(err, ErrNotStored), errors.Is(err, errNoStorage):
+		return dp, false, nil
+	default:
+		return dp, false, err
+	}
+}
+
+func (m *ExternalMap[D]) Load(id identity.Stream) (D, bool) {
+	dp, ok, err := m.TryLoad(id)
+	if err != nil {
+		m.Logger.Warn("failed to load stream, considering it absent", zap.Stringer("stream", id), zap.Error(err))
+	}
+	return dp, ok
+}
+
+func (m *ExternalMap[D]) Store(id identity.Stream, dp D) error {
+	if m.Client == nil {
+		return errNoStorage
+	}
+	buf, err := m.Codec.Marshal(dp)
+	if err != nil {
+		return err
+	}
+	if err := m.Client.Set(m.ctx, key(id), buf); err != nil {
+		return err
+	}
+	m.seen[id] = dp.Timestamp()
+	return nil
+}
+
+func (m *ExternalMap[D]) Delete(id identity.Stream) {
+	last, ok := m.seen[id]
+	if !ok {
+		return
+	}
+	delete(m.seen, id)
+
+	// another collector took over the stream, it is not stale
+	if dp, ok := m.Load(id); ok && dp.Timestamp() > last {
+		return
+	}
+	if m.Client != nil {
+		if err := m.Client.Delete(m.ctx, key(id)); err != nil {
+			m.Logger.Warn("failed to delete stream from storage", zap.Stringer("stream", id), zap.Error(err))
+		}
+	}
+}
+
+func (m *ExternalMap[D]) Items() func(yield func(identity.Stream, D) bool) bool {
+	return func(yield func(identity.Stream, D) bool) bool {
+		for id := range m.seen {
+			dp, ok := m.Load(id)
+			if !ok {
+				continue
+			}
+			if !yield(id, dp) {
+				break
+			}
+		}
+		return false
+	}
+}
+
+func (m *ExternalMap[D]) Len() int {
+	return len(m.seen)
+}
+
+func (m *ExternalMap[D]) Clear() {
+	clear(m.seen)
+}
+
+func key(id identity.Stream) string {
+	return strconv.FormatUint(id.Hash().Sum64(), 16)
+}
@@ -0,0 +1,189 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package streams_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/deltatocumulativeprocessor/internal/data"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/deltatocumulativeprocessor/internal/delta"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/deltatocumulativeprocessor/internal/streams"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/deltatocumulativeprocessor/internal/testdata/random"
+)
+
+func TestExternal(t *testing.T) {
+	client := make(memClient)
+
+	// two collectors sharing the same storage
+	extA := streams.External[data.Number](data.NumberCodec{})
+	extA.Client = client
+	extB := streams.External[data.Number](data.NumberCodec{})
+	extB.Client = client
+	accA := delta.Accumulator[data.Number]{Map: extA}
+	accB := delta.Accumulator[data.Number]{Map: extB}
+
+	id, dp := random.Sum().Stream()
+	dp.SetStartTimestamp(ts(10))
+
+	dp.SetTimestamp(ts(20))
+	dp.SetIntValue(1)
+	require.NoError(t, accA.Store(id, dp))
+
+	// the second collector continues the stream of the first one
+	dp.SetTimestamp(ts(30))
+	dp.SetIntValue(2)
+	require.NoError(t, accB.Store(id, dp))
+
+	got, ok := extA.Load(id)
+	require.True(t, ok)
+	require.Equal(t, int64(3), got.IntValue())
+	require.Equal(t, ts(30), got.Timestamp())
+
+	// samples already accumulated by the other collector are rejected
+	dp.SetTimestamp(ts(25))
+	require.ErrorAs(t, accA.Store(id, dp), &delta.ErrOutOfOrder{})
+
+	require.Equal(t, 1, extA.Len())
+	require.Equal(t, 1, extB.Len())
+
+	// the stream was written by the other collector since, so it is kept
+	extA.Delete(id)
+	require.Equal(t, 0, extA.Len())
+	_, ok = extB.Load(id)
+	require.True(t, ok)
+
+	extB.Delete(id)
+	require.Equal(t, 0, extB.Len())
+	_, ok = extA.Load(id)
+	require.False(t, ok)
+}
+
+func TestExternalNotStarted(t *testing.T) {
+	ext := streams.External[data.Number](data.NumberCodec{})
+
+	id, dp := random.Sum().Stream()
+	_, ok := ext.Load(id)
+	require.False(t, ok)
+	require.Error(t, ext.Store(id, dp))
+}
+
+func TestExternalLoadErrors(t *testing.T) {
+	client := &failingClient{memClient: make(memClient)}
+	ext := streams.External[data.Number](data.NumberCodec{})
+	ext.Client = client
+	acc := delta.Accumulator[data.Number]{Map: ext}
+
+	id, dp := random.Sum().Stream()
+	_, err := ext.Get(context.Background(), id)
+	require.ErrorIs(t, err, streams.ErrNotStored)
+
+	dp.SetTimestamp(ts(20))
+	require.NoError(t, acc.Store(id, dp))
+
+	// the storage failures are not mistaken for an absent stream, which
+	// would restart it from the sample
+	client.err = errors.New("storage unavailable")
+	_, err = ext.Get(context.Background(), id)
+	require.ErrorIs(t, err, client.err)
+	require.NotErrorIs(t, err, streams.ErrNotStored)
+	dp.SetTimestamp(ts(30))
+	require.ErrorIs(t, acc.Store(id, dp), client.err)
+	_, ok := ext.Load(id)
+	require.False(t, ok)
+
+	client.err = nil
+	got, ok := ext.Load(id)
+	require.True(t, ok)
+	require.Equal(t, ts(20), got.Timestamp())
+
+	client.memClient[client.lastKey] = []byte("not a datapoint")
+	_, err = ext.Get(context.Background(), id)
+	require.ErrorContains(t, err, "failed to unmarshal the stream")
+	require.NotErrorIs(t, err, streams.ErrNotStored)
+}
+
+func TestExternalContext(t *testing.T) {
+	client := &failingClient{memClient: make(memClient)}
+	ext := streams.External[data.Number](data.NumberCodec{})
+	ext.Client = client
+
+	type key struct{}
+	ctx := context.WithValue(context.Background(), key{}, "batch")
+	ext.SetContext(ctx)
+
+	id, dp := random.Sum().Stream()
+	require.NoError(t, ext.Store(id, dp))
+	require.Equal(t, "batch", client.lastCtx.Value(key{}))
+	_, ok := ext.Load(id)
+	require.True(t, ok)
+	require.Equal(t, "batch", client.lastCtx.Value(key{}))
+}
+
+func ts(n int64) pcommon.Timestamp {
+	return pcommon.Timestamp(n)
+}
+
+var _ storage.Client = memClient(nil)
+
+type memClient map[string][]byte
+
+func (c memClient) Get(_ context.Context, key string) ([]byte, error) {
+	return c[key], nil
+}
+
+func (c memClient) Set(_ context.Context, key string, value []byte) error {
+	c[key] = value
+	return nil
+}
+
+func (c memClient) Delete(_ context.Context, key string) error {
+	delete(c, key)
+	return nil
+}
+
+func (c memClient) Batch(ctx context.Context, ops ...storage.Operation) error {
+	for _, op := range ops {
+		switch op.Type {
+		case storage.Get:
+			op.Value, _ = c.Get(ctx, op.Key)
+		case storage.Set:
+			_ = c.Set(ctx, op.Key, op.Value)
+		case storage.Delete:
+			_ = c.Delete(ctx, op.Key)
+		}
+	}
+	return nil
+}
+
+func (c memClient) Close(context.Context) error {
+	return nil
+}
+
+// failingClient records the context and the key of the last call, and fails
+// the reads with err if not nil.
+type failingClient struct {
+	memClient
+	err     error
+	lastCtx context.Context
+	lastKey string
+}
+
+func (c *failingClient) Get(ctx context.Context, key string) ([]byte, error) {
+	c.lastCtx, c.lastKey = ctx, key
+	if c.err != nil {
+		return nil, c.err
+	}
+	return c.memClient.Get(ctx, key)
+}
+
+func (c *failingClient) Set(ctx context.Context, key string, value []byte) error {
+	c.lastCtx, c.lastKey = ctx, key
+	return c.memClient.Set(ctx, key, value)
+}
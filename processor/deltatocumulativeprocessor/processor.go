@@ -6,11 +6,13 @@ package deltatocumulativeprocessor // import "github.com/open-telemetry/opentele
 import (
 	"context"
 	"errors"
+	"fmt"
 	"sync"
 	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/processor"
 	"go.opentelemetry.io/otel/metric"
@@ -29,6 +31,7 @@ var _ processor.Metrics = (*Processor)(nil)
 
 type Processor struct {
 	next consumer.Metrics
+	id   component.ID
 
 	log    *zap.Logger
 	ctx    context.Context
@@ -37,10 +40,13 @@ type Processor struct {
 	sums Pipeline[data.Number]
 	expo Pipeline[data.ExpHistogram]
 
+	storageID *component.ID
+	storage   storage.Client
+
 	mtx sync.Mutex
 }
 
-func newProcessor(cfg *Config, log *zap.Logger, meter metric.Meter, next consumer.Metrics) *Processor {
+func newProcessor(cfg *Config, id component.ID, log *zap.Logger, meter metric.Meter, next consumer.Metrics) *Processor {
 	ctx, cancel := context.WithCancel(context.Background())
 
 	tel := telemetry.New(meter)
@@ -50,24 +56,34 @@ func newProcessor(cfg *Config, log *zap.Logger, meter metric.Meter, next consume
 		ctx:    ctx,
 		cancel: cancel,
 		next:   next,
+		id:     id,
+
+		sums: pipeline[data.Number](cfg, &tel, data.NumberCodec{}),
+		expo: pipeline[data.ExpHistogram](cfg, &tel, data.ExpHistogramCodec{}),
 
-		sums: pipeline[data.Number](cfg, &tel),
-		expo: pipeline[data.ExpHistogram](cfg, &tel),
+		storageID: cfg.Storage,
 	}
 
 	return &proc
 }
 
 type Pipeline[D data.Point[D]] struct {
-	aggr  streams.Aggregator[D]
-	stale maybe.Ptr[staleness.Staleness[D]]
+	aggr     streams.Aggregator[D]
+	stale    maybe.Ptr[staleness.Staleness[D]]
+	external maybe.Ptr[streams.ExternalMap[D]]
 }
 
-func pipeline[D data.Point[D]](cfg *Config, tel *telemetry.Telemetry) Pipeline[D] {
+func pipeline[D data.Point[D]](cfg *Config, tel *telemetry.Telemetry, codec data.Codec[D]) Pipeline[D] {
 	var pipe Pipeline[D]
 
 	var dps streams.Map[D]
-	dps = delta.New[D]()
+	acc := delta.New[D]()
+	if cfg.Storage != nil {
+		external := streams.External(codec)
+		pipe.external = maybe.Some(external)
+		acc.Map = external
+	}
+	dps = acc
 	dps = telemetry.ObserveItems(dps, &tel.Metrics)
 
 	if cfg.MaxStale > 0 {
@@ -91,7 +107,31 @@ func pipeline[D data.Point[D]](cfg *Config, tel *telemetry.Telemetry) Pipeline[D
 	return pipe
 }
 
-func (p *Processor) Start(_ context.Context, _ component.Host) error {
+// setContext sets the context of the calls to the storage of the streams, if
+// any.
+func (p Pipeline[D]) setContext(ctx context.Context) {
+	if external, ok := p.external.Try(); ok {
+		external.SetContext(ctx)
+	}
+}
+
+func (p *Processor) Start(ctx context.Context, host component.Host) error {
+	if p.storageID != nil {
+		client, err := storageClient(ctx, host, *p.storageID, p.id)
+		if err != nil {
+			return err
+		}
+		p.storage = client
+		if sums, ok := p.sums.external.Try(); ok {
+			sums.Client = client
+			sums.Logger = p.log
+		}
+		if expo, ok := p.expo.external.Try(); ok {
+			expo.Client = client
+			expo.Logger = p.log
+		}
+	}
+
 	sums, sok := p.sums.stale.Try()
 	expo, eok := p.expo.stale.Try()
 	if !(sok && eok) {
@@ -106,6 +146,8 @@ func (p *Processor) Start(_ context.Context, _ component.Host) error {
 				return
 			case <-tick.C:
 				p.mtx.Lock()
+				p.sums.setContext(p.ctx)
+				p.expo.setContext(p.ctx)
 				sums.ExpireOldEntries()
 				expo.ExpireOldEntries()
 				p.mtx.Unlock()
@@ -115,11 +157,26 @@ func (p *Processor) Start(_ context.Context, _ component.Host) error {
 	return nil
 }
 
-func (p *Processor) Shutdown(_ context.Context) error {
+func (p *Processor) Shutdown(ctx context.Context) error {
 	p.cancel()
+	if p.storage != nil {
+		return p.storage.Close(ctx)
+	}
 	return nil
 }
 
+func storageClient(ctx context.Context, host component.Host, storageID component.ID, id component.ID) (storage.Client, error) {
+	ext, ok := host.GetExtensions()[storageID]
+	if !ok {
+		return nil, fmt.Errorf("storage extension %q not found", storageID)
+	}
+	se, ok := ext.(storage.Extension)
+	if !ok {
+		return nil, fmt.Errorf("extension %q is not a storage extension", storageID)
+	}
+	return se.GetClient(ctx, component.KindProcessor, id, "")
+}
+
 func (p *Processor) Capabilities() consumer.Capabilities {
 	return consumer.Capabilities{MutatesData: true}
 }
@@ -131,6 +188,8 @@ func (p *Processor) ConsumeMetrics(ctx context.Context, md pmetric.Metrics) erro
 
 	p.mtx.Lock()
 	defer p.mtx.Unlock()
+	p.sums.setContext(ctx)
+	p.expo.setContext(ctx)
 
 	var errs error
 	metrics.Each(md, func(m metrics.Metric) {
@@ -6,6 +6,7 @@ package filterprocessor
 import (
 	"context"
 	"fmt"
+	"math"
 	"testing"
 	"time"
 
@@ -815,6 +816,42 @@ func TestFilterMetricProcessorWithOTTL(t *testing.T) {
 	}
 }
 
+func TestFilterMetricProcessorDataPointValues(t *testing.T) {
+	now := time.Now()
+	md := pmetric.NewMetrics()
+	dps := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty().SetEmptyGauge().DataPoints()
+	addPoint := func(value float64, age time.Duration) {
+		dp := dps.AppendEmpty()
+		dp.SetDoubleValue(value)
+		dp.SetTimestamp(pcommon.NewTimestampFromTime(now.Add(-age)))
+	}
+	addPoint(math.NaN(), 0)
+	addPoint(1e15, 0)
+	addPoint(2*time.Hour.Seconds(), 2*time.Hour)
+	addPoint(42, time.Minute)
+	addPoint(1e11, 0)
+
+	processor, err := newFilterMetricProcessor(processortest.NewNopCreateSettings(), &Config{
+		Metrics: MetricFilters{
+			DataPointConditions: []string{
+				`IsNaN(value_double)`,
+				`value_double > 1000000000000.0`,
+				`time < Now() - Duration("1h")`,
+			},
+		},
+		ErrorMode: ottl.PropagateError,
+	})
+	require.NoError(t, err)
+
+	got, err := processor.processMetrics(context.Background(), md)
+	require.NoError(t, err)
+	gotDps := got.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Gauge().DataPoints()
+	// the OTTL floats require a decimal point, 1e12 not being parsed
+	require.Equal(t, 2, gotDps.Len())
+	assert.Equal(t, float64(42), gotDps.At(0).DoubleValue())
+	assert.Equal(t, 1e11, gotDps.At(1).DoubleValue())
+}
+
 func constructMetrics() pmetric.Metrics {
 	td := pmetric.NewMetrics()
 	rm0 := td.ResourceMetrics().AppendEmpty()
@@ -0,0 +1,157 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8slookupprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/k8slookupprocessor"
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
+)
+
+// SourceKind is the kind of the Kubernetes object holding the entries of a source.
+type SourceKind string
+
+const (
+	// SourceKindConfigMap reads the entries from the data of a ConfigMap.
+	SourceKindConfigMap SourceKind = "configmap"
+	// SourceKindSecret reads the entries from the decoded data of a Secret.
+	SourceKindSecret SourceKind = "secret"
+)
+
+// LookupContext is the part of the telemetry whose attributes are enriched by a lookup.
+type LookupContext string
+
+const (
+	// LookupContextResource enriches the attributes of the resources.
+	LookupContextResource LookupContext = "resource"
+	// LookupContextAttributes enriches the attributes of the spans, the data points and the log
+	// records.
+	LookupContextAttributes LookupContext = "attributes"
+)
+
+const defaultSyncTimeout = 10 * time.Second
+
+// Config defines the configuration of the Kubernetes lookup processor.
+type Config struct {
+	k8sconfig.APIConfig `mapstructure:",squash"`
+
+	// Sources are the ConfigMaps and the Secrets holding the lookup entries, by name.
+	Sources map[string]SourceConfig `mapstructure:"sources"`
+
+	// Lookups set the attributes from the entries of the sources, in order.
+	Lookups []LookupConfig `mapstructure:"lookups"`
+
+	// SyncTimeout is how long the start of the processor waits for the sources to be listed, not
+	// waiting when 0. The telemetry received before is forwarded without enrichment.
+	SyncTimeout time.Duration `mapstructure:"sync_timeout"`
+
+	// For mocking purposes only.
+	makeClient func() (kubernetes.Interface, error)
+}
+
+// SourceConfig identifies the Kubernetes object holding the entries of a source.
+type SourceConfig struct {
+	Kind      SourceKind `mapstructure:"kind"`
+	Namespace string     `mapstructure:"namespace"`
+	Name      string     `mapstructure:"name"`
+	// Key is the data key holding the entries as a YAML or JSON object, the entries being the data
+	// of the object when empty.
+	Key string `mapstructure:"key"`
+}
+
+// LookupConfig sets an attribute to the entry of a source for the value of another attribute.
+type LookupConfig struct {
+	// Source is the name of the source of the entries.
+	Source string `mapstructure:"source"`
+	// Context is the part of the telemetry whose attributes are enriched, `resource` by default.
+	Context LookupContext `mapstructure:"context"`
+	// KeyAttribute is the attribute whose value is looked up in the entries.
+	KeyAttribute string `mapstructure:"key_attribute"`
+	// TargetAttribute is the attribute set to the entry found.
+	TargetAttribute string `mapstructure:"target_attribute"`
+	// Default is the value of the target attribute when the entries have no entry for the key,
+	// leaving the attribute unset when empty.
+	Default string `mapstructure:"default"`
+	// Overwrite replaces the existing values of the target attribute.
+	Overwrite bool `mapstructure:"overwrite"`
+}
+
+func (cfg *Config) Validate() error {
+	var errs []error
+	if err := cfg.APIConfig.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+	if len(cfg.Sources) == 0 {
+		errs = append(errs, errors.New("'sources' cannot be empty"))
+	}
+	names := make([]string, 0, len(cfg.Sources))
+	for name := range cfg.Sources {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if err := cfg.Sources[name].validate(); err != nil {
+			errs = append(errs, fmt.Errorf("sources::%s: %w", name, err))
+		}
+	}
+	if len(cfg.Lookups) == 0 {
+		errs = append(errs, errors.New("'lookups' cannot be empty"))
+	}
+	for i, lookup := range cfg.Lookups {
+		if _, found := cfg.Sources[lookup.Source]; !found {
+			errs = append(errs, fmt.Errorf("lookups[%d]: unknown source %q", i, lookup.Source))
+		}
+		if err := lookup.validate(); err != nil {
+			errs = append(errs, fmt.Errorf("lookups[%d]: %w", i, err))
+		}
+	}
+	if cfg.SyncTimeout < 0 {
+		errs = append(errs, errors.New("'sync_timeout' cannot be negative"))
+	}
+	return errors.Join(errs...)
+}
+
+func (cfg SourceConfig) validate() error {
+	var errs []error
+	switch cfg.Kind {
+	case SourceKindConfigMap, SourceKindSecret:
+	default:
+		errs = append(errs, fmt.Errorf("unsupported kind %q", cfg.Kind))
+	}
+	if cfg.Namespace == "" {
+		errs = append(errs, errors.New("'namespace' cannot be empty"))
+	}
+	if cfg.Name == "" {
+		errs = append(errs, errors.New("'name' cannot be empty"))
+	}
+	return errors.Join(errs...)
+}
+
+func (cfg LookupConfig) validate() error {
+	var errs []error
+	switch cfg.Context {
+	case "", LookupContextResource, LookupContextAttributes:
+	default:
+		errs = append(errs, fmt.Errorf("unsupported context %q", cfg.Context))
+	}
+	if cfg.KeyAttribute == "" {
+		errs = append(errs, errors.New("'key_attribute' cannot be empty"))
+	}
+	if cfg.TargetAttribute == "" {
+		errs = append(errs, errors.New("'target_attribute' cannot be empty"))
+	}
+	return errors.Join(errs...)
+}
+
+func (cfg *Config) getClient() (kubernetes.Interface, error) {
+	if cfg.makeClient != nil {
+		return cfg.makeClient()
+	}
+	return k8sconfig.MakeClient(cfg.APIConfig)
+}
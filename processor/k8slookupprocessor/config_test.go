@@ -0,0 +1,77 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8slookupprocessor
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/k8slookupprocessor/internal/metadata"
+)
+
+func TestLoadConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		id          component.ID
+		expected    component.Config
+		expectedErr string
+	}{
+		{
+			id: component.NewID(metadata.Type),
+			expected: &Config{
+				APIConfig: k8sconfig.APIConfig{AuthType: k8sconfig.AuthTypeKubeConfig},
+				Sources: map[string]SourceConfig{
+					"owners": {Kind: SourceKindConfigMap, Namespace: "observability", Name: "service-owners"},
+					"oncall": {Kind: SourceKindSecret, Namespace: "observability", Name: "oncall", Key: "rotations.yaml"},
+				},
+				Lookups: []LookupConfig{
+					{Source: "owners", KeyAttribute: "service.name", TargetAttribute: "team", Default: "unowned"},
+					{Source: "oncall", Context: LookupContextAttributes, KeyAttribute: "team", TargetAttribute: "oncall", Overwrite: true},
+				},
+				SyncTimeout: 5 * time.Second,
+			},
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "empty_sources"),
+			expectedErr: "'sources' cannot be empty\nlookups[0]: unknown source \"owners\"",
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "invalid_source"),
+			expectedErr: "sources::owners: unsupported kind \"deployment\"\n'namespace' cannot be empty",
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "invalid_lookup"),
+			expectedErr: "lookups[0]: unknown source \"teams\"\nlookups[0]: unsupported context \"span\"\n'key_attribute' cannot be empty",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+			require.NoError(t, err)
+
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, component.UnmarshalConfig(sub, cfg))
+
+			if tt.expectedErr != "" {
+				assert.EqualError(t, component.ValidateConfig(cfg), tt.expectedErr)
+				return
+			}
+			assert.NoError(t, component.ValidateConfig(cfg))
+			assert.Equal(t, tt.expected, cfg)
+		})
+	}
+}
@@ -0,0 +1,8 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:generate mdatagen metadata.yaml
+
+// Package k8slookupprocessor enriches the attributes of the telemetry with the values looked up
+// in Kubernetes ConfigMaps and Secrets, watched for changes.
+package k8slookupprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/k8slookupprocessor"
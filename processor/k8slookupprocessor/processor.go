@@ -0,0 +1,189 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8slookupprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/k8slookupprocessor"
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
+	"k8s.io/client-go/tools/cache"
+)
+
+// lookup is a lookup of the config with its source.
+type lookup struct {
+	cfg    LookupConfig
+	source *lookupSource
+}
+
+// apply sets the target attribute to the entry of the value of the key attribute.
+func (l lookup) apply(attrs pcommon.Map) {
+	key, found := attrs.Get(l.cfg.KeyAttribute)
+	if !found {
+		return
+	}
+	if _, exists := attrs.Get(l.cfg.TargetAttribute); exists && !l.cfg.Overwrite {
+		return
+	}
+	value, found := l.source.lookup(key.AsString())
+	if !found {
+		if l.cfg.Default == "" {
+			return
+		}
+		value = l.cfg.Default
+	}
+	attrs.PutStr(l.cfg.TargetAttribute, value)
+}
+
+type lookupProcessor struct {
+	cfg              *Config
+	logger           *zap.Logger
+	resourceLookups  []lookup
+	attributeLookups []lookup
+	sources          []*lookupSource
+	stopCh           chan struct{}
+}
+
+func newLookupProcessor(cfg *Config, logger *zap.Logger) *lookupProcessor {
+	return &lookupProcessor{cfg: cfg, logger: logger}
+}
+
+func (p *lookupProcessor) start(ctx context.Context, _ component.Host) error {
+	client, err := p.cfg.getClient()
+	if err != nil {
+		return fmt.Errorf("error creating the Kubernetes client: %w", err)
+	}
+	sources := make(map[string]*lookupSource, len(p.cfg.Sources))
+	for name, sourceCfg := range p.cfg.Sources {
+		source := newLookupSource(client, name, sourceCfg, p.logger)
+		sources[name] = source
+		p.sources = append(p.sources, source)
+	}
+	for _, lookupCfg := range p.cfg.Lookups {
+		l := lookup{cfg: lookupCfg, source: sources[lookupCfg.Source]}
+		if lookupCfg.Context == LookupContextAttributes {
+			p.attributeLookups = append(p.attributeLookups, l)
+		} else {
+			p.resourceLookups = append(p.resourceLookups, l)
+		}
+	}
+
+	p.stopCh = make(chan struct{})
+	synced := make([]cache.InformerSynced, 0, len(p.sources))
+	for _, source := range p.sources {
+		go source.informer.Run(p.stopCh)
+		synced = append(synced, source.informer.HasSynced)
+	}
+	if p.cfg.SyncTimeout == 0 {
+		return nil
+	}
+	syncCtx, cancel := context.WithTimeout(ctx, p.cfg.SyncTimeout)
+	defer cancel()
+	if !cache.WaitForCacheSync(syncCtx.Done(), synced...) {
+		p.logger.Warn("The lookup sources were not listed in time, the telemetry is not enriched until they are", zap.Duration("sync_timeout", p.cfg.SyncTimeout))
+	}
+	return nil
+}
+
+func (p *lookupProcessor) shutdown(context.Context) error {
+	if p.stopCh != nil {
+		close(p.stopCh)
+		p.stopCh = nil
+	}
+	return nil
+}
+
+func applyLookups(lookups []lookup, attrs pcommon.Map) {
+	for _, l := range lookups {
+		l.apply(attrs)
+	}
+}
+
+func (p *lookupProcessor) processTraces(_ context.Context, td ptrace.Traces) (ptrace.Traces, error) {
+	for i := 0; i < td.ResourceSpans().Len(); i++ {
+		rs := td.ResourceSpans().At(i)
+		applyLookups(p.resourceLookups, rs.Resource().Attributes())
+		if len(p.attributeLookups) == 0 {
+			continue
+		}
+		for j := 0; j < rs.ScopeSpans().Len(); j++ {
+			spans := rs.ScopeSpans().At(j).Spans()
+			for k := 0; k < spans.Len(); k++ {
+				applyLookups(p.attributeLookups, spans.At(k).Attributes())
+			}
+		}
+	}
+	return td, nil
+}
+
+func (p *lookupProcessor) processMetrics(_ context.Context, md pmetric.Metrics) (pmetric.Metrics, error) {
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		rm := md.ResourceMetrics().At(i)
+		applyLookups(p.resourceLookups, rm.Resource().Attributes())
+		if len(p.attributeLookups) == 0 {
+			continue
+		}
+		for j := 0; j < rm.ScopeMetrics().Len(); j++ {
+			metrics := rm.ScopeMetrics().At(j).Metrics()
+			for k := 0; k < metrics.Len(); k++ {
+				p.processDataPoints(metrics.At(k))
+			}
+		}
+	}
+	return md, nil
+}
+
+func (p *lookupProcessor) processDataPoints(metric pmetric.Metric) {
+	//exhaustive:enforce
+	switch metric.Type() {
+	case pmetric.MetricTypeGauge:
+		dps := metric.Gauge().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			applyLookups(p.attributeLookups, dps.At(i).Attributes())
+		}
+	case pmetric.MetricTypeSum:
+		dps := metric.Sum().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			applyLookups(p.attributeLookups, dps.At(i).Attributes())
+		}
+	case pmetric.MetricTypeHistogram:
+		dps := metric.Histogram().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			applyLookups(p.attributeLookups, dps.At(i).Attributes())
+		}
+	case pmetric.MetricTypeExponentialHistogram:
+		dps := metric.ExponentialHistogram().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			applyLookups(p.attributeLookups, dps.At(i).Attributes())
+		}
+	case pmetric.MetricTypeSummary:
+		dps := metric.Summary().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			applyLookups(p.attributeLookups, dps.At(i).Attributes())
+		}
+	case pmetric.MetricTypeEmpty:
+	}
+}
+
+func (p *lookupProcessor) processLogs(_ context.Context, ld plog.Logs) (plog.Logs, error) {
+	for i := 0; i < ld.ResourceLogs().Len(); i++ {
+		rl := ld.ResourceLogs().At(i)
+		applyLookups(p.resourceLookups, rl.Resource().Attributes())
+		if len(p.attributeLookups) == 0 {
+			continue
+		}
+		for j := 0; j < rl.ScopeLogs().Len(); j++ {
+			logs := rl.ScopeLogs().At(j).LogRecords()
+			for k := 0; k < logs.Len(); k++ {
+				applyLookups(p.attributeLookups, logs.At(k).Attributes())
+			}
+		}
+	}
+	return ld, nil
+}
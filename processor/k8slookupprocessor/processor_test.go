@@ -0,0 +1,166 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8slookupprocessor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
+)
+
+func newTestProcessor(t *testing.T, client kubernetes.Interface) *lookupProcessor {
+	cfg := &Config{
+		APIConfig: k8sconfig.APIConfig{AuthType: k8sconfig.AuthTypeServiceAccount},
+		Sources: map[string]SourceConfig{
+			"owners": {Kind: SourceKindConfigMap, Namespace: "observability", Name: "service-owners"},
+			"oncall": {Kind: SourceKindSecret, Namespace: "observability", Name: "oncall", Key: "rotations.yaml"},
+		},
+		Lookups: []LookupConfig{
+			{Source: "owners", KeyAttribute: "service.name", TargetAttribute: "team", Default: "unowned"},
+			{Source: "oncall", Context: LookupContextAttributes, KeyAttribute: "team", TargetAttribute: "oncall", Overwrite: true},
+		},
+		SyncTimeout: 10 * time.Second,
+		makeClient:  func() (kubernetes.Interface, error) { return client, nil },
+	}
+	require.NoError(t, cfg.Validate())
+	p := newLookupProcessor(cfg, zap.NewNop())
+	require.NoError(t, p.start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() { require.NoError(t, p.shutdown(context.Background())) })
+	return p
+}
+
+func serviceOwners(data map[string]string) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "observability", Name: "service-owners"},
+		Data:       data,
+	}
+}
+
+func TestProcessTraces(t *testing.T) {
+	client := fake.NewSimpleClientset(
+		serviceOwners(map[string]string{"checkout": "payments", "cart": "storefront"}),
+		// another ConfigMap of the namespace is ignored
+		&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: "observability", Name: "other"}, Data: map[string]string{"checkout": "other"}},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "observability", Name: "oncall"},
+			Data:       map[string][]byte{"rotations.yaml": []byte("payments: alice\nstorefront: bob\n")},
+		},
+	)
+	p := newTestProcessor(t, client)
+
+	td := ptrace.NewTraces()
+	for _, service := range []string{"checkout", "cart", "search"} {
+		rs := td.ResourceSpans().AppendEmpty()
+		rs.Resource().Attributes().PutStr("service.name", service)
+		span := rs.ScopeSpans().AppendEmpty().Spans().AppendEmpty()
+		span.Attributes().PutStr("team", "payments")
+		span.Attributes().PutStr("oncall", "carol")
+	}
+	td.ResourceSpans().At(1).Resource().Attributes().PutStr("team", "web")
+
+	_, err := p.processTraces(context.Background(), td)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"service.name": "checkout", "team": "payments"}, td.ResourceSpans().At(0).Resource().Attributes().AsRaw())
+	// the existing attributes are kept without overwrite
+	assert.Equal(t, map[string]any{"service.name": "cart", "team": "web"}, td.ResourceSpans().At(1).Resource().Attributes().AsRaw())
+	assert.Equal(t, map[string]any{"service.name": "search", "team": "unowned"}, td.ResourceSpans().At(2).Resource().Attributes().AsRaw())
+	for i := 0; i < td.ResourceSpans().Len(); i++ {
+		assert.Equal(t, map[string]any{"team": "payments", "oncall": "alice"}, td.ResourceSpans().At(i).ScopeSpans().At(0).Spans().At(0).Attributes().AsRaw())
+	}
+}
+
+func TestSourceUpdates(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	p := newTestProcessor(t, client)
+	configMaps := client.CoreV1().ConfigMaps("observability")
+
+	resourceTeam := func() string {
+		ld := plog.NewLogs()
+		attrs := ld.ResourceLogs().AppendEmpty().Resource().Attributes()
+		attrs.PutStr("service.name", "checkout")
+		_, err := p.processLogs(context.Background(), ld)
+		require.NoError(t, err)
+		team, _ := attrs.Get("team")
+		return team.Str()
+	}
+	assert.Equal(t, "unowned", resourceTeam())
+
+	_, err := configMaps.Create(context.Background(), serviceOwners(map[string]string{"checkout": "payments"}), metav1.CreateOptions{})
+	require.NoError(t, err)
+	assert.Eventually(t, func() bool { return resourceTeam() == "payments" }, 10*time.Second, 10*time.Millisecond)
+
+	_, err = configMaps.Update(context.Background(), serviceOwners(map[string]string{"checkout": "billing"}), metav1.UpdateOptions{})
+	require.NoError(t, err)
+	assert.Eventually(t, func() bool { return resourceTeam() == "billing" }, 10*time.Second, 10*time.Millisecond)
+
+	require.NoError(t, configMaps.Delete(context.Background(), "service-owners", metav1.DeleteOptions{}))
+	assert.Eventually(t, func() bool { return resourceTeam() == "unowned" }, 10*time.Second, 10*time.Millisecond)
+}
+
+func TestProcessMetrics(t *testing.T) {
+	client := fake.NewSimpleClientset(
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "observability", Name: "oncall"},
+			Data:       map[string][]byte{"rotations.yaml": []byte(`{"payments": "alice"}`)},
+		},
+	)
+	p := newTestProcessor(t, client)
+
+	md := pmetric.NewMetrics()
+	metrics := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics()
+	gauge := metrics.AppendEmpty().SetEmptyGauge().DataPoints().AppendEmpty()
+	gauge.Attributes().PutStr("team", "payments")
+	sum := metrics.AppendEmpty().SetEmptySum().DataPoints().AppendEmpty()
+	sum.Attributes().PutStr("team", "payments")
+	histogram := metrics.AppendEmpty().SetEmptyHistogram().DataPoints().AppendEmpty()
+	histogram.Attributes().PutStr("team", "payments")
+	exponential := metrics.AppendEmpty().SetEmptyExponentialHistogram().DataPoints().AppendEmpty()
+	exponential.Attributes().PutStr("team", "payments")
+	summary := metrics.AppendEmpty().SetEmptySummary().DataPoints().AppendEmpty()
+	summary.Attributes().PutStr("team", "storefront")
+	metrics.AppendEmpty()
+
+	_, err := p.processMetrics(context.Background(), md)
+	require.NoError(t, err)
+	for _, attrs := range []pcommon.Map{gauge.Attributes(), sum.Attributes(), histogram.Attributes(), exponential.Attributes()} {
+		assert.Equal(t, map[string]any{"team": "payments", "oncall": "alice"}, attrs.AsRaw())
+	}
+	// the lookups without a default leave the unknown keys unset
+	assert.Equal(t, map[string]any{"team": "storefront"}, summary.Attributes().AsRaw())
+}
+
+func TestParseEntries(t *testing.T) {
+	source := &lookupSource{cfg: SourceConfig{Key: "owners.yaml"}}
+	entries, err := source.parseEntries(map[string]string{"owners.yaml": "checkout: payments\n"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"checkout": "payments"}, entries)
+
+	_, err = source.parseEntries(map[string]string{"owners.json": "{}"})
+	assert.EqualError(t, err, `key "owners.yaml" not found`)
+	_, err = source.parseEntries(map[string]string{"owners.yaml": "[checkout]"})
+	assert.ErrorContains(t, err, `key "owners.yaml": `)
+
+	// the invalid entries keep the previous ones
+	source = newLookupSource(fake.NewSimpleClientset(), "owners", SourceConfig{Kind: SourceKindConfigMap, Name: "service-owners", Key: "owners.yaml"}, zap.NewNop())
+	source.update(serviceOwners(map[string]string{"owners.yaml": "checkout: payments"}))
+	source.update(serviceOwners(map[string]string{"owners.yaml": "checkout: [payments"}))
+	value, found := source.lookup("checkout")
+	assert.True(t, found)
+	assert.Equal(t, "payments", value)
+}
@@ -0,0 +1,144 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8slookupprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/k8slookupprocessor"
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/yaml"
+)
+
+// lookupSource keeps the entries of a ConfigMap or a Secret, replacing them on each change of the
+// object.
+type lookupSource struct {
+	name     string
+	cfg      SourceConfig
+	logger   *zap.Logger
+	entries  atomic.Pointer[map[string]string]
+	informer cache.SharedInformer
+}
+
+func newLookupSource(client kubernetes.Interface, name string, cfg SourceConfig, logger *zap.Logger) *lookupSource {
+	s := &lookupSource{
+		name:   name,
+		cfg:    cfg,
+		logger: logger.With(zap.String("source", name)),
+	}
+	s.entries.Store(&map[string]string{})
+
+	selector := fields.OneTermEqualSelector("metadata.name", cfg.Name).String()
+	var listWatch *cache.ListWatch
+	var object runtime.Object
+	switch cfg.Kind {
+	case SourceKindSecret:
+		secrets := client.CoreV1().Secrets(cfg.Namespace)
+		listWatch = &cache.ListWatch{
+			ListFunc: func(opts metav1.ListOptions) (runtime.Object, error) {
+				opts.FieldSelector = selector
+				return secrets.List(context.Background(), opts)
+			},
+			WatchFunc: func(opts metav1.ListOptions) (watch.Interface, error) {
+				opts.FieldSelector = selector
+				return secrets.Watch(context.Background(), opts)
+			},
+		}
+		object = &corev1.Secret{}
+	default:
+		configMaps := client.CoreV1().ConfigMaps(cfg.Namespace)
+		listWatch = &cache.ListWatch{
+			ListFunc: func(opts metav1.ListOptions) (runtime.Object, error) {
+				opts.FieldSelector = selector
+				return configMaps.List(context.Background(), opts)
+			},
+			WatchFunc: func(opts metav1.ListOptions) (watch.Interface, error) {
+				opts.FieldSelector = selector
+				return configMaps.Watch(context.Background(), opts)
+			},
+		}
+		object = &corev1.ConfigMap{}
+	}
+	s.informer = cache.NewSharedInformer(listWatch, object, 0)
+	_, _ = s.informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    s.update,
+		UpdateFunc: func(_, obj any) { s.update(obj) },
+		DeleteFunc: s.delete,
+	})
+	return s
+}
+
+// lookup returns the entry of the key.
+func (s *lookupSource) lookup(key string) (string, bool) {
+	value, found := (*s.entries.Load())[key]
+	return value, found
+}
+
+func (s *lookupSource) update(obj any) {
+	var data map[string]string
+	switch object := obj.(type) {
+	case *corev1.ConfigMap:
+		if object.Name != s.cfg.Name {
+			return
+		}
+		data = object.Data
+	case *corev1.Secret:
+		if object.Name != s.cfg.Name {
+			return
+		}
+		data = make(map[string]string, len(object.Data))
+		for key, value := range object.Data {
+			data[key] = string(value)
+		}
+	default:
+		return
+	}
+	entries, err := s.parseEntries(data)
+	if err != nil {
+		s.logger.Error("Invalid lookup entries, keeping the previous entries", zap.Error(err))
+		return
+	}
+	s.entries.Store(&entries)
+	s.logger.Info("Updated the lookup entries", zap.Int("entries", len(entries)))
+}
+
+func (s *lookupSource) delete(obj any) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	if object, ok := obj.(metav1.Object); ok && object.GetName() != s.cfg.Name {
+		return
+	}
+	s.entries.Store(&map[string]string{})
+	s.logger.Warn("The lookup source was deleted, its entries are cleared")
+}
+
+// parseEntries returns the entries of the data of the object, decoding the YAML or JSON object
+// of the key of the source when set.
+func (s *lookupSource) parseEntries(data map[string]string) (map[string]string, error) {
+	if s.cfg.Key == "" {
+		entries := make(map[string]string, len(data))
+		for key, value := range data {
+			entries[key] = value
+		}
+		return entries, nil
+	}
+	value, found := data[s.cfg.Key]
+	if !found {
+		return nil, fmt.Errorf("key %q not found", s.cfg.Key)
+	}
+	entries := map[string]string{}
+	if err := yaml.Unmarshal([]byte(value), &entries); err != nil {
+		return nil, fmt.Errorf("key %q: %w", s.cfg.Key, err)
+	}
+	return entries, nil
+}
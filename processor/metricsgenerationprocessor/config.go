@@ -6,6 +6,8 @@ package metricsgenerationprocessor // import "github.com/open-telemetry/opentele
 import (
 	"fmt"
 	"sort"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
 )
 
 const (
@@ -26,6 +28,9 @@ const (
 
 	// operationFieldName is the mapstructure field name for Operation field
 	operationFieldName = "operation"
+
+	// constantFieldName is the mapstructure field name for Constant field
+	constantFieldName = "constant"
 )
 
 // Config defines the configuration for the processor.
@@ -33,6 +38,11 @@ type Config struct {
 
 	// Set of rules for generating new metrics
 	Rules []Rule `mapstructure:"rules"`
+
+	// ErrorMode determines how the processor reacts to errors that occur while evaluating the
+	// OTTL conditions of a rule. Valid values are `ignore` and `propagate`.
+	// The default value is `propagate`.
+	ErrorMode ottl.ErrorMode `mapstructure:"error_mode"`
 }
 
 type Rule struct {
@@ -56,6 +66,22 @@ type Rule struct {
 
 	// A constant number by which the first operand will be scaled. A required field if the type is scale.
 	ScaleBy float64 `mapstructure:"scale_by"`
+
+	// A constant number used as the second operand of the calculation instead of Metric2.
+	Constant *float64 `mapstructure:"constant"`
+
+	// OTTL conditions, in the datapoint context, selecting the data points of Metric1 used
+	// as the first operand. All data points are used if empty.
+	Metric1Conditions []string `mapstructure:"metric1_conditions"`
+
+	// OTTL conditions, in the datapoint context, selecting the data points of Metric2 used
+	// as the second operand. All data points are used if empty.
+	Metric2Conditions []string `mapstructure:"metric2_conditions"`
+
+	// AcrossResources sums the selected data points of each operand over all the resources
+	// of the batch instead of matching the operands within each resource. The new metric is
+	// added to a resource of its own holding the attributes shared by those resources.
+	AcrossResources bool `mapstructure:"across_resources"`
 }
 
 type GenerationType string
@@ -151,10 +177,14 @@ func (config *Config) Validate() error {
 			return fmt.Errorf("missing required field %q", metric1FieldName)
 		}
 
-		if rule.Type == calculate && rule.Metric2 == "" {
+		if rule.Type == calculate && rule.Metric2 == "" && rule.Constant == nil {
 			return fmt.Errorf("missing required field %q for generation type %q", metric2FieldName, calculate)
 		}
 
+		if rule.Metric2 != "" && rule.Constant != nil {
+			return fmt.Errorf("only one of %q and %q can be set", metric2FieldName, constantFieldName)
+		}
+
 		if rule.Type == scale && rule.ScaleBy <= 0 {
 			return fmt.Errorf("field %q required to be greater than 0 for generation type %q", scaleByFieldName, scale)
 		}
@@ -13,12 +13,14 @@ import (
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/confmap/confmaptest"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/metricsgenerationprocessor/internal/metadata"
 )
 
 func TestLoadConfig(t *testing.T) {
 	t.Parallel()
 
+	constant := float64(1073741824)
 	tests := []struct {
 		id           component.ID
 		expected     component.Config
@@ -27,6 +29,7 @@ func TestLoadConfig(t *testing.T) {
 		{
 			id: component.NewIDWithName(metadata.Type, ""),
 			expected: &Config{
+				ErrorMode: ottl.PropagateError,
 				Rules: []Rule{
 					{
 						Name:      "new_metric",
@@ -47,6 +50,35 @@ func TestLoadConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "conditions"),
+			expected: &Config{
+				ErrorMode: ottl.IgnoreError,
+				Rules: []Rule{
+					{
+						Name:              "cluster.cpu.utilization",
+						Unit:              "percent",
+						Type:              "calculate",
+						Metric1:           "container.cpu.usage",
+						Metric1Conditions: []string{`resource.attributes["k8s.namespace.name"] != "kube-system"`},
+						Metric2:           "k8s.node.allocatable_cpu",
+						Operation:         "percent",
+						AcrossResources:   true,
+					},
+					{
+						Name:      "container.memory.usage.ratio",
+						Type:      "calculate",
+						Metric1:   "container.memory.usage",
+						Constant:  &constant,
+						Operation: "divide",
+					},
+				},
+			},
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "metric2_and_constant"),
+			errorMessage: fmt.Sprintf("only one of %q and %q can be set", metric2FieldName, constantFieldName),
+		},
 		{
 			id:           component.NewIDWithName(metadata.Type, "missing_new_metric"),
 			errorMessage: fmt.Sprintf("missing required field %q", nameFieldName),
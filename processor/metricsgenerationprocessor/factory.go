@@ -12,6 +12,8 @@ import (
 	"go.opentelemetry.io/collector/processor"
 	"go.opentelemetry.io/collector/processor/processorhelper"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/metricsgenerationprocessor/internal/metadata"
 )
 
@@ -26,7 +28,9 @@ func NewFactory() processor.Factory {
 }
 
 func createDefaultConfig() component.Config {
-	return &Config{}
+	return &Config{
+		ErrorMode: ottl.PropagateError,
+	}
 }
 
 func createMetricsProcessor(
@@ -40,7 +44,11 @@ func createMetricsProcessor(
 		return nil, fmt.Errorf("configuration parsing error")
 	}
 
-	metricsProcessor := newMetricsGenerationProcessor(buildInternalConfig(processorConfig), set.Logger)
+	rules, err := buildInternalConfig(processorConfig, set.TelemetrySettings)
+	if err != nil {
+		return nil, err
+	}
+	metricsProcessor := newMetricsGenerationProcessor(rules, set.Logger)
 
 	return processorhelper.NewMetricsProcessor(
 		ctx,
@@ -52,20 +60,36 @@ func createMetricsProcessor(
 }
 
 // buildInternalConfig constructs the internal metric generation rules
-func buildInternalConfig(config *Config) []internalRule {
+func buildInternalConfig(config *Config, set component.TelemetrySettings) ([]internalRule, error) {
 	internalRules := make([]internalRule, len(config.Rules))
 
 	for i, rule := range config.Rules {
 		customRule := internalRule{
-			name:      rule.Name,
-			unit:      rule.Unit,
-			ruleType:  string(rule.Type),
-			metric1:   rule.Metric1,
-			metric2:   rule.Metric2,
-			operation: string(rule.Operation),
-			scaleBy:   rule.ScaleBy,
+			name:            rule.Name,
+			unit:            rule.Unit,
+			ruleType:        string(rule.Type),
+			metric1:         rule.Metric1,
+			metric2:         rule.Metric2,
+			operation:       string(rule.Operation),
+			scaleBy:         rule.ScaleBy,
+			constant:        rule.Constant,
+			acrossResources: rule.AcrossResources,
+		}
+		if len(rule.Metric1Conditions) > 0 {
+			cond, err := filterottl.NewBoolExprForDataPoint(rule.Metric1Conditions, filterottl.StandardDataPointFuncs(), config.ErrorMode, set)
+			if err != nil {
+				return nil, fmt.Errorf("rule %q: %w", rule.Name, err)
+			}
+			customRule.metric1Conditions = cond
+		}
+		if len(rule.Metric2Conditions) > 0 {
+			cond, err := filterottl.NewBoolExprForDataPoint(rule.Metric2Conditions, filterottl.StandardDataPointFuncs(), config.ErrorMode, set)
+			if err != nil {
+				return nil, fmt.Errorf("rule %q: %w", rule.Name, err)
+			}
+			customRule.metric2Conditions = cond
 		}
 		internalRules[i] = customRule
 	}
-	return internalRules
+	return internalRules, nil
 }
@@ -9,6 +9,9 @@ import (
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/expr"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottldatapoint"
 )
 
 type metricsGenerationProcessor struct {
@@ -24,6 +27,11 @@ type internalRule struct {
 	metric2   string
 	operation string
 	scaleBy   float64
+
+	constant          *float64
+	metric1Conditions expr.BoolExpr[ottldatapoint.TransformContext]
+	metric2Conditions expr.BoolExpr[ottldatapoint.TransformContext]
+	acrossResources   bool
 }
 
 func newMetricsGenerationProcessor(rules []internalRule, logger *zap.Logger) *metricsGenerationProcessor {
@@ -39,7 +47,7 @@ func (mgp *metricsGenerationProcessor) Start(context.Context, component.Host) er
 }
 
 // processMetrics implements the ProcessMetricsFunc type.
-func (mgp *metricsGenerationProcessor) processMetrics(_ context.Context, md pmetric.Metrics) (pmetric.Metrics, error) {
+func (mgp *metricsGenerationProcessor) processMetrics(ctx context.Context, md pmetric.Metrics) (pmetric.Metrics, error) {
 	resourceMetricsSlice := md.ResourceMetrics()
 
 	for i := 0; i < resourceMetricsSlice.Len(); i++ {
@@ -47,6 +55,10 @@ func (mgp *metricsGenerationProcessor) processMetrics(_ context.Context, md pmet
 		nameToMetricMap := getNameToMetricMap(rm)
 
 		for _, rule := range mgp.rules {
+			if rule.acrossResources {
+				continue
+			}
+
 			operand2 := float64(0)
 			_, ok := nameToMetricMap[rule.metric1]
 			if !ok {
@@ -54,13 +66,27 @@ func (mgp *metricsGenerationProcessor) processMetrics(_ context.Context, md pmet
 				continue
 			}
 
-			if rule.ruleType == string(calculate) {
+			if rule.ruleType == string(calculate) && rule.constant != nil {
+				operand2 = *rule.constant
+			} else if rule.ruleType == string(calculate) {
 				metric2, ok := nameToMetricMap[rule.metric2]
 				if !ok {
 					mgp.logger.Debug("Missing second metric", zap.String("metric_name", rule.metric2))
 					continue
 				}
-				operand2 = getMetricValue(metric2)
+				if rule.metric2Conditions == nil {
+					operand2 = getMetricValue(metric2)
+				} else {
+					var err error
+					operand2, ok, err = getMatchingMetricValue(ctx, rm, rule.metric2, rule.metric2Conditions)
+					if err != nil {
+						return md, err
+					}
+					if !ok {
+						mgp.logger.Debug("No data point of the second metric matches the conditions", zap.String("metric_name", rule.metric2))
+						continue
+					}
+				}
 				if operand2 <= 0 {
 					continue
 				}
@@ -68,12 +94,73 @@ func (mgp *metricsGenerationProcessor) processMetrics(_ context.Context, md pmet
 			} else if rule.ruleType == string(scale) {
 				operand2 = rule.scaleBy
 			}
-			generateMetrics(rm, operand2, rule, mgp.logger)
+			if err := generateMetrics(ctx, rm, operand2, rule, mgp.logger); err != nil {
+				return md, err
+			}
+		}
+	}
+
+	for _, rule := range mgp.rules {
+		if !rule.acrossResources {
+			continue
+		}
+		if err := mgp.generateAcrossResources(ctx, md, rule); err != nil {
+			return md, err
 		}
 	}
 	return md, nil
 }
 
+// generateAcrossResources calculates the new metric from the data points of the operands
+// summed over all the resources, and adds it to a new resource holding the attributes
+// common to the resources the data points were taken from.
+func (mgp *metricsGenerationProcessor) generateAcrossResources(ctx context.Context, md pmetric.Metrics, rule internalRule) error {
+	operand1, err := aggregateAcrossResources(ctx, md, rule.metric1, rule.metric1Conditions)
+	if err != nil {
+		return err
+	}
+	if operand1.count == 0 {
+		mgp.logger.Debug("Missing first metric", zap.String("metric_name", rule.metric1))
+		return nil
+	}
+
+	resources := operand1.resources
+	timestamp := operand1.timestamp
+	var operand2 float64
+	switch {
+	case rule.ruleType == string(scale):
+		operand2 = rule.scaleBy
+	case rule.constant != nil:
+		operand2 = *rule.constant
+	default:
+		var aggregated aggregate
+		aggregated, err = aggregateAcrossResources(ctx, md, rule.metric2, rule.metric2Conditions)
+		if err != nil {
+			return err
+		}
+		if aggregated.count == 0 {
+			mgp.logger.Debug("Missing second metric", zap.String("metric_name", rule.metric2))
+			return nil
+		}
+		if aggregated.sum <= 0 {
+			return nil
+		}
+		operand2 = aggregated.sum
+		resources = append(resources, aggregated.resources...)
+		if aggregated.timestamp > timestamp {
+			timestamp = aggregated.timestamp
+		}
+	}
+
+	rm := md.ResourceMetrics().AppendEmpty()
+	copyCommonAttributes(resources, rm.Resource().Attributes())
+	newMetric := appendMetric(rm.ScopeMetrics().AppendEmpty(), rule.name, rule.unit)
+	dp := newMetric.SetEmptyGauge().DataPoints().AppendEmpty()
+	dp.SetTimestamp(timestamp)
+	dp.SetDoubleValue(calculateValue(operand1.sum, operand2, rule.operation, mgp.logger, rule.name))
+	return nil
+}
+
 // Shutdown is invoked during service shutdown.
 func (mgp *metricsGenerationProcessor) Shutdown(context.Context) error {
 	return nil
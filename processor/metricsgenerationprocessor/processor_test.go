@@ -245,6 +245,69 @@ var (
 				metricValues: [][]float64{{100}, {0}},
 			}),
 		},
+		{
+			name: "metrics_generation_rule_calculate_constant",
+			rules: []Rule{
+				{
+					Name:      "metric_1_calculated_constant",
+					Type:      "calculate",
+					Metric1:   "metric_1",
+					Constant:  func() *float64 { c := 8.0; return &c }(),
+					Operation: "divide",
+				},
+			},
+			inMetrics: generateTestMetrics(testMetric{
+				metricNames:  []string{"metric_1", "metric_2"},
+				metricValues: [][]float64{{100}, {4}},
+			}),
+			outMetrics: generateTestMetrics(testMetric{
+				metricNames:  []string{"metric_1", "metric_2", "metric_1_calculated_constant"},
+				metricValues: [][]float64{{100}, {4}, {12.5}},
+			}),
+		},
+		{
+			name: "metrics_generation_rule_calculate_conditions",
+			rules: []Rule{
+				{
+					Name:              "metric_1_calculated_conditions",
+					Type:              "calculate",
+					Metric1:           "metric_1",
+					Metric1Conditions: []string{"value_double > 50"},
+					Metric2:           "metric_2",
+					Metric2Conditions: []string{"value_double > 2"},
+					Operation:         "divide",
+				},
+			},
+			inMetrics: generateTestMetrics(testMetric{
+				metricNames:  []string{"metric_1", "metric_2"},
+				metricValues: [][]float64{{10, 100}, {2, 4}},
+			}),
+			outMetrics: generateTestMetrics(testMetric{
+				metricNames:  []string{"metric_1", "metric_2", "metric_1_calculated_conditions"},
+				metricValues: [][]float64{{10, 100}, {2, 4}, {25}},
+			}),
+		},
+		{
+			name: "metrics_generation_rule_calculate_conditions_no_match",
+			rules: []Rule{
+				{
+					Name:              "metric_1_calculated_conditions",
+					Type:              "calculate",
+					Metric1:           "metric_1",
+					Metric1Conditions: []string{"value_double > 1000"},
+					Metric2:           "metric_2",
+					Operation:         "divide",
+				},
+			},
+			inMetrics: generateTestMetrics(testMetric{
+				metricNames:  []string{"metric_1", "metric_2"},
+				metricValues: [][]float64{{100}, {4}},
+			}),
+			outMetrics: generateTestMetrics(testMetric{
+				metricNames:  []string{"metric_1", "metric_2"},
+				metricValues: [][]float64{{100}, {4}},
+			}),
+		},
 		{
 			name: "metrics_generation_test_int_gauge_add",
 			rules: []Rule{
@@ -329,6 +392,101 @@ func TestMetricsGenerationProcessor(t *testing.T) {
 	}
 }
 
+func TestMetricsGenerationProcessorAcrossResources(t *testing.T) {
+	md := pmetric.NewMetrics()
+	for _, node := range []struct {
+		name      string
+		namespace string
+		usage     float64
+		cpu       int64
+	}{
+		{name: "node-1", namespace: "default", usage: 1.5, cpu: 4},
+		{name: "node-2", namespace: "default", usage: 0.5, cpu: 4},
+		{name: "node-3", namespace: "kube-system", usage: 2, cpu: 2},
+	} {
+		rm := md.ResourceMetrics().AppendEmpty()
+		rm.Resource().Attributes().PutStr("k8s.cluster.name", "cluster-1")
+		rm.Resource().Attributes().PutStr("k8s.node.name", node.name)
+		rm.Resource().Attributes().PutStr("k8s.namespace.name", node.namespace)
+		ms := rm.ScopeMetrics().AppendEmpty().Metrics()
+		usage := ms.AppendEmpty()
+		usage.SetName("container.cpu.usage")
+		dp := usage.SetEmptyGauge().DataPoints().AppendEmpty()
+		dp.SetTimestamp(pcommon.Timestamp(10))
+		dp.SetDoubleValue(node.usage)
+		allocatable := ms.AppendEmpty()
+		allocatable.SetName("k8s.node.allocatable_cpu")
+		dp = allocatable.SetEmptyGauge().DataPoints().AppendEmpty()
+		dp.SetTimestamp(pcommon.Timestamp(20))
+		dp.SetIntValue(node.cpu)
+	}
+
+	next := new(consumertest.MetricsSink)
+	cfg := &Config{
+		Rules: []Rule{
+			{
+				Name:              "cluster.cpu.utilization",
+				Unit:              "%",
+				Type:              "calculate",
+				Metric1:           "container.cpu.usage",
+				Metric1Conditions: []string{`resource.attributes["k8s.namespace.name"] != "kube-system"`},
+				Metric2:           "k8s.node.allocatable_cpu",
+				Operation:         "percent",
+				AcrossResources:   true,
+			},
+			{
+				Name:            "cluster.cpu.usage.millicores",
+				Type:            "scale",
+				Metric1:         "container.cpu.usage",
+				ScaleBy:         1000,
+				Operation:       "multiply",
+				AcrossResources: true,
+			},
+		},
+	}
+	mgp, err := NewFactory().CreateMetricsProcessor(context.Background(), processortest.NewNopCreateSettings(), cfg, next)
+	require.NoError(t, err)
+	require.NoError(t, mgp.ConsumeMetrics(context.Background(), md))
+
+	got := next.AllMetrics()
+	require.Len(t, got, 1)
+	require.Equal(t, 5, got[0].ResourceMetrics().Len())
+
+	// the operands are summed over all the nodes
+	rm := got[0].ResourceMetrics().At(3)
+	assert.Equal(t, map[string]any{"k8s.cluster.name": "cluster-1"}, rm.Resource().Attributes().AsRaw())
+	m := rm.ScopeMetrics().At(0).Metrics().At(0)
+	assert.Equal(t, "cluster.cpu.utilization", m.Name())
+	assert.Equal(t, "%", m.Unit())
+	require.Equal(t, 1, m.Gauge().DataPoints().Len())
+	assert.Equal(t, 20.0, m.Gauge().DataPoints().At(0).DoubleValue())
+	assert.Equal(t, pcommon.Timestamp(20), m.Gauge().DataPoints().At(0).Timestamp())
+
+	// without conditions the data points of all the nodes are used
+	rm = got[0].ResourceMetrics().At(4)
+	assert.Equal(t, map[string]any{"k8s.cluster.name": "cluster-1"}, rm.Resource().Attributes().AsRaw())
+	m = rm.ScopeMetrics().At(0).Metrics().At(0)
+	assert.Equal(t, "cluster.cpu.usage.millicores", m.Name())
+	assert.Equal(t, 4000.0, m.Gauge().DataPoints().At(0).DoubleValue())
+}
+
+func TestMetricsGenerationProcessorInvalidCondition(t *testing.T) {
+	cfg := &Config{
+		Rules: []Rule{
+			{
+				Name:              "new_metric",
+				Type:              "calculate",
+				Metric1:           "metric_1",
+				Metric1Conditions: []string{"invalid condition"},
+				Metric2:           "metric_2",
+				Operation:         "divide",
+			},
+		},
+	}
+	_, err := NewFactory().CreateMetricsProcessor(context.Background(), processortest.NewNopCreateSettings(), cfg, consumertest.NewNop())
+	assert.Error(t, err)
+}
+
 func generateTestMetrics(tm testMetric) pmetric.Metrics {
 	md := pmetric.NewMetrics()
 	now := time.Now()
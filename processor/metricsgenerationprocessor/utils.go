@@ -4,8 +4,14 @@
 package metricsgenerationprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/metricsgenerationprocessor"
 
 import (
+	"context"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/expr"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottldatapoint"
 )
 
 func getNameToMetricMap(rm pmetric.ResourceMetrics) map[string]pmetric.Metric {
@@ -40,42 +46,168 @@ func getMetricValue(metric pmetric.Metric) float64 {
 	return 0
 }
 
+// getMatchingMetricValue returns the value of the first data point of the metric with the given name
+// in the Resource Metric which matches the conditions.
+func getMatchingMetricValue(ctx context.Context, rm pmetric.ResourceMetrics, name string, conditions expr.BoolExpr[ottldatapoint.TransformContext]) (float64, bool, error) {
+	ilms := rm.ScopeMetrics()
+	for i := 0; i < ilms.Len(); i++ {
+		ilm := ilms.At(i)
+		metricSlice := ilm.Metrics()
+		for j := 0; j < metricSlice.Len(); j++ {
+			metric := metricSlice.At(j)
+			if metric.Name() != name || metric.Type() != pmetric.MetricTypeGauge {
+				continue
+			}
+			dataPoints := metric.Gauge().DataPoints()
+			for k := 0; k < dataPoints.Len(); k++ {
+				matched, err := matchDataPoint(ctx, conditions, dataPoints.At(k), metric, ilm, rm.Resource())
+				if err != nil {
+					return 0, false, err
+				}
+				if matched {
+					return getDataPointValue(dataPoints.At(k)), true, nil
+				}
+			}
+		}
+	}
+	return 0, false, nil
+}
+
+// aggregate holds the sum of the data points of a metric selected across resources.
+type aggregate struct {
+	sum       float64
+	count     int
+	timestamp pcommon.Timestamp
+	// resources the data points were taken from
+	resources []pcommon.Resource
+}
+
+// aggregateAcrossResources sums the data points of the metric with the given name matching the
+// conditions over all the resources. The timestamp of the aggregate is the latest one of those data points.
+func aggregateAcrossResources(ctx context.Context, md pmetric.Metrics, name string, conditions expr.BoolExpr[ottldatapoint.TransformContext]) (aggregate, error) {
+	var agg aggregate
+	rms := md.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		rm := rms.At(i)
+		matchedResource := false
+		ilms := rm.ScopeMetrics()
+		for j := 0; j < ilms.Len(); j++ {
+			ilm := ilms.At(j)
+			metricSlice := ilm.Metrics()
+			for k := 0; k < metricSlice.Len(); k++ {
+				metric := metricSlice.At(k)
+				if metric.Name() != name || metric.Type() != pmetric.MetricTypeGauge {
+					continue
+				}
+				dataPoints := metric.Gauge().DataPoints()
+				for l := 0; l < dataPoints.Len(); l++ {
+					dataPoint := dataPoints.At(l)
+					matched, err := matchDataPoint(ctx, conditions, dataPoint, metric, ilm, rm.Resource())
+					if err != nil {
+						return agg, err
+					}
+					if !matched {
+						continue
+					}
+					agg.sum += getDataPointValue(dataPoint)
+					agg.count++
+					if dataPoint.Timestamp() > agg.timestamp {
+						agg.timestamp = dataPoint.Timestamp()
+					}
+					matchedResource = true
+				}
+			}
+		}
+		if matchedResource {
+			agg.resources = append(agg.resources, rm.Resource())
+		}
+	}
+	return agg, nil
+}
+
+// copyCommonAttributes copies to dest the attributes having the same value in all the given resources.
+func copyCommonAttributes(resources []pcommon.Resource, dest pcommon.Map) {
+	if len(resources) == 0 {
+		return
+	}
+	resources[0].Attributes().CopyTo(dest)
+	for _, resource := range resources[1:] {
+		dest.RemoveIf(func(k string, v pcommon.Value) bool {
+			other, ok := resource.Attributes().Get(k)
+			return !ok || other.Type() != v.Type() || other.AsString() != v.AsString()
+		})
+	}
+}
+
+// matchDataPoint reports whether the data point matches the conditions. Every data point matches
+// when there are no conditions.
+func matchDataPoint(ctx context.Context, conditions expr.BoolExpr[ottldatapoint.TransformContext], dataPoint pmetric.NumberDataPoint, metric pmetric.Metric, ilm pmetric.ScopeMetrics, resource pcommon.Resource) (bool, error) {
+	if conditions == nil {
+		return true, nil
+	}
+	return conditions.Eval(ctx, ottldatapoint.NewTransformContext(dataPoint, metric, ilm.Metrics(), ilm.Scope(), resource))
+}
+
+func getDataPointValue(dataPoint pmetric.NumberDataPoint) float64 {
+	switch dataPoint.ValueType() {
+	case pmetric.NumberDataPointValueTypeDouble:
+		return dataPoint.DoubleValue()
+	case pmetric.NumberDataPointValueTypeInt:
+		return float64(dataPoint.IntValue())
+	}
+	return 0
+}
+
 // generateMetrics creates a new metric based on the given rule and add it to the Resource Metric.
 // The value for newly calculated metrics is always a floting point number and the dataType is set
-// as MetricTypeDoubleGauge.
-func generateMetrics(rm pmetric.ResourceMetrics, operand2 float64, rule internalRule, logger *zap.Logger) {
+// as MetricTypeDoubleGauge. When the rule has conditions for the first metric, only the matching
+// data points are used and no metric is created if none matches.
+func generateMetrics(ctx context.Context, rm pmetric.ResourceMetrics, operand2 float64, rule internalRule, logger *zap.Logger) error {
 	ilms := rm.ScopeMetrics()
 	for i := 0; i < ilms.Len(); i++ {
 		ilm := ilms.At(i)
 		metricSlice := ilm.Metrics()
 		for j := 0; j < metricSlice.Len(); j++ {
 			metric := metricSlice.At(j)
-			if metric.Name() == rule.metric1 {
-				newMetric := appendMetric(ilm, rule.name, rule.unit)
-				newMetric.SetEmptyGauge()
-				addDoubleGaugeDataPoints(metric, newMetric, operand2, rule.operation, logger)
+			if metric.Name() != rule.metric1 {
+				continue
+			}
+			newMetric := pmetric.NewMetric()
+			newMetric.SetName(rule.name)
+			newMetric.SetUnit(rule.unit)
+			newMetric.SetEmptyGauge()
+			err := addDoubleGaugeDataPoints(ctx, metric, newMetric, operand2, rule, ilm, rm.Resource(), logger)
+			if err != nil {
+				return err
 			}
+			if rule.metric1Conditions != nil && newMetric.Gauge().DataPoints().Len() == 0 {
+				continue
+			}
+			newMetric.MoveTo(metricSlice.AppendEmpty())
 		}
 	}
+	return nil
 }
 
-func addDoubleGaugeDataPoints(from pmetric.Metric, to pmetric.Metric, operand2 float64, operation string, logger *zap.Logger) {
+func addDoubleGaugeDataPoints(ctx context.Context, from pmetric.Metric, to pmetric.Metric, operand2 float64, rule internalRule, ilm pmetric.ScopeMetrics, resource pcommon.Resource, logger *zap.Logger) error {
 	dataPoints := from.Gauge().DataPoints()
 	for i := 0; i < dataPoints.Len(); i++ {
 		fromDataPoint := dataPoints.At(i)
-		var operand1 float64
-		switch fromDataPoint.ValueType() {
-		case pmetric.NumberDataPointValueTypeDouble:
-			operand1 = fromDataPoint.DoubleValue()
-		case pmetric.NumberDataPointValueTypeInt:
-			operand1 = float64(fromDataPoint.IntValue())
+		matched, err := matchDataPoint(ctx, rule.metric1Conditions, fromDataPoint, from, ilm, resource)
+		if err != nil {
+			return err
+		}
+		if !matched {
+			continue
 		}
+		operand1 := getDataPointValue(fromDataPoint)
 
 		neweDoubleDataPoint := to.Gauge().DataPoints().AppendEmpty()
 		fromDataPoint.CopyTo(neweDoubleDataPoint)
-		value := calculateValue(operand1, operand2, operation, logger, to.Name())
+		value := calculateValue(operand1, operand2, rule.operation, logger, to.Name())
 		neweDoubleDataPoint.SetDoubleValue(value)
 	}
+	return nil
 }
 
 func appendMetric(ilm pmetric.ScopeMetrics, name, unit string) pmetric.Metric {
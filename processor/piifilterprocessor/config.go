@@ -0,0 +1,201 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package piifilterprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/piifilterprocessor"
+
+import (
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/collector/config/configopaque"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/piifilterprocessor/internal/detector"
+)
+
+// DetectorType is the kind of a detector.
+type DetectorType string
+
+const (
+	// DetectorTypeRegex matches a regular expression, or one of the presets.
+	DetectorTypeRegex DetectorType = "regex"
+	// DetectorTypeLuhn matches the payment card numbers with a valid Luhn check digit.
+	DetectorTypeLuhn DetectorType = "luhn"
+	// DetectorTypeDictionary matches the words and the phrases of a dictionary.
+	DetectorTypeDictionary DetectorType = "dictionary"
+	// DetectorTypeEntropy matches the random looking tokens, such as the API keys.
+	DetectorTypeEntropy DetectorType = "entropy"
+)
+
+// Action is what the processor does with a detected value.
+type Action string
+
+const (
+	// ActionMask replaces the value with the mask.
+	ActionMask Action = "mask"
+	// ActionHash replaces the value with its hex encoded HMAC-SHA256, keyed with the salt.
+	ActionHash Action = "hash"
+	// ActionTokenize replaces the value with a short token prefixed by the name of the detector,
+	// the same value always getting the same token.
+	ActionTokenize Action = "tokenize"
+	// ActionRemove removes the attributes with a detected value, and the detected values from
+	// the log bodies.
+	ActionRemove Action = "remove"
+)
+
+const defaultMask = "****"
+
+func (a Action) validate() error {
+	switch a {
+	case ActionMask, ActionHash, ActionTokenize, ActionRemove:
+		return nil
+	}
+	return fmt.Errorf("unsupported action %q", a)
+}
+
+// Config defines the configuration of the PII filter processor.
+type Config struct {
+	// Detectors find the values to filter, the first detector winning when the values of several
+	// detectors overlap.
+	Detectors []DetectorConfig `mapstructure:"detectors"`
+
+	// Action is applied to the values of the detectors without an action of their own.
+	Action Action `mapstructure:"action"`
+
+	// Mask replaces the values with the mask action.
+	Mask string `mapstructure:"mask"`
+
+	// Salt is prepended to the values before computing their hashes and their tokens, so that
+	// they can't be computed from a list of known values.
+	Salt configopaque.String `mapstructure:"salt"`
+
+	// IgnoredKeys are the attribute keys which are never filtered.
+	IgnoredKeys []string `mapstructure:"ignored_keys"`
+
+	// Traces, Metrics and Logs are the parts of each signal that are filtered.
+	Traces  SignalConfig `mapstructure:"traces"`
+	Metrics SignalConfig `mapstructure:"metrics"`
+	Logs    SignalConfig `mapstructure:"logs"`
+}
+
+// DetectorConfig configures a detector.
+type DetectorConfig struct {
+	// Name identifies the detector in the tokens and the telemetry of the processor.
+	Name string       `mapstructure:"name"`
+	Type DetectorType `mapstructure:"type"`
+	// Action overrides the action of the processor for the values of the detector.
+	Action Action `mapstructure:"action"`
+
+	// Pattern is the regular expression of the regex detectors.
+	Pattern string `mapstructure:"pattern"`
+	// Preset is a predefined pattern of the regex detectors, instead of Pattern: `email`,
+	// `ipv4` or `us_ssn`.
+	Preset string `mapstructure:"preset"`
+
+	// Words are the words and the phrases of the dictionary detectors.
+	Words []string `mapstructure:"words"`
+	// CaseSensitive matches the words of the dictionary with their case.
+	CaseSensitive bool `mapstructure:"case_sensitive"`
+
+	// MinLength is the length of the shortest token of the entropy detectors.
+	MinLength int `mapstructure:"min_length"`
+	// Threshold is the entropy of the tokens of the entropy detectors, in bits per character,
+	// above which they are detected.
+	Threshold float64 `mapstructure:"threshold"`
+}
+
+// SignalConfig selects the parts of the telemetry of a signal that are filtered.
+type SignalConfig struct {
+	// Attributes filters the attributes of the spans and their events and links, of the data
+	// points and of the log records.
+	Attributes bool `mapstructure:"attributes"`
+	// ResourceAttributes filters the attributes of the resources.
+	ResourceAttributes bool `mapstructure:"resource_attributes"`
+	// Body filters the bodies of the log records, for the logs only.
+	Body bool `mapstructure:"body"`
+	// Detectors are the names of the detectors of the signal, all the detectors when empty.
+	Detectors []string `mapstructure:"detectors"`
+}
+
+func (cfg *Config) Validate() error {
+	var errs []error
+	if len(cfg.Detectors) == 0 {
+		errs = append(errs, errors.New("'detectors' cannot be empty"))
+	}
+	if err := cfg.Action.validate(); err != nil {
+		errs = append(errs, fmt.Errorf("'action': %w", err))
+	}
+	names := map[string]bool{}
+	for i, detectorCfg := range cfg.Detectors {
+		if names[detectorCfg.Name] {
+			errs = append(errs, fmt.Errorf("detectors[%d]: duplicate name %q", i, detectorCfg.Name))
+		}
+		names[detectorCfg.Name] = true
+		if err := detectorCfg.validate(); err != nil {
+			errs = append(errs, fmt.Errorf("detectors[%d]: %w", i, err))
+		}
+	}
+	for _, signal := range []struct {
+		name string
+		cfg  SignalConfig
+	}{{"traces", cfg.Traces}, {"metrics", cfg.Metrics}, {"logs", cfg.Logs}} {
+		for _, name := range signal.cfg.Detectors {
+			if !names[name] {
+				errs = append(errs, fmt.Errorf("'%s.detectors': unknown detector %q", signal.name, name))
+			}
+		}
+	}
+	if cfg.Traces.Body || cfg.Metrics.Body {
+		errs = append(errs, errors.New("'body' applies only to logs"))
+	}
+	return errors.Join(errs...)
+}
+
+func (cfg DetectorConfig) validate() error {
+	if cfg.Name == "" {
+		return errors.New("'name' cannot be empty")
+	}
+	if cfg.Action != "" {
+		if err := cfg.Action.validate(); err != nil {
+			return fmt.Errorf("'action': %w", err)
+		}
+	}
+	_, err := cfg.newDetector()
+	return err
+}
+
+// newDetector creates the detector of the config.
+func (cfg DetectorConfig) newDetector() (detector.Detector, error) {
+	switch cfg.Type {
+	case DetectorTypeRegex:
+		switch {
+		case cfg.Pattern != "" && cfg.Preset != "":
+			return nil, errors.New("'pattern' and 'preset' cannot be both set")
+		case cfg.Preset != "":
+			pattern, found := detector.Presets[cfg.Preset]
+			if !found {
+				return nil, fmt.Errorf("unknown preset %q", cfg.Preset)
+			}
+			return detector.NewRegex(pattern)
+		case cfg.Pattern == "":
+			return nil, errors.New("'pattern' or 'preset' must be set")
+		}
+		return detector.NewRegex(cfg.Pattern)
+	case DetectorTypeLuhn:
+		return detector.NewLuhn(), nil
+	case DetectorTypeDictionary:
+		return detector.NewDictionary(cfg.Words, cfg.CaseSensitive)
+	case DetectorTypeEntropy:
+		minLength, threshold := cfg.MinLength, cfg.Threshold
+		if minLength < 0 || threshold < 0 {
+			return nil, errors.New("'min_length' and 'threshold' cannot be negative")
+		}
+		if minLength == 0 {
+			minLength = detector.DefaultEntropyMinLength
+		}
+		if threshold == 0 {
+			threshold = detector.DefaultEntropyThreshold
+		}
+		return detector.NewEntropy(minLength, threshold), nil
+	}
+	return nil, fmt.Errorf("unsupported type %q", cfg.Type)
+}
@@ -0,0 +1,118 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package piifilterprocessor
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/piifilterprocessor/internal/metadata"
+)
+
+func TestLoadConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		id          component.ID
+		expected    component.Config
+		expectedErr string
+	}{
+		{
+			id: component.NewID(metadata.Type),
+			expected: &Config{
+				Detectors: []DetectorConfig{
+					{Name: "email", Type: DetectorTypeRegex, Preset: "email"},
+					{Name: "card", Type: DetectorTypeLuhn, Action: ActionTokenize},
+					{Name: "customers", Type: DetectorTypeDictionary, Words: []string{"Jane Doe", "John Smith"}},
+					{Name: "employee_id", Type: DetectorTypeRegex, Pattern: "EMP-[0-9]{6}", Action: ActionHash},
+					{Name: "secrets", Type: DetectorTypeEntropy, MinLength: 24, Threshold: 4, Action: ActionRemove},
+				},
+				Action:      ActionMask,
+				Mask:        defaultMask,
+				Salt:        "s3cr3t",
+				IgnoredKeys: []string{"trace_id"},
+				Traces:      SignalConfig{Attributes: true},
+				Metrics:     SignalConfig{Attributes: true, ResourceAttributes: true, Detectors: []string{"email"}},
+				Logs:        SignalConfig{Attributes: true, Body: true},
+			},
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "empty_detectors"),
+			expectedErr: "'detectors' cannot be empty",
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "unknown_type"),
+			expectedErr: `detectors[0]: unsupported type "phone"`,
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "invalid_pattern"),
+			expectedErr: "detectors[0]: invalid pattern \"(\": error parsing regexp: missing closing ): `(`",
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "invalid_action"),
+			expectedErr: `detectors[0]: 'action': unsupported action "encrypt"`,
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "duplicate_name"),
+			expectedErr: `detectors[1]: duplicate name "email"`,
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "unknown_signal_detector"),
+			expectedErr: `'traces.detectors': unknown detector "card"`,
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "traces_body"),
+			expectedErr: "'body' applies only to logs",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+			require.NoError(t, err)
+
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, component.UnmarshalConfig(sub, cfg))
+
+			if tt.expectedErr != "" {
+				assert.EqualError(t, component.ValidateConfig(cfg), tt.expectedErr)
+				return
+			}
+			assert.NoError(t, component.ValidateConfig(cfg))
+			assert.Equal(t, tt.expected, cfg)
+		})
+	}
+}
+
+func TestValidateDetectorConfig(t *testing.T) {
+	tests := []struct {
+		cfg         DetectorConfig
+		expectedErr string
+	}{
+		{cfg: DetectorConfig{Type: DetectorTypeLuhn}, expectedErr: "'name' cannot be empty"},
+		{cfg: DetectorConfig{Name: "id", Type: DetectorTypeRegex}, expectedErr: "'pattern' or 'preset' must be set"},
+		{cfg: DetectorConfig{Name: "id", Type: DetectorTypeRegex, Pattern: "[0-9]+", Preset: "email"}, expectedErr: "'pattern' and 'preset' cannot be both set"},
+		{cfg: DetectorConfig{Name: "id", Type: DetectorTypeRegex, Preset: "iban"}, expectedErr: `unknown preset "iban"`},
+		{cfg: DetectorConfig{Name: "names", Type: DetectorTypeDictionary}, expectedErr: "the dictionary has no words"},
+		{cfg: DetectorConfig{Name: "secrets", Type: DetectorTypeEntropy, Threshold: -1}, expectedErr: "'min_length' and 'threshold' cannot be negative"},
+		{cfg: DetectorConfig{Name: "secrets", Type: DetectorTypeEntropy}},
+	}
+	for _, tt := range tests {
+		err := tt.cfg.validate()
+		if tt.expectedErr == "" {
+			assert.NoError(t, err)
+		} else {
+			assert.EqualError(t, err, tt.expectedErr)
+		}
+	}
+}
@@ -0,0 +1,8 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:generate mdatagen metadata.yaml
+
+// Package piifilterprocessor detects the personally identifiable information in the
+// attributes and the log bodies of the telemetry, and masks, hashes or tokenizes it.
+package piifilterprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/piifilterprocessor"
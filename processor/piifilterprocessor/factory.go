@@ -0,0 +1,109 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package piifilterprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/piifilterprocessor"
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/processor"
+	"go.opentelemetry.io/collector/processor/processorhelper"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/piifilterprocessor/internal/metadata"
+)
+
+var processorCapabilities = consumer.Capabilities{MutatesData: true}
+
+// NewFactory returns a new factory for the PII filter processor.
+func NewFactory() processor.Factory {
+	return processor.NewFactory(
+		metadata.Type,
+		createDefaultConfig,
+		processor.WithTraces(createTracesProcessor, metadata.TracesStability),
+		processor.WithMetrics(createMetricsProcessor, metadata.MetricsStability),
+		processor.WithLogs(createLogsProcessor, metadata.LogsStability),
+	)
+}
+
+func createDefaultConfig() component.Config {
+	return &Config{
+		Action:  ActionMask,
+		Mask:    defaultMask,
+		Traces:  SignalConfig{Attributes: true},
+		Metrics: SignalConfig{Attributes: true},
+		Logs:    SignalConfig{Attributes: true, Body: true},
+	}
+}
+
+func newFilter(set processor.CreateSettings, cfg component.Config, signal string, scope func(*Config) SignalConfig) (*piiFilter, error) {
+	oCfg := cfg.(*Config)
+	telemetry, err := newFilterTelemetry(metadata.Meter(set.TelemetrySettings), set.ID)
+	if err != nil {
+		return nil, err
+	}
+	filter, err := newPIIFilter(oCfg, signal, scope(oCfg), telemetry)
+	if err != nil {
+		return nil, fmt.Errorf("error creating a PII filter processor: %w", err)
+	}
+	return filter, nil
+}
+
+func createTracesProcessor(
+	ctx context.Context,
+	set processor.CreateSettings,
+	cfg component.Config,
+	nextConsumer consumer.Traces,
+) (processor.Traces, error) {
+	filter, err := newFilter(set, cfg, "traces", func(c *Config) SignalConfig { return c.Traces })
+	if err != nil {
+		return nil, err
+	}
+	return processorhelper.NewTracesProcessor(
+		ctx,
+		set,
+		cfg,
+		nextConsumer,
+		filter.processTraces,
+		processorhelper.WithCapabilities(processorCapabilities))
+}
+
+func createMetricsProcessor(
+	ctx context.Context,
+	set processor.CreateSettings,
+	cfg component.Config,
+	nextConsumer consumer.Metrics,
+) (processor.Metrics, error) {
+	filter, err := newFilter(set, cfg, "metrics", func(c *Config) SignalConfig { return c.Metrics })
+	if err != nil {
+		return nil, err
+	}
+	return processorhelper.NewMetricsProcessor(
+		ctx,
+		set,
+		cfg,
+		nextConsumer,
+		filter.processMetrics,
+		processorhelper.WithCapabilities(processorCapabilities))
+}
+
+func createLogsProcessor(
+	ctx context.Context,
+	set processor.CreateSettings,
+	cfg component.Config,
+	nextConsumer consumer.Logs,
+) (processor.Logs, error) {
+	filter, err := newFilter(set, cfg, "logs", func(c *Config) SignalConfig { return c.Logs })
+	if err != nil {
+		return nil, err
+	}
+	return processorhelper.NewLogsProcessor(
+		ctx,
+		set,
+		cfg,
+		nextConsumer,
+		filter.processLogs,
+		processorhelper.WithCapabilities(processorCapabilities))
+}
@@ -0,0 +1,47 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package piifilterprocessor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/processor/processortest"
+)
+
+func TestCreateDefaultConfig(t *testing.T) {
+	cfg := NewFactory().CreateDefaultConfig().(*Config)
+	assert.Equal(t, &Config{
+		Action:  ActionMask,
+		Mask:    "****",
+		Traces:  SignalConfig{Attributes: true},
+		Metrics: SignalConfig{Attributes: true},
+		Logs:    SignalConfig{Attributes: true, Body: true},
+	}, cfg)
+	assert.EqualError(t, cfg.Validate(), "'detectors' cannot be empty")
+}
+
+func TestCreateProcessors(t *testing.T) {
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig().(*Config)
+	cfg.Detectors = []DetectorConfig{{Name: "card", Type: DetectorTypeLuhn}}
+	set := processortest.NewNopCreateSettings()
+
+	tp, err := factory.CreateTracesProcessor(context.Background(), set, cfg, consumertest.NewNop())
+	require.NoError(t, err)
+	assert.True(t, tp.Capabilities().MutatesData)
+	mp, err := factory.CreateMetricsProcessor(context.Background(), set, cfg, consumertest.NewNop())
+	require.NoError(t, err)
+	assert.True(t, mp.Capabilities().MutatesData)
+	lp, err := factory.CreateLogsProcessor(context.Background(), set, cfg, consumertest.NewNop())
+	require.NoError(t, err)
+	assert.True(t, lp.Capabilities().MutatesData)
+
+	cfg.Detectors = []DetectorConfig{{Name: "id", Type: DetectorTypeRegex, Pattern: "("}}
+	_, err = factory.CreateLogsProcessor(context.Background(), set, cfg, consumertest.NewNop())
+	assert.ErrorContains(t, err, "error creating a PII filter processor")
+}
@@ -0,0 +1,57 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package detector finds the personally identifiable information in strings.
+package detector // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/piifilterprocessor/internal/detector"
+
+import (
+	"sort"
+)
+
+// Match is the position of a detected value in a string, as byte offsets.
+type Match struct {
+	Start int
+	End   int
+}
+
+// Detector finds the values to filter in a string.
+type Detector interface {
+	// Detect returns the positions of the values found in s, in increasing order and
+	// without overlaps.
+	Detect(s string) []Match
+}
+
+// Tagged is a match of one of the detectors passed to DetectAll.
+type Tagged struct {
+	Match
+	// Detector is the index of the detector of the match.
+	Detector int
+}
+
+// DetectAll returns the matches of all the detectors in s, in increasing order. The matches
+// overlapping a previous match are dropped, the earliest and then longest match winning, and the
+// first detector on a tie.
+func DetectAll(detectors []Detector, s string) []Tagged {
+	var matches []Tagged
+	for i, detector := range detectors {
+		for _, match := range detector.Detect(s) {
+			matches = append(matches, Tagged{Match: match, Detector: i})
+		}
+	}
+	if len(matches) < 2 {
+		return matches
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].Start != matches[j].Start {
+			return matches[i].Start < matches[j].Start
+		}
+		return matches[i].End > matches[j].End
+	})
+	kept := matches[:1]
+	for _, match := range matches[1:] {
+		if match.Start >= kept[len(kept)-1].End {
+			kept = append(kept, match)
+		}
+	}
+	return kept
+}
@@ -0,0 +1,44 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package detector // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/piifilterprocessor/internal/detector"
+
+import (
+	"errors"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+type dictionaryDetector struct {
+	re *regexp.Regexp
+}
+
+// NewDictionary returns a detector of the words and phrases of the dictionary, matched as whole
+// words.
+func NewDictionary(words []string, caseSensitive bool) (Detector, error) {
+	quoted := make([]string, 0, len(words))
+	for _, word := range words {
+		if word = strings.TrimSpace(word); word != "" {
+			quoted = append(quoted, regexp.QuoteMeta(word))
+		}
+	}
+	if len(quoted) == 0 {
+		return nil, errors.New("the dictionary has no words")
+	}
+	// the longest words first, so that the phrases win over their words
+	sort.SliceStable(quoted, func(i, j int) bool { return len(quoted[i]) > len(quoted[j]) })
+	pattern := `\b(?:` + strings.Join(quoted, "|") + `)\b`
+	if !caseSensitive {
+		pattern = "(?i)" + pattern
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	return &dictionaryDetector{re: re}, nil
+}
+
+func (d *dictionaryDetector) Detect(s string) []Match {
+	return toMatches(d.re.FindAllStringIndex(s, -1))
+}
@@ -0,0 +1,72 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package detector // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/piifilterprocessor/internal/detector"
+
+import (
+	"math"
+	"regexp"
+)
+
+const (
+	DefaultEntropyMinLength = 20
+	DefaultEntropyThreshold = 3.5
+)
+
+// entropyTokens are the runs of the characters of the keys and the tokens, in their usual
+// base64, hexadecimal and URL-safe encodings.
+var entropyTokens = regexp.MustCompile(`[A-Za-z0-9+/_-]+={0,2}`)
+
+type entropyDetector struct {
+	minLength int
+	threshold float64
+}
+
+// NewEntropy returns a lightweight statistical detector of the secrets, such as the API keys
+// and the access tokens: the tokens of at least minLength characters, mixing letters and
+// digits, with a Shannon entropy of at least threshold bits per character. The words of the
+// natural languages stay below, their letters being far from evenly distributed.
+func NewEntropy(minLength int, threshold float64) Detector {
+	return &entropyDetector{minLength: minLength, threshold: threshold}
+}
+
+func (d *entropyDetector) Detect(s string) []Match {
+	var matches []Match
+	for _, index := range entropyTokens.FindAllStringIndex(s, -1) {
+		token := s[index[0]:index[1]]
+		if len(token) >= d.minLength && mixesLettersAndDigits(token) && shannonEntropy(token) >= d.threshold {
+			matches = append(matches, Match{Start: index[0], End: index[1]})
+		}
+	}
+	return matches
+}
+
+func mixesLettersAndDigits(token string) bool {
+	var letters, digits bool
+	for i := 0; i < len(token); i++ {
+		switch c := token[i]; {
+		case c >= '0' && c <= '9':
+			digits = true
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z':
+			letters = true
+		}
+	}
+	return letters && digits
+}
+
+// shannonEntropy returns the entropy of the characters of the token, in bits per character.
+func shannonEntropy(token string) float64 {
+	var counts [256]int
+	for i := 0; i < len(token); i++ {
+		counts[token[i]]++
+	}
+	entropy := 0.0
+	length := float64(len(token))
+	for _, count := range counts {
+		if count > 0 {
+			p := float64(count) / length
+			entropy -= p * math.Log2(p)
+		}
+	}
+	return entropy
+}
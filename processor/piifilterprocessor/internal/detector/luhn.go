@@ -0,0 +1,52 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package detector // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/piifilterprocessor/internal/detector"
+
+import (
+	"regexp"
+)
+
+// cardNumberCandidates are the sequences of 13 to 19 digits, optionally grouped by spaces or
+// dashes, e.g. `4111 1111 1111 1111`.
+var cardNumberCandidates = regexp.MustCompile(`\b[0-9](?:[ -]?[0-9]){12,18}\b`)
+
+type luhnDetector struct{}
+
+// NewLuhn returns a detector of the payment card numbers, the candidates being validated with
+// their Luhn check digit to leave out the other long numbers.
+func NewLuhn() Detector {
+	return luhnDetector{}
+}
+
+func (luhnDetector) Detect(s string) []Match {
+	var matches []Match
+	for _, index := range cardNumberCandidates.FindAllStringIndex(s, -1) {
+		if validLuhn(s[index[0]:index[1]]) {
+			matches = append(matches, Match{Start: index[0], End: index[1]})
+		}
+	}
+	return matches
+}
+
+// validLuhn returns whether the digits of s have a valid Luhn check digit, the separators
+// being skipped.
+func validLuhn(s string) bool {
+	sum := 0
+	double := false
+	for i := len(s) - 1; i >= 0; i-- {
+		if s[i] < '0' || s[i] > '9' {
+			continue
+		}
+		digit := int(s[i] - '0')
+		if double {
+			digit *= 2
+			if digit > 9 {
+				digit -= 9
+			}
+		}
+		sum += digit
+		double = !double
+	}
+	return sum%10 == 0
+}
@@ -0,0 +1,46 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package detector // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/piifilterprocessor/internal/detector"
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// Presets are the patterns of the common identifiers, usable instead of writing a pattern.
+var Presets = map[string]string{
+	"email":  `[A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\.[A-Za-z]{2,}`,
+	"ipv4":   `\b(?:(?:25[0-5]|2[0-4][0-9]|1[0-9]{2}|[1-9]?[0-9])\.){3}(?:25[0-5]|2[0-4][0-9]|1[0-9]{2}|[1-9]?[0-9])\b`,
+	"us_ssn": `\b[0-9]{3}-[0-9]{2}-[0-9]{4}\b`,
+}
+
+type regexDetector struct {
+	re *regexp.Regexp
+}
+
+// NewRegex returns a detector matching the regular expression.
+func NewRegex(pattern string) (Detector, error) {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid pattern %q: %w", pattern, err)
+	}
+	return &regexDetector{re: re}, nil
+}
+
+func (d *regexDetector) Detect(s string) []Match {
+	return toMatches(d.re.FindAllStringIndex(s, -1))
+}
+
+func toMatches(indexes [][]int) []Match {
+	if len(indexes) == 0 {
+		return nil
+	}
+	matches := make([]Match, 0, len(indexes))
+	for _, index := range indexes {
+		if index[1] > index[0] {
+			matches = append(matches, Match{Start: index[0], End: index[1]})
+		}
+	}
+	return matches
+}
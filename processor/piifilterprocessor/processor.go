@@ -0,0 +1,248 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package piifilterprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/piifilterprocessor"
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/piifilterprocessor/internal/detector"
+)
+
+// piiFilter filters the values found by the detectors of a signal.
+type piiFilter struct {
+	signal    string
+	scope     SignalConfig
+	names     []string
+	detectors []detector.Detector
+	actions   []Action
+	ignored   map[string]bool
+	mask      string
+	salt      []byte
+	telemetry *filterTelemetry
+}
+
+func newPIIFilter(cfg *Config, signal string, scope SignalConfig, telemetry *filterTelemetry) (*piiFilter, error) {
+	selected := map[string]bool{}
+	for _, name := range scope.Detectors {
+		selected[name] = true
+	}
+	filter := &piiFilter{
+		signal:    signal,
+		scope:     scope,
+		ignored:   map[string]bool{},
+		mask:      cfg.Mask,
+		salt:      []byte(cfg.Salt),
+		telemetry: telemetry,
+	}
+	for _, detectorCfg := range cfg.Detectors {
+		if len(selected) > 0 && !selected[detectorCfg.Name] {
+			continue
+		}
+		d, err := detectorCfg.newDetector()
+		if err != nil {
+			return nil, err
+		}
+		action := detectorCfg.Action
+		if action == "" {
+			action = cfg.Action
+		}
+		filter.names = append(filter.names, detectorCfg.Name)
+		filter.detectors = append(filter.detectors, d)
+		filter.actions = append(filter.actions, action)
+	}
+	for _, key := range cfg.IgnoredKeys {
+		filter.ignored[key] = true
+	}
+	return filter, nil
+}
+
+func (f *piiFilter) processTraces(ctx context.Context, td ptrace.Traces) (ptrace.Traces, error) {
+	counts := f.newCounts()
+	for i := 0; i < td.ResourceSpans().Len(); i++ {
+		rs := td.ResourceSpans().At(i)
+		if f.scope.ResourceAttributes {
+			f.filterAttributes(rs.Resource().Attributes(), counts)
+		}
+		if !f.scope.Attributes {
+			continue
+		}
+		for j := 0; j < rs.ScopeSpans().Len(); j++ {
+			spans := rs.ScopeSpans().At(j).Spans()
+			for k := 0; k < spans.Len(); k++ {
+				span := spans.At(k)
+				f.filterAttributes(span.Attributes(), counts)
+				for l := 0; l < span.Events().Len(); l++ {
+					f.filterAttributes(span.Events().At(l).Attributes(), counts)
+				}
+				for l := 0; l < span.Links().Len(); l++ {
+					f.filterAttributes(span.Links().At(l).Attributes(), counts)
+				}
+			}
+		}
+	}
+	f.telemetry.record(ctx, f.signal, f.names, f.actions, counts)
+	return td, nil
+}
+
+func (f *piiFilter) processMetrics(ctx context.Context, md pmetric.Metrics) (pmetric.Metrics, error) {
+	counts := f.newCounts()
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		rm := md.ResourceMetrics().At(i)
+		if f.scope.ResourceAttributes {
+			f.filterAttributes(rm.Resource().Attributes(), counts)
+		}
+		if !f.scope.Attributes {
+			continue
+		}
+		for j := 0; j < rm.ScopeMetrics().Len(); j++ {
+			metrics := rm.ScopeMetrics().At(j).Metrics()
+			for k := 0; k < metrics.Len(); k++ {
+				f.filterDataPoints(metrics.At(k), counts)
+			}
+		}
+	}
+	f.telemetry.record(ctx, f.signal, f.names, f.actions, counts)
+	return md, nil
+}
+
+func (f *piiFilter) filterDataPoints(metric pmetric.Metric, counts *detectionCounts) {
+	//exhaustive:enforce
+	switch metric.Type() {
+	case pmetric.MetricTypeGauge:
+		dps := metric.Gauge().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			f.filterAttributes(dps.At(i).Attributes(), counts)
+		}
+	case pmetric.MetricTypeSum:
+		dps := metric.Sum().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			f.filterAttributes(dps.At(i).Attributes(), counts)
+		}
+	case pmetric.MetricTypeHistogram:
+		dps := metric.Histogram().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			f.filterAttributes(dps.At(i).Attributes(), counts)
+		}
+	case pmetric.MetricTypeExponentialHistogram:
+		dps := metric.ExponentialHistogram().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			f.filterAttributes(dps.At(i).Attributes(), counts)
+		}
+	case pmetric.MetricTypeSummary:
+		dps := metric.Summary().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			f.filterAttributes(dps.At(i).Attributes(), counts)
+		}
+	case pmetric.MetricTypeEmpty:
+	}
+}
+
+func (f *piiFilter) processLogs(ctx context.Context, ld plog.Logs) (plog.Logs, error) {
+	counts := f.newCounts()
+	for i := 0; i < ld.ResourceLogs().Len(); i++ {
+		rl := ld.ResourceLogs().At(i)
+		if f.scope.ResourceAttributes {
+			f.filterAttributes(rl.Resource().Attributes(), counts)
+		}
+		for j := 0; j < rl.ScopeLogs().Len(); j++ {
+			logs := rl.ScopeLogs().At(j).LogRecords()
+			for k := 0; k < logs.Len(); k++ {
+				logRecord := logs.At(k)
+				if f.scope.Attributes {
+					f.filterAttributes(logRecord.Attributes(), counts)
+				}
+				if f.scope.Body {
+					f.filterValue(logRecord.Body(), counts)
+				}
+			}
+		}
+	}
+	f.telemetry.record(ctx, f.signal, f.names, f.actions, counts)
+	return ld, nil
+}
+
+// filterAttributes filters the values of the attributes, removing the attributes with a value of
+// a detector with the remove action.
+func (f *piiFilter) filterAttributes(attrs pcommon.Map, counts *detectionCounts) {
+	attrs.RemoveIf(func(key string, value pcommon.Value) bool {
+		if f.ignored[key] {
+			return false
+		}
+		return f.filterValue(value, counts)
+	})
+}
+
+// filterValue filters the strings of the value, recursively for the maps and the slices. It returns
+// whether the value has to be removed, the strings of the slices and the bodies only having their
+// detected values removed.
+func (f *piiFilter) filterValue(value pcommon.Value, counts *detectionCounts) bool {
+	//exhaustive:enforce
+	switch value.Type() {
+	case pcommon.ValueTypeStr:
+		filtered, remove := f.filterString(value.Str(), counts)
+		value.SetStr(filtered)
+		return remove
+	case pcommon.ValueTypeMap:
+		f.filterAttributes(value.Map(), counts)
+	case pcommon.ValueTypeSlice:
+		slice := value.Slice()
+		for i := 0; i < slice.Len(); i++ {
+			f.filterValue(slice.At(i), counts)
+		}
+	case pcommon.ValueTypeEmpty, pcommon.ValueTypeInt, pcommon.ValueTypeDouble, pcommon.ValueTypeBool, pcommon.ValueTypeBytes:
+	}
+	return false
+}
+
+// filterString replaces the values detected in s according to the actions of their detectors, and
+// returns whether one of them has the remove action.
+func (f *piiFilter) filterString(s string, counts *detectionCounts) (string, bool) {
+	counts.scanned++
+	matches := detector.DetectAll(f.detectors, s)
+	if len(matches) == 0 {
+		return s, false
+	}
+	var b strings.Builder
+	remove := false
+	last := 0
+	for _, match := range matches {
+		counts.detected[match.Detector]++
+		b.WriteString(s[last:match.Start])
+		value := s[match.Start:match.End]
+		switch f.actions[match.Detector] {
+		case ActionMask:
+			b.WriteString(f.mask)
+		case ActionHash:
+			b.WriteString(f.hash(value))
+		case ActionTokenize:
+			b.WriteString(f.names[match.Detector])
+			b.WriteByte('_')
+			b.WriteString(f.hash(value)[:16])
+		case ActionRemove:
+			remove = true
+		}
+		last = match.End
+	}
+	b.WriteString(s[last:])
+	return b.String(), remove
+}
+
+func (f *piiFilter) hash(value string) string {
+	mac := hmac.New(sha256.New, f.salt)
+	mac.Write([]byte(value))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (f *piiFilter) newCounts() *detectionCounts {
+	return &detectionCounts{detected: make([]int64, len(f.detectors))}
+}
@@ -0,0 +1,200 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package piifilterprocessor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/otel/metric/noop"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+func newTestFilter(t *testing.T, cfg *Config, signal string, scope SignalConfig) *piiFilter {
+	telemetry, err := newFilterTelemetry(noop.NewMeterProvider().Meter("test"), component.MustNewID("piifilter"))
+	require.NoError(t, err)
+	filter, err := newPIIFilter(cfg, signal, scope, telemetry)
+	require.NoError(t, err)
+	return filter
+}
+
+func testConfig() *Config {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Salt = "salt"
+	cfg.Detectors = []DetectorConfig{
+		{Name: "email", Type: DetectorTypeRegex, Preset: "email"},
+		{Name: "card", Type: DetectorTypeLuhn, Action: ActionTokenize},
+		{Name: "employee_id", Type: DetectorTypeRegex, Pattern: "EMP-[0-9]{6}", Action: ActionHash},
+		{Name: "secrets", Type: DetectorTypeEntropy, Action: ActionRemove},
+	}
+	cfg.IgnoredKeys = []string{"billing.email"}
+	return cfg
+}
+
+func TestFilterString(t *testing.T) {
+	filter := newTestFilter(t, testConfig(), "logs", SignalConfig{})
+	counts := filter.newCounts()
+
+	filtered, remove := filter.filterString("mail jane@example.com, card 4111 1111 1111 1111, id EMP-123456", counts)
+	assert.False(t, remove)
+	assert.Equal(t, "mail ****, card card_"+filter.hash("4111 1111 1111 1111")[:16]+", id "+filter.hash("EMP-123456"), filtered)
+	assert.Equal(t, "fb007376d58f1bcd288cc987035858e0603ad3c7a1f172fbfe1d53cda56ca4f7", filter.hash("EMP-123456"))
+
+	// the same values get the same tokens, the salt changing them
+	other, _ := filter.filterString("4111 1111 1111 1111", counts)
+	assert.Equal(t, "card_"+filter.hash("4111 1111 1111 1111")[:16], other)
+	unsalted := newTestFilter(t, &Config{Detectors: testConfig().Detectors, Action: ActionMask}, "logs", SignalConfig{})
+	assert.NotEqual(t, filter.hash("EMP-123456"), unsalted.hash("EMP-123456"))
+
+	filtered, remove = filter.filterString("token AKIAIOSFODNN7EXAMPLE1 was revoked", counts)
+	assert.True(t, remove)
+	assert.Equal(t, "token  was revoked", filtered)
+
+	filtered, remove = filter.filterString("nothing to filter", counts)
+	assert.False(t, remove)
+	assert.Equal(t, "nothing to filter", filtered)
+
+	assert.EqualValues(t, 4, counts.scanned)
+	assert.Equal(t, []int64{1, 2, 1, 1}, counts.detected)
+}
+
+func TestProcessTraces(t *testing.T) {
+	filter := newTestFilter(t, testConfig(), "traces", SignalConfig{Attributes: true})
+	td := ptrace.NewTraces()
+	rs := td.ResourceSpans().AppendEmpty()
+	rs.Resource().Attributes().PutStr("owner", "ops@example.com")
+	span := rs.ScopeSpans().AppendEmpty().Spans().AppendEmpty()
+	span.Attributes().PutStr("user.email", "jane@example.com")
+	span.Attributes().PutStr("billing.email", "billing@example.com")
+	span.Attributes().PutStr("api.key", "AKIAIOSFODNN7EXAMPLE1")
+	span.Attributes().PutInt("http.status_code", 200)
+	span.Attributes().PutEmptySlice("recipients").AppendEmpty().SetStr("john@example.com")
+	span.Attributes().PutEmptyMap("user").PutStr("email", "jane@example.com")
+	span.Events().AppendEmpty().Attributes().PutStr("message", "charged 4111 1111 1111 1111")
+	span.Links().AppendEmpty().Attributes().PutStr("employee", "EMP-123456")
+
+	td, err := filter.processTraces(context.Background(), td)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"owner": "ops@example.com"}, rs.Resource().Attributes().AsRaw())
+	assert.Equal(t, map[string]any{
+		"user.email":       "****",
+		"billing.email":    "billing@example.com",
+		"http.status_code": int64(200),
+		"recipients":       []any{"****"},
+		"user":             map[string]any{"email": "****"},
+	}, span.Attributes().AsRaw())
+	assert.Equal(t, map[string]any{"message": "charged card_" + filter.hash("4111 1111 1111 1111")[:16]}, span.Events().At(0).Attributes().AsRaw())
+	assert.Equal(t, map[string]any{"employee": filter.hash("EMP-123456")}, td.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).Links().At(0).Attributes().AsRaw())
+}
+
+func TestProcessMetrics(t *testing.T) {
+	cfg := testConfig()
+	// the detectors of the signal only
+	filter := newTestFilter(t, cfg, "metrics", SignalConfig{Attributes: true, ResourceAttributes: true, Detectors: []string{"email"}})
+	md := pmetric.NewMetrics()
+	rm := md.ResourceMetrics().AppendEmpty()
+	rm.Resource().Attributes().PutStr("owner", "ops@example.com")
+	metrics := rm.ScopeMetrics().AppendEmpty().Metrics()
+	gauge := metrics.AppendEmpty().SetEmptyGauge().DataPoints().AppendEmpty()
+	gauge.Attributes().PutStr("user", "jane@example.com")
+	gauge.Attributes().PutStr("employee", "EMP-123456")
+	sum := metrics.AppendEmpty().SetEmptySum().DataPoints().AppendEmpty()
+	sum.Attributes().PutStr("user", "jane@example.com")
+	histogram := metrics.AppendEmpty().SetEmptyHistogram().DataPoints().AppendEmpty()
+	histogram.Attributes().PutStr("user", "jane@example.com")
+	exponential := metrics.AppendEmpty().SetEmptyExponentialHistogram().DataPoints().AppendEmpty()
+	exponential.Attributes().PutStr("user", "jane@example.com")
+	summary := metrics.AppendEmpty().SetEmptySummary().DataPoints().AppendEmpty()
+	summary.Attributes().PutStr("user", "jane@example.com")
+	metrics.AppendEmpty()
+
+	_, err := filter.processMetrics(context.Background(), md)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"owner": "****"}, rm.Resource().Attributes().AsRaw())
+	assert.Equal(t, map[string]any{"user": "****", "employee": "EMP-123456"}, gauge.Attributes().AsRaw())
+	for _, attrs := range []pcommon.Map{sum.Attributes(), histogram.Attributes(), exponential.Attributes(), summary.Attributes()} {
+		assert.Equal(t, map[string]any{"user": "****"}, attrs.AsRaw())
+	}
+}
+
+func TestProcessLogs(t *testing.T) {
+	cfg := testConfig()
+	cfg.Mask = "[REDACTED]"
+	logs := func() (plog.Logs, plog.LogRecord) {
+		ld := plog.NewLogs()
+		logRecord := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+		logRecord.Body().SetStr("login of jane@example.com with key AKIAIOSFODNN7EXAMPLE1")
+		logRecord.Attributes().PutStr("user", "jane@example.com")
+		return ld, logRecord
+	}
+
+	ld, logRecord := logs()
+	filter := newTestFilter(t, cfg, "logs", cfg.Logs)
+	_, err := filter.processLogs(context.Background(), ld)
+	require.NoError(t, err)
+	assert.Equal(t, "login of [REDACTED] with key ", logRecord.Body().Str())
+	assert.Equal(t, map[string]any{"user": "[REDACTED]"}, logRecord.Attributes().AsRaw())
+
+	ld, logRecord = logs()
+	filter = newTestFilter(t, cfg, "logs", SignalConfig{Attributes: true})
+	_, err = filter.processLogs(context.Background(), ld)
+	require.NoError(t, err)
+	assert.Equal(t, "login of jane@example.com with key AKIAIOSFODNN7EXAMPLE1", logRecord.Body().Str())
+	assert.Equal(t, map[string]any{"user": "[REDACTED]"}, logRecord.Attributes().AsRaw())
+
+	ld, logRecord = logs()
+	logRecord.Body().SetEmptyMap().PutStr("user", "jane@example.com")
+	filter = newTestFilter(t, cfg, "logs", SignalConfig{Body: true})
+	_, err = filter.processLogs(context.Background(), ld)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"user": "[REDACTED]"}, logRecord.Body().AsRaw())
+	assert.Equal(t, map[string]any{"user": "jane@example.com"}, logRecord.Attributes().AsRaw())
+}
+
+func TestTelemetry(t *testing.T) {
+	reader := sdkmetric.NewManualReader()
+	meterProvider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	telemetry, err := newFilterTelemetry(meterProvider.Meter("test"), component.MustNewID("piifilter"))
+	require.NoError(t, err)
+	filter, err := newPIIFilter(testConfig(), "logs", SignalConfig{Attributes: true}, telemetry)
+	require.NoError(t, err)
+
+	ld := plog.NewLogs()
+	attrs := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Attributes()
+	attrs.PutStr("user", "jane@example.com")
+	attrs.PutStr("manager", "john@example.com")
+	attrs.PutStr("card", "4111 1111 1111 1111")
+	attrs.PutStr("status", "ok")
+	_, err = filter.processLogs(context.Background(), ld)
+	require.NoError(t, err)
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &rm))
+	counts := map[string]int64{}
+	for _, m := range rm.ScopeMetrics[0].Metrics {
+		for _, dp := range m.Data.(metricdata.Sum[int64]).DataPoints {
+			signal, _ := dp.Attributes.Value("signal")
+			assert.Equal(t, "logs", signal.AsString())
+			key := m.Name
+			if detector, found := dp.Attributes.Value("detector"); found {
+				action, _ := dp.Attributes.Value("action")
+				key += "/" + detector.AsString() + "/" + action.AsString()
+			}
+			counts[key] += dp.Value
+		}
+	}
+	assert.Equal(t, map[string]int64{
+		"otelcol_processor_piifilter_scanned_values":                4,
+		"otelcol_processor_piifilter_detected_values/email/mask":    2,
+		"otelcol_processor_piifilter_detected_values/card/tokenize": 1,
+	}, counts)
+}
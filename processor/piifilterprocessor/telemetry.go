@@ -0,0 +1,62 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package piifilterprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/piifilterprocessor"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const metricsPrefix = "otelcol_processor_piifilter_"
+
+// detectionCounts are the strings scanned in a batch, and the values found per detector.
+type detectionCounts struct {
+	scanned  int64
+	detected []int64
+}
+
+// filterTelemetry counts the strings scanned by the processor and the values found by its
+// detectors, the ratio of both being the detection rate of each detector.
+type filterTelemetry struct {
+	scanned  metric.Int64Counter
+	detected metric.Int64Counter
+	id       string
+}
+
+func newFilterTelemetry(meter metric.Meter, id component.ID) (*filterTelemetry, error) {
+	scanned, err := meter.Int64Counter(
+		metricsPrefix+"scanned_values",
+		metric.WithDescription("Number of string values scanned by the detectors"),
+		metric.WithUnit("1"),
+	)
+	if err != nil {
+		return nil, err
+	}
+	detected, err := meter.Int64Counter(
+		metricsPrefix+"detected_values",
+		metric.WithDescription("Number of values found by a detector and filtered"),
+		metric.WithUnit("1"),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &filterTelemetry{scanned: scanned, detected: detected, id: id.String()}, nil
+}
+
+func (t *filterTelemetry) record(ctx context.Context, signal string, names []string, actions []Action, counts *detectionCounts) {
+	attrs := []attribute.KeyValue{attribute.String("processor", t.id), attribute.String("signal", signal)}
+	t.scanned.Add(ctx, counts.scanned, metric.WithAttributes(attrs...))
+	for i, count := range counts.detected {
+		if count == 0 {
+			continue
+		}
+		t.detected.Add(ctx, count, metric.WithAttributes(append(attrs,
+			attribute.String("detector", names[i]),
+			attribute.String("action", string(actions[i])),
+		)...))
+	}
+}
@@ -7,6 +7,8 @@ import (
 	"fmt"
 
 	"go.opentelemetry.io/collector/component"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/sampling"
 )
 
 type AttributeSource string
@@ -55,6 +57,30 @@ type Config struct {
 
 	// SamplingPriority (logs only) enables using a log record attribute as the sampling priority of the log record.
 	SamplingPriority string `mapstructure:"sampling_priority"`
+
+	// Mode selects the sampling behavior. Supported values:
+	//
+	// - "hash_seed": the default, the legacy behavior of this
+	//   processor. Hashes the TraceID (or attribute) with the
+	//   configured seed, leaving the tracestate unmodified.
+	//
+	// - "equalizing": uses OTEP 235 consistent probability sampling,
+	//   setting the sampling threshold of the items to the configured
+	//   one, unless they arrive with a lesser sampling probability.
+	//
+	// - "proportional": uses OTEP 235 consistent probability sampling,
+	//   multiplying the sampling probability of the items by the
+	//   configured one.
+	//
+	// In the OTEP 235 modes, the sampling threshold is recorded in
+	// the W3C tracestate of spans and in the "sampling.threshold"
+	// attribute of log records.
+	Mode SamplerMode `mapstructure:"mode"`
+
+	// SamplingPrecision is the number of hexadecimal digits used to
+	// encode the sampling threshold in the equalizing and
+	// proportional modes. Defaults to 4.
+	SamplingPrecision int `mapstructure:"sampling_precision"`
 }
 
 var _ component.Config = (*Config)(nil)
@@ -67,5 +93,14 @@ func (cfg *Config) Validate() error {
 	if cfg.AttributeSource != "" && !validAttributeSource[cfg.AttributeSource] {
 		return fmt.Errorf("invalid attribute source: %v. Expected: %v or %v", cfg.AttributeSource, traceIDAttributeSource, recordAttributeSource)
 	}
+	switch cfg.Mode {
+	case Equalizing, Proportional:
+		if cfg.SamplingPrecision < 1 || cfg.SamplingPrecision > sampling.NumHexDigits {
+			return fmt.Errorf("invalid sampling precision: %d. Expected a value between 1 and %d", cfg.SamplingPrecision, sampling.NumHexDigits)
+		}
+		if ratio := float64(cfg.SamplingPercentage) / 100; ratio > 0 && ratio < 1/float64(sampling.MaxAdjustedCount) {
+			return fmt.Errorf("sampling rate is too small: %g%%", cfg.SamplingPercentage)
+		}
+	}
 	return nil
 }
@@ -28,6 +28,7 @@ func TestLoadConfig(t *testing.T) {
 				SamplingPercentage: 15.3,
 				AttributeSource:    "traceID",
 				FailClosed:         true,
+				SamplingPrecision:  defaultPrecision,
 			},
 		},
 		{
@@ -39,6 +40,17 @@ func TestLoadConfig(t *testing.T) {
 				FromAttribute:      "foo",
 				SamplingPriority:   "bar",
 				FailClosed:         true,
+				SamplingPrecision:  defaultPrecision,
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "equalizing"),
+			expected: &Config{
+				SamplingPercentage: 25,
+				AttributeSource:    "traceID",
+				FailClosed:         true,
+				Mode:               Equalizing,
+				SamplingPrecision:  6,
 			},
 		},
 	}
@@ -69,6 +81,8 @@ func TestLoadInvalidConfig(t *testing.T) {
 		contains string
 	}{
 		{"invalid_negative.yaml", "negative sampling rate"},
+		{"invalid_precision.yaml", "invalid sampling precision"},
+		{"invalid_small.yaml", "sampling rate is too small"},
 	} {
 		t.Run(test.file, func(t *testing.T) {
 			factories, err := otelcoltest.NopFactories()
@@ -5,6 +5,7 @@ package probabilisticsamplerprocessor // import "github.com/open-telemetry/opent
 
 import (
 	"context"
+	"errors"
 
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/pdata/pcommon"
@@ -24,16 +25,97 @@ type logsProcessor struct {
 	logger           *zap.Logger
 }
 
+const (
+	// logsThresholdAttribute holds the sampling threshold of a log
+	// record, encoded as the OTEP 235 T-value.
+	logsThresholdAttribute = "sampling.threshold"
+	// logsRandomnessAttribute holds the explicit randomness of a log
+	// record, encoded as the OTEP 235 R-value.
+	logsRandomnessAttribute = "sampling.randomness"
+)
+
+// recordCarrier conveys the sampling attributes of a log record.
 type recordCarrier struct {
 	record plog.LogRecord
+
+	parsed struct {
+		threshold    sampling.Threshold
+		hasThreshold bool
+
+		randomness    sampling.Randomness
+		hasRandomness bool
+	}
 }
 
 var _ samplingCarrier = &recordCarrier{}
 
-func newLogRecordCarrier(l plog.LogRecord) samplingCarrier {
-	return &recordCarrier{
+func newLogRecordCarrier(l plog.LogRecord) (*recordCarrier, error) {
+	var ret error
+	carrier := &recordCarrier{
 		record: l,
 	}
+	if tv, has := l.Attributes().Get(logsThresholdAttribute); has && tv.Type() == pcommon.ValueTypeStr {
+		th, err := sampling.TValueToThreshold(tv.Str())
+		if err != nil {
+			ret = errors.Join(ret, err)
+		} else {
+			carrier.parsed.threshold = th
+			carrier.parsed.hasThreshold = true
+		}
+	}
+	if rv, has := l.Attributes().Get(logsRandomnessAttribute); has && rv.Type() == pcommon.ValueTypeStr {
+		rnd, err := sampling.RValueToRandomness(rv.Str())
+		if err != nil {
+			ret = errors.Join(ret, err)
+		} else {
+			carrier.parsed.randomness = rnd
+			carrier.parsed.hasRandomness = true
+		}
+	}
+	return carrier, ret
+}
+
+func (rc *recordCarrier) threshold() (sampling.Threshold, bool) {
+	return rc.parsed.threshold, rc.parsed.hasThreshold
+}
+
+func (rc *recordCarrier) explicitRandomness() (randomnessNamer, bool) {
+	if !rc.parsed.hasRandomness {
+		return newMissingRandomnessMethod(), false
+	}
+	return newSamplingRandomnessMethod(rc.parsed.randomness), true
+}
+
+// setExplicitRandomness records randomness derived from an attribute,
+// so later sampling stages make consistent decisions.
+func (rc *recordCarrier) setExplicitRandomness(rnd randomnessNamer) {
+	rc.parsed.randomness = rnd.randomness()
+	rc.parsed.hasRandomness = true
+}
+
+func (rc *recordCarrier) updateThreshold(th sampling.Threshold) error {
+	if rc.parsed.hasThreshold && sampling.ThresholdLessThan(th, rc.parsed.threshold) {
+		return sampling.ErrInconsistentSampling
+	}
+	rc.parsed.threshold = th
+	rc.parsed.hasThreshold = true
+	return nil
+}
+
+func (rc *recordCarrier) clearThreshold() {
+	rc.parsed.threshold = sampling.NeverSampleThreshold
+	rc.parsed.hasThreshold = false
+	rc.record.Attributes().Remove(logsThresholdAttribute)
+}
+
+func (rc *recordCarrier) reserialize() error {
+	if rc.parsed.hasThreshold {
+		rc.record.Attributes().PutStr(logsThresholdAttribute, rc.parsed.threshold.TValue())
+	}
+	if rc.parsed.hasRandomness {
+		rc.record.Attributes().PutStr(logsRandomnessAttribute, rc.parsed.randomness.RValue())
+	}
+	return nil
 }
 
 func (*neverSampler) randomnessFromLogRecord(_ plog.LogRecord) (randomnessNamer, samplingCarrier, error) {
@@ -46,7 +128,6 @@ func (*neverSampler) randomnessFromLogRecord(_ plog.LogRecord) (randomnessNamer,
 // the TraceID
 func (th *hashingSampler) randomnessFromLogRecord(logRec plog.LogRecord) (randomnessNamer, samplingCarrier, error) {
 	rnd := newMissingRandomnessMethod()
-	lrc := newLogRecordCarrier(logRec)
 
 	if th.logsTraceIDEnabled {
 		value := logRec.TraceID()
@@ -67,6 +148,37 @@ func (th *hashingSampler) randomnessFromLogRecord(logRec plog.LogRecord) (random
 		}
 	}
 
+	return rnd, nil, nil
+}
+
+// randomnessFromLogRecord (equalizing and proportional samplers) uses
+// the explicit randomness attribute, otherwise the TraceID, otherwise
+// a hash of the configured attribute.
+func (ctc *consistentTracestateCommon) randomnessFromLogRecord(logRec plog.LogRecord) (randomnessNamer, samplingCarrier, error) {
+	rnd := newMissingRandomnessMethod()
+	lrc, err := newLogRecordCarrier(logRec)
+	if err != nil {
+		// The attributes are not modified when they can't be parsed.
+		return rnd, nil, err
+	}
+
+	if rv, has := lrc.explicitRandomness(); has {
+		rnd = rv
+	} else if tid := logRec.TraceID(); ctc.logsTraceIDEnabled && !tid.IsEmpty() {
+		rnd = newTraceIDW3CSpecMethod(sampling.TraceIDToRandomness(tid))
+	} else if ctc.logsRandomnessSourceAttribute != "" {
+		if value, ok := logRec.Attributes().Get(ctc.logsRandomnessSourceAttribute); ok {
+			by := getBytesFromValue(value)
+			if len(by) > 0 {
+				rnd = newAttributeHashingMethod(
+					ctc.logsRandomnessSourceAttribute,
+					randomnessFromBytes(by, ctc.logsRandomnessHashSeed),
+				)
+				lrc.setExplicitRandomness(rnd)
+			}
+		}
+	}
+
 	return rnd, lrc, nil
 }
 
@@ -264,3 +264,77 @@ func TestLogsMissingRandomness(t *testing.T) {
 		})
 	}
 }
+
+func TestLogsSamplingThreshold(t *testing.T) {
+	tests := []struct {
+		name       string
+		cfg        *Config
+		tid        pcommon.TraceID
+		attributes map[string]any
+		expected   map[string]any
+	}{
+		{
+			name: "equalizing_trace_id",
+			cfg: &Config{
+				SamplingPercentage: 50,
+				Mode:               Equalizing,
+				AttributeSource:    traceIDAttributeSource,
+			},
+			tid: pcommon.TraceID{0, 0, 0, 0, 0, 0, 0, 0, 0, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+			expected: map[string]any{
+				"sampling.threshold": "8",
+			},
+		},
+		{
+			name: "proportional_explicit_randomness",
+			cfg: &Config{
+				SamplingPercentage: 50,
+				Mode:               Proportional,
+				AttributeSource:    traceIDAttributeSource,
+			},
+			attributes: map[string]any{
+				"sampling.threshold":  "c",
+				"sampling.randomness": "ffffffffffffff",
+			},
+			expected: map[string]any{
+				"sampling.threshold":  "e",
+				"sampling.randomness": "ffffffffffffff",
+			},
+		},
+		{
+			name: "record_attribute_randomness",
+			cfg: &Config{
+				SamplingPercentage: 100,
+				Mode:               Equalizing,
+				AttributeSource:    recordAttributeSource,
+				FromAttribute:      "foo",
+			},
+			attributes: map[string]any{
+				"foo": "bar",
+			},
+			expected: map[string]any{
+				"foo":                 "bar",
+				"sampling.threshold":  "0",
+				"sampling.randomness": newAttributeHashingMethod("foo", randomnessFromBytes([]byte("bar"), 0)).randomness().RValue(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.cfg.SamplingPrecision = defaultPrecision
+			sink := new(consumertest.LogsSink)
+			lp, err := newLogsProcessor(context.Background(), processortest.NewNopCreateSettings(), sink, tt.cfg)
+			require.NoError(t, err)
+
+			logs := plog.NewLogs()
+			record := logs.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+			record.SetTraceID(tt.tid)
+			require.NoError(t, record.Attributes().FromRaw(tt.attributes))
+
+			require.NoError(t, lp.ConsumeLogs(context.Background(), logs))
+			require.Equal(t, 1, sink.LogRecordCount())
+			got := sink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+			assert.Equal(t, tt.expected, got.Attributes().AsRaw())
+		})
+	}
+}
@@ -29,21 +29,33 @@ const (
 )
 
 // SamplerMode controls the logic used in making a sampling decision.
-// The HashSeed mode is the only mode, presently, and it is also the
-// default mode.
-//
-// TODO: In the future, when OTEP 235 is introduced, there will be two
-// new modes.
+// HashSeed is the default mode. The Equalizing and Proportional modes
+// implement consistent probability sampling as specified by OTEP 235,
+// encoding the sampling threshold of each item in the W3C tracestate
+// (traces) or in attributes (logs).
 type SamplerMode string
 
 const (
-	HashSeed    SamplerMode = "hash_seed"
-	DefaultMode SamplerMode = HashSeed
-	modeUnset   SamplerMode = ""
+	// HashSeed applies the hash function over the TraceID or an attribute.
+	HashSeed SamplerMode = "hash_seed"
+	// Equalizing sets the sampling probability of every item to the
+	// configured one, unless the item arrives with a lesser probability.
+	Equalizing SamplerMode = "equalizing"
+	// Proportional multiplies the arriving sampling probability of every
+	// item by the configured one.
+	Proportional SamplerMode = "proportional"
+	DefaultMode  SamplerMode = HashSeed
+	modeUnset    SamplerMode = ""
 )
 
-// ErrMissingRandomness indicates no randomness source was found.
-var ErrMissingRandomness = errors.New("missing randomness")
+var (
+	// ErrMissingRandomness indicates no randomness source was found.
+	ErrMissingRandomness = errors.New("missing randomness")
+
+	// ErrInconsistentArrivingTValue indicates the arriving threshold
+	// would not have sampled the item given its randomness.
+	ErrInconsistentArrivingTValue = errors.New("inconsistent arriving threshold: item should not have been sampled")
+)
 
 type randomnessNamer interface {
 	randomness() sampling.Randomness
@@ -57,6 +69,8 @@ func (rm randomnessMethod) randomness() sampling.Randomness {
 }
 
 type traceIDHashingMethod struct{ randomnessMethod }
+type traceIDW3CSpecMethod struct{ randomnessMethod }
+type samplingRandomnessMethod struct{ randomnessMethod }
 type samplingPriorityMethod struct{ randomnessMethod }
 
 type missingRandomnessMethod struct{}
@@ -82,12 +96,22 @@ func (traceIDHashingMethod) policyName() string {
 	return "trace_id_hash"
 }
 
+func (traceIDW3CSpecMethod) policyName() string {
+	return "trace_id_w3c"
+}
+
+func (samplingRandomnessMethod) policyName() string {
+	return "sampling_randomness"
+}
+
 func (samplingPriorityMethod) policyName() string {
 	return "sampling_priority"
 }
 
 var _ randomnessNamer = missingRandomnessMethod{}
 var _ randomnessNamer = traceIDHashingMethod{}
+var _ randomnessNamer = traceIDW3CSpecMethod{}
+var _ randomnessNamer = samplingRandomnessMethod{}
 var _ randomnessNamer = samplingPriorityMethod{}
 
 func newMissingRandomnessMethod() randomnessNamer {
@@ -103,6 +127,14 @@ func newTraceIDHashingMethod(rnd sampling.Randomness) randomnessNamer {
 	return traceIDHashingMethod{randomnessMethod(rnd)}
 }
 
+func newTraceIDW3CSpecMethod(rnd sampling.Randomness) randomnessNamer {
+	return traceIDW3CSpecMethod{randomnessMethod(rnd)}
+}
+
+func newSamplingRandomnessMethod(rnd sampling.Randomness) randomnessNamer {
+	return samplingRandomnessMethod{randomnessMethod(rnd)}
+}
+
 func newSamplingPriorityMethod(rnd sampling.Randomness) randomnessNamer {
 	return samplingPriorityMethod{randomnessMethod(rnd)}
 }
@@ -114,9 +146,25 @@ func newAttributeHashingMethod(attribute string, rnd sampling.Randomness) random
 	}
 }
 
-// TODO: Placeholder interface, see #31894 for its future contents,
-// will become a non-empty interface.  (Linter forces us to write "any".)
-type samplingCarrier any
+// samplingCarrier conveys the consistent sampling information of an
+// item, i.e. its arriving threshold, between the call to parse incoming
+// randomness and the call to decide, and records the new threshold of
+// sampled items.
+type samplingCarrier interface {
+	// threshold returns the arriving threshold, if any.
+	threshold() (sampling.Threshold, bool)
+
+	// clearThreshold unsets the arriving threshold.
+	clearThreshold()
+
+	// updateThreshold sets the threshold the item was sampled with. It
+	// returns sampling.ErrInconsistentSampling rather than raising the
+	// arriving sampling probability.
+	updateThreshold(sampling.Threshold) error
+
+	// reserialize writes the sampling information back to the item.
+	reserialize() error
+}
 
 type dataSampler interface {
 	// decide reports the result based on a probabilistic decision.
@@ -129,11 +177,13 @@ type dataSampler interface {
 	randomnessFromLogRecord(s plog.LogRecord) (randomness randomnessNamer, carrier samplingCarrier, err error)
 }
 
-var AllModes = []SamplerMode{HashSeed}
+var AllModes = []SamplerMode{HashSeed, Equalizing, Proportional}
 
 func (sm *SamplerMode) UnmarshalText(in []byte) error {
 	switch mode := SamplerMode(in); mode {
 	case HashSeed,
+		Equalizing,
+		Proportional,
 		modeUnset:
 		*sm = mode
 		return nil
@@ -161,6 +211,62 @@ func (th *hashingSampler) decide(_ samplingCarrier) sampling.Threshold {
 	return th.tvalueThreshold
 }
 
+// consistentTracestateCommon holds the settings shared by the
+// equalizing and proportional samplers.
+type consistentTracestateCommon struct {
+	// Logs only: name of attribute to obtain randomness
+	logsRandomnessSourceAttribute string
+
+	// Logs only: hash seed applied to the randomness attribute
+	logsRandomnessHashSeed uint32
+
+	// Logs only: whether the TraceID is used as randomness
+	logsTraceIDEnabled bool
+}
+
+// equalizingSampler raises the threshold of the items arriving with a
+// lesser one to the configured threshold.
+type equalizingSampler struct {
+	// TraceID-randomness-based calculation
+	tvalueThreshold sampling.Threshold
+
+	consistentTracestateCommon
+}
+
+func (te *equalizingSampler) decide(carrier samplingCarrier) sampling.Threshold {
+	if tv, has := carrier.threshold(); has && sampling.ThresholdLessThan(te.tvalueThreshold, tv) {
+		return tv
+	}
+	return te.tvalueThreshold
+}
+
+// proportionalSampler multiplies the arriving sampling probability of
+// the items by the configured ratio.
+type proportionalSampler struct {
+	ratio     float64
+	precision int
+
+	consistentTracestateCommon
+}
+
+func (tp *proportionalSampler) decide(carrier samplingCarrier) sampling.Threshold {
+	incoming := 1.0
+	if tv, has := carrier.threshold(); has {
+		incoming = tv.Probability()
+	}
+
+	// There is a potential here for the product probability to
+	// underflow, which is checked here.
+	threshold, err := sampling.ProbabilityToThresholdWithPrecision(incoming*tp.ratio, tp.precision)
+	if errors.Is(err, sampling.ErrProbabilityRange) {
+		// Considered valid, a case where the sampling probability
+		// has fallen below the minimum supported value and simply
+		// becomes unsampled.
+		return sampling.NeverSampleThreshold
+	}
+	return threshold
+}
+
 // neverSampler always decides false.
 type neverSampler struct {
 }
@@ -217,10 +323,19 @@ func randomnessFromBytes(b []byte, hashSeed uint32) sampling.Randomness {
 // consistencyCheck checks for certain inconsistent inputs.
 //
 // if the randomness is missing, returns ErrMissingRandomness.
-func consistencyCheck(rnd randomnessNamer, _ samplingCarrier) error {
+// if the arriving threshold would not have sampled the item, it is
+// cleared and ErrInconsistentArrivingTValue is returned.
+func consistencyCheck(rnd randomnessNamer, carrier samplingCarrier) error {
 	if isMissing(rnd) {
 		return ErrMissingRandomness
 	}
+	if carrier == nil {
+		return nil
+	}
+	if tv, has := carrier.threshold(); has && !tv.ShouldSample(rnd.randomness()) {
+		carrier.clearThreshold()
+		return ErrInconsistentArrivingTValue
+	}
 	return nil
 }
 
@@ -243,6 +358,35 @@ func makeSampler(cfg *Config) dataSampler {
 		return never
 	}
 
+	ctcom := consistentTracestateCommon{
+		logsRandomnessSourceAttribute: cfg.FromAttribute,
+		logsRandomnessHashSeed:        cfg.HashSeed,
+		logsTraceIDEnabled:            cfg.AttributeSource == traceIDAttributeSource,
+	}
+	// Note: float32 to float64 conversion after the division, as
+	// the original hashing logic.
+	ratio := float64(pct / 100)
+
+	switch cfg.Mode {
+	case Equalizing:
+		threshold, err := sampling.ProbabilityToThresholdWithPrecision(ratio, cfg.SamplingPrecision)
+		if err != nil {
+			// The configuration is validated, the probability can
+			// only be out of range when it rounds to zero.
+			return never
+		}
+		return &equalizingSampler{
+			tvalueThreshold:            threshold,
+			consistentTracestateCommon: ctcom,
+		}
+	case Proportional:
+		return &proportionalSampler{
+			ratio:                      ratio,
+			precision:                  cfg.SamplingPrecision,
+			consistentTracestateCommon: ctcom,
+		}
+	}
+
 	// Note: the original hash function used in this code
 	// is preserved to ensure consistency across updates.
 	//
@@ -312,6 +456,25 @@ func commonShouldSampleLogic[T any](
 
 	sampled := threshold.ShouldSample(rnd.randomness())
 
+	if sampled && carrier != nil {
+		// Note: updateThreshold limits loss of adjusted count, by
+		// preventing the threshold from being lowered, only allowing
+		// probability to fall and never to rise.
+		if err = carrier.updateThreshold(threshold); err != nil {
+			if errors.Is(err, sampling.ErrInconsistentSampling) {
+				// This is working-as-intended: the arriving
+				// threshold, e.g. with a sampling priority
+				// forcing the item to be sampled, is kept.
+				logger.Debug(description, zap.Error(err))
+			} else {
+				logger.Info(description, zap.Error(err))
+			}
+		}
+		if err = carrier.reserialize(); err != nil {
+			logger.Info(description, zap.Error(err))
+		}
+	}
+
 	_ = stats.RecordWithTags(
 		ctx,
 		[]tag.Mutator{tag.Upsert(tagPolicyKey, rnd.policyName()), tag.Upsert(tagSampledKey, strconv.FormatBool(sampled))},
@@ -18,6 +18,12 @@ func TestUnmarshalText(t *testing.T) {
 		{
 			samplerMode: "hash_seed",
 		},
+		{
+			samplerMode: "equalizing",
+		},
+		{
+			samplerMode: "proportional",
+		},
 		{
 			samplerMode: "",
 		},
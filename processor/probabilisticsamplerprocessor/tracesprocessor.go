@@ -6,6 +6,7 @@ package probabilisticsamplerprocessor // import "github.com/open-telemetry/opent
 import (
 	"context"
 	"strconv"
+	"strings"
 
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/pdata/pcommon"
@@ -47,14 +48,47 @@ type traceProcessor struct {
 // decide.
 type tracestateCarrier struct {
 	span ptrace.Span
+	sampling.W3CTraceState
 }
 
 var _ samplingCarrier = &tracestateCarrier{}
 
-func newTracestateCarrier(s ptrace.Span) samplingCarrier {
-	return &tracestateCarrier{
+func newTracestateCarrier(s ptrace.Span) (*tracestateCarrier, error) {
+	var err error
+	tsc := &tracestateCarrier{
 		span: s,
 	}
+	tsc.W3CTraceState, err = sampling.NewW3CTraceState(s.TraceState().AsRaw())
+	return tsc, err
+}
+
+func (tc *tracestateCarrier) threshold() (sampling.Threshold, bool) {
+	return tc.W3CTraceState.OTelValue().TValueThreshold()
+}
+
+func (tc *tracestateCarrier) explicitRandomness() (randomnessNamer, bool) {
+	rnd, ok := tc.W3CTraceState.OTelValue().RValueRandomness()
+	if !ok {
+		return newMissingRandomnessMethod(), false
+	}
+	return newSamplingRandomnessMethod(rnd), true
+}
+
+func (tc *tracestateCarrier) updateThreshold(th sampling.Threshold) error {
+	return tc.W3CTraceState.OTelValue().UpdateTValueWithSampling(th)
+}
+
+func (tc *tracestateCarrier) clearThreshold() {
+	tc.W3CTraceState.OTelValue().ClearTValue()
+}
+
+func (tc *tracestateCarrier) reserialize() error {
+	var w strings.Builder
+	err := tc.W3CTraceState.Serialize(&w)
+	if err == nil {
+		tc.span.TraceState().FromRaw(w.String())
+	}
+	return err
 }
 
 // newTracesProcessor returns a processor.TracesProcessor that will
@@ -81,15 +115,35 @@ func (th *neverSampler) randomnessFromSpan(_ ptrace.Span) (randomnessNamer, samp
 	return newSamplingPriorityMethod(sampling.AllProbabilitiesRandomness), nil, nil
 }
 
+// randomnessFromSpan (hashingSampler) uses a hash function over the
+// TraceID. The tracestate is left unmodified in this mode.
 func (th *hashingSampler) randomnessFromSpan(s ptrace.Span) (randomnessNamer, samplingCarrier, error) {
 	tid := s.TraceID()
-	tsc := newTracestateCarrier(s)
 	rnd := newMissingRandomnessMethod()
 	if !tid.IsEmpty() {
 		rnd = newTraceIDHashingMethod(randomnessFromBytes(tid[:], th.hashSeed))
 	}
+	return rnd, nil, nil
+}
+
+// randomnessFromSpan (equalizing and proportional samplers) uses the
+// explicit randomness of the tracestate, otherwise the least
+// significant 56 bits of the TraceID.
+func (ctc *consistentTracestateCommon) randomnessFromSpan(s ptrace.Span) (randomnessNamer, samplingCarrier, error) {
+	rnd := newMissingRandomnessMethod()
+	tsc, err := newTracestateCarrier(s)
+	if err != nil {
+		// The tracestate is not modified when it can't be parsed.
+		return rnd, nil, err
+	}
+	if rv, has := tsc.explicitRandomness(); has {
+		rnd = rv
+	} else if tid := s.TraceID(); !tid.IsEmpty() {
+		rnd = newTraceIDW3CSpecMethod(sampling.TraceIDToRandomness(tid))
+	}
 	return rnd, tsc, nil
 }
+
 func (tp *traceProcessor) processTraces(ctx context.Context, td ptrace.Traces) (ptrace.Traces, error) {
 	td.ResourceSpans().RemoveIf(func(rs ptrace.ResourceSpans) bool {
 		rs.ScopeSpans().RemoveIf(func(ils ptrace.ScopeSpans) bool {
@@ -747,3 +747,117 @@ func TestHashingFunction(t *testing.T) {
 		require.Equal(t, tc.sampled, wasSampled)
 	}
 }
+
+// Test_tracesamplerprocessor_TraceState checks the sampling threshold
+// recorded in the tracestate by the OTEP 235 modes.
+func Test_tracesamplerprocessor_TraceState(t *testing.T) {
+	// the least significant 56 bits of the TraceID are the randomness
+	highRandomTID := mustParseTID("00000000000000000fffffffffffffff")
+	lowRandomTID := mustParseTID("00000000000000000090000000000000")
+
+	tests := []struct {
+		name       string
+		mode       SamplerMode
+		pct        float32
+		tid        pcommon.TraceID
+		tracestate string
+		sampled    bool
+		expected   string
+	}{
+		{
+			name:     "equalizing_no_tracestate",
+			mode:     Equalizing,
+			pct:      50,
+			tid:      highRandomTID,
+			sampled:  true,
+			expected: "ot=th:8",
+		},
+		{
+			name:       "equalizing_lesser_arriving_probability",
+			mode:       Equalizing,
+			pct:        50,
+			tid:        highRandomTID,
+			tracestate: "ot=th:c",
+			sampled:    true,
+			expected:   "ot=th:c",
+		},
+		{
+			name:       "equalizing_greater_arriving_probability",
+			mode:       Equalizing,
+			pct:        25,
+			tid:        highRandomTID,
+			tracestate: "ot=th:8",
+			sampled:    true,
+			expected:   "ot=th:c",
+		},
+		{
+			name:       "proportional",
+			mode:       Proportional,
+			pct:        50,
+			tid:        highRandomTID,
+			tracestate: "ot=th:8,vendor=value",
+			sampled:    true,
+			expected:   "ot=th:c,vendor=value",
+		},
+		{
+			name:       "explicit_randomness_sampled",
+			mode:       Equalizing,
+			pct:        50,
+			tid:        lowRandomTID,
+			tracestate: "ot=rv:ffffffffffffff",
+			sampled:    true,
+			expected:   "ot=rv:ffffffffffffff;th:8",
+		},
+		{
+			name:       "explicit_randomness_not_sampled",
+			mode:       Equalizing,
+			pct:        50,
+			tid:        highRandomTID,
+			tracestate: "ot=rv:00000000000000",
+		},
+		{
+			name:       "inconsistent_arriving_threshold",
+			mode:       Equalizing,
+			pct:        100,
+			tid:        lowRandomTID,
+			tracestate: "ot=th:c",
+		},
+		{
+			name:       "hash_seed_leaves_tracestate",
+			mode:       HashSeed,
+			pct:        100,
+			tid:        highRandomTID,
+			tracestate: "ot=th:c",
+			sampled:    true,
+			expected:   "ot=th:c",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{
+				SamplingPercentage: tt.pct,
+				Mode:               tt.mode,
+				SamplingPrecision:  defaultPrecision,
+				FailClosed:         true,
+			}
+			sink := new(consumertest.TracesSink)
+			tsp, err := newTracesProcessor(context.Background(), processortest.NewNopCreateSettings(), cfg, sink)
+			require.NoError(t, err)
+
+			td := ptrace.NewTraces()
+			span := td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty()
+			span.SetTraceID(tt.tid)
+			span.SetSpanID(pcommon.SpanID{1, 2, 3, 4, 5, 6, 7, 8})
+			span.TraceState().FromRaw(tt.tracestate)
+
+			require.NoError(t, tsp.ConsumeTraces(context.Background(), td))
+			if !tt.sampled {
+				assert.Equal(t, 0, sink.SpanCount())
+				return
+			}
+			require.Equal(t, 1, sink.SpanCount())
+			got := sink.AllTraces()[0].ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0)
+			assert.Equal(t, tt.expected, got.TraceState().AsRaw())
+		})
+	}
+}
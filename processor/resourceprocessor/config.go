@@ -5,10 +5,12 @@ package resourceprocessor // import "github.com/open-telemetry/opentelemetry-col
 
 import (
 	"errors"
+	"fmt"
 
 	"go.opentelemetry.io/collector/component"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/attraction"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
 )
 
 // Config defines configuration for Resource processor.
@@ -17,14 +19,34 @@ type Config struct {
 	// AttributesActions specifies the list of actions to be applied on resource attributes.
 	// The set of actions are {INSERT, UPDATE, UPSERT, DELETE, HASH, EXTRACT}.
 	AttributesActions []attraction.ActionKeyValue `mapstructure:"attributes"`
+
+	// Conditions is a list of OTTL conditions for the resource context. If set, the resources
+	// are only changed if they match at least one of the conditions.
+	Conditions []string `mapstructure:"conditions"`
+
+	// SchemaURL is set as the schema URL of the resources, unless they already refer to
+	// a newer version of the same schema.
+	SchemaURL string `mapstructure:"schema_url"`
+
+	// ErrorMode determines how the processor reacts to errors that occur while evaluating the conditions.
+	// Valid values are `ignore` and `propagate`.
+	// `ignore` means the processor ignores errors returned by conditions and does not change the resource.
+	// `propagate` means the processor returns the error up the pipeline. This will result in the payload being dropped from the collector.
+	// The default value is `propagate`.
+	ErrorMode ottl.ErrorMode `mapstructure:"error_mode"`
 }
 
 var _ component.Config = (*Config)(nil)
 
 // Validate checks if the processor configuration is valid
 func (cfg *Config) Validate() error {
-	if len(cfg.AttributesActions) == 0 {
+	if len(cfg.AttributesActions) == 0 && cfg.SchemaURL == "" {
 		return errors.New("missing required field \"attributes\"")
 	}
+	if cfg.SchemaURL != "" {
+		if _, version := splitSchemaURL(cfg.SchemaURL); version == nil {
+			return fmt.Errorf("invalid schema_url %q, it must end with the version of the schema", cfg.SchemaURL)
+		}
+	}
 	return nil
 }
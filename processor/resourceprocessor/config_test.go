@@ -13,6 +13,7 @@ import (
 	"go.opentelemetry.io/collector/confmap/confmaptest"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/attraction"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourceprocessor/internal/metadata"
 )
 
@@ -32,9 +33,29 @@ func TestLoadConfig(t *testing.T) {
 					{Key: "k8s.cluster.name", FromAttribute: "k8s-cluster", Action: attraction.INSERT},
 					{Key: "redundant-attribute", Action: attraction.DELETE},
 				},
+				ErrorMode: ottl.PropagateError,
 			},
 			valid: true,
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "conditional"),
+			expected: &Config{
+				AttributesActions: []attraction.ActionKeyValue{
+					{Key: "deployment.environment", Value: "production", Action: attraction.UPSERT},
+				},
+				Conditions: []string{`attributes["k8s.namespace.name"] == "prod"`},
+				SchemaURL:  "https://opentelemetry.io/schemas/1.25.0",
+				ErrorMode:  ottl.IgnoreError,
+			},
+			valid: true,
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "invalid_schema_url"),
+			expected: &Config{
+				SchemaURL: "https://opentelemetry.io/schemas/latest",
+				ErrorMode: ottl.PropagateError,
+			},
+		},
 		{
 			id:       component.NewIDWithName(metadata.Type, "invalid"),
 			expected: createDefaultConfig(),
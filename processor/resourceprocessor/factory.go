@@ -11,7 +11,7 @@ import (
 	"go.opentelemetry.io/collector/processor"
 	"go.opentelemetry.io/collector/processor/processorhelper"
 
-	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/attraction"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourceprocessor/internal/metadata"
 )
 
@@ -29,7 +29,9 @@ func NewFactory() processor.Factory {
 
 // Note: This isn't a valid configuration because the processor would do no work.
 func createDefaultConfig() component.Config {
-	return &Config{}
+	return &Config{
+		ErrorMode: ottl.PropagateError,
+	}
 }
 
 func createTracesProcessor(
@@ -37,11 +39,10 @@ func createTracesProcessor(
 	set processor.CreateSettings,
 	cfg component.Config,
 	nextConsumer consumer.Traces) (processor.Traces, error) {
-	attrProc, err := attraction.NewAttrProc(&attraction.Settings{Actions: cfg.(*Config).AttributesActions})
+	proc, err := newResourceProcessor(set, cfg.(*Config))
 	if err != nil {
 		return nil, err
 	}
-	proc := &resourceProcessor{logger: set.Logger, attrProc: attrProc}
 	return processorhelper.NewTracesProcessor(
 		ctx,
 		set,
@@ -56,11 +57,10 @@ func createMetricsProcessor(
 	set processor.CreateSettings,
 	cfg component.Config,
 	nextConsumer consumer.Metrics) (processor.Metrics, error) {
-	attrProc, err := attraction.NewAttrProc(&attraction.Settings{Actions: cfg.(*Config).AttributesActions})
+	proc, err := newResourceProcessor(set, cfg.(*Config))
 	if err != nil {
 		return nil, err
 	}
-	proc := &resourceProcessor{logger: set.Logger, attrProc: attrProc}
 	return processorhelper.NewMetricsProcessor(
 		ctx,
 		set,
@@ -75,11 +75,10 @@ func createLogsProcessor(
 	set processor.CreateSettings,
 	cfg component.Config,
 	nextConsumer consumer.Logs) (processor.Logs, error) {
-	attrProc, err := attraction.NewAttrProc(&attraction.Settings{Actions: cfg.(*Config).AttributesActions})
+	proc, err := newResourceProcessor(set, cfg.(*Config))
 	if err != nil {
 		return nil, err
 	}
-	proc := &resourceProcessor{logger: set.Logger, attrProc: attrProc}
 	return processorhelper.NewLogsProcessor(
 		ctx,
 		set,
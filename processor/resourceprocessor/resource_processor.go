@@ -6,23 +6,52 @@ package resourceprocessor // import "github.com/open-telemetry/opentelemetry-col
 import (
 	"context"
 
+	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/processor"
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/attraction"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/expr"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlresource"
 )
 
 type resourceProcessor struct {
 	logger   *zap.Logger
 	attrProc *attraction.AttrProc
+
+	// condition is nil when all resources are changed
+	condition expr.BoolExpr[ottlresource.TransformContext]
+	schemaURL string
+}
+
+func newResourceProcessor(set processor.CreateSettings, cfg *Config) (*resourceProcessor, error) {
+	attrProc, err := attraction.NewAttrProc(&attraction.Settings{Actions: cfg.AttributesActions})
+	if err != nil {
+		return nil, err
+	}
+	rp := &resourceProcessor{logger: set.Logger, attrProc: attrProc, schemaURL: cfg.SchemaURL}
+	if len(cfg.Conditions) > 0 {
+		rp.condition, err = filterottl.NewBoolExprForResource(cfg.Conditions, filterottl.StandardResourceFuncs(), cfg.ErrorMode, set.TelemetrySettings)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return rp, nil
 }
 
 func (rp *resourceProcessor) processTraces(ctx context.Context, td ptrace.Traces) (ptrace.Traces, error) {
 	rss := td.ResourceSpans()
 	for i := 0; i < rss.Len(); i++ {
-		rp.attrProc.Process(ctx, rp.logger, rss.At(i).Resource().Attributes())
+		rs := rss.At(i)
+		schemaURL, err := rp.processResource(ctx, rs.Resource(), rs.SchemaUrl())
+		if err != nil {
+			return td, err
+		}
+		rs.SetSchemaUrl(schemaURL)
 	}
 	return td, nil
 }
@@ -30,7 +59,12 @@ func (rp *resourceProcessor) processTraces(ctx context.Context, td ptrace.Traces
 func (rp *resourceProcessor) processMetrics(ctx context.Context, md pmetric.Metrics) (pmetric.Metrics, error) {
 	rms := md.ResourceMetrics()
 	for i := 0; i < rms.Len(); i++ {
-		rp.attrProc.Process(ctx, rp.logger, rms.At(i).Resource().Attributes())
+		rm := rms.At(i)
+		schemaURL, err := rp.processResource(ctx, rm.Resource(), rm.SchemaUrl())
+		if err != nil {
+			return md, err
+		}
+		rm.SetSchemaUrl(schemaURL)
 	}
 	return md, nil
 }
@@ -38,7 +72,25 @@ func (rp *resourceProcessor) processMetrics(ctx context.Context, md pmetric.Metr
 func (rp *resourceProcessor) processLogs(ctx context.Context, ld plog.Logs) (plog.Logs, error) {
 	rls := ld.ResourceLogs()
 	for i := 0; i < rls.Len(); i++ {
-		rp.attrProc.Process(ctx, rp.logger, rls.At(i).Resource().Attributes())
+		rl := rls.At(i)
+		schemaURL, err := rp.processResource(ctx, rl.Resource(), rl.SchemaUrl())
+		if err != nil {
+			return ld, err
+		}
+		rl.SetSchemaUrl(schemaURL)
 	}
 	return ld, nil
 }
+
+// processResource applies the attributes actions to resource if it matches the conditions,
+// and returns the schema URL the resource should have.
+func (rp *resourceProcessor) processResource(ctx context.Context, resource pcommon.Resource, schemaURL string) (string, error) {
+	if rp.condition != nil {
+		matches, err := rp.condition.Eval(ctx, ottlresource.NewTransformContext(resource))
+		if err != nil || !matches {
+			return schemaURL, err
+		}
+	}
+	rp.attrProc.Process(ctx, rp.logger, resource.Attributes())
+	return upgradeSchemaURL(schemaURL, rp.schemaURL), nil
+}
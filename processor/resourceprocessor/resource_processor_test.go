@@ -17,6 +17,7 @@ import (
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/attraction"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/testdata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/plogtest"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/pmetrictest"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/ptracetest"
@@ -170,3 +171,77 @@ func generateLogData(attributes map[string]string) plog.Logs {
 	}
 	return ld
 }
+
+func TestResourceProcessorConditions(t *testing.T) {
+	config := &Config{
+		AttributesActions: []attraction.ActionKeyValue{
+			{Key: "deployment.environment", Value: "production", Action: attraction.UPSERT},
+		},
+		Conditions: []string{`attributes["k8s.namespace.name"] == "prod"`},
+		SchemaURL:  "https://opentelemetry.io/schemas/1.25.0",
+		ErrorMode:  ottl.PropagateError,
+	}
+
+	sink := new(consumertest.LogsSink)
+	rlp, err := NewFactory().CreateLogsProcessor(context.Background(), processortest.NewNopCreateSettings(), config, sink)
+	require.NoError(t, err)
+
+	ld := plog.NewLogs()
+	matching := ld.ResourceLogs().AppendEmpty()
+	matching.Resource().Attributes().PutStr("k8s.namespace.name", "prod")
+	other := ld.ResourceLogs().AppendEmpty()
+	other.Resource().Attributes().PutStr("k8s.namespace.name", "dev")
+	other.SetSchemaUrl("https://opentelemetry.io/schemas/1.20.0")
+	newer := ld.ResourceLogs().AppendEmpty()
+	newer.Resource().Attributes().PutStr("k8s.namespace.name", "prod")
+	newer.SetSchemaUrl("https://opentelemetry.io/schemas/1.26.0")
+
+	require.NoError(t, rlp.ConsumeLogs(context.Background(), ld))
+	require.Len(t, sink.AllLogs(), 1)
+	rls := sink.AllLogs()[0].ResourceLogs()
+
+	env, ok := rls.At(0).Resource().Attributes().Get("deployment.environment")
+	require.True(t, ok)
+	assert.Equal(t, "production", env.Str())
+	assert.Equal(t, "https://opentelemetry.io/schemas/1.25.0", rls.At(0).SchemaUrl())
+
+	// resources not matching the conditions are left unchanged
+	_, ok = rls.At(1).Resource().Attributes().Get("deployment.environment")
+	assert.False(t, ok)
+	assert.Equal(t, "https://opentelemetry.io/schemas/1.20.0", rls.At(1).SchemaUrl())
+
+	// the schema URL is never downgraded
+	_, ok = rls.At(2).Resource().Attributes().Get("deployment.environment")
+	assert.True(t, ok)
+	assert.Equal(t, "https://opentelemetry.io/schemas/1.26.0", rls.At(2).SchemaUrl())
+}
+
+func TestResourceProcessorInvalidCondition(t *testing.T) {
+	config := &Config{
+		AttributesActions: cfg.AttributesActions,
+		Conditions:        []string{`attributes["key"] ==`},
+		ErrorMode:         ottl.PropagateError,
+	}
+	_, err := NewFactory().CreateTracesProcessor(context.Background(), processortest.NewNopCreateSettings(), config, consumertest.NewNop())
+	assert.Error(t, err)
+}
+
+func TestUpgradeSchemaURL(t *testing.T) {
+	tests := []struct {
+		current string
+		target  string
+		want    string
+	}{
+		{current: "", target: "https://opentelemetry.io/schemas/1.25.0", want: "https://opentelemetry.io/schemas/1.25.0"},
+		{current: "https://opentelemetry.io/schemas/1.9.0", target: "https://opentelemetry.io/schemas/1.25.0", want: "https://opentelemetry.io/schemas/1.25.0"},
+		{current: "https://opentelemetry.io/schemas/1.25.1", target: "https://opentelemetry.io/schemas/1.25.0", want: "https://opentelemetry.io/schemas/1.25.1"},
+		{current: "https://opentelemetry.io/schemas/1.25", target: "https://opentelemetry.io/schemas/1.25.0", want: "https://opentelemetry.io/schemas/1.25.0"},
+		{current: "https://example.com/schemas/2.0.0", target: "https://opentelemetry.io/schemas/1.25.0", want: "https://opentelemetry.io/schemas/1.25.0"},
+		{current: "https://opentelemetry.io/schemas/1.25.0", target: "", want: "https://opentelemetry.io/schemas/1.25.0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.current, func(t *testing.T) {
+			assert.Equal(t, tt.want, upgradeSchemaURL(tt.current, tt.target))
+		})
+	}
+}
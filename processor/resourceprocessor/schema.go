@@ -0,0 +1,74 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package resourceprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourceprocessor"
+
+import (
+	"strconv"
+	"strings"
+)
+
+// upgradeSchemaURL returns the schema URL of a resource currently using current once upgraded
+// to target. A resource already using a newer version of the same schema keeps its schema URL.
+func upgradeSchemaURL(current, target string) string {
+	if target == "" {
+		return current
+	}
+	if current == "" {
+		return target
+	}
+	family, version := splitSchemaURL(current)
+	targetFamily, targetVersion := splitSchemaURL(target)
+	if family == targetFamily && compareVersions(version, targetVersion) > 0 {
+		return current
+	}
+	return target
+}
+
+// splitSchemaURL splits a schema URL such as https://opentelemetry.io/schemas/1.25.0 into the
+// schema family and its version. The version is nil if it is not made of dot separated numbers.
+func splitSchemaURL(url string) (string, []int) {
+	i := strings.LastIndexByte(url, '/')
+	if i < 0 {
+		return url, nil
+	}
+	parts := strings.Split(url[i+1:], ".")
+	version := make([]int, len(parts))
+	for j, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 {
+			return url[:i], nil
+		}
+		version[j] = n
+	}
+	return url[:i], version
+}
+
+// compareVersions returns -1, 0 or 1 when a is lower, equal or greater than b.
+// Versions which can't be parsed are considered lower than any other.
+func compareVersions(a, b []int) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return -1
+	case b == nil:
+		return 1
+	}
+	for i := 0; i < len(a) || i < len(b); i++ {
+		var x, y int
+		if i < len(a) {
+			x = a[i]
+		}
+		if i < len(b) {
+			y = b[i]
+		}
+		if x != y {
+			if x < y {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
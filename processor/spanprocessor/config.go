@@ -25,6 +25,11 @@ type Config struct {
 
 	// SetStatus specifies status which should be set for this span.
 	SetStatus *Status `mapstructure:"status"`
+
+	// DropEvents is a list of regex patterns matched against the names of the
+	// span events. Matching events are removed from the span and counted in its
+	// dropped events count. They are not copied anywhere else.
+	DropEvents []string `mapstructure:"drop_events"`
 }
 
 // Name specifies the attributes to use to re-name a span.
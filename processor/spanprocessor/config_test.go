@@ -97,6 +97,12 @@ func TestLoadingConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			id: component.MustNewIDWithName("span", "drop_events"),
+			expected: &Config{
+				DropEvents: []string{"^exception$"},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.id.String(), func(t *testing.T) {
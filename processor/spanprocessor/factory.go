@@ -30,7 +30,7 @@ var processorCapabilities = consumer.Capabilities{MutatesData: true}
 //
 //	Move this to the error package that allows for span name and field to be specified.
 var (
-	errMissingRequiredField       = errors.New("error creating \"span\" processor: either \"from_attributes\" or \"to_attributes\" must be specified in \"name:\" or \"setStatus\" must be specified")
+	errMissingRequiredField       = errors.New("error creating \"span\" processor: either \"from_attributes\" or \"to_attributes\" must be specified in \"name:\" or \"setStatus\" or \"events\" must be specified")
 	errIncorrectStatusCode        = errors.New("error creating \"span\" processor: \"status\" must have specified \"code\" as \"Ok\" or \"Error\" or \"Unset\"")
 	errIncorrectStatusDescription = errors.New("error creating \"span\" processor: \"description\" can be specified only for \"code\" \"Error\"")
 )
@@ -59,7 +59,8 @@ func createTracesProcessor(
 	oCfg := cfg.(*Config)
 	if len(oCfg.Rename.FromAttributes) == 0 &&
 		(oCfg.Rename.ToAttributes == nil || len(oCfg.Rename.ToAttributes.Rules) == 0) &&
-		oCfg.SetStatus == nil {
+		oCfg.SetStatus == nil &&
+		len(oCfg.DropEvents) == 0 {
 		return nil, errMissingRequiredField
 	}
 
@@ -21,6 +21,7 @@ import (
 type spanProcessor struct {
 	config           Config
 	toAttributeRules []toAttributeRule
+	dropEvents       []*regexp.Regexp
 	skipExpr         expr.BoolExpr[ottlspan.TransformContext]
 }
 
@@ -63,6 +64,14 @@ func newSpanProcessor(config Config) (*spanProcessor, error) {
 		}
 	}
 
+	for _, pattern := range config.DropEvents {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid regexp pattern %s", pattern)
+		}
+		sp.dropEvents = append(sp.dropEvents, re)
+	}
+
 	return sp, nil
 }
 
@@ -90,6 +99,7 @@ func (sp *spanProcessor) processTraces(ctx context.Context, td ptrace.Traces) (p
 				sp.processFromAttributes(span)
 				sp.processToAttributes(span)
 				sp.processUpdateStatus(span)
+				sp.processDropEvents(span)
 			}
 		}
 	}
@@ -238,3 +248,21 @@ func (sp *spanProcessor) processUpdateStatus(span ptrace.Span) {
 		}
 	}
 }
+
+func (sp *spanProcessor) processDropEvents(span ptrace.Span) {
+	if len(sp.dropEvents) == 0 {
+		return
+	}
+
+	var dropped uint32
+	span.Events().RemoveIf(func(event ptrace.SpanEvent) bool {
+		for _, re := range sp.dropEvents {
+			if re.MatchString(event.Name()) {
+				dropped++
+				return true
+			}
+		}
+		return false
+	})
+	span.SetDroppedEventsCount(span.DroppedEventsCount() + dropped)
+}
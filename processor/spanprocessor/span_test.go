@@ -651,3 +651,36 @@ func TestSpanProcessor_setStatusCodeConditionally(t *testing.T) {
 		})
 	}
 }
+
+func TestSpanProcessor_dropEvents(t *testing.T) {
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+	oCfg := cfg.(*Config)
+	oCfg.DropEvents = []string{"^exception$", "^cache\\."}
+	tp, err := factory.CreateTracesProcessor(context.Background(), processortest.NewNopCreateSettings(), oCfg, consumertest.NewNop())
+	require.NoError(t, err)
+
+	td := ptrace.NewTraces()
+	span := td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty()
+	span.SetName("GET /users")
+	span.SetDroppedEventsCount(1)
+	for _, name := range []string{"exception", "cache.miss", "retry", "exception.handled"} {
+		span.Events().AppendEmpty().SetName(name)
+	}
+
+	assert.NoError(t, tp.ConsumeTraces(context.Background(), td))
+
+	require.Equal(t, 2, span.Events().Len())
+	assert.Equal(t, "retry", span.Events().At(0).Name())
+	assert.Equal(t, "exception.handled", span.Events().At(1).Name())
+	assert.Equal(t, uint32(3), span.DroppedEventsCount())
+}
+
+func TestSpanProcessor_dropEventsInvalidPattern(t *testing.T) {
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+	oCfg := cfg.(*Config)
+	oCfg.DropEvents = []string{"(exception"}
+	_, err := factory.CreateTracesProcessor(context.Background(), processortest.NewNopCreateSettings(), oCfg, consumertest.NewNop())
+	assert.EqualError(t, err, "invalid regexp pattern (exception")
+}
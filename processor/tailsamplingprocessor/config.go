@@ -4,8 +4,12 @@
 package tailsamplingprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/tailsamplingprocessor"
 
 import (
+	"errors"
+	"fmt"
 	"time"
 
+	"go.opentelemetry.io/collector/component"
+
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
 )
 
@@ -159,8 +163,23 @@ type ProbabilisticCfg struct {
 	// SamplingPercentage is the percentage rate at which traces are going to be sampled. Defaults to zero, i.e.: no sample.
 	// Values greater or equal 100 are treated as "sample all traces".
 	SamplingPercentage float64 `mapstructure:"sampling_percentage"`
+	// Mode selects how the traces are sampled, hashing the trace ID with the salt by default.
+	// The equalizing mode samples consistently as specified by OTEP 235, recording the
+	// sampling threshold of the sampled traces in their tracestate.
+	Mode ProbabilisticMode `mapstructure:"mode"`
 }
 
+// ProbabilisticMode selects the sampling behavior of the probabilistic policy.
+type ProbabilisticMode string
+
+const (
+	// ProbabilisticHashSalt hashes the trace ID with the configured salt.
+	ProbabilisticHashSalt ProbabilisticMode = "hash_salt"
+	// ProbabilisticEqualizing uses the randomness of the tracestate or the trace ID, equalizing
+	// the sampling probability of the traces arriving with a greater one.
+	ProbabilisticEqualizing ProbabilisticMode = "equalizing"
+)
+
 // StatusCodeCfg holds the configurable settings to create a status code filter sampling
 // policy evaluator.
 type StatusCodeCfg struct {
@@ -220,6 +239,33 @@ type OTTLConditionCfg struct {
 	SpanEventConditions []string       `mapstructure:"spanevent"`
 }
 
+// LateSpanPolicy indicates how the spans arriving after the sampling decision of their trace are handled.
+type LateSpanPolicy string
+
+const (
+	// LateSpanForward applies the decision of the trace: the late spans of sampled traces are
+	// forwarded and those of traces not sampled are dropped.
+	LateSpanForward LateSpanPolicy = "forward"
+	// LateSpanDrop drops the late spans whatever the decision of the trace.
+	LateSpanDrop LateSpanPolicy = "drop"
+	// LateSpanReevaluate forwards the late spans of sampled traces and evaluates the policies
+	// again for those of traces not sampled.
+	LateSpanReevaluate LateSpanPolicy = "reevaluate"
+)
+
+// DecisionCacheCfg holds the configurable settings of the cache keeping the sampling decisions
+// of the traces removed from memory.
+type DecisionCacheCfg struct {
+	// Size is the number of decisions kept. The cache is disabled if zero.
+	Size int `mapstructure:"size"`
+	// TTL is how long a decision is kept after it was made. Decisions are kept until evicted
+	// by newer ones if zero.
+	TTL time.Duration `mapstructure:"ttl"`
+	// Storage is the ID of the storage extension the decisions are persisted to on shutdown,
+	// so the spans arriving after a restart get the decision made before it.
+	Storage *component.ID `mapstructure:"storage"`
+}
+
 // Config holds the configuration for tail-based sampling.
 type Config struct {
 	// DecisionWait is the desired wait time from the arrival of the first span of
@@ -234,4 +280,25 @@ type Config struct {
 	// PolicyCfgs sets the tail-based sampling policy which makes a sampling decision
 	// for a given trace when requested.
 	PolicyCfgs []PolicyCfg `mapstructure:"policies"`
+	// DecisionCache sets the cache keeping the decisions of the traces removed from memory.
+	DecisionCache DecisionCacheCfg `mapstructure:"decision_cache"`
+	// LateSpanPolicy determines how the spans arriving after the decision about their trace
+	// are handled.
+	LateSpanPolicy LateSpanPolicy `mapstructure:"late_span_policy"`
+}
+
+// Validate checks if the processor configuration is valid.
+func (cfg *Config) Validate() error {
+	switch cfg.LateSpanPolicy {
+	case "", LateSpanForward, LateSpanDrop, LateSpanReevaluate:
+	default:
+		return fmt.Errorf("unknown late span policy %q", cfg.LateSpanPolicy)
+	}
+	if cfg.DecisionCache.Size < 0 {
+		return errors.New("decision cache size must not be negative")
+	}
+	if cfg.DecisionCache.Storage != nil && cfg.DecisionCache.Size == 0 {
+		return errors.New("decision cache storage requires a decision cache size")
+	}
+	return nil
 }
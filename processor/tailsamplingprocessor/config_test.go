@@ -36,6 +36,11 @@ func TestLoadConfig(t *testing.T) {
 			DecisionWait:            10 * time.Second,
 			NumTraces:               100,
 			ExpectedNewTracesPerSec: 10,
+			DecisionCache: DecisionCacheCfg{
+				Size: 1000,
+				TTL:  10 * time.Minute,
+			},
+			LateSpanPolicy: LateSpanReevaluate,
 			PolicyCfgs: []PolicyCfg{
 				{
 					sharedPolicyCfg: sharedPolicyCfg{
@@ -186,3 +191,42 @@ func TestLoadConfig(t *testing.T) {
 			},
 		})
 }
+
+func TestValidateConfig(t *testing.T) {
+	storageID := component.MustNewID("file_storage")
+	tests := []struct {
+		name   string
+		cfg    Config
+		errMsg string
+	}{
+		{
+			name: "valid",
+			cfg:  Config{LateSpanPolicy: LateSpanDrop, DecisionCache: DecisionCacheCfg{Size: 10, Storage: &storageID}},
+		},
+		{
+			name:   "unknown late span policy",
+			cfg:    Config{LateSpanPolicy: "forget"},
+			errMsg: `unknown late span policy "forget"`,
+		},
+		{
+			name:   "negative decision cache size",
+			cfg:    Config{DecisionCache: DecisionCacheCfg{Size: -1}},
+			errMsg: "decision cache size must not be negative",
+		},
+		{
+			name:   "storage without decision cache",
+			cfg:    Config{DecisionCache: DecisionCacheCfg{Storage: &storageID}},
+			errMsg: "decision cache storage requires a decision cache size",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.Validate()
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tt.errMsg)
+		})
+	}
+}
@@ -0,0 +1,145 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tailsamplingprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/tailsamplingprocessor"
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/extension/experimental/storage"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/tailsamplingprocessor/internal/sampling"
+)
+
+// decisionCacheKey is the storage key the decisions are persisted under.
+const decisionCacheKey = "decisions"
+
+// size of an encoded entry: trace ID, decision and decision time
+const decisionEntrySize = 16 + 1 + 8
+
+var errInvalidDecisions = errors.New("invalid persisted sampling decisions")
+
+type cachedDecision struct {
+	decision sampling.Decision
+	time     time.Time
+}
+
+// decisionCache keeps the final decision of the traces removed from memory, so the spans
+// arriving for them later on get the same decision instead of being evaluated as a new trace.
+// The decisions are kept in the order they were made, the oldest ones being evicted once the
+// cache is full or their TTL expired.
+type decisionCache struct {
+	size int
+	ttl  time.Duration
+
+	mu        sync.Mutex
+	decisions map[pcommon.TraceID]cachedDecision
+	order     []pcommon.TraceID
+
+	// client persists the decisions so they survive restarts, nil if no storage is configured.
+	client storage.Client
+}
+
+func newDecisionCache(size int, ttl time.Duration) *decisionCache {
+	return &decisionCache{
+		size:      size,
+		ttl:       ttl,
+		decisions: make(map[pcommon.TraceID]cachedDecision, size),
+	}
+}
+
+// add records the decision made at the given time for the trace.
+func (c *decisionCache) add(id pcommon.TraceID, decision sampling.Decision, decisionTime time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.decisions[id]; !ok {
+		c.order = append(c.order, id)
+	}
+	c.decisions[id] = cachedDecision{decision: decision, time: decisionTime}
+	for len(c.decisions) > c.size {
+		c.evictOldest()
+	}
+}
+
+// get returns the decision cached for the trace, if its TTL didn't expire.
+func (c *decisionCache) get(id pcommon.TraceID) (cachedDecision, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	d, ok := c.decisions[id]
+	if !ok || c.expired(d, time.Now()) {
+		return cachedDecision{}, false
+	}
+	return d, true
+}
+
+// evictExpired removes the decisions whose TTL expired.
+func (c *decisionCache) evictExpired(now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for len(c.order) > 0 {
+		d, ok := c.decisions[c.order[0]]
+		if ok && !c.expired(d, now) {
+			return
+		}
+		c.evictOldest()
+	}
+}
+
+func (c *decisionCache) expired(d cachedDecision, now time.Time) bool {
+	return c.ttl > 0 && now.Sub(d.time) > c.ttl
+}
+
+func (c *decisionCache) evictOldest() {
+	delete(c.decisions, c.order[0])
+	c.order[0] = pcommon.TraceID{}
+	c.order = c.order[1:]
+}
+
+// load restores the decisions persisted in the storage.
+func (c *decisionCache) load(ctx context.Context) error {
+	if c.client == nil {
+		return nil
+	}
+	buf, err := c.client.Get(ctx, decisionCacheKey)
+	if err != nil || buf == nil {
+		return err
+	}
+	if len(buf)%decisionEntrySize != 0 {
+		return errInvalidDecisions
+	}
+	now := time.Now()
+	for ; len(buf) > 0; buf = buf[decisionEntrySize:] {
+		var id pcommon.TraceID
+		copy(id[:], buf[:16])
+		d := cachedDecision{
+			decision: sampling.Decision(buf[16]),
+			time:     time.Unix(0, int64(binary.BigEndian.Uint64(buf[17:decisionEntrySize]))),
+		}
+		if !c.expired(d, now) {
+			c.add(id, d.decision, d.time)
+		}
+	}
+	return nil
+}
+
+// persist writes the cached decisions to the storage.
+func (c *decisionCache) persist(ctx context.Context) error {
+	if c.client == nil {
+		return nil
+	}
+	c.mu.Lock()
+	buf := make([]byte, 0, len(c.order)*decisionEntrySize)
+	for _, id := range c.order {
+		d := c.decisions[id]
+		buf = append(buf, id[:]...)
+		buf = append(buf, byte(d.decision))
+		buf = binary.BigEndian.AppendUint64(buf, uint64(d.time.UnixNano()))
+	}
+	c.mu.Unlock()
+	return c.client.Set(ctx, decisionCacheKey, buf)
+}
@@ -0,0 +1,107 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tailsamplingprocessor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/tailsamplingprocessor/internal/sampling"
+)
+
+func TestDecisionCacheEviction(t *testing.T) {
+	c := newDecisionCache(2, time.Minute)
+	now := time.Now()
+	c.add(uInt64ToTraceID(1), sampling.Sampled, now.Add(-2*time.Minute))
+	c.add(uInt64ToTraceID(2), sampling.NotSampled, now)
+	c.add(uInt64ToTraceID(3), sampling.Sampled, now)
+
+	// the oldest decision is evicted once the cache is full
+	_, ok := c.get(uInt64ToTraceID(1))
+	assert.False(t, ok)
+	d, ok := c.get(uInt64ToTraceID(2))
+	require.True(t, ok)
+	assert.Equal(t, sampling.NotSampled, d.decision)
+
+	c.evictExpired(now.Add(2 * time.Minute))
+	assert.Empty(t, c.decisions)
+	assert.Empty(t, c.order)
+}
+
+func TestDecisionCacheExpired(t *testing.T) {
+	c := newDecisionCache(10, time.Minute)
+	c.add(uInt64ToTraceID(1), sampling.Sampled, time.Now().Add(-2*time.Minute))
+	_, ok := c.get(uInt64ToTraceID(1))
+	assert.False(t, ok)
+}
+
+func TestDecisionCachePersistence(t *testing.T) {
+	client := &memClient{data: map[string][]byte{}}
+	now := time.Now()
+
+	c := newDecisionCache(10, time.Minute)
+	c.client = client
+	c.add(uInt64ToTraceID(1), sampling.Sampled, now)
+	c.add(uInt64ToTraceID(2), sampling.NotSampled, now)
+	c.add(uInt64ToTraceID(3), sampling.Sampled, now.Add(-2*time.Minute))
+	require.NoError(t, c.persist(context.Background()))
+
+	restored := newDecisionCache(10, time.Minute)
+	restored.client = client
+	require.NoError(t, restored.load(context.Background()))
+
+	d, ok := restored.get(uInt64ToTraceID(1))
+	require.True(t, ok)
+	assert.Equal(t, sampling.Sampled, d.decision)
+	assert.Equal(t, now.UnixNano(), d.time.UnixNano())
+	d, ok = restored.get(uInt64ToTraceID(2))
+	require.True(t, ok)
+	assert.Equal(t, sampling.NotSampled, d.decision)
+	// expired decisions aren't restored
+	assert.Len(t, restored.decisions, 2)
+
+	client.data[decisionCacheKey] = []byte{1, 2, 3}
+	assert.ErrorIs(t, restored.load(context.Background()), errInvalidDecisions)
+}
+
+type memClient struct {
+	data map[string][]byte
+}
+
+func (m *memClient) Get(_ context.Context, key string) ([]byte, error) {
+	return m.data[key], nil
+}
+
+func (m *memClient) Set(_ context.Context, key string, value []byte) error {
+	m.data[key] = value
+	return nil
+}
+
+func (m *memClient) Delete(_ context.Context, key string) error {
+	delete(m.data, key)
+	return nil
+}
+
+func (m *memClient) Batch(ctx context.Context, ops ...storage.Operation) error {
+	for _, op := range ops {
+		switch op.Type {
+		case storage.Get:
+			op.Value, _ = m.Get(ctx, op.Key)
+		case storage.Set:
+			_ = m.Set(ctx, op.Key, op.Value)
+		case storage.Delete:
+			_ = m.Delete(ctx, op.Key)
+		}
+	}
+	return nil
+}
+
+func (m *memClient) Close(context.Context) error {
+	return nil
+}
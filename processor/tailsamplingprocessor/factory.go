@@ -49,6 +49,10 @@ func createDefaultConfig() component.Config {
 	return &Config{
 		DecisionWait: 30 * time.Second,
 		NumTraces:    50000,
+		DecisionCache: DecisionCacheCfg{
+			TTL: 5 * time.Minute,
+		},
+		LateSpanPolicy: LateSpanForward,
 	}
 }
 
@@ -59,5 +63,5 @@ func createTracesProcessor(
 	nextConsumer consumer.Traces,
 ) (processor.Traces, error) {
 	tCfg := cfg.(*Config)
-	return newTracesProcessor(ctx, params.TelemetrySettings, nextConsumer, *tCfg)
+	return newTracesProcessor(ctx, params, nextConsumer, *tCfg)
 }
@@ -11,7 +11,10 @@ import (
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.uber.org/zap"
+
+	pkgsampling "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/sampling"
 )
 
 const (
@@ -52,6 +55,77 @@ func (s *probabilisticSampler) Evaluate(_ context.Context, traceID pcommon.Trace
 	return NotSampled, nil
 }
 
+// ThresholdPolicyEvaluator is a PolicyEvaluator sampling traces consistently, as specified by
+// OTEP 235, the sampled traces being recorded with a sampling threshold in their tracestate.
+type ThresholdPolicyEvaluator interface {
+	PolicyEvaluator
+	// Threshold returns the sampling threshold of the traces sampled by the policy.
+	Threshold() pkgsampling.Threshold
+}
+
+type consistentProbabilisticSampler struct {
+	logger    *zap.Logger
+	threshold pkgsampling.Threshold
+}
+
+var _ ThresholdPolicyEvaluator = (*consistentProbabilisticSampler)(nil)
+
+// NewConsistentProbabilisticSampler creates a policy evaluator that samples a percentage of
+// traces using the randomness of the W3C tracestate or of the trace ID, equalizing the
+// sampling probability of the traces arriving with a greater one.
+func NewConsistentProbabilisticSampler(settings component.TelemetrySettings, samplingPercentage float64) (ThresholdPolicyEvaluator, error) {
+	threshold := pkgsampling.NeverSampleThreshold
+	if samplingPercentage > 0 {
+		var err error
+		threshold, err = pkgsampling.ProbabilityToThreshold(math.Min(samplingPercentage/100, 1))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &consistentProbabilisticSampler{
+		logger:    settings.Logger,
+		threshold: threshold,
+	}, nil
+}
+
+// Threshold returns the configured sampling threshold.
+func (s *consistentProbabilisticSampler) Threshold() pkgsampling.Threshold {
+	return s.threshold
+}
+
+// Evaluate looks at the trace data and returns a corresponding SamplingDecision.
+func (s *consistentProbabilisticSampler) Evaluate(_ context.Context, traceID pcommon.TraceID, trace *TraceData) (Decision, error) {
+	s.logger.Debug("Evaluating spans in consistent probabilistic filter")
+
+	rnd := pkgsampling.TraceIDToRandomness(traceID)
+	threshold := s.threshold
+
+	trace.Lock()
+	defer trace.Unlock()
+	// the first span carrying OpenTelemetry sampling values conveys those of the trace
+	hasSpanWithCondition(trace.ReceivedBatches, func(span ptrace.Span) bool {
+		ts, err := pkgsampling.NewW3CTraceState(span.TraceState().AsRaw())
+		if err != nil {
+			return false
+		}
+		otts := ts.OTelValue()
+		rv, hasRandomness := otts.RValueRandomness()
+		if hasRandomness {
+			rnd = rv
+		}
+		th, hasThreshold := otts.TValueThreshold()
+		if hasThreshold && pkgsampling.ThresholdLessThan(threshold, th) {
+			threshold = th
+		}
+		return hasRandomness || hasThreshold
+	})
+
+	if threshold.ShouldSample(rnd) {
+		return Sampled, nil
+	}
+	return NotSampled, nil
+}
+
 // calculateThreshold converts a ratio into a value between 0 and MaxUint64
 func calculateThreshold(ratio float64) uint64 {
 	// Use big.Float and big.Int to calculate threshold because directly convert
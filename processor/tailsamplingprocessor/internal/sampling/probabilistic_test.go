@@ -10,8 +10,11 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	pkgsampling "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/sampling"
 )
 
 func TestProbabilisticSampling(t *testing.T) {
@@ -90,6 +93,59 @@ func TestProbabilisticSampling(t *testing.T) {
 	}
 }
 
+func TestConsistentProbabilisticSampling(t *testing.T) {
+	for _, pct := range []float64{0, 25, 50, 100} {
+		probabilisticSampler, err := NewConsistentProbabilisticSampler(componenttest.NewNopTelemetrySettings(), pct)
+		require.NoError(t, err)
+
+		traceCount := 100_000
+		sampled := 0
+		for _, traceID := range genRandomTraceIDs(traceCount) {
+			decision, err := probabilisticSampler.Evaluate(context.Background(), traceID, newTraceStringAttrs(nil, "example", "value"))
+			require.NoError(t, err)
+			if decision == Sampled {
+				sampled++
+			}
+		}
+		assert.InDelta(t, pct, float64(sampled)/float64(traceCount)*100, 0.5)
+	}
+}
+
+func TestConsistentProbabilisticSamplingTraceState(t *testing.T) {
+	// the least significant 56 bits of the trace ID are its randomness
+	traceID := pcommon.TraceID{0, 0, 0, 0, 0, 0, 0, 0, 0, 0xa0}
+
+	tests := []struct {
+		name       string
+		tracestate string
+		decision   Decision
+	}{
+		{name: "trace ID randomness", decision: Sampled},
+		{name: "explicit randomness", tracestate: "ot=rv:10000000000000", decision: NotSampled},
+		{name: "lesser arriving probability", tracestate: "ot=th:c", decision: NotSampled},
+		{name: "greater arriving probability", tracestate: "ot=th:4", decision: Sampled},
+		{name: "invalid tracestate", tracestate: "ot=th:xyz", decision: Sampled},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			probabilisticSampler, err := NewConsistentProbabilisticSampler(componenttest.NewNopTelemetrySettings(), 50)
+			require.NoError(t, err)
+			assert.Equal(t, "8", probabilisticSampler.Threshold().TValue())
+
+			trace := newTraceStringAttrs(nil, "example", "value")
+			trace.ReceivedBatches.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).TraceState().FromRaw(tt.tracestate)
+
+			decision, err := probabilisticSampler.Evaluate(context.Background(), traceID, trace)
+			require.NoError(t, err)
+			assert.Equal(t, tt.decision, decision)
+		})
+	}
+
+	probabilisticSampler, err := NewConsistentProbabilisticSampler(componenttest.NewNopTelemetrySettings(), 0)
+	require.NoError(t, err)
+	assert.Equal(t, pkgsampling.NeverSampleThreshold, probabilisticSampler.Threshold())
+}
+
 func genRandomTraceIDs(num int) (ids []pcommon.TraceID) {
 	r := rand.New(rand.NewSource(1))
 	ids = make([]pcommon.TraceID, 0, num)
@@ -5,9 +5,11 @@ package tailsamplingprocessor // import "github.com/open-telemetry/opentelemetry
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math"
 	"runtime"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -16,12 +18,14 @@ import (
 	"go.opencensus.io/tag"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.opentelemetry.io/collector/processor"
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/timeutils"
+	pkgsampling "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/sampling"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/tailsamplingprocessor/internal/idbatcher"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/tailsamplingprocessor/internal/sampling"
 )
@@ -56,6 +60,14 @@ type tailSamplingSpanProcessor struct {
 	decisionBatcher idbatcher.Batcher
 	deleteChan      chan pcommon.TraceID
 	numTracesOnMap  *atomic.Uint64
+	id              component.ID
+	lateSpanPolicy  LateSpanPolicy
+	// decisionCache keeps the decisions of the traces removed from memory, nil if disabled.
+	decisionCache *decisionCache
+	storageID     *component.ID
+	// consistent is set when a policy samples consistently, the sampling threshold of the
+	// sampled traces then being recorded in their tracestate.
+	consistent bool
 
 	// This is for reusing the slice by each call of `makeDecision`. This
 	// was previously identified to be a bottleneck using profiling.
@@ -76,9 +88,11 @@ const (
 
 // newTracesProcessor returns a processor.TracesProcessor that will perform tail sampling according to the given
 // configuration.
-func newTracesProcessor(ctx context.Context, settings component.TelemetrySettings, nextConsumer consumer.Traces, cfg Config) (processor.Traces, error) {
+func newTracesProcessor(ctx context.Context, set processor.CreateSettings, nextConsumer consumer.Traces, cfg Config) (processor.Traces, error) {
+	settings := set.TelemetrySettings
 	policyNames := map[string]bool{}
 	policies := make([]*policy, len(cfg.PolicyCfgs))
+	consistent := false
 	for i := range cfg.PolicyCfgs {
 		policyCfg := &cfg.PolicyCfgs[i]
 
@@ -101,6 +115,9 @@ func newTracesProcessor(ctx context.Context, settings component.TelemetrySetting
 			ctx:       policyCtx,
 		}
 		policies[i] = p
+		if _, ok := eval.(sampling.ThresholdPolicyEvaluator); ok {
+			consistent = true
+		}
 	}
 
 	// this will start a goroutine in the background, so we run it only if everything went
@@ -120,6 +137,10 @@ func newTracesProcessor(ctx context.Context, settings component.TelemetrySetting
 		policies:        policies,
 		tickerFrequency: time.Second,
 		numTracesOnMap:  &atomic.Uint64{},
+		id:              set.ID,
+		lateSpanPolicy:  cfg.LateSpanPolicy,
+		storageID:       cfg.DecisionCache.Storage,
+		consistent:      consistent,
 
 		// We allocate exactly 1 element, because that's the exact amount
 		// used in any place.
@@ -128,6 +149,9 @@ func newTracesProcessor(ctx context.Context, settings component.TelemetrySetting
 
 	tsp.policyTicker = &timeutils.PolicyTicker{OnTickFunc: tsp.samplingPolicyOnTick}
 	tsp.deleteChan = make(chan pcommon.TraceID, cfg.NumTraces)
+	if cfg.DecisionCache.Size > 0 {
+		tsp.decisionCache = newDecisionCache(cfg.DecisionCache.Size, cfg.DecisionCache.TTL)
+	}
 
 	return tsp, nil
 }
@@ -157,7 +181,14 @@ func getSharedPolicyEvaluator(settings component.TelemetrySettings, cfg *sharedP
 		return sampling.NewNumericAttributeFilter(settings, nafCfg.Key, nafCfg.MinValue, nafCfg.MaxValue, nafCfg.InvertMatch), nil
 	case Probabilistic:
 		pCfg := cfg.ProbabilisticCfg
-		return sampling.NewProbabilisticSampler(settings, pCfg.HashSalt, pCfg.SamplingPercentage), nil
+		switch pCfg.Mode {
+		case "", ProbabilisticHashSalt:
+			return sampling.NewProbabilisticSampler(settings, pCfg.HashSalt, pCfg.SamplingPercentage), nil
+		case ProbabilisticEqualizing:
+			return sampling.NewConsistentProbabilisticSampler(settings, pCfg.SamplingPercentage)
+		default:
+			return nil, fmt.Errorf("unknown probabilistic sampling mode %s", pCfg.Mode)
+		}
 	case StringAttribute:
 		safCfg := cfg.StringAttributeCfg
 		return sampling.NewStringAttributeFilter(settings, safCfg.Key, safCfg.Values, safCfg.EnabledRegexMatching, safCfg.CacheMaxSize, safCfg.InvertMatch), nil
@@ -215,10 +246,17 @@ func (tsp *tailSamplingSpanProcessor) samplingPolicyOnTick() {
 		trace.Unlock()
 
 		if decision == sampling.Sampled {
+			if tsp.consistent {
+				updateTraceState(allSpans, tsp.samplingThreshold(trace))
+			}
 			_ = tsp.nextConsumer.ConsumeTraces(policy.ctx, allSpans)
 		}
 	}
 
+	if tsp.decisionCache != nil {
+		tsp.decisionCache.evictExpired(time.Now())
+	}
+
 	stats.Record(tsp.ctx,
 		statOverallDecisionLatencyUs.M(int64(time.Since(startTime)/time.Microsecond)),
 		statDroppedTooEarlyCount.M(metrics.idNotFoundOnMapCount),
@@ -392,6 +430,13 @@ func (tsp *tailSamplingSpanProcessor) processTraces(resourceSpans ptrace.Resourc
 			initialDecisions[i] = sampling.Pending
 		}
 		d, loaded := tsp.idToTrace.Load(id)
+		if !loaded && tsp.decisionCache != nil {
+			// the trace may have been removed from memory after its decision was made
+			if cached, ok := tsp.decisionCache.get(id); ok && !tsp.reevaluates(cached.decision) {
+				tsp.processLateSpans(resourceSpans, spans, cached.decision, cached.time)
+				continue
+			}
+		}
 		if !loaded {
 			spanCount := &atomic.Int64{}
 			spanCount.Store(lenSpans)
@@ -430,46 +475,106 @@ func (tsp *tailSamplingSpanProcessor) processTraces(resourceSpans ptrace.Resourc
 			// If the final decision hasn't been made, add the new spans under the lock.
 			appendToTraces(actualData.ReceivedBatches, resourceSpans, spans)
 			actualData.Unlock()
+		} else if tsp.reevaluates(finalDecision) {
+			// Evaluate the policies again with the late spans only, the previous ones are gone.
+			actualData.FinalDecision = sampling.Unspecified
+			copy(actualData.Decisions, initialDecisions)
+			appendToTraces(actualData.ReceivedBatches, resourceSpans, spans)
+			actualData.Unlock()
+			tsp.decisionBatcher.AddToCurrentBatch(id)
 		} else {
+			decisionTime := actualData.DecisionTime
 			actualData.Unlock()
-
-			switch finalDecision {
-			case sampling.Sampled:
-				// Forward the spans to the policy destinations
-				traceTd := ptrace.NewTraces()
-				appendToTraces(traceTd, resourceSpans, spans)
-				if err := tsp.nextConsumer.ConsumeTraces(tsp.ctx, traceTd); err != nil {
-					tsp.logger.Warn(
-						"Error sending late arrived spans to destination",
-						zap.Error(err))
-				}
-			case sampling.NotSampled:
-				stats.Record(tsp.ctx, statLateSpanArrivalAfterDecision.M(int64(time.Since(actualData.DecisionTime)/time.Second)))
-			default:
-				tsp.logger.Warn("Encountered unexpected sampling decision",
-					zap.Int("decision", int(finalDecision)))
-			}
+			tsp.processLateSpans(resourceSpans, spans, finalDecision, decisionTime)
 		}
 	}
 
 	stats.Record(tsp.ctx, statNewTraceIDReceivedCount.M(newTraceIDs))
 }
 
+// reevaluates reports whether the policies are evaluated again for the late spans of a trace
+// given its decision.
+func (tsp *tailSamplingSpanProcessor) reevaluates(decision sampling.Decision) bool {
+	return tsp.lateSpanPolicy == LateSpanReevaluate && decision == sampling.NotSampled
+}
+
+// processLateSpans handles the spans arriving after the decision about their trace was made.
+func (tsp *tailSamplingSpanProcessor) processLateSpans(resourceSpans ptrace.ResourceSpans, spans []spanAndScope, finalDecision sampling.Decision, decisionTime time.Time) {
+	switch {
+	case finalDecision == sampling.Sampled && tsp.lateSpanPolicy != LateSpanDrop:
+		// Forward the spans to the policy destinations
+		traceTd := ptrace.NewTraces()
+		appendToTraces(traceTd, resourceSpans, spans)
+		if err := tsp.nextConsumer.ConsumeTraces(tsp.ctx, traceTd); err != nil {
+			tsp.logger.Warn(
+				"Error sending late arrived spans to destination",
+				zap.Error(err))
+		}
+	case finalDecision == sampling.Sampled || finalDecision == sampling.NotSampled:
+		stats.Record(tsp.ctx, statLateSpanArrivalAfterDecision.M(int64(time.Since(decisionTime)/time.Second)))
+	default:
+		tsp.logger.Warn("Encountered unexpected sampling decision",
+			zap.Int("decision", int(finalDecision)))
+	}
+}
+
 func (tsp *tailSamplingSpanProcessor) Capabilities() consumer.Capabilities {
 	return consumer.Capabilities{MutatesData: false}
 }
 
 // Start is invoked during service startup.
-func (tsp *tailSamplingSpanProcessor) Start(context.Context, component.Host) error {
+func (tsp *tailSamplingSpanProcessor) Start(ctx context.Context, host component.Host) error {
+	if tsp.decisionCache != nil && tsp.storageID != nil {
+		client, err := storageClient(ctx, host, *tsp.storageID, tsp.id)
+		if err != nil {
+			return err
+		}
+		tsp.decisionCache.client = client
+		if err = tsp.decisionCache.load(ctx); err != nil {
+			tsp.logger.Warn("Failed to load the persisted sampling decisions", zap.Error(err))
+		}
+	}
 	tsp.policyTicker.Start(tsp.tickerFrequency)
 	return nil
 }
 
+func storageClient(ctx context.Context, host component.Host, storageID component.ID, id component.ID) (storage.Client, error) {
+	ext, ok := host.GetExtensions()[storageID]
+	if !ok {
+		return nil, fmt.Errorf("storage extension %q not found", storageID)
+	}
+	se, ok := ext.(storage.Extension)
+	if !ok {
+		return nil, fmt.Errorf("extension %q is not a storage extension", storageID)
+	}
+	return se.GetClient(ctx, component.KindProcessor, id, "")
+}
+
 // Shutdown is invoked during service shutdown.
-func (tsp *tailSamplingSpanProcessor) Shutdown(context.Context) error {
+func (tsp *tailSamplingSpanProcessor) Shutdown(ctx context.Context) error {
 	tsp.decisionBatcher.Stop()
 	tsp.policyTicker.Stop()
-	return nil
+	if tsp.decisionCache == nil || tsp.decisionCache.client == nil {
+		return nil
+	}
+	tsp.cacheRemainingDecisions()
+	err := tsp.decisionCache.persist(ctx)
+	return errors.Join(err, tsp.decisionCache.client.Close(ctx))
+}
+
+// cacheRemainingDecisions adds the decisions of the traces still in memory to the cache, so they
+// are persisted as well.
+func (tsp *tailSamplingSpanProcessor) cacheRemainingDecisions() {
+	tsp.idToTrace.Range(func(key, value any) bool {
+		trace := value.(*sampling.TraceData)
+		trace.Lock()
+		decision, decisionTime := trace.FinalDecision, trace.DecisionTime
+		trace.Unlock()
+		if decision == sampling.Sampled || decision == sampling.NotSampled {
+			tsp.decisionCache.add(key.(pcommon.TraceID), decision, decisionTime)
+		}
+		return true
+	})
 }
 
 func (tsp *tailSamplingSpanProcessor) dropTrace(traceID pcommon.TraceID, deletionTime time.Time) {
@@ -485,9 +590,65 @@ func (tsp *tailSamplingSpanProcessor) dropTrace(traceID pcommon.TraceID, deletio
 		return
 	}
 
+	if tsp.decisionCache != nil {
+		trace.Lock()
+		decision, decisionTime := trace.FinalDecision, trace.DecisionTime
+		trace.Unlock()
+		if decision == sampling.Sampled || decision == sampling.NotSampled {
+			tsp.decisionCache.add(traceID, decision, decisionTime)
+		}
+	}
+
 	stats.Record(tsp.ctx, statTraceRemovalAgeSec.M(int64(deletionTime.Sub(trace.ArrivalTime)/time.Second)))
 }
 
+// samplingThreshold returns the threshold a sampled trace was sampled with: the least threshold
+// of the consistent policies which sampled it, or always sampling if any other policy did.
+func (tsp *tailSamplingSpanProcessor) samplingThreshold(trace *sampling.TraceData) pkgsampling.Threshold {
+	threshold := pkgsampling.NeverSampleThreshold
+	for i, p := range tsp.policies {
+		if trace.Decisions[i] != sampling.Sampled {
+			continue
+		}
+		te, ok := p.evaluator.(sampling.ThresholdPolicyEvaluator)
+		if !ok {
+			return pkgsampling.AlwaysSampleThreshold
+		}
+		if pkgsampling.ThresholdLessThan(te.Threshold(), threshold) {
+			threshold = te.Threshold()
+		}
+	}
+	if threshold == pkgsampling.NeverSampleThreshold {
+		return pkgsampling.AlwaysSampleThreshold
+	}
+	return threshold
+}
+
+// updateTraceState records the sampling threshold in the tracestate of the spans. The spans
+// arriving with a greater threshold keep it, since the sampling probability can't be raised.
+func updateTraceState(td ptrace.Traces, threshold pkgsampling.Threshold) {
+	for i := 0; i < td.ResourceSpans().Len(); i++ {
+		ilss := td.ResourceSpans().At(i).ScopeSpans()
+		for j := 0; j < ilss.Len(); j++ {
+			spans := ilss.At(j).Spans()
+			for k := 0; k < spans.Len(); k++ {
+				span := spans.At(k)
+				ts, err := pkgsampling.NewW3CTraceState(span.TraceState().AsRaw())
+				if err != nil {
+					continue
+				}
+				if err = ts.OTelValue().UpdateTValueWithSampling(threshold); err != nil {
+					continue
+				}
+				var w strings.Builder
+				if err = ts.Serialize(&w); err == nil {
+					span.TraceState().FromRaw(w.String())
+				}
+			}
+		}
+	}
+}
+
 func appendToTraces(dest ptrace.Traces, rss ptrace.ResourceSpans, spanAndScopes []spanAndScope) {
 	rs := dest.ResourceSpans().AppendEmpty()
 	rss.Resource().CopyTo(rs.Resource())
@@ -20,6 +20,7 @@ import (
 	"go.opentelemetry.io/collector/consumer/consumertest"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/processor/processortest"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zaptest/observer"
 
@@ -170,7 +171,7 @@ func TestSequentialTraceArrival(t *testing.T) {
 		PolicyCfgs:              testPolicy,
 	}
 
-	sp, _ := newTracesProcessor(context.Background(), componenttest.NewNopTelemetrySettings(), consumertest.NewNop(), cfg)
+	sp, _ := newTracesProcessor(context.Background(), processortest.NewNopCreateSettings(), consumertest.NewNop(), cfg)
 	tsp := sp.(*tailSamplingSpanProcessor)
 	tsp.tickerFrequency = 100 * time.Millisecond
 	require.NoError(t, tsp.Start(context.Background(), componenttest.NewNopHost()))
@@ -200,7 +201,7 @@ func TestConcurrentTraceArrival(t *testing.T) {
 		ExpectedNewTracesPerSec: 64,
 		PolicyCfgs:              testPolicy,
 	}
-	sp, _ := newTracesProcessor(context.Background(), componenttest.NewNopTelemetrySettings(), consumertest.NewNop(), cfg)
+	sp, _ := newTracesProcessor(context.Background(), processortest.NewNopCreateSettings(), consumertest.NewNop(), cfg)
 	tsp := sp.(*tailSamplingSpanProcessor)
 	tsp.tickerFrequency = 100 * time.Millisecond
 	require.NoError(t, tsp.Start(context.Background(), componenttest.NewNopHost()))
@@ -251,7 +252,7 @@ func TestConcurrentArrivalAndEvaluation(t *testing.T) {
 		ExpectedNewTracesPerSec: 64,
 		PolicyCfgs:              testLatencyPolicy,
 	}
-	sp, _ := newTracesProcessor(context.Background(), componenttest.NewNopTelemetrySettings(), consumertest.NewNop(), cfg)
+	sp, _ := newTracesProcessor(context.Background(), processortest.NewNopCreateSettings(), consumertest.NewNop(), cfg)
 	tsp := sp.(*tailSamplingSpanProcessor)
 	tsp.tickerFrequency = 1 * time.Millisecond
 	require.NoError(t, tsp.Start(context.Background(), componenttest.NewNopHost()))
@@ -293,7 +294,7 @@ func TestSequentialTraceMapSize(t *testing.T) {
 		ExpectedNewTracesPerSec: 64,
 		PolicyCfgs:              testPolicy,
 	}
-	sp, _ := newTracesProcessor(context.Background(), componenttest.NewNopTelemetrySettings(), consumertest.NewNop(), cfg)
+	sp, _ := newTracesProcessor(context.Background(), processortest.NewNopCreateSettings(), consumertest.NewNop(), cfg)
 	tsp := sp.(*tailSamplingSpanProcessor)
 	tsp.tickerFrequency = 100 * time.Millisecond
 	require.NoError(t, tsp.Start(context.Background(), componenttest.NewNopHost()))
@@ -323,7 +324,7 @@ func TestConcurrentTraceMapSize(t *testing.T) {
 		ExpectedNewTracesPerSec: 64,
 		PolicyCfgs:              testPolicy,
 	}
-	sp, _ := newTracesProcessor(context.Background(), componenttest.NewNopTelemetrySettings(), consumertest.NewNop(), cfg)
+	sp, _ := newTracesProcessor(context.Background(), processortest.NewNopCreateSettings(), consumertest.NewNop(), cfg)
 	tsp := sp.(*tailSamplingSpanProcessor)
 	tsp.tickerFrequency = 100 * time.Millisecond
 	require.NoError(t, tsp.Start(context.Background(), componenttest.NewNopHost()))
@@ -742,6 +743,128 @@ func TestLateArrivingSpansAssignedOriginalDecision(t *testing.T) {
 	require.EqualValues(t, 0, nextConsumer.SpanCount(), "original final decision not honored")
 }
 
+func TestLateArrivingSpansReevaluated(t *testing.T) {
+	const maxSize = 100
+	nextConsumer := new(consumertest.TracesSink)
+	mpe := &mockPolicyEvaluator{}
+	tsp := &tailSamplingSpanProcessor{
+		ctx:             context.Background(),
+		nextConsumer:    nextConsumer,
+		maxNumTraces:    maxSize,
+		logger:          zap.NewNop(),
+		decisionBatcher: newSyncIDBatcher(1),
+		policies:        []*policy{{name: "mock-policy", evaluator: mpe, ctx: context.TODO()}},
+		deleteChan:      make(chan pcommon.TraceID, maxSize),
+		policyTicker:    &manualTTicker{},
+		tickerFrequency: 100 * time.Millisecond,
+		numTracesOnMap:  &atomic.Uint64{},
+		mutatorsBuf:     make([]tag.Mutator, 1),
+		lateSpanPolicy:  LateSpanReevaluate,
+	}
+	require.NoError(t, tsp.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() {
+		require.NoError(t, tsp.Shutdown(context.Background()))
+	}()
+
+	traceID := uInt64ToTraceID(1)
+	mpe.NextDecision = sampling.NotSampled
+	require.NoError(t, tsp.ConsumeTraces(context.Background(), simpleTracesWithID(traceID)))
+	tsp.samplingPolicyOnTick()
+	tsp.samplingPolicyOnTick()
+	require.EqualValues(t, 1, mpe.EvaluationCount)
+	require.EqualValues(t, 0, nextConsumer.SpanCount())
+
+	// The late span of the trace not sampled is evaluated again, on its own.
+	mpe.NextDecision = sampling.Sampled
+	require.NoError(t, tsp.ConsumeTraces(context.Background(), simpleTracesWithID(traceID)))
+	tsp.samplingPolicyOnTick()
+	tsp.samplingPolicyOnTick()
+	require.EqualValues(t, 2, mpe.EvaluationCount)
+	require.EqualValues(t, 1, nextConsumer.SpanCount())
+
+	// The spans arriving after a sampled decision are forwarded right away.
+	require.NoError(t, tsp.ConsumeTraces(context.Background(), simpleTracesWithID(traceID)))
+	require.EqualValues(t, 2, mpe.EvaluationCount)
+	require.EqualValues(t, 2, nextConsumer.SpanCount())
+}
+
+func TestLateArrivingSpansDropped(t *testing.T) {
+	const maxSize = 100
+	nextConsumer := new(consumertest.TracesSink)
+	mpe := &mockPolicyEvaluator{NextDecision: sampling.Sampled}
+	tsp := &tailSamplingSpanProcessor{
+		ctx:             context.Background(),
+		nextConsumer:    nextConsumer,
+		maxNumTraces:    maxSize,
+		logger:          zap.NewNop(),
+		decisionBatcher: newSyncIDBatcher(1),
+		policies:        []*policy{{name: "mock-policy", evaluator: mpe, ctx: context.TODO()}},
+		deleteChan:      make(chan pcommon.TraceID, maxSize),
+		policyTicker:    &manualTTicker{},
+		tickerFrequency: 100 * time.Millisecond,
+		numTracesOnMap:  &atomic.Uint64{},
+		mutatorsBuf:     make([]tag.Mutator, 1),
+		lateSpanPolicy:  LateSpanDrop,
+	}
+	require.NoError(t, tsp.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() {
+		require.NoError(t, tsp.Shutdown(context.Background()))
+	}()
+
+	traceID := uInt64ToTraceID(1)
+	require.NoError(t, tsp.ConsumeTraces(context.Background(), simpleTracesWithID(traceID)))
+	tsp.samplingPolicyOnTick()
+	tsp.samplingPolicyOnTick()
+	require.EqualValues(t, 1, nextConsumer.SpanCount())
+
+	require.NoError(t, tsp.ConsumeTraces(context.Background(), simpleTracesWithID(traceID)))
+	require.EqualValues(t, 1, mpe.EvaluationCount)
+	require.EqualValues(t, 1, nextConsumer.SpanCount(), "late span not dropped")
+}
+
+func TestLateArrivingSpansAssignedCachedDecision(t *testing.T) {
+	// Only one trace is kept in memory.
+	const maxSize = 1
+	nextConsumer := new(consumertest.TracesSink)
+	mpe := &mockPolicyEvaluator{NextDecision: sampling.Sampled}
+	tsp := &tailSamplingSpanProcessor{
+		ctx:             context.Background(),
+		nextConsumer:    nextConsumer,
+		maxNumTraces:    maxSize,
+		logger:          zap.NewNop(),
+		decisionBatcher: newSyncIDBatcher(1),
+		policies:        []*policy{{name: "mock-policy", evaluator: mpe, ctx: context.TODO()}},
+		deleteChan:      make(chan pcommon.TraceID, maxSize),
+		policyTicker:    &manualTTicker{},
+		tickerFrequency: 100 * time.Millisecond,
+		numTracesOnMap:  &atomic.Uint64{},
+		mutatorsBuf:     make([]tag.Mutator, 1),
+		decisionCache:   newDecisionCache(10, time.Minute),
+	}
+	require.NoError(t, tsp.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() {
+		require.NoError(t, tsp.Shutdown(context.Background()))
+	}()
+
+	sampledID := uInt64ToTraceID(1)
+	require.NoError(t, tsp.ConsumeTraces(context.Background(), simpleTracesWithID(sampledID)))
+	tsp.samplingPolicyOnTick()
+	tsp.samplingPolicyOnTick()
+	require.EqualValues(t, 1, nextConsumer.SpanCount())
+
+	// A new trace removes the sampled one from memory.
+	require.NoError(t, tsp.ConsumeTraces(context.Background(), simpleTracesWithID(uInt64ToTraceID(2))))
+	_, ok := tsp.idToTrace.Load(sampledID)
+	require.False(t, ok)
+
+	// The late span gets the cached decision instead of starting a new trace.
+	require.NoError(t, tsp.ConsumeTraces(context.Background(), simpleTracesWithID(sampledID)))
+	require.EqualValues(t, 1, mpe.EvaluationCount)
+	require.EqualValues(t, 2, nextConsumer.SpanCount())
+	_, ok = tsp.idToTrace.Load(sampledID)
+	require.False(t, ok)
+}
+
 func TestMultipleBatchesAreCombinedIntoOne(t *testing.T) {
 	const maxSize = 100
 	const decisionWaitSeconds = 1
@@ -819,7 +942,7 @@ func TestSubSecondDecisionTime(t *testing.T) {
 	// prepare
 	msp := new(consumertest.TracesSink)
 
-	tsp, err := newTracesProcessor(context.Background(), componenttest.NewNopTelemetrySettings(), msp, Config{
+	tsp, err := newTracesProcessor(context.Background(), processortest.NewNopCreateSettings(), msp, Config{
 		DecisionWait: 500 * time.Millisecond,
 		NumTraces:    uint64(50000),
 		PolicyCfgs:   testPolicy,
@@ -870,7 +993,7 @@ func TestPolicyLoggerAddsPolicyName(t *testing.T) {
 
 func TestDuplicatePolicyName(t *testing.T) {
 	// prepare
-	set := componenttest.NewNopTelemetrySettings()
+	set := processortest.NewNopCreateSettings()
 	msp := new(consumertest.TracesSink)
 
 	alwaysSample := sharedPolicyCfg{
@@ -956,6 +1079,71 @@ func uInt64ToSpanID(id uint64) pcommon.SpanID {
 	return pcommon.SpanID(spanID)
 }
 
+func TestSampledTraceStateThreshold(t *testing.T) {
+	tests := []struct {
+		name     string
+		others   []*policy
+		expected []string
+	}{
+		{
+			name:     "consistent policy",
+			expected: []string{"ot=th:8", "ot=th:c;rv:ffffffffffffff"},
+		},
+		{
+			name:     "other sampling policy",
+			others:   []*policy{{name: "mock-policy", evaluator: &mockPolicyEvaluator{NextDecision: sampling.Sampled}, ctx: context.TODO()}},
+			expected: []string{"ot=th:0", "ot=th:c;rv:ffffffffffffff"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			eval, err := sampling.NewConsistentProbabilisticSampler(componenttest.NewNopTelemetrySettings(), 50)
+			require.NoError(t, err)
+
+			const maxSize = 100
+			nextConsumer := new(consumertest.TracesSink)
+			tsp := &tailSamplingSpanProcessor{
+				ctx:             context.Background(),
+				nextConsumer:    nextConsumer,
+				maxNumTraces:    maxSize,
+				logger:          zap.NewNop(),
+				decisionBatcher: newSyncIDBatcher(1),
+				policies:        append([]*policy{{name: "consistent-policy", evaluator: eval, ctx: context.TODO()}}, tt.others...),
+				deleteChan:      make(chan pcommon.TraceID, maxSize),
+				policyTicker:    &manualTTicker{},
+				tickerFrequency: 100 * time.Millisecond,
+				numTracesOnMap:  &atomic.Uint64{},
+				mutatorsBuf:     make([]tag.Mutator, 1),
+				consistent:      true,
+			}
+			require.NoError(t, tsp.Start(context.Background(), componenttest.NewNopHost()))
+			defer func() {
+				require.NoError(t, tsp.Shutdown(context.Background()))
+			}()
+
+			traceID := pcommon.TraceID{0, 0, 0, 0, 0, 0, 0, 0, 0, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+			td := ptrace.NewTraces()
+			spans := td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans()
+			spans.AppendEmpty().SetTraceID(traceID)
+			span := spans.AppendEmpty()
+			span.SetTraceID(traceID)
+			// the spans sampled upstream with a lesser probability keep their threshold
+			span.TraceState().FromRaw("ot=th:c;rv:ffffffffffffff")
+
+			require.NoError(t, tsp.ConsumeTraces(context.Background(), td))
+			tsp.samplingPolicyOnTick()
+			tsp.samplingPolicyOnTick()
+
+			require.Len(t, nextConsumer.AllTraces(), 1)
+			got := nextConsumer.AllTraces()[0].ResourceSpans().At(0).ScopeSpans().At(0).Spans()
+			require.Equal(t, 2, got.Len())
+			for i, expected := range tt.expected {
+				assert.Equal(t, expected, got.At(i).TraceState().AsRaw())
+			}
+		})
+	}
+}
+
 type mockPolicyEvaluator struct {
 	NextDecision    sampling.Decision
 	NextError       error
@@ -1040,7 +1228,7 @@ func BenchmarkSampling(b *testing.B) {
 		PolicyCfgs:              testPolicy,
 	}
 
-	sp, _ := newTracesProcessor(context.Background(), componenttest.NewNopTelemetrySettings(), consumertest.NewNop(), cfg)
+	sp, _ := newTracesProcessor(context.Background(), processortest.NewNopCreateSettings(), consumertest.NewNop(), cfg)
 	tsp := sp.(*tailSamplingSpanProcessor)
 	require.NoError(b, tsp.Start(context.Background(), componenttest.NewNopHost()))
 	defer func() {
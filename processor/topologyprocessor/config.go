@@ -0,0 +1,84 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package topologyprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/topologyprocessor"
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Context is the part of the telemetry whose attributes hold the IP addresses.
+type Context string
+
+const (
+	// ContextResource looks up the addresses of the attributes of the resources.
+	ContextResource Context = "resource"
+	// ContextAttributes looks up the addresses of the attributes of the spans, the data points
+	// and the log records.
+	ContextAttributes Context = "attributes"
+)
+
+const defaultReloadInterval = 30 * time.Second
+
+// Config defines the configuration of the topology processor.
+type Config struct {
+	// Subnets are the subnets of the table, with their labels.
+	Subnets []SubnetConfig `mapstructure:"subnets"`
+
+	// SubnetsFile is a YAML file holding more subnets under a `subnets` key, reloaded when it
+	// changes.
+	SubnetsFile string `mapstructure:"subnets_file"`
+
+	// ReloadInterval is how often the subnets file is checked for changes.
+	ReloadInterval time.Duration `mapstructure:"reload_interval"`
+
+	// Attributes are the attributes holding the IP addresses to look up.
+	Attributes []AttributeConfig `mapstructure:"attributes"`
+}
+
+// SubnetConfig sets labels to the addresses of a subnet, the labels of the most specific subnet
+// winning over the labels of the subnets containing it.
+type SubnetConfig struct {
+	CIDR   string            `mapstructure:"cidr" yaml:"cidr"`
+	Labels map[string]string `mapstructure:"labels" yaml:"labels"`
+}
+
+// AttributeConfig sets the labels of the subnets of an address attribute as attributes.
+type AttributeConfig struct {
+	// Key is the attribute holding the IP address, with or without a port.
+	Key string `mapstructure:"key"`
+	// Prefix is prepended to the labels to get the keys of the attributes, e.g. `source.` for
+	// `source.site`.
+	Prefix string `mapstructure:"prefix"`
+	// Context is the part of the telemetry holding the attribute, `attributes` by default.
+	Context Context `mapstructure:"context"`
+}
+
+func (cfg *Config) Validate() error {
+	var errs []error
+	if len(cfg.Subnets) == 0 && cfg.SubnetsFile == "" {
+		errs = append(errs, errors.New("at least one of 'subnets' and 'subnets_file' must be set"))
+	}
+	if _, err := newSubnetTable(cfg.Subnets); err != nil {
+		errs = append(errs, err)
+	}
+	if cfg.SubnetsFile != "" && cfg.ReloadInterval <= 0 {
+		errs = append(errs, errors.New("'reload_interval' must be positive"))
+	}
+	if len(cfg.Attributes) == 0 {
+		errs = append(errs, errors.New("'attributes' cannot be empty"))
+	}
+	for i, attribute := range cfg.Attributes {
+		if attribute.Key == "" {
+			errs = append(errs, fmt.Errorf("attributes[%d]: 'key' cannot be empty", i))
+		}
+		switch attribute.Context {
+		case "", ContextResource, ContextAttributes:
+		default:
+			errs = append(errs, fmt.Errorf("attributes[%d]: unsupported context %q", i, attribute.Context))
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,77 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package topologyprocessor
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/topologyprocessor/internal/metadata"
+)
+
+func TestLoadConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		id          component.ID
+		expected    component.Config
+		expectedErr string
+	}{
+		{
+			id: component.NewID(metadata.Type),
+			expected: &Config{
+				Subnets: []SubnetConfig{
+					{CIDR: "10.1.0.0/16", Labels: map[string]string{"site": "paris"}},
+					{CIDR: "10.1.2.0/24", Labels: map[string]string{"zone": "a", "vlan": "120"}},
+				},
+				SubnetsFile:    "testdata/subnets.yaml",
+				ReloadInterval: time.Minute,
+				Attributes: []AttributeConfig{
+					{Key: "source.address", Prefix: "source."},
+					{Key: "destination.address", Prefix: "destination."},
+					{Key: "host.ip", Context: ContextResource},
+				},
+			},
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "empty_subnets"),
+			expectedErr: "at least one of 'subnets' and 'subnets_file' must be set",
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "invalid_subnet"),
+			expectedErr: `invalid subnet "10.1.0.0/33"`,
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "invalid_attributes"),
+			expectedErr: "attributes[0]: 'key' cannot be empty\nattributes[0]: unsupported context \"span\"",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+			require.NoError(t, err)
+
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, component.UnmarshalConfig(sub, cfg))
+
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, component.ValidateConfig(cfg), tt.expectedErr)
+				return
+			}
+			assert.NoError(t, component.ValidateConfig(cfg))
+			assert.Equal(t, tt.expected, cfg)
+		})
+	}
+}
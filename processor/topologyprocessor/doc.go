@@ -0,0 +1,8 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:generate mdatagen metadata.yaml
+
+// Package topologyprocessor sets the site, the zone or the VLAN of the IP addresses of the
+// telemetry, from a table of subnets.
+package topologyprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/topologyprocessor"
@@ -0,0 +1,221 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package topologyprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/topologyprocessor"
+
+import (
+	"context"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
+)
+
+type topologyProcessor struct {
+	cfg                *Config
+	logger             *zap.Logger
+	table              atomic.Pointer[subnetTable]
+	resourceAttributes []AttributeConfig
+	recordAttributes   []AttributeConfig
+	fileModTime        time.Time
+	fileSize           int64
+	stopCh             chan struct{}
+	wg                 sync.WaitGroup
+}
+
+func newTopologyProcessor(cfg *Config, logger *zap.Logger) *topologyProcessor {
+	p := &topologyProcessor{cfg: cfg, logger: logger}
+	for _, attribute := range cfg.Attributes {
+		if attribute.Context == ContextResource {
+			p.resourceAttributes = append(p.resourceAttributes, attribute)
+		} else {
+			p.recordAttributes = append(p.recordAttributes, attribute)
+		}
+	}
+	return p
+}
+
+func (p *topologyProcessor) start(context.Context, component.Host) error {
+	if err := p.loadTable(); err != nil {
+		return err
+	}
+	if p.cfg.SubnetsFile == "" {
+		return nil
+	}
+	p.stopCh = make(chan struct{})
+	p.wg.Add(1)
+	go p.watchSubnetsFile()
+	return nil
+}
+
+func (p *topologyProcessor) shutdown(context.Context) error {
+	if p.stopCh != nil {
+		close(p.stopCh)
+		p.wg.Wait()
+		p.stopCh = nil
+	}
+	return nil
+}
+
+// loadTable builds the table from the subnets of the config and of the subnets file.
+func (p *topologyProcessor) loadTable() error {
+	subnets := p.cfg.Subnets
+	if p.cfg.SubnetsFile != "" {
+		info, err := os.Stat(p.cfg.SubnetsFile)
+		if err != nil {
+			return err
+		}
+		fileSubnets, err := loadSubnetsFile(p.cfg.SubnetsFile)
+		if err != nil {
+			return err
+		}
+		p.fileModTime, p.fileSize = info.ModTime(), info.Size()
+		subnets = append(append([]SubnetConfig{}, subnets...), fileSubnets...)
+	}
+	table, err := newSubnetTable(subnets)
+	if err != nil {
+		return err
+	}
+	p.table.Store(table)
+	return nil
+}
+
+// watchSubnetsFile reloads the table when the subnets file changes, keeping the previous table
+// when the new subnets are invalid.
+func (p *topologyProcessor) watchSubnetsFile() {
+	defer p.wg.Done()
+	ticker := time.NewTicker(p.cfg.ReloadInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-p.stopCh:
+			return
+		case <-ticker.C:
+			info, err := os.Stat(p.cfg.SubnetsFile)
+			if err != nil {
+				p.logger.Warn("Failed to check the subnets file, keeping the current subnets", zap.Error(err))
+				continue
+			}
+			if info.ModTime().Equal(p.fileModTime) && info.Size() == p.fileSize {
+				continue
+			}
+			if err = p.loadTable(); err != nil {
+				p.logger.Error("Failed to reload the subnets file, keeping the current subnets", zap.Error(err))
+				// the same content is not reported again
+				p.fileModTime, p.fileSize = info.ModTime(), info.Size()
+				continue
+			}
+			p.logger.Info("Reloaded the subnets file", zap.String("path", p.cfg.SubnetsFile))
+		}
+	}
+}
+
+// setTopology sets the labels of the subnets of the addresses of the attributes.
+func (p *topologyProcessor) setTopology(table *subnetTable, attributes []AttributeConfig, attrs pcommon.Map) {
+	for _, attribute := range attributes {
+		value, found := attrs.Get(attribute.Key)
+		if !found || value.Type() != pcommon.ValueTypeStr {
+			continue
+		}
+		addr, ok := parseAddr(value.Str())
+		if !ok {
+			continue
+		}
+		table.lookup(addr, func(label string, labelValue string) {
+			attrs.PutStr(attribute.Prefix+label, labelValue)
+		})
+	}
+}
+
+func (p *topologyProcessor) processTraces(_ context.Context, td ptrace.Traces) (ptrace.Traces, error) {
+	table := p.table.Load()
+	for i := 0; i < td.ResourceSpans().Len(); i++ {
+		rs := td.ResourceSpans().At(i)
+		p.setTopology(table, p.resourceAttributes, rs.Resource().Attributes())
+		if len(p.recordAttributes) == 0 {
+			continue
+		}
+		for j := 0; j < rs.ScopeSpans().Len(); j++ {
+			spans := rs.ScopeSpans().At(j).Spans()
+			for k := 0; k < spans.Len(); k++ {
+				p.setTopology(table, p.recordAttributes, spans.At(k).Attributes())
+			}
+		}
+	}
+	return td, nil
+}
+
+func (p *topologyProcessor) processMetrics(_ context.Context, md pmetric.Metrics) (pmetric.Metrics, error) {
+	table := p.table.Load()
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		rm := md.ResourceMetrics().At(i)
+		p.setTopology(table, p.resourceAttributes, rm.Resource().Attributes())
+		if len(p.recordAttributes) == 0 {
+			continue
+		}
+		for j := 0; j < rm.ScopeMetrics().Len(); j++ {
+			metrics := rm.ScopeMetrics().At(j).Metrics()
+			for k := 0; k < metrics.Len(); k++ {
+				p.processDataPoints(table, metrics.At(k))
+			}
+		}
+	}
+	return md, nil
+}
+
+func (p *topologyProcessor) processDataPoints(table *subnetTable, metric pmetric.Metric) {
+	//exhaustive:enforce
+	switch metric.Type() {
+	case pmetric.MetricTypeGauge:
+		dps := metric.Gauge().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			p.setTopology(table, p.recordAttributes, dps.At(i).Attributes())
+		}
+	case pmetric.MetricTypeSum:
+		dps := metric.Sum().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			p.setTopology(table, p.recordAttributes, dps.At(i).Attributes())
+		}
+	case pmetric.MetricTypeHistogram:
+		dps := metric.Histogram().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			p.setTopology(table, p.recordAttributes, dps.At(i).Attributes())
+		}
+	case pmetric.MetricTypeExponentialHistogram:
+		dps := metric.ExponentialHistogram().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			p.setTopology(table, p.recordAttributes, dps.At(i).Attributes())
+		}
+	case pmetric.MetricTypeSummary:
+		dps := metric.Summary().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			p.setTopology(table, p.recordAttributes, dps.At(i).Attributes())
+		}
+	case pmetric.MetricTypeEmpty:
+	}
+}
+
+func (p *topologyProcessor) processLogs(_ context.Context, ld plog.Logs) (plog.Logs, error) {
+	table := p.table.Load()
+	for i := 0; i < ld.ResourceLogs().Len(); i++ {
+		rl := ld.ResourceLogs().At(i)
+		p.setTopology(table, p.resourceAttributes, rl.Resource().Attributes())
+		if len(p.recordAttributes) == 0 {
+			continue
+		}
+		for j := 0; j < rl.ScopeLogs().Len(); j++ {
+			logs := rl.ScopeLogs().At(j).LogRecords()
+			for k := 0; k < logs.Len(); k++ {
+				p.setTopology(table, p.recordAttributes, logs.At(k).Attributes())
+			}
+		}
+	}
+	return ld, nil
+}
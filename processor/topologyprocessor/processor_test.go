@@ -0,0 +1,137 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package topologyprocessor
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
+)
+
+func testConfig() *Config {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Subnets = []SubnetConfig{
+		{CIDR: "10.0.0.0/8", Labels: map[string]string{"site": "paris"}},
+		{CIDR: "10.1.0.0/16", Labels: map[string]string{"zone": "a"}},
+	}
+	cfg.Attributes = []AttributeConfig{
+		{Key: "source.address", Prefix: "source."},
+		{Key: "destination.address", Prefix: "destination."},
+		{Key: "host.ip", Context: ContextResource},
+	}
+	return cfg
+}
+
+func startProcessor(t *testing.T, cfg *Config) *topologyProcessor {
+	p := newTopologyProcessor(cfg, zap.NewNop())
+	require.NoError(t, p.start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() {
+		assert.NoError(t, p.shutdown(context.Background()))
+	})
+	return p
+}
+
+func TestProcessTraces(t *testing.T) {
+	p := startProcessor(t, testConfig())
+	td := ptrace.NewTraces()
+	rs := td.ResourceSpans().AppendEmpty()
+	rs.Resource().Attributes().PutStr("host.ip", "10.2.0.1")
+	span := rs.ScopeSpans().AppendEmpty().Spans().AppendEmpty()
+	span.Attributes().PutStr("source.address", "10.1.0.1:5353")
+	span.Attributes().PutStr("destination.address", "8.8.8.8")
+
+	td, err := p.processTraces(context.Background(), td)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"host.ip": "10.2.0.1", "site": "paris"}, rs.Resource().Attributes().AsRaw())
+	assert.Equal(t, map[string]any{
+		"source.address":      "10.1.0.1:5353",
+		"source.site":         "paris",
+		"source.zone":         "a",
+		"destination.address": "8.8.8.8",
+	}, td.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).Attributes().AsRaw())
+}
+
+func TestProcessMetrics(t *testing.T) {
+	p := startProcessor(t, testConfig())
+	md := pmetric.NewMetrics()
+	metrics := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics()
+	sum := metrics.AppendEmpty().SetEmptySum().DataPoints().AppendEmpty()
+	sum.Attributes().PutStr("source.address", "10.1.0.1")
+	histogram := metrics.AppendEmpty().SetEmptyHistogram().DataPoints().AppendEmpty()
+	histogram.Attributes().PutStr("destination.address", "10.3.0.1")
+	// the addresses must be strings
+	gauge := metrics.AppendEmpty().SetEmptyGauge().DataPoints().AppendEmpty()
+	gauge.Attributes().PutInt("source.address", 167772161)
+
+	_, err := p.processMetrics(context.Background(), md)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"source.address": "10.1.0.1", "source.site": "paris", "source.zone": "a"}, sum.Attributes().AsRaw())
+	assert.Equal(t, map[string]any{"destination.address": "10.3.0.1", "destination.site": "paris"}, histogram.Attributes().AsRaw())
+	assert.Equal(t, map[string]any{"source.address": int64(167772161)}, gauge.Attributes().AsRaw())
+}
+
+func TestProcessLogs(t *testing.T) {
+	p := startProcessor(t, testConfig())
+	ld := plog.NewLogs()
+	record := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+	record.Attributes().PutStr("destination.address", "::ffff:10.1.9.9")
+	record.Attributes().PutStr("destination.zone", "unknown")
+
+	_, err := p.processLogs(context.Background(), ld)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{
+		"destination.address": "::ffff:10.1.9.9",
+		"destination.site":    "paris",
+		"destination.zone":    "a",
+	}, record.Attributes().AsRaw())
+}
+
+func TestReloadSubnetsFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "subnets.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("subnets:\n  - cidr: 192.168.0.0/16\n    labels:\n      site: lyon\n"), 0o600))
+	cfg := testConfig()
+	cfg.SubnetsFile = path
+	cfg.ReloadInterval = 10 * time.Millisecond
+	p := startProcessor(t, cfg)
+
+	siteOf := func(address string) string {
+		ld := plog.NewLogs()
+		record := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+		record.Attributes().PutStr("source.address", address)
+		_, err := p.processLogs(context.Background(), ld)
+		require.NoError(t, err)
+		site, _ := record.Attributes().Get("source.site")
+		return site.AsString()
+	}
+	assert.Equal(t, "lyon", siteOf("192.168.1.1"))
+	assert.Equal(t, "paris", siteOf("10.0.0.1"))
+
+	require.NoError(t, os.WriteFile(path, []byte("subnets:\n  - cidr: 192.168.0.0/16\n    labels:\n      site: marseille\n"), 0o600))
+	assert.Eventually(t, func() bool {
+		return siteOf("192.168.1.1") == "marseille"
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// the invalid subnets keep the current table
+	require.NoError(t, os.WriteFile(path, []byte("subnets:\n  - cidr: 10.0.0.0/8\n"), 0o600))
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, "marseille", siteOf("192.168.1.1"))
+}
+
+func TestStartErrors(t *testing.T) {
+	cfg := testConfig()
+	cfg.SubnetsFile = filepath.Join(t.TempDir(), "missing.yaml")
+	p := newTopologyProcessor(cfg, zap.NewNop())
+	assert.Error(t, p.start(context.Background(), componenttest.NewNopHost()))
+	assert.NoError(t, p.shutdown(context.Background()))
+}
@@ -0,0 +1,126 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package topologyprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/topologyprocessor"
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/netip"
+	"os"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// subnetTable finds the subnets of the addresses by their prefix length, from the least to the
+// most specific, a lookup costing a map access per distinct prefix length of the table.
+type subnetTable struct {
+	v4 prefixes
+	v6 prefixes
+}
+
+type prefixes struct {
+	lengths  []int
+	byLength map[int]map[netip.Prefix]map[string]string
+}
+
+func newSubnetTable(subnets []SubnetConfig) (*subnetTable, error) {
+	table := &subnetTable{
+		v4: prefixes{byLength: map[int]map[netip.Prefix]map[string]string{}},
+		v6: prefixes{byLength: map[int]map[netip.Prefix]map[string]string{}},
+	}
+	for _, subnet := range subnets {
+		prefix, err := netip.ParsePrefix(subnet.CIDR)
+		if err != nil {
+			return nil, fmt.Errorf("invalid subnet %q: %w", subnet.CIDR, err)
+		}
+		family := &table.v6
+		if prefix.Addr().Is4() {
+			family = &table.v4
+		}
+		if err = family.add(prefix.Masked(), subnet.Labels); err != nil {
+			return nil, err
+		}
+	}
+	sort.Ints(table.v4.lengths)
+	sort.Ints(table.v6.lengths)
+	return table, nil
+}
+
+func (p *prefixes) add(prefix netip.Prefix, labels map[string]string) error {
+	subnets, found := p.byLength[prefix.Bits()]
+	if !found {
+		subnets = map[netip.Prefix]map[string]string{}
+		p.byLength[prefix.Bits()] = subnets
+		p.lengths = append(p.lengths, prefix.Bits())
+	}
+	if _, found = subnets[prefix]; found {
+		return fmt.Errorf("duplicate subnet %q", prefix)
+	}
+	subnets[prefix] = labels
+	return nil
+}
+
+// lookup calls f with the labels of the subnets of the address, from the least to the most
+// specific subnet, and returns whether the address belongs to a subnet of the table.
+func (t *subnetTable) lookup(addr netip.Addr, f func(label string, value string)) bool {
+	addr = addr.Unmap()
+	family := &t.v6
+	if addr.Is4() {
+		family = &t.v4
+	}
+	matched := false
+	for _, length := range family.lengths {
+		prefix, err := addr.Prefix(length)
+		if err != nil {
+			continue
+		}
+		labels, found := family.byLength[length][prefix]
+		if !found {
+			continue
+		}
+		matched = true
+		for label, value := range labels {
+			f(label, value)
+		}
+	}
+	return matched
+}
+
+// parseAddr parses an IP address, with or without a port.
+func parseAddr(value string) (netip.Addr, bool) {
+	if addr, err := netip.ParseAddr(value); err == nil {
+		return addr, true
+	}
+	if !strings.Contains(value, ":") {
+		return netip.Addr{}, false
+	}
+	addrPort, err := netip.ParseAddrPort(value)
+	if err != nil {
+		return netip.Addr{}, false
+	}
+	return addrPort.Addr(), true
+}
+
+type subnetsFile struct {
+	Subnets []SubnetConfig `yaml:"subnets"`
+}
+
+// loadSubnetsFile reads the subnets of a YAML file, rejecting the unknown keys.
+func loadSubnetsFile(path string) ([]SubnetConfig, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var file subnetsFile
+	decoder := yaml.NewDecoder(bytes.NewReader(content))
+	decoder.KnownFields(true)
+	if err = decoder.Decode(&file); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("invalid subnets file %q: %w", path, err)
+	}
+	return file.Subnets, nil
+}
@@ -0,0 +1,105 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package topologyprocessor
+
+import (
+	"net/netip"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func lookupLabels(table *subnetTable, value string) (map[string]string, bool) {
+	addr, ok := parseAddr(value)
+	if !ok {
+		return nil, false
+	}
+	labels := map[string]string{}
+	matched := table.lookup(addr, func(label string, labelValue string) {
+		labels[label] = labelValue
+	})
+	return labels, matched
+}
+
+func TestSubnetTableLookup(t *testing.T) {
+	table, err := newSubnetTable([]SubnetConfig{
+		{CIDR: "10.0.0.0/8", Labels: map[string]string{"site": "paris", "zone": "default"}},
+		{CIDR: "10.1.2.3/24", Labels: map[string]string{"zone": "a", "vlan": "120"}},
+		{CIDR: "10.1.2.128/25", Labels: map[string]string{"vlan": "121"}},
+		{CIDR: "2001:db8::/32", Labels: map[string]string{"site": "lyon"}},
+		{CIDR: "2001:db8:1::/48", Labels: map[string]string{"zone": "b"}},
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		value    string
+		expected map[string]string
+	}{
+		{value: "10.9.9.9", expected: map[string]string{"site": "paris", "zone": "default"}},
+		{value: "10.1.2.3", expected: map[string]string{"site": "paris", "zone": "a", "vlan": "120"}},
+		{value: "10.1.2.200:443", expected: map[string]string{"site": "paris", "zone": "a", "vlan": "121"}},
+		{value: "::ffff:10.1.2.3", expected: map[string]string{"site": "paris", "zone": "a", "vlan": "120"}},
+		{value: "2001:db8:2::1", expected: map[string]string{"site": "lyon"}},
+		{value: "[2001:db8:1::1]:8080", expected: map[string]string{"site": "lyon", "zone": "b"}},
+		{value: "192.168.1.1"},
+		{value: "not an address"},
+		{value: "host:80"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			labels, matched := lookupLabels(table, tt.value)
+			assert.Equal(t, tt.expected != nil, matched)
+			if tt.expected != nil {
+				assert.Equal(t, tt.expected, labels)
+			}
+		})
+	}
+}
+
+func TestNewSubnetTableErrors(t *testing.T) {
+	_, err := newSubnetTable([]SubnetConfig{{CIDR: "10.0.0.1"}})
+	assert.ErrorContains(t, err, `invalid subnet "10.0.0.1"`)
+
+	// the subnets are compared once masked
+	_, err = newSubnetTable([]SubnetConfig{{CIDR: "10.1.0.0/16"}, {CIDR: "10.1.2.0/16"}})
+	assert.EqualError(t, err, `duplicate subnet "10.1.0.0/16"`)
+}
+
+func TestParseAddr(t *testing.T) {
+	addr, ok := parseAddr("10.1.2.3:8080")
+	require.True(t, ok)
+	assert.Equal(t, netip.MustParseAddr("10.1.2.3"), addr)
+	addr, ok = parseAddr("fe80::1")
+	require.True(t, ok)
+	assert.Equal(t, netip.MustParseAddr("fe80::1"), addr)
+	_, ok = parseAddr("")
+	assert.False(t, ok)
+}
+
+func TestLoadSubnetsFile(t *testing.T) {
+	subnets, err := loadSubnetsFile(filepath.Join("testdata", "subnets.yaml"))
+	require.NoError(t, err)
+	assert.Equal(t, []SubnetConfig{
+		{CIDR: "192.168.0.0/16", Labels: map[string]string{"site": "lyon"}},
+		{CIDR: "2001:db8::/32", Labels: map[string]string{"site": "paris"}},
+	}, subnets)
+
+	dir := t.TempDir()
+	empty := filepath.Join(dir, "empty.yaml")
+	require.NoError(t, os.WriteFile(empty, nil, 0o600))
+	subnets, err = loadSubnetsFile(empty)
+	require.NoError(t, err)
+	assert.Empty(t, subnets)
+
+	unknown := filepath.Join(dir, "unknown.yaml")
+	require.NoError(t, os.WriteFile(unknown, []byte("subnets:\n  - cidr: 10.0.0.0/8\n    label:\n      site: paris\n"), 0o600))
+	_, err = loadSubnetsFile(unknown)
+	assert.ErrorContains(t, err, "invalid subnets file")
+
+	_, err = loadSubnetsFile(filepath.Join(dir, "missing.yaml"))
+	assert.Error(t, err)
+}
@@ -27,6 +27,10 @@ type Config struct {
 	TraceStatements  []common.ContextStatements `mapstructure:"trace_statements"`
 	MetricStatements []common.ContextStatements `mapstructure:"metric_statements"`
 	LogStatements    []common.ContextStatements `mapstructure:"log_statements"`
+
+	// DryRun executes the statements on a copy of the telemetry and logs the changes they would make,
+	// passing the telemetry on unchanged, to validate the statements against live traffic.
+	DryRun common.DryRunConfig `mapstructure:"dry_run"`
 }
 
 var _ component.Config = (*Config)(nil)
@@ -35,7 +39,7 @@ func (c *Config) Validate() error {
 	var errors error
 
 	if len(c.TraceStatements) > 0 {
-		pc, err := common.NewTraceParserCollection(component.TelemetrySettings{Logger: zap.NewNop()}, common.WithSpanParser(traces.SpanFunctions()), common.WithSpanEventParser(traces.SpanEventFunctions()))
+		pc, err := common.NewTraceParserCollection(component.TelemetrySettings{Logger: zap.NewNop()}, common.WithSpanParser(traces.SpanFunctions()), common.WithSpanEventParser(traces.SpanEventFunctions()), common.WithSpanLinkParser(traces.SpanLinkFunctions()))
 		if err != nil {
 			return err
 		}
@@ -73,5 +77,9 @@ func (c *Config) Validate() error {
 		}
 	}
 
+	if err := c.DryRun.Validate(); err != nil {
+		errors = multierr.Append(errors, err)
+	}
+
 	return errors
 }
@@ -74,6 +74,7 @@ func TestLoadConfig(t *testing.T) {
 						},
 					},
 				},
+				DryRun: common.DryRunConfig{MaxSamples: 3},
 			},
 		},
 		{
@@ -107,8 +108,29 @@ func TestLoadConfig(t *testing.T) {
 						},
 					},
 				},
+				DryRun: common.DryRunConfig{MaxSamples: 3},
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "dry_run"),
+			expected: &Config{
+				ErrorMode:        ottl.PropagateError,
+				TraceStatements:  []common.ContextStatements{},
+				MetricStatements: []common.ContextStatements{},
+				LogStatements: []common.ContextStatements{
+					{
+						Context: "log",
+						Statements: []string{
+							`set(attributes["service.tier"], "gold") where attributes["customer"] == "acme"`,
+						},
+					},
+				},
+				DryRun: common.DryRunConfig{Enabled: true, MaxSamples: 10},
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "bad_dry_run"),
+		},
 		{
 			id: component.NewIDWithName(metadata.Type, "ignore_errors"),
 			expected: &Config{
@@ -123,6 +145,7 @@ func TestLoadConfig(t *testing.T) {
 				},
 				MetricStatements: []common.ContextStatements{},
 				LogStatements:    []common.ContextStatements{},
+				DryRun:           common.DryRunConfig{MaxSamples: 3},
 			},
 		},
 		{
@@ -0,0 +1,127 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package transformprocessor
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/processor"
+	"go.opentelemetry.io/collector/processor/processortest"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/common"
+)
+
+func dryRunSettings() (processor.CreateSettings, *observer.ObservedLogs) {
+	core, logs := observer.New(zap.InfoLevel)
+	set := processortest.NewNopCreateSettings()
+	set.Logger = zap.New(core)
+	return set, logs
+}
+
+func TestDryRunLogs(t *testing.T) {
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig().(*Config)
+	cfg.DryRun = common.DryRunConfig{Enabled: true, MaxSamples: 1}
+	cfg.LogStatements = []common.ContextStatements{{
+		Context:    "log",
+		Statements: []string{`set(attributes["tier"], "gold") where attributes["customer"] == "acme"`},
+	}}
+	set, logs := dryRunSettings()
+	sink := new(consumertest.LogsSink)
+	lp, err := factory.CreateLogsProcessor(context.Background(), set, cfg, sink)
+	require.NoError(t, err)
+	assert.False(t, lp.Capabilities().MutatesData)
+
+	ld := plog.NewLogs()
+	records := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+	for _, customer := range []string{"acme", "globex", "acme"} {
+		records.AppendEmpty().Attributes().PutStr("customer", customer)
+	}
+	require.NoError(t, lp.ConsumeLogs(context.Background(), ld))
+
+	// the logs are passed on unchanged
+	require.Len(t, sink.AllLogs(), 1)
+	for i := 0; i < records.Len(); i++ {
+		_, found := records.At(i).Attributes().Get("tier")
+		assert.False(t, found)
+	}
+	entries := logs.FilterMessage("Dry run: the statements would change the telemetry").All()
+	require.Len(t, entries, 1)
+	fields := entries[0].ContextMap()
+	assert.Equal(t, "logs", fields["signal"])
+	assert.EqualValues(t, 3, fields["records"])
+	assert.EqualValues(t, 2, fields["changed"])
+	assert.EqualValues(t, 0, fields["added"])
+	samples := fields["samples"].([]any)
+	require.Len(t, samples, 1)
+	sample := samples[0].(map[string]any)
+	assert.NotContains(t, string(sample["before"].(json.RawMessage)), `"key":"tier"`)
+	assert.Contains(t, string(sample["after"].(json.RawMessage)), `"key":"tier"`)
+
+	// nothing is logged without changes
+	ld = plog.NewLogs()
+	ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Attributes().PutStr("customer", "initech")
+	require.NoError(t, lp.ConsumeLogs(context.Background(), ld))
+	assert.Len(t, logs.FilterMessage("Dry run: the statements would change the telemetry").All(), 1)
+}
+
+func TestDryRunMetrics(t *testing.T) {
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig().(*Config)
+	cfg.DryRun = common.DryRunConfig{Enabled: true, MaxSamples: 3}
+	cfg.MetricStatements = []common.ContextStatements{{
+		Context:    "metric",
+		Statements: []string{`extract_count_metric(true) where name == "http.duration"`},
+	}}
+	set, logs := dryRunSettings()
+	sink := new(consumertest.MetricsSink)
+	mp, err := factory.CreateMetricsProcessor(context.Background(), set, cfg, sink)
+	require.NoError(t, err)
+
+	md := pmetric.NewMetrics()
+	metric := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+	metric.SetName("http.duration")
+	metric.SetEmptyHistogram().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	metric.Histogram().DataPoints().AppendEmpty().SetCount(4)
+	require.NoError(t, mp.ConsumeMetrics(context.Background(), md))
+
+	assert.Equal(t, 1, sink.AllMetrics()[0].MetricCount())
+	entries := logs.FilterMessage("Dry run: the statements would change the telemetry").All()
+	require.Len(t, entries, 1)
+	assert.EqualValues(t, 1, entries[0].ContextMap()["added"])
+	assert.EqualValues(t, 0, entries[0].ContextMap()["changed"])
+}
+
+func TestDryRunTracesError(t *testing.T) {
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig().(*Config)
+	cfg.ErrorMode = ottl.PropagateError
+	cfg.DryRun = common.DryRunConfig{Enabled: true}
+	cfg.TraceStatements = []common.ContextStatements{{
+		Context:    "span",
+		Statements: []string{`set(attributes["parsed"], ParseJSON(1))`},
+	}}
+	set, logs := dryRunSettings()
+	sink := new(consumertest.TracesSink)
+	tp, err := factory.CreateTracesProcessor(context.Background(), set, cfg, sink)
+	require.NoError(t, err)
+
+	td := ptrace.NewTraces()
+	td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty().SetName("GET /")
+	// the failures of the statements don't drop the traces
+	require.NoError(t, tp.ConsumeTraces(context.Background(), td))
+	assert.Len(t, sink.AllTraces(), 1)
+	assert.Equal(t, 1, logs.FilterMessage("Dry run: the statements would fail").Len())
+}
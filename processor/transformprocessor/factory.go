@@ -22,6 +22,18 @@ import (
 
 var processorCapabilities = consumer.Capabilities{MutatesData: true}
 
+// the dry run executes the statements on a copy of the telemetry
+var dryRunCapabilities = consumer.Capabilities{MutatesData: false}
+
+const defaultDryRunMaxSamples = 3
+
+func capabilities(cfg *Config) consumer.Capabilities {
+	if cfg.DryRun.Enabled {
+		return dryRunCapabilities
+	}
+	return processorCapabilities
+}
+
 func NewFactory() processor.Factory {
 	return processor.NewFactory(
 		metadata.Type,
@@ -38,6 +50,7 @@ func createDefaultConfig() component.Config {
 		TraceStatements:  []common.ContextStatements{},
 		MetricStatements: []common.ContextStatements{},
 		LogStatements:    []common.ContextStatements{},
+		DryRun:           common.DryRunConfig{MaxSamples: defaultDryRunMaxSamples},
 	}
 }
 
@@ -53,13 +66,17 @@ func createLogsProcessor(
 	if err != nil {
 		return nil, fmt.Errorf("invalid config for \"transform\" processor %w", err)
 	}
+	process := proc.ProcessLogs
+	if oCfg.DryRun.Enabled {
+		process = common.NewLogsDryRun(oCfg.DryRun, set.Logger, process)
+	}
 	return processorhelper.NewLogsProcessor(
 		ctx,
 		set,
 		cfg,
 		nextConsumer,
-		proc.ProcessLogs,
-		processorhelper.WithCapabilities(processorCapabilities))
+		process,
+		processorhelper.WithCapabilities(capabilities(oCfg)))
 }
 
 func createTracesProcessor(
@@ -74,13 +91,17 @@ func createTracesProcessor(
 	if err != nil {
 		return nil, fmt.Errorf("invalid config for \"transform\" processor %w", err)
 	}
+	process := proc.ProcessTraces
+	if oCfg.DryRun.Enabled {
+		process = common.NewTracesDryRun(oCfg.DryRun, set.Logger, process)
+	}
 	return processorhelper.NewTracesProcessor(
 		ctx,
 		set,
 		cfg,
 		nextConsumer,
-		proc.ProcessTraces,
-		processorhelper.WithCapabilities(processorCapabilities))
+		process,
+		processorhelper.WithCapabilities(capabilities(oCfg)))
 }
 
 func createMetricsProcessor(
@@ -95,11 +116,15 @@ func createMetricsProcessor(
 	if err != nil {
 		return nil, fmt.Errorf("invalid config for \"transform\" processor %w", err)
 	}
+	process := proc.ProcessMetrics
+	if oCfg.DryRun.Enabled {
+		process = common.NewMetricsDryRun(oCfg.DryRun, set.Logger, process)
+	}
 	return processorhelper.NewMetricsProcessor(
 		ctx,
 		set,
 		cfg,
 		nextConsumer,
-		proc.ProcessMetrics,
-		processorhelper.WithCapabilities(processorCapabilities))
+		process,
+		processorhelper.WithCapabilities(capabilities(oCfg)))
 }
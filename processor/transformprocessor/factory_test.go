@@ -34,6 +34,7 @@ func TestFactory_CreateDefaultConfig(t *testing.T) {
 		TraceStatements:  []common.ContextStatements{},
 		MetricStatements: []common.ContextStatements{},
 		LogStatements:    []common.ContextStatements{},
+		DryRun:           common.DryRunConfig{MaxSamples: 3},
 	})
 	assert.NoError(t, componenttest.CheckConfigStruct(cfg))
 }
@@ -0,0 +1,227 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package common // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/common"
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// DryRunConfig configures the dry run of the statements, which are executed on a copy of the
+// telemetry to log the changes they would make, the telemetry being passed on unchanged.
+type DryRunConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+	// MaxSamples is the maximum number of changed records logged with their values before and
+	// after the statements, per batch.
+	MaxSamples int `mapstructure:"max_samples"`
+}
+
+func (c DryRunConfig) Validate() error {
+	if c.MaxSamples < 0 {
+		return errors.New("'dry_run.max_samples' cannot be negative")
+	}
+	return nil
+}
+
+type dryRun struct {
+	cfg    DryRunConfig
+	logger *zap.Logger
+}
+
+// dryRunSample is a record changed by the statements, without before when added and without
+// after when removed.
+type dryRunSample struct {
+	before json.RawMessage
+	after  json.RawMessage
+}
+
+func (s dryRunSample) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if s.before != nil {
+		if err := enc.AddReflected("before", s.before); err != nil {
+			return err
+		}
+	}
+	if s.after != nil {
+		return enc.AddReflected("after", s.after)
+	}
+	return nil
+}
+
+type dryRunSamples []dryRunSample
+
+func (s dryRunSamples) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for _, sample := range s {
+		if err := enc.AppendObject(sample); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// report logs the records changed by the statements, the records being compared by position.
+func (d dryRun) report(signal string, before [][]byte, after [][]byte) {
+	changed, added, removed := 0, 0, 0
+	var samples dryRunSamples
+	for i := 0; i < len(before) || i < len(after); i++ {
+		var sample dryRunSample
+		switch {
+		case i >= len(after):
+			removed++
+			sample.before = before[i]
+		case i >= len(before):
+			added++
+			sample.after = after[i]
+		case !bytes.Equal(before[i], after[i]):
+			changed++
+			sample = dryRunSample{before: before[i], after: after[i]}
+		default:
+			continue
+		}
+		if len(samples) < d.cfg.MaxSamples {
+			samples = append(samples, sample)
+		}
+	}
+	if changed == 0 && added == 0 && removed == 0 {
+		return
+	}
+	d.logger.Info("Dry run: the statements would change the telemetry",
+		zap.String("signal", signal),
+		zap.Int("records", len(before)),
+		zap.Int("changed", changed),
+		zap.Int("added", added),
+		zap.Int("removed", removed),
+		zap.Array("samples", samples))
+}
+
+func (d dryRun) reportError(signal string, err error) {
+	d.logger.Warn("Dry run: the statements would fail", zap.String("signal", signal), zap.Error(err))
+}
+
+// NewTracesDryRun returns a process function executing process on a copy of the traces, and
+// logging the spans it would change.
+func NewTracesDryRun(cfg DryRunConfig, logger *zap.Logger, process func(context.Context, ptrace.Traces) (ptrace.Traces, error)) func(context.Context, ptrace.Traces) (ptrace.Traces, error) {
+	d := dryRun{cfg: cfg, logger: logger}
+	return func(ctx context.Context, td ptrace.Traces) (ptrace.Traces, error) {
+		shadow := ptrace.NewTraces()
+		td.CopyTo(shadow)
+		shadow, err := process(ctx, shadow)
+		if err != nil {
+			d.reportError("traces", err)
+			return td, nil
+		}
+		d.report("traces", spanRecords(td), spanRecords(shadow))
+		return td, nil
+	}
+}
+
+// NewMetricsDryRun returns a process function executing process on a copy of the metrics, and
+// logging the metrics it would change.
+func NewMetricsDryRun(cfg DryRunConfig, logger *zap.Logger, process func(context.Context, pmetric.Metrics) (pmetric.Metrics, error)) func(context.Context, pmetric.Metrics) (pmetric.Metrics, error) {
+	d := dryRun{cfg: cfg, logger: logger}
+	return func(ctx context.Context, md pmetric.Metrics) (pmetric.Metrics, error) {
+		shadow := pmetric.NewMetrics()
+		md.CopyTo(shadow)
+		shadow, err := process(ctx, shadow)
+		if err != nil {
+			d.reportError("metrics", err)
+			return md, nil
+		}
+		d.report("metrics", metricRecords(md), metricRecords(shadow))
+		return md, nil
+	}
+}
+
+// NewLogsDryRun returns a process function executing process on a copy of the logs, and logging
+// the log records it would change.
+func NewLogsDryRun(cfg DryRunConfig, logger *zap.Logger, process func(context.Context, plog.Logs) (plog.Logs, error)) func(context.Context, plog.Logs) (plog.Logs, error) {
+	d := dryRun{cfg: cfg, logger: logger}
+	return func(ctx context.Context, ld plog.Logs) (plog.Logs, error) {
+		shadow := plog.NewLogs()
+		ld.CopyTo(shadow)
+		shadow, err := process(ctx, shadow)
+		if err != nil {
+			d.reportError("logs", err)
+			return ld, nil
+		}
+		d.report("logs", logRecords(ld), logRecords(shadow))
+		return ld, nil
+	}
+}
+
+// spanRecords returns the JSON encoding of each span with its resource and its scope.
+func spanRecords(td ptrace.Traces) [][]byte {
+	var records [][]byte
+	marshaler := &ptrace.JSONMarshaler{}
+	for i := 0; i < td.ResourceSpans().Len(); i++ {
+		rs := td.ResourceSpans().At(i)
+		for j := 0; j < rs.ScopeSpans().Len(); j++ {
+			ss := rs.ScopeSpans().At(j)
+			for k := 0; k < ss.Spans().Len(); k++ {
+				record := ptrace.NewTraces()
+				recordResource := record.ResourceSpans().AppendEmpty()
+				rs.Resource().CopyTo(recordResource.Resource())
+				recordScope := recordResource.ScopeSpans().AppendEmpty()
+				ss.Scope().CopyTo(recordScope.Scope())
+				ss.Spans().At(k).CopyTo(recordScope.Spans().AppendEmpty())
+				encoded, _ := marshaler.MarshalTraces(record)
+				records = append(records, encoded)
+			}
+		}
+	}
+	return records
+}
+
+// metricRecords returns the JSON encoding of each metric with its resource and its scope.
+func metricRecords(md pmetric.Metrics) [][]byte {
+	var records [][]byte
+	marshaler := &pmetric.JSONMarshaler{}
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		rm := md.ResourceMetrics().At(i)
+		for j := 0; j < rm.ScopeMetrics().Len(); j++ {
+			sm := rm.ScopeMetrics().At(j)
+			for k := 0; k < sm.Metrics().Len(); k++ {
+				record := pmetric.NewMetrics()
+				recordResource := record.ResourceMetrics().AppendEmpty()
+				rm.Resource().CopyTo(recordResource.Resource())
+				recordScope := recordResource.ScopeMetrics().AppendEmpty()
+				sm.Scope().CopyTo(recordScope.Scope())
+				sm.Metrics().At(k).CopyTo(recordScope.Metrics().AppendEmpty())
+				encoded, _ := marshaler.MarshalMetrics(record)
+				records = append(records, encoded)
+			}
+		}
+	}
+	return records
+}
+
+// logRecords returns the JSON encoding of each log record with its resource and its scope.
+func logRecords(ld plog.Logs) [][]byte {
+	var records [][]byte
+	marshaler := &plog.JSONMarshaler{}
+	for i := 0; i < ld.ResourceLogs().Len(); i++ {
+		rl := ld.ResourceLogs().At(i)
+		for j := 0; j < rl.ScopeLogs().Len(); j++ {
+			sl := rl.ScopeLogs().At(j)
+			for k := 0; k < sl.LogRecords().Len(); k++ {
+				record := plog.NewLogs()
+				recordResource := record.ResourceLogs().AppendEmpty()
+				rl.Resource().CopyTo(recordResource.Resource())
+				recordScope := recordResource.ScopeLogs().AppendEmpty()
+				sl.Scope().CopyTo(recordScope.Scope())
+				sl.LogRecords().At(k).CopyTo(recordScope.LogRecords().AppendEmpty())
+				encoded, _ := marshaler.MarshalLogs(record)
+				records = append(records, encoded)
+			}
+		}
+	}
+	return records
+}
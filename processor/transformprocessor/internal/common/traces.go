@@ -17,6 +17,7 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlscope"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspan"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspanevent"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspanlink"
 )
 
 var _ consumer.Traces = &traceStatements{}
@@ -97,10 +98,52 @@ func (s spanEventStatements) ConsumeTraces(ctx context.Context, td ptrace.Traces
 	return nil
 }
 
+var _ consumer.Traces = &spanLinkStatements{}
+
+type spanLinkStatements struct {
+	ottl.StatementSequence[ottlspanlink.TransformContext]
+	expr.BoolExpr[ottlspanlink.TransformContext]
+}
+
+func (s spanLinkStatements) Capabilities() consumer.Capabilities {
+	return consumer.Capabilities{
+		MutatesData: true,
+	}
+}
+
+func (s spanLinkStatements) ConsumeTraces(ctx context.Context, td ptrace.Traces) error {
+	for i := 0; i < td.ResourceSpans().Len(); i++ {
+		rspans := td.ResourceSpans().At(i)
+		for j := 0; j < rspans.ScopeSpans().Len(); j++ {
+			sspans := rspans.ScopeSpans().At(j)
+			spans := sspans.Spans()
+			for k := 0; k < spans.Len(); k++ {
+				span := spans.At(k)
+				spanLinks := span.Links()
+				for n := 0; n < spanLinks.Len(); n++ {
+					tCtx := ottlspanlink.NewTransformContext(spanLinks.At(n), span, sspans.Scope(), rspans.Resource())
+					condition, err := s.BoolExpr.Eval(ctx, tCtx)
+					if err != nil {
+						return err
+					}
+					if condition {
+						err := s.Execute(ctx, tCtx)
+						if err != nil {
+							return err
+						}
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
 type TraceParserCollection struct {
 	parserCollection
 	spanParser      ottl.Parser[ottlspan.TransformContext]
 	spanEventParser ottl.Parser[ottlspanevent.TransformContext]
+	spanLinkParser  ottl.Parser[ottlspanlink.TransformContext]
 }
 
 type TraceParserCollectionOption func(*TraceParserCollection) error
@@ -127,6 +170,17 @@ func WithSpanEventParser(functions map[string]ottl.Factory[ottlspanevent.Transfo
 	}
 }
 
+func WithSpanLinkParser(functions map[string]ottl.Factory[ottlspanlink.TransformContext]) TraceParserCollectionOption {
+	return func(tp *TraceParserCollection) error {
+		spanLinkParser, err := ottlspanlink.NewParser(functions, tp.settings)
+		if err != nil {
+			return err
+		}
+		tp.spanLinkParser = spanLinkParser
+		return nil
+	}
+}
+
 func WithTraceErrorMode(errorMode ottl.ErrorMode) TraceParserCollectionOption {
 	return func(tp *TraceParserCollection) error {
 		tp.errorMode = errorMode
@@ -185,6 +239,17 @@ func (pc TraceParserCollection) ParseContextStatements(contextStatements Context
 		}
 		seStatements := ottlspanevent.NewStatementSequence(parsedStatements, pc.settings, ottlspanevent.WithStatementSequenceErrorMode(pc.errorMode))
 		return spanEventStatements{seStatements, globalExpr}, nil
+	case SpanLink:
+		parsedStatements, err := pc.spanLinkParser.ParseStatements(contextStatements.Statements)
+		if err != nil {
+			return nil, err
+		}
+		globalExpr, errGlobalBoolExpr := parseGlobalExpr(filterottl.NewBoolExprForSpanLink, contextStatements.Conditions, pc.parserCollection, filterottl.StandardSpanLinkFuncs())
+		if errGlobalBoolExpr != nil {
+			return nil, errGlobalBoolExpr
+		}
+		slStatements := ottlspanlink.NewStatementSequence(parsedStatements, pc.settings, ottlspanlink.WithStatementSequenceErrorMode(pc.errorMode))
+		return spanLinkStatements{slStatements, globalExpr}, nil
 	default:
 		return pc.parseCommonContextStatements(contextStatements)
 	}
@@ -21,6 +21,12 @@ var defaultNodeInfoCommands = []string{
 	"statistics",
 }
 
+const (
+	xdrConfigCommand  = "get-config:context=xdr"
+	sindexListCommand = "sindex-list:"
+	latenciesCommand  = "latencies:"
+)
+
 // nodeName: metricName: stats
 type clusterInfo = map[string]map[string]string
 
@@ -30,6 +36,12 @@ type Aerospike interface {
 	NamespaceInfo() namespaceInfo
 	// Info gets high-level information about the node/system.
 	Info() clusterInfo
+	// XDRInfo gets the XDR statistics of the datacenters the nodes ship records to
+	XDRInfo() xdrInfo
+	// SindexInfo gets the statistics of the secondary indexes of the namespaces
+	SindexInfo() sindexInfo
+	// LatencyInfo gets the latency histograms of the namespaces
+	LatencyInfo() latencyInfo
 	// Close closes the connection to the Aerospike node
 	Close()
 }
@@ -172,6 +184,95 @@ func (c *defaultASClient) NamespaceInfo() namespaceInfo {
 	return res
 }
 
+// nodeName: dcName: metricName: stats
+type xdrInfo = map[string]map[string]map[string]string
+
+// XDRInfo returns a xdrInfo map
+// the map contains the results of the "get-stats:context=xdr;dc=<name>" info command
+// for all nodes' XDR datacenters
+func (c *defaultASClient) XDRInfo() xdrInfo {
+	res := xdrInfo{}
+
+	metricsToParse := c.useNodeFunc(allXDRInfo)
+	for node, dcs := range metricsToParse {
+		res[node] = map[string]map[string]string{}
+		for command, stats := range dcs {
+			// command == "get-stats:context=xdr;dc=<dcName>"
+			_, dcName, found := strings.Cut(command, ";dc=")
+			if !found {
+				c.logger.Warnf("XDRInfo unexpected command: %s", command)
+				continue
+			}
+			res[node][dcName] = parseStats(command, stats, ";")
+		}
+	}
+
+	return res
+}
+
+// nodeName: namespaceName: sindexName: metricName: stats
+type sindexInfo = map[string]map[string]map[string]map[string]string
+
+// SindexInfo returns a sindexInfo map
+// the map contains the results of the "sindex-stat:namespace=<name>;indexname=<name>" info command
+// for all nodes' secondary indexes
+func (c *defaultASClient) SindexInfo() sindexInfo {
+	res := sindexInfo{}
+
+	metricsToParse := c.useNodeFunc(allSindexInfo)
+	for node, sindexes := range metricsToParse {
+		res[node] = map[string]map[string]map[string]string{}
+		for command, stats := range sindexes {
+			// command == "sindex-stat:namespace=<namespaceName>;indexname=<sindexName>"
+			_, args, _ := strings.Cut(command, ":")
+			names := parseStats("", args, ";")
+			nsName, sindexName := names["namespace"], names["indexname"]
+			if nsName == "" || sindexName == "" {
+				c.logger.Warnf("SindexInfo unexpected command: %s", command)
+				continue
+			}
+			if res[node][nsName] == nil {
+				res[node][nsName] = map[string]map[string]string{}
+			}
+			res[node][nsName][sindexName] = parseStats(command, stats, ";")
+		}
+	}
+
+	return res
+}
+
+// nodeName: namespaceName: operation: histogram
+type latencyInfo = map[string]map[string]map[string]string
+
+// LatencyInfo returns a latencyInfo map
+// the map contains the histograms of the namespaces returned by the "latencies:" info command,
+// e.g. "msec,6.5,1.53,0.00,0.00" for the read operations
+func (c *defaultASClient) LatencyInfo() latencyInfo {
+	res := latencyInfo{}
+
+	metricsToParse := c.useNodeFunc(allLatencyInfo)
+	for node, commands := range metricsToParse {
+		res[node] = map[string]map[string]string{}
+		for _, histogram := range strings.Split(commands[latenciesCommand], ";") {
+			// histogram == "{<namespaceName>}-<operation>:<histogram>", or a histogram of the node
+			name, value, found := strings.Cut(histogram, ":")
+			if !found || !strings.HasPrefix(name, "{") {
+				continue
+			}
+			nsName, op, found := strings.Cut(strings.TrimPrefix(name, "{"), "}-")
+			if !found {
+				continue
+			}
+			if res[node][nsName] == nil {
+				res[node][nsName] = map[string]string{}
+			}
+			res[node][nsName][op] = value
+		}
+	}
+
+	return res
+}
+
 // Close closes the client's connections to all nodes
 func (c *defaultASClient) Close() {
 	c.cluster.Close()
@@ -278,6 +379,81 @@ func allNamespaceInfo(n cluster.Node, policy *as.InfoPolicy) (metricsMap, error)
 	return res, nil
 }
 
+// xdrDCNames is used by nodeFuncs to get the names of the XDR datacenters of a node
+func xdrDCNames(n cluster.Node, policy *as.InfoPolicy) ([]string, error) {
+	info, err := n.RequestInfo(policy, xdrConfigCommand)
+	if err != nil {
+		return nil, err
+	}
+
+	dcs := parseStats(xdrConfigCommand, info[xdrConfigCommand], ";")["dcs"]
+	if dcs == "" {
+		return nil, nil
+	}
+	return strings.Split(dcs, ","), nil
+}
+
+// allXDRInfo returns the results of get-stats:context=xdr;dc=%s for each XDR datacenter of the node
+func allXDRInfo(n cluster.Node, policy *as.InfoPolicy) (metricsMap, error) {
+	names, err := xdrDCNames(n, policy)
+	if err != nil {
+		return nil, err
+	}
+	if len(names) == 0 {
+		return metricsMap{}, nil
+	}
+
+	commands := make([]string, len(names))
+	for i, name := range names {
+		commands[i] = fmt.Sprintf("get-stats:context=xdr;dc=%s", name)
+	}
+
+	res, err := n.RequestInfo(policy, commands...)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// allSindexInfo returns the results of sindex-stat:namespace=%s;indexname=%s for each secondary index on the node
+func allSindexInfo(n cluster.Node, policy *as.InfoPolicy) (metricsMap, error) {
+	info, err := n.RequestInfo(policy, sindexListCommand)
+	if err != nil {
+		return nil, err
+	}
+
+	var commands []string
+	for _, sindex := range strings.Split(info[sindexListCommand], ";") {
+		// sindex == "ns=<namespaceName>:indexname=<sindexName>:set=<setName>:..."
+		attrs := parseStats("", sindex, ":")
+		if attrs["ns"] == "" || attrs["indexname"] == "" {
+			continue
+		}
+		commands = append(commands, fmt.Sprintf("sindex-stat:namespace=%s;indexname=%s", attrs["ns"], attrs["indexname"]))
+	}
+	if len(commands) == 0 {
+		return metricsMap{}, nil
+	}
+
+	res, err := n.RequestInfo(policy, commands...)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// allLatencyInfo returns the result of latencies: for the node
+func allLatencyInfo(n cluster.Node, policy *as.InfoPolicy) (metricsMap, error) {
+	res, err := n.RequestInfo(policy, latenciesCommand)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
 func parseStats(defaultKey, s, sep string) metricsMap {
 	stats := make(metricsMap, strings.Count(s, sep)+1)
 	s2 := strings.Split(s, sep)
@@ -214,3 +214,158 @@ func TestAerospike_NamespaceInfo_Negative(t *testing.T) {
 
 	client.Close()
 }
+
+func TestAerospike_XDRInfo(t *testing.T) {
+	t.Parallel()
+
+	testNode := cm.NewNode(t)
+	testNode.On("GetName").Return("BB990C28F270008")
+	testNode.On("RequestInfo", &as.InfoPolicy{Timeout: 0}, "get-config:context=xdr").Return(metricsMap{
+		"get-config:context=xdr": "dcs=dc1,dc2;src-id=0;trace-sample=0",
+	}, nil)
+	testNode.On("RequestInfo", &as.InfoPolicy{Timeout: 0}, "get-stats:context=xdr;dc=dc1", "get-stats:context=xdr;dc=dc2").Return(metricsMap{
+		"get-stats:context=xdr;dc=dc1": "lag=2;in_queue=10;in_progress=0",
+		"get-stats:context=xdr;dc=dc2": "lag=0;in_queue=0;in_progress=0",
+	}, nil)
+
+	testNodeNoXDR := cm.NewNode(t)
+	testNodeNoXDR.On("GetName").Return("BB990C28F270009")
+	testNodeNoXDR.On("RequestInfo", &as.InfoPolicy{Timeout: 0}, "get-config:context=xdr").Return(metricsMap{
+		"get-config:context=xdr": "dcs=;src-id=0;trace-sample=0",
+	}, nil)
+
+	testCluster := mocks.NewNodeGetter(t)
+	testCluster.On("GetNodes").Return([]cluster.Node{testNode, testNodeNoXDR})
+	testCluster.On("Close").Return()
+
+	logger, err := zap.NewDevelopment()
+	require.NoError(t, err)
+
+	clientCfg := clientConfig{
+		logger: logger.Sugar(),
+	}
+
+	nodeGetterFactoryFunc := func(*clientConfig, *as.ClientPolicy, bool) (nodeGetter, error) {
+		return testCluster, nil
+	}
+
+	client, err := newASClient(&clientCfg, nodeGetterFactoryFunc)
+	require.NoError(t, err)
+
+	expectedMetrics := xdrInfo{
+		"BB990C28F270008": map[string]map[string]string{
+			"dc1": metricsMap{
+				"lag":         "2",
+				"in_queue":    "10",
+				"in_progress": "0",
+			},
+			"dc2": metricsMap{
+				"lag":         "0",
+				"in_queue":    "0",
+				"in_progress": "0",
+			},
+		},
+		"BB990C28F270009": map[string]map[string]string{},
+	}
+	require.Equal(t, expectedMetrics, client.XDRInfo())
+
+	client.Close()
+}
+
+func TestAerospike_SindexInfo(t *testing.T) {
+	t.Parallel()
+
+	testNode := cm.NewNode(t)
+	testNode.On("GetName").Return("BB990C28F270008")
+	testNode.On("RequestInfo", &as.InfoPolicy{Timeout: 0}, "sindex-list:").Return(metricsMap{
+		"sindex-list:": "ns=test:indexname=idx_age:set=demo:bin=age:type=numeric:indextype=default:context=NULL:state=RW;" +
+			"ns=bar:indexname=idx_name:set=NULL:bin=name:type=string:indextype=default:context=NULL:state=RW",
+	}, nil)
+	testNode.On("RequestInfo", &as.InfoPolicy{Timeout: 0}, "sindex-stat:namespace=test;indexname=idx_age", "sindex-stat:namespace=bar;indexname=idx_name").Return(metricsMap{
+		"sindex-stat:namespace=test;indexname=idx_age": "entries=100;memory_used=18688;load_pct=100",
+		"sindex-stat:namespace=bar;indexname=idx_name": "entries=20;memory_used=4096;load_pct=100",
+	}, nil)
+
+	testCluster := mocks.NewNodeGetter(t)
+	testCluster.On("GetNodes").Return([]cluster.Node{testNode})
+	testCluster.On("Close").Return()
+
+	logger, err := zap.NewDevelopment()
+	require.NoError(t, err)
+
+	clientCfg := clientConfig{
+		logger: logger.Sugar(),
+	}
+
+	nodeGetterFactoryFunc := func(*clientConfig, *as.ClientPolicy, bool) (nodeGetter, error) {
+		return testCluster, nil
+	}
+
+	client, err := newASClient(&clientCfg, nodeGetterFactoryFunc)
+	require.NoError(t, err)
+
+	expectedMetrics := sindexInfo{
+		"BB990C28F270008": map[string]map[string]map[string]string{
+			"test": {
+				"idx_age": metricsMap{
+					"entries":     "100",
+					"memory_used": "18688",
+					"load_pct":    "100",
+				},
+			},
+			"bar": {
+				"idx_name": metricsMap{
+					"entries":     "20",
+					"memory_used": "4096",
+					"load_pct":    "100",
+				},
+			},
+		},
+	}
+	require.Equal(t, expectedMetrics, client.SindexInfo())
+
+	client.Close()
+}
+
+func TestAerospike_LatencyInfo(t *testing.T) {
+	t.Parallel()
+
+	testNode := cm.NewNode(t)
+	testNode.On("GetName").Return("BB990C28F270008")
+	testNode.On("RequestInfo", &as.InfoPolicy{Timeout: 0}, "latencies:").Return(metricsMap{
+		"latencies:": "batch-index:;{test}-read:msec,6.5,1.53,0.00,0.00;{test}-write:msec,2.0,0.00,0.00,0.00;{bar}-read:usec,1.0,100.00,50.00,0.00",
+	}, nil)
+
+	testCluster := mocks.NewNodeGetter(t)
+	testCluster.On("GetNodes").Return([]cluster.Node{testNode})
+	testCluster.On("Close").Return()
+
+	logger, err := zap.NewDevelopment()
+	require.NoError(t, err)
+
+	clientCfg := clientConfig{
+		logger: logger.Sugar(),
+	}
+
+	nodeGetterFactoryFunc := func(*clientConfig, *as.ClientPolicy, bool) (nodeGetter, error) {
+		return testCluster, nil
+	}
+
+	client, err := newASClient(&clientCfg, nodeGetterFactoryFunc)
+	require.NoError(t, err)
+
+	expectedMetrics := latencyInfo{
+		"BB990C28F270008": map[string]map[string]string{
+			"test": {
+				"read":  "msec,6.5,1.53,0.00,0.00",
+				"write": "msec,2.0,0.00,0.00,0.00",
+			},
+			"bar": {
+				"read": "usec,1.0,100.00,50.00,0.00",
+			},
+		},
+	}
+	require.Equal(t, expectedMetrics, client.LatencyInfo())
+
+	client.Close()
+}
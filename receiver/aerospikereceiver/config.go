@@ -38,11 +38,19 @@ type Config struct {
 	Username                       string                        `mapstructure:"username"`
 	Password                       configopaque.String           `mapstructure:"password"`
 	CollectClusterMetrics          bool                          `mapstructure:"collect_cluster_metrics"`
+	LatencyHistograms              LatencyHistogramsConfig       `mapstructure:"latency_histograms"`
 	Timeout                        time.Duration                 `mapstructure:"timeout"`
 	MetricsBuilderConfig           metadata.MetricsBuilderConfig `mapstructure:",squash"`
 	TLS                            *configtls.ClientConfig       `mapstructure:"tls,omitempty"`
 }
 
+// LatencyHistogramsConfig configures the collection of the latency histograms of the namespaces
+type LatencyHistogramsConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+	// Operations are the histograms collected, e.g. read or write. All the histograms are collected if empty.
+	Operations []string `mapstructure:"operations"`
+}
+
 // Validate validates the values of the given Config, and returns an error if validation fails
 func (c *Config) Validate() error {
 	var allErrs error
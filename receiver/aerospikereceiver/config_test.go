@@ -151,6 +151,10 @@ func TestLoadConfig(t *testing.T) {
 	expected := factory.CreateDefaultConfig().(*Config)
 	expected.Endpoint = "localhost:3000"
 	expected.CollectionInterval = 30 * time.Second
+	expected.LatencyHistograms = LatencyHistogramsConfig{
+		Enabled:    true,
+		Operations: []string{"read", "write"},
+	}
 
 	require.Equal(t, expected, cfg)
 }
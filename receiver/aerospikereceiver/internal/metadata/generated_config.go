@@ -37,11 +37,15 @@ type MetricsConfig struct {
 	AerospikeNamespaceMemoryUsage                     MetricConfig `mapstructure:"aerospike.namespace.memory.usage"`
 	AerospikeNamespaceQueryCount                      MetricConfig `mapstructure:"aerospike.namespace.query.count"`
 	AerospikeNamespaceScanCount                       MetricConfig `mapstructure:"aerospike.namespace.scan.count"`
+	AerospikeNamespaceSindexEntries                   MetricConfig `mapstructure:"aerospike.namespace.sindex.entries"`
+	AerospikeNamespaceSindexMemoryUsage               MetricConfig `mapstructure:"aerospike.namespace.sindex.memory.usage"`
 	AerospikeNamespaceTransactionCount                MetricConfig `mapstructure:"aerospike.namespace.transaction.count"`
 	AerospikeNodeConnectionCount                      MetricConfig `mapstructure:"aerospike.node.connection.count"`
 	AerospikeNodeConnectionOpen                       MetricConfig `mapstructure:"aerospike.node.connection.open"`
 	AerospikeNodeMemoryFree                           MetricConfig `mapstructure:"aerospike.node.memory.free"`
 	AerospikeNodeQueryTracked                         MetricConfig `mapstructure:"aerospike.node.query.tracked"`
+	AerospikeNodeXdrInQueue                           MetricConfig `mapstructure:"aerospike.node.xdr.in_queue"`
+	AerospikeNodeXdrLag                               MetricConfig `mapstructure:"aerospike.node.xdr.lag"`
 }
 
 func DefaultMetricsConfig() MetricsConfig {
@@ -73,6 +77,12 @@ func DefaultMetricsConfig() MetricsConfig {
 		AerospikeNamespaceScanCount: MetricConfig{
 			Enabled: true,
 		},
+		AerospikeNamespaceSindexEntries: MetricConfig{
+			Enabled: false,
+		},
+		AerospikeNamespaceSindexMemoryUsage: MetricConfig{
+			Enabled: false,
+		},
 		AerospikeNamespaceTransactionCount: MetricConfig{
 			Enabled: true,
 		},
@@ -88,6 +98,12 @@ func DefaultMetricsConfig() MetricsConfig {
 		AerospikeNodeQueryTracked: MetricConfig{
 			Enabled: true,
 		},
+		AerospikeNodeXdrInQueue: MetricConfig{
+			Enabled: false,
+		},
+		AerospikeNodeXdrLag: MetricConfig{
+			Enabled: false,
+		},
 	}
 }
 
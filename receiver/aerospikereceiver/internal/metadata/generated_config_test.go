@@ -35,11 +35,15 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					AerospikeNamespaceMemoryUsage:                     MetricConfig{Enabled: true},
 					AerospikeNamespaceQueryCount:                      MetricConfig{Enabled: true},
 					AerospikeNamespaceScanCount:                       MetricConfig{Enabled: true},
+					AerospikeNamespaceSindexEntries:                   MetricConfig{Enabled: true},
+					AerospikeNamespaceSindexMemoryUsage:               MetricConfig{Enabled: true},
 					AerospikeNamespaceTransactionCount:                MetricConfig{Enabled: true},
 					AerospikeNodeConnectionCount:                      MetricConfig{Enabled: true},
 					AerospikeNodeConnectionOpen:                       MetricConfig{Enabled: true},
 					AerospikeNodeMemoryFree:                           MetricConfig{Enabled: true},
 					AerospikeNodeQueryTracked:                         MetricConfig{Enabled: true},
+					AerospikeNodeXdrInQueue:                           MetricConfig{Enabled: true},
+					AerospikeNodeXdrLag:                               MetricConfig{Enabled: true},
 				},
 				ResourceAttributes: ResourceAttributesConfig{
 					AerospikeNamespace: ResourceAttributeConfig{Enabled: true},
@@ -60,11 +64,15 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					AerospikeNamespaceMemoryUsage:                     MetricConfig{Enabled: false},
 					AerospikeNamespaceQueryCount:                      MetricConfig{Enabled: false},
 					AerospikeNamespaceScanCount:                       MetricConfig{Enabled: false},
+					AerospikeNamespaceSindexEntries:                   MetricConfig{Enabled: false},
+					AerospikeNamespaceSindexMemoryUsage:               MetricConfig{Enabled: false},
 					AerospikeNamespaceTransactionCount:                MetricConfig{Enabled: false},
 					AerospikeNodeConnectionCount:                      MetricConfig{Enabled: false},
 					AerospikeNodeConnectionOpen:                       MetricConfig{Enabled: false},
 					AerospikeNodeMemoryFree:                           MetricConfig{Enabled: false},
 					AerospikeNodeQueryTracked:                         MetricConfig{Enabled: false},
+					AerospikeNodeXdrInQueue:                           MetricConfig{Enabled: false},
+					AerospikeNodeXdrLag:                               MetricConfig{Enabled: false},
 				},
 				ResourceAttributes: ResourceAttributesConfig{
 					AerospikeNamespace: ResourceAttributeConfig{Enabled: false},
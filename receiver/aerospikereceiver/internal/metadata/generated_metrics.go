@@ -810,6 +810,112 @@ func newMetricAerospikeNamespaceScanCount(cfg MetricConfig) metricAerospikeNames
 	return m
 }
 
+type metricAerospikeNamespaceSindexEntries struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills aerospike.namespace.sindex.entries metric with initial data.
+func (m *metricAerospikeNamespaceSindexEntries) init() {
+	m.data.SetName("aerospike.namespace.sindex.entries")
+	m.data.SetDescription("Number of entries of the secondary index")
+	m.data.SetUnit("{entries}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricAerospikeNamespaceSindexEntries) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, sindexNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("sindex", sindexNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricAerospikeNamespaceSindexEntries) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricAerospikeNamespaceSindexEntries) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricAerospikeNamespaceSindexEntries(cfg MetricConfig) metricAerospikeNamespaceSindexEntries {
+	m := metricAerospikeNamespaceSindexEntries{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricAerospikeNamespaceSindexMemoryUsage struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills aerospike.namespace.sindex.memory.usage metric with initial data.
+func (m *metricAerospikeNamespaceSindexMemoryUsage) init() {
+	m.data.SetName("aerospike.namespace.sindex.memory.usage")
+	m.data.SetDescription("Memory used by the secondary index")
+	m.data.SetUnit("By")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricAerospikeNamespaceSindexMemoryUsage) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, sindexNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("sindex", sindexNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricAerospikeNamespaceSindexMemoryUsage) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricAerospikeNamespaceSindexMemoryUsage) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricAerospikeNamespaceSindexMemoryUsage(cfg MetricConfig) metricAerospikeNamespaceSindexMemoryUsage {
+	m := metricAerospikeNamespaceSindexMemoryUsage{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricAerospikeNamespaceTransactionCount struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -1071,6 +1177,110 @@ func newMetricAerospikeNodeQueryTracked(cfg MetricConfig) metricAerospikeNodeQue
 	return m
 }
 
+type metricAerospikeNodeXdrInQueue struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills aerospike.node.xdr.in_queue metric with initial data.
+func (m *metricAerospikeNodeXdrInQueue) init() {
+	m.data.SetName("aerospike.node.xdr.in_queue")
+	m.data.SetDescription("Number of records waiting to be shipped to the datacenter")
+	m.data.SetUnit("{records}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricAerospikeNodeXdrInQueue) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, xdrDcAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("dc", xdrDcAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricAerospikeNodeXdrInQueue) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricAerospikeNodeXdrInQueue) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricAerospikeNodeXdrInQueue(cfg MetricConfig) metricAerospikeNodeXdrInQueue {
+	m := metricAerospikeNodeXdrInQueue{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricAerospikeNodeXdrLag struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills aerospike.node.xdr.lag metric with initial data.
+func (m *metricAerospikeNodeXdrLag) init() {
+	m.data.SetName("aerospike.node.xdr.lag")
+	m.data.SetDescription("Time elapsed since the last record shipped to the datacenter was written")
+	m.data.SetUnit("s")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricAerospikeNodeXdrLag) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, xdrDcAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("dc", xdrDcAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricAerospikeNodeXdrLag) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricAerospikeNodeXdrLag) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricAerospikeNodeXdrLag(cfg MetricConfig) metricAerospikeNodeXdrLag {
+	m := metricAerospikeNodeXdrLag{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 // MetricsBuilder provides an interface for scrapers to report metrics while taking care of all the transformations
 // required to produce metric representation defined in metadata and user config.
 type MetricsBuilder struct {
@@ -1090,11 +1300,15 @@ type MetricsBuilder struct {
 	metricAerospikeNamespaceMemoryUsage                     metricAerospikeNamespaceMemoryUsage
 	metricAerospikeNamespaceQueryCount                      metricAerospikeNamespaceQueryCount
 	metricAerospikeNamespaceScanCount                       metricAerospikeNamespaceScanCount
+	metricAerospikeNamespaceSindexEntries                   metricAerospikeNamespaceSindexEntries
+	metricAerospikeNamespaceSindexMemoryUsage               metricAerospikeNamespaceSindexMemoryUsage
 	metricAerospikeNamespaceTransactionCount                metricAerospikeNamespaceTransactionCount
 	metricAerospikeNodeConnectionCount                      metricAerospikeNodeConnectionCount
 	metricAerospikeNodeConnectionOpen                       metricAerospikeNodeConnectionOpen
 	metricAerospikeNodeMemoryFree                           metricAerospikeNodeMemoryFree
 	metricAerospikeNodeQueryTracked                         metricAerospikeNodeQueryTracked
+	metricAerospikeNodeXdrInQueue                           metricAerospikeNodeXdrInQueue
+	metricAerospikeNodeXdrLag                               metricAerospikeNodeXdrLag
 }
 
 // metricBuilderOption applies changes to default metrics builder.
@@ -1122,11 +1336,15 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.CreateSetting
 		metricAerospikeNamespaceMemoryUsage:                     newMetricAerospikeNamespaceMemoryUsage(mbc.Metrics.AerospikeNamespaceMemoryUsage),
 		metricAerospikeNamespaceQueryCount:                      newMetricAerospikeNamespaceQueryCount(mbc.Metrics.AerospikeNamespaceQueryCount),
 		metricAerospikeNamespaceScanCount:                       newMetricAerospikeNamespaceScanCount(mbc.Metrics.AerospikeNamespaceScanCount),
+		metricAerospikeNamespaceSindexEntries:                   newMetricAerospikeNamespaceSindexEntries(mbc.Metrics.AerospikeNamespaceSindexEntries),
+		metricAerospikeNamespaceSindexMemoryUsage:               newMetricAerospikeNamespaceSindexMemoryUsage(mbc.Metrics.AerospikeNamespaceSindexMemoryUsage),
 		metricAerospikeNamespaceTransactionCount:                newMetricAerospikeNamespaceTransactionCount(mbc.Metrics.AerospikeNamespaceTransactionCount),
 		metricAerospikeNodeConnectionCount:                      newMetricAerospikeNodeConnectionCount(mbc.Metrics.AerospikeNodeConnectionCount),
 		metricAerospikeNodeConnectionOpen:                       newMetricAerospikeNodeConnectionOpen(mbc.Metrics.AerospikeNodeConnectionOpen),
 		metricAerospikeNodeMemoryFree:                           newMetricAerospikeNodeMemoryFree(mbc.Metrics.AerospikeNodeMemoryFree),
 		metricAerospikeNodeQueryTracked:                         newMetricAerospikeNodeQueryTracked(mbc.Metrics.AerospikeNodeQueryTracked),
+		metricAerospikeNodeXdrInQueue:                           newMetricAerospikeNodeXdrInQueue(mbc.Metrics.AerospikeNodeXdrInQueue),
+		metricAerospikeNodeXdrLag:                               newMetricAerospikeNodeXdrLag(mbc.Metrics.AerospikeNodeXdrLag),
 		resourceAttributeIncludeFilter:                          make(map[string]filter.Filter),
 		resourceAttributeExcludeFilter:                          make(map[string]filter.Filter),
 	}
@@ -1212,11 +1430,15 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	mb.metricAerospikeNamespaceMemoryUsage.emit(ils.Metrics())
 	mb.metricAerospikeNamespaceQueryCount.emit(ils.Metrics())
 	mb.metricAerospikeNamespaceScanCount.emit(ils.Metrics())
+	mb.metricAerospikeNamespaceSindexEntries.emit(ils.Metrics())
+	mb.metricAerospikeNamespaceSindexMemoryUsage.emit(ils.Metrics())
 	mb.metricAerospikeNamespaceTransactionCount.emit(ils.Metrics())
 	mb.metricAerospikeNodeConnectionCount.emit(ils.Metrics())
 	mb.metricAerospikeNodeConnectionOpen.emit(ils.Metrics())
 	mb.metricAerospikeNodeMemoryFree.emit(ils.Metrics())
 	mb.metricAerospikeNodeQueryTracked.emit(ils.Metrics())
+	mb.metricAerospikeNodeXdrInQueue.emit(ils.Metrics())
+	mb.metricAerospikeNodeXdrLag.emit(ils.Metrics())
 
 	for _, op := range rmo {
 		op(rm)
@@ -1338,6 +1560,26 @@ func (mb *MetricsBuilder) RecordAerospikeNamespaceScanCountDataPoint(ts pcommon.
 	return nil
 }
 
+// RecordAerospikeNamespaceSindexEntriesDataPoint adds a data point to aerospike.namespace.sindex.entries metric.
+func (mb *MetricsBuilder) RecordAerospikeNamespaceSindexEntriesDataPoint(ts pcommon.Timestamp, inputVal string, sindexNameAttributeValue string) error {
+	val, err := strconv.ParseInt(inputVal, 10, 64)
+	if err != nil {
+		return fmt.Errorf("failed to parse int64 for AerospikeNamespaceSindexEntries, value was %s: %w", inputVal, err)
+	}
+	mb.metricAerospikeNamespaceSindexEntries.recordDataPoint(mb.startTime, ts, val, sindexNameAttributeValue)
+	return nil
+}
+
+// RecordAerospikeNamespaceSindexMemoryUsageDataPoint adds a data point to aerospike.namespace.sindex.memory.usage metric.
+func (mb *MetricsBuilder) RecordAerospikeNamespaceSindexMemoryUsageDataPoint(ts pcommon.Timestamp, inputVal string, sindexNameAttributeValue string) error {
+	val, err := strconv.ParseInt(inputVal, 10, 64)
+	if err != nil {
+		return fmt.Errorf("failed to parse int64 for AerospikeNamespaceSindexMemoryUsage, value was %s: %w", inputVal, err)
+	}
+	mb.metricAerospikeNamespaceSindexMemoryUsage.recordDataPoint(mb.startTime, ts, val, sindexNameAttributeValue)
+	return nil
+}
+
 // RecordAerospikeNamespaceTransactionCountDataPoint adds a data point to aerospike.namespace.transaction.count metric.
 func (mb *MetricsBuilder) RecordAerospikeNamespaceTransactionCountDataPoint(ts pcommon.Timestamp, inputVal string, transactionTypeAttributeValue AttributeTransactionType, transactionResultAttributeValue AttributeTransactionResult) error {
 	val, err := strconv.ParseInt(inputVal, 10, 64)
@@ -1388,6 +1630,26 @@ func (mb *MetricsBuilder) RecordAerospikeNodeQueryTrackedDataPoint(ts pcommon.Ti
 	return nil
 }
 
+// RecordAerospikeNodeXdrInQueueDataPoint adds a data point to aerospike.node.xdr.in_queue metric.
+func (mb *MetricsBuilder) RecordAerospikeNodeXdrInQueueDataPoint(ts pcommon.Timestamp, inputVal string, xdrDcAttributeValue string) error {
+	val, err := strconv.ParseInt(inputVal, 10, 64)
+	if err != nil {
+		return fmt.Errorf("failed to parse int64 for AerospikeNodeXdrInQueue, value was %s: %w", inputVal, err)
+	}
+	mb.metricAerospikeNodeXdrInQueue.recordDataPoint(mb.startTime, ts, val, xdrDcAttributeValue)
+	return nil
+}
+
+// RecordAerospikeNodeXdrLagDataPoint adds a data point to aerospike.node.xdr.lag metric.
+func (mb *MetricsBuilder) RecordAerospikeNodeXdrLagDataPoint(ts pcommon.Timestamp, inputVal string, xdrDcAttributeValue string) error {
+	val, err := strconv.ParseInt(inputVal, 10, 64)
+	if err != nil {
+		return fmt.Errorf("failed to parse int64 for AerospikeNodeXdrLag, value was %s: %w", inputVal, err)
+	}
+	mb.metricAerospikeNodeXdrLag.recordDataPoint(mb.startTime, ts, val, xdrDcAttributeValue)
+	return nil
+}
+
 // Reset resets metrics builder to its initial state. It should be used when external metrics source is restarted,
 // and metrics builder should update its startTime and reset it's internal state accordingly.
 func (mb *MetricsBuilder) Reset(options ...metricBuilderOption) {
@@ -104,6 +104,12 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordAerospikeNamespaceScanCountDataPoint(ts, "1", AttributeScanTypeAggregation, AttributeScanResultAbort)
 
+			allMetricsCount++
+			mb.RecordAerospikeNamespaceSindexEntriesDataPoint(ts, "1", "sindex_name-val")
+
+			allMetricsCount++
+			mb.RecordAerospikeNamespaceSindexMemoryUsageDataPoint(ts, "1", "sindex_name-val")
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordAerospikeNamespaceTransactionCountDataPoint(ts, "1", AttributeTransactionTypeDelete, AttributeTransactionResultError)
@@ -124,6 +130,12 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordAerospikeNodeQueryTrackedDataPoint(ts, "1")
 
+			allMetricsCount++
+			mb.RecordAerospikeNodeXdrInQueueDataPoint(ts, "1", "xdr_dc-val")
+
+			allMetricsCount++
+			mb.RecordAerospikeNodeXdrLagDataPoint(ts, "1", "xdr_dc-val")
+
 			rb := mb.NewResourceBuilder()
 			rb.SetAerospikeNamespace("aerospike.namespace-val")
 			rb.SetAerospikeNodeName("aerospike.node.name-val")
@@ -289,6 +301,40 @@ func TestMetricsBuilder(t *testing.T) {
 					attrVal, ok = dp.Attributes().Get("result")
 					assert.True(t, ok)
 					assert.EqualValues(t, "abort", attrVal.Str())
+				case "aerospike.namespace.sindex.entries":
+					assert.False(t, validatedMetrics["aerospike.namespace.sindex.entries"], "Found a duplicate in the metrics slice: aerospike.namespace.sindex.entries")
+					validatedMetrics["aerospike.namespace.sindex.entries"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Number of entries of the secondary index", ms.At(i).Description())
+					assert.Equal(t, "{entries}", ms.At(i).Unit())
+					assert.Equal(t, false, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("sindex")
+					assert.True(t, ok)
+					assert.EqualValues(t, "sindex_name-val", attrVal.Str())
+				case "aerospike.namespace.sindex.memory.usage":
+					assert.False(t, validatedMetrics["aerospike.namespace.sindex.memory.usage"], "Found a duplicate in the metrics slice: aerospike.namespace.sindex.memory.usage")
+					validatedMetrics["aerospike.namespace.sindex.memory.usage"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Memory used by the secondary index", ms.At(i).Description())
+					assert.Equal(t, "By", ms.At(i).Unit())
+					assert.Equal(t, false, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("sindex")
+					assert.True(t, ok)
+					assert.EqualValues(t, "sindex_name-val", attrVal.Str())
 				case "aerospike.namespace.transaction.count":
 					assert.False(t, validatedMetrics["aerospike.namespace.transaction.count"], "Found a duplicate in the metrics slice: aerospike.namespace.transaction.count")
 					validatedMetrics["aerospike.namespace.transaction.count"] = true
@@ -372,6 +418,38 @@ func TestMetricsBuilder(t *testing.T) {
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
+				case "aerospike.node.xdr.in_queue":
+					assert.False(t, validatedMetrics["aerospike.node.xdr.in_queue"], "Found a duplicate in the metrics slice: aerospike.node.xdr.in_queue")
+					validatedMetrics["aerospike.node.xdr.in_queue"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Number of records waiting to be shipped to the datacenter", ms.At(i).Description())
+					assert.Equal(t, "{records}", ms.At(i).Unit())
+					assert.Equal(t, false, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("dc")
+					assert.True(t, ok)
+					assert.EqualValues(t, "xdr_dc-val", attrVal.Str())
+				case "aerospike.node.xdr.lag":
+					assert.False(t, validatedMetrics["aerospike.node.xdr.lag"], "Found a duplicate in the metrics slice: aerospike.node.xdr.lag")
+					validatedMetrics["aerospike.node.xdr.lag"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Time elapsed since the last record shipped to the datacenter was written", ms.At(i).Description())
+					assert.Equal(t, "s", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("dc")
+					assert.True(t, ok)
+					assert.EqualValues(t, "xdr_dc-val", attrVal.Str())
 				}
 			}
 		})
@@ -0,0 +1,109 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package aerospikereceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/aerospikereceiver"
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+const (
+	latencyMetricName        = "aerospike.namespace.latency"
+	latencyMetricDescription = "Latency of the operations performed on the namespace"
+
+	// latencySlice is the duration covered by the histograms of the "latencies:" info command,
+	// the ticker-interval of the node.
+	latencySlice = 10 * time.Second
+)
+
+// newLatencyHistograms returns the histogram metric of the latencies of a namespace, with a data point per operation.
+// The histograms of the "latencies:" info command are formatted as "<unit>,<ops/sec>,<pct>,<pct>,...", where the
+// percentages are the operations slower than 1, 8, 64... units.
+func newLatencyHistograms(latencies map[string]string, operations []string, now pcommon.Timestamp) (pmetric.Metric, error) {
+	var errs error
+
+	m := pmetric.NewMetric()
+	m.SetName(latencyMetricName)
+	m.SetDescription(latencyMetricDescription)
+	m.SetUnit("ms")
+	h := m.SetEmptyHistogram()
+	h.SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+
+	start := pcommon.NewTimestampFromTime(now.AsTime().Add(-latencySlice))
+	for op, histogram := range latencies {
+		if len(operations) > 0 && !slices.Contains(operations, op) {
+			continue
+		}
+		dp := pmetric.NewHistogramDataPoint()
+		if err := setLatencyDataPoint(dp, histogram); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("failed to parse the latencies of %s, value was %s: %w", op, histogram, err))
+			continue
+		}
+		dp.SetStartTimestamp(start)
+		dp.SetTimestamp(now)
+		dp.Attributes().PutStr("operation", op)
+		dp.MoveTo(h.DataPoints().AppendEmpty())
+	}
+
+	return m, errs
+}
+
+func setLatencyDataPoint(dp pmetric.HistogramDataPoint, histogram string) error {
+	fields := strings.Split(histogram, ",")
+	if len(fields) < 3 {
+		return errors.New("missing buckets")
+	}
+
+	var unitMs float64
+	switch fields[0] {
+	case "msec":
+		unitMs = 1
+	case "usec":
+		unitMs = 0.001
+	default:
+		return fmt.Errorf("unknown unit %q", fields[0])
+	}
+
+	opsPerSec, err := strconv.ParseFloat(fields[1], 64)
+	if err != nil {
+		return err
+	}
+	count := uint64(math.Round(opsPerSec * latencySlice.Seconds()))
+
+	// the number of operations slower than each bound
+	above := make([]uint64, len(fields)-2)
+	bounds := make([]float64, len(fields)-2)
+	for i, field := range fields[2:] {
+		pct, err := strconv.ParseFloat(field, 64)
+		if err != nil {
+			return err
+		}
+		above[i] = min(uint64(math.Round(float64(count)*pct/100)), count)
+		bounds[i] = math.Pow(2, float64(3*i)) * unitMs
+	}
+
+	buckets := make([]uint64, len(bounds)+1)
+	below := count
+	for i, a := range above {
+		if a > below {
+			a = below
+		}
+		buckets[i] = below - a
+		below = a
+	}
+	buckets[len(bounds)] = below
+
+	dp.SetCount(count)
+	dp.ExplicitBounds().FromRaw(bounds)
+	dp.BucketCounts().FromRaw(buckets)
+	return nil
+}
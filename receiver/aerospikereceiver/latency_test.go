@@ -0,0 +1,97 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package aerospikereceiver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+func TestSetLatencyDataPoint(t *testing.T) {
+	testCases := []struct {
+		name      string
+		histogram string
+		count     uint64
+		bounds    []float64
+		buckets   []uint64
+		errMsg    string
+	}{
+		{
+			name:      "msec",
+			histogram: "msec,10.0,20.00,5.00,0.00",
+			count:     100,
+			bounds:    []float64{1, 8, 64},
+			buckets:   []uint64{80, 15, 5, 0},
+		},
+		{
+			name:      "usec",
+			histogram: "usec,1.0,100.00,50.00",
+			count:     10,
+			bounds:    []float64{0.001, 0.008},
+			buckets:   []uint64{0, 5, 5},
+		},
+		{
+			name:      "no operations",
+			histogram: "msec,0.0,0.00,0.00,0.00",
+			count:     0,
+			bounds:    []float64{1, 8, 64},
+			buckets:   []uint64{0, 0, 0, 0},
+		},
+		{
+			name:      "unknown unit",
+			histogram: "sec,1.0,0.00",
+			errMsg:    `unknown unit "sec"`,
+		},
+		{
+			name:      "missing buckets",
+			histogram: "msec,1.0",
+			errMsg:    "missing buckets",
+		},
+		{
+			name:      "bad percentage",
+			histogram: "msec,1.0,abc",
+			errMsg:    `strconv.ParseFloat: parsing "abc": invalid syntax`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			dp := pmetric.NewHistogramDataPoint()
+			err := setLatencyDataPoint(dp, tc.histogram)
+			if tc.errMsg != "" {
+				require.EqualError(t, err, tc.errMsg)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.count, dp.Count())
+			require.Equal(t, tc.bounds, dp.ExplicitBounds().AsRaw())
+			require.Equal(t, tc.buckets, dp.BucketCounts().AsRaw())
+		})
+	}
+}
+
+func TestNewLatencyHistograms(t *testing.T) {
+	now := pcommon.NewTimestampFromTime(time.Now())
+	latencies := map[string]string{
+		"read":  "msec,1.0,0.00,0.00,0.00",
+		"write": "msec,bad,0.00,0.00,0.00",
+		"udf":   "msec,1.0,0.00,0.00,0.00",
+	}
+
+	m, err := newLatencyHistograms(latencies, []string{"read", "write"}, now)
+	require.EqualError(t, err, `failed to parse the latencies of write, value was msec,bad,0.00,0.00,0.00: strconv.ParseFloat: parsing "bad": invalid syntax`)
+
+	require.Equal(t, "aerospike.namespace.latency", m.Name())
+	require.Equal(t, pmetric.AggregationTemporalityDelta, m.Histogram().AggregationTemporality())
+	require.Equal(t, 1, m.Histogram().DataPoints().Len())
+	dp := m.Histogram().DataPoints().At(0)
+	op, _ := dp.Attributes().Get("operation")
+	require.Equal(t, "read", op.Str())
+	require.Equal(t, now, dp.Timestamp())
+	require.Equal(t, pcommon.NewTimestampFromTime(now.AsTime().Add(-latencySlice)), dp.StartTimestamp())
+}
@@ -30,6 +30,22 @@ func (_m *Aerospike) Info() map[string]map[string]string {
 	return r0
 }
 
+// LatencyInfo provides a mock function with given fields:
+func (_m *Aerospike) LatencyInfo() map[string]map[string]map[string]string {
+	ret := _m.Called()
+
+	var r0 map[string]map[string]map[string]string
+	if rf, ok := ret.Get(0).(func() map[string]map[string]map[string]string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]map[string]map[string]string)
+		}
+	}
+
+	return r0
+}
+
 // NamespaceInfo provides a mock function with given fields:
 func (_m *Aerospike) NamespaceInfo() map[string]map[string]map[string]string {
 	ret := _m.Called()
@@ -46,6 +62,38 @@ func (_m *Aerospike) NamespaceInfo() map[string]map[string]map[string]string {
 	return r0
 }
 
+// SindexInfo provides a mock function with given fields:
+func (_m *Aerospike) SindexInfo() map[string]map[string]map[string]map[string]string {
+	ret := _m.Called()
+
+	var r0 map[string]map[string]map[string]map[string]string
+	if rf, ok := ret.Get(0).(func() map[string]map[string]map[string]map[string]string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]map[string]map[string]map[string]string)
+		}
+	}
+
+	return r0
+}
+
+// XDRInfo provides a mock function with given fields:
+func (_m *Aerospike) XDRInfo() map[string]map[string]map[string]string {
+	ret := _m.Called()
+
+	var r0 map[string]map[string]map[string]string
+	if rf, ok := ret.Get(0).(func() map[string]map[string]map[string]string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]map[string]map[string]string)
+		}
+	}
+
+	return r0
+}
+
 type mockConstructorTestingTNewAerospike interface {
 	mock.TestingT
 	Cleanup(func())
@@ -128,7 +128,9 @@ func (r *aerospikeReceiver) scrape(_ context.Context) (pmetric.Metrics, error) {
 	client := r.client
 
 	info := client.Info()
-	for _, nodeInfo := range info {
+	xdr := r.xdrInfo(client)
+	for node, nodeInfo := range info {
+		r.recordXDR(xdr[node], now, errs)
 		r.emitNode(nodeInfo, now, errs)
 	}
 	r.scrapeNamespaces(client, now, errs)
@@ -172,23 +174,74 @@ func (r *aerospikeReceiver) emitNode(info map[string]string, now pcommon.Timesta
 	r.logger.Debug("finished emitNode")
 }
 
+// xdrInfo returns the XDR statistics of the nodes, or nil if the XDR metrics are disabled
+func (r *aerospikeReceiver) xdrInfo(client Aerospike) xdrInfo {
+	metrics := r.config.MetricsBuilderConfig.Metrics
+	if !metrics.AerospikeNodeXdrLag.Enabled && !metrics.AerospikeNodeXdrInQueue.Enabled {
+		return nil
+	}
+	return client.XDRInfo()
+}
+
+// recordXDR records the XDR metrics of a node for each of its datacenters
+func (r *aerospikeReceiver) recordXDR(dcs map[string]map[string]string, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	for dc, stats := range dcs {
+		for k, v := range stats {
+			switch k {
+			case "lag":
+				addPartialIfError(errs, r.mb.RecordAerospikeNodeXdrLagDataPoint(now, v, dc))
+			case "in_queue":
+				addPartialIfError(errs, r.mb.RecordAerospikeNodeXdrInQueueDataPoint(now, v, dc))
+			}
+		}
+	}
+}
+
 // scrapeNamespaces records metrics for all namespaces on a node
 // The given client is used to collect namespace metrics, which is connected to a single node
 func (r *aerospikeReceiver) scrapeNamespaces(client Aerospike, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
 	r.logger.Debug("scraping namespaces")
 	nInfo := client.NamespaceInfo()
 	r.logger.Debugf("scrapeNamespaces len(nInfo): %v", len(nInfo))
+
+	var sInfo sindexInfo
+	metrics := r.config.MetricsBuilderConfig.Metrics
+	if metrics.AerospikeNamespaceSindexEntries.Enabled || metrics.AerospikeNamespaceSindexMemoryUsage.Enabled {
+		sInfo = client.SindexInfo()
+	}
+	var lInfo latencyInfo
+	if r.config.LatencyHistograms.Enabled {
+		lInfo = client.LatencyInfo()
+	}
+
 	for node, nsMap := range nInfo {
 		for nsName, nsStats := range nsMap {
 			nsStats["node"] = node
 			nsStats["name"] = nsName
-			r.emitNamespace(nsStats, now, errs)
+			r.recordSindexes(sInfo[node][nsName], now, errs)
+			r.emitNamespace(nsStats, lInfo[node][nsName], now, errs)
+		}
+	}
+}
+
+// recordSindexes records the metrics of the secondary indexes of a namespace
+func (r *aerospikeReceiver) recordSindexes(sindexes map[string]map[string]string, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	for sindex, stats := range sindexes {
+		for k, v := range stats {
+			switch k {
+			case "entries":
+				addPartialIfError(errs, r.mb.RecordAerospikeNamespaceSindexEntriesDataPoint(now, v, sindex))
+			// renamed used_bytes in Aerospike 7.0
+			case "memory_used", "used_bytes":
+				addPartialIfError(errs, r.mb.RecordAerospikeNamespaceSindexMemoryUsageDataPoint(now, v, sindex))
+			}
 		}
 	}
 }
 
 // emitNamespace emits a namespace resource with its name as resource attribute
-func (r *aerospikeReceiver) emitNamespace(info map[string]string, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+// The latency histograms of the namespace are added to its metrics if they are collected
+func (r *aerospikeReceiver) emitNamespace(info map[string]string, latencies map[string]string, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
 	r.logger.Debugf("emitNamespace len(info): %v", len(info))
 	for k, v := range info {
 		switch k {
@@ -390,10 +443,25 @@ func (r *aerospikeReceiver) emitNamespace(info map[string]string, now pcommon.Ti
 	rb := r.mb.NewResourceBuilder()
 	rb.SetAerospikeNamespace(info["name"])
 	rb.SetAerospikeNodeName(info["node"])
-	r.mb.EmitForResource(metadata.WithResource(rb.Emit()))
+	r.mb.EmitForResource(metadata.WithResource(rb.Emit()), r.withLatencyHistograms(latencies, now, errs))
 	r.logger.Debug("finished emitNamespace")
 }
 
+// withLatencyHistograms adds the latency histograms to the metrics of the namespace
+func (r *aerospikeReceiver) withLatencyHistograms(latencies map[string]string, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) metadata.ResourceMetricsOption {
+	if len(latencies) == 0 {
+		return func(pmetric.ResourceMetrics) {}
+	}
+
+	histograms, err := newLatencyHistograms(latencies, r.config.LatencyHistograms.Operations, now)
+	addPartialIfError(errs, err)
+	return func(rm pmetric.ResourceMetrics) {
+		if histograms.Histogram().DataPoints().Len() > 0 {
+			histograms.MoveTo(rm.ScopeMetrics().At(0).Metrics().AppendEmpty())
+		}
+	}
+}
+
 // addPartialIfError adds a partial error if the given error isn't nil
 func addPartialIfError(errs *scrapererror.ScrapeErrors, err error) {
 	if err != nil {
@@ -173,3 +173,105 @@ func TestScrape_CollectClusterMetrics(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, receiverConnErr.client, nil, "client should be set to nil because of connection error")
 }
+
+func TestScrape_XDRSindexAndLatencies(t *testing.T) {
+	t.Parallel()
+
+	logger, err := zap.NewDevelopment()
+	require.NoError(t, err)
+	now := pcommon.NewTimestampFromTime(time.Now().UTC())
+
+	mbc := metadata.DefaultMetricsBuilderConfig()
+	mbc.Metrics.AerospikeNodeXdrLag.Enabled = true
+	mbc.Metrics.AerospikeNodeXdrInQueue.Enabled = true
+	mbc.Metrics.AerospikeNamespaceSindexEntries.Enabled = true
+	mbc.Metrics.AerospikeNamespaceSindexMemoryUsage.Enabled = true
+
+	expectedMB := metadata.NewMetricsBuilder(mbc, receivertest.NewNopCreateSettings())
+	rb := metadata.NewResourceBuilder(metadata.DefaultResourceAttributesConfig())
+
+	require.NoError(t, expectedMB.RecordAerospikeNodeXdrLagDataPoint(now, "2", "dc1"))
+	require.NoError(t, expectedMB.RecordAerospikeNodeXdrInQueueDataPoint(now, "10", "dc1"))
+	rb.SetAerospikeNodeName("BB990C28F270008")
+	expectedMB.EmitForResource(metadata.WithResource(rb.Emit()))
+
+	require.NoError(t, expectedMB.RecordAerospikeNamespaceSindexEntriesDataPoint(now, "100", "idx_age"))
+	require.NoError(t, expectedMB.RecordAerospikeNamespaceSindexMemoryUsageDataPoint(now, "18688", "idx_age"))
+	rb.SetAerospikeNamespace("test")
+	rb.SetAerospikeNodeName("BB990C28F270008")
+	expectedMB.EmitForResource(metadata.WithResource(rb.Emit()), func(rm pmetric.ResourceMetrics) {
+		m := rm.ScopeMetrics().At(0).Metrics().AppendEmpty()
+		m.SetName("aerospike.namespace.latency")
+		m.SetDescription("Latency of the operations performed on the namespace")
+		m.SetUnit("ms")
+		h := m.SetEmptyHistogram()
+		h.SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+		dp := h.DataPoints().AppendEmpty()
+		dp.Attributes().PutStr("operation", "read")
+		dp.SetCount(100)
+		dp.ExplicitBounds().FromRaw([]float64{1, 8, 64})
+		dp.BucketCounts().FromRaw([]uint64{80, 15, 5, 0})
+	})
+
+	client := mocks.NewAerospike(t)
+	client.On("Info").Return(clusterInfo{
+		"BB990C28F270008": metricsMap{
+			"node": "BB990C28F270008",
+		},
+	}, nil)
+	client.On("XDRInfo").Return(xdrInfo{
+		"BB990C28F270008": map[string]map[string]string{
+			"dc1": metricsMap{
+				"lag":      "2",
+				"in_queue": "10",
+			},
+		},
+	}, nil)
+	client.On("NamespaceInfo").Return(namespaceInfo{
+		"BB990C28F270008": map[string]map[string]string{
+			"test": metricsMap{},
+		},
+	}, nil)
+	client.On("SindexInfo").Return(sindexInfo{
+		"BB990C28F270008": map[string]map[string]map[string]string{
+			"test": {
+				"idx_age": metricsMap{
+					"entries":     "100",
+					"memory_used": "18688",
+				},
+			},
+		},
+	}, nil)
+	client.On("LatencyInfo").Return(latencyInfo{
+		"BB990C28F270008": map[string]map[string]string{
+			"test": {
+				"read":  "msec,10.0,20.00,5.00,0.00",
+				"write": "msec,1.0,0.00,0.00,0.00",
+			},
+		},
+	}, nil)
+
+	receiver := &aerospikeReceiver{
+		clientFactory: func() (Aerospike, error) {
+			return client, nil
+		},
+		mb:     metadata.NewMetricsBuilder(mbc, receivertest.NewNopCreateSettings()),
+		logger: logger.Sugar(),
+		config: &Config{
+			MetricsBuilderConfig: mbc,
+			LatencyHistograms: LatencyHistogramsConfig{
+				Enabled:    true,
+				Operations: []string{"read"},
+			},
+		},
+	}
+
+	require.NoError(t, receiver.start(context.Background(), componenttest.NewNopHost()))
+
+	actualMetrics, err := receiver.scrape(context.Background())
+	require.NoError(t, err)
+
+	expectedMetrics := expectedMB.Emit()
+	require.NoError(t, pmetrictest.CompareMetrics(expectedMetrics, actualMetrics, pmetrictest.IgnoreResourceMetricsOrder(),
+		pmetrictest.IgnoreMetricDataPointsOrder(), pmetrictest.IgnoreStartTimestamp(), pmetrictest.IgnoreTimestamp()))
+}
@@ -0,0 +1,35 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package translator // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awsxrayreceiver/internal/translator"
+
+import (
+	"go.opentelemetry.io/collector/pdata/ptrace"
+
+	awsxray "github.com/open-telemetry/opentelemetry-collector-contrib/internal/aws/xray"
+)
+
+func addLinks(links []awsxray.SpanLinkData, span ptrace.Span) error {
+	if len(links) == 0 {
+		return nil
+	}
+	spanLinks := span.Links()
+	spanLinks.EnsureCapacity(len(links))
+	for _, l := range links {
+		traceID, err := decodeXRayTraceID(l.TraceID)
+		if err != nil {
+			return err
+		}
+		spanID, err := decodeXRaySpanID(l.SpanID)
+		if err != nil {
+			return err
+		}
+		link := spanLinks.AppendEmpty()
+		link.SetTraceID(traceID)
+		link.SetSpanID(spanID)
+		if err := link.Attributes().FromRaw(l.Attributes); err != nil {
+			return err
+		}
+	}
+	return nil
+}
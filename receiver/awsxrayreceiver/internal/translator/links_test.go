@@ -0,0 +1,63 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package translator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+
+	awsxray "github.com/open-telemetry/opentelemetry-collector-contrib/internal/aws/xray"
+)
+
+func TestAddLinks(t *testing.T) {
+	span := ptrace.NewSpan()
+	err := addLinks([]awsxray.SpanLinkData{
+		{
+			TraceID: awsxray.String("1-5f84c7a1-e7d1852db8c4fd35d88bf49a"),
+			SpanID:  awsxray.String("defdfd9912dc5a56"),
+			Attributes: map[string]any{
+				"str":   "value",
+				"count": float64(3),
+				"list":  []any{"a", "b"},
+			},
+		},
+		{
+			TraceID: awsxray.String("1-5f84c7a1-e7d1852db8c4fd35d88bf49b"),
+			SpanID:  awsxray.String("defdfd9912dc5a57"),
+		},
+	}, span)
+	require.NoError(t, err)
+
+	require.Equal(t, 2, span.Links().Len())
+	link := span.Links().At(0)
+	assert.Equal(t, pcommon.TraceID{0x5f, 0x84, 0xc7, 0xa1, 0xe7, 0xd1, 0x85, 0x2d, 0xb8, 0xc4, 0xfd, 0x35, 0xd8, 0x8b, 0xf4, 0x9a}, link.TraceID())
+	assert.Equal(t, pcommon.SpanID{0xde, 0xfd, 0xfd, 0x99, 0x12, 0xdc, 0x5a, 0x56}, link.SpanID())
+	assert.Equal(t, map[string]any{
+		"str":   "value",
+		"count": float64(3),
+		"list":  []any{"a", "b"},
+	}, link.Attributes().AsRaw())
+	assert.Equal(t, 0, span.Links().At(1).Attributes().Len())
+}
+
+func TestAddLinksInvalidID(t *testing.T) {
+	err := addLinks([]awsxray.SpanLinkData{
+		{
+			TraceID: awsxray.String("1-5f84c7a1-e7d1852db8c4fd35d88bf49a"),
+			SpanID:  awsxray.String("defdfd99"),
+		},
+	}, ptrace.NewSpan())
+	assert.EqualError(t, err, "spanID length is wrong")
+
+	err = addLinks([]awsxray.SpanLinkData{
+		{
+			SpanID: awsxray.String("defdfd9912dc5a56"),
+		},
+	}, ptrace.NewSpan())
+	assert.EqualError(t, err, "traceID is null")
+}
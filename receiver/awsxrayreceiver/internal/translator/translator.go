@@ -183,6 +183,9 @@ func populateSpan(seg *awsxray.Segment, traceID, parentID *string, span ptrace.S
 	addBool(seg.Traced, awsxray.AWSXRayTracedAttribute, attrs)
 
 	addAnnotations(seg.Annotations, attrs)
+	if err = addLinks(seg.Links, span); err != nil {
+		return err
+	}
 	return addMetadata(seg.Metadata, attrs)
 }
 
@@ -33,6 +33,10 @@ const (
 	nodesStatsPath = "/mgmt/tm/ltm/node/stats"
 	// poolMembersStatsPathSuffix is the suffix added onto an individual pool's statistics endpoint
 	poolMembersStatsPathSuffix = "/members/stats"
+	// logsStatsPathFormat is the format of the path to a system log's endpoint, e.g. ltm or audit
+	logsStatsPathFormat = "/mgmt/tm/sys/log/%s/stats?options=lines,%d"
+	// logsMaxLines is the number of most recent lines requested from a system log
+	logsMaxLines = 1000
 )
 
 // custom errors
@@ -54,6 +58,8 @@ type client interface {
 	GetPoolMembers(ctx context.Context, pools *models.Pools) (*models.PoolMembers, error)
 	// GetNodes retrieves data for all LTM nodes in a Big-IP environment
 	GetNodes(ctx context.Context) (*models.Nodes, error)
+	// GetLogs retrieves the most recent lines of the named system log in a Big-IP environment
+	GetLogs(ctx context.Context, logName string) (*models.Logs, error)
 }
 
 // bigipClient implements the client interface and retrieves data through the iControl REST API
@@ -187,6 +193,16 @@ func (c *bigipClient) GetNodes(ctx context.Context) (nodes *models.Nodes, err er
 	return nodes, nil
 }
 
+// GetLogs makes a call to the endpoint of the named system log and returns its most recent lines.
+func (c *bigipClient) GetLogs(ctx context.Context, logName string) (logs *models.Logs, err error) {
+	if err = c.get(ctx, fmt.Sprintf(logsStatsPathFormat, logName, logsMaxLines), &logs); err != nil {
+		c.logger.Debug("Failed to retrieve logs", zap.String("log", logName), zap.Error(err))
+		return nil, err
+	}
+
+	return logs, nil
+}
+
 // post makes a POST request for the passed in path and stores result in the respObj
 func (c *bigipClient) post(ctx context.Context, path string, respObj any) error {
 	// Construct endpoint and create request
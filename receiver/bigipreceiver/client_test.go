@@ -717,6 +717,60 @@ func TestGetNodes(t *testing.T) {
 	}
 }
 
+func TestGetLogs(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		testFunc func(*testing.T)
+	}{
+		{
+			desc: "Non-200 Response",
+			testFunc: func(t *testing.T) {
+				// Setup test server
+				ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+					w.WriteHeader(http.StatusUnauthorized)
+				}))
+				defer ts.Close()
+
+				tc := createTestClient(t, ts.URL)
+
+				logs, err := tc.GetLogs(context.Background(), ltmLogName)
+				require.Nil(t, logs)
+				require.EqualError(t, err, "non 200 code returned 401")
+			},
+		},
+		{
+			desc: "Successful call",
+			testFunc: func(t *testing.T) {
+				data := loadAPIResponseData(t, ltmLogResponseFile)
+
+				// Setup test server
+				ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					require.Equal(t, "/mgmt/tm/sys/log/ltm/stats", r.URL.Path)
+					require.Equal(t, "lines,1000", r.URL.Query().Get("options"))
+					_, err := w.Write(data)
+					require.NoError(t, err)
+				}))
+				defer ts.Close()
+
+				tc := createTestClient(t, ts.URL)
+
+				// Load the valid data into a struct to compare
+				var expected *models.Logs
+				err := json.Unmarshal(data, &expected)
+				require.NoError(t, err)
+
+				logs, err := tc.GetLogs(context.Background(), ltmLogName)
+				require.NoError(t, err)
+				require.Equal(t, expected, logs)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, tc.testFunc)
+	}
+}
+
 func createTestClient(t *testing.T, baseEndpoint string) client {
 	t.Helper()
 	cfg := createDefaultConfig().(*Config)
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"time"
 
 	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/config/configopaque"
@@ -21,6 +22,7 @@ var (
 	errMissingUsername = errors.New(`"username" not specified in config`)
 	errMissingPassword = errors.New(`"password" not specified in config`)
 	errInvalidEndpoint = errors.New(`"endpoint" must be in the form of <scheme>://<hostname>:<port>`)
+	errInvalidTimezone = errors.New(`"logs::timezone" must be a valid IANA time zone name`)
 )
 
 const defaultEndpoint = "https://localhost:443"
@@ -32,6 +34,19 @@ type Config struct {
 	Username                       string              `mapstructure:"username"`
 	Password                       configopaque.String `mapstructure:"password"`
 	metadata.MetricsBuilderConfig  `mapstructure:",squash"`
+	Logs                           LogsConfig `mapstructure:"logs"`
+}
+
+// LogsConfig defines which system logs are collected when the receiver is used in a logs pipeline
+type LogsConfig struct {
+	// PollInterval is how often the system logs are read
+	PollInterval time.Duration `mapstructure:"poll_interval"`
+	// LTMLogs enables collection of the local traffic manager log, which contains pool member and monitor events
+	LTMLogs bool `mapstructure:"ltm_logs"`
+	// AuditLogs enables collection of the audit log
+	AuditLogs bool `mapstructure:"audit_logs"`
+	// Timezone is the time zone of the Big-IP system, used to interpret the timestamps of log messages
+	Timezone string `mapstructure:"timezone"`
 }
 
 // Validate validates the configuration by checking for missing or invalid fields
@@ -51,5 +66,9 @@ func (cfg *Config) Validate() error {
 		err = multierr.Append(err, wrappedErr)
 	}
 
+	if _, tzErr := time.LoadLocation(cfg.Logs.Timezone); tzErr != nil {
+		err = multierr.Append(err, fmt.Errorf("%s: %w", errInvalidTimezone.Error(), tzErr))
+	}
+
 	return err
 }
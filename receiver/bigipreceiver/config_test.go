@@ -4,9 +4,11 @@
 package bigipreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/bigipreceiver"
 
 import (
+	"errors"
 	"fmt"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
@@ -103,6 +105,21 @@ func TestValidate(t *testing.T) {
 				errMissingPassword,
 			),
 		},
+		{
+			desc: "invalid timezone",
+			cfg: &Config{
+				Username: "otelu",
+				Password: "otelp",
+				ClientConfig: confighttp.ClientConfig{
+					Endpoint: defaultEndpoint,
+				},
+				ControllerConfig: scraperhelper.NewDefaultControllerConfig(),
+				Logs: LogsConfig{
+					Timezone: "Mars/Olympus_Mons",
+				},
+			},
+			expectedErr: fmt.Errorf("%s: %w", errInvalidTimezone.Error(), errors.New("unknown time zone Mars/Olympus_Mons")),
+		},
 		{
 			desc:        "valid default config with supplied username/password",
 			cfg:         defaultConfig,
@@ -138,6 +155,9 @@ func TestLoadConfig(t *testing.T) {
 	expected.Username = "otelu"
 	expected.Password = "${env:BIGIP_PASSWORD}"
 	expected.TLSSetting.InsecureSkipVerify = true
+	expected.Logs.PollInterval = 5 * time.Minute
+	expected.Logs.AuditLogs = true
+	expected.Logs.Timezone = "America/Los_Angeles"
 
 	require.Equal(t, expected, cfg)
 }
@@ -19,12 +19,18 @@ import (
 
 var errConfigNotBigip = errors.New("config was not a Big-IP receiver config")
 
+const (
+	defaultLogsPollInterval = time.Minute
+	defaultLogsTimezone     = "UTC"
+)
+
 // NewFactory creates a new receiver factory for Big-IP
 func NewFactory() receiver.Factory {
 	return receiver.NewFactory(
 		metadata.Type,
 		createDefaultConfig,
-		receiver.WithMetrics(createMetricsReceiver, metadata.MetricsStability))
+		receiver.WithMetrics(createMetricsReceiver, metadata.MetricsStability),
+		receiver.WithLogs(createLogsReceiver, metadata.LogsStability))
 }
 
 // createDefaultConfig creates a config for Big-IP with as many default values as possible
@@ -38,6 +44,11 @@ func createDefaultConfig() component.Config {
 			Timeout:  10 * time.Second,
 		},
 		MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+		Logs: LogsConfig{
+			PollInterval: defaultLogsPollInterval,
+			LTMLogs:      true,
+			Timezone:     defaultLogsTimezone,
+		},
 	}
 }
 
@@ -56,3 +67,12 @@ func createMetricsReceiver(_ context.Context, params receiver.CreateSettings, rC
 
 	return scraperhelper.NewScraperControllerReceiver(&cfg.ControllerConfig, params, consumer, scraperhelper.AddScraper(scraper))
 }
+
+func createLogsReceiver(_ context.Context, params receiver.CreateSettings, rConf component.Config, consumer consumer.Logs) (receiver.Logs, error) {
+	cfg, ok := rConf.(*Config)
+	if !ok {
+		return nil, errConfigNotBigip
+	}
+
+	return newLogsReceiver(params.Logger, cfg, params, consumer), nil
+}
@@ -15,6 +15,29 @@ type MockClient struct {
 	mock.Mock
 }
 
+// GetLogs provides a mock function with given fields: ctx, logName
+func (_m *MockClient) GetLogs(ctx context.Context, logName string) (*models.Logs, error) {
+	ret := _m.Called(ctx, logName)
+
+	var r0 *models.Logs
+	if rf, ok := ret.Get(0).(func(context.Context, string) *models.Logs); ok {
+		r0 = rf(ctx, logName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Logs)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, logName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetNewToken provides a mock function with given fields: ctx
 func (_m *MockClient) GetNewToken(ctx context.Context) error {
 	ret := _m.Called(ctx)
@@ -0,0 +1,12 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package models // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/bigipreceiver/internal/models"
+
+// Logs represents the top level json returned by the sys/log/<name>/stats endpoint
+type Logs struct {
+	APIRawValues struct {
+		// APIAnonymous contains the raw output of the log, one syslog formatted message per line
+		APIAnonymous string `json:"apiAnonymous,omitempty"`
+	} `json:"apiRawValues,omitempty"`
+}
@@ -0,0 +1,269 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package bigipreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/bigipreceiver"
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/receiver"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+)
+
+const (
+	// logsScopeName matches the scope name of the metrics emitted by the receiver
+	logsScopeName = "otelcol/bigipreceiver"
+	ltmLogName    = "ltm"
+	auditLogName  = "audit"
+	// logTimestampLayout is the RFC 3164 timestamp layout used by Big-IP system logs, which omits the year
+	logTimestampLayout = "Jan _2 15:04:05"
+)
+
+// logLineRegex matches a Big-IP system log message, e.g.
+// May 12 13:00:00 bigip1 notice mcpd[6245]: 01070638:5: Pool /Common/web member /Common/10.0.0.1:80 monitor status down.
+var logLineRegex = regexp.MustCompile(`^(\w{3} [ \d]\d \d{2}:\d{2}:\d{2}) (\S+) (\w+) ([^\[:\s]+)(?:\[(\d+)\])?: (?:([0-9a-fA-F]{8}):\d: )?(.*)$`)
+
+var severityMapping = map[string]plog.SeverityNumber{
+	"emerg":   plog.SeverityNumberFatal,
+	"alert":   plog.SeverityNumberError3,
+	"crit":    plog.SeverityNumberError2,
+	"err":     plog.SeverityNumberError,
+	"warning": plog.SeverityNumberWarn,
+	"warn":    plog.SeverityNumberWarn,
+	"notice":  plog.SeverityNumberInfo2,
+	"info":    plog.SeverityNumberInfo,
+	"debug":   plog.SeverityNumberDebug,
+}
+
+// logMessage is a single parsed line of a Big-IP system log
+type logMessage struct {
+	timestamp time.Time
+	hostname  string
+	severity  string
+	appname   string
+	procID    string
+	msgID     string
+	message   string
+	raw       string
+}
+
+// logCheckpoint tracks the most recent messages emitted for a system log. Messages which
+// share the timestamp of the checkpoint are remembered so they are not emitted twice.
+type logCheckpoint struct {
+	timestamp time.Time
+	seen      map[string]struct{}
+}
+
+// bigipLogsReceiver polls the system logs of a Big-IP environment and converts them to logs
+type bigipLogsReceiver struct {
+	client       client
+	logger       *zap.Logger
+	cfg          *Config
+	settings     component.TelemetrySettings
+	consumer     consumer.Logs
+	pollInterval time.Duration
+	location     *time.Location
+	checkpoints  map[string]*logCheckpoint
+	cancel       context.CancelFunc
+	wg           *sync.WaitGroup
+}
+
+// newLogsReceiver creates an initialized bigipLogsReceiver
+func newLogsReceiver(logger *zap.Logger, cfg *Config, settings receiver.CreateSettings, consumer consumer.Logs) *bigipLogsReceiver {
+	r := &bigipLogsReceiver{
+		logger:       logger,
+		cfg:          cfg,
+		settings:     settings.TelemetrySettings,
+		consumer:     consumer,
+		pollInterval: cfg.Logs.PollInterval,
+		location:     time.UTC,
+		checkpoints:  map[string]*logCheckpoint{},
+		wg:           &sync.WaitGroup{},
+	}
+
+	if r.pollInterval == 0 {
+		r.pollInterval = defaultLogsPollInterval
+	}
+
+	// the time zone has already been validated as part of the config
+	if location, err := time.LoadLocation(cfg.Logs.Timezone); err == nil {
+		r.location = location
+	}
+
+	return r
+}
+
+// Start initializes a new big-ip client and starts polling the configured system logs
+func (r *bigipLogsReceiver) Start(ctx context.Context, host component.Host) error {
+	if r.client == nil {
+		c, err := newClient(ctx, r.cfg, host, r.settings, r.logger)
+		if err != nil {
+			return err
+		}
+		r.client = c
+	}
+
+	cancelCtx, cancel := context.WithCancel(context.Background())
+	r.cancel = cancel
+
+	t := time.NewTicker(r.pollInterval)
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		defer t.Stop()
+		for {
+			select {
+			case <-t.C:
+				if err := r.poll(cancelCtx); err != nil {
+					r.logger.Error("Failed to poll Big-IP system logs", zap.Error(err))
+				}
+			case <-cancelCtx.Done():
+				return
+			}
+		}
+	}()
+
+	return nil
+}
+
+// Shutdown stops polling the system logs
+func (r *bigipLogsReceiver) Shutdown(context.Context) error {
+	if r.cancel != nil {
+		r.cancel()
+	}
+	r.wg.Wait()
+	return nil
+}
+
+// poll reads the enabled system logs and emits the messages that have not been emitted yet
+func (r *bigipLogsReceiver) poll(ctx context.Context) error {
+	// initialize auth token
+	if err := r.client.GetNewToken(ctx); err != nil {
+		return err
+	}
+
+	var logNames []string
+	if r.cfg.Logs.LTMLogs {
+		logNames = append(logNames, ltmLogName)
+	}
+	if r.cfg.Logs.AuditLogs {
+		logNames = append(logNames, auditLogName)
+	}
+
+	now := time.Now()
+	logs := plog.NewLogs()
+	var errs error
+	for _, logName := range logNames {
+		resp, err := r.client.GetLogs(ctx, logName)
+		if err != nil {
+			errs = multierr.Append(errs, err)
+			continue
+		}
+		r.processLog(logs, now, logName, parseLogMessages(resp.APIRawValues.APIAnonymous, now.In(r.location)))
+	}
+
+	if logs.LogRecordCount() > 0 {
+		if err := r.consumer.ConsumeLogs(ctx, logs); err != nil {
+			errs = multierr.Append(errs, err)
+		}
+	}
+	return errs
+}
+
+// processLog appends the messages newer than the checkpoint of the system log to logs.
+// On the first poll of a system log only the messages written during the last poll interval are emitted.
+func (r *bigipLogsReceiver) processLog(logs plog.Logs, now time.Time, logName string, messages []logMessage) {
+	checkpoint, ok := r.checkpoints[logName]
+	if !ok {
+		checkpoint = &logCheckpoint{timestamp: now.Add(-r.pollInterval), seen: map[string]struct{}{}}
+		r.checkpoints[logName] = checkpoint
+	}
+
+	observed := pcommon.NewTimestampFromTime(now)
+	scopeLogsByHost := map[string]plog.ScopeLogs{}
+	for _, msg := range messages {
+		if msg.timestamp.Before(checkpoint.timestamp) {
+			continue
+		}
+		if msg.timestamp.Equal(checkpoint.timestamp) {
+			if _, seen := checkpoint.seen[msg.raw]; seen {
+				continue
+			}
+		} else {
+			checkpoint.timestamp = msg.timestamp
+			checkpoint.seen = map[string]struct{}{}
+		}
+		checkpoint.seen[msg.raw] = struct{}{}
+
+		sl, ok := scopeLogsByHost[msg.hostname]
+		if !ok {
+			rl := logs.ResourceLogs().AppendEmpty()
+			rl.Resource().Attributes().PutStr("host.name", msg.hostname)
+			sl = rl.ScopeLogs().AppendEmpty()
+			sl.Scope().SetName(logsScopeName)
+			scopeLogsByHost[msg.hostname] = sl
+		}
+
+		lr := sl.LogRecords().AppendEmpty()
+		lr.SetObservedTimestamp(observed)
+		lr.SetTimestamp(pcommon.NewTimestampFromTime(msg.timestamp))
+		lr.SetSeverityNumber(severityMapping[msg.severity])
+		lr.SetSeverityText(msg.severity)
+		lr.Body().SetStr(msg.message)
+
+		attrs := lr.Attributes()
+		attrs.PutStr("event.domain", "bigip")
+		attrs.PutStr("event.name", logName)
+		attrs.PutStr("appname", msg.appname)
+		if msg.procID != "" {
+			attrs.PutStr("proc_id", msg.procID)
+		}
+		if msg.msgID != "" {
+			attrs.PutStr("msg_id", msg.msgID)
+		}
+	}
+}
+
+// parseLogMessages parses the raw output of a system log. Lines which are not log messages, such as the
+// header of the output, are skipped. As the messages don't include a year, it is inferred from now.
+func parseLogMessages(raw string, now time.Time) []logMessage {
+	var messages []logMessage
+	for _, line := range strings.Split(raw, "\n") {
+		line = strings.TrimSpace(line)
+		match := logLineRegex.FindStringSubmatch(line)
+		if match == nil {
+			continue
+		}
+
+		ts, err := time.ParseInLocation(logTimestampLayout, match[1], now.Location())
+		if err != nil {
+			continue
+		}
+		ts = ts.AddDate(now.Year(), 0, 0)
+		// messages from the end of the previous year read at the start of a new one
+		if ts.After(now.Add(24 * time.Hour)) {
+			ts = ts.AddDate(-1, 0, 0)
+		}
+
+		messages = append(messages, logMessage{
+			timestamp: ts,
+			hostname:  match[2],
+			severity:  strings.ToLower(match[3]),
+			appname:   match[4],
+			procID:    match[5],
+			msgID:     match[6],
+			message:   match[7],
+			raw:       line,
+		})
+	}
+	return messages
+}
@@ -0,0 +1,144 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package bigipreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/bigipreceiver"
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/bigipreceiver/internal/mocks"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/bigipreceiver/internal/models"
+)
+
+const ltmLogResponseFile = "get_ltm_log_response.json"
+
+func TestParseLogMessages(t *testing.T) {
+	var logs *models.Logs
+	require.NoError(t, json.Unmarshal(loadAPIResponseData(t, ltmLogResponseFile), &logs))
+
+	now := time.Date(2022, time.May, 12, 13, 1, 0, 0, time.UTC)
+	messages := parseLogMessages(logs.APIRawValues.APIAnonymous, now)
+	require.Len(t, messages, 4)
+
+	require.Equal(t, logMessage{
+		timestamp: time.Date(2022, time.May, 12, 13, 0, 0, 0, time.UTC),
+		hostname:  "bigip1",
+		severity:  "err",
+		appname:   "mcpd",
+		procID:    "6245",
+		msgID:     "01070727",
+		message:   "Pool /Common/web_pool member /Common/10.0.0.2:80 monitor status down.",
+		raw:       "May 12 13:00:00 bigip1 err mcpd[6245]: 01070727:3: Pool /Common/web_pool member /Common/10.0.0.2:80 monitor status down.",
+	}, messages[2])
+	require.Empty(t, messages[0].msgID)
+
+	// messages from December read in January belong to the previous year
+	messages = parseLogMessages("Dec 31 23:59:59 bigip1 info mcpd[6245]: 01070417:6: done", time.Date(2023, time.January, 1, 0, 0, 10, 0, time.UTC))
+	require.Len(t, messages, 1)
+	require.Equal(t, 2022, messages[0].timestamp.Year())
+}
+
+func TestProcessLog(t *testing.T) {
+	var logs *models.Logs
+	require.NoError(t, json.Unmarshal(loadAPIResponseData(t, ltmLogResponseFile), &logs))
+
+	r := newLogsReceiver(zap.NewNop(), createDefaultConfig().(*Config), receivertest.NewNopCreateSettings(), &consumertest.LogsSink{})
+
+	now := time.Date(2022, time.May, 12, 13, 0, 30, 0, time.UTC)
+	messages := parseLogMessages(logs.APIRawValues.APIAnonymous, now)
+
+	// only the messages of the last poll interval are emitted by the first poll
+	first := plog.NewLogs()
+	r.processLog(first, now, ltmLogName, messages)
+	require.Equal(t, 3, first.LogRecordCount())
+
+	records := first.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+	require.Equal(t, plog.SeverityNumberInfo2, records.At(0).SeverityNumber())
+	require.Equal(t, plog.SeverityNumberError, records.At(1).SeverityNumber())
+	require.Equal(t, plog.SeverityNumberError2, records.At(2).SeverityNumber())
+	require.Equal(t, "crit", records.At(2).SeverityText())
+	msgID, ok := records.At(2).Attributes().Get("msg_id")
+	require.True(t, ok)
+	require.Equal(t, "01010028", msgID.Str())
+
+	// messages which have already been emitted are skipped, even if they share the timestamp of the checkpoint
+	next := "May 12 13:00:05 bigip1 notice mcpd[6245]: 01070727:5: Pool /Common/web_pool member /Common/10.0.0.2:80 monitor status up."
+	second := plog.NewLogs()
+	r.processLog(second, now.Add(time.Minute), ltmLogName, append(messages, parseLogMessages(next, now)...))
+	require.Equal(t, 1, second.LogRecordCount())
+	require.Equal(t, "Pool /Common/web_pool member /Common/10.0.0.2:80 monitor status up.",
+		second.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Body().Str())
+}
+
+func TestPollLogs(t *testing.T) {
+	var logs *models.Logs
+	require.NoError(t, json.Unmarshal(loadAPIResponseData(t, ltmLogResponseFile), &logs))
+
+	testCases := []struct {
+		desc              string
+		setupMockClient   func(t *testing.T) client
+		expectedErr       error
+		expectedLogsCount int
+	}{
+		{
+			desc: "Failed to fetch token",
+			setupMockClient: func(*testing.T) client {
+				mockClient := mocks.MockClient{}
+				mockClient.On("GetNewToken", mock.Anything).Return(errors.New("some api error"))
+				return &mockClient
+			},
+			expectedErr: errors.New("some api error"),
+		},
+		{
+			desc: "Failed to fetch logs",
+			setupMockClient: func(*testing.T) client {
+				mockClient := mocks.MockClient{}
+				mockClient.On("GetNewToken", mock.Anything).Return(nil)
+				mockClient.On("GetLogs", mock.Anything, ltmLogName).Return(nil, errors.New("some api error"))
+				return &mockClient
+			},
+			expectedErr: errors.New("some api error"),
+		},
+		{
+			desc: "Successfully fetched logs",
+			setupMockClient: func(*testing.T) client {
+				mockClient := mocks.MockClient{}
+				mockClient.On("GetNewToken", mock.Anything).Return(nil)
+				mockClient.On("GetLogs", mock.Anything, ltmLogName).Return(logs, nil)
+				return &mockClient
+			},
+			expectedLogsCount: 4,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			sink := &consumertest.LogsSink{}
+			r := newLogsReceiver(zap.NewNop(), createDefaultConfig().(*Config), receivertest.NewNopCreateSettings(), sink)
+			r.client = tc.setupMockClient(t)
+			// emit all messages of the test data regardless of the current time
+			r.checkpoints[ltmLogName] = &logCheckpoint{seen: map[string]struct{}{}}
+
+			err := r.poll(context.Background())
+			if tc.expectedErr != nil {
+				require.EqualError(t, err, tc.expectedErr.Error())
+				require.Empty(t, sink.AllLogs())
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedLogsCount, sink.LogRecordCount())
+		})
+	}
+}
@@ -5,10 +5,12 @@ package carbonreceiver // import "github.com/open-telemetry/opentelemetry-collec
 
 import (
 	"errors"
+	"strings"
 	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/confignet"
+	"go.opentelemetry.io/collector/config/configtls"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/carbonreceiver/protocol"
 )
@@ -23,6 +25,10 @@ type Config struct {
 	// if transport being used is UDP.
 	TCPIdleTimeout time.Duration `mapstructure:"tcp_idle_timeout"`
 
+	// TLSSetting enables TLS on the TCP connections, it is not supported if
+	// transport being used is UDP.
+	TLSSetting *configtls.ServerConfig `mapstructure:"tls"`
+
 	// Parser specifies a parser and the respective configuration to be used
 	// by the receiver.
 	Parser *protocol.Config `mapstructure:"parser"`
@@ -32,5 +38,8 @@ func (cfg *Config) Validate() error {
 	if cfg.TCPIdleTimeout < 0 {
 		return errors.New("'tcp_idle_timeout' must be non-negative")
 	}
+	if cfg.TLSSetting != nil && strings.EqualFold(string(cfg.Transport), "udp") {
+		return errors.New("'tls' is not supported with the udp transport")
+	}
 	return nil
 }
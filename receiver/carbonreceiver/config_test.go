@@ -12,6 +12,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/confignet"
+	"go.opentelemetry.io/collector/config/configtls"
 	"go.opentelemetry.io/collector/confmap/confmaptest"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/carbonreceiver/internal/metadata"
@@ -106,4 +107,9 @@ func TestConfigValidate(t *testing.T) {
 		},
 	}
 	assert.Error(t, cfg.Validate())
+
+	cfg.TCPIdleTimeout = 0
+	cfg.Transport = confignet.TransportTypeUDP
+	cfg.TLSSetting = &configtls.ServerConfig{}
+	assert.EqualError(t, cfg.Validate(), "'tls' is not supported with the udp transport")
 }
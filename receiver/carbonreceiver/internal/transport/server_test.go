@@ -28,7 +28,7 @@ func Test_Server_ListenAndServe(t *testing.T) {
 		{
 			name: "tcp",
 			buildServerFn: func(addr string) (Server, error) {
-				return NewTCPServer(addr, 1*time.Second)
+				return NewTCPServer(addr, 1*time.Second, nil)
 			},
 			buildClientFn: func(addr string) (*client.Graphite, error) {
 				return client.NewGraphite(client.TCP, addr)
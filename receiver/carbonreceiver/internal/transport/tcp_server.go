@@ -6,8 +6,8 @@ package transport // import "github.com/open-telemetry/opentelemetry-collector-c
 import (
 	"bufio"
 	"context"
+	"crypto/tls"
 	"errors"
-	"io"
 	"net"
 	"strings"
 	"sync"
@@ -28,15 +28,20 @@ type tcpServer struct {
 
 var _ Server = (*tcpServer)(nil)
 
-// NewTCPServer creates a transport.Server using TCP as its transport.
+// NewTCPServer creates a transport.Server using TCP as its transport, the
+// connections using TLS when tlsCfg is not nil.
 func NewTCPServer(
 	addr string,
 	idleTimeout time.Duration,
+	tlsCfg *tls.Config,
 ) (Server, error) {
 	ln, err := net.Listen("tcp", addr)
 	if err != nil {
 		return nil, err
 	}
+	if tlsCfg != nil {
+		ln = tls.NewListener(ln, tlsCfg)
+	}
 
 	t := tcpServer{
 		ln:          ln,
@@ -183,7 +188,9 @@ func (t *tcpServer) handleConnection(
 			}
 		}
 
-		if errors.Is(err, io.EOF) {
+		// Besides io.EOF, the errors such as a failed TLS handshake are returned
+		// again by the next reads: the connection is done in all cases.
+		if err != nil {
 			t.reporter.OnDebugf(
 				"TCP Transport (%s) - error: %v",
 				t.ln.Addr(),
@@ -5,6 +5,7 @@ package carbonreceiver // import "github.com/open-telemetry/opentelemetry-collec
 
 import (
 	"context"
+	"crypto/tls"
 	"errors"
 	"fmt"
 	"net"
@@ -76,10 +77,17 @@ func newMetricsReceiver(
 	return &r, nil
 }
 
-func buildTransportServer(config Config) (transport.Server, error) {
+func buildTransportServer(ctx context.Context, config Config) (transport.Server, error) {
 	switch strings.ToLower(string(config.Transport)) {
 	case "", "tcp":
-		return transport.NewTCPServer(config.Endpoint, config.TCPIdleTimeout)
+		var tlsCfg *tls.Config
+		if config.TLSSetting != nil {
+			var err error
+			if tlsCfg, err = config.TLSSetting.LoadTLSConfig(ctx); err != nil {
+				return nil, fmt.Errorf("failed to load the TLS config: %w", err)
+			}
+		}
+		return transport.NewTCPServer(config.Endpoint, config.TCPIdleTimeout, tlsCfg)
 	case "udp":
 		return transport.NewUDPServer(config.Endpoint)
 	}
@@ -90,8 +98,8 @@ func buildTransportServer(config Config) (transport.Server, error) {
 // Start tells the receiver to start its processing.
 // By convention the consumer of the received data is set when the receiver
 // instance is created.
-func (r *carbonReceiver) Start(_ context.Context, _ component.Host) error {
-	server, err := buildTransportServer(*r.config)
+func (r *carbonReceiver) Start(ctx context.Context, _ component.Host) error {
+	server, err := buildTransportServer(ctx, *r.config)
 	if err != nil {
 		return err
 	}
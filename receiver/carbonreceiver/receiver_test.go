@@ -5,7 +5,9 @@ package carbonreceiver
 
 import (
 	"context"
+	"crypto/tls"
 	"errors"
+	"fmt"
 	"runtime"
 	"testing"
 	"time"
@@ -15,6 +17,7 @@ import (
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/config/confignet"
+	"go.opentelemetry.io/collector/config/configtls"
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/consumer/consumertest"
 	"go.opentelemetry.io/collector/receiver/receivertest"
@@ -236,3 +239,58 @@ func Test_carbonreceiver_EndToEnd(t *testing.T) {
 		})
 	}
 }
+
+func Test_carbonreceiver_TLS(t *testing.T) {
+	addr := testutil.GetAvailableLocalAddress(t)
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = addr
+	cfg.TLSSetting = &configtls.ServerConfig{
+		Config: configtls.Config{
+			CertFile: "./testdata/server.crt",
+			KeyFile:  "./testdata/server.key",
+		},
+	}
+	sink := new(consumertest.MetricsSink)
+	r, err := newMetricsReceiver(receivertest.NewNopCreateSettings(), *cfg, sink)
+	require.NoError(t, err)
+	require.NoError(t, r.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() {
+		require.NoError(t, r.Shutdown(context.Background()))
+	}()
+
+	tlscs := configtls.ClientConfig{
+		Config: configtls.Config{
+			CAFile: "./testdata/ca.crt",
+		},
+		ServerName: "localhost",
+	}
+	tlsCfg, err := tlscs.LoadTLSConfig(context.Background())
+	require.NoError(t, err)
+	conn, err := tls.Dial("tcp", addr, tlsCfg)
+	require.NoError(t, err)
+	_, err = fmt.Fprintf(conn, "tst_dbl 1.23 %d\n", time.Now().Unix())
+	require.NoError(t, err)
+	require.NoError(t, conn.Close())
+
+	require.Eventually(t, func() bool {
+		return sink.DataPointCount() == 1
+	}, 10*time.Second, 100*time.Millisecond)
+	m := sink.AllMetrics()[0].ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0)
+	assert.Equal(t, "tst_dbl", m.Name())
+	assert.Equal(t, 1.23, m.Gauge().DataPoints().At(0).DoubleValue())
+}
+
+func Test_carbonreceiver_TLSInvalidCertificate(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = testutil.GetAvailableLocalAddress(t)
+	cfg.TLSSetting = &configtls.ServerConfig{
+		Config: configtls.Config{
+			CertFile: "./testdata/missing.crt",
+			KeyFile:  "./testdata/missing.key",
+		},
+	}
+	r, err := newMetricsReceiver(receivertest.NewNopCreateSettings(), *cfg, consumertest.NewNop())
+	require.NoError(t, err)
+	require.ErrorContains(t, r.Start(context.Background(), componenttest.NewNopHost()), "failed to load the TLS config")
+	assert.NoError(t, r.Shutdown(context.Background()))
+}
@@ -26,6 +26,20 @@ type Config struct {
 	//
 	// The default value is unix:///var/run/chrony/chronyd.sock
 	Endpoint string `mapstructure:"endpoint"`
+	// Events configures the clock skew events emitted as logs
+	// when the measured offset or drift crosses its threshold.
+	Events EventsConfig `mapstructure:"events"`
+}
+
+type EventsConfig struct {
+	// OffsetThreshold is the absolute offset between the system clock and
+	// the NTP time above which a clock skew event is emitted.
+	// A value of zero disables the offset events.
+	OffsetThreshold time.Duration `mapstructure:"offset_threshold"`
+	// DriftThreshold is the absolute rate, in parts per million, at which the
+	// system clock would be wrong if chronyd was not correcting it above which
+	// a clock drift event is emitted. A value of zero disables the drift events.
+	DriftThreshold float64 `mapstructure:"drift_threshold"`
 }
 
 var (
@@ -42,6 +56,10 @@ func newDefaultCongfig() component.Config {
 		MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
 
 		Endpoint: "unix:///var/run/chrony/chronyd.sock",
+		Events: EventsConfig{
+			OffsetThreshold: 100 * time.Millisecond,
+			DriftThreshold:  100,
+		},
 	}
 }
 
@@ -49,6 +67,12 @@ func (c *Config) Validate() error {
 	if c.Timeout < 1 {
 		return fmt.Errorf("must have a positive timeout: %w", errInvalidValue)
 	}
+	if c.Events.OffsetThreshold < 0 {
+		return fmt.Errorf("must have a non negative offset threshold: %w", errInvalidValue)
+	}
+	if c.Events.DriftThreshold < 0 {
+		return fmt.Errorf("must have a non negative drift threshold: %w", errInvalidValue)
+	}
 	_, _, err := chrony.SplitNetworkEndpoint(c.Endpoint)
 	return err
 }
@@ -40,6 +40,10 @@ func TestLoadConfig(t *testing.T) {
 		ControllerConfig:     scs,
 		MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
 		Endpoint:             "udp://localhost:3030",
+		Events: EventsConfig{
+			OffsetThreshold: 50 * time.Millisecond,
+			DriftThreshold:  100,
+		},
 	}, cfg)
 }
 
@@ -123,6 +127,36 @@ func TestValidate(t *testing.T) {
 			},
 			err: errInvalidValue,
 		},
+		{
+			scenario: "Invalid offset threshold set",
+			conf: Config{
+				Endpoint: "udp://localhost:323",
+				ControllerConfig: scraperhelper.ControllerConfig{
+					CollectionInterval: time.Minute,
+					InitialDelay:       time.Second,
+					Timeout:            10 * time.Second,
+				},
+				Events: EventsConfig{
+					OffsetThreshold: -time.Second,
+				},
+			},
+			err: errInvalidValue,
+		},
+		{
+			scenario: "Invalid drift threshold set",
+			conf: Config{
+				Endpoint: "udp://localhost:323",
+				ControllerConfig: scraperhelper.ControllerConfig{
+					CollectionInterval: time.Minute,
+					InitialDelay:       time.Second,
+					Timeout:            10 * time.Second,
+				},
+				Events: EventsConfig{
+					DriftThreshold: -1,
+				},
+			},
+			err: errInvalidValue,
+		},
 	}
 
 	for _, tc := range tests {
@@ -21,6 +21,7 @@ func NewFactory() receiver.Factory {
 		metadata.Type,
 		newDefaultCongfig,
 		receiver.WithMetrics(newMetricsReceiver, metadata.MetricsStability),
+		receiver.WithLogs(newLogsReceiver, metadata.LogsStability),
 	)
 }
 
@@ -55,3 +56,16 @@ func newMetricsReceiver(
 		scraperhelper.AddScraper(scraper),
 	)
 }
+
+func newLogsReceiver(
+	_ context.Context,
+	set receiver.CreateSettings,
+	rCfg component.Config,
+	consumer consumer.Logs) (receiver.Logs, error) {
+	cfg, ok := rCfg.(*Config)
+	if !ok {
+		return nil, fmt.Errorf("wrong config provided: %w", errInvalidValue)
+	}
+
+	return newChronyLogsReceiver(cfg, set, consumer), nil
+}
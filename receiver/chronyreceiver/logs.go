@@ -0,0 +1,166 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package chronyreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/chronyreceiver"
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/tilinna/clock"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/receiver"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/chronyreceiver/internal/chrony"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/chronyreceiver/internal/metadata"
+)
+
+const (
+	// logsScopeName matches the scope name of the metrics emitted by the receiver
+	logsScopeName = "otelcol/chronyreceiver"
+
+	clockSkewEventName  = "ntp.clock_skew"
+	clockDriftEventName = "ntp.clock_drift"
+)
+
+// chronyLogsReceiver polls chronyd for its tracking data and emits an event
+// each time the offset or drift of the system clock crosses its threshold.
+type chronyLogsReceiver struct {
+	cfg      *Config
+	settings component.TelemetrySettings
+	consumer consumer.Logs
+	client   chrony.Client
+	cancel   context.CancelFunc
+	wg       *sync.WaitGroup
+
+	// offsetExceeded and driftExceeded record whether the thresholds
+	// were exceeded by the previous poll, so only changes are emitted.
+	offsetExceeded bool
+	driftExceeded  bool
+}
+
+func newChronyLogsReceiver(cfg *Config, set receiver.CreateSettings, consumer consumer.Logs) *chronyLogsReceiver {
+	return &chronyLogsReceiver{
+		cfg:      cfg,
+		settings: set.TelemetrySettings,
+		consumer: consumer,
+		wg:       &sync.WaitGroup{},
+	}
+}
+
+func (r *chronyLogsReceiver) Start(_ context.Context, _ component.Host) error {
+	if r.client == nil {
+		chronyc, err := chrony.New(r.cfg.Endpoint, r.cfg.Timeout)
+		if err != nil {
+			return err
+		}
+		r.client = chronyc
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	r.cancel = cancel
+
+	t := time.NewTicker(r.cfg.CollectionInterval)
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		defer t.Stop()
+		for {
+			select {
+			case <-t.C:
+				if err := r.poll(ctx); err != nil {
+					r.settings.Logger.Error("Failed to check the clock skew reported by chronyd", zap.Error(err))
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+func (r *chronyLogsReceiver) Shutdown(context.Context) error {
+	if r.cancel != nil {
+		r.cancel()
+	}
+	r.wg.Wait()
+	return nil
+}
+
+func (r *chronyLogsReceiver) poll(ctx context.Context) error {
+	data, err := r.client.GetTrackingData(ctx)
+	if err != nil {
+		return err
+	}
+
+	now := pcommon.NewTimestampFromTime(clock.Now(ctx))
+	logs := plog.NewLogs()
+
+	if threshold := r.cfg.Events.OffsetThreshold; threshold > 0 {
+		exceeded := math.Abs(data.CurrentCorrection) > threshold.Seconds()
+		if exceeded != r.offsetExceeded {
+			r.offsetExceeded = exceeded
+			body := fmt.Sprintf("system clock offset of %gs is back within the threshold of %s", data.CurrentCorrection, threshold)
+			if exceeded {
+				body = fmt.Sprintf("system clock offset of %gs exceeds the threshold of %s", data.CurrentCorrection, threshold)
+			}
+			lr := appendEvent(logs, now, clockSkewEventName, exceeded, body, data)
+			lr.Attributes().PutDouble("ntp.threshold.value", threshold.Seconds())
+		}
+	}
+
+	if threshold := r.cfg.Events.DriftThreshold; threshold > 0 {
+		exceeded := math.Abs(data.FreqPPM) > threshold
+		if exceeded != r.driftExceeded {
+			r.driftExceeded = exceeded
+			body := fmt.Sprintf("system clock drift of %gppm is back within the threshold of %gppm", data.FreqPPM, threshold)
+			if exceeded {
+				body = fmt.Sprintf("system clock drift of %gppm exceeds the threshold of %gppm", data.FreqPPM, threshold)
+			}
+			lr := appendEvent(logs, now, clockDriftEventName, exceeded, body, data)
+			lr.Attributes().PutDouble("ntp.threshold.value", threshold)
+		}
+	}
+
+	if logs.LogRecordCount() == 0 {
+		return nil
+	}
+	return r.consumer.ConsumeLogs(ctx, logs)
+}
+
+// appendEvent adds a clock skew event to logs, reported as a warning when
+// the threshold has been exceeded and as information once it has recovered.
+func appendEvent(logs plog.Logs, now pcommon.Timestamp, name string, exceeded bool, body string, data *chrony.Tracking) plog.LogRecord {
+	if logs.ResourceLogs().Len() == 0 {
+		logs.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().Scope().SetName(logsScopeName)
+	}
+	lr := logs.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().AppendEmpty()
+	lr.SetTimestamp(now)
+	lr.SetObservedTimestamp(now)
+	lr.Body().SetStr(body)
+
+	state := "recovered"
+	lr.SetSeverityNumber(plog.SeverityNumberInfo)
+	lr.SetSeverityText("INFO")
+	if exceeded {
+		state = "exceeded"
+		lr.SetSeverityNumber(plog.SeverityNumberWarn)
+		lr.SetSeverityText("WARN")
+	}
+
+	attrs := lr.Attributes()
+	attrs.PutStr("event.domain", "chrony")
+	attrs.PutStr("event.name", name)
+	attrs.PutStr("ntp.threshold.state", state)
+	attrs.PutDouble("ntp.time.correction", data.CurrentCorrection)
+	attrs.PutDouble("ntp.frequency.offset", data.FreqPPM)
+	attrs.PutStr("leap.status", metadata.AttributeLeapStatus(data.LeapStatus+1).String())
+	return lr
+}
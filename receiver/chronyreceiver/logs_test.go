@@ -0,0 +1,111 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package chronyreceiver
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tilinna/clock"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/chronyreceiver/internal/chrony"
+)
+
+func TestChronyLogsReceiverPoll(t *testing.T) {
+	t.Parallel()
+
+	ctx := clock.Context(context.Background(), clock.NewMock(time.Unix(100, 0)))
+	cfg := newDefaultCongfig().(*Config)
+	sink := &consumertest.LogsSink{}
+	r := newChronyLogsReceiver(cfg, receivertest.NewNopCreateSettings(), sink)
+
+	poll := func(tracking *chrony.Tracking) {
+		client := &mockClient{}
+		client.On("GetTrackingData").Return(tracking, nil)
+		r.client = client
+		require.NoError(t, r.poll(ctx))
+	}
+
+	// the clock is within the thresholds
+	poll(&chrony.Tracking{CurrentCorrection: 0.002, FreqPPM: 12.5})
+	assert.Equal(t, 0, sink.LogRecordCount())
+
+	// the offset crosses its threshold
+	poll(&chrony.Tracking{CurrentCorrection: -0.25, FreqPPM: 12.5})
+	require.Equal(t, 1, sink.LogRecordCount())
+	lr := sink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	assert.Equal(t, plog.SeverityNumberWarn, lr.SeverityNumber())
+	assert.Equal(t, "system clock offset of -0.25s exceeds the threshold of 100ms", lr.Body().Str())
+	eventName, ok := lr.Attributes().Get("event.name")
+	require.True(t, ok)
+	assert.Equal(t, clockSkewEventName, eventName.Str())
+	state, ok := lr.Attributes().Get("ntp.threshold.state")
+	require.True(t, ok)
+	assert.Equal(t, "exceeded", state.Str())
+	threshold, ok := lr.Attributes().Get("ntp.threshold.value")
+	require.True(t, ok)
+	assert.Equal(t, 0.1, threshold.Double())
+
+	// the offset is still above its threshold, nothing new is emitted
+	poll(&chrony.Tracking{CurrentCorrection: -0.3, FreqPPM: 12.5})
+	assert.Equal(t, 1, sink.LogRecordCount())
+
+	// the offset recovers while the drift crosses its threshold
+	poll(&chrony.Tracking{CurrentCorrection: 0.001, FreqPPM: 250})
+	require.Equal(t, 3, sink.LogRecordCount())
+	records := sink.AllLogs()[1].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+	assert.Equal(t, plog.SeverityNumberInfo, records.At(0).SeverityNumber())
+	assert.Equal(t, "system clock offset of 0.001s is back within the threshold of 100ms", records.At(0).Body().Str())
+	assert.Equal(t, plog.SeverityNumberWarn, records.At(1).SeverityNumber())
+	eventName, ok = records.At(1).Attributes().Get("event.name")
+	require.True(t, ok)
+	assert.Equal(t, clockDriftEventName, eventName.Str())
+}
+
+func TestChronyLogsReceiverDisabledThresholds(t *testing.T) {
+	t.Parallel()
+
+	cfg := newDefaultCongfig().(*Config)
+	cfg.Events = EventsConfig{}
+	sink := &consumertest.LogsSink{}
+	r := newChronyLogsReceiver(cfg, receivertest.NewNopCreateSettings(), sink)
+
+	client := &mockClient{}
+	client.On("GetTrackingData").Return(&chrony.Tracking{CurrentCorrection: 10, FreqPPM: 1000}, nil)
+	r.client = client
+
+	require.NoError(t, r.poll(context.Background()))
+	assert.Equal(t, 0, sink.LogRecordCount())
+}
+
+func TestChronyLogsReceiverError(t *testing.T) {
+	t.Parallel()
+
+	sink := &consumertest.LogsSink{}
+	r := newChronyLogsReceiver(newDefaultCongfig().(*Config), receivertest.NewNopCreateSettings(), sink)
+
+	client := &mockClient{}
+	client.On("GetTrackingData").Return(&chrony.Tracking{}, errors.New("failed to connect"))
+	r.client = client
+
+	assert.EqualError(t, r.poll(context.Background()), "failed to connect")
+	assert.Equal(t, 0, sink.LogRecordCount())
+}
+
+func TestChronyLogsReceiverLifecycle(t *testing.T) {
+	t.Parallel()
+
+	cfg := newDefaultCongfig().(*Config)
+	cfg.Endpoint = "udp://localhost:323"
+	r := newChronyLogsReceiver(cfg, receivertest.NewNopCreateSettings(), &consumertest.LogsSink{})
+	require.NoError(t, r.Start(context.Background(), nil))
+	require.NoError(t, r.Shutdown(context.Background()))
+}
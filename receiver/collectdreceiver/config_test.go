@@ -13,6 +13,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/config/configtls"
 	"go.opentelemetry.io/collector/confmap/confmaptest"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/collectdreceiver/internal/metadata"
@@ -42,6 +43,20 @@ func TestLoadConfig(t *testing.T) {
 			},
 			wantErr: errors.New("CollectD only support JSON encoding format. command is not supported"),
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "tls"),
+			expected: func() component.Config {
+				cfg := createDefaultConfig().(*Config)
+				cfg.Endpoint = "0.0.0.0:8081"
+				cfg.TLSSetting = &configtls.ServerConfig{
+					Config: configtls.Config{
+						CertFile: "/etc/ssl/collectd.crt",
+						KeyFile:  "/etc/ssl/collectd.key",
+					},
+				}
+				return cfg
+			}(),
+		},
 	}
 
 	for _, tt := range tests {
@@ -19,6 +19,7 @@ import (
 type client interface {
 	Get(path string) ([]byte, error)
 	GetStats(nodeName string) (map[string]any, error)
+	GetMembership() ([]string, error)
 }
 
 var _ client = (*couchDBClient)(nil)
@@ -93,6 +94,27 @@ func (c *couchDBClient) GetStats(nodeName string) (map[string]any, error) {
 	return stats, nil
 }
 
+// membership is the response of the /_membership endpoint.
+type membership struct {
+	AllNodes []string `json:"all_nodes"`
+}
+
+// GetMembership gets the names of the nodes the couchdb node is connected to, itself included.
+func (c *couchDBClient) GetMembership() ([]string, error) {
+	body, err := c.Get("/_membership")
+	if err != nil {
+		return nil, err
+	}
+
+	var m membership
+	err = json.Unmarshal(body, &m)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.AllNodes, nil
+}
+
 func (c *couchDBClient) buildReq(path string) (*http.Request, error) {
 	url := c.cfg.Endpoint + path
 	req, err := http.NewRequest(http.MethodGet, url, nil)
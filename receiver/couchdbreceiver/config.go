@@ -34,6 +34,8 @@ type Config struct {
 	metadata.MetricsBuilderConfig  `mapstructure:",squash"`
 	Username                       string              `mapstructure:"username"`
 	Password                       configopaque.String `mapstructure:"password"`
+	// CollectClusterNodes scrapes all the nodes of the cluster through the endpoint, instead of the endpoint's node only.
+	CollectClusterNodes bool `mapstructure:"collect_cluster_nodes"`
 }
 
 // Validate validates missing and invalid configuration fields.
@@ -48,20 +48,50 @@ func (c *couchdbScraper) scrape(context.Context) (pmetric.Metrics, error) {
 		return pmetric.NewMetrics(), errors.New("no client available")
 	}
 
-	localNode := "_local"
-	stats, err := c.client.GetStats(localNode)
+	errs := &scrapererror.ScrapeErrors{}
+
+	if !c.config.CollectClusterNodes {
+		// the resource of the local node is named after the endpoint
+		if err := c.scrapeNode("_local", c.config.Endpoint, errs); err != nil {
+			c.settings.Logger.Error("Failed to fetch couchdb stats",
+				zap.String("endpoint", c.config.Endpoint),
+				zap.Error(err),
+			)
+			return pmetric.NewMetrics(), err
+		}
+		return c.mb.Emit(), errs.Combine()
+	}
+
+	nodes, err := c.client.GetMembership()
 	if err != nil {
-		c.settings.Logger.Error("Failed to fetch couchdb stats",
+		c.settings.Logger.Error("Failed to fetch couchdb cluster membership",
 			zap.String("endpoint", c.config.Endpoint),
 			zap.Error(err),
 		)
 		return pmetric.NewMetrics(), err
 	}
+	for _, node := range nodes {
+		if err := c.scrapeNode(node, node, errs); err != nil {
+			c.settings.Logger.Error("Failed to fetch couchdb stats",
+				zap.String("endpoint", c.config.Endpoint),
+				zap.String("node", node),
+				zap.Error(err),
+			)
+			errs.AddPartial(1, fmt.Errorf("failed to fetch the stats of the node %s: %w", node, err))
+		}
+	}
+	return c.mb.Emit(), errs.Combine()
+}
+
+// scrapeNode records the metrics of a node and emits them for its resource.
+func (c *couchdbScraper) scrapeNode(node, nodeName string, errs *scrapererror.ScrapeErrors) error {
+	stats, err := c.client.GetStats(node)
+	if err != nil {
+		return err
+	}
 
 	now := pcommon.NewTimestampFromTime(time.Now())
 
-	errs := &scrapererror.ScrapeErrors{}
-
 	c.recordCouchdbAverageRequestTimeDataPoint(now, stats, errs)
 	c.recordCouchdbHttpdBulkRequestsDataPoint(now, stats, errs)
 	c.recordCouchdbHttpdRequestsDataPoint(now, stats, errs)
@@ -72,6 +102,7 @@ func (c *couchdbScraper) scrape(context.Context) (pmetric.Metrics, error) {
 	c.recordCouchdbDatabaseOperationsDataPoint(now, stats, errs)
 
 	rb := c.mb.NewResourceBuilder()
-	rb.SetCouchdbNodeName(c.config.Endpoint)
-	return c.mb.Emit(metadata.WithResource(rb.Emit())), errs.Combine()
+	rb.SetCouchdbNodeName(nodeName)
+	c.mb.EmitForResource(metadata.WithResource(rb.Emit()))
+	return nil
 }
@@ -117,6 +117,58 @@ func TestScrape(t *testing.T) {
 	})
 }
 
+func TestScrapeClusterNodes(t *testing.T) {
+	f := NewFactory()
+	cfg := f.CreateDefaultConfig().(*Config)
+	cfg.Username = "otelu"
+	cfg.Password = "otelp"
+	cfg.CollectClusterNodes = true
+
+	t.Run("scrape all the nodes", func(t *testing.T) {
+		mockClient := new(mockClient)
+		mockClient.On("GetMembership").Return([]string{"couchdb@node1", "couchdb@node2"}, nil)
+		mockClient.On("GetStats", "couchdb@node1").Return(getStats("response_3.12.json"))
+		mockClient.On("GetStats", "couchdb@node2").Return(getStats("response_3.12.json"))
+		scraper := newCouchdbScraper(receivertest.NewNopCreateSettings(), cfg)
+		scraper.client = mockClient
+
+		actualMetrics, err := scraper.scrape(context.Background())
+		require.NoError(t, err)
+
+		expectedFile := filepath.Join("testdata", "scraper", "expected_cluster.yaml")
+		expectedMetrics, err := golden.ReadMetrics(expectedFile)
+		require.NoError(t, err)
+
+		require.NoError(t, pmetrictest.CompareMetrics(expectedMetrics, actualMetrics, pmetrictest.IgnoreResourceMetricsOrder(),
+			pmetrictest.IgnoreMetricDataPointsOrder(), pmetrictest.IgnoreStartTimestamp(), pmetrictest.IgnoreTimestamp()))
+	})
+
+	t.Run("scrape error: a node fails", func(t *testing.T) {
+		mockClient := new(mockClient)
+		mockClient.On("GetMembership").Return([]string{"couchdb@node1", "couchdb@node2"}, nil)
+		mockClient.On("GetStats", "couchdb@node1").Return(getStats("response_3.12.json"))
+		mockClient.On("GetStats", "couchdb@node2").Return(getStats(""))
+		scraper := newCouchdbScraper(receivertest.NewNopCreateSettings(), cfg)
+		scraper.client = mockClient
+
+		actualMetrics, err := scraper.scrape(context.Background())
+		require.EqualError(t, err, "failed to fetch the stats of the node couchdb@node2: bad response")
+		require.Equal(t, 1, actualMetrics.ResourceMetrics().Len())
+		nodeName, _ := actualMetrics.ResourceMetrics().At(0).Resource().Attributes().Get("couchdb.node.name")
+		require.Equal(t, "couchdb@node1", nodeName.Str())
+	})
+
+	t.Run("scrape error: membership endpoint error", func(t *testing.T) {
+		mockClient := new(mockClient)
+		mockClient.On("GetMembership").Return(nil, errors.New("bad response"))
+		scraper := newCouchdbScraper(receivertest.NewNopCreateSettings(), cfg)
+		scraper.client = mockClient
+
+		_, err := scraper.scrape(context.Background())
+		require.EqualError(t, err, "bad response")
+	})
+}
+
 func TestStart(t *testing.T) {
 	t.Run("start success", func(t *testing.T) {
 		f := NewFactory()
@@ -245,3 +297,24 @@ func (_m *mockClient) GetStats(nodeName string) (map[string]any, error) {
 
 	return r0, r1
 }
+
+// GetMembership provides a mock function with given fields:
+func (_m *mockClient) GetMembership() ([]string, error) {
+	ret := _m.Called()
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -97,6 +97,7 @@ type MetricsConfig struct {
 	ContainerNetworkIoUsageTxPackets           MetricConfig `mapstructure:"container.network.io.usage.tx_packets"`
 	ContainerPidsCount                         MetricConfig `mapstructure:"container.pids.count"`
 	ContainerPidsLimit                         MetricConfig `mapstructure:"container.pids.limit"`
+	ContainerPidsUtilization                   MetricConfig `mapstructure:"container.pids.utilization"`
 	ContainerRestarts                          MetricConfig `mapstructure:"container.restarts"`
 	ContainerUptime                            MetricConfig `mapstructure:"container.uptime"`
 }
@@ -310,6 +311,9 @@ func DefaultMetricsConfig() MetricsConfig {
 		ContainerPidsLimit: MetricConfig{
 			Enabled: false,
 		},
+		ContainerPidsUtilization: MetricConfig{
+			Enabled: false,
+		},
 		ContainerRestarts: MetricConfig{
 			Enabled: false,
 		},
@@ -95,6 +95,7 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					ContainerNetworkIoUsageTxPackets:           MetricConfig{Enabled: true},
 					ContainerPidsCount:                         MetricConfig{Enabled: true},
 					ContainerPidsLimit:                         MetricConfig{Enabled: true},
+					ContainerPidsUtilization:                   MetricConfig{Enabled: true},
 					ContainerRestarts:                          MetricConfig{Enabled: true},
 					ContainerUptime:                            MetricConfig{Enabled: true},
 				},
@@ -182,6 +183,7 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					ContainerNetworkIoUsageTxPackets:           MetricConfig{Enabled: false},
 					ContainerPidsCount:                         MetricConfig{Enabled: false},
 					ContainerPidsLimit:                         MetricConfig{Enabled: false},
+					ContainerPidsUtilization:                   MetricConfig{Enabled: false},
 					ContainerRestarts:                          MetricConfig{Enabled: false},
 					ContainerUptime:                            MetricConfig{Enabled: false},
 				},
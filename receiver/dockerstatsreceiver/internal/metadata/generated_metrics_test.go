@@ -289,6 +289,9 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordContainerPidsLimitDataPoint(ts, 1)
 
+			allMetricsCount++
+			mb.RecordContainerPidsUtilizationDataPoint(ts, 1)
+
 			allMetricsCount++
 			mb.RecordContainerRestartsDataPoint(ts, 1)
 
@@ -1380,6 +1383,18 @@ func TestMetricsBuilder(t *testing.T) {
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
+				case "container.pids.utilization":
+					assert.False(t, validatedMetrics["container.pids.utilization"], "Found a duplicate in the metrics slice: container.pids.utilization")
+					validatedMetrics["container.pids.utilization"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Fraction of the container's pids limit in use.", ms.At(i).Description())
+					assert.Equal(t, "1", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
 				case "container.restarts":
 					assert.False(t, validatedMetrics["container.restarts"], "Found a duplicate in the metrics slice: container.restarts")
 					validatedMetrics["container.restarts"] = true
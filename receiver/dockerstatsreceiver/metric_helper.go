@@ -79,6 +79,14 @@ func calculateMemoryPercent(limit uint64, usedNoCache uint64) float64 {
 	return 0.0
 }
 
+// calculatePidsUtilization calculates the fraction of the pids limit used by the container.
+func calculatePidsUtilization(limit uint64, current uint64) float64 {
+	if limit != 0 {
+		return float64(current) / float64(limit)
+	}
+	return 0.0
+}
+
 // calculateCPULimit calculate the number of cpus assigned to a container.
 //
 // Calculation is based on 3 alternatives by the following order:
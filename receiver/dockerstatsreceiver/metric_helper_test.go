@@ -119,3 +119,21 @@ func Test_parseCPUSet(t *testing.T) {
 		}
 	}
 }
+
+func Test_calculatePidsUtilization(t *testing.T) {
+	tests := []struct {
+		name    string
+		limit   uint64
+		current uint64
+		want    float64
+	}{
+		{"no limit", 0, 10, 0},
+		{"half used", 200, 100, 0.5},
+		{"single pid", 4, 1, 0.25},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equalf(t, tt.want, calculatePidsUtilization(tt.limit, tt.current), "calculatePidsUtilization(%v, %v)", tt.limit, tt.current)
+		})
+	}
+}
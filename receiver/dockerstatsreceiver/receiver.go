@@ -282,6 +282,7 @@ func (r *metricsReceiver) recordPidsMetrics(now pcommon.Timestamp, pidsStats *dt
 		r.mb.RecordContainerPidsCountDataPoint(now, int64(pidsStats.Current))
 		if pidsStats.Limit != 0 {
 			r.mb.RecordContainerPidsLimitDataPoint(now, int64(pidsStats.Limit))
+			r.mb.RecordContainerPidsUtilizationDataPoint(now, calculatePidsUtilization(pidsStats.Limit, pidsStats.Current))
 		}
 	}
 }
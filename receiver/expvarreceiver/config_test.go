@@ -13,7 +13,9 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configauth"
 	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/config/configtls"
 	"go.opentelemetry.io/collector/confmap/confmaptest"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
 
@@ -52,6 +54,25 @@ func TestLoadConfig(t *testing.T) {
 				MetricsBuilderConfig: metricCfg,
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "auth"),
+			expected: &Config{
+				ControllerConfig: scraperhelper.NewDefaultControllerConfig(),
+				ClientConfig: confighttp.ClientConfig{
+					Endpoint: "https://localhost:8000/debug/vars",
+					Timeout:  defaultTimeout,
+					Auth:     &configauth.Authentication{AuthenticatorID: component.MustNewID("bearertokenauth")},
+					TLSSetting: configtls.ClientConfig{
+						Config: configtls.Config{
+							CAFile:   "/etc/otel/ca.pem",
+							CertFile: "/etc/otel/client.pem",
+							KeyFile:  "/etc/otel/client-key.pem",
+						},
+					},
+				},
+				MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+			},
+		},
 		{
 			id:           component.NewIDWithName(metadata.Type, "bad_schemeless_endpoint"),
 			errorMessage: "scheme must be 'http' or 'https', but was 'localhost'",
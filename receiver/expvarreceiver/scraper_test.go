@@ -12,7 +12,9 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config/configauth"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver/receivertest"
 
@@ -145,6 +147,15 @@ func TestNotFoundResponse(t *testing.T) {
 	require.EqualError(t, err, "expected 200 but received 404 status code")
 }
 
+func TestMissingAuthenticator(t *testing.T) {
+	cfg := newDefaultConfig().(*Config)
+	cfg.Auth = &configauth.Authentication{AuthenticatorID: component.MustNewID("bearertokenauth")}
+	scraper := newExpVarScraper(cfg, receivertest.NewNopCreateSettings())
+	err := scraper.start(context.Background(), componenttest.NewNopHost())
+	// the authenticator extension is not available on the host
+	require.Error(t, err)
+}
+
 func TestBadTypeInReturnedData(t *testing.T) {
 	ms := newMockServer(t, filepath.Join("testdata", "response", "bad_data_response.json"))
 	defer ms.Close()
@@ -52,6 +52,7 @@ type flinkClient struct {
 	hostEndpoint string
 	hostName     string
 	logger       *zap.Logger
+	jobFilter    *jobFilter
 }
 
 func newClient(ctx context.Context, cfg *Config, host component.Host, settings component.TelemetrySettings, logger *zap.Logger) (client, error) {
@@ -65,11 +66,17 @@ func newClient(ctx context.Context, cfg *Config, host component.Host, settings c
 		return nil, err
 	}
 
+	jobFilter, err := newJobFilter(cfg.Jobs)
+	if err != nil {
+		return nil, err
+	}
+
 	return &flinkClient{
 		client:       httpClient,
 		hostName:     hostName,
 		hostEndpoint: cfg.Endpoint,
 		logger:       logger,
+		jobFilter:    jobFilter,
 	}, nil
 }
 
@@ -228,8 +235,11 @@ func (c *flinkClient) GetJobsMetrics(ctx context.Context) ([]*models.JobMetrics,
 
 // getJobsMetricsByIDs gets jobs metrics for each job id.
 func (c *flinkClient) getJobsMetricsByIDs(ctx context.Context, jobIDs *models.JobOverviewResponse) ([]*models.JobMetrics, error) {
-	jobInstances := make([]*models.JobMetrics, len(jobIDs.Jobs))
-	for i, job := range jobIDs.Jobs {
+	jobInstances := make([]*models.JobMetrics, 0, len(jobIDs.Jobs))
+	for _, job := range jobIDs.Jobs {
+		if !c.jobFilter.matches(job.Name) {
+			continue
+		}
 		query := fmt.Sprintf(jobsMetricEndpoint, job.Jid)
 		metrics, err := c.getMetrics(ctx, query)
 		if err != nil {
@@ -240,7 +250,7 @@ func (c *flinkClient) getJobsMetricsByIDs(ctx context.Context, jobIDs *models.Jo
 			JobName: job.Name,
 			Metrics: *metrics,
 		}
-		jobInstances[i] = &jobInstance
+		jobInstances = append(jobInstances, &jobInstance)
 	}
 	return jobInstances, nil
 }
@@ -281,6 +291,9 @@ func (c *flinkClient) getSubtasksMetricsByIDs(ctx context.Context, jobsResponse
 		if err != nil {
 			return nil, fmt.Errorf("failed to unmarshal response body: %w", err)
 		}
+		if !c.jobFilter.matches(jobsWithIDResponse.Name) {
+			continue
+		}
 		// Gets subtask info for each vertex id
 		for _, vertex := range jobsWithIDResponse.Vertices {
 			var vertexResponse *models.VerticesResponse
@@ -382,6 +382,31 @@ func TestGetJobsMetrics(t *testing.T) {
 				require.EqualValues(t, hostname, actual[0].Host)
 			},
 		},
+		{
+			desc: "Successful call with excluded job",
+			testFunc: func(t *testing.T) {
+				jobsOverviewData := loadAPIResponseData(t, apiResponses, jobsOverview)
+				ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					if r.URL.Path == jobsOverviewEndpoint {
+						_, err := w.Write(jobsOverviewData)
+						require.NoError(t, err)
+						return
+					}
+					t.Errorf("unexpected request for the metrics of an excluded job: %s", r.URL.Path)
+				}))
+				defer ts.Close()
+
+				cfg := createDefaultConfig().(*Config)
+				cfg.Endpoint = ts.URL
+				cfg.Jobs.Exclude = []string{"^State machine"}
+				tc, err := newClient(context.Background(), cfg, componenttest.NewNopHost(), componenttest.NewNopTelemetrySettings(), zap.NewNop())
+				require.NoError(t, err)
+
+				actual, err := tc.GetJobsMetrics(context.Background())
+				require.NoError(t, err)
+				require.Empty(t, actual)
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -6,6 +6,7 @@ package flinkmetricsreceiver // import "github.com/open-telemetry/opentelemetry-
 import (
 	"fmt"
 	"net/url"
+	"regexp"
 
 	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
@@ -20,6 +21,17 @@ type Config struct {
 	scraperhelper.ControllerConfig `mapstructure:",squash"`
 	confighttp.ClientConfig        `mapstructure:",squash"`
 	metadata.MetricsBuilderConfig  `mapstructure:",squash"`
+
+	// Jobs filters the jobs for which job, task and operator metrics are collected.
+	Jobs JobsConfig `mapstructure:"jobs"`
+}
+
+// JobsConfig filters jobs by their name using regular expressions.
+// A job is collected if it matches any of the include expressions, or if none are configured,
+// and it doesn't match any of the exclude expressions.
+type JobsConfig struct {
+	Include []string `mapstructure:"include"`
+	Exclude []string `mapstructure:"exclude"`
 }
 
 // Validate validates the configuration by checking for missing or invalid fields
@@ -28,5 +40,57 @@ func (cfg *Config) Validate() error {
 		return fmt.Errorf("\"endpoint\" must be in the form of <scheme>://<hostname>:<port>: %w", err)
 	}
 
+	if _, err := newJobFilter(cfg.Jobs); err != nil {
+		return err
+	}
+
 	return nil
 }
+
+// jobFilter matches job names against the compiled expressions of a JobsConfig
+type jobFilter struct {
+	include []*regexp.Regexp
+	exclude []*regexp.Regexp
+}
+
+func newJobFilter(cfg JobsConfig) (*jobFilter, error) {
+	include, err := compileJobNameRegexps("include", cfg.Include)
+	if err != nil {
+		return nil, err
+	}
+	exclude, err := compileJobNameRegexps("exclude", cfg.Exclude)
+	if err != nil {
+		return nil, err
+	}
+	return &jobFilter{include: include, exclude: exclude}, nil
+}
+
+func compileJobNameRegexps(field string, exprs []string) ([]*regexp.Regexp, error) {
+	regexps := make([]*regexp.Regexp, 0, len(exprs))
+	for _, expr := range exprs {
+		re, err := regexp.Compile(expr)
+		if err != nil {
+			return nil, fmt.Errorf("\"jobs.%s\" contains an invalid regular expression %q: %w", field, expr, err)
+		}
+		regexps = append(regexps, re)
+	}
+	return regexps, nil
+}
+
+// matches returns whether metrics should be collected for the job with the given name
+func (f *jobFilter) matches(name string) bool {
+	for _, re := range f.exclude {
+		if re.MatchString(name) {
+			return false
+		}
+	}
+	if len(f.include) == 0 {
+		return true
+	}
+	for _, re := range f.include {
+		if re.MatchString(name) {
+			return true
+		}
+	}
+	return false
+}
@@ -35,6 +35,19 @@ func TestValidate(t *testing.T) {
 			},
 			expectedErr: fmt.Errorf("\"endpoint\" must be in the form of <scheme>://<hostname>:<port>: %w", errors.New(`parse "invalid://endpoint:  12efg": invalid port ":  12efg" after host`)),
 		},
+		{
+			desc: "invalid job name regexp",
+			cfg: &Config{
+				ClientConfig: confighttp.ClientConfig{
+					Endpoint: defaultEndpoint,
+				},
+				ControllerConfig: scraperhelper.NewDefaultControllerConfig(),
+				Jobs: JobsConfig{
+					Exclude: []string{"("},
+				},
+			},
+			expectedErr: errors.New(`"jobs.exclude" contains an invalid regular expression "(": error parsing regexp: missing closing ): ` + "`(`"),
+		},
 		{
 			desc: "valid config",
 			cfg: &Config{
@@ -74,6 +87,10 @@ func TestLoadConfig(t *testing.T) {
 	expected := factory.CreateDefaultConfig().(*Config)
 	expected.Endpoint = "http://localhost:8081"
 	expected.CollectionInterval = 10 * time.Second
+	expected.Jobs = JobsConfig{
+		Include: []string{"^orders-.*"},
+		Exclude: []string{"-test$"},
+	}
 
 	require.Equal(t, expected, cfg)
 }
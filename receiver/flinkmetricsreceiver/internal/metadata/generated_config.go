@@ -30,6 +30,7 @@ func (ms *MetricConfig) Unmarshal(parser *confmap.Conf) error {
 type MetricsConfig struct {
 	FlinkJobCheckpointCount           MetricConfig `mapstructure:"flink.job.checkpoint.count"`
 	FlinkJobCheckpointInProgress      MetricConfig `mapstructure:"flink.job.checkpoint.in_progress"`
+	FlinkJobLastCheckpointFullSize    MetricConfig `mapstructure:"flink.job.last_checkpoint.full_size"`
 	FlinkJobLastCheckpointSize        MetricConfig `mapstructure:"flink.job.last_checkpoint.size"`
 	FlinkJobLastCheckpointTime        MetricConfig `mapstructure:"flink.job.last_checkpoint.time"`
 	FlinkJobRestartCount              MetricConfig `mapstructure:"flink.job.restart.count"`
@@ -56,7 +57,12 @@ type MetricsConfig struct {
 	FlinkMemoryManagedUsed            MetricConfig `mapstructure:"flink.memory.managed.used"`
 	FlinkOperatorRecordCount          MetricConfig `mapstructure:"flink.operator.record.count"`
 	FlinkOperatorWatermarkOutput      MetricConfig `mapstructure:"flink.operator.watermark.output"`
+	FlinkTaskCheckpointAlignmentTime  MetricConfig `mapstructure:"flink.task.checkpoint.alignment.time"`
+	FlinkTaskCheckpointStartDelay     MetricConfig `mapstructure:"flink.task.checkpoint.start_delay"`
 	FlinkTaskRecordCount              MetricConfig `mapstructure:"flink.task.record.count"`
+	FlinkTaskTime                     MetricConfig `mapstructure:"flink.task.time"`
+	FlinkTaskWatermarkInput           MetricConfig `mapstructure:"flink.task.watermark.input"`
+	FlinkTaskWatermarkLag             MetricConfig `mapstructure:"flink.task.watermark.lag"`
 }
 
 func DefaultMetricsConfig() MetricsConfig {
@@ -67,6 +73,9 @@ func DefaultMetricsConfig() MetricsConfig {
 		FlinkJobCheckpointInProgress: MetricConfig{
 			Enabled: true,
 		},
+		FlinkJobLastCheckpointFullSize: MetricConfig{
+			Enabled: false,
+		},
 		FlinkJobLastCheckpointSize: MetricConfig{
 			Enabled: true,
 		},
@@ -145,9 +154,24 @@ func DefaultMetricsConfig() MetricsConfig {
 		FlinkOperatorWatermarkOutput: MetricConfig{
 			Enabled: true,
 		},
+		FlinkTaskCheckpointAlignmentTime: MetricConfig{
+			Enabled: false,
+		},
+		FlinkTaskCheckpointStartDelay: MetricConfig{
+			Enabled: false,
+		},
 		FlinkTaskRecordCount: MetricConfig{
 			Enabled: true,
 		},
+		FlinkTaskTime: MetricConfig{
+			Enabled: false,
+		},
+		FlinkTaskWatermarkInput: MetricConfig{
+			Enabled: false,
+		},
+		FlinkTaskWatermarkLag: MetricConfig{
+			Enabled: false,
+		},
 	}
 }
 
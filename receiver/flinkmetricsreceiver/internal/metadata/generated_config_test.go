@@ -28,6 +28,7 @@ func TestMetricsBuilderConfig(t *testing.T) {
 				Metrics: MetricsConfig{
 					FlinkJobCheckpointCount:           MetricConfig{Enabled: true},
 					FlinkJobCheckpointInProgress:      MetricConfig{Enabled: true},
+					FlinkJobLastCheckpointFullSize:    MetricConfig{Enabled: true},
 					FlinkJobLastCheckpointSize:        MetricConfig{Enabled: true},
 					FlinkJobLastCheckpointTime:        MetricConfig{Enabled: true},
 					FlinkJobRestartCount:              MetricConfig{Enabled: true},
@@ -54,7 +55,12 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					FlinkMemoryManagedUsed:            MetricConfig{Enabled: true},
 					FlinkOperatorRecordCount:          MetricConfig{Enabled: true},
 					FlinkOperatorWatermarkOutput:      MetricConfig{Enabled: true},
+					FlinkTaskCheckpointAlignmentTime:  MetricConfig{Enabled: true},
+					FlinkTaskCheckpointStartDelay:     MetricConfig{Enabled: true},
 					FlinkTaskRecordCount:              MetricConfig{Enabled: true},
+					FlinkTaskTime:                     MetricConfig{Enabled: true},
+					FlinkTaskWatermarkInput:           MetricConfig{Enabled: true},
+					FlinkTaskWatermarkLag:             MetricConfig{Enabled: true},
 				},
 				ResourceAttributes: ResourceAttributesConfig{
 					FlinkJobName:       ResourceAttributeConfig{Enabled: true},
@@ -72,6 +78,7 @@ func TestMetricsBuilderConfig(t *testing.T) {
 				Metrics: MetricsConfig{
 					FlinkJobCheckpointCount:           MetricConfig{Enabled: false},
 					FlinkJobCheckpointInProgress:      MetricConfig{Enabled: false},
+					FlinkJobLastCheckpointFullSize:    MetricConfig{Enabled: false},
 					FlinkJobLastCheckpointSize:        MetricConfig{Enabled: false},
 					FlinkJobLastCheckpointTime:        MetricConfig{Enabled: false},
 					FlinkJobRestartCount:              MetricConfig{Enabled: false},
@@ -98,7 +105,12 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					FlinkMemoryManagedUsed:            MetricConfig{Enabled: false},
 					FlinkOperatorRecordCount:          MetricConfig{Enabled: false},
 					FlinkOperatorWatermarkOutput:      MetricConfig{Enabled: false},
+					FlinkTaskCheckpointAlignmentTime:  MetricConfig{Enabled: false},
+					FlinkTaskCheckpointStartDelay:     MetricConfig{Enabled: false},
 					FlinkTaskRecordCount:              MetricConfig{Enabled: false},
+					FlinkTaskTime:                     MetricConfig{Enabled: false},
+					FlinkTaskWatermarkInput:           MetricConfig{Enabled: false},
+					FlinkTaskWatermarkLag:             MetricConfig{Enabled: false},
 				},
 				ResourceAttributes: ResourceAttributesConfig{
 					FlinkJobName:       ResourceAttributeConfig{Enabled: false},
@@ -104,6 +104,36 @@ var MapAttributeRecord = map[string]AttributeRecord{
 	"dropped": AttributeRecordDropped,
 }
 
+// AttributeTaskState specifies the a value task_state attribute.
+type AttributeTaskState int
+
+const (
+	_ AttributeTaskState = iota
+	AttributeTaskStateBackpressured
+	AttributeTaskStateBusy
+	AttributeTaskStateIdle
+)
+
+// String returns the string representation of the AttributeTaskState.
+func (av AttributeTaskState) String() string {
+	switch av {
+	case AttributeTaskStateBackpressured:
+		return "backpressured"
+	case AttributeTaskStateBusy:
+		return "busy"
+	case AttributeTaskStateIdle:
+		return "idle"
+	}
+	return ""
+}
+
+// MapAttributeTaskState is a helper map of string to AttributeTaskState attribute value.
+var MapAttributeTaskState = map[string]AttributeTaskState{
+	"backpressured": AttributeTaskStateBackpressured,
+	"busy":          AttributeTaskStateBusy,
+	"idle":          AttributeTaskStateIdle,
+}
+
 type metricFlinkJobCheckpointCount struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -208,6 +238,57 @@ func newMetricFlinkJobCheckpointInProgress(cfg MetricConfig) metricFlinkJobCheck
 	return m
 }
 
+type metricFlinkJobLastCheckpointFullSize struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills flink.job.last_checkpoint.full_size metric with initial data.
+func (m *metricFlinkJobLastCheckpointFullSize) init() {
+	m.data.SetName("flink.job.last_checkpoint.full_size")
+	m.data.SetDescription("The full size of the last checkpoint, including the state shared with previous checkpoints.")
+	m.data.SetUnit("By")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+}
+
+func (m *metricFlinkJobLastCheckpointFullSize) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricFlinkJobLastCheckpointFullSize) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricFlinkJobLastCheckpointFullSize) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricFlinkJobLastCheckpointFullSize(cfg MetricConfig) metricFlinkJobLastCheckpointFullSize {
+	m := metricFlinkJobLastCheckpointFullSize{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricFlinkJobLastCheckpointSize struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -1539,6 +1620,104 @@ func newMetricFlinkOperatorWatermarkOutput(cfg MetricConfig) metricFlinkOperator
 	return m
 }
 
+type metricFlinkTaskCheckpointAlignmentTime struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills flink.task.checkpoint.alignment.time metric with initial data.
+func (m *metricFlinkTaskCheckpointAlignmentTime) init() {
+	m.data.SetName("flink.task.checkpoint.alignment.time")
+	m.data.SetDescription("The time the last checkpoint barrier alignment of a task took to complete.")
+	m.data.SetUnit("ns")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricFlinkTaskCheckpointAlignmentTime) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricFlinkTaskCheckpointAlignmentTime) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricFlinkTaskCheckpointAlignmentTime) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricFlinkTaskCheckpointAlignmentTime(cfg MetricConfig) metricFlinkTaskCheckpointAlignmentTime {
+	m := metricFlinkTaskCheckpointAlignmentTime{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricFlinkTaskCheckpointStartDelay struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills flink.task.checkpoint.start_delay metric with initial data.
+func (m *metricFlinkTaskCheckpointStartDelay) init() {
+	m.data.SetName("flink.task.checkpoint.start_delay")
+	m.data.SetDescription("The time between the creation of the last checkpoint and the start of its checkpointing by a task.")
+	m.data.SetUnit("ns")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricFlinkTaskCheckpointStartDelay) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricFlinkTaskCheckpointStartDelay) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricFlinkTaskCheckpointStartDelay) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricFlinkTaskCheckpointStartDelay(cfg MetricConfig) metricFlinkTaskCheckpointStartDelay {
+	m := metricFlinkTaskCheckpointStartDelay{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricFlinkTaskRecordCount struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -1592,6 +1771,157 @@ func newMetricFlinkTaskRecordCount(cfg MetricConfig) metricFlinkTaskRecordCount
 	return m
 }
 
+type metricFlinkTaskTime struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills flink.task.time metric with initial data.
+func (m *metricFlinkTaskTime) init() {
+	m.data.SetName("flink.task.time")
+	m.data.SetDescription("The time per second a task spends backpressured, busy or idle.")
+	m.data.SetUnit("ms/s")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricFlinkTaskTime) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, taskStateAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("state", taskStateAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricFlinkTaskTime) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricFlinkTaskTime) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricFlinkTaskTime(cfg MetricConfig) metricFlinkTaskTime {
+	m := metricFlinkTaskTime{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricFlinkTaskWatermarkInput struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills flink.task.watermark.input metric with initial data.
+func (m *metricFlinkTaskWatermarkInput) init() {
+	m.data.SetName("flink.task.watermark.input")
+	m.data.SetDescription("The last watermark a task has received.")
+	m.data.SetUnit("ms")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+}
+
+func (m *metricFlinkTaskWatermarkInput) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricFlinkTaskWatermarkInput) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricFlinkTaskWatermarkInput) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricFlinkTaskWatermarkInput(cfg MetricConfig) metricFlinkTaskWatermarkInput {
+	m := metricFlinkTaskWatermarkInput{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricFlinkTaskWatermarkLag struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills flink.task.watermark.lag metric with initial data.
+func (m *metricFlinkTaskWatermarkLag) init() {
+	m.data.SetName("flink.task.watermark.lag")
+	m.data.SetDescription("The difference between the time of collection and the last watermark a task has received.")
+	m.data.SetUnit("ms")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricFlinkTaskWatermarkLag) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricFlinkTaskWatermarkLag) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricFlinkTaskWatermarkLag) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricFlinkTaskWatermarkLag(cfg MetricConfig) metricFlinkTaskWatermarkLag {
+	m := metricFlinkTaskWatermarkLag{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 // MetricsBuilder provides an interface for scrapers to report metrics while taking care of all the transformations
 // required to produce metric representation defined in metadata and user config.
 type MetricsBuilder struct {
@@ -1604,6 +1934,7 @@ type MetricsBuilder struct {
 	resourceAttributeExcludeFilter          map[string]filter.Filter
 	metricFlinkJobCheckpointCount           metricFlinkJobCheckpointCount
 	metricFlinkJobCheckpointInProgress      metricFlinkJobCheckpointInProgress
+	metricFlinkJobLastCheckpointFullSize    metricFlinkJobLastCheckpointFullSize
 	metricFlinkJobLastCheckpointSize        metricFlinkJobLastCheckpointSize
 	metricFlinkJobLastCheckpointTime        metricFlinkJobLastCheckpointTime
 	metricFlinkJobRestartCount              metricFlinkJobRestartCount
@@ -1630,7 +1961,12 @@ type MetricsBuilder struct {
 	metricFlinkMemoryManagedUsed            metricFlinkMemoryManagedUsed
 	metricFlinkOperatorRecordCount          metricFlinkOperatorRecordCount
 	metricFlinkOperatorWatermarkOutput      metricFlinkOperatorWatermarkOutput
+	metricFlinkTaskCheckpointAlignmentTime  metricFlinkTaskCheckpointAlignmentTime
+	metricFlinkTaskCheckpointStartDelay     metricFlinkTaskCheckpointStartDelay
 	metricFlinkTaskRecordCount              metricFlinkTaskRecordCount
+	metricFlinkTaskTime                     metricFlinkTaskTime
+	metricFlinkTaskWatermarkInput           metricFlinkTaskWatermarkInput
+	metricFlinkTaskWatermarkLag             metricFlinkTaskWatermarkLag
 }
 
 // metricBuilderOption applies changes to default metrics builder.
@@ -1651,6 +1987,7 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.CreateSetting
 		buildInfo:                               settings.BuildInfo,
 		metricFlinkJobCheckpointCount:           newMetricFlinkJobCheckpointCount(mbc.Metrics.FlinkJobCheckpointCount),
 		metricFlinkJobCheckpointInProgress:      newMetricFlinkJobCheckpointInProgress(mbc.Metrics.FlinkJobCheckpointInProgress),
+		metricFlinkJobLastCheckpointFullSize:    newMetricFlinkJobLastCheckpointFullSize(mbc.Metrics.FlinkJobLastCheckpointFullSize),
 		metricFlinkJobLastCheckpointSize:        newMetricFlinkJobLastCheckpointSize(mbc.Metrics.FlinkJobLastCheckpointSize),
 		metricFlinkJobLastCheckpointTime:        newMetricFlinkJobLastCheckpointTime(mbc.Metrics.FlinkJobLastCheckpointTime),
 		metricFlinkJobRestartCount:              newMetricFlinkJobRestartCount(mbc.Metrics.FlinkJobRestartCount),
@@ -1677,7 +2014,12 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.CreateSetting
 		metricFlinkMemoryManagedUsed:            newMetricFlinkMemoryManagedUsed(mbc.Metrics.FlinkMemoryManagedUsed),
 		metricFlinkOperatorRecordCount:          newMetricFlinkOperatorRecordCount(mbc.Metrics.FlinkOperatorRecordCount),
 		metricFlinkOperatorWatermarkOutput:      newMetricFlinkOperatorWatermarkOutput(mbc.Metrics.FlinkOperatorWatermarkOutput),
+		metricFlinkTaskCheckpointAlignmentTime:  newMetricFlinkTaskCheckpointAlignmentTime(mbc.Metrics.FlinkTaskCheckpointAlignmentTime),
+		metricFlinkTaskCheckpointStartDelay:     newMetricFlinkTaskCheckpointStartDelay(mbc.Metrics.FlinkTaskCheckpointStartDelay),
 		metricFlinkTaskRecordCount:              newMetricFlinkTaskRecordCount(mbc.Metrics.FlinkTaskRecordCount),
+		metricFlinkTaskTime:                     newMetricFlinkTaskTime(mbc.Metrics.FlinkTaskTime),
+		metricFlinkTaskWatermarkInput:           newMetricFlinkTaskWatermarkInput(mbc.Metrics.FlinkTaskWatermarkInput),
+		metricFlinkTaskWatermarkLag:             newMetricFlinkTaskWatermarkLag(mbc.Metrics.FlinkTaskWatermarkLag),
 		resourceAttributeIncludeFilter:          make(map[string]filter.Filter),
 		resourceAttributeExcludeFilter:          make(map[string]filter.Filter),
 	}
@@ -1780,6 +2122,7 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	ils.Metrics().EnsureCapacity(mb.metricsCapacity)
 	mb.metricFlinkJobCheckpointCount.emit(ils.Metrics())
 	mb.metricFlinkJobCheckpointInProgress.emit(ils.Metrics())
+	mb.metricFlinkJobLastCheckpointFullSize.emit(ils.Metrics())
 	mb.metricFlinkJobLastCheckpointSize.emit(ils.Metrics())
 	mb.metricFlinkJobLastCheckpointTime.emit(ils.Metrics())
 	mb.metricFlinkJobRestartCount.emit(ils.Metrics())
@@ -1806,7 +2149,12 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	mb.metricFlinkMemoryManagedUsed.emit(ils.Metrics())
 	mb.metricFlinkOperatorRecordCount.emit(ils.Metrics())
 	mb.metricFlinkOperatorWatermarkOutput.emit(ils.Metrics())
+	mb.metricFlinkTaskCheckpointAlignmentTime.emit(ils.Metrics())
+	mb.metricFlinkTaskCheckpointStartDelay.emit(ils.Metrics())
 	mb.metricFlinkTaskRecordCount.emit(ils.Metrics())
+	mb.metricFlinkTaskTime.emit(ils.Metrics())
+	mb.metricFlinkTaskWatermarkInput.emit(ils.Metrics())
+	mb.metricFlinkTaskWatermarkLag.emit(ils.Metrics())
 
 	for _, op := range rmo {
 		op(rm)
@@ -1858,6 +2206,16 @@ func (mb *MetricsBuilder) RecordFlinkJobCheckpointInProgressDataPoint(ts pcommon
 	return nil
 }
 
+// RecordFlinkJobLastCheckpointFullSizeDataPoint adds a data point to flink.job.last_checkpoint.full_size metric.
+func (mb *MetricsBuilder) RecordFlinkJobLastCheckpointFullSizeDataPoint(ts pcommon.Timestamp, inputVal string) error {
+	val, err := strconv.ParseInt(inputVal, 10, 64)
+	if err != nil {
+		return fmt.Errorf("failed to parse int64 for FlinkJobLastCheckpointFullSize, value was %s: %w", inputVal, err)
+	}
+	mb.metricFlinkJobLastCheckpointFullSize.recordDataPoint(mb.startTime, ts, val)
+	return nil
+}
+
 // RecordFlinkJobLastCheckpointSizeDataPoint adds a data point to flink.job.last_checkpoint.size metric.
 func (mb *MetricsBuilder) RecordFlinkJobLastCheckpointSizeDataPoint(ts pcommon.Timestamp, inputVal string) error {
 	val, err := strconv.ParseInt(inputVal, 10, 64)
@@ -2118,6 +2476,26 @@ func (mb *MetricsBuilder) RecordFlinkOperatorWatermarkOutputDataPoint(ts pcommon
 	return nil
 }
 
+// RecordFlinkTaskCheckpointAlignmentTimeDataPoint adds a data point to flink.task.checkpoint.alignment.time metric.
+func (mb *MetricsBuilder) RecordFlinkTaskCheckpointAlignmentTimeDataPoint(ts pcommon.Timestamp, inputVal string) error {
+	val, err := strconv.ParseInt(inputVal, 10, 64)
+	if err != nil {
+		return fmt.Errorf("failed to parse int64 for FlinkTaskCheckpointAlignmentTime, value was %s: %w", inputVal, err)
+	}
+	mb.metricFlinkTaskCheckpointAlignmentTime.recordDataPoint(mb.startTime, ts, val)
+	return nil
+}
+
+// RecordFlinkTaskCheckpointStartDelayDataPoint adds a data point to flink.task.checkpoint.start_delay metric.
+func (mb *MetricsBuilder) RecordFlinkTaskCheckpointStartDelayDataPoint(ts pcommon.Timestamp, inputVal string) error {
+	val, err := strconv.ParseInt(inputVal, 10, 64)
+	if err != nil {
+		return fmt.Errorf("failed to parse int64 for FlinkTaskCheckpointStartDelay, value was %s: %w", inputVal, err)
+	}
+	mb.metricFlinkTaskCheckpointStartDelay.recordDataPoint(mb.startTime, ts, val)
+	return nil
+}
+
 // RecordFlinkTaskRecordCountDataPoint adds a data point to flink.task.record.count metric.
 func (mb *MetricsBuilder) RecordFlinkTaskRecordCountDataPoint(ts pcommon.Timestamp, inputVal string, recordAttributeValue AttributeRecord) error {
 	val, err := strconv.ParseInt(inputVal, 10, 64)
@@ -2128,6 +2506,31 @@ func (mb *MetricsBuilder) RecordFlinkTaskRecordCountDataPoint(ts pcommon.Timesta
 	return nil
 }
 
+// RecordFlinkTaskTimeDataPoint adds a data point to flink.task.time metric.
+func (mb *MetricsBuilder) RecordFlinkTaskTimeDataPoint(ts pcommon.Timestamp, inputVal string, taskStateAttributeValue AttributeTaskState) error {
+	val, err := strconv.ParseFloat(inputVal, 64)
+	if err != nil {
+		return fmt.Errorf("failed to parse float64 for FlinkTaskTime, value was %s: %w", inputVal, err)
+	}
+	mb.metricFlinkTaskTime.recordDataPoint(mb.startTime, ts, val, taskStateAttributeValue.String())
+	return nil
+}
+
+// RecordFlinkTaskWatermarkInputDataPoint adds a data point to flink.task.watermark.input metric.
+func (mb *MetricsBuilder) RecordFlinkTaskWatermarkInputDataPoint(ts pcommon.Timestamp, inputVal string) error {
+	val, err := strconv.ParseInt(inputVal, 10, 64)
+	if err != nil {
+		return fmt.Errorf("failed to parse int64 for FlinkTaskWatermarkInput, value was %s: %w", inputVal, err)
+	}
+	mb.metricFlinkTaskWatermarkInput.recordDataPoint(mb.startTime, ts, val)
+	return nil
+}
+
+// RecordFlinkTaskWatermarkLagDataPoint adds a data point to flink.task.watermark.lag metric.
+func (mb *MetricsBuilder) RecordFlinkTaskWatermarkLagDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricFlinkTaskWatermarkLag.recordDataPoint(mb.startTime, ts, val)
+}
+
 // Reset resets metrics builder to its initial state. It should be used when external metrics source is restarted,
 // and metrics builder should update its startTime and reset it's internal state accordingly.
 func (mb *MetricsBuilder) Reset(options ...metricBuilderOption) {
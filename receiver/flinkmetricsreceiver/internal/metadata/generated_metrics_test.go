@@ -76,6 +76,9 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordFlinkJobCheckpointInProgressDataPoint(ts, "1")
 
+			allMetricsCount++
+			mb.RecordFlinkJobLastCheckpointFullSizeDataPoint(ts, "1")
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordFlinkJobLastCheckpointSizeDataPoint(ts, "1")
@@ -180,10 +183,25 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordFlinkOperatorWatermarkOutputDataPoint(ts, "1", "operator_name-val")
 
+			allMetricsCount++
+			mb.RecordFlinkTaskCheckpointAlignmentTimeDataPoint(ts, "1")
+
+			allMetricsCount++
+			mb.RecordFlinkTaskCheckpointStartDelayDataPoint(ts, "1")
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordFlinkTaskRecordCountDataPoint(ts, "1", AttributeRecordIn)
 
+			allMetricsCount++
+			mb.RecordFlinkTaskTimeDataPoint(ts, "1", AttributeTaskStateBackpressured)
+
+			allMetricsCount++
+			mb.RecordFlinkTaskWatermarkInputDataPoint(ts, "1")
+
+			allMetricsCount++
+			mb.RecordFlinkTaskWatermarkLagDataPoint(ts, 1)
+
 			rb := mb.NewResourceBuilder()
 			rb.SetFlinkJobName("flink.job.name-val")
 			rb.SetFlinkResourceTypeJobmanager()
@@ -244,6 +262,20 @@ func TestMetricsBuilder(t *testing.T) {
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
+				case "flink.job.last_checkpoint.full_size":
+					assert.False(t, validatedMetrics["flink.job.last_checkpoint.full_size"], "Found a duplicate in the metrics slice: flink.job.last_checkpoint.full_size")
+					validatedMetrics["flink.job.last_checkpoint.full_size"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "The full size of the last checkpoint, including the state shared with previous checkpoints.", ms.At(i).Description())
+					assert.Equal(t, "By", ms.At(i).Unit())
+					assert.Equal(t, false, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
 				case "flink.job.last_checkpoint.size":
 					assert.False(t, validatedMetrics["flink.job.last_checkpoint.size"], "Found a duplicate in the metrics slice: flink.job.last_checkpoint.size")
 					validatedMetrics["flink.job.last_checkpoint.size"] = true
@@ -619,6 +651,30 @@ func TestMetricsBuilder(t *testing.T) {
 					attrVal, ok := dp.Attributes().Get("name")
 					assert.True(t, ok)
 					assert.EqualValues(t, "operator_name-val", attrVal.Str())
+				case "flink.task.checkpoint.alignment.time":
+					assert.False(t, validatedMetrics["flink.task.checkpoint.alignment.time"], "Found a duplicate in the metrics slice: flink.task.checkpoint.alignment.time")
+					validatedMetrics["flink.task.checkpoint.alignment.time"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The time the last checkpoint barrier alignment of a task took to complete.", ms.At(i).Description())
+					assert.Equal(t, "ns", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "flink.task.checkpoint.start_delay":
+					assert.False(t, validatedMetrics["flink.task.checkpoint.start_delay"], "Found a duplicate in the metrics slice: flink.task.checkpoint.start_delay")
+					validatedMetrics["flink.task.checkpoint.start_delay"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The time between the creation of the last checkpoint and the start of its checkpointing by a task.", ms.At(i).Description())
+					assert.Equal(t, "ns", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
 				case "flink.task.record.count":
 					assert.False(t, validatedMetrics["flink.task.record.count"], "Found a duplicate in the metrics slice: flink.task.record.count")
 					validatedMetrics["flink.task.record.count"] = true
@@ -636,6 +692,47 @@ func TestMetricsBuilder(t *testing.T) {
 					attrVal, ok := dp.Attributes().Get("record")
 					assert.True(t, ok)
 					assert.EqualValues(t, "in", attrVal.Str())
+				case "flink.task.time":
+					assert.False(t, validatedMetrics["flink.task.time"], "Found a duplicate in the metrics slice: flink.task.time")
+					validatedMetrics["flink.task.time"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The time per second a task spends backpressured, busy or idle.", ms.At(i).Description())
+					assert.Equal(t, "ms/s", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
+					attrVal, ok := dp.Attributes().Get("state")
+					assert.True(t, ok)
+					assert.EqualValues(t, "backpressured", attrVal.Str())
+				case "flink.task.watermark.input":
+					assert.False(t, validatedMetrics["flink.task.watermark.input"], "Found a duplicate in the metrics slice: flink.task.watermark.input")
+					validatedMetrics["flink.task.watermark.input"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "The last watermark a task has received.", ms.At(i).Description())
+					assert.Equal(t, "ms", ms.At(i).Unit())
+					assert.Equal(t, false, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "flink.task.watermark.lag":
+					assert.False(t, validatedMetrics["flink.task.watermark.lag"], "Found a duplicate in the metrics slice: flink.task.watermark.lag")
+					validatedMetrics["flink.task.watermark.lag"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The difference between the time of collection and the last watermark a task has received.", ms.At(i).Description())
+					assert.Equal(t, "ms", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
 				}
 			}
 		})
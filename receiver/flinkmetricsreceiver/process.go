@@ -4,6 +4,7 @@
 package flinkmetricsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/flinkmetricsreceiver"
 
 import (
+	"strconv"
 	"strings"
 
 	"go.opentelemetry.io/collector/pdata/pcommon"
@@ -140,6 +141,8 @@ func (s *flinkmetricsScraper) processJobsMetrics(now pcommon.Timestamp, jobsMetr
 				_ = s.mb.RecordFlinkJobRestartCountDataPoint(now, metric.Value)
 			case "lastCheckpointSize":
 				_ = s.mb.RecordFlinkJobLastCheckpointSizeDataPoint(now, metric.Value)
+			case "lastCheckpointFullSize":
+				_ = s.mb.RecordFlinkJobLastCheckpointFullSizeDataPoint(now, metric.Value)
 			case "lastCheckpointDuration":
 				_ = s.mb.RecordFlinkJobLastCheckpointTimeDataPoint(now, metric.Value)
 			case "numberOfInProgressCheckpoints":
@@ -168,6 +171,19 @@ func (s *flinkmetricsScraper) processSubtaskMetrics(now pcommon.Timestamp, subta
 				_ = s.mb.RecordFlinkTaskRecordCountDataPoint(now, metric.Value, metadata.AttributeRecordOut)
 			case metric.ID == "numLateRecordsDropped":
 				_ = s.mb.RecordFlinkTaskRecordCountDataPoint(now, metric.Value, metadata.AttributeRecordDropped)
+			case metric.ID == "backPressuredTimeMsPerSecond":
+				_ = s.mb.RecordFlinkTaskTimeDataPoint(now, metric.Value, metadata.AttributeTaskStateBackpressured)
+			case metric.ID == "busyTimeMsPerSecond":
+				_ = s.mb.RecordFlinkTaskTimeDataPoint(now, metric.Value, metadata.AttributeTaskStateBusy)
+			case metric.ID == "idleTimeMsPerSecond":
+				_ = s.mb.RecordFlinkTaskTimeDataPoint(now, metric.Value, metadata.AttributeTaskStateIdle)
+			case metric.ID == "checkpointAlignmentTime":
+				_ = s.mb.RecordFlinkTaskCheckpointAlignmentTimeDataPoint(now, metric.Value)
+			case metric.ID == "checkpointStartDelayNanos":
+				_ = s.mb.RecordFlinkTaskCheckpointStartDelayDataPoint(now, metric.Value)
+			case metric.ID == "currentInputWatermark":
+				_ = s.mb.RecordFlinkTaskWatermarkInputDataPoint(now, metric.Value)
+				s.recordWatermarkLag(now, metric.Value)
 				// record operator metrics
 			case strings.Contains(metric.ID, ".numRecordsIn"):
 				operatorName := strings.Split(metric.ID, ".numRecordsIn")
@@ -192,3 +208,13 @@ func (s *flinkmetricsScraper) processSubtaskMetrics(now pcommon.Timestamp, subta
 		s.mb.EmitForResource(metadata.WithResource(rb.Emit()))
 	}
 }
+
+// recordWatermarkLag records how far the input watermark of a task is behind the time of collection.
+// Tasks which have not received a watermark yet report the minimum value of a long and are skipped.
+func (s *flinkmetricsScraper) recordWatermarkLag(now pcommon.Timestamp, value string) {
+	watermark, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || watermark <= 0 {
+		return
+	}
+	s.mb.RecordFlinkTaskWatermarkLagDataPoint(now, now.AsTime().UnixMilli()-watermark)
+}
@@ -27,7 +27,8 @@ func NewFactory() receiver.Factory {
 	return receiver.NewFactory(
 		metadata.Type,
 		createDefaultConfig,
-		receiver.WithMetrics(createMetricsReceiver, metadata.MetricsStability))
+		receiver.WithMetrics(createMetricsReceiver, metadata.MetricsStability),
+		receiver.WithLogs(createLogsReceiver, metadata.LogsStability))
 }
 
 func createDefaultConfig() component.Config {
@@ -59,3 +60,13 @@ func createMetricsReceiver(
 	return scraperhelper.NewScraperControllerReceiver(&rCfg.ControllerConfig, settings, consumer,
 		scraperhelper.AddScraper(scraper))
 }
+
+func createLogsReceiver(
+	_ context.Context,
+	settings receiver.CreateSettings,
+	baseCfg component.Config,
+	consumer consumer.Logs,
+) (receiver.Logs, error) {
+	rCfg := baseCfg.(*Config)
+	return newGoogleCloudSpannerLogsReceiver(settings.Logger, rCfg, consumer), nil
+}
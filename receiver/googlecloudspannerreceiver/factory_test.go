@@ -40,3 +40,19 @@ func TestCreateMetricsReceiver(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, receiver, "failed to create metrics receiver")
 }
+
+func TestCreateLogsReceiver(t *testing.T) {
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+	receiverConfig := cfg.(*Config)
+
+	receiver, err := factory.CreateLogsReceiver(
+		context.Background(),
+		receivertest.NewNopCreateSettings(),
+		receiverConfig,
+		consumertest.NewNop(),
+	)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, receiver, "failed to create logs receiver")
+}
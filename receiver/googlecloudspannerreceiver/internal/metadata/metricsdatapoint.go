@@ -138,6 +138,11 @@ func parseAndHashRowrangestartkey(key string) string {
 	return builderHashedKey.String()
 }
 
+// HashRowrangestartkey masks the key values of a row_range_start_key, as HideLockStatsRowrangestartkeyPII does.
+func HashRowrangestartkey(key string) string {
+	return parseAndHashRowrangestartkey(key)
+}
+
 func (mdp *MetricsDataPoint) HideLockStatsRowrangestartkeyPII() {
 	for index, labelValue := range mdp.labelValues {
 		if labelValue.Metadata().Name() == "row_range_start_key" {
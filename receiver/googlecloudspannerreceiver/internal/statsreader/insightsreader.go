@@ -0,0 +1,254 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package statsreader // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/googlecloudspannerreceiver/internal/statsreader"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+	"google.golang.org/api/iterator"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/googlecloudspannerreceiver/internal/datasource"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/googlecloudspannerreceiver/internal/metadata"
+)
+
+const (
+	queryStatsEventName = "spanner.query_stats"
+	lockStatsEventName  = "spanner.lock_stats"
+
+	topQueryStatsQuery = "SELECT INTERVAL_END, TEXT, REQUEST_TAG, TEXT_TRUNCATED, TEXT_FINGERPRINT, EXECUTION_COUNT, AVG_LATENCY_SECONDS, AVG_ROWS, AVG_BYTES, AVG_ROWS_SCANNED, AVG_CPU_SECONDS, ALL_FAILED_EXECUTION_COUNT, CANCELLED_OR_DISCONNECTED_EXECUTION_COUNT, TIMED_OUT_EXECUTION_COUNT FROM SPANNER_SYS.QUERY_STATS_TOP_MINUTE WHERE INTERVAL_END = @pullTimestamp ORDER BY EXECUTION_COUNT * AVG_CPU_SECONDS DESC"
+	topLockStatsQuery  = "SELECT INTERVAL_END, ROW_RANGE_START_KEY, LOCK_WAIT_SECONDS, SAMPLE_LOCK_REQUESTS FROM SPANNER_SYS.LOCK_STATS_TOP_MINUTE WHERE INTERVAL_END = @pullTimestamp ORDER BY LOCK_WAIT_SECONDS DESC"
+)
+
+type queryStatsRow struct {
+	IntervalEnd                           time.Time          `spanner:"INTERVAL_END"`
+	Text                                  string             `spanner:"TEXT"`
+	RequestTag                            spanner.NullString `spanner:"REQUEST_TAG"`
+	TextTruncated                         bool               `spanner:"TEXT_TRUNCATED"`
+	TextFingerprint                       int64              `spanner:"TEXT_FINGERPRINT"`
+	ExecutionCount                        int64              `spanner:"EXECUTION_COUNT"`
+	AvgLatencySeconds                     float64            `spanner:"AVG_LATENCY_SECONDS"`
+	AvgRows                               float64            `spanner:"AVG_ROWS"`
+	AvgBytes                              float64            `spanner:"AVG_BYTES"`
+	AvgRowsScanned                        float64            `spanner:"AVG_ROWS_SCANNED"`
+	AvgCPUSeconds                         float64            `spanner:"AVG_CPU_SECONDS"`
+	AllFailedExecutionCount               int64              `spanner:"ALL_FAILED_EXECUTION_COUNT"`
+	CancelledOrDisconnectedExecutionCount int64              `spanner:"CANCELLED_OR_DISCONNECTED_EXECUTION_COUNT"`
+	TimedOutExecutionCount                int64              `spanner:"TIMED_OUT_EXECUTION_COUNT"`
+}
+
+type lockStatsRow struct {
+	IntervalEnd        time.Time      `spanner:"INTERVAL_END"`
+	RowRangeStartKey   []byte         `spanner:"ROW_RANGE_START_KEY"`
+	LockWaitSeconds    float64        `spanner:"LOCK_WAIT_SECONDS"`
+	SampleLockRequests []*lockRequest `spanner:"SAMPLE_LOCK_REQUESTS"`
+}
+
+type lockRequest struct {
+	LockMode       string             `spanner:"lock_mode"`
+	Column         string             `spanner:"column"`
+	TransactionTag spanner.NullString `spanner:"transaction_tag"`
+}
+
+// InsightsReader reads the top query and lock statistics of a database, emitting a log record per row so the
+// statements and the row ranges behind the contention keep their details, instead of the metrics of
+// DatabaseReader.
+type InsightsReader struct {
+	database            *datasource.Database
+	logger              *zap.Logger
+	config              ReaderConfig
+	timestampsGenerator *timestampsGenerator
+	lastPullTimestamp   time.Time
+	queryStatsQuery     string
+	lockStatsQuery      string
+}
+
+func NewInsightsReader(ctx context.Context,
+	databaseID *datasource.DatabaseID,
+	serviceAccountPath string,
+	readerConfig ReaderConfig,
+	logger *zap.Logger) (*InsightsReader, error) {
+
+	database, err := datasource.NewDatabase(ctx, databaseID, serviceAccountPath)
+	if err != nil {
+		return nil, fmt.Errorf("error occurred during client instantiation for database %q: %w", databaseID.ID(), err)
+	}
+
+	return newInsightsReader(logger, database, readerConfig), nil
+}
+
+func newInsightsReader(logger *zap.Logger, database *datasource.Database, readerConfig ReaderConfig) *InsightsReader {
+	return &InsightsReader{
+		database: database,
+		logger:   logger,
+		config:   readerConfig,
+		timestampsGenerator: &timestampsGenerator{
+			backfillEnabled: readerConfig.BackfillEnabled,
+			difference:      time.Minute,
+		},
+		queryStatsQuery: topQueryStatsQuery,
+		lockStatsQuery:  topLockStatsQuery,
+	}
+}
+
+func (reader *InsightsReader) Name() string {
+	return "insights " + reader.database.DatabaseID().ID()
+}
+
+func (reader *InsightsReader) Shutdown() {
+	reader.logger.Debug(
+		"Closing connection to database",
+		zap.String("database", reader.database.DatabaseID().ID()),
+	)
+	reader.database.Client().Close()
+}
+
+// Read returns the statistics of the minutes elapsed since the previous read, the statistics of a minute being
+// read once.
+func (reader *InsightsReader) Read(ctx context.Context) (plog.Logs, error) {
+	reader.logger.Debug("Executing read method", zap.String("reader", reader.Name()))
+
+	logs := plog.NewLogs()
+	now := time.Now().UTC()
+	if !reader.lastPullTimestamp.IsZero() && !shiftToStartOfMinute(now).After(reader.lastPullTimestamp) {
+		return logs, nil
+	}
+
+	resourceLogs := logs.ResourceLogs().AppendEmpty()
+	databaseID := reader.database.DatabaseID()
+	attributes := resourceLogs.Resource().Attributes()
+	attributes.PutStr("project_id", databaseID.ProjectID())
+	attributes.PutStr("instance_id", databaseID.InstanceID())
+	attributes.PutStr("database", databaseID.DatabaseName())
+	logRecords := resourceLogs.ScopeLogs().AppendEmpty().LogRecords()
+
+	var err error
+	pullTimestamps := reader.timestampsGenerator.pullTimestamps(reader.lastPullTimestamp, now)
+	stalenessRead := reader.timestampsGenerator.isBackfillExecution(reader.lastPullTimestamp)
+	for i, pullTimestamp := range pullTimestamps {
+		args := statementArgs{
+			topMetricsQueryMaxRows: reader.config.TopMetricsQueryMaxRows,
+			pullTimestamp:          pullTimestamp,
+			// Latest timestamp for backfilling must be read from actual data(not stale)
+			stalenessRead: stalenessRead && i < len(pullTimestamps)-1,
+		}
+
+		args.query = reader.queryStatsQuery
+		err = multierr.Append(err, reader.pull(ctx, intervalStatsStatement(args), func(row *spanner.Row) error {
+			var stats queryStatsRow
+			if rowErr := row.ToStruct(&stats); rowErr != nil {
+				return rowErr
+			}
+			reader.queryStatsToLogRecord(stats, logRecords.AppendEmpty())
+			return nil
+		}))
+
+		args.query = reader.lockStatsQuery
+		err = multierr.Append(err, reader.pull(ctx, intervalStatsStatement(args), func(row *spanner.Row) error {
+			var stats lockStatsRow
+			if rowErr := row.ToStruct(&stats); rowErr != nil {
+				return rowErr
+			}
+			reader.lockStatsToLogRecord(stats, logRecords.AppendEmpty())
+			return nil
+		}))
+	}
+
+	reader.lastPullTimestamp = pullTimestamps[len(pullTimestamps)-1]
+
+	return logs, err
+}
+
+func (reader *InsightsReader) pull(ctx context.Context, stmt statsStatement, consumeRow func(row *spanner.Row) error) error {
+	transaction := reader.database.Client().Single()
+	if stmt.stalenessRead || isSafeToUseStaleRead(time.Now().UTC()) {
+		transaction = transaction.WithTimestampBound(spanner.ExactStaleness(dataStalenessPeriod))
+	}
+	rowsIterator := transaction.Query(ctx, stmt.statement)
+	defer rowsIterator.Stop()
+
+	for {
+		row, err := rowsIterator.Next()
+		if err != nil {
+			if errors.Is(err, iterator.Done) {
+				return nil
+			}
+			return fmt.Errorf("query %q failed with error: %w", stmt.statement.SQL, err)
+		}
+
+		if err = consumeRow(row); err != nil {
+			return fmt.Errorf("query %q failed with error: %w", stmt.statement.SQL, err)
+		}
+	}
+}
+
+func (reader *InsightsReader) queryStatsToLogRecord(stats queryStatsRow, dest plog.LogRecord) {
+	dest.SetTimestamp(pcommon.NewTimestampFromTime(stats.IntervalEnd))
+	dest.SetObservedTimestamp(pcommon.NewTimestampFromTime(time.Now()))
+	dest.SetSeverityNumber(plog.SeverityNumberInfo)
+	dest.SetSeverityText(plog.SeverityNumberInfo.String())
+
+	text := stats.Text
+	if reader.config.TruncateText {
+		text = metadata.TruncateString(text, maxLengthTruncateText)
+	}
+	dest.Body().SetStr(text)
+
+	attributes := dest.Attributes()
+	attributes.PutStr("event.name", queryStatsEventName)
+	// the fingerprint identifies the statement across its parameters, as the text is normalized by Spanner
+	attributes.PutInt("spanner.query.fingerprint", stats.TextFingerprint)
+	attributes.PutBool("spanner.query.text_truncated", stats.TextTruncated || len(text) < len(stats.Text))
+	if stats.RequestTag.Valid && stats.RequestTag.StringVal != "" {
+		attributes.PutStr("spanner.request_tag", stats.RequestTag.StringVal)
+	}
+	attributes.PutInt("spanner.query.execution_count", stats.ExecutionCount)
+	attributes.PutDouble("spanner.query.avg_latency_seconds", stats.AvgLatencySeconds)
+	attributes.PutDouble("spanner.query.avg_cpu_seconds", stats.AvgCPUSeconds)
+	attributes.PutDouble("spanner.query.avg_rows", stats.AvgRows)
+	attributes.PutDouble("spanner.query.avg_rows_scanned", stats.AvgRowsScanned)
+	attributes.PutDouble("spanner.query.avg_bytes", stats.AvgBytes)
+	attributes.PutInt("spanner.query.failed_execution_count", stats.AllFailedExecutionCount)
+	attributes.PutInt("spanner.query.cancelled_or_disconnected_execution_count", stats.CancelledOrDisconnectedExecutionCount)
+	attributes.PutInt("spanner.query.timed_out_execution_count", stats.TimedOutExecutionCount)
+}
+
+func (reader *InsightsReader) lockStatsToLogRecord(stats lockStatsRow, dest plog.LogRecord) {
+	dest.SetTimestamp(pcommon.NewTimestampFromTime(stats.IntervalEnd))
+	dest.SetObservedTimestamp(pcommon.NewTimestampFromTime(time.Now()))
+	// the rows of the top lock statistics are the row ranges the transactions waited on
+	dest.SetSeverityNumber(plog.SeverityNumberWarn)
+	dest.SetSeverityText(plog.SeverityNumberWarn.String())
+
+	rowRangeStartKey := string(stats.RowRangeStartKey)
+	if reader.config.HideTopnLockstatsRowrangestartkey {
+		rowRangeStartKey = metadata.HashRowrangestartkey(rowRangeStartKey)
+	}
+	dest.Body().SetStr(fmt.Sprintf("Lock wait of %gs on the row range starting at %s", stats.LockWaitSeconds, rowRangeStartKey))
+
+	attributes := dest.Attributes()
+	attributes.PutStr("event.name", lockStatsEventName)
+	attributes.PutStr("spanner.lock.row_range_start_key", rowRangeStartKey)
+	attributes.PutDouble("spanner.lock.wait_seconds", stats.LockWaitSeconds)
+	requests := attributes.PutEmptySlice("spanner.lock.sample_requests")
+	requests.EnsureCapacity(len(stats.SampleLockRequests))
+	for _, request := range stats.SampleLockRequests {
+		if request == nil {
+			continue
+		}
+		sample := requests.AppendEmpty().SetEmptyMap()
+		sample.PutStr("lock_mode", request.LockMode)
+		sample.PutStr("column", request.Column)
+		if request.TransactionTag.Valid && request.TransactionTag.StringVal != "" {
+			sample.PutStr("transaction_tag", request.TransactionTag.StringVal)
+		}
+	}
+}
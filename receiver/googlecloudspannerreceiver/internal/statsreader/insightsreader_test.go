@@ -0,0 +1,136 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package statsreader
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/googlecloudspannerreceiver/internal/datasource"
+)
+
+func TestNewInsightsReader(t *testing.T) {
+	databaseID := datasource.NewDatabaseID(projectID, instanceID, databaseName)
+	logger := zaptest.NewLogger(t)
+
+	reader, err := NewInsightsReader(context.Background(), databaseID, "../../testdata/serviceAccount.json", ReaderConfig{}, logger)
+	require.NoError(t, err)
+	defer reader.Shutdown()
+
+	assert.Equal(t, databaseID, reader.database.DatabaseID())
+	assert.Equal(t, "insights "+databaseID.ID(), reader.Name())
+	assert.Equal(t, topQueryStatsQuery, reader.queryStatsQuery)
+	assert.Equal(t, topLockStatsQuery, reader.lockStatsQuery)
+
+	reader, err = NewInsightsReader(context.Background(), databaseID, "does not exist", ReaderConfig{}, logger)
+	assert.Error(t, err)
+	assert.Nil(t, reader)
+}
+
+func TestInsightsReader_ReadPulledMinute(t *testing.T) {
+	databaseID := datasource.NewDatabaseID(projectID, instanceID, databaseName)
+	reader := newInsightsReader(zaptest.NewLogger(t), datasource.NewDatabaseFromClient(nil, databaseID), ReaderConfig{})
+	reader.lastPullTimestamp = shiftToStartOfMinute(time.Now().UTC()).Add(time.Minute)
+
+	// the statistics of a minute are read once, without querying the database again
+	logs, err := reader.Read(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 0, logs.LogRecordCount())
+}
+
+func TestQueryStatsToLogRecord(t *testing.T) {
+	intervalEnd := time.Date(2024, 5, 10, 10, 15, 0, 0, time.UTC)
+	row, err := spanner.NewRow(
+		[]string{"INTERVAL_END", "TEXT", "REQUEST_TAG", "TEXT_TRUNCATED", "TEXT_FINGERPRINT", "EXECUTION_COUNT",
+			"AVG_LATENCY_SECONDS", "AVG_ROWS", "AVG_BYTES", "AVG_ROWS_SCANNED", "AVG_CPU_SECONDS",
+			"ALL_FAILED_EXECUTION_COUNT", "CANCELLED_OR_DISCONNECTED_EXECUTION_COUNT", "TIMED_OUT_EXECUTION_COUNT"},
+		[]any{intervalEnd, "SELECT * FROM Orders WHERE OrderId = @id", "app=orders", false, int64(-4231), int64(120),
+			0.25, 1.0, 48.0, 2500.0, 0.2, int64(3), int64(1), int64(2)},
+	)
+	require.NoError(t, err)
+	var stats queryStatsRow
+	require.NoError(t, row.ToStruct(&stats))
+
+	reader := &InsightsReader{}
+	logRecord := plog.NewLogRecord()
+	reader.queryStatsToLogRecord(stats, logRecord)
+
+	assert.Equal(t, intervalEnd, logRecord.Timestamp().AsTime())
+	assert.Equal(t, plog.SeverityNumberInfo, logRecord.SeverityNumber())
+	assert.Equal(t, "SELECT * FROM Orders WHERE OrderId = @id", logRecord.Body().Str())
+	assert.Equal(t, map[string]any{
+		"event.name":                                              "spanner.query_stats",
+		"spanner.query.fingerprint":                               int64(-4231),
+		"spanner.query.text_truncated":                            false,
+		"spanner.request_tag":                                     "app=orders",
+		"spanner.query.execution_count":                           int64(120),
+		"spanner.query.avg_latency_seconds":                       0.25,
+		"spanner.query.avg_cpu_seconds":                           0.2,
+		"spanner.query.avg_rows":                                  1.0,
+		"spanner.query.avg_rows_scanned":                          2500.0,
+		"spanner.query.avg_bytes":                                 48.0,
+		"spanner.query.failed_execution_count":                    int64(3),
+		"spanner.query.cancelled_or_disconnected_execution_count": int64(1),
+		"spanner.query.timed_out_execution_count":                 int64(2),
+	}, logRecord.Attributes().AsRaw())
+
+	stats.Text = strings.Repeat("a", 2*maxLengthTruncateText)
+	stats.RequestTag = spanner.NullString{}
+	reader.config.TruncateText = true
+	logRecord = plog.NewLogRecord()
+	reader.queryStatsToLogRecord(stats, logRecord)
+
+	assert.Equal(t, maxLengthTruncateText, len(logRecord.Body().Str()))
+	truncated, _ := logRecord.Attributes().Get("spanner.query.text_truncated")
+	assert.True(t, truncated.Bool())
+	_, found := logRecord.Attributes().Get("spanner.request_tag")
+	assert.False(t, found)
+}
+
+func TestLockStatsToLogRecord(t *testing.T) {
+	intervalEnd := time.Date(2024, 5, 10, 10, 15, 0, 0, time.UTC)
+	row, err := spanner.NewRow(
+		[]string{"INTERVAL_END", "ROW_RANGE_START_KEY", "LOCK_WAIT_SECONDS", "SAMPLE_LOCK_REQUESTS"},
+		[]any{intervalEnd, []byte("Orders(1234,5678)"), 1.5, []*lockRequest{
+			{LockMode: "WRITER_SHARED", Column: "Orders._exists", TransactionTag: spanner.NullString{StringVal: "app=checkout", Valid: true}},
+			{LockMode: "EXCLUSIVE", Column: "Orders.Status"},
+		}},
+	)
+	require.NoError(t, err)
+	var stats lockStatsRow
+	require.NoError(t, row.ToStruct(&stats))
+
+	reader := &InsightsReader{}
+	logRecord := plog.NewLogRecord()
+	reader.lockStatsToLogRecord(stats, logRecord)
+
+	assert.Equal(t, intervalEnd, logRecord.Timestamp().AsTime())
+	assert.Equal(t, plog.SeverityNumberWarn, logRecord.SeverityNumber())
+	assert.Equal(t, "Lock wait of 1.5s on the row range starting at Orders(1234,5678)", logRecord.Body().Str())
+	assert.Equal(t, map[string]any{
+		"event.name":                       "spanner.lock_stats",
+		"spanner.lock.row_range_start_key": "Orders(1234,5678)",
+		"spanner.lock.wait_seconds":        1.5,
+		"spanner.lock.sample_requests": []any{
+			map[string]any{"lock_mode": "WRITER_SHARED", "column": "Orders._exists", "transaction_tag": "app=checkout"},
+			map[string]any{"lock_mode": "EXCLUSIVE", "column": "Orders.Status"},
+		},
+	}, logRecord.Attributes().AsRaw())
+
+	reader.config.HideTopnLockstatsRowrangestartkey = true
+	logRecord = plog.NewLogRecord()
+	reader.lockStatsToLogRecord(stats, logRecord)
+
+	key, _ := logRecord.Attributes().Get("spanner.lock.row_range_start_key")
+	assert.Equal(t, "Orders(4257489661,3305550597)", key.Str())
+	assert.NotContains(t, logRecord.Body().Str(), "1234")
+}
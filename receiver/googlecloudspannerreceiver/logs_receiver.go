@@ -0,0 +1,131 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package googlecloudspannerreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/googlecloudspannerreceiver"
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/receiver"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/googlecloudspannerreceiver/internal/datasource"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/googlecloudspannerreceiver/internal/statsreader"
+)
+
+var _ receiver.Logs = (*googleCloudSpannerLogsReceiver)(nil)
+
+type insightsReader interface {
+	Name() string
+	Read(ctx context.Context) (plog.Logs, error)
+	Shutdown()
+}
+
+// googleCloudSpannerLogsReceiver emits the top query and lock statistics of the databases as events, at each
+// collection interval.
+type googleCloudSpannerLogsReceiver struct {
+	logger       *zap.Logger
+	config       *Config
+	nextConsumer consumer.Logs
+	cancel       context.CancelFunc
+	wg           sync.WaitGroup
+	readers      []insightsReader
+}
+
+func newGoogleCloudSpannerLogsReceiver(logger *zap.Logger, config *Config, nextConsumer consumer.Logs) *googleCloudSpannerLogsReceiver {
+	return &googleCloudSpannerLogsReceiver{
+		logger:       logger,
+		config:       config,
+		nextConsumer: nextConsumer,
+	}
+}
+
+func (r *googleCloudSpannerLogsReceiver) Start(ctx context.Context, _ component.Host) error {
+	readerConfig := statsreader.ReaderConfig{
+		BackfillEnabled:                   r.config.BackfillEnabled,
+		TopMetricsQueryMaxRows:            r.config.TopMetricsQueryMaxRows,
+		HideTopnLockstatsRowrangestartkey: r.config.HideTopnLockstatsRowrangestartkey,
+		TruncateText:                      r.config.TruncateText,
+	}
+
+	for _, project := range r.config.Projects {
+		for _, instance := range project.Instances {
+			for _, database := range instance.Databases {
+				databaseID := datasource.NewDatabaseID(project.ID, instance.ID, database)
+				reader, err := statsreader.NewInsightsReader(ctx, databaseID, project.ServiceAccountKey, readerConfig, r.logger)
+				if err != nil {
+					return err
+				}
+				r.readers = append(r.readers, reader)
+			}
+		}
+	}
+
+	var collectCtx context.Context
+	collectCtx, r.cancel = context.WithCancel(context.Background())
+	r.wg.Add(1)
+	go r.collectLoop(collectCtx)
+
+	return nil
+}
+
+func (r *googleCloudSpannerLogsReceiver) Shutdown(context.Context) error {
+	if r.cancel != nil {
+		r.cancel()
+	}
+	r.wg.Wait()
+
+	for _, reader := range r.readers {
+		reader.Shutdown()
+	}
+
+	return nil
+}
+
+func (r *googleCloudSpannerLogsReceiver) collectLoop(ctx context.Context) {
+	defer r.wg.Done()
+
+	if r.config.InitialDelay > 0 {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(r.config.InitialDelay):
+		}
+	}
+
+	ticker := time.NewTicker(r.config.CollectionInterval)
+	defer ticker.Stop()
+
+	for {
+		r.collect(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (r *googleCloudSpannerLogsReceiver) collect(ctx context.Context) {
+	logs := plog.NewLogs()
+	for _, reader := range r.readers {
+		readerLogs, err := reader.Read(ctx)
+		if err != nil {
+			r.logger.Error("Error reading the insights of the database", zap.String("reader", reader.Name()), zap.Error(err))
+		}
+		readerLogs.ResourceLogs().MoveAndAppendTo(logs.ResourceLogs())
+	}
+
+	if logs.LogRecordCount() == 0 {
+		return
+	}
+	if err := r.nextConsumer.ConsumeLogs(ctx, logs); err != nil {
+		r.logger.Error("Error consuming the insights of the databases", zap.Error(err))
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package googlecloudspannerreceiver
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.uber.org/zap/zaptest"
+)
+
+type fakeInsightsReader struct {
+	logs     plog.Logs
+	err      error
+	shutdown bool
+}
+
+func (r *fakeInsightsReader) Name() string {
+	return "fakeInsightsReader"
+}
+
+func (r *fakeInsightsReader) Read(context.Context) (plog.Logs, error) {
+	logs := plog.NewLogs()
+	r.logs.CopyTo(logs)
+	return logs, r.err
+}
+
+func (r *fakeInsightsReader) Shutdown() {
+	r.shutdown = true
+}
+
+func newInsightsLogs(eventName string) plog.Logs {
+	logs := plog.NewLogs()
+	logRecord := logs.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+	logRecord.Attributes().PutStr("event.name", eventName)
+	return logs
+}
+
+func TestLogsReceiverCollect(t *testing.T) {
+	sink := new(consumertest.LogsSink)
+	receiver := newGoogleCloudSpannerLogsReceiver(zaptest.NewLogger(t), createDefaultConfig().(*Config), sink)
+	failing := &fakeInsightsReader{logs: plog.NewLogs(), err: errors.New("query failed")}
+	receiver.readers = []insightsReader{
+		&fakeInsightsReader{logs: newInsightsLogs("spanner.query_stats")},
+		failing,
+		&fakeInsightsReader{logs: newInsightsLogs("spanner.lock_stats")},
+	}
+
+	receiver.collect(context.Background())
+
+	// the events of the databases are consumed together, without the database failing
+	require.Len(t, sink.AllLogs(), 1)
+	logs := sink.AllLogs()[0]
+	assert.Equal(t, 2, logs.LogRecordCount())
+	assert.Equal(t, 2, logs.ResourceLogs().Len())
+
+	// nothing is consumed without events
+	receiver.readers = []insightsReader{failing}
+	receiver.collect(context.Background())
+	assert.Len(t, sink.AllLogs(), 1)
+}
+
+func TestLogsReceiverStartAndShutdown(t *testing.T) {
+	sink := new(consumertest.LogsSink)
+	cfg := createDefaultConfig().(*Config)
+	cfg.InitialDelay = 0
+	cfg.CollectionInterval = time.Hour
+	receiver := newGoogleCloudSpannerLogsReceiver(zaptest.NewLogger(t), cfg, sink)
+	require.NoError(t, receiver.Start(context.Background(), componenttest.NewNopHost()))
+	require.NoError(t, receiver.Shutdown(context.Background()))
+
+	reader := &fakeInsightsReader{logs: plog.NewLogs()}
+	receiver.readers = []insightsReader{reader}
+	require.NoError(t, receiver.Shutdown(context.Background()))
+	assert.True(t, reader.shutdown)
+}
+
+func TestLogsReceiverStartWithInvalidServiceAccount(t *testing.T) {
+	receiver := newGoogleCloudSpannerLogsReceiver(zaptest.NewLogger(t), createConfig(serviceAccountInvalidPath), consumertest.NewNop())
+
+	assert.Error(t, receiver.Start(context.Background(), componenttest.NewNopHost()))
+	assert.NoError(t, receiver.Shutdown(context.Background()))
+}
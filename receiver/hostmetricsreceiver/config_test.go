@@ -27,6 +27,8 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/pagingscraper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/processesscraper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/processscraper"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/smartscraper"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/systemdscraper"
 )
 
 func TestLoadConfig(t *testing.T) {
@@ -115,6 +117,18 @@ func TestLoadConfig(t *testing.T) {
 				cfg.SetEnvMap(common.EnvMap{})
 				return cfg
 			})(),
+			smartscraper.TypeStr: (func() internal.Config {
+				cfg := (&smartscraper.Factory{}).CreateDefaultConfig()
+				cfg.(*smartscraper.Config).Devices = []string{"/dev/sda"}
+				cfg.SetEnvMap(common.EnvMap{})
+				return cfg
+			})(),
+			systemdscraper.TypeStr: (func() internal.Config {
+				cfg := (&systemdscraper.Factory{}).CreateDefaultConfig()
+				cfg.(*systemdscraper.Config).Units = []string{"nginx.service", "docker*"}
+				cfg.SetEnvMap(common.EnvMap{})
+				return cfg
+			})(),
 		},
 	}
 
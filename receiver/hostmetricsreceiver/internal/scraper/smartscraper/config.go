@@ -0,0 +1,20 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package smartscraper // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/smartscraper"
+
+import (
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/smartscraper/internal/metadata"
+)
+
+// Config relating to SMART Metric Scraper.
+type Config struct {
+	// MetricsBuilderConfig allows customizing scraped metrics/attributes representation.
+	metadata.MetricsBuilderConfig `mapstructure:",squash"`
+	internal.ScraperConfig
+	// SmartctlPath is the path of the smartctl binary, by default it is looked up in the PATH.
+	SmartctlPath string `mapstructure:"smartctl_path"`
+	// Devices specifies the devices to report (e.g. /dev/sda). If empty, the devices found by `smartctl --scan` are reported.
+	Devices []string `mapstructure:"devices"`
+}
@@ -0,0 +1,139 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package smartscraper // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/smartscraper"
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/smartscraper/internal/metadata"
+)
+
+const metricsLen = 6
+
+// scraper for SMART Metrics
+type scraper struct {
+	settings receiver.CreateSettings
+	config   *Config
+	mb       *metadata.MetricsBuilder
+
+	// for mocking smartctl
+	smartctl func(ctx context.Context, args ...string) ([]byte, error)
+}
+
+// newSmartScraper creates a SMART Scraper
+func newSmartScraper(settings receiver.CreateSettings, cfg *Config) *scraper {
+	return &scraper{
+		settings: settings,
+		config:   cfg,
+		smartctl: func(ctx context.Context, args ...string) ([]byte, error) {
+			return runSmartctl(ctx, cfg.SmartctlPath, args...)
+		},
+	}
+}
+
+func (s *scraper) start(context.Context, component.Host) error {
+	s.mb = metadata.NewMetricsBuilder(s.config.MetricsBuilderConfig, s.settings)
+	return nil
+}
+
+func (s *scraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
+	devices, err := s.devices(ctx)
+	if err != nil {
+		return pmetric.NewMetrics(), scrapererror.NewPartialScrapeError(err, metricsLen)
+	}
+
+	var errs scrapererror.ScrapeErrors
+	for _, device := range devices {
+		info, infoErr := s.deviceInfo(ctx, device)
+		if infoErr != nil {
+			errs.AddPartial(metricsLen, fmt.Errorf("failed to read the SMART data of %s: %w", device.Name, infoErr))
+			continue
+		}
+		s.recordDeviceMetrics(pcommon.NewTimestampFromTime(time.Now()), device, info)
+	}
+
+	return s.mb.Emit(), errs.Combine()
+}
+
+// devices returns the configured devices, or the devices found by smartctl if none are configured
+func (s *scraper) devices(ctx context.Context) ([]smartctlDevice, error) {
+	if len(s.config.Devices) > 0 {
+		devices := make([]smartctlDevice, 0, len(s.config.Devices))
+		for _, name := range s.config.Devices {
+			devices = append(devices, smartctlDevice{Name: name})
+		}
+		return devices, nil
+	}
+
+	out, err := s.smartctl(ctx, "--json", "--scan")
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan devices: %w", err)
+	}
+	var scan smartctlScan
+	if err = json.Unmarshal(out, &scan); err != nil {
+		return nil, fmt.Errorf("failed to parse the devices found by smartctl: %w", err)
+	}
+	return scan.Devices, nil
+}
+
+func (s *scraper) deviceInfo(ctx context.Context, device smartctlDevice) (*smartctlInfo, error) {
+	args := []string{"--json", "--all"}
+	if device.Type != "" {
+		args = append(args, "--device", device.Type)
+	}
+	out, err := s.smartctl(ctx, append(args, device.Name)...)
+	if err != nil {
+		return nil, err
+	}
+	info := &smartctlInfo{}
+	if err = json.Unmarshal(out, info); err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+func (s *scraper) recordDeviceMetrics(now pcommon.Timestamp, device smartctlDevice, info *smartctlInfo) {
+	// report the same device names as the disk scraper
+	name := strings.TrimPrefix(device.Name, "/dev/")
+
+	if info.SmartStatus != nil {
+		var passed int64
+		if info.SmartStatus.Passed {
+			passed = 1
+		}
+		s.mb.RecordSystemDiskSmartHealthDataPoint(now, passed, name)
+	}
+	if info.Temperature != nil {
+		s.mb.RecordSystemDiskSmartTemperatureDataPoint(now, info.Temperature.Current, name)
+	}
+	if info.PowerOnTime != nil {
+		s.mb.RecordSystemDiskSmartPowerOnTimeDataPoint(now, info.PowerOnTime.Hours*3600+info.PowerOnTime.Minutes*60, name)
+	}
+
+	if attribute, ok := info.ataAttribute(ataReallocatedSectorsID); ok {
+		s.mb.RecordSystemDiskSmartReallocatedSectorsDataPoint(now, attribute.Raw.Value, name)
+	}
+	if attribute, ok := info.ataAttribute(ataWearLevelIDs...); ok {
+		s.mb.RecordSystemDiskSmartWearLevelDataPoint(now, 100-attribute.Value, name)
+	}
+
+	if nvme := info.NVMeSmartHealth; nvme != nil {
+		if nvme.PercentageUsed != nil {
+			s.mb.RecordSystemDiskSmartWearLevelDataPoint(now, *nvme.PercentageUsed, name)
+		}
+		if nvme.MediaErrors != nil {
+			s.mb.RecordSystemDiskSmartMediaErrorsDataPoint(now, *nvme.MediaErrors, name)
+		}
+	}
+}
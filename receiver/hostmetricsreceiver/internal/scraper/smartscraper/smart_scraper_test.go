@@ -0,0 +1,115 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package smartscraper
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/smartscraper/internal/metadata"
+)
+
+// fakeSmartctl returns the testdata file matching the device, or the scan output
+func fakeSmartctl(_ context.Context, args ...string) ([]byte, error) {
+	file := "scan.json"
+	if args[len(args)-1] != "--scan" {
+		file = strings.TrimPrefix(args[len(args)-1], "/dev/") + ".json"
+	}
+	return os.ReadFile(filepath.Join("testdata", file))
+}
+
+func newTestScraper(t *testing.T, cfg *Config) *scraper {
+	if cfg == nil {
+		cfg = &Config{}
+	}
+	cfg.MetricsBuilderConfig = metadata.DefaultMetricsBuilderConfig()
+	cfg.Metrics.SystemDiskSmartMediaErrors.Enabled = true
+	cfg.Metrics.SystemDiskSmartPowerOnTime.Enabled = true
+	s := newSmartScraper(receivertest.NewNopCreateSettings(), cfg)
+	s.smartctl = fakeSmartctl
+	require.NoError(t, s.start(context.Background(), componenttest.NewNopHost()))
+	return s
+}
+
+func TestScrape(t *testing.T) {
+	md, err := newTestScraper(t, nil).scrape(context.Background())
+	require.NoError(t, err)
+
+	metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	assert.Equal(t, map[string]int64{"sda": 1, "nvme0": 0}, valuesByDevice(t, metrics, "system.disk.smart.health"))
+	assert.Equal(t, map[string]int64{"sda": 31, "nvme0": 48}, valuesByDevice(t, metrics, "system.disk.smart.temperature"))
+	assert.Equal(t, map[string]int64{"sda": 8}, valuesByDevice(t, metrics, "system.disk.smart.reallocated_sectors"))
+	assert.Equal(t, map[string]int64{"sda": 6, "nvme0": 104}, valuesByDevice(t, metrics, "system.disk.smart.wear_level"))
+	assert.Equal(t, map[string]int64{"nvme0": 3}, valuesByDevice(t, metrics, "system.disk.smart.media_errors"))
+	assert.Equal(t, map[string]int64{"sda": 21034 * 3600, "nvme0": 1520 * 3600}, valuesByDevice(t, metrics, "system.disk.smart.power_on_time"))
+}
+
+func TestScrapeConfiguredDevices(t *testing.T) {
+	s := newTestScraper(t, &Config{Devices: []string{"/dev/nvme0"}})
+	var calls [][]string
+	s.smartctl = func(ctx context.Context, args ...string) ([]byte, error) {
+		calls = append(calls, args)
+		return fakeSmartctl(ctx, args...)
+	}
+
+	md, err := s.scrape(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, [][]string{{"--json", "--all", "/dev/nvme0"}}, calls)
+	metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	assert.Equal(t, map[string]int64{"nvme0": 0}, valuesByDevice(t, metrics, "system.disk.smart.health"))
+}
+
+func TestScrapeErrors(t *testing.T) {
+	s := newTestScraper(t, nil)
+	s.smartctl = func(context.Context, ...string) ([]byte, error) {
+		return nil, errors.New("executable file not found in $PATH")
+	}
+	_, err := s.scrape(context.Background())
+	assert.EqualError(t, err, "failed to scan devices: executable file not found in $PATH")
+	assert.True(t, scrapererror.IsPartialScrapeError(err))
+
+	// the other devices are still reported when one of them cannot be read
+	s = newTestScraper(t, &Config{Devices: []string{"/dev/sda", "/dev/sdb"}})
+	md, err := s.scrape(context.Background())
+	require.Error(t, err)
+	assert.True(t, scrapererror.IsPartialScrapeError(err))
+	assert.Contains(t, err.Error(), "failed to read the SMART data of /dev/sdb")
+	metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	assert.Equal(t, map[string]int64{"sda": 1}, valuesByDevice(t, metrics, "system.disk.smart.health"))
+}
+
+func valuesByDevice(t *testing.T, metrics pmetric.MetricSlice, name string) map[string]int64 {
+	for i := 0; i < metrics.Len(); i++ {
+		metric := metrics.At(i)
+		if metric.Name() != name {
+			continue
+		}
+		var dps pmetric.NumberDataPointSlice
+		if metric.Type() == pmetric.MetricTypeSum {
+			dps = metric.Sum().DataPoints()
+		} else {
+			dps = metric.Gauge().DataPoints()
+		}
+		values := map[string]int64{}
+		for j := 0; j < dps.Len(); j++ {
+			device, ok := dps.At(j).Attributes().Get("device")
+			require.True(t, ok)
+			values[device.Str()] = dps.At(j).IntValue()
+		}
+		return values
+	}
+	require.Failf(t, "metric not found", "%s", name)
+	return nil
+}
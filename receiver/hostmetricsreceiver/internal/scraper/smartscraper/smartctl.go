@@ -0,0 +1,91 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package smartscraper // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/smartscraper"
+
+import (
+	"context"
+	"errors"
+	"os/exec"
+)
+
+// fatalExitStatus are the bits of the smartctl exit status meaning that the command line
+// could not be parsed or that the device could not be opened. The other bits report problems
+// of the disk itself, in which case the output is still valid.
+const fatalExitStatus = 0x3
+
+const (
+	ataReallocatedSectorsID = 5
+)
+
+// ataWearLevelIDs are the attributes reporting the remaining endurance of solid-state disks,
+// as a normalized value decreasing from 100. Vendors use different ids for it.
+var ataWearLevelIDs = []int{
+	177, // Wear_Leveling_Count
+	231, // SSD_Life_Left
+	233, // Media_Wearout_Indicator
+}
+
+// smartctlScan is the output of `smartctl --json --scan`
+type smartctlScan struct {
+	Devices []smartctlDevice `json:"devices"`
+}
+
+type smartctlDevice struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// smartctlInfo is the subset of the output of `smartctl --json --all` used by the scraper
+type smartctlInfo struct {
+	SmartStatus *struct {
+		Passed bool `json:"passed"`
+	} `json:"smart_status"`
+	Temperature *struct {
+		Current int64 `json:"current"`
+	} `json:"temperature"`
+	PowerOnTime *struct {
+		Hours   int64 `json:"hours"`
+		Minutes int64 `json:"minutes"`
+	} `json:"power_on_time"`
+	ATASmartAttributes *struct {
+		Table []ataSmartAttribute `json:"table"`
+	} `json:"ata_smart_attributes"`
+	NVMeSmartHealth *struct {
+		PercentageUsed *int64 `json:"percentage_used"`
+		MediaErrors    *int64 `json:"media_errors"`
+	} `json:"nvme_smart_health_information_log"`
+}
+
+type ataSmartAttribute struct {
+	ID    int   `json:"id"`
+	Value int64 `json:"value"`
+	Raw   struct {
+		Value int64 `json:"value"`
+	} `json:"raw"`
+}
+
+// ataAttribute returns the first attribute of the table with one of the ids
+func (i *smartctlInfo) ataAttribute(ids ...int) (ataSmartAttribute, bool) {
+	if i.ATASmartAttributes == nil {
+		return ataSmartAttribute{}, false
+	}
+	for _, id := range ids {
+		for _, attribute := range i.ATASmartAttributes.Table {
+			if attribute.ID == id {
+				return attribute, true
+			}
+		}
+	}
+	return ataSmartAttribute{}, false
+}
+
+// runSmartctl runs smartctl and returns its output, unless its exit status is fatal
+func runSmartctl(ctx context.Context, path string, args ...string) ([]byte, error) {
+	out, err := exec.CommandContext(ctx, path, args...).Output()
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) && exitErr.ExitCode()&fatalExitStatus == 0 {
+		return out, nil
+	}
+	return out, err
+}
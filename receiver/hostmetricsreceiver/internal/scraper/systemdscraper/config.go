@@ -0,0 +1,28 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package systemdscraper // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/systemdscraper"
+
+import (
+	"errors"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/systemdscraper/internal/metadata"
+)
+
+// Config relating to Systemd Metric Scraper.
+type Config struct {
+	// MetricsBuilderConfig allows customizing scraped metrics/attributes representation.
+	metadata.MetricsBuilderConfig `mapstructure:",squash"`
+	internal.ScraperConfig
+	// Units specifies the units to report, as names or shell-style glob patterns (e.g. "nginx.service", "docker*").
+	Units []string `mapstructure:"units"`
+}
+
+// Validate checks the scraper configuration is valid
+func (cfg *Config) Validate() error {
+	if len(cfg.Units) == 0 {
+		return errors.New("at least one unit must be specified in units")
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package systemdscraper // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/systemdscraper"
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/godbus/dbus/v5"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/systemdscraper/internal/metadata"
+)
+
+// unitStatus is the subset of the unit status reported by systemd which is used by the scraper
+type unitStatus struct {
+	name        string
+	activeState string
+}
+
+// systemdClient queries systemd over its D-Bus API, it allows mocking the system bus in tests
+type systemdClient interface {
+	// listUnits returns the loaded units matching any of the patterns.
+	listUnits(ctx context.Context, patterns []string) ([]unitStatus, error)
+	// serviceRestarts returns the number of automatic restarts of a service unit.
+	serviceRestarts(ctx context.Context, unit string) (int64, error)
+	close()
+}
+
+// scraper for Systemd Metrics
+type scraper struct {
+	settings receiver.CreateSettings
+	config   *Config
+	mb       *metadata.MetricsBuilder
+	client   systemdClient
+
+	// for mocking the D-Bus connection
+	newClient func(context.Context) (systemdClient, error)
+}
+
+// newSystemdScraper creates a Systemd Scraper
+func newSystemdScraper(settings receiver.CreateSettings, cfg *Config) *scraper {
+	return &scraper{
+		settings:  settings,
+		config:    cfg,
+		newClient: newDBusClient,
+	}
+}
+
+func (s *scraper) start(ctx context.Context, _ component.Host) error {
+	client, err := s.newClient(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to connect to systemd: %w", err)
+	}
+	s.client = client
+	s.mb = metadata.NewMetricsBuilder(s.config.MetricsBuilderConfig, s.settings)
+	return nil
+}
+
+func (s *scraper) shutdown(_ context.Context) error {
+	if s.client != nil {
+		s.client.close()
+	}
+	return nil
+}
+
+func (s *scraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
+	now := pcommon.NewTimestampFromTime(time.Now())
+
+	units, err := s.client.listUnits(ctx, s.config.Units)
+	if err != nil {
+		return pmetric.NewMetrics(), fmt.Errorf("failed to list systemd units: %w", err)
+	}
+
+	var errs scrapererror.ScrapeErrors
+	for _, unit := range units {
+		if state, ok := metadata.MapAttributeActiveState[unit.activeState]; ok {
+			for _, value := range metadata.MapAttributeActiveState {
+				var active int64
+				if value == state {
+					active = 1
+				}
+				s.mb.RecordSystemdUnitStateDataPoint(now, active, unit.name, value)
+			}
+		}
+
+		if !s.config.Metrics.SystemdServiceRestarts.Enabled || !strings.HasSuffix(unit.name, ".service") {
+			continue
+		}
+		restarts, restartsErr := s.client.serviceRestarts(ctx, unit.name)
+		if restartsErr != nil {
+			errs.AddPartial(1, fmt.Errorf("failed to get the restarts of %s: %w", unit.name, restartsErr))
+			continue
+		}
+		s.mb.RecordSystemdServiceRestartsDataPoint(now, restarts, unit.name)
+	}
+
+	return s.mb.Emit(), errs.Combine()
+}
+
+// restartsFromVariant converts the NRestarts property of a service, which systemd reports as an uint32
+func restartsFromVariant(v dbus.Variant) (int64, error) {
+	restarts, ok := v.Value().(uint32)
+	if !ok {
+		return 0, fmt.Errorf("unexpected type %s for NRestarts", v.Signature())
+	}
+	return int64(restarts), nil
+}
@@ -0,0 +1,51 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build linux
+
+package systemdscraper // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/systemdscraper"
+
+import (
+	"context"
+
+	systemd "github.com/coreos/go-systemd/v22/dbus"
+)
+
+// dbusClient talks to systemd over the system bus. The bus address can be
+// changed with the DBUS_SYSTEM_BUS_ADDRESS environment variable, e.g. to
+// reach the host systemd from a container.
+type dbusClient struct {
+	conn *systemd.Conn
+}
+
+func newDBusClient(ctx context.Context) (systemdClient, error) {
+	conn, err := systemd.NewWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &dbusClient{conn: conn}, nil
+}
+
+func (c *dbusClient) listUnits(ctx context.Context, patterns []string) ([]unitStatus, error) {
+	statuses, err := c.conn.ListUnitsByPatternsContext(ctx, nil, patterns)
+	if err != nil {
+		return nil, err
+	}
+	units := make([]unitStatus, 0, len(statuses))
+	for _, status := range statuses {
+		units = append(units, unitStatus{name: status.Name, activeState: status.ActiveState})
+	}
+	return units, nil
+}
+
+func (c *dbusClient) serviceRestarts(ctx context.Context, unit string) (int64, error) {
+	property, err := c.conn.GetUnitTypePropertyContext(ctx, unit, "Service", "NRestarts")
+	if err != nil {
+		return 0, err
+	}
+	return restartsFromVariant(property.Value)
+}
+
+func (c *dbusClient) close() {
+	c.conn.Close()
+}
@@ -0,0 +1,155 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package systemdscraper
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/godbus/dbus/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/systemdscraper/internal/metadata"
+)
+
+type fakeClient struct {
+	units      []unitStatus
+	listErr    error
+	restarts   map[string]int64
+	patterns   []string
+	closed     bool
+	restartErr error
+}
+
+func (c *fakeClient) listUnits(_ context.Context, patterns []string) ([]unitStatus, error) {
+	c.patterns = patterns
+	return c.units, c.listErr
+}
+
+func (c *fakeClient) serviceRestarts(_ context.Context, unit string) (int64, error) {
+	if c.restartErr != nil {
+		return 0, c.restartErr
+	}
+	return c.restarts[unit], nil
+}
+
+func (c *fakeClient) close() {
+	c.closed = true
+}
+
+func TestScrape(t *testing.T) {
+	client := &fakeClient{
+		units: []unitStatus{
+			{name: "nginx.service", activeState: "active"},
+			{name: "backup.service", activeState: "failed"},
+			{name: "docker.socket", activeState: "active"},
+		},
+		restarts: map[string]int64{"nginx.service": 2, "backup.service": 5},
+	}
+	cfg := &Config{
+		MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+		Units:                []string{"nginx.service", "backup.service", "docker*"},
+	}
+	scraper := newSystemdScraper(receivertest.NewNopCreateSettings(), cfg)
+	scraper.newClient = func(context.Context) (systemdClient, error) { return client, nil }
+
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+	md, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, cfg.Units, client.patterns)
+
+	metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 2, metrics.Len())
+	states := findMetric(t, metrics, "systemd.unit.state")
+	assert.Equal(t, 3*len(metadata.MapAttributeActiveState), states.Sum().DataPoints().Len())
+	assert.Equal(t, map[string]string{
+		"nginx.service":  "active",
+		"backup.service": "failed",
+		"docker.socket":  "active",
+	}, activeStates(states))
+
+	// only services report restarts
+	restarts := findMetric(t, metrics, "systemd.service.restarts")
+	require.Equal(t, 2, restarts.Sum().DataPoints().Len())
+	for i := 0; i < restarts.Sum().DataPoints().Len(); i++ {
+		dp := restarts.Sum().DataPoints().At(i)
+		unit, ok := dp.Attributes().Get("systemd.unit.name")
+		require.True(t, ok)
+		assert.Equal(t, client.restarts[unit.Str()], dp.IntValue())
+	}
+
+	require.NoError(t, scraper.shutdown(context.Background()))
+	assert.True(t, client.closed)
+}
+
+func TestScrapeErrors(t *testing.T) {
+	cfg := &Config{
+		MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+		Units:                []string{"*.service"},
+	}
+
+	client := &fakeClient{listErr: errors.New("connection closed")}
+	scraper := newSystemdScraper(receivertest.NewNopCreateSettings(), cfg)
+	scraper.newClient = func(context.Context) (systemdClient, error) { return client, nil }
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+	_, err := scraper.scrape(context.Background())
+	assert.EqualError(t, err, "failed to list systemd units: connection closed")
+
+	// the state of the units is still reported when their restarts cannot be retrieved
+	client = &fakeClient{
+		units:      []unitStatus{{name: "nginx.service", activeState: "active"}},
+		restartErr: errors.New("unknown property"),
+	}
+	scraper = newSystemdScraper(receivertest.NewNopCreateSettings(), cfg)
+	scraper.newClient = func(context.Context) (systemdClient, error) { return client, nil }
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+	md, err := scraper.scrape(context.Background())
+	require.Error(t, err)
+	assert.True(t, scrapererror.IsPartialScrapeError(err))
+	assert.Equal(t, 1, md.MetricCount())
+
+	scraper = newSystemdScraper(receivertest.NewNopCreateSettings(), cfg)
+	scraper.newClient = func(context.Context) (systemdClient, error) { return nil, errors.New("no bus") }
+	assert.EqualError(t, scraper.start(context.Background(), componenttest.NewNopHost()), "failed to connect to systemd: no bus")
+}
+
+func TestRestartsFromVariant(t *testing.T) {
+	restarts, err := restartsFromVariant(dbus.MakeVariant(uint32(3)))
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), restarts)
+
+	_, err = restartsFromVariant(dbus.MakeVariant("3"))
+	assert.EqualError(t, err, "unexpected type s for NRestarts")
+}
+
+func findMetric(t *testing.T, metrics pmetric.MetricSlice, name string) pmetric.Metric {
+	for i := 0; i < metrics.Len(); i++ {
+		if metrics.At(i).Name() == name {
+			return metrics.At(i)
+		}
+	}
+	require.Failf(t, "metric not found", "%s", name)
+	return pmetric.Metric{}
+}
+
+// activeStates returns the active state of each unit, that is the state for which the metric is set
+func activeStates(metric pmetric.Metric) map[string]string {
+	states := map[string]string{}
+	for i := 0; i < metric.Sum().DataPoints().Len(); i++ {
+		dp := metric.Sum().DataPoints().At(i)
+		if dp.IntValue() != 1 {
+			continue
+		}
+		unit, _ := dp.Attributes().Get("systemd.unit.name")
+		state, _ := dp.Attributes().Get("systemd.unit.active_state")
+		states[unit.Str()] = state.Str()
+	}
+	return states
+}
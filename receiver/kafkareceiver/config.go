@@ -4,6 +4,9 @@
 package kafkareceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/kafkareceiver"
 
 import (
+	"errors"
+	"fmt"
+	"regexp"
 	"time"
 
 	"go.opentelemetry.io/collector/component"
@@ -37,6 +40,18 @@ type HeaderExtraction struct {
 	Headers        []string `mapstructure:"headers"`
 }
 
+// TopicEncoding sets the encoding of the messages of the topics matching a regular expression.
+type TopicEncoding struct {
+	// Topic is the regular expression matching the whole name of the topics.
+	Topic string `mapstructure:"topic"`
+	// Encoding of the messages of the matching topics.
+	Encoding string `mapstructure:"encoding"`
+	// Signal limits the mapping to the receiver of one signal: traces, metrics or logs.
+	// The receivers of the other signals don't consume from the topics only matched by
+	// mappings of other signals. The mapping applies to all the signals when empty.
+	Signal string `mapstructure:"signal"`
+}
+
 // Config defines configuration for Kafka receiver.
 type Config struct {
 	// The list of kafka brokers (default localhost:9092)
@@ -50,6 +65,15 @@ type Config struct {
 	ProtocolVersion string `mapstructure:"protocol_version"`
 	// The name of the kafka topic to consume from (default "otlp_spans" for traces, "otlp_metrics" for metrics, "otlp_logs" for logs)
 	Topic string `mapstructure:"topic"`
+	// TopicRegex is a regular expression matching the whole name of the kafka topics to
+	// consume from, instead of the single Topic.
+	TopicRegex string `mapstructure:"topic_regex"`
+	// TopicRefreshInterval is the interval between two listings of the topics of the
+	// cluster to find the topics matching TopicRegex (default 1m).
+	TopicRefreshInterval time.Duration `mapstructure:"topic_refresh_interval"`
+	// TopicEncodings overrides Encoding for the topics they match, the first matching
+	// mapping applies.
+	TopicEncodings []TopicEncoding `mapstructure:"topic_encodings"`
 	// Encoding of the messages (default "otlp_proto")
 	Encoding string `mapstructure:"encoding"`
 	// The consumer group that receiver will be consuming messages from (default "otel-collector")
@@ -81,9 +105,47 @@ const (
 	offsetEarliest string = "earliest"
 )
 
+const (
+	signalTraces  = "traces"
+	signalMetrics = "metrics"
+	signalLogs    = "logs"
+)
+
 var _ component.Config = (*Config)(nil)
 
 // Validate checks the receiver configuration is valid
 func (cfg *Config) Validate() error {
+	if cfg.TopicRegex != "" {
+		if cfg.Topic != "" {
+			return errors.New("topic and topic_regex cannot be both set")
+		}
+		if _, err := compileTopicRegex(cfg.TopicRegex); err != nil {
+			return fmt.Errorf("invalid topic_regex: %w", err)
+		}
+		if cfg.TopicRefreshInterval <= 0 {
+			return errors.New("topic_refresh_interval must be positive")
+		}
+	}
+	for i, te := range cfg.TopicEncodings {
+		if _, err := compileTopicRegex(te.Topic); err != nil {
+			return fmt.Errorf("invalid topic_encodings[%d].topic: %w", i, err)
+		}
+		if te.Encoding == "" {
+			return fmt.Errorf("topic_encodings[%d].encoding is required", i)
+		}
+		switch te.Signal {
+		case "", signalTraces, signalMetrics, signalLogs:
+		default:
+			return fmt.Errorf("topic_encodings[%d].signal %q is not one of %s, %s or %s", i, te.Signal, signalTraces, signalMetrics, signalLogs)
+		}
+	}
 	return nil
 }
+
+// compileTopicRegex compiles a regular expression matching the whole name of a topic.
+func compileTopicRegex(expr string) (*regexp.Regexp, error) {
+	if expr == "" {
+		return nil, errors.New("empty regular expression")
+	}
+	return regexp.Compile("^(?:" + expr + ")$")
+}
@@ -40,6 +40,7 @@ func TestLoadConfig(t *testing.T) {
 				ClientID:                             "otel-collector",
 				GroupID:                              "otel-collector",
 				InitialOffset:                        "latest",
+				TopicRefreshInterval:                 time.Minute,
 				Authentication: kafka.Authentication{
 					TLS: &configtls.ClientConfig{
 						Config: configtls.Config{
@@ -66,12 +67,13 @@ func TestLoadConfig(t *testing.T) {
 
 			id: component.NewIDWithName(metadata.Type, "logs"),
 			expected: &Config{
-				Topic:         "logs",
-				Encoding:      "direct",
-				Brokers:       []string{"coffee:123", "foobar:456"},
-				ClientID:      "otel-collector",
-				GroupID:       "otel-collector",
-				InitialOffset: "earliest",
+				Topic:                "logs",
+				Encoding:             "direct",
+				Brokers:              []string{"coffee:123", "foobar:456"},
+				ClientID:             "otel-collector",
+				GroupID:              "otel-collector",
+				InitialOffset:        "earliest",
+				TopicRefreshInterval: time.Minute,
 				Authentication: kafka.Authentication{
 					TLS: &configtls.ClientConfig{
 						Config: configtls.Config{
@@ -94,6 +96,33 @@ func TestLoadConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "topics"),
+			expected: &Config{
+				TopicRegex:           "otlp_.*",
+				TopicRefreshInterval: 10 * time.Second,
+				TopicEncodings: []TopicEncoding{
+					{Topic: "otlp_jaeger_.*", Encoding: "jaeger_proto", Signal: "traces"},
+					{Topic: "otlp_raw_.*", Encoding: "raw", Signal: "logs"},
+				},
+				Encoding:      "otlp_proto",
+				Brokers:       []string{"localhost:9092"},
+				ClientID:      "otel-collector",
+				GroupID:       "otel-collector",
+				InitialOffset: "latest",
+				Metadata: kafkaexporter.Metadata{
+					Full: true,
+					Retry: kafkaexporter.MetadataRetry{
+						Max:     3,
+						Backoff: time.Millisecond * 250,
+					},
+				},
+				AutoCommit: AutoCommit{
+					Enable:   true,
+					Interval: 1 * time.Second,
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -110,3 +139,63 @@ func TestLoadConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateConfig(t *testing.T) {
+	tests := []struct {
+		name        string
+		modify      func(cfg *Config)
+		expectedErr string
+	}{
+		{
+			name: "topic and topic_regex",
+			modify: func(cfg *Config) {
+				cfg.Topic = "spans"
+				cfg.TopicRegex = "spans_.*"
+			},
+			expectedErr: "topic and topic_regex cannot be both set",
+		},
+		{
+			name: "invalid topic_regex",
+			modify: func(cfg *Config) {
+				cfg.TopicRegex = "spans_("
+			},
+			expectedErr: "invalid topic_regex: error parsing regexp: missing closing ): `^(?:spans_()$`",
+		},
+		{
+			name: "topic_refresh_interval",
+			modify: func(cfg *Config) {
+				cfg.TopicRegex = "spans_.*"
+				cfg.TopicRefreshInterval = 0
+			},
+			expectedErr: "topic_refresh_interval must be positive",
+		},
+		{
+			name: "topic_encodings without encoding",
+			modify: func(cfg *Config) {
+				cfg.TopicEncodings = []TopicEncoding{{Topic: "spans_.*"}}
+			},
+			expectedErr: "topic_encodings[0].encoding is required",
+		},
+		{
+			name: "topic_encodings without topic",
+			modify: func(cfg *Config) {
+				cfg.TopicEncodings = []TopicEncoding{{Encoding: "raw"}}
+			},
+			expectedErr: "invalid topic_encodings[0].topic: empty regular expression",
+		},
+		{
+			name: "topic_encodings invalid signal",
+			modify: func(cfg *Config) {
+				cfg.TopicEncodings = []TopicEncoding{{Topic: "spans_.*", Encoding: "raw", Signal: "profiles"}}
+			},
+			expectedErr: `topic_encodings[0].signal "profiles" is not one of traces, metrics or logs`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			tt.modify(cfg)
+			assert.EqualError(t, component.ValidateConfig(cfg), tt.expectedErr)
+		})
+	}
+}
@@ -28,6 +28,8 @@ const (
 	defaultGroupID       = defaultClientID
 	defaultInitialOffset = offsetLatest
 
+	defaultTopicRefreshInterval = time.Minute
+
 	// default from sarama.NewConfig()
 	defaultMetadataRetryMax = 3
 	// default from sarama.NewConfig()
@@ -96,11 +98,12 @@ func NewFactory(options ...FactoryOption) receiver.Factory {
 
 func createDefaultConfig() component.Config {
 	return &Config{
-		Encoding:      defaultEncoding,
-		Brokers:       []string{defaultBroker},
-		ClientID:      defaultClientID,
-		GroupID:       defaultGroupID,
-		InitialOffset: defaultInitialOffset,
+		Encoding:             defaultEncoding,
+		Brokers:              []string{defaultBroker},
+		ClientID:             defaultClientID,
+		GroupID:              defaultGroupID,
+		InitialOffset:        defaultInitialOffset,
+		TopicRefreshInterval: defaultTopicRefreshInterval,
 		Metadata: kafkaexporter.Metadata{
 			Full: defaultMetadataFull,
 			Retry: kafkaexporter.MetadataRetry{
@@ -139,18 +142,28 @@ func (f *kafkaReceiverFactory) createTracesReceiver(
 	}
 
 	oCfg := *(cfg.(*Config))
-	if oCfg.Topic == "" {
+	if oCfg.Topic == "" && oCfg.TopicRegex == "" {
 		oCfg.Topic = defaultTracesTopic
 	}
 	unmarshaler := f.tracesUnmarshalers[oCfg.Encoding]
 	if unmarshaler == nil {
 		return nil, errUnrecognizedEncoding
 	}
+	topicUnmarshalers, err := newTopicRoutes(oCfg.TopicEncodings, signalTraces, func(encoding string) (TracesUnmarshaler, error) {
+		if u := f.tracesUnmarshalers[encoding]; u != nil {
+			return u, nil
+		}
+		return nil, errUnrecognizedEncoding
+	})
+	if err != nil {
+		return nil, err
+	}
 
 	r, err := newTracesReceiver(oCfg, set, unmarshaler, nextConsumer)
 	if err != nil {
 		return nil, err
 	}
+	r.topicUnmarshalers = topicUnmarshalers
 	return r, nil
 }
 
@@ -165,18 +178,28 @@ func (f *kafkaReceiverFactory) createMetricsReceiver(
 	}
 
 	oCfg := *(cfg.(*Config))
-	if oCfg.Topic == "" {
+	if oCfg.Topic == "" && oCfg.TopicRegex == "" {
 		oCfg.Topic = defaultMetricsTopic
 	}
 	unmarshaler := f.metricsUnmarshalers[oCfg.Encoding]
 	if unmarshaler == nil {
 		return nil, errUnrecognizedEncoding
 	}
+	topicUnmarshalers, err := newTopicRoutes(oCfg.TopicEncodings, signalMetrics, func(encoding string) (MetricsUnmarshaler, error) {
+		if u := f.metricsUnmarshalers[encoding]; u != nil {
+			return u, nil
+		}
+		return nil, errUnrecognizedEncoding
+	})
+	if err != nil {
+		return nil, err
+	}
 
 	r, err := newMetricsReceiver(oCfg, set, unmarshaler, nextConsumer)
 	if err != nil {
 		return nil, err
 	}
+	r.topicUnmarshalers = topicUnmarshalers
 	return r, nil
 }
 
@@ -191,18 +214,25 @@ func (f *kafkaReceiverFactory) createLogsReceiver(
 	}
 
 	oCfg := *(cfg.(*Config))
-	if oCfg.Topic == "" {
+	if oCfg.Topic == "" && oCfg.TopicRegex == "" {
 		oCfg.Topic = defaultLogsTopic
 	}
 	unmarshaler, err := getLogsUnmarshaler(oCfg.Encoding, f.logsUnmarshalers)
 	if err != nil {
 		return nil, err
 	}
+	topicUnmarshalers, err := newTopicRoutes(oCfg.TopicEncodings, signalLogs, func(encoding string) (LogsUnmarshaler, error) {
+		return getLogsUnmarshaler(encoding, f.logsUnmarshalers)
+	})
+	if err != nil {
+		return nil, err
+	}
 
 	r, err := newLogsReceiver(oCfg, set, unmarshaler, nextConsumer)
 	if err != nil {
 		return nil, err
 	}
+	r.topicUnmarshalers = topicUnmarshalers
 	return r, nil
 }
 
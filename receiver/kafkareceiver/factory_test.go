@@ -39,6 +39,15 @@ func TestCreateTracesReceiver(t *testing.T) {
 	require.Error(t, r.Start(context.Background(), componenttest.NewNopHost()))
 }
 
+func TestCreateTracesReceiver_topic_encodings_error(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.TopicEncodings = []TopicEncoding{{Topic: "raw_.*", Encoding: "raw"}}
+	f := kafkaReceiverFactory{tracesUnmarshalers: defaultTracesUnmarshalers()}
+	r, err := f.createTracesReceiver(context.Background(), receivertest.NewNopCreateSettings(), cfg, nil)
+	require.ErrorIs(t, err, errUnrecognizedEncoding)
+	assert.Nil(t, r)
+}
+
 func TestWithTracesUnmarshalers(t *testing.T) {
 	unmarshaler := &customTracesUnmarshaler{}
 	f := NewFactory(withTracesUnmarshalers(unmarshaler))
@@ -16,6 +16,7 @@ import (
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/receiver"
 	"go.opentelemetry.io/collector/receiver/receiverhelper"
+	"go.uber.org/multierr"
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/kafka"
@@ -33,8 +34,10 @@ type kafkaTracesConsumer struct {
 	consumerGroup     sarama.ConsumerGroup
 	nextConsumer      consumer.Traces
 	topics            []string
+	subscription      *topicSubscription
 	cancelConsumeLoop context.CancelFunc
 	unmarshaler       TracesUnmarshaler
+	topicUnmarshalers []topicRoute[TracesUnmarshaler]
 
 	settings receiver.CreateSettings
 
@@ -50,8 +53,10 @@ type kafkaMetricsConsumer struct {
 	consumerGroup     sarama.ConsumerGroup
 	nextConsumer      consumer.Metrics
 	topics            []string
+	subscription      *topicSubscription
 	cancelConsumeLoop context.CancelFunc
 	unmarshaler       MetricsUnmarshaler
+	topicUnmarshalers []topicRoute[MetricsUnmarshaler]
 
 	settings receiver.CreateSettings
 
@@ -67,8 +72,10 @@ type kafkaLogsConsumer struct {
 	consumerGroup     sarama.ConsumerGroup
 	nextConsumer      consumer.Logs
 	topics            []string
+	subscription      *topicSubscription
 	cancelConsumeLoop context.CancelFunc
 	unmarshaler       LogsUnmarshaler
+	topicUnmarshalers []topicRoute[LogsUnmarshaler]
 
 	settings receiver.CreateSettings
 
@@ -86,10 +93,15 @@ func newTracesReceiver(config Config, set receiver.CreateSettings, unmarshaler T
 	if unmarshaler == nil {
 		return nil, errUnrecognizedEncoding
 	}
+	subscription, err := newTopicSubscription(config, signalTraces, set.Logger)
+	if err != nil {
+		return nil, err
+	}
 
 	return &kafkaTracesConsumer{
 		config:            config,
 		topics:            []string{config.Topic},
+		subscription:      subscription,
 		nextConsumer:      nextConsumer,
 		unmarshaler:       unmarshaler,
 		settings:          set,
@@ -101,6 +113,24 @@ func newTracesReceiver(config Config, set receiver.CreateSettings, unmarshaler T
 }
 
 func createKafkaClient(config Config) (sarama.ConsumerGroup, error) {
+	saramaConfig, err := newSaramaConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	return sarama.NewConsumerGroup(config.Brokers, config.GroupID, saramaConfig)
+}
+
+// createKafkaTopicLister returns a client listing the topics of the cluster, for the
+// topic regex subscription.
+func createKafkaTopicLister(config Config) (topicLister, error) {
+	saramaConfig, err := newSaramaConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	return sarama.NewClient(config.Brokers, saramaConfig)
+}
+
+func newSaramaConfig(config Config) (*sarama.Config, error) {
 	saramaConfig := sarama.NewConfig()
 	saramaConfig.ClientID = config.ClientID
 	saramaConfig.Metadata.Full = config.Metadata.Full
@@ -123,7 +153,7 @@ func createKafkaClient(config Config) (sarama.ConsumerGroup, error) {
 	if err := kafka.ConfigureAuthentication(config.Authentication, saramaConfig); err != nil {
 		return nil, err
 	}
-	return sarama.NewConsumerGroup(config.Brokers, config.GroupID, saramaConfig)
+	return saramaConfig, nil
 }
 
 func (c *kafkaTracesConsumer) Start(_ context.Context, _ component.Host) error {
@@ -143,9 +173,20 @@ func (c *kafkaTracesConsumer) Start(_ context.Context, _ component.Host) error {
 			return err
 		}
 	}
+	if c.subscription != nil {
+		if c.subscription.lister == nil {
+			if c.subscription.lister, err = createKafkaTopicLister(c.config); err != nil {
+				return err
+			}
+		}
+		if _, err = c.subscription.refresh(); err != nil {
+			return err
+		}
+	}
 	consumerGroup := &tracesConsumerGroupHandler{
 		logger:            c.settings.Logger,
 		unmarshaler:       c.unmarshaler,
+		topicUnmarshalers: c.topicUnmarshalers,
 		nextConsumer:      c.nextConsumer,
 		ready:             make(chan bool),
 		obsrecv:           obsrecv,
@@ -164,11 +205,17 @@ func (c *kafkaTracesConsumer) Start(_ context.Context, _ component.Host) error {
 			c.settings.ReportStatus(component.NewFatalErrorEvent(err))
 		}
 	}()
-	<-consumerGroup.ready
+	// with a topic regex matching no topic yet, no session is set up until topics are created
+	if c.subscription == nil || len(c.subscription.current()) > 0 {
+		<-consumerGroup.ready
+	}
 	return nil
 }
 
 func (c *kafkaTracesConsumer) consumeLoop(ctx context.Context, handler sarama.ConsumerGroupHandler) error {
+	if c.subscription != nil {
+		return c.subscription.consume(ctx, c.consumerGroup, handler)
+	}
 	for {
 		// `Consume` should be called inside an infinite loop, when a
 		// server-side rebalance happens, the consumer session will need to be
@@ -189,20 +236,29 @@ func (c *kafkaTracesConsumer) Shutdown(context.Context) error {
 		return nil
 	}
 	c.cancelConsumeLoop()
-	if c.consumerGroup == nil {
-		return nil
+	var errs error
+	if c.subscription != nil {
+		errs = multierr.Append(errs, c.subscription.close())
 	}
-	return c.consumerGroup.Close()
+	if c.consumerGroup != nil {
+		errs = multierr.Append(errs, c.consumerGroup.Close())
+	}
+	return errs
 }
 
 func newMetricsReceiver(config Config, set receiver.CreateSettings, unmarshaler MetricsUnmarshaler, nextConsumer consumer.Metrics) (*kafkaMetricsConsumer, error) {
 	if unmarshaler == nil {
 		return nil, errUnrecognizedEncoding
 	}
+	subscription, err := newTopicSubscription(config, signalMetrics, set.Logger)
+	if err != nil {
+		return nil, err
+	}
 
 	return &kafkaMetricsConsumer{
 		config:            config,
 		topics:            []string{config.Topic},
+		subscription:      subscription,
 		nextConsumer:      nextConsumer,
 		unmarshaler:       unmarshaler,
 		settings:          set,
@@ -230,9 +286,20 @@ func (c *kafkaMetricsConsumer) Start(_ context.Context, _ component.Host) error
 			return err
 		}
 	}
+	if c.subscription != nil {
+		if c.subscription.lister == nil {
+			if c.subscription.lister, err = createKafkaTopicLister(c.config); err != nil {
+				return err
+			}
+		}
+		if _, err = c.subscription.refresh(); err != nil {
+			return err
+		}
+	}
 	metricsConsumerGroup := &metricsConsumerGroupHandler{
 		logger:            c.settings.Logger,
 		unmarshaler:       c.unmarshaler,
+		topicUnmarshalers: c.topicUnmarshalers,
 		nextConsumer:      c.nextConsumer,
 		ready:             make(chan bool),
 		obsrecv:           obsrecv,
@@ -251,11 +318,17 @@ func (c *kafkaMetricsConsumer) Start(_ context.Context, _ component.Host) error
 			c.settings.ReportStatus(component.NewFatalErrorEvent(err))
 		}
 	}()
-	<-metricsConsumerGroup.ready
+	// with a topic regex matching no topic yet, no session is set up until topics are created
+	if c.subscription == nil || len(c.subscription.current()) > 0 {
+		<-metricsConsumerGroup.ready
+	}
 	return nil
 }
 
 func (c *kafkaMetricsConsumer) consumeLoop(ctx context.Context, handler sarama.ConsumerGroupHandler) error {
+	if c.subscription != nil {
+		return c.subscription.consume(ctx, c.consumerGroup, handler)
+	}
 	for {
 		// `Consume` should be called inside an infinite loop, when a
 		// server-side rebalance happens, the consumer session will need to be
@@ -276,20 +349,29 @@ func (c *kafkaMetricsConsumer) Shutdown(context.Context) error {
 		return nil
 	}
 	c.cancelConsumeLoop()
-	if c.consumerGroup == nil {
-		return nil
+	var errs error
+	if c.subscription != nil {
+		errs = multierr.Append(errs, c.subscription.close())
+	}
+	if c.consumerGroup != nil {
+		errs = multierr.Append(errs, c.consumerGroup.Close())
 	}
-	return c.consumerGroup.Close()
+	return errs
 }
 
 func newLogsReceiver(config Config, set receiver.CreateSettings, unmarshaler LogsUnmarshaler, nextConsumer consumer.Logs) (*kafkaLogsConsumer, error) {
 	if unmarshaler == nil {
 		return nil, errUnrecognizedEncoding
 	}
+	subscription, err := newTopicSubscription(config, signalLogs, set.Logger)
+	if err != nil {
+		return nil, err
+	}
 
 	return &kafkaLogsConsumer{
 		config:            config,
 		topics:            []string{config.Topic},
+		subscription:      subscription,
 		nextConsumer:      nextConsumer,
 		unmarshaler:       unmarshaler,
 		settings:          set,
@@ -317,9 +399,20 @@ func (c *kafkaLogsConsumer) Start(_ context.Context, _ component.Host) error {
 			return err
 		}
 	}
+	if c.subscription != nil {
+		if c.subscription.lister == nil {
+			if c.subscription.lister, err = createKafkaTopicLister(c.config); err != nil {
+				return err
+			}
+		}
+		if _, err = c.subscription.refresh(); err != nil {
+			return err
+		}
+	}
 	logsConsumerGroup := &logsConsumerGroupHandler{
 		logger:            c.settings.Logger,
 		unmarshaler:       c.unmarshaler,
+		topicUnmarshalers: c.topicUnmarshalers,
 		nextConsumer:      c.nextConsumer,
 		ready:             make(chan bool),
 		obsrecv:           obsrecv,
@@ -338,11 +431,17 @@ func (c *kafkaLogsConsumer) Start(_ context.Context, _ component.Host) error {
 			c.settings.ReportStatus(component.NewFatalErrorEvent(err))
 		}
 	}()
-	<-logsConsumerGroup.ready
+	// with a topic regex matching no topic yet, no session is set up until topics are created
+	if c.subscription == nil || len(c.subscription.current()) > 0 {
+		<-logsConsumerGroup.ready
+	}
 	return nil
 }
 
 func (c *kafkaLogsConsumer) consumeLoop(ctx context.Context, handler sarama.ConsumerGroupHandler) error {
+	if c.subscription != nil {
+		return c.subscription.consume(ctx, c.consumerGroup, handler)
+	}
 	for {
 		// `Consume` should be called inside an infinite loop, when a
 		// server-side rebalance happens, the consumer session will need to be
@@ -363,18 +462,23 @@ func (c *kafkaLogsConsumer) Shutdown(context.Context) error {
 		return nil
 	}
 	c.cancelConsumeLoop()
-	if c.consumerGroup == nil {
-		return nil
+	var errs error
+	if c.subscription != nil {
+		errs = multierr.Append(errs, c.subscription.close())
 	}
-	return c.consumerGroup.Close()
+	if c.consumerGroup != nil {
+		errs = multierr.Append(errs, c.consumerGroup.Close())
+	}
+	return errs
 }
 
 type tracesConsumerGroupHandler struct {
-	id           component.ID
-	unmarshaler  TracesUnmarshaler
-	nextConsumer consumer.Traces
-	ready        chan bool
-	readyCloser  sync.Once
+	id                component.ID
+	unmarshaler       TracesUnmarshaler
+	topicUnmarshalers []topicRoute[TracesUnmarshaler]
+	nextConsumer      consumer.Traces
+	ready             chan bool
+	readyCloser       sync.Once
 
 	logger *zap.Logger
 
@@ -386,11 +490,12 @@ type tracesConsumerGroupHandler struct {
 }
 
 type metricsConsumerGroupHandler struct {
-	id           component.ID
-	unmarshaler  MetricsUnmarshaler
-	nextConsumer consumer.Metrics
-	ready        chan bool
-	readyCloser  sync.Once
+	id                component.ID
+	unmarshaler       MetricsUnmarshaler
+	topicUnmarshalers []topicRoute[MetricsUnmarshaler]
+	nextConsumer      consumer.Metrics
+	ready             chan bool
+	readyCloser       sync.Once
 
 	logger *zap.Logger
 
@@ -402,11 +507,12 @@ type metricsConsumerGroupHandler struct {
 }
 
 type logsConsumerGroupHandler struct {
-	id           component.ID
-	unmarshaler  LogsUnmarshaler
-	nextConsumer consumer.Logs
-	ready        chan bool
-	readyCloser  sync.Once
+	id                component.ID
+	unmarshaler       LogsUnmarshaler
+	topicUnmarshalers []topicRoute[LogsUnmarshaler]
+	nextConsumer      consumer.Logs
+	ready             chan bool
+	readyCloser       sync.Once
 
 	logger *zap.Logger
 
@@ -465,7 +571,8 @@ func (c *tracesConsumerGroupHandler) ConsumeClaim(session sarama.ConsumerGroupSe
 				statMessageOffset.M(message.Offset),
 				statMessageOffsetLag.M(claim.HighWaterMarkOffset()-message.Offset-1))
 
-			traces, err := c.unmarshaler.Unmarshal(message.Value)
+			unmarshaler := routeTopic(c.topicUnmarshalers, message.Topic, c.unmarshaler)
+			traces, err := unmarshaler.Unmarshal(message.Value)
 			if err != nil {
 				c.logger.Error("failed to unmarshal message", zap.Error(err))
 				_ = stats.RecordWithTags(
@@ -481,7 +588,7 @@ func (c *tracesConsumerGroupHandler) ConsumeClaim(session sarama.ConsumerGroupSe
 			c.headerExtractor.extractHeadersTraces(traces, message)
 			spanCount := traces.SpanCount()
 			err = c.nextConsumer.ConsumeTraces(session.Context(), traces)
-			c.obsrecv.EndTracesOp(ctx, c.unmarshaler.Encoding(), spanCount, err)
+			c.obsrecv.EndTracesOp(ctx, unmarshaler.Encoding(), spanCount, err)
 			if err != nil {
 				if c.messageMarking.After && c.messageMarking.OnError {
 					session.MarkMessage(message, "")
@@ -548,7 +655,8 @@ func (c *metricsConsumerGroupHandler) ConsumeClaim(session sarama.ConsumerGroupS
 				statMessageOffset.M(message.Offset),
 				statMessageOffsetLag.M(claim.HighWaterMarkOffset()-message.Offset-1))
 
-			metrics, err := c.unmarshaler.Unmarshal(message.Value)
+			unmarshaler := routeTopic(c.topicUnmarshalers, message.Topic, c.unmarshaler)
+			metrics, err := unmarshaler.Unmarshal(message.Value)
 			if err != nil {
 				c.logger.Error("failed to unmarshal message", zap.Error(err))
 				_ = stats.RecordWithTags(
@@ -564,7 +672,7 @@ func (c *metricsConsumerGroupHandler) ConsumeClaim(session sarama.ConsumerGroupS
 
 			dataPointCount := metrics.DataPointCount()
 			err = c.nextConsumer.ConsumeMetrics(session.Context(), metrics)
-			c.obsrecv.EndMetricsOp(ctx, c.unmarshaler.Encoding(), dataPointCount, err)
+			c.obsrecv.EndMetricsOp(ctx, unmarshaler.Encoding(), dataPointCount, err)
 			if err != nil {
 				if c.messageMarking.After && c.messageMarking.OnError {
 					session.MarkMessage(message, "")
@@ -637,7 +745,8 @@ func (c *logsConsumerGroupHandler) ConsumeClaim(session sarama.ConsumerGroupSess
 				statMessageOffset.M(message.Offset),
 				statMessageOffsetLag.M(claim.HighWaterMarkOffset()-message.Offset-1))
 
-			logs, err := c.unmarshaler.Unmarshal(message.Value)
+			unmarshaler := routeTopic(c.topicUnmarshalers, message.Topic, c.unmarshaler)
+			logs, err := unmarshaler.Unmarshal(message.Value)
 			if err != nil {
 				c.logger.Error("failed to unmarshal message", zap.Error(err))
 				_ = stats.RecordWithTags(
@@ -652,7 +761,7 @@ func (c *logsConsumerGroupHandler) ConsumeClaim(session sarama.ConsumerGroupSess
 			c.headerExtractor.extractHeadersLogs(logs, message)
 			logRecordCount := logs.LogRecordCount()
 			err = c.nextConsumer.ConsumeLogs(session.Context(), logs)
-			c.obsrecv.EndLogsOp(ctx, c.unmarshaler.Encoding(), logRecordCount, err)
+			c.obsrecv.EndLogsOp(ctx, unmarshaler.Encoding(), logRecordCount, err)
 			if err != nil {
 				if c.messageMarking.After && c.messageMarking.OnError {
 					session.MarkMessage(message, "")
@@ -0,0 +1,233 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kafkareceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/kafkareceiver"
+
+import (
+	"context"
+	"regexp"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/IBM/sarama"
+	"go.uber.org/zap"
+)
+
+// topicRoute selects the value used for the messages of the topics matching its pattern.
+type topicRoute[T any] struct {
+	pattern *regexp.Regexp
+	value   T
+}
+
+// newTopicRoutes returns the routes of the topic encodings applying to the signal,
+// in the configured order.
+func newTopicRoutes[T any](encodings []TopicEncoding, signal string, lookup func(encoding string) (T, error)) ([]topicRoute[T], error) {
+	var routes []topicRoute[T]
+	for _, te := range encodings {
+		if te.Signal != "" && te.Signal != signal {
+			continue
+		}
+		pattern, err := compileTopicRegex(te.Topic)
+		if err != nil {
+			return nil, err
+		}
+		value, err := lookup(te.Encoding)
+		if err != nil {
+			return nil, err
+		}
+		routes = append(routes, topicRoute[T]{pattern: pattern, value: value})
+	}
+	return routes, nil
+}
+
+// routeTopic returns the value of the first route matching the topic, or the fallback.
+func routeTopic[T any](routes []topicRoute[T], topic string, fallback T) T {
+	for _, route := range routes {
+		if route.pattern.MatchString(topic) {
+			return route.value
+		}
+	}
+	return fallback
+}
+
+// topicLister lists the topics of the cluster, it is implemented by sarama.Client.
+type topicLister interface {
+	RefreshMetadata(topics ...string) error
+	Topics() ([]string, error)
+	Close() error
+}
+
+// topicMapping tells whether a topic encoding applies to the signal of the receiver.
+type topicMapping struct {
+	pattern *regexp.Regexp
+	own     bool
+}
+
+// topicSubscription consumes from the topics of the cluster matching a regular expression,
+// the topics are listed periodically and the consumer session is restarted when they change.
+type topicSubscription struct {
+	pattern  *regexp.Regexp
+	mappings []topicMapping
+	interval time.Duration
+	logger   *zap.Logger
+
+	// lister may be set in tests to inject fake implementation.
+	lister topicLister
+
+	mu      sync.Mutex
+	topics  []string
+	changed chan struct{}
+}
+
+// newTopicSubscription returns the subscription of the receiver of the signal, or nil
+// when the receiver consumes from a single topic.
+func newTopicSubscription(config Config, signal string, logger *zap.Logger) (*topicSubscription, error) {
+	if config.TopicRegex == "" {
+		return nil, nil
+	}
+	pattern, err := compileTopicRegex(config.TopicRegex)
+	if err != nil {
+		return nil, err
+	}
+	s := &topicSubscription{
+		pattern:  pattern,
+		interval: config.TopicRefreshInterval,
+		logger:   logger,
+		changed:  make(chan struct{}, 1),
+	}
+	if s.interval <= 0 {
+		s.interval = defaultTopicRefreshInterval
+	}
+	for _, te := range config.TopicEncodings {
+		mappingPattern, err := compileTopicRegex(te.Topic)
+		if err != nil {
+			return nil, err
+		}
+		s.mappings = append(s.mappings, topicMapping{
+			pattern: mappingPattern,
+			own:     te.Signal == "" || te.Signal == signal,
+		})
+	}
+	return s, nil
+}
+
+// includes reports whether the receiver consumes from the topic: a topic matched only
+// by the topic encodings of other signals is left to the receivers of these signals.
+func (s *topicSubscription) includes(topic string) bool {
+	matched := false
+	for _, m := range s.mappings {
+		if m.pattern.MatchString(topic) {
+			if m.own {
+				return true
+			}
+			matched = true
+		}
+	}
+	return !matched
+}
+
+// refresh lists the topics of the cluster and reports whether the matching topics changed.
+func (s *topicSubscription) refresh() (bool, error) {
+	if err := s.lister.RefreshMetadata(); err != nil {
+		return false, err
+	}
+	all, err := s.lister.Topics()
+	if err != nil {
+		return false, err
+	}
+	var topics []string
+	for _, topic := range all {
+		if s.pattern.MatchString(topic) && s.includes(topic) {
+			topics = append(topics, topic)
+		}
+	}
+	sort.Strings(topics)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if slices.Equal(topics, s.topics) {
+		return false, nil
+	}
+	s.topics = topics
+	return true, nil
+}
+
+func (s *topicSubscription) current() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.topics
+}
+
+// watch refreshes the topics until the context is done, and signals their changes.
+func (s *topicSubscription) watch(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			changed, err := s.refresh()
+			if ctx.Err() != nil {
+				return
+			}
+			if err != nil {
+				s.logger.Warn("Failed to list the topics, keeping the current subscription", zap.Error(err))
+				continue
+			}
+			if !changed {
+				continue
+			}
+			s.logger.Info("Topics matching the topic regex changed", zap.Strings("topics", s.current()))
+			select {
+			case s.changed <- struct{}{}:
+			default:
+			}
+		}
+	}
+}
+
+// consume runs the consumer sessions of the matching topics until the context is done.
+func (s *topicSubscription) consume(ctx context.Context, consumerGroup sarama.ConsumerGroup, handler sarama.ConsumerGroupHandler) error {
+	go s.watch(ctx)
+	for {
+		topics := s.current()
+		if len(topics) == 0 {
+			s.logger.Warn("No topic matches the topic regex", zap.String("topic_regex", s.pattern.String()))
+			select {
+			case <-ctx.Done():
+				s.logger.Info("Consumer stopped", zap.Error(ctx.Err()))
+				return ctx.Err()
+			case <-s.changed:
+				continue
+			}
+		}
+
+		// the session is ended when the topics change, to start a new one subscribed to them
+		sessionCtx, cancel := context.WithCancel(ctx)
+		go func() {
+			select {
+			case <-s.changed:
+				cancel()
+			case <-sessionCtx.Done():
+			}
+		}()
+		if err := consumerGroup.Consume(sessionCtx, topics, handler); err != nil {
+			s.logger.Error("Error from consumer", zap.Error(err))
+		}
+		cancel()
+		if ctx.Err() != nil {
+			s.logger.Info("Consumer stopped", zap.Error(ctx.Err()))
+			return ctx.Err()
+		}
+	}
+}
+
+func (s *topicSubscription) close() error {
+	if s.lister == nil {
+		return nil
+	}
+	return s.lister.Close()
+}
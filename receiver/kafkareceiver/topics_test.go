@@ -0,0 +1,226 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kafkareceiver
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/receiver/receiverhelper"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	"go.uber.org/zap"
+)
+
+type testTopicLister struct {
+	mu     sync.Mutex
+	topics []string
+	err    error
+	closed bool
+}
+
+func (l *testTopicLister) setTopics(topics ...string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.topics = topics
+}
+
+func (l *testTopicLister) RefreshMetadata(...string) error {
+	return l.err
+}
+
+func (l *testTopicLister) Topics() ([]string, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.topics, nil
+}
+
+func (l *testTopicLister) Close() error {
+	l.closed = true
+	return nil
+}
+
+// testSessionConsumerGroup records the topics of each session, which lasts until its context is done.
+type testSessionConsumerGroup struct {
+	testConsumerGroup
+	mu       sync.Mutex
+	sessions [][]string
+}
+
+func (g *testSessionConsumerGroup) Consume(ctx context.Context, topics []string, handler sarama.ConsumerGroupHandler) error {
+	g.mu.Lock()
+	g.sessions = append(g.sessions, topics)
+	g.mu.Unlock()
+	_ = g.testConsumerGroup.Consume(ctx, topics, handler)
+	<-ctx.Done()
+	return nil
+}
+
+func (g *testSessionConsumerGroup) consumedTopics() [][]string {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return append([][]string(nil), g.sessions...)
+}
+
+func TestRouteTopic(t *testing.T) {
+	routes, err := newTopicRoutes([]TopicEncoding{
+		{Topic: "jaeger_.*", Encoding: "jaeger_proto", Signal: signalTraces},
+		{Topic: "raw_.*", Encoding: "raw", Signal: signalLogs},
+		{Topic: "zipkin_.*", Encoding: "zipkin_json"},
+		{Topic: "jaeger_json", Encoding: "jaeger_json"},
+	}, signalTraces, func(encoding string) (string, error) {
+		return encoding, nil
+	})
+	require.NoError(t, err)
+	require.Len(t, routes, 3)
+
+	assert.Equal(t, "jaeger_proto", routeTopic(routes, "jaeger_spans", "otlp_proto"))
+	assert.Equal(t, "jaeger_proto", routeTopic(routes, "jaeger_json", "otlp_proto"))
+	assert.Equal(t, "zipkin_json", routeTopic(routes, "zipkin_spans", "otlp_proto"))
+	assert.Equal(t, "otlp_proto", routeTopic(routes, "raw_logs", "otlp_proto"))
+	assert.Equal(t, "otlp_proto", routeTopic(routes, "other_jaeger_spans", "otlp_proto"))
+
+	_, err = newTopicRoutes([]TopicEncoding{
+		{Topic: "foo", Encoding: "foo"},
+	}, signalTraces, func(string) (string, error) {
+		return "", errUnrecognizedEncoding
+	})
+	assert.ErrorIs(t, err, errUnrecognizedEncoding)
+}
+
+func TestTopicSubscriptionRefresh(t *testing.T) {
+	cfg := Config{
+		TopicRegex: "otlp_.*",
+		TopicEncodings: []TopicEncoding{
+			{Topic: "otlp_raw_.*", Encoding: "raw", Signal: signalLogs},
+			{Topic: "otlp_.*_spans", Encoding: "jaeger_proto", Signal: signalTraces},
+		},
+	}
+	lister := &testTopicLister{}
+	lister.setTopics("otlp_raw_app", "otlp_metrics", "otlp_raw_spans", "other", "otlp_jaeger_spans", "xotlp_foo")
+
+	traces, err := newTopicSubscription(cfg, signalTraces, zap.NewNop())
+	require.NoError(t, err)
+	traces.lister = lister
+	changed, err := traces.refresh()
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.Equal(t, []string{"otlp_jaeger_spans", "otlp_metrics", "otlp_raw_spans"}, traces.current())
+
+	logs, err := newTopicSubscription(cfg, signalLogs, zap.NewNop())
+	require.NoError(t, err)
+	logs.lister = lister
+	_, err = logs.refresh()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"otlp_metrics", "otlp_raw_app", "otlp_raw_spans"}, logs.current())
+
+	changed, err = logs.refresh()
+	require.NoError(t, err)
+	assert.False(t, changed)
+
+	lister.err = errors.New("metadata error")
+	_, err = logs.refresh()
+	assert.EqualError(t, err, "metadata error")
+	assert.Equal(t, []string{"otlp_metrics", "otlp_raw_app", "otlp_raw_spans"}, logs.current())
+}
+
+func TestNewTopicSubscriptionSingleTopic(t *testing.T) {
+	s, err := newTopicSubscription(Config{Topic: "spans"}, signalTraces, zap.NewNop())
+	require.NoError(t, err)
+	assert.Nil(t, s)
+}
+
+func TestTracesReceiverTopicRegex(t *testing.T) {
+	lister := &testTopicLister{}
+	lister.setTopics("otlp_spans")
+	consumerGroup := &testSessionConsumerGroup{}
+
+	c, err := newTracesReceiver(Config{
+		TopicRegex:           "otlp_.*",
+		TopicRefreshInterval: 10 * time.Millisecond,
+	}, receivertest.NewNopCreateSettings(), newPdataTracesUnmarshaler(&ptrace.ProtoUnmarshaler{}, defaultEncoding), consumertest.NewNop())
+	require.NoError(t, err)
+	c.consumerGroup = consumerGroup
+	c.subscription.lister = lister
+
+	require.NoError(t, c.Start(context.Background(), componenttest.NewNopHost()))
+	assert.Eventually(t, func() bool {
+		return len(consumerGroup.consumedTopics()) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	lister.setTopics("otlp_spans", "otlp_spans_2", "other")
+	assert.Eventually(t, func() bool {
+		return len(consumerGroup.consumedTopics()) == 2
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, [][]string{{"otlp_spans"}, {"otlp_spans", "otlp_spans_2"}}, consumerGroup.consumedTopics())
+
+	require.NoError(t, c.Shutdown(context.Background()))
+	assert.True(t, lister.closed)
+}
+
+func TestLogsReceiverTopicRegexNoTopic(t *testing.T) {
+	lister := &testTopicLister{}
+	consumerGroup := &testSessionConsumerGroup{}
+
+	c, err := newLogsReceiver(Config{
+		TopicRegex:           "otlp_.*",
+		TopicRefreshInterval: 10 * time.Millisecond,
+	}, receivertest.NewNopCreateSettings(), newPdataLogsUnmarshaler(&plog.ProtoUnmarshaler{}, defaultEncoding), consumertest.NewNop())
+	require.NoError(t, err)
+	c.consumerGroup = consumerGroup
+	c.subscription.lister = lister
+
+	require.NoError(t, c.Start(context.Background(), componenttest.NewNopHost()))
+	assert.Empty(t, consumerGroup.consumedTopics())
+
+	lister.setTopics("otlp_logs")
+	assert.Eventually(t, func() bool {
+		return len(consumerGroup.consumedTopics()) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	require.NoError(t, c.Shutdown(context.Background()))
+}
+
+func TestLogsConsumerGroupHandlerTopicEncodings(t *testing.T) {
+	obsrecv, err := receiverhelper.NewObsReport(receiverhelper.ObsReportSettings{ReceiverCreateSettings: receivertest.NewNopCreateSettings()})
+	require.NoError(t, err)
+	sink := &consumertest.LogsSink{}
+	c := logsConsumerGroupHandler{
+		unmarshaler: newPdataLogsUnmarshaler(&plog.ProtoUnmarshaler{}, defaultEncoding),
+		topicUnmarshalers: []topicRoute[LogsUnmarshaler]{
+			{pattern: regexp.MustCompile("^raw_.*$"), value: newRawLogsUnmarshaler()},
+		},
+		logger:          zap.NewNop(),
+		ready:           make(chan bool),
+		nextConsumer:    sink,
+		obsrecv:         obsrecv,
+		headerExtractor: &nopHeaderExtractor{},
+	}
+
+	logs := plog.NewLogs()
+	logs.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Body().SetStr("otlp")
+	otlp, err := (&plog.ProtoMarshaler{}).MarshalLogs(logs)
+	require.NoError(t, err)
+
+	groupClaim := &testConsumerGroupClaim{
+		messageChan: make(chan *sarama.ConsumerMessage, 2),
+	}
+	groupClaim.messageChan <- &sarama.ConsumerMessage{Topic: "otlp_logs", Value: otlp}
+	groupClaim.messageChan <- &sarama.ConsumerMessage{Topic: "raw_logs", Value: []byte("raw")}
+	close(groupClaim.messageChan)
+	require.NoError(t, c.ConsumeClaim(testConsumerGroupSession{ctx: context.Background()}, groupClaim))
+
+	require.Len(t, sink.AllLogs(), 2)
+	assert.Equal(t, "otlp", sink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Body().Str())
+	assert.Equal(t, []byte("raw"), sink.AllLogs()[1].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Body().Bytes().AsRaw())
+}
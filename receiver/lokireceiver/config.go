@@ -29,6 +29,9 @@ type Config struct {
 	// Protocols is the configuration for the supported protocols, currently gRPC and HTTP (Proto and JSON).
 	Protocols     `mapstructure:"protocols"`
 	KeepTimestamp bool `mapstructure:"use_incoming_timestamp"`
+	// RateLimiter is the extension limiting the rate of the push requests per client, the requests exceeding the rate
+	// are rejected with a 429 status code over HTTP, and a ResourceExhausted status code over gRPC.
+	RateLimiter *component.ID `mapstructure:"rate_limiter"`
 }
 
 var _ component.Config = (*Config)(nil)
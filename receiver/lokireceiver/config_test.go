@@ -23,6 +23,7 @@ func TestLoadConfig(t *testing.T) {
 
 	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
 	require.NoError(t, err)
+	rateLimiterID := component.MustNewID("ratelimiter")
 
 	tests := []struct {
 		id       component.ID
@@ -59,6 +60,7 @@ func TestLoadConfig(t *testing.T) {
 					},
 				},
 				KeepTimestamp: true,
+				RateLimiter:   &rateLimiterID,
 			},
 		},
 	}
@@ -19,7 +19,11 @@ import (
 	"go.opentelemetry.io/collector/receiver/receiverhelper"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/ratelimiterextension"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/loki"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/lokireceiver/internal"
 )
@@ -39,6 +43,7 @@ type lokiReceiver struct {
 	serverHTTP   *http.Server
 	serverGRPC   *grpc.Server
 	shutdownWG   sync.WaitGroup
+	rateLimiter  ratelimiterextension.RateLimiter
 
 	obsrepGRPC *receiverhelper.ObsReport
 	obsrepHTTP *receiverhelper.ObsReport
@@ -153,6 +158,12 @@ func (r *lokiReceiver) startGRPCServer(ctx context.Context) error {
 }
 
 func (r *lokiReceiver) Push(ctx context.Context, pushRequest *push.PushRequest) (*push.PushResponse, error) {
+	if r.rateLimiter != nil {
+		md, _ := metadata.FromIncomingContext(ctx)
+		if err := r.rateLimiter.Limit(ctx, md); err != nil {
+			return nil, status.Error(codes.ResourceExhausted, err.Error())
+		}
+	}
 	logs, err := loki.PushRequestToLogs(pushRequest, r.conf.KeepTimestamp)
 	if err != nil {
 		r.settings.Logger.Warn(ErrAtLeastOneEntryFailedToProcess, zap.Error(err))
@@ -166,6 +177,17 @@ func (r *lokiReceiver) Push(ctx context.Context, pushRequest *push.PushRequest)
 }
 
 func (r *lokiReceiver) Start(ctx context.Context, host component.Host) error {
+	if r.conf.RateLimiter != nil {
+		ext, found := host.GetExtensions()[*r.conf.RateLimiter]
+		if !found {
+			return fmt.Errorf("rate limiter extension %q not found", *r.conf.RateLimiter)
+		}
+		rateLimiter, ok := ext.(ratelimiterextension.RateLimiter)
+		if !ok {
+			return fmt.Errorf("extension %q is not a rate limiter", *r.conf.RateLimiter)
+		}
+		r.rateLimiter = rateLimiter
+	}
 	return r.startProtocolsServers(ctx, host)
 }
 
@@ -202,6 +224,13 @@ func writeResponse(w http.ResponseWriter, contentType string, statusCode int, ms
 }
 
 func handleLogs(resp http.ResponseWriter, req *http.Request, r *lokiReceiver) {
+	if r.rateLimiter != nil {
+		if err := r.rateLimiter.Limit(req.Context(), req.Header); err != nil {
+			http.Error(resp, err.Error(), http.StatusTooManyRequests)
+			return
+		}
+	}
+
 	pushRequest, err := internal.ParseRequest(req)
 	if err != nil {
 		http.Error(resp, err.Error(), http.StatusBadRequest)
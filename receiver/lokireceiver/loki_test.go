@@ -19,17 +19,23 @@ import (
 	"github.com/grafana/loki/pkg/push"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/config/configgrpc"
 	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/config/confignet"
 	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/extension/extensiontest"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/receiver/receivertest"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/ratelimiterextension"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/common/testutil"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/plogtest"
 )
@@ -362,6 +368,94 @@ func TestSendingPushRequestToGRPCEndpoint(t *testing.T) {
 	}
 }
 
+func TestRateLimiter(t *testing.T) {
+	rateLimiterID := component.MustNewID("ratelimiter")
+	rateLimiterFactory := ratelimiterextension.NewFactory()
+	rateLimiterConfig := rateLimiterFactory.CreateDefaultConfig().(*ratelimiterextension.Config)
+	rateLimiterConfig.Rate = 1
+	rateLimiterConfig.Burst = 1
+	rateLimiterConfig.MetadataKey = "X-Scope-OrgID"
+	rateLimiter, err := rateLimiterFactory.CreateExtension(context.Background(), extensiontest.NewNopCreateSettings(), rateLimiterConfig)
+	require.NoError(t, err)
+	host := &mockHost{extensions: map[component.ID]component.Component{rateLimiterID: rateLimiter}}
+
+	httpAddr := testutil.GetAvailableLocalAddress(t)
+	config := &Config{
+		Protocols: Protocols{
+			GRPC: &configgrpc.ServerConfig{
+				NetAddr: confignet.AddrConfig{
+					Endpoint:  testutil.GetAvailableLocalAddress(t),
+					Transport: confignet.TransportTypeTCP,
+				},
+			},
+			HTTP: &confighttp.ServerConfig{
+				Endpoint: httpAddr,
+			},
+		},
+		RateLimiter: &rateLimiterID,
+	}
+	sink := new(consumertest.LogsSink)
+	lr, err := newLokiReceiver(config, sink, receivertest.NewNopCreateSettings())
+	require.NoError(t, err)
+	require.NoError(t, lr.Start(context.Background(), host))
+	t.Cleanup(func() { require.NoError(t, lr.Shutdown(context.Background())) })
+
+	body := []byte(`{"streams": [{"stream": {"foo": "bar"},"values": [["1676888496000000000", "logline 1"]]}]}`)
+	send := func(tenant string) int {
+		req, err := http.NewRequest("POST", fmt.Sprintf("http://%s/loki/api/v1/push", httpAddr), bytes.NewReader(body))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", jsonContentType)
+		req.Header.Set("X-Scope-OrgID", tenant)
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+	assert.Equal(t, http.StatusNoContent, send("a"))
+	assert.Equal(t, http.StatusTooManyRequests, send("a"))
+	assert.Equal(t, http.StatusNoContent, send("b"))
+
+	conn, err := grpc.Dial(config.GRPC.NetAddr.Endpoint, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithBlock())
+	require.NoError(t, err)
+	defer conn.Close()
+	client := push.NewPusherClient(conn)
+	pushRequest := &push.PushRequest{Streams: []push.Stream{{
+		Labels:  "{foo=\"bar\"}",
+		Entries: []push.Entry{{Timestamp: time.Unix(0, 1676888496000000000), Line: "logline 1"}},
+	}}}
+	_, err = client.Push(metadata.AppendToOutgoingContext(context.Background(), "X-Scope-OrgID", "b"), pushRequest)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	_, err = client.Push(metadata.AppendToOutgoingContext(context.Background(), "X-Scope-OrgID", "c"), pushRequest)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 3, len(sink.AllLogs()))
+}
+
+func TestRateLimiterNotFound(t *testing.T) {
+	rateLimiterID := component.MustNewID("ratelimiter")
+	config := &Config{
+		Protocols: Protocols{
+			HTTP: &confighttp.ServerConfig{
+				Endpoint: testutil.GetAvailableLocalAddress(t),
+			},
+		},
+		RateLimiter: &rateLimiterID,
+	}
+	lr, err := newLokiReceiver(config, consumertest.NewNop(), receivertest.NewNopCreateSettings())
+	require.NoError(t, err)
+	assert.EqualError(t, lr.Start(context.Background(), componenttest.NewNopHost()), `rate limiter extension "ratelimiter" not found`)
+	assert.NoError(t, lr.Shutdown(context.Background()))
+}
+
+type mockHost struct {
+	component.Host
+	extensions map[component.ID]component.Component
+}
+
+func (h *mockHost) GetExtensions() map[component.ID]component.Component {
+	return h.extensions
+}
+
 type Log struct {
 	Timestamp  int64
 	Body       pcommon.Value
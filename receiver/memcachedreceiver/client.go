@@ -4,7 +4,11 @@
 package memcachedreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/memcachedreceiver"
 
 import (
+	"bufio"
+	"errors"
+	"fmt"
 	"net"
+	"strings"
 	"time"
 
 	"github.com/grobie/gomemcache/memcache"
@@ -14,10 +18,10 @@ type client interface {
 	Stats() (map[net.Addr]memcache.Stats, error)
 }
 
-type newMemcachedClientFunc func(endpoint string, timeout time.Duration) (client, error)
+type newMemcachedClientFunc func(endpoints []string, timeout time.Duration) (client, error)
 
-func newMemcachedClient(endpoint string, timeout time.Duration) (client, error) {
-	newClient, err := memcache.New(endpoint)
+func newMemcachedClient(endpoints []string, timeout time.Duration) (client, error) {
+	newClient, err := memcache.New(endpoints...)
 	if err != nil {
 		return nil, err
 	}
@@ -25,3 +29,64 @@ func newMemcachedClient(endpoint string, timeout time.Duration) (client, error)
 	newClient.Timeout = timeout
 	return newClient, nil
 }
+
+type discoverNodesFunc func(endpoint string, timeout time.Duration) ([]string, error)
+
+// discoverNodes returns the addresses of the nodes of the cluster, as listed by the
+// "config get cluster" command of the configuration endpoint of Amazon ElastiCache.
+func discoverNodes(endpoint string, timeout time.Duration) ([]string, error) {
+	conn, err := net.DialTimeout("tcp", endpoint, timeout)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	if err = conn.SetDeadline(time.Now().Add(timeout)); err != nil {
+		return nil, err
+	}
+	if _, err = fmt.Fprint(conn, "config get cluster\r\n"); err != nil {
+		return nil, err
+	}
+
+	var lines []string
+	scanner := bufio.NewScanner(conn)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "END" {
+			return parseClusterConfig(lines)
+		}
+		lines = append(lines, line)
+	}
+	if err = scanner.Err(); err != nil {
+		return nil, err
+	}
+	return nil, errors.New("unexpected end of the cluster config")
+}
+
+// parseClusterConfig parses the response of the "config get cluster" command:
+//
+//	CONFIG cluster 0 <length>
+//	<version>
+//	<hostname>|<ip>|<port> <hostname>|<ip>|<port> ...
+func parseClusterConfig(lines []string) ([]string, error) {
+	if len(lines) < 3 || !strings.HasPrefix(lines[0], "CONFIG cluster") {
+		return nil, fmt.Errorf("unexpected cluster config: %q", strings.Join(lines, "\n"))
+	}
+
+	var nodes []string
+	for _, node := range strings.Fields(lines[2]) {
+		fields := strings.Split(node, "|")
+		if len(fields) != 3 {
+			return nil, fmt.Errorf("unexpected node in the cluster config: %q", node)
+		}
+		host := fields[1]
+		if host == "" {
+			host = fields[0]
+		}
+		nodes = append(nodes, net.JoinHostPort(host, fields[2]))
+	}
+	if len(nodes) == 0 {
+		return nil, errors.New("no nodes in the cluster config")
+	}
+	return nodes, nil
+}
@@ -0,0 +1,68 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package memcachedreceiver
+
+import (
+	"bufio"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiscoverNodes(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		command, _ := bufio.NewReader(conn).ReadString('\n')
+		if command != "config get cluster\r\n" {
+			_, _ = conn.Write([]byte("ERROR\r\n"))
+			return
+		}
+		_, _ = conn.Write([]byte("CONFIG cluster 0 147\r\n12\n" +
+			"mycluster.0001.cache.amazonaws.com|10.82.235.120|11211 mycluster.0002.cache.amazonaws.com||11211\n\r\nEND\r\n"))
+	}()
+
+	nodes, err := discoverNodes(listener.Addr().String(), time.Second)
+	require.NoError(t, err)
+	require.Equal(t, []string{"10.82.235.120:11211", "mycluster.0002.cache.amazonaws.com:11211"}, nodes)
+}
+
+func TestParseClusterConfig(t *testing.T) {
+	testCases := []struct {
+		name   string
+		lines  []string
+		errMsg string
+	}{
+		{
+			name:   "not a cluster config",
+			lines:  []string{"ERROR"},
+			errMsg: `unexpected cluster config: "ERROR"`,
+		},
+		{
+			name:   "bad node",
+			lines:  []string{"CONFIG cluster 0 10", "1", "host:11211"},
+			errMsg: `unexpected node in the cluster config: "host:11211"`,
+		},
+		{
+			name:   "no nodes",
+			lines:  []string{"CONFIG cluster 0 2", "1", ""},
+			errMsg: "no nodes in the cluster config",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := parseClusterConfig(tc.lines)
+			require.EqualError(t, err, tc.errMsg)
+		})
+	}
+}
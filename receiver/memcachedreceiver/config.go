@@ -14,6 +14,10 @@ type Config struct {
 	scraperhelper.ControllerConfig `mapstructure:",squash"`
 	confignet.AddrConfig           `mapstructure:",squash"`
 
+	// AutoDiscovery discovers the nodes of the cluster from the configuration endpoint,
+	// as supported by Amazon ElastiCache, and scrapes each of them.
+	AutoDiscovery bool `mapstructure:"auto_discovery"`
+
 	// MetricsBuilderConfig allows customizing scraped metrics/attributes representation.
 	metadata.MetricsBuilderConfig `mapstructure:",squash"`
 }
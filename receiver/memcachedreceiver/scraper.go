@@ -21,6 +21,7 @@ type memcachedScraper struct {
 	config    *Config
 	mb        *metadata.MetricsBuilder
 	newClient newMemcachedClientFunc
+	discover  discoverNodesFunc
 }
 
 func newMemcachedScraper(
@@ -31,6 +32,7 @@ func newMemcachedScraper(
 		logger:    settings.Logger,
 		config:    config,
 		newClient: newMemcachedClient,
+		discover:  discoverNodes,
 		mb:        metadata.NewMetricsBuilder(config.MetricsBuilderConfig, settings),
 	}
 }
@@ -38,7 +40,17 @@ func newMemcachedScraper(
 func (r *memcachedScraper) scrape(_ context.Context) (pmetric.Metrics, error) {
 	// Init client in scrape method in case there are transient errors in the
 	// constructor.
-	statsClient, err := r.newClient(r.config.Endpoint, r.config.Timeout)
+	endpoints := []string{r.config.Endpoint}
+	if r.config.AutoDiscovery {
+		nodes, err := r.discover(r.config.Endpoint, r.config.Timeout)
+		if err != nil {
+			r.logger.Error("Failed to discover the nodes of the cluster", zap.Error(err))
+			return pmetric.Metrics{}, err
+		}
+		endpoints = nodes
+	}
+
+	statsClient, err := r.newClient(endpoints, r.config.Timeout)
 	if err != nil {
 		r.logger.Error("Failed to establish client", zap.Error(err))
 		return pmetric.Metrics{}, err
@@ -52,7 +64,7 @@ func (r *memcachedScraper) scrape(_ context.Context) (pmetric.Metrics, error) {
 
 	now := pcommon.NewTimestampFromTime(time.Now())
 
-	for _, stats := range allServerStats {
+	for addr, stats := range allServerStats {
 		for k, v := range stats.Stats {
 			switch k {
 			case "bytes":
@@ -167,6 +179,10 @@ func (r *memcachedScraper) scrape(_ context.Context) (pmetric.Metrics, error) {
 		if okHit && okMiss {
 			r.mb.RecordMemcachedOperationHitRatioDataPoint(now, calculateHitRatio(parsedHit, parsedMiss), metadata.AttributeOperationGet)
 		}
+
+		rb := r.mb.NewResourceBuilder()
+		rb.SetMemcachedNodeAddress(addr.String())
+		r.mb.EmitForResource(metadata.WithResource(rb.Emit()))
 	}
 
 	return r.mb.Emit(), nil
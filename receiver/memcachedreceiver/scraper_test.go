@@ -5,6 +5,7 @@ package memcachedreceiver
 
 import (
 	"context"
+	"errors"
 	"path/filepath"
 	"testing"
 	"time"
@@ -20,7 +21,7 @@ func TestScraper(t *testing.T) {
 	f := NewFactory()
 	cfg := f.CreateDefaultConfig().(*Config)
 	scraper := newMemcachedScraper(receivertest.NewNopCreateSettings(), cfg)
-	scraper.newClient = func(string, time.Duration) (client, error) {
+	scraper.newClient = func([]string, time.Duration) (client, error) {
 		return &fakeClient{}, nil
 	}
 
@@ -34,3 +35,30 @@ func TestScraper(t *testing.T) {
 	require.NoError(t, pmetrictest.CompareMetrics(expectedMetrics, actualMetrics,
 		pmetrictest.IgnoreMetricDataPointsOrder(), pmetrictest.IgnoreStartTimestamp(), pmetrictest.IgnoreTimestamp()))
 }
+
+func TestScraperAutoDiscovery(t *testing.T) {
+	f := NewFactory()
+	cfg := f.CreateDefaultConfig().(*Config)
+	cfg.Endpoint = "cluster.cfg.cache.amazonaws.com:11211"
+	cfg.AutoDiscovery = true
+	scraper := newMemcachedScraper(receivertest.NewNopCreateSettings(), cfg)
+	scraper.discover = func(endpoint string, _ time.Duration) ([]string, error) {
+		require.Equal(t, cfg.Endpoint, endpoint)
+		return []string{"10.0.0.1:11211", "10.0.0.2:11211"}, nil
+	}
+	var endpoints []string
+	scraper.newClient = func(e []string, _ time.Duration) (client, error) {
+		endpoints = e
+		return &fakeClient{}, nil
+	}
+
+	_, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []string{"10.0.0.1:11211", "10.0.0.2:11211"}, endpoints)
+
+	scraper.discover = func(string, time.Duration) ([]string, error) {
+		return nil, errors.New("no nodes in the cluster config")
+	}
+	_, err = scraper.scrape(context.Background())
+	require.EqualError(t, err, "no nodes in the cluster config")
+}
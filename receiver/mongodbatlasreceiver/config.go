@@ -51,8 +51,9 @@ type AlertConfig struct {
 }
 
 type LogConfig struct {
-	Enabled  bool                 `mapstructure:"enabled"`
-	Projects []*LogsProjectConfig `mapstructure:"projects"`
+	Enabled      bool                 `mapstructure:"enabled"`
+	Projects     []*LogsProjectConfig `mapstructure:"projects"`
+	PollInterval time.Duration        `mapstructure:"poll_interval"`
 }
 
 // EventsConfig is the configuration options for events collection
@@ -123,9 +124,10 @@ var (
 	errPageSizeIncorrect = errors.New("page size must be a value between 1 and 500")
 
 	// Logs Receiver Errors
-	errNoProjects    = errors.New("at least one 'project' must be specified")
-	errNoEvents      = errors.New("at least one 'project' or 'organizations' event type must be specified")
-	errClusterConfig = errors.New("only one of 'include_clusters' or 'exclude_clusters' may be specified")
+	errNoProjects       = errors.New("at least one 'project' must be specified")
+	errNoEvents         = errors.New("at least one 'project' or 'organizations' event type must be specified")
+	errClusterConfig    = errors.New("only one of 'include_clusters' or 'exclude_clusters' may be specified")
+	errLogsPollInterval = errors.New("'poll_interval' must be greater than 0")
 
 	// Access Logs Errors
 	errMaxPageSize = errors.New("the maximum value for 'page_size' is 20000")
@@ -159,6 +161,10 @@ func (l *LogConfig) validate() error {
 		errs = multierr.Append(errs, errNoProjects)
 	}
 
+	if l.PollInterval <= 0 {
+		errs = multierr.Append(errs, errLogsPollInterval)
+	}
+
 	for _, project := range l.Projects {
 		if len(project.ExcludeClusters) != 0 && len(project.IncludeClusters) != 0 {
 			errs = multierr.Append(errs, errClusterConfig)
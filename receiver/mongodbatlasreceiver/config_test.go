@@ -161,7 +161,8 @@ func TestValidate(t *testing.T) {
 			name: "Valid Logs Config",
 			input: Config{
 				Logs: LogConfig{
-					Enabled: true,
+					Enabled:      true,
+					PollInterval: defaultLogsPollInterval,
 					Projects: []*LogsProjectConfig{
 						{
 							ProjectConfig: ProjectConfig{
@@ -185,10 +186,28 @@ func TestValidate(t *testing.T) {
 			expectedErr: errNoProjects.Error(),
 		},
 		{
-			name: "Invalid ProjectConfig",
+			name: "Invalid Logs Config - no poll interval",
 			input: Config{
 				Logs: LogConfig{
 					Enabled: true,
+					Projects: []*LogsProjectConfig{
+						{
+							ProjectConfig: ProjectConfig{
+								Name: "Project1",
+							},
+						},
+					},
+				},
+				ControllerConfig: scraperhelper.NewDefaultControllerConfig(),
+			},
+			expectedErr: errLogsPollInterval.Error(),
+		},
+		{
+			name: "Invalid ProjectConfig",
+			input: Config{
+				Logs: LogConfig{
+					Enabled:      true,
+					PollInterval: defaultLogsPollInterval,
 					Projects: []*LogsProjectConfig{
 						{
 							ProjectConfig: ProjectConfig{
@@ -315,7 +334,8 @@ func TestValidate(t *testing.T) {
 			name: "Invalid Access Logs Config - bad project config",
 			input: Config{
 				Logs: LogConfig{
-					Enabled: true,
+					Enabled:      true,
+					PollInterval: defaultLogsPollInterval,
 					Projects: []*LogsProjectConfig{
 						{
 							ProjectConfig: ProjectConfig{
@@ -379,6 +399,7 @@ func TestLoadConfig(t *testing.T) {
 				EnableAuditLogs: true,
 			},
 		},
+		PollInterval: 10 * time.Minute,
 	}
 	expected.Alerts = AlertConfig{
 		Enabled: true,
@@ -107,8 +107,9 @@ func createDefaultConfig() component.Config {
 			MaxPages:     defaultAlertsMaxPages,
 		},
 		Logs: LogConfig{
-			Enabled:  defaultLogsEnabled,
-			Projects: []*LogsProjectConfig{},
+			Enabled:      defaultLogsEnabled,
+			Projects:     []*LogsProjectConfig{},
+			PollInterval: defaultLogsPollInterval,
 		},
 	}
 	// reset default of 1 minute to be 3 minutes in order to avoid null values for some metrics that do not publish
@@ -41,7 +41,7 @@ type ProjectContext struct {
 }
 
 // MongoDB Atlas Documentation reccommends a polling interval of 5  minutes: https://www.mongodb.com/docs/atlas/reference/api/logs/#logs
-const collectionInterval = time.Minute * 5
+const defaultLogsPollInterval = time.Minute * 5
 
 func newMongoDBAtlasLogsReceiver(settings rcvr.CreateSettings, cfg *Config, consumer consumer.Logs) *logsReceiver {
 	client := internal.NewMongoDBAtlasClient(cfg.PublicKey, string(cfg.PrivateKey), cfg.BackOffConfig, settings.Logger)
@@ -64,7 +64,7 @@ func (s *logsReceiver) Start(ctx context.Context, _ component.Host) error {
 	s.wg.Add(1)
 	go func() {
 		defer s.wg.Done()
-		s.start = time.Now().Add(-collectionInterval)
+		s.start = time.Now().Add(-s.cfg.Logs.PollInterval)
 		s.end = time.Now()
 		for {
 			s.collect(ctx)
@@ -74,7 +74,7 @@ func (s *logsReceiver) Start(ctx context.Context, _ component.Host) error {
 				return
 			case <-s.stopperChan:
 				return
-			case <-time.After(collectionInterval):
+			case <-time.After(s.cfg.Logs.PollInterval):
 				s.start = s.end
 				s.end = time.Now()
 			}
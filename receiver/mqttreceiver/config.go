@@ -0,0 +1,65 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mqttreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/mqttreceiver"
+
+import (
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/collector/component"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/mqtt"
+)
+
+// Config defines the configuration of the MQTT receiver.
+type Config struct {
+	mqtt.ClientConfig `mapstructure:",squash"`
+
+	// Encoding is the encoding of the payloads of the messages: otlp_proto or otlp_json.
+	Encoding string `mapstructure:"encoding"`
+
+	// Logs defines the subscription of the logs.
+	Logs SubscriptionConfig `mapstructure:"logs"`
+	// Metrics defines the subscription of the metrics.
+	Metrics SubscriptionConfig `mapstructure:"metrics"`
+	// Traces defines the subscription of the traces.
+	Traces SubscriptionConfig `mapstructure:"traces"`
+}
+
+// SubscriptionConfig defines the subscription a signal is received with.
+type SubscriptionConfig struct {
+	// Topic is the topic filter of the subscription, which can contain the wildcards '+' and
+	// '#', e.g. gateways/+/logs. The shared subscriptions $share/<group>/<filter> balance the
+	// messages between the receivers of the group.
+	Topic string `mapstructure:"topic"`
+
+	// QoS is the maximum quality of service of the messages: 0 (at most once), 1 (at least once)
+	// or 2 (exactly once).
+	QoS int `mapstructure:"qos"`
+}
+
+var _ component.Config = (*Config)(nil)
+
+// Validate checks if the receiver configuration is valid.
+func (cfg *Config) Validate() error {
+	var errs []error
+	if _, ok := unmarshalers[cfg.Encoding]; !ok {
+		errs = append(errs, fmt.Errorf("unsupported 'encoding' '%s', must be one of 'otlp_proto' and 'otlp_json'", cfg.Encoding))
+	}
+	errs = append(errs, cfg.Logs.validate("logs"))
+	errs = append(errs, cfg.Metrics.validate("metrics"))
+	errs = append(errs, cfg.Traces.validate("traces"))
+	return errors.Join(errs...)
+}
+
+func (cfg SubscriptionConfig) validate(signal string) error {
+	var errs []error
+	if cfg.Topic == "" {
+		errs = append(errs, fmt.Errorf("'%s.topic' must not be empty", signal))
+	} else if err := validateTopicFilter(cfg.Topic); err != nil {
+		errs = append(errs, fmt.Errorf("invalid '%s.topic' '%s': %w", signal, cfg.Topic, err))
+	}
+	errs = append(errs, mqtt.ValidateQoS(signal+".qos", cfg.QoS))
+	return errors.Join(errs...)
+}
@@ -0,0 +1,75 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mqttreceiver
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/mqtt"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/mqttreceiver/internal/metadata"
+)
+
+func TestLoadConfig(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+	factory := NewFactory()
+
+	tests := []struct {
+		id       component.ID
+		expected func(*Config)
+	}{
+		{
+			id:       component.NewIDWithName(metadata.Type, ""),
+			expected: func(*Config) {},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "customname"),
+			expected: func(cfg *Config) {
+				cfg.Endpoint = "wss://broker.example.com/mqtt"
+				cfg.ClientID = "collector-1"
+				cfg.Auth = mqtt.AuthConfig{Username: "collector", Password: "secret"}
+				cfg.SessionExpiryInterval = time.Hour
+				cfg.Encoding = "otlp_json"
+				cfg.Logs = SubscriptionConfig{Topic: "$share/collectors/gateways/+/logs", QoS: 2}
+				cfg.Metrics = SubscriptionConfig{Topic: "gateways/+/metrics", QoS: 1}
+				cfg.Traces = SubscriptionConfig{Topic: "gateways/+/traces", QoS: 0}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			cfg := factory.CreateDefaultConfig()
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, component.UnmarshalConfig(sub, cfg))
+			require.NoError(t, component.ValidateConfig(cfg))
+
+			expected := factory.CreateDefaultConfig().(*Config)
+			tt.expected(expected)
+			assert.Equal(t, expected, cfg)
+		})
+	}
+}
+
+func TestConfigValidate(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+	cfg := NewFactory().CreateDefaultConfig().(*Config)
+	sub, err := cm.Sub(component.NewIDWithName(metadata.Type, "invalid").String())
+	require.NoError(t, err)
+	require.NoError(t, component.UnmarshalConfig(sub, cfg))
+
+	assert.EqualError(t, cfg.Validate(), "unsupported 'encoding' 'avro', must be one of 'otlp_proto' and 'otlp_json'\n"+
+		"invalid 'logs.topic' 'gateways/#/logs': the wildcard '#' must be the last level\n"+
+		"invalid 'metrics.topic' '$share/gateways/+/metrics-group+': the wildcards '+' and '#' must occupy an entire level\n"+
+		"unsupported 'metrics.qos' -1, must be 0, 1 or 2\n"+
+		"invalid 'traces.topic' '$share/traces': shared subscriptions must be $share/<group>/<filter>")
+}
@@ -0,0 +1,9 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:generate mdatagen metadata.yaml
+
+// Package mqttreceiver subscribes to the topics of an MQTT broker with the MQTT v5 protocol, and
+// receives the logs, the metrics and the traces published to them, e.g. by the MQTT exporter of
+// IoT gateways.
+package mqttreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/mqttreceiver"
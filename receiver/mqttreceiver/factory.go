@@ -0,0 +1,106 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mqttreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/mqttreceiver"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/receiver/receiverhelper"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/mqtt"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/sharedcomponent"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/mqttreceiver/internal/metadata"
+)
+
+const (
+	reportTransport = "mqtt"
+
+	defaultQoS = 1
+)
+
+// NewFactory creates a factory for the MQTT receiver.
+func NewFactory() receiver.Factory {
+	return receiver.NewFactory(
+		metadata.Type,
+		createDefaultConfig,
+		receiver.WithLogs(createLogsReceiver, metadata.LogsStability),
+		receiver.WithMetrics(createMetricsReceiver, metadata.MetricsStability),
+		receiver.WithTraces(createTracesReceiver, metadata.TracesStability))
+}
+
+func createDefaultConfig() component.Config {
+	return &Config{
+		ClientConfig: mqtt.NewDefaultClientConfig(),
+		Encoding:     "otlp_proto",
+		Logs:         SubscriptionConfig{Topic: "otel/logs", QoS: defaultQoS},
+		Metrics:      SubscriptionConfig{Topic: "otel/metrics", QoS: defaultQoS},
+		Traces:       SubscriptionConfig{Topic: "otel/traces", QoS: defaultQoS},
+	}
+}
+
+func createLogsReceiver(
+	_ context.Context,
+	set receiver.CreateSettings,
+	cfg component.Config,
+	nextConsumer consumer.Logs,
+) (receiver.Logs, error) {
+	r, err := getOrCreateMQTTReceiver(cfg.(*Config), set)
+	if err != nil {
+		return nil, err
+	}
+	r.Unwrap().(*mqttReceiver).logsConsumer = nextConsumer
+	return r, nil
+}
+
+func createMetricsReceiver(
+	_ context.Context,
+	set receiver.CreateSettings,
+	cfg component.Config,
+	nextConsumer consumer.Metrics,
+) (receiver.Metrics, error) {
+	r, err := getOrCreateMQTTReceiver(cfg.(*Config), set)
+	if err != nil {
+		return nil, err
+	}
+	r.Unwrap().(*mqttReceiver).metricsConsumer = nextConsumer
+	return r, nil
+}
+
+func createTracesReceiver(
+	_ context.Context,
+	set receiver.CreateSettings,
+	cfg component.Config,
+	nextConsumer consumer.Traces,
+) (receiver.Traces, error) {
+	r, err := getOrCreateMQTTReceiver(cfg.(*Config), set)
+	if err != nil {
+		return nil, err
+	}
+	r.Unwrap().(*mqttReceiver).tracesConsumer = nextConsumer
+	return r, nil
+}
+
+// getOrCreateMQTTReceiver returns the receiver of the configuration, the signals sharing its
+// connection since the broker only accepts a single connection per client ID.
+func getOrCreateMQTTReceiver(cfg *Config, set receiver.CreateSettings) (*sharedcomponent.SharedComponent, error) {
+	obsrecv, err := receiverhelper.NewObsReport(receiverhelper.ObsReportSettings{
+		ReceiverID:             set.ID,
+		Transport:              reportTransport,
+		ReceiverCreateSettings: set,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return receivers.GetOrAdd(cfg, func() component.Component {
+		return newMQTTReceiver(cfg, set.Logger, obsrecv)
+	}), nil
+}
+
+// This is the map of already created MQTT receivers for particular configurations.
+// We maintain this map because the Factory is asked log, metric and trace receivers
+// separately but they must share a connection to the broker.
+var receivers = sharedcomponent.NewSharedComponents()
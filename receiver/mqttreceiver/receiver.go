@@ -0,0 +1,149 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mqttreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/mqttreceiver"
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/eclipse/paho.golang/autopaho"
+	"github.com/eclipse/paho.golang/paho"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/receiver/receiverhelper"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/mqtt"
+)
+
+type mqttReceiver struct {
+	config      *Config
+	logger      *zap.Logger
+	obsrecv     *receiverhelper.ObsReport
+	unmarshaler unmarshaler
+
+	logsConsumer    consumer.Logs
+	metricsConsumer consumer.Metrics
+	tracesConsumer  consumer.Traces
+
+	conn *autopaho.ConnectionManager
+}
+
+func newMQTTReceiver(config *Config, logger *zap.Logger, obsrecv *receiverhelper.ObsReport) *mqttReceiver {
+	return &mqttReceiver{
+		config:      config,
+		logger:      logger,
+		obsrecv:     obsrecv,
+		unmarshaler: unmarshalers[config.Encoding],
+	}
+}
+
+func (r *mqttReceiver) Start(ctx context.Context, _ component.Host) error {
+	conn, err := mqtt.NewConnection(ctx, r.config.ClientConfig, r.logger, r.subscribe, r.handlePublish)
+	if err != nil {
+		return err
+	}
+	r.conn = conn
+	return nil
+}
+
+func (r *mqttReceiver) Shutdown(ctx context.Context) error {
+	if r.conn == nil {
+		return nil
+	}
+	return r.conn.Disconnect(ctx)
+}
+
+// subscriptions returns the subscriptions of the signals the receiver has a consumer of.
+func (r *mqttReceiver) subscriptions() []paho.SubscribeOptions {
+	var subscriptions []paho.SubscribeOptions
+	if r.logsConsumer != nil {
+		subscriptions = append(subscriptions, paho.SubscribeOptions{Topic: r.config.Logs.Topic, QoS: byte(r.config.Logs.QoS)})
+	}
+	if r.metricsConsumer != nil {
+		subscriptions = append(subscriptions, paho.SubscribeOptions{Topic: r.config.Metrics.Topic, QoS: byte(r.config.Metrics.QoS)})
+	}
+	if r.tracesConsumer != nil {
+		subscriptions = append(subscriptions, paho.SubscribeOptions{Topic: r.config.Traces.Topic, QoS: byte(r.config.Traces.QoS)})
+	}
+	return subscriptions
+}
+
+// subscribe subscribes to the topics on every connection, the broker only keeping the
+// subscriptions of the sessions which did not expire.
+func (r *mqttReceiver) subscribe(cm *autopaho.ConnectionManager) {
+	ctx, cancel := context.WithTimeout(context.Background(), r.config.ConnectTimeout)
+	defer cancel()
+	subscriptions := r.subscriptions()
+	suback, err := cm.Subscribe(ctx, &paho.Subscribe{Subscriptions: subscriptions})
+	if err != nil {
+		r.logger.Error("Failed to subscribe to the topics", zap.Error(err))
+		return
+	}
+	for i, reason := range suback.Reasons {
+		// the reason codes from 0x80 are failures, the others being the granted QoS
+		if reason >= 0x80 && i < len(subscriptions) {
+			r.logger.Error("The broker refused the subscription",
+				zap.String("topic", subscriptions[i].Topic), zap.Uint8("reason_code", reason))
+		}
+	}
+}
+
+// handlePublish passes the data of a message to the consumer of its signal. The messages are
+// acknowledged once handled, so the data failing to be consumed is dropped.
+func (r *mqttReceiver) handlePublish(pr paho.PublishReceived) (bool, error) {
+	topic := pr.Packet.Topic
+	var err error
+	switch {
+	case r.logsConsumer != nil && topicMatches(r.config.Logs.Topic, topic):
+		err = r.consumeLogs(context.Background(), pr.Packet.Payload)
+	case r.metricsConsumer != nil && topicMatches(r.config.Metrics.Topic, topic):
+		err = r.consumeMetrics(context.Background(), pr.Packet.Payload)
+	case r.tracesConsumer != nil && topicMatches(r.config.Traces.Topic, topic):
+		err = r.consumeTraces(context.Background(), pr.Packet.Payload)
+	default:
+		r.logger.Debug("Dropping a message of a topic no signal subscribed to", zap.String("topic", topic))
+		return true, nil
+	}
+	if err != nil {
+		r.logger.Error("Failed to consume a message", zap.String("topic", topic), zap.Error(err))
+	}
+	return true, nil
+}
+
+func (r *mqttReceiver) consumeLogs(ctx context.Context, payload []byte) error {
+	ctx = r.obsrecv.StartLogsOp(ctx)
+	logs, err := r.unmarshaler.logs.UnmarshalLogs(payload)
+	if err != nil {
+		r.obsrecv.EndLogsOp(ctx, r.config.Encoding, 0, err)
+		return fmt.Errorf("failed to unmarshal the logs: %w", err)
+	}
+	err = r.logsConsumer.ConsumeLogs(ctx, logs)
+	r.obsrecv.EndLogsOp(ctx, r.config.Encoding, logs.LogRecordCount(), err)
+	return err
+}
+
+func (r *mqttReceiver) consumeMetrics(ctx context.Context, payload []byte) error {
+	ctx = r.obsrecv.StartMetricsOp(ctx)
+	metrics, err := r.unmarshaler.metrics.UnmarshalMetrics(payload)
+	if err != nil {
+		r.obsrecv.EndMetricsOp(ctx, r.config.Encoding, 0, err)
+		return fmt.Errorf("failed to unmarshal the metrics: %w", err)
+	}
+	err = r.metricsConsumer.ConsumeMetrics(ctx, metrics)
+	r.obsrecv.EndMetricsOp(ctx, r.config.Encoding, metrics.DataPointCount(), err)
+	return err
+}
+
+func (r *mqttReceiver) consumeTraces(ctx context.Context, payload []byte) error {
+	ctx = r.obsrecv.StartTracesOp(ctx)
+	traces, err := r.unmarshaler.traces.UnmarshalTraces(payload)
+	if err != nil {
+		r.obsrecv.EndTracesOp(ctx, r.config.Encoding, 0, err)
+		return fmt.Errorf("failed to unmarshal the traces: %w", err)
+	}
+	err = r.tracesConsumer.ConsumeTraces(ctx, traces)
+	r.obsrecv.EndTracesOp(ctx, r.config.Encoding, traces.SpanCount(), err)
+	return err
+}
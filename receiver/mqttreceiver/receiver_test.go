@@ -0,0 +1,120 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mqttreceiver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/eclipse/paho.golang/paho"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/receiver/receiverhelper"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	"go.uber.org/zap"
+)
+
+func newTestReceiver(t *testing.T, configure func(*Config)) *mqttReceiver {
+	cfg := createDefaultConfig().(*Config)
+	configure(cfg)
+	require.NoError(t, cfg.Validate())
+	set := receivertest.NewNopCreateSettings()
+	obsrecv, err := receiverhelper.NewObsReport(receiverhelper.ObsReportSettings{
+		ReceiverID:             set.ID,
+		Transport:              reportTransport,
+		ReceiverCreateSettings: set,
+	})
+	require.NoError(t, err)
+	return newMQTTReceiver(cfg, zap.NewNop(), obsrecv)
+}
+
+func publishReceived(topic string, payload []byte) paho.PublishReceived {
+	return paho.PublishReceived{Packet: &paho.Publish{Topic: topic, Payload: payload}}
+}
+
+func TestHandlePublish(t *testing.T) {
+	rcvr := newTestReceiver(t, func(cfg *Config) {
+		cfg.Logs.Topic = "gateways/+/logs"
+		cfg.Metrics.Topic = "gateways/+/metrics"
+		cfg.Traces.Topic = "gateways/+/traces"
+	})
+	logsSink := new(consumertest.LogsSink)
+	metricsSink := new(consumertest.MetricsSink)
+	tracesSink := new(consumertest.TracesSink)
+	rcvr.logsConsumer = logsSink
+	rcvr.metricsConsumer = metricsSink
+	rcvr.tracesConsumer = tracesSink
+
+	ld := plog.NewLogs()
+	ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Body().SetStr("door opened")
+	payload, err := (&plog.ProtoMarshaler{}).MarshalLogs(ld)
+	require.NoError(t, err)
+	handled, err := rcvr.handlePublish(publishReceived("gateways/gateway-42/logs", payload))
+	require.NoError(t, err)
+	assert.True(t, handled)
+	require.Len(t, logsSink.AllLogs(), 1)
+	assert.Equal(t, ld, logsSink.AllLogs()[0])
+
+	md := pmetric.NewMetrics()
+	m := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+	m.SetName("temperature")
+	m.SetEmptyGauge().DataPoints().AppendEmpty().SetDoubleValue(21.5)
+	payload, err = (&pmetric.ProtoMarshaler{}).MarshalMetrics(md)
+	require.NoError(t, err)
+	_, err = rcvr.handlePublish(publishReceived("gateways/gateway-42/metrics", payload))
+	require.NoError(t, err)
+	require.Len(t, metricsSink.AllMetrics(), 1)
+	assert.Equal(t, md, metricsSink.AllMetrics()[0])
+
+	td := ptrace.NewTraces()
+	td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty().SetName("read")
+	payload, err = (&ptrace.ProtoMarshaler{}).MarshalTraces(td)
+	require.NoError(t, err)
+	_, err = rcvr.handlePublish(publishReceived("gateways/gateway-42/traces", payload))
+	require.NoError(t, err)
+	require.Len(t, tracesSink.AllTraces(), 1)
+	assert.Equal(t, td, tracesSink.AllTraces()[0])
+
+	// the messages of the other topics, and the invalid payloads, are dropped
+	_, err = rcvr.handlePublish(publishReceived("gateways/gateway-42/events", payload))
+	require.NoError(t, err)
+	_, err = rcvr.handlePublish(publishReceived("gateways/gateway-42/logs", []byte("door opened")))
+	require.NoError(t, err)
+	assert.Len(t, logsSink.AllLogs(), 1)
+	assert.Len(t, metricsSink.AllMetrics(), 1)
+	assert.Len(t, tracesSink.AllTraces(), 1)
+}
+
+func TestHandlePublishJSON(t *testing.T) {
+	rcvr := newTestReceiver(t, func(cfg *Config) {
+		cfg.Encoding = "otlp_json"
+	})
+	logsSink := new(consumertest.LogsSink)
+	rcvr.logsConsumer = logsSink
+
+	ld := plog.NewLogs()
+	rl := ld.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().PutStr("host.name", "gateway-42")
+	rl.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Body().SetStr("door opened")
+	payload, err := (&plog.JSONMarshaler{}).MarshalLogs(ld)
+	require.NoError(t, err)
+	_, err = rcvr.handlePublish(publishReceived("otel/logs", payload))
+	require.NoError(t, err)
+	require.Len(t, logsSink.AllLogs(), 1)
+	assert.Equal(t, ld, logsSink.AllLogs()[0])
+
+	// the signals without consumers are not subscribed to
+	_, err = rcvr.handlePublish(publishReceived("otel/metrics", payload))
+	require.NoError(t, err)
+	assert.Len(t, rcvr.subscriptions(), 1)
+}
+
+func TestShutdownWithoutStart(t *testing.T) {
+	rcvr := newTestReceiver(t, func(*Config) {})
+	assert.NoError(t, rcvr.Shutdown(context.Background()))
+}
@@ -0,0 +1,71 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mqttreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/mqttreceiver"
+
+import (
+	"errors"
+	"strings"
+)
+
+const sharedSubscriptionPrefix = "$share/"
+
+// validateTopicFilter checks if the wildcards of the topic filter occupy entire levels, the '#'
+// being the last one.
+func validateTopicFilter(filter string) error {
+	filter, err := unshare(filter)
+	if err != nil {
+		return err
+	}
+	levels := strings.Split(filter, "/")
+	for i, level := range levels {
+		if strings.ContainsAny(level, "+#") && len(level) > 1 {
+			return errors.New("the wildcards '+' and '#' must occupy an entire level")
+		}
+		if level == "#" && i != len(levels)-1 {
+			return errors.New("the wildcard '#' must be the last level")
+		}
+	}
+	return nil
+}
+
+// unshare returns the topic filter of a shared subscription $share/<group>/<filter>, or the
+// filter itself.
+func unshare(filter string) (string, error) {
+	if !strings.HasPrefix(filter, sharedSubscriptionPrefix) {
+		return filter, nil
+	}
+	group, shared, found := strings.Cut(strings.TrimPrefix(filter, sharedSubscriptionPrefix), "/")
+	if !found || group == "" || shared == "" {
+		return "", errors.New("shared subscriptions must be $share/<group>/<filter>")
+	}
+	return shared, nil
+}
+
+// topicMatches returns whether the topic of a message matches the topic filter of a
+// subscription.
+func topicMatches(filter, topic string) bool {
+	filter, err := unshare(filter)
+	if err != nil {
+		return false
+	}
+	// the wildcards at the first level do not match the topics starting with '$', e.g. $SYS
+	if strings.HasPrefix(topic, "$") && (strings.HasPrefix(filter, "+") || strings.HasPrefix(filter, "#")) {
+		return false
+	}
+	filterLevels := strings.Split(filter, "/")
+	topicLevels := strings.Split(topic, "/")
+	for i, level := range filterLevels {
+		if level == "#" {
+			// also matches the parent level, e.g. otel/# matches otel
+			return true
+		}
+		if i == len(topicLevels) {
+			return false
+		}
+		if level != "+" && level != topicLevels[i] {
+			return false
+		}
+	}
+	return len(filterLevels) == len(topicLevels)
+}
@@ -0,0 +1,45 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mqttreceiver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTopicMatches(t *testing.T) {
+	tests := []struct {
+		filter  string
+		topic   string
+		matches bool
+	}{
+		{filter: "otel/logs", topic: "otel/logs", matches: true},
+		{filter: "otel/logs", topic: "otel/metrics", matches: false},
+		{filter: "otel/logs", topic: "otel/logs/extra", matches: false},
+		{filter: "gateways/+/logs", topic: "gateways/gateway-42/logs", matches: true},
+		{filter: "gateways/+/logs", topic: "gateways/logs", matches: false},
+		{filter: "gateways/+", topic: "gateways", matches: false},
+		{filter: "gateways/#", topic: "gateways/gateway-42/logs", matches: true},
+		{filter: "gateways/#", topic: "gateways", matches: true},
+		{filter: "#", topic: "gateways/gateway-42/logs", matches: true},
+		{filter: "#", topic: "$SYS/broker/uptime", matches: false},
+		{filter: "+/broker/uptime", topic: "$SYS/broker/uptime", matches: false},
+		{filter: "$SYS/#", topic: "$SYS/broker/uptime", matches: true},
+		{filter: "$share/collectors/gateways/+/logs", topic: "gateways/gateway-42/logs", matches: true},
+		{filter: "$share/collectors/gateways/+/logs", topic: "gateways/gateway-42/metrics", matches: false},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.matches, topicMatches(tt.filter, tt.topic), "%s %s", tt.filter, tt.topic)
+	}
+}
+
+func TestValidateTopicFilter(t *testing.T) {
+	for _, filter := range []string{"otel/logs", "gateways/+/logs", "gateways/#", "#", "+", "$share/collectors/gateways/+/logs"} {
+		assert.NoError(t, validateTopicFilter(filter), filter)
+	}
+	assert.EqualError(t, validateTopicFilter("gateways/gateway-+/logs"), "the wildcards '+' and '#' must occupy an entire level")
+	assert.EqualError(t, validateTopicFilter("gateways/#/logs"), "the wildcard '#' must be the last level")
+	assert.EqualError(t, validateTopicFilter("$share//logs"), "shared subscriptions must be $share/<group>/<filter>")
+}
@@ -0,0 +1,31 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mqttreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/mqttreceiver"
+
+import (
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+// unmarshaler decodes the payloads of the messages.
+type unmarshaler struct {
+	logs    plog.Unmarshaler
+	metrics pmetric.Unmarshaler
+	traces  ptrace.Unmarshaler
+}
+
+// unmarshalers are the unmarshalers by encoding.
+var unmarshalers = map[string]unmarshaler{
+	"otlp_proto": {
+		logs:    &plog.ProtoUnmarshaler{},
+		metrics: &pmetric.ProtoUnmarshaler{},
+		traces:  &ptrace.ProtoUnmarshaler{},
+	},
+	"otlp_json": {
+		logs:    &plog.JSONUnmarshaler{},
+		metrics: &pmetric.JSONUnmarshaler{},
+		traces:  &ptrace.JSONUnmarshaler{},
+	},
+}
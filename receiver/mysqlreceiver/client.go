@@ -19,6 +19,8 @@ type client interface {
 	getVersion() (string, error)
 	getGlobalStats() (map[string]string, error)
 	getInnodbStats() (map[string]string, error)
+	getInnodbBufferPoolStats() ([]innodbBufferPoolStats, error)
+	getInnodbRedoLogVariables() (map[string]string, error)
 	getTableIoWaitsStats() ([]TableIoWaitsStats, error)
 	getIndexIoWaitsStats() ([]IndexIoWaitsStats, error)
 	getStatementEventsStats() ([]StatementEventStats, error)
@@ -99,6 +101,17 @@ type tableLockWaitEventStats struct {
 	sumTimerWriteExternal         int64
 }
 
+type innodbBufferPoolStats struct {
+	poolID                int64
+	freeBuffers           int64
+	databasePages         int64
+	oldDatabasePages      int64
+	modifiedDatabasePages int64
+	pagesCreated          int64
+	pagesRead             int64
+	pagesWritten          int64
+}
+
 type ReplicaStatusStats struct {
 	replicaIOState            string
 	sourceHost                string
@@ -165,15 +178,30 @@ type ReplicaStatusStats struct {
 var _ client = (*mySQLClient)(nil)
 
 func newMySQLClient(conf *Config) (client, error) {
-	tls, err := conf.TLS.LoadTLSConfig(context.Background())
+	connStr, err := newConnectionString(conf)
 	if err != nil {
 		return nil, err
 	}
+
+	return &mySQLClient{
+		connStr:                        connStr,
+		statementEventsDigestTextLimit: conf.StatementEvents.DigestTextLimit,
+		statementEventsLimit:           conf.StatementEvents.Limit,
+		statementEventsTimeLimit:       conf.StatementEvents.TimeLimit,
+	}, nil
+}
+
+// newConnectionString returns the DSN of the MySQL driver for the connection settings of the receiver.
+func newConnectionString(conf *Config) (string, error) {
+	tls, err := conf.TLS.LoadTLSConfig(context.Background())
+	if err != nil {
+		return "", err
+	}
 	tlsConfig := ""
 	if tls != nil {
 		err := mysql.RegisterTLSConfig("custom", tls)
 		if err != nil {
-			return nil, err
+			return "", err
 		}
 		tlsConfig = "custom"
 	}
@@ -188,14 +216,7 @@ func newMySQLClient(conf *Config) (client, error) {
 		TLS:                  tls,
 		TLSConfig:            tlsConfig,
 	}
-	connStr := driverConf.FormatDSN()
-
-	return &mySQLClient{
-		connStr:                        connStr,
-		statementEventsDigestTextLimit: conf.StatementEvents.DigestTextLimit,
-		statementEventsLimit:           conf.StatementEvents.Limit,
-		statementEventsTimeLimit:       conf.StatementEvents.TimeLimit,
-	}, nil
+	return driverConf.FormatDSN(), nil
 }
 
 func (c *mySQLClient) Connect() error {
@@ -226,8 +247,41 @@ func (c *mySQLClient) getGlobalStats() (map[string]string, error) {
 }
 
 // getInnodbStats queries the db for innodb metrics.
+// The redo log counters are disabled by default and are only returned once enabled through innodb_monitor_enable.
 func (c *mySQLClient) getInnodbStats() (map[string]string, error) {
-	q := "SELECT name, count FROM information_schema.innodb_metrics WHERE name LIKE '%buffer_pool_size%';"
+	q := "SELECT name, count FROM information_schema.innodb_metrics WHERE name LIKE '%buffer_pool_size%' " +
+		"OR (name IN ('log_lsn_current', 'log_lsn_last_checkpoint', 'log_max_modified_age_async', 'log_max_modified_age_sync') " +
+		"AND status = 'enabled');"
+	return query(*c, q)
+}
+
+// getInnodbBufferPoolStats queries the db for per buffer pool instance stats.
+func (c *mySQLClient) getInnodbBufferPoolStats() ([]innodbBufferPoolStats, error) {
+	query := "SELECT POOL_ID, FREE_BUFFERS, DATABASE_PAGES, OLD_DATABASE_PAGES, MODIFIED_DATABASE_PAGES, " +
+		"NUMBER_PAGES_CREATED, NUMBER_PAGES_READ, NUMBER_PAGES_WRITTEN " +
+		"FROM information_schema.innodb_buffer_pool_stats;"
+	rows, err := c.client.Query(query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var stats []innodbBufferPoolStats
+	for rows.Next() {
+		var s innodbBufferPoolStats
+		err := rows.Scan(&s.poolID, &s.freeBuffers, &s.databasePages, &s.oldDatabasePages, &s.modifiedDatabasePages,
+			&s.pagesCreated, &s.pagesRead, &s.pagesWritten)
+		if err != nil {
+			return nil, err
+		}
+		stats = append(stats, s)
+	}
+
+	return stats, nil
+}
+
+// getInnodbRedoLogVariables queries the db for the variables defining the redo log capacity.
+func (c *mySQLClient) getInnodbRedoLogVariables() (map[string]string, error) {
+	q := "SHOW GLOBAL VARIABLES WHERE Variable_name IN ('innodb_redo_log_capacity', 'innodb_log_file_size', 'innodb_log_files_in_group');"
 	return query(*c, q)
 }
 
@@ -12,6 +12,7 @@ import (
 	"go.opentelemetry.io/collector/confmap"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/sqlquery"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/mysqlreceiver/internal/metadata"
 )
 
@@ -31,6 +32,9 @@ type Config struct {
 	TLS                            configtls.ClientConfig        `mapstructure:"tls,omitempty"`
 	MetricsBuilderConfig           metadata.MetricsBuilderConfig `mapstructure:",squash"`
 	StatementEvents                StatementEventsConfig         `mapstructure:"statement_events"`
+	// CustomQueries are run with the connection settings of the receiver, each query
+	// producing the metrics it defines as for the SQL query receiver.
+	CustomQueries []sqlquery.Query `mapstructure:"custom_queries"`
 }
 
 type StatementEventsConfig struct {
@@ -54,3 +58,7 @@ func (cfg *Config) Unmarshal(componentParser *confmap.Conf) error {
 
 	return componentParser.Unmarshal(cfg)
 }
+
+func (cfg *Config) Validate() error {
+	return sqlquery.ValidateCustomQueries(cfg.CustomQueries)
+}
@@ -61,3 +61,24 @@ func TestLoadConfigDefaultTLS(t *testing.T) {
 
 	require.Equal(t, expected, cfg)
 }
+
+func TestLoadConfigCustomQueries(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+
+	sub, err := cm.Sub(component.NewIDWithName(metadata.Type, "custom_queries").String())
+	require.NoError(t, err)
+	require.NoError(t, component.UnmarshalConfig(sub, cfg))
+
+	customQueries := cfg.(*Config).CustomQueries
+	require.Len(t, customQueries, 2)
+	require.Equal(t, "mysql.processes", customQueries[0].Metrics[0].MetricName)
+	require.Equal(t, []string{"command"}, customQueries[0].Metrics[0].AttributeColumns)
+	require.EqualError(t, component.ValidateConfig(cfg), "custom_queries[1]: only 'metrics' are supported")
+
+	cfg.(*Config).CustomQueries = customQueries[:1]
+	require.NoError(t, component.ValidateConfig(cfg))
+}
@@ -5,6 +5,7 @@ package mysqlreceiver // import "github.com/open-telemetry/opentelemetry-collect
 
 import (
 	"context"
+	"database/sql"
 	"time"
 
 	"go.opentelemetry.io/collector/component"
@@ -13,6 +14,7 @@ import (
 	"go.opentelemetry.io/collector/receiver"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/sqlquery"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/mysqlreceiver/internal/metadata"
 )
 
@@ -59,8 +61,22 @@ func createMetricsReceiver(
 		return nil, err
 	}
 
+	opts := []scraperhelper.ScraperControllerOption{scraperhelper.AddScraper(scraper)}
+	if len(cfg.CustomQueries) > 0 {
+		connStr, connErr := newConnectionString(cfg)
+		if connErr != nil {
+			return nil, connErr
+		}
+		dbProviderFunc := func() (*sql.DB, error) {
+			return sql.Open("mysql", connStr)
+		}
+		for _, customScraper := range sqlquery.NewCustomQueryScrapers(metadata.Type, cfg.CustomQueries, cfg.ControllerConfig, params.Logger, dbProviderFunc) {
+			opts = append(opts, scraperhelper.AddScraper(customScraper))
+		}
+	}
+
 	return scraperhelper.NewScraperControllerReceiver(
 		&cfg.ControllerConfig, params, consumer,
-		scraperhelper.AddScraper(scraper),
+		opts...,
 	)
 }
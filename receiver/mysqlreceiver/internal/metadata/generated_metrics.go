@@ -40,6 +40,40 @@ var MapAttributeBufferPoolData = map[string]AttributeBufferPoolData{
 	"clean": AttributeBufferPoolDataClean,
 }
 
+// AttributeBufferPoolInstancePages specifies the a value buffer_pool_instance_pages attribute.
+type AttributeBufferPoolInstancePages int
+
+const (
+	_ AttributeBufferPoolInstancePages = iota
+	AttributeBufferPoolInstancePagesData
+	AttributeBufferPoolInstancePagesFree
+	AttributeBufferPoolInstancePagesModified
+	AttributeBufferPoolInstancePagesOld
+)
+
+// String returns the string representation of the AttributeBufferPoolInstancePages.
+func (av AttributeBufferPoolInstancePages) String() string {
+	switch av {
+	case AttributeBufferPoolInstancePagesData:
+		return "data"
+	case AttributeBufferPoolInstancePagesFree:
+		return "free"
+	case AttributeBufferPoolInstancePagesModified:
+		return "modified"
+	case AttributeBufferPoolInstancePagesOld:
+		return "old"
+	}
+	return ""
+}
+
+// MapAttributeBufferPoolInstancePages is a helper map of string to AttributeBufferPoolInstancePages attribute value.
+var MapAttributeBufferPoolInstancePages = map[string]AttributeBufferPoolInstancePages{
+	"data":     AttributeBufferPoolInstancePagesData,
+	"free":     AttributeBufferPoolInstancePagesFree,
+	"modified": AttributeBufferPoolInstancePagesModified,
+	"old":      AttributeBufferPoolInstancePagesOld,
+}
+
 // AttributeBufferPoolOperations specifies the a value buffer_pool_operations attribute.
 type AttributeBufferPoolOperations int
 
@@ -146,6 +180,32 @@ var MapAttributeCacheStatus = map[string]AttributeCacheStatus{
 	"overflow": AttributeCacheStatusOverflow,
 }
 
+// AttributeCheckpointAgeThreshold specifies the a value checkpoint_age_threshold attribute.
+type AttributeCheckpointAgeThreshold int
+
+const (
+	_ AttributeCheckpointAgeThreshold = iota
+	AttributeCheckpointAgeThresholdAsync
+	AttributeCheckpointAgeThresholdSync
+)
+
+// String returns the string representation of the AttributeCheckpointAgeThreshold.
+func (av AttributeCheckpointAgeThreshold) String() string {
+	switch av {
+	case AttributeCheckpointAgeThresholdAsync:
+		return "async"
+	case AttributeCheckpointAgeThresholdSync:
+		return "sync"
+	}
+	return ""
+}
+
+// MapAttributeCheckpointAgeThreshold is a helper map of string to AttributeCheckpointAgeThreshold attribute value.
+var MapAttributeCheckpointAgeThreshold = map[string]AttributeCheckpointAgeThreshold{
+	"async": AttributeCheckpointAgeThresholdAsync,
+	"sync":  AttributeCheckpointAgeThresholdSync,
+}
+
 // AttributeCommand specifies the a value command attribute.
 type AttributeCommand int
 
@@ -1037,6 +1097,114 @@ func newMetricMysqlBufferPoolDataPages(cfg MetricConfig) metricMysqlBufferPoolDa
 	return m
 }
 
+type metricMysqlBufferPoolInstancePageOperations struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills mysql.buffer_pool.instance.page_operations metric with initial data.
+func (m *metricMysqlBufferPoolInstancePageOperations) init() {
+	m.data.SetName("mysql.buffer_pool.instance.page_operations")
+	m.data.SetDescription("The number of page operations on an InnoDB buffer pool instance.")
+	m.data.SetUnit("1")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricMysqlBufferPoolInstancePageOperations) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, bufferPoolInstanceAttributeValue int64, pageOperationsAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutInt("pool_id", bufferPoolInstanceAttributeValue)
+	dp.Attributes().PutStr("operation", pageOperationsAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricMysqlBufferPoolInstancePageOperations) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricMysqlBufferPoolInstancePageOperations) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricMysqlBufferPoolInstancePageOperations(cfg MetricConfig) metricMysqlBufferPoolInstancePageOperations {
+	m := metricMysqlBufferPoolInstancePageOperations{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricMysqlBufferPoolInstancePages struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills mysql.buffer_pool.instance.pages metric with initial data.
+func (m *metricMysqlBufferPoolInstancePages) init() {
+	m.data.SetName("mysql.buffer_pool.instance.pages")
+	m.data.SetDescription("The number of pages in an InnoDB buffer pool instance.")
+	m.data.SetUnit("1")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricMysqlBufferPoolInstancePages) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, bufferPoolInstanceAttributeValue int64, bufferPoolInstancePagesAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutInt("pool_id", bufferPoolInstanceAttributeValue)
+	dp.Attributes().PutStr("kind", bufferPoolInstancePagesAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricMysqlBufferPoolInstancePages) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricMysqlBufferPoolInstancePages) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricMysqlBufferPoolInstancePages(cfg MetricConfig) metricMysqlBufferPoolInstancePages {
+	m := metricMysqlBufferPoolInstancePages{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricMysqlBufferPoolLimit struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -1726,6 +1894,210 @@ func newMetricMysqlIndexIoWaitTime(cfg MetricConfig) metricMysqlIndexIoWaitTime
 	return m
 }
 
+type metricMysqlInnodbCheckpointAge struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills mysql.innodb.checkpoint.age metric with initial data.
+func (m *metricMysqlInnodbCheckpointAge) init() {
+	m.data.SetName("mysql.innodb.checkpoint.age")
+	m.data.SetDescription("The amount of redo log written since the last checkpoint.")
+	m.data.SetUnit("By")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+}
+
+func (m *metricMysqlInnodbCheckpointAge) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricMysqlInnodbCheckpointAge) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricMysqlInnodbCheckpointAge) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricMysqlInnodbCheckpointAge(cfg MetricConfig) metricMysqlInnodbCheckpointAge {
+	m := metricMysqlInnodbCheckpointAge{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricMysqlInnodbCheckpointAgeThreshold struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills mysql.innodb.checkpoint.age_threshold metric with initial data.
+func (m *metricMysqlInnodbCheckpointAgeThreshold) init() {
+	m.data.SetName("mysql.innodb.checkpoint.age_threshold")
+	m.data.SetDescription("The checkpoint age at which InnoDB starts flushing modified pages.")
+	m.data.SetUnit("By")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricMysqlInnodbCheckpointAgeThreshold) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, checkpointAgeThresholdAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("kind", checkpointAgeThresholdAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricMysqlInnodbCheckpointAgeThreshold) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricMysqlInnodbCheckpointAgeThreshold) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricMysqlInnodbCheckpointAgeThreshold(cfg MetricConfig) metricMysqlInnodbCheckpointAgeThreshold {
+	m := metricMysqlInnodbCheckpointAgeThreshold{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricMysqlInnodbRedoLogCapacity struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills mysql.innodb.redo_log.capacity metric with initial data.
+func (m *metricMysqlInnodbRedoLogCapacity) init() {
+	m.data.SetName("mysql.innodb.redo_log.capacity")
+	m.data.SetDescription("The configured capacity of the InnoDB redo log.")
+	m.data.SetUnit("By")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+}
+
+func (m *metricMysqlInnodbRedoLogCapacity) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricMysqlInnodbRedoLogCapacity) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricMysqlInnodbRedoLogCapacity) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricMysqlInnodbRedoLogCapacity(cfg MetricConfig) metricMysqlInnodbRedoLogCapacity {
+	m := metricMysqlInnodbRedoLogCapacity{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricMysqlInnodbRedoLogOccupancy struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills mysql.innodb.redo_log.occupancy metric with initial data.
+func (m *metricMysqlInnodbRedoLogOccupancy) init() {
+	m.data.SetName("mysql.innodb.redo_log.occupancy")
+	m.data.SetDescription("The fraction of the InnoDB redo log capacity occupied by the checkpoint age.")
+	m.data.SetUnit("1")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricMysqlInnodbRedoLogOccupancy) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricMysqlInnodbRedoLogOccupancy) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricMysqlInnodbRedoLogOccupancy) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricMysqlInnodbRedoLogOccupancy(cfg MetricConfig) metricMysqlInnodbRedoLogOccupancy {
+	m := metricMysqlInnodbRedoLogOccupancy{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricMysqlJoins struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -3271,56 +3643,62 @@ func newMetricMysqlUptime(cfg MetricConfig) metricMysqlUptime {
 // MetricsBuilder provides an interface for scrapers to report metrics while taking care of all the transformations
 // required to produce metric representation defined in metadata and user config.
 type MetricsBuilder struct {
-	config                             MetricsBuilderConfig // config of the metrics builder.
-	startTime                          pcommon.Timestamp    // start time that will be applied to all recorded data points.
-	metricsCapacity                    int                  // maximum observed number of metrics per resource.
-	metricsBuffer                      pmetric.Metrics      // accumulates metrics data before emitting.
-	buildInfo                          component.BuildInfo  // contains version information.
-	resourceAttributeIncludeFilter     map[string]filter.Filter
-	resourceAttributeExcludeFilter     map[string]filter.Filter
-	metricMysqlBufferPoolDataPages     metricMysqlBufferPoolDataPages
-	metricMysqlBufferPoolLimit         metricMysqlBufferPoolLimit
-	metricMysqlBufferPoolOperations    metricMysqlBufferPoolOperations
-	metricMysqlBufferPoolPageFlushes   metricMysqlBufferPoolPageFlushes
-	metricMysqlBufferPoolPages         metricMysqlBufferPoolPages
-	metricMysqlBufferPoolUsage         metricMysqlBufferPoolUsage
-	metricMysqlClientNetworkIo         metricMysqlClientNetworkIo
-	metricMysqlCommands                metricMysqlCommands
-	metricMysqlConnectionCount         metricMysqlConnectionCount
-	metricMysqlConnectionErrors        metricMysqlConnectionErrors
-	metricMysqlDoubleWrites            metricMysqlDoubleWrites
-	metricMysqlHandlers                metricMysqlHandlers
-	metricMysqlIndexIoWaitCount        metricMysqlIndexIoWaitCount
-	metricMysqlIndexIoWaitTime         metricMysqlIndexIoWaitTime
-	metricMysqlJoins                   metricMysqlJoins
-	metricMysqlLocks                   metricMysqlLocks
-	metricMysqlLogOperations           metricMysqlLogOperations
-	metricMysqlMysqlxConnections       metricMysqlMysqlxConnections
-	metricMysqlMysqlxWorkerThreads     metricMysqlMysqlxWorkerThreads
-	metricMysqlOpenedResources         metricMysqlOpenedResources
-	metricMysqlOperations              metricMysqlOperations
-	metricMysqlPageOperations          metricMysqlPageOperations
-	metricMysqlPreparedStatements      metricMysqlPreparedStatements
-	metricMysqlQueryClientCount        metricMysqlQueryClientCount
-	metricMysqlQueryCount              metricMysqlQueryCount
-	metricMysqlQuerySlowCount          metricMysqlQuerySlowCount
-	metricMysqlReplicaSQLDelay         metricMysqlReplicaSQLDelay
-	metricMysqlReplicaTimeBehindSource metricMysqlReplicaTimeBehindSource
-	metricMysqlRowLocks                metricMysqlRowLocks
-	metricMysqlRowOperations           metricMysqlRowOperations
-	metricMysqlSorts                   metricMysqlSorts
-	metricMysqlStatementEventCount     metricMysqlStatementEventCount
-	metricMysqlStatementEventWaitTime  metricMysqlStatementEventWaitTime
-	metricMysqlTableIoWaitCount        metricMysqlTableIoWaitCount
-	metricMysqlTableIoWaitTime         metricMysqlTableIoWaitTime
-	metricMysqlTableLockWaitReadCount  metricMysqlTableLockWaitReadCount
-	metricMysqlTableLockWaitReadTime   metricMysqlTableLockWaitReadTime
-	metricMysqlTableLockWaitWriteCount metricMysqlTableLockWaitWriteCount
-	metricMysqlTableLockWaitWriteTime  metricMysqlTableLockWaitWriteTime
-	metricMysqlTableOpenCache          metricMysqlTableOpenCache
-	metricMysqlThreads                 metricMysqlThreads
-	metricMysqlTmpResources            metricMysqlTmpResources
-	metricMysqlUptime                  metricMysqlUptime
+	config                                      MetricsBuilderConfig // config of the metrics builder.
+	startTime                                   pcommon.Timestamp    // start time that will be applied to all recorded data points.
+	metricsCapacity                             int                  // maximum observed number of metrics per resource.
+	metricsBuffer                               pmetric.Metrics      // accumulates metrics data before emitting.
+	buildInfo                                   component.BuildInfo  // contains version information.
+	resourceAttributeIncludeFilter              map[string]filter.Filter
+	resourceAttributeExcludeFilter              map[string]filter.Filter
+	metricMysqlBufferPoolDataPages              metricMysqlBufferPoolDataPages
+	metricMysqlBufferPoolInstancePageOperations metricMysqlBufferPoolInstancePageOperations
+	metricMysqlBufferPoolInstancePages          metricMysqlBufferPoolInstancePages
+	metricMysqlBufferPoolLimit                  metricMysqlBufferPoolLimit
+	metricMysqlBufferPoolOperations             metricMysqlBufferPoolOperations
+	metricMysqlBufferPoolPageFlushes            metricMysqlBufferPoolPageFlushes
+	metricMysqlBufferPoolPages                  metricMysqlBufferPoolPages
+	metricMysqlBufferPoolUsage                  metricMysqlBufferPoolUsage
+	metricMysqlClientNetworkIo                  metricMysqlClientNetworkIo
+	metricMysqlCommands                         metricMysqlCommands
+	metricMysqlConnectionCount                  metricMysqlConnectionCount
+	metricMysqlConnectionErrors                 metricMysqlConnectionErrors
+	metricMysqlDoubleWrites                     metricMysqlDoubleWrites
+	metricMysqlHandlers                         metricMysqlHandlers
+	metricMysqlIndexIoWaitCount                 metricMysqlIndexIoWaitCount
+	metricMysqlIndexIoWaitTime                  metricMysqlIndexIoWaitTime
+	metricMysqlInnodbCheckpointAge              metricMysqlInnodbCheckpointAge
+	metricMysqlInnodbCheckpointAgeThreshold     metricMysqlInnodbCheckpointAgeThreshold
+	metricMysqlInnodbRedoLogCapacity            metricMysqlInnodbRedoLogCapacity
+	metricMysqlInnodbRedoLogOccupancy           metricMysqlInnodbRedoLogOccupancy
+	metricMysqlJoins                            metricMysqlJoins
+	metricMysqlLocks                            metricMysqlLocks
+	metricMysqlLogOperations                    metricMysqlLogOperations
+	metricMysqlMysqlxConnections                metricMysqlMysqlxConnections
+	metricMysqlMysqlxWorkerThreads              metricMysqlMysqlxWorkerThreads
+	metricMysqlOpenedResources                  metricMysqlOpenedResources
+	metricMysqlOperations                       metricMysqlOperations
+	metricMysqlPageOperations                   metricMysqlPageOperations
+	metricMysqlPreparedStatements               metricMysqlPreparedStatements
+	metricMysqlQueryClientCount                 metricMysqlQueryClientCount
+	metricMysqlQueryCount                       metricMysqlQueryCount
+	metricMysqlQuerySlowCount                   metricMysqlQuerySlowCount
+	metricMysqlReplicaSQLDelay                  metricMysqlReplicaSQLDelay
+	metricMysqlReplicaTimeBehindSource          metricMysqlReplicaTimeBehindSource
+	metricMysqlRowLocks                         metricMysqlRowLocks
+	metricMysqlRowOperations                    metricMysqlRowOperations
+	metricMysqlSorts                            metricMysqlSorts
+	metricMysqlStatementEventCount              metricMysqlStatementEventCount
+	metricMysqlStatementEventWaitTime           metricMysqlStatementEventWaitTime
+	metricMysqlTableIoWaitCount                 metricMysqlTableIoWaitCount
+	metricMysqlTableIoWaitTime                  metricMysqlTableIoWaitTime
+	metricMysqlTableLockWaitReadCount           metricMysqlTableLockWaitReadCount
+	metricMysqlTableLockWaitReadTime            metricMysqlTableLockWaitReadTime
+	metricMysqlTableLockWaitWriteCount          metricMysqlTableLockWaitWriteCount
+	metricMysqlTableLockWaitWriteTime           metricMysqlTableLockWaitWriteTime
+	metricMysqlTableOpenCache                   metricMysqlTableOpenCache
+	metricMysqlThreads                          metricMysqlThreads
+	metricMysqlTmpResources                     metricMysqlTmpResources
+	metricMysqlUptime                           metricMysqlUptime
 }
 
 // metricBuilderOption applies changes to default metrics builder.
@@ -3335,55 +3713,61 @@ func WithStartTime(startTime pcommon.Timestamp) metricBuilderOption {
 
 func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.CreateSettings, options ...metricBuilderOption) *MetricsBuilder {
 	mb := &MetricsBuilder{
-		config:                             mbc,
-		startTime:                          pcommon.NewTimestampFromTime(time.Now()),
-		metricsBuffer:                      pmetric.NewMetrics(),
-		buildInfo:                          settings.BuildInfo,
-		metricMysqlBufferPoolDataPages:     newMetricMysqlBufferPoolDataPages(mbc.Metrics.MysqlBufferPoolDataPages),
-		metricMysqlBufferPoolLimit:         newMetricMysqlBufferPoolLimit(mbc.Metrics.MysqlBufferPoolLimit),
-		metricMysqlBufferPoolOperations:    newMetricMysqlBufferPoolOperations(mbc.Metrics.MysqlBufferPoolOperations),
-		metricMysqlBufferPoolPageFlushes:   newMetricMysqlBufferPoolPageFlushes(mbc.Metrics.MysqlBufferPoolPageFlushes),
-		metricMysqlBufferPoolPages:         newMetricMysqlBufferPoolPages(mbc.Metrics.MysqlBufferPoolPages),
-		metricMysqlBufferPoolUsage:         newMetricMysqlBufferPoolUsage(mbc.Metrics.MysqlBufferPoolUsage),
-		metricMysqlClientNetworkIo:         newMetricMysqlClientNetworkIo(mbc.Metrics.MysqlClientNetworkIo),
-		metricMysqlCommands:                newMetricMysqlCommands(mbc.Metrics.MysqlCommands),
-		metricMysqlConnectionCount:         newMetricMysqlConnectionCount(mbc.Metrics.MysqlConnectionCount),
-		metricMysqlConnectionErrors:        newMetricMysqlConnectionErrors(mbc.Metrics.MysqlConnectionErrors),
-		metricMysqlDoubleWrites:            newMetricMysqlDoubleWrites(mbc.Metrics.MysqlDoubleWrites),
-		metricMysqlHandlers:                newMetricMysqlHandlers(mbc.Metrics.MysqlHandlers),
-		metricMysqlIndexIoWaitCount:        newMetricMysqlIndexIoWaitCount(mbc.Metrics.MysqlIndexIoWaitCount),
-		metricMysqlIndexIoWaitTime:         newMetricMysqlIndexIoWaitTime(mbc.Metrics.MysqlIndexIoWaitTime),
-		metricMysqlJoins:                   newMetricMysqlJoins(mbc.Metrics.MysqlJoins),
-		metricMysqlLocks:                   newMetricMysqlLocks(mbc.Metrics.MysqlLocks),
-		metricMysqlLogOperations:           newMetricMysqlLogOperations(mbc.Metrics.MysqlLogOperations),
-		metricMysqlMysqlxConnections:       newMetricMysqlMysqlxConnections(mbc.Metrics.MysqlMysqlxConnections),
-		metricMysqlMysqlxWorkerThreads:     newMetricMysqlMysqlxWorkerThreads(mbc.Metrics.MysqlMysqlxWorkerThreads),
-		metricMysqlOpenedResources:         newMetricMysqlOpenedResources(mbc.Metrics.MysqlOpenedResources),
-		metricMysqlOperations:              newMetricMysqlOperations(mbc.Metrics.MysqlOperations),
-		metricMysqlPageOperations:          newMetricMysqlPageOperations(mbc.Metrics.MysqlPageOperations),
-		metricMysqlPreparedStatements:      newMetricMysqlPreparedStatements(mbc.Metrics.MysqlPreparedStatements),
-		metricMysqlQueryClientCount:        newMetricMysqlQueryClientCount(mbc.Metrics.MysqlQueryClientCount),
-		metricMysqlQueryCount:              newMetricMysqlQueryCount(mbc.Metrics.MysqlQueryCount),
-		metricMysqlQuerySlowCount:          newMetricMysqlQuerySlowCount(mbc.Metrics.MysqlQuerySlowCount),
-		metricMysqlReplicaSQLDelay:         newMetricMysqlReplicaSQLDelay(mbc.Metrics.MysqlReplicaSQLDelay),
-		metricMysqlReplicaTimeBehindSource: newMetricMysqlReplicaTimeBehindSource(mbc.Metrics.MysqlReplicaTimeBehindSource),
-		metricMysqlRowLocks:                newMetricMysqlRowLocks(mbc.Metrics.MysqlRowLocks),
-		metricMysqlRowOperations:           newMetricMysqlRowOperations(mbc.Metrics.MysqlRowOperations),
-		metricMysqlSorts:                   newMetricMysqlSorts(mbc.Metrics.MysqlSorts),
-		metricMysqlStatementEventCount:     newMetricMysqlStatementEventCount(mbc.Metrics.MysqlStatementEventCount),
-		metricMysqlStatementEventWaitTime:  newMetricMysqlStatementEventWaitTime(mbc.Metrics.MysqlStatementEventWaitTime),
-		metricMysqlTableIoWaitCount:        newMetricMysqlTableIoWaitCount(mbc.Metrics.MysqlTableIoWaitCount),
-		metricMysqlTableIoWaitTime:         newMetricMysqlTableIoWaitTime(mbc.Metrics.MysqlTableIoWaitTime),
-		metricMysqlTableLockWaitReadCount:  newMetricMysqlTableLockWaitReadCount(mbc.Metrics.MysqlTableLockWaitReadCount),
-		metricMysqlTableLockWaitReadTime:   newMetricMysqlTableLockWaitReadTime(mbc.Metrics.MysqlTableLockWaitReadTime),
-		metricMysqlTableLockWaitWriteCount: newMetricMysqlTableLockWaitWriteCount(mbc.Metrics.MysqlTableLockWaitWriteCount),
-		metricMysqlTableLockWaitWriteTime:  newMetricMysqlTableLockWaitWriteTime(mbc.Metrics.MysqlTableLockWaitWriteTime),
-		metricMysqlTableOpenCache:          newMetricMysqlTableOpenCache(mbc.Metrics.MysqlTableOpenCache),
-		metricMysqlThreads:                 newMetricMysqlThreads(mbc.Metrics.MysqlThreads),
-		metricMysqlTmpResources:            newMetricMysqlTmpResources(mbc.Metrics.MysqlTmpResources),
-		metricMysqlUptime:                  newMetricMysqlUptime(mbc.Metrics.MysqlUptime),
-		resourceAttributeIncludeFilter:     make(map[string]filter.Filter),
-		resourceAttributeExcludeFilter:     make(map[string]filter.Filter),
+		config:                         mbc,
+		startTime:                      pcommon.NewTimestampFromTime(time.Now()),
+		metricsBuffer:                  pmetric.NewMetrics(),
+		buildInfo:                      settings.BuildInfo,
+		metricMysqlBufferPoolDataPages: newMetricMysqlBufferPoolDataPages(mbc.Metrics.MysqlBufferPoolDataPages),
+		metricMysqlBufferPoolInstancePageOperations: newMetricMysqlBufferPoolInstancePageOperations(mbc.Metrics.MysqlBufferPoolInstancePageOperations),
+		metricMysqlBufferPoolInstancePages:          newMetricMysqlBufferPoolInstancePages(mbc.Metrics.MysqlBufferPoolInstancePages),
+		metricMysqlBufferPoolLimit:                  newMetricMysqlBufferPoolLimit(mbc.Metrics.MysqlBufferPoolLimit),
+		metricMysqlBufferPoolOperations:             newMetricMysqlBufferPoolOperations(mbc.Metrics.MysqlBufferPoolOperations),
+		metricMysqlBufferPoolPageFlushes:            newMetricMysqlBufferPoolPageFlushes(mbc.Metrics.MysqlBufferPoolPageFlushes),
+		metricMysqlBufferPoolPages:                  newMetricMysqlBufferPoolPages(mbc.Metrics.MysqlBufferPoolPages),
+		metricMysqlBufferPoolUsage:                  newMetricMysqlBufferPoolUsage(mbc.Metrics.MysqlBufferPoolUsage),
+		metricMysqlClientNetworkIo:                  newMetricMysqlClientNetworkIo(mbc.Metrics.MysqlClientNetworkIo),
+		metricMysqlCommands:                         newMetricMysqlCommands(mbc.Metrics.MysqlCommands),
+		metricMysqlConnectionCount:                  newMetricMysqlConnectionCount(mbc.Metrics.MysqlConnectionCount),
+		metricMysqlConnectionErrors:                 newMetricMysqlConnectionErrors(mbc.Metrics.MysqlConnectionErrors),
+		metricMysqlDoubleWrites:                     newMetricMysqlDoubleWrites(mbc.Metrics.MysqlDoubleWrites),
+		metricMysqlHandlers:                         newMetricMysqlHandlers(mbc.Metrics.MysqlHandlers),
+		metricMysqlIndexIoWaitCount:                 newMetricMysqlIndexIoWaitCount(mbc.Metrics.MysqlIndexIoWaitCount),
+		metricMysqlIndexIoWaitTime:                  newMetricMysqlIndexIoWaitTime(mbc.Metrics.MysqlIndexIoWaitTime),
+		metricMysqlInnodbCheckpointAge:              newMetricMysqlInnodbCheckpointAge(mbc.Metrics.MysqlInnodbCheckpointAge),
+		metricMysqlInnodbCheckpointAgeThreshold:     newMetricMysqlInnodbCheckpointAgeThreshold(mbc.Metrics.MysqlInnodbCheckpointAgeThreshold),
+		metricMysqlInnodbRedoLogCapacity:            newMetricMysqlInnodbRedoLogCapacity(mbc.Metrics.MysqlInnodbRedoLogCapacity),
+		metricMysqlInnodbRedoLogOccupancy:           newMetricMysqlInnodbRedoLogOccupancy(mbc.Metrics.MysqlInnodbRedoLogOccupancy),
+		metricMysqlJoins:                            newMetricMysqlJoins(mbc.Metrics.MysqlJoins),
+		metricMysqlLocks:                            newMetricMysqlLocks(mbc.Metrics.MysqlLocks),
+		metricMysqlLogOperations:                    newMetricMysqlLogOperations(mbc.Metrics.MysqlLogOperations),
+		metricMysqlMysqlxConnections:                newMetricMysqlMysqlxConnections(mbc.Metrics.MysqlMysqlxConnections),
+		metricMysqlMysqlxWorkerThreads:              newMetricMysqlMysqlxWorkerThreads(mbc.Metrics.MysqlMysqlxWorkerThreads),
+		metricMysqlOpenedResources:                  newMetricMysqlOpenedResources(mbc.Metrics.MysqlOpenedResources),
+		metricMysqlOperations:                       newMetricMysqlOperations(mbc.Metrics.MysqlOperations),
+		metricMysqlPageOperations:                   newMetricMysqlPageOperations(mbc.Metrics.MysqlPageOperations),
+		metricMysqlPreparedStatements:               newMetricMysqlPreparedStatements(mbc.Metrics.MysqlPreparedStatements),
+		metricMysqlQueryClientCount:                 newMetricMysqlQueryClientCount(mbc.Metrics.MysqlQueryClientCount),
+		metricMysqlQueryCount:                       newMetricMysqlQueryCount(mbc.Metrics.MysqlQueryCount),
+		metricMysqlQuerySlowCount:                   newMetricMysqlQuerySlowCount(mbc.Metrics.MysqlQuerySlowCount),
+		metricMysqlReplicaSQLDelay:                  newMetricMysqlReplicaSQLDelay(mbc.Metrics.MysqlReplicaSQLDelay),
+		metricMysqlReplicaTimeBehindSource:          newMetricMysqlReplicaTimeBehindSource(mbc.Metrics.MysqlReplicaTimeBehindSource),
+		metricMysqlRowLocks:                         newMetricMysqlRowLocks(mbc.Metrics.MysqlRowLocks),
+		metricMysqlRowOperations:                    newMetricMysqlRowOperations(mbc.Metrics.MysqlRowOperations),
+		metricMysqlSorts:                            newMetricMysqlSorts(mbc.Metrics.MysqlSorts),
+		metricMysqlStatementEventCount:              newMetricMysqlStatementEventCount(mbc.Metrics.MysqlStatementEventCount),
+		metricMysqlStatementEventWaitTime:           newMetricMysqlStatementEventWaitTime(mbc.Metrics.MysqlStatementEventWaitTime),
+		metricMysqlTableIoWaitCount:                 newMetricMysqlTableIoWaitCount(mbc.Metrics.MysqlTableIoWaitCount),
+		metricMysqlTableIoWaitTime:                  newMetricMysqlTableIoWaitTime(mbc.Metrics.MysqlTableIoWaitTime),
+		metricMysqlTableLockWaitReadCount:           newMetricMysqlTableLockWaitReadCount(mbc.Metrics.MysqlTableLockWaitReadCount),
+		metricMysqlTableLockWaitReadTime:            newMetricMysqlTableLockWaitReadTime(mbc.Metrics.MysqlTableLockWaitReadTime),
+		metricMysqlTableLockWaitWriteCount:          newMetricMysqlTableLockWaitWriteCount(mbc.Metrics.MysqlTableLockWaitWriteCount),
+		metricMysqlTableLockWaitWriteTime:           newMetricMysqlTableLockWaitWriteTime(mbc.Metrics.MysqlTableLockWaitWriteTime),
+		metricMysqlTableOpenCache:                   newMetricMysqlTableOpenCache(mbc.Metrics.MysqlTableOpenCache),
+		metricMysqlThreads:                          newMetricMysqlThreads(mbc.Metrics.MysqlThreads),
+		metricMysqlTmpResources:                     newMetricMysqlTmpResources(mbc.Metrics.MysqlTmpResources),
+		metricMysqlUptime:                           newMetricMysqlUptime(mbc.Metrics.MysqlUptime),
+		resourceAttributeIncludeFilter:              make(map[string]filter.Filter),
+		resourceAttributeExcludeFilter:              make(map[string]filter.Filter),
 	}
 	if mbc.ResourceAttributes.MysqlInstanceEndpoint.MetricsInclude != nil {
 		mb.resourceAttributeIncludeFilter["mysql.instance.endpoint"] = filter.CreateFilter(mbc.ResourceAttributes.MysqlInstanceEndpoint.MetricsInclude)
@@ -3453,6 +3837,8 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	ils.Scope().SetVersion(mb.buildInfo.Version)
 	ils.Metrics().EnsureCapacity(mb.metricsCapacity)
 	mb.metricMysqlBufferPoolDataPages.emit(ils.Metrics())
+	mb.metricMysqlBufferPoolInstancePageOperations.emit(ils.Metrics())
+	mb.metricMysqlBufferPoolInstancePages.emit(ils.Metrics())
 	mb.metricMysqlBufferPoolLimit.emit(ils.Metrics())
 	mb.metricMysqlBufferPoolOperations.emit(ils.Metrics())
 	mb.metricMysqlBufferPoolPageFlushes.emit(ils.Metrics())
@@ -3466,6 +3852,10 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	mb.metricMysqlHandlers.emit(ils.Metrics())
 	mb.metricMysqlIndexIoWaitCount.emit(ils.Metrics())
 	mb.metricMysqlIndexIoWaitTime.emit(ils.Metrics())
+	mb.metricMysqlInnodbCheckpointAge.emit(ils.Metrics())
+	mb.metricMysqlInnodbCheckpointAgeThreshold.emit(ils.Metrics())
+	mb.metricMysqlInnodbRedoLogCapacity.emit(ils.Metrics())
+	mb.metricMysqlInnodbRedoLogOccupancy.emit(ils.Metrics())
 	mb.metricMysqlJoins.emit(ils.Metrics())
 	mb.metricMysqlLocks.emit(ils.Metrics())
 	mb.metricMysqlLogOperations.emit(ils.Metrics())
@@ -3531,6 +3921,16 @@ func (mb *MetricsBuilder) RecordMysqlBufferPoolDataPagesDataPoint(ts pcommon.Tim
 	mb.metricMysqlBufferPoolDataPages.recordDataPoint(mb.startTime, ts, val, bufferPoolDataAttributeValue.String())
 }
 
+// RecordMysqlBufferPoolInstancePageOperationsDataPoint adds a data point to mysql.buffer_pool.instance.page_operations metric.
+func (mb *MetricsBuilder) RecordMysqlBufferPoolInstancePageOperationsDataPoint(ts pcommon.Timestamp, val int64, bufferPoolInstanceAttributeValue int64, pageOperationsAttributeValue AttributePageOperations) {
+	mb.metricMysqlBufferPoolInstancePageOperations.recordDataPoint(mb.startTime, ts, val, bufferPoolInstanceAttributeValue, pageOperationsAttributeValue.String())
+}
+
+// RecordMysqlBufferPoolInstancePagesDataPoint adds a data point to mysql.buffer_pool.instance.pages metric.
+func (mb *MetricsBuilder) RecordMysqlBufferPoolInstancePagesDataPoint(ts pcommon.Timestamp, val int64, bufferPoolInstanceAttributeValue int64, bufferPoolInstancePagesAttributeValue AttributeBufferPoolInstancePages) {
+	mb.metricMysqlBufferPoolInstancePages.recordDataPoint(mb.startTime, ts, val, bufferPoolInstanceAttributeValue, bufferPoolInstancePagesAttributeValue.String())
+}
+
 // RecordMysqlBufferPoolLimitDataPoint adds a data point to mysql.buffer_pool.limit metric.
 func (mb *MetricsBuilder) RecordMysqlBufferPoolLimitDataPoint(ts pcommon.Timestamp, inputVal string) error {
 	val, err := strconv.ParseInt(inputVal, 10, 64)
@@ -3646,6 +4046,31 @@ func (mb *MetricsBuilder) RecordMysqlIndexIoWaitTimeDataPoint(ts pcommon.Timesta
 	mb.metricMysqlIndexIoWaitTime.recordDataPoint(mb.startTime, ts, val, ioWaitsOperationsAttributeValue.String(), tableNameAttributeValue, schemaAttributeValue, indexNameAttributeValue)
 }
 
+// RecordMysqlInnodbCheckpointAgeDataPoint adds a data point to mysql.innodb.checkpoint.age metric.
+func (mb *MetricsBuilder) RecordMysqlInnodbCheckpointAgeDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricMysqlInnodbCheckpointAge.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordMysqlInnodbCheckpointAgeThresholdDataPoint adds a data point to mysql.innodb.checkpoint.age_threshold metric.
+func (mb *MetricsBuilder) RecordMysqlInnodbCheckpointAgeThresholdDataPoint(ts pcommon.Timestamp, inputVal string, checkpointAgeThresholdAttributeValue AttributeCheckpointAgeThreshold) error {
+	val, err := strconv.ParseInt(inputVal, 10, 64)
+	if err != nil {
+		return fmt.Errorf("failed to parse int64 for MysqlInnodbCheckpointAgeThreshold, value was %s: %w", inputVal, err)
+	}
+	mb.metricMysqlInnodbCheckpointAgeThreshold.recordDataPoint(mb.startTime, ts, val, checkpointAgeThresholdAttributeValue.String())
+	return nil
+}
+
+// RecordMysqlInnodbRedoLogCapacityDataPoint adds a data point to mysql.innodb.redo_log.capacity metric.
+func (mb *MetricsBuilder) RecordMysqlInnodbRedoLogCapacityDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricMysqlInnodbRedoLogCapacity.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordMysqlInnodbRedoLogOccupancyDataPoint adds a data point to mysql.innodb.redo_log.occupancy metric.
+func (mb *MetricsBuilder) RecordMysqlInnodbRedoLogOccupancyDataPoint(ts pcommon.Timestamp, val float64) {
+	mb.metricMysqlInnodbRedoLogOccupancy.recordDataPoint(mb.startTime, ts, val)
+}
+
 // RecordMysqlJoinsDataPoint adds a data point to mysql.joins metric.
 func (mb *MetricsBuilder) RecordMysqlJoinsDataPoint(ts pcommon.Timestamp, inputVal string, joinKindAttributeValue AttributeJoinKind) error {
 	val, err := strconv.ParseInt(inputVal, 10, 64)
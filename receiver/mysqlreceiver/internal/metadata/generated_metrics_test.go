@@ -72,6 +72,12 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordMysqlBufferPoolDataPagesDataPoint(ts, 1, AttributeBufferPoolDataDirty)
 
+			allMetricsCount++
+			mb.RecordMysqlBufferPoolInstancePageOperationsDataPoint(ts, 1, 20, AttributePageOperationsCreated)
+
+			allMetricsCount++
+			mb.RecordMysqlBufferPoolInstancePagesDataPoint(ts, 1, 20, AttributeBufferPoolInstancePagesData)
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordMysqlBufferPoolLimitDataPoint(ts, "1")
@@ -120,6 +126,18 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordMysqlIndexIoWaitTimeDataPoint(ts, 1, AttributeIoWaitsOperationsDelete, "table_name-val", "schema-val", "index_name-val")
 
+			allMetricsCount++
+			mb.RecordMysqlInnodbCheckpointAgeDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordMysqlInnodbCheckpointAgeThresholdDataPoint(ts, "1", AttributeCheckpointAgeThresholdAsync)
+
+			allMetricsCount++
+			mb.RecordMysqlInnodbRedoLogCapacityDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordMysqlInnodbRedoLogOccupancyDataPoint(ts, 1)
+
 			allMetricsCount++
 			mb.RecordMysqlJoinsDataPoint(ts, "1", AttributeJoinKindFull)
 
@@ -263,6 +281,46 @@ func TestMetricsBuilder(t *testing.T) {
 					attrVal, ok := dp.Attributes().Get("status")
 					assert.True(t, ok)
 					assert.EqualValues(t, "dirty", attrVal.Str())
+				case "mysql.buffer_pool.instance.page_operations":
+					assert.False(t, validatedMetrics["mysql.buffer_pool.instance.page_operations"], "Found a duplicate in the metrics slice: mysql.buffer_pool.instance.page_operations")
+					validatedMetrics["mysql.buffer_pool.instance.page_operations"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "The number of page operations on an InnoDB buffer pool instance.", ms.At(i).Description())
+					assert.Equal(t, "1", ms.At(i).Unit())
+					assert.Equal(t, true, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("pool_id")
+					assert.True(t, ok)
+					assert.EqualValues(t, 20, attrVal.Int())
+					attrVal, ok = dp.Attributes().Get("operation")
+					assert.True(t, ok)
+					assert.EqualValues(t, "created", attrVal.Str())
+				case "mysql.buffer_pool.instance.pages":
+					assert.False(t, validatedMetrics["mysql.buffer_pool.instance.pages"], "Found a duplicate in the metrics slice: mysql.buffer_pool.instance.pages")
+					validatedMetrics["mysql.buffer_pool.instance.pages"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "The number of pages in an InnoDB buffer pool instance.", ms.At(i).Description())
+					assert.Equal(t, "1", ms.At(i).Unit())
+					assert.Equal(t, false, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("pool_id")
+					assert.True(t, ok)
+					assert.EqualValues(t, 20, attrVal.Int())
+					attrVal, ok = dp.Attributes().Get("kind")
+					assert.True(t, ok)
+					assert.EqualValues(t, "data", attrVal.Str())
 				case "mysql.buffer_pool.limit":
 					assert.False(t, validatedMetrics["mysql.buffer_pool.limit"], "Found a duplicate in the metrics slice: mysql.buffer_pool.limit")
 					validatedMetrics["mysql.buffer_pool.limit"] = true
@@ -493,6 +551,63 @@ func TestMetricsBuilder(t *testing.T) {
 					attrVal, ok = dp.Attributes().Get("index")
 					assert.True(t, ok)
 					assert.EqualValues(t, "index_name-val", attrVal.Str())
+				case "mysql.innodb.checkpoint.age":
+					assert.False(t, validatedMetrics["mysql.innodb.checkpoint.age"], "Found a duplicate in the metrics slice: mysql.innodb.checkpoint.age")
+					validatedMetrics["mysql.innodb.checkpoint.age"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "The amount of redo log written since the last checkpoint.", ms.At(i).Description())
+					assert.Equal(t, "By", ms.At(i).Unit())
+					assert.Equal(t, false, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "mysql.innodb.checkpoint.age_threshold":
+					assert.False(t, validatedMetrics["mysql.innodb.checkpoint.age_threshold"], "Found a duplicate in the metrics slice: mysql.innodb.checkpoint.age_threshold")
+					validatedMetrics["mysql.innodb.checkpoint.age_threshold"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "The checkpoint age at which InnoDB starts flushing modified pages.", ms.At(i).Description())
+					assert.Equal(t, "By", ms.At(i).Unit())
+					assert.Equal(t, false, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("kind")
+					assert.True(t, ok)
+					assert.EqualValues(t, "async", attrVal.Str())
+				case "mysql.innodb.redo_log.capacity":
+					assert.False(t, validatedMetrics["mysql.innodb.redo_log.capacity"], "Found a duplicate in the metrics slice: mysql.innodb.redo_log.capacity")
+					validatedMetrics["mysql.innodb.redo_log.capacity"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "The configured capacity of the InnoDB redo log.", ms.At(i).Description())
+					assert.Equal(t, "By", ms.At(i).Unit())
+					assert.Equal(t, false, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "mysql.innodb.redo_log.occupancy":
+					assert.False(t, validatedMetrics["mysql.innodb.redo_log.occupancy"], "Found a duplicate in the metrics slice: mysql.innodb.redo_log.occupancy")
+					validatedMetrics["mysql.innodb.redo_log.occupancy"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The fraction of the InnoDB redo log capacity occupied by the checkpoint age.", ms.At(i).Description())
+					assert.Equal(t, "1", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
 				case "mysql.joins":
 					assert.False(t, validatedMetrics["mysql.joins"], "Found a duplicate in the metrics slice: mysql.joins")
 					validatedMetrics["mysql.joins"] = true
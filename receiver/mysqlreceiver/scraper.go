@@ -84,12 +84,20 @@ func (m *mySQLScraper) scrape(context.Context) (pmetric.Metrics, error) {
 
 	errs := &scrapererror.ScrapeErrors{}
 	for k, v := range innodbStats {
-		if k != "buffer_pool_size" {
-			continue
+		switch k {
+		case "buffer_pool_size":
+			addPartialIfError(errs, m.mb.RecordMysqlBufferPoolLimitDataPoint(now, v))
+		case "log_max_modified_age_async":
+			addPartialIfError(errs, m.mb.RecordMysqlInnodbCheckpointAgeThresholdDataPoint(now, v, metadata.AttributeCheckpointAgeThresholdAsync))
+		case "log_max_modified_age_sync":
+			addPartialIfError(errs, m.mb.RecordMysqlInnodbCheckpointAgeThresholdDataPoint(now, v, metadata.AttributeCheckpointAgeThresholdSync))
 		}
-		addPartialIfError(errs, m.mb.RecordMysqlBufferPoolLimitDataPoint(now, v))
 	}
 
+	// collect innodb buffer pool instance and redo log metrics.
+	m.scrapeInnodbBufferPoolStats(now, errs)
+	m.scrapeInnodbRedoLogStats(now, innodbStats, errs)
+
 	// collect io_waits metrics.
 	m.scrapeTableIoWaitsStats(now, errs)
 	m.scrapeIndexIoWaitsStats(now, errs)
@@ -540,6 +548,102 @@ func (m *mySQLScraper) scrapeTableLockWaitEventStats(now pcommon.Timestamp, errs
 	}
 }
 
+func (m *mySQLScraper) scrapeInnodbBufferPoolStats(now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	metrics := m.config.MetricsBuilderConfig.Metrics
+	if !metrics.MysqlBufferPoolInstancePages.Enabled && !metrics.MysqlBufferPoolInstancePageOperations.Enabled {
+		return
+	}
+
+	bufferPoolStats, err := m.sqlclient.getInnodbBufferPoolStats()
+	if err != nil {
+		m.logger.Error("Failed to fetch InnoDB buffer pool stats", zap.Error(err))
+		errs.AddPartial(7, err)
+		return
+	}
+
+	for i := 0; i < len(bufferPoolStats); i++ {
+		s := bufferPoolStats[i]
+		// pages
+		m.mb.RecordMysqlBufferPoolInstancePagesDataPoint(now, s.databasePages, s.poolID, metadata.AttributeBufferPoolInstancePagesData)
+		m.mb.RecordMysqlBufferPoolInstancePagesDataPoint(now, s.freeBuffers, s.poolID, metadata.AttributeBufferPoolInstancePagesFree)
+		m.mb.RecordMysqlBufferPoolInstancePagesDataPoint(now, s.modifiedDatabasePages, s.poolID, metadata.AttributeBufferPoolInstancePagesModified)
+		m.mb.RecordMysqlBufferPoolInstancePagesDataPoint(now, s.oldDatabasePages, s.poolID, metadata.AttributeBufferPoolInstancePagesOld)
+
+		// page operations
+		m.mb.RecordMysqlBufferPoolInstancePageOperationsDataPoint(now, s.pagesCreated, s.poolID, metadata.AttributePageOperationsCreated)
+		m.mb.RecordMysqlBufferPoolInstancePageOperationsDataPoint(now, s.pagesRead, s.poolID, metadata.AttributePageOperationsRead)
+		m.mb.RecordMysqlBufferPoolInstancePageOperationsDataPoint(now, s.pagesWritten, s.poolID, metadata.AttributePageOperationsWritten)
+	}
+}
+
+func (m *mySQLScraper) scrapeInnodbRedoLogStats(now pcommon.Timestamp, innodbStats map[string]string, errs *scrapererror.ScrapeErrors) {
+	capacity := m.redoLogCapacity(errs)
+	if capacity > 0 {
+		m.mb.RecordMysqlInnodbRedoLogCapacityDataPoint(now, capacity)
+	}
+
+	current, currentOk := innodbStats["log_lsn_current"]
+	lastCheckpoint, lastCheckpointOk := innodbStats["log_lsn_last_checkpoint"]
+	if !currentOk || !lastCheckpointOk {
+		// the counters are not enabled on the server.
+		return
+	}
+
+	currentLSN, err := parseInt(current)
+	if err != nil {
+		errs.AddPartial(2, err) // checkpoint age and redo log occupancy are lost here
+		return
+	}
+	lastCheckpointLSN, err := parseInt(lastCheckpoint)
+	if err != nil {
+		errs.AddPartial(2, err)
+		return
+	}
+
+	age := currentLSN - lastCheckpointLSN
+	m.mb.RecordMysqlInnodbCheckpointAgeDataPoint(now, age)
+	if capacity > 0 {
+		m.mb.RecordMysqlInnodbRedoLogOccupancyDataPoint(now, float64(age)/float64(capacity))
+	}
+}
+
+// redoLogCapacity returns the redo log capacity in bytes, or 0 if it is unknown.
+// MySQL 8.0.30 replaced innodb_log_file_size and innodb_log_files_in_group by innodb_redo_log_capacity.
+func (m *mySQLScraper) redoLogCapacity(errs *scrapererror.ScrapeErrors) int64 {
+	metrics := m.config.MetricsBuilderConfig.Metrics
+	if !metrics.MysqlInnodbRedoLogCapacity.Enabled && !metrics.MysqlInnodbRedoLogOccupancy.Enabled {
+		return 0
+	}
+
+	variables, err := m.sqlclient.getInnodbRedoLogVariables()
+	if err != nil {
+		m.logger.Error("Failed to fetch InnoDB redo log variables", zap.Error(err))
+		errs.AddPartial(2, err)
+		return 0
+	}
+
+	if v, ok := variables["innodb_redo_log_capacity"]; ok {
+		capacity, err := parseInt(v)
+		if err != nil {
+			errs.AddPartial(2, err)
+			return 0
+		}
+		return capacity
+	}
+
+	fileSize, err := parseInt(variables["innodb_log_file_size"])
+	if err != nil {
+		errs.AddPartial(2, err)
+		return 0
+	}
+	filesInGroup, err := parseInt(variables["innodb_log_files_in_group"])
+	if err != nil {
+		errs.AddPartial(2, err)
+		return 0
+	}
+	return fileSize * filesInGroup
+}
+
 func (m *mySQLScraper) scrapeReplicaStatusStats(now pcommon.Timestamp) {
 	replicaStatusStats, err := m.sqlclient.getReplicaStatusStats()
 	if err != nil {
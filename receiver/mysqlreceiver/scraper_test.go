@@ -53,10 +53,19 @@ func TestScrape(t *testing.T) {
 
 		cfg.MetricsBuilderConfig.Metrics.MysqlConnectionCount.Enabled = true
 
+		cfg.MetricsBuilderConfig.Metrics.MysqlBufferPoolInstancePages.Enabled = true
+		cfg.MetricsBuilderConfig.Metrics.MysqlBufferPoolInstancePageOperations.Enabled = true
+		cfg.MetricsBuilderConfig.Metrics.MysqlInnodbCheckpointAge.Enabled = true
+		cfg.MetricsBuilderConfig.Metrics.MysqlInnodbCheckpointAgeThreshold.Enabled = true
+		cfg.MetricsBuilderConfig.Metrics.MysqlInnodbRedoLogCapacity.Enabled = true
+		cfg.MetricsBuilderConfig.Metrics.MysqlInnodbRedoLogOccupancy.Enabled = true
+
 		scraper := newMySQLScraper(receivertest.NewNopCreateSettings(), cfg)
 		scraper.sqlclient = &mockClient{
 			globalStatsFile:             "global_stats",
 			innodbStatsFile:             "innodb_stats",
+			innodbBufferPoolStatsFile:   "innodb_buffer_pool_stats",
+			innodbRedoLogVariablesFile:  "innodb_redo_log_variables",
 			tableIoWaitsFile:            "table_io_waits_stats",
 			indexIoWaitsFile:            "index_io_waits_stats",
 			statementEventsFile:         "statement_events",
@@ -125,6 +134,8 @@ var _ client = (*mockClient)(nil)
 type mockClient struct {
 	globalStatsFile             string
 	innodbStatsFile             string
+	innodbBufferPoolStatsFile   string
+	innodbRedoLogVariablesFile  string
 	tableIoWaitsFile            string
 	indexIoWaitsFile            string
 	statementEventsFile         string
@@ -164,6 +175,37 @@ func (c *mockClient) getInnodbStats() (map[string]string, error) {
 	return readFile(c.innodbStatsFile)
 }
 
+func (c *mockClient) getInnodbBufferPoolStats() ([]innodbBufferPoolStats, error) {
+	var stats []innodbBufferPoolStats
+	file, err := os.Open(filepath.Join("testdata", "scraper", c.innodbBufferPoolStatsFile+".txt"))
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var s innodbBufferPoolStats
+		text := strings.Split(scanner.Text(), "\t")
+
+		s.poolID, _ = parseInt(text[0])
+		s.freeBuffers, _ = parseInt(text[1])
+		s.databasePages, _ = parseInt(text[2])
+		s.oldDatabasePages, _ = parseInt(text[3])
+		s.modifiedDatabasePages, _ = parseInt(text[4])
+		s.pagesCreated, _ = parseInt(text[5])
+		s.pagesRead, _ = parseInt(text[6])
+		s.pagesWritten, _ = parseInt(text[7])
+
+		stats = append(stats, s)
+	}
+	return stats, nil
+}
+
+func (c *mockClient) getInnodbRedoLogVariables() (map[string]string, error) {
+	return readFile(c.innodbRedoLogVariablesFile)
+}
+
 func (c *mockClient) getTableIoWaitsStats() ([]TableIoWaitsStats, error) {
 	var stats []TableIoWaitsStats
 	file, err := os.Open(filepath.Join("testdata", "scraper", c.tableIoWaitsFile+".txt"))
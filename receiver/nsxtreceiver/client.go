@@ -4,6 +4,7 @@
 package nsxtreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/nsxtreceiver"
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
@@ -27,6 +28,8 @@ type Client interface {
 	NodeStatus(ctx context.Context, nodeID string, class nodeClass) (*dm.NodeStatus, error)
 	Interfaces(ctx context.Context, nodeID string, class nodeClass) ([]dm.NetworkInterface, error)
 	InterfaceStatus(ctx context.Context, nodeID, interfaceID string, class nodeClass) (*dm.NetworkInterfaceStats, error)
+	Alarms(ctx context.Context) ([]dm.Alarm, error)
+	AuditLogs(ctx context.Context) ([]dm.AuditLog, error)
 }
 
 type nsxClient struct {
@@ -40,6 +43,13 @@ var (
 	errUnauthorized = errors.New("STATUS 403, unauthorized")
 )
 
+const (
+	// maxPages limits how many pages of alarms or audit logs are requested during a single poll
+	maxPages = 10
+	// auditLogsPageSize is the number of audit logs returned in a single page
+	auditLogsPageSize = 100
+)
+
 func newClient(ctx context.Context, c *Config, settings component.TelemetrySettings, host component.Host, logger *zap.Logger) (*nsxClient, error) {
 	client, err := c.ClientConfig.ToClient(ctx, host, settings)
 	if err != nil {
@@ -142,13 +152,76 @@ func (c *nsxClient) InterfaceStatus(
 	return &interfaceStats, err
 }
 
+func (c *nsxClient) Alarms(ctx context.Context) ([]dm.Alarm, error) {
+	var alarms []dm.Alarm
+	cursor := ""
+	for page := 0; page < maxPages; page++ {
+		path := "/api/v1/alarms"
+		if cursor != "" {
+			path += "?cursor=" + url.QueryEscape(cursor)
+		}
+		body, err := c.doRequest(ctx, path)
+		if err != nil {
+			return nil, fmt.Errorf("unable to get alarms: %w", err)
+		}
+		var result dm.AlarmListResult
+		if err = json.Unmarshal(body, &result); err != nil {
+			return nil, err
+		}
+		alarms = append(alarms, result.Results...)
+		if result.Cursor == "" {
+			break
+		}
+		cursor = result.Cursor
+	}
+	return alarms, nil
+}
+
+func (c *nsxClient) AuditLogs(ctx context.Context) ([]dm.AuditLog, error) {
+	var logs []dm.AuditLog
+	filter := dm.AuditLogRequest{
+		// the API only allows limiting the age of the logs in days, more recent logs are filtered out by the receiver
+		LogAgeLimit: 1,
+		PageSize:    auditLogsPageSize,
+	}
+	for page := 0; page < maxPages; page++ {
+		reqBody, err := json.Marshal(filter)
+		if err != nil {
+			return nil, err
+		}
+		body, err := c.doRequestWithBody(ctx, http.MethodPost, "/api/v1/administration/audit-logs", reqBody)
+		if err != nil {
+			return nil, fmt.Errorf("unable to get audit logs: %w", err)
+		}
+		var result dm.AuditLogListResult
+		if err = json.Unmarshal(body, &result); err != nil {
+			return nil, err
+		}
+		logs = append(logs, result.Results...)
+		if result.Cursor == "" {
+			break
+		}
+		filter.Cursor = result.Cursor
+	}
+	return logs, nil
+}
+
 func (c *nsxClient) doRequest(ctx context.Context, path string) ([]byte, error) {
+	return c.doRequestWithBody(ctx, http.MethodGet, path, nil)
+}
+
+func (c *nsxClient) doRequestWithBody(ctx context.Context, method, path string, reqBody []byte) ([]byte, error) {
 	endpoint, err := c.endpoint.Parse(path)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint.String(), nil)
+	var bodyReader io.Reader
+	if reqBody != nil {
+		bodyReader = bytes.NewReader(reqBody)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, endpoint.String(), bodyReader)
 	if err != nil {
 		return nil, err
 	}
@@ -157,6 +230,9 @@ func (c *nsxClient) doRequest(ctx context.Context, path string) ([]byte, error)
 	h.Add("User-Agent", "opentelemetry-collector")
 	h.Add("Accept", "application/json")
 	h.Add("Connection", "keep-alive")
+	if reqBody != nil {
+		h.Add("Content-Type", "application/json")
+	}
 
 	resp, err := c.client.Do(req)
 	if err != nil {
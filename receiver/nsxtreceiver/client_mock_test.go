@@ -27,6 +27,48 @@ type MockClient struct {
 	mock.Mock
 }
 
+// Alarms provides a mock function with given fields: ctx
+func (m *MockClient) Alarms(ctx context.Context) ([]model.Alarm, error) {
+	ret := m.Called(ctx)
+
+	var r0 []model.Alarm
+	if rf, ok := ret.Get(0).(func(context.Context) []model.Alarm); ok {
+		r0 = rf(ctx)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]model.Alarm)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuditLogs provides a mock function with given fields: ctx
+func (m *MockClient) AuditLogs(ctx context.Context) ([]model.AuditLog, error) {
+	ret := m.Called(ctx)
+
+	var r0 []model.AuditLog
+	if rf, ok := ret.Get(0).(func(context.Context) []model.AuditLog); ok {
+		r0 = rf(ctx)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]model.AuditLog)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ClusterNodes provides a mock function with given fields: ctx
 func (m *MockClient) ClusterNodes(ctx context.Context) ([]model.ClusterNode, error) {
 	ret := m.Called(ctx)
@@ -154,6 +154,36 @@ func TestManagerNodeInterfaceStatus(t *testing.T) {
 	require.NotZero(t, iStats.RxBytes)
 }
 
+func TestAlarms(t *testing.T) {
+	nsxMock := mockServer(t)
+	defer nsxMock.Close()
+
+	client, err := newClient(context.Background(), &Config{
+		ClientConfig: confighttp.ClientConfig{
+			Endpoint: nsxMock.URL,
+		},
+	}, componenttest.NewNopTelemetrySettings(), componenttest.NewNopHost(), zap.NewNop())
+	require.NoError(t, err)
+	alarms, err := client.Alarms(context.Background())
+	require.NoError(t, err)
+	require.Len(t, alarms, 2)
+}
+
+func TestAuditLogs(t *testing.T) {
+	nsxMock := mockServer(t)
+	defer nsxMock.Close()
+
+	client, err := newClient(context.Background(), &Config{
+		ClientConfig: confighttp.ClientConfig{
+			Endpoint: nsxMock.URL,
+		},
+	}, componenttest.NewNopTelemetrySettings(), componenttest.NewNopHost(), zap.NewNop())
+	require.NoError(t, err)
+	logs, err := client.AuditLogs(context.Background())
+	require.NoError(t, err)
+	require.Len(t, logs, 2)
+}
+
 func TestDoRequestBadUrl(t *testing.T) {
 	nsxMock := mockServer(t)
 	defer nsxMock.Close()
@@ -292,6 +322,12 @@ func mockServer(t *testing.T) *httptest.Server {
 	mNodeInterfaceStats, err := os.ReadFile(filepath.Join("testdata", "metrics", "nodes", "cluster", managerNode1, "interfaces", managerNodeNic1, "stats.json"))
 	require.NoError(t, err)
 
+	alarms, err := os.ReadFile(filepath.Join("testdata", "logs", "alarms.json"))
+	require.NoError(t, err)
+
+	auditLogs, err := os.ReadFile(filepath.Join("testdata", "logs", "audit_logs.json"))
+	require.NoError(t, err)
+
 	nsxMock := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
 		authUser, authPass, ok := req.BasicAuth()
 		switch {
@@ -362,6 +398,20 @@ func mockServer(t *testing.T) *httptest.Server {
 			return
 		}
 
+		if req.URL.Path == "/api/v1/alarms" {
+			rw.WriteHeader(200)
+			_, err = rw.Write(alarms)
+			require.NoError(t, err)
+			return
+		}
+
+		if req.URL.Path == "/api/v1/administration/audit-logs" && req.Method == http.MethodPost {
+			rw.WriteHeader(200)
+			_, err = rw.Write(auditLogs)
+			require.NoError(t, err)
+			return
+		}
+
 		rw.WriteHeader(404)
 	}))
 
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"time"
 
 	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/config/configopaque"
@@ -23,6 +24,18 @@ type Config struct {
 	metadata.MetricsBuilderConfig  `mapstructure:",squash"`
 	Username                       string              `mapstructure:"username"`
 	Password                       configopaque.String `mapstructure:"password"`
+	Logs                           LogsConfig          `mapstructure:"logs"`
+}
+
+// LogsConfig is the configuration for collecting NSX Manager alarms and audit logs when
+// the receiver is used in a logs pipeline
+type LogsConfig struct {
+	// PollInterval is how often the NSX Manager is queried for new alarms and audit logs
+	PollInterval time.Duration `mapstructure:"poll_interval"`
+	// Alarms enables the collection of alarms raised by NSX features
+	Alarms bool `mapstructure:"alarms"`
+	// AuditLogs enables the collection of the NSX Manager's audit logs
+	AuditLogs bool `mapstructure:"audit_logs"`
 }
 
 // Validate returns if the NSX configuration is valid
@@ -102,6 +102,8 @@ func TestLoadConfig(t *testing.T) {
 	expected.Password = "${env:NSXT_PASSWORD}"
 	expected.TLSSetting.Insecure = true
 	expected.CollectionInterval = time.Minute
+	expected.Logs.PollInterval = 5 * time.Minute
+	expected.Logs.AuditLogs = true
 
 	require.Equal(t, expected, cfg)
 }
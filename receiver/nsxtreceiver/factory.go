@@ -6,6 +6,7 @@ package nsxtreceiver // import "github.com/open-telemetry/opentelemetry-collecto
 import (
 	"context"
 	"errors"
+	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
@@ -17,12 +18,15 @@ import (
 
 var errConfigNotNSX = errors.New("config was not a NSX receiver config")
 
+const defaultLogsPollInterval = time.Minute
+
 // NewFactory creates a new receiver factory
 func NewFactory() receiver.Factory {
 	return receiver.NewFactory(
 		metadata.Type,
 		createDefaultConfig,
 		receiver.WithMetrics(createMetricsReceiver, metadata.MetricsStability),
+		receiver.WithLogs(createLogsReceiver, metadata.LogsStability),
 	)
 }
 
@@ -30,6 +34,10 @@ func createDefaultConfig() component.Config {
 	return &Config{
 		ControllerConfig:     scraperhelper.NewDefaultControllerConfig(),
 		MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+		Logs: LogsConfig{
+			PollInterval: defaultLogsPollInterval,
+			Alarms:       true,
+		},
 	}
 }
 
@@ -56,3 +64,11 @@ func createMetricsReceiver(_ context.Context, params receiver.CreateSettings, rC
 		scraperhelper.AddScraper(scraper),
 	)
 }
+
+func createLogsReceiver(_ context.Context, params receiver.CreateSettings, rConf component.Config, consumer consumer.Logs) (receiver.Logs, error) {
+	cfg, ok := rConf.(*Config)
+	if !ok {
+		return nil, errConfigNotNSX
+	}
+	return newLogsReceiver(cfg, params, consumer), nil
+}
@@ -0,0 +1,74 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package model // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/nsxtreceiver/internal/model"
+
+// AlarmListResult wraps the results of a page of the NSX Manager's alarms
+type AlarmListResult struct {
+	Results     []Alarm `json:"results"`
+	Cursor      string  `json:"cursor"`
+	ResultCount int     `json:"result_count"`
+}
+
+// Alarm is an alarm raised by an NSX feature for one of its event types
+type Alarm struct {
+	ID                   string `json:"id"`
+	FeatureName          string `json:"feature_name"`
+	FeatureDisplayName   string `json:"feature_display_name"`
+	EventType            string `json:"event_type"`
+	EventTypeDisplayName string `json:"event_type_display_name"`
+	EntityID             string `json:"entity_id"`
+	NodeID               string `json:"node_id"`
+	NodeDisplayName      string `json:"node_display_name"`
+	NodeResourceType     string `json:"node_resource_type"`
+	// Severity is one of CRITICAL, HIGH, MEDIUM or LOW
+	Severity string `json:"severity"`
+	// Status is one of OPEN, ACKNOWLEDGED, SUPPRESSED or RESOLVED
+	Status            string `json:"status"`
+	Description       string `json:"description"`
+	RecommendedAction string `json:"recommended_action"`
+	// timestamps are expressed in milliseconds since epoch
+	CreateTime       int64 `json:"_create_time"`
+	LastModifiedTime int64 `json:"_last_modified_time"`
+	ResolvedTime     int64 `json:"resolved_time"`
+}
+
+// AuditLogListResult wraps the results of a page of the NSX Manager's audit logs
+type AuditLogListResult struct {
+	Results     []AuditLog `json:"results"`
+	Cursor      string     `json:"cursor"`
+	ResultCount int        `json:"result_count"`
+}
+
+// AuditLog is a single RFC 5424 formatted audit log message of the NSX Manager
+type AuditLog struct {
+	Timestamp   string             `json:"timestamp"`
+	Hostname    string             `json:"hostname"`
+	Appname     string             `json:"appname"`
+	Procid      int64              `json:"procid"`
+	Msgid       string             `json:"msgid"`
+	Facility    int64              `json:"facility"`
+	Priority    int64              `json:"priority"`
+	Message     string             `json:"message"`
+	FullMessage string             `json:"full_message"`
+	StructData  AuditLogStructData `json:"struct_data"`
+}
+
+// AuditLogStructData is the structured data element of an audit log message
+type AuditLogStructData struct {
+	Audit     string `json:"audit"`
+	Comp      string `json:"comp"`
+	Subcomp   string `json:"subcomp"`
+	EntityID  string `json:"entId"`
+	ErrorCode string `json:"errorCode"`
+	Level     string `json:"level"`
+	ReqID     string `json:"reqId"`
+	Username  string `json:"username"`
+}
+
+// AuditLogRequest is the filter used to query the NSX Manager's audit logs
+type AuditLogRequest struct {
+	LogAgeLimit int    `json:"log_age_limit,omitempty"`
+	PageSize    int    `json:"page_size,omitempty"`
+	Cursor      string `json:"cursor,omitempty"`
+}
@@ -0,0 +1,277 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package nsxtreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/nsxtreceiver"
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/receiver"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+
+	dm "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/nsxtreceiver/internal/model"
+)
+
+// logsScopeName matches the scope name of the metrics emitted by the receiver
+const logsScopeName = "otelcol/nsxtreceiver"
+
+// logsReceiver polls the NSX Manager for alarms and audit logs and converts them to logs
+type logsReceiver struct {
+	config       *Config
+	settings     component.TelemetrySettings
+	consumer     consumer.Logs
+	client       Client
+	pollInterval time.Duration
+	cancel       context.CancelFunc
+	wg           *sync.WaitGroup
+
+	// lastAlarmTime and lastAuditLogTime record the most recent entries that have been emitted
+	lastAlarmTime    time.Time
+	lastAuditLogTime time.Time
+}
+
+func newLogsReceiver(cfg *Config, settings receiver.CreateSettings, consumer consumer.Logs) *logsReceiver {
+	r := &logsReceiver{
+		config:       cfg,
+		settings:     settings.TelemetrySettings,
+		consumer:     consumer,
+		pollInterval: cfg.Logs.PollInterval,
+		wg:           &sync.WaitGroup{},
+	}
+
+	if r.pollInterval == 0 {
+		r.pollInterval = defaultLogsPollInterval
+	}
+
+	return r
+}
+
+func (r *logsReceiver) Start(ctx context.Context, host component.Host) error {
+	if r.client == nil {
+		client, err := newClient(ctx, r.config, r.settings, host, r.settings.Logger)
+		if err != nil {
+			return fmt.Errorf("unable to construct http client: %w", err)
+		}
+		r.client = client
+	}
+
+	start := time.Now().Add(-r.pollInterval)
+	r.lastAlarmTime = start
+	r.lastAuditLogTime = start
+
+	cancelCtx, cancel := context.WithCancel(context.Background())
+	r.cancel = cancel
+	r.startPolling(cancelCtx)
+	return nil
+}
+
+func (r *logsReceiver) Shutdown(context.Context) error {
+	if r.cancel != nil {
+		r.cancel()
+	}
+	r.wg.Wait()
+	return nil
+}
+
+func (r *logsReceiver) startPolling(ctx context.Context) {
+	t := time.NewTicker(r.pollInterval)
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		defer t.Stop()
+		for {
+			select {
+			case <-t.C:
+				if err := r.poll(ctx); err != nil {
+					r.settings.Logger.Error("error while polling the NSX Manager for logs", zap.Error(err))
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+func (r *logsReceiver) poll(ctx context.Context) error {
+	now := pcommon.NewTimestampFromTime(time.Now())
+	logs := plog.NewLogs()
+
+	var errs error
+	if r.config.Logs.Alarms {
+		alarms, err := r.client.Alarms(ctx)
+		if err != nil {
+			errs = multierr.Append(errs, err)
+		} else {
+			r.processAlarms(logs, now, alarms)
+		}
+	}
+
+	if r.config.Logs.AuditLogs {
+		auditLogs, err := r.client.AuditLogs(ctx)
+		if err != nil {
+			errs = multierr.Append(errs, err)
+		} else {
+			r.processAuditLogs(logs, now, auditLogs)
+		}
+	}
+
+	if logs.LogRecordCount() > 0 {
+		if err := r.consumer.ConsumeLogs(ctx, logs); err != nil {
+			errs = multierr.Append(errs, err)
+		}
+	}
+	return errs
+}
+
+// processAlarms converts the alarms modified since the last poll into logs, grouped by the node that raised them
+func (r *logsReceiver) processAlarms(logs plog.Logs, now pcommon.Timestamp, alarms []dm.Alarm) {
+	latest := r.lastAlarmTime
+	scopeLogsByNode := map[string]plog.ScopeLogs{}
+	for _, alarm := range alarms {
+		modified := time.UnixMilli(alarm.LastModifiedTime)
+		if !modified.After(r.lastAlarmTime) {
+			continue
+		}
+		if modified.After(latest) {
+			latest = modified
+		}
+
+		sl, ok := scopeLogsByNode[alarm.NodeID]
+		if !ok {
+			rl := logs.ResourceLogs().AppendEmpty()
+			attrs := rl.Resource().Attributes()
+			attrs.PutStr("nsxt.node.id", alarm.NodeID)
+			attrs.PutStr("nsxt.node.name", alarm.NodeDisplayName)
+			if alarm.NodeResourceType != "" {
+				attrs.PutStr("nsxt.node.type", alarm.NodeResourceType)
+			}
+			sl = rl.ScopeLogs().AppendEmpty()
+			sl.Scope().SetName(logsScopeName)
+			scopeLogsByNode[alarm.NodeID] = sl
+		}
+
+		lr := sl.LogRecords().AppendEmpty()
+		lr.SetObservedTimestamp(now)
+		lr.SetTimestamp(pcommon.NewTimestampFromTime(modified))
+		lr.SetSeverityNumber(severityFromAlarm(alarm))
+		lr.SetSeverityText(alarm.Severity)
+		lr.Body().SetStr(alarm.Description)
+
+		attrs := lr.Attributes()
+		attrs.PutStr("event.domain", "nsxt")
+		attrs.PutStr("event.name", alarm.EventType)
+		attrs.PutStr("nsxt.alarm.id", alarm.ID)
+		attrs.PutStr("nsxt.alarm.feature", alarm.FeatureName)
+		attrs.PutStr("nsxt.alarm.status", alarm.Status)
+		putStrIfNotEmpty(attrs, "nsxt.alarm.entity_id", alarm.EntityID)
+		putStrIfNotEmpty(attrs, "nsxt.alarm.recommended_action", alarm.RecommendedAction)
+		if alarm.ResolvedTime != 0 {
+			attrs.PutStr("nsxt.alarm.resolved_time", time.UnixMilli(alarm.ResolvedTime).UTC().Format(time.RFC3339))
+		}
+	}
+	r.lastAlarmTime = latest
+}
+
+// processAuditLogs converts the audit logs written since the last poll into logs, grouped by the manager that wrote them
+func (r *logsReceiver) processAuditLogs(logs plog.Logs, now pcommon.Timestamp, auditLogs []dm.AuditLog) {
+	latest := r.lastAuditLogTime
+	scopeLogsByHost := map[string]plog.ScopeLogs{}
+	for _, auditLog := range auditLogs {
+		ts, err := time.Parse(time.RFC3339Nano, auditLog.Timestamp)
+		if err != nil {
+			r.settings.Logger.Warn("unable to interpret timestamp of audit log, expecting a RFC3339 timestamp", zap.String("timestamp", auditLog.Timestamp))
+			continue
+		}
+		if !ts.After(r.lastAuditLogTime) {
+			continue
+		}
+		if ts.After(latest) {
+			latest = ts
+		}
+
+		sl, ok := scopeLogsByHost[auditLog.Hostname]
+		if !ok {
+			rl := logs.ResourceLogs().AppendEmpty()
+			rl.Resource().Attributes().PutStr("host.name", auditLog.Hostname)
+			sl = rl.ScopeLogs().AppendEmpty()
+			sl.Scope().SetName(logsScopeName)
+			scopeLogsByHost[auditLog.Hostname] = sl
+		}
+
+		lr := sl.LogRecords().AppendEmpty()
+		lr.SetObservedTimestamp(now)
+		lr.SetTimestamp(pcommon.NewTimestampFromTime(ts))
+		// the severity is encoded in the lowest 3 bits of the syslog priority
+		severity := auditLog.Priority & 0x7
+		lr.SetSeverityNumber(syslogSeverityMapping[severity])
+		lr.SetSeverityText(syslogSeverityText[severity])
+		lr.Body().SetStr(auditLog.Message)
+
+		attrs := lr.Attributes()
+		attrs.PutStr("event.domain", "nsxt")
+		attrs.PutStr("event.name", "audit")
+		putStrIfNotEmpty(attrs, "appname", auditLog.Appname)
+		putStrIfNotEmpty(attrs, "msg_id", auditLog.Msgid)
+		putStrIfNotEmpty(attrs, "nsxt.audit.component", auditLog.StructData.Comp)
+		putStrIfNotEmpty(attrs, "nsxt.audit.subcomponent", auditLog.StructData.Subcomp)
+		putStrIfNotEmpty(attrs, "nsxt.audit.error_code", auditLog.StructData.ErrorCode)
+		putStrIfNotEmpty(attrs, "nsxt.audit.request_id", auditLog.StructData.ReqID)
+		putStrIfNotEmpty(attrs, "enduser.id", auditLog.StructData.Username)
+	}
+	r.lastAuditLogTime = latest
+}
+
+// severityFromAlarm maps the severity of an NSX alarm to a severity number.
+// Resolved alarms are always reported as INFO.
+func severityFromAlarm(alarm dm.Alarm) plog.SeverityNumber {
+	if alarm.Status == "RESOLVED" {
+		return plog.SeverityNumberInfo
+	}
+	switch alarm.Severity {
+	case "CRITICAL":
+		return plog.SeverityNumberFatal
+	case "HIGH":
+		return plog.SeverityNumberError
+	case "MEDIUM":
+		return plog.SeverityNumberWarn
+	default:
+		return plog.SeverityNumberInfo
+	}
+}
+
+// syslogSeverityMapping maps the severity of a syslog priority to a severity number
+var syslogSeverityMapping = [...]plog.SeverityNumber{
+	0: plog.SeverityNumberFatal,
+	1: plog.SeverityNumberError3,
+	2: plog.SeverityNumberError2,
+	3: plog.SeverityNumberError,
+	4: plog.SeverityNumberWarn,
+	5: plog.SeverityNumberInfo2,
+	6: plog.SeverityNumberInfo,
+	7: plog.SeverityNumberDebug,
+}
+
+var syslogSeverityText = [...]string{
+	0: "emerg",
+	1: "alert",
+	2: "crit",
+	3: "err",
+	4: "warning",
+	5: "notice",
+	6: "info",
+	7: "debug",
+}
+
+func putStrIfNotEmpty(attrs pcommon.Map, key, value string) {
+	if value != "" {
+		attrs.PutStr(key, value)
+	}
+}
@@ -0,0 +1,122 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package nsxtreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/nsxtreceiver"
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+
+	dm "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/nsxtreceiver/internal/model"
+)
+
+func TestPollLogs(t *testing.T) {
+	mockClient := newMockClient(t)
+	mockClient.On("Alarms", mock.Anything).Return(loadTestAlarms(t))
+	mockClient.On("AuditLogs", mock.Anything).Return(loadTestAuditLogs(t))
+
+	sink := &consumertest.LogsSink{}
+	cfg := createDefaultConfig().(*Config)
+	cfg.Logs.AuditLogs = true
+	r := newLogsReceiver(cfg, receivertest.NewNopCreateSettings(), sink)
+	r.client = mockClient
+	// the first alarm has already been emitted by a previous poll
+	r.lastAlarmTime = time.UnixMilli(1652360400000)
+
+	require.NoError(t, r.poll(context.Background()))
+	require.Len(t, sink.AllLogs(), 1)
+
+	logs := sink.AllLogs()[0]
+	require.Equal(t, 3, logs.LogRecordCount())
+	require.Equal(t, 2, logs.ResourceLogs().Len())
+
+	alarms := logs.ResourceLogs().At(0)
+	nodeID, ok := alarms.Resource().Attributes().Get("nsxt.node.id")
+	require.True(t, ok)
+	require.Equal(t, transportNode1, nodeID.Str())
+	alarm := alarms.ScopeLogs().At(0).LogRecords().At(0)
+	require.Equal(t, plog.SeverityNumberInfo, alarm.SeverityNumber())
+	require.Equal(t, "CRITICAL", alarm.SeverityText())
+	eventName, ok := alarm.Attributes().Get("event.name")
+	require.True(t, ok)
+	require.Equal(t, "edge_cpu_usage_very_high", eventName.Str())
+
+	auditLogs := logs.ResourceLogs().At(1).ScopeLogs().At(0).LogRecords()
+	require.Equal(t, 2, auditLogs.Len())
+	require.Equal(t, plog.SeverityNumberInfo, auditLogs.At(0).SeverityNumber())
+	require.Equal(t, "info", auditLogs.At(0).SeverityText())
+	require.Equal(t, plog.SeverityNumberError, auditLogs.At(1).SeverityNumber())
+	require.Equal(t, "err", auditLogs.At(1).SeverityText())
+	username, ok := auditLogs.At(1).Attributes().Get("enduser.id")
+	require.True(t, ok)
+	require.Equal(t, "admin", username.Str())
+
+	// nothing new has been raised since the previous poll
+	require.NoError(t, r.poll(context.Background()))
+	require.Len(t, sink.AllLogs(), 1)
+}
+
+func TestPollLogsErrors(t *testing.T) {
+	mockClient := newMockClient(t)
+	mockClient.On("Alarms", mock.Anything).Return(nil, errors.New("unable to get alarms"))
+
+	sink := &consumertest.LogsSink{}
+	r := newLogsReceiver(createDefaultConfig().(*Config), receivertest.NewNopCreateSettings(), sink)
+	r.client = mockClient
+
+	require.ErrorContains(t, r.poll(context.Background()), "unable to get alarms")
+	require.Empty(t, sink.AllLogs())
+}
+
+func TestSeverityFromAlarm(t *testing.T) {
+	cases := []struct {
+		severity string
+		status   string
+		expected plog.SeverityNumber
+	}{
+		{severity: "CRITICAL", status: "OPEN", expected: plog.SeverityNumberFatal},
+		{severity: "HIGH", status: "ACKNOWLEDGED", expected: plog.SeverityNumberError},
+		{severity: "MEDIUM", status: "OPEN", expected: plog.SeverityNumberWarn},
+		{severity: "LOW", status: "OPEN", expected: plog.SeverityNumberInfo},
+		{severity: "CRITICAL", status: "RESOLVED", expected: plog.SeverityNumberInfo},
+	}
+	for _, tc := range cases {
+		t.Run(tc.severity+"/"+tc.status, func(t *testing.T) {
+			require.Equal(t, tc.expected, severityFromAlarm(dm.Alarm{Severity: tc.severity, Status: tc.status}))
+		})
+	}
+}
+
+func TestLogsReceiverLifecycle(t *testing.T) {
+	r := newLogsReceiver(createDefaultConfig().(*Config), receivertest.NewNopCreateSettings(), &consumertest.LogsSink{})
+	r.client = newMockClient(t)
+	require.NoError(t, r.Start(context.Background(), nil))
+	require.NoError(t, r.Shutdown(context.Background()))
+}
+
+func loadTestAlarms(t *testing.T) ([]dm.Alarm, error) {
+	testFile, err := os.ReadFile(filepath.Join("testdata", "logs", "alarms.json"))
+	require.NoError(t, err)
+	var alarms dm.AlarmListResult
+	err = json.Unmarshal(testFile, &alarms)
+	return alarms.Results, err
+}
+
+func loadTestAuditLogs(t *testing.T) ([]dm.AuditLog, error) {
+	testFile, err := os.ReadFile(filepath.Join("testdata", "logs", "audit_logs.json"))
+	require.NoError(t, err)
+	var auditLogs dm.AuditLogListResult
+	err = json.Unmarshal(testFile, &auditLogs)
+	return auditLogs.Results, err
+}
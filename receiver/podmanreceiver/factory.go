@@ -23,7 +23,8 @@ func NewFactory() receiver.Factory {
 	return receiver.NewFactory(
 		metadata.Type,
 		createDefaultReceiverConfig,
-		receiver.WithMetrics(createMetricsReceiver, metadata.MetricsStability))
+		receiver.WithMetrics(createMetricsReceiver, metadata.MetricsStability),
+		receiver.WithLogs(createLogsReceiver, metadata.LogsStability))
 }
 
 func createDefaultConfig() *Config {
@@ -52,3 +53,13 @@ func createMetricsReceiver(
 	podmanConfig := config.(*Config)
 	return newMetricsReceiver(ctx, params, podmanConfig, consumer, nil)
 }
+
+func createLogsReceiver(
+	ctx context.Context,
+	params receiver.CreateSettings,
+	config component.Config,
+	consumer consumer.Logs,
+) (receiver.Logs, error) {
+	podmanConfig := config.(*Config)
+	return newLogsReceiver(ctx, params, podmanConfig, consumer, nil)
+}
@@ -39,6 +39,12 @@ type MetricsConfig struct {
 	ContainerMemoryUsageTotal                    MetricConfig `mapstructure:"container.memory.usage.total"`
 	ContainerNetworkIoUsageRxBytes               MetricConfig `mapstructure:"container.network.io.usage.rx_bytes"`
 	ContainerNetworkIoUsageTxBytes               MetricConfig `mapstructure:"container.network.io.usage.tx_bytes"`
+	PodmanPodContainers                          MetricConfig `mapstructure:"podman.pod.containers"`
+	PodmanPodCPUPercent                          MetricConfig `mapstructure:"podman.pod.cpu.percent"`
+	PodmanPodCPUUsageTotal                       MetricConfig `mapstructure:"podman.pod.cpu.usage.total"`
+	PodmanPodMemoryUsageTotal                    MetricConfig `mapstructure:"podman.pod.memory.usage.total"`
+	PodmanPodNetworkIoUsageRxBytes               MetricConfig `mapstructure:"podman.pod.network.io.usage.rx_bytes"`
+	PodmanPodNetworkIoUsageTxBytes               MetricConfig `mapstructure:"podman.pod.network.io.usage.tx_bytes"`
 }
 
 func DefaultMetricsConfig() MetricsConfig {
@@ -76,6 +82,24 @@ func DefaultMetricsConfig() MetricsConfig {
 		ContainerNetworkIoUsageTxBytes: MetricConfig{
 			Enabled: true,
 		},
+		PodmanPodContainers: MetricConfig{
+			Enabled: false,
+		},
+		PodmanPodCPUPercent: MetricConfig{
+			Enabled: false,
+		},
+		PodmanPodCPUUsageTotal: MetricConfig{
+			Enabled: false,
+		},
+		PodmanPodMemoryUsageTotal: MetricConfig{
+			Enabled: false,
+		},
+		PodmanPodNetworkIoUsageRxBytes: MetricConfig{
+			Enabled: false,
+		},
+		PodmanPodNetworkIoUsageTxBytes: MetricConfig{
+			Enabled: false,
+		},
 	}
 }
 
@@ -111,6 +135,8 @@ type ResourceAttributesConfig struct {
 	ContainerImageName ResourceAttributeConfig `mapstructure:"container.image.name"`
 	ContainerName      ResourceAttributeConfig `mapstructure:"container.name"`
 	ContainerRuntime   ResourceAttributeConfig `mapstructure:"container.runtime"`
+	PodmanPodID        ResourceAttributeConfig `mapstructure:"podman.pod.id"`
+	PodmanPodName      ResourceAttributeConfig `mapstructure:"podman.pod.name"`
 }
 
 func DefaultResourceAttributesConfig() ResourceAttributesConfig {
@@ -127,6 +153,12 @@ func DefaultResourceAttributesConfig() ResourceAttributesConfig {
 		ContainerRuntime: ResourceAttributeConfig{
 			Enabled: true,
 		},
+		PodmanPodID: ResourceAttributeConfig{
+			Enabled: true,
+		},
+		PodmanPodName: ResourceAttributeConfig{
+			Enabled: true,
+		},
 	}
 }
 
@@ -37,12 +37,20 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					ContainerMemoryUsageTotal:                    MetricConfig{Enabled: true},
 					ContainerNetworkIoUsageRxBytes:               MetricConfig{Enabled: true},
 					ContainerNetworkIoUsageTxBytes:               MetricConfig{Enabled: true},
+					PodmanPodContainers:                          MetricConfig{Enabled: true},
+					PodmanPodCPUPercent:                          MetricConfig{Enabled: true},
+					PodmanPodCPUUsageTotal:                       MetricConfig{Enabled: true},
+					PodmanPodMemoryUsageTotal:                    MetricConfig{Enabled: true},
+					PodmanPodNetworkIoUsageRxBytes:               MetricConfig{Enabled: true},
+					PodmanPodNetworkIoUsageTxBytes:               MetricConfig{Enabled: true},
 				},
 				ResourceAttributes: ResourceAttributesConfig{
 					ContainerID:        ResourceAttributeConfig{Enabled: true},
 					ContainerImageName: ResourceAttributeConfig{Enabled: true},
 					ContainerName:      ResourceAttributeConfig{Enabled: true},
 					ContainerRuntime:   ResourceAttributeConfig{Enabled: true},
+					PodmanPodID:        ResourceAttributeConfig{Enabled: true},
+					PodmanPodName:      ResourceAttributeConfig{Enabled: true},
 				},
 			},
 		},
@@ -61,12 +69,20 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					ContainerMemoryUsageTotal:                    MetricConfig{Enabled: false},
 					ContainerNetworkIoUsageRxBytes:               MetricConfig{Enabled: false},
 					ContainerNetworkIoUsageTxBytes:               MetricConfig{Enabled: false},
+					PodmanPodContainers:                          MetricConfig{Enabled: false},
+					PodmanPodCPUPercent:                          MetricConfig{Enabled: false},
+					PodmanPodCPUUsageTotal:                       MetricConfig{Enabled: false},
+					PodmanPodMemoryUsageTotal:                    MetricConfig{Enabled: false},
+					PodmanPodNetworkIoUsageRxBytes:               MetricConfig{Enabled: false},
+					PodmanPodNetworkIoUsageTxBytes:               MetricConfig{Enabled: false},
 				},
 				ResourceAttributes: ResourceAttributesConfig{
 					ContainerID:        ResourceAttributeConfig{Enabled: false},
 					ContainerImageName: ResourceAttributeConfig{Enabled: false},
 					ContainerName:      ResourceAttributeConfig{Enabled: false},
 					ContainerRuntime:   ResourceAttributeConfig{Enabled: false},
+					PodmanPodID:        ResourceAttributeConfig{Enabled: false},
+					PodmanPodName:      ResourceAttributeConfig{Enabled: false},
 				},
 			},
 		},
@@ -107,6 +123,8 @@ func TestResourceAttributesConfig(t *testing.T) {
 				ContainerImageName: ResourceAttributeConfig{Enabled: true},
 				ContainerName:      ResourceAttributeConfig{Enabled: true},
 				ContainerRuntime:   ResourceAttributeConfig{Enabled: true},
+				PodmanPodID:        ResourceAttributeConfig{Enabled: true},
+				PodmanPodName:      ResourceAttributeConfig{Enabled: true},
 			},
 		},
 		{
@@ -116,6 +134,8 @@ func TestResourceAttributesConfig(t *testing.T) {
 				ContainerImageName: ResourceAttributeConfig{Enabled: false},
 				ContainerName:      ResourceAttributeConfig{Enabled: false},
 				ContainerRuntime:   ResourceAttributeConfig{Enabled: false},
+				PodmanPodID:        ResourceAttributeConfig{Enabled: false},
+				PodmanPodName:      ResourceAttributeConfig{Enabled: false},
 			},
 		},
 	}
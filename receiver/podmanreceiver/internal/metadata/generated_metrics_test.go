@@ -112,11 +112,31 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordContainerNetworkIoUsageTxBytesDataPoint(ts, 1)
 
+			allMetricsCount++
+			mb.RecordPodmanPodContainersDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordPodmanPodCPUPercentDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordPodmanPodCPUUsageTotalDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordPodmanPodMemoryUsageTotalDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordPodmanPodNetworkIoUsageRxBytesDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordPodmanPodNetworkIoUsageTxBytesDataPoint(ts, 1)
+
 			rb := mb.NewResourceBuilder()
 			rb.SetContainerID("container.id-val")
 			rb.SetContainerImageName("container.image.name-val")
 			rb.SetContainerName("container.name-val")
 			rb.SetContainerRuntime("container.runtime-val")
+			rb.SetPodmanPodID("podman.pod.id-val")
+			rb.SetPodmanPodName("podman.pod.name-val")
 			res := rb.Emit()
 			metrics := mb.Emit(WithResource(res))
 
@@ -292,6 +312,88 @@ func TestMetricsBuilder(t *testing.T) {
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
+				case "podman.pod.containers":
+					assert.False(t, validatedMetrics["podman.pod.containers"], "Found a duplicate in the metrics slice: podman.pod.containers")
+					validatedMetrics["podman.pod.containers"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Number of running containers of the pod.", ms.At(i).Description())
+					assert.Equal(t, "{containers}", ms.At(i).Unit())
+					assert.Equal(t, false, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "podman.pod.cpu.percent":
+					assert.False(t, validatedMetrics["podman.pod.cpu.percent"], "Found a duplicate in the metrics slice: podman.pod.cpu.percent")
+					validatedMetrics["podman.pod.cpu.percent"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Percent of CPU used by the containers of the pod.", ms.At(i).Description())
+					assert.Equal(t, "1", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
+				case "podman.pod.cpu.usage.total":
+					assert.False(t, validatedMetrics["podman.pod.cpu.usage.total"], "Found a duplicate in the metrics slice: podman.pod.cpu.usage.total")
+					validatedMetrics["podman.pod.cpu.usage.total"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Total CPU time consumed by the containers of the pod.", ms.At(i).Description())
+					assert.Equal(t, "s", ms.At(i).Unit())
+					assert.Equal(t, true, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "podman.pod.memory.usage.total":
+					assert.False(t, validatedMetrics["podman.pod.memory.usage.total"], "Found a duplicate in the metrics slice: podman.pod.memory.usage.total")
+					validatedMetrics["podman.pod.memory.usage.total"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Memory usage of the containers of the pod.", ms.At(i).Description())
+					assert.Equal(t, "By", ms.At(i).Unit())
+					assert.Equal(t, false, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "podman.pod.network.io.usage.rx_bytes":
+					assert.False(t, validatedMetrics["podman.pod.network.io.usage.rx_bytes"], "Found a duplicate in the metrics slice: podman.pod.network.io.usage.rx_bytes")
+					validatedMetrics["podman.pod.network.io.usage.rx_bytes"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Bytes received by the pod.", ms.At(i).Description())
+					assert.Equal(t, "By", ms.At(i).Unit())
+					assert.Equal(t, true, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "podman.pod.network.io.usage.tx_bytes":
+					assert.False(t, validatedMetrics["podman.pod.network.io.usage.tx_bytes"], "Found a duplicate in the metrics slice: podman.pod.network.io.usage.tx_bytes")
+					validatedMetrics["podman.pod.network.io.usage.tx_bytes"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Bytes sent by the pod.", ms.At(i).Description())
+					assert.Equal(t, "By", ms.At(i).Unit())
+					assert.Equal(t, true, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
 				}
 			}
 		})
@@ -49,6 +49,20 @@ func (rb *ResourceBuilder) SetContainerRuntime(val string) {
 	}
 }
 
+// SetPodmanPodID sets provided value as "podman.pod.id" attribute.
+func (rb *ResourceBuilder) SetPodmanPodID(val string) {
+	if rb.config.PodmanPodID.Enabled {
+		rb.res.Attributes().PutStr("podman.pod.id", val)
+	}
+}
+
+// SetPodmanPodName sets provided value as "podman.pod.name" attribute.
+func (rb *ResourceBuilder) SetPodmanPodName(val string) {
+	if rb.config.PodmanPodName.Enabled {
+		rb.res.Attributes().PutStr("podman.pod.name", val)
+	}
+}
+
 // Emit returns the built resource and resets the internal builder state.
 func (rb *ResourceBuilder) Emit() pcommon.Resource {
 	r := rb.res
@@ -17,15 +17,17 @@ func TestResourceBuilder(t *testing.T) {
 			rb.SetContainerImageName("container.image.name-val")
 			rb.SetContainerName("container.name-val")
 			rb.SetContainerRuntime("container.runtime-val")
+			rb.SetPodmanPodID("podman.pod.id-val")
+			rb.SetPodmanPodName("podman.pod.name-val")
 
 			res := rb.Emit()
 			assert.Equal(t, 0, rb.Emit().Attributes().Len()) // Second call should return empty Resource
 
 			switch test {
 			case "default":
-				assert.Equal(t, 4, res.Attributes().Len())
+				assert.Equal(t, 6, res.Attributes().Len())
 			case "all_set":
-				assert.Equal(t, 4, res.Attributes().Len())
+				assert.Equal(t, 6, res.Attributes().Len())
 			case "none_set":
 				assert.Equal(t, 0, res.Attributes().Len())
 				return
@@ -53,6 +55,16 @@ func TestResourceBuilder(t *testing.T) {
 			if ok {
 				assert.EqualValues(t, "container.runtime-val", val.Str())
 			}
+			val, ok = res.Attributes().Get("podman.pod.id")
+			assert.True(t, ok)
+			if ok {
+				assert.EqualValues(t, "podman.pod.id-val", val.Str())
+			}
+			val, ok = res.Attributes().Get("podman.pod.name")
+			assert.True(t, ok)
+			if ok {
+				assert.EqualValues(t, "podman.pod.name-val", val.Str())
+			}
 		})
 	}
 }
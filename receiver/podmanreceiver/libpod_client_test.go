@@ -229,8 +229,30 @@ func TestEvents(t *testing.T) {
 	assert.NoError(t, err)
 
 	expectedEvents := []event{
-		{ID: "49a4c52afb06e6b36b2941422a0adf47421dbfbf40503dbe17bd56b4570b6681", Status: "start"},
-		{ID: "d5c43c6954e4bfe62170c75f9f18f81da644bd35bfd22dbfafda349192d4940a", Status: "died"},
+		{
+			ID:     "49a4c52afb06e6b36b2941422a0adf47421dbfbf40503dbe17bd56b4570b6681",
+			Status: "start",
+			Type:   "container",
+			Action: "start",
+			Actor: eventActor{
+				ID:         "49a4c52afb06e6b36b2941422a0adf47421dbfbf40503dbe17bd56b4570b6681",
+				Attributes: map[string]string{"containerExitCode": "0", "image": "docker.io/library/httpd:latest", "name": "vigilant_jennings"},
+			},
+			Time:     1655230086,
+			TimeNano: 1655230086294801585,
+		},
+		{
+			ID:     "d5c43c6954e4bfe62170c75f9f18f81da644bd35bfd22dbfafda349192d4940a",
+			Status: "died",
+			Type:   "container",
+			Action: "died",
+			Actor: eventActor{
+				ID:         "d5c43c6954e4bfe62170c75f9f18f81da644bd35bfd22dbfafda349192d4940a",
+				Attributes: map[string]string{"containerExitCode": "0", "image": "docker.io/library/nginx:latest", "name": "relaxed_mccarthy"},
+			},
+			Time:     1655653026,
+			TimeNano: 1655653026340832435,
+		},
 	}
 
 	events, errs := cli.events(context.Background(), nil)
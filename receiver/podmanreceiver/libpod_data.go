@@ -35,8 +35,19 @@ type container struct {
 }
 
 type event struct {
-	ID     string
-	Status string
+	ID           string
+	Status       string
+	Type         string
+	Action       string
+	Actor        eventActor
+	Time         int64
+	TimeNano     int64
+	HealthStatus string
+}
+
+type eventActor struct {
+	ID         string
+	Attributes map[string]string
 }
 
 type containerStats struct {
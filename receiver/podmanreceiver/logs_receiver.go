@@ -0,0 +1,222 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !windows
+
+package podmanreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/podmanreceiver"
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/receiver"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/podmanreceiver/internal/metadata"
+)
+
+const (
+	eventHealthStatus     = "health_status"
+	eventDied             = "died"
+	healthStatusUnhealthy = "unhealthy"
+
+	attributeEventName    = "event.name"
+	attributeHealthStatus = "podman.container.health_status"
+	attributeExitCode     = "podman.container.exit_code"
+
+	// the attributes of the actors of the events
+	actorAttributeName         = "name"
+	actorAttributeImage        = "image"
+	actorAttributeExitCode     = "containerExitCode"
+	actorAttributePodID        = "podId"
+	actorAttributeHealthStatus = "health_status"
+)
+
+// logsReceiver emits the health check and the death events of the containers as logs.
+type logsReceiver struct {
+	config        *Config
+	set           receiver.CreateSettings
+	clientFactory clientFactory
+	nextConsumer  consumer.Logs
+	cancel        context.CancelFunc
+	wg            sync.WaitGroup
+}
+
+func newLogsReceiver(
+	_ context.Context,
+	set receiver.CreateSettings,
+	config *Config,
+	nextConsumer consumer.Logs,
+	clientFactory clientFactory,
+) (receiver.Logs, error) {
+	err := config.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	if clientFactory == nil {
+		clientFactory = newLibpodClient
+	}
+
+	return &logsReceiver{
+		config:        config,
+		set:           set,
+		clientFactory: clientFactory,
+		nextConsumer:  nextConsumer,
+	}, nil
+}
+
+func (r *logsReceiver) Start(_ context.Context, _ component.Host) error {
+	podmanClient, err := r.clientFactory(r.set.Logger, r.config)
+	if err != nil {
+		return err
+	}
+
+	var ctx context.Context
+	ctx, r.cancel = context.WithCancel(context.Background())
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		r.eventLoop(ctx, podmanClient)
+	}()
+	return nil
+}
+
+func (r *logsReceiver) Shutdown(_ context.Context) error {
+	if r.cancel != nil {
+		r.cancel()
+	}
+	r.wg.Wait()
+	return nil
+}
+
+func (r *logsReceiver) eventLoop(ctx context.Context, client PodmanClient) {
+	filters := url.Values{}
+	eventFilter := map[string][]string{
+		"status": {eventHealthStatus, eventDied},
+		"type":   {"container"},
+	}
+	jsonFilter, err := json.Marshal(eventFilter)
+	if err != nil {
+		return
+	}
+	filters.Add("filters", string(jsonFilter))
+EVENT_LOOP:
+	for {
+		eventCh, errCh := client.events(ctx, filters)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case podmanEvent := <-eventCh:
+				if err := r.nextConsumer.ConsumeLogs(ctx, eventToLogs(podmanEvent, r.config.ResourceAttributes)); err != nil {
+					r.set.Logger.Error("Failed to consume the podman container event", zap.String("id", podmanEvent.ID), zap.Error(err))
+				}
+			case err := <-errCh:
+				// We are only interested when the context hasn't been canceled since requests made
+				// with a closed context are guaranteed to fail.
+				if ctx.Err() == nil {
+					r.set.Logger.Error("Error watching podman container events", zap.Error(err))
+					select {
+					case <-time.After(3 * time.Second):
+						continue EVENT_LOOP
+					case <-ctx.Done():
+						return
+					}
+				}
+			}
+		}
+	}
+}
+
+// eventToLogs converts a container event to a log record, with the resource of the container.
+// The failed health checks and the non-zero exit codes are warnings.
+func eventToLogs(e event, rac metadata.ResourceAttributesConfig) plog.Logs {
+	logs := plog.NewLogs()
+	resourceLogs := logs.ResourceLogs().AppendEmpty()
+	rb := metadata.NewResourceBuilder(rac)
+	rb.SetContainerRuntime("podman")
+	rb.SetContainerID(containerID(e))
+	name := e.Actor.Attributes[actorAttributeName]
+	if name != "" {
+		rb.SetContainerName(name)
+	}
+	if image := e.Actor.Attributes[actorAttributeImage]; image != "" {
+		rb.SetContainerImageName(image)
+	}
+	if podID := e.Actor.Attributes[actorAttributePodID]; podID != "" {
+		rb.SetPodmanPodID(podID)
+	}
+	rb.Emit().MoveTo(resourceLogs.Resource())
+
+	record := resourceLogs.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+	switch {
+	case e.TimeNano != 0:
+		record.SetTimestamp(pcommon.Timestamp(e.TimeNano))
+	case e.Time != 0:
+		record.SetTimestamp(pcommon.NewTimestampFromTime(time.Unix(e.Time, 0)))
+	}
+	record.SetObservedTimestamp(pcommon.NewTimestampFromTime(time.Now()))
+	record.SetSeverityNumber(plog.SeverityNumberInfo)
+
+	status := eventStatus(e)
+	attrs := record.Attributes()
+	attrs.PutStr(attributeEventName, "podman.container."+status)
+	switch status {
+	case eventHealthStatus:
+		health := e.HealthStatus
+		if health == "" {
+			health = e.Actor.Attributes[actorAttributeHealthStatus]
+		}
+		attrs.PutStr(attributeHealthStatus, health)
+		if health == healthStatusUnhealthy {
+			record.SetSeverityNumber(plog.SeverityNumberWarn)
+		}
+		record.Body().SetStr(fmt.Sprintf("Container %s health status: %s", containerLabel(e, name), health))
+	case eventDied:
+		body := fmt.Sprintf("Container %s died", containerLabel(e, name))
+		if exitCode, err := strconv.ParseInt(e.Actor.Attributes[actorAttributeExitCode], 10, 64); err == nil {
+			attrs.PutInt(attributeExitCode, exitCode)
+			if exitCode != 0 {
+				record.SetSeverityNumber(plog.SeverityNumberWarn)
+			}
+			body = fmt.Sprintf("%s with exit code %d", body, exitCode)
+		}
+		record.Body().SetStr(body)
+	default:
+		record.Body().SetStr(fmt.Sprintf("Container %s %s", containerLabel(e, name), status))
+	}
+	record.SetSeverityText(record.SeverityNumber().String())
+	return logs
+}
+
+// eventStatus returns the status of the events, the newer versions of the API setting the action instead.
+func eventStatus(e event) string {
+	if e.Status != "" {
+		return e.Status
+	}
+	return e.Action
+}
+
+func containerID(e event) string {
+	if e.ID != "" {
+		return e.ID
+	}
+	return e.Actor.ID
+}
+
+func containerLabel(e event, name string) string {
+	if name != "" {
+		return name
+	}
+	return containerID(e)
+}
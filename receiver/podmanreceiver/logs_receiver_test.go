@@ -0,0 +1,131 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !windows
+
+package podmanreceiver
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/podmanreceiver/internal/metadata"
+)
+
+func TestLogsReceiver(t *testing.T) {
+	eventChan := make(chan event)
+	var filters url.Values
+	client := baseClient
+	client.EventsF = func(_ context.Context, options url.Values) (<-chan event, <-chan error) {
+		filters = options
+		return eventChan, make(chan error)
+	}
+	factory := func(*zap.Logger, *Config) (PodmanClient, error) {
+		return &client, nil
+	}
+
+	sink := new(consumertest.LogsSink)
+	r, err := newLogsReceiver(context.Background(), receivertest.NewNopCreateSettings(), createDefaultConfig(), sink, factory)
+	require.NoError(t, err)
+	require.NoError(t, r.Start(context.Background(), componenttest.NewNopHost()))
+
+	eventChan <- event{ID: "c1", Status: "died", Actor: eventActor{Attributes: map[string]string{"name": "web", "containerExitCode": "137"}}}
+	assert.Eventually(t, func() bool {
+		return sink.LogRecordCount() == 1
+	}, time.Second, time.Millisecond)
+	assert.JSONEq(t, `{"status":["health_status","died"],"type":["container"]}`, filters.Get("filters"))
+	record := sink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	assert.Equal(t, "Container web died with exit code 137", record.Body().Str())
+
+	require.NoError(t, r.Shutdown(context.Background()))
+}
+
+func TestEventToLogs(t *testing.T) {
+	rac := metadata.DefaultResourceAttributesConfig()
+	tests := []struct {
+		name       string
+		event      event
+		body       string
+		severity   plog.SeverityNumber
+		attributes map[string]any
+	}{
+		{
+			name: "unhealthy",
+			event: event{
+				ID:           "c1",
+				Status:       "health_status",
+				TimeNano:     1700000000000000000,
+				HealthStatus: "unhealthy",
+				Actor:        eventActor{Attributes: map[string]string{"name": "web", "image": "nginx:latest", "podId": "p1"}},
+			},
+			body:     "Container web health status: unhealthy",
+			severity: plog.SeverityNumberWarn,
+			attributes: map[string]any{
+				"event.name":                     "podman.container.health_status",
+				"podman.container.health_status": "unhealthy",
+			},
+		},
+		{
+			name: "healthy from the actor attributes",
+			event: event{
+				ID:     "c1",
+				Status: "health_status",
+				Actor:  eventActor{Attributes: map[string]string{"name": "web", "health_status": "healthy"}},
+			},
+			body:     "Container web health status: healthy",
+			severity: plog.SeverityNumberInfo,
+			attributes: map[string]any{
+				"event.name":                     "podman.container.health_status",
+				"podman.container.health_status": "healthy",
+			},
+		},
+		{
+			name: "died without error",
+			event: event{
+				Action: "died",
+				Actor:  eventActor{ID: "c1", Attributes: map[string]string{"containerExitCode": "0"}},
+			},
+			body:     "Container c1 died with exit code 0",
+			severity: plog.SeverityNumberInfo,
+			attributes: map[string]any{
+				"event.name":                 "podman.container.died",
+				"podman.container.exit_code": int64(0),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logs := eventToLogs(tt.event, rac)
+			require.Equal(t, 1, logs.LogRecordCount())
+			resource := logs.ResourceLogs().At(0).Resource().Attributes()
+			id, ok := resource.Get("container.id")
+			require.True(t, ok)
+			assert.Equal(t, "c1", id.Str())
+			record := logs.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+			assert.Equal(t, tt.body, record.Body().Str())
+			assert.Equal(t, tt.severity, record.SeverityNumber())
+			assert.Equal(t, tt.severity.String(), record.SeverityText())
+			assert.Equal(t, tt.attributes, record.Attributes().AsRaw())
+		})
+	}
+
+	logs := eventToLogs(tests[0].event, rac)
+	assert.Equal(t, map[string]any{
+		"container.runtime":    "podman",
+		"container.id":         "c1",
+		"container.name":       "web",
+		"container.image.name": "nginx:latest",
+		"podman.pod.id":        "p1",
+	}, logs.ResourceLogs().At(0).Resource().Attributes().AsRaw())
+	assert.EqualValues(t, 1700000000000000000, logs.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Timestamp())
+}
@@ -8,6 +8,7 @@ package podmanreceiver // import "github.com/open-telemetry/opentelemetry-collec
 import (
 	"context"
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
@@ -102,6 +103,7 @@ func (r *metricsReceiver) scrape(ctx context.Context) (pmetric.Metrics, error) {
 	var errs error
 	now := pcommon.NewTimestampFromTime(time.Now())
 
+	pods := map[string]*podStats{}
 	for res := range results {
 		if res.err != nil {
 			// Don't know the number of failed metrics, but one container fetch is a partial error.
@@ -109,10 +111,64 @@ func (r *metricsReceiver) scrape(ctx context.Context) (pmetric.Metrics, error) {
 			continue
 		}
 		r.recordContainerStats(now, res.container, &res.containerStats)
+		if res.container.Pod != "" {
+			pod, ok := pods[res.container.Pod]
+			if !ok {
+				pod = &podStats{id: res.container.Pod, name: res.container.PodName}
+				pods[res.container.Pod] = pod
+			}
+			pod.add(&res.containerStats)
+		}
+	}
+	podIDs := make([]string, 0, len(pods))
+	for id := range pods {
+		podIDs = append(podIDs, id)
+	}
+	sort.Strings(podIDs)
+	for _, id := range podIDs {
+		r.recordPodStats(now, pods[id])
 	}
 	return r.mb.Emit(), errs
 }
 
+// podStats aggregates the stats of the containers of a pod.
+type podStats struct {
+	id         string
+	name       string
+	containers int64
+	cpuNano    uint64
+	cpu        float64
+	memUsage   uint64
+	netInput   uint64
+	netOutput  uint64
+}
+
+func (p *podStats) add(stats *containerStats) {
+	p.containers++
+	p.cpuNano += stats.CPUNano
+	p.cpu += stats.CPU
+	p.memUsage += stats.MemUsage
+	// the containers of a pod share its network namespace, and so report the same network stats
+	p.netInput = max(p.netInput, stats.NetInput)
+	p.netOutput = max(p.netOutput, stats.NetOutput)
+}
+
+func (r *metricsReceiver) recordPodStats(now pcommon.Timestamp, pod *podStats) {
+	r.mb.RecordPodmanPodContainersDataPoint(now, pod.containers)
+	r.mb.RecordPodmanPodCPUUsageTotalDataPoint(now, int64(toSecondsWithNanosecondPrecision(pod.cpuNano)))
+	r.mb.RecordPodmanPodCPUPercentDataPoint(now, pod.cpu)
+	r.mb.RecordPodmanPodMemoryUsageTotalDataPoint(now, int64(pod.memUsage))
+	r.mb.RecordPodmanPodNetworkIoUsageRxBytesDataPoint(now, int64(pod.netOutput))
+	r.mb.RecordPodmanPodNetworkIoUsageTxBytesDataPoint(now, int64(pod.netInput))
+
+	rb := r.mb.NewResourceBuilder()
+	rb.SetContainerRuntime("podman")
+	rb.SetPodmanPodID(pod.id)
+	rb.SetPodmanPodName(pod.name)
+
+	r.mb.EmitForResource(metadata.WithResource(rb.Emit()))
+}
+
 func (r *metricsReceiver) recordContainerStats(now pcommon.Timestamp, container container, stats *containerStats) {
 	r.recordCPUMetrics(now, stats)
 	r.recordNetworkMetrics(now, stats)
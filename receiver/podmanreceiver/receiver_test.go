@@ -21,6 +21,8 @@ import (
 	"go.opentelemetry.io/collector/receiver/receivertest"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
 	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/podmanreceiver/internal/metadata"
 )
 
 func TestNewReceiver(t *testing.T) {
@@ -84,6 +86,63 @@ func TestScraperLoop(t *testing.T) {
 	assert.NoError(t, r.Shutdown(ctx))
 }
 
+func TestScrapePodStats(t *testing.T) {
+	cfg := createDefaultConfig()
+	cfg.Metrics.PodmanPodContainers.Enabled = true
+	cfg.Metrics.PodmanPodCPUUsageTotal.Enabled = true
+	cfg.Metrics.PodmanPodMemoryUsageTotal.Enabled = true
+	cfg.Metrics.PodmanPodNetworkIoUsageRxBytes.Enabled = true
+
+	stats := map[string]containerStats{
+		"c1": {ContainerID: "c1", Name: "web", CPUNano: 2e9, MemUsage: 100, NetOutput: 50},
+		"c2": {ContainerID: "c2", Name: "sidecar", CPUNano: 3e9, MemUsage: 20, NetOutput: 50},
+		"c3": {ContainerID: "c3", Name: "standalone", CPUNano: 1e9, MemUsage: 10},
+	}
+	client := baseClient
+	client.StatsF = func(_ context.Context, options url.Values) ([]containerStats, error) {
+		return []containerStats{stats[options.Get("containers")]}, nil
+	}
+
+	set := receivertest.NewNopCreateSettings()
+	r := &metricsReceiver{
+		config:  cfg,
+		set:     set,
+		scraper: newContainerScraper(&client, zap.NewNop(), cfg),
+		mb:      metadata.NewMetricsBuilder(cfg.MetricsBuilderConfig, set),
+	}
+	r.scraper.persistContainer(container{ID: "c1", Pod: "p1", PodName: "app"})
+	r.scraper.persistContainer(container{ID: "c2", Pod: "p1", PodName: "app"})
+	r.scraper.persistContainer(container{ID: "c3"})
+
+	md, err := r.scrape(context.Background())
+	require.NoError(t, err)
+	// a resource per container and a resource for the pod
+	require.Equal(t, 4, md.ResourceMetrics().Len())
+	var pod pmetric.ResourceMetrics
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		if _, ok := md.ResourceMetrics().At(i).Resource().Attributes().Get("podman.pod.id"); ok {
+			pod = md.ResourceMetrics().At(i)
+		}
+	}
+	assert.Equal(t, map[string]any{
+		"container.runtime": "podman",
+		"podman.pod.id":     "p1",
+		"podman.pod.name":   "app",
+	}, pod.Resource().Attributes().AsRaw())
+
+	values := map[string]int64{}
+	metrics := pod.ScopeMetrics().At(0).Metrics()
+	for i := 0; i < metrics.Len(); i++ {
+		values[metrics.At(i).Name()] = metrics.At(i).Sum().DataPoints().At(0).IntValue()
+	}
+	assert.Equal(t, map[string]int64{
+		"podman.pod.containers":                2,
+		"podman.pod.cpu.usage.total":           5,
+		"podman.pod.memory.usage.total":        120,
+		"podman.pod.network.io.usage.rx_bytes": 50,
+	}, values)
+}
+
 type mockClient chan containerStatsReport
 
 func (c mockClient) factory(_ *zap.Logger, _ *Config) (PodmanClient, error) {
@@ -20,3 +20,13 @@ func newMetricsReceiver(
 ) (receiver.Metrics, error) {
 	return nil, fmt.Errorf("podman receiver is not supported on windows")
 }
+
+func newLogsReceiver(
+	_ context.Context,
+	_ receiver.CreateSettings,
+	_ *Config,
+	_ consumer.Logs,
+	_ any,
+) (receiver.Logs, error) {
+	return nil, fmt.Errorf("podman receiver is not supported on windows")
+}
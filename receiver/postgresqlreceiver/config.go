@@ -15,6 +15,7 @@ import (
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
 	"go.uber.org/multierr"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/sqlquery"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/postgresqlreceiver/internal/metadata"
 )
 
@@ -37,6 +38,9 @@ type Config struct {
 	configtls.ClientConfig         `mapstructure:"tls,omitempty"` // provides SSL details
 	ConnectionPool                 `mapstructure:"connection_pool,omitempty"`
 	metadata.MetricsBuilderConfig  `mapstructure:",squash"`
+	// CustomQueries are run with the connection settings of the receiver, each query
+	// producing the metrics it defines as for the SQL query receiver.
+	CustomQueries []sqlquery.Query `mapstructure:"custom_queries"`
 }
 
 type ConnectionPool struct {
@@ -76,5 +80,9 @@ func (cfg *Config) Validate() error {
 		err = multierr.Append(err, errors.New(ErrTransportsSupported))
 	}
 
+	if customQueriesErr := sqlquery.ValidateCustomQueries(cfg.CustomQueries); customQueriesErr != nil {
+		err = multierr.Append(err, customQueriesErr)
+	}
+
 	return err
 }
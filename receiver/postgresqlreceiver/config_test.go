@@ -17,6 +17,7 @@ import (
 	"go.opentelemetry.io/collector/confmap/confmaptest"
 	"go.uber.org/multierr"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/sqlquery"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/postgresqlreceiver/internal/metadata"
 )
 
@@ -89,6 +90,18 @@ func TestValidate(t *testing.T) {
 				fmt.Errorf(ErrNotSupported, "MinVersion"),
 			),
 		},
+		{
+			desc: "custom query with logs",
+			defaultConfigModifier: func(cfg *Config) {
+				cfg.Username = "otel"
+				cfg.Password = "otel"
+				cfg.CustomQueries = []sqlquery.Query{{
+					SQL:  "SELECT count(*) AS count FROM pg_stat_activity",
+					Logs: []sqlquery.LogsCfg{{BodyColumn: "count"}},
+				}}
+			},
+			expected: errors.Join(errors.New("custom_queries[0]: only 'metrics' are supported")),
+		},
 		{
 			desc: "no error",
 			defaultConfigModifier: func(cfg *Config) {
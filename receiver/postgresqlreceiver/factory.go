@@ -5,6 +5,7 @@ package postgresqlreceiver // import "github.com/open-telemetry/opentelemetry-co
 
 import (
 	"context"
+	"database/sql"
 	"time"
 
 	"go.opentelemetry.io/collector/component"
@@ -14,6 +15,7 @@ import (
 	"go.opentelemetry.io/collector/receiver"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/sqlquery"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/postgresqlreceiver/internal/metadata"
 )
 
@@ -63,8 +65,23 @@ func createMetricsReceiver(
 		return nil, err
 	}
 
+	opts := []scraperhelper.ScraperControllerOption{scraperhelper.AddScraper(scraper)}
+	// the custom queries connect to the default database, as the listing of the databases does
+	baseConfig := postgreSQLConfig{
+		username: cfg.Username,
+		password: string(cfg.Password),
+		address:  cfg.AddrConfig,
+		tls:      cfg.ClientConfig,
+	}
+	dbProviderFunc := func() (*sql.DB, error) {
+		return getDB(baseConfig, "")
+	}
+	for _, customScraper := range sqlquery.NewCustomQueryScrapers(metadata.Type, cfg.CustomQueries, cfg.ControllerConfig, params.Logger, dbProviderFunc) {
+		opts = append(opts, scraperhelper.AddScraper(customScraper))
+	}
+
 	return scraperhelper.NewScraperControllerReceiver(
 		&cfg.ControllerConfig, params, consumer,
-		scraperhelper.AddScraper(scraper),
+		opts...,
 	)
 }
@@ -0,0 +1,144 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package restreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/restreceiver"
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"go.opentelemetry.io/collector/component"
+)
+
+const (
+	// xmlAttributePrefix prefixes the names of the attributes of the XML elements.
+	xmlAttributePrefix = "@"
+	// xmlTextKey is the name of the text of the XML elements with attributes or children.
+	xmlTextKey = "#text"
+)
+
+// apiClient requests the endpoint and decodes the responses.
+type apiClient struct {
+	client *http.Client
+	cfg    *Config
+}
+
+func newAPIClient(ctx context.Context, cfg *Config, host component.Host, settings component.TelemetrySettings) (*apiClient, error) {
+	client, err := cfg.ToClient(ctx, host, settings)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create HTTP Client: %w", err)
+	}
+	return &apiClient{client: client, cfg: cfg}, nil
+}
+
+// fetch returns the decoded response of the endpoint, made of maps of string keys, slices
+// and scalars.
+func (c *apiClient) fetch(ctx context.Context) (any, error) {
+	var body io.Reader
+	if c.cfg.Body != "" {
+		body = strings.NewReader(c.cfg.Body)
+	}
+	req, err := http.NewRequestWithContext(ctx, c.cfg.Method, c.cfg.Endpoint, body)
+	if err != nil {
+		return nil, err
+	}
+	if c.cfg.Format == FormatXML {
+		req.Header.Set("Accept", "application/xml")
+	} else {
+		req.Header.Set("Accept", "application/json")
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("request %s %s failed - %q", req.Method, req.URL.String(), resp.Status)
+	}
+
+	if c.cfg.Format == FormatXML {
+		return decodeXML(resp.Body)
+	}
+	return decodeJSON(resp.Body)
+}
+
+func decodeJSON(r io.Reader) (any, error) {
+	var value any
+	decoder := json.NewDecoder(r)
+	decoder.UseNumber()
+	if err := decoder.Decode(&value); err != nil {
+		return nil, fmt.Errorf("failed to decode the JSON response: %w", err)
+	}
+	return value, nil
+}
+
+// decodeXML decodes the document into a map holding the root element. The elements are
+// decoded as maps, with the attributes prefixed with `@` and the text in `#text`, unless
+// they only have text, decoded as strings. The repeated children are decoded as slices.
+func decodeXML(r io.Reader) (any, error) {
+	decoder := xml.NewDecoder(r)
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			return nil, errors.New("failed to decode the XML response: no root element")
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode the XML response: %w", err)
+		}
+		if start, ok := token.(xml.StartElement); ok {
+			value, err := decodeXMLElement(decoder, start)
+			if err != nil {
+				return nil, fmt.Errorf("failed to decode the XML response: %w", err)
+			}
+			return map[string]any{start.Name.Local: value}, nil
+		}
+	}
+}
+
+func decodeXMLElement(decoder *xml.Decoder, start xml.StartElement) (any, error) {
+	element := map[string]any{}
+	for _, attr := range start.Attr {
+		element[xmlAttributePrefix+attr.Name.Local] = attr.Value
+	}
+	var text strings.Builder
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil, err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			child, err := decodeXMLElement(decoder, t)
+			if err != nil {
+				return nil, err
+			}
+			name := t.Name.Local
+			switch existing := element[name].(type) {
+			case nil:
+				element[name] = child
+			case []any:
+				element[name] = append(existing, child)
+			default:
+				element[name] = []any{existing, child}
+			}
+		case xml.CharData:
+			text.Write(t)
+		case xml.EndElement:
+			content := strings.TrimSpace(text.String())
+			if len(element) == 0 {
+				return content, nil
+			}
+			if content != "" {
+				element[xmlTextKey] = content
+			}
+			return element, nil
+		}
+	}
+}
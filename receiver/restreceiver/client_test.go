@@ -0,0 +1,58 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package restreceiver
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeXML(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		document string
+		expected any
+		errMsg   string
+	}{
+		{
+			desc:     "text",
+			document: `<?xml version="1.0"?><value> 42 </value>`,
+			expected: map[string]any{"value": "42"},
+		},
+		{
+			desc:     "attributes and text",
+			document: `<disk id="sda" state="ok">120</disk>`,
+			expected: map[string]any{"disk": map[string]any{"@id": "sda", "@state": "ok", "#text": "120"}},
+		},
+		{
+			desc:     "repeated children",
+			document: `<disks><disk>sda</disk><disk>sdb</disk><disk>sdc</disk><count>3</count></disks>`,
+			expected: map[string]any{"disks": map[string]any{"disk": []any{"sda", "sdb", "sdc"}, "count": "3"}},
+		},
+		{
+			desc:     "empty",
+			document: `<?xml version="1.0"?>`,
+			errMsg:   "failed to decode the XML response: no root element",
+		},
+		{
+			desc:     "unterminated",
+			document: `<disks><disk>`,
+			errMsg:   "failed to decode the XML response: XML syntax error on line 1: unexpected EOF",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			value, err := decodeXML(strings.NewReader(tc.document))
+			if tc.errMsg != "" {
+				require.EqualError(t, err, tc.errMsg)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, value)
+		})
+	}
+}
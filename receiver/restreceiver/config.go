@@ -0,0 +1,207 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package restreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/restreceiver"
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/receiver/scraperhelper"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/restreceiver/internal/jsonpath"
+)
+
+// Format is the format of the responses of the API.
+type Format string
+
+const (
+	FormatJSON Format = "json"
+	FormatXML  Format = "xml"
+)
+
+// MetricType is the type of the metric recorded from the responses.
+type MetricType string
+
+const (
+	MetricTypeGauge MetricType = "gauge"
+	MetricTypeSum   MetricType = "sum"
+)
+
+// ValueType is the type of the values of the data points.
+type ValueType string
+
+const (
+	ValueTypeInt    ValueType = "int"
+	ValueTypeDouble ValueType = "double"
+)
+
+var (
+	errMissingEndpoint   = errors.New(`"endpoint" must be specified`)
+	errMissingSignals    = errors.New(`at least one of "metrics" and "logs" must be specified`)
+	errMissingMetricName = errors.New(`"name" must be specified for each metric`)
+)
+
+// Config defines the configuration of the REST receiver.
+type Config struct {
+	scraperhelper.ControllerConfig `mapstructure:",squash"`
+	confighttp.ClientConfig        `mapstructure:",squash"`
+	// Method is the HTTP method of the requests, GET by default.
+	Method string `mapstructure:"method"`
+	// Body is the body of the requests, for the APIs queried with POST.
+	Body string `mapstructure:"body"`
+	// Format is the format of the responses, json or xml.
+	Format Format `mapstructure:"format"`
+	// Metrics are the metrics recorded from each response.
+	Metrics []MetricConfig `mapstructure:"metrics"`
+	// Logs are the log records emitted from each response.
+	Logs []LogsConfig `mapstructure:"logs"`
+}
+
+// MetricConfig maps the fields of the responses to a metric, with a data point per item.
+type MetricConfig struct {
+	Name        string `mapstructure:"name"`
+	Description string `mapstructure:"description"`
+	Unit        string `mapstructure:"unit"`
+	// Type is the type of the metric, gauge (the default) or sum.
+	Type MetricType `mapstructure:"type"`
+	// Monotonic sets whether the sum is monotonic.
+	Monotonic bool `mapstructure:"monotonic"`
+	// ValueType is the type of the values, int or double (the default).
+	ValueType ValueType `mapstructure:"value_type"`
+	// Items is the JSONPath of the items of the response, the whole response by default.
+	Items string `mapstructure:"items"`
+	// Value is the JSONPath of the value of the data point, relative to the item.
+	Value string `mapstructure:"value"`
+	// Attributes are the attributes of the data point.
+	Attributes []AttributeConfig `mapstructure:"attributes"`
+}
+
+// LogsConfig maps the items of the responses to log records.
+type LogsConfig struct {
+	// Items is the JSONPath of the items of the response, the whole response by default.
+	Items string `mapstructure:"items"`
+	// Body is the JSONPath of the body of the log record relative to the item, the whole
+	// item by default.
+	Body string `mapstructure:"body"`
+	// Timestamp is the JSONPath of the timestamp of the log record relative to the item, as an
+	// RFC 3339 string or a number of seconds since the epoch.
+	Timestamp string `mapstructure:"timestamp"`
+	// Severity is the JSONPath of the severity of the log record relative to the item.
+	Severity string `mapstructure:"severity"`
+	// Attributes are the attributes of the log record.
+	Attributes []AttributeConfig `mapstructure:"attributes"`
+}
+
+// AttributeConfig maps a field of the items to an attribute.
+type AttributeConfig struct {
+	Name string `mapstructure:"name"`
+	// Value is the JSONPath of the value of the attribute, relative to the item.
+	Value string `mapstructure:"value"`
+}
+
+// Validate checks the request, the format and the mappings of the metrics and the logs.
+func (cfg *Config) Validate() error {
+	var errs []error
+	if cfg.Endpoint == "" {
+		errs = append(errs, errMissingEndpoint)
+	} else if _, err := url.Parse(cfg.Endpoint); err != nil {
+		errs = append(errs, fmt.Errorf(`invalid "endpoint": %w`, err))
+	}
+	switch cfg.Method {
+	case http.MethodGet, http.MethodPost:
+	default:
+		errs = append(errs, fmt.Errorf(`unsupported "method" '%s', must be GET or POST`, cfg.Method))
+	}
+	switch cfg.Format {
+	case FormatJSON, FormatXML:
+	default:
+		errs = append(errs, fmt.Errorf(`unsupported "format" '%s', must be json or xml`, cfg.Format))
+	}
+	if len(cfg.Metrics) == 0 && len(cfg.Logs) == 0 {
+		errs = append(errs, errMissingSignals)
+	}
+
+	names := map[string]bool{}
+	for _, m := range cfg.Metrics {
+		if err := m.validate(); err != nil {
+			errs = append(errs, err)
+		}
+		if m.Name != "" && names[m.Name] {
+			errs = append(errs, fmt.Errorf("duplicate metric '%s'", m.Name))
+		}
+		names[m.Name] = true
+	}
+	for i, l := range cfg.Logs {
+		if err := l.validate(); err != nil {
+			errs = append(errs, fmt.Errorf("logs %d: %w", i, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (m MetricConfig) validate() error {
+	if m.Name == "" {
+		return errMissingMetricName
+	}
+	var errs []error
+	switch m.Type {
+	case "", MetricTypeGauge:
+		if m.Monotonic {
+			errs = append(errs, errors.New(`"monotonic" can only be set for the sums`))
+		}
+	case MetricTypeSum:
+	default:
+		errs = append(errs, fmt.Errorf(`unsupported "type" '%s', must be gauge or sum`, m.Type))
+	}
+	switch m.ValueType {
+	case "", ValueTypeInt, ValueTypeDouble:
+	default:
+		errs = append(errs, fmt.Errorf(`unsupported "value_type" '%s', must be int or double`, m.ValueType))
+	}
+	if m.Value == "" {
+		errs = append(errs, errors.New(`"value" must be specified`))
+	}
+	errs = append(errs, validatePaths(m.Items, m.Value)...)
+	errs = append(errs, validateAttributes(m.Attributes)...)
+	if err := errors.Join(errs...); err != nil {
+		return fmt.Errorf("metric '%s': %w", m.Name, err)
+	}
+	return nil
+}
+
+func (l LogsConfig) validate() error {
+	errs := validatePaths(l.Items, l.Body, l.Timestamp, l.Severity)
+	errs = append(errs, validateAttributes(l.Attributes)...)
+	return errors.Join(errs...)
+}
+
+func validatePaths(paths ...string) []error {
+	var errs []error
+	for _, path := range paths {
+		if path == "" {
+			continue
+		}
+		if _, err := jsonpath.Parse(path); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
+
+func validateAttributes(attributes []AttributeConfig) []error {
+	var errs []error
+	for _, a := range attributes {
+		if a.Name == "" {
+			errs = append(errs, errors.New(`"name" must be specified for each attribute`))
+		}
+		if a.Value == "" {
+			errs = append(errs, fmt.Errorf(`"value" must be specified for the attribute '%s'`, a.Name))
+		}
+		errs = append(errs, validatePaths(a.Value)...)
+	}
+	return errs
+}
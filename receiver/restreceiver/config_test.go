@@ -0,0 +1,112 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package restreceiver
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configopaque"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/restreceiver/internal/metadata"
+)
+
+func TestLoadConfig(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+
+	t.Run("json", func(t *testing.T) {
+		cfg := createDefaultConfig().(*Config)
+		sub, err := cm.Sub(component.NewID(metadata.Type).String())
+		require.NoError(t, err)
+		require.NoError(t, component.UnmarshalConfig(sub, cfg))
+		require.NoError(t, component.ValidateConfig(cfg))
+
+		expected := createDefaultConfig().(*Config)
+		expected.Endpoint = "https://array01.example.com/api/2.26/arrays/space"
+		expected.CollectionInterval = 30 * time.Second
+		expected.Headers = map[string]configopaque.String{"x-auth-token": "secret"}
+		expected.Metrics = []MetricConfig{
+			{
+				Name:        "array.capacity",
+				Description: "The usable capacity of the array.",
+				Unit:        "By",
+				ValueType:   ValueTypeInt,
+				Items:       "$.items[*]",
+				Value:       "$.capacity",
+				Attributes:  []AttributeConfig{{Name: "array.name", Value: "$.name"}},
+			},
+			{
+				Name:       "array.reads",
+				Unit:       "{operations}",
+				Type:       MetricTypeSum,
+				Monotonic:  true,
+				Items:      "$.items[*]",
+				Value:      "$.space.reads",
+				Attributes: []AttributeConfig{{Name: "array.name", Value: "$.name"}},
+			},
+		}
+		expected.Logs = []LogsConfig{
+			{
+				Items:      "$.alerts[*]",
+				Body:       "$.summary",
+				Timestamp:  "$.created",
+				Severity:   "$.severity",
+				Attributes: []AttributeConfig{{Name: "alert.id", Value: "$.id"}},
+			},
+		}
+		require.Equal(t, expected, cfg)
+	})
+
+	t.Run("xml", func(t *testing.T) {
+		cfg := createDefaultConfig().(*Config)
+		sub, err := cm.Sub(component.NewIDWithName(metadata.Type, "xml").String())
+		require.NoError(t, err)
+		require.NoError(t, component.UnmarshalConfig(sub, cfg))
+		require.NoError(t, component.ValidateConfig(cfg))
+
+		require.Equal(t, "POST", cfg.Method)
+		require.Equal(t, `<request type="status"/>`, cfg.Body)
+		require.Equal(t, FormatXML, cfg.Format)
+		require.Equal(t, []MetricConfig{{Name: "appliance.temperature", Unit: "Cel", Value: "$.status.temperature"}}, cfg.Metrics)
+	})
+}
+
+func TestValidate(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config-invalid.yaml"))
+	require.NoError(t, err)
+	cfg := createDefaultConfig().(*Config)
+	sub, err := cm.Sub(component.NewID(metadata.Type).String())
+	require.NoError(t, err)
+	require.NoError(t, component.UnmarshalConfig(sub, cfg))
+
+	err = component.ValidateConfig(cfg)
+	require.Error(t, err)
+	for _, msg := range []string{
+		`unsupported "method" 'PUT', must be GET or POST`,
+		`unsupported "format" 'yaml', must be json or xml`,
+		`metric 'api.value': unsupported "type" 'histogram', must be gauge or sum`,
+		`unsupported "value_type" 'string', must be int or double`,
+		`"value" must be specified`,
+		"path 'items[*]' must start with '$'",
+		`metric 'api.value': "monotonic" can only be set for the sums`,
+		`"name" must be specified for each attribute`,
+		"invalid path '$[x]': invalid index 'x'",
+		"duplicate metric 'api.value'",
+		errMissingMetricName.Error(),
+		"logs 0: invalid path '$..message': recursive descent is not supported",
+	} {
+		assert.ErrorContains(t, err, msg)
+	}
+
+	cfg = createDefaultConfig().(*Config)
+	err = component.ValidateConfig(cfg)
+	require.ErrorIs(t, err, errMissingEndpoint)
+	require.ErrorIs(t, err, errMissingSignals)
+}
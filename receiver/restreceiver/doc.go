@@ -0,0 +1,8 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:generate mdatagen metadata.yaml
+
+// Package restreceiver polls JSON and XML HTTP APIs and maps the fields of their responses to
+// metrics and logs.
+package restreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/restreceiver"
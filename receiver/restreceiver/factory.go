@@ -0,0 +1,70 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package restreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/restreceiver"
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/receiver/scraperhelper"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/restreceiver/internal/metadata"
+)
+
+// NewFactory creates the restreceiver factory
+func NewFactory() receiver.Factory {
+	return receiver.NewFactory(
+		metadata.Type,
+		createDefaultConfig,
+		receiver.WithMetrics(createMetricsReceiver, metadata.MetricsStability),
+		receiver.WithLogs(createLogsReceiver, metadata.LogsStability))
+}
+
+func createDefaultConfig() component.Config {
+	return &Config{
+		ControllerConfig: scraperhelper.NewDefaultControllerConfig(),
+		ClientConfig: confighttp.ClientConfig{
+			Timeout: 10 * time.Second,
+		},
+		Method: http.MethodGet,
+		Format: FormatJSON,
+	}
+}
+
+func createMetricsReceiver(
+	_ context.Context,
+	params receiver.CreateSettings,
+	rConf component.Config,
+	consumer consumer.Metrics,
+) (receiver.Metrics, error) {
+	cfg := rConf.(*Config)
+	rs, err := newRESTScraper(params, cfg)
+	if err != nil {
+		return nil, err
+	}
+	scraper, err := scraperhelper.NewScraper(metadata.Type.String(), rs.scrape, scraperhelper.WithStart(rs.start))
+	if err != nil {
+		return nil, err
+	}
+
+	return scraperhelper.NewScraperControllerReceiver(
+		&cfg.ControllerConfig, params, consumer,
+		scraperhelper.AddScraper(scraper),
+	)
+}
+
+func createLogsReceiver(
+	_ context.Context,
+	params receiver.CreateSettings,
+	rConf component.Config,
+	consumer consumer.Logs,
+) (receiver.Logs, error) {
+	cfg := rConf.(*Config)
+	return newRESTLogsReceiver(cfg, params, consumer)
+}
@@ -0,0 +1,160 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package jsonpath implements the subset of JSONPath used to select the values of the decoded
+// responses: the root `$`, the child names `.name` and `['name']`, the indexes `[n]` and
+// the wildcards `.*` and `[*]`.
+package jsonpath // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/restreceiver/internal/jsonpath"
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type stepKind int
+
+const (
+	childStep stepKind = iota
+	indexStep
+	wildcardStep
+)
+
+type step struct {
+	kind  stepKind
+	name  string
+	index int
+}
+
+// Path is a parsed JSONPath expression.
+type Path struct {
+	expr  string
+	steps []step
+}
+
+// Parse parses the JSONPath expression, which must start with the root `$`.
+func Parse(expr string) (*Path, error) {
+	if !strings.HasPrefix(expr, "$") {
+		return nil, fmt.Errorf("path '%s' must start with '$'", expr)
+	}
+	p := &Path{expr: expr}
+	rest := expr[1:]
+	for rest != "" {
+		var s step
+		var err error
+		switch rest[0] {
+		case '.':
+			s, rest, err = parseDot(rest[1:])
+		case '[':
+			s, rest, err = parseBracket(rest[1:])
+		default:
+			err = fmt.Errorf("unexpected character '%c'", rest[0])
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid path '%s': %w", expr, err)
+		}
+		p.steps = append(p.steps, s)
+	}
+	return p, nil
+}
+
+func parseDot(rest string) (step, string, error) {
+	if strings.HasPrefix(rest, ".") {
+		return step{}, "", errors.New("recursive descent is not supported")
+	}
+	if strings.HasPrefix(rest, "*") {
+		return step{kind: wildcardStep}, rest[1:], nil
+	}
+	end := strings.IndexAny(rest, ".[")
+	if end < 0 {
+		end = len(rest)
+	}
+	if end == 0 {
+		return step{}, "", errors.New("empty child name")
+	}
+	return step{kind: childStep, name: rest[:end]}, rest[end:], nil
+}
+
+func parseBracket(rest string) (step, string, error) {
+	if rest != "" && (rest[0] == '\'' || rest[0] == '"') {
+		end := strings.IndexByte(rest[1:], rest[0])
+		if end < 0 || !strings.HasPrefix(rest[end+2:], "]") {
+			return step{}, "", errors.New("unterminated child name")
+		}
+		return step{kind: childStep, name: rest[1 : end+1]}, rest[end+3:], nil
+	}
+	end := strings.IndexByte(rest, ']')
+	if end < 0 {
+		return step{}, "", errors.New("unterminated bracket")
+	}
+	content := rest[:end]
+	if content == "*" {
+		return step{kind: wildcardStep}, rest[end+1:], nil
+	}
+	index, err := strconv.Atoi(content)
+	if err != nil {
+		return step{}, "", fmt.Errorf("invalid index '%s'", content)
+	}
+	return step{kind: indexStep, index: index}, rest[end+1:], nil
+}
+
+// String returns the expression of the path.
+func (p *Path) String() string {
+	return p.expr
+}
+
+// Get returns the values selected by the path in the value decoded from JSON or XML, made of
+// maps of string keys, slices and scalars. The children of a map selected by a wildcard are
+// returned in the order of their keys, and the negative indexes count from the end of a slice.
+func (p *Path) Get(value any) []any {
+	values := []any{value}
+	for _, s := range p.steps {
+		var next []any
+		for _, v := range values {
+			next = s.apply(v, next)
+		}
+		if len(next) == 0 {
+			return nil
+		}
+		values = next
+	}
+	return values
+}
+
+func (s step) apply(value any, dest []any) []any {
+	switch s.kind {
+	case childStep:
+		if m, ok := value.(map[string]any); ok {
+			if child, found := m[s.name]; found {
+				dest = append(dest, child)
+			}
+		}
+	case indexStep:
+		if l, ok := value.([]any); ok {
+			index := s.index
+			if index < 0 {
+				index += len(l)
+			}
+			if index >= 0 && index < len(l) {
+				dest = append(dest, l[index])
+			}
+		}
+	case wildcardStep:
+		switch v := value.(type) {
+		case []any:
+			dest = append(dest, v...)
+		case map[string]any:
+			keys := make([]string, 0, len(v))
+			for key := range v {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+			for _, key := range keys {
+				dest = append(dest, v[key])
+			}
+		}
+	}
+	return dest
+}
@@ -0,0 +1,81 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package jsonpath
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	testCases := []struct {
+		expr   string
+		errMsg string
+	}{
+		{expr: "$"},
+		{expr: "$.a.b"},
+		{expr: "$['a b'][0]"},
+		{expr: `$["a"].*[*][-1]`},
+		{expr: "a.b", errMsg: "path 'a.b' must start with '$'"},
+		{expr: "$..a", errMsg: "invalid path '$..a': recursive descent is not supported"},
+		{expr: "$.", errMsg: "invalid path '$.': empty child name"},
+		{expr: "$[0", errMsg: "invalid path '$[0': unterminated bracket"},
+		{expr: "$['a]", errMsg: "invalid path '$['a]': unterminated child name"},
+		{expr: "$[a]", errMsg: "invalid path '$[a]': invalid index 'a'"},
+		{expr: "$a", errMsg: "invalid path '$a': unexpected character 'a'"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.expr, func(t *testing.T) {
+			p, err := Parse(tc.expr)
+			if tc.errMsg != "" {
+				require.EqualError(t, err, tc.errMsg)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expr, p.String())
+		})
+	}
+}
+
+func TestGet(t *testing.T) {
+	value := map[string]any{
+		"name": "array01",
+		"space": map[string]any{
+			"used":  int64(10),
+			"total": int64(100),
+		},
+		"volumes": []any{
+			map[string]any{"name": "vol1", "size": 1.5},
+			map[string]any{"name": "vol2", "size": 2.5},
+			map[string]any{"name": "vol3"},
+		},
+		"a b": "c",
+	}
+
+	testCases := []struct {
+		expr     string
+		expected []any
+	}{
+		{expr: "$", expected: []any{value}},
+		{expr: "$.name", expected: []any{"array01"}},
+		{expr: "$['a b']", expected: []any{"c"}},
+		{expr: "$.space.*", expected: []any{int64(100), int64(10)}},
+		{expr: "$.volumes[1].name", expected: []any{"vol2"}},
+		{expr: "$.volumes[-1].name", expected: []any{"vol3"}},
+		{expr: "$.volumes[*].size", expected: []any{1.5, 2.5}},
+		{expr: "$.volumes[3]"},
+		{expr: "$.missing.name"},
+		{expr: "$.name[0]"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.expr, func(t *testing.T) {
+			p, err := Parse(tc.expr)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, p.Get(value))
+		})
+	}
+}
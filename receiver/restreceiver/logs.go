@@ -0,0 +1,135 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package restreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/restreceiver"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/receiver"
+	"go.uber.org/zap"
+)
+
+// restLogsReceiver polls the endpoint and emits a log record per item of the responses.
+type restLogsReceiver struct {
+	cfg      *Config
+	settings component.TelemetrySettings
+	consumer consumer.Logs
+	client   *apiClient
+	logs     []logsMapping
+	cancel   context.CancelFunc
+	wg       *sync.WaitGroup
+}
+
+func newRESTLogsReceiver(cfg *Config, set receiver.CreateSettings, consumer consumer.Logs) (*restLogsReceiver, error) {
+	logs, err := newLogsMappings(cfg.Logs)
+	if err != nil {
+		return nil, err
+	}
+	return &restLogsReceiver{
+		cfg:      cfg,
+		settings: set.TelemetrySettings,
+		consumer: consumer,
+		logs:     logs,
+		wg:       &sync.WaitGroup{},
+	}, nil
+}
+
+func (r *restLogsReceiver) Start(ctx context.Context, host component.Host) error {
+	client, err := newAPIClient(ctx, r.cfg, host, r.settings)
+	if err != nil {
+		return err
+	}
+	r.client = client
+
+	ctx, cancel := context.WithCancel(context.Background())
+	r.cancel = cancel
+
+	t := time.NewTicker(r.cfg.CollectionInterval)
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		defer t.Stop()
+		for {
+			select {
+			case <-t.C:
+				if err := r.poll(ctx); err != nil {
+					r.settings.Logger.Error("Failed to collect the logs", zap.String("endpoint", r.cfg.Endpoint), zap.Error(err))
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+func (r *restLogsReceiver) Shutdown(context.Context) error {
+	if r.cancel != nil {
+		r.cancel()
+	}
+	r.wg.Wait()
+	return nil
+}
+
+func (r *restLogsReceiver) poll(ctx context.Context) error {
+	response, err := r.client.fetch(ctx)
+	if err != nil {
+		return err
+	}
+
+	now := pcommon.NewTimestampFromTime(time.Now())
+	logs := plog.NewLogs()
+	sl := logs.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty()
+	sl.Scope().SetName(scopeName)
+	var errs []error
+	for _, l := range r.logs {
+		for i, item := range l.items.Get(response) {
+			lr := sl.LogRecords().AppendEmpty()
+			if err := l.setLogRecord(item, now, lr); err != nil {
+				errs = append(errs, fmt.Errorf("item %d: %w", i, err))
+			}
+		}
+	}
+
+	if sl.LogRecords().Len() > 0 {
+		if err := r.consumer.ConsumeLogs(ctx, logs); err != nil {
+			return err
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// setLogRecord sets the log record from the item, the record being kept without the fields
+// that could not be parsed.
+func (l logsMapping) setLogRecord(item any, now pcommon.Timestamp, lr plog.LogRecord) error {
+	lr.SetObservedTimestamp(now)
+	if body, ok := first(l.body, item); ok {
+		if err := lr.Body().FromRaw(normalize(body)); err != nil {
+			return err
+		}
+	}
+
+	var err error
+	if raw, ok := first(l.timestamp, item); ok {
+		var timestamp pcommon.Timestamp
+		if timestamp, err = timestampValue(raw); err == nil {
+			lr.SetTimestamp(timestamp)
+		}
+	}
+	if raw, ok := first(l.severity, item); ok {
+		severity := fmt.Sprint(raw)
+		lr.SetSeverityText(severity)
+		lr.SetSeverityNumber(severityNumber(severity))
+	}
+	putAttributes(l.attributes, item, lr.Attributes())
+	return err
+}
@@ -0,0 +1,77 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package restreceiver
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+)
+
+func TestLogsPoll(t *testing.T) {
+	server := newMockServer(t, http.MethodGet, "arrays.json")
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = server.URL
+	cfg.Logs = []LogsConfig{
+		{
+			Items:      "$.alerts[*]",
+			Body:       "$.summary",
+			Timestamp:  "$.created",
+			Severity:   "$.severity",
+			Attributes: []AttributeConfig{{Name: "alert.id", Value: "$.id"}},
+		},
+		{
+			Items: "$.items[1]",
+		},
+	}
+
+	sink := &consumertest.LogsSink{}
+	r, err := newRESTLogsReceiver(cfg, receivertest.NewNopCreateSettings(), sink)
+	require.NoError(t, err)
+	require.NoError(t, r.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() {
+		require.NoError(t, r.Shutdown(context.Background()))
+	}()
+
+	err = r.poll(context.Background())
+	require.EqualError(t, err, "item 2: invalid timestamp yesterday")
+	require.Len(t, sink.AllLogs(), 1)
+
+	sl := sink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0)
+	require.Equal(t, scopeName, sl.Scope().Name())
+	records := sl.LogRecords()
+	require.Equal(t, 4, records.Len())
+
+	lr := records.At(0)
+	require.Equal(t, "array01 controller CT0 failed", lr.Body().Str())
+	require.Equal(t, time.Date(2024, 5, 10, 8, 30, 0, 0, time.UTC), lr.Timestamp().AsTime())
+	require.NotZero(t, lr.ObservedTimestamp())
+	require.Equal(t, "critical", lr.SeverityText())
+	require.Equal(t, plog.SeverityNumberFatal, lr.SeverityNumber())
+	require.Equal(t, map[string]any{"alert.id": int64(42)}, lr.Attributes().AsRaw())
+
+	lr = records.At(1)
+	require.Equal(t, map[string]any{"array": "array02", "message": "space usage above 90%"}, lr.Body().Map().AsRaw())
+	require.Equal(t, time.Unix(1715330400, 500000000).UTC(), lr.Timestamp().AsTime())
+	require.Equal(t, plog.SeverityNumberWarn, lr.SeverityNumber())
+
+	lr = records.At(2)
+	require.Equal(t, "array02 replication restored", lr.Body().Str())
+	require.Equal(t, pcommon.Timestamp(0), lr.Timestamp())
+	require.Equal(t, plog.SeverityNumberUnspecified, lr.SeverityNumber())
+
+	lr = records.At(3)
+	require.Equal(t, pcommon.ValueTypeMap, lr.Body().Type())
+	name, ok := lr.Body().Map().Get("name")
+	require.True(t, ok)
+	require.Equal(t, "array02", name.Str())
+}
@@ -0,0 +1,228 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package restreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/restreceiver"
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/restreceiver/internal/jsonpath"
+)
+
+// rootPath is the path of the items when none is configured, the whole response.
+const rootPath = "$"
+
+type attributeMapping struct {
+	name  string
+	value *jsonpath.Path
+}
+
+type metricMapping struct {
+	MetricConfig
+	items      *jsonpath.Path
+	value      *jsonpath.Path
+	attributes []attributeMapping
+}
+
+type logsMapping struct {
+	items      *jsonpath.Path
+	body       *jsonpath.Path
+	timestamp  *jsonpath.Path
+	severity   *jsonpath.Path
+	attributes []attributeMapping
+}
+
+func newMetricMappings(cfgs []MetricConfig) ([]metricMapping, error) {
+	mappings := make([]metricMapping, 0, len(cfgs))
+	for _, cfg := range cfgs {
+		m := metricMapping{MetricConfig: cfg}
+		var err error
+		if m.items, err = parsePath(cfg.Items, rootPath); err != nil {
+			return nil, err
+		}
+		if m.value, err = parsePath(cfg.Value, ""); err != nil {
+			return nil, err
+		}
+		if m.attributes, err = newAttributeMappings(cfg.Attributes); err != nil {
+			return nil, err
+		}
+		mappings = append(mappings, m)
+	}
+	return mappings, nil
+}
+
+func newLogsMappings(cfgs []LogsConfig) ([]logsMapping, error) {
+	mappings := make([]logsMapping, 0, len(cfgs))
+	for _, cfg := range cfgs {
+		var l logsMapping
+		var err error
+		if l.items, err = parsePath(cfg.Items, rootPath); err != nil {
+			return nil, err
+		}
+		if l.body, err = parsePath(cfg.Body, rootPath); err != nil {
+			return nil, err
+		}
+		if l.timestamp, err = parsePath(cfg.Timestamp, ""); err != nil {
+			return nil, err
+		}
+		if l.severity, err = parsePath(cfg.Severity, ""); err != nil {
+			return nil, err
+		}
+		if l.attributes, err = newAttributeMappings(cfg.Attributes); err != nil {
+			return nil, err
+		}
+		mappings = append(mappings, l)
+	}
+	return mappings, nil
+}
+
+func newAttributeMappings(cfgs []AttributeConfig) ([]attributeMapping, error) {
+	mappings := make([]attributeMapping, 0, len(cfgs))
+	for _, cfg := range cfgs {
+		value, err := jsonpath.Parse(cfg.Value)
+		if err != nil {
+			return nil, err
+		}
+		mappings = append(mappings, attributeMapping{name: cfg.Name, value: value})
+	}
+	return mappings, nil
+}
+
+// parsePath parses the path, or the default path when it is empty. No path is returned when
+// both are empty.
+func parsePath(path string, defaultPath string) (*jsonpath.Path, error) {
+	if path == "" {
+		path = defaultPath
+	}
+	if path == "" {
+		return nil, nil
+	}
+	return jsonpath.Parse(path)
+}
+
+// first returns the first value selected by the path in the item.
+func first(path *jsonpath.Path, item any) (any, bool) {
+	if path == nil {
+		return nil, false
+	}
+	values := path.Get(item)
+	if len(values) == 0 {
+		return nil, false
+	}
+	return values[0], true
+}
+
+// putAttributes sets the attributes selected in the item, the missing ones being skipped.
+func putAttributes(mappings []attributeMapping, item any, attrs pcommon.Map) {
+	for _, a := range mappings {
+		if value, ok := first(a.value, item); ok {
+			_ = attrs.PutEmpty(a.name).FromRaw(normalize(value))
+		}
+	}
+}
+
+// normalize converts the JSON numbers of the decoded value to int64 or float64, as expected by
+// pcommon.Value.FromRaw.
+func normalize(value any) any {
+	switch v := value.(type) {
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i
+		}
+		f, _ := v.Float64()
+		return f
+	case map[string]any:
+		m := make(map[string]any, len(v))
+		for key, child := range v {
+			m[key] = normalize(child)
+		}
+		return m
+	case []any:
+		l := make([]any, len(v))
+		for i, child := range v {
+			l[i] = normalize(child)
+		}
+		return l
+	}
+	return value
+}
+
+func doubleValue(value any) (float64, error) {
+	switch v := value.(type) {
+	case json.Number:
+		return v.Float64()
+	case string:
+		f, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+		if err != nil {
+			return 0, fmt.Errorf("value %q is not a number", v)
+		}
+		return f, nil
+	case bool:
+		if v {
+			return 1, nil
+		}
+		return 0, nil
+	}
+	return 0, fmt.Errorf("value %v is not a number", value)
+}
+
+func intValue(value any) (int64, error) {
+	switch v := value.(type) {
+	case json.Number:
+		return v.Int64()
+	case string:
+		i, err := strconv.ParseInt(strings.TrimSpace(v), 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("value %q is not an integer", v)
+		}
+		return i, nil
+	case bool:
+		if v {
+			return 1, nil
+		}
+		return 0, nil
+	}
+	return 0, fmt.Errorf("value %v is not an integer", value)
+}
+
+// timestampValue parses an RFC 3339 timestamp or a number of seconds since the epoch.
+func timestampValue(value any) (pcommon.Timestamp, error) {
+	if s, ok := value.(string); ok {
+		if t, err := time.Parse(time.RFC3339Nano, strings.TrimSpace(s)); err == nil {
+			return pcommon.NewTimestampFromTime(t), nil
+		}
+	}
+	seconds, err := doubleValue(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid timestamp %v", value)
+	}
+	whole, frac := math.Modf(seconds)
+	return pcommon.NewTimestampFromTime(time.Unix(int64(whole), int64(frac*1e9))), nil
+}
+
+// severityNumber returns the severity number of the usual names of the levels.
+func severityNumber(severity string) plog.SeverityNumber {
+	switch strings.ToLower(strings.TrimSpace(severity)) {
+	case "trace":
+		return plog.SeverityNumberTrace
+	case "debug":
+		return plog.SeverityNumberDebug
+	case "info", "information", "informational", "notice":
+		return plog.SeverityNumberInfo
+	case "warn", "warning":
+		return plog.SeverityNumberWarn
+	case "error", "err":
+		return plog.SeverityNumberError
+	case "fatal", "critical", "crit", "emergency", "alert":
+		return plog.SeverityNumberFatal
+	}
+	return plog.SeverityNumberUnspecified
+}
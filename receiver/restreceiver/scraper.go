@@ -0,0 +1,121 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package restreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/restreceiver"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+)
+
+const scopeName = "otelcol/restreceiver"
+
+type restScraper struct {
+	cfg       *Config
+	settings  component.TelemetrySettings
+	client    *apiClient
+	metrics   []metricMapping
+	startTime pcommon.Timestamp
+}
+
+func newRESTScraper(settings receiver.CreateSettings, cfg *Config) (*restScraper, error) {
+	metrics, err := newMetricMappings(cfg.Metrics)
+	if err != nil {
+		return nil, err
+	}
+	return &restScraper{
+		cfg:      cfg,
+		settings: settings.TelemetrySettings,
+		metrics:  metrics,
+	}, nil
+}
+
+func (s *restScraper) start(ctx context.Context, host component.Host) error {
+	client, err := newAPIClient(ctx, s.cfg, host, s.settings)
+	if err != nil {
+		return err
+	}
+	s.client = client
+	s.startTime = pcommon.NewTimestampFromTime(time.Now())
+	return nil
+}
+
+func (s *restScraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
+	if s.client == nil {
+		return pmetric.NewMetrics(), errors.New("no client available")
+	}
+	response, err := s.client.fetch(ctx)
+	if err != nil {
+		return pmetric.NewMetrics(), err
+	}
+
+	now := pcommon.NewTimestampFromTime(time.Now())
+	metrics := pmetric.NewMetrics()
+	sm := metrics.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty()
+	sm.Scope().SetName(scopeName)
+	errs := &scrapererror.ScrapeErrors{}
+	for _, m := range s.metrics {
+		s.recordMetric(m, response, now, sm.Metrics(), errs)
+	}
+	return metrics, errs.Combine()
+}
+
+// recordMetric appends the metric to dest with a data point per item of the response, the
+// metric being skipped when none of the items has a value.
+func (s *restScraper) recordMetric(m metricMapping, response any, now pcommon.Timestamp, dest pmetric.MetricSlice, errs *scrapererror.ScrapeErrors) {
+	metric := pmetric.NewMetric()
+	metric.SetName(m.Name)
+	metric.SetDescription(m.Description)
+	metric.SetUnit(m.Unit)
+	var dps pmetric.NumberDataPointSlice
+	if m.Type == MetricTypeSum {
+		sum := metric.SetEmptySum()
+		sum.SetIsMonotonic(m.Monotonic)
+		sum.SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+		dps = sum.DataPoints()
+	} else {
+		dps = metric.SetEmptyGauge().DataPoints()
+	}
+
+	for i, item := range m.items.Get(response) {
+		raw, ok := first(m.value, item)
+		if !ok {
+			errs.AddPartial(1, fmt.Errorf("metric '%s': item %d: no value at '%s'", m.Name, i, m.value))
+			continue
+		}
+		dp := pmetric.NewNumberDataPoint()
+		if m.ValueType == ValueTypeInt {
+			value, err := intValue(raw)
+			if err != nil {
+				errs.AddPartial(1, fmt.Errorf("metric '%s': item %d: %w", m.Name, i, err))
+				continue
+			}
+			dp.SetIntValue(value)
+		} else {
+			value, err := doubleValue(raw)
+			if err != nil {
+				errs.AddPartial(1, fmt.Errorf("metric '%s': item %d: %w", m.Name, i, err))
+				continue
+			}
+			dp.SetDoubleValue(value)
+		}
+		dp.SetTimestamp(now)
+		if m.Type == MetricTypeSum {
+			dp.SetStartTimestamp(s.startTime)
+		}
+		putAttributes(m.attributes, item, dp.Attributes())
+		dp.MoveTo(dps.AppendEmpty())
+	}
+
+	if dps.Len() > 0 {
+		metric.MoveTo(dest.AppendEmpty())
+	}
+}
@@ -0,0 +1,168 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package restreceiver
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+)
+
+// newMockServer serves the file of the testdata directory to the requests with the method.
+func newMockServer(t *testing.T, method string, file string) *httptest.Server {
+	body, err := os.ReadFile(filepath.Join("testdata", file))
+	require.NoError(t, err)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != method {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		_, _ = w.Write(body)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func newTestScraper(t *testing.T, cfg *Config) *restScraper {
+	scraper, err := newRESTScraper(receivertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err)
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+	return scraper
+}
+
+func TestScrapeJSON(t *testing.T) {
+	server := newMockServer(t, http.MethodGet, "arrays.json")
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = server.URL
+	cfg.Metrics = []MetricConfig{
+		{
+			Name:       "array.capacity",
+			Unit:       "By",
+			ValueType:  ValueTypeInt,
+			Items:      "$.items[*]",
+			Value:      "$.capacity",
+			Attributes: []AttributeConfig{{Name: "array.name", Value: "$.name"}, {Name: "array.tags", Value: "$.tags"}},
+		},
+		{
+			Name:       "array.reads",
+			Type:       MetricTypeSum,
+			Monotonic:  true,
+			Items:      "$.items[*]",
+			Value:      "$.space.reads",
+			Attributes: []AttributeConfig{{Name: "array.name", Value: "$.name"}},
+		},
+		{
+			Name:  "array.data_reduction",
+			Items: "$.items[*]",
+			Value: "$.space.data_reduction",
+		},
+		{
+			Name:  "arrays.count",
+			Value: "$.total_item_count",
+		},
+		{
+			Name:  "arrays.missing",
+			Value: "$.missing",
+		},
+	}
+	scraper := newTestScraper(t, cfg)
+
+	metrics, err := scraper.scrape(context.Background())
+	require.True(t, scrapererror.IsPartialScrapeError(err))
+	require.EqualError(t, err, "metric 'array.reads': item 1: value \"nope\" is not a number; metric 'arrays.missing': item 0: no value at '$.missing'")
+
+	require.Equal(t, 1, metrics.ResourceMetrics().Len())
+	sm := metrics.ResourceMetrics().At(0).ScopeMetrics().At(0)
+	require.Equal(t, scopeName, sm.Scope().Name())
+	ms := sm.Metrics()
+	require.Equal(t, 4, ms.Len())
+
+	capacity := ms.At(0)
+	require.Equal(t, "array.capacity", capacity.Name())
+	require.Equal(t, "By", capacity.Unit())
+	require.Equal(t, pmetric.MetricTypeGauge, capacity.Type())
+	require.Equal(t, 2, capacity.Gauge().DataPoints().Len())
+	dp := capacity.Gauge().DataPoints().At(0)
+	require.Equal(t, int64(1099511627776), dp.IntValue())
+	require.Equal(t, map[string]any{"array.name": "array01", "array.tags": []any{"prod", "eu"}}, dp.Attributes().AsRaw())
+	dp = capacity.Gauge().DataPoints().At(1)
+	require.Equal(t, int64(549755813888), dp.IntValue())
+	require.Equal(t, map[string]any{"array.name": "array02"}, dp.Attributes().AsRaw())
+
+	reads := ms.At(1)
+	require.Equal(t, "array.reads", reads.Name())
+	require.True(t, reads.Sum().IsMonotonic())
+	require.Equal(t, pmetric.AggregationTemporalityCumulative, reads.Sum().AggregationTemporality())
+	require.Equal(t, 1, reads.Sum().DataPoints().Len())
+	dp = reads.Sum().DataPoints().At(0)
+	require.Equal(t, 12345.0, dp.DoubleValue())
+	require.Equal(t, scraper.startTime, dp.StartTimestamp())
+
+	reduction := ms.At(2)
+	require.Equal(t, 2, reduction.Gauge().DataPoints().Len())
+	require.Equal(t, 3.5, reduction.Gauge().DataPoints().At(0).DoubleValue())
+	require.Equal(t, 1.0, reduction.Gauge().DataPoints().At(1).DoubleValue())
+
+	count := ms.At(3)
+	require.Equal(t, "arrays.count", count.Name())
+	require.Equal(t, 2.0, count.Gauge().DataPoints().At(0).DoubleValue())
+}
+
+func TestScrapeXML(t *testing.T) {
+	server := newMockServer(t, http.MethodPost, "status.xml")
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = server.URL
+	cfg.Method = http.MethodPost
+	cfg.Body = `<request type="status"/>`
+	cfg.Format = FormatXML
+	cfg.Metrics = []MetricConfig{
+		{
+			Name:       "appliance.fan.speed",
+			ValueType:  ValueTypeInt,
+			Items:      "$.status.fans.fan[*]",
+			Value:      "$['#text']",
+			Attributes: []AttributeConfig{{Name: "fan", Value: "$['@id']"}},
+		},
+		{
+			Name:  "appliance.temperature",
+			Value: "$.status.temperature",
+		},
+	}
+	scraper := newTestScraper(t, cfg)
+
+	metrics, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+	ms := metrics.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 2, ms.Len())
+
+	dps := ms.At(0).Gauge().DataPoints()
+	require.Equal(t, 2, dps.Len())
+	require.Equal(t, int64(1200), dps.At(0).IntValue())
+	require.Equal(t, map[string]any{"fan": "1"}, dps.At(0).Attributes().AsRaw())
+	require.Equal(t, int64(1350), dps.At(1).IntValue())
+	require.Equal(t, map[string]any{"fan": "2"}, dps.At(1).Attributes().AsRaw())
+
+	require.Equal(t, 41.5, ms.At(1).Gauge().DataPoints().At(0).DoubleValue())
+}
+
+func TestScrapeFailedRequest(t *testing.T) {
+	server := newMockServer(t, http.MethodPost, "arrays.json")
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = server.URL
+	cfg.Metrics = []MetricConfig{{Name: "arrays.count", Value: "$.total_item_count"}}
+	scraper := newTestScraper(t, cfg)
+
+	metrics, err := scraper.scrape(context.Background())
+	require.ErrorContains(t, err, `failed - "405 Method Not Allowed"`)
+	require.Equal(t, 0, metrics.MetricCount())
+}
@@ -19,6 +19,8 @@ import (
 const (
 	ErrNoUsername = "invalid config: missing username"
 	ErrNoPassword = "invalid config: missing password" // #nosec G101 - not hardcoded credentials
+
+	ErrInvalidMaxExpensiveStatements = "invalid config: max_expensive_statements must be positive"
 )
 
 type Config struct {
@@ -30,6 +32,10 @@ type Config struct {
 
 	Username string              `mapstructure:"username"`
 	Password configopaque.String `mapstructure:"password"`
+
+	// MaxExpensiveStatements limits the statements of the expensive statements trace emitted as
+	// events by a collection, the following statements being emitted by the next collections.
+	MaxExpensiveStatements int `mapstructure:"max_expensive_statements"`
 }
 
 func (cfg *Config) Validate() error {
@@ -40,6 +46,9 @@ func (cfg *Config) Validate() error {
 	if cfg.Password == "" {
 		err = multierr.Append(err, errors.New(ErrNoPassword))
 	}
+	if cfg.MaxExpensiveStatements <= 0 {
+		err = multierr.Append(err, errors.New(ErrInvalidMaxExpensiveStatements))
+	}
 
 	return err
 }
@@ -51,6 +51,17 @@ func TestValidate(t *testing.T) {
 				errors.New(ErrNoUsername),
 			),
 		},
+		{
+			desc: "invalid max expensive statements",
+			defaultConfigModifier: func(cfg *Config) {
+				cfg.Username = "otel"
+				cfg.Password = "otel"
+				cfg.MaxExpensiveStatements = 0
+			},
+			expected: multierr.Combine(
+				errors.New(ErrInvalidMaxExpensiveStatements),
+			),
+		},
 		{
 			desc: "no error",
 			defaultConfigModifier: func(cfg *Config) {
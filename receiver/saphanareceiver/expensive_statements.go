@@ -0,0 +1,105 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package saphanareceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/saphanareceiver"
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+)
+
+const (
+	expensiveStatementEventName = "saphana.expensive_statement"
+
+	expensiveStatementsQuery = "SELECT HOST, START_TIME, PORT, CONNECTION_ID, TRANSACTION_ID, STATEMENT_ID, STATEMENT_HASH, DB_USER, APP_USER, APPLICATION_NAME, OBJECT_NAME, OPERATION, RECORDS, DURATION_MICROSEC, CPU_TIME, LOCK_WAIT_DURATION, MEMORY_SIZE, ERROR_CODE, ERROR_TEXT, STATEMENT_STRING FROM SYS.M_EXPENSIVE_STATEMENTS WHERE START_TIME > %s ORDER BY START_TIME LIMIT %d"
+	// hanaTimestampLayout is the layout of the timestamps in the TO_TIMESTAMP format below.
+	hanaTimestampLayout = "2006-01-02 15:04:05.000000"
+)
+
+// expensiveStatementAttributes maps the columns of the expensive statements to the attributes of the events,
+// with whether their values are integers.
+var expensiveStatementAttributes = []struct {
+	column    string
+	attribute string
+	isInt     bool
+}{
+	{column: "port", attribute: "saphana.port", isInt: true},
+	{column: "connection_id", attribute: "saphana.connection.id", isInt: true},
+	{column: "transaction_id", attribute: "saphana.transaction.id", isInt: true},
+	{column: "statement_id", attribute: "saphana.statement.id"},
+	{column: "statement_hash", attribute: "saphana.statement.hash"},
+	{column: "db_user", attribute: "db.user"},
+	{column: "app_user", attribute: "saphana.app_user"},
+	{column: "application_name", attribute: "saphana.application.name"},
+	{column: "object_name", attribute: "saphana.statement.object_name"},
+	{column: "operation", attribute: "db.operation"},
+	{column: "records", attribute: "saphana.statement.records", isInt: true},
+	{column: "duration_microsec", attribute: "saphana.statement.duration_us", isInt: true},
+	{column: "cpu_time", attribute: "saphana.statement.cpu_time_us", isInt: true},
+	{column: "lock_wait_duration", attribute: "saphana.statement.lock_wait_duration_us", isInt: true},
+	{column: "memory_size", attribute: "saphana.statement.memory_size", isInt: true},
+	{column: "error_code", attribute: "saphana.statement.error_code", isInt: true},
+	{column: "error_text", attribute: "saphana.statement.error_text"},
+}
+
+// newExpensiveStatementsQuery returns the query of the statements started after the last statement of the
+// previous collection, or within the lookback of the first collection.
+func newExpensiveStatementsQuery(lastStartTime time.Time, lookback time.Duration, limit int) *monitoringQuery {
+	since := fmt.Sprintf("ADD_SECONDS(CURRENT_TIMESTAMP, -%d)", int64(lookback.Seconds()))
+	if !lastStartTime.IsZero() {
+		since = fmt.Sprintf("TO_TIMESTAMP('%s', 'YYYY-MM-DD HH24:MI:SS.FF6')", lastStartTime.UTC().Format(hanaTimestampLayout))
+	}
+
+	stats := make([]queryStat, 0, len(expensiveStatementAttributes)+1)
+	for _, attribute := range expensiveStatementAttributes {
+		stats = append(stats, queryStat{key: attribute.column})
+	}
+	stats = append(stats, queryStat{key: "statement_string"})
+
+	return &monitoringQuery{
+		query:                 fmt.Sprintf(expensiveStatementsQuery, since, limit),
+		orderedResourceLabels: []string{"host"},
+		orderedMetricLabels:   []string{"start_time"},
+		orderedStats:          stats,
+	}
+}
+
+// expensiveStatementToLogRecord converts a row of the expensive statements, returning the start time of the
+// statement.
+func expensiveStatementToLogRecord(row map[string]string, dest plog.LogRecord) (time.Time, error) {
+	startTime, err := time.Parse(time.RFC3339Nano, row["start_time"])
+	if err != nil {
+		return time.Time{}, fmt.Errorf("unable to parse the start time of the expensive statement: %w", err)
+	}
+	dest.SetTimestamp(pcommon.NewTimestampFromTime(startTime))
+	dest.SetObservedTimestamp(pcommon.NewTimestampFromTime(time.Now()))
+	dest.Body().SetStr(row["statement_string"])
+
+	// the statements exceeding the threshold of the trace, the failed ones being errors
+	dest.SetSeverityNumber(plog.SeverityNumberWarn)
+	if code, ok := row["error_code"]; ok && code != "0" {
+		dest.SetSeverityNumber(plog.SeverityNumberError)
+	}
+	dest.SetSeverityText(dest.SeverityNumber().String())
+
+	attributes := dest.Attributes()
+	attributes.PutStr("event.name", expensiveStatementEventName)
+	for _, attribute := range expensiveStatementAttributes {
+		value, ok := row[attribute.column]
+		if !ok || value == "" {
+			continue
+		}
+		if attribute.isInt {
+			if parsed, err := strconv.ParseInt(value, 10, 64); err == nil {
+				attributes.PutInt(attribute.attribute, parsed)
+				continue
+			}
+		}
+		attributes.PutStr(attribute.attribute, value)
+	}
+	return startTime, nil
+}
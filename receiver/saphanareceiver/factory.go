@@ -19,7 +19,8 @@ import (
 )
 
 const (
-	defaultEndpoint = "localhost:33015"
+	defaultEndpoint               = "localhost:33015"
+	defaultMaxExpensiveStatements = 1000
 )
 
 // NewFactory creates a factory for SAP HANA receiver.
@@ -27,7 +28,8 @@ func NewFactory() receiver.Factory {
 	return receiver.NewFactory(
 		metadata.Type,
 		createDefaultConfig,
-		receiver.WithMetrics(createMetricsReceiver, metadata.MetricsStability))
+		receiver.WithMetrics(createMetricsReceiver, metadata.MetricsStability),
+		receiver.WithLogs(createLogsReceiver, metadata.LogsStability))
 }
 
 func createDefaultConfig() component.Config {
@@ -40,8 +42,9 @@ func createDefaultConfig() component.Config {
 		ClientConfig: configtls.ClientConfig{
 			Insecure: true,
 		},
-		ControllerConfig:     scs,
-		MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+		ControllerConfig:       scs,
+		MetricsBuilderConfig:   metadata.DefaultMetricsBuilderConfig(),
+		MaxExpensiveStatements: defaultMaxExpensiveStatements,
 	}
 }
 
@@ -64,3 +67,16 @@ func createMetricsReceiver(
 
 	return scraperhelper.NewScraperControllerReceiver(&c.ControllerConfig, set, consumer, scraperhelper.AddScraper(scraper))
 }
+
+func createLogsReceiver(
+	_ context.Context,
+	set receiver.CreateSettings,
+	cfg component.Config,
+	consumer consumer.Logs,
+) (receiver.Logs, error) {
+	c, ok := cfg.(*Config)
+	if !ok {
+		return nil, errConfigNotSAPHANA
+	}
+	return newLogsReceiver(set, c, consumer, &defaultConnectionFactory{}), nil
+}
@@ -29,6 +29,8 @@ func (ms *MetricConfig) Unmarshal(parser *confmap.Conf) error {
 // MetricsConfig provides config for saphana metrics.
 type MetricsConfig struct {
 	SaphanaAlertCount                       MetricConfig `mapstructure:"saphana.alert.count"`
+	SaphanaBackupAge                        MetricConfig `mapstructure:"saphana.backup.age"`
+	SaphanaBackupCount                      MetricConfig `mapstructure:"saphana.backup.count"`
 	SaphanaBackupLatest                     MetricConfig `mapstructure:"saphana.backup.latest"`
 	SaphanaColumnMemoryUsed                 MetricConfig `mapstructure:"saphana.column.memory.used"`
 	SaphanaComponentMemoryUsed              MetricConfig `mapstructure:"saphana.component.memory.used"`
@@ -80,6 +82,12 @@ func DefaultMetricsConfig() MetricsConfig {
 		SaphanaAlertCount: MetricConfig{
 			Enabled: true,
 		},
+		SaphanaBackupAge: MetricConfig{
+			Enabled: false,
+		},
+		SaphanaBackupCount: MetricConfig{
+			Enabled: false,
+		},
 		SaphanaBackupLatest: MetricConfig{
 			Enabled: true,
 		},
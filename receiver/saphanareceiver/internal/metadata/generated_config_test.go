@@ -27,6 +27,8 @@ func TestMetricsBuilderConfig(t *testing.T) {
 			want: MetricsBuilderConfig{
 				Metrics: MetricsConfig{
 					SaphanaAlertCount:                       MetricConfig{Enabled: true},
+					SaphanaBackupAge:                        MetricConfig{Enabled: true},
+					SaphanaBackupCount:                      MetricConfig{Enabled: true},
 					SaphanaBackupLatest:                     MetricConfig{Enabled: true},
 					SaphanaColumnMemoryUsed:                 MetricConfig{Enabled: true},
 					SaphanaComponentMemoryUsed:              MetricConfig{Enabled: true},
@@ -83,6 +85,8 @@ func TestMetricsBuilderConfig(t *testing.T) {
 			want: MetricsBuilderConfig{
 				Metrics: MetricsConfig{
 					SaphanaAlertCount:                       MetricConfig{Enabled: false},
+					SaphanaBackupAge:                        MetricConfig{Enabled: false},
+					SaphanaBackupCount:                      MetricConfig{Enabled: false},
 					SaphanaBackupLatest:                     MetricConfig{Enabled: false},
 					SaphanaColumnMemoryUsed:                 MetricConfig{Enabled: false},
 					SaphanaComponentMemoryUsed:              MetricConfig{Enabled: false},
@@ -579,6 +579,109 @@ func newMetricSaphanaAlertCount(cfg MetricConfig) metricSaphanaAlertCount {
 	return m
 }
 
+type metricSaphanaBackupAge struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills saphana.backup.age metric with initial data.
+func (m *metricSaphanaBackupAge) init() {
+	m.data.SetName("saphana.backup.age")
+	m.data.SetDescription("The age of the latest successful backup of each type by start time.")
+	m.data.SetUnit("s")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSaphanaBackupAge) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, backupTypeAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("type", backupTypeAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSaphanaBackupAge) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSaphanaBackupAge) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSaphanaBackupAge(cfg MetricConfig) metricSaphanaBackupAge {
+	m := metricSaphanaBackupAge{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSaphanaBackupCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills saphana.backup.count metric with initial data.
+func (m *metricSaphanaBackupCount) init() {
+	m.data.SetName("saphana.backup.count")
+	m.data.SetDescription("The number of backups started in the last 24 hours.")
+	m.data.SetUnit("{backups}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSaphanaBackupCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, backupTypeAttributeValue string, backupStateAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("type", backupTypeAttributeValue)
+	dp.Attributes().PutStr("state", backupStateAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSaphanaBackupCount) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSaphanaBackupCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSaphanaBackupCount(cfg MetricConfig) metricSaphanaBackupCount {
+	m := metricSaphanaBackupCount{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricSaphanaBackupLatest struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -2930,6 +3033,8 @@ type MetricsBuilder struct {
 	resourceAttributeIncludeFilter                map[string]filter.Filter
 	resourceAttributeExcludeFilter                map[string]filter.Filter
 	metricSaphanaAlertCount                       metricSaphanaAlertCount
+	metricSaphanaBackupAge                        metricSaphanaBackupAge
+	metricSaphanaBackupCount                      metricSaphanaBackupCount
 	metricSaphanaBackupLatest                     metricSaphanaBackupLatest
 	metricSaphanaColumnMemoryUsed                 metricSaphanaColumnMemoryUsed
 	metricSaphanaComponentMemoryUsed              metricSaphanaComponentMemoryUsed
@@ -2993,6 +3098,8 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.CreateSetting
 		metricsBuffer:                                 pmetric.NewMetrics(),
 		buildInfo:                                     settings.BuildInfo,
 		metricSaphanaAlertCount:                       newMetricSaphanaAlertCount(mbc.Metrics.SaphanaAlertCount),
+		metricSaphanaBackupAge:                        newMetricSaphanaBackupAge(mbc.Metrics.SaphanaBackupAge),
+		metricSaphanaBackupCount:                      newMetricSaphanaBackupCount(mbc.Metrics.SaphanaBackupCount),
 		metricSaphanaBackupLatest:                     newMetricSaphanaBackupLatest(mbc.Metrics.SaphanaBackupLatest),
 		metricSaphanaColumnMemoryUsed:                 newMetricSaphanaColumnMemoryUsed(mbc.Metrics.SaphanaColumnMemoryUsed),
 		metricSaphanaComponentMemoryUsed:              newMetricSaphanaComponentMemoryUsed(mbc.Metrics.SaphanaComponentMemoryUsed),
@@ -3114,6 +3221,8 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	ils.Scope().SetVersion(mb.buildInfo.Version)
 	ils.Metrics().EnsureCapacity(mb.metricsCapacity)
 	mb.metricSaphanaAlertCount.emit(ils.Metrics())
+	mb.metricSaphanaBackupAge.emit(ils.Metrics())
+	mb.metricSaphanaBackupCount.emit(ils.Metrics())
 	mb.metricSaphanaBackupLatest.emit(ils.Metrics())
 	mb.metricSaphanaColumnMemoryUsed.emit(ils.Metrics())
 	mb.metricSaphanaComponentMemoryUsed.emit(ils.Metrics())
@@ -3199,6 +3308,26 @@ func (mb *MetricsBuilder) RecordSaphanaAlertCountDataPoint(ts pcommon.Timestamp,
 	return nil
 }
 
+// RecordSaphanaBackupAgeDataPoint adds a data point to saphana.backup.age metric.
+func (mb *MetricsBuilder) RecordSaphanaBackupAgeDataPoint(ts pcommon.Timestamp, inputVal string, backupTypeAttributeValue string) error {
+	val, err := strconv.ParseInt(inputVal, 10, 64)
+	if err != nil {
+		return fmt.Errorf("failed to parse int64 for SaphanaBackupAge, value was %s: %w", inputVal, err)
+	}
+	mb.metricSaphanaBackupAge.recordDataPoint(mb.startTime, ts, val, backupTypeAttributeValue)
+	return nil
+}
+
+// RecordSaphanaBackupCountDataPoint adds a data point to saphana.backup.count metric.
+func (mb *MetricsBuilder) RecordSaphanaBackupCountDataPoint(ts pcommon.Timestamp, inputVal string, backupTypeAttributeValue string, backupStateAttributeValue string) error {
+	val, err := strconv.ParseInt(inputVal, 10, 64)
+	if err != nil {
+		return fmt.Errorf("failed to parse int64 for SaphanaBackupCount, value was %s: %w", inputVal, err)
+	}
+	mb.metricSaphanaBackupCount.recordDataPoint(mb.startTime, ts, val, backupTypeAttributeValue, backupStateAttributeValue)
+	return nil
+}
+
 // RecordSaphanaBackupLatestDataPoint adds a data point to saphana.backup.latest metric.
 func (mb *MetricsBuilder) RecordSaphanaBackupLatestDataPoint(ts pcommon.Timestamp, inputVal string) error {
 	val, err := strconv.ParseInt(inputVal, 10, 64)
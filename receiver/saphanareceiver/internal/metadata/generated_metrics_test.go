@@ -72,6 +72,12 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordSaphanaAlertCountDataPoint(ts, "1", "alert_rating-val")
 
+			allMetricsCount++
+			mb.RecordSaphanaBackupAgeDataPoint(ts, "1", "backup_type-val")
+
+			allMetricsCount++
+			mb.RecordSaphanaBackupCountDataPoint(ts, "1", "backup_type-val", "backup_state-val")
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordSaphanaBackupLatestDataPoint(ts, "1")
@@ -290,6 +296,39 @@ func TestMetricsBuilder(t *testing.T) {
 					attrVal, ok := dp.Attributes().Get("rating")
 					assert.True(t, ok)
 					assert.EqualValues(t, "alert_rating-val", attrVal.Str())
+				case "saphana.backup.age":
+					assert.False(t, validatedMetrics["saphana.backup.age"], "Found a duplicate in the metrics slice: saphana.backup.age")
+					validatedMetrics["saphana.backup.age"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The age of the latest successful backup of each type by start time.", ms.At(i).Description())
+					assert.Equal(t, "s", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("type")
+					assert.True(t, ok)
+					assert.EqualValues(t, "backup_type-val", attrVal.Str())
+				case "saphana.backup.count":
+					assert.False(t, validatedMetrics["saphana.backup.count"], "Found a duplicate in the metrics slice: saphana.backup.count")
+					validatedMetrics["saphana.backup.count"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The number of backups started in the last 24 hours.", ms.At(i).Description())
+					assert.Equal(t, "{backups}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("type")
+					assert.True(t, ok)
+					assert.EqualValues(t, "backup_type-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("state")
+					assert.True(t, ok)
+					assert.EqualValues(t, "backup_state-val", attrVal.Str())
 				case "saphana.backup.latest":
 					assert.False(t, validatedMetrics["saphana.backup.latest"], "Found a duplicate in the metrics slice: saphana.backup.latest")
 					validatedMetrics["saphana.backup.latest"] = true
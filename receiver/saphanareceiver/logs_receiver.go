@@ -0,0 +1,126 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package saphanareceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/saphanareceiver"
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/receiver"
+	"go.uber.org/zap"
+)
+
+// Runs intermittently, reading the statements of the expensive statements trace started since the previous
+// collection, and feeding them as events to a logsConsumer.
+type logsReceiver struct {
+	settings      receiver.CreateSettings
+	cfg           *Config
+	nextConsumer  consumer.Logs
+	factory       sapHanaConnectionFactory
+	lastStartTime time.Time
+	cancel        context.CancelFunc
+	wg            sync.WaitGroup
+}
+
+func newLogsReceiver(settings receiver.CreateSettings, cfg *Config, nextConsumer consumer.Logs, factory sapHanaConnectionFactory) *logsReceiver {
+	return &logsReceiver{
+		settings:     settings,
+		cfg:          cfg,
+		nextConsumer: nextConsumer,
+		factory:      factory,
+	}
+}
+
+func (r *logsReceiver) Start(_ context.Context, _ component.Host) error {
+	var ctx context.Context
+	ctx, r.cancel = context.WithCancel(context.Background())
+	r.wg.Add(1)
+	go r.collectLoop(ctx)
+	return nil
+}
+
+func (r *logsReceiver) Shutdown(context.Context) error {
+	if r.cancel != nil {
+		r.cancel()
+	}
+	r.wg.Wait()
+	return nil
+}
+
+func (r *logsReceiver) collectLoop(ctx context.Context) {
+	defer r.wg.Done()
+
+	if r.cfg.InitialDelay > 0 {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(r.cfg.InitialDelay):
+		}
+	}
+
+	ticker := time.NewTicker(r.cfg.CollectionInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := r.collect(ctx); err != nil {
+			r.settings.Logger.Error("Error collecting the expensive statements", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (r *logsReceiver) collect(ctx context.Context) error {
+	client := newSapHanaClient(r.cfg, r.factory)
+	if err := client.Connect(ctx); err != nil {
+		return err
+	}
+	defer client.Close()
+
+	rows, err := client.collectDataFromQuery(ctx, newExpensiveStatementsQuery(r.lastStartTime, r.cfg.CollectionInterval, r.cfg.MaxExpensiveStatements))
+	if err != nil {
+		// the rows with a NULL host or start time are skipped, the others are still emitted
+		r.settings.Logger.Warn("Error reading the expensive statements", zap.Error(err))
+	}
+
+	logs := plog.NewLogs()
+	resourceLogs := map[string]plog.LogRecordSlice{}
+	for _, row := range rows {
+		logRecord := plog.NewLogRecord()
+		startTime, err := expensiveStatementToLogRecord(row, logRecord)
+		if err != nil {
+			r.settings.Logger.Warn("Skipping an expensive statement", zap.Error(err))
+			continue
+		}
+		if startTime.After(r.lastStartTime) {
+			r.lastStartTime = startTime
+		}
+
+		records, ok := resourceLogs[row["host"]]
+		if !ok {
+			rl := logs.ResourceLogs().AppendEmpty()
+			rl.Resource().Attributes().PutStr("db.system", "saphana")
+			rl.Resource().Attributes().PutStr("saphana.host", row["host"])
+			sl := rl.ScopeLogs().AppendEmpty()
+			sl.Scope().SetName("otelcol/saphanareceiver")
+			sl.Scope().SetVersion(r.settings.BuildInfo.Version)
+			records = sl.LogRecords()
+			resourceLogs[row["host"]] = records
+		}
+		logRecord.MoveTo(records.AppendEmpty())
+	}
+
+	if logs.LogRecordCount() == 0 {
+		return nil
+	}
+	return r.nextConsumer.ConsumeLogs(ctx, logs)
+}
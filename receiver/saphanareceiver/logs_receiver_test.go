@@ -0,0 +1,122 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package saphanareceiver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+)
+
+func TestNewExpensiveStatementsQuery(t *testing.T) {
+	query := newExpensiveStatementsQuery(time.Time{}, time.Minute, 100)
+	assert.Contains(t, query.query, "WHERE START_TIME > ADD_SECONDS(CURRENT_TIMESTAMP, -60) ORDER BY START_TIME LIMIT 100")
+	assert.Equal(t, []string{"host"}, query.orderedResourceLabels)
+	assert.Equal(t, []string{"start_time"}, query.orderedMetricLabels)
+	assert.Len(t, query.orderedStats, len(expensiveStatementAttributes)+1)
+
+	query = newExpensiveStatementsQuery(time.Date(2024, 5, 10, 10, 15, 30, 123456000, time.UTC), time.Minute, 100)
+	assert.Contains(t, query.query, "WHERE START_TIME > TO_TIMESTAMP('2024-05-10 10:15:30.123456', 'YYYY-MM-DD HH24:MI:SS.FF6') ORDER BY START_TIME LIMIT 100")
+}
+
+func TestExpensiveStatementToLogRecord(t *testing.T) {
+	logRecord := plog.NewLogRecord()
+	startTime, err := expensiveStatementToLogRecord(map[string]string{
+		"host":               "host1",
+		"start_time":         "2024-05-10T10:15:30.123456Z",
+		"port":               "30003",
+		"connection_id":      "200123",
+		"statement_hash":     "a3bf82dd1cd4a8e0b6a4b1c2f1e3c4d5",
+		"db_user":            "SYSTEM",
+		"application_name":   "reporting",
+		"operation":          "SELECT",
+		"records":            "1200",
+		"duration_microsec":  "5400000",
+		"error_code":         "0",
+		"error_text":         "",
+		"statement_string":   "SELECT * FROM SALES WHERE REGION = ?",
+		"lock_wait_duration": "not a number",
+	}, logRecord)
+	require.NoError(t, err)
+
+	assert.Equal(t, time.Date(2024, 5, 10, 10, 15, 30, 123456000, time.UTC), startTime)
+	assert.Equal(t, startTime, logRecord.Timestamp().AsTime())
+	assert.Equal(t, plog.SeverityNumberWarn, logRecord.SeverityNumber())
+	assert.Equal(t, "SELECT * FROM SALES WHERE REGION = ?", logRecord.Body().Str())
+	assert.Equal(t, map[string]any{
+		"event.name":                              "saphana.expensive_statement",
+		"saphana.port":                            int64(30003),
+		"saphana.connection.id":                   int64(200123),
+		"saphana.statement.hash":                  "a3bf82dd1cd4a8e0b6a4b1c2f1e3c4d5",
+		"db.user":                                 "SYSTEM",
+		"saphana.application.name":                "reporting",
+		"db.operation":                            "SELECT",
+		"saphana.statement.records":               int64(1200),
+		"saphana.statement.duration_us":           int64(5400000),
+		"saphana.statement.error_code":            int64(0),
+		"saphana.statement.lock_wait_duration_us": "not a number",
+	}, logRecord.Attributes().AsRaw())
+
+	logRecord = plog.NewLogRecord()
+	_, err = expensiveStatementToLogRecord(map[string]string{
+		"start_time":       "2024-05-10T10:15:30Z",
+		"error_code":       "2048",
+		"error_text":       "column store error",
+		"statement_string": "INSERT INTO SALES VALUES (?)",
+	}, logRecord)
+	require.NoError(t, err)
+	assert.Equal(t, plog.SeverityNumberError, logRecord.SeverityNumber())
+
+	_, err = expensiveStatementToLogRecord(map[string]string{"start_time": "yesterday"}, plog.NewLogRecord())
+	assert.ErrorContains(t, err, "unable to parse the start time of the expensive statement")
+}
+
+func TestLogsReceiverCollect(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.CollectionInterval = time.Minute
+	cfg.MaxExpensiveStatements = 10
+
+	dbWrapper := &testDBWrapper{}
+	dbWrapper.On("PingContext").Return(nil)
+	dbWrapper.On("Close").Return(nil)
+	row := func(host string, startTime *string, statement string) []*string {
+		values := []*string{str(host), startTime}
+		for range expensiveStatementAttributes {
+			values = append(values, nil)
+		}
+		return append(values, str(statement))
+	}
+	dbWrapper.mockQueryResult(newExpensiveStatementsQuery(time.Time{}, time.Minute, 10).query, [][]*string{
+		row("host1", str("2024-05-10T10:15:30Z"), "SELECT 1 FROM DUMMY"),
+		row("host2", str("2024-05-10T10:15:31Z"), "SELECT 2 FROM DUMMY"),
+		row("host1", str("2024-05-10T10:15:32Z"), "SELECT 3 FROM DUMMY"),
+		// a row without start time is skipped
+		row("host1", nil, "SELECT 4 FROM DUMMY"),
+	}, nil)
+	lastStartTime := time.Date(2024, 5, 10, 10, 15, 32, 0, time.UTC)
+	dbWrapper.mockQueryResult(newExpensiveStatementsQuery(lastStartTime, time.Minute, 10).query, nil, nil)
+
+	sink := new(consumertest.LogsSink)
+	receiver := newLogsReceiver(receivertest.NewNopCreateSettings(), cfg, sink, &testConnectionFactory{dbWrapper})
+	require.NoError(t, receiver.collect(context.Background()))
+
+	require.Len(t, sink.AllLogs(), 1)
+	logs := sink.AllLogs()[0]
+	require.Equal(t, 2, logs.ResourceLogs().Len())
+	assert.Equal(t, map[string]any{"db.system": "saphana", "saphana.host": "host1"}, logs.ResourceLogs().At(0).Resource().Attributes().AsRaw())
+	assert.Equal(t, 2, logs.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().Len())
+	assert.Equal(t, 1, logs.ResourceLogs().At(1).ScopeLogs().At(0).LogRecords().Len())
+	assert.Equal(t, lastStartTime, receiver.lastStartTime)
+
+	// the next collection continues after the last statement, nothing being consumed without statements
+	require.NoError(t, receiver.collect(context.Background()))
+	assert.Len(t, sink.AllLogs(), 1)
+	dbWrapper.AssertExpectations(t)
+}
@@ -241,6 +241,38 @@ var queries = []monitoringQuery{
 			return c.MetricsBuilderConfig.Metrics.SaphanaBackupLatest.Enabled
 		},
 	},
+	{
+		query:               "SELECT ENTRY_TYPE_NAME, seconds_between(MAX(UTC_START_TIME), CURRENT_UTCTIMESTAMP) age FROM SYS.M_BACKUP_CATALOG WHERE STATE_NAME = 'successful' GROUP BY ENTRY_TYPE_NAME",
+		orderedMetricLabels: []string{"type"},
+		orderedStats: []queryStat{
+			{
+				key: "age",
+				addMetricFunction: func(mb *metadata.MetricsBuilder, now pcommon.Timestamp, val string,
+					row map[string]string) error {
+					return mb.RecordSaphanaBackupAgeDataPoint(now, val, row["type"])
+				},
+			},
+		},
+		Enabled: func(c *Config) bool {
+			return c.MetricsBuilderConfig.Metrics.SaphanaBackupAge.Enabled
+		},
+	},
+	{
+		query:               "SELECT ENTRY_TYPE_NAME, STATE_NAME, COUNT(*) backups FROM SYS.M_BACKUP_CATALOG WHERE UTC_START_TIME > ADD_DAYS(CURRENT_UTCTIMESTAMP, -1) GROUP BY ENTRY_TYPE_NAME, STATE_NAME",
+		orderedMetricLabels: []string{"type", "state"},
+		orderedStats: []queryStat{
+			{
+				key: "backups",
+				addMetricFunction: func(mb *metadata.MetricsBuilder, now pcommon.Timestamp, val string,
+					row map[string]string) error {
+					return mb.RecordSaphanaBackupCountDataPoint(now, val, row["type"], row["state"])
+				},
+			},
+		},
+		Enabled: func(c *Config) bool {
+			return c.MetricsBuilderConfig.Metrics.SaphanaBackupCount.Enabled
+		},
+	},
 	{
 		query:                 "SELECT HOST, SYSTEM_ID, DATABASE_NAME, seconds_between(START_TIME, CURRENT_TIMESTAMP) age FROM SYS.M_DATABASE",
 		orderedResourceLabels: []string{"host"},
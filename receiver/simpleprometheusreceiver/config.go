@@ -4,10 +4,12 @@
 package simpleprometheusreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/simpleprometheusreceiver"
 
 import (
+	"errors"
 	"net/url"
 	"time"
 
 	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/config/configopaque"
 )
 
 // Config defines configuration for simple prometheus receiver.
@@ -25,6 +27,62 @@ type Config struct {
 	Labels map[string]string `mapstructure:"labels,omitempty"`
 	// Whether or not to use pod service account to authenticate.
 	UseServiceAccount bool `mapstructure:"use_service_account"`
+	// BasicAuth the credentials used to authenticate with basic authentication.
+	BasicAuth *BasicAuth `mapstructure:"basic_auth,omitempty"`
+	// BearerToken the token used to authenticate with bearer authentication.
+	BearerToken configopaque.String `mapstructure:"bearer_token,omitempty"`
+	// BearerTokenFile the path to a file containing the token used to authenticate
+	// with bearer authentication. The file is read on every scrape.
+	BearerTokenFile string `mapstructure:"bearer_token_file,omitempty"`
+}
+
+// BasicAuth holds the credentials used to authenticate with basic authentication.
+type BasicAuth struct {
+	Username string              `mapstructure:"username"`
+	Password configopaque.String `mapstructure:"password"`
+	// PasswordFile the path to a file containing the password. The file is read on every scrape.
+	PasswordFile string `mapstructure:"password_file"`
+}
+
+var (
+	errAuthNotSupported     = errors.New("the `auth` setting is not supported as the scrape requests are not sent by the collector's HTTP client, use `basic_auth`, `bearer_token` or `bearer_token_file` instead")
+	errMultipleAuthMethods  = errors.New("only one of `use_service_account`, `basic_auth`, `bearer_token` and `bearer_token_file` can be set")
+	errMultiplePasswords    = errors.New("only one of `basic_auth.password` and `basic_auth.password_file` can be set")
+	errMissingBasicAuthUser = errors.New("`basic_auth.username` must be set")
+)
+
+// Validate checks that at most one authentication method is configured.
+func (cfg *Config) Validate() error {
+	if cfg.Auth != nil {
+		return errAuthNotSupported
+	}
+
+	methods := 0
+	if cfg.UseServiceAccount {
+		methods++
+	}
+	if cfg.BasicAuth != nil {
+		methods++
+	}
+	if cfg.BearerToken != "" {
+		methods++
+	}
+	if cfg.BearerTokenFile != "" {
+		methods++
+	}
+	if methods > 1 {
+		return errMultipleAuthMethods
+	}
+
+	if cfg.BasicAuth != nil {
+		if cfg.BasicAuth.Username == "" {
+			return errMissingBasicAuthUser
+		}
+		if cfg.BasicAuth.Password != "" && cfg.BasicAuth.PasswordFile != "" {
+			return errMultiplePasswords
+		}
+	}
+	return nil
 }
 
 // TODO: Move to a common package for use by other receivers and also pull
@@ -12,6 +12,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configauth"
 	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/config/configtls"
 	"go.opentelemetry.io/collector/confmap/confmaptest"
@@ -66,6 +67,42 @@ func TestLoadConfig(t *testing.T) {
 				MetricsPath:        "/metrics",
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "basic_auth"),
+			expected: &Config{
+				ClientConfig: confighttp.ClientConfig{
+					Endpoint: "localhost:1234",
+					TLSSetting: configtls.ClientConfig{
+						Config: configtls.Config{
+							CAFile:   "ca",
+							CertFile: "cert",
+							KeyFile:  "key",
+						},
+						ServerName: "prometheus.example.com",
+					},
+				},
+				CollectionInterval: 10 * time.Second,
+				MetricsPath:        "/metrics",
+				BasicAuth: &BasicAuth{
+					Username:     "user",
+					PasswordFile: "/var/run/secrets/password",
+				},
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "bearer_token"),
+			expected: &Config{
+				ClientConfig: confighttp.ClientConfig{
+					Endpoint: "localhost:1234",
+					TLSSetting: configtls.ClientConfig{
+						Insecure: true,
+					},
+				},
+				CollectionInterval: 10 * time.Second,
+				MetricsPath:        "/metrics",
+				BearerToken:        "token",
+			},
+		},
 		{
 			id: component.NewIDWithName(metadata.Type, "partial_tls_settings"),
 			expected: &Config{
@@ -92,3 +129,59 @@ func TestLoadConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		cfg         *Config
+		expectedErr error
+	}{
+		{
+			name: "configauth authenticator",
+			cfg: &Config{
+				ClientConfig: confighttp.ClientConfig{
+					Auth: &configauth.Authentication{AuthenticatorID: component.MustNewID("basicauth")},
+				},
+			},
+			expectedErr: errAuthNotSupported,
+		},
+		{
+			name: "multiple authentication methods",
+			cfg: &Config{
+				UseServiceAccount: true,
+				BearerTokenFile:   "/var/run/secrets/token",
+			},
+			expectedErr: errMultipleAuthMethods,
+		},
+		{
+			name: "basic auth without username",
+			cfg: &Config{
+				BasicAuth: &BasicAuth{Password: "password"},
+			},
+			expectedErr: errMissingBasicAuthUser,
+		},
+		{
+			name: "basic auth with password and password file",
+			cfg: &Config{
+				BasicAuth: &BasicAuth{Username: "user", Password: "password", PasswordFile: "/var/run/secrets/password"},
+			},
+			expectedErr: errMultiplePasswords,
+		},
+		{
+			name: "valid basic auth",
+			cfg: &Config{
+				BasicAuth: &BasicAuth{Username: "user", Password: "password"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.Validate()
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -97,11 +97,23 @@ func getPrometheusConfig(cfg *Config) (*prometheusreceiver.Config, error) {
 			CAFile:             cfg.TLSSetting.CAFile,
 			CertFile:           cfg.TLSSetting.CertFile,
 			KeyFile:            cfg.TLSSetting.KeyFile,
+			ServerName:         cfg.TLSSetting.ServerName,
 			InsecureSkipVerify: cfg.TLSSetting.InsecureSkipVerify,
 		}
 	}
 
+	if cfg.BearerToken != "" {
+		bearerToken = string(cfg.BearerToken)
+	}
 	httpConfig.BearerToken = configutil.Secret(bearerToken)
+	httpConfig.BearerTokenFile = cfg.BearerTokenFile
+	if cfg.BasicAuth != nil {
+		httpConfig.BasicAuth = &configutil.BasicAuth{
+			Username:     cfg.BasicAuth.Username,
+			Password:     configutil.Secret(cfg.BasicAuth.Password),
+			PasswordFile: cfg.BasicAuth.PasswordFile,
+		}
+	}
 
 	labels := make(model.LabelSet, len(cfg.Labels)+1)
 	for k, v := range cfg.Labels {
@@ -111,6 +111,94 @@ func TestGetPrometheusConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Test with basic auth",
+			config: &Config{
+				ClientConfig: confighttp.ClientConfig{
+					Endpoint: "localhost:1234",
+					TLSSetting: configtls.ClientConfig{
+						Insecure: true,
+					},
+				},
+				CollectionInterval: 10 * time.Second,
+				MetricsPath:        "/metrics",
+				BasicAuth: &BasicAuth{
+					Username: "user",
+					Password: "password",
+				},
+			},
+			want: &prometheusreceiver.Config{
+				PrometheusConfig: &prometheusreceiver.PromConfig{
+					GlobalConfig: config.DefaultGlobalConfig,
+					ScrapeConfigs: []*config.ScrapeConfig{
+						{
+							ScrapeInterval:  model.Duration(10 * time.Second),
+							ScrapeTimeout:   model.Duration(10 * time.Second),
+							JobName:         "prometheus_simple/localhost:1234",
+							HonorTimestamps: true,
+							Scheme:          "http",
+							MetricsPath:     "/metrics",
+							ServiceDiscoveryConfigs: discovery.Configs{
+								&discovery.StaticConfig{
+									{
+										Targets: []model.LabelSet{
+											{model.AddressLabel: model.LabelValue("localhost:1234")},
+										},
+									},
+								},
+							},
+							HTTPClientConfig: configutil.HTTPClientConfig{
+								BasicAuth: &configutil.BasicAuth{
+									Username: "user",
+									Password: "password",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Test with bearer token file",
+			config: &Config{
+				ClientConfig: confighttp.ClientConfig{
+					Endpoint: "localhost:1234",
+					TLSSetting: configtls.ClientConfig{
+						Insecure: true,
+					},
+				},
+				CollectionInterval: 10 * time.Second,
+				MetricsPath:        "/metrics",
+				BearerTokenFile:    "/var/run/secrets/token",
+			},
+			want: &prometheusreceiver.Config{
+				PrometheusConfig: &prometheusreceiver.PromConfig{
+					GlobalConfig: config.DefaultGlobalConfig,
+					ScrapeConfigs: []*config.ScrapeConfig{
+						{
+							ScrapeInterval:  model.Duration(10 * time.Second),
+							ScrapeTimeout:   model.Duration(10 * time.Second),
+							JobName:         "prometheus_simple/localhost:1234",
+							HonorTimestamps: true,
+							Scheme:          "http",
+							MetricsPath:     "/metrics",
+							ServiceDiscoveryConfigs: discovery.Configs{
+								&discovery.StaticConfig{
+									{
+										Targets: []model.LabelSet{
+											{model.AddressLabel: model.LabelValue("localhost:1234")},
+										},
+									},
+								},
+							},
+							HTTPClientConfig: configutil.HTTPClientConfig{
+								BearerTokenFile: "/var/run/secrets/token",
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "Test with TLS",
 			config: &Config{
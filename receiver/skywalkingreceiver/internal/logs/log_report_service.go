@@ -0,0 +1,147 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package logs // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/skywalkingreceiver/internal/logs"
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/receiver/receiverhelper"
+	"google.golang.org/protobuf/encoding/protojson"
+	common "skywalking.apache.org/repo/goapi/collect/common/v3"
+	logging "skywalking.apache.org/repo/goapi/collect/logging/v3"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/skywalking"
+)
+
+const (
+	collectorHTTPTransport = "http"
+	grpcTransport          = "grpc"
+	failing                = "failing"
+)
+
+var unmarshalOptions = protojson.UnmarshalOptions{DiscardUnknown: true}
+
+type Receiver struct {
+	nextConsumer consumer.Logs
+	grpcObsrecv  *receiverhelper.ObsReport
+	httpObsrecv  *receiverhelper.ObsReport
+	logging.UnimplementedLogReportServiceServer
+}
+
+// NewReceiver creates a new Receiver reference.
+func NewReceiver(nextConsumer consumer.Logs, set receiver.CreateSettings) (*Receiver, error) {
+	grpcObsrecv, err := receiverhelper.NewObsReport(receiverhelper.ObsReportSettings{
+		ReceiverID:             set.ID,
+		Transport:              grpcTransport,
+		ReceiverCreateSettings: set,
+	})
+	if err != nil {
+		return nil, err
+	}
+	httpObsrecv, err := receiverhelper.NewObsReport(receiverhelper.ObsReportSettings{
+		ReceiverID:             set.ID,
+		Transport:              collectorHTTPTransport,
+		ReceiverCreateSettings: set,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &Receiver{
+		nextConsumer: nextConsumer,
+		grpcObsrecv:  grpcObsrecv,
+		httpObsrecv:  httpObsrecv,
+	}, nil
+}
+
+// Collect implements the service Collect logs func.
+func (r *Receiver) Collect(stream logging.LogReportService_CollectServer) error {
+	// the agents only set the service of the first log of the stream
+	var service string
+	for {
+		logData, err := stream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return stream.SendAndClose(&common.Commands{})
+			}
+			return err
+		}
+
+		if logData.GetService() == "" {
+			logData.Service = service
+		}
+		service = logData.GetService()
+		if err = consumeLogs(stream.Context(), r.grpcObsrecv, logData, r.nextConsumer); err != nil {
+			return err
+		}
+	}
+}
+
+func consumeLogs(ctx context.Context, obsrecv *receiverhelper.ObsReport, logData *logging.LogData, nextConsumer consumer.Logs) error {
+	if logData == nil {
+		return nil
+	}
+	ctx = obsrecv.StartLogsOp(ctx)
+	err := nextConsumer.ConsumeLogs(ctx, skywalking.ProtoToLogs(logData))
+	obsrecv.EndLogsOp(ctx, "skywalking", 1, err)
+	return err
+}
+
+// HTTPHandler receives the json arrays of logs posted to /v3/logs.
+func (r *Receiver) HTTPHandler(rsp http.ResponseWriter, req *http.Request) {
+	rsp.Header().Set("Content-Type", "application/json")
+	b, err := io.ReadAll(req.Body)
+	if err != nil {
+		response := &Response{Status: failing, Msg: err.Error()}
+		ResponseWithJSON(rsp, response, http.StatusBadRequest)
+		return
+	}
+	data, err := unmarshalLogs(b)
+	if err != nil {
+		response := &Response{Status: failing, Msg: err.Error()}
+		ResponseWithJSON(rsp, response, http.StatusBadRequest)
+		return
+	}
+
+	for _, logData := range data {
+		if err = consumeLogs(req.Context(), r.httpObsrecv, logData, r.nextConsumer); err != nil {
+			response := &Response{Status: failing, Msg: err.Error()}
+			ResponseWithJSON(rsp, response, http.StatusInternalServerError)
+			return
+		}
+	}
+}
+
+// unmarshalLogs decodes the json array of logs, the body of the logs is a
+// oneof which only the protobuf json mapping decodes.
+func unmarshalLogs(b []byte) ([]*logging.LogData, error) {
+	var messages []json.RawMessage
+	if err := json.Unmarshal(b, &messages); err != nil {
+		return nil, err
+	}
+	data := make([]*logging.LogData, 0, len(messages))
+	for _, message := range messages {
+		logData := &logging.LogData{}
+		if err := unmarshalOptions.Unmarshal(message, logData); err != nil {
+			return nil, err
+		}
+		data = append(data, logData)
+	}
+	return data, nil
+}
+
+type Response struct {
+	Status string `json:"status"`
+	Msg    string `json:"msg"`
+}
+
+func ResponseWithJSON(rsp http.ResponseWriter, response *Response, code int) {
+	rsp.WriteHeader(code)
+	_ = json.NewEncoder(rsp).Encode(response)
+}
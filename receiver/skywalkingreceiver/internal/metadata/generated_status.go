@@ -11,6 +11,7 @@ var (
 )
 
 const (
+	LogsStability    = component.StabilityLevelDevelopment
 	MetricsStability = component.StabilityLevelDevelopment
 	TracesStability  = component.StabilityLevelBeta
 )
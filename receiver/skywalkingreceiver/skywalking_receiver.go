@@ -23,8 +23,10 @@ import (
 	event "skywalking.apache.org/repo/goapi/collect/event/v3"
 	v3 "skywalking.apache.org/repo/goapi/collect/language/agent/v3"
 	profile "skywalking.apache.org/repo/goapi/collect/language/profile/v3"
+	logging "skywalking.apache.org/repo/goapi/collect/logging/v3"
 	management "skywalking.apache.org/repo/goapi/collect/management/v3"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/skywalkingreceiver/internal/logs"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/skywalkingreceiver/internal/metrics"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/skywalkingreceiver/internal/trace"
 )
@@ -54,6 +56,8 @@ type swReceiver struct {
 
 	metricsReceiver *metrics.Receiver
 
+	logsReceiver *logs.Receiver
+
 	dummyReportService *dummyReportService
 }
 
@@ -78,7 +82,7 @@ func (sr *swReceiver) registerTraceConsumer(tc consumer.Traces) error {
 	return nil
 }
 
-// registerTraceConsumer register a TracesReceiver that receives trace
+// registerMetricsConsumer register a MetricsReceiver that receives metrics
 func (sr *swReceiver) registerMetricsConsumer(mc consumer.Metrics) error {
 	var err error
 	sr.metricsReceiver, err = metrics.NewReceiver(mc, sr.settings)
@@ -88,6 +92,16 @@ func (sr *swReceiver) registerMetricsConsumer(mc consumer.Metrics) error {
 	return nil
 }
 
+// registerLogsConsumer register a LogsReceiver that receives logs
+func (sr *swReceiver) registerLogsConsumer(lc consumer.Logs) error {
+	var err error
+	sr.logsReceiver, err = logs.NewReceiver(lc, sr.settings)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
 func (sr *swReceiver) collectorGRPCAddr() string {
 	var port int
 	if sr.config != nil {
@@ -139,7 +153,12 @@ func (sr *swReceiver) startCollector(host component.Host) error {
 		}
 
 		nr := mux.NewRouter()
-		nr.HandleFunc("/v3/segments", sr.traceReceiver.HTTPHandler).Methods(http.MethodPost)
+		if sr.traceReceiver != nil {
+			nr.HandleFunc("/v3/segments", sr.traceReceiver.HTTPHandler).Methods(http.MethodPost)
+		}
+		if sr.logsReceiver != nil {
+			nr.HandleFunc("/v3/logs", sr.logsReceiver.HTTPHandler).Methods(http.MethodPost)
+		}
 		sr.collectorServer, cerr = sr.config.CollectorHTTPSettings.ToServer(ctx, host, sr.settings.TelemetrySettings, nr)
 		if cerr != nil {
 			return cerr
@@ -171,6 +190,9 @@ func (sr *swReceiver) startCollector(host component.Host) error {
 		if sr.metricsReceiver != nil {
 			v3.RegisterJVMMetricReportServiceServer(sr.grpc, sr.metricsReceiver)
 		}
+		if sr.logsReceiver != nil {
+			logging.RegisterLogReportServiceServer(sr.grpc, sr.logsReceiver)
+		}
 		sr.dummyReportService = &dummyReportService{}
 		management.RegisterManagementServiceServer(sr.grpc, sr.dummyReportService)
 		cds.RegisterConfigurationDiscoveryServiceServer(sr.grpc, sr.dummyReportService)
@@ -23,6 +23,7 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 	common "skywalking.apache.org/repo/goapi/collect/common/v3"
 	agent "skywalking.apache.org/repo/goapi/collect/language/agent/v3"
+	logging "skywalking.apache.org/repo/goapi/collect/logging/v3"
 )
 
 var (
@@ -154,6 +155,100 @@ func TestHttpReception(t *testing.T) {
 
 }
 
+var logsJSON = []byte(`
+	[{
+	"timestamp": 1588664577013,
+	"service": "User_Service_Name",
+	"serviceInstance": "User_Service_Instance_Name",
+	"body": {
+		"text": {
+			"text": "order created"
+		}
+	},
+	"tags": {
+		"data": [{
+			"key": "level",
+			"value": "INFO"
+		}]
+	}
+}]`)
+
+func TestGRPCLogsReception(t *testing.T) {
+	config := &configuration{
+		CollectorGRPCPort: 11800,
+	}
+
+	sink := new(consumertest.LogsSink)
+
+	set := receivertest.NewNopCreateSettings()
+	set.ID = skywalkingReceiver
+	mockSwReceiver := newSkywalkingReceiver(config, set)
+	require.NoError(t, mockSwReceiver.registerLogsConsumer(sink))
+	require.NoError(t, mockSwReceiver.Start(context.Background(), componenttest.NewNopHost()))
+
+	t.Cleanup(func() { require.NoError(t, mockSwReceiver.Shutdown(context.Background())) })
+	conn, err := grpc.Dial(fmt.Sprintf("0.0.0.0:%d", config.CollectorGRPCPort), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+
+	// skywalking agent client send logs to otel/skywalkingreceiver
+	stream, err := logging.NewLogReportServiceClient(conn).Collect(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, stream.Send(&logging.LogData{
+		Service: "demo-logReportService",
+		Body:    &logging.LogDataBody{Content: &logging.LogDataBody_Text{Text: &logging.TextLog{Text: "first"}}},
+	}))
+	require.NoError(t, stream.Send(&logging.LogData{
+		Body: &logging.LogDataBody{Content: &logging.LogDataBody_Text{Text: &logging.TextLog{Text: "second"}}},
+	}))
+	commands, err := stream.CloseAndRecv()
+	require.NoError(t, err)
+	assert.NotNil(t, commands)
+
+	require.Equal(t, 2, sink.LogRecordCount())
+	for i, body := range []string{"first", "second"} {
+		rl := sink.AllLogs()[i].ResourceLogs().At(0)
+		// the service is only set on the first log of the stream
+		service, _ := rl.Resource().Attributes().Get("service.name")
+		assert.Equal(t, "demo-logReportService", service.Str())
+		assert.Equal(t, body, rl.ScopeLogs().At(0).LogRecords().At(0).Body().Str())
+	}
+}
+
+func TestHTTPLogsReception(t *testing.T) {
+	config := &configuration{
+		CollectorHTTPPort: 12800,
+		CollectorHTTPSettings: confighttp.ServerConfig{
+			Endpoint: fmt.Sprintf(":%d", 12800),
+		},
+	}
+
+	sink := new(consumertest.LogsSink)
+
+	set := receivertest.NewNopCreateSettings()
+	set.ID = skywalkingReceiver
+	mockSwReceiver := newSkywalkingReceiver(config, set)
+	require.NoError(t, mockSwReceiver.registerLogsConsumer(sink))
+	require.NoError(t, mockSwReceiver.Start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() { require.NoError(t, mockSwReceiver.Shutdown(context.Background())) })
+
+	response, err := http.Post("http://127.0.0.1:12800/v3/logs", "application/json", bytes.NewBuffer(logsJSON))
+	require.NoError(t, err)
+	require.NoError(t, response.Body.Close())
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+
+	require.Equal(t, 1, sink.LogRecordCount())
+	lr := sink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	assert.Equal(t, "order created", lr.Body().Str())
+	assert.Equal(t, "INFO", lr.SeverityText())
+
+	response, err = http.Post("http://127.0.0.1:12800/v3/logs", "application/json", bytes.NewBufferString(`[{"body": 1}]`))
+	require.NoError(t, err)
+	require.NoError(t, response.Body.Close())
+	assert.Equal(t, http.StatusBadRequest, response.StatusCode)
+	assert.Equal(t, 1, sink.LogRecordCount())
+}
+
 func mockGrpcTraceSegment(sequence int) *agent.SegmentObject {
 	seq := strconv.Itoa(sequence)
 	return &agent.SegmentObject{
@@ -7,6 +7,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"time"
 
 	sf "github.com/snowflakedb/gosnowflake"
 	"go.opentelemetry.io/collector/component"
@@ -23,6 +24,9 @@ var (
 	sessionMetricsQuery          = "select USER_NAME, count(distinct(SESSION_ID)) from Sessions where created_on >= DATEADD(hour, -24, current_timestamp()) group by 1;"
 	snowpipeMetricsQuery         = "select pipe_name, sum(credits_used), sum(bytes_inserted), sum(files_inserted) from pipe_usage_history where start_time >= DATEADD(hour, -24, current_timestamp()) group by 1;"
 	storageMetricsQuery          = "select STORAGE_BYTES, STAGE_BYTES, FAILSAFE_BYTES from STORAGE_USAGE ORDER BY USAGE_DATE DESC LIMIT 1;"
+	queryAttributionMetricsQuery = "select WAREHOUSE_NAME, USER_NAME, QUERY_TAG, sum(CREDITS_ATTRIBUTED_COMPUTE) from QUERY_ATTRIBUTION_HISTORY where start_time >= DATEADD(hour, -24, current_timestamp()) group by 1, 2, 3;"
+	databaseStorageMetricsQuery  = "select DATABASE_NAME, AVERAGE_DATABASE_BYTES, AVERAGE_FAILSAFE_BYTES from DATABASE_STORAGE_USAGE_HISTORY where USAGE_DATE = (select max(USAGE_DATE) from DATABASE_STORAGE_USAGE_HISTORY) and DELETED is null;"
+	slowQueriesQuery             = "select QUERY_ID, QUERY_TEXT, DATABASE_NAME, SCHEMA_NAME, WAREHOUSE_NAME, WAREHOUSE_SIZE, USER_NAME, ROLE_NAME, QUERY_TAG, EXECUTION_STATUS, ERROR_MESSAGE, START_TIME, END_TIME, TOTAL_ELAPSED_TIME, BYTES_SCANNED, CREDITS_USED_CLOUD_SERVICES from QUERY_HISTORY where end_time > ? and total_elapsed_time >= ? order by end_time limit ?;"
 )
 
 // snowflake client is comprised of a sql.DB (the proper 'client' in question),
@@ -69,8 +73,8 @@ func newDefaultClient(settings component.TelemetrySettings, c Config) (*snowflak
 }
 
 // queries database and returns resulting rows
-func (c snowflakeClient) readDB(ctx context.Context, q string) (*sql.Rows, error) {
-	rows, err := c.client.QueryContext(ctx, q)
+func (c snowflakeClient) readDB(ctx context.Context, q string, args ...any) (*sql.Rows, error) {
+	rows, err := c.client.QueryContext(ctx, q, args...)
 	if err != nil {
 		c.logger.Error(fmt.Sprintf("Query failed with %v", err))
 		return nil, err
@@ -411,3 +415,93 @@ func (c snowflakeClient) FetchStorageMetrics(ctx context.Context) (*[]storageMet
 	}
 	return &res, nil
 }
+
+func (c snowflakeClient) FetchQueryAttributionMetrics(ctx context.Context) (*[]queryAttributionMetric, error) {
+	rows, err := c.readDB(ctx, queryAttributionMetricsQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	if rows == nil {
+		err = fmt.Errorf("no rows returned by query: %v", queryAttributionMetricsQuery)
+		return nil, err
+	}
+
+	var res []queryAttributionMetric
+
+	for rows.Next() {
+		var warehouseName, userName, queryTag sql.NullString
+		var creditsAttributed float64
+
+		err := rows.Scan(&warehouseName, &userName, &queryTag, &creditsAttributed)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, queryAttributionMetric{
+			warehouseName:     warehouseName,
+			userName:          userName,
+			queryTag:          queryTag,
+			creditsAttributed: creditsAttributed,
+		})
+	}
+	return &res, nil
+}
+
+func (c snowflakeClient) FetchDatabaseStorageMetrics(ctx context.Context) (*[]databaseStorageMetric, error) {
+	rows, err := c.readDB(ctx, databaseStorageMetricsQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	if rows == nil {
+		err = fmt.Errorf("no rows returned by query: %v", databaseStorageMetricsQuery)
+		return nil, err
+	}
+
+	var res []databaseStorageMetric
+
+	for rows.Next() {
+		var databaseName sql.NullString
+		var databaseBytes, failsafeBytes float64
+
+		err := rows.Scan(&databaseName, &databaseBytes, &failsafeBytes)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, databaseStorageMetric{
+			databaseName:  databaseName,
+			databaseBytes: int64(databaseBytes),
+			failsafeBytes: int64(failsafeBytes),
+		})
+	}
+	return &res, nil
+}
+
+// FetchSlowQueries returns the queries which ended after the given time and took longer than
+// the threshold, ordered by end time.
+func (c snowflakeClient) FetchSlowQueries(ctx context.Context, endedAfter time.Time, threshold time.Duration, limit int) (*[]slowQuery, error) {
+	rows, err := c.readDB(ctx, slowQueriesQuery, endedAfter, threshold.Milliseconds(), limit)
+	if err != nil {
+		return nil, err
+	}
+
+	if rows == nil {
+		err = fmt.Errorf("no rows returned by query: %v", slowQueriesQuery)
+		return nil, err
+	}
+
+	var res []slowQuery
+
+	for rows.Next() {
+		var q slowQuery
+		err := rows.Scan(&q.queryID, &q.queryText, &q.databaseName, &q.schemaName,
+			&q.warehouseName, &q.warehouseSize, &q.userName, &q.roleName, &q.queryTag,
+			&q.executionStatus, &q.errorMessage, &q.startTime, &q.endTime, &q.totalElapsedTime,
+			&q.bytesScanned, &q.creditsUsedCloudServices)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, q)
+	}
+	return &res, nil
+}
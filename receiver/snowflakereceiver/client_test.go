@@ -250,6 +250,29 @@ func TestMetricQueries(t *testing.T) {
 				failsafeBytes: 3,
 			},
 		},
+		{
+			desc:    "FetchQueryAttributionMetrics",
+			query:   queryAttributionMetricsQuery,
+			columns: []string{"wh_name", "username", "query_tag", "credits_attributed"},
+			params:  []driver.Value{"n", "t", "etl", 1.0},
+			expect: queryAttributionMetric{
+				warehouseName:     sql.NullString{String: "n", Valid: true},
+				userName:          sql.NullString{String: "t", Valid: true},
+				queryTag:          sql.NullString{String: "etl", Valid: true},
+				creditsAttributed: 1.0,
+			},
+		},
+		{
+			desc:    "FetchDatabaseStorageMetrics",
+			query:   databaseStorageMetricsQuery,
+			columns: []string{"db_name", "database_bytes", "failsafe_bytes"},
+			params:  []driver.Value{"db", 1.0, 2.0},
+			expect: databaseStorageMetric{
+				databaseName:  sql.NullString{String: "db", Valid: true},
+				databaseBytes: 1,
+				failsafeBytes: 2,
+			},
+		},
 	}
 
 	for i := range tests {
@@ -5,6 +5,7 @@ package snowflakereceiver // import "github.com/open-telemetry/opentelemetry-col
 
 import (
 	"errors"
+	"time"
 
 	"go.opentelemetry.io/collector/config/configopaque"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
@@ -18,6 +19,8 @@ var (
 	errMissingPassword  = errors.New("You must provide a password for the snowflake username")
 	errMissingAccount   = errors.New("You must provide a valid account name")
 	errMissingWarehouse = errors.New("You must provide a valid warehouse name")
+	errInvalidThreshold = errors.New("The slow queries threshold must be positive")
+	errInvalidLimit     = errors.New("The slow queries limit must be positive")
 )
 
 type Config struct {
@@ -30,6 +33,15 @@ type Config struct {
 	Warehouse                      string              `mapstructure:"warehouse"`
 	Database                       string              `mapstructure:"database"`
 	Role                           string              `mapstructure:"role"`
+	SlowQueries                    SlowQueriesConfig   `mapstructure:"slow_queries"`
+}
+
+// SlowQueriesConfig configures the events of the logs receiver, emitted for the queries of the
+// query history which took longer than the threshold.
+type SlowQueriesConfig struct {
+	Threshold time.Duration `mapstructure:"threshold"`
+	// Limit is the maximum number of events emitted per collection interval.
+	Limit int `mapstructure:"limit"`
 }
 
 func (cfg *Config) Validate() error {
@@ -50,5 +62,13 @@ func (cfg *Config) Validate() error {
 		errs = multierr.Append(errs, errMissingWarehouse)
 	}
 
+	if cfg.SlowQueries.Threshold <= 0 {
+		errs = multierr.Append(errs, errInvalidThreshold)
+	}
+
+	if cfg.SlowQueries.Limit <= 0 {
+		errs = multierr.Append(errs, errInvalidLimit)
+	}
+
 	return errs
 }
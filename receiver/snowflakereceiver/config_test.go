@@ -77,6 +77,18 @@ func TestValidateConfig(t *testing.T) {
 				ControllerConfig: scraperhelper.NewDefaultControllerConfig(),
 			},
 		},
+		{
+			desc:   "Invalid slow queries threshold all else present",
+			expect: errInvalidThreshold,
+			conf: Config{
+				Username:         "username",
+				Password:         "password",
+				Account:          "account",
+				Warehouse:        "warehouse",
+				ControllerConfig: scraperhelper.NewDefaultControllerConfig(),
+				SlowQueries:      SlowQueriesConfig{Threshold: -time.Second, Limit: 100},
+			},
+		},
 		{
 			desc:   "Missing multiple check multierror",
 			expect: multierror,
@@ -130,6 +142,10 @@ func TestLoadConfig(t *testing.T) {
 		Database:             "SNOWFLAKE",
 		Schema:               "ACCOUNT_USAGE",
 		MetricsBuilderConfig: testMetrics,
+		SlowQueries: SlowQueriesConfig{
+			Threshold: 5 * time.Minute,
+			Limit:     100,
+		},
 	}
 
 	factory := NewFactory()
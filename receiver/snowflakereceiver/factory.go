@@ -20,6 +20,9 @@ const (
 	defaultRole     = "ACCOUNTADMIN"
 	defaultDB       = "SNOWFLAKE"
 	defaultSchema   = "ACCOUNT_USAGE"
+
+	defaultSlowQueriesThreshold = time.Minute
+	defaultSlowQueriesLimit     = 100
 )
 
 func createDefaultConfig() component.Config {
@@ -32,6 +35,10 @@ func createDefaultConfig() component.Config {
 		Schema:               defaultSchema,
 		Database:             defaultDB,
 		Role:                 defaultRole,
+		SlowQueries: SlowQueriesConfig{
+			Threshold: defaultSlowQueriesThreshold,
+			Limit:     defaultSlowQueriesLimit,
+		},
 	}
 }
 
@@ -40,6 +47,7 @@ func NewFactory() receiver.Factory {
 		metadata.Type,
 		createDefaultConfig,
 		receiver.WithMetrics(createMetricsReceiver, metadata.MetricsStability),
+		receiver.WithLogs(createLogsReceiver, metadata.LogsStability),
 	)
 }
 
@@ -63,3 +71,12 @@ func createMetricsReceiver(_ context.Context,
 		scraperhelper.AddScraper(scraper),
 	)
 }
+
+func createLogsReceiver(_ context.Context,
+	params receiver.CreateSettings,
+	baseCfg component.Config,
+	consumer consumer.Logs,
+) (receiver.Logs, error) {
+	cfg := baseCfg.(*Config)
+	return newSnowflakeLogsReceiver(params, cfg, consumer), nil
+}
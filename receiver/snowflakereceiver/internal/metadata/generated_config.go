@@ -29,6 +29,7 @@ func (ms *MetricConfig) Unmarshal(parser *confmap.Conf) error {
 // MetricsConfig provides config for snowflake metrics.
 type MetricsConfig struct {
 	SnowflakeBillingCloudServiceTotal              MetricConfig `mapstructure:"snowflake.billing.cloud_service.total"`
+	SnowflakeBillingQueryAttributionTotal          MetricConfig `mapstructure:"snowflake.billing.query_attribution.total"`
 	SnowflakeBillingTotalCreditTotal               MetricConfig `mapstructure:"snowflake.billing.total_credit.total"`
 	SnowflakeBillingVirtualWarehouseTotal          MetricConfig `mapstructure:"snowflake.billing.virtual_warehouse.total"`
 	SnowflakeBillingWarehouseCloudServiceTotal     MetricConfig `mapstructure:"snowflake.billing.warehouse.cloud_service.total"`
@@ -59,6 +60,8 @@ type MetricsConfig struct {
 	SnowflakeRowsUnloadedAvg                       MetricConfig `mapstructure:"snowflake.rows_unloaded.avg"`
 	SnowflakeRowsUpdatedAvg                        MetricConfig `mapstructure:"snowflake.rows_updated.avg"`
 	SnowflakeSessionIDCount                        MetricConfig `mapstructure:"snowflake.session_id.count"`
+	SnowflakeStorageDatabaseBytesTotal             MetricConfig `mapstructure:"snowflake.storage.database_bytes.total"`
+	SnowflakeStorageDatabaseFailsafeBytesTotal     MetricConfig `mapstructure:"snowflake.storage.database_failsafe_bytes.total"`
 	SnowflakeStorageFailsafeBytesTotal             MetricConfig `mapstructure:"snowflake.storage.failsafe_bytes.total"`
 	SnowflakeStorageStageBytesTotal                MetricConfig `mapstructure:"snowflake.storage.stage_bytes.total"`
 	SnowflakeStorageStorageBytesTotal              MetricConfig `mapstructure:"snowflake.storage.storage_bytes.total"`
@@ -70,6 +73,9 @@ func DefaultMetricsConfig() MetricsConfig {
 		SnowflakeBillingCloudServiceTotal: MetricConfig{
 			Enabled: false,
 		},
+		SnowflakeBillingQueryAttributionTotal: MetricConfig{
+			Enabled: false,
+		},
 		SnowflakeBillingTotalCreditTotal: MetricConfig{
 			Enabled: false,
 		},
@@ -160,6 +166,12 @@ func DefaultMetricsConfig() MetricsConfig {
 		SnowflakeSessionIDCount: MetricConfig{
 			Enabled: false,
 		},
+		SnowflakeStorageDatabaseBytesTotal: MetricConfig{
+			Enabled: false,
+		},
+		SnowflakeStorageDatabaseFailsafeBytesTotal: MetricConfig{
+			Enabled: false,
+		},
 		SnowflakeStorageFailsafeBytesTotal: MetricConfig{
 			Enabled: false,
 		},
@@ -27,6 +27,7 @@ func TestMetricsBuilderConfig(t *testing.T) {
 			want: MetricsBuilderConfig{
 				Metrics: MetricsConfig{
 					SnowflakeBillingCloudServiceTotal:              MetricConfig{Enabled: true},
+					SnowflakeBillingQueryAttributionTotal:          MetricConfig{Enabled: true},
 					SnowflakeBillingTotalCreditTotal:               MetricConfig{Enabled: true},
 					SnowflakeBillingVirtualWarehouseTotal:          MetricConfig{Enabled: true},
 					SnowflakeBillingWarehouseCloudServiceTotal:     MetricConfig{Enabled: true},
@@ -57,6 +58,8 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					SnowflakeRowsUnloadedAvg:                       MetricConfig{Enabled: true},
 					SnowflakeRowsUpdatedAvg:                        MetricConfig{Enabled: true},
 					SnowflakeSessionIDCount:                        MetricConfig{Enabled: true},
+					SnowflakeStorageDatabaseBytesTotal:             MetricConfig{Enabled: true},
+					SnowflakeStorageDatabaseFailsafeBytesTotal:     MetricConfig{Enabled: true},
 					SnowflakeStorageFailsafeBytesTotal:             MetricConfig{Enabled: true},
 					SnowflakeStorageStageBytesTotal:                MetricConfig{Enabled: true},
 					SnowflakeStorageStorageBytesTotal:              MetricConfig{Enabled: true},
@@ -72,6 +75,7 @@ func TestMetricsBuilderConfig(t *testing.T) {
 			want: MetricsBuilderConfig{
 				Metrics: MetricsConfig{
 					SnowflakeBillingCloudServiceTotal:              MetricConfig{Enabled: false},
+					SnowflakeBillingQueryAttributionTotal:          MetricConfig{Enabled: false},
 					SnowflakeBillingTotalCreditTotal:               MetricConfig{Enabled: false},
 					SnowflakeBillingVirtualWarehouseTotal:          MetricConfig{Enabled: false},
 					SnowflakeBillingWarehouseCloudServiceTotal:     MetricConfig{Enabled: false},
@@ -102,6 +106,8 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					SnowflakeRowsUnloadedAvg:                       MetricConfig{Enabled: false},
 					SnowflakeRowsUpdatedAvg:                        MetricConfig{Enabled: false},
 					SnowflakeSessionIDCount:                        MetricConfig{Enabled: false},
+					SnowflakeStorageDatabaseBytesTotal:             MetricConfig{Enabled: false},
+					SnowflakeStorageDatabaseFailsafeBytesTotal:     MetricConfig{Enabled: false},
 					SnowflakeStorageFailsafeBytesTotal:             MetricConfig{Enabled: false},
 					SnowflakeStorageStageBytesTotal:                MetricConfig{Enabled: false},
 					SnowflakeStorageStorageBytesTotal:              MetricConfig{Enabled: false},
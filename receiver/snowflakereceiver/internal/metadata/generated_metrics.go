@@ -63,6 +63,59 @@ func newMetricSnowflakeBillingCloudServiceTotal(cfg MetricConfig) metricSnowflak
 	return m
 }
 
+type metricSnowflakeBillingQueryAttributionTotal struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills snowflake.billing.query_attribution.total metric with initial data.
+func (m *metricSnowflakeBillingQueryAttributionTotal) init() {
+	m.data.SetName("snowflake.billing.query_attribution.total")
+	m.data.SetDescription("Compute credits attributed to the queries of a user over the last 24 hour window, per warehouse and query tag.")
+	m.data.SetUnit("{credits}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSnowflakeBillingQueryAttributionTotal) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, warehouseNameAttributeValue string, userNameAttributeValue string, queryTagAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("warehouse_name", warehouseNameAttributeValue)
+	dp.Attributes().PutStr("user_name", userNameAttributeValue)
+	dp.Attributes().PutStr("query_tag", queryTagAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSnowflakeBillingQueryAttributionTotal) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSnowflakeBillingQueryAttributionTotal) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSnowflakeBillingQueryAttributionTotal(cfg MetricConfig) metricSnowflakeBillingQueryAttributionTotal {
+	m := metricSnowflakeBillingQueryAttributionTotal{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricSnowflakeBillingTotalCreditTotal struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -1703,6 +1756,108 @@ func newMetricSnowflakeSessionIDCount(cfg MetricConfig) metricSnowflakeSessionID
 	return m
 }
 
+type metricSnowflakeStorageDatabaseBytesTotal struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills snowflake.storage.database_bytes.total metric with initial data.
+func (m *metricSnowflakeStorageDatabaseBytesTotal) init() {
+	m.data.SetName("snowflake.storage.database_bytes.total")
+	m.data.SetDescription("Number of bytes of table storage used by the database, including bytes for data currently in Time Travel.")
+	m.data.SetUnit("By")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSnowflakeStorageDatabaseBytesTotal) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, databaseNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("database_name", databaseNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSnowflakeStorageDatabaseBytesTotal) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSnowflakeStorageDatabaseBytesTotal) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSnowflakeStorageDatabaseBytesTotal(cfg MetricConfig) metricSnowflakeStorageDatabaseBytesTotal {
+	m := metricSnowflakeStorageDatabaseBytesTotal{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSnowflakeStorageDatabaseFailsafeBytesTotal struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills snowflake.storage.database_failsafe_bytes.total metric with initial data.
+func (m *metricSnowflakeStorageDatabaseFailsafeBytesTotal) init() {
+	m.data.SetName("snowflake.storage.database_failsafe_bytes.total")
+	m.data.SetDescription("Number of bytes of data of the database in Fail-safe.")
+	m.data.SetUnit("By")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSnowflakeStorageDatabaseFailsafeBytesTotal) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, databaseNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("database_name", databaseNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSnowflakeStorageDatabaseFailsafeBytesTotal) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSnowflakeStorageDatabaseFailsafeBytesTotal) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSnowflakeStorageDatabaseFailsafeBytesTotal(cfg MetricConfig) metricSnowflakeStorageDatabaseFailsafeBytesTotal {
+	m := metricSnowflakeStorageDatabaseFailsafeBytesTotal{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricSnowflakeStorageFailsafeBytesTotal struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -1918,6 +2073,7 @@ type MetricsBuilder struct {
 	resourceAttributeIncludeFilter                       map[string]filter.Filter
 	resourceAttributeExcludeFilter                       map[string]filter.Filter
 	metricSnowflakeBillingCloudServiceTotal              metricSnowflakeBillingCloudServiceTotal
+	metricSnowflakeBillingQueryAttributionTotal          metricSnowflakeBillingQueryAttributionTotal
 	metricSnowflakeBillingTotalCreditTotal               metricSnowflakeBillingTotalCreditTotal
 	metricSnowflakeBillingVirtualWarehouseTotal          metricSnowflakeBillingVirtualWarehouseTotal
 	metricSnowflakeBillingWarehouseCloudServiceTotal     metricSnowflakeBillingWarehouseCloudServiceTotal
@@ -1948,6 +2104,8 @@ type MetricsBuilder struct {
 	metricSnowflakeRowsUnloadedAvg                       metricSnowflakeRowsUnloadedAvg
 	metricSnowflakeRowsUpdatedAvg                        metricSnowflakeRowsUpdatedAvg
 	metricSnowflakeSessionIDCount                        metricSnowflakeSessionIDCount
+	metricSnowflakeStorageDatabaseBytesTotal             metricSnowflakeStorageDatabaseBytesTotal
+	metricSnowflakeStorageDatabaseFailsafeBytesTotal     metricSnowflakeStorageDatabaseFailsafeBytesTotal
 	metricSnowflakeStorageFailsafeBytesTotal             metricSnowflakeStorageFailsafeBytesTotal
 	metricSnowflakeStorageStageBytesTotal                metricSnowflakeStorageStageBytesTotal
 	metricSnowflakeStorageStorageBytesTotal              metricSnowflakeStorageStorageBytesTotal
@@ -1971,7 +2129,8 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.CreateSetting
 		metricsBuffer:                           pmetric.NewMetrics(),
 		buildInfo:                               settings.BuildInfo,
 		metricSnowflakeBillingCloudServiceTotal: newMetricSnowflakeBillingCloudServiceTotal(mbc.Metrics.SnowflakeBillingCloudServiceTotal),
-		metricSnowflakeBillingTotalCreditTotal:  newMetricSnowflakeBillingTotalCreditTotal(mbc.Metrics.SnowflakeBillingTotalCreditTotal),
+		metricSnowflakeBillingQueryAttributionTotal:          newMetricSnowflakeBillingQueryAttributionTotal(mbc.Metrics.SnowflakeBillingQueryAttributionTotal),
+		metricSnowflakeBillingTotalCreditTotal:               newMetricSnowflakeBillingTotalCreditTotal(mbc.Metrics.SnowflakeBillingTotalCreditTotal),
 		metricSnowflakeBillingVirtualWarehouseTotal:          newMetricSnowflakeBillingVirtualWarehouseTotal(mbc.Metrics.SnowflakeBillingVirtualWarehouseTotal),
 		metricSnowflakeBillingWarehouseCloudServiceTotal:     newMetricSnowflakeBillingWarehouseCloudServiceTotal(mbc.Metrics.SnowflakeBillingWarehouseCloudServiceTotal),
 		metricSnowflakeBillingWarehouseTotalCreditTotal:      newMetricSnowflakeBillingWarehouseTotalCreditTotal(mbc.Metrics.SnowflakeBillingWarehouseTotalCreditTotal),
@@ -2001,6 +2160,8 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.CreateSetting
 		metricSnowflakeRowsUnloadedAvg:                       newMetricSnowflakeRowsUnloadedAvg(mbc.Metrics.SnowflakeRowsUnloadedAvg),
 		metricSnowflakeRowsUpdatedAvg:                        newMetricSnowflakeRowsUpdatedAvg(mbc.Metrics.SnowflakeRowsUpdatedAvg),
 		metricSnowflakeSessionIDCount:                        newMetricSnowflakeSessionIDCount(mbc.Metrics.SnowflakeSessionIDCount),
+		metricSnowflakeStorageDatabaseBytesTotal:             newMetricSnowflakeStorageDatabaseBytesTotal(mbc.Metrics.SnowflakeStorageDatabaseBytesTotal),
+		metricSnowflakeStorageDatabaseFailsafeBytesTotal:     newMetricSnowflakeStorageDatabaseFailsafeBytesTotal(mbc.Metrics.SnowflakeStorageDatabaseFailsafeBytesTotal),
 		metricSnowflakeStorageFailsafeBytesTotal:             newMetricSnowflakeStorageFailsafeBytesTotal(mbc.Metrics.SnowflakeStorageFailsafeBytesTotal),
 		metricSnowflakeStorageStageBytesTotal:                newMetricSnowflakeStorageStageBytesTotal(mbc.Metrics.SnowflakeStorageStageBytesTotal),
 		metricSnowflakeStorageStorageBytesTotal:              newMetricSnowflakeStorageStorageBytesTotal(mbc.Metrics.SnowflakeStorageStorageBytesTotal),
@@ -2076,6 +2237,7 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	ils.Scope().SetVersion(mb.buildInfo.Version)
 	ils.Metrics().EnsureCapacity(mb.metricsCapacity)
 	mb.metricSnowflakeBillingCloudServiceTotal.emit(ils.Metrics())
+	mb.metricSnowflakeBillingQueryAttributionTotal.emit(ils.Metrics())
 	mb.metricSnowflakeBillingTotalCreditTotal.emit(ils.Metrics())
 	mb.metricSnowflakeBillingVirtualWarehouseTotal.emit(ils.Metrics())
 	mb.metricSnowflakeBillingWarehouseCloudServiceTotal.emit(ils.Metrics())
@@ -2106,6 +2268,8 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	mb.metricSnowflakeRowsUnloadedAvg.emit(ils.Metrics())
 	mb.metricSnowflakeRowsUpdatedAvg.emit(ils.Metrics())
 	mb.metricSnowflakeSessionIDCount.emit(ils.Metrics())
+	mb.metricSnowflakeStorageDatabaseBytesTotal.emit(ils.Metrics())
+	mb.metricSnowflakeStorageDatabaseFailsafeBytesTotal.emit(ils.Metrics())
 	mb.metricSnowflakeStorageFailsafeBytesTotal.emit(ils.Metrics())
 	mb.metricSnowflakeStorageStageBytesTotal.emit(ils.Metrics())
 	mb.metricSnowflakeStorageStorageBytesTotal.emit(ils.Metrics())
@@ -2146,6 +2310,11 @@ func (mb *MetricsBuilder) RecordSnowflakeBillingCloudServiceTotalDataPoint(ts pc
 	mb.metricSnowflakeBillingCloudServiceTotal.recordDataPoint(mb.startTime, ts, val, serviceTypeAttributeValue)
 }
 
+// RecordSnowflakeBillingQueryAttributionTotalDataPoint adds a data point to snowflake.billing.query_attribution.total metric.
+func (mb *MetricsBuilder) RecordSnowflakeBillingQueryAttributionTotalDataPoint(ts pcommon.Timestamp, val float64, warehouseNameAttributeValue string, userNameAttributeValue string, queryTagAttributeValue string) {
+	mb.metricSnowflakeBillingQueryAttributionTotal.recordDataPoint(mb.startTime, ts, val, warehouseNameAttributeValue, userNameAttributeValue, queryTagAttributeValue)
+}
+
 // RecordSnowflakeBillingTotalCreditTotalDataPoint adds a data point to snowflake.billing.total_credit.total metric.
 func (mb *MetricsBuilder) RecordSnowflakeBillingTotalCreditTotalDataPoint(ts pcommon.Timestamp, val float64, serviceTypeAttributeValue string) {
 	mb.metricSnowflakeBillingTotalCreditTotal.recordDataPoint(mb.startTime, ts, val, serviceTypeAttributeValue)
@@ -2296,6 +2465,16 @@ func (mb *MetricsBuilder) RecordSnowflakeSessionIDCountDataPoint(ts pcommon.Time
 	mb.metricSnowflakeSessionIDCount.recordDataPoint(mb.startTime, ts, val, userNameAttributeValue)
 }
 
+// RecordSnowflakeStorageDatabaseBytesTotalDataPoint adds a data point to snowflake.storage.database_bytes.total metric.
+func (mb *MetricsBuilder) RecordSnowflakeStorageDatabaseBytesTotalDataPoint(ts pcommon.Timestamp, val int64, databaseNameAttributeValue string) {
+	mb.metricSnowflakeStorageDatabaseBytesTotal.recordDataPoint(mb.startTime, ts, val, databaseNameAttributeValue)
+}
+
+// RecordSnowflakeStorageDatabaseFailsafeBytesTotalDataPoint adds a data point to snowflake.storage.database_failsafe_bytes.total metric.
+func (mb *MetricsBuilder) RecordSnowflakeStorageDatabaseFailsafeBytesTotalDataPoint(ts pcommon.Timestamp, val int64, databaseNameAttributeValue string) {
+	mb.metricSnowflakeStorageDatabaseFailsafeBytesTotal.recordDataPoint(mb.startTime, ts, val, databaseNameAttributeValue)
+}
+
 // RecordSnowflakeStorageFailsafeBytesTotalDataPoint adds a data point to snowflake.storage.failsafe_bytes.total metric.
 func (mb *MetricsBuilder) RecordSnowflakeStorageFailsafeBytesTotalDataPoint(ts pcommon.Timestamp, val int64) {
 	mb.metricSnowflakeStorageFailsafeBytesTotal.recordDataPoint(mb.startTime, ts, val)
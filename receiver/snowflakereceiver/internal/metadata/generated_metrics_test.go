@@ -71,6 +71,9 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordSnowflakeBillingCloudServiceTotalDataPoint(ts, 1, "service_type-val")
 
+			allMetricsCount++
+			mb.RecordSnowflakeBillingQueryAttributionTotalDataPoint(ts, 1, "warehouse_name-val", "user_name-val", "query_tag-val")
+
 			allMetricsCount++
 			mb.RecordSnowflakeBillingTotalCreditTotalDataPoint(ts, 1, "service_type-val")
 
@@ -174,6 +177,12 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordSnowflakeSessionIDCountDataPoint(ts, 1, "user_name-val")
 
+			allMetricsCount++
+			mb.RecordSnowflakeStorageDatabaseBytesTotalDataPoint(ts, 1, "database_name-val")
+
+			allMetricsCount++
+			mb.RecordSnowflakeStorageDatabaseFailsafeBytesTotalDataPoint(ts, 1, "database_name-val")
+
 			allMetricsCount++
 			mb.RecordSnowflakeStorageFailsafeBytesTotalDataPoint(ts, 1)
 
@@ -228,6 +237,27 @@ func TestMetricsBuilder(t *testing.T) {
 					attrVal, ok := dp.Attributes().Get("service_type")
 					assert.True(t, ok)
 					assert.EqualValues(t, "service_type-val", attrVal.Str())
+				case "snowflake.billing.query_attribution.total":
+					assert.False(t, validatedMetrics["snowflake.billing.query_attribution.total"], "Found a duplicate in the metrics slice: snowflake.billing.query_attribution.total")
+					validatedMetrics["snowflake.billing.query_attribution.total"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Compute credits attributed to the queries of a user over the last 24 hour window, per warehouse and query tag.", ms.At(i).Description())
+					assert.Equal(t, "{credits}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
+					attrVal, ok := dp.Attributes().Get("warehouse_name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "warehouse_name-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("user_name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "user_name-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("query_tag")
+					assert.True(t, ok)
+					assert.EqualValues(t, "query_tag-val", attrVal.Str())
 				case "snowflake.billing.total_credit.total":
 					assert.False(t, validatedMetrics["snowflake.billing.total_credit.total"], "Found a duplicate in the metrics slice: snowflake.billing.total_credit.total")
 					validatedMetrics["snowflake.billing.total_credit.total"] = true
@@ -1008,6 +1038,36 @@ func TestMetricsBuilder(t *testing.T) {
 					attrVal, ok := dp.Attributes().Get("user_name")
 					assert.True(t, ok)
 					assert.EqualValues(t, "user_name-val", attrVal.Str())
+				case "snowflake.storage.database_bytes.total":
+					assert.False(t, validatedMetrics["snowflake.storage.database_bytes.total"], "Found a duplicate in the metrics slice: snowflake.storage.database_bytes.total")
+					validatedMetrics["snowflake.storage.database_bytes.total"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Number of bytes of table storage used by the database, including bytes for data currently in Time Travel.", ms.At(i).Description())
+					assert.Equal(t, "By", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("database_name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "database_name-val", attrVal.Str())
+				case "snowflake.storage.database_failsafe_bytes.total":
+					assert.False(t, validatedMetrics["snowflake.storage.database_failsafe_bytes.total"], "Found a duplicate in the metrics slice: snowflake.storage.database_failsafe_bytes.total")
+					validatedMetrics["snowflake.storage.database_failsafe_bytes.total"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Number of bytes of data of the database in Fail-safe.", ms.At(i).Description())
+					assert.Equal(t, "By", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("database_name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "database_name-val", attrVal.Str())
 				case "snowflake.storage.failsafe_bytes.total":
 					assert.False(t, validatedMetrics["snowflake.storage.failsafe_bytes.total"], "Found a duplicate in the metrics slice: snowflake.storage.failsafe_bytes.total")
 					validatedMetrics["snowflake.storage.failsafe_bytes.total"] = true
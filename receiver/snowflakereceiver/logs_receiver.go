@@ -0,0 +1,154 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package snowflakereceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/snowflakereceiver"
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/receiver"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/snowflakereceiver/internal/metadata"
+)
+
+const (
+	scopeName = "otelcol/snowflakereceiver"
+
+	// slowQueryEventName is the name of the events emitted for the slow queries.
+	slowQueryEventName = "snowflake.query.slow"
+	eventNameAttribute = "event.name"
+
+	// slowQueriesLookback is how far back the query history is read on the first collection,
+	// as the metrics are computed over the last 24 hours.
+	slowQueriesLookback = 24 * time.Hour
+)
+
+// snowflakeLogsReceiver emits an event per query of the query history which took longer than
+// the threshold, reading the queries which ended since the last collection.
+type snowflakeLogsReceiver struct {
+	settings receiver.CreateSettings
+	conf     *Config
+	consumer consumer.Logs
+	client   *snowflakeClient
+
+	// lastEndTime is the end time of the last query emitted.
+	lastEndTime time.Time
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func newSnowflakeLogsReceiver(settings receiver.CreateSettings, conf *Config, consumer consumer.Logs) *snowflakeLogsReceiver {
+	return &snowflakeLogsReceiver{
+		settings: settings,
+		conf:     conf,
+		consumer: consumer,
+	}
+}
+
+func (r *snowflakeLogsReceiver) Start(_ context.Context, _ component.Host) (err error) {
+	r.client, err = newDefaultClient(r.settings.TelemetrySettings, *r.conf)
+	if err != nil {
+		return err
+	}
+	r.lastEndTime = time.Now().Add(-slowQueriesLookback)
+
+	var ctx context.Context
+	ctx, r.cancel = context.WithCancel(context.Background())
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		ticker := time.NewTicker(r.conf.CollectionInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				r.collect(ctx)
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+func (r *snowflakeLogsReceiver) Shutdown(_ context.Context) error {
+	if r.cancel != nil {
+		r.cancel()
+	}
+	r.wg.Wait()
+	if r.client == nil {
+		return nil
+	}
+	return r.client.client.Close()
+}
+
+func (r *snowflakeLogsReceiver) collect(ctx context.Context) {
+	logs, err := r.scrape(ctx)
+	if err != nil {
+		r.settings.Logger.Error("Failed to read the slow queries", zap.Error(err))
+		return
+	}
+	if logs.LogRecordCount() == 0 {
+		return
+	}
+	if err = r.consumer.ConsumeLogs(ctx, logs); err != nil {
+		r.settings.Logger.Error("Failed to consume the slow queries", zap.Error(err))
+	}
+}
+
+func (r *snowflakeLogsReceiver) scrape(ctx context.Context) (plog.Logs, error) {
+	logs := plog.NewLogs()
+	queries, err := r.client.FetchSlowQueries(ctx, r.lastEndTime, r.conf.SlowQueries.Threshold, r.conf.SlowQueries.Limit)
+	if err != nil {
+		return logs, err
+	}
+
+	resourceLogs := logs.ResourceLogs().AppendEmpty()
+	rb := metadata.NewResourceBuilder(r.conf.MetricsBuilderConfig.ResourceAttributes)
+	rb.SetSnowflakeAccountName(r.conf.Account)
+	rb.Emit().MoveTo(resourceLogs.Resource())
+	scopeLogs := resourceLogs.ScopeLogs().AppendEmpty()
+	scopeLogs.Scope().SetName(scopeName)
+	scopeLogs.Scope().SetVersion(r.settings.BuildInfo.Version)
+
+	observedAt := pcommon.NewTimestampFromTime(time.Now())
+	for _, q := range *queries {
+		logRecord := scopeLogs.LogRecords().AppendEmpty()
+		logRecord.SetTimestamp(pcommon.NewTimestampFromTime(q.startTime))
+		logRecord.SetObservedTimestamp(observedAt)
+		logRecord.Body().SetStr(q.queryText.String)
+
+		attributes := logRecord.Attributes()
+		attributes.PutStr(eventNameAttribute, slowQueryEventName)
+		attributes.PutStr("query_id", q.queryID)
+		attributes.PutStr("database_name", q.databaseName.String)
+		attributes.PutStr("schema_name", q.schemaName.String)
+		attributes.PutStr("warehouse_name", q.warehouseName.String)
+		attributes.PutStr("warehouse_size", q.warehouseSize.String)
+		attributes.PutStr("user_name", q.userName.String)
+		attributes.PutStr("role_name", q.roleName.String)
+		attributes.PutStr("query_tag", q.queryTag.String)
+		attributes.PutStr("execution_status", q.executionStatus.String)
+		if q.errorMessage.Valid {
+			attributes.PutStr("error_message", q.errorMessage.String)
+		}
+		attributes.PutInt("total_elapsed_time", q.totalElapsedTime)
+		attributes.PutInt("bytes_scanned", q.bytesScanned)
+		if q.creditsUsedCloudServices.Valid {
+			attributes.PutDouble("credits_used_cloud_services", q.creditsUsedCloudServices.Float64)
+		}
+
+		if q.endTime.After(r.lastEndTime) {
+			r.lastEndTime = q.endTime
+		}
+	}
+	return logs, nil
+}
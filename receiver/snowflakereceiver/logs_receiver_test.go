@@ -0,0 +1,79 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package snowflakereceiver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+)
+
+func TestLogsReceiverScrape(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Account = "account"
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	columns := []string{"query_id", "query_text", "database_name", "schema_name", "warehouse_name",
+		"warehouse_size", "user_name", "role_name", "query_tag", "execution_status", "error_message",
+		"start_time", "end_time", "total_elapsed_time", "bytes_scanned", "credits_used_cloud_services"}
+	start := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	end := start.Add(2 * time.Minute)
+	since := start.Add(-time.Hour)
+	mock.ExpectQuery(slowQueriesQuery).
+		WithArgs(since, int64(60000), 100).
+		WillReturnRows(mock.NewRows(columns).AddRow("01b2", "select * from orders", "SALES", "PUBLIC", "ETL_WH",
+			"X-Small", "etl", "SYSADMIN", "nightly", "SUCCESS", nil, start, end, 120000, 4096, 0.5))
+	mock.ExpectQuery(slowQueriesQuery).
+		WithArgs(end, int64(60000), 100).
+		WillReturnRows(mock.NewRows(columns))
+
+	r := newSnowflakeLogsReceiver(receivertest.NewNopCreateSettings(), cfg, consumertest.NewNop())
+	r.client = &snowflakeClient{
+		client: db,
+		logger: receivertest.NewNopCreateSettings().Logger,
+	}
+	r.lastEndTime = since
+
+	logs, err := r.scrape(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 1, logs.LogRecordCount())
+
+	resourceLogs := logs.ResourceLogs().At(0)
+	assert.Equal(t, map[string]any{"snowflake.account.name": "account"}, resourceLogs.Resource().Attributes().AsRaw())
+	assert.Equal(t, "otelcol/snowflakereceiver", resourceLogs.ScopeLogs().At(0).Scope().Name())
+	logRecord := resourceLogs.ScopeLogs().At(0).LogRecords().At(0)
+	assert.Equal(t, "select * from orders", logRecord.Body().Str())
+	assert.Equal(t, pcommon.NewTimestampFromTime(start), logRecord.Timestamp())
+	assert.Equal(t, map[string]any{
+		"event.name":                  "snowflake.query.slow",
+		"query_id":                    "01b2",
+		"database_name":               "SALES",
+		"schema_name":                 "PUBLIC",
+		"warehouse_name":              "ETL_WH",
+		"warehouse_size":              "X-Small",
+		"user_name":                   "etl",
+		"role_name":                   "SYSADMIN",
+		"query_tag":                   "nightly",
+		"execution_status":            "SUCCESS",
+		"total_elapsed_time":          int64(120000),
+		"bytes_scanned":               int64(4096),
+		"credits_used_cloud_services": 0.5,
+	}, logRecord.Attributes().AsRaw())
+
+	// the next collection only reads the queries which ended later
+	logs, err = r.scrape(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 0, logs.LogRecordCount())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -3,7 +3,10 @@
 
 package snowflakereceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/snowflakereceiver"
 
-import "database/sql"
+import (
+	"database/sql"
+	"time"
+)
 
 // each query returns columns which serialize into these data structures
 // these are consumed by the scraper to create and emit metrics
@@ -95,3 +98,37 @@ type storageMetric struct {
 	stageBytes    int64
 	failsafeBytes int64
 }
+
+// query attribution history query, attributing the cost of the queries
+type queryAttributionMetric struct {
+	warehouseName     sql.NullString
+	userName          sql.NullString
+	queryTag          sql.NullString
+	creditsAttributed float64
+}
+
+type databaseStorageMetric struct {
+	databaseName  sql.NullString
+	databaseBytes int64
+	failsafeBytes int64
+}
+
+// slow queries query, each row is emitted as an event by the logs receiver
+type slowQuery struct {
+	queryID                  string
+	queryText                sql.NullString
+	databaseName             sql.NullString
+	schemaName               sql.NullString
+	warehouseName            sql.NullString
+	warehouseSize            sql.NullString
+	userName                 sql.NullString
+	roleName                 sql.NullString
+	queryTag                 sql.NullString
+	executionStatus          sql.NullString
+	errorMessage             sql.NullString
+	startTime                time.Time
+	endTime                  time.Time
+	totalElapsedTime         int64
+	bytesScanned             int64
+	creditsUsedCloudServices sql.NullFloat64
+}
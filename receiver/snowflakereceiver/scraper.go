@@ -74,6 +74,8 @@ func (s *snowflakeMetricsScraper) scrape(ctx context.Context) (pmetric.Metrics,
 		s.scrapeSessionMetrics,
 		s.scrapeSnowpipeMetrics,
 		s.scrapeStorageMetrics,
+		s.scrapeQueryAttributionMetrics,
+		s.scrapeDatabaseStorageMetrics,
 	}
 
 	errChan := make(chan error, len(metricScrapes))
@@ -236,3 +238,37 @@ func (s *snowflakeMetricsScraper) scrapeStorageMetrics(ctx context.Context, t pc
 		s.mb.RecordSnowflakeStorageFailsafeBytesTotalDataPoint(t, row.failsafeBytes)
 	}
 }
+
+func (s *snowflakeMetricsScraper) scrapeQueryAttributionMetrics(ctx context.Context, t pcommon.Timestamp, errs chan<- error) {
+	if !s.conf.MetricsBuilderConfig.Metrics.SnowflakeBillingQueryAttributionTotal.Enabled {
+		return
+	}
+	queryAttributionMetrics, err := s.client.FetchQueryAttributionMetrics(ctx)
+
+	if err != nil {
+		errs <- err
+		return
+	}
+
+	for _, row := range *queryAttributionMetrics {
+		s.mb.RecordSnowflakeBillingQueryAttributionTotalDataPoint(t, row.creditsAttributed, row.warehouseName.String, row.userName.String, row.queryTag.String)
+	}
+}
+
+func (s *snowflakeMetricsScraper) scrapeDatabaseStorageMetrics(ctx context.Context, t pcommon.Timestamp, errs chan<- error) {
+	if !s.conf.MetricsBuilderConfig.Metrics.SnowflakeStorageDatabaseBytesTotal.Enabled &&
+		!s.conf.MetricsBuilderConfig.Metrics.SnowflakeStorageDatabaseFailsafeBytesTotal.Enabled {
+		return
+	}
+	databaseStorageMetrics, err := s.client.FetchDatabaseStorageMetrics(ctx)
+
+	if err != nil {
+		errs <- err
+		return
+	}
+
+	for _, row := range *databaseStorageMetrics {
+		s.mb.RecordSnowflakeStorageDatabaseBytesTotalDataPoint(t, row.databaseBytes, row.databaseName.String)
+		s.mb.RecordSnowflakeStorageDatabaseFailsafeBytesTotalDataPoint(t, row.failsafeBytes, row.databaseName.String)
+	}
+}
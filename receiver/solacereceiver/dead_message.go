@@ -0,0 +1,132 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package solacereceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/solacereceiver"
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+	"unicode/utf8"
+
+	"github.com/Azure/go-amqp"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+)
+
+const (
+	messagingSystemKey             = "messaging.system"
+	messagingDestinationNameKey    = "messaging.destination.name"
+	messagingMessageIDKey          = "messaging.message.id"
+	messagingConversationIDKey     = "messaging.message.conversation_id"
+	messagingDeadMessageQueueKey   = "messaging.solace.dead_message_queue"
+	messagingDeliveryCountKey      = "messaging.solace.delivery_count"
+	messagingSubjectKey            = "messaging.solace.subject"
+	messagingUserPropertiesKeyBase = "messaging.solace.user_properties."
+
+	messagingSystemSolace = "solace"
+)
+
+// deadMessageToLogs maps a message of the dead message queue to an error log record. The body of the
+// record is the payload of the message, the attributes hold the original destination and the properties
+// of the message.
+func deadMessageToLogs(msg *inboundMessage, queue string) plog.Logs {
+	logs := plog.NewLogs()
+	record := logs.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+	record.SetObservedTimestamp(pcommon.NewTimestampFromTime(time.Now()))
+	record.SetSeverityNumber(plog.SeverityNumberError)
+	record.SetSeverityText("ERROR")
+
+	attrs := record.Attributes()
+	attrs.PutStr(messagingSystemKey, messagingSystemSolace)
+	attrs.PutStr(messagingDeadMessageQueueKey, queue)
+	if properties := msg.Properties; properties != nil {
+		// the brokers keep the destination the messages were published to when moving them to the dead message queue
+		if properties.To != nil {
+			attrs.PutStr(messagingDestinationNameKey, *properties.To)
+		}
+		if properties.MessageID != nil {
+			attrs.PutStr(messagingMessageIDKey, messageIDToString(properties.MessageID))
+		}
+		if properties.CorrelationID != nil {
+			attrs.PutStr(messagingConversationIDKey, messageIDToString(properties.CorrelationID))
+		}
+		if properties.Subject != nil {
+			attrs.PutStr(messagingSubjectKey, *properties.Subject)
+		}
+		if properties.CreationTime != nil {
+			record.SetTimestamp(pcommon.NewTimestampFromTime(*properties.CreationTime))
+		}
+	}
+	if msg.Header != nil {
+		attrs.PutInt(messagingDeliveryCountKey, int64(msg.Header.DeliveryCount))
+	}
+	for key, value := range msg.ApplicationProperties {
+		putAMQPValue(attrs.PutEmpty(messagingUserPropertiesKeyBase+key), value)
+	}
+
+	switch {
+	case len(msg.Data) > 0:
+		payload := bytes.Join(msg.Data, nil)
+		if utf8.Valid(payload) {
+			record.Body().SetStr(string(payload))
+		} else {
+			record.Body().SetEmptyBytes().FromRaw(payload)
+		}
+	case msg.Value != nil:
+		putAMQPValue(record.Body(), msg.Value)
+	}
+	return logs
+}
+
+// messageIDToString formats the message ids, which are an uint64, an UUID, a []byte or a string
+func messageIDToString(id any) string {
+	switch v := id.(type) {
+	case string:
+		return v
+	case amqp.UUID:
+		return v.String()
+	case []byte:
+		return string(v)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// putAMQPValue sets the AMQP primitive value to dest. Since pcommon.Value only supports int64
+// integer types, uint64 data may be misrepresented.
+func putAMQPValue(dest pcommon.Value, value any) {
+	switch v := value.(type) {
+	case nil:
+	case bool:
+		dest.SetBool(v)
+	case string:
+		dest.SetStr(v)
+	case []byte:
+		dest.SetEmptyBytes().FromRaw(v)
+	case int8:
+		dest.SetInt(int64(v))
+	case int16:
+		dest.SetInt(int64(v))
+	case int32:
+		dest.SetInt(int64(v))
+	case int64:
+		dest.SetInt(v)
+	case uint8:
+		dest.SetInt(int64(v))
+	case uint16:
+		dest.SetInt(int64(v))
+	case uint32:
+		dest.SetInt(int64(v))
+	case uint64:
+		dest.SetInt(int64(v))
+	case float32:
+		dest.SetDouble(float64(v))
+	case float64:
+		dest.SetDouble(v)
+	case time.Time:
+		dest.SetStr(v.Format(time.RFC3339Nano))
+	default:
+		dest.SetStr(fmt.Sprint(v))
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package solacereceiver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Azure/go-amqp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+)
+
+func TestDeadMessageToLogs(t *testing.T) {
+	destination := "orders/created"
+	subject := "order"
+	created := time.Date(2024, 3, 12, 8, 30, 0, 0, time.UTC)
+	msg := &inboundMessage{
+		Header: &amqp.MessageHeader{DeliveryCount: 3},
+		Properties: &amqp.MessageProperties{
+			MessageID:     "ID:42",
+			CorrelationID: uint64(7),
+			To:            &destination,
+			Subject:       &subject,
+			CreationTime:  &created,
+		},
+		ApplicationProperties: map[string]any{
+			"tenant":   "acme",
+			"priority": int32(4),
+			"urgent":   true,
+		},
+		Data: [][]byte{[]byte(`{"order":`), []byte(`42}`)},
+	}
+
+	logs := deadMessageToLogs(msg, "queue://#DEAD_MSG_QUEUE")
+	require.Equal(t, 1, logs.LogRecordCount())
+	record := logs.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	assert.Equal(t, plog.SeverityNumberError, record.SeverityNumber())
+	assert.Equal(t, "ERROR", record.SeverityText())
+	assert.Equal(t, pcommon.NewTimestampFromTime(created), record.Timestamp())
+	assert.NotZero(t, record.ObservedTimestamp())
+	assert.Equal(t, `{"order":42}`, record.Body().Str())
+	assert.Equal(t, map[string]any{
+		"messaging.system":                          "solace",
+		"messaging.solace.dead_message_queue":       "queue://#DEAD_MSG_QUEUE",
+		"messaging.destination.name":                "orders/created",
+		"messaging.message.id":                      "ID:42",
+		"messaging.message.conversation_id":         "7",
+		"messaging.solace.subject":                  "order",
+		"messaging.solace.delivery_count":           int64(3),
+		"messaging.solace.user_properties.tenant":   "acme",
+		"messaging.solace.user_properties.priority": int64(4),
+		"messaging.solace.user_properties.urgent":   true,
+	}, record.Attributes().AsRaw())
+}
+
+func TestDeadMessageToLogsBody(t *testing.T) {
+	testCases := []struct {
+		name string
+		msg  *inboundMessage
+		want any
+	}{
+		{
+			name: "binary data",
+			msg:  &inboundMessage{Data: [][]byte{{0xff, 0xfe}}},
+			want: []byte{0xff, 0xfe},
+		},
+		{
+			name: "amqp value",
+			msg:  &inboundMessage{Value: int64(42)},
+			want: int64(42),
+		},
+		{
+			name: "no payload",
+			msg:  &inboundMessage{},
+			want: nil,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			logs := deadMessageToLogs(tc.msg, "queue://#DEAD_MSG_QUEUE")
+			record := logs.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+			assert.Equal(t, tc.want, record.Body().AsRaw())
+			// only the attributes of the receiver are set for the messages without properties
+			assert.Equal(t, 2, record.Attributes().Len())
+		})
+	}
+}
@@ -28,6 +28,7 @@ func NewFactory() receiver.Factory {
 		metadata.Type,
 		createDefaultConfig,
 		receiver.WithTraces(createTracesReceiver, metadata.TracesStability),
+		receiver.WithLogs(createLogsReceiver, metadata.LogsStability),
 	)
 }
 
@@ -63,3 +64,17 @@ func createTracesReceiver(
 	// pass cfg, params and next consumer through
 	return newTracesReceiver(cfg, params, nextConsumer)
 }
+
+// createLogsReceiver creates a logs receiver ingesting the messages of the configured dead message queue. Component is not shared
+func createLogsReceiver(
+	_ context.Context,
+	params receiver.CreateSettings,
+	receiverConfig component.Config,
+	nextConsumer consumer.Logs,
+) (receiver.Logs, error) {
+	cfg, ok := receiverConfig.(*Config)
+	if !ok {
+		return nil, component.ErrDataTypeIsNotSupported
+	}
+	return newLogsReceiver(cfg, params, nextConsumer)
+}
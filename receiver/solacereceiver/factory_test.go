@@ -44,6 +44,38 @@ func TestCreateTracesReceiver(t *testing.T) {
 	assert.Equal(t, castedReceiver.config, cfg)
 }
 
+func TestCreateLogsReceiver(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+
+	sub, err := cm.Sub(component.NewIDWithName(metadata.Type, "primary").String())
+	require.NoError(t, err)
+	require.NoError(t, component.UnmarshalConfig(sub, cfg))
+
+	set := receivertest.NewNopCreateSettings()
+	set.ID = component.MustNewIDWithName("solace", "dmq")
+	receiver, err := factory.CreateLogsReceiver(
+		context.Background(),
+		set,
+		cfg,
+		consumertest.NewNop(),
+	)
+	assert.NoError(t, err)
+	castedReceiver, ok := receiver.(*solaceTracesReceiver)
+	assert.True(t, ok)
+	assert.Equal(t, castedReceiver.config, cfg)
+	assert.NotNil(t, castedReceiver.nextLogsConsumer)
+	assert.Nil(t, castedReceiver.unmarshaller)
+}
+
+func TestCreateLogsReceiverWrongConfig(t *testing.T) {
+	factory := NewFactory()
+	_, err := factory.CreateLogsReceiver(context.Background(), receivertest.NewNopCreateSettings(), nil, nil)
+	assert.Equal(t, component.ErrDataTypeIsNotSupported, err)
+}
+
 func TestCreateTracesReceiverWrongConfig(t *testing.T) {
 	factory := NewFactory()
 	_, err := factory.CreateTracesReceiver(context.Background(), receivertest.NewNopCreateSettings(), nil, nil)
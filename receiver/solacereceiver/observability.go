@@ -53,6 +53,8 @@ type opencensusMetrics struct {
 		flowControlTotal               *stats.Int64Measure
 		flowControlSingleSuccess       *stats.Int64Measure
 		droppedEgressSpans             *stats.Int64Measure
+		receivedDeadMessages           *stats.Int64Measure
+		droppedDeadMessages            *stats.Int64Measure
 	}
 	views struct {
 		failedReconnections            *view.View
@@ -68,6 +70,8 @@ type opencensusMetrics struct {
 		flowControlTotal               *view.View
 		flowControlSingleSuccess       *view.View
 		droppedEgressSpans             *view.View
+		receivedDeadMessages           *view.View
+		droppedDeadMessages            *view.View
 	}
 }
 
@@ -95,6 +99,9 @@ func newOpenCensusMetrics(instanceName string) (*opencensusMetrics, error) {
 
 	m.stats.droppedEgressSpans = stats.Int64(prefix+"dropped_egress_spans", "Number of dropped egress spans", stats.UnitDimensionless)
 
+	m.stats.receivedDeadMessages = stats.Int64(prefix+"received_dead_messages", "Number of messages received from a dead message queue", stats.UnitDimensionless)
+	m.stats.droppedDeadMessages = stats.Int64(prefix+"dropped_dead_messages", "Number of messages of a dead message queue dropped after a permanent error of the next consumer", stats.UnitDimensionless)
+
 	m.views.failedReconnections = fromMeasure(m.stats.failedReconnections, view.Count())
 	m.views.recoverableUnmarshallingErrors = fromMeasure(m.stats.recoverableUnmarshallingErrors, view.Count())
 	m.views.fatalUnmarshallingErrors = fromMeasure(m.stats.fatalUnmarshallingErrors, view.Count())
@@ -111,6 +118,9 @@ func newOpenCensusMetrics(instanceName string) (*opencensusMetrics, error) {
 
 	m.views.droppedEgressSpans = fromMeasure(m.stats.droppedEgressSpans, view.Count())
 
+	m.views.receivedDeadMessages = fromMeasure(m.stats.receivedDeadMessages, view.Count())
+	m.views.droppedDeadMessages = fromMeasure(m.stats.droppedDeadMessages, view.Count())
+
 	err := view.Register(
 		m.views.failedReconnections,
 		m.views.recoverableUnmarshallingErrors,
@@ -125,6 +135,8 @@ func newOpenCensusMetrics(instanceName string) (*opencensusMetrics, error) {
 		m.views.flowControlTotal,
 		m.views.flowControlSingleSuccess,
 		m.views.droppedEgressSpans,
+		m.views.receivedDeadMessages,
+		m.views.droppedDeadMessages,
 	)
 	if err != nil {
 		return nil, err
@@ -204,3 +216,13 @@ func (m *opencensusMetrics) recordFlowControlSingleSuccess() {
 func (m *opencensusMetrics) recordDroppedEgressSpan() {
 	stats.Record(context.Background(), m.stats.droppedEgressSpans.M(1))
 }
+
+// recordReceivedDeadMessages increments the metric that records a message received from a dead message queue
+func (m *opencensusMetrics) recordReceivedDeadMessages() {
+	stats.Record(context.Background(), m.stats.receivedDeadMessages.M(1))
+}
+
+// recordDroppedDeadMessages increments the metric that records a dropped message of a dead message queue
+func (m *opencensusMetrics) recordDroppedDeadMessages() {
+	stats.Record(context.Background(), m.stats.droppedDeadMessages.M(1))
+}
@@ -45,6 +45,8 @@ func TestRecordMetrics(t *testing.T) {
 		{metrics.recordFlowControlTotal, metrics.views.flowControlTotal, metrics.stats.flowControlTotal, 3, 3},
 		{metrics.recordFlowControlSingleSuccess, metrics.views.flowControlSingleSuccess, metrics.stats.flowControlSingleSuccess, 3, 3},
 		{metrics.recordDroppedEgressSpan, metrics.views.droppedEgressSpans, metrics.stats.droppedEgressSpans, 3, 3},
+		{metrics.recordReceivedDeadMessages, metrics.views.receivedDeadMessages, metrics.stats.receivedDeadMessages, 3, 3},
+		{metrics.recordDroppedDeadMessages, metrics.views.droppedDeadMessages, metrics.stats.droppedDeadMessages, 3, 3},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.m.Name(), func(t *testing.T) {
@@ -6,7 +6,6 @@ package solacereceiver // import "github.com/open-telemetry/opentelemetry-collec
 import (
 	"context"
 	"errors"
-	"fmt"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -18,7 +17,10 @@ import (
 	"go.uber.org/zap"
 )
 
-// solaceTracesReceiver uses azure AMQP to consume and handle telemetry data from SOlace. Implements receiver.Traces
+var errDelayedRetryInterrupted = errors.New("delayed retry interrupted by shutdown request")
+
+// solaceTracesReceiver uses azure AMQP to consume and handle telemetry data from SOlace. Implements receiver.Traces,
+// and receiver.Logs when ingesting the messages of a dead message queue
 type solaceTracesReceiver struct {
 	// config is the receiver.Config instance used to build the receiver
 	config *Config
@@ -36,11 +38,32 @@ type solaceTracesReceiver struct {
 	terminating *atomic.Bool
 	// retryTimeout is the timeout between connection attempts
 	retryTimeout time.Duration
+	// nextLogsConsumer is set instead of nextConsumer when the receiver ingests a dead message queue
+	nextLogsConsumer consumer.Logs
 }
 
 // newTracesReceiver creates a new solaceTraceReceiver as a receiver.Traces
 func newTracesReceiver(config *Config, set receiver.CreateSettings, nextConsumer consumer.Traces) (receiver.Traces, error) {
+	r, err := newSolaceReceiver(config, set)
+	if err != nil {
+		return nil, err
+	}
+	r.nextConsumer = nextConsumer
+	r.unmarshaller = newTracesUnmarshaller(set.Logger, r.metrics)
+	return r, nil
+}
+
+// newLogsReceiver creates a new solaceTraceReceiver as a receiver.Logs, consuming the configured queue as a dead message queue
+func newLogsReceiver(config *Config, set receiver.CreateSettings, nextConsumer consumer.Logs) (receiver.Logs, error) {
+	r, err := newSolaceReceiver(config, set)
+	if err != nil {
+		return nil, err
+	}
+	r.nextLogsConsumer = nextConsumer
+	return r, nil
+}
 
+func newSolaceReceiver(config *Config, set receiver.CreateSettings) (*solaceTracesReceiver, error) {
 	factory, err := newAMQPMessagingServiceFactory(config, set.Logger)
 	if err != nil {
 		set.Logger.Warn("Error validating messaging service configuration", zap.Any("error", err))
@@ -53,14 +76,10 @@ func newTracesReceiver(config *Config, set receiver.CreateSettings, nextConsumer
 		return nil, err
 	}
 
-	unmarshaller := newTracesUnmarshaller(set.Logger, metrics)
-
 	return &solaceTracesReceiver{
 		config:            config,
-		nextConsumer:      nextConsumer,
 		settings:          set,
 		metrics:           metrics,
-		unmarshaller:      unmarshaller,
 		shutdownWaitGroup: &sync.WaitGroup{},
 		factory:           factory,
 		retryTimeout:      1 * time.Second,
@@ -199,6 +218,13 @@ func (s *solaceTracesReceiver) receiveMessage(ctx context.Context, service messa
 			}
 		}
 	}()
+	if s.nextLogsConsumer != nil {
+		if deadMessageErr := s.receiveDeadMessage(ctx, msg); deadMessageErr != nil {
+			disposition = nil // do not make any network requests, we are shutting down
+			return deadMessageErr
+		}
+		return nil
+	}
 	// message received successfully
 	s.metrics.recordReceivedSpanMessages()
 	// unmarshal the message. unmarshalling errors are not fatal unless the version is unknown
@@ -214,51 +240,77 @@ func (s *solaceTracesReceiver) receiveMessage(ctx context.Context, service messa
 		return nil                            // don't propagate error, but don't continue forwarding traces
 	}
 
+	forwardErr := s.forward(ctx, func(ctx context.Context) error {
+		return s.nextConsumer.ConsumeTraces(ctx, traces)
+	})
+	switch {
+	case errors.Is(forwardErr, errDelayedRetryInterrupted):
+		disposition = nil // do not make any network requests, we are shutting down
+		return forwardErr
+	case forwardErr != nil: // error is permanent, we want to accept the message and increment the number of dropped messages
+		s.settings.Logger.Warn("Encountered permanent error while forwarding traces to next receiver, will swallow trace", zap.Error(forwardErr))
+		s.metrics.recordDroppedSpanMessages()
+	default:
+		s.metrics.recordReportedSpans(int64(traces.SpanCount()))
+	}
+	return nil
+}
+
+// receiveDeadMessage forwards a message of a dead message queue as an error log. Only returns an error
+// when the receiver is shutting down while delaying a retry, the message should then be left unsettled.
+func (s *solaceTracesReceiver) receiveDeadMessage(ctx context.Context, msg *inboundMessage) error {
+	s.metrics.recordReceivedDeadMessages()
+	logs := deadMessageToLogs(msg, s.config.Queue)
+	forwardErr := s.forward(ctx, func(ctx context.Context) error {
+		return s.nextLogsConsumer.ConsumeLogs(ctx, logs)
+	})
+	switch {
+	case errors.Is(forwardErr, errDelayedRetryInterrupted):
+		return forwardErr
+	case forwardErr != nil:
+		s.settings.Logger.Warn("Encountered permanent error while forwarding dead message to next receiver, will swallow message", zap.Error(forwardErr))
+		s.metrics.recordDroppedDeadMessages()
+	}
+	return nil
+}
+
+// forward calls consume until it does not return a temporary error, delaying the retries as configured by the flow control.
+// It returns the permanent error of consume, or errDelayedRetryInterrupted if the context was cancelled while delaying a retry.
+func (s *solaceTracesReceiver) forward(ctx context.Context, consume func(context.Context) error) error {
 	var flowControlCount int64
 flowControlLoop:
 	for {
 		// forward to next consumer. Forwarding errors are not fatal so are not propagated to the caller.
 		// Temporary consumer errors will lead to redelivered messages, permanent will be accepted
-		forwardErr := s.nextConsumer.ConsumeTraces(ctx, traces)
-		if forwardErr != nil {
-			if !consumererror.IsPermanent(forwardErr) {
-				s.settings.Logger.Info("Encountered temporary error while forwarding traces to next receiver, will allow redelivery", zap.Error(forwardErr))
-				// handle flow control metrics
-				if flowControlCount == 0 {
-					s.metrics.recordFlowControlStatus(flowControlStateControlled)
-				}
-				flowControlCount++
-				s.metrics.recordFlowControlRecentRetries(flowControlCount)
-				// Backpressure scenario. For now, we are only delayed retry, eventually we may need to handle this
-				delayTimer := time.NewTimer(s.config.Flow.DelayedRetry.Delay)
-				select {
-				case <-delayTimer.C:
-					continue flowControlLoop
-				case <-ctx.Done():
-					s.settings.Logger.Info("Context was cancelled while attempting redelivery, exiting")
-					disposition = nil // do not make any network requests, we are shutting down
-					return fmt.Errorf("delayed retry interrupted by shutdown request")
+		forwardErr := consume(ctx)
+		if forwardErr == nil || consumererror.IsPermanent(forwardErr) {
+			// Make sure to clear the stats no matter what, unless we were interrupted in which case we should preserve the last state
+			if flowControlCount != 0 {
+				s.metrics.recordFlowControlStatus(flowControlStateClear)
+				s.metrics.recordFlowControlTotal()
+				if flowControlCount == 1 {
+					s.metrics.recordFlowControlSingleSuccess()
 				}
-			} else { // error is permanent, we want to accept the message and increment the number of dropped messages
-				s.settings.Logger.Warn("Encountered permanent error while forwarding traces to next receiver, will swallow trace", zap.Error(forwardErr))
-				s.metrics.recordDroppedSpanMessages()
-				break flowControlLoop
 			}
-		} else {
-			// no forward error
-			s.metrics.recordReportedSpans(int64(traces.SpanCount()))
-			break flowControlLoop
+			return forwardErr
 		}
-	}
-	// Make sure to clear the stats no matter what, unless we were interrupted in which case we should preserve the last state
-	if flowControlCount != 0 {
-		s.metrics.recordFlowControlStatus(flowControlStateClear)
-		s.metrics.recordFlowControlTotal()
-		if flowControlCount == 1 {
-			s.metrics.recordFlowControlSingleSuccess()
+		s.settings.Logger.Info("Encountered temporary error while forwarding to next receiver, will allow redelivery", zap.Error(forwardErr))
+		// handle flow control metrics
+		if flowControlCount == 0 {
+			s.metrics.recordFlowControlStatus(flowControlStateControlled)
+		}
+		flowControlCount++
+		s.metrics.recordFlowControlRecentRetries(flowControlCount)
+		// Backpressure scenario. For now, we are only delayed retry, eventually we may need to handle this
+		delayTimer := time.NewTimer(s.config.Flow.DelayedRetry.Delay)
+		select {
+		case <-delayTimer.C:
+			continue flowControlLoop
+		case <-ctx.Done():
+			s.settings.Logger.Info("Context was cancelled while attempting redelivery, exiting")
+			return errDelayedRetryInterrupted
 		}
 	}
-	return nil
 }
 
 func sleep(ctx context.Context, d time.Duration) {
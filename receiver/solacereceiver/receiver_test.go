@@ -13,11 +13,13 @@ import (
 	"testing"
 	"time"
 
+	"github.com/Azure/go-amqp"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/consumer/consumererror"
 	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.opentelemetry.io/collector/receiver/receivertest"
 )
@@ -454,6 +456,96 @@ func TestReceiverFlowControlDelayedRetryInterrupt(t *testing.T) {
 	}
 }
 
+func TestReceiveDeadMessage(t *testing.T) {
+	someError := consumererror.NewPermanent(fmt.Errorf("some error"))
+	testCases := []struct {
+		name         string
+		nextConsumer consumer.Logs
+		dropped      any
+	}{
+		{
+			name:         "Without error",
+			nextConsumer: new(consumertest.LogsSink),
+		},
+		{
+			name:         "With permanent error",
+			nextConsumer: consumertest.NewErr(someError),
+			dropped:      1,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			receiver, messagingService, _ := newReceiver(t)
+			receiver.nextConsumer = nil
+			receiver.unmarshaller = nil
+			receiver.config.Queue = "queue://#DEAD_MSG_QUEUE"
+			receiver.nextLogsConsumer = tc.nextConsumer
+
+			destination := "orders/created"
+			messagingService.receiveMessageFunc = func(context.Context) (*inboundMessage, error) {
+				return &inboundMessage{Data: [][]byte{[]byte("order 42")}, Properties: &amqp.MessageProperties{To: &destination}}, nil
+			}
+			var ackCalled bool
+			messagingService.ackFunc = func(context.Context, *inboundMessage) error {
+				ackCalled = true
+				return nil
+			}
+
+			require.NoError(t, receiver.receiveMessage(context.Background(), messagingService))
+			assert.True(t, ackCalled)
+			if sink, ok := tc.nextConsumer.(*consumertest.LogsSink); ok {
+				require.Len(t, sink.AllLogs(), 1)
+				record := sink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+				assert.Equal(t, "order 42", record.Body().Str())
+				destinationName, found := record.Attributes().Get(messagingDestinationNameKey)
+				require.True(t, found)
+				assert.Equal(t, destination, destinationName.Str())
+			}
+			validateMetric(t, receiver.metrics.views.receivedDeadMessages, 1)
+			validateMetric(t, receiver.metrics.views.droppedDeadMessages, tc.dropped)
+			validateMetric(t, receiver.metrics.views.receivedSpanMessages, nil)
+		})
+	}
+}
+
+func TestReceiveDeadMessageDelayedRetryInterrupt(t *testing.T) {
+	receiver, messagingService, _ := newReceiver(t)
+	receiver.nextConsumer = nil
+	receiver.unmarshaller = nil
+	// we won't wait 10 seconds since we will interrupt well before
+	receiver.config.Flow.DelayedRetry.Delay = 10 * time.Second
+	var err error
+	receiver.nextLogsConsumer, err = consumer.NewLogs(func(context.Context, plog.Logs) error {
+		return fmt.Errorf("Some temporary error")
+	})
+	require.NoError(t, err)
+
+	// the message should not be settled when interrupted, ack is not expected to be called
+	messagingService.receiveMessageFunc = func(context.Context) (*inboundMessage, error) {
+		return &inboundMessage{}, nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	receiveMessageComplete := make(chan error, 1)
+	go func() {
+		receiveMessageComplete <- receiver.receiveMessage(ctx, messagingService)
+	}()
+	select {
+	case <-time.After(2 * time.Millisecond):
+		// success
+	case <-receiveMessageComplete:
+		require.Fail(t, "Did not expect receiveMessage to return before delay interval")
+	}
+	validateMetric(t, receiver.metrics.views.flowControlStatus, flowControlStateControlled)
+	cancel()
+	select {
+	case <-time.After(time.Second):
+		require.Fail(t, "receiveMessage did not return after some time")
+	case err := <-receiveMessageComplete:
+		assert.ErrorIs(t, err, errDelayedRetryInterrupted)
+	}
+}
+
 func TestReceiverFlowControlDelayedRetryMultipleRetries(t *testing.T) {
 	receiver, messagingService, unmarshaller := newReceiver(t)
 	// we won't wait 10 seconds since we will interrupt well before
@@ -25,6 +25,9 @@ type Config struct {
 
 	Ack `mapstructure:"ack"`
 
+	// RateLimiter defines the extension limiting the rate of the requests per client. The requests exceeding the rate are rejected with a 429 status code.
+	RateLimiter *component.ID `mapstructure:"rate_limiter"`
+
 	// RawPath for raw data collection, default is '/services/collector/raw'
 	RawPath string `mapstructure:"raw_path"`
 	// Splitting defines the splitting strategy used by the receiver when ingesting raw events. Can be set to "line" or "none". Default is "line".
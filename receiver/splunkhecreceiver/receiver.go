@@ -27,6 +27,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/ackextension"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/ratelimiterextension"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/splunk"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/splunkhecreceiver/internal/metadata"
 )
@@ -52,6 +53,7 @@ const (
 	responseErrDataChannelMissing     = `{"text": "Data channel is missing","code":10}`
 	responseErrInvalidDataChannel     = `{"text": "Invalid data channel", "code": 11}`
 	responseNoData                    = `{"text":"No data","code":5}`
+	responseErrServerBusy             = `{"text":"Server is busy","code":9}`
 	// Centralizing some HTTP and related string constants.
 	gzipEncoding              = "gzip"
 	httpContentEncodingHeader = "Content-Encoding"
@@ -80,6 +82,7 @@ var (
 	errUnsupportedMetricEvent     = []byte(responseErrUnsupportedMetricEvent)
 	errUnsupportedLogEvent        = []byte(responseErrUnsupportedLogEvent)
 	noDataRespBody                = []byte(responseNoData)
+	serverBusyRespBody            = []byte(responseErrServerBusy)
 )
 
 // splunkReceiver implements the receiver.Metrics for Splunk HEC metric protocol.
@@ -93,6 +96,7 @@ type splunkReceiver struct {
 	obsrecv         *receiverhelper.ObsReport
 	gzipReaderPool  *sync.Pool
 	ackExt          ackextension.AckExtension
+	rateLimiter     ratelimiterextension.RateLimiter
 }
 
 var _ receiver.Metrics = (*splunkReceiver)(nil)
@@ -207,6 +211,18 @@ func (r *splunkReceiver) Start(ctx context.Context, host component.Host) error {
 		}
 	}
 
+	if r.config.RateLimiter != nil {
+		ext, found := host.GetExtensions()[*r.config.RateLimiter]
+		if !found {
+			return fmt.Errorf("specified rate limiter extension with id %q could not be found", *r.config.RateLimiter)
+		}
+		rateLimiter, ok := ext.(ratelimiterextension.RateLimiter)
+		if !ok {
+			return fmt.Errorf("specified extension with id %q is not a rate limiter", *r.config.RateLimiter)
+		}
+		r.rateLimiter = rateLimiter
+	}
+
 	mx.NewRoute().Path(r.config.HealthPath).HandlerFunc(r.handleHealthReq)
 	mx.NewRoute().Path(r.config.HealthPath + "/1.0").HandlerFunc(r.handleHealthReq).Methods("GET")
 	if r.logsConsumer != nil {
@@ -319,6 +335,10 @@ func (r *splunkReceiver) handleRawReq(resp http.ResponseWriter, req *http.Reques
 		return
 	}
 
+	if !r.allowRequest(ctx, resp, req) {
+		return
+	}
+
 	encoding := req.Header.Get(httpContentEncodingHeader)
 	if encoding != "" && encoding != gzipEncoding {
 		r.failRequest(ctx, resp, http.StatusUnsupportedMediaType, invalidEncodingRespBody, 0, errInvalidEncoding)
@@ -437,6 +457,10 @@ func (r *splunkReceiver) handleReq(resp http.ResponseWriter, req *http.Request)
 		return
 	}
 
+	if !r.allowRequest(ctx, resp, req) {
+		return
+	}
+
 	encoding := req.Header.Get(httpContentEncodingHeader)
 	if encoding != "" && encoding != gzipEncoding {
 		r.failRequest(ctx, resp, http.StatusUnsupportedMediaType, invalidEncodingRespBody, 0, errInvalidEncoding)
@@ -557,6 +581,18 @@ func (r *splunkReceiver) createResourceCustomizer(req *http.Request) func(resour
 	return nil
 }
 
+// allowRequest rejects the request with a 429 status code when its client exceeded its rate.
+func (r *splunkReceiver) allowRequest(ctx context.Context, resp http.ResponseWriter, req *http.Request) bool {
+	if r.rateLimiter == nil {
+		return true
+	}
+	if err := r.rateLimiter.Limit(ctx, req.Header); err != nil {
+		r.failRequest(ctx, resp, http.StatusTooManyRequests, serverBusyRespBody, 0, err)
+		return false
+	}
+	return true
+}
+
 func (r *splunkReceiver) failRequest(
 	ctx context.Context,
 	resp http.ResponseWriter,
@@ -27,11 +27,13 @@ import (
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/consumer/consumertest"
 	"go.opentelemetry.io/collector/exporter/exportertest"
+	"go.opentelemetry.io/collector/extension/extensiontest"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/receiver/receivertest"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/splunkhecexporter"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/ratelimiterextension"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/common/testutil"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/sharedcomponent"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/splunk"
@@ -1141,6 +1143,15 @@ func Test_splunkhecReceiver_Start(t *testing.T) {
 			},
 			errorExpected: true,
 		},
+		{
+			name: "rate_limiter_extension_does_not_exist",
+			getConfig: func() *Config {
+				config := createDefaultConfig().(*Config)
+				config.RateLimiter = &component.ID{}
+				return config
+			},
+			errorExpected: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -1744,6 +1755,62 @@ func Test_splunkhecReceiver_handleReq_WithAck(t *testing.T) {
 	}
 }
 
+func Test_splunkhecReceiver_RateLimiter(t *testing.T) {
+	config := createDefaultConfig().(*Config)
+	config.Endpoint = "localhost:0" // Actually not creating the endpoint
+	id := component.MustNewID("ratelimiter")
+	config.RateLimiter = &id
+	currentTime := float64(time.Now().UnixNano()) / 1e6
+	msgBytes, err := json.Marshal(buildSplunkHecMsg(currentTime, 3))
+	require.NoError(t, err)
+
+	rateLimiterFactory := ratelimiterextension.NewFactory()
+	rateLimiterConfig := rateLimiterFactory.CreateDefaultConfig().(*ratelimiterextension.Config)
+	rateLimiterConfig.Rate = 1
+	rateLimiterConfig.Burst = 1
+	rateLimiterConfig.MetadataKey = "X-Tenant"
+	rateLimiter, err := rateLimiterFactory.CreateExtension(context.Background(), extensiontest.NewNopCreateSettings(), rateLimiterConfig)
+	require.NoError(t, err)
+
+	sink := new(consumertest.LogsSink)
+	rcv, err := newLogsReceiver(receivertest.NewNopCreateSettings(), *config, sink)
+	require.NoError(t, err)
+	r := rcv.(*splunkReceiver)
+	require.NoError(t, r.Start(context.Background(), mockHost{extensions: map[component.ID]component.Component{
+		id: rateLimiter,
+	}}))
+	defer func() {
+		assert.NoError(t, r.Shutdown(context.Background()))
+	}()
+
+	send := func(tenant string, raw bool) *http.Response {
+		req := httptest.NewRequest("POST", "http://localhost/foo", bytes.NewReader(msgBytes))
+		req.Header.Set("X-Tenant", tenant)
+		w := httptest.NewRecorder()
+		if raw {
+			r.handleRawReq(w, req)
+		} else {
+			r.handleReq(w, req)
+		}
+		return w.Result()
+	}
+
+	resp := send("a", false)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	resp = send("a", false)
+	assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, responseErrServerBusy, string(body))
+	resp = send("a", true)
+	assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+
+	// the other tenants are not limited
+	resp = send("b", true)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, 2, len(sink.AllLogs()))
+}
+
 func Test_splunkhecreceiver_handleHealthPath(t *testing.T) {
 	config := createDefaultConfig().(*Config)
 	sink := new(consumertest.LogsSink)
@@ -83,6 +83,51 @@ func TestLoadConfig(t *testing.T) {
 			id:           component.NewIDWithName(metadata.Type, ""),
 			errorMessage: "'value_column' cannot be empty",
 		},
+		{
+			fname:        "config-invalid-expansion-attribute.yaml",
+			id:           component.NewIDWithName(metadata.Type, ""),
+			errorMessage: "'expansion_attribute' requires 'expand_value'",
+		},
+		{
+			fname:        "config-invalid-histogram.yaml",
+			id:           component.NewIDWithName(metadata.Type, ""),
+			errorMessage: "'histogram.bucket_count_columns' must have one more column than 'histogram.explicit_bounds', got 2 columns for 2 bounds",
+		},
+		{
+			fname:        "config-invalid-exponential-histogram.yaml",
+			id:           component.NewIDWithName(metadata.Type, ""),
+			errorMessage: "'exponential_histogram.scale_column' cannot be empty",
+		},
+		{
+			fname:        "config-invalid-summary.yaml",
+			id:           component.NewIDWithName(metadata.Type, ""),
+			errorMessage: "'summary.quantiles' must be between 0 and 1, got 50",
+		},
+		{
+			fname:        "config-invalid-staleness-markers.yaml",
+			id:           component.NewIDWithName(metadata.Type, ""),
+			errorMessage: "'staleness_markers' applies only to metrics, 'query.metrics' cannot be empty",
+		},
+		{
+			fname:        "config-invalid-exemplar.yaml",
+			id:           component.NewIDWithName(metadata.Type, ""),
+			errorMessage: "'exemplar.value_column' cannot be empty with 'data_type: histogram'",
+		},
+		{
+			fname:        "config-invalid-value-columns.yaml",
+			id:           component.NewIDWithName(metadata.Type, ""),
+			errorMessage: "'value_columns': invalid pattern \"[seq\": syntax error in pattern",
+		},
+		{
+			fname:        "config-invalid-metric-name-column.yaml",
+			id:           component.NewIDWithName(metadata.Type, ""),
+			errorMessage: "'metric_name_column' cannot be set with 'value_columns'",
+		},
+		{
+			fname:        "config-invalid-attribute-limits.yaml",
+			id:           component.NewIDWithName(metadata.Type, ""),
+			errorMessage: "attribute_limits: 'max_distinct_values' for column 'host' cannot be negative",
+		},
 		{
 			fname:        "config-invalid-missing-sql.yaml",
 			id:           component.NewIDWithName(metadata.Type, ""),
@@ -101,7 +146,7 @@ func TestLoadConfig(t *testing.T) {
 		{
 			fname:        "config-invalid-missing-logs-metrics.yaml",
 			id:           component.NewIDWithName(metadata.Type, ""),
-			errorMessage: "at least one of 'query.logs' and 'query.metrics' must not be empty",
+			errorMessage: "at least one of 'query.logs', 'query.metrics' and 'query.traces' must not be empty",
 		},
 		{
 			fname:        "config-invalid-missing-datasource.yaml",
@@ -117,8 +162,9 @@ func TestLoadConfig(t *testing.T) {
 						CollectionInterval: 10 * time.Second,
 						InitialDelay:       time.Second,
 					},
-					Driver:     "mydriver",
-					DataSource: "host=localhost port=5432 user=me password=s3cr3t sslmode=disable",
+					Driver:             "mydriver",
+					DataSource:         "host=localhost port=5432 user=me password=s3cr3t sslmode=disable",
+					PreparedStatements: true,
 					Queries: []sqlquery.Query{
 						{
 							SQL:                "select * from test_logs where log_id > ?",
@@ -139,6 +185,74 @@ func TestLoadConfig(t *testing.T) {
 			id:           component.NewIDWithName(metadata.Type, ""),
 			errorMessage: "'body_column' must not be empty",
 		},
+		{
+			fname: "config-traces.yaml",
+			id:    component.NewIDWithName(metadata.Type, ""),
+			expected: &Config{
+				Config: sqlquery.Config{
+					ControllerConfig: scraperhelper.ControllerConfig{
+						CollectionInterval: 10 * time.Second,
+						InitialDelay:       time.Second,
+					},
+					Driver:     "mydriver",
+					DataSource: "host=localhost port=5432 user=me password=s3cr3t sslmode=disable",
+					Queries: []sqlquery.Query{
+						{
+							SQL:                "select * from job_runs where run_id > ?",
+							TrackingColumn:     "run_id",
+							TrackingStartValue: "0",
+							Traces: []sqlquery.TracesCfg{
+								{
+									TraceIDColumn:        "trace_id",
+									SpanIDColumn:         "span_id",
+									ParentSpanIDColumn:   "parent_span_id",
+									NameColumn:           "job_name",
+									StartTimestampColumn: "started_at",
+									EndTimestampColumn:   "finished_at",
+									StatusCodeColumn:     "state",
+									StatusMapping:        map[string]string{"FAILED": "error"},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			fname:        "config-traces-invalid.yaml",
+			id:           component.NewIDWithName(metadata.Type, ""),
+			errorMessage: "'span_id_column' must not be empty\n'status_mapping' requires 'status_code_column'\n'status_mapping' has unsupported status code 'failed' for value 'FAILED'",
+		},
+		{
+			fname:        "config-invalid-table.yaml",
+			id:           component.NewIDWithName(metadata.Type, ""),
+			errorMessage: "'table.key_column' cannot be empty",
+		},
+		{
+			fname:        "config-invalid-replica-lag.yaml",
+			id:           component.NewIDWithName(metadata.Type, ""),
+			errorMessage: "'replica_lag.max_lag' requires 'replica_lag.sql'",
+		},
+		{
+			fname:        "config-logs-invalid-max-body.yaml",
+			id:           component.NewIDWithName(metadata.Type, ""),
+			errorMessage: "logs config has unsupported max_body_action: 'ignore'\n'compress_body' requires 'max_body_bytes'",
+		},
+		{
+			fname:        "config-logs-invalid-body-type.yaml",
+			id:           component.NewIDWithName(metadata.Type, ""),
+			errorMessage: "'body_column' cannot be set with 'body_type: map'",
+		},
+		{
+			fname:        "config-logs-invalid-body-template.yaml",
+			id:           component.NewIDWithName(metadata.Type, ""),
+			errorMessage: "'body_column' cannot be set with 'body_template'\ninvalid 'body_template': unterminated reference '${action'",
+		},
+		{
+			fname:        "config-logs-invalid-parse-body.yaml",
+			id:           component.NewIDWithName(metadata.Type, ""),
+			errorMessage: "logs config has unsupported parse_body_as: 'xml'",
+		},
 		{
 			fname:        "config-unnecessary-aggregation.yaml",
 			id:           component.NewIDWithName(metadata.Type, ""),
@@ -0,0 +1,93 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build integration && db2
+
+package sqlqueryreceiver
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+	"go.opentelemetry.io/collector/component"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/scraperinttest"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/sqlquery"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/pmetrictest"
+)
+
+const db2Port = "50000"
+
+// TestDb2IntegrationMetrics requires the IBM Db2 CLI driver, see the README, and runs with
+// make mod-integration-test GO_BUILD_TAGS=db2.
+func TestDb2IntegrationMetrics(t *testing.T) {
+	scraperinttest.NewIntegrationTest(
+		NewFactory(),
+		scraperinttest.WithContainerRequest(
+			testcontainers.ContainerRequest{
+				Image:      "icr.io/db2_community/db2:11.5.9.0",
+				Privileged: true,
+				Env: map[string]string{
+					"LICENSE":           "accept",
+					"DB2INSTANCE":       "db2inst1",
+					"DB2INST1_PASSWORD": "otel",
+					"DBNAME":            "otel",
+				},
+				Files: []testcontainers.ContainerFile{
+					{
+						HostFilePath:      filepath.Join("testdata", "integration", "db2", "init.sql"),
+						ContainerFilePath: "/tmp/init.sql",
+						FileMode:          0644,
+					},
+					{
+						HostFilePath:      filepath.Join("testdata", "integration", "db2", "init.sh"),
+						ContainerFilePath: "/var/custom/init.sh",
+						FileMode:          0755,
+					},
+				},
+				ExposedPorts: []string{db2Port},
+				// the instance and the database take minutes to set up
+				WaitingFor: wait.ForLog("otel init done").WithStartupTimeout(10 * time.Minute),
+			}),
+		scraperinttest.WithCustomConfig(
+			func(t *testing.T, cfg component.Config, ci *scraperinttest.ContainerInfo) {
+				rCfg := cfg.(*Config)
+				rCfg.Driver = "go_ibm_db"
+				rCfg.DataSource = fmt.Sprintf("HOSTNAME=%s;PORT=%s;DATABASE=otel;UID=db2inst1;PWD=otel",
+					ci.Host(t), ci.MappedPort(t, db2Port))
+				rCfg.Queries = []sqlquery.Query{
+					{
+						// the unquoted column names are upper case in Db2
+						SQL: `select genre as "genre", count(*) as "count", decimal(avg(imdb_rating), 4, 2) as "avg", max(released) as "released" ` +
+							"from movie group by genre order by genre desc",
+						Metrics: []sqlquery.MetricCfg{
+							{
+								MetricName:       "genre.count",
+								ValueColumn:      "count",
+								AttributeColumns: []string{"genre", "released"},
+								ValueType:        sqlquery.MetricValueTypeInt,
+								DataType:         sqlquery.MetricTypeGauge,
+							},
+							{
+								MetricName:       "genre.imdb",
+								ValueColumn:      "avg",
+								AttributeColumns: []string{"genre"},
+								ValueType:        sqlquery.MetricValueTypeDouble,
+								DataType:         sqlquery.MetricTypeGauge,
+							},
+						},
+					},
+				}
+			}),
+		scraperinttest.WithExpectedFile(
+			filepath.Join("testdata", "integration", "db2", "expected.yaml"),
+		),
+		scraperinttest.WithCompareOptions(
+			pmetrictest.IgnoreTimestamp(),
+		),
+	).Run(t)
+}
@@ -38,6 +38,8 @@ const (
 	postgresqlPort = "5432"
 	oraclePort     = "1521"
 	mysqlPort      = "3306"
+	clickhousePort = "9000"
+	trinoPort      = "8080"
 )
 
 func TestPostgresIntegrationLogsTrackingWithoutStorage(t *testing.T) {
@@ -606,6 +608,110 @@ func TestMysqlIntegrationMetrics(t *testing.T) {
 	).Run(t)
 }
 
+func TestClickHouseIntegrationMetrics(t *testing.T) {
+	scraperinttest.NewIntegrationTest(
+		NewFactory(),
+		scraperinttest.WithContainerRequest(
+			testcontainers.ContainerRequest{
+				Image: "clickhouse/clickhouse-server:24.3",
+				Env: map[string]string{
+					"CLICKHOUSE_USER":     "otel",
+					"CLICKHOUSE_PASSWORD": "otel",
+					"CLICKHOUSE_DB":       "otel",
+				},
+				Files: []testcontainers.ContainerFile{{
+					HostFilePath:      filepath.Join("testdata", "integration", "clickhouse", "init.sql"),
+					ContainerFilePath: "/docker-entrypoint-initdb.d/init.sql",
+					FileMode:          700,
+				}},
+				ExposedPorts: []string{clickhousePort},
+				WaitingFor:   wait.ForListeningPort(nat.Port(clickhousePort)).WithStartupTimeout(2 * time.Minute),
+			}),
+		scraperinttest.WithCustomConfig(
+			func(t *testing.T, cfg component.Config, ci *scraperinttest.ContainerInfo) {
+				rCfg := cfg.(*Config)
+				rCfg.Driver = "clickhouse"
+				rCfg.DataSource = fmt.Sprintf("clickhouse://otel:otel@%s:%s/otel",
+					ci.Host(t), ci.MappedPort(t, clickhousePort))
+				rCfg.Queries = []sqlquery.Query{
+					{
+						SQL: "select genre, count() as count, round(avg(imdb_rating), 2) as avg from movie group by genre order by genre desc",
+						Metrics: []sqlquery.MetricCfg{
+							{
+								MetricName:       "genre.count",
+								ValueColumn:      "count",
+								AttributeColumns: []string{"genre"},
+								ValueType:        sqlquery.MetricValueTypeInt,
+								DataType:         sqlquery.MetricTypeGauge,
+							},
+							{
+								MetricName:       "genre.imdb",
+								ValueColumn:      "avg",
+								AttributeColumns: []string{"genre"},
+								ValueType:        sqlquery.MetricValueTypeDouble,
+								DataType:         sqlquery.MetricTypeGauge,
+							},
+						},
+					},
+				}
+			}),
+		scraperinttest.WithExpectedFile(
+			filepath.Join("testdata", "integration", "clickhouse", "expected.yaml"),
+		),
+		scraperinttest.WithCompareOptions(
+			pmetrictest.IgnoreTimestamp(),
+		),
+	).Run(t)
+}
+
+func TestTrinoIntegrationMetrics(t *testing.T) {
+	scraperinttest.NewIntegrationTest(
+		NewFactory(),
+		scraperinttest.WithContainerRequest(
+			testcontainers.ContainerRequest{
+				Image:        "trinodb/trino:448",
+				ExposedPorts: []string{trinoPort},
+				WaitingFor:   wait.ForLog("SERVER STARTED").WithStartupTimeout(3 * time.Minute),
+			}),
+		scraperinttest.WithCustomConfig(
+			func(t *testing.T, cfg component.Config, ci *scraperinttest.ContainerInfo) {
+				rCfg := cfg.(*Config)
+				rCfg.Driver = "trino"
+				rCfg.DataSource = fmt.Sprintf("http://otel@%s:%s?catalog=tpch&schema=tiny",
+					ci.Host(t), ci.MappedPort(t, trinoPort))
+				rCfg.Queries = []sqlquery.Query{
+					{
+						// the tpch catalog generates the TPC-H data, the nations being the same at every scale
+						SQL: "select r.name as region, count(*) as nations, cast(avg(n.nationkey) as decimal(4, 2)) as avg_nationkey " +
+							"from nation n join region r on n.regionkey = r.regionkey group by r.name order by r.name",
+						Metrics: []sqlquery.MetricCfg{
+							{
+								MetricName:       "region.nations",
+								ValueColumn:      "nations",
+								AttributeColumns: []string{"region"},
+								ValueType:        sqlquery.MetricValueTypeInt,
+								DataType:         sqlquery.MetricTypeGauge,
+							},
+							{
+								MetricName:       "region.avg_nationkey",
+								ValueColumn:      "avg_nationkey",
+								AttributeColumns: []string{"region"},
+								ValueType:        sqlquery.MetricValueTypeDouble,
+								DataType:         sqlquery.MetricTypeGauge,
+							},
+						},
+					},
+				}
+			}),
+		scraperinttest.WithExpectedFile(
+			filepath.Join("testdata", "integration", "trino", "expected.yaml"),
+		),
+		scraperinttest.WithCompareOptions(
+			pmetrictest.IgnoreTimestamp(),
+		),
+	).Run(t)
+}
+
 func testAllSimpleLogs(t *testing.T, logs []plog.Logs) {
 	assert.Equal(t, 1, len(logs))
 	assert.Equal(t, 1, logs[0].ResourceLogs().Len())
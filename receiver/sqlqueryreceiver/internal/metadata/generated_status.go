@@ -13,4 +13,5 @@ var (
 const (
 	LogsStability    = component.StabilityLevelDevelopment
 	MetricsStability = component.StabilityLevelAlpha
+	TracesStability  = component.StabilityLevelDevelopment
 )
@@ -0,0 +1,244 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlqueryreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/sqlqueryreceiver"
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"strings"
+	"unicode/utf8"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/sqlquery"
+)
+
+const (
+	// Attributes of the log records whose body is larger than max_body_bytes.
+	bodyOriginalSizeAttribute = "sqlquery.body.original_size"
+	bodyTruncatedAttribute    = "sqlquery.body.truncated"
+	bodyOversizedAttribute    = "sqlquery.body.oversized"
+	bodyEncodingAttribute     = "sqlquery.body.encoding"
+	// The index of the part of a split body, and the number of parts of the body.
+	bodyPartAttribute      = "sqlquery.body.part"
+	bodyPartCountAttribute = "sqlquery.body.part_count"
+	// bodyParseFailedAttribute is set on the log records whose body couldn't be parsed as
+	// parse_body_as, the body being the value of the column.
+	bodyParseFailedAttribute = "sqlquery.body.parse_failed"
+
+	bodyEncodingGzip = "gzip"
+)
+
+// logBody is the body of a log record once the maximum size of the body is enforced.
+type logBody struct {
+	// row is set for the bodies of type map, holding all the columns of the row.
+	row sqlquery.StringMap
+	// parsed is set for the bodies parsed as parse_body_as.
+	parsed       *pcommon.Value
+	parseFailed  bool
+	value        string
+	gzipped      []byte
+	originalSize int
+	truncated    bool
+	oversized    bool
+	// parts is set for the split bodies, value being the first part.
+	parts []string
+}
+
+// newLogBody returns the body of the log record of the row. It returns false when the log
+// record must be dropped.
+func newLogBody(row sqlquery.StringMap, config sqlquery.LogsCfg) (logBody, bool) {
+	if config.BodyType == sqlquery.BodyTypeMap {
+		return logBody{row: row}, true
+	}
+	if config.BodyTemplate != "" {
+		return limitBody(sqlquery.ExecuteBodyTemplate(config.BodyTemplate, row), config)
+	}
+	return limitBody(row[config.BodyColumn], config)
+}
+
+// limitBody enforces the maximum size of the body. It returns false when the log record
+// must be dropped.
+func limitBody(body string, config sqlquery.LogsCfg) (logBody, bool) {
+	if config.MaxBodyBytes == 0 || len(body) <= config.MaxBodyBytes {
+		return logBody{value: body}, true
+	}
+	limited := logBody{originalSize: len(body)}
+	if config.CompressBody {
+		if gzipped, err := gzipBody(body); err == nil && len(gzipped) <= config.MaxBodyBytes {
+			limited.gzipped = gzipped
+			return limited, true
+		}
+	}
+
+	switch config.MaxBodyAction {
+	case sqlquery.MaxBodyActionDrop:
+		return limited, false
+	case sqlquery.MaxBodyActionFlag:
+		limited.value = body
+		limited.oversized = true
+	case sqlquery.MaxBodyActionSplit:
+		for len(body) > 0 {
+			size := cutBody(body, config.MaxBodyBytes)
+			if size == 0 {
+				// a character larger than max_body_bytes is kept whole
+				_, size = utf8.DecodeRuneInString(body)
+			}
+			limited.parts = append(limited.parts, body[:size])
+			body = body[size:]
+		}
+		limited.value = limited.parts[0]
+	default:
+		limited.value = body[:cutBody(body, config.MaxBodyBytes)]
+		limited.truncated = true
+	}
+	return limited, true
+}
+
+// cutBody returns the size of the longest prefix of the body up to size bytes which does
+// not split a multi-byte character.
+func cutBody(body string, size int) int {
+	if size >= len(body) {
+		return len(body)
+	}
+	for size > 0 && !utf8.RuneStart(body[size]) {
+		size--
+	}
+	return size
+}
+
+func gzipBody(body string) ([]byte, error) {
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	if _, err := w.Write([]byte(body)); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// parse parses the body as parse_body_as. The body is kept as a string when it can't be
+// parsed.
+func (b *logBody) parse(parser sqlquery.BodyParser) error {
+	if parser != sqlquery.BodyParserJSON {
+		return nil
+	}
+	parsed, err := parseJSONBody(b.value)
+	if err != nil {
+		b.parseFailed = true
+		return err
+	}
+	b.parsed = &parsed
+	return nil
+}
+
+// parseJSONBody parses a JSON object or array. The numbers are integers when they are
+// integral, and doubles otherwise.
+func parseJSONBody(value string) (pcommon.Value, error) {
+	decoder := json.NewDecoder(strings.NewReader(value))
+	decoder.UseNumber()
+	var raw any
+	if err := decoder.Decode(&raw); err != nil {
+		return pcommon.Value{}, err
+	}
+	if decoder.More() {
+		return pcommon.Value{}, errors.New("unexpected data after the JSON value")
+	}
+	parsed := pcommon.NewValueEmpty()
+	switch raw := raw.(type) {
+	case map[string]any:
+		putJSONMap(parsed.SetEmptyMap(), raw)
+	case []any:
+		putJSONSlice(parsed.SetEmptySlice(), raw)
+	default:
+		return pcommon.Value{}, errors.New("value is not a JSON object or array")
+	}
+	return parsed, nil
+}
+
+func putJSONMap(m pcommon.Map, raw map[string]any) {
+	m.EnsureCapacity(len(raw))
+	for key, value := range raw {
+		setJSONValue(m.PutEmpty(key), value)
+	}
+}
+
+func putJSONSlice(s pcommon.Slice, raw []any) {
+	s.EnsureCapacity(len(raw))
+	for _, value := range raw {
+		setJSONValue(s.AppendEmpty(), value)
+	}
+}
+
+func setJSONValue(v pcommon.Value, raw any) {
+	switch raw := raw.(type) {
+	case map[string]any:
+		putJSONMap(v.SetEmptyMap(), raw)
+	case []any:
+		putJSONSlice(v.SetEmptySlice(), raw)
+	case json.Number:
+		if i, err := raw.Int64(); err == nil {
+			v.SetInt(i)
+		} else if f, err := raw.Float64(); err == nil {
+			v.SetDouble(f)
+		} else {
+			v.SetStr(raw.String())
+		}
+	case string:
+		v.SetStr(raw)
+	case bool:
+		v.SetBool(raw)
+	}
+	// the null values are left empty
+}
+
+func (b logBody) setTo(logRecord plog.LogRecord) {
+	if b.row != nil {
+		putTypedAttributes(b.row, logRecord.Body().SetEmptyMap())
+		return
+	}
+	if b.parsed != nil {
+		b.parsed.CopyTo(logRecord.Body())
+		return
+	}
+	if b.parseFailed {
+		logRecord.Attributes().PutBool(bodyParseFailedAttribute, true)
+	}
+	if b.gzipped != nil {
+		logRecord.Body().SetEmptyBytes().FromRaw(b.gzipped)
+		logRecord.Attributes().PutStr(bodyEncodingAttribute, bodyEncodingGzip)
+	} else {
+		logRecord.Body().SetStr(b.value)
+	}
+	if b.originalSize == 0 {
+		return
+	}
+	logRecord.Attributes().PutInt(bodyOriginalSizeAttribute, int64(b.originalSize))
+	if b.truncated {
+		logRecord.Attributes().PutBool(bodyTruncatedAttribute, true)
+	}
+	if b.oversized {
+		logRecord.Attributes().PutBool(bodyOversizedAttribute, true)
+	}
+	if b.parts != nil {
+		logRecord.Attributes().PutInt(bodyPartAttribute, 0)
+		logRecord.Attributes().PutInt(bodyPartCountAttribute, int64(len(b.parts)))
+	}
+}
+
+// appendParts appends a log record for each of the other parts of a split body, copied from
+// the log record of its first part.
+func (b logBody) appendParts(first plog.LogRecord, logRecords plog.LogRecordSlice) {
+	for i := 1; i < len(b.parts); i++ {
+		logRecord := logRecords.AppendEmpty()
+		first.CopyTo(logRecord)
+		logRecord.Body().SetStr(b.parts[i])
+		logRecord.Attributes().PutInt(bodyPartAttribute, int64(i))
+	}
+}
@@ -36,9 +36,14 @@ type logsReceiver struct {
 	collectionIntervalTicker *time.Ticker
 	shutdownRequested        chan struct{}
 
-	id            component.ID
-	storageClient storage.Client
-	obsrecv       *receiverhelper.ObsReport
+	id                 component.ID
+	storageClient      storage.Client
+	obsrecv            *receiverhelper.ObsReport
+	statementTelemetry *sqlquery.StatementTelemetry
+
+	replicaLagChecker   *sqlquery.ReplicaLagChecker
+	replicaLagDb        *sql.DB
+	replicaLagWrappedDb sqlquery.Db
 }
 
 func newLogsReceiver(
@@ -57,17 +62,32 @@ func newLogsReceiver(
 		return nil, err
 	}
 
+	statementTelemetry, err := sqlquery.NewStatementTelemetry(metadata.Meter(settings.TelemetrySettings), settings.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	var replicaLagChecker *sqlquery.ReplicaLagChecker
+	if config.ReplicaLag.SQL != "" {
+		replicaLagChecker, err = sqlquery.NewReplicaLagChecker(config.ReplicaLag, metadata.Meter(settings.TelemetrySettings), settings.ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	receiver := &logsReceiver{
 		config:   config,
 		settings: settings,
 		createConnection: func() (*sql.DB, error) {
-			return sqlOpenerFunc(config.Driver, config.DataSource)
+			return sqlOpenerFunc(config.Driver, config.ConnectionString())
 		},
-		createClient:      createClient,
-		nextConsumer:      nextConsumer,
-		shutdownRequested: make(chan struct{}),
-		id:                settings.ID,
-		obsrecv:           obsr,
+		createClient:       createClient,
+		nextConsumer:       nextConsumer,
+		shutdownRequested:  make(chan struct{}),
+		id:                 settings.ID,
+		obsrecv:            obsr,
+		statementTelemetry: statementTelemetry,
+		replicaLagChecker:  replicaLagChecker,
 	}
 
 	return receiver, nil
@@ -98,6 +118,14 @@ func (receiver *logsReceiver) Start(ctx context.Context, host component.Host) er
 			return err
 		}
 	}
+	if receiver.replicaLagChecker != nil {
+		receiver.replicaLagDb, err = receiver.createConnection()
+		if err != nil {
+			return fmt.Errorf("failed to open db connection: %w", err)
+		}
+		receiver.replicaLagWrappedDb = sqlquery.WrapDb(receiver.replicaLagDb, receiver.config.PreparedStatements, receiver.statementTelemetry)
+		receiver.replicaLagChecker.Client = receiver.createClient(receiver.replicaLagWrappedDb, receiver.config.ReplicaLag.SQL, receiver.settings.Logger, receiver.config.Telemetry)
+	}
 	receiver.startCollecting()
 	receiver.settings.Logger.Debug("started.")
 	return nil
@@ -106,6 +134,9 @@ func (receiver *logsReceiver) Start(ctx context.Context, host component.Host) er
 func (receiver *logsReceiver) createQueryReceivers() error {
 	receiver.queryReceivers = nil
 	for i, query := range receiver.config.Queries {
+		if query.Table != nil {
+			query = query.Table.Query(receiver.config.Driver, query)
+		}
 		if len(query.Logs) == 0 {
 			continue
 		}
@@ -118,6 +149,8 @@ func (receiver *logsReceiver) createQueryReceivers() error {
 			receiver.settings.Logger,
 			receiver.config.Telemetry,
 			receiver.storageClient,
+			receiver.config.PreparedStatements,
+			receiver.statementTelemetry,
 		)
 		receiver.queryReceivers = append(receiver.queryReceivers, queryReceiver)
 	}
@@ -140,9 +173,15 @@ func (receiver *logsReceiver) startCollecting() {
 }
 
 func (receiver *logsReceiver) collect() {
+	lagging := receiver.replicaLagging()
 	logsChannel := make(chan plog.Logs)
 	for _, queryReceiver := range receiver.queryReceivers {
 		go func(queryReceiver *logsQueryReceiver) {
+			if lagging && queryReceiver.query.TrackingColumn != "" {
+				// the rows the replica didn't replay yet would be skipped by the next query
+				logsChannel <- plog.NewLogs()
+				return
+			}
 			logs, err := queryReceiver.collect(context.Background())
 			if err != nil {
 				receiver.settings.Logger.Error("error collecting logs", zap.Error(err), zap.String("query", queryReceiver.ID()))
@@ -168,6 +207,23 @@ func (receiver *logsReceiver) collect() {
 	}
 }
 
+// replicaLagging returns whether the replica lags behind by more than the maximum lag, in
+// which case the incremental queries are skipped for this collection interval.
+func (receiver *logsReceiver) replicaLagging() bool {
+	if receiver.replicaLagChecker == nil {
+		return false
+	}
+	lagging, err := receiver.replicaLagChecker.Check(context.Background())
+	if err != nil {
+		receiver.settings.Logger.Error("error checking the replica lag", zap.Error(err))
+		return false
+	}
+	if lagging {
+		receiver.settings.Logger.Warn("The replica lags behind, skipping the incremental queries", zap.Duration("max_lag", receiver.replicaLagChecker.MaxLag))
+	}
+	return lagging
+}
+
 func (receiver *logsReceiver) Shutdown(ctx context.Context) error {
 	if !receiver.isStarted {
 		receiver.settings.Logger.Debug("Requested shutdown, but not started, ignoring.")
@@ -181,6 +237,9 @@ func (receiver *logsReceiver) Shutdown(ctx context.Context) error {
 		errs = append(errs, queryReceiver.shutdown(ctx))
 	}
 
+	if receiver.replicaLagDb != nil {
+		errs = append(errs, sqlquery.CloseStatements(receiver.replicaLagWrappedDb), receiver.replicaLagDb.Close())
+	}
 	if receiver.storageClient != nil {
 		errs = append(errs, receiver.storageClient.Close(ctx))
 	}
@@ -206,12 +265,22 @@ type logsQueryReceiver struct {
 	logger       *zap.Logger
 	telemetry    sqlquery.TelemetryConfig
 
+	preparedStatements bool
+	statementTelemetry *sqlquery.StatementTelemetry
+
 	db            *sql.DB
+	wrappedDb     sqlquery.Db
 	client        sqlquery.DbClient
 	trackingValue string
+	// keysClient reads the keys of the tailed table, to detect the deleted rows
+	keysClient sqlquery.DbClient
+	knownKeys  map[string]struct{}
+	// deduplicator suppresses the rows emitted by the previous collections
+	deduplicator *sqlquery.Deduplicator
 	// TODO: Extract persistence into its own component
 	storageClient           storage.Client
 	trackingValueStorageKey string
+	dedupStorageKey         string
 }
 
 func newLogsQueryReceiver(
@@ -222,18 +291,26 @@ func newLogsQueryReceiver(
 	logger *zap.Logger,
 	telemetry sqlquery.TelemetryConfig,
 	storageClient storage.Client,
+	preparedStatements bool,
+	statementTelemetry *sqlquery.StatementTelemetry,
 ) *logsQueryReceiver {
 	queryReceiver := &logsQueryReceiver{
-		id:            id,
-		query:         query,
-		createDb:      dbProviderFunc,
-		createClient:  clientProviderFunc,
-		logger:        logger,
-		telemetry:     telemetry,
-		storageClient: storageClient,
+		id:                 id,
+		query:              query,
+		createDb:           dbProviderFunc,
+		createClient:       clientProviderFunc,
+		logger:             logger,
+		telemetry:          telemetry,
+		storageClient:      storageClient,
+		preparedStatements: preparedStatements,
+		statementTelemetry: statementTelemetry,
 	}
 	queryReceiver.trackingValue = queryReceiver.query.TrackingStartValue
 	queryReceiver.trackingValueStorageKey = fmt.Sprintf("%s.%s", queryReceiver.id, "trackingValue")
+	if query.Dedup != nil {
+		queryReceiver.deduplicator = sqlquery.NewDeduplicator(*query.Dedup)
+		queryReceiver.dedupStorageKey = fmt.Sprintf("%s.%s", queryReceiver.id, "dedup")
+	}
 	return queryReceiver
 }
 
@@ -247,9 +324,15 @@ func (queryReceiver *logsQueryReceiver) start(ctx context.Context) error {
 	if err != nil {
 		return fmt.Errorf("failed to open db connection: %w", err)
 	}
-	queryReceiver.client = queryReceiver.createClient(sqlquery.DbWrapper{Db: queryReceiver.db}, queryReceiver.query.SQL, queryReceiver.logger, queryReceiver.telemetry)
+	queryReceiver.wrappedDb = sqlquery.WrapDb(queryReceiver.db, queryReceiver.preparedStatements, queryReceiver.statementTelemetry)
+	db := queryReceiver.wrappedDb
+	queryReceiver.client = queryReceiver.createClient(db, queryReceiver.query.SQL, queryReceiver.logger, queryReceiver.telemetry)
+	if queryReceiver.query.Table != nil && queryReceiver.query.Table.DetectDeletions {
+		queryReceiver.keysClient = queryReceiver.createClient(db, queryReceiver.query.Table.KeysSQL(), queryReceiver.logger, queryReceiver.telemetry)
+	}
 
 	queryReceiver.trackingValue = queryReceiver.retrieveTrackingValue(ctx)
+	queryReceiver.retrieveDedupState(ctx)
 
 	return nil
 }
@@ -287,25 +370,113 @@ func (queryReceiver *logsQueryReceiver) collect(ctx context.Context) (plog.Logs,
 	}
 
 	var errs []error
-	scopeLogs := logs.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
-	for logsConfigIndex, logsConfig := range queryReceiver.query.Logs {
-		for _, row := range rows {
-			logRecord := scopeLogs.AppendEmpty()
-			rowToLog(row, logsConfig, logRecord)
-			logRecord.SetObservedTimestamp(observedAt)
-			if logsConfigIndex == 0 {
-				errs = append(errs, queryReceiver.storeTrackingValue(ctx, row))
+	newRows := rows
+	if queryReceiver.deduplicator != nil {
+		newRows, err = queryReceiver.deduplicator.Filter(rows, observedAt.AsTime())
+		if err != nil {
+			errs = append(errs, err)
+		}
+		errs = append(errs, queryReceiver.storeDedupState(ctx))
+	}
+	resources, err := sqlquery.GroupRowsByResource(newRows, queryReceiver.query.ResourceAttributeColumns)
+	if err != nil {
+		errs = append(errs, err)
+	}
+	dropped := 0
+	parseFailures := 0
+	var parseErr error
+	for _, resource := range resources {
+		resourceLogs := logs.ResourceLogs().AppendEmpty()
+		resource.PutAttributes(resourceLogs.Resource().Attributes())
+		for _, logsConfig := range queryReceiver.query.Logs {
+			// the schema URL is set per scope, each logs config has its own
+			scope := resourceLogs.ScopeLogs().AppendEmpty()
+			scope.SetSchemaUrl(logsConfig.SchemaURL)
+			queryReceiver.query.Scope.CopyTo(scope.Scope())
+			scopeLogs := scope.LogRecords()
+			for _, row := range resource.Rows {
+				if body, keep := newLogBody(row, logsConfig); keep {
+					if err := body.parse(logsConfig.ParseBodyAs); err != nil {
+						parseFailures++
+						if parseErr == nil {
+							parseErr = err
+						}
+					}
+					logRecord := scopeLogs.AppendEmpty()
+					if queryReceiver.query.Table != nil {
+						putTypedAttributes(row, logRecord.Attributes())
+					}
+					errs = append(errs, rowToLog(row, body, logsConfig, logRecord))
+					logRecord.SetObservedTimestamp(observedAt)
+					body.appendParts(logRecord, scopeLogs)
+				} else {
+					dropped++
+				}
 			}
 		}
 	}
+	// the rows are tracked in the order of the result set, not of their resources
+	for _, row := range rows {
+		queryReceiver.updateTrackingValue(row)
+	}
+	if len(rows) > 0 {
+		errs = append(errs, queryReceiver.storeTrackingValue(ctx))
+	}
+	if queryReceiver.keysClient != nil {
+		errs = append(errs, queryReceiver.collectDeletions(ctx, logs.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords(), observedAt))
+	}
+	if parseFailures > 0 {
+		errs = append(errs, fmt.Errorf("parse_body_as: failed to parse the body of %d log records, keeping them as strings: %w", parseFailures, parseErr))
+	}
+	if dropped > 0 {
+		queryReceiver.logger.Warn("Dropped log records with a body larger than max_body_bytes", zap.Int("count", dropped))
+	}
 	return logs, errors.Join(errs...)
 }
 
-func (queryReceiver *logsQueryReceiver) storeTrackingValue(ctx context.Context, row sqlquery.StringMap) error {
+// retrieveDedupState restores the rows emitted within the window of the de-duplication from
+// storage, if both are configured.
+func (queryReceiver *logsQueryReceiver) retrieveDedupState(ctx context.Context) {
+	if queryReceiver.deduplicator == nil || queryReceiver.storageClient == nil {
+		return
+	}
+	state, err := queryReceiver.storageClient.Get(ctx, queryReceiver.dedupStorageKey)
+	if err != nil || state == nil {
+		return
+	}
+	if err = queryReceiver.deduplicator.UnmarshalState(state); err != nil {
+		queryReceiver.logger.Warn("Failed to restore the rows of the de-duplication, the rows may be emitted again", zap.Error(err))
+	}
+}
+
+// storeDedupState persists the rows emitted within the window of the de-duplication, if storage is configured.
+func (queryReceiver *logsQueryReceiver) storeDedupState(ctx context.Context) error {
+	if queryReceiver.deduplicator == nil || queryReceiver.storageClient == nil {
+		return nil
+	}
+	state, err := queryReceiver.deduplicator.MarshalState()
+	if err != nil {
+		return err
+	}
+	return queryReceiver.storageClient.Set(ctx, queryReceiver.dedupStorageKey, state)
+}
+
+// updateTrackingValue keeps the value of the tracking column of the row. A NULL value is skipped, as the
+// next collection would read the whole table again.
+func (queryReceiver *logsQueryReceiver) updateTrackingValue(row sqlquery.StringMap) {
+	if queryReceiver.query.TrackingColumn == "" {
+		return
+	}
+	if value := row[queryReceiver.query.TrackingColumn]; value != "" {
+		queryReceiver.trackingValue = value
+	}
+}
+
+// storeTrackingValue persists the tracking value once the rows of a collection are processed, if storage is configured.
+func (queryReceiver *logsQueryReceiver) storeTrackingValue(ctx context.Context) error {
 	if queryReceiver.query.TrackingColumn == "" {
 		return nil
 	}
-	queryReceiver.trackingValue = row[queryReceiver.query.TrackingColumn]
 	if queryReceiver.storageClient != nil {
 		err := queryReceiver.storageClient.Set(ctx, queryReceiver.trackingValueStorageKey, []byte(queryReceiver.trackingValue))
 		if err != nil {
@@ -315,8 +486,82 @@ func (queryReceiver *logsQueryReceiver) storeTrackingValue(ctx context.Context,
 	return nil
 }
 
-func rowToLog(row sqlquery.StringMap, config sqlquery.LogsCfg, logRecord plog.LogRecord) {
-	logRecord.Body().SetStr(row[config.BodyColumn])
+// eventNameAttribute identifies the log records which are events, see
+// https://opentelemetry.io/docs/specs/semconv/general/events/
+const eventNameAttribute = "event.name"
+
+func rowToLog(row sqlquery.StringMap, body logBody, config sqlquery.LogsCfg, logRecord plog.LogRecord) error {
+	body.setTo(logRecord)
+	if config.EventName != "" {
+		logRecord.Attributes().PutStr(eventNameAttribute, config.EventName)
+	}
+	var errs []error
+	for _, column := range sqlquery.BodyTemplateColumns(config.BodyTemplate) {
+		if _, found := row[column]; !found {
+			errs = append(errs, fmt.Errorf("body_template: column '%s' not found in result set", column))
+		}
+	}
+	for _, column := range config.AttributeColumns {
+		if value, found := row[column]; found {
+			logRecord.Attributes().PutStr(column, value)
+		} else {
+			errs = append(errs, fmt.Errorf("attribute_columns: column '%s' not found in result set", column))
+		}
+	}
+	if config.TimestampColumn != "" {
+		if value, found := row[config.TimestampColumn]; found {
+			timestamp, err := parseTimestamp(value, config.TimestampFormat)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("timestamp_column: failed to parse the value '%s' of column '%s': %w", value, config.TimestampColumn, err))
+			} else {
+				logRecord.SetTimestamp(timestamp)
+			}
+		} else {
+			errs = append(errs, fmt.Errorf("timestamp_column: column '%s' not found in result set", config.TimestampColumn))
+		}
+	}
+	if config.TraceIDColumn != "" {
+		if value, found := row[config.TraceIDColumn]; found {
+			if err := setTraceID(logRecord, value); err != nil {
+				errs = append(errs, fmt.Errorf("trace_id_column: failed to parse the value '%s' of column '%s': %w", value, config.TraceIDColumn, err))
+			}
+		} else {
+			errs = append(errs, fmt.Errorf("trace_id_column: column '%s' not found in result set", config.TraceIDColumn))
+		}
+	}
+	if config.SpanIDColumn != "" {
+		if value, found := row[config.SpanIDColumn]; found {
+			if err := setSpanID(logRecord, value); err != nil {
+				errs = append(errs, fmt.Errorf("span_id_column: failed to parse the value '%s' of column '%s': %w", value, config.SpanIDColumn, err))
+			}
+		} else {
+			errs = append(errs, fmt.Errorf("span_id_column: column '%s' not found in result set", config.SpanIDColumn))
+		}
+	}
+	if config.SeverityColumn != "" {
+		if severity, found := row[config.SeverityColumn]; found {
+			logRecord.SetSeverityText(severity)
+			logRecord.SetSeverityNumber(sqlquery.ParseSeverity(severity, config.SeverityMapping))
+		} else {
+			errs = append(errs, fmt.Errorf("severity_column: column '%s' not found in result set", config.SeverityColumn))
+		}
+	}
+	for _, column := range config.ExpandColumns {
+		value, found := row[column]
+		if !found {
+			errs = append(errs, fmt.Errorf("expand_columns: column '%s' not found in result set", column))
+			continue
+		}
+		values, err := sqlquery.ExpandValue(value)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("expand_columns: column '%s': %w", column, err))
+			continue
+		}
+		for _, v := range values {
+			logRecord.Attributes().PutStr(column+"."+v.Key, v.Value)
+		}
+	}
+	return errors.Join(errs...)
 }
 
 func (queryReceiver *logsQueryReceiver) shutdown(_ context.Context) error {
@@ -324,5 +569,5 @@ func (queryReceiver *logsQueryReceiver) shutdown(_ context.Context) error {
 		return nil
 	}
 
-	return queryReceiver.db.Close()
+	return errors.Join(sqlquery.CloseStatements(queryReceiver.wrappedDb), queryReceiver.db.Close())
 }
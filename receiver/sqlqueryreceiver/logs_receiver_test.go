@@ -4,13 +4,24 @@
 package sqlqueryreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/sqlqueryreceiver"
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
+	"io"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer/consumertest"
 	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	"go.uber.org/zap"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/storagetest"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/sqlquery"
 )
 
@@ -51,3 +62,671 @@ func TestLogsQueryReceiver_Collect(t *testing.T) {
 		"Observed timestamps of all log records collected in a single scrape should be equal",
 	)
 }
+
+func TestLogsQueryReceiver_ExpandColumns(t *testing.T) {
+	fakeClient := &sqlquery.FakeDBClient{
+		StringMaps: [][]sqlquery.StringMap{
+			{
+				{"body": "first", "tags": `{"env":"prod","zone":"a"}`, "ids": "{4,5}"},
+				{"body": "second", "tags": "none", "ids": "[]"},
+			},
+		},
+	}
+	queryReceiver := logsQueryReceiver{
+		client: fakeClient,
+		query: sqlquery.Query{
+			Logs: []sqlquery.LogsCfg{
+				{
+					BodyColumn:    "body",
+					ExpandColumns: []string{"tags", "ids"},
+				},
+			},
+		},
+	}
+	logs, err := queryReceiver.collect(context.Background())
+	assert.EqualError(t, err, "expand_columns: column 'tags': value is not an array or a JSON object")
+	assert.Equal(t, 2, logs.LogRecordCount())
+
+	logRecords := logs.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+	assert.Equal(t, map[string]any{
+		"tags.env":  "prod",
+		"tags.zone": "a",
+		"ids.0":     "4",
+		"ids.1":     "5",
+	}, logRecords.At(0).Attributes().AsRaw())
+	assert.Equal(t, "second", logRecords.At(1).Body().Str())
+	assert.Equal(t, 0, logRecords.At(1).Attributes().Len())
+}
+
+func TestLogsQueryReceiver_TrackingValue(t *testing.T) {
+	storageClient := storagetest.NewInMemoryClient(component.KindReceiver, component.MustNewID("sqlquery"), "")
+	query := sqlquery.Query{
+		TrackingColumn:     "id",
+		TrackingStartValue: "0",
+		Logs:               []sqlquery.LogsCfg{{BodyColumn: "body"}},
+	}
+	fakeClient := &sqlquery.FakeDBClient{
+		StringMaps: [][]sqlquery.StringMap{
+			{{"body": "first", "id": "1"}, {"body": "second", "id": "2"}},
+			// the NULL tracking value is skipped
+			{{"body": "third", "id": ""}},
+			{},
+		},
+	}
+	queryReceiver := newLogsQueryReceiver("query-0", query, nil, nil, zap.NewNop(), sqlquery.TelemetryConfig{}, storageClient, false, nil)
+	queryReceiver.client = fakeClient
+	assert.Equal(t, "0", queryReceiver.retrieveTrackingValue(context.Background()))
+
+	for _, expected := range []string{"2", "2", "2"} {
+		_, err := queryReceiver.collect(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, expected, queryReceiver.trackingValue)
+	}
+
+	// the tracking value is read back from the storage after a restart
+	restarted := newLogsQueryReceiver("query-0", query, nil, nil, zap.NewNop(), sqlquery.TelemetryConfig{}, storageClient, false, nil)
+	assert.Equal(t, "2", restarted.retrieveTrackingValue(context.Background()))
+}
+
+func TestLogsQueryReceiver_Dedup(t *testing.T) {
+	storageClient := storagetest.NewInMemoryClient(component.KindReceiver, component.MustNewID("sqlquery"), "")
+	query := sqlquery.Query{
+		Logs:  []sqlquery.LogsCfg{{BodyColumn: "body"}},
+		Dedup: &sqlquery.DedupCfg{Columns: []string{"id"}},
+	}
+	fakeClient := &sqlquery.FakeDBClient{
+		StringMaps: [][]sqlquery.StringMap{
+			{{"body": "first", "id": "1"}, {"body": "second", "id": "2"}},
+			{{"body": "first", "id": "1"}, {"body": "second", "id": "2"}, {"body": "third", "id": "3"}},
+			{{"body": "fourth"}},
+			{{"body": "third", "id": "3"}, {"body": "fifth", "id": "5"}},
+		},
+	}
+	queryReceiver := newLogsQueryReceiver("query-0", query, nil, nil, zap.NewNop(), sqlquery.TelemetryConfig{}, storageClient, false, nil)
+	queryReceiver.client = fakeClient
+
+	bodies := func(logs plog.Logs) []string {
+		var bodies []string
+		logRecords := logs.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+		for i := 0; i < logRecords.Len(); i++ {
+			bodies = append(bodies, logRecords.At(i).Body().Str())
+		}
+		return bodies
+	}
+
+	logs, err := queryReceiver.collect(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"first", "second"}, bodies(logs))
+
+	logs, err = queryReceiver.collect(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"third"}, bodies(logs))
+
+	// the rows missing a column of the de-duplication are emitted
+	logs, err = queryReceiver.collect(context.Background())
+	assert.EqualError(t, err, "row 0: dedup: column 'id' not found in result set")
+	assert.Equal(t, []string{"fourth"}, bodies(logs))
+
+	// the emitted rows are read back from the storage after a restart
+	restarted := newLogsQueryReceiver("query-0", query, nil, nil, zap.NewNop(), sqlquery.TelemetryConfig{}, storageClient, false, nil)
+	restarted.client = fakeClient
+	restarted.retrieveDedupState(context.Background())
+	logs, err = restarted.collect(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"fifth"}, bodies(logs))
+}
+
+func TestLogsQueryReceiver_MaxBodyBytes(t *testing.T) {
+	large := strings.Repeat("é", 8)
+	fakeClient := &sqlquery.FakeDBClient{
+		StringMaps: [][]sqlquery.StringMap{
+			{{"body": "short", "id": "1"}, {"body": large, "id": "2"}},
+		},
+	}
+	queryReceiver := logsQueryReceiver{
+		client: fakeClient,
+		logger: zap.NewNop(),
+		query: sqlquery.Query{
+			TrackingColumn: "id",
+			Logs: []sqlquery.LogsCfg{
+				{BodyColumn: "body", MaxBodyBytes: 5},
+				{BodyColumn: "body", MaxBodyBytes: 5, MaxBodyAction: sqlquery.MaxBodyActionFlag},
+				{BodyColumn: "body", MaxBodyBytes: 5, MaxBodyAction: sqlquery.MaxBodyActionDrop},
+			},
+		},
+	}
+	logs, err := queryReceiver.collect(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 5, logs.LogRecordCount())
+	// the tracking value is stored even when the log record is dropped
+	assert.Equal(t, "2", queryReceiver.trackingValue)
+
+	scopeLogs := logs.ResourceLogs().At(0).ScopeLogs()
+	require.Equal(t, 3, scopeLogs.Len())
+	logRecords := scopeLogs.At(0).LogRecords()
+	assert.Equal(t, "short", logRecords.At(0).Body().Str())
+	assert.Equal(t, 0, logRecords.At(0).Attributes().Len())
+	// the body is truncated without splitting a character
+	assert.Equal(t, "éé", logRecords.At(1).Body().Str())
+	assert.Equal(t, map[string]any{
+		bodyOriginalSizeAttribute: int64(16),
+		bodyTruncatedAttribute:    true,
+	}, logRecords.At(1).Attributes().AsRaw())
+	logRecords = scopeLogs.At(1).LogRecords()
+	assert.Equal(t, "short", logRecords.At(0).Body().Str())
+	assert.Equal(t, large, logRecords.At(1).Body().Str())
+	assert.Equal(t, map[string]any{
+		bodyOriginalSizeAttribute: int64(16),
+		bodyOversizedAttribute:    true,
+	}, logRecords.At(1).Attributes().AsRaw())
+	logRecords = scopeLogs.At(2).LogRecords()
+	require.Equal(t, 1, logRecords.Len())
+	assert.Equal(t, "short", logRecords.At(0).Body().Str())
+}
+
+func TestLogsQueryReceiver_SplitBody(t *testing.T) {
+	fakeClient := &sqlquery.FakeDBClient{
+		StringMaps: [][]sqlquery.StringMap{
+			{{"body": "abcdéfghij", "id": "1", "level": "info"}, {"body": "short", "id": "2", "level": "warn"}},
+		},
+	}
+	queryReceiver := logsQueryReceiver{
+		client: fakeClient,
+		logger: zap.NewNop(),
+		query: sqlquery.Query{
+			Logs: []sqlquery.LogsCfg{
+				{BodyColumn: "body", MaxBodyBytes: 5, MaxBodyAction: sqlquery.MaxBodyActionSplit, AttributeColumns: []string{"id"}},
+			},
+		},
+	}
+	logs, err := queryReceiver.collect(context.Background())
+	assert.NoError(t, err)
+	require.Equal(t, 4, logs.LogRecordCount())
+
+	logRecords := logs.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+	// the parts do not split a character, and keep the attributes of the row
+	for i, part := range []string{"abcd", "éfgh", "ij"} {
+		assert.Equal(t, part, logRecords.At(i).Body().Str())
+		assert.Equal(t, map[string]any{
+			"id":                      "1",
+			bodyOriginalSizeAttribute: int64(11),
+			bodyPartAttribute:         int64(i),
+			bodyPartCountAttribute:    int64(3),
+		}, logRecords.At(i).Attributes().AsRaw())
+		assert.NotZero(t, logRecords.At(i).ObservedTimestamp())
+	}
+	assert.Equal(t, "short", logRecords.At(3).Body().Str())
+	assert.Equal(t, map[string]any{"id": "2"}, logRecords.At(3).Attributes().AsRaw())
+}
+
+func TestLimitBody_SplitLargeCharacter(t *testing.T) {
+	body, keep := limitBody("ééa", sqlquery.LogsCfg{MaxBodyBytes: 1, MaxBodyAction: sqlquery.MaxBodyActionSplit})
+	require.True(t, keep)
+	// the characters larger than max_body_bytes are not split
+	assert.Equal(t, []string{"é", "é", "a"}, body.parts)
+}
+
+func TestLogsQueryReceiver_CompressBody(t *testing.T) {
+	large := strings.Repeat("a", 1000)
+	fakeClient := &sqlquery.FakeDBClient{
+		StringMaps: [][]sqlquery.StringMap{
+			{{"body": large}, {"body": "small"}},
+		},
+	}
+	queryReceiver := logsQueryReceiver{
+		client: fakeClient,
+		logger: zap.NewNop(),
+		query: sqlquery.Query{
+			Logs: []sqlquery.LogsCfg{
+				{BodyColumn: "body", MaxBodyBytes: 100, CompressBody: true},
+			},
+		},
+	}
+	logs, err := queryReceiver.collect(context.Background())
+	assert.NoError(t, err)
+	require.Equal(t, 2, logs.LogRecordCount())
+
+	logRecords := logs.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+	assert.Equal(t, map[string]any{
+		bodyOriginalSizeAttribute: int64(1000),
+		bodyEncodingAttribute:     bodyEncodingGzip,
+	}, logRecords.At(0).Attributes().AsRaw())
+	r, err := gzip.NewReader(bytes.NewReader(logRecords.At(0).Body().Bytes().AsRaw()))
+	require.NoError(t, err)
+	body, err := io.ReadAll(r)
+	require.NoError(t, err)
+	assert.Equal(t, large, string(body))
+	assert.Equal(t, "small", logRecords.At(1).Body().Str())
+}
+
+func TestLogsReceiver_ReplicaLag(t *testing.T) {
+	sink := new(consumertest.LogsSink)
+	config := &Config{Config: sqlquery.Config{
+		ReplicaLag: sqlquery.ReplicaLagCfg{SQL: "select lag", MaxLag: 30 * time.Second},
+	}}
+	receiver, err := newLogsReceiver(config, receivertest.NewNopCreateSettings(), nil, nil, sink)
+	require.NoError(t, err)
+	receiver.replicaLagChecker.Client = &sqlquery.FakeDBClient{
+		StringMaps: [][]sqlquery.StringMap{{{"lag": "45"}}, {{"lag": "1"}}},
+	}
+	incremental := &logsQueryReceiver{
+		client: &sqlquery.FakeDBClient{StringMaps: [][]sqlquery.StringMap{{{"id": "1", "body": "incremental"}}}},
+		logger: zap.NewNop(),
+		query: sqlquery.Query{
+			TrackingColumn: "id",
+			Logs:           []sqlquery.LogsCfg{{BodyColumn: "body"}},
+		},
+	}
+	full := &logsQueryReceiver{
+		client: &sqlquery.FakeDBClient{StringMaps: [][]sqlquery.StringMap{{{"body": "full"}}, {{"body": "full"}}}},
+		logger: zap.NewNop(),
+		query: sqlquery.Query{
+			Logs: []sqlquery.LogsCfg{{BodyColumn: "body"}},
+		},
+	}
+	receiver.queryReceivers = []*logsQueryReceiver{incremental, full}
+
+	// the incremental query is skipped while the replica lags behind
+	receiver.collect()
+	require.Len(t, sink.AllLogs(), 1)
+	assert.Equal(t, 1, sink.AllLogs()[0].LogRecordCount())
+	assert.Equal(t, "full", sink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Body().Str())
+	assert.Equal(t, "", incremental.trackingValue)
+
+	receiver.collect()
+	require.Len(t, sink.AllLogs(), 2)
+	assert.Equal(t, 2, sink.AllLogs()[1].LogRecordCount())
+	assert.Equal(t, "1", incremental.trackingValue)
+}
+
+func TestLogsQueryReceiver_SeverityColumn(t *testing.T) {
+	fakeClient := &sqlquery.FakeDBClient{
+		StringMaps: [][]sqlquery.StringMap{
+			{
+				{"body": "denied", "level": "W"},
+				{"body": "login", "level": "info"},
+				{"body": "unknown", "level": "verbose"},
+				{"body": "missing"},
+			},
+		},
+	}
+	queryReceiver := logsQueryReceiver{
+		client: fakeClient,
+		query: sqlquery.Query{
+			Logs: []sqlquery.LogsCfg{
+				{
+					BodyColumn:      "body",
+					SeverityColumn:  "level",
+					SeverityMapping: map[string]string{"W": "warn"},
+				},
+			},
+		},
+	}
+	logs, err := queryReceiver.collect(context.Background())
+	assert.EqualError(t, err, "severity_column: column 'level' not found in result set")
+	require.Equal(t, 4, logs.LogRecordCount())
+
+	logRecords := logs.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+	assert.Equal(t, plog.SeverityNumberWarn, logRecords.At(0).SeverityNumber())
+	assert.Equal(t, "W", logRecords.At(0).SeverityText())
+	assert.Equal(t, plog.SeverityNumberInfo, logRecords.At(1).SeverityNumber())
+	assert.Equal(t, "info", logRecords.At(1).SeverityText())
+	assert.Equal(t, plog.SeverityNumberUnspecified, logRecords.At(2).SeverityNumber())
+	assert.Equal(t, "verbose", logRecords.At(2).SeverityText())
+	assert.Equal(t, plog.SeverityNumberUnspecified, logRecords.At(3).SeverityNumber())
+	assert.Equal(t, "", logRecords.At(3).SeverityText())
+}
+
+func TestLogsQueryReceiver_TraceColumns(t *testing.T) {
+	fakeClient := &sqlquery.FakeDBClient{
+		StringMaps: [][]sqlquery.StringMap{
+			{
+				{"body": "hex", "trace_id": "4bf92f3577b34da6a3ce929d0e0e4736", "span_id": "00f067aa0ba902b7"},
+				{"body": "traceparent", "trace_id": "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01", "span_id": ""},
+				{"body": "uncorrelated", "trace_id": "", "span_id": ""},
+				{"body": "invalid", "trace_id": "abc", "span_id": ""},
+			},
+		},
+	}
+	queryReceiver := logsQueryReceiver{
+		client: fakeClient,
+		query: sqlquery.Query{
+			Logs: []sqlquery.LogsCfg{
+				{
+					BodyColumn:    "body",
+					TraceIDColumn: "trace_id",
+					SpanIDColumn:  "span_id",
+				},
+			},
+		},
+	}
+	logs, err := queryReceiver.collect(context.Background())
+	assert.EqualError(t, err, "trace_id_column: failed to parse the value 'abc' of column 'trace_id': expected 32 hex characters, got 3")
+	require.Equal(t, 4, logs.LogRecordCount())
+
+	logRecords := logs.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+	for i := 0; i < 2; i++ {
+		assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", logRecords.At(i).TraceID().String())
+		assert.Equal(t, "00f067aa0ba902b7", logRecords.At(i).SpanID().String())
+	}
+	assert.Equal(t, plog.LogRecordFlags(0), logRecords.At(0).Flags())
+	assert.True(t, logRecords.At(1).Flags().IsSampled())
+	for i := 2; i < 4; i++ {
+		assert.True(t, logRecords.At(i).TraceID().IsEmpty())
+		assert.True(t, logRecords.At(i).SpanID().IsEmpty())
+	}
+}
+
+func TestLogsQueryReceiver_ResourceAttributeColumns(t *testing.T) {
+	fakeClient := &sqlquery.FakeDBClient{
+		StringMaps: [][]sqlquery.StringMap{
+			{
+				{"body": "first", "tenant_id": "a", "id": "1"},
+				{"body": "second", "tenant_id": "b", "id": "2"},
+				{"body": "third", "tenant_id": "a", "id": "3"},
+			},
+		},
+	}
+	queryReceiver := logsQueryReceiver{
+		client: fakeClient,
+		query: sqlquery.Query{
+			Logs: []sqlquery.LogsCfg{
+				{BodyColumn: "body"},
+			},
+			TrackingColumn:           "id",
+			ResourceAttributeColumns: []string{"tenant_id"},
+		},
+	}
+	logs, err := queryReceiver.collect(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 2, logs.ResourceLogs().Len())
+
+	resourceLogs := logs.ResourceLogs().At(0)
+	assert.Equal(t, map[string]any{"tenant_id": "a"}, resourceLogs.Resource().Attributes().AsRaw())
+	logRecords := resourceLogs.ScopeLogs().At(0).LogRecords()
+	require.Equal(t, 2, logRecords.Len())
+	assert.Equal(t, "first", logRecords.At(0).Body().Str())
+	assert.Equal(t, "third", logRecords.At(1).Body().Str())
+
+	resourceLogs = logs.ResourceLogs().At(1)
+	assert.Equal(t, map[string]any{"tenant_id": "b"}, resourceLogs.Resource().Attributes().AsRaw())
+	logRecords = resourceLogs.ScopeLogs().At(0).LogRecords()
+	require.Equal(t, 1, logRecords.Len())
+	assert.Equal(t, "second", logRecords.At(0).Body().Str())
+
+	// the last row of the result set is tracked, not the last row of the resources
+	assert.Equal(t, "3", queryReceiver.trackingValue)
+}
+
+func TestLogsQueryReceiver_BodyTemplate(t *testing.T) {
+	fakeClient := &sqlquery.FakeDBClient{
+		StringMaps: [][]sqlquery.StringMap{
+			{
+				{"user": "alice", "action": "DELETE", "object": "orders"},
+				{"user": "bob", "action": "UPDATE"},
+			},
+		},
+	}
+	queryReceiver := logsQueryReceiver{
+		client: fakeClient,
+		query: sqlquery.Query{
+			Logs: []sqlquery.LogsCfg{
+				{BodyTemplate: "${user} did ${action} on ${object}"},
+			},
+		},
+	}
+	logs, err := queryReceiver.collect(context.Background())
+	assert.EqualError(t, err, "body_template: column 'object' not found in result set")
+	logRecords := logs.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+	require.Equal(t, 2, logRecords.Len())
+	assert.Equal(t, "alice did DELETE on orders", logRecords.At(0).Body().Str())
+	assert.Equal(t, "bob did UPDATE on ", logRecords.At(1).Body().Str())
+}
+
+func TestLogsQueryReceiver_Scope(t *testing.T) {
+	fakeClient := &sqlquery.FakeDBClient{
+		StringMaps: [][]sqlquery.StringMap{
+			{
+				{"body": "first", "severity": "WARN"},
+			},
+		},
+	}
+	queryReceiver := logsQueryReceiver{
+		client: fakeClient,
+		query: sqlquery.Query{
+			Logs: []sqlquery.LogsCfg{
+				{BodyColumn: "body"},
+				{BodyColumn: "severity"},
+			},
+			Scope: sqlquery.ScopeCfg{Name: "audit", Version: "1.2", Attributes: map[string]string{"team": "security"}},
+		},
+	}
+	logs, err := queryReceiver.collect(context.Background())
+	require.NoError(t, err)
+	scopeLogs := logs.ResourceLogs().At(0).ScopeLogs()
+	require.Equal(t, 2, scopeLogs.Len())
+	for i := 0; i < scopeLogs.Len(); i++ {
+		scope := scopeLogs.At(i).Scope()
+		assert.Equal(t, "audit", scope.Name())
+		assert.Equal(t, "1.2", scope.Version())
+		assert.Equal(t, map[string]any{"team": "security"}, scope.Attributes().AsRaw())
+	}
+}
+
+func TestLogsQueryReceiver_EventName(t *testing.T) {
+	fakeClient := &sqlquery.FakeDBClient{
+		StringMaps: [][]sqlquery.StringMap{
+			{{"body": "user logged in"}},
+		},
+	}
+	queryReceiver := logsQueryReceiver{
+		client: fakeClient,
+		query: sqlquery.Query{
+			Logs: []sqlquery.LogsCfg{
+				{
+					BodyColumn: "body",
+					EventName:  "audit.login",
+					SchemaURL:  "https://opentelemetry.io/schemas/1.25.0",
+				},
+				{
+					BodyColumn: "body",
+				},
+			},
+		},
+	}
+	logs, err := queryReceiver.collect(context.Background())
+	require.NoError(t, err)
+
+	scopeLogs := logs.ResourceLogs().At(0).ScopeLogs()
+	require.Equal(t, 2, scopeLogs.Len())
+	assert.Equal(t, "https://opentelemetry.io/schemas/1.25.0", scopeLogs.At(0).SchemaUrl())
+	assert.Equal(t, map[string]any{"event.name": "audit.login"}, scopeLogs.At(0).LogRecords().At(0).Attributes().AsRaw())
+	assert.Equal(t, "", scopeLogs.At(1).SchemaUrl())
+	assert.Equal(t, 0, scopeLogs.At(1).LogRecords().At(0).Attributes().Len())
+}
+
+func TestLogsQueryReceiver_TimestampColumn(t *testing.T) {
+	fakeClient := &sqlquery.FakeDBClient{
+		StringMaps: [][]sqlquery.StringMap{
+			{
+				{"body": "first", "created_at": "1715941815"},
+				{"body": "second", "created_at": "never"},
+			},
+		},
+	}
+	queryReceiver := logsQueryReceiver{
+		client: fakeClient,
+		query: sqlquery.Query{
+			Logs: []sqlquery.LogsCfg{
+				{
+					BodyColumn:      "body",
+					TimestampColumn: "created_at",
+					TimestampFormat: sqlquery.TimestampFormatEpochSeconds,
+				},
+			},
+		},
+	}
+	logs, err := queryReceiver.collect(context.Background())
+	assert.ErrorContains(t, err, "timestamp_column: failed to parse the value 'never' of column 'created_at'")
+	require.Equal(t, 2, logs.LogRecordCount())
+
+	logRecords := logs.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+	assert.Equal(t, pcommon.Timestamp(1715941815*time.Second), logRecords.At(0).Timestamp())
+	assert.NotZero(t, logRecords.At(0).ObservedTimestamp())
+	assert.Zero(t, logRecords.At(1).Timestamp())
+}
+
+func TestLogsQueryReceiver_Table(t *testing.T) {
+	table := sqlquery.TableCfg{Name: "audit", KeyColumn: "id", TimestampColumn: "updated_at", DetectDeletions: true}
+	queryReceiver := logsQueryReceiver{
+		client: &sqlquery.FakeDBClient{StringMaps: [][]sqlquery.StringMap{
+			{
+				{"id": "1", "updated_at": "2024-05-17T10:00:00Z", "action": "login", "success": "true", "zip": "01234"},
+				{"id": "2", "updated_at": "2024-05-17T10:01:00Z", "action": "logout", "success": "false", "zip": "1.5"},
+			},
+			{},
+		}},
+		keysClient: &sqlquery.FakeDBClient{StringMaps: [][]sqlquery.StringMap{
+			{{"id": "1"}, {"id": "2"}},
+			{{"id": "2"}},
+		}},
+		query: table.Query("postgres", sqlquery.Query{Table: &table}),
+	}
+	queryReceiver.trackingValue = queryReceiver.query.TrackingStartValue
+
+	logs, err := queryReceiver.collect(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 2, logs.LogRecordCount())
+	logRecord := logs.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	assert.Equal(t, "1", logRecord.Body().Str())
+	assert.Equal(t, map[string]any{
+		"id":         int64(1),
+		"updated_at": "2024-05-17T10:00:00Z",
+		"action":     "login",
+		"success":    true,
+		"zip":        "01234",
+	}, logRecord.Attributes().AsRaw())
+	assert.Equal(t, pcommon.NewTimestampFromTime(time.Date(2024, 5, 17, 10, 0, 0, 0, time.UTC)), logRecord.Timestamp())
+	logRecord = logs.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(1)
+	assert.Equal(t, 1.5, logRecord.Attributes().AsRaw()["zip"])
+	assert.Equal(t, "2024-05-17T10:01:00Z", queryReceiver.trackingValue)
+
+	// the row 1 was deleted
+	logs, err = queryReceiver.collect(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 1, logs.LogRecordCount())
+	logRecord = logs.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	assert.Equal(t, "1", logRecord.Body().Str())
+	assert.Equal(t, map[string]any{"id": int64(1), deletedAttribute: true}, logRecord.Attributes().AsRaw())
+	assert.NotZero(t, logRecord.ObservedTimestamp())
+}
+
+func TestLogsQueryReceiver_AttributeColumns(t *testing.T) {
+	fakeClient := &sqlquery.FakeDBClient{
+		StringMaps: [][]sqlquery.StringMap{
+			{
+				{"body": "login", "user": "alice", "host": "db1", "ignored": "x"},
+				{"body": "logout", "user": "bob"},
+			},
+		},
+	}
+	queryReceiver := logsQueryReceiver{
+		client: fakeClient,
+		query: sqlquery.Query{
+			Logs: []sqlquery.LogsCfg{
+				{
+					BodyColumn:       "body",
+					AttributeColumns: []string{"user", "host"},
+				},
+			},
+		},
+	}
+	logs, err := queryReceiver.collect(context.Background())
+	assert.EqualError(t, err, "attribute_columns: column 'host' not found in result set")
+	require.Equal(t, 2, logs.LogRecordCount())
+
+	logRecords := logs.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+	assert.Equal(t, "login", logRecords.At(0).Body().Str())
+	assert.Equal(t, map[string]any{"user": "alice", "host": "db1"}, logRecords.At(0).Attributes().AsRaw())
+	assert.Equal(t, map[string]any{"user": "bob"}, logRecords.At(1).Attributes().AsRaw())
+}
+
+func TestLogsQueryReceiver_MapBody(t *testing.T) {
+	fakeClient := &sqlquery.FakeDBClient{
+		StringMaps: [][]sqlquery.StringMap{
+			{
+				{"id": "1", "message": "login", "duration": "0.25", "success": "true", "code": "007"},
+			},
+		},
+	}
+	queryReceiver := logsQueryReceiver{
+		client: fakeClient,
+		query: sqlquery.Query{
+			Logs: []sqlquery.LogsCfg{
+				{
+					BodyType:         sqlquery.BodyTypeMap,
+					AttributeColumns: []string{"id"},
+				},
+			},
+		},
+	}
+	logs, err := queryReceiver.collect(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 1, logs.LogRecordCount())
+
+	logRecord := logs.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	assert.Equal(t, pcommon.ValueTypeMap, logRecord.Body().Type())
+	assert.Equal(t, map[string]any{
+		"id":       int64(1),
+		"message":  "login",
+		"duration": 0.25,
+		"success":  true,
+		"code":     "007",
+	}, logRecord.Body().Map().AsRaw())
+	assert.Equal(t, map[string]any{"id": "1"}, logRecord.Attributes().AsRaw())
+}
+
+func TestLogsQueryReceiver_ParseBodyAsJSON(t *testing.T) {
+	fakeClient := &sqlquery.FakeDBClient{
+		StringMaps: [][]sqlquery.StringMap{
+			{
+				{"body": `{"user":"alice","attempts":3,"latency":0.5,"admin":false,"tags":["a","b"],"extra":null}`},
+				{"body": `[1,{"id":2}]`},
+				{"body": "plain text"},
+				{"body": `"scalar"`},
+			},
+		},
+	}
+	queryReceiver := logsQueryReceiver{
+		client: fakeClient,
+		query: sqlquery.Query{
+			Logs: []sqlquery.LogsCfg{
+				{
+					BodyColumn:  "body",
+					ParseBodyAs: sqlquery.BodyParserJSON,
+				},
+			},
+		},
+	}
+	logs, err := queryReceiver.collect(context.Background())
+	assert.EqualError(t, err, "parse_body_as: failed to parse the body of 2 log records, keeping them as strings: invalid character 'p' looking for beginning of value")
+	require.Equal(t, 4, logs.LogRecordCount())
+
+	logRecords := logs.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+	assert.Equal(t, map[string]any{
+		"user":     "alice",
+		"attempts": int64(3),
+		"latency":  0.5,
+		"admin":    false,
+		"tags":     []any{"a", "b"},
+		"extra":    nil,
+	}, logRecords.At(0).Body().Map().AsRaw())
+	assert.Equal(t, 0, logRecords.At(0).Attributes().Len())
+	assert.Equal(t, []any{int64(1), map[string]any{"id": int64(2)}}, logRecords.At(1).Body().Slice().AsRaw())
+
+	for i := 2; i < 4; i++ {
+		assert.Equal(t, pcommon.ValueTypeStr, logRecords.At(i).Body().Type())
+		assert.Equal(t, map[string]any{"sqlquery.body.parse_failed": true}, logRecords.At(i).Attributes().AsRaw())
+	}
+	assert.Equal(t, `"scalar"`, logRecords.At(3).Body().Str())
+}
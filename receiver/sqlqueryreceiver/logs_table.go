@@ -0,0 +1,72 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlqueryreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/sqlqueryreceiver"
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/sqlquery"
+)
+
+// deletedAttribute marks the tombstone log records of the rows deleted from a table.
+const deletedAttribute = "sqlquery.deleted"
+
+// putTypedAttributes puts the columns of the row into the map, the values being typed when
+// they are the canonical representation of a number or a boolean.
+func putTypedAttributes(row sqlquery.StringMap, attributes pcommon.Map) {
+	for column, value := range row {
+		putTypedAttribute(attributes, column, value)
+	}
+}
+
+func putTypedAttribute(attributes pcommon.Map, key, value string) {
+	if i, err := strconv.ParseInt(value, 10, 64); err == nil && strconv.FormatInt(i, 10) == value {
+		attributes.PutInt(key, i)
+		return
+	}
+	if f, err := strconv.ParseFloat(value, 64); err == nil && strconv.FormatFloat(f, 'f', -1, 64) == value {
+		attributes.PutDouble(key, f)
+		return
+	}
+	if b, err := strconv.ParseBool(value); err == nil && strconv.FormatBool(b) == value {
+		attributes.PutBool(key, b)
+		return
+	}
+	attributes.PutStr(key, value)
+}
+
+// collectDeletions reads all the keys of the table, and appends a tombstone log record for
+// each key read on the previous collection interval which is now missing. The keys read on
+// the first collection interval are the baseline.
+func (queryReceiver *logsQueryReceiver) collectDeletions(ctx context.Context, logRecords plog.LogRecordSlice, observedAt pcommon.Timestamp) error {
+	rows, err := queryReceiver.keysClient.QueryRows(ctx)
+	if err != nil {
+		return fmt.Errorf("error getting the keys of the table: %w", err)
+	}
+	keyColumn := queryReceiver.query.Table.KeyColumn
+	keys := make(map[string]struct{}, len(rows))
+	for _, row := range rows {
+		keys[row[keyColumn]] = struct{}{}
+	}
+
+	if queryReceiver.knownKeys != nil {
+		for key := range queryReceiver.knownKeys {
+			if _, found := keys[key]; found {
+				continue
+			}
+			logRecord := logRecords.AppendEmpty()
+			logRecord.Body().SetStr(key)
+			putTypedAttribute(logRecord.Attributes(), keyColumn, key)
+			logRecord.Attributes().PutBool(deletedAttribute, true)
+			logRecord.SetObservedTimestamp(observedAt)
+		}
+	}
+	queryReceiver.knownKeys = keys
+	return nil
+}
@@ -0,0 +1,73 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlqueryreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/sqlqueryreceiver"
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/timeutils"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/sqlquery"
+)
+
+// parseTimestamp parses the value of a timestamp column. The time columns are formatted
+// as RFC 3339 by the client, which is the default format.
+func parseTimestamp(value, format string) (pcommon.Timestamp, error) {
+	value = strings.TrimSpace(value)
+	switch format {
+	case "":
+		t, err := time.Parse(time.RFC3339Nano, value)
+		if err != nil {
+			return 0, err
+		}
+		return pcommon.NewTimestampFromTime(t), nil
+	case sqlquery.TimestampFormatEpochSeconds:
+		return parseEpoch(value, time.Second)
+	case sqlquery.TimestampFormatEpochMillis:
+		return parseEpoch(value, time.Millisecond)
+	case sqlquery.TimestampFormatEpochMicros:
+		return parseEpoch(value, time.Microsecond)
+	case sqlquery.TimestampFormatEpochNanos:
+		return parseEpoch(value, time.Nanosecond)
+	}
+
+	var t time.Time
+	var err error
+	if strings.Contains(format, "%") {
+		t, err = timeutils.ParseStrptime(format, value, time.UTC)
+	} else {
+		t, err = timeutils.ParseGotime(format, value, time.UTC)
+	}
+	if err != nil {
+		return 0, err
+	}
+	return pcommon.NewTimestampFromTime(t), nil
+}
+
+// parseEpoch parses a time since the Unix epoch in the unit, which may be fractional, e.g.
+// the result of `extract(epoch from ...)`.
+func parseEpoch(value string, unit time.Duration) (pcommon.Timestamp, error) {
+	integer, fraction, _ := strings.Cut(value, ".")
+	i, err := strconv.ParseInt(integer, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	timestamp := i * int64(unit)
+	if fraction != "" {
+		// keep the digits of the fraction which are smaller than a nanosecond out
+		digits := len(strconv.FormatInt(int64(unit), 10)) - 1
+		if len(fraction) > digits {
+			fraction = fraction[:digits]
+		}
+		f, err := strconv.ParseInt(fraction+strings.Repeat("0", digits-len(fraction)), 10, 64)
+		if err != nil {
+			return 0, err
+		}
+		timestamp += f
+	}
+	return pcommon.Timestamp(timestamp), nil
+}
@@ -0,0 +1,46 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlqueryreceiver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+func TestParseTimestamp(t *testing.T) {
+	want := pcommon.NewTimestampFromTime(time.Date(2024, 5, 17, 10, 30, 15, 250000000, time.UTC))
+	tests := []struct {
+		value  string
+		format string
+	}{
+		{value: "2024-05-17T10:30:15.25Z", format: ""},
+		{value: "2024-05-17T12:30:15.25+02:00", format: ""},
+		{value: "1715941815.25", format: "epoch_seconds"},
+		{value: "1715941815250", format: "epoch_millis"},
+		{value: "1715941815250000", format: "epoch_micros"},
+		{value: "1715941815250000000", format: "epoch_nanos"},
+		{value: "2024-05-17 10:30:15.250", format: "%Y-%m-%d %H:%M:%S.%L"},
+		{value: "17/05/2024 10:30:15.25", format: "02/01/2006 15:04:05.99"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			timestamp, err := parseTimestamp(tt.value, tt.format)
+			require.NoError(t, err)
+			assert.Equal(t, want, timestamp)
+		})
+	}
+}
+
+func TestParseTimestamp_Errors(t *testing.T) {
+	_, err := parseTimestamp("yesterday", "")
+	assert.Error(t, err)
+	_, err = parseTimestamp("1715941815.x", "epoch_seconds")
+	assert.Error(t, err)
+	_, err = parseTimestamp("2024-05-17", "%Y/%m/%d")
+	assert.Error(t, err)
+}
@@ -0,0 +1,76 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlqueryreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/sqlqueryreceiver"
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/plog"
+)
+
+// setTraceID sets the trace ID of the log record from the value of the trace ID column: a hex
+// trace ID, or a W3C traceparent, e.g. 00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01,
+// which also sets the span ID and the flags. A NULL value leaves the trace ID empty.
+func setTraceID(logRecord plog.LogRecord, value string) error {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil
+	}
+	if strings.Count(value, "-") != 3 {
+		var traceID [16]byte
+		if err := decodeID(traceID[:], value); err != nil {
+			return err
+		}
+		logRecord.SetTraceID(traceID)
+		return nil
+	}
+
+	// version-traceid-parentid-flags
+	fields := strings.Split(value, "-")
+	if len(fields[0]) != 2 || fields[0] == "ff" {
+		return fmt.Errorf("invalid traceparent version '%s'", fields[0])
+	}
+	var traceID [16]byte
+	if err := decodeID(traceID[:], fields[1]); err != nil {
+		return err
+	}
+	var spanID [8]byte
+	if err := decodeID(spanID[:], fields[2]); err != nil {
+		return err
+	}
+	var flags [1]byte
+	if err := decodeID(flags[:], fields[3]); err != nil {
+		return err
+	}
+	logRecord.SetTraceID(traceID)
+	logRecord.SetSpanID(spanID)
+	logRecord.SetFlags(plog.LogRecordFlags(flags[0]))
+	return nil
+}
+
+// setSpanID sets the span ID of the log record from the hex value of the span ID column.
+// A NULL value leaves the span ID unchanged.
+func setSpanID(logRecord plog.LogRecord, value string) error {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil
+	}
+	var spanID [8]byte
+	if err := decodeID(spanID[:], value); err != nil {
+		return err
+	}
+	logRecord.SetSpanID(spanID)
+	return nil
+}
+
+// decodeID decodes the hex value of an ID of exactly len(id) bytes.
+func decodeID(id []byte, value string) error {
+	if len(value) != hex.EncodedLen(len(id)) {
+		return fmt.Errorf("expected %d hex characters, got %d", hex.EncodedLen(len(id)), len(value))
+	}
+	_, err := hex.Decode(id, []byte(value))
+	return err
+}
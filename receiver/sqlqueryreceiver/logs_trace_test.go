@@ -0,0 +1,65 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlqueryreceiver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/plog"
+)
+
+func TestSetTraceID(t *testing.T) {
+	tests := []struct {
+		value   string
+		traceID string
+		spanID  string
+		flags   plog.LogRecordFlags
+		wantErr string
+	}{
+		{value: "4bf92f3577b34da6a3ce929d0e0e4736", traceID: "4bf92f3577b34da6a3ce929d0e0e4736"},
+		{value: "4BF92F3577B34DA6A3CE929D0E0E4736", traceID: "4bf92f3577b34da6a3ce929d0e0e4736"},
+		{value: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01", traceID: "4bf92f3577b34da6a3ce929d0e0e4736", spanID: "00f067aa0ba902b7", flags: 1},
+		{value: ""},
+		{value: "4bf92f3577b34da6", wantErr: "expected 32 hex characters, got 16"},
+		{value: "4bf92f3577b34da6a3ce929d0e0e473g", wantErr: "encoding/hex: invalid byte: U+0067 'g'"},
+		{value: "ff-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01", wantErr: "invalid traceparent version 'ff'"},
+		{value: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa-01", wantErr: "expected 16 hex characters, got 8"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			logRecord := plog.NewLogRecord()
+			err := setTraceID(logRecord, tt.value)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				assert.True(t, logRecord.TraceID().IsEmpty())
+				return
+			}
+			require.NoError(t, err)
+			if tt.traceID == "" {
+				assert.True(t, logRecord.TraceID().IsEmpty())
+			} else {
+				assert.Equal(t, tt.traceID, logRecord.TraceID().String())
+			}
+			if tt.spanID == "" {
+				assert.True(t, logRecord.SpanID().IsEmpty())
+			} else {
+				assert.Equal(t, tt.spanID, logRecord.SpanID().String())
+			}
+			assert.Equal(t, tt.flags, logRecord.Flags())
+		})
+	}
+}
+
+func TestSetSpanID(t *testing.T) {
+	logRecord := plog.NewLogRecord()
+	require.NoError(t, setSpanID(logRecord, "00f067aa0ba902b7"))
+	assert.Equal(t, "00f067aa0ba902b7", logRecord.SpanID().String())
+
+	require.NoError(t, setSpanID(logRecord, ""))
+	assert.Equal(t, "00f067aa0ba902b7", logRecord.SpanID().String())
+
+	require.EqualError(t, setSpanID(logRecord, "00f067aa0ba902"), "expected 16 hex characters, got 14")
+}
@@ -10,10 +10,12 @@ import (
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
 	"go.opentelemetry.io/collector/receiver"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/sqlquery"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/sqlqueryreceiver/internal/metadata"
 )
 
 func createLogsReceiverFunc(sqlOpenerFunc sqlquery.SQLOpenerFunc, clientProviderFunc sqlquery.ClientProviderFunc) receiver.CreateLogsFunc {
@@ -28,6 +30,18 @@ func createLogsReceiverFunc(sqlOpenerFunc sqlquery.SQLOpenerFunc, clientProvider
 	}
 }
 
+func createTracesReceiverFunc(sqlOpenerFunc sqlquery.SQLOpenerFunc, clientProviderFunc sqlquery.ClientProviderFunc) receiver.CreateTracesFunc {
+	return func(
+		_ context.Context,
+		settings receiver.CreateSettings,
+		config component.Config,
+		consumer consumer.Traces,
+	) (receiver.Traces, error) {
+		sqlQueryConfig := config.(*Config)
+		return newTracesReceiver(sqlQueryConfig, settings, sqlOpenerFunc, clientProviderFunc, consumer)
+	}
+}
+
 func createMetricsReceiverFunc(sqlOpenerFunc sqlquery.SQLOpenerFunc, clientProviderFunc sqlquery.ClientProviderFunc) receiver.CreateMetricsFunc {
 	return func(
 		_ context.Context,
@@ -36,6 +50,10 @@ func createMetricsReceiverFunc(sqlOpenerFunc sqlquery.SQLOpenerFunc, clientProvi
 		consumer consumer.Metrics,
 	) (receiver.Metrics, error) {
 		sqlCfg := cfg.(*Config)
+		statementTelemetry, err := sqlquery.NewStatementTelemetry(metadata.Meter(settings.TelemetrySettings), settings.ID)
+		if err != nil {
+			return nil, err
+		}
 		var opts []scraperhelper.ScraperControllerOption
 		for i, query := range sqlCfg.Queries {
 			if len(query.Metrics) == 0 {
@@ -43,9 +61,15 @@ func createMetricsReceiverFunc(sqlOpenerFunc sqlquery.SQLOpenerFunc, clientProvi
 			}
 			id := component.MustNewIDWithName("sqlqueryreceiver", fmt.Sprintf("query-%d: %s", i, query.SQL))
 			dbProviderFunc := func() (*sql.DB, error) {
-				return sqlOpenerFunc(sqlCfg.Driver, sqlCfg.DataSource)
+				return sqlOpenerFunc(sqlCfg.Driver, sqlCfg.ConnectionString())
 			}
 			mp := sqlquery.NewScraper(id, query, sqlCfg.ControllerConfig, settings.TelemetrySettings.Logger, sqlCfg.Config.Telemetry, dbProviderFunc, clientProviderFunc)
+			mp.PreparedStatements = sqlCfg.PreparedStatements
+			mp.StatementTelemetry = statementTelemetry
+			if sqlCfg.StorageID != nil {
+				// a client per query, the clients of a storage extension cannot share a name
+				mp.StorageProviderFunc = newStorageProviderFunc(*sqlCfg.StorageID, settings.ID, fmt.Sprintf("query-%d", i))
+			}
 
 			opt := scraperhelper.AddScraper(mp)
 			opts = append(opts, opt)
@@ -58,3 +82,19 @@ func createMetricsReceiverFunc(sqlOpenerFunc sqlquery.SQLOpenerFunc, clientProvi
 		)
 	}
 }
+
+// newStorageProviderFunc provides the client of the storage extension persisting the start timestamps
+// of the metrics of a query.
+func newStorageProviderFunc(storageID component.ID, receiverID component.ID, name string) sqlquery.StorageProviderFunc {
+	return func(ctx context.Context, host component.Host) (sqlquery.StateStorage, error) {
+		ext, found := host.GetExtensions()[storageID]
+		if !found {
+			return nil, fmt.Errorf("storage extension '%s' not found", storageID)
+		}
+		storageExtension, ok := ext.(storage.Extension)
+		if !ok {
+			return nil, fmt.Errorf("non-storage extension '%s' found", storageID)
+		}
+		return storageExtension.GetClient(ctx, component.KindReceiver, receiverID, name)
+	}
+}
@@ -10,12 +10,14 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/consumer/consumertest"
 	"go.opentelemetry.io/collector/receiver/receivertest"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
 	"go.uber.org/zap"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/storagetest"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/sqlquery"
 )
 
@@ -48,6 +50,35 @@ func TestCreateLogsReceiver(t *testing.T) {
 	require.NoError(t, receiver.Shutdown(ctx))
 }
 
+func TestCreateTracesReceiver(t *testing.T) {
+	createReceiver := createTracesReceiverFunc(fakeDBConnect, mkFakeClient)
+	ctx := context.Background()
+	receiver, err := createReceiver(
+		ctx,
+		receivertest.NewNopCreateSettings(),
+		&Config{
+			Config: sqlquery.Config{
+				ControllerConfig: scraperhelper.ControllerConfig{
+					CollectionInterval: 10 * time.Second,
+				},
+				Driver:     "mydriver",
+				DataSource: "my-datasource",
+				Queries: []sqlquery.Query{{
+					SQL: "select * from foo",
+					Traces: []sqlquery.TracesCfg{
+						{},
+					},
+				}},
+			},
+		},
+		consumertest.NewNop(),
+	)
+	require.NoError(t, err)
+	err = receiver.Start(ctx, componenttest.NewNopHost())
+	require.NoError(t, err)
+	require.NoError(t, receiver.Shutdown(ctx))
+}
+
 func TestCreateMetricsReceiver(t *testing.T) {
 	createReceiver := createMetricsReceiverFunc(fakeDBConnect, mkFakeClient)
 	ctx := context.Background()
@@ -79,6 +110,24 @@ func TestCreateMetricsReceiver(t *testing.T) {
 	require.NoError(t, receiver.Shutdown(ctx))
 }
 
+func TestNewStorageProviderFunc(t *testing.T) {
+	ctx := context.Background()
+	receiverID := component.MustNewID("sqlquery")
+	host := storagetest.NewStorageHost().
+		WithInMemoryStorageExtension("storage").
+		WithNonStorageExtension("other")
+
+	client, err := newStorageProviderFunc(storagetest.NewStorageID("storage"), receiverID, "query-0")(ctx, host)
+	require.NoError(t, err)
+	require.NoError(t, client.Set(ctx, "startTimes", []byte("{}")))
+	require.NoError(t, client.Close(ctx))
+
+	_, err = newStorageProviderFunc(storagetest.NewStorageID("missing"), receiverID, "query-0")(ctx, host)
+	require.EqualError(t, err, "storage extension 'test_storage/missing' not found")
+	_, err = newStorageProviderFunc(storagetest.NewNonStorageID("other"), receiverID, "query-0")(ctx, host)
+	require.EqualError(t, err, "non-storage extension 'non_storage/other' found")
+}
+
 func fakeDBConnect(string, string) (*sql.DB, error) {
 	return nil, nil
 }
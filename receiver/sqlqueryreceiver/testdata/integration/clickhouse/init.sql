@@ -0,0 +1,13 @@
+create table otel.movie
+(
+    title       String,
+    genre       String,
+    imdb_rating Float64
+) engine = MergeTree order by title;
+
+insert into otel.movie (title, genre, imdb_rating) values
+('E.T.', 'SciFi', 7.9),
+('Blade Runner', 'SciFi', 8.1),
+('Star Wars', 'SciFi', 8.6),
+('Die Hard', 'Action', 8.2),
+('Mission Impossible', 'Action', 7.1);
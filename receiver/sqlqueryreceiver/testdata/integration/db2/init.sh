@@ -0,0 +1,4 @@
+#!/bin/bash
+# Run by the Db2 container once the instance and the database are set up.
+su - db2inst1 -c "db2 connect to otel && db2 -tvf /tmp/init.sql"
+echo "otel init done"
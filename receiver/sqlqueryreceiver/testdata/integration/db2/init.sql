@@ -0,0 +1,14 @@
+create table movie
+(
+    title       varchar(64) not null,
+    genre       varchar(16) not null,
+    imdb_rating decimal(3, 1) not null,
+    released    timestamp not null
+);
+
+insert into movie (title, genre, imdb_rating, released) values
+('E.T.', 'SciFi', 7.9, '1982-06-11-00.00.00.000000'),
+('Blade Runner', 'SciFi', 8.1, '1982-06-25-00.00.00.000000'),
+('Star Wars', 'SciFi', 8.6, '1977-05-25-00.00.00.000000'),
+('Die Hard', 'Action', 8.2, '1988-07-15-00.00.00.000000'),
+('Mission Impossible', 'Action', 7.1, '1996-05-22-00.00.00.000000');
@@ -0,0 +1,188 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlqueryreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/sqlqueryreceiver"
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/sqlquery"
+)
+
+// jsonSpanEvent is an element of the JSON array of the events column.
+type jsonSpanEvent struct {
+	Name       string          `json:"name"`
+	Timestamp  json.RawMessage `json:"timestamp"`
+	Attributes map[string]any  `json:"attributes"`
+}
+
+// jsonSpanLink is an element of the JSON array of the links column.
+type jsonSpanLink struct {
+	TraceID    string         `json:"trace_id"`
+	SpanID     string         `json:"span_id"`
+	Attributes map[string]any `json:"attributes"`
+}
+
+// appendEventsColumn appends the events of the JSON array of the events column to the span.
+// A NULL value appends no events.
+func appendEventsColumn(span ptrace.Span, value string, timestampFormat string) error {
+	if strings.TrimSpace(value) == "" {
+		return nil
+	}
+	var events []jsonSpanEvent
+	if err := json.Unmarshal([]byte(value), &events); err != nil {
+		return fmt.Errorf("invalid JSON array of events: %w", err)
+	}
+	var errs []error
+	for i, event := range events {
+		timestamp, err := parseTimestamp(jsonScalarString(event.Timestamp), timestampFormat)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("event %d: failed to parse the timestamp: %w", i, err))
+			continue
+		}
+		spanEvent := span.Events().AppendEmpty()
+		spanEvent.SetName(event.Name)
+		spanEvent.SetTimestamp(timestamp)
+		if err := spanEvent.Attributes().FromRaw(event.Attributes); err != nil {
+			errs = append(errs, fmt.Errorf("event %d: %w", i, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// appendLinksColumn appends the links of the JSON array of the links column to the span. A
+// NULL value appends no links.
+func appendLinksColumn(span ptrace.Span, value string) error {
+	if strings.TrimSpace(value) == "" {
+		return nil
+	}
+	var links []jsonSpanLink
+	if err := json.Unmarshal([]byte(value), &links); err != nil {
+		return fmt.Errorf("invalid JSON array of links: %w", err)
+	}
+	var errs []error
+	for i, link := range links {
+		var traceID [16]byte
+		if err := decodeID(traceID[:], link.TraceID); err != nil {
+			errs = append(errs, fmt.Errorf("link %d: failed to parse the trace ID '%s': %w", i, link.TraceID, err))
+			continue
+		}
+		var spanID [8]byte
+		if err := decodeID(spanID[:], link.SpanID); err != nil {
+			errs = append(errs, fmt.Errorf("link %d: failed to parse the span ID '%s': %w", i, link.SpanID, err))
+			continue
+		}
+		spanLink := span.Links().AppendEmpty()
+		spanLink.SetTraceID(traceID)
+		spanLink.SetSpanID(spanID)
+		if err := spanLink.Attributes().FromRaw(link.Attributes); err != nil {
+			errs = append(errs, fmt.Errorf("link %d: %w", i, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// jsonScalarString returns the value of a JSON string, or the JSON text of the other values,
+// e.g. the number of an epoch timestamp.
+func jsonScalarString(raw json.RawMessage) string {
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		return s
+	}
+	return string(raw)
+}
+
+// groupRowsBySpanID groups the rows of an auxiliary query by the span ID of their column, to
+// be joined to the spans.
+func groupRowsBySpanID(rows []sqlquery.StringMap, setting, column string) (map[pcommon.SpanID][]sqlquery.StringMap, error) {
+	grouped := make(map[pcommon.SpanID][]sqlquery.StringMap)
+	var errs []error
+	for _, row := range rows {
+		var spanID [8]byte
+		if err := decodeIDColumn(row, setting, column, spanID[:]); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		grouped[spanID] = append(grouped[spanID], row)
+	}
+	return grouped, errors.Join(errs...)
+}
+
+// auxiliaryRows are the rows of the auxiliary queries of a traces config, by span ID.
+type auxiliaryRows struct {
+	events map[pcommon.SpanID][]sqlquery.StringMap
+	links  map[pcommon.SpanID][]sqlquery.StringMap
+}
+
+// appendTo appends the events and the links of the rows joined to the span.
+func (rows auxiliaryRows) appendTo(span ptrace.Span, config sqlquery.TracesCfg) error {
+	var errs []error
+	if config.Events != nil {
+		errs = append(errs, appendEventRows(span, rows.events[span.SpanID()], *config.Events, config.TimestampFormat))
+	}
+	if config.Links != nil {
+		errs = append(errs, appendLinkRows(span, rows.links[span.SpanID()], *config.Links))
+	}
+	return errors.Join(errs...)
+}
+
+// appendEventRows appends an event per row of the events query to the span.
+func appendEventRows(span ptrace.Span, rows []sqlquery.StringMap, config sqlquery.SpanEventsCfg, timestampFormat string) error {
+	var errs []error
+	for _, row := range rows {
+		timestamp, err := timestampColumn(row, "events.timestamp_column", config.TimestampColumn, timestampFormat)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		event := span.Events().AppendEmpty()
+		event.SetTimestamp(timestamp)
+		if value, found := row[config.NameColumn]; found {
+			event.SetName(value)
+		} else {
+			errs = append(errs, fmt.Errorf("events.name_column: column '%s' not found in result set", config.NameColumn))
+		}
+		errs = append(errs, putAttributeColumns(row, "events.attribute_columns", config.AttributeColumns, event.Attributes()))
+	}
+	return errors.Join(errs...)
+}
+
+// appendLinkRows appends a link per row of the links query to the span.
+func appendLinkRows(span ptrace.Span, rows []sqlquery.StringMap, config sqlquery.SpanLinksCfg) error {
+	var errs []error
+	for _, row := range rows {
+		var traceID [16]byte
+		if err := decodeIDColumn(row, "links.linked_trace_id_column", config.LinkedTraceIDColumn, traceID[:]); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		var spanID [8]byte
+		if err := decodeIDColumn(row, "links.linked_span_id_column", config.LinkedSpanIDColumn, spanID[:]); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		link := span.Links().AppendEmpty()
+		link.SetTraceID(traceID)
+		link.SetSpanID(spanID)
+		errs = append(errs, putAttributeColumns(row, "links.attribute_columns", config.AttributeColumns, link.Attributes()))
+	}
+	return errors.Join(errs...)
+}
+
+func putAttributeColumns(row sqlquery.StringMap, setting string, columns []string, attributes pcommon.Map) error {
+	var errs []error
+	for _, column := range columns {
+		if value, found := row[column]; found {
+			attributes.PutStr(column, value)
+		} else {
+			errs = append(errs, fmt.Errorf("%s: column '%s' not found in result set", setting, column))
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,566 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlqueryreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/sqlqueryreceiver"
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/receiver/receiverhelper"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/sqlquery"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/adapter"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/sqlqueryreceiver/internal/metadata"
+)
+
+type tracesReceiver struct {
+	config           *Config
+	settings         receiver.CreateSettings
+	createConnection sqlquery.DbProviderFunc
+	createClient     sqlquery.ClientProviderFunc
+	queryReceivers   []*tracesQueryReceiver
+	nextConsumer     consumer.Traces
+
+	isStarted                bool
+	collectionIntervalTicker *time.Ticker
+	shutdownRequested        chan struct{}
+
+	id                 component.ID
+	storageClient      storage.Client
+	obsrecv            *receiverhelper.ObsReport
+	statementTelemetry *sqlquery.StatementTelemetry
+
+	replicaLagChecker   *sqlquery.ReplicaLagChecker
+	replicaLagDb        *sql.DB
+	replicaLagWrappedDb sqlquery.Db
+}
+
+func newTracesReceiver(
+	config *Config,
+	settings receiver.CreateSettings,
+	sqlOpenerFunc sqlquery.SQLOpenerFunc,
+	createClient sqlquery.ClientProviderFunc,
+	nextConsumer consumer.Traces,
+) (*tracesReceiver, error) {
+
+	obsr, err := receiverhelper.NewObsReport(receiverhelper.ObsReportSettings{
+		ReceiverID:             settings.ID,
+		ReceiverCreateSettings: settings,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	statementTelemetry, err := sqlquery.NewStatementTelemetry(metadata.Meter(settings.TelemetrySettings), settings.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	var replicaLagChecker *sqlquery.ReplicaLagChecker
+	if config.ReplicaLag.SQL != "" {
+		replicaLagChecker, err = sqlquery.NewReplicaLagChecker(config.ReplicaLag, metadata.Meter(settings.TelemetrySettings), settings.ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	receiver := &tracesReceiver{
+		config:   config,
+		settings: settings,
+		createConnection: func() (*sql.DB, error) {
+			return sqlOpenerFunc(config.Driver, config.ConnectionString())
+		},
+		createClient:       createClient,
+		nextConsumer:       nextConsumer,
+		shutdownRequested:  make(chan struct{}),
+		id:                 settings.ID,
+		obsrecv:            obsr,
+		statementTelemetry: statementTelemetry,
+		replicaLagChecker:  replicaLagChecker,
+	}
+
+	return receiver, nil
+}
+
+func (receiver *tracesReceiver) Start(ctx context.Context, host component.Host) error {
+	if receiver.isStarted {
+		receiver.settings.Logger.Debug("requested start, but already started, ignoring.")
+		return nil
+	}
+	receiver.settings.Logger.Debug("starting...")
+	receiver.isStarted = true
+
+	var err error
+	receiver.storageClient, err = adapter.GetStorageClient(ctx, host, receiver.config.StorageID, receiver.settings.ID)
+	if err != nil {
+		return fmt.Errorf("error connecting to storage: %w", err)
+	}
+
+	receiver.createQueryReceivers()
+	for _, queryReceiver := range receiver.queryReceivers {
+		err := queryReceiver.start(ctx)
+		if err != nil {
+			return err
+		}
+	}
+	if receiver.replicaLagChecker != nil {
+		receiver.replicaLagDb, err = receiver.createConnection()
+		if err != nil {
+			return fmt.Errorf("failed to open db connection: %w", err)
+		}
+		receiver.replicaLagWrappedDb = sqlquery.WrapDb(receiver.replicaLagDb, receiver.config.PreparedStatements, receiver.statementTelemetry)
+		receiver.replicaLagChecker.Client = receiver.createClient(receiver.replicaLagWrappedDb, receiver.config.ReplicaLag.SQL, receiver.settings.Logger, receiver.config.Telemetry)
+	}
+	receiver.startCollecting()
+	receiver.settings.Logger.Debug("started.")
+	return nil
+}
+
+func (receiver *tracesReceiver) createQueryReceivers() {
+	receiver.queryReceivers = nil
+	for i, query := range receiver.config.Queries {
+		if len(query.Traces) == 0 {
+			continue
+		}
+		id := fmt.Sprintf("query-%d: %s", i, query.SQL)
+		queryReceiver := newTracesQueryReceiver(
+			id,
+			query,
+			receiver.createConnection,
+			receiver.createClient,
+			receiver.settings.Logger,
+			receiver.config.Telemetry,
+			receiver.storageClient,
+			receiver.config.PreparedStatements,
+			receiver.statementTelemetry,
+		)
+		receiver.queryReceivers = append(receiver.queryReceivers, queryReceiver)
+	}
+}
+
+func (receiver *tracesReceiver) startCollecting() {
+	receiver.collectionIntervalTicker = time.NewTicker(receiver.config.CollectionInterval)
+
+	go func() {
+		for {
+			select {
+			case <-receiver.collectionIntervalTicker.C:
+				receiver.collect()
+			case <-receiver.shutdownRequested:
+				return
+			}
+		}
+	}()
+}
+
+func (receiver *tracesReceiver) collect() {
+	lagging := receiver.replicaLagging()
+	tracesChannel := make(chan ptrace.Traces)
+	for _, queryReceiver := range receiver.queryReceivers {
+		go func(queryReceiver *tracesQueryReceiver) {
+			if lagging && queryReceiver.query.TrackingColumn != "" {
+				// the rows the replica didn't replay yet would be skipped by the next query
+				tracesChannel <- ptrace.NewTraces()
+				return
+			}
+			traces, err := queryReceiver.collect(context.Background())
+			if err != nil {
+				receiver.settings.Logger.Error("error collecting traces", zap.Error(err), zap.String("query", queryReceiver.ID()))
+			}
+			tracesChannel <- traces
+		}(queryReceiver)
+	}
+
+	allTraces := ptrace.NewTraces()
+	for range receiver.queryReceivers {
+		traces := <-tracesChannel
+		traces.ResourceSpans().MoveAndAppendTo(allTraces.ResourceSpans())
+	}
+
+	spanCount := allTraces.SpanCount()
+	if spanCount > 0 {
+		ctx := receiver.obsrecv.StartTracesOp(context.Background())
+		err := receiver.nextConsumer.ConsumeTraces(context.Background(), allTraces)
+		receiver.obsrecv.EndTracesOp(ctx, metadata.Type.String(), spanCount, err)
+		if err != nil {
+			receiver.settings.Logger.Error("failed to send traces", zap.Error(err))
+		}
+	}
+}
+
+// replicaLagging returns whether the replica lags behind by more than the maximum lag, in
+// which case the incremental queries are skipped for this collection interval.
+func (receiver *tracesReceiver) replicaLagging() bool {
+	if receiver.replicaLagChecker == nil {
+		return false
+	}
+	lagging, err := receiver.replicaLagChecker.Check(context.Background())
+	if err != nil {
+		receiver.settings.Logger.Error("error checking the replica lag", zap.Error(err))
+		return false
+	}
+	if lagging {
+		receiver.settings.Logger.Warn("The replica lags behind, skipping the incremental queries", zap.Duration("max_lag", receiver.replicaLagChecker.MaxLag))
+	}
+	return lagging
+}
+
+func (receiver *tracesReceiver) Shutdown(ctx context.Context) error {
+	if !receiver.isStarted {
+		receiver.settings.Logger.Debug("Requested shutdown, but not started, ignoring.")
+		return nil
+	}
+
+	var errs []error
+	receiver.settings.Logger.Debug("stopping...")
+	receiver.stopCollecting()
+	for _, queryReceiver := range receiver.queryReceivers {
+		errs = append(errs, queryReceiver.shutdown(ctx))
+	}
+
+	if receiver.replicaLagDb != nil {
+		errs = append(errs, sqlquery.CloseStatements(receiver.replicaLagWrappedDb), receiver.replicaLagDb.Close())
+	}
+	if receiver.storageClient != nil {
+		errs = append(errs, receiver.storageClient.Close(ctx))
+	}
+
+	receiver.isStarted = false
+	receiver.settings.Logger.Debug("stopped.")
+
+	return errors.Join(errs...)
+}
+
+func (receiver *tracesReceiver) stopCollecting() {
+	if receiver.collectionIntervalTicker != nil {
+		receiver.collectionIntervalTicker.Stop()
+	}
+	close(receiver.shutdownRequested)
+}
+
+type tracesQueryReceiver struct {
+	id           string
+	query        sqlquery.Query
+	createDb     sqlquery.DbProviderFunc
+	createClient sqlquery.ClientProviderFunc
+	logger       *zap.Logger
+	telemetry    sqlquery.TelemetryConfig
+
+	preparedStatements bool
+	statementTelemetry *sqlquery.StatementTelemetry
+
+	db            *sql.DB
+	wrappedDb     sqlquery.Db
+	client        sqlquery.DbClient
+	trackingValue string
+	// eventsClients and linksClients run the auxiliary queries of the traces configs, by index
+	eventsClients map[int]sqlquery.DbClient
+	linksClients  map[int]sqlquery.DbClient
+	// TODO: Extract persistence into its own component
+	storageClient           storage.Client
+	trackingValueStorageKey string
+}
+
+func newTracesQueryReceiver(
+	id string,
+	query sqlquery.Query,
+	dbProviderFunc sqlquery.DbProviderFunc,
+	clientProviderFunc sqlquery.ClientProviderFunc,
+	logger *zap.Logger,
+	telemetry sqlquery.TelemetryConfig,
+	storageClient storage.Client,
+	preparedStatements bool,
+	statementTelemetry *sqlquery.StatementTelemetry,
+) *tracesQueryReceiver {
+	queryReceiver := &tracesQueryReceiver{
+		id:                 id,
+		query:              query,
+		createDb:           dbProviderFunc,
+		createClient:       clientProviderFunc,
+		logger:             logger,
+		telemetry:          telemetry,
+		storageClient:      storageClient,
+		preparedStatements: preparedStatements,
+		statementTelemetry: statementTelemetry,
+	}
+	queryReceiver.trackingValue = queryReceiver.query.TrackingStartValue
+	// the key differs from the one of the logs, a query may be tailed by both pipelines
+	queryReceiver.trackingValueStorageKey = fmt.Sprintf("%s.%s", queryReceiver.id, "tracesTrackingValue")
+	return queryReceiver
+}
+
+func (queryReceiver *tracesQueryReceiver) ID() string {
+	return queryReceiver.id
+}
+
+func (queryReceiver *tracesQueryReceiver) start(ctx context.Context) error {
+	var err error
+	queryReceiver.db, err = queryReceiver.createDb()
+	if err != nil {
+		return fmt.Errorf("failed to open db connection: %w", err)
+	}
+	queryReceiver.wrappedDb = sqlquery.WrapDb(queryReceiver.db, queryReceiver.preparedStatements, queryReceiver.statementTelemetry)
+	db := queryReceiver.wrappedDb
+	queryReceiver.client = queryReceiver.createClient(db, queryReceiver.query.SQL, queryReceiver.logger, queryReceiver.telemetry)
+	queryReceiver.eventsClients = make(map[int]sqlquery.DbClient)
+	queryReceiver.linksClients = make(map[int]sqlquery.DbClient)
+	for i, tracesConfig := range queryReceiver.query.Traces {
+		if tracesConfig.Events != nil {
+			queryReceiver.eventsClients[i] = queryReceiver.createClient(db, tracesConfig.Events.SQL, queryReceiver.logger, queryReceiver.telemetry)
+		}
+		if tracesConfig.Links != nil {
+			queryReceiver.linksClients[i] = queryReceiver.createClient(db, tracesConfig.Links.SQL, queryReceiver.logger, queryReceiver.telemetry)
+		}
+	}
+	queryReceiver.trackingValue = queryReceiver.retrieveTrackingValue(ctx)
+	return nil
+}
+
+// retrieveTrackingValue retrieves the tracking value from storage, if storage is configured.
+// Otherwise, it returns the tracking value configured in `tracking_start_value`.
+func (queryReceiver *tracesQueryReceiver) retrieveTrackingValue(ctx context.Context) string {
+	trackingValueFromConfig := queryReceiver.query.TrackingStartValue
+	if queryReceiver.storageClient == nil {
+		return trackingValueFromConfig
+	}
+
+	storedTrackingValueBytes, err := queryReceiver.storageClient.Get(ctx, queryReceiver.trackingValueStorageKey)
+	if err != nil || storedTrackingValueBytes == nil {
+		return trackingValueFromConfig
+	}
+
+	return string(storedTrackingValueBytes)
+}
+
+func (queryReceiver *tracesQueryReceiver) collect(ctx context.Context) (ptrace.Traces, error) {
+	traces := ptrace.NewTraces()
+
+	rows, err := queryReceiver.queryRows(ctx, queryReceiver.client)
+	if err != nil {
+		return traces, fmt.Errorf("error getting rows: %w", err)
+	}
+
+	var errs []error
+	auxiliary := make([]auxiliaryRows, len(queryReceiver.query.Traces))
+	for i := range queryReceiver.query.Traces {
+		auxiliary[i], err = queryReceiver.queryAuxiliaryRows(ctx, i)
+		errs = append(errs, err)
+	}
+	resources, err := sqlquery.GroupRowsByResource(rows, queryReceiver.query.ResourceAttributeColumns)
+	if err != nil {
+		errs = append(errs, err)
+	}
+	for _, resource := range resources {
+		resourceSpans := traces.ResourceSpans().AppendEmpty()
+		resource.PutAttributes(resourceSpans.Resource().Attributes())
+		for i, tracesConfig := range queryReceiver.query.Traces {
+			scopeSpans := resourceSpans.ScopeSpans().AppendEmpty()
+			queryReceiver.query.Scope.CopyTo(scopeSpans.Scope())
+			for _, row := range resource.Rows {
+				span := ptrace.NewSpan()
+				keep, err := rowToSpan(row, tracesConfig, span)
+				errs = append(errs, err)
+				if keep {
+					errs = append(errs, auxiliary[i].appendTo(span, tracesConfig))
+					span.MoveTo(scopeSpans.Spans().AppendEmpty())
+				}
+			}
+		}
+	}
+	for _, row := range rows {
+		queryReceiver.updateTrackingValue(row)
+	}
+	if len(rows) > 0 {
+		errs = append(errs, queryReceiver.storeTrackingValue(ctx))
+	}
+	return traces, errors.Join(errs...)
+}
+
+// queryRows runs the query of the client, with the tracking value as parameter when the query
+// is tracked, the auxiliary queries reading the rows of the same spans as the query.
+func (queryReceiver *tracesQueryReceiver) queryRows(ctx context.Context, client sqlquery.DbClient) ([]sqlquery.StringMap, error) {
+	if queryReceiver.query.TrackingColumn != "" {
+		return client.QueryRows(ctx, queryReceiver.trackingValue)
+	}
+	return client.QueryRows(ctx)
+}
+
+// queryAuxiliaryRows runs the auxiliary queries of the traces config, their rows being grouped
+// by span ID.
+func (queryReceiver *tracesQueryReceiver) queryAuxiliaryRows(ctx context.Context, i int) (auxiliaryRows, error) {
+	var auxiliary auxiliaryRows
+	var errs []error
+	if client := queryReceiver.eventsClients[i]; client != nil {
+		rows, err := queryReceiver.queryRows(ctx, client)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("error getting the rows of the events: %w", err))
+		} else {
+			auxiliary.events, err = groupRowsBySpanID(rows, "events.span_id_column", queryReceiver.query.Traces[i].Events.SpanIDColumn)
+			errs = append(errs, err)
+		}
+	}
+	if client := queryReceiver.linksClients[i]; client != nil {
+		rows, err := queryReceiver.queryRows(ctx, client)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("error getting the rows of the links: %w", err))
+		} else {
+			auxiliary.links, err = groupRowsBySpanID(rows, "links.span_id_column", queryReceiver.query.Traces[i].Links.SpanIDColumn)
+			errs = append(errs, err)
+		}
+	}
+	return auxiliary, errors.Join(errs...)
+}
+
+// updateTrackingValue keeps the value of the tracking column of the row. A NULL value is skipped, as the
+// next collection would read the whole table again.
+func (queryReceiver *tracesQueryReceiver) updateTrackingValue(row sqlquery.StringMap) {
+	if queryReceiver.query.TrackingColumn == "" {
+		return
+	}
+	if value := row[queryReceiver.query.TrackingColumn]; value != "" {
+		queryReceiver.trackingValue = value
+	}
+}
+
+// storeTrackingValue persists the tracking value once the rows of a collection are processed, if storage is configured.
+func (queryReceiver *tracesQueryReceiver) storeTrackingValue(ctx context.Context) error {
+	if queryReceiver.query.TrackingColumn == "" || queryReceiver.storageClient == nil {
+		return nil
+	}
+	return queryReceiver.storageClient.Set(ctx, queryReceiver.trackingValueStorageKey, []byte(queryReceiver.trackingValue))
+}
+
+// rowToSpan sets the span from the columns of the row. The span is not kept when its trace ID,
+// its span ID or its start timestamp is missing or invalid, as it could not be correlated with
+// the other spans.
+func rowToSpan(row sqlquery.StringMap, config sqlquery.TracesCfg, span ptrace.Span) (bool, error) {
+	var traceID [16]byte
+	if err := decodeIDColumn(row, "trace_id_column", config.TraceIDColumn, traceID[:]); err != nil {
+		return false, err
+	}
+	span.SetTraceID(traceID)
+	var spanID [8]byte
+	if err := decodeIDColumn(row, "span_id_column", config.SpanIDColumn, spanID[:]); err != nil {
+		return false, err
+	}
+	span.SetSpanID(spanID)
+	start, err := timestampColumn(row, "start_timestamp_column", config.StartTimestampColumn, config.TimestampFormat)
+	if err != nil {
+		return false, err
+	}
+	span.SetStartTimestamp(start)
+	span.SetEndTimestamp(start)
+
+	var errs []error
+	if config.ParentSpanIDColumn != "" {
+		if value, found := row[config.ParentSpanIDColumn]; !found {
+			errs = append(errs, fmt.Errorf("parent_span_id_column: column '%s' not found in result set", config.ParentSpanIDColumn))
+		} else if strings.TrimSpace(value) != "" {
+			var parentSpanID [8]byte
+			if err := decodeID(parentSpanID[:], strings.TrimSpace(value)); err != nil {
+				errs = append(errs, fmt.Errorf("parent_span_id_column: failed to parse the value '%s' of column '%s': %w", value, config.ParentSpanIDColumn, err))
+			} else {
+				span.SetParentSpanID(parentSpanID)
+			}
+		}
+	}
+	span.SetName(config.Name)
+	if config.NameColumn != "" {
+		if value, found := row[config.NameColumn]; found {
+			span.SetName(value)
+		} else {
+			errs = append(errs, fmt.Errorf("name_column: column '%s' not found in result set", config.NameColumn))
+		}
+	}
+	if config.EndTimestampColumn != "" {
+		if value, found := row[config.EndTimestampColumn]; !found {
+			errs = append(errs, fmt.Errorf("end_timestamp_column: column '%s' not found in result set", config.EndTimestampColumn))
+		} else if strings.TrimSpace(value) != "" {
+			end, err := parseTimestamp(value, config.TimestampFormat)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("end_timestamp_column: failed to parse the value '%s' of column '%s': %w", value, config.EndTimestampColumn, err))
+			} else {
+				span.SetEndTimestamp(end)
+			}
+		}
+	}
+	if config.StatusCodeColumn != "" {
+		if value, found := row[config.StatusCodeColumn]; found {
+			code, err := sqlquery.ParseStatusCode(value, config.StatusMapping)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("status_code_column: column '%s': %w", config.StatusCodeColumn, err))
+			}
+			span.Status().SetCode(code)
+		} else {
+			errs = append(errs, fmt.Errorf("status_code_column: column '%s' not found in result set", config.StatusCodeColumn))
+		}
+	}
+	if config.StatusMessageColumn != "" {
+		if value, found := row[config.StatusMessageColumn]; found {
+			span.Status().SetMessage(value)
+		} else {
+			errs = append(errs, fmt.Errorf("status_message_column: column '%s' not found in result set", config.StatusMessageColumn))
+		}
+	}
+	errs = append(errs, putAttributeColumns(row, "attribute_columns", config.AttributeColumns, span.Attributes()))
+	if config.EventsColumn != "" {
+		if value, found := row[config.EventsColumn]; !found {
+			errs = append(errs, fmt.Errorf("events_column: column '%s' not found in result set", config.EventsColumn))
+		} else if err := appendEventsColumn(span, value, config.TimestampFormat); err != nil {
+			errs = append(errs, fmt.Errorf("events_column: column '%s': %w", config.EventsColumn, err))
+		}
+	}
+	if config.LinksColumn != "" {
+		if value, found := row[config.LinksColumn]; !found {
+			errs = append(errs, fmt.Errorf("links_column: column '%s' not found in result set", config.LinksColumn))
+		} else if err := appendLinksColumn(span, value); err != nil {
+			errs = append(errs, fmt.Errorf("links_column: column '%s': %w", config.LinksColumn, err))
+		}
+	}
+	return true, errors.Join(errs...)
+}
+
+// decodeIDColumn decodes the hex ID of the column of the row, a NULL value being invalid.
+func decodeIDColumn(row sqlquery.StringMap, setting, column string, id []byte) error {
+	value, found := row[column]
+	if !found {
+		return fmt.Errorf("%s: column '%s' not found in result set", setting, column)
+	}
+	if err := decodeID(id, strings.TrimSpace(value)); err != nil {
+		return fmt.Errorf("%s: failed to parse the value '%s' of column '%s': %w", setting, value, column, err)
+	}
+	return nil
+}
+
+// timestampColumn parses the timestamp of the column of the row, a NULL value being invalid.
+func timestampColumn(row sqlquery.StringMap, setting, column, format string) (pcommon.Timestamp, error) {
+	value, found := row[column]
+	if !found {
+		return 0, fmt.Errorf("%s: column '%s' not found in result set", setting, column)
+	}
+	timestamp, err := parseTimestamp(value, format)
+	if err != nil {
+		return 0, fmt.Errorf("%s: failed to parse the value '%s' of column '%s': %w", setting, value, column, err)
+	}
+	return timestamp, nil
+}
+
+func (queryReceiver *tracesQueryReceiver) shutdown(_ context.Context) error {
+	if queryReceiver.db == nil {
+		return nil
+	}
+
+	return errors.Join(sqlquery.CloseStatements(queryReceiver.wrappedDb), queryReceiver.db.Close())
+}
@@ -0,0 +1,278 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlqueryreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/sqlqueryreceiver"
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/storagetest"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/sqlquery"
+)
+
+func jobRunsTracesCfg() sqlquery.TracesCfg {
+	return sqlquery.TracesCfg{
+		TraceIDColumn:        "trace_id",
+		SpanIDColumn:         "span_id",
+		ParentSpanIDColumn:   "parent_span_id",
+		NameColumn:           "job_name",
+		StartTimestampColumn: "started_at",
+		EndTimestampColumn:   "finished_at",
+		StatusCodeColumn:     "state",
+		StatusMapping:        map[string]string{"SUCCEEDED": "ok", "FAILED": "error"},
+		StatusMessageColumn:  "error",
+		AttributeColumns:     []string{"run_id"},
+	}
+}
+
+func TestTracesQueryReceiver_Collect(t *testing.T) {
+	fakeClient := &sqlquery.FakeDBClient{
+		StringMaps: [][]sqlquery.StringMap{
+			{
+				{
+					"run_id": "1", "trace_id": "4bf92f3577b34da6a3ce929d0e0e4736", "span_id": "00f067aa0ba902b7", "parent_span_id": "",
+					"job_name": "nightly-export", "state": "SUCCEEDED", "error": "",
+					"started_at": "2024-05-01T02:00:00Z", "finished_at": "2024-05-01T02:10:00Z",
+				},
+				{
+					"run_id": "2", "trace_id": "4bf92f3577b34da6a3ce929d0e0e4736", "span_id": "53995c3f42cd8ad8", "parent_span_id": "00f067aa0ba902b7",
+					"job_name": "upload", "state": "FAILED", "error": "connection reset",
+					"started_at": "2024-05-01T02:05:00Z", "finished_at": "",
+				},
+			},
+		},
+	}
+	queryReceiver := tracesQueryReceiver{
+		client: fakeClient,
+		query: sqlquery.Query{
+			Traces: []sqlquery.TracesCfg{jobRunsTracesCfg()},
+		},
+	}
+	traces, err := queryReceiver.collect(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 2, traces.SpanCount())
+
+	spans := traces.ResourceSpans().At(0).ScopeSpans().At(0).Spans()
+	root := spans.At(0)
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", root.TraceID().String())
+	assert.Equal(t, "00f067aa0ba902b7", root.SpanID().String())
+	assert.True(t, root.ParentSpanID().IsEmpty())
+	assert.Equal(t, "nightly-export", root.Name())
+	assert.Equal(t, time.Date(2024, 5, 1, 2, 0, 0, 0, time.UTC), root.StartTimestamp().AsTime())
+	assert.Equal(t, time.Date(2024, 5, 1, 2, 10, 0, 0, time.UTC), root.EndTimestamp().AsTime())
+	assert.Equal(t, ptrace.StatusCodeOk, root.Status().Code())
+	assert.Equal(t, map[string]any{"run_id": "1"}, root.Attributes().AsRaw())
+
+	// the run still in progress ends when it starts
+	child := spans.At(1)
+	assert.Equal(t, "00f067aa0ba902b7", child.ParentSpanID().String())
+	assert.Equal(t, child.StartTimestamp(), child.EndTimestamp())
+	assert.Equal(t, ptrace.StatusCodeError, child.Status().Code())
+	assert.Equal(t, "connection reset", child.Status().Message())
+}
+
+func TestTracesQueryReceiver_InvalidRows(t *testing.T) {
+	fakeClient := &sqlquery.FakeDBClient{
+		StringMaps: [][]sqlquery.StringMap{
+			{
+				{"trace_id": "abc", "span_id": "00f067aa0ba902b7", "started_at": "1714528800"},
+				{"trace_id": "4bf92f3577b34da6a3ce929d0e0e4736", "span_id": "00f067aa0ba902b7", "started_at": ""},
+				{"trace_id": "4bf92f3577b34da6a3ce929d0e0e4736", "span_id": "00f067aa0ba902b7", "started_at": "1714528800", "state": "RUNNING"},
+			},
+		},
+	}
+	queryReceiver := tracesQueryReceiver{
+		client: fakeClient,
+		query: sqlquery.Query{
+			Traces: []sqlquery.TracesCfg{{
+				TraceIDColumn:        "trace_id",
+				SpanIDColumn:         "span_id",
+				Name:                 "job",
+				StartTimestampColumn: "started_at",
+				TimestampFormat:      sqlquery.TimestampFormatEpochSeconds,
+				StatusCodeColumn:     "state",
+			}},
+		},
+	}
+	traces, err := queryReceiver.collect(context.Background())
+	assert.EqualError(t, err, "trace_id_column: failed to parse the value 'abc' of column 'trace_id': expected 32 hex characters, got 3\n"+
+		"start_timestamp_column: failed to parse the value '' of column 'started_at': strconv.ParseInt: parsing \"\": invalid syntax\n"+
+		"status_code_column: column 'state': unsupported status code 'RUNNING'")
+
+	// the rows without their IDs or their start are dropped, the other errors keep the span
+	require.Equal(t, 1, traces.SpanCount())
+	span := traces.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0)
+	assert.Equal(t, "job", span.Name())
+	assert.Equal(t, time.Unix(1714528800, 0).UTC(), span.StartTimestamp().AsTime())
+	assert.Equal(t, ptrace.StatusCodeUnset, span.Status().Code())
+}
+
+func TestTracesQueryReceiver_TrackingValue(t *testing.T) {
+	storageClient := storagetest.NewInMemoryClient(component.KindReceiver, component.MustNewID("sqlquery"), "")
+	query := sqlquery.Query{
+		TrackingColumn:     "run_id",
+		TrackingStartValue: "0",
+		Traces:             []sqlquery.TracesCfg{jobRunsTracesCfg()},
+	}
+	row := func(runID string) sqlquery.StringMap {
+		return sqlquery.StringMap{
+			"run_id": runID, "trace_id": "4bf92f3577b34da6a3ce929d0e0e4736", "span_id": "00f067aa0ba902b7", "parent_span_id": "",
+			"job_name": "nightly-export", "state": "", "error": "", "started_at": "2024-05-01T02:00:00Z", "finished_at": "",
+		}
+	}
+	fakeClient := &sqlquery.FakeDBClient{
+		StringMaps: [][]sqlquery.StringMap{{row("1"), row("2")}, {}},
+	}
+	queryReceiver := newTracesQueryReceiver("query-0", query, nil, nil, zap.NewNop(), sqlquery.TelemetryConfig{}, storageClient, false, nil)
+	queryReceiver.client = fakeClient
+	assert.Equal(t, "0", queryReceiver.retrieveTrackingValue(context.Background()))
+
+	for i := 0; i < 2; i++ {
+		_, err := queryReceiver.collect(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, "2", queryReceiver.trackingValue)
+	}
+
+	// the tracking value is read back from the storage after a restart, apart from the one of the logs
+	restarted := newTracesQueryReceiver("query-0", query, nil, nil, zap.NewNop(), sqlquery.TelemetryConfig{}, storageClient, false, nil)
+	assert.Equal(t, "2", restarted.retrieveTrackingValue(context.Background()))
+	logs := newLogsQueryReceiver("query-0", query, nil, nil, zap.NewNop(), sqlquery.TelemetryConfig{}, storageClient, false, nil)
+	assert.Equal(t, "0", logs.retrieveTrackingValue(context.Background()))
+}
+
+func TestTracesReceiver_Collect(t *testing.T) {
+	sink := new(consumertest.TracesSink)
+	receiver, err := newTracesReceiver(&Config{}, receivertest.NewNopCreateSettings(), nil, nil, sink)
+	require.NoError(t, err)
+	receiver.queryReceivers = []*tracesQueryReceiver{
+		{
+			client: &sqlquery.FakeDBClient{StringMaps: [][]sqlquery.StringMap{{
+				{"trace_id": "4bf92f3577b34da6a3ce929d0e0e4736", "span_id": "00f067aa0ba902b7", "name": "first", "started_at": "2024-05-01T02:00:00Z"},
+			}}},
+			logger: zap.NewNop(),
+			query: sqlquery.Query{
+				Traces: []sqlquery.TracesCfg{{TraceIDColumn: "trace_id", SpanIDColumn: "span_id", NameColumn: "name", StartTimestampColumn: "started_at"}},
+			},
+		},
+	}
+	receiver.collect()
+	require.Len(t, sink.AllTraces(), 1)
+	assert.Equal(t, "first", sink.AllTraces()[0].ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).Name())
+}
+
+func TestTracesQueryReceiver_EventsAndLinksColumns(t *testing.T) {
+	fakeClient := &sqlquery.FakeDBClient{
+		StringMaps: [][]sqlquery.StringMap{
+			{
+				{
+					"trace_id": "4bf92f3577b34da6a3ce929d0e0e4736", "span_id": "00f067aa0ba902b7", "started_at": "1714528800",
+					"steps":    `[{"name": "extract", "timestamp": 1714528801, "attributes": {"rows": 42}}, {"name": "load", "timestamp": "1714528802"}]`,
+					"triggers": `[{"trace_id": "0af7651916cd43dd8448eb211c80319c", "span_id": "b7ad6b7169203331", "attributes": {"trigger": "schedule"}}]`,
+				},
+				{
+					"trace_id": "4bf92f3577b34da6a3ce929d0e0e4736", "span_id": "53995c3f42cd8ad8", "started_at": "1714528800",
+					"steps": "", "triggers": `[{"trace_id": "abc"}]`,
+				},
+			},
+		},
+	}
+	queryReceiver := tracesQueryReceiver{
+		client: fakeClient,
+		query: sqlquery.Query{
+			Traces: []sqlquery.TracesCfg{{
+				TraceIDColumn:        "trace_id",
+				SpanIDColumn:         "span_id",
+				Name:                 "job",
+				StartTimestampColumn: "started_at",
+				TimestampFormat:      sqlquery.TimestampFormatEpochSeconds,
+				EventsColumn:         "steps",
+				LinksColumn:          "triggers",
+			}},
+		},
+	}
+	traces, err := queryReceiver.collect(context.Background())
+	assert.EqualError(t, err, "links_column: column 'triggers': link 0: failed to parse the trace ID 'abc': expected 32 hex characters, got 3")
+	require.Equal(t, 2, traces.SpanCount())
+
+	span := traces.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0)
+	require.Equal(t, 2, span.Events().Len())
+	assert.Equal(t, "extract", span.Events().At(0).Name())
+	assert.Equal(t, time.Unix(1714528801, 0).UTC(), span.Events().At(0).Timestamp().AsTime())
+	assert.Equal(t, map[string]any{"rows": float64(42)}, span.Events().At(0).Attributes().AsRaw())
+	assert.Equal(t, "load", span.Events().At(1).Name())
+	require.Equal(t, 1, span.Links().Len())
+	assert.Equal(t, "0af7651916cd43dd8448eb211c80319c", span.Links().At(0).TraceID().String())
+	assert.Equal(t, "b7ad6b7169203331", span.Links().At(0).SpanID().String())
+	assert.Equal(t, map[string]any{"trigger": "schedule"}, span.Links().At(0).Attributes().AsRaw())
+
+	span = traces.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(1)
+	assert.Equal(t, 0, span.Events().Len())
+	assert.Equal(t, 0, span.Links().Len())
+}
+
+func TestTracesQueryReceiver_EventsAndLinksQueries(t *testing.T) {
+	span := func(spanID string) sqlquery.StringMap {
+		return sqlquery.StringMap{"run_id": "1", "trace_id": "4bf92f3577b34da6a3ce929d0e0e4736", "span_id": spanID, "started_at": "2024-05-01T02:00:00Z"}
+	}
+	queryReceiver := tracesQueryReceiver{
+		client: &sqlquery.FakeDBClient{StringMaps: [][]sqlquery.StringMap{{span("00f067aa0ba902b7"), span("53995c3f42cd8ad8")}}},
+		eventsClients: map[int]sqlquery.DbClient{0: &sqlquery.FakeDBClient{StringMaps: [][]sqlquery.StringMap{{
+			{"span_id": "00F067AA0BA902B7", "step": "extract", "at": "2024-05-01T02:01:00Z", "rows": "42"},
+			{"span_id": "00f067aa0ba902b7", "step": "load", "at": "2024-05-01T02:02:00Z", "rows": "42"},
+			// the events of the spans not returned by the query are ignored
+			{"span_id": "b7ad6b7169203331", "step": "extract", "at": "2024-05-01T02:01:00Z", "rows": "0"},
+			{"span_id": "", "step": "orphan", "at": "2024-05-01T02:01:00Z", "rows": "0"},
+		}}}},
+		linksClients: map[int]sqlquery.DbClient{0: &sqlquery.FakeDBClient{StringMaps: [][]sqlquery.StringMap{{
+			{"span_id": "53995c3f42cd8ad8", "upstream_trace_id": "0af7651916cd43dd8448eb211c80319c", "upstream_span_id": "b7ad6b7169203331"},
+		}}}},
+		query: sqlquery.Query{
+			Traces: []sqlquery.TracesCfg{{
+				TraceIDColumn:        "trace_id",
+				SpanIDColumn:         "span_id",
+				Name:                 "job",
+				StartTimestampColumn: "started_at",
+				Events: &sqlquery.SpanEventsCfg{
+					SQL:              "select * from job_steps",
+					SpanIDColumn:     "span_id",
+					NameColumn:       "step",
+					TimestampColumn:  "at",
+					AttributeColumns: []string{"rows"},
+				},
+				Links: &sqlquery.SpanLinksCfg{
+					SQL:                 "select * from job_triggers",
+					SpanIDColumn:        "span_id",
+					LinkedTraceIDColumn: "upstream_trace_id",
+					LinkedSpanIDColumn:  "upstream_span_id",
+				},
+			}},
+		},
+	}
+	traces, err := queryReceiver.collect(context.Background())
+	assert.EqualError(t, err, "events.span_id_column: failed to parse the value '' of column 'span_id': expected 16 hex characters, got 0")
+	require.Equal(t, 2, traces.SpanCount())
+
+	spans := traces.ResourceSpans().At(0).ScopeSpans().At(0).Spans()
+	events := spans.At(0).Events()
+	require.Equal(t, 2, events.Len())
+	assert.Equal(t, "extract", events.At(0).Name())
+	assert.Equal(t, time.Date(2024, 5, 1, 2, 1, 0, 0, time.UTC), events.At(0).Timestamp().AsTime())
+	assert.Equal(t, map[string]any{"rows": "42"}, events.At(0).Attributes().AsRaw())
+	assert.Equal(t, "load", events.At(1).Name())
+	assert.Equal(t, 0, spans.At(0).Links().Len())
+
+	assert.Equal(t, 0, spans.At(1).Events().Len())
+	require.Equal(t, 1, spans.At(1).Links().Len())
+	assert.Equal(t, "0af7651916cd43dd8448eb211c80319c", spans.At(1).Links().At(0).TraceID().String())
+	assert.Equal(t, "b7ad6b7169203331", spans.At(1).Links().At(0).SpanID().String())
+}
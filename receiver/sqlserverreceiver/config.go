@@ -4,11 +4,13 @@
 package sqlserverreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/sqlserverreceiver"
 
 import (
+	"errors"
 	"fmt"
 
 	"go.opentelemetry.io/collector/config/configopaque"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/sqlquery"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/sqlserverreceiver/internal/metadata"
 )
 
@@ -25,6 +27,10 @@ type Config struct {
 	Port     uint                `mapstructure:"port"`
 	Server   string              `mapstructure:"server"`
 	Username string              `mapstructure:"username"`
+
+	// CustomQueries are run with the direct connection to the SQL Server instance, each
+	// query producing the metrics it defines as for the SQL query receiver.
+	CustomQueries []sqlquery.Query `mapstructure:"custom_queries"`
 }
 
 func (cfg *Config) Validate() error {
@@ -38,7 +44,10 @@ func (cfg *Config) Validate() error {
 			return fmt.Errorf("Found one or more of the following configuration options set: [server, port, username, password]. " +
 				"All of these options must be configured to directly connect to a SQL Server instance.")
 		}
+		if len(cfg.CustomQueries) > 0 {
+			return errors.New("'custom_queries' require a direct connection: [server, port, username, password] must be configured")
+		}
 	}
 
-	return nil
+	return sqlquery.ValidateCustomQueries(cfg.CustomQueries)
 }
@@ -15,9 +15,18 @@ import (
 	"go.opentelemetry.io/collector/confmap/confmaptest"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/sqlquery"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/sqlserverreceiver/internal/metadata"
 )
 
+var customQuery = sqlquery.Query{
+	SQL: "select count(*) as count from sys.dm_exec_sessions",
+	Metrics: []sqlquery.MetricCfg{{
+		MetricName:  "sqlserver.sessions",
+		ValueColumn: "count",
+	}},
+}
+
 func TestValidate(t *testing.T) {
 	testCases := []struct {
 		desc            string
@@ -63,6 +72,41 @@ func TestValidate(t *testing.T) {
 			},
 			expectedSuccess: true,
 		},
+		{
+			desc: "valid config with custom queries",
+			cfg: &Config{
+				ControllerConfig: scraperhelper.NewDefaultControllerConfig(),
+				Server:           "0.0.0.0",
+				Username:         "sa",
+				Password:         "password",
+				Port:             1433,
+				CustomQueries:    []sqlquery.Query{customQuery},
+			},
+			expectedSuccess: true,
+		},
+		{
+			desc: "invalid config with custom queries without direct connection",
+			cfg: &Config{
+				ControllerConfig: scraperhelper.NewDefaultControllerConfig(),
+				CustomQueries:    []sqlquery.Query{customQuery},
+			},
+			expectedSuccess: false,
+		},
+		{
+			desc: "invalid config with logs custom queries",
+			cfg: &Config{
+				ControllerConfig: scraperhelper.NewDefaultControllerConfig(),
+				Server:           "0.0.0.0",
+				Username:         "sa",
+				Password:         "password",
+				Port:             1433,
+				CustomQueries: []sqlquery.Query{{
+					SQL:  "select message from app_logs",
+					Logs: []sqlquery.LogsCfg{{BodyColumn: "message"}},
+				}},
+			},
+			expectedSuccess: false,
+		},
 	}
 
 	for _, tc := range testCases {
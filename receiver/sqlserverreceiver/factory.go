@@ -123,5 +123,14 @@ func setupScrapers(params receiver.CreateSettings, cfg *Config) ([]scraperhelper
 		opts = append(opts, opt)
 	}
 
+	if directDBConnectionEnabled(cfg) {
+		dbProviderFunc := func() (*sql.DB, error) {
+			return sql.Open("sqlserver", getDBConnectionString(cfg))
+		}
+		for _, scraper := range sqlquery.NewCustomQueryScrapers(metadata.Type, cfg.CustomQueries, cfg.ControllerConfig, params.Logger, dbProviderFunc) {
+			opts = append(opts, scraperhelper.AddScraper(scraper))
+		}
+	}
+
 	return opts, nil
 }
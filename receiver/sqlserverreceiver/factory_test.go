@@ -15,6 +15,7 @@ import (
 	"go.opentelemetry.io/collector/receiver/receivertest"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/sqlquery"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/sqlserverreceiver/internal/metadata"
 )
 
@@ -123,6 +124,11 @@ func TestCreateMetricsReceiver(t *testing.T) {
 
 				require.True(t, databaseIOScraperFound)
 
+				cfg.CustomQueries = []sqlquery.Query{customQuery}
+				customScrapers, err := setupScrapers(params, cfg)
+				require.NoError(t, err)
+				require.Len(t, customScrapers, len(scrapers)+1)
+
 				r, err := factory.CreateMetricsReceiver(
 					context.Background(),
 					receivertest.NewNopCreateSettings(),
@@ -0,0 +1,82 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sshcheckreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/sshcheckreceiver"
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+const commandNameAttribute = "command.name"
+
+// command is a command of the config with the compiled regular expressions of its metrics.
+type command struct {
+	CommandConfig
+	regexes []*regexp.Regexp
+}
+
+func newCommands(cfgs []CommandConfig) ([]command, error) {
+	commands := make([]command, 0, len(cfgs))
+	for _, cfg := range cfgs {
+		c := command{CommandConfig: cfg}
+		for _, metric := range cfg.Metrics {
+			re, err := regexp.Compile(metric.Regex)
+			if err != nil {
+				return nil, fmt.Errorf("invalid regex of the metric %q: %w", metric.Name, err)
+			}
+			c.regexes = append(c.regexes, re)
+		}
+		commands = append(commands, c)
+	}
+	return commands, nil
+}
+
+// scrapeCommands runs the commands and records their status, the metrics extracted from their
+// output being added to dest.
+func (s *sshcheckScraper) scrapeCommands(now pcommon.Timestamp, dest pmetric.MetricSlice) {
+	for _, c := range s.commands {
+		var success int64
+
+		start := time.Now()
+		stdout, exitCode, err := s.Client.Run(c.Command)
+		s.mb.RecordSshcheckCommandDurationDataPoint(now, time.Since(start).Milliseconds(), c.Name)
+		if err != nil {
+			s.mb.RecordSshcheckCommandExitCodeDataPoint(now, -1, c.Name)
+			s.mb.RecordSshcheckCommandStatusDataPoint(now, success, c.Name)
+			s.mb.RecordSshcheckErrorDataPoint(now, int64(1), fmt.Sprintf("command %s: %s", c.Name, err))
+			continue
+		}
+		if exitCode == 0 {
+			success = 1
+		}
+		s.mb.RecordSshcheckCommandExitCodeDataPoint(now, int64(exitCode), c.Name)
+		s.mb.RecordSshcheckCommandStatusDataPoint(now, success, c.Name)
+
+		for i, metric := range c.Metrics {
+			match := c.regexes[i].FindSubmatch(stdout)
+			if match == nil {
+				s.mb.RecordSshcheckErrorDataPoint(now, int64(1), fmt.Sprintf("command %s: no match of the metric %s in the output", c.Name, metric.Name))
+				continue
+			}
+			value, err := strconv.ParseFloat(string(match[1]), 64)
+			if err != nil {
+				s.mb.RecordSshcheckErrorDataPoint(now, int64(1), fmt.Sprintf("command %s: invalid value of the metric %s: %s", c.Name, metric.Name, err))
+				continue
+			}
+			m := dest.AppendEmpty()
+			m.SetName(metric.Name)
+			m.SetDescription(metric.Description)
+			m.SetUnit(metric.Unit)
+			dp := m.SetEmptyGauge().DataPoints().AppendEmpty()
+			dp.SetTimestamp(now)
+			dp.SetDoubleValue(value)
+			dp.Attributes().PutStr(commandNameAttribute, c.Name)
+		}
+	}
+}
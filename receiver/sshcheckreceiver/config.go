@@ -5,7 +5,9 @@ package sshcheckreceiver // import "github.com/open-telemetry/opentelemetry-coll
 
 import (
 	"errors"
+	"fmt"
 	"net"
+	"regexp"
 	"strings"
 
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
@@ -21,6 +23,7 @@ var (
 	errInvalidEndpoint           = errors.New(`"endpoint" is invalid`)
 	errMissingUsername           = errors.New(`"username" not specified in config`)
 	errMissingPasswordAndKeyFile = errors.New(`either "password" or "key_file" is required`)
+	errMissingCommandName        = errors.New(`"name" not specified for a command`)
 
 	errConfigNotSSHCheck = errors.New("config was not a SSH check receiver config")
 )
@@ -31,6 +34,28 @@ type Config struct {
 
 	CheckSFTP            bool                          `mapstructure:"check_sftp"`
 	MetricsBuilderConfig metadata.MetricsBuilderConfig `mapstructure:",squash"`
+
+	// Commands are run over SSH on each scrape, to check their exit status and to extract
+	// metrics from their output.
+	Commands []CommandConfig `mapstructure:"commands"`
+}
+
+// CommandConfig is a command run over SSH.
+type CommandConfig struct {
+	// Name is set as the command.name attribute of the metrics of the command.
+	Name    string `mapstructure:"name"`
+	Command string `mapstructure:"command"`
+	// Metrics are the values extracted from the standard output of the command.
+	Metrics []OutputMetricConfig `mapstructure:"metrics"`
+}
+
+// OutputMetricConfig is a gauge whose value is extracted from the standard output of a
+// command by the first capture group of a regular expression.
+type OutputMetricConfig struct {
+	Name        string `mapstructure:"name"`
+	Description string `mapstructure:"description"`
+	Unit        string `mapstructure:"unit"`
+	Regex       string `mapstructure:"regex"`
 }
 
 // SFTPEnabled tells whether SFTP metrics are Enabled in MetricsSettings.
@@ -55,5 +80,35 @@ func (c Config) Validate() (err error) {
 		err = multierr.Append(err, errMissingPasswordAndKeyFile)
 	}
 
+	names := map[string]bool{}
+	for _, command := range c.Commands {
+		if names[command.Name] {
+			err = multierr.Append(err, fmt.Errorf("duplicate command name %q", command.Name))
+		}
+		names[command.Name] = true
+		err = multierr.Append(err, command.validate())
+	}
+
+	return
+}
+
+func (c CommandConfig) validate() (err error) {
+	if c.Name == "" {
+		err = multierr.Append(err, errMissingCommandName)
+	}
+	if c.Command == "" {
+		err = multierr.Append(err, fmt.Errorf(`"command" not specified for the command %q`, c.Name))
+	}
+	for _, metric := range c.Metrics {
+		if metric.Name == "" {
+			err = multierr.Append(err, fmt.Errorf(`"name" not specified for a metric of the command %q`, c.Name))
+		}
+		re, reErr := regexp.Compile(metric.Regex)
+		if reErr != nil {
+			err = multierr.Append(err, fmt.Errorf("invalid regex of the metric %q: %w", metric.Name, reErr))
+		} else if re.NumSubexp() < 1 {
+			err = multierr.Append(err, fmt.Errorf("the regex of the metric %q has no capture group", metric.Name))
+		}
+	}
 	return
 }
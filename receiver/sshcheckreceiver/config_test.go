@@ -4,6 +4,7 @@
 package sshcheckreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/sshcheckreceiver"
 
 import (
+	"errors"
 	"path/filepath"
 	"testing"
 	"time"
@@ -95,6 +96,32 @@ func TestValidate(t *testing.T) {
 			},
 			expectedErr: error(nil),
 		},
+		{
+			desc: "invalid commands",
+			cfg: &Config{
+				SSHClientSettings: configssh.SSHClientSettings{
+					Endpoint: "localhost:2222",
+					Username: "otelu",
+					Password: "otelp",
+				},
+				ControllerConfig: scraperhelper.NewDefaultControllerConfig(),
+				Commands: []CommandConfig{
+					{Name: "load", Command: "uptime", Metrics: []OutputMetricConfig{
+						{Name: "system.load.1m", Regex: `load average: [\d.]+`},
+						{Name: "system.users", Regex: `(\d+ users`},
+					}},
+					{Name: "load"},
+					{Command: "uptime"},
+				},
+			},
+			expectedErr: multierr.Combine(
+				errors.New(`the regex of the metric "system.load.1m" has no capture group`),
+				errors.New("invalid regex of the metric \"system.users\": error parsing regexp: missing closing ): `(\\d+ users`"),
+				errors.New(`duplicate command name "load"`),
+				errors.New(`"command" not specified for the command "load"`),
+				errMissingCommandName,
+			),
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.desc, func(t *testing.T) {
@@ -138,5 +165,19 @@ func TestLoadConfig(t *testing.T) {
 		IgnoreHostKey: false,
 		Timeout:       10 * time.Second,
 	}
+	expectedConfig.Commands = []CommandConfig{
+		{
+			Name:    "load",
+			Command: "cat /proc/loadavg",
+			Metrics: []OutputMetricConfig{
+				{
+					Name:        "system.cpu.load_average.1m",
+					Description: "Average CPU Load over 1 minute.",
+					Unit:        "{thread}",
+					Regex:       `^([\d.]+)`,
+				},
+			},
+		},
+	}
 	require.Equal(t, expectedConfig, actualConfig)
 }
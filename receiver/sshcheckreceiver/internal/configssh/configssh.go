@@ -4,6 +4,7 @@
 package configssh // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/sshcheckreceiver/internal/configssh"
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"os"
@@ -67,6 +68,31 @@ func (c *Client) SFTPClient() (*SFTPClient, error) {
 	}, nil
 }
 
+// Run runs the command in a new session, and returns its standard output and its exit status.
+// The error is only set when the command could not be run.
+func (c *Client) Run(command string) ([]byte, int, error) {
+	if c.Client == nil || c.Client.Conn == nil {
+		return nil, 0, fmt.Errorf("SSH client not initialized")
+	}
+	session, err := c.Client.NewSession()
+	if err != nil {
+		return nil, 0, err
+	}
+	defer session.Close()
+
+	var stdout bytes.Buffer
+	session.Stdout = &stdout
+	err = session.Run(command)
+	var exitErr *ssh.ExitError
+	if errors.As(err, &exitErr) {
+		return stdout.Bytes(), exitErr.ExitStatus(), nil
+	}
+	if err != nil {
+		return nil, 0, err
+	}
+	return stdout.Bytes(), 0, nil
+}
+
 type SFTPClient struct {
 	*sftp.Client
 	*ssh.ClientConfig
@@ -28,16 +28,28 @@ func (ms *MetricConfig) Unmarshal(parser *confmap.Conf) error {
 
 // MetricsConfig provides config for sshcheck metrics.
 type MetricsConfig struct {
-	SshcheckDuration     MetricConfig `mapstructure:"sshcheck.duration"`
-	SshcheckError        MetricConfig `mapstructure:"sshcheck.error"`
-	SshcheckSftpDuration MetricConfig `mapstructure:"sshcheck.sftp_duration"`
-	SshcheckSftpError    MetricConfig `mapstructure:"sshcheck.sftp_error"`
-	SshcheckSftpStatus   MetricConfig `mapstructure:"sshcheck.sftp_status"`
-	SshcheckStatus       MetricConfig `mapstructure:"sshcheck.status"`
+	SshcheckCommandDuration MetricConfig `mapstructure:"sshcheck.command.duration"`
+	SshcheckCommandExitCode MetricConfig `mapstructure:"sshcheck.command.exit_code"`
+	SshcheckCommandStatus   MetricConfig `mapstructure:"sshcheck.command.status"`
+	SshcheckDuration        MetricConfig `mapstructure:"sshcheck.duration"`
+	SshcheckError           MetricConfig `mapstructure:"sshcheck.error"`
+	SshcheckSftpDuration    MetricConfig `mapstructure:"sshcheck.sftp_duration"`
+	SshcheckSftpError       MetricConfig `mapstructure:"sshcheck.sftp_error"`
+	SshcheckSftpStatus      MetricConfig `mapstructure:"sshcheck.sftp_status"`
+	SshcheckStatus          MetricConfig `mapstructure:"sshcheck.status"`
 }
 
 func DefaultMetricsConfig() MetricsConfig {
 	return MetricsConfig{
+		SshcheckCommandDuration: MetricConfig{
+			Enabled: true,
+		},
+		SshcheckCommandExitCode: MetricConfig{
+			Enabled: true,
+		},
+		SshcheckCommandStatus: MetricConfig{
+			Enabled: true,
+		},
 		SshcheckDuration: MetricConfig{
 			Enabled: true,
 		},
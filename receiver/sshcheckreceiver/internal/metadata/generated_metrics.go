@@ -12,6 +12,161 @@ import (
 	"go.opentelemetry.io/collector/receiver"
 )
 
+type metricSshcheckCommandDuration struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills sshcheck.command.duration metric with initial data.
+func (m *metricSshcheckCommandDuration) init() {
+	m.data.SetName("sshcheck.command.duration")
+	m.data.SetDescription("Measures the duration of the command.")
+	m.data.SetUnit("ms")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSshcheckCommandDuration) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, commandNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("command.name", commandNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSshcheckCommandDuration) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSshcheckCommandDuration) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSshcheckCommandDuration(cfg MetricConfig) metricSshcheckCommandDuration {
+	m := metricSshcheckCommandDuration{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSshcheckCommandExitCode struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills sshcheck.command.exit_code metric with initial data.
+func (m *metricSshcheckCommandExitCode) init() {
+	m.data.SetName("sshcheck.command.exit_code")
+	m.data.SetDescription("Exit status of the command, -1 when the command could not be run.")
+	m.data.SetUnit("1")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSshcheckCommandExitCode) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, commandNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("command.name", commandNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSshcheckCommandExitCode) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSshcheckCommandExitCode) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSshcheckCommandExitCode(cfg MetricConfig) metricSshcheckCommandExitCode {
+	m := metricSshcheckCommandExitCode{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSshcheckCommandStatus struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills sshcheck.command.status metric with initial data.
+func (m *metricSshcheckCommandStatus) init() {
+	m.data.SetName("sshcheck.command.status")
+	m.data.SetDescription("1 if the command exited with a zero status, otherwise 0.")
+	m.data.SetUnit("1")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSshcheckCommandStatus) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, commandNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("command.name", commandNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSshcheckCommandStatus) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSshcheckCommandStatus) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSshcheckCommandStatus(cfg MetricConfig) metricSshcheckCommandStatus {
+	m := metricSshcheckCommandStatus{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricSshcheckDuration struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -328,6 +483,9 @@ type MetricsBuilder struct {
 	buildInfo                      component.BuildInfo  // contains version information.
 	resourceAttributeIncludeFilter map[string]filter.Filter
 	resourceAttributeExcludeFilter map[string]filter.Filter
+	metricSshcheckCommandDuration  metricSshcheckCommandDuration
+	metricSshcheckCommandExitCode  metricSshcheckCommandExitCode
+	metricSshcheckCommandStatus    metricSshcheckCommandStatus
 	metricSshcheckDuration         metricSshcheckDuration
 	metricSshcheckError            metricSshcheckError
 	metricSshcheckSftpDuration     metricSshcheckSftpDuration
@@ -352,6 +510,9 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.CreateSetting
 		startTime:                      pcommon.NewTimestampFromTime(time.Now()),
 		metricsBuffer:                  pmetric.NewMetrics(),
 		buildInfo:                      settings.BuildInfo,
+		metricSshcheckCommandDuration:  newMetricSshcheckCommandDuration(mbc.Metrics.SshcheckCommandDuration),
+		metricSshcheckCommandExitCode:  newMetricSshcheckCommandExitCode(mbc.Metrics.SshcheckCommandExitCode),
+		metricSshcheckCommandStatus:    newMetricSshcheckCommandStatus(mbc.Metrics.SshcheckCommandStatus),
 		metricSshcheckDuration:         newMetricSshcheckDuration(mbc.Metrics.SshcheckDuration),
 		metricSshcheckError:            newMetricSshcheckError(mbc.Metrics.SshcheckError),
 		metricSshcheckSftpDuration:     newMetricSshcheckSftpDuration(mbc.Metrics.SshcheckSftpDuration),
@@ -428,6 +589,9 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	ils.Scope().SetName("otelcol/sshcheckreceiver")
 	ils.Scope().SetVersion(mb.buildInfo.Version)
 	ils.Metrics().EnsureCapacity(mb.metricsCapacity)
+	mb.metricSshcheckCommandDuration.emit(ils.Metrics())
+	mb.metricSshcheckCommandExitCode.emit(ils.Metrics())
+	mb.metricSshcheckCommandStatus.emit(ils.Metrics())
 	mb.metricSshcheckDuration.emit(ils.Metrics())
 	mb.metricSshcheckError.emit(ils.Metrics())
 	mb.metricSshcheckSftpDuration.emit(ils.Metrics())
@@ -465,6 +629,21 @@ func (mb *MetricsBuilder) Emit(rmo ...ResourceMetricsOption) pmetric.Metrics {
 	return metrics
 }
 
+// RecordSshcheckCommandDurationDataPoint adds a data point to sshcheck.command.duration metric.
+func (mb *MetricsBuilder) RecordSshcheckCommandDurationDataPoint(ts pcommon.Timestamp, val int64, commandNameAttributeValue string) {
+	mb.metricSshcheckCommandDuration.recordDataPoint(mb.startTime, ts, val, commandNameAttributeValue)
+}
+
+// RecordSshcheckCommandExitCodeDataPoint adds a data point to sshcheck.command.exit_code metric.
+func (mb *MetricsBuilder) RecordSshcheckCommandExitCodeDataPoint(ts pcommon.Timestamp, val int64, commandNameAttributeValue string) {
+	mb.metricSshcheckCommandExitCode.recordDataPoint(mb.startTime, ts, val, commandNameAttributeValue)
+}
+
+// RecordSshcheckCommandStatusDataPoint adds a data point to sshcheck.command.status metric.
+func (mb *MetricsBuilder) RecordSshcheckCommandStatusDataPoint(ts pcommon.Timestamp, val int64, commandNameAttributeValue string) {
+	mb.metricSshcheckCommandStatus.recordDataPoint(mb.startTime, ts, val, commandNameAttributeValue)
+}
+
 // RecordSshcheckDurationDataPoint adds a data point to sshcheck.duration metric.
 func (mb *MetricsBuilder) RecordSshcheckDurationDataPoint(ts pcommon.Timestamp, val int64) {
 	mb.metricSshcheckDuration.recordDataPoint(mb.startTime, ts, val)
@@ -68,6 +68,18 @@ func TestMetricsBuilder(t *testing.T) {
 			defaultMetricsCount := 0
 			allMetricsCount := 0
 
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordSshcheckCommandDurationDataPoint(ts, 1, "command.name-val")
+
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordSshcheckCommandExitCodeDataPoint(ts, 1, "command.name-val")
+
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordSshcheckCommandStatusDataPoint(ts, 1, "command.name-val")
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordSshcheckDurationDataPoint(ts, 1)
@@ -113,6 +125,53 @@ func TestMetricsBuilder(t *testing.T) {
 			validatedMetrics := make(map[string]bool)
 			for i := 0; i < ms.Len(); i++ {
 				switch ms.At(i).Name() {
+				case "sshcheck.command.duration":
+					assert.False(t, validatedMetrics["sshcheck.command.duration"], "Found a duplicate in the metrics slice: sshcheck.command.duration")
+					validatedMetrics["sshcheck.command.duration"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Measures the duration of the command.", ms.At(i).Description())
+					assert.Equal(t, "ms", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("command.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "command.name-val", attrVal.Str())
+				case "sshcheck.command.exit_code":
+					assert.False(t, validatedMetrics["sshcheck.command.exit_code"], "Found a duplicate in the metrics slice: sshcheck.command.exit_code")
+					validatedMetrics["sshcheck.command.exit_code"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Exit status of the command, -1 when the command could not be run.", ms.At(i).Description())
+					assert.Equal(t, "1", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("command.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "command.name-val", attrVal.Str())
+				case "sshcheck.command.status":
+					assert.False(t, validatedMetrics["sshcheck.command.status"], "Found a duplicate in the metrics slice: sshcheck.command.status")
+					validatedMetrics["sshcheck.command.status"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "1 if the command exited with a zero status, otherwise 0.", ms.At(i).Description())
+					assert.Equal(t, "1", ms.At(i).Unit())
+					assert.Equal(t, false, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("command.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "command.name-val", attrVal.Str())
 				case "sshcheck.duration":
 					assert.False(t, validatedMetrics["sshcheck.duration"], "Found a duplicate in the metrics slice: sshcheck.duration")
 					validatedMetrics["sshcheck.duration"] = true
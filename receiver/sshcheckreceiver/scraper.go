@@ -24,11 +24,15 @@ type sshcheckScraper struct {
 	*Config
 	settings component.TelemetrySettings
 	mb       *metadata.MetricsBuilder
+	commands []command
 }
 
 // start starts the scraper by creating a new SSH Client on the scraper
 func (s *sshcheckScraper) start(_ context.Context, host component.Host) error {
 	var err error
+	if s.commands, err = newCommands(s.Config.Commands); err != nil {
+		return err
+	}
 	s.Client, err = s.Config.ToClient(host, s.settings)
 	return err
 }
@@ -105,6 +109,7 @@ func (s *sshcheckScraper) scrape(ctx context.Context) (_ pmetric.Metrics, err er
 		return pmetric.NewMetrics(), errClientNotInit
 	}
 
+	outputMetrics := pmetric.NewMetricSlice()
 	if err = s.scrapeSSH(now); err != nil {
 		s.mb.RecordSshcheckErrorDataPoint(now, int64(1), err.Error())
 	} else {
@@ -112,6 +117,7 @@ func (s *sshcheckScraper) scrape(ctx context.Context) (_ pmetric.Metrics, err er
 			<-ctx.Done()
 			cleanup()
 		}()
+		s.scrapeCommands(now, outputMetrics)
 	}
 
 	if s.SFTPEnabled() {
@@ -122,7 +128,23 @@ func (s *sshcheckScraper) scrape(ctx context.Context) (_ pmetric.Metrics, err er
 
 	rb := s.mb.NewResourceBuilder()
 	rb.SetSSHEndpoint(s.Config.SSHClientSettings.Endpoint)
-	return s.mb.Emit(metadata.WithResource(rb.Emit())), nil
+	res := rb.Emit()
+	metrics := s.mb.Emit(metadata.WithResource(res))
+	if outputMetrics.Len() > 0 {
+		appendOutputMetrics(metrics, res, outputMetrics)
+	}
+	return metrics, nil
+}
+
+// appendOutputMetrics adds the metrics extracted from the output of the commands to the
+// scope of the metrics of the receiver.
+func appendOutputMetrics(metrics pmetric.Metrics, res pcommon.Resource, outputMetrics pmetric.MetricSlice) {
+	if metrics.ResourceMetrics().Len() == 0 {
+		rm := metrics.ResourceMetrics().AppendEmpty()
+		res.CopyTo(rm.Resource())
+		rm.ScopeMetrics().AppendEmpty().Scope().SetName("otelcol/sshcheckreceiver")
+	}
+	outputMetrics.MoveAndAppendTo(metrics.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics())
 }
 
 func newScraper(conf *Config, settings receiver.CreateSettings) *sshcheckScraper {
@@ -15,8 +15,10 @@ import (
 	"time"
 
 	"github.com/pkg/sftp"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver/receivertest"
 	"golang.org/x/crypto/ssh"
 
@@ -128,6 +130,10 @@ func handleChannels(chans <-chan ssh.NewChannel) {
 						}
 					}()
 				}
+				if req.Type == "exec" {
+					ok = true
+					go runCommand(channel, string(req.Payload[4:]))
+				}
 				if err := req.Reply(ok, nil); err != nil {
 					return
 				}
@@ -136,6 +142,20 @@ func handleChannels(chans <-chan ssh.NewChannel) {
 	}
 }
 
+// runCommand answers the commands of the tests with a fixed output and exit status.
+func runCommand(channel ssh.Channel, command string) {
+	defer channel.Close()
+	output, status := "", uint32(127)
+	switch command {
+	case "uptime":
+		output, status = " 10:00:00 up 1 day,  2 users,  load average: 0.52, 0.48, 0.41\n", 0
+	case "df /":
+		output, status = "df: /: No such file or directory\n", 1
+	}
+	_, _ = channel.Write([]byte(output))
+	_, _ = channel.SendRequest("exit-status", false, ssh.Marshal(struct{ Status uint32 }{status}))
+}
+
 func TestScraper(t *testing.T) {
 	s, err := newSSHServer("tcp", "127.0.0.1:0")
 	require.NoError(t, err)
@@ -337,3 +357,83 @@ func TestWithoutStartErrsNotPanics(t *testing.T) {
 	require.NotPanics(t, func() { _, err = scrpr.scrape(context.Background()) }, "scrape should not panic")
 	require.Error(t, err, "expected scrape to err when without start")
 }
+
+func TestScraperCommands(t *testing.T) {
+	s, err := newSSHServer("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	endpoint := s.runSSHServer(t)
+	require.NotEmpty(t, endpoint)
+	defer s.shutdown()
+
+	f := NewFactory()
+	cfg := f.CreateDefaultConfig().(*Config)
+	cfg.Username = "otelu"
+	cfg.Password = "otelp"
+	cfg.Endpoint = endpoint
+	cfg.IgnoreHostKey = true
+	cfg.Commands = []CommandConfig{
+		{
+			Name:    "load",
+			Command: "uptime",
+			Metrics: []OutputMetricConfig{
+				{Name: "system.load.1m", Unit: "{thread}", Regex: `load average: ([\d.]+)`},
+				{Name: "system.users", Regex: `(\d+) users`},
+				{Name: "system.load.invalid", Regex: `users,\s+(\w+)`},
+			},
+		},
+		{
+			Name:    "disk",
+			Command: "df /",
+			Metrics: []OutputMetricConfig{
+				{Name: "system.disk.used", Regex: `(\d+)%`},
+			},
+		},
+	}
+
+	scrpr := newScraper(cfg, receivertest.NewNopCreateSettings())
+	require.NoError(t, scrpr.start(context.Background(), componenttest.NewNopHost()), "failed starting scraper")
+	actualMetrics, err := scrpr.scrape(context.Background())
+	require.NoError(t, err, "failed scrape")
+
+	metrics := map[string]pmetric.Metric{}
+	ms := actualMetrics.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	for i := 0; i < ms.Len(); i++ {
+		metrics[ms.At(i).Name()] = ms.At(i)
+	}
+
+	load := metrics["system.load.1m"]
+	require.Equal(t, 1, load.Gauge().DataPoints().Len())
+	assert.Equal(t, "{thread}", load.Unit())
+	assert.Equal(t, 0.52, load.Gauge().DataPoints().At(0).DoubleValue())
+	assert.Equal(t, map[string]any{"command.name": "load"}, load.Gauge().DataPoints().At(0).Attributes().AsRaw())
+
+	assert.Equal(t, 2.0, metrics["system.users"].Gauge().DataPoints().At(0).DoubleValue())
+
+	exitCodes := map[string]int64{}
+	dps := metrics["sshcheck.command.exit_code"].Gauge().DataPoints()
+	for i := 0; i < dps.Len(); i++ {
+		name, _ := dps.At(i).Attributes().Get("command.name")
+		exitCodes[name.Str()] = dps.At(i).IntValue()
+	}
+	assert.Equal(t, map[string]int64{"load": 0, "disk": 1}, exitCodes)
+
+	statuses := map[string]int64{}
+	dps = metrics["sshcheck.command.status"].Sum().DataPoints()
+	for i := 0; i < dps.Len(); i++ {
+		name, _ := dps.At(i).Attributes().Get("command.name")
+		statuses[name.Str()] = dps.At(i).IntValue()
+	}
+	assert.Equal(t, map[string]int64{"load": 1, "disk": 0}, statuses)
+	assert.Equal(t, 2, metrics["sshcheck.command.duration"].Gauge().DataPoints().Len())
+
+	var errorMessages []string
+	dps = metrics["sshcheck.error"].Sum().DataPoints()
+	for i := 0; i < dps.Len(); i++ {
+		message, _ := dps.At(i).Attributes().Get("error.message")
+		errorMessages = append(errorMessages, message.Str())
+	}
+	assert.ElementsMatch(t, []string{
+		`command load: invalid value of the metric system.load.invalid: strconv.ParseFloat: parsing "load": invalid syntax`,
+		"command disk: no match of the metric system.disk.used in the output",
+	}, errorMessages)
+}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"go.opentelemetry.io/collector/config/confignet"
+	"go.opentelemetry.io/collector/config/configtls"
 )
 
 // Config defines configuration for the Wavefront receiver.
@@ -17,6 +18,10 @@ type Config struct {
 	// TCPIdleTimeout is the timout for idle TCP connections.
 	TCPIdleTimeout time.Duration `mapstructure:"tcp_idle_timeout"`
 
+	// TLSSetting enables TLS on the TCP connections, for the senders which
+	// cannot reach the receiver over a trusted network.
+	TLSSetting *configtls.ServerConfig `mapstructure:"tls"`
+
 	// ExtractCollectdTags instructs the Wavefront receiver to attempt to extract
 	// tags in the CollectD format from the metric name. The default is false.
 	ExtractCollectdTags bool `mapstructure:"extract_collectd_tags"`
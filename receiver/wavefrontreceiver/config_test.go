@@ -12,6 +12,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/confignet"
+	"go.opentelemetry.io/collector/config/configtls"
 	"go.opentelemetry.io/collector/confmap/confmaptest"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/wavefrontreceiver/internal/metadata"
@@ -41,6 +42,19 @@ func TestLoadConfig(t *testing.T) {
 				ExtractCollectdTags: true,
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "tls"),
+			expected: func() component.Config {
+				cfg := createDefaultConfig().(*Config)
+				cfg.TLSSetting = &configtls.ServerConfig{
+					Config: configtls.Config{
+						CertFile: "/etc/ssl/wavefront.crt",
+						KeyFile:  "/etc/ssl/wavefront.key",
+					},
+				}
+				return cfg
+			}(),
+		},
 	}
 
 	for _, tt := range tests {
@@ -47,6 +47,7 @@ func (r *metricsReceiver) Start(ctx context.Context, host component.Host) error
 			Transport: confignet.TransportTypeTCP,
 		},
 		TCPIdleTimeout: r.cfg.TCPIdleTimeout,
+		TLSSetting:     r.cfg.TLSSetting,
 		Parser: &protocol.Config{
 			Type: "plaintext", // TODO: update after other parsers are implemented for Carbon receiver.
 			Config: &WavefrontParser{
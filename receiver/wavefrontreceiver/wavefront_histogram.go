@@ -0,0 +1,124 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package wavefrontreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/wavefrontreceiver"
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+// histogramGranularities are the aggregation intervals of the histograms, by
+// the prefix of their lines.
+var histogramGranularities = map[string]time.Duration{
+	"!M": time.Minute,
+	"!H": time.Hour,
+	"!D": 24 * time.Hour,
+}
+
+// parseHistogram transforms a Wavefront histogram distribution, see
+// https://docs.wavefront.com/histograms.html#sending-histogram-distributions,
+// in the following format:
+//
+//	"{!M | !H | !D} [<timestamp>] {#<count> <centroid>}+ <metricName> source=<source> [pointTags]"
+//
+// into a delta histogram over the aggregation interval. The centroids are the
+// explicit bounds of the histogram, each bucket holding the count of the
+// centroid of its upper bound, so the distribution is kept without loss.
+func (wp *WavefrontParser) parseHistogram(line string) (pmetric.Metric, error) {
+	parts := strings.SplitN(line, " ", 2)
+	granularity, ok := histogramGranularities[parts[0]]
+	if !ok || len(parts) < 2 {
+		return pmetric.Metric{}, fmt.Errorf("invalid wavefront histogram [%s]", line)
+	}
+	rest := parts[1]
+
+	ts := time.Now()
+	parts = strings.SplitN(rest, " ", 2)
+	if !strings.HasPrefix(parts[0], "#") {
+		unixTime, err := strconv.ParseInt(parts[0], 10, 64)
+		if err != nil || len(parts) < 2 {
+			return pmetric.Metric{}, fmt.Errorf(
+				"invalid timestamp for wavefront histogram [%s]", line)
+		}
+		ts = time.Unix(unixTime, 0)
+		rest = parts[1]
+	}
+
+	centroids := map[float64]uint64{}
+	for strings.HasPrefix(rest, "#") {
+		parts = strings.SplitN(rest, " ", 3)
+		if len(parts) < 3 {
+			return pmetric.Metric{}, fmt.Errorf("invalid wavefront histogram [%s]", line)
+		}
+		count, err := strconv.ParseUint(parts[0][1:], 10, 64)
+		if err != nil {
+			return pmetric.Metric{}, fmt.Errorf("invalid wavefront histogram count [%s]: %w", line, err)
+		}
+		centroid, err := strconv.ParseFloat(parts[1], 64)
+		if err != nil {
+			return pmetric.Metric{}, fmt.Errorf("invalid wavefront histogram centroid [%s]: %w", line, err)
+		}
+		if math.IsNaN(centroid) || math.IsInf(centroid, 0) {
+			return pmetric.Metric{}, fmt.Errorf("invalid wavefront histogram centroid [%s]", line)
+		}
+		centroids[centroid] += count
+		rest = parts[2]
+	}
+	if len(centroids) == 0 {
+		return pmetric.Metric{}, fmt.Errorf("no centroids for wavefront histogram [%s]", line)
+	}
+
+	parts = strings.SplitN(rest, " ", 2)
+	metricName := unDoubleQuote(parts[0])
+	if metricName == "" {
+		return pmetric.Metric{}, fmt.Errorf("empty name for wavefront histogram [%s]", line)
+	}
+	attributes := pcommon.NewMap()
+	if len(parts) == 2 {
+		if err := buildLabels(attributes, parts[1]); err != nil {
+			return pmetric.Metric{}, fmt.Errorf("invalid wavefront histogram [%s]: %w", line, err)
+		}
+	}
+	if wp.ExtractCollectdTags {
+		metricName = wp.injectCollectDLabels(metricName, attributes)
+	}
+
+	bounds := make([]float64, 0, len(centroids))
+	for centroid := range centroids {
+		bounds = append(bounds, centroid)
+	}
+	sort.Float64s(bounds)
+	// The last bucket, above the greatest centroid, is always empty.
+	bucketCounts := make([]uint64, len(bounds)+1)
+	var count uint64
+	var sum float64
+	for i, bound := range bounds {
+		bucketCounts[i] = centroids[bound]
+		count += bucketCounts[i]
+		sum += float64(bucketCounts[i]) * bound
+	}
+
+	metric := pmetric.NewMetric()
+	metric.SetName(metricName)
+	histogram := metric.SetEmptyHistogram()
+	histogram.SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+	dp := histogram.DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(pcommon.NewTimestampFromTime(ts.Add(-granularity)))
+	dp.SetTimestamp(pcommon.NewTimestampFromTime(ts))
+	attributes.CopyTo(dp.Attributes())
+	dp.ExplicitBounds().FromRaw(bounds)
+	dp.BucketCounts().FromRaw(bucketCounts)
+	dp.SetCount(count)
+	dp.SetSum(sum)
+	dp.SetMin(bounds[0])
+	dp.SetMax(bounds[len(bounds)-1])
+	return metric, nil
+}
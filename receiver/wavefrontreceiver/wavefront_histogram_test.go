@@ -0,0 +1,174 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package wavefrontreceiver
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+func Test_wavefrontParser_ParseHistogram(t *testing.T) {
+	tests := []struct {
+		line                string
+		extractCollectDTags bool
+		want                pmetric.Metric
+		wantErr             bool
+	}{
+		{
+			line: "!M 1582230020 #20 30.0 #10 5.1 request.latency source=appServer1 region=us-west",
+			want: buildHistogramMetric(
+				"request.latency",
+				func() pcommon.Map {
+					m := pcommon.NewMap()
+					m.PutStr("source", "appServer1")
+					m.PutStr("region", "us-west")
+					return m
+				}(),
+				time.Minute,
+				1582230020,
+				[]float64{5.1, 30.0},
+				[]uint64{10, 20, 0},
+			),
+		},
+		{
+			line: "!H 1582230020 #1 2 #3 2 #4 -1.5 no.tags",
+			want: buildHistogramMetric(
+				"no.tags",
+				pcommon.NewMap(),
+				time.Hour,
+				1582230020,
+				[]float64{-1.5, 2},
+				[]uint64{4, 4, 0},
+			),
+		},
+		{
+			line: "!D 1582230020 #7 0.25 \"quoted.name\" source=tst",
+			want: buildHistogramMetric(
+				"quoted.name",
+				func() pcommon.Map {
+					m := pcommon.NewMap()
+					m.PutStr("source", "tst")
+					return m
+				}(),
+				24*time.Hour,
+				1582230020,
+				[]float64{0.25},
+				[]uint64{7, 0},
+			),
+		},
+		{
+			line:                "!M 1582230020 #2 1.5 cpu.[cdk0=cdv0]utilization source=tst",
+			extractCollectDTags: true,
+			want: buildHistogramMetric(
+				"cpu.utilization",
+				func() pcommon.Map {
+					m := pcommon.NewMap()
+					m.PutStr("source", "tst")
+					m.PutStr("cdk0", "cdv0")
+					return m
+				}(),
+				time.Minute,
+				1582230020,
+				[]float64{1.5},
+				[]uint64{2, 0},
+			),
+		},
+		{
+			line:    "!W 1582230020 #1 2 unknown.granularity source=tst",
+			wantErr: true,
+		},
+		{
+			line:    "!M 1582230020 no.centroids source=tst",
+			wantErr: true,
+		},
+		{
+			line:    "!M xyz #1 2 invalid.timestamp source=tst",
+			wantErr: true,
+		},
+		{
+			line:    "!M 1582230020 #x 2 invalid.count source=tst",
+			wantErr: true,
+		},
+		{
+			line:    "!M 1582230020 #1 xyz invalid.centroid source=tst",
+			wantErr: true,
+		},
+		{
+			line:    "!M 1582230020 #1 NaN nan.centroid source=tst",
+			wantErr: true,
+		},
+		{
+			line:    "!M 1582230020 #1 2",
+			wantErr: true,
+		},
+		{
+			line:    "!M 1582230020 #1 2 invalid.tags source",
+			wantErr: true,
+		},
+		{
+			line:    "!M",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.line, func(t *testing.T) {
+			p := WavefrontParser{ExtractCollectdTags: tt.extractCollectDTags}
+			got, err := p.Parse(tt.line)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
+
+func Test_wavefrontParser_ParseHistogramMissingTimestamp(t *testing.T) {
+	p := WavefrontParser{}
+	got, err := p.Parse("!M #3 1.5 no.timestamp source=tst")
+	require.NoError(t, err)
+
+	dp := got.Histogram().DataPoints().At(0)
+	ts := dp.Timestamp().AsTime()
+	assert.LessOrEqual(t, ts, time.Now())
+	assert.LessOrEqual(t, math.Abs(float64(ts.Unix()-time.Now().Unix())), 2.0)
+	assert.Equal(t, ts.Add(-time.Minute), dp.StartTimestamp().AsTime())
+	assert.Equal(t, uint64(3), dp.Count())
+	assert.Equal(t, 4.5, dp.Sum())
+}
+
+func buildHistogramMetric(
+	name string,
+	attributes pcommon.Map,
+	granularity time.Duration,
+	ts int64,
+	bounds []float64,
+	bucketCounts []uint64,
+) pmetric.Metric {
+	metric := pmetric.NewMetric()
+	metric.SetName(name)
+	h := metric.SetEmptyHistogram()
+	h.SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+	dp := h.DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(pcommon.NewTimestampFromTime(time.Unix(ts, 0).Add(-granularity)))
+	dp.SetTimestamp(pcommon.NewTimestampFromTime(time.Unix(ts, 0)))
+	attributes.CopyTo(dp.Attributes())
+	dp.ExplicitBounds().FromRaw(bounds)
+	dp.BucketCounts().FromRaw(bucketCounts)
+	var count uint64
+	var sum float64
+	for i, bound := range bounds {
+		count += bucketCounts[i]
+		sum += float64(bucketCounts[i]) * bound
+	}
+	dp.SetCount(count)
+	dp.SetSum(sum)
+	dp.SetMin(bounds[0])
+	dp.SetMax(bounds[len(bounds)-1])
+	return metric
+}
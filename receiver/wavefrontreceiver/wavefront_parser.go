@@ -47,8 +47,13 @@ func (wp *WavefrontParser) BuildParser() (protocol.Parser, error) {
 //
 //	"<metricName> <metricValue> [<timestamp>] source=<source> [pointTags]"
 //
-// Detailed description of each element is available on the link above.
+// Detailed description of each element is available on the link above. The
+// lines starting with "!" are histograms, see parseHistogram.
 func (wp *WavefrontParser) Parse(line string) (pmetric.Metric, error) {
+	if strings.HasPrefix(line, "!") {
+		return wp.parseHistogram(line)
+	}
+
 	parts := strings.SplitN(line, " ", 3)
 	if len(parts) < 3 {
 		return pmetric.Metric{}, fmt.Errorf("invalid wavefront metric [%s]", line)
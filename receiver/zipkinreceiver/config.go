@@ -4,8 +4,21 @@
 package zipkinreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/zipkinreceiver"
 
 import (
+	"errors"
+	"fmt"
+
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configgrpc"
 	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/config/confignet"
+	"go.opentelemetry.io/collector/confmap"
+)
+
+const (
+	// traceIDFormat128Bit left-pads 64-bit trace IDs with zeros.
+	traceIDFormat128Bit = "128bit"
+	// traceIDFormat64Bit truncates 128-bit trace IDs to their lower 64 bits.
+	traceIDFormat64Bit = "64bit"
 )
 
 // Config defines configuration for Zipkin receiver.
@@ -15,11 +28,39 @@ type Config struct {
 	// If enabled the zipkin receiver will attempt to parse string tags/binary annotations into int/bool/float.
 	// Disabled by default
 	ParseStringTags bool `mapstructure:"parse_string_tags"`
+	// GRPC configures a server receiving Zipkin v3 protobuf spans over gRPC, the
+	// zipkin.proto3.SpanService. Disabled by default.
+	GRPC *configgrpc.ServerConfig `mapstructure:"grpc"`
+	// TraceIDFormat is the format of the received trace IDs, either "128bit" or "64bit".
+	// Zipkin 64-bit trace IDs are left-padded with zeros in the "128bit" format, 128-bit
+	// trace IDs are truncated to their lower 64 bits in the "64bit" format.
+	TraceIDFormat string `mapstructure:"trace_id_format"`
 }
 
 var _ component.Config = (*Config)(nil)
 
 // Validate checks the receiver configuration is valid
 func (cfg *Config) Validate() error {
+	switch cfg.TraceIDFormat {
+	case "", traceIDFormat128Bit, traceIDFormat64Bit:
+	default:
+		return fmt.Errorf("trace_id_format %q is not one of %s or %s", cfg.TraceIDFormat, traceIDFormat128Bit, traceIDFormat64Bit)
+	}
+	if cfg.GRPC != nil && cfg.GRPC.NetAddr.Endpoint == "" {
+		return errors.New("grpc endpoint required")
+	}
+	return nil
+}
+
+var _ confmap.Unmarshaler = (*Config)(nil)
+
+// Unmarshal a confmap.Conf into the config struct, defaulting the transport of the gRPC server to TCP.
+func (cfg *Config) Unmarshal(componentParser *confmap.Conf) error {
+	if err := componentParser.Unmarshal(cfg); err != nil {
+		return err
+	}
+	if cfg.GRPC != nil && cfg.GRPC.NetAddr.Transport == "" {
+		cfg.GRPC.NetAddr.Transport = confignet.TransportTypeTCP
+	}
 	return nil
 }
@@ -10,7 +10,9 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configgrpc"
 	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/config/confignet"
 	"go.opentelemetry.io/collector/confmap/confmaptest"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/zipkinreceiver/internal/metadata"
@@ -37,6 +39,7 @@ func TestLoadConfig(t *testing.T) {
 					Endpoint: "localhost:8765",
 				},
 				ParseStringTags: false,
+				TraceIDFormat:   traceIDFormat128Bit,
 			},
 		},
 		{
@@ -46,6 +49,22 @@ func TestLoadConfig(t *testing.T) {
 					Endpoint: defaultBindEndpoint,
 				},
 				ParseStringTags: true,
+				TraceIDFormat:   traceIDFormat128Bit,
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "grpc"),
+			expected: &Config{
+				ServerConfig: confighttp.ServerConfig{
+					Endpoint: defaultBindEndpoint,
+				},
+				GRPC: &configgrpc.ServerConfig{
+					NetAddr: confignet.AddrConfig{
+						Endpoint:  "localhost:9412",
+						Transport: confignet.TransportTypeTCP,
+					},
+				},
+				TraceIDFormat: traceIDFormat64Bit,
 			},
 		},
 	}
@@ -64,3 +83,32 @@ func TestLoadConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateConfig(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  *Config
+		err  string
+	}{
+		{
+			name: "invalid_trace_id_format",
+			cfg: &Config{
+				TraceIDFormat: "32bit",
+			},
+			err: `trace_id_format "32bit" is not one of 128bit or 64bit`,
+		},
+		{
+			name: "missing_grpc_endpoint",
+			cfg: &Config{
+				GRPC: &configgrpc.ServerConfig{},
+			},
+			err: "grpc endpoint required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.EqualError(t, tt.cfg.Validate(), tt.err)
+		})
+	}
+}
@@ -38,6 +38,7 @@ func createDefaultConfig() component.Config {
 			Endpoint: localhostgate.EndpointForPort(defaultHTTPPort),
 		},
 		ParseStringTags: false,
+		TraceIDFormat:   traceIDFormat128Bit,
 	}
 }
 
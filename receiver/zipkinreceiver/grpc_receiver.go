@@ -0,0 +1,87 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package zipkinreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/zipkinreceiver"
+
+import (
+	"context"
+
+	"github.com/openzipkin/zipkin-go/proto/zipkin_proto3"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const spanServiceReportMethod = "/zipkin.proto3.SpanService/Report"
+
+// spanServiceServer is the server API of the SpanService defined in
+// https://github.com/openzipkin/zipkin-api/blob/master/zipkin.proto.
+// Its ReportResponse message is empty, making it wire compatible with
+// emptypb.Empty.
+type spanServiceServer interface {
+	Report(context.Context, *zipkin_proto3.ListOfSpans) (*emptypb.Empty, error)
+}
+
+// spanServiceDesc registers the SpanService, zipkin-go only provides the
+// generated messages.
+var spanServiceDesc = grpc.ServiceDesc{
+	ServiceName: "zipkin.proto3.SpanService",
+	HandlerType: (*spanServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Report",
+			Handler: func(srv any, ctx context.Context, dec func(any) error, interceptor grpc.UnaryServerInterceptor) (any, error) {
+				in := new(zipkin_proto3.ListOfSpans)
+				if err := dec(in); err != nil {
+					return nil, err
+				}
+				if interceptor == nil {
+					return srv.(spanServiceServer).Report(ctx, in)
+				}
+				info := &grpc.UnaryServerInfo{
+					Server:     srv,
+					FullMethod: spanServiceReportMethod,
+				}
+				handler := func(ctx context.Context, req any) (any, error) {
+					return srv.(spanServiceServer).Report(ctx, req.(*zipkin_proto3.ListOfSpans))
+				}
+				return interceptor(ctx, in, info, handler)
+			},
+		},
+	},
+	Metadata: "zipkin.proto",
+}
+
+var _ spanServiceServer = (*zipkinReceiver)(nil)
+
+// Report receives the spans sent by Zipkin v3 clients over gRPC.
+func (zr *zipkinReceiver) Report(ctx context.Context, in *zipkin_proto3.ListOfSpans) (*emptypb.Empty, error) {
+	obsrecv := zr.obsrecvrs[receiverTransportV2GRPC]
+	ctx = obsrecv.StartTracesOp(ctx)
+
+	// zipkin-go only converts the encoded form of the messages to its
+	// span model, so the request is marshaled again.
+	blob, err := proto.Marshal(in)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	td, err := zr.protobufUnmarshaler.UnmarshalTraces(blob)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	zr.normalizeTraceIDs(td)
+
+	numReceivedSpans := td.SpanCount()
+	consumerErr := zr.nextConsumer.ConsumeTraces(ctx, td)
+	obsrecv.EndTracesOp(ctx, zipkinV2TagValue, numReceivedSpans, consumerErr)
+	if consumerErr == nil {
+		return &emptypb.Empty{}, nil
+	}
+	if consumererror.IsPermanent(consumerErr) {
+		return nil, status.Error(codes.InvalidArgument, consumerErr.Error())
+	}
+	return nil, status.Error(codes.Unavailable, consumerErr.Error())
+}
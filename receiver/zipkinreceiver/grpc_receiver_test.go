@@ -0,0 +1,141 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package zipkinreceiver
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/openzipkin/zipkin-go/proto/zipkin_proto3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config/configgrpc"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/config/confignet"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/common/testutil"
+)
+
+var (
+	testTraceID = []byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}
+	testSpanID  = []byte{1, 2, 3, 4, 5, 6, 7, 8}
+)
+
+func testListOfSpans() *zipkin_proto3.ListOfSpans {
+	return &zipkin_proto3.ListOfSpans{
+		Spans: []*zipkin_proto3.Span{
+			{
+				TraceId:   testTraceID,
+				Id:        testSpanID,
+				Name:      "get /api",
+				Kind:      zipkin_proto3.Span_SERVER,
+				Timestamp: uint64(time.Date(2024, 5, 10, 12, 0, 0, 0, time.UTC).UnixMicro()),
+				Duration:  1000,
+				LocalEndpoint: &zipkin_proto3.Endpoint{
+					ServiceName: "frontend",
+				},
+			},
+		},
+	}
+}
+
+// startGRPCReceiver starts a receiver serving gRPC and returns a client connection to it.
+func startGRPCReceiver(t *testing.T, cfg *Config, next consumer.Traces) *grpc.ClientConn {
+	endpoint := testutil.GetAvailableLocalAddress(t)
+	cfg.ServerConfig = confighttp.ServerConfig{
+		Endpoint: "localhost:0",
+	}
+	cfg.GRPC = &configgrpc.ServerConfig{
+		NetAddr: confignet.AddrConfig{
+			Endpoint:  endpoint,
+			Transport: confignet.TransportTypeTCP,
+		},
+	}
+	zr, err := newReceiver(cfg, next, receivertest.NewNopCreateSettings())
+	require.NoError(t, err)
+	require.NoError(t, zr.Start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() {
+		require.NoError(t, zr.Shutdown(context.Background()))
+	})
+
+	conn, err := grpc.NewClient(endpoint, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, conn.Close())
+	})
+	return conn
+}
+
+func TestReceiverGRPC(t *testing.T) {
+	sink := new(consumertest.TracesSink)
+	conn := startGRPCReceiver(t, &Config{}, sink)
+
+	err := conn.Invoke(context.Background(), spanServiceReportMethod, testListOfSpans(), &emptypb.Empty{})
+	require.NoError(t, err)
+
+	require.Len(t, sink.AllTraces(), 1)
+	td := sink.AllTraces()[0]
+	require.Equal(t, 1, td.SpanCount())
+	serviceName, ok := td.ResourceSpans().At(0).Resource().Attributes().Get("service.name")
+	require.True(t, ok)
+	assert.Equal(t, "frontend", serviceName.Str())
+	span := td.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0)
+	assert.Equal(t, "get /api", span.Name())
+	assert.Equal(t, pcommon.TraceID(testTraceID), span.TraceID())
+	assert.Equal(t, pcommon.SpanID(testSpanID), span.SpanID())
+}
+
+func TestReceiverGRPCConsumerError(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		wantCode codes.Code
+	}{
+		{
+			name:     "transient",
+			err:      errors.New("consumer error"),
+			wantCode: codes.Unavailable,
+		},
+		{
+			name:     "permanent",
+			err:      consumererror.NewPermanent(errors.New("consumer error")),
+			wantCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn := startGRPCReceiver(t, &Config{}, consumertest.NewErr(tt.err))
+
+			err := conn.Invoke(context.Background(), spanServiceReportMethod, testListOfSpans(), &emptypb.Empty{})
+			require.Error(t, err)
+			assert.Equal(t, tt.wantCode, status.Code(err))
+		})
+	}
+}
+
+func TestReceiverTraceIDFormat64Bit(t *testing.T) {
+	sink := new(consumertest.TracesSink)
+	conn := startGRPCReceiver(t, &Config{TraceIDFormat: traceIDFormat64Bit}, sink)
+
+	err := conn.Invoke(context.Background(), spanServiceReportMethod, testListOfSpans(), &emptypb.Empty{})
+	require.NoError(t, err)
+
+	require.Len(t, sink.AllTraces(), 1)
+	span := sink.AllTraces()[0].ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0)
+	assert.Equal(t, pcommon.TraceID([16]byte{0, 0, 0, 0, 0, 0, 0, 0, 9, 10, 11, 12, 13, 14, 15, 16}), span.TraceID())
+}
@@ -8,6 +8,7 @@ import (
 	"compress/zlib"
 	"context"
 	"errors"
+	"fmt"
 	"io"
 	"net"
 	"net/http"
@@ -17,9 +18,11 @@ import (
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.opentelemetry.io/collector/receiver"
 	"go.opentelemetry.io/collector/receiver/receiverhelper"
+	"google.golang.org/grpc"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/zipkin/zipkinv1"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/zipkin/zipkinv2"
@@ -30,6 +33,7 @@ const (
 	receiverTransportV1JSON   = "http_v1_json"
 	receiverTransportV2JSON   = "http_v2_json"
 	receiverTransportV2PROTO  = "http_v2_proto"
+	receiverTransportV2GRPC   = "grpc_v2_proto"
 )
 
 var errNextConsumerRespBody = []byte(`"Internal Server Error"`)
@@ -41,6 +45,7 @@ type zipkinReceiver struct {
 
 	shutdownWG sync.WaitGroup
 	server     *http.Server
+	grpcServer *grpc.Server
 	config     *Config
 
 	v1ThriftUnmarshaler      ptrace.Unmarshaler
@@ -57,7 +62,7 @@ var _ http.Handler = (*zipkinReceiver)(nil)
 
 // newReceiver creates a new zipkinReceiver reference.
 func newReceiver(config *Config, nextConsumer consumer.Traces, settings receiver.CreateSettings) (*zipkinReceiver, error) {
-	transports := []string{receiverTransportV1Thrift, receiverTransportV1JSON, receiverTransportV2JSON, receiverTransportV2PROTO}
+	transports := []string{receiverTransportV1Thrift, receiverTransportV1JSON, receiverTransportV2JSON, receiverTransportV2PROTO, receiverTransportV2GRPC}
 	obsrecvrs := make(map[string]*receiverhelper.ObsReport)
 	for _, transport := range transports {
 		obsrecv, err := receiverhelper.NewObsReport(receiverhelper.ObsReportSettings{
@@ -111,6 +116,29 @@ func (zr *zipkinReceiver) Start(ctx context.Context, host component.Host) error
 		}
 	}()
 
+	if zr.config.GRPC != nil {
+		zr.grpcServer, err = zr.config.GRPC.ToServer(ctx, host, zr.settings.TelemetrySettings)
+		if err != nil {
+			return fmt.Errorf("failed to build the options for the Zipkin gRPC server: %w", err)
+		}
+
+		var grpcListener net.Listener
+		grpcListener, err = zr.config.GRPC.NetAddr.Listen(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to bind to gRPC address %q: %w", zr.config.GRPC.NetAddr.Endpoint, err)
+		}
+		zr.grpcServer.RegisterService(&spanServiceDesc, zr)
+
+		zr.shutdownWG.Add(1)
+		go func() {
+			defer zr.shutdownWG.Done()
+
+			if errGRPC := zr.grpcServer.Serve(grpcListener); !errors.Is(errGRPC, grpc.ErrServerStopped) && errGRPC != nil {
+				zr.settings.TelemetrySettings.ReportStatus(component.NewFatalErrorEvent(errGRPC))
+			}
+		}()
+	}
+
 	return nil
 }
 
@@ -152,6 +180,9 @@ func (zr *zipkinReceiver) Shutdown(context.Context) error {
 	if zr.server != nil {
 		err = zr.server.Close()
 	}
+	if zr.grpcServer != nil {
+		zr.grpcServer.GracefulStop()
+	}
 	zr.shutdownWG.Wait()
 	return err
 }
@@ -229,6 +260,7 @@ func (zr *zipkinReceiver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
+	zr.normalizeTraceIDs(td)
 
 	numReceivedSpans := td.SpanCount()
 	consumerErr := zr.nextConsumer.ConsumeTraces(ctx, td)
@@ -256,6 +288,34 @@ func (zr *zipkinReceiver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 }
 
+// normalizeTraceIDs truncates the trace IDs of the spans and of their links
+// to their lower 64 bits when the 64-bit trace ID format is configured.
+func (zr *zipkinReceiver) normalizeTraceIDs(td ptrace.Traces) {
+	if zr.config.TraceIDFormat != traceIDFormat64Bit {
+		return
+	}
+	for i := 0; i < td.ResourceSpans().Len(); i++ {
+		ilss := td.ResourceSpans().At(i).ScopeSpans()
+		for j := 0; j < ilss.Len(); j++ {
+			spans := ilss.At(j).Spans()
+			for k := 0; k < spans.Len(); k++ {
+				span := spans.At(k)
+				span.SetTraceID(truncateTraceID(span.TraceID()))
+				for l := 0; l < span.Links().Len(); l++ {
+					link := span.Links().At(l)
+					link.SetTraceID(truncateTraceID(link.TraceID()))
+				}
+			}
+		}
+	}
+}
+
+func truncateTraceID(traceID pcommon.TraceID) pcommon.TraceID {
+	var truncated pcommon.TraceID
+	copy(truncated[8:], traceID[8:])
+	return truncated
+}
+
 func transportType(r *http.Request, asZipkinv1 bool) string {
 	if asZipkinv1 {
 		if r.Header.Get("Content-Type") == "application/x-thrift" {
@@ -0,0 +1,111 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package zookeeperreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/zookeeperreceiver"
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+const leaderCommand = "leader"
+
+// errCommandFailed is returned when the AdminServer answered with the error of the command.
+var errCommandFailed = errors.New("command failed")
+
+// adminClient runs the commands through the HTTP API of the AdminServer, each command being
+// served at `<endpoint>/<command>` as a JSON object.
+type adminClient struct {
+	client   *http.Client
+	endpoint string
+}
+
+func newAdminClient(client *http.Client, endpoint string) *adminClient {
+	return &adminClient{
+		client:   client,
+		endpoint: strings.TrimSuffix(endpoint, "/"),
+	}
+}
+
+// command returns the fields of the response to the command, without the `command` and
+// `error` fields shared by all the commands.
+func (c *adminClient) command(ctx context.Context, command string) (map[string]any, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.endpoint+"/"+command, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("the %s command of the AdminServer returned status %d", command, resp.StatusCode)
+	}
+
+	var fields map[string]any
+	decoder := json.NewDecoder(resp.Body)
+	decoder.UseNumber()
+	if err = decoder.Decode(&fields); err != nil {
+		return nil, fmt.Errorf("failed to decode the response to the %s command: %w", command, err)
+	}
+	if cmdErr := fields["error"]; cmdErr != nil {
+		return nil, fmt.Errorf("%w: %s: %v", errCommandFailed, command, cmdErr)
+	}
+	delete(fields, "command")
+	delete(fields, "error")
+	return fields, nil
+}
+
+// mntr returns the response to the mntr command formatted as the response to the four letter
+// word command, a `zk_<key>\t<value>` line per field.
+func (c *adminClient) mntr(ctx context.Context) ([]string, error) {
+	fields, err := c.command(ctx, mntrCommand)
+	if err != nil {
+		return nil, err
+	}
+	response := make([]string, 0, len(fields))
+	for key, value := range fields {
+		switch value.(type) {
+		case map[string]any, []any, nil:
+			// the nested values have no equivalent in the four letter word command
+			continue
+		}
+		response = append(response, fmt.Sprintf("zk_%s\t%v", key, value))
+	}
+	sort.Strings(response)
+	return response, nil
+}
+
+// ruok returns the response to the ruok command formatted as the response to the four letter
+// word command, empty when the AdminServer answered with the error of the command.
+func (c *adminClient) ruok(ctx context.Context) ([]string, error) {
+	_, err := c.command(ctx, ruokCommand)
+	switch {
+	case errors.Is(err, errCommandFailed):
+		return nil, nil
+	case err != nil:
+		return nil, err
+	}
+	return []string{"imok"}, nil
+}
+
+// leader returns the ID and the address of the leader of the ensemble.
+func (c *adminClient) leader(ctx context.Context) (id string, ip string, err error) {
+	fields, err := c.command(ctx, leaderCommand)
+	if err != nil {
+		return "", "", err
+	}
+	if leaderID, ok := fields["leader_id"]; ok {
+		id = fmt.Sprint(leaderID)
+	}
+	if leaderIP, ok := fields["leader_ip"]; ok {
+		ip = fmt.Sprint(leaderIP)
+	}
+	return id, ip, nil
+}
@@ -0,0 +1,110 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package zookeeperreceiver
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newMockAdminServer serves the responses of testdata/admin to the commands, the commands
+// missing from responses being answered with their file.
+func newMockAdminServer(t *testing.T, responses map[string]string) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		command := strings.TrimPrefix(r.URL.Path, "/commands/")
+		if response, ok := responses[command]; ok {
+			_, _ = w.Write([]byte(response))
+			return
+		}
+		out, err := os.ReadFile(filepath.Join("testdata", "admin", command+".json"))
+		if err != nil {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write(out)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestAdminClientMntr(t *testing.T) {
+	server := newMockAdminServer(t, nil)
+	client := newAdminClient(server.Client(), server.URL+"/commands/")
+
+	response, err := client.mntr(context.Background())
+	require.NoError(t, err)
+	assert.Contains(t, response, "zk_server_state\tleader")
+	assert.Contains(t, response, "zk_znode_count\t5")
+	assert.Contains(t, response, "zk_version\t3.5.5-390fe37ea45dee01bf87dc1c042b5e3dcce88653, built on 05/03/2019 12:07 GMT")
+	for _, line := range response {
+		assert.False(t, strings.HasPrefix(line, "zk_command") || strings.HasPrefix(line, "zk_error"), line)
+	}
+}
+
+func TestAdminClientRuok(t *testing.T) {
+	tests := []struct {
+		name     string
+		response string
+		expected []string
+		wantErr  string
+	}{
+		{
+			name:     "running",
+			expected: []string{"imok"},
+		},
+		{
+			name:     "command error",
+			response: `{"command": "ruok", "error": "ZooKeeperServer is not running"}`,
+		},
+		{
+			name:     "invalid response",
+			response: "imok",
+			wantErr:  "failed to decode the response to the ruok command: invalid character 'i' looking for beginning of value",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			responses := map[string]string{}
+			if tt.response != "" {
+				responses[ruokCommand] = tt.response
+			}
+			server := newMockAdminServer(t, responses)
+			client := newAdminClient(server.Client(), server.URL+"/commands")
+
+			response, err := client.ruok(context.Background())
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, response)
+		})
+	}
+}
+
+func TestAdminClientLeader(t *testing.T) {
+	server := newMockAdminServer(t, nil)
+	client := newAdminClient(server.Client(), server.URL+"/commands")
+
+	id, ip, err := client.leader(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "1", id)
+	assert.Equal(t, "zoo1", ip)
+}
+
+func TestAdminClientUnknownCommand(t *testing.T) {
+	server := newMockAdminServer(t, nil)
+	client := newAdminClient(server.Client(), server.URL+"/commands")
+
+	_, err := client.command(context.Background(), "unknown")
+	assert.EqualError(t, err, "the unknown command of the AdminServer returned status 404")
+}
@@ -4,6 +4,7 @@
 package zookeeperreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/zookeeperreceiver"
 
 import (
+	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/config/confignet"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
 
@@ -14,4 +15,14 @@ type Config struct {
 	scraperhelper.ControllerConfig `mapstructure:",squash"`
 	confignet.TCPAddrConfig        `mapstructure:",squash"`
 	metadata.MetricsBuilderConfig  `mapstructure:",squash"`
+	// AdminServer runs the commands through the HTTP API of the AdminServer, instead of the
+	// four letter word commands which are often disabled.
+	AdminServer AdminServerConfig `mapstructure:"admin_server"`
+}
+
+// AdminServerConfig configures the client of the AdminServer, its endpoint being the URL of
+// its commands.
+type AdminServerConfig struct {
+	Enabled                 bool `mapstructure:"enabled"`
+	confighttp.ClientConfig `mapstructure:",squash"`
 }
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/config/confignet"
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/receiver"
@@ -21,6 +22,8 @@ const (
 	defaultPort               = 2181
 	defaultCollectionInterval = 10 * time.Second
 	defaultTimeout            = 10 * time.Second
+
+	defaultAdminServerEndpoint = "http://localhost:8080/commands"
 )
 
 func NewFactory() receiver.Factory {
@@ -28,6 +31,7 @@ func NewFactory() receiver.Factory {
 		metadata.Type,
 		createDefaultConfig,
 		receiver.WithMetrics(createMetricsReceiver, metadata.MetricsStability),
+		receiver.WithLogs(createLogsReceiver, metadata.LogsStability),
 	)
 }
 
@@ -36,12 +40,19 @@ func createDefaultConfig() component.Config {
 	cfg.CollectionInterval = defaultCollectionInterval
 	cfg.Timeout = defaultTimeout
 
+	adminServer := confighttp.NewDefaultClientConfig()
+	adminServer.Endpoint = defaultAdminServerEndpoint
+	adminServer.Timeout = defaultTimeout
+
 	return &Config{
 		ControllerConfig: cfg,
 		TCPAddrConfig: confignet.TCPAddrConfig{
 			Endpoint: localhostgate.EndpointForPort(defaultPort),
 		},
 		MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+		AdminServer: AdminServerConfig{
+			ClientConfig: adminServer,
+		},
 	}
 }
 
@@ -61,6 +72,7 @@ func createMetricsReceiver(
 	scrp, err := scraperhelper.NewScraper(
 		metadata.Type.String(),
 		zms.scrape,
+		scraperhelper.WithStart(zms.start),
 		scraperhelper.WithShutdown(zms.shutdown),
 	)
 	if err != nil {
@@ -74,3 +86,13 @@ func createMetricsReceiver(
 		scraperhelper.AddScraper(scrp),
 	)
 }
+
+// createLogsReceiver creates zookeeper (logs) receiver, emitting the leader change events.
+func createLogsReceiver(
+	_ context.Context,
+	params receiver.CreateSettings,
+	config component.Config,
+	consumer consumer.Logs,
+) (receiver.Logs, error) {
+	return newZookeeperLogsReceiver(config.(*Config), params, consumer)
+}
@@ -0,0 +1,161 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package zookeeperreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/zookeeperreceiver"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/receiver"
+	"go.uber.org/zap"
+)
+
+const (
+	// logsScopeName matches the scope name of the metrics emitted by the receiver
+	logsScopeName = "otelcol/zookeeperreceiver"
+
+	leaderChangeEventName = "zookeeper.leader.change"
+)
+
+// zookeeperLogsReceiver polls the server for its state and emits an event each time the
+// server changes state or, through the AdminServer, the ensemble elects another leader.
+type zookeeperLogsReceiver struct {
+	cfg      *Config
+	settings component.TelemetrySettings
+	consumer consumer.Logs
+	scraper  *zookeeperMetricsScraper
+	cancel   context.CancelFunc
+	wg       *sync.WaitGroup
+
+	// observed, state and leaderID record the state of the server and the leader of the
+	// previous poll, so only changes are emitted.
+	observed bool
+	state    string
+	leaderID string
+}
+
+func newZookeeperLogsReceiver(cfg *Config, set receiver.CreateSettings, consumer consumer.Logs) (*zookeeperLogsReceiver, error) {
+	scraper, err := newZookeeperMetricsScraper(set, cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &zookeeperLogsReceiver{
+		cfg:      cfg,
+		settings: set.TelemetrySettings,
+		consumer: consumer,
+		scraper:  scraper,
+		wg:       &sync.WaitGroup{},
+	}, nil
+}
+
+func (r *zookeeperLogsReceiver) Start(ctx context.Context, host component.Host) error {
+	if err := r.scraper.start(ctx, host); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	r.cancel = cancel
+
+	t := time.NewTicker(r.cfg.CollectionInterval)
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		defer t.Stop()
+		for {
+			select {
+			case <-t.C:
+				if err := r.poll(ctx); err != nil {
+					r.settings.Logger.Error("Failed to check the state of the zookeeper server", zap.Error(err))
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+func (r *zookeeperLogsReceiver) Shutdown(context.Context) error {
+	if r.cancel != nil {
+		r.cancel()
+	}
+	r.wg.Wait()
+	return nil
+}
+
+func (r *zookeeperLogsReceiver) poll(ctx context.Context) error {
+	pollCtx, cancel := context.WithTimeout(ctx, r.cfg.Timeout)
+	defer cancel()
+
+	response, err := r.scraper.mntr(pollCtx)
+	if err != nil {
+		return err
+	}
+	state := serverState(response)
+	if state == "" {
+		return errors.New("the response to the mntr command has no server state")
+	}
+
+	leaderID, leaderIP := r.leaderID, ""
+	if r.scraper.admin != nil && state != "standalone" {
+		// the leader command is only available since zookeeper 3.7
+		if leaderID, leaderIP, err = r.scraper.admin.leader(pollCtx); err != nil {
+			r.settings.Logger.Debug("Failed to get the leader of the ensemble", zap.Error(err))
+			leaderID, leaderIP = r.leaderID, ""
+		}
+	}
+
+	previousState, previousLeaderID := r.state, r.leaderID
+	observed := r.observed
+	r.observed, r.state, r.leaderID = true, state, leaderID
+	if !observed || (state == previousState && leaderID == previousLeaderID) {
+		return nil
+	}
+
+	now := pcommon.NewTimestampFromTime(time.Now())
+	logs := plog.NewLogs()
+	sl := logs.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty()
+	sl.Scope().SetName(logsScopeName)
+	lr := sl.LogRecords().AppendEmpty()
+	lr.SetTimestamp(now)
+	lr.SetObservedTimestamp(now)
+	lr.SetSeverityNumber(plog.SeverityNumberInfo)
+	lr.SetSeverityText("INFO")
+	if state != previousState {
+		lr.Body().SetStr(fmt.Sprintf("zookeeper server state changed from %s to %s", previousState, state))
+	} else {
+		lr.Body().SetStr(fmt.Sprintf("zookeeper leader changed from %s to %s", previousLeaderID, leaderID))
+	}
+
+	attrs := lr.Attributes()
+	attrs.PutStr("event.domain", "zookeeper")
+	attrs.PutStr("event.name", leaderChangeEventName)
+	attrs.PutStr("zookeeper.server.state", state)
+	attrs.PutStr("zookeeper.server.state.previous", previousState)
+	if leaderID != "" {
+		attrs.PutStr("zookeeper.leader.id", leaderID)
+	}
+	if leaderIP != "" {
+		attrs.PutStr("zookeeper.leader.ip", leaderIP)
+	}
+	return r.consumer.ConsumeLogs(ctx, logs)
+}
+
+// serverState returns the state of the server in the response to the mntr command.
+func serverState(response []string) string {
+	for _, line := range response {
+		parts := zookeeperFormatRE.FindStringSubmatch(line)
+		if len(parts) == 3 && parts[1] == serverStateKey {
+			return parts[2]
+		}
+	}
+	return ""
+}
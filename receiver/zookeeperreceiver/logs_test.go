@@ -0,0 +1,109 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package zookeeperreceiver
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+)
+
+func TestZookeeperLogsReceiverPoll(t *testing.T) {
+	responses := map[string]string{}
+	setState := func(state string, leaderID int) {
+		responses[mntrCommand] = fmt.Sprintf(`{"server_state": %q, "command": "monitor", "error": null}`, state)
+		responses[leaderCommand] = fmt.Sprintf(`{"is_leader": %t, "leader_id": %d, "leader_ip": "zoo%d", "command": "leader", "error": null}`,
+			state == "leader", leaderID, leaderID)
+	}
+	server := newMockAdminServer(t, responses)
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.AdminServer.Enabled = true
+	cfg.AdminServer.Endpoint = server.URL + "/commands"
+	sink := &consumertest.LogsSink{}
+	r, err := newZookeeperLogsReceiver(cfg, receivertest.NewNopCreateSettings(), sink)
+	require.NoError(t, err)
+	require.NoError(t, r.scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	// the state of the first poll is recorded without events
+	setState("follower", 1)
+	require.NoError(t, r.poll(context.Background()))
+	assert.Equal(t, 0, sink.LogRecordCount())
+
+	// another server is elected
+	setState("follower", 2)
+	require.NoError(t, r.poll(context.Background()))
+	require.Equal(t, 1, sink.LogRecordCount())
+	lr := sink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	assert.Equal(t, "zookeeper leader changed from 1 to 2", lr.Body().Str())
+	assert.Equal(t, map[string]any{
+		"event.domain":                    "zookeeper",
+		"event.name":                      leaderChangeEventName,
+		"zookeeper.server.state":          "follower",
+		"zookeeper.server.state.previous": "follower",
+		"zookeeper.leader.id":             "2",
+		"zookeeper.leader.ip":             "zoo2",
+	}, lr.Attributes().AsRaw())
+
+	// nothing changed
+	require.NoError(t, r.poll(context.Background()))
+	assert.Equal(t, 1, sink.LogRecordCount())
+
+	// the server is elected
+	setState("leader", 3)
+	require.NoError(t, r.poll(context.Background()))
+	require.Equal(t, 2, sink.LogRecordCount())
+	lr = sink.AllLogs()[1].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	assert.Equal(t, "zookeeper server state changed from follower to leader", lr.Body().Str())
+	state, ok := lr.Attributes().Get("zookeeper.server.state")
+	require.True(t, ok)
+	assert.Equal(t, "leader", state.Str())
+}
+
+func TestZookeeperLogsReceiverPollWithoutLeaderCommand(t *testing.T) {
+	responses := map[string]string{
+		mntrCommand:   `{"server_state": "follower", "command": "monitor", "error": null}`,
+		leaderCommand: `{"command": "leader", "error": "Unknown command: leader"}`,
+	}
+	server := newMockAdminServer(t, responses)
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.AdminServer.Enabled = true
+	cfg.AdminServer.Endpoint = server.URL + "/commands"
+	sink := &consumertest.LogsSink{}
+	r, err := newZookeeperLogsReceiver(cfg, receivertest.NewNopCreateSettings(), sink)
+	require.NoError(t, err)
+	require.NoError(t, r.scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	require.NoError(t, r.poll(context.Background()))
+	responses[mntrCommand] = `{"server_state": "leader", "command": "monitor", "error": null}`
+	require.NoError(t, r.poll(context.Background()))
+
+	require.Equal(t, 1, sink.LogRecordCount())
+	lr := sink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	assert.Equal(t, "zookeeper server state changed from follower to leader", lr.Body().Str())
+	_, ok := lr.Attributes().Get("zookeeper.leader.id")
+	assert.False(t, ok)
+}
+
+func TestZookeeperLogsReceiverPollWithoutState(t *testing.T) {
+	server := newMockAdminServer(t, map[string]string{
+		mntrCommand: `{"command": "monitor", "error": null}`,
+	})
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.AdminServer.Enabled = true
+	cfg.AdminServer.Endpoint = server.URL + "/commands"
+	r, err := newZookeeperLogsReceiver(cfg, receivertest.NewNopCreateSettings(), consumertest.NewNop())
+	require.NoError(t, err)
+	require.NoError(t, r.scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	assert.EqualError(t, r.poll(context.Background()), "the response to the mntr command has no server state")
+}
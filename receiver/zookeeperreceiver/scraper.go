@@ -13,6 +13,7 @@ import (
 	"strconv"
 	"time"
 
+	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver"
@@ -29,11 +30,14 @@ const (
 )
 
 type zookeeperMetricsScraper struct {
-	logger *zap.Logger
-	config *Config
-	cancel context.CancelFunc
-	rb     *metadata.ResourceBuilder
-	mb     *metadata.MetricsBuilder
+	logger   *zap.Logger
+	settings component.TelemetrySettings
+	config   *Config
+	cancel   context.CancelFunc
+	rb       *metadata.ResourceBuilder
+	mb       *metadata.MetricsBuilder
+	// admin runs the commands through the AdminServer, when enabled.
+	admin *adminClient
 
 	// For mocking.
 	closeConnection       func(net.Conn) error
@@ -57,6 +61,7 @@ func newZookeeperMetricsScraper(settings receiver.CreateSettings, config *Config
 
 	z := &zookeeperMetricsScraper{
 		logger:                settings.Logger,
+		settings:              settings.TelemetrySettings,
 		config:                config,
 		rb:                    metadata.NewResourceBuilder(config.ResourceAttributes),
 		mb:                    metadata.NewMetricsBuilder(config.MetricsBuilderConfig, settings),
@@ -68,6 +73,18 @@ func newZookeeperMetricsScraper(settings receiver.CreateSettings, config *Config
 	return z, nil
 }
 
+func (z *zookeeperMetricsScraper) start(ctx context.Context, host component.Host) error {
+	if !z.config.AdminServer.Enabled {
+		return nil
+	}
+	client, err := z.config.AdminServer.ToClient(ctx, host, z.settings)
+	if err != nil {
+		return fmt.Errorf("failed to create the client of the AdminServer: %w", err)
+	}
+	z.admin = newAdminClient(client, z.config.AdminServer.Endpoint)
+	return nil
+}
+
 func (z *zookeeperMetricsScraper) shutdown(_ context.Context) error {
 	if z.cancel != nil {
 		z.cancel()
@@ -77,12 +94,12 @@ func (z *zookeeperMetricsScraper) shutdown(_ context.Context) error {
 }
 
 func (z *zookeeperMetricsScraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
-	responseMntr, err := z.runCommand(ctx, "mntr")
+	responseMntr, err := z.mntr(ctx)
 	if err != nil {
 		return pmetric.NewMetrics(), err
 	}
 
-	responseRuok, err := z.runCommand(ctx, "ruok")
+	responseRuok, err := z.ruok(ctx)
 	if err != nil {
 		return pmetric.NewMetrics(), err
 	}
@@ -93,6 +110,22 @@ func (z *zookeeperMetricsScraper) scrape(ctx context.Context) (pmetric.Metrics,
 	return z.mb.Emit(metadata.WithResource(z.rb.Emit())), nil
 }
 
+// mntr returns the response to the mntr command, through the AdminServer when enabled.
+func (z *zookeeperMetricsScraper) mntr(ctx context.Context) ([]string, error) {
+	if z.admin != nil {
+		return z.admin.mntr(ctx)
+	}
+	return z.runCommand(ctx, mntrCommand)
+}
+
+// ruok returns the response to the ruok command, through the AdminServer when enabled.
+func (z *zookeeperMetricsScraper) ruok(ctx context.Context) ([]string, error) {
+	if z.admin != nil {
+		return z.admin.ruok(ctx)
+	}
+	return z.runCommand(ctx, ruokCommand)
+}
+
 func (z *zookeeperMetricsScraper) runCommand(ctx context.Context, command string) ([]string, error) {
 	conn, err := z.config.Dial(context.Background())
 
@@ -16,6 +16,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver/receivertest"
 	"go.uber.org/zap"
@@ -337,6 +338,28 @@ func TestZookeeperMetricsScraperScrape(t *testing.T) {
 	}
 }
 
+func TestZookeeperMetricsScraperScrapeAdminServer(t *testing.T) {
+	server := newMockAdminServer(t, nil)
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.AdminServer.Enabled = true
+	cfg.AdminServer.Endpoint = server.URL + "/commands"
+
+	z, err := newZookeeperMetricsScraper(receivertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err)
+	require.NoError(t, z.start(context.Background(), componenttest.NewNopHost()))
+
+	actualMetrics, err := z.scrape(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, z.shutdown(context.Background()))
+
+	// the AdminServer returns the same values as the four letter word commands of the server
+	expectedMetrics, err := golden.ReadMetrics(filepath.Join("testdata", "scraper", "correctness-v3.5.5.yaml"))
+	require.NoError(t, err)
+	require.NoError(t, pmetrictest.CompareMetrics(expectedMetrics, actualMetrics,
+		pmetrictest.IgnoreStartTimestamp(), pmetrictest.IgnoreTimestamp()))
+}
+
 func TestZookeeperShutdownBeforeScrape(t *testing.T) {
 	cfg := createDefaultConfig().(*Config)
 	z, err := newZookeeperMetricsScraper(receivertest.NewNopCreateSettings(), cfg)